@@ -0,0 +1,434 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package apitokens
+
+import (
+	http "net/http"
+
+	apitokens "github.com/backtesting-org/live-trading/pkg/apitokens"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TokenStore is an autogenerated mock type for the TokenStore type
+type TokenStore struct {
+	mock.Mock
+}
+
+type TokenStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TokenStore) EXPECT() *TokenStore_Expecter {
+	return &TokenStore_Expecter{mock: &_m.Mock}
+}
+
+// Admin provides a mock function with given fields: secret
+func (_m *TokenStore) Admin(secret string) bool {
+	ret := _m.Called(secret)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Admin")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(secret)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// TokenStore_Admin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Admin'
+type TokenStore_Admin_Call struct {
+	*mock.Call
+}
+
+// Admin is a helper method to define mock.On call
+//   - secret string
+func (_e *TokenStore_Expecter) Admin(secret interface{}) *TokenStore_Admin_Call {
+	return &TokenStore_Admin_Call{Call: _e.mock.On("Admin", secret)}
+}
+
+func (_c *TokenStore_Admin_Call) Run(run func(secret string)) *TokenStore_Admin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *TokenStore_Admin_Call) Return(_a0 bool) *TokenStore_Admin_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TokenStore_Admin_Call) RunAndReturn(run func(string) bool) *TokenStore_Admin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Authorize provides a mock function with given fields: secret, runID, scope
+func (_m *TokenStore) Authorize(secret string, runID string, scope apitokens.Scope) (apitokens.Token, error) {
+	ret := _m.Called(secret, runID, scope)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Authorize")
+	}
+
+	var r0 apitokens.Token
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, apitokens.Scope) (apitokens.Token, error)); ok {
+		return rf(secret, runID, scope)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, apitokens.Scope) apitokens.Token); ok {
+		r0 = rf(secret, runID, scope)
+	} else {
+		r0 = ret.Get(0).(apitokens.Token)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, apitokens.Scope) error); ok {
+		r1 = rf(secret, runID, scope)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TokenStore_Authorize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Authorize'
+type TokenStore_Authorize_Call struct {
+	*mock.Call
+}
+
+// Authorize is a helper method to define mock.On call
+//   - secret string
+//   - runID string
+//   - scope apitokens.Scope
+func (_e *TokenStore_Expecter) Authorize(secret interface{}, runID interface{}, scope interface{}) *TokenStore_Authorize_Call {
+	return &TokenStore_Authorize_Call{Call: _e.mock.On("Authorize", secret, runID, scope)}
+}
+
+func (_c *TokenStore_Authorize_Call) Run(run func(secret string, runID string, scope apitokens.Scope)) *TokenStore_Authorize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(apitokens.Scope))
+	})
+	return _c
+}
+
+func (_c *TokenStore_Authorize_Call) Return(_a0 apitokens.Token, _a1 error) *TokenStore_Authorize_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TokenStore_Authorize_Call) RunAndReturn(run func(string, string, apitokens.Scope) (apitokens.Token, error)) *TokenStore_Authorize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *TokenStore) Configure(config apitokens.Config) error {
+	ret := _m.Called(config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Configure")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(apitokens.Config) error); ok {
+		r0 = rf(config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TokenStore_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type TokenStore_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config apitokens.Config
+func (_e *TokenStore_Expecter) Configure(config interface{}) *TokenStore_Configure_Call {
+	return &TokenStore_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *TokenStore_Configure_Call) Run(run func(config apitokens.Config)) *TokenStore_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(apitokens.Config))
+	})
+	return _c
+}
+
+func (_c *TokenStore_Configure_Call) Return(_a0 error) *TokenStore_Configure_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TokenStore_Configure_Call) RunAndReturn(run func(apitokens.Config) error) *TokenStore_Configure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Handler provides a mock function with no fields
+func (_m *TokenStore) Handler() http.Handler {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handler")
+	}
+
+	var r0 http.Handler
+	if rf, ok := ret.Get(0).(func() http.Handler); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(http.Handler)
+		}
+	}
+
+	return r0
+}
+
+// TokenStore_Handler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handler'
+type TokenStore_Handler_Call struct {
+	*mock.Call
+}
+
+// Handler is a helper method to define mock.On call
+func (_e *TokenStore_Expecter) Handler() *TokenStore_Handler_Call {
+	return &TokenStore_Handler_Call{Call: _e.mock.On("Handler")}
+}
+
+func (_c *TokenStore_Handler_Call) Run(run func()) *TokenStore_Handler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TokenStore_Handler_Call) Return(_a0 http.Handler) *TokenStore_Handler_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TokenStore_Handler_Call) RunAndReturn(run func() http.Handler) *TokenStore_Handler_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Issue provides a mock function with given fields: request
+func (_m *TokenStore) Issue(request apitokens.Request) (apitokens.Issued, error) {
+	ret := _m.Called(request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Issue")
+	}
+
+	var r0 apitokens.Issued
+	var r1 error
+	if rf, ok := ret.Get(0).(func(apitokens.Request) (apitokens.Issued, error)); ok {
+		return rf(request)
+	}
+	if rf, ok := ret.Get(0).(func(apitokens.Request) apitokens.Issued); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Get(0).(apitokens.Issued)
+	}
+
+	if rf, ok := ret.Get(1).(func(apitokens.Request) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TokenStore_Issue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Issue'
+type TokenStore_Issue_Call struct {
+	*mock.Call
+}
+
+// Issue is a helper method to define mock.On call
+//   - request apitokens.Request
+func (_e *TokenStore_Expecter) Issue(request interface{}) *TokenStore_Issue_Call {
+	return &TokenStore_Issue_Call{Call: _e.mock.On("Issue", request)}
+}
+
+func (_c *TokenStore_Issue_Call) Run(run func(request apitokens.Request)) *TokenStore_Issue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(apitokens.Request))
+	})
+	return _c
+}
+
+func (_c *TokenStore_Issue_Call) Return(_a0 apitokens.Issued, _a1 error) *TokenStore_Issue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TokenStore_Issue_Call) RunAndReturn(run func(apitokens.Request) (apitokens.Issued, error)) *TokenStore_Issue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Protect provides a mock function with given fields: scope, handler
+func (_m *TokenStore) Protect(scope apitokens.Scope, handler http.Handler) http.Handler {
+	ret := _m.Called(scope, handler)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Protect")
+	}
+
+	var r0 http.Handler
+	if rf, ok := ret.Get(0).(func(apitokens.Scope, http.Handler) http.Handler); ok {
+		r0 = rf(scope, handler)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(http.Handler)
+		}
+	}
+
+	return r0
+}
+
+// TokenStore_Protect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Protect'
+type TokenStore_Protect_Call struct {
+	*mock.Call
+}
+
+// Protect is a helper method to define mock.On call
+//   - scope apitokens.Scope
+//   - handler http.Handler
+func (_e *TokenStore_Expecter) Protect(scope interface{}, handler interface{}) *TokenStore_Protect_Call {
+	return &TokenStore_Protect_Call{Call: _e.mock.On("Protect", scope, handler)}
+}
+
+func (_c *TokenStore_Protect_Call) Run(run func(scope apitokens.Scope, handler http.Handler)) *TokenStore_Protect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(apitokens.Scope), args[1].(http.Handler))
+	})
+	return _c
+}
+
+func (_c *TokenStore_Protect_Call) Return(_a0 http.Handler) *TokenStore_Protect_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TokenStore_Protect_Call) RunAndReturn(run func(apitokens.Scope, http.Handler) http.Handler) *TokenStore_Protect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revoke provides a mock function with given fields: id, actor
+func (_m *TokenStore) Revoke(id string, actor string) error {
+	ret := _m.Called(id, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revoke")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(id, actor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TokenStore_Revoke_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revoke'
+type TokenStore_Revoke_Call struct {
+	*mock.Call
+}
+
+// Revoke is a helper method to define mock.On call
+//   - id string
+//   - actor string
+func (_e *TokenStore_Expecter) Revoke(id interface{}, actor interface{}) *TokenStore_Revoke_Call {
+	return &TokenStore_Revoke_Call{Call: _e.mock.On("Revoke", id, actor)}
+}
+
+func (_c *TokenStore_Revoke_Call) Run(run func(id string, actor string)) *TokenStore_Revoke_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TokenStore_Revoke_Call) Return(_a0 error) *TokenStore_Revoke_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TokenStore_Revoke_Call) RunAndReturn(run func(string, string) error) *TokenStore_Revoke_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Tokens provides a mock function with no fields
+func (_m *TokenStore) Tokens() []apitokens.Token {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Tokens")
+	}
+
+	var r0 []apitokens.Token
+	if rf, ok := ret.Get(0).(func() []apitokens.Token); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]apitokens.Token)
+		}
+	}
+
+	return r0
+}
+
+// TokenStore_Tokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Tokens'
+type TokenStore_Tokens_Call struct {
+	*mock.Call
+}
+
+// Tokens is a helper method to define mock.On call
+func (_e *TokenStore_Expecter) Tokens() *TokenStore_Tokens_Call {
+	return &TokenStore_Tokens_Call{Call: _e.mock.On("Tokens")}
+}
+
+func (_c *TokenStore_Tokens_Call) Run(run func()) *TokenStore_Tokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TokenStore_Tokens_Call) Return(_a0 []apitokens.Token) *TokenStore_Tokens_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TokenStore_Tokens_Call) RunAndReturn(run func() []apitokens.Token) *TokenStore_Tokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTokenStore creates a new instance of TokenStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTokenStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TokenStore {
+	mock := &TokenStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
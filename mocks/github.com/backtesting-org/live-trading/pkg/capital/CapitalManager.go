@@ -0,0 +1,353 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package capital
+
+import (
+	http "net/http"
+
+	capital "github.com/backtesting-org/live-trading/pkg/capital"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CapitalManager is an autogenerated mock type for the CapitalManager type
+type CapitalManager struct {
+	mock.Mock
+}
+
+type CapitalManager_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CapitalManager) EXPECT() *CapitalManager_Expecter {
+	return &CapitalManager_Expecter{mock: &_m.Mock}
+}
+
+// Allocation provides a mock function with given fields: runID
+func (_m *CapitalManager) Allocation(runID string) (capital.Allocation, bool) {
+	ret := _m.Called(runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Allocation")
+	}
+
+	var r0 capital.Allocation
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string) (capital.Allocation, bool)); ok {
+		return rf(runID)
+	}
+	if rf, ok := ret.Get(0).(func(string) capital.Allocation); ok {
+		r0 = rf(runID)
+	} else {
+		r0 = ret.Get(0).(capital.Allocation)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(runID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// CapitalManager_Allocation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Allocation'
+type CapitalManager_Allocation_Call struct {
+	*mock.Call
+}
+
+// Allocation is a helper method to define mock.On call
+//   - runID string
+func (_e *CapitalManager_Expecter) Allocation(runID interface{}) *CapitalManager_Allocation_Call {
+	return &CapitalManager_Allocation_Call{Call: _e.mock.On("Allocation", runID)}
+}
+
+func (_c *CapitalManager_Allocation_Call) Run(run func(runID string)) *CapitalManager_Allocation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *CapitalManager_Allocation_Call) Return(_a0 capital.Allocation, _a1 bool) *CapitalManager_Allocation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CapitalManager_Allocation_Call) RunAndReturn(run func(string) (capital.Allocation, bool)) *CapitalManager_Allocation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Begin provides a mock function with given fields: runID, config
+func (_m *CapitalManager) Begin(runID string, config capital.Config) error {
+	ret := _m.Called(runID, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Begin")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, capital.Config) error); ok {
+		r0 = rf(runID, config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CapitalManager_Begin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Begin'
+type CapitalManager_Begin_Call struct {
+	*mock.Call
+}
+
+// Begin is a helper method to define mock.On call
+//   - runID string
+//   - config capital.Config
+func (_e *CapitalManager_Expecter) Begin(runID interface{}, config interface{}) *CapitalManager_Begin_Call {
+	return &CapitalManager_Begin_Call{Call: _e.mock.On("Begin", runID, config)}
+}
+
+func (_c *CapitalManager_Begin_Call) Run(run func(runID string, config capital.Config)) *CapitalManager_Begin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(capital.Config))
+	})
+	return _c
+}
+
+func (_c *CapitalManager_Begin_Call) Return(_a0 error) *CapitalManager_Begin_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CapitalManager_Begin_Call) RunAndReturn(run func(string, capital.Config) error) *CapitalManager_Begin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Current provides a mock function with no fields
+func (_m *CapitalManager) Current() (capital.Allocation, bool) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Current")
+	}
+
+	var r0 capital.Allocation
+	var r1 bool
+	if rf, ok := ret.Get(0).(func() (capital.Allocation, bool)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() capital.Allocation); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(capital.Allocation)
+	}
+
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// CapitalManager_Current_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Current'
+type CapitalManager_Current_Call struct {
+	*mock.Call
+}
+
+// Current is a helper method to define mock.On call
+func (_e *CapitalManager_Expecter) Current() *CapitalManager_Current_Call {
+	return &CapitalManager_Current_Call{Call: _e.mock.On("Current")}
+}
+
+func (_c *CapitalManager_Current_Call) Run(run func()) *CapitalManager_Current_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *CapitalManager_Current_Call) Return(_a0 capital.Allocation, _a1 bool) *CapitalManager_Current_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CapitalManager_Current_Call) RunAndReturn(run func() (capital.Allocation, bool)) *CapitalManager_Current_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Finish provides a mock function with no fields
+func (_m *CapitalManager) Finish() (capital.Allocation, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Finish")
+	}
+
+	var r0 capital.Allocation
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (capital.Allocation, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() capital.Allocation); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(capital.Allocation)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CapitalManager_Finish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Finish'
+type CapitalManager_Finish_Call struct {
+	*mock.Call
+}
+
+// Finish is a helper method to define mock.On call
+func (_e *CapitalManager_Expecter) Finish() *CapitalManager_Finish_Call {
+	return &CapitalManager_Finish_Call{Call: _e.mock.On("Finish")}
+}
+
+func (_c *CapitalManager_Finish_Call) Run(run func()) *CapitalManager_Finish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *CapitalManager_Finish_Call) Return(_a0 capital.Allocation, _a1 error) *CapitalManager_Finish_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CapitalManager_Finish_Call) RunAndReturn(run func() (capital.Allocation, error)) *CapitalManager_Finish_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Handler provides a mock function with no fields
+func (_m *CapitalManager) Handler() http.Handler {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handler")
+	}
+
+	var r0 http.Handler
+	if rf, ok := ret.Get(0).(func() http.Handler); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(http.Handler)
+		}
+	}
+
+	return r0
+}
+
+// CapitalManager_Handler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handler'
+type CapitalManager_Handler_Call struct {
+	*mock.Call
+}
+
+// Handler is a helper method to define mock.On call
+func (_e *CapitalManager_Expecter) Handler() *CapitalManager_Handler_Call {
+	return &CapitalManager_Handler_Call{Call: _e.mock.On("Handler")}
+}
+
+func (_c *CapitalManager_Handler_Call) Run(run func()) *CapitalManager_Handler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *CapitalManager_Handler_Call) Return(_a0 http.Handler) *CapitalManager_Handler_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CapitalManager_Handler_Call) RunAndReturn(run func() http.Handler) *CapitalManager_Handler_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with no fields
+func (_m *CapitalManager) Update() (capital.Allocation, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 capital.Allocation
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (capital.Allocation, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() capital.Allocation); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(capital.Allocation)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CapitalManager_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type CapitalManager_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+func (_e *CapitalManager_Expecter) Update() *CapitalManager_Update_Call {
+	return &CapitalManager_Update_Call{Call: _e.mock.On("Update")}
+}
+
+func (_c *CapitalManager_Update_Call) Run(run func()) *CapitalManager_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *CapitalManager_Update_Call) Return(_a0 capital.Allocation, _a1 error) *CapitalManager_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CapitalManager_Update_Call) RunAndReturn(run func() (capital.Allocation, error)) *CapitalManager_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCapitalManager creates a new instance of CapitalManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCapitalManager(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CapitalManager {
+	mock := &CapitalManager{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,255 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package certification
+
+import (
+	certification "github.com/backtesting-org/live-trading/pkg/certification"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Certifier is an autogenerated mock type for the Certifier type
+type Certifier struct {
+	mock.Mock
+}
+
+type Certifier_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Certifier) EXPECT() *Certifier_Expecter {
+	return &Certifier_Expecter{mock: &_m.Mock}
+}
+
+// Certificates provides a mock function with no fields
+func (_m *Certifier) Certificates() ([]certification.Certificate, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Certificates")
+	}
+
+	var r0 []certification.Certificate
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]certification.Certificate, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []certification.Certificate); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]certification.Certificate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Certifier_Certificates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Certificates'
+type Certifier_Certificates_Call struct {
+	*mock.Call
+}
+
+// Certificates is a helper method to define mock.On call
+func (_e *Certifier_Expecter) Certificates() *Certifier_Certificates_Call {
+	return &Certifier_Certificates_Call{Call: _e.mock.On("Certificates")}
+}
+
+func (_c *Certifier_Certificates_Call) Run(run func()) *Certifier_Certificates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Certifier_Certificates_Call) Return(_a0 []certification.Certificate, _a1 error) *Certifier_Certificates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Certifier_Certificates_Call) RunAndReturn(run func() ([]certification.Certificate, error)) *Certifier_Certificates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Certify provides a mock function with given fields: request
+func (_m *Certifier) Certify(request certification.Request) (*certification.Certificate, error) {
+	ret := _m.Called(request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Certify")
+	}
+
+	var r0 *certification.Certificate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(certification.Request) (*certification.Certificate, error)); ok {
+		return rf(request)
+	}
+	if rf, ok := ret.Get(0).(func(certification.Request) *certification.Certificate); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*certification.Certificate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(certification.Request) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Certifier_Certify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Certify'
+type Certifier_Certify_Call struct {
+	*mock.Call
+}
+
+// Certify is a helper method to define mock.On call
+//   - request certification.Request
+func (_e *Certifier_Expecter) Certify(request interface{}) *Certifier_Certify_Call {
+	return &Certifier_Certify_Call{Call: _e.mock.On("Certify", request)}
+}
+
+func (_c *Certifier_Certify_Call) Run(run func(request certification.Request)) *Certifier_Certify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(certification.Request))
+	})
+	return _c
+}
+
+func (_c *Certifier_Certify_Call) Return(_a0 *certification.Certificate, _a1 error) *Certifier_Certify_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Certifier_Certify_Call) RunAndReturn(run func(certification.Request) (*certification.Certificate, error)) *Certifier_Certify_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *Certifier) Configure(config certification.Config) error {
+	ret := _m.Called(config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Configure")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(certification.Config) error); ok {
+		r0 = rf(config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Certifier_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type Certifier_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config certification.Config
+func (_e *Certifier_Expecter) Configure(config interface{}) *Certifier_Configure_Call {
+	return &Certifier_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *Certifier_Configure_Call) Run(run func(config certification.Config)) *Certifier_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(certification.Config))
+	})
+	return _c
+}
+
+func (_c *Certifier_Configure_Call) Return(_a0 error) *Certifier_Configure_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Certifier_Configure_Call) RunAndReturn(run func(certification.Config) error) *Certifier_Configure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Verify provides a mock function with given fields: pluginPath, version
+func (_m *Certifier) Verify(pluginPath string, version string) (*certification.Certificate, error) {
+	ret := _m.Called(pluginPath, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Verify")
+	}
+
+	var r0 *certification.Certificate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*certification.Certificate, error)); ok {
+		return rf(pluginPath, version)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *certification.Certificate); ok {
+		r0 = rf(pluginPath, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*certification.Certificate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(pluginPath, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Certifier_Verify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Verify'
+type Certifier_Verify_Call struct {
+	*mock.Call
+}
+
+// Verify is a helper method to define mock.On call
+//   - pluginPath string
+//   - version string
+func (_e *Certifier_Expecter) Verify(pluginPath interface{}, version interface{}) *Certifier_Verify_Call {
+	return &Certifier_Verify_Call{Call: _e.mock.On("Verify", pluginPath, version)}
+}
+
+func (_c *Certifier_Verify_Call) Run(run func(pluginPath string, version string)) *Certifier_Verify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Certifier_Verify_Call) Return(_a0 *certification.Certificate, _a1 error) *Certifier_Verify_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Certifier_Verify_Call) RunAndReturn(run func(string, string) (*certification.Certificate, error)) *Certifier_Verify_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCertifier creates a new instance of Certifier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCertifier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Certifier {
+	mock := &Certifier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
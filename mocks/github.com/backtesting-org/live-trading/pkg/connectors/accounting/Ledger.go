@@ -0,0 +1,719 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package accounting
+
+import (
+	connector "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	accounting "github.com/backtesting-org/live-trading/pkg/connectors/accounting"
+
+	mock "github.com/stretchr/testify/mock"
+
+	numerical "github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+
+	time "time"
+
+	tracker "github.com/backtesting-org/live-trading/pkg/connectors/tracker"
+)
+
+// Ledger is an autogenerated mock type for the Ledger type
+type Ledger struct {
+	mock.Mock
+}
+
+type Ledger_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Ledger) EXPECT() *Ledger_Expecter {
+	return &Ledger_Expecter{mock: &_m.Mock}
+}
+
+// Adopt provides a mock function with given fields: fill
+func (_m *Ledger) Adopt(fill accounting.Fill) error {
+	ret := _m.Called(fill)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Adopt")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(accounting.Fill) error); ok {
+		r0 = rf(fill)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Ledger_Adopt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Adopt'
+type Ledger_Adopt_Call struct {
+	*mock.Call
+}
+
+// Adopt is a helper method to define mock.On call
+//   - fill accounting.Fill
+func (_e *Ledger_Expecter) Adopt(fill interface{}) *Ledger_Adopt_Call {
+	return &Ledger_Adopt_Call{Call: _e.mock.On("Adopt", fill)}
+}
+
+func (_c *Ledger_Adopt_Call) Run(run func(fill accounting.Fill)) *Ledger_Adopt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(accounting.Fill))
+	})
+	return _c
+}
+
+func (_c *Ledger_Adopt_Call) Return(_a0 error) *Ledger_Adopt_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Ledger_Adopt_Call) RunAndReturn(run func(accounting.Fill) error) *Ledger_Adopt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClosedLots provides a mock function with given fields: since
+func (_m *Ledger) ClosedLots(since time.Time) []accounting.ClosedLot {
+	ret := _m.Called(since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClosedLots")
+	}
+
+	var r0 []accounting.ClosedLot
+	if rf, ok := ret.Get(0).(func(time.Time) []accounting.ClosedLot); ok {
+		r0 = rf(since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]accounting.ClosedLot)
+		}
+	}
+
+	return r0
+}
+
+// Ledger_ClosedLots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClosedLots'
+type Ledger_ClosedLots_Call struct {
+	*mock.Call
+}
+
+// ClosedLots is a helper method to define mock.On call
+//   - since time.Time
+func (_e *Ledger_Expecter) ClosedLots(since interface{}) *Ledger_ClosedLots_Call {
+	return &Ledger_ClosedLots_Call{Call: _e.mock.On("ClosedLots", since)}
+}
+
+func (_c *Ledger_ClosedLots_Call) Run(run func(since time.Time)) *Ledger_ClosedLots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Ledger_ClosedLots_Call) Return(_a0 []accounting.ClosedLot) *Ledger_ClosedLots_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Ledger_ClosedLots_Call) RunAndReturn(run func(time.Time) []accounting.ClosedLot) *Ledger_ClosedLots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Entries provides a mock function with given fields: limit
+func (_m *Ledger) Entries(limit int) []accounting.Entry {
+	ret := _m.Called(limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Entries")
+	}
+
+	var r0 []accounting.Entry
+	if rf, ok := ret.Get(0).(func(int) []accounting.Entry); ok {
+		r0 = rf(limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]accounting.Entry)
+		}
+	}
+
+	return r0
+}
+
+// Ledger_Entries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Entries'
+type Ledger_Entries_Call struct {
+	*mock.Call
+}
+
+// Entries is a helper method to define mock.On call
+//   - limit int
+func (_e *Ledger_Expecter) Entries(limit interface{}) *Ledger_Entries_Call {
+	return &Ledger_Entries_Call{Call: _e.mock.On("Entries", limit)}
+}
+
+func (_c *Ledger_Entries_Call) Run(run func(limit int)) *Ledger_Entries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *Ledger_Entries_Call) Return(_a0 []accounting.Entry) *Ledger_Entries_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Ledger_Entries_Call) RunAndReturn(run func(int) []accounting.Entry) *Ledger_Entries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExecutionQuality provides a mock function with given fields: exchange
+func (_m *Ledger) ExecutionQuality(exchange connector.ExchangeName) accounting.ExecutionQuality {
+	ret := _m.Called(exchange)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExecutionQuality")
+	}
+
+	var r0 accounting.ExecutionQuality
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName) accounting.ExecutionQuality); ok {
+		r0 = rf(exchange)
+	} else {
+		r0 = ret.Get(0).(accounting.ExecutionQuality)
+	}
+
+	return r0
+}
+
+// Ledger_ExecutionQuality_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExecutionQuality'
+type Ledger_ExecutionQuality_Call struct {
+	*mock.Call
+}
+
+// ExecutionQuality is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+func (_e *Ledger_Expecter) ExecutionQuality(exchange interface{}) *Ledger_ExecutionQuality_Call {
+	return &Ledger_ExecutionQuality_Call{Call: _e.mock.On("ExecutionQuality", exchange)}
+}
+
+func (_c *Ledger_ExecutionQuality_Call) Run(run func(exchange connector.ExchangeName)) *Ledger_ExecutionQuality_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName))
+	})
+	return _c
+}
+
+func (_c *Ledger_ExecutionQuality_Call) Return(_a0 accounting.ExecutionQuality) *Ledger_ExecutionQuality_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Ledger_ExecutionQuality_Call) RunAndReturn(run func(connector.ExchangeName) accounting.ExecutionQuality) *Ledger_ExecutionQuality_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Fees provides a mock function with no fields
+func (_m *Ledger) Fees() accounting.FeeSchedule {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Fees")
+	}
+
+	var r0 accounting.FeeSchedule
+	if rf, ok := ret.Get(0).(func() accounting.FeeSchedule); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(accounting.FeeSchedule)
+		}
+	}
+
+	return r0
+}
+
+// Ledger_Fees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Fees'
+type Ledger_Fees_Call struct {
+	*mock.Call
+}
+
+// Fees is a helper method to define mock.On call
+func (_e *Ledger_Expecter) Fees() *Ledger_Fees_Call {
+	return &Ledger_Fees_Call{Call: _e.mock.On("Fees")}
+}
+
+func (_c *Ledger_Fees_Call) Run(run func()) *Ledger_Fees_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Ledger_Fees_Call) Return(_a0 accounting.FeeSchedule) *Ledger_Fees_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Ledger_Fees_Call) RunAndReturn(run func() accounting.FeeSchedule) *Ledger_Fees_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HoldingPeriods provides a mock function with given fields: since, until
+func (_m *Ledger) HoldingPeriods(since time.Time, until time.Time) accounting.HoldingStats {
+	ret := _m.Called(since, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HoldingPeriods")
+	}
+
+	var r0 accounting.HoldingStats
+	if rf, ok := ret.Get(0).(func(time.Time, time.Time) accounting.HoldingStats); ok {
+		r0 = rf(since, until)
+	} else {
+		r0 = ret.Get(0).(accounting.HoldingStats)
+	}
+
+	return r0
+}
+
+// Ledger_HoldingPeriods_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HoldingPeriods'
+type Ledger_HoldingPeriods_Call struct {
+	*mock.Call
+}
+
+// HoldingPeriods is a helper method to define mock.On call
+//   - since time.Time
+//   - until time.Time
+func (_e *Ledger_Expecter) HoldingPeriods(since interface{}, until interface{}) *Ledger_HoldingPeriods_Call {
+	return &Ledger_HoldingPeriods_Call{Call: _e.mock.On("HoldingPeriods", since, until)}
+}
+
+func (_c *Ledger_HoldingPeriods_Call) Run(run func(since time.Time, until time.Time)) *Ledger_HoldingPeriods_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Time), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Ledger_HoldingPeriods_Call) Return(_a0 accounting.HoldingStats) *Ledger_HoldingPeriods_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Ledger_HoldingPeriods_Call) RunAndReturn(run func(time.Time, time.Time) accounting.HoldingStats) *Ledger_HoldingPeriods_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OpenLots provides a mock function with no fields
+func (_m *Ledger) OpenLots() []accounting.Lot {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for OpenLots")
+	}
+
+	var r0 []accounting.Lot
+	if rf, ok := ret.Get(0).(func() []accounting.Lot); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]accounting.Lot)
+		}
+	}
+
+	return r0
+}
+
+// Ledger_OpenLots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenLots'
+type Ledger_OpenLots_Call struct {
+	*mock.Call
+}
+
+// OpenLots is a helper method to define mock.On call
+func (_e *Ledger_Expecter) OpenLots() *Ledger_OpenLots_Call {
+	return &Ledger_OpenLots_Call{Call: _e.mock.On("OpenLots")}
+}
+
+func (_c *Ledger_OpenLots_Call) Run(run func()) *Ledger_OpenLots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Ledger_OpenLots_Call) Return(_a0 []accounting.Lot) *Ledger_OpenLots_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Ledger_OpenLots_Call) RunAndReturn(run func() []accounting.Lot) *Ledger_OpenLots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Record provides a mock function with given fields: fill
+func (_m *Ledger) Record(fill accounting.Fill) (*accounting.Entry, error) {
+	ret := _m.Called(fill)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Record")
+	}
+
+	var r0 *accounting.Entry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(accounting.Fill) (*accounting.Entry, error)); ok {
+		return rf(fill)
+	}
+	if rf, ok := ret.Get(0).(func(accounting.Fill) *accounting.Entry); ok {
+		r0 = rf(fill)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*accounting.Entry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(accounting.Fill) error); ok {
+		r1 = rf(fill)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Ledger_Record_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Record'
+type Ledger_Record_Call struct {
+	*mock.Call
+}
+
+// Record is a helper method to define mock.On call
+//   - fill accounting.Fill
+func (_e *Ledger_Expecter) Record(fill interface{}) *Ledger_Record_Call {
+	return &Ledger_Record_Call{Call: _e.mock.On("Record", fill)}
+}
+
+func (_c *Ledger_Record_Call) Run(run func(fill accounting.Fill)) *Ledger_Record_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(accounting.Fill))
+	})
+	return _c
+}
+
+func (_c *Ledger_Record_Call) Return(_a0 *accounting.Entry, _a1 error) *Ledger_Record_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Ledger_Record_Call) RunAndReturn(run func(accounting.Fill) (*accounting.Entry, error)) *Ledger_Record_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordFill provides a mock function with given fields: event
+func (_m *Ledger) RecordFill(event tracker.FillEvent) (*accounting.Entry, error) {
+	ret := _m.Called(event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordFill")
+	}
+
+	var r0 *accounting.Entry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(tracker.FillEvent) (*accounting.Entry, error)); ok {
+		return rf(event)
+	}
+	if rf, ok := ret.Get(0).(func(tracker.FillEvent) *accounting.Entry); ok {
+		r0 = rf(event)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*accounting.Entry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(tracker.FillEvent) error); ok {
+		r1 = rf(event)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Ledger_RecordFill_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordFill'
+type Ledger_RecordFill_Call struct {
+	*mock.Call
+}
+
+// RecordFill is a helper method to define mock.On call
+//   - event tracker.FillEvent
+func (_e *Ledger_Expecter) RecordFill(event interface{}) *Ledger_RecordFill_Call {
+	return &Ledger_RecordFill_Call{Call: _e.mock.On("RecordFill", event)}
+}
+
+func (_c *Ledger_RecordFill_Call) Run(run func(event tracker.FillEvent)) *Ledger_RecordFill_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(tracker.FillEvent))
+	})
+	return _c
+}
+
+func (_c *Ledger_RecordFill_Call) Return(_a0 *accounting.Entry, _a1 error) *Ledger_RecordFill_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Ledger_RecordFill_Call) RunAndReturn(run func(tracker.FillEvent) (*accounting.Entry, error)) *Ledger_RecordFill_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordFunding provides a mock function with given fields: exchange, symbol, amount
+func (_m *Ledger) RecordFunding(exchange connector.ExchangeName, symbol string, amount numerical.Decimal) {
+	_m.Called(exchange, symbol, amount)
+}
+
+// Ledger_RecordFunding_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordFunding'
+type Ledger_RecordFunding_Call struct {
+	*mock.Call
+}
+
+// RecordFunding is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - symbol string
+//   - amount numerical.Decimal
+func (_e *Ledger_Expecter) RecordFunding(exchange interface{}, symbol interface{}, amount interface{}) *Ledger_RecordFunding_Call {
+	return &Ledger_RecordFunding_Call{Call: _e.mock.On("RecordFunding", exchange, symbol, amount)}
+}
+
+func (_c *Ledger_RecordFunding_Call) Run(run func(exchange connector.ExchangeName, symbol string, amount numerical.Decimal)) *Ledger_RecordFunding_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(string), args[2].(numerical.Decimal))
+	})
+	return _c
+}
+
+func (_c *Ledger_RecordFunding_Call) Return() *Ledger_RecordFunding_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Ledger_RecordFunding_Call) RunAndReturn(run func(connector.ExchangeName, string, numerical.Decimal)) *Ledger_RecordFunding_Call {
+	_c.Run(run)
+	return _c
+}
+
+// RecordTrade provides a mock function with given fields: trade
+func (_m *Ledger) RecordTrade(trade connector.Trade) (*accounting.Entry, error) {
+	ret := _m.Called(trade)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordTrade")
+	}
+
+	var r0 *accounting.Entry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(connector.Trade) (*accounting.Entry, error)); ok {
+		return rf(trade)
+	}
+	if rf, ok := ret.Get(0).(func(connector.Trade) *accounting.Entry); ok {
+		r0 = rf(trade)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*accounting.Entry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.Trade) error); ok {
+		r1 = rf(trade)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Ledger_RecordTrade_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordTrade'
+type Ledger_RecordTrade_Call struct {
+	*mock.Call
+}
+
+// RecordTrade is a helper method to define mock.On call
+//   - trade connector.Trade
+func (_e *Ledger_Expecter) RecordTrade(trade interface{}) *Ledger_RecordTrade_Call {
+	return &Ledger_RecordTrade_Call{Call: _e.mock.On("RecordTrade", trade)}
+}
+
+func (_c *Ledger_RecordTrade_Call) Run(run func(trade connector.Trade)) *Ledger_RecordTrade_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.Trade))
+	})
+	return _c
+}
+
+func (_c *Ledger_RecordTrade_Call) Return(_a0 *accounting.Entry, _a1 error) *Ledger_RecordTrade_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Ledger_RecordTrade_Call) RunAndReturn(run func(connector.Trade) (*accounting.Entry, error)) *Ledger_RecordTrade_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetFees provides a mock function with given fields: exchange, fees
+func (_m *Ledger) SetFees(exchange connector.ExchangeName, fees accounting.Fees) {
+	_m.Called(exchange, fees)
+}
+
+// Ledger_SetFees_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetFees'
+type Ledger_SetFees_Call struct {
+	*mock.Call
+}
+
+// SetFees is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - fees accounting.Fees
+func (_e *Ledger_Expecter) SetFees(exchange interface{}, fees interface{}) *Ledger_SetFees_Call {
+	return &Ledger_SetFees_Call{Call: _e.mock.On("SetFees", exchange, fees)}
+}
+
+func (_c *Ledger_SetFees_Call) Run(run func(exchange connector.ExchangeName, fees accounting.Fees)) *Ledger_SetFees_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(accounting.Fees))
+	})
+	return _c
+}
+
+func (_c *Ledger_SetFees_Call) Return() *Ledger_SetFees_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Ledger_SetFees_Call) RunAndReturn(run func(connector.ExchangeName, accounting.Fees)) *Ledger_SetFees_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Summaries provides a mock function with no fields
+func (_m *Ledger) Summaries() []accounting.Summary {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Summaries")
+	}
+
+	var r0 []accounting.Summary
+	if rf, ok := ret.Get(0).(func() []accounting.Summary); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]accounting.Summary)
+		}
+	}
+
+	return r0
+}
+
+// Ledger_Summaries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Summaries'
+type Ledger_Summaries_Call struct {
+	*mock.Call
+}
+
+// Summaries is a helper method to define mock.On call
+func (_e *Ledger_Expecter) Summaries() *Ledger_Summaries_Call {
+	return &Ledger_Summaries_Call{Call: _e.mock.On("Summaries")}
+}
+
+func (_c *Ledger_Summaries_Call) Run(run func()) *Ledger_Summaries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Ledger_Summaries_Call) Return(_a0 []accounting.Summary) *Ledger_Summaries_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Ledger_Summaries_Call) RunAndReturn(run func() []accounting.Summary) *Ledger_Summaries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Summary provides a mock function with given fields: exchange, symbol
+func (_m *Ledger) Summary(exchange connector.ExchangeName, symbol string) (accounting.Summary, bool) {
+	ret := _m.Called(exchange, symbol)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Summary")
+	}
+
+	var r0 accounting.Summary
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, string) (accounting.Summary, bool)); ok {
+		return rf(exchange, symbol)
+	}
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, string) accounting.Summary); ok {
+		r0 = rf(exchange, symbol)
+	} else {
+		r0 = ret.Get(0).(accounting.Summary)
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.ExchangeName, string) bool); ok {
+		r1 = rf(exchange, symbol)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// Ledger_Summary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Summary'
+type Ledger_Summary_Call struct {
+	*mock.Call
+}
+
+// Summary is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - symbol string
+func (_e *Ledger_Expecter) Summary(exchange interface{}, symbol interface{}) *Ledger_Summary_Call {
+	return &Ledger_Summary_Call{Call: _e.mock.On("Summary", exchange, symbol)}
+}
+
+func (_c *Ledger_Summary_Call) Run(run func(exchange connector.ExchangeName, symbol string)) *Ledger_Summary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Ledger_Summary_Call) Return(_a0 accounting.Summary, _a1 bool) *Ledger_Summary_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Ledger_Summary_Call) RunAndReturn(run func(connector.ExchangeName, string) (accounting.Summary, bool)) *Ledger_Summary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewLedger creates a new instance of Ledger. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLedger(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Ledger {
+	mock := &Ledger{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
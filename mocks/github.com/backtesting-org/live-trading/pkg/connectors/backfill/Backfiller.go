@@ -0,0 +1,201 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package backfill
+
+import (
+	connector "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	backfill "github.com/backtesting-org/live-trading/pkg/connectors/backfill"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Backfiller is an autogenerated mock type for the Backfiller type
+type Backfiller struct {
+	mock.Mock
+}
+
+type Backfiller_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Backfiller) EXPECT() *Backfiller_Expecter {
+	return &Backfiller_Expecter{mock: &_m.Mock}
+}
+
+// Backfill provides a mock function with given fields: exchange, symbol, interval, depth
+func (_m *Backfiller) Backfill(exchange connector.ExchangeName, symbol string, interval string, depth int) ([]connector.Kline, error) {
+	ret := _m.Called(exchange, symbol, interval, depth)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Backfill")
+	}
+
+	var r0 []connector.Kline
+	var r1 error
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, string, string, int) ([]connector.Kline, error)); ok {
+		return rf(exchange, symbol, interval, depth)
+	}
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, string, string, int) []connector.Kline); ok {
+		r0 = rf(exchange, symbol, interval, depth)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]connector.Kline)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.ExchangeName, string, string, int) error); ok {
+		r1 = rf(exchange, symbol, interval, depth)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Backfiller_Backfill_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Backfill'
+type Backfiller_Backfill_Call struct {
+	*mock.Call
+}
+
+// Backfill is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - symbol string
+//   - interval string
+//   - depth int
+func (_e *Backfiller_Expecter) Backfill(exchange interface{}, symbol interface{}, interval interface{}, depth interface{}) *Backfiller_Backfill_Call {
+	return &Backfiller_Backfill_Call{Call: _e.mock.On("Backfill", exchange, symbol, interval, depth)}
+}
+
+func (_c *Backfiller_Backfill_Call) Run(run func(exchange connector.ExchangeName, symbol string, interval string, depth int)) *Backfiller_Backfill_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(string), args[2].(string), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *Backfiller_Backfill_Call) Return(_a0 []connector.Kline, _a1 error) *Backfiller_Backfill_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Backfiller_Backfill_Call) RunAndReturn(run func(connector.ExchangeName, string, string, int) ([]connector.Kline, error)) *Backfiller_Backfill_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *Backfiller) Configure(config backfill.Config) {
+	_m.Called(config)
+}
+
+// Backfiller_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type Backfiller_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config backfill.Config
+func (_e *Backfiller_Expecter) Configure(config interface{}) *Backfiller_Configure_Call {
+	return &Backfiller_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *Backfiller_Configure_Call) Run(run func(config backfill.Config)) *Backfiller_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(backfill.Config))
+	})
+	return _c
+}
+
+func (_c *Backfiller_Configure_Call) Return() *Backfiller_Configure_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Backfiller_Configure_Call) RunAndReturn(run func(backfill.Config)) *Backfiller_Configure_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Repair provides a mock function with given fields: exchange, symbol, interval, history
+func (_m *Backfiller) Repair(exchange connector.ExchangeName, symbol string, interval string, history []connector.Kline) ([]connector.Kline, []backfill.Gap, error) {
+	ret := _m.Called(exchange, symbol, interval, history)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Repair")
+	}
+
+	var r0 []connector.Kline
+	var r1 []backfill.Gap
+	var r2 error
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, string, string, []connector.Kline) ([]connector.Kline, []backfill.Gap, error)); ok {
+		return rf(exchange, symbol, interval, history)
+	}
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, string, string, []connector.Kline) []connector.Kline); ok {
+		r0 = rf(exchange, symbol, interval, history)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]connector.Kline)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.ExchangeName, string, string, []connector.Kline) []backfill.Gap); ok {
+		r1 = rf(exchange, symbol, interval, history)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]backfill.Gap)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(connector.ExchangeName, string, string, []connector.Kline) error); ok {
+		r2 = rf(exchange, symbol, interval, history)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Backfiller_Repair_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Repair'
+type Backfiller_Repair_Call struct {
+	*mock.Call
+}
+
+// Repair is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - symbol string
+//   - interval string
+//   - history []connector.Kline
+func (_e *Backfiller_Expecter) Repair(exchange interface{}, symbol interface{}, interval interface{}, history interface{}) *Backfiller_Repair_Call {
+	return &Backfiller_Repair_Call{Call: _e.mock.On("Repair", exchange, symbol, interval, history)}
+}
+
+func (_c *Backfiller_Repair_Call) Run(run func(exchange connector.ExchangeName, symbol string, interval string, history []connector.Kline)) *Backfiller_Repair_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(string), args[2].(string), args[3].([]connector.Kline))
+	})
+	return _c
+}
+
+func (_c *Backfiller_Repair_Call) Return(_a0 []connector.Kline, _a1 []backfill.Gap, _a2 error) *Backfiller_Repair_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *Backfiller_Repair_Call) RunAndReturn(run func(connector.ExchangeName, string, string, []connector.Kline) ([]connector.Kline, []backfill.Gap, error)) *Backfiller_Repair_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewBackfiller creates a new instance of Backfiller. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBackfiller(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Backfiller {
+	mock := &Backfiller{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,191 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package batch
+
+import (
+	connector "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	batch "github.com/backtesting-org/live-trading/pkg/connectors/batch"
+
+	mock "github.com/stretchr/testify/mock"
+
+	types "github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+// OrderBatcher is an autogenerated mock type for the OrderBatcher type
+type OrderBatcher struct {
+	mock.Mock
+}
+
+type OrderBatcher_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OrderBatcher) EXPECT() *OrderBatcher_Expecter {
+	return &OrderBatcher_Expecter{mock: &_m.Mock}
+}
+
+// CancelOrders provides a mock function with given fields: exchange, symbol, orderIDs
+func (_m *OrderBatcher) CancelOrders(exchange connector.ExchangeName, symbol string, orderIDs []string) ([]types.CancelResult, error) {
+	ret := _m.Called(exchange, symbol, orderIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelOrders")
+	}
+
+	var r0 []types.CancelResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, string, []string) ([]types.CancelResult, error)); ok {
+		return rf(exchange, symbol, orderIDs)
+	}
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, string, []string) []types.CancelResult); ok {
+		r0 = rf(exchange, symbol, orderIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.CancelResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.ExchangeName, string, []string) error); ok {
+		r1 = rf(exchange, symbol, orderIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderBatcher_CancelOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelOrders'
+type OrderBatcher_CancelOrders_Call struct {
+	*mock.Call
+}
+
+// CancelOrders is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - symbol string
+//   - orderIDs []string
+func (_e *OrderBatcher_Expecter) CancelOrders(exchange interface{}, symbol interface{}, orderIDs interface{}) *OrderBatcher_CancelOrders_Call {
+	return &OrderBatcher_CancelOrders_Call{Call: _e.mock.On("CancelOrders", exchange, symbol, orderIDs)}
+}
+
+func (_c *OrderBatcher_CancelOrders_Call) Run(run func(exchange connector.ExchangeName, symbol string, orderIDs []string)) *OrderBatcher_CancelOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *OrderBatcher_CancelOrders_Call) Return(_a0 []types.CancelResult, _a1 error) *OrderBatcher_CancelOrders_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderBatcher_CancelOrders_Call) RunAndReturn(run func(connector.ExchangeName, string, []string) ([]types.CancelResult, error)) *OrderBatcher_CancelOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *OrderBatcher) Configure(config batch.Config) {
+	_m.Called(config)
+}
+
+// OrderBatcher_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type OrderBatcher_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config batch.Config
+func (_e *OrderBatcher_Expecter) Configure(config interface{}) *OrderBatcher_Configure_Call {
+	return &OrderBatcher_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *OrderBatcher_Configure_Call) Run(run func(config batch.Config)) *OrderBatcher_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(batch.Config))
+	})
+	return _c
+}
+
+func (_c *OrderBatcher_Configure_Call) Return() *OrderBatcher_Configure_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *OrderBatcher_Configure_Call) RunAndReturn(run func(batch.Config)) *OrderBatcher_Configure_Call {
+	_c.Run(run)
+	return _c
+}
+
+// PlaceOrders provides a mock function with given fields: exchange, requests
+func (_m *OrderBatcher) PlaceOrders(exchange connector.ExchangeName, requests []types.OrderRequest) ([]types.OrderResult, error) {
+	ret := _m.Called(exchange, requests)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlaceOrders")
+	}
+
+	var r0 []types.OrderResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, []types.OrderRequest) ([]types.OrderResult, error)); ok {
+		return rf(exchange, requests)
+	}
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, []types.OrderRequest) []types.OrderResult); ok {
+		r0 = rf(exchange, requests)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.OrderResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.ExchangeName, []types.OrderRequest) error); ok {
+		r1 = rf(exchange, requests)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderBatcher_PlaceOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PlaceOrders'
+type OrderBatcher_PlaceOrders_Call struct {
+	*mock.Call
+}
+
+// PlaceOrders is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - requests []types.OrderRequest
+func (_e *OrderBatcher_Expecter) PlaceOrders(exchange interface{}, requests interface{}) *OrderBatcher_PlaceOrders_Call {
+	return &OrderBatcher_PlaceOrders_Call{Call: _e.mock.On("PlaceOrders", exchange, requests)}
+}
+
+func (_c *OrderBatcher_PlaceOrders_Call) Run(run func(exchange connector.ExchangeName, requests []types.OrderRequest)) *OrderBatcher_PlaceOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].([]types.OrderRequest))
+	})
+	return _c
+}
+
+func (_c *OrderBatcher_PlaceOrders_Call) Return(_a0 []types.OrderResult, _a1 error) *OrderBatcher_PlaceOrders_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderBatcher_PlaceOrders_Call) RunAndReturn(run func(connector.ExchangeName, []types.OrderRequest) ([]types.OrderResult, error)) *OrderBatcher_PlaceOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOrderBatcher creates a new instance of OrderBatcher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOrderBatcher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OrderBatcher {
+	mock := &OrderBatcher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,282 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package adaptor
+
+import (
+	context "context"
+	url "net/url"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Client is an autogenerated mock type for the Client type
+type Client struct {
+	mock.Mock
+}
+
+type Client_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Client) EXPECT() *Client_Expecter {
+	return &Client_Expecter{mock: &_m.Mock}
+}
+
+// Configure provides a mock function with given fields: baseURL, spotBaseURL, apiKey, apiSecret, recvWindow
+func (_m *Client) Configure(baseURL string, spotBaseURL string, apiKey string, apiSecret string, recvWindow int64) error {
+	ret := _m.Called(baseURL, spotBaseURL, apiKey, apiSecret, recvWindow)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Configure")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string, int64) error); ok {
+		r0 = rf(baseURL, spotBaseURL, apiKey, apiSecret, recvWindow)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Client_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type Client_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - baseURL string
+//   - spotBaseURL string
+//   - apiKey string
+//   - apiSecret string
+//   - recvWindow int64
+func (_e *Client_Expecter) Configure(baseURL interface{}, spotBaseURL interface{}, apiKey interface{}, apiSecret interface{}, recvWindow interface{}) *Client_Configure_Call {
+	return &Client_Configure_Call{Call: _e.mock.On("Configure", baseURL, spotBaseURL, apiKey, apiSecret, recvWindow)}
+}
+
+func (_c *Client_Configure_Call) Run(run func(baseURL string, spotBaseURL string, apiKey string, apiSecret string, recvWindow int64)) *Client_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(string), args[4].(int64))
+	})
+	return _c
+}
+
+func (_c *Client_Configure_Call) Return(_a0 error) *Client_Configure_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Client_Configure_Call) RunAndReturn(run func(string, string, string, string, int64) error) *Client_Configure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsConfigured provides a mock function with no fields
+func (_m *Client) IsConfigured() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsConfigured")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Client_IsConfigured_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsConfigured'
+type Client_IsConfigured_Call struct {
+	*mock.Call
+}
+
+// IsConfigured is a helper method to define mock.On call
+func (_e *Client_Expecter) IsConfigured() *Client_IsConfigured_Call {
+	return &Client_IsConfigured_Call{Call: _e.mock.On("IsConfigured")}
+}
+
+func (_c *Client_IsConfigured_Call) Run(run func()) *Client_IsConfigured_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Client_IsConfigured_Call) Return(_a0 bool) *Client_IsConfigured_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Client_IsConfigured_Call) RunAndReturn(run func() bool) *Client_IsConfigured_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Keyed provides a mock function with given fields: ctx, method, path, params, out
+func (_m *Client) Keyed(ctx context.Context, method string, path string, params url.Values, out interface{}) error {
+	ret := _m.Called(ctx, method, path, params, out)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Keyed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, url.Values, interface{}) error); ok {
+		r0 = rf(ctx, method, path, params, out)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Client_Keyed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Keyed'
+type Client_Keyed_Call struct {
+	*mock.Call
+}
+
+// Keyed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - method string
+//   - path string
+//   - params url.Values
+//   - out interface{}
+func (_e *Client_Expecter) Keyed(ctx interface{}, method interface{}, path interface{}, params interface{}, out interface{}) *Client_Keyed_Call {
+	return &Client_Keyed_Call{Call: _e.mock.On("Keyed", ctx, method, path, params, out)}
+}
+
+func (_c *Client_Keyed_Call) Run(run func(ctx context.Context, method string, path string, params url.Values, out interface{})) *Client_Keyed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(url.Values), args[4].(interface{}))
+	})
+	return _c
+}
+
+func (_c *Client_Keyed_Call) Return(_a0 error) *Client_Keyed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Client_Keyed_Call) RunAndReturn(run func(context.Context, string, string, url.Values, interface{}) error) *Client_Keyed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Public provides a mock function with given fields: ctx, method, path, params, out
+func (_m *Client) Public(ctx context.Context, method string, path string, params url.Values, out interface{}) error {
+	ret := _m.Called(ctx, method, path, params, out)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Public")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, url.Values, interface{}) error); ok {
+		r0 = rf(ctx, method, path, params, out)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Client_Public_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Public'
+type Client_Public_Call struct {
+	*mock.Call
+}
+
+// Public is a helper method to define mock.On call
+//   - ctx context.Context
+//   - method string
+//   - path string
+//   - params url.Values
+//   - out interface{}
+func (_e *Client_Expecter) Public(ctx interface{}, method interface{}, path interface{}, params interface{}, out interface{}) *Client_Public_Call {
+	return &Client_Public_Call{Call: _e.mock.On("Public", ctx, method, path, params, out)}
+}
+
+func (_c *Client_Public_Call) Run(run func(ctx context.Context, method string, path string, params url.Values, out interface{})) *Client_Public_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(url.Values), args[4].(interface{}))
+	})
+	return _c
+}
+
+func (_c *Client_Public_Call) Return(_a0 error) *Client_Public_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Client_Public_Call) RunAndReturn(run func(context.Context, string, string, url.Values, interface{}) error) *Client_Public_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Signed provides a mock function with given fields: ctx, method, path, params, out
+func (_m *Client) Signed(ctx context.Context, method string, path string, params url.Values, out interface{}) error {
+	ret := _m.Called(ctx, method, path, params, out)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Signed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, url.Values, interface{}) error); ok {
+		r0 = rf(ctx, method, path, params, out)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Client_Signed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Signed'
+type Client_Signed_Call struct {
+	*mock.Call
+}
+
+// Signed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - method string
+//   - path string
+//   - params url.Values
+//   - out interface{}
+func (_e *Client_Expecter) Signed(ctx interface{}, method interface{}, path interface{}, params interface{}, out interface{}) *Client_Signed_Call {
+	return &Client_Signed_Call{Call: _e.mock.On("Signed", ctx, method, path, params, out)}
+}
+
+func (_c *Client_Signed_Call) Run(run func(ctx context.Context, method string, path string, params url.Values, out interface{})) *Client_Signed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(url.Values), args[4].(interface{}))
+	})
+	return _c
+}
+
+func (_c *Client_Signed_Call) Return(_a0 error) *Client_Signed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Client_Signed_Call) RunAndReturn(run func(context.Context, string, string, url.Values, interface{}) error) *Client_Signed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewClient creates a new instance of Client. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Client {
+	mock := &Client{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
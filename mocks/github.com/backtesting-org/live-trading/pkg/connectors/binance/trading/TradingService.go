@@ -0,0 +1,810 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package trading
+
+import (
+	jsontext "encoding/json/jsontext"
+
+	connector "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+
+	mock "github.com/stretchr/testify/mock"
+
+	numerical "github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+
+	time "time"
+
+	types "github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+// TradingService is an autogenerated mock type for the TradingService type
+type TradingService struct {
+	mock.Mock
+}
+
+type TradingService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TradingService) EXPECT() *TradingService_Expecter {
+	return &TradingService_Expecter{mock: &_m.Mock}
+}
+
+// CancelOrder provides a mock function with given fields: instrument, symbol, orderID
+func (_m *TradingService) CancelOrder(instrument connector.Instrument, symbol string, orderID string) (*connector.CancelResponse, error) {
+	ret := _m.Called(instrument, symbol, orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelOrder")
+	}
+
+	var r0 *connector.CancelResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string, string) (*connector.CancelResponse, error)); ok {
+		return rf(instrument, symbol, orderID)
+	}
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string, string) *connector.CancelResponse); ok {
+		r0 = rf(instrument, symbol, orderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connector.CancelResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.Instrument, string, string) error); ok {
+		r1 = rf(instrument, symbol, orderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TradingService_CancelOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelOrder'
+type TradingService_CancelOrder_Call struct {
+	*mock.Call
+}
+
+// CancelOrder is a helper method to define mock.On call
+//   - instrument connector.Instrument
+//   - symbol string
+//   - orderID string
+func (_e *TradingService_Expecter) CancelOrder(instrument interface{}, symbol interface{}, orderID interface{}) *TradingService_CancelOrder_Call {
+	return &TradingService_CancelOrder_Call{Call: _e.mock.On("CancelOrder", instrument, symbol, orderID)}
+}
+
+func (_c *TradingService_CancelOrder_Call) Run(run func(instrument connector.Instrument, symbol string, orderID string)) *TradingService_CancelOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.Instrument), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TradingService_CancelOrder_Call) Return(_a0 *connector.CancelResponse, _a1 error) *TradingService_CancelOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TradingService_CancelOrder_Call) RunAndReturn(run func(connector.Instrument, string, string) (*connector.CancelResponse, error)) *TradingService_CancelOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAPIKeyPermissions provides a mock function with no fields
+func (_m *TradingService) GetAPIKeyPermissions() (*types.APIKeyPermissions, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAPIKeyPermissions")
+	}
+
+	var r0 *types.APIKeyPermissions
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*types.APIKeyPermissions, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *types.APIKeyPermissions); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.APIKeyPermissions)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TradingService_GetAPIKeyPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAPIKeyPermissions'
+type TradingService_GetAPIKeyPermissions_Call struct {
+	*mock.Call
+}
+
+// GetAPIKeyPermissions is a helper method to define mock.On call
+func (_e *TradingService_Expecter) GetAPIKeyPermissions() *TradingService_GetAPIKeyPermissions_Call {
+	return &TradingService_GetAPIKeyPermissions_Call{Call: _e.mock.On("GetAPIKeyPermissions")}
+}
+
+func (_c *TradingService_GetAPIKeyPermissions_Call) Run(run func()) *TradingService_GetAPIKeyPermissions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TradingService_GetAPIKeyPermissions_Call) Return(_a0 *types.APIKeyPermissions, _a1 error) *TradingService_GetAPIKeyPermissions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TradingService_GetAPIKeyPermissions_Call) RunAndReturn(run func() (*types.APIKeyPermissions, error)) *TradingService_GetAPIKeyPermissions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAccountBalance provides a mock function with no fields
+func (_m *TradingService) GetAccountBalance() (*connector.AccountBalance, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccountBalance")
+	}
+
+	var r0 *connector.AccountBalance
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*connector.AccountBalance, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *connector.AccountBalance); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connector.AccountBalance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TradingService_GetAccountBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccountBalance'
+type TradingService_GetAccountBalance_Call struct {
+	*mock.Call
+}
+
+// GetAccountBalance is a helper method to define mock.On call
+func (_e *TradingService_Expecter) GetAccountBalance() *TradingService_GetAccountBalance_Call {
+	return &TradingService_GetAccountBalance_Call{Call: _e.mock.On("GetAccountBalance")}
+}
+
+func (_c *TradingService_GetAccountBalance_Call) Run(run func()) *TradingService_GetAccountBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TradingService_GetAccountBalance_Call) Return(_a0 *connector.AccountBalance, _a1 error) *TradingService_GetAccountBalance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TradingService_GetAccountBalance_Call) RunAndReturn(run func() (*connector.AccountBalance, error)) *TradingService_GetAccountBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOpenOrders provides a mock function with given fields: instrument
+func (_m *TradingService) GetOpenOrders(instrument connector.Instrument) ([]connector.Order, error) {
+	ret := _m.Called(instrument)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOpenOrders")
+	}
+
+	var r0 []connector.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(connector.Instrument) ([]connector.Order, error)); ok {
+		return rf(instrument)
+	}
+	if rf, ok := ret.Get(0).(func(connector.Instrument) []connector.Order); ok {
+		r0 = rf(instrument)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]connector.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.Instrument) error); ok {
+		r1 = rf(instrument)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TradingService_GetOpenOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOpenOrders'
+type TradingService_GetOpenOrders_Call struct {
+	*mock.Call
+}
+
+// GetOpenOrders is a helper method to define mock.On call
+//   - instrument connector.Instrument
+func (_e *TradingService_Expecter) GetOpenOrders(instrument interface{}) *TradingService_GetOpenOrders_Call {
+	return &TradingService_GetOpenOrders_Call{Call: _e.mock.On("GetOpenOrders", instrument)}
+}
+
+func (_c *TradingService_GetOpenOrders_Call) Run(run func(instrument connector.Instrument)) *TradingService_GetOpenOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.Instrument))
+	})
+	return _c
+}
+
+func (_c *TradingService_GetOpenOrders_Call) Return(_a0 []connector.Order, _a1 error) *TradingService_GetOpenOrders_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TradingService_GetOpenOrders_Call) RunAndReturn(run func(connector.Instrument) ([]connector.Order, error)) *TradingService_GetOpenOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrderStatus provides a mock function with given fields: instrument, orderID
+func (_m *TradingService) GetOrderStatus(instrument connector.Instrument, orderID string) (*connector.Order, error) {
+	ret := _m.Called(instrument, orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrderStatus")
+	}
+
+	var r0 *connector.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string) (*connector.Order, error)); ok {
+		return rf(instrument, orderID)
+	}
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string) *connector.Order); ok {
+		r0 = rf(instrument, orderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connector.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.Instrument, string) error); ok {
+		r1 = rf(instrument, orderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TradingService_GetOrderStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrderStatus'
+type TradingService_GetOrderStatus_Call struct {
+	*mock.Call
+}
+
+// GetOrderStatus is a helper method to define mock.On call
+//   - instrument connector.Instrument
+//   - orderID string
+func (_e *TradingService_Expecter) GetOrderStatus(instrument interface{}, orderID interface{}) *TradingService_GetOrderStatus_Call {
+	return &TradingService_GetOrderStatus_Call{Call: _e.mock.On("GetOrderStatus", instrument, orderID)}
+}
+
+func (_c *TradingService_GetOrderStatus_Call) Run(run func(instrument connector.Instrument, orderID string)) *TradingService_GetOrderStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.Instrument), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TradingService_GetOrderStatus_Call) Return(_a0 *connector.Order, _a1 error) *TradingService_GetOrderStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TradingService_GetOrderStatus_Call) RunAndReturn(run func(connector.Instrument, string) (*connector.Order, error)) *TradingService_GetOrderStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPositions provides a mock function with no fields
+func (_m *TradingService) GetPositions() ([]connector.Position, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPositions")
+	}
+
+	var r0 []connector.Position
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]connector.Position, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []connector.Position); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]connector.Position)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TradingService_GetPositions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPositions'
+type TradingService_GetPositions_Call struct {
+	*mock.Call
+}
+
+// GetPositions is a helper method to define mock.On call
+func (_e *TradingService_Expecter) GetPositions() *TradingService_GetPositions_Call {
+	return &TradingService_GetPositions_Call{Call: _e.mock.On("GetPositions")}
+}
+
+func (_c *TradingService_GetPositions_Call) Run(run func()) *TradingService_GetPositions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TradingService_GetPositions_Call) Return(_a0 []connector.Position, _a1 error) *TradingService_GetPositions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TradingService_GetPositions_Call) RunAndReturn(run func() ([]connector.Position, error)) *TradingService_GetPositions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSpotBalances provides a mock function with no fields
+func (_m *TradingService) GetSpotBalances() ([]types.SpotBalance, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSpotBalances")
+	}
+
+	var r0 []types.SpotBalance
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]types.SpotBalance, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []types.SpotBalance); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.SpotBalance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TradingService_GetSpotBalances_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSpotBalances'
+type TradingService_GetSpotBalances_Call struct {
+	*mock.Call
+}
+
+// GetSpotBalances is a helper method to define mock.On call
+func (_e *TradingService_Expecter) GetSpotBalances() *TradingService_GetSpotBalances_Call {
+	return &TradingService_GetSpotBalances_Call{Call: _e.mock.On("GetSpotBalances")}
+}
+
+func (_c *TradingService_GetSpotBalances_Call) Run(run func()) *TradingService_GetSpotBalances_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TradingService_GetSpotBalances_Call) Return(_a0 []types.SpotBalance, _a1 error) *TradingService_GetSpotBalances_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TradingService_GetSpotBalances_Call) RunAndReturn(run func() ([]types.SpotBalance, error)) *TradingService_GetSpotBalances_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTradingHistory provides a mock function with given fields: instrument, symbol, limit
+func (_m *TradingService) GetTradingHistory(instrument connector.Instrument, symbol string, limit int) ([]connector.Trade, error) {
+	ret := _m.Called(instrument, symbol, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTradingHistory")
+	}
+
+	var r0 []connector.Trade
+	var r1 error
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string, int) ([]connector.Trade, error)); ok {
+		return rf(instrument, symbol, limit)
+	}
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string, int) []connector.Trade); ok {
+		r0 = rf(instrument, symbol, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]connector.Trade)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.Instrument, string, int) error); ok {
+		r1 = rf(instrument, symbol, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TradingService_GetTradingHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTradingHistory'
+type TradingService_GetTradingHistory_Call struct {
+	*mock.Call
+}
+
+// GetTradingHistory is a helper method to define mock.On call
+//   - instrument connector.Instrument
+//   - symbol string
+//   - limit int
+func (_e *TradingService_Expecter) GetTradingHistory(instrument interface{}, symbol interface{}, limit interface{}) *TradingService_GetTradingHistory_Call {
+	return &TradingService_GetTradingHistory_Call{Call: _e.mock.On("GetTradingHistory", instrument, symbol, limit)}
+}
+
+func (_c *TradingService_GetTradingHistory_Call) Run(run func(instrument connector.Instrument, symbol string, limit int)) *TradingService_GetTradingHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.Instrument), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *TradingService_GetTradingHistory_Call) Return(_a0 []connector.Trade, _a1 error) *TradingService_GetTradingHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TradingService_GetTradingHistory_Call) RunAndReturn(run func(connector.Instrument, string, int) ([]connector.Trade, error)) *TradingService_GetTradingHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTradingHistoryRange provides a mock function with given fields: instrument, symbol, start, end, limit
+func (_m *TradingService) GetTradingHistoryRange(instrument connector.Instrument, symbol string, start time.Time, end time.Time, limit int) ([]connector.Trade, error) {
+	ret := _m.Called(instrument, symbol, start, end, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTradingHistoryRange")
+	}
+
+	var r0 []connector.Trade
+	var r1 error
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string, time.Time, time.Time, int) ([]connector.Trade, error)); ok {
+		return rf(instrument, symbol, start, end, limit)
+	}
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string, time.Time, time.Time, int) []connector.Trade); ok {
+		r0 = rf(instrument, symbol, start, end, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]connector.Trade)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.Instrument, string, time.Time, time.Time, int) error); ok {
+		r1 = rf(instrument, symbol, start, end, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TradingService_GetTradingHistoryRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTradingHistoryRange'
+type TradingService_GetTradingHistoryRange_Call struct {
+	*mock.Call
+}
+
+// GetTradingHistoryRange is a helper method to define mock.On call
+//   - instrument connector.Instrument
+//   - symbol string
+//   - start time.Time
+//   - end time.Time
+//   - limit int
+func (_e *TradingService_Expecter) GetTradingHistoryRange(instrument interface{}, symbol interface{}, start interface{}, end interface{}, limit interface{}) *TradingService_GetTradingHistoryRange_Call {
+	return &TradingService_GetTradingHistoryRange_Call{Call: _e.mock.On("GetTradingHistoryRange", instrument, symbol, start, end, limit)}
+}
+
+func (_c *TradingService_GetTradingHistoryRange_Call) Run(run func(instrument connector.Instrument, symbol string, start time.Time, end time.Time, limit int)) *TradingService_GetTradingHistoryRange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.Instrument), args[1].(string), args[2].(time.Time), args[3].(time.Time), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *TradingService_GetTradingHistoryRange_Call) Return(_a0 []connector.Trade, _a1 error) *TradingService_GetTradingHistoryRange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TradingService_GetTradingHistoryRange_Call) RunAndReturn(run func(connector.Instrument, string, time.Time, time.Time, int) ([]connector.Trade, error)) *TradingService_GetTradingHistoryRange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTransferIncome provides a mock function with given fields: since
+func (_m *TradingService) GetTransferIncome(since time.Time) ([]types.TransferEvent, error) {
+	ret := _m.Called(since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransferIncome")
+	}
+
+	var r0 []types.TransferEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time) ([]types.TransferEvent, error)); ok {
+		return rf(since)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time) []types.TransferEvent); ok {
+		r0 = rf(since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.TransferEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = rf(since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TradingService_GetTransferIncome_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTransferIncome'
+type TradingService_GetTransferIncome_Call struct {
+	*mock.Call
+}
+
+// GetTransferIncome is a helper method to define mock.On call
+//   - since time.Time
+func (_e *TradingService_Expecter) GetTransferIncome(since interface{}) *TradingService_GetTransferIncome_Call {
+	return &TradingService_GetTransferIncome_Call{Call: _e.mock.On("GetTransferIncome", since)}
+}
+
+func (_c *TradingService_GetTransferIncome_Call) Run(run func(since time.Time)) *TradingService_GetTransferIncome_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Time))
+	})
+	return _c
+}
+
+func (_c *TradingService_GetTransferIncome_Call) Return(_a0 []types.TransferEvent, _a1 error) *TradingService_GetTransferIncome_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TradingService_GetTransferIncome_Call) RunAndReturn(run func(time.Time) ([]types.TransferEvent, error)) *TradingService_GetTransferIncome_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OrderPayload provides a mock function with given fields: orderID
+func (_m *TradingService) OrderPayload(orderID string) (jsontext.Value, bool) {
+	ret := _m.Called(orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OrderPayload")
+	}
+
+	var r0 jsontext.Value
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string) (jsontext.Value, bool)); ok {
+		return rf(orderID)
+	}
+	if rf, ok := ret.Get(0).(func(string) jsontext.Value); ok {
+		r0 = rf(orderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(jsontext.Value)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(orderID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// TradingService_OrderPayload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrderPayload'
+type TradingService_OrderPayload_Call struct {
+	*mock.Call
+}
+
+// OrderPayload is a helper method to define mock.On call
+//   - orderID string
+func (_e *TradingService_Expecter) OrderPayload(orderID interface{}) *TradingService_OrderPayload_Call {
+	return &TradingService_OrderPayload_Call{Call: _e.mock.On("OrderPayload", orderID)}
+}
+
+func (_c *TradingService_OrderPayload_Call) Run(run func(orderID string)) *TradingService_OrderPayload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *TradingService_OrderPayload_Call) Return(_a0 jsontext.Value, _a1 bool) *TradingService_OrderPayload_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TradingService_OrderPayload_Call) RunAndReturn(run func(string) (jsontext.Value, bool)) *TradingService_OrderPayload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PlaceLimitOrder provides a mock function with given fields: instrument, symbol, side, quantity, price
+func (_m *TradingService) PlaceLimitOrder(instrument connector.Instrument, symbol string, side connector.OrderSide, quantity numerical.Decimal, price numerical.Decimal) (*connector.OrderResponse, error) {
+	ret := _m.Called(instrument, symbol, side, quantity, price)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlaceLimitOrder")
+	}
+
+	var r0 *connector.OrderResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string, connector.OrderSide, numerical.Decimal, numerical.Decimal) (*connector.OrderResponse, error)); ok {
+		return rf(instrument, symbol, side, quantity, price)
+	}
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string, connector.OrderSide, numerical.Decimal, numerical.Decimal) *connector.OrderResponse); ok {
+		r0 = rf(instrument, symbol, side, quantity, price)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connector.OrderResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.Instrument, string, connector.OrderSide, numerical.Decimal, numerical.Decimal) error); ok {
+		r1 = rf(instrument, symbol, side, quantity, price)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TradingService_PlaceLimitOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PlaceLimitOrder'
+type TradingService_PlaceLimitOrder_Call struct {
+	*mock.Call
+}
+
+// PlaceLimitOrder is a helper method to define mock.On call
+//   - instrument connector.Instrument
+//   - symbol string
+//   - side connector.OrderSide
+//   - quantity numerical.Decimal
+//   - price numerical.Decimal
+func (_e *TradingService_Expecter) PlaceLimitOrder(instrument interface{}, symbol interface{}, side interface{}, quantity interface{}, price interface{}) *TradingService_PlaceLimitOrder_Call {
+	return &TradingService_PlaceLimitOrder_Call{Call: _e.mock.On("PlaceLimitOrder", instrument, symbol, side, quantity, price)}
+}
+
+func (_c *TradingService_PlaceLimitOrder_Call) Run(run func(instrument connector.Instrument, symbol string, side connector.OrderSide, quantity numerical.Decimal, price numerical.Decimal)) *TradingService_PlaceLimitOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.Instrument), args[1].(string), args[2].(connector.OrderSide), args[3].(numerical.Decimal), args[4].(numerical.Decimal))
+	})
+	return _c
+}
+
+func (_c *TradingService_PlaceLimitOrder_Call) Return(_a0 *connector.OrderResponse, _a1 error) *TradingService_PlaceLimitOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TradingService_PlaceLimitOrder_Call) RunAndReturn(run func(connector.Instrument, string, connector.OrderSide, numerical.Decimal, numerical.Decimal) (*connector.OrderResponse, error)) *TradingService_PlaceLimitOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PlaceMarketOrder provides a mock function with given fields: instrument, symbol, side, quantity
+func (_m *TradingService) PlaceMarketOrder(instrument connector.Instrument, symbol string, side connector.OrderSide, quantity numerical.Decimal) (*connector.OrderResponse, error) {
+	ret := _m.Called(instrument, symbol, side, quantity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlaceMarketOrder")
+	}
+
+	var r0 *connector.OrderResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string, connector.OrderSide, numerical.Decimal) (*connector.OrderResponse, error)); ok {
+		return rf(instrument, symbol, side, quantity)
+	}
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string, connector.OrderSide, numerical.Decimal) *connector.OrderResponse); ok {
+		r0 = rf(instrument, symbol, side, quantity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connector.OrderResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.Instrument, string, connector.OrderSide, numerical.Decimal) error); ok {
+		r1 = rf(instrument, symbol, side, quantity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TradingService_PlaceMarketOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PlaceMarketOrder'
+type TradingService_PlaceMarketOrder_Call struct {
+	*mock.Call
+}
+
+// PlaceMarketOrder is a helper method to define mock.On call
+//   - instrument connector.Instrument
+//   - symbol string
+//   - side connector.OrderSide
+//   - quantity numerical.Decimal
+func (_e *TradingService_Expecter) PlaceMarketOrder(instrument interface{}, symbol interface{}, side interface{}, quantity interface{}) *TradingService_PlaceMarketOrder_Call {
+	return &TradingService_PlaceMarketOrder_Call{Call: _e.mock.On("PlaceMarketOrder", instrument, symbol, side, quantity)}
+}
+
+func (_c *TradingService_PlaceMarketOrder_Call) Run(run func(instrument connector.Instrument, symbol string, side connector.OrderSide, quantity numerical.Decimal)) *TradingService_PlaceMarketOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.Instrument), args[1].(string), args[2].(connector.OrderSide), args[3].(numerical.Decimal))
+	})
+	return _c
+}
+
+func (_c *TradingService_PlaceMarketOrder_Call) Return(_a0 *connector.OrderResponse, _a1 error) *TradingService_PlaceMarketOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TradingService_PlaceMarketOrder_Call) RunAndReturn(run func(connector.Instrument, string, connector.OrderSide, numerical.Decimal) (*connector.OrderResponse, error)) *TradingService_PlaceMarketOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTradingService creates a new instance of TradingService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTradingService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TradingService {
+	mock := &TradingService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
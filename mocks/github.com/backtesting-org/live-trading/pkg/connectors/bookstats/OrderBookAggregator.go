@@ -0,0 +1,696 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package bookstats
+
+import (
+	connector "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	bookstats "github.com/backtesting-org/live-trading/pkg/connectors/bookstats"
+
+	mock "github.com/stretchr/testify/mock"
+
+	numerical "github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+
+	portfolio "github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+
+	time "time"
+)
+
+// OrderBookAggregator is an autogenerated mock type for the OrderBookAggregator type
+type OrderBookAggregator struct {
+	mock.Mock
+}
+
+type OrderBookAggregator_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OrderBookAggregator) EXPECT() *OrderBookAggregator_Expecter {
+	return &OrderBookAggregator_Expecter{mock: &_m.Mock}
+}
+
+// ApplyDelta provides a mock function with given fields: exchange, asset, bids, asks, at
+func (_m *OrderBookAggregator) ApplyDelta(exchange connector.ExchangeName, asset portfolio.Asset, bids []connector.PriceLevel, asks []connector.PriceLevel, at time.Time) error {
+	ret := _m.Called(exchange, asset, bids, asks, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyDelta")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, portfolio.Asset, []connector.PriceLevel, []connector.PriceLevel, time.Time) error); ok {
+		r0 = rf(exchange, asset, bids, asks, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderBookAggregator_ApplyDelta_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyDelta'
+type OrderBookAggregator_ApplyDelta_Call struct {
+	*mock.Call
+}
+
+// ApplyDelta is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - asset portfolio.Asset
+//   - bids []connector.PriceLevel
+//   - asks []connector.PriceLevel
+//   - at time.Time
+func (_e *OrderBookAggregator_Expecter) ApplyDelta(exchange interface{}, asset interface{}, bids interface{}, asks interface{}, at interface{}) *OrderBookAggregator_ApplyDelta_Call {
+	return &OrderBookAggregator_ApplyDelta_Call{Call: _e.mock.On("ApplyDelta", exchange, asset, bids, asks, at)}
+}
+
+func (_c *OrderBookAggregator_ApplyDelta_Call) Run(run func(exchange connector.ExchangeName, asset portfolio.Asset, bids []connector.PriceLevel, asks []connector.PriceLevel, at time.Time)) *OrderBookAggregator_ApplyDelta_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(portfolio.Asset), args[2].([]connector.PriceLevel), args[3].([]connector.PriceLevel), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *OrderBookAggregator_ApplyDelta_Call) Return(_a0 error) *OrderBookAggregator_ApplyDelta_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderBookAggregator_ApplyDelta_Call) RunAndReturn(run func(connector.ExchangeName, portfolio.Asset, []connector.PriceLevel, []connector.PriceLevel, time.Time) error) *OrderBookAggregator_ApplyDelta_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BestAsk provides a mock function with given fields: exchange, asset
+func (_m *OrderBookAggregator) BestAsk(exchange connector.ExchangeName, asset portfolio.Asset) (connector.PriceLevel, bool) {
+	ret := _m.Called(exchange, asset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BestAsk")
+	}
+
+	var r0 connector.PriceLevel
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, portfolio.Asset) (connector.PriceLevel, bool)); ok {
+		return rf(exchange, asset)
+	}
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, portfolio.Asset) connector.PriceLevel); ok {
+		r0 = rf(exchange, asset)
+	} else {
+		r0 = ret.Get(0).(connector.PriceLevel)
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.ExchangeName, portfolio.Asset) bool); ok {
+		r1 = rf(exchange, asset)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// OrderBookAggregator_BestAsk_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BestAsk'
+type OrderBookAggregator_BestAsk_Call struct {
+	*mock.Call
+}
+
+// BestAsk is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - asset portfolio.Asset
+func (_e *OrderBookAggregator_Expecter) BestAsk(exchange interface{}, asset interface{}) *OrderBookAggregator_BestAsk_Call {
+	return &OrderBookAggregator_BestAsk_Call{Call: _e.mock.On("BestAsk", exchange, asset)}
+}
+
+func (_c *OrderBookAggregator_BestAsk_Call) Run(run func(exchange connector.ExchangeName, asset portfolio.Asset)) *OrderBookAggregator_BestAsk_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(portfolio.Asset))
+	})
+	return _c
+}
+
+func (_c *OrderBookAggregator_BestAsk_Call) Return(_a0 connector.PriceLevel, _a1 bool) *OrderBookAggregator_BestAsk_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderBookAggregator_BestAsk_Call) RunAndReturn(run func(connector.ExchangeName, portfolio.Asset) (connector.PriceLevel, bool)) *OrderBookAggregator_BestAsk_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BestBid provides a mock function with given fields: exchange, asset
+func (_m *OrderBookAggregator) BestBid(exchange connector.ExchangeName, asset portfolio.Asset) (connector.PriceLevel, bool) {
+	ret := _m.Called(exchange, asset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BestBid")
+	}
+
+	var r0 connector.PriceLevel
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, portfolio.Asset) (connector.PriceLevel, bool)); ok {
+		return rf(exchange, asset)
+	}
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, portfolio.Asset) connector.PriceLevel); ok {
+		r0 = rf(exchange, asset)
+	} else {
+		r0 = ret.Get(0).(connector.PriceLevel)
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.ExchangeName, portfolio.Asset) bool); ok {
+		r1 = rf(exchange, asset)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// OrderBookAggregator_BestBid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BestBid'
+type OrderBookAggregator_BestBid_Call struct {
+	*mock.Call
+}
+
+// BestBid is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - asset portfolio.Asset
+func (_e *OrderBookAggregator_Expecter) BestBid(exchange interface{}, asset interface{}) *OrderBookAggregator_BestBid_Call {
+	return &OrderBookAggregator_BestBid_Call{Call: _e.mock.On("BestBid", exchange, asset)}
+}
+
+func (_c *OrderBookAggregator_BestBid_Call) Run(run func(exchange connector.ExchangeName, asset portfolio.Asset)) *OrderBookAggregator_BestBid_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(portfolio.Asset))
+	})
+	return _c
+}
+
+func (_c *OrderBookAggregator_BestBid_Call) Return(_a0 connector.PriceLevel, _a1 bool) *OrderBookAggregator_BestBid_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderBookAggregator_BestBid_Call) RunAndReturn(run func(connector.ExchangeName, portfolio.Asset) (connector.PriceLevel, bool)) *OrderBookAggregator_BestBid_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Book provides a mock function with given fields: exchange, asset
+func (_m *OrderBookAggregator) Book(exchange connector.ExchangeName, asset portfolio.Asset) (connector.OrderBook, bool) {
+	ret := _m.Called(exchange, asset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Book")
+	}
+
+	var r0 connector.OrderBook
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, portfolio.Asset) (connector.OrderBook, bool)); ok {
+		return rf(exchange, asset)
+	}
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, portfolio.Asset) connector.OrderBook); ok {
+		r0 = rf(exchange, asset)
+	} else {
+		r0 = ret.Get(0).(connector.OrderBook)
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.ExchangeName, portfolio.Asset) bool); ok {
+		r1 = rf(exchange, asset)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// OrderBookAggregator_Book_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Book'
+type OrderBookAggregator_Book_Call struct {
+	*mock.Call
+}
+
+// Book is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - asset portfolio.Asset
+func (_e *OrderBookAggregator_Expecter) Book(exchange interface{}, asset interface{}) *OrderBookAggregator_Book_Call {
+	return &OrderBookAggregator_Book_Call{Call: _e.mock.On("Book", exchange, asset)}
+}
+
+func (_c *OrderBookAggregator_Book_Call) Run(run func(exchange connector.ExchangeName, asset portfolio.Asset)) *OrderBookAggregator_Book_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(portfolio.Asset))
+	})
+	return _c
+}
+
+func (_c *OrderBookAggregator_Book_Call) Return(_a0 connector.OrderBook, _a1 bool) *OrderBookAggregator_Book_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderBookAggregator_Book_Call) RunAndReturn(run func(connector.ExchangeName, portfolio.Asset) (connector.OrderBook, bool)) *OrderBookAggregator_Book_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Configure provides a mock function with given fields: snapshotInterval
+func (_m *OrderBookAggregator) Configure(snapshotInterval time.Duration) {
+	_m.Called(snapshotInterval)
+}
+
+// OrderBookAggregator_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type OrderBookAggregator_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - snapshotInterval time.Duration
+func (_e *OrderBookAggregator_Expecter) Configure(snapshotInterval interface{}) *OrderBookAggregator_Configure_Call {
+	return &OrderBookAggregator_Configure_Call{Call: _e.mock.On("Configure", snapshotInterval)}
+}
+
+func (_c *OrderBookAggregator_Configure_Call) Run(run func(snapshotInterval time.Duration)) *OrderBookAggregator_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *OrderBookAggregator_Configure_Call) Return() *OrderBookAggregator_Configure_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *OrderBookAggregator_Configure_Call) RunAndReturn(run func(time.Duration)) *OrderBookAggregator_Configure_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Consolidated provides a mock function with given fields: asset
+func (_m *OrderBookAggregator) Consolidated(asset portfolio.Asset) (connector.OrderBook, bool) {
+	ret := _m.Called(asset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Consolidated")
+	}
+
+	var r0 connector.OrderBook
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(portfolio.Asset) (connector.OrderBook, bool)); ok {
+		return rf(asset)
+	}
+	if rf, ok := ret.Get(0).(func(portfolio.Asset) connector.OrderBook); ok {
+		r0 = rf(asset)
+	} else {
+		r0 = ret.Get(0).(connector.OrderBook)
+	}
+
+	if rf, ok := ret.Get(1).(func(portfolio.Asset) bool); ok {
+		r1 = rf(asset)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// OrderBookAggregator_Consolidated_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Consolidated'
+type OrderBookAggregator_Consolidated_Call struct {
+	*mock.Call
+}
+
+// Consolidated is a helper method to define mock.On call
+//   - asset portfolio.Asset
+func (_e *OrderBookAggregator_Expecter) Consolidated(asset interface{}) *OrderBookAggregator_Consolidated_Call {
+	return &OrderBookAggregator_Consolidated_Call{Call: _e.mock.On("Consolidated", asset)}
+}
+
+func (_c *OrderBookAggregator_Consolidated_Call) Run(run func(asset portfolio.Asset)) *OrderBookAggregator_Consolidated_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(portfolio.Asset))
+	})
+	return _c
+}
+
+func (_c *OrderBookAggregator_Consolidated_Call) Return(_a0 connector.OrderBook, _a1 bool) *OrderBookAggregator_Consolidated_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderBookAggregator_Consolidated_Call) RunAndReturn(run func(portfolio.Asset) (connector.OrderBook, bool)) *OrderBookAggregator_Consolidated_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DepthWithinBps provides a mock function with given fields: exchange, asset, bandBps
+func (_m *OrderBookAggregator) DepthWithinBps(exchange connector.ExchangeName, asset portfolio.Asset, bandBps numerical.Decimal) (bookstats.Depth, bool) {
+	ret := _m.Called(exchange, asset, bandBps)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DepthWithinBps")
+	}
+
+	var r0 bookstats.Depth
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, portfolio.Asset, numerical.Decimal) (bookstats.Depth, bool)); ok {
+		return rf(exchange, asset, bandBps)
+	}
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, portfolio.Asset, numerical.Decimal) bookstats.Depth); ok {
+		r0 = rf(exchange, asset, bandBps)
+	} else {
+		r0 = ret.Get(0).(bookstats.Depth)
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.ExchangeName, portfolio.Asset, numerical.Decimal) bool); ok {
+		r1 = rf(exchange, asset, bandBps)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// OrderBookAggregator_DepthWithinBps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DepthWithinBps'
+type OrderBookAggregator_DepthWithinBps_Call struct {
+	*mock.Call
+}
+
+// DepthWithinBps is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - asset portfolio.Asset
+//   - bandBps numerical.Decimal
+func (_e *OrderBookAggregator_Expecter) DepthWithinBps(exchange interface{}, asset interface{}, bandBps interface{}) *OrderBookAggregator_DepthWithinBps_Call {
+	return &OrderBookAggregator_DepthWithinBps_Call{Call: _e.mock.On("DepthWithinBps", exchange, asset, bandBps)}
+}
+
+func (_c *OrderBookAggregator_DepthWithinBps_Call) Run(run func(exchange connector.ExchangeName, asset portfolio.Asset, bandBps numerical.Decimal)) *OrderBookAggregator_DepthWithinBps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(portfolio.Asset), args[2].(numerical.Decimal))
+	})
+	return _c
+}
+
+func (_c *OrderBookAggregator_DepthWithinBps_Call) Return(_a0 bookstats.Depth, _a1 bool) *OrderBookAggregator_DepthWithinBps_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderBookAggregator_DepthWithinBps_Call) RunAndReturn(run func(connector.ExchangeName, portfolio.Asset, numerical.Decimal) (bookstats.Depth, bool)) *OrderBookAggregator_DepthWithinBps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Snapshots provides a mock function with no fields
+func (_m *OrderBookAggregator) Snapshots() <-chan connector.OrderBook {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Snapshots")
+	}
+
+	var r0 <-chan connector.OrderBook
+	if rf, ok := ret.Get(0).(func() <-chan connector.OrderBook); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan connector.OrderBook)
+		}
+	}
+
+	return r0
+}
+
+// OrderBookAggregator_Snapshots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Snapshots'
+type OrderBookAggregator_Snapshots_Call struct {
+	*mock.Call
+}
+
+// Snapshots is a helper method to define mock.On call
+func (_e *OrderBookAggregator_Expecter) Snapshots() *OrderBookAggregator_Snapshots_Call {
+	return &OrderBookAggregator_Snapshots_Call{Call: _e.mock.On("Snapshots")}
+}
+
+func (_c *OrderBookAggregator_Snapshots_Call) Run(run func()) *OrderBookAggregator_Snapshots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OrderBookAggregator_Snapshots_Call) Return(_a0 <-chan connector.OrderBook) *OrderBookAggregator_Snapshots_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderBookAggregator_Snapshots_Call) RunAndReturn(run func() <-chan connector.OrderBook) *OrderBookAggregator_Snapshots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Spread provides a mock function with given fields: exchange, asset
+func (_m *OrderBookAggregator) Spread(exchange connector.ExchangeName, asset portfolio.Asset) (numerical.Decimal, bool) {
+	ret := _m.Called(exchange, asset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Spread")
+	}
+
+	var r0 numerical.Decimal
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, portfolio.Asset) (numerical.Decimal, bool)); ok {
+		return rf(exchange, asset)
+	}
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, portfolio.Asset) numerical.Decimal); ok {
+		r0 = rf(exchange, asset)
+	} else {
+		r0 = ret.Get(0).(numerical.Decimal)
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.ExchangeName, portfolio.Asset) bool); ok {
+		r1 = rf(exchange, asset)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// OrderBookAggregator_Spread_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Spread'
+type OrderBookAggregator_Spread_Call struct {
+	*mock.Call
+}
+
+// Spread is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - asset portfolio.Asset
+func (_e *OrderBookAggregator_Expecter) Spread(exchange interface{}, asset interface{}) *OrderBookAggregator_Spread_Call {
+	return &OrderBookAggregator_Spread_Call{Call: _e.mock.On("Spread", exchange, asset)}
+}
+
+func (_c *OrderBookAggregator_Spread_Call) Run(run func(exchange connector.ExchangeName, asset portfolio.Asset)) *OrderBookAggregator_Spread_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(portfolio.Asset))
+	})
+	return _c
+}
+
+func (_c *OrderBookAggregator_Spread_Call) Return(_a0 numerical.Decimal, _a1 bool) *OrderBookAggregator_Spread_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderBookAggregator_Spread_Call) RunAndReturn(run func(connector.ExchangeName, portfolio.Asset) (numerical.Decimal, bool)) *OrderBookAggregator_Spread_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with no fields
+func (_m *OrderBookAggregator) Start() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderBookAggregator_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type OrderBookAggregator_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+func (_e *OrderBookAggregator_Expecter) Start() *OrderBookAggregator_Start_Call {
+	return &OrderBookAggregator_Start_Call{Call: _e.mock.On("Start")}
+}
+
+func (_c *OrderBookAggregator_Start_Call) Run(run func()) *OrderBookAggregator_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OrderBookAggregator_Start_Call) Return(_a0 error) *OrderBookAggregator_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderBookAggregator_Start_Call) RunAndReturn(run func() error) *OrderBookAggregator_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with no fields
+func (_m *OrderBookAggregator) Stop() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderBookAggregator_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type OrderBookAggregator_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *OrderBookAggregator_Expecter) Stop() *OrderBookAggregator_Stop_Call {
+	return &OrderBookAggregator_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *OrderBookAggregator_Stop_Call) Run(run func()) *OrderBookAggregator_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OrderBookAggregator_Stop_Call) Return(_a0 error) *OrderBookAggregator_Stop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderBookAggregator_Stop_Call) RunAndReturn(run func() error) *OrderBookAggregator_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Top provides a mock function with given fields: exchange, asset
+func (_m *OrderBookAggregator) Top(exchange connector.ExchangeName, asset portfolio.Asset) (bookstats.TopOfBook, bool) {
+	ret := _m.Called(exchange, asset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Top")
+	}
+
+	var r0 bookstats.TopOfBook
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, portfolio.Asset) (bookstats.TopOfBook, bool)); ok {
+		return rf(exchange, asset)
+	}
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, portfolio.Asset) bookstats.TopOfBook); ok {
+		r0 = rf(exchange, asset)
+	} else {
+		r0 = ret.Get(0).(bookstats.TopOfBook)
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.ExchangeName, portfolio.Asset) bool); ok {
+		r1 = rf(exchange, asset)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// OrderBookAggregator_Top_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Top'
+type OrderBookAggregator_Top_Call struct {
+	*mock.Call
+}
+
+// Top is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - asset portfolio.Asset
+func (_e *OrderBookAggregator_Expecter) Top(exchange interface{}, asset interface{}) *OrderBookAggregator_Top_Call {
+	return &OrderBookAggregator_Top_Call{Call: _e.mock.On("Top", exchange, asset)}
+}
+
+func (_c *OrderBookAggregator_Top_Call) Run(run func(exchange connector.ExchangeName, asset portfolio.Asset)) *OrderBookAggregator_Top_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(portfolio.Asset))
+	})
+	return _c
+}
+
+func (_c *OrderBookAggregator_Top_Call) Return(_a0 bookstats.TopOfBook, _a1 bool) *OrderBookAggregator_Top_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderBookAggregator_Top_Call) RunAndReturn(run func(connector.ExchangeName, portfolio.Asset) (bookstats.TopOfBook, bool)) *OrderBookAggregator_Top_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: exchange, book
+func (_m *OrderBookAggregator) Update(exchange connector.ExchangeName, book connector.OrderBook) {
+	_m.Called(exchange, book)
+}
+
+// OrderBookAggregator_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type OrderBookAggregator_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - book connector.OrderBook
+func (_e *OrderBookAggregator_Expecter) Update(exchange interface{}, book interface{}) *OrderBookAggregator_Update_Call {
+	return &OrderBookAggregator_Update_Call{Call: _e.mock.On("Update", exchange, book)}
+}
+
+func (_c *OrderBookAggregator_Update_Call) Run(run func(exchange connector.ExchangeName, book connector.OrderBook)) *OrderBookAggregator_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(connector.OrderBook))
+	})
+	return _c
+}
+
+func (_c *OrderBookAggregator_Update_Call) Return() *OrderBookAggregator_Update_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *OrderBookAggregator_Update_Call) RunAndReturn(run func(connector.ExchangeName, connector.OrderBook)) *OrderBookAggregator_Update_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewOrderBookAggregator creates a new instance of OrderBookAggregator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOrderBookAggregator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OrderBookAggregator {
+	mock := &OrderBookAggregator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,166 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package bookstats
+
+import (
+	connector "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	bookstats "github.com/backtesting-org/live-trading/pkg/connectors/bookstats"
+
+	mock "github.com/stretchr/testify/mock"
+
+	numerical "github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+
+	portfolio "github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+
+	time "time"
+)
+
+// ResiliencyTracker is an autogenerated mock type for the ResiliencyTracker type
+type ResiliencyTracker struct {
+	mock.Mock
+}
+
+type ResiliencyTracker_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ResiliencyTracker) EXPECT() *ResiliencyTracker_Expecter {
+	return &ResiliencyTracker_Expecter{mock: &_m.Mock}
+}
+
+// Configure provides a mock function with given fields: bandBps, window
+func (_m *ResiliencyTracker) Configure(bandBps numerical.Decimal, window time.Duration) {
+	_m.Called(bandBps, window)
+}
+
+// ResiliencyTracker_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type ResiliencyTracker_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - bandBps numerical.Decimal
+//   - window time.Duration
+func (_e *ResiliencyTracker_Expecter) Configure(bandBps interface{}, window interface{}) *ResiliencyTracker_Configure_Call {
+	return &ResiliencyTracker_Configure_Call{Call: _e.mock.On("Configure", bandBps, window)}
+}
+
+func (_c *ResiliencyTracker_Configure_Call) Run(run func(bandBps numerical.Decimal, window time.Duration)) *ResiliencyTracker_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(numerical.Decimal), args[1].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *ResiliencyTracker_Configure_Call) Return() *ResiliencyTracker_Configure_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ResiliencyTracker_Configure_Call) RunAndReturn(run func(numerical.Decimal, time.Duration)) *ResiliencyTracker_Configure_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Observe provides a mock function with given fields: book
+func (_m *ResiliencyTracker) Observe(book *connector.OrderBook) {
+	_m.Called(book)
+}
+
+// ResiliencyTracker_Observe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Observe'
+type ResiliencyTracker_Observe_Call struct {
+	*mock.Call
+}
+
+// Observe is a helper method to define mock.On call
+//   - book *connector.OrderBook
+func (_e *ResiliencyTracker_Expecter) Observe(book interface{}) *ResiliencyTracker_Observe_Call {
+	return &ResiliencyTracker_Observe_Call{Call: _e.mock.On("Observe", book)}
+}
+
+func (_c *ResiliencyTracker_Observe_Call) Run(run func(book *connector.OrderBook)) *ResiliencyTracker_Observe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*connector.OrderBook))
+	})
+	return _c
+}
+
+func (_c *ResiliencyTracker_Observe_Call) Return() *ResiliencyTracker_Observe_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ResiliencyTracker_Observe_Call) RunAndReturn(run func(*connector.OrderBook)) *ResiliencyTracker_Observe_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Resiliency provides a mock function with given fields: asset
+func (_m *ResiliencyTracker) Resiliency(asset portfolio.Asset) (bookstats.Resiliency, bool) {
+	ret := _m.Called(asset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Resiliency")
+	}
+
+	var r0 bookstats.Resiliency
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(portfolio.Asset) (bookstats.Resiliency, bool)); ok {
+		return rf(asset)
+	}
+	if rf, ok := ret.Get(0).(func(portfolio.Asset) bookstats.Resiliency); ok {
+		r0 = rf(asset)
+	} else {
+		r0 = ret.Get(0).(bookstats.Resiliency)
+	}
+
+	if rf, ok := ret.Get(1).(func(portfolio.Asset) bool); ok {
+		r1 = rf(asset)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// ResiliencyTracker_Resiliency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resiliency'
+type ResiliencyTracker_Resiliency_Call struct {
+	*mock.Call
+}
+
+// Resiliency is a helper method to define mock.On call
+//   - asset portfolio.Asset
+func (_e *ResiliencyTracker_Expecter) Resiliency(asset interface{}) *ResiliencyTracker_Resiliency_Call {
+	return &ResiliencyTracker_Resiliency_Call{Call: _e.mock.On("Resiliency", asset)}
+}
+
+func (_c *ResiliencyTracker_Resiliency_Call) Run(run func(asset portfolio.Asset)) *ResiliencyTracker_Resiliency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(portfolio.Asset))
+	})
+	return _c
+}
+
+func (_c *ResiliencyTracker_Resiliency_Call) Return(_a0 bookstats.Resiliency, _a1 bool) *ResiliencyTracker_Resiliency_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResiliencyTracker_Resiliency_Call) RunAndReturn(run func(portfolio.Asset) (bookstats.Resiliency, bool)) *ResiliencyTracker_Resiliency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewResiliencyTracker creates a new instance of ResiliencyTracker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewResiliencyTracker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ResiliencyTracker {
+	mock := &ResiliencyTracker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
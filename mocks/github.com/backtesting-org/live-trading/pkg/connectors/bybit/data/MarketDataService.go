@@ -9,6 +9,10 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	portfolio "github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+
+	time "time"
+
+	types "github.com/backtesting-org/live-trading/pkg/connectors/types"
 )
 
 // MarketDataService is an autogenerated mock type for the MarketDataService type
@@ -313,9 +317,66 @@ func (_c *MarketDataService_FetchHistoricalFundingRates_Call) RunAndReturn(run f
 	return _c
 }
 
-// FetchKlines provides a mock function with given fields: symbol, interval, limit
-func (_m *MarketDataService) FetchKlines(symbol string, interval string, limit int) ([]connector.Kline, error) {
-	ret := _m.Called(symbol, interval, limit)
+// FetchInstrumentStatuses provides a mock function with no fields
+func (_m *MarketDataService) FetchInstrumentStatuses() ([]types.InstrumentStatus, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchInstrumentStatuses")
+	}
+
+	var r0 []types.InstrumentStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]types.InstrumentStatus, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []types.InstrumentStatus); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.InstrumentStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarketDataService_FetchInstrumentStatuses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchInstrumentStatuses'
+type MarketDataService_FetchInstrumentStatuses_Call struct {
+	*mock.Call
+}
+
+// FetchInstrumentStatuses is a helper method to define mock.On call
+func (_e *MarketDataService_Expecter) FetchInstrumentStatuses() *MarketDataService_FetchInstrumentStatuses_Call {
+	return &MarketDataService_FetchInstrumentStatuses_Call{Call: _e.mock.On("FetchInstrumentStatuses")}
+}
+
+func (_c *MarketDataService_FetchInstrumentStatuses_Call) Run(run func()) *MarketDataService_FetchInstrumentStatuses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MarketDataService_FetchInstrumentStatuses_Call) Return(_a0 []types.InstrumentStatus, _a1 error) *MarketDataService_FetchInstrumentStatuses_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MarketDataService_FetchInstrumentStatuses_Call) RunAndReturn(run func() ([]types.InstrumentStatus, error)) *MarketDataService_FetchInstrumentStatuses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FetchKlines provides a mock function with given fields: instrument, symbol, interval, limit
+func (_m *MarketDataService) FetchKlines(instrument connector.Instrument, symbol string, interval string, limit int) ([]connector.Kline, error) {
+	ret := _m.Called(instrument, symbol, interval, limit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FetchKlines")
@@ -323,19 +384,19 @@ func (_m *MarketDataService) FetchKlines(symbol string, interval string, limit i
 
 	var r0 []connector.Kline
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, string, int) ([]connector.Kline, error)); ok {
-		return rf(symbol, interval, limit)
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string, string, int) ([]connector.Kline, error)); ok {
+		return rf(instrument, symbol, interval, limit)
 	}
-	if rf, ok := ret.Get(0).(func(string, string, int) []connector.Kline); ok {
-		r0 = rf(symbol, interval, limit)
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string, string, int) []connector.Kline); ok {
+		r0 = rf(instrument, symbol, interval, limit)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]connector.Kline)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, string, int) error); ok {
-		r1 = rf(symbol, interval, limit)
+	if rf, ok := ret.Get(1).(func(connector.Instrument, string, string, int) error); ok {
+		r1 = rf(instrument, symbol, interval, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -349,16 +410,17 @@ type MarketDataService_FetchKlines_Call struct {
 }
 
 // FetchKlines is a helper method to define mock.On call
+//   - instrument connector.Instrument
 //   - symbol string
 //   - interval string
 //   - limit int
-func (_e *MarketDataService_Expecter) FetchKlines(symbol interface{}, interval interface{}, limit interface{}) *MarketDataService_FetchKlines_Call {
-	return &MarketDataService_FetchKlines_Call{Call: _e.mock.On("FetchKlines", symbol, interval, limit)}
+func (_e *MarketDataService_Expecter) FetchKlines(instrument interface{}, symbol interface{}, interval interface{}, limit interface{}) *MarketDataService_FetchKlines_Call {
+	return &MarketDataService_FetchKlines_Call{Call: _e.mock.On("FetchKlines", instrument, symbol, interval, limit)}
 }
 
-func (_c *MarketDataService_FetchKlines_Call) Run(run func(symbol string, interval string, limit int)) *MarketDataService_FetchKlines_Call {
+func (_c *MarketDataService_FetchKlines_Call) Run(run func(instrument connector.Instrument, symbol string, interval string, limit int)) *MarketDataService_FetchKlines_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string), args[2].(int))
+		run(args[0].(connector.Instrument), args[1].(string), args[2].(string), args[3].(int))
 	})
 	return _c
 }
@@ -368,14 +430,134 @@ func (_c *MarketDataService_FetchKlines_Call) Return(_a0 []connector.Kline, _a1
 	return _c
 }
 
-func (_c *MarketDataService_FetchKlines_Call) RunAndReturn(run func(string, string, int) ([]connector.Kline, error)) *MarketDataService_FetchKlines_Call {
+func (_c *MarketDataService_FetchKlines_Call) RunAndReturn(run func(connector.Instrument, string, string, int) ([]connector.Kline, error)) *MarketDataService_FetchKlines_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FetchKlinesRange provides a mock function with given fields: symbol, interval, start, end, limit
+func (_m *MarketDataService) FetchKlinesRange(symbol string, interval string, start time.Time, end time.Time, limit int) ([]connector.Kline, error) {
+	ret := _m.Called(symbol, interval, start, end, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchKlinesRange")
+	}
+
+	var r0 []connector.Kline
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, time.Time, time.Time, int) ([]connector.Kline, error)); ok {
+		return rf(symbol, interval, start, end, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, time.Time, time.Time, int) []connector.Kline); ok {
+		r0 = rf(symbol, interval, start, end, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]connector.Kline)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, time.Time, time.Time, int) error); ok {
+		r1 = rf(symbol, interval, start, end, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarketDataService_FetchKlinesRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchKlinesRange'
+type MarketDataService_FetchKlinesRange_Call struct {
+	*mock.Call
+}
+
+// FetchKlinesRange is a helper method to define mock.On call
+//   - symbol string
+//   - interval string
+//   - start time.Time
+//   - end time.Time
+//   - limit int
+func (_e *MarketDataService_Expecter) FetchKlinesRange(symbol interface{}, interval interface{}, start interface{}, end interface{}, limit interface{}) *MarketDataService_FetchKlinesRange_Call {
+	return &MarketDataService_FetchKlinesRange_Call{Call: _e.mock.On("FetchKlinesRange", symbol, interval, start, end, limit)}
+}
+
+func (_c *MarketDataService_FetchKlinesRange_Call) Run(run func(symbol string, interval string, start time.Time, end time.Time, limit int)) *MarketDataService_FetchKlinesRange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(time.Time), args[3].(time.Time), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *MarketDataService_FetchKlinesRange_Call) Return(_a0 []connector.Kline, _a1 error) *MarketDataService_FetchKlinesRange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MarketDataService_FetchKlinesRange_Call) RunAndReturn(run func(string, string, time.Time, time.Time, int) ([]connector.Kline, error)) *MarketDataService_FetchKlinesRange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FetchMarkPrice provides a mock function with given fields: symbol
+func (_m *MarketDataService) FetchMarkPrice(symbol string) (*types.MarkPrice, error) {
+	ret := _m.Called(symbol)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchMarkPrice")
+	}
+
+	var r0 *types.MarkPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*types.MarkPrice, error)); ok {
+		return rf(symbol)
+	}
+	if rf, ok := ret.Get(0).(func(string) *types.MarkPrice); ok {
+		r0 = rf(symbol)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.MarkPrice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(symbol)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarketDataService_FetchMarkPrice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchMarkPrice'
+type MarketDataService_FetchMarkPrice_Call struct {
+	*mock.Call
+}
+
+// FetchMarkPrice is a helper method to define mock.On call
+//   - symbol string
+func (_e *MarketDataService_Expecter) FetchMarkPrice(symbol interface{}) *MarketDataService_FetchMarkPrice_Call {
+	return &MarketDataService_FetchMarkPrice_Call{Call: _e.mock.On("FetchMarkPrice", symbol)}
+}
+
+func (_c *MarketDataService_FetchMarkPrice_Call) Run(run func(symbol string)) *MarketDataService_FetchMarkPrice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MarketDataService_FetchMarkPrice_Call) Return(_a0 *types.MarkPrice, _a1 error) *MarketDataService_FetchMarkPrice_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MarketDataService_FetchMarkPrice_Call) RunAndReturn(run func(string) (*types.MarkPrice, error)) *MarketDataService_FetchMarkPrice_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FetchOrderBook provides a mock function with given fields: symbol, depth
-func (_m *MarketDataService) FetchOrderBook(symbol string, depth int) (*connector.OrderBook, error) {
-	ret := _m.Called(symbol, depth)
+// FetchOrderBook provides a mock function with given fields: instrument, symbol, depth
+func (_m *MarketDataService) FetchOrderBook(instrument connector.Instrument, symbol string, depth int) (*connector.OrderBook, error) {
+	ret := _m.Called(instrument, symbol, depth)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FetchOrderBook")
@@ -383,19 +565,19 @@ func (_m *MarketDataService) FetchOrderBook(symbol string, depth int) (*connecto
 
 	var r0 *connector.OrderBook
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, int) (*connector.OrderBook, error)); ok {
-		return rf(symbol, depth)
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string, int) (*connector.OrderBook, error)); ok {
+		return rf(instrument, symbol, depth)
 	}
-	if rf, ok := ret.Get(0).(func(string, int) *connector.OrderBook); ok {
-		r0 = rf(symbol, depth)
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string, int) *connector.OrderBook); ok {
+		r0 = rf(instrument, symbol, depth)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*connector.OrderBook)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, int) error); ok {
-		r1 = rf(symbol, depth)
+	if rf, ok := ret.Get(1).(func(connector.Instrument, string, int) error); ok {
+		r1 = rf(instrument, symbol, depth)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -409,15 +591,16 @@ type MarketDataService_FetchOrderBook_Call struct {
 }
 
 // FetchOrderBook is a helper method to define mock.On call
+//   - instrument connector.Instrument
 //   - symbol string
 //   - depth int
-func (_e *MarketDataService_Expecter) FetchOrderBook(symbol interface{}, depth interface{}) *MarketDataService_FetchOrderBook_Call {
-	return &MarketDataService_FetchOrderBook_Call{Call: _e.mock.On("FetchOrderBook", symbol, depth)}
+func (_e *MarketDataService_Expecter) FetchOrderBook(instrument interface{}, symbol interface{}, depth interface{}) *MarketDataService_FetchOrderBook_Call {
+	return &MarketDataService_FetchOrderBook_Call{Call: _e.mock.On("FetchOrderBook", instrument, symbol, depth)}
 }
 
-func (_c *MarketDataService_FetchOrderBook_Call) Run(run func(symbol string, depth int)) *MarketDataService_FetchOrderBook_Call {
+func (_c *MarketDataService_FetchOrderBook_Call) Run(run func(instrument connector.Instrument, symbol string, depth int)) *MarketDataService_FetchOrderBook_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(int))
+		run(args[0].(connector.Instrument), args[1].(string), args[2].(int))
 	})
 	return _c
 }
@@ -427,14 +610,14 @@ func (_c *MarketDataService_FetchOrderBook_Call) Return(_a0 *connector.OrderBook
 	return _c
 }
 
-func (_c *MarketDataService_FetchOrderBook_Call) RunAndReturn(run func(string, int) (*connector.OrderBook, error)) *MarketDataService_FetchOrderBook_Call {
+func (_c *MarketDataService_FetchOrderBook_Call) RunAndReturn(run func(connector.Instrument, string, int) (*connector.OrderBook, error)) *MarketDataService_FetchOrderBook_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FetchPrice provides a mock function with given fields: symbol
-func (_m *MarketDataService) FetchPrice(symbol string) (*connector.Price, error) {
-	ret := _m.Called(symbol)
+// FetchPrice provides a mock function with given fields: instrument, symbol
+func (_m *MarketDataService) FetchPrice(instrument connector.Instrument, symbol string) (*connector.Price, error) {
+	ret := _m.Called(instrument, symbol)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FetchPrice")
@@ -442,19 +625,19 @@ func (_m *MarketDataService) FetchPrice(symbol string) (*connector.Price, error)
 
 	var r0 *connector.Price
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (*connector.Price, error)); ok {
-		return rf(symbol)
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string) (*connector.Price, error)); ok {
+		return rf(instrument, symbol)
 	}
-	if rf, ok := ret.Get(0).(func(string) *connector.Price); ok {
-		r0 = rf(symbol)
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string) *connector.Price); ok {
+		r0 = rf(instrument, symbol)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*connector.Price)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(symbol)
+	if rf, ok := ret.Get(1).(func(connector.Instrument, string) error); ok {
+		r1 = rf(instrument, symbol)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -468,14 +651,15 @@ type MarketDataService_FetchPrice_Call struct {
 }
 
 // FetchPrice is a helper method to define mock.On call
+//   - instrument connector.Instrument
 //   - symbol string
-func (_e *MarketDataService_Expecter) FetchPrice(symbol interface{}) *MarketDataService_FetchPrice_Call {
-	return &MarketDataService_FetchPrice_Call{Call: _e.mock.On("FetchPrice", symbol)}
+func (_e *MarketDataService_Expecter) FetchPrice(instrument interface{}, symbol interface{}) *MarketDataService_FetchPrice_Call {
+	return &MarketDataService_FetchPrice_Call{Call: _e.mock.On("FetchPrice", instrument, symbol)}
 }
 
-func (_c *MarketDataService_FetchPrice_Call) Run(run func(symbol string)) *MarketDataService_FetchPrice_Call {
+func (_c *MarketDataService_FetchPrice_Call) Run(run func(instrument connector.Instrument, symbol string)) *MarketDataService_FetchPrice_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(connector.Instrument), args[1].(string))
 	})
 	return _c
 }
@@ -485,14 +669,14 @@ func (_c *MarketDataService_FetchPrice_Call) Return(_a0 *connector.Price, _a1 er
 	return _c
 }
 
-func (_c *MarketDataService_FetchPrice_Call) RunAndReturn(run func(string) (*connector.Price, error)) *MarketDataService_FetchPrice_Call {
+func (_c *MarketDataService_FetchPrice_Call) RunAndReturn(run func(connector.Instrument, string) (*connector.Price, error)) *MarketDataService_FetchPrice_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FetchRecentTrades provides a mock function with given fields: symbol, limit
-func (_m *MarketDataService) FetchRecentTrades(symbol string, limit int) ([]connector.Trade, error) {
-	ret := _m.Called(symbol, limit)
+// FetchRecentTrades provides a mock function with given fields: instrument, symbol, limit
+func (_m *MarketDataService) FetchRecentTrades(instrument connector.Instrument, symbol string, limit int) ([]connector.Trade, error) {
+	ret := _m.Called(instrument, symbol, limit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FetchRecentTrades")
@@ -500,19 +684,19 @@ func (_m *MarketDataService) FetchRecentTrades(symbol string, limit int) ([]conn
 
 	var r0 []connector.Trade
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, int) ([]connector.Trade, error)); ok {
-		return rf(symbol, limit)
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string, int) ([]connector.Trade, error)); ok {
+		return rf(instrument, symbol, limit)
 	}
-	if rf, ok := ret.Get(0).(func(string, int) []connector.Trade); ok {
-		r0 = rf(symbol, limit)
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string, int) []connector.Trade); ok {
+		r0 = rf(instrument, symbol, limit)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]connector.Trade)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, int) error); ok {
-		r1 = rf(symbol, limit)
+	if rf, ok := ret.Get(1).(func(connector.Instrument, string, int) error); ok {
+		r1 = rf(instrument, symbol, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -526,15 +710,16 @@ type MarketDataService_FetchRecentTrades_Call struct {
 }
 
 // FetchRecentTrades is a helper method to define mock.On call
+//   - instrument connector.Instrument
 //   - symbol string
 //   - limit int
-func (_e *MarketDataService_Expecter) FetchRecentTrades(symbol interface{}, limit interface{}) *MarketDataService_FetchRecentTrades_Call {
-	return &MarketDataService_FetchRecentTrades_Call{Call: _e.mock.On("FetchRecentTrades", symbol, limit)}
+func (_e *MarketDataService_Expecter) FetchRecentTrades(instrument interface{}, symbol interface{}, limit interface{}) *MarketDataService_FetchRecentTrades_Call {
+	return &MarketDataService_FetchRecentTrades_Call{Call: _e.mock.On("FetchRecentTrades", instrument, symbol, limit)}
 }
 
-func (_c *MarketDataService_FetchRecentTrades_Call) Run(run func(symbol string, limit int)) *MarketDataService_FetchRecentTrades_Call {
+func (_c *MarketDataService_FetchRecentTrades_Call) Run(run func(instrument connector.Instrument, symbol string, limit int)) *MarketDataService_FetchRecentTrades_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(int))
+		run(args[0].(connector.Instrument), args[1].(string), args[2].(int))
 	})
 	return _c
 }
@@ -544,7 +729,62 @@ func (_c *MarketDataService_FetchRecentTrades_Call) Return(_a0 []connector.Trade
 	return _c
 }
 
-func (_c *MarketDataService_FetchRecentTrades_Call) RunAndReturn(run func(string, int) ([]connector.Trade, error)) *MarketDataService_FetchRecentTrades_Call {
+func (_c *MarketDataService_FetchRecentTrades_Call) RunAndReturn(run func(connector.Instrument, string, int) ([]connector.Trade, error)) *MarketDataService_FetchRecentTrades_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FetchServerTime provides a mock function with no fields
+func (_m *MarketDataService) FetchServerTime() (time.Time, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchServerTime")
+	}
+
+	var r0 time.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (time.Time, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() time.Time); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarketDataService_FetchServerTime_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchServerTime'
+type MarketDataService_FetchServerTime_Call struct {
+	*mock.Call
+}
+
+// FetchServerTime is a helper method to define mock.On call
+func (_e *MarketDataService_Expecter) FetchServerTime() *MarketDataService_FetchServerTime_Call {
+	return &MarketDataService_FetchServerTime_Call{Call: _e.mock.On("FetchServerTime")}
+}
+
+func (_c *MarketDataService_FetchServerTime_Call) Run(run func()) *MarketDataService_FetchServerTime_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MarketDataService_FetchServerTime_Call) Return(_a0 time.Time, _a1 error) *MarketDataService_FetchServerTime_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MarketDataService_FetchServerTime_Call) RunAndReturn(run func() (time.Time, error)) *MarketDataService_FetchServerTime_Call {
 	_c.Call.Return(run)
 	return _c
 }
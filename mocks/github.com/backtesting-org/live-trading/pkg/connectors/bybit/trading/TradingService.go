@@ -3,12 +3,19 @@
 package trading
 
 import (
+	jsontext "encoding/json/jsontext"
+
 	connector "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+
 	mock "github.com/stretchr/testify/mock"
 
 	numerical "github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
 
+	time "time"
+
 	trading "github.com/backtesting-org/live-trading/pkg/connectors/bybit/trading"
+
+	types "github.com/backtesting-org/live-trading/pkg/connectors/types"
 )
 
 // TradingService is an autogenerated mock type for the TradingService type
@@ -24,9 +31,115 @@ func (_m *TradingService) EXPECT() *TradingService_Expecter {
 	return &TradingService_Expecter{mock: &_m.Mock}
 }
 
-// CancelOrder provides a mock function with given fields: symbol, orderID
-func (_m *TradingService) CancelOrder(symbol string, orderID string) (*connector.CancelResponse, error) {
-	ret := _m.Called(symbol, orderID)
+// CancelAllOrders provides a mock function with given fields: instrument
+func (_m *TradingService) CancelAllOrders(instrument connector.Instrument) error {
+	ret := _m.Called(instrument)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelAllOrders")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(connector.Instrument) error); ok {
+		r0 = rf(instrument)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TradingService_CancelAllOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelAllOrders'
+type TradingService_CancelAllOrders_Call struct {
+	*mock.Call
+}
+
+// CancelAllOrders is a helper method to define mock.On call
+//   - instrument connector.Instrument
+func (_e *TradingService_Expecter) CancelAllOrders(instrument interface{}) *TradingService_CancelAllOrders_Call {
+	return &TradingService_CancelAllOrders_Call{Call: _e.mock.On("CancelAllOrders", instrument)}
+}
+
+func (_c *TradingService_CancelAllOrders_Call) Run(run func(instrument connector.Instrument)) *TradingService_CancelAllOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.Instrument))
+	})
+	return _c
+}
+
+func (_c *TradingService_CancelAllOrders_Call) Return(_a0 error) *TradingService_CancelAllOrders_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TradingService_CancelAllOrders_Call) RunAndReturn(run func(connector.Instrument) error) *TradingService_CancelAllOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CancelBatchOrders provides a mock function with given fields: instrument, symbol, orderIDs
+func (_m *TradingService) CancelBatchOrders(instrument connector.Instrument, symbol string, orderIDs []string) ([]types.CancelResult, error) {
+	ret := _m.Called(instrument, symbol, orderIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelBatchOrders")
+	}
+
+	var r0 []types.CancelResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string, []string) ([]types.CancelResult, error)); ok {
+		return rf(instrument, symbol, orderIDs)
+	}
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string, []string) []types.CancelResult); ok {
+		r0 = rf(instrument, symbol, orderIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.CancelResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.Instrument, string, []string) error); ok {
+		r1 = rf(instrument, symbol, orderIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TradingService_CancelBatchOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelBatchOrders'
+type TradingService_CancelBatchOrders_Call struct {
+	*mock.Call
+}
+
+// CancelBatchOrders is a helper method to define mock.On call
+//   - instrument connector.Instrument
+//   - symbol string
+//   - orderIDs []string
+func (_e *TradingService_Expecter) CancelBatchOrders(instrument interface{}, symbol interface{}, orderIDs interface{}) *TradingService_CancelBatchOrders_Call {
+	return &TradingService_CancelBatchOrders_Call{Call: _e.mock.On("CancelBatchOrders", instrument, symbol, orderIDs)}
+}
+
+func (_c *TradingService_CancelBatchOrders_Call) Run(run func(instrument connector.Instrument, symbol string, orderIDs []string)) *TradingService_CancelBatchOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.Instrument), args[1].(string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *TradingService_CancelBatchOrders_Call) Return(_a0 []types.CancelResult, _a1 error) *TradingService_CancelBatchOrders_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TradingService_CancelBatchOrders_Call) RunAndReturn(run func(connector.Instrument, string, []string) ([]types.CancelResult, error)) *TradingService_CancelBatchOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CancelOrder provides a mock function with given fields: instrument, symbol, orderID
+func (_m *TradingService) CancelOrder(instrument connector.Instrument, symbol string, orderID string) (*connector.CancelResponse, error) {
+	ret := _m.Called(instrument, symbol, orderID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CancelOrder")
@@ -34,19 +147,19 @@ func (_m *TradingService) CancelOrder(symbol string, orderID string) (*connector
 
 	var r0 *connector.CancelResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, string) (*connector.CancelResponse, error)); ok {
-		return rf(symbol, orderID)
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string, string) (*connector.CancelResponse, error)); ok {
+		return rf(instrument, symbol, orderID)
 	}
-	if rf, ok := ret.Get(0).(func(string, string) *connector.CancelResponse); ok {
-		r0 = rf(symbol, orderID)
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string, string) *connector.CancelResponse); ok {
+		r0 = rf(instrument, symbol, orderID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*connector.CancelResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(symbol, orderID)
+	if rf, ok := ret.Get(1).(func(connector.Instrument, string, string) error); ok {
+		r1 = rf(instrument, symbol, orderID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -60,15 +173,16 @@ type TradingService_CancelOrder_Call struct {
 }
 
 // CancelOrder is a helper method to define mock.On call
+//   - instrument connector.Instrument
 //   - symbol string
 //   - orderID string
-func (_e *TradingService_Expecter) CancelOrder(symbol interface{}, orderID interface{}) *TradingService_CancelOrder_Call {
-	return &TradingService_CancelOrder_Call{Call: _e.mock.On("CancelOrder", symbol, orderID)}
+func (_e *TradingService_Expecter) CancelOrder(instrument interface{}, symbol interface{}, orderID interface{}) *TradingService_CancelOrder_Call {
+	return &TradingService_CancelOrder_Call{Call: _e.mock.On("CancelOrder", instrument, symbol, orderID)}
 }
 
-func (_c *TradingService_CancelOrder_Call) Run(run func(symbol string, orderID string)) *TradingService_CancelOrder_Call {
+func (_c *TradingService_CancelOrder_Call) Run(run func(instrument connector.Instrument, symbol string, orderID string)) *TradingService_CancelOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string))
+		run(args[0].(connector.Instrument), args[1].(string), args[2].(string))
 	})
 	return _c
 }
@@ -78,7 +192,64 @@ func (_c *TradingService_CancelOrder_Call) Return(_a0 *connector.CancelResponse,
 	return _c
 }
 
-func (_c *TradingService_CancelOrder_Call) RunAndReturn(run func(string, string) (*connector.CancelResponse, error)) *TradingService_CancelOrder_Call {
+func (_c *TradingService_CancelOrder_Call) RunAndReturn(run func(connector.Instrument, string, string) (*connector.CancelResponse, error)) *TradingService_CancelOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAPIKeyPermissions provides a mock function with no fields
+func (_m *TradingService) GetAPIKeyPermissions() (*types.APIKeyPermissions, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAPIKeyPermissions")
+	}
+
+	var r0 *types.APIKeyPermissions
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*types.APIKeyPermissions, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *types.APIKeyPermissions); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.APIKeyPermissions)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TradingService_GetAPIKeyPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAPIKeyPermissions'
+type TradingService_GetAPIKeyPermissions_Call struct {
+	*mock.Call
+}
+
+// GetAPIKeyPermissions is a helper method to define mock.On call
+func (_e *TradingService_Expecter) GetAPIKeyPermissions() *TradingService_GetAPIKeyPermissions_Call {
+	return &TradingService_GetAPIKeyPermissions_Call{Call: _e.mock.On("GetAPIKeyPermissions")}
+}
+
+func (_c *TradingService_GetAPIKeyPermissions_Call) Run(run func()) *TradingService_GetAPIKeyPermissions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TradingService_GetAPIKeyPermissions_Call) Return(_a0 *types.APIKeyPermissions, _a1 error) *TradingService_GetAPIKeyPermissions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TradingService_GetAPIKeyPermissions_Call) RunAndReturn(run func() (*types.APIKeyPermissions, error)) *TradingService_GetAPIKeyPermissions_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -140,24 +311,24 @@ func (_c *TradingService_GetAccountBalance_Call) RunAndReturn(run func() (*conne
 	return _c
 }
 
-// GetOpenOrders provides a mock function with no fields
-func (_m *TradingService) GetOpenOrders() ([]connector.Order, error) {
+// GetMarginInfo provides a mock function with no fields
+func (_m *TradingService) GetMarginInfo() (*types.MarginInfo, error) {
 	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetOpenOrders")
+		panic("no return value specified for GetMarginInfo")
 	}
 
-	var r0 []connector.Order
+	var r0 *types.MarginInfo
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]connector.Order, error)); ok {
+	if rf, ok := ret.Get(0).(func() (*types.MarginInfo, error)); ok {
 		return rf()
 	}
-	if rf, ok := ret.Get(0).(func() []connector.Order); ok {
+	if rf, ok := ret.Get(0).(func() *types.MarginInfo); ok {
 		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]connector.Order)
+			r0 = ret.Get(0).(*types.MarginInfo)
 		}
 	}
 
@@ -170,19 +341,77 @@ func (_m *TradingService) GetOpenOrders() ([]connector.Order, error) {
 	return r0, r1
 }
 
+// TradingService_GetMarginInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMarginInfo'
+type TradingService_GetMarginInfo_Call struct {
+	*mock.Call
+}
+
+// GetMarginInfo is a helper method to define mock.On call
+func (_e *TradingService_Expecter) GetMarginInfo() *TradingService_GetMarginInfo_Call {
+	return &TradingService_GetMarginInfo_Call{Call: _e.mock.On("GetMarginInfo")}
+}
+
+func (_c *TradingService_GetMarginInfo_Call) Run(run func()) *TradingService_GetMarginInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TradingService_GetMarginInfo_Call) Return(_a0 *types.MarginInfo, _a1 error) *TradingService_GetMarginInfo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TradingService_GetMarginInfo_Call) RunAndReturn(run func() (*types.MarginInfo, error)) *TradingService_GetMarginInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOpenOrders provides a mock function with given fields: instrument
+func (_m *TradingService) GetOpenOrders(instrument connector.Instrument) ([]connector.Order, error) {
+	ret := _m.Called(instrument)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOpenOrders")
+	}
+
+	var r0 []connector.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(connector.Instrument) ([]connector.Order, error)); ok {
+		return rf(instrument)
+	}
+	if rf, ok := ret.Get(0).(func(connector.Instrument) []connector.Order); ok {
+		r0 = rf(instrument)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]connector.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.Instrument) error); ok {
+		r1 = rf(instrument)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // TradingService_GetOpenOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOpenOrders'
 type TradingService_GetOpenOrders_Call struct {
 	*mock.Call
 }
 
 // GetOpenOrders is a helper method to define mock.On call
-func (_e *TradingService_Expecter) GetOpenOrders() *TradingService_GetOpenOrders_Call {
-	return &TradingService_GetOpenOrders_Call{Call: _e.mock.On("GetOpenOrders")}
+//   - instrument connector.Instrument
+func (_e *TradingService_Expecter) GetOpenOrders(instrument interface{}) *TradingService_GetOpenOrders_Call {
+	return &TradingService_GetOpenOrders_Call{Call: _e.mock.On("GetOpenOrders", instrument)}
 }
 
-func (_c *TradingService_GetOpenOrders_Call) Run(run func()) *TradingService_GetOpenOrders_Call {
+func (_c *TradingService_GetOpenOrders_Call) Run(run func(instrument connector.Instrument)) *TradingService_GetOpenOrders_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(connector.Instrument))
 	})
 	return _c
 }
@@ -192,14 +421,14 @@ func (_c *TradingService_GetOpenOrders_Call) Return(_a0 []connector.Order, _a1 e
 	return _c
 }
 
-func (_c *TradingService_GetOpenOrders_Call) RunAndReturn(run func() ([]connector.Order, error)) *TradingService_GetOpenOrders_Call {
+func (_c *TradingService_GetOpenOrders_Call) RunAndReturn(run func(connector.Instrument) ([]connector.Order, error)) *TradingService_GetOpenOrders_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetOrderStatus provides a mock function with given fields: orderID
-func (_m *TradingService) GetOrderStatus(orderID string) (*connector.Order, error) {
-	ret := _m.Called(orderID)
+// GetOrderStatus provides a mock function with given fields: instrument, orderID
+func (_m *TradingService) GetOrderStatus(instrument connector.Instrument, orderID string) (*connector.Order, error) {
+	ret := _m.Called(instrument, orderID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetOrderStatus")
@@ -207,19 +436,19 @@ func (_m *TradingService) GetOrderStatus(orderID string) (*connector.Order, erro
 
 	var r0 *connector.Order
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (*connector.Order, error)); ok {
-		return rf(orderID)
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string) (*connector.Order, error)); ok {
+		return rf(instrument, orderID)
 	}
-	if rf, ok := ret.Get(0).(func(string) *connector.Order); ok {
-		r0 = rf(orderID)
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string) *connector.Order); ok {
+		r0 = rf(instrument, orderID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*connector.Order)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(orderID)
+	if rf, ok := ret.Get(1).(func(connector.Instrument, string) error); ok {
+		r1 = rf(instrument, orderID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -233,14 +462,15 @@ type TradingService_GetOrderStatus_Call struct {
 }
 
 // GetOrderStatus is a helper method to define mock.On call
+//   - instrument connector.Instrument
 //   - orderID string
-func (_e *TradingService_Expecter) GetOrderStatus(orderID interface{}) *TradingService_GetOrderStatus_Call {
-	return &TradingService_GetOrderStatus_Call{Call: _e.mock.On("GetOrderStatus", orderID)}
+func (_e *TradingService_Expecter) GetOrderStatus(instrument interface{}, orderID interface{}) *TradingService_GetOrderStatus_Call {
+	return &TradingService_GetOrderStatus_Call{Call: _e.mock.On("GetOrderStatus", instrument, orderID)}
 }
 
-func (_c *TradingService_GetOrderStatus_Call) Run(run func(orderID string)) *TradingService_GetOrderStatus_Call {
+func (_c *TradingService_GetOrderStatus_Call) Run(run func(instrument connector.Instrument, orderID string)) *TradingService_GetOrderStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(connector.Instrument), args[1].(string))
 	})
 	return _c
 }
@@ -250,7 +480,7 @@ func (_c *TradingService_GetOrderStatus_Call) Return(_a0 *connector.Order, _a1 e
 	return _c
 }
 
-func (_c *TradingService_GetOrderStatus_Call) RunAndReturn(run func(string) (*connector.Order, error)) *TradingService_GetOrderStatus_Call {
+func (_c *TradingService_GetOrderStatus_Call) RunAndReturn(run func(connector.Instrument, string) (*connector.Order, error)) *TradingService_GetOrderStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -312,9 +542,66 @@ func (_c *TradingService_GetPositions_Call) RunAndReturn(run func() ([]connector
 	return _c
 }
 
-// GetTradingHistory provides a mock function with given fields: symbol, limit
-func (_m *TradingService) GetTradingHistory(symbol string, limit int) ([]connector.Trade, error) {
-	ret := _m.Called(symbol, limit)
+// GetSpotBalances provides a mock function with no fields
+func (_m *TradingService) GetSpotBalances() ([]types.SpotBalance, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSpotBalances")
+	}
+
+	var r0 []types.SpotBalance
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]types.SpotBalance, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []types.SpotBalance); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.SpotBalance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TradingService_GetSpotBalances_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSpotBalances'
+type TradingService_GetSpotBalances_Call struct {
+	*mock.Call
+}
+
+// GetSpotBalances is a helper method to define mock.On call
+func (_e *TradingService_Expecter) GetSpotBalances() *TradingService_GetSpotBalances_Call {
+	return &TradingService_GetSpotBalances_Call{Call: _e.mock.On("GetSpotBalances")}
+}
+
+func (_c *TradingService_GetSpotBalances_Call) Run(run func()) *TradingService_GetSpotBalances_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TradingService_GetSpotBalances_Call) Return(_a0 []types.SpotBalance, _a1 error) *TradingService_GetSpotBalances_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TradingService_GetSpotBalances_Call) RunAndReturn(run func() ([]types.SpotBalance, error)) *TradingService_GetSpotBalances_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTradingHistory provides a mock function with given fields: instrument, symbol, limit
+func (_m *TradingService) GetTradingHistory(instrument connector.Instrument, symbol string, limit int) ([]connector.Trade, error) {
+	ret := _m.Called(instrument, symbol, limit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetTradingHistory")
@@ -322,19 +609,19 @@ func (_m *TradingService) GetTradingHistory(symbol string, limit int) ([]connect
 
 	var r0 []connector.Trade
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, int) ([]connector.Trade, error)); ok {
-		return rf(symbol, limit)
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string, int) ([]connector.Trade, error)); ok {
+		return rf(instrument, symbol, limit)
 	}
-	if rf, ok := ret.Get(0).(func(string, int) []connector.Trade); ok {
-		r0 = rf(symbol, limit)
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string, int) []connector.Trade); ok {
+		r0 = rf(instrument, symbol, limit)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]connector.Trade)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, int) error); ok {
-		r1 = rf(symbol, limit)
+	if rf, ok := ret.Get(1).(func(connector.Instrument, string, int) error); ok {
+		r1 = rf(instrument, symbol, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -348,15 +635,16 @@ type TradingService_GetTradingHistory_Call struct {
 }
 
 // GetTradingHistory is a helper method to define mock.On call
+//   - instrument connector.Instrument
 //   - symbol string
 //   - limit int
-func (_e *TradingService_Expecter) GetTradingHistory(symbol interface{}, limit interface{}) *TradingService_GetTradingHistory_Call {
-	return &TradingService_GetTradingHistory_Call{Call: _e.mock.On("GetTradingHistory", symbol, limit)}
+func (_e *TradingService_Expecter) GetTradingHistory(instrument interface{}, symbol interface{}, limit interface{}) *TradingService_GetTradingHistory_Call {
+	return &TradingService_GetTradingHistory_Call{Call: _e.mock.On("GetTradingHistory", instrument, symbol, limit)}
 }
 
-func (_c *TradingService_GetTradingHistory_Call) Run(run func(symbol string, limit int)) *TradingService_GetTradingHistory_Call {
+func (_c *TradingService_GetTradingHistory_Call) Run(run func(instrument connector.Instrument, symbol string, limit int)) *TradingService_GetTradingHistory_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(int))
+		run(args[0].(connector.Instrument), args[1].(string), args[2].(int))
 	})
 	return _c
 }
@@ -366,7 +654,185 @@ func (_c *TradingService_GetTradingHistory_Call) Return(_a0 []connector.Trade, _
 	return _c
 }
 
-func (_c *TradingService_GetTradingHistory_Call) RunAndReturn(run func(string, int) ([]connector.Trade, error)) *TradingService_GetTradingHistory_Call {
+func (_c *TradingService_GetTradingHistory_Call) RunAndReturn(run func(connector.Instrument, string, int) ([]connector.Trade, error)) *TradingService_GetTradingHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTradingHistoryRange provides a mock function with given fields: instrument, symbol, start, end, limit
+func (_m *TradingService) GetTradingHistoryRange(instrument connector.Instrument, symbol string, start time.Time, end time.Time, limit int) ([]connector.Trade, error) {
+	ret := _m.Called(instrument, symbol, start, end, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTradingHistoryRange")
+	}
+
+	var r0 []connector.Trade
+	var r1 error
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string, time.Time, time.Time, int) ([]connector.Trade, error)); ok {
+		return rf(instrument, symbol, start, end, limit)
+	}
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string, time.Time, time.Time, int) []connector.Trade); ok {
+		r0 = rf(instrument, symbol, start, end, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]connector.Trade)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.Instrument, string, time.Time, time.Time, int) error); ok {
+		r1 = rf(instrument, symbol, start, end, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TradingService_GetTradingHistoryRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTradingHistoryRange'
+type TradingService_GetTradingHistoryRange_Call struct {
+	*mock.Call
+}
+
+// GetTradingHistoryRange is a helper method to define mock.On call
+//   - instrument connector.Instrument
+//   - symbol string
+//   - start time.Time
+//   - end time.Time
+//   - limit int
+func (_e *TradingService_Expecter) GetTradingHistoryRange(instrument interface{}, symbol interface{}, start interface{}, end interface{}, limit interface{}) *TradingService_GetTradingHistoryRange_Call {
+	return &TradingService_GetTradingHistoryRange_Call{Call: _e.mock.On("GetTradingHistoryRange", instrument, symbol, start, end, limit)}
+}
+
+func (_c *TradingService_GetTradingHistoryRange_Call) Run(run func(instrument connector.Instrument, symbol string, start time.Time, end time.Time, limit int)) *TradingService_GetTradingHistoryRange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.Instrument), args[1].(string), args[2].(time.Time), args[3].(time.Time), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *TradingService_GetTradingHistoryRange_Call) Return(_a0 []connector.Trade, _a1 error) *TradingService_GetTradingHistoryRange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TradingService_GetTradingHistoryRange_Call) RunAndReturn(run func(connector.Instrument, string, time.Time, time.Time, int) ([]connector.Trade, error)) *TradingService_GetTradingHistoryRange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUniversalTransfers provides a mock function with given fields: since
+func (_m *TradingService) GetUniversalTransfers(since time.Time) ([]types.TransferEvent, error) {
+	ret := _m.Called(since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUniversalTransfers")
+	}
+
+	var r0 []types.TransferEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time) ([]types.TransferEvent, error)); ok {
+		return rf(since)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time) []types.TransferEvent); ok {
+		r0 = rf(since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.TransferEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = rf(since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TradingService_GetUniversalTransfers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUniversalTransfers'
+type TradingService_GetUniversalTransfers_Call struct {
+	*mock.Call
+}
+
+// GetUniversalTransfers is a helper method to define mock.On call
+//   - since time.Time
+func (_e *TradingService_Expecter) GetUniversalTransfers(since interface{}) *TradingService_GetUniversalTransfers_Call {
+	return &TradingService_GetUniversalTransfers_Call{Call: _e.mock.On("GetUniversalTransfers", since)}
+}
+
+func (_c *TradingService_GetUniversalTransfers_Call) Run(run func(since time.Time)) *TradingService_GetUniversalTransfers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Time))
+	})
+	return _c
+}
+
+func (_c *TradingService_GetUniversalTransfers_Call) Return(_a0 []types.TransferEvent, _a1 error) *TradingService_GetUniversalTransfers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TradingService_GetUniversalTransfers_Call) RunAndReturn(run func(time.Time) ([]types.TransferEvent, error)) *TradingService_GetUniversalTransfers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWithdrawals provides a mock function with given fields: since
+func (_m *TradingService) GetWithdrawals(since time.Time) ([]types.TransferEvent, error) {
+	ret := _m.Called(since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWithdrawals")
+	}
+
+	var r0 []types.TransferEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time) ([]types.TransferEvent, error)); ok {
+		return rf(since)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time) []types.TransferEvent); ok {
+		r0 = rf(since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.TransferEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = rf(since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TradingService_GetWithdrawals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWithdrawals'
+type TradingService_GetWithdrawals_Call struct {
+	*mock.Call
+}
+
+// GetWithdrawals is a helper method to define mock.On call
+//   - since time.Time
+func (_e *TradingService_Expecter) GetWithdrawals(since interface{}) *TradingService_GetWithdrawals_Call {
+	return &TradingService_GetWithdrawals_Call{Call: _e.mock.On("GetWithdrawals", since)}
+}
+
+func (_c *TradingService_GetWithdrawals_Call) Run(run func(since time.Time)) *TradingService_GetWithdrawals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Time))
+	})
+	return _c
+}
+
+func (_c *TradingService_GetWithdrawals_Call) Return(_a0 []types.TransferEvent, _a1 error) *TradingService_GetWithdrawals_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TradingService_GetWithdrawals_Call) RunAndReturn(run func(time.Time) ([]types.TransferEvent, error)) *TradingService_GetWithdrawals_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -417,9 +883,126 @@ func (_c *TradingService_Initialize_Call) RunAndReturn(run func(*trading.Config)
 	return _c
 }
 
-// PlaceLimitOrder provides a mock function with given fields: symbol, side, quantity, price
-func (_m *TradingService) PlaceLimitOrder(symbol string, side connector.OrderSide, quantity numerical.Decimal, price numerical.Decimal) (*connector.OrderResponse, error) {
-	ret := _m.Called(symbol, side, quantity, price)
+// OrderPayload provides a mock function with given fields: orderID
+func (_m *TradingService) OrderPayload(orderID string) (jsontext.Value, bool) {
+	ret := _m.Called(orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OrderPayload")
+	}
+
+	var r0 jsontext.Value
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string) (jsontext.Value, bool)); ok {
+		return rf(orderID)
+	}
+	if rf, ok := ret.Get(0).(func(string) jsontext.Value); ok {
+		r0 = rf(orderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(jsontext.Value)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(orderID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// TradingService_OrderPayload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrderPayload'
+type TradingService_OrderPayload_Call struct {
+	*mock.Call
+}
+
+// OrderPayload is a helper method to define mock.On call
+//   - orderID string
+func (_e *TradingService_Expecter) OrderPayload(orderID interface{}) *TradingService_OrderPayload_Call {
+	return &TradingService_OrderPayload_Call{Call: _e.mock.On("OrderPayload", orderID)}
+}
+
+func (_c *TradingService_OrderPayload_Call) Run(run func(orderID string)) *TradingService_OrderPayload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *TradingService_OrderPayload_Call) Return(_a0 jsontext.Value, _a1 bool) *TradingService_OrderPayload_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TradingService_OrderPayload_Call) RunAndReturn(run func(string) (jsontext.Value, bool)) *TradingService_OrderPayload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PlaceBatchLimitOrders provides a mock function with given fields: instrument, requests
+func (_m *TradingService) PlaceBatchLimitOrders(instrument connector.Instrument, requests []types.OrderRequest) ([]types.OrderResult, error) {
+	ret := _m.Called(instrument, requests)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlaceBatchLimitOrders")
+	}
+
+	var r0 []types.OrderResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(connector.Instrument, []types.OrderRequest) ([]types.OrderResult, error)); ok {
+		return rf(instrument, requests)
+	}
+	if rf, ok := ret.Get(0).(func(connector.Instrument, []types.OrderRequest) []types.OrderResult); ok {
+		r0 = rf(instrument, requests)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.OrderResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.Instrument, []types.OrderRequest) error); ok {
+		r1 = rf(instrument, requests)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TradingService_PlaceBatchLimitOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PlaceBatchLimitOrders'
+type TradingService_PlaceBatchLimitOrders_Call struct {
+	*mock.Call
+}
+
+// PlaceBatchLimitOrders is a helper method to define mock.On call
+//   - instrument connector.Instrument
+//   - requests []types.OrderRequest
+func (_e *TradingService_Expecter) PlaceBatchLimitOrders(instrument interface{}, requests interface{}) *TradingService_PlaceBatchLimitOrders_Call {
+	return &TradingService_PlaceBatchLimitOrders_Call{Call: _e.mock.On("PlaceBatchLimitOrders", instrument, requests)}
+}
+
+func (_c *TradingService_PlaceBatchLimitOrders_Call) Run(run func(instrument connector.Instrument, requests []types.OrderRequest)) *TradingService_PlaceBatchLimitOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.Instrument), args[1].([]types.OrderRequest))
+	})
+	return _c
+}
+
+func (_c *TradingService_PlaceBatchLimitOrders_Call) Return(_a0 []types.OrderResult, _a1 error) *TradingService_PlaceBatchLimitOrders_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TradingService_PlaceBatchLimitOrders_Call) RunAndReturn(run func(connector.Instrument, []types.OrderRequest) ([]types.OrderResult, error)) *TradingService_PlaceBatchLimitOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PlaceLimitOrder provides a mock function with given fields: instrument, symbol, side, quantity, price
+func (_m *TradingService) PlaceLimitOrder(instrument connector.Instrument, symbol string, side connector.OrderSide, quantity numerical.Decimal, price numerical.Decimal) (*connector.OrderResponse, error) {
+	ret := _m.Called(instrument, symbol, side, quantity, price)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PlaceLimitOrder")
@@ -427,19 +1010,19 @@ func (_m *TradingService) PlaceLimitOrder(symbol string, side connector.OrderSid
 
 	var r0 *connector.OrderResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, connector.OrderSide, numerical.Decimal, numerical.Decimal) (*connector.OrderResponse, error)); ok {
-		return rf(symbol, side, quantity, price)
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string, connector.OrderSide, numerical.Decimal, numerical.Decimal) (*connector.OrderResponse, error)); ok {
+		return rf(instrument, symbol, side, quantity, price)
 	}
-	if rf, ok := ret.Get(0).(func(string, connector.OrderSide, numerical.Decimal, numerical.Decimal) *connector.OrderResponse); ok {
-		r0 = rf(symbol, side, quantity, price)
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string, connector.OrderSide, numerical.Decimal, numerical.Decimal) *connector.OrderResponse); ok {
+		r0 = rf(instrument, symbol, side, quantity, price)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*connector.OrderResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, connector.OrderSide, numerical.Decimal, numerical.Decimal) error); ok {
-		r1 = rf(symbol, side, quantity, price)
+	if rf, ok := ret.Get(1).(func(connector.Instrument, string, connector.OrderSide, numerical.Decimal, numerical.Decimal) error); ok {
+		r1 = rf(instrument, symbol, side, quantity, price)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -453,17 +1036,18 @@ type TradingService_PlaceLimitOrder_Call struct {
 }
 
 // PlaceLimitOrder is a helper method to define mock.On call
+//   - instrument connector.Instrument
 //   - symbol string
 //   - side connector.OrderSide
 //   - quantity numerical.Decimal
 //   - price numerical.Decimal
-func (_e *TradingService_Expecter) PlaceLimitOrder(symbol interface{}, side interface{}, quantity interface{}, price interface{}) *TradingService_PlaceLimitOrder_Call {
-	return &TradingService_PlaceLimitOrder_Call{Call: _e.mock.On("PlaceLimitOrder", symbol, side, quantity, price)}
+func (_e *TradingService_Expecter) PlaceLimitOrder(instrument interface{}, symbol interface{}, side interface{}, quantity interface{}, price interface{}) *TradingService_PlaceLimitOrder_Call {
+	return &TradingService_PlaceLimitOrder_Call{Call: _e.mock.On("PlaceLimitOrder", instrument, symbol, side, quantity, price)}
 }
 
-func (_c *TradingService_PlaceLimitOrder_Call) Run(run func(symbol string, side connector.OrderSide, quantity numerical.Decimal, price numerical.Decimal)) *TradingService_PlaceLimitOrder_Call {
+func (_c *TradingService_PlaceLimitOrder_Call) Run(run func(instrument connector.Instrument, symbol string, side connector.OrderSide, quantity numerical.Decimal, price numerical.Decimal)) *TradingService_PlaceLimitOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(connector.OrderSide), args[2].(numerical.Decimal), args[3].(numerical.Decimal))
+		run(args[0].(connector.Instrument), args[1].(string), args[2].(connector.OrderSide), args[3].(numerical.Decimal), args[4].(numerical.Decimal))
 	})
 	return _c
 }
@@ -473,14 +1057,14 @@ func (_c *TradingService_PlaceLimitOrder_Call) Return(_a0 *connector.OrderRespon
 	return _c
 }
 
-func (_c *TradingService_PlaceLimitOrder_Call) RunAndReturn(run func(string, connector.OrderSide, numerical.Decimal, numerical.Decimal) (*connector.OrderResponse, error)) *TradingService_PlaceLimitOrder_Call {
+func (_c *TradingService_PlaceLimitOrder_Call) RunAndReturn(run func(connector.Instrument, string, connector.OrderSide, numerical.Decimal, numerical.Decimal) (*connector.OrderResponse, error)) *TradingService_PlaceLimitOrder_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// PlaceMarketOrder provides a mock function with given fields: symbol, side, quantity
-func (_m *TradingService) PlaceMarketOrder(symbol string, side connector.OrderSide, quantity numerical.Decimal) (*connector.OrderResponse, error) {
-	ret := _m.Called(symbol, side, quantity)
+// PlaceMarketOrder provides a mock function with given fields: instrument, symbol, side, quantity
+func (_m *TradingService) PlaceMarketOrder(instrument connector.Instrument, symbol string, side connector.OrderSide, quantity numerical.Decimal) (*connector.OrderResponse, error) {
+	ret := _m.Called(instrument, symbol, side, quantity)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PlaceMarketOrder")
@@ -488,19 +1072,19 @@ func (_m *TradingService) PlaceMarketOrder(symbol string, side connector.OrderSi
 
 	var r0 *connector.OrderResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, connector.OrderSide, numerical.Decimal) (*connector.OrderResponse, error)); ok {
-		return rf(symbol, side, quantity)
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string, connector.OrderSide, numerical.Decimal) (*connector.OrderResponse, error)); ok {
+		return rf(instrument, symbol, side, quantity)
 	}
-	if rf, ok := ret.Get(0).(func(string, connector.OrderSide, numerical.Decimal) *connector.OrderResponse); ok {
-		r0 = rf(symbol, side, quantity)
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string, connector.OrderSide, numerical.Decimal) *connector.OrderResponse); ok {
+		r0 = rf(instrument, symbol, side, quantity)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*connector.OrderResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, connector.OrderSide, numerical.Decimal) error); ok {
-		r1 = rf(symbol, side, quantity)
+	if rf, ok := ret.Get(1).(func(connector.Instrument, string, connector.OrderSide, numerical.Decimal) error); ok {
+		r1 = rf(instrument, symbol, side, quantity)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -514,16 +1098,17 @@ type TradingService_PlaceMarketOrder_Call struct {
 }
 
 // PlaceMarketOrder is a helper method to define mock.On call
+//   - instrument connector.Instrument
 //   - symbol string
 //   - side connector.OrderSide
 //   - quantity numerical.Decimal
-func (_e *TradingService_Expecter) PlaceMarketOrder(symbol interface{}, side interface{}, quantity interface{}) *TradingService_PlaceMarketOrder_Call {
-	return &TradingService_PlaceMarketOrder_Call{Call: _e.mock.On("PlaceMarketOrder", symbol, side, quantity)}
+func (_e *TradingService_Expecter) PlaceMarketOrder(instrument interface{}, symbol interface{}, side interface{}, quantity interface{}) *TradingService_PlaceMarketOrder_Call {
+	return &TradingService_PlaceMarketOrder_Call{Call: _e.mock.On("PlaceMarketOrder", instrument, symbol, side, quantity)}
 }
 
-func (_c *TradingService_PlaceMarketOrder_Call) Run(run func(symbol string, side connector.OrderSide, quantity numerical.Decimal)) *TradingService_PlaceMarketOrder_Call {
+func (_c *TradingService_PlaceMarketOrder_Call) Run(run func(instrument connector.Instrument, symbol string, side connector.OrderSide, quantity numerical.Decimal)) *TradingService_PlaceMarketOrder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(connector.OrderSide), args[2].(numerical.Decimal))
+		run(args[0].(connector.Instrument), args[1].(string), args[2].(connector.OrderSide), args[3].(numerical.Decimal))
 	})
 	return _c
 }
@@ -533,7 +1118,111 @@ func (_c *TradingService_PlaceMarketOrder_Call) Return(_a0 *connector.OrderRespo
 	return _c
 }
 
-func (_c *TradingService_PlaceMarketOrder_Call) RunAndReturn(run func(string, connector.OrderSide, numerical.Decimal) (*connector.OrderResponse, error)) *TradingService_PlaceMarketOrder_Call {
+func (_c *TradingService_PlaceMarketOrder_Call) RunAndReturn(run func(connector.Instrument, string, connector.OrderSide, numerical.Decimal) (*connector.OrderResponse, error)) *TradingService_PlaceMarketOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PlaceTriggerOrder provides a mock function with given fields: order
+func (_m *TradingService) PlaceTriggerOrder(order types.TriggerOrder) (*connector.OrderResponse, error) {
+	ret := _m.Called(order)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlaceTriggerOrder")
+	}
+
+	var r0 *connector.OrderResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.TriggerOrder) (*connector.OrderResponse, error)); ok {
+		return rf(order)
+	}
+	if rf, ok := ret.Get(0).(func(types.TriggerOrder) *connector.OrderResponse); ok {
+		r0 = rf(order)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connector.OrderResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(types.TriggerOrder) error); ok {
+		r1 = rf(order)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TradingService_PlaceTriggerOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PlaceTriggerOrder'
+type TradingService_PlaceTriggerOrder_Call struct {
+	*mock.Call
+}
+
+// PlaceTriggerOrder is a helper method to define mock.On call
+//   - order types.TriggerOrder
+func (_e *TradingService_Expecter) PlaceTriggerOrder(order interface{}) *TradingService_PlaceTriggerOrder_Call {
+	return &TradingService_PlaceTriggerOrder_Call{Call: _e.mock.On("PlaceTriggerOrder", order)}
+}
+
+func (_c *TradingService_PlaceTriggerOrder_Call) Run(run func(order types.TriggerOrder)) *TradingService_PlaceTriggerOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(types.TriggerOrder))
+	})
+	return _c
+}
+
+func (_c *TradingService_PlaceTriggerOrder_Call) Return(_a0 *connector.OrderResponse, _a1 error) *TradingService_PlaceTriggerOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TradingService_PlaceTriggerOrder_Call) RunAndReturn(run func(types.TriggerOrder) (*connector.OrderResponse, error)) *TradingService_PlaceTriggerOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetDisconnectCancel provides a mock function with given fields: window
+func (_m *TradingService) SetDisconnectCancel(window time.Duration) error {
+	ret := _m.Called(window)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetDisconnectCancel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(time.Duration) error); ok {
+		r0 = rf(window)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TradingService_SetDisconnectCancel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetDisconnectCancel'
+type TradingService_SetDisconnectCancel_Call struct {
+	*mock.Call
+}
+
+// SetDisconnectCancel is a helper method to define mock.On call
+//   - window time.Duration
+func (_e *TradingService_Expecter) SetDisconnectCancel(window interface{}) *TradingService_SetDisconnectCancel_Call {
+	return &TradingService_SetDisconnectCancel_Call{Call: _e.mock.On("SetDisconnectCancel", window)}
+}
+
+func (_c *TradingService_SetDisconnectCancel_Call) Run(run func(window time.Duration)) *TradingService_SetDisconnectCancel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *TradingService_SetDisconnectCancel_Call) Return(_a0 error) *TradingService_SetDisconnectCancel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TradingService_SetDisconnectCancel_Call) RunAndReturn(run func(time.Duration) error) *TradingService_SetDisconnectCancel_Call {
 	_c.Call.Return(run)
 	return _c
 }
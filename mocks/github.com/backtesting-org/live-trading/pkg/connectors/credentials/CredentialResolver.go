@@ -0,0 +1,129 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package credentials
+
+import (
+	connector "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	credentials "github.com/backtesting-org/live-trading/pkg/connectors/credentials"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CredentialResolver is an autogenerated mock type for the CredentialResolver type
+type CredentialResolver struct {
+	mock.Mock
+}
+
+type CredentialResolver_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CredentialResolver) EXPECT() *CredentialResolver_Expecter {
+	return &CredentialResolver_Expecter{mock: &_m.Mock}
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *CredentialResolver) Configure(config credentials.Config) error {
+	ret := _m.Called(config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Configure")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(credentials.Config) error); ok {
+		r0 = rf(config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CredentialResolver_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type CredentialResolver_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config credentials.Config
+func (_e *CredentialResolver_Expecter) Configure(config interface{}) *CredentialResolver_Configure_Call {
+	return &CredentialResolver_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *CredentialResolver_Configure_Call) Run(run func(config credentials.Config)) *CredentialResolver_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(credentials.Config))
+	})
+	return _c
+}
+
+func (_c *CredentialResolver_Configure_Call) Return(_a0 error) *CredentialResolver_Configure_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CredentialResolver_Configure_Call) RunAndReturn(run func(credentials.Config) error) *CredentialResolver_Configure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Resolve provides a mock function with given fields: config
+func (_m *CredentialResolver) Resolve(config connector.Config) error {
+	ret := _m.Called(config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Resolve")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(connector.Config) error); ok {
+		r0 = rf(config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CredentialResolver_Resolve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resolve'
+type CredentialResolver_Resolve_Call struct {
+	*mock.Call
+}
+
+// Resolve is a helper method to define mock.On call
+//   - config connector.Config
+func (_e *CredentialResolver_Expecter) Resolve(config interface{}) *CredentialResolver_Resolve_Call {
+	return &CredentialResolver_Resolve_Call{Call: _e.mock.On("Resolve", config)}
+}
+
+func (_c *CredentialResolver_Resolve_Call) Run(run func(config connector.Config)) *CredentialResolver_Resolve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.Config))
+	})
+	return _c
+}
+
+func (_c *CredentialResolver_Resolve_Call) Return(_a0 error) *CredentialResolver_Resolve_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CredentialResolver_Resolve_Call) RunAndReturn(run func(connector.Config) error) *CredentialResolver_Resolve_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCredentialResolver creates a new instance of CredentialResolver. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCredentialResolver(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CredentialResolver {
+	mock := &CredentialResolver{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,136 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package credentials
+
+import (
+	credentials "github.com/backtesting-org/live-trading/pkg/connectors/credentials"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Provider is an autogenerated mock type for the Provider type
+type Provider struct {
+	mock.Mock
+}
+
+type Provider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Provider) EXPECT() *Provider_Expecter {
+	return &Provider_Expecter{mock: &_m.Mock}
+}
+
+// Kind provides a mock function with no fields
+func (_m *Provider) Kind() credentials.Kind {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Kind")
+	}
+
+	var r0 credentials.Kind
+	if rf, ok := ret.Get(0).(func() credentials.Kind); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(credentials.Kind)
+	}
+
+	return r0
+}
+
+// Provider_Kind_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Kind'
+type Provider_Kind_Call struct {
+	*mock.Call
+}
+
+// Kind is a helper method to define mock.On call
+func (_e *Provider_Expecter) Kind() *Provider_Kind_Call {
+	return &Provider_Kind_Call{Call: _e.mock.On("Kind")}
+}
+
+func (_c *Provider_Kind_Call) Run(run func()) *Provider_Kind_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Provider_Kind_Call) Return(_a0 credentials.Kind) *Provider_Kind_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Provider_Kind_Call) RunAndReturn(run func() credentials.Kind) *Provider_Kind_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Secret provides a mock function with given fields: key
+func (_m *Provider) Secret(key string) (string, error) {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Secret")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return rf(key)
+	}
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(key)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Provider_Secret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Secret'
+type Provider_Secret_Call struct {
+	*mock.Call
+}
+
+// Secret is a helper method to define mock.On call
+//   - key string
+func (_e *Provider_Expecter) Secret(key interface{}) *Provider_Secret_Call {
+	return &Provider_Secret_Call{Call: _e.mock.On("Secret", key)}
+}
+
+func (_c *Provider_Secret_Call) Run(run func(key string)) *Provider_Secret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Provider_Secret_Call) Return(_a0 string, _a1 error) *Provider_Secret_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Provider_Secret_Call) RunAndReturn(run func(string) (string, error)) *Provider_Secret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewProvider creates a new instance of Provider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Provider {
+	mock := &Provider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,299 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package deadman
+
+import (
+	deadman "github.com/backtesting-org/live-trading/pkg/connectors/deadman"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DeadMansSwitch is an autogenerated mock type for the DeadMansSwitch type
+type DeadMansSwitch struct {
+	mock.Mock
+}
+
+type DeadMansSwitch_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DeadMansSwitch) EXPECT() *DeadMansSwitch_Expecter {
+	return &DeadMansSwitch_Expecter{mock: &_m.Mock}
+}
+
+// Check provides a mock function with no fields
+func (_m *DeadMansSwitch) Check() []deadman.Trip {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Check")
+	}
+
+	var r0 []deadman.Trip
+	if rf, ok := ret.Get(0).(func() []deadman.Trip); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]deadman.Trip)
+		}
+	}
+
+	return r0
+}
+
+// DeadMansSwitch_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type DeadMansSwitch_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+func (_e *DeadMansSwitch_Expecter) Check() *DeadMansSwitch_Check_Call {
+	return &DeadMansSwitch_Check_Call{Call: _e.mock.On("Check")}
+}
+
+func (_c *DeadMansSwitch_Check_Call) Run(run func()) *DeadMansSwitch_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DeadMansSwitch_Check_Call) Return(_a0 []deadman.Trip) *DeadMansSwitch_Check_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DeadMansSwitch_Check_Call) RunAndReturn(run func() []deadman.Trip) *DeadMansSwitch_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *DeadMansSwitch) Configure(config deadman.Config) {
+	_m.Called(config)
+}
+
+// DeadMansSwitch_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type DeadMansSwitch_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config deadman.Config
+func (_e *DeadMansSwitch_Expecter) Configure(config interface{}) *DeadMansSwitch_Configure_Call {
+	return &DeadMansSwitch_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *DeadMansSwitch_Configure_Call) Run(run func(config deadman.Config)) *DeadMansSwitch_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(deadman.Config))
+	})
+	return _c
+}
+
+func (_c *DeadMansSwitch_Configure_Call) Return() *DeadMansSwitch_Configure_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *DeadMansSwitch_Configure_Call) RunAndReturn(run func(deadman.Config)) *DeadMansSwitch_Configure_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Start provides a mock function with no fields
+func (_m *DeadMansSwitch) Start() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeadMansSwitch_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type DeadMansSwitch_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+func (_e *DeadMansSwitch_Expecter) Start() *DeadMansSwitch_Start_Call {
+	return &DeadMansSwitch_Start_Call{Call: _e.mock.On("Start")}
+}
+
+func (_c *DeadMansSwitch_Start_Call) Run(run func()) *DeadMansSwitch_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DeadMansSwitch_Start_Call) Return(_a0 error) *DeadMansSwitch_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DeadMansSwitch_Start_Call) RunAndReturn(run func() error) *DeadMansSwitch_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Status provides a mock function with no fields
+func (_m *DeadMansSwitch) Status() []deadman.VenueStatus {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Status")
+	}
+
+	var r0 []deadman.VenueStatus
+	if rf, ok := ret.Get(0).(func() []deadman.VenueStatus); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]deadman.VenueStatus)
+		}
+	}
+
+	return r0
+}
+
+// DeadMansSwitch_Status_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Status'
+type DeadMansSwitch_Status_Call struct {
+	*mock.Call
+}
+
+// Status is a helper method to define mock.On call
+func (_e *DeadMansSwitch_Expecter) Status() *DeadMansSwitch_Status_Call {
+	return &DeadMansSwitch_Status_Call{Call: _e.mock.On("Status")}
+}
+
+func (_c *DeadMansSwitch_Status_Call) Run(run func()) *DeadMansSwitch_Status_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DeadMansSwitch_Status_Call) Return(_a0 []deadman.VenueStatus) *DeadMansSwitch_Status_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DeadMansSwitch_Status_Call) RunAndReturn(run func() []deadman.VenueStatus) *DeadMansSwitch_Status_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with no fields
+func (_m *DeadMansSwitch) Stop() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeadMansSwitch_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type DeadMansSwitch_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *DeadMansSwitch_Expecter) Stop() *DeadMansSwitch_Stop_Call {
+	return &DeadMansSwitch_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *DeadMansSwitch_Stop_Call) Run(run func()) *DeadMansSwitch_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DeadMansSwitch_Stop_Call) Return(_a0 error) *DeadMansSwitch_Stop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DeadMansSwitch_Stop_Call) RunAndReturn(run func() error) *DeadMansSwitch_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Trips provides a mock function with no fields
+func (_m *DeadMansSwitch) Trips() <-chan deadman.Trip {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Trips")
+	}
+
+	var r0 <-chan deadman.Trip
+	if rf, ok := ret.Get(0).(func() <-chan deadman.Trip); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan deadman.Trip)
+		}
+	}
+
+	return r0
+}
+
+// DeadMansSwitch_Trips_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Trips'
+type DeadMansSwitch_Trips_Call struct {
+	*mock.Call
+}
+
+// Trips is a helper method to define mock.On call
+func (_e *DeadMansSwitch_Expecter) Trips() *DeadMansSwitch_Trips_Call {
+	return &DeadMansSwitch_Trips_Call{Call: _e.mock.On("Trips")}
+}
+
+func (_c *DeadMansSwitch_Trips_Call) Run(run func()) *DeadMansSwitch_Trips_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DeadMansSwitch_Trips_Call) Return(_a0 <-chan deadman.Trip) *DeadMansSwitch_Trips_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DeadMansSwitch_Trips_Call) RunAndReturn(run func() <-chan deadman.Trip) *DeadMansSwitch_Trips_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDeadMansSwitch creates a new instance of DeadMansSwitch. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDeadMansSwitch(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DeadMansSwitch {
+	mock := &DeadMansSwitch{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
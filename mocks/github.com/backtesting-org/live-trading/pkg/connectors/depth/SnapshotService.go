@@ -0,0 +1,193 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package depth
+
+import (
+	connector "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	depth "github.com/backtesting-org/live-trading/pkg/connectors/depth"
+
+	mock "github.com/stretchr/testify/mock"
+
+	portfolio "github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+)
+
+// SnapshotService is an autogenerated mock type for the SnapshotService type
+type SnapshotService struct {
+	mock.Mock
+}
+
+type SnapshotService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SnapshotService) EXPECT() *SnapshotService_Expecter {
+	return &SnapshotService_Expecter{mock: &_m.Mock}
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *SnapshotService) Configure(config depth.Config) {
+	_m.Called(config)
+}
+
+// SnapshotService_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type SnapshotService_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config depth.Config
+func (_e *SnapshotService_Expecter) Configure(config interface{}) *SnapshotService_Configure_Call {
+	return &SnapshotService_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *SnapshotService_Configure_Call) Run(run func(config depth.Config)) *SnapshotService_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(depth.Config))
+	})
+	return _c
+}
+
+func (_c *SnapshotService_Configure_Call) Return() *SnapshotService_Configure_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *SnapshotService_Configure_Call) RunAndReturn(run func(depth.Config)) *SnapshotService_Configure_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Latest provides a mock function with given fields: exchange, asset, instrument
+func (_m *SnapshotService) Latest(exchange connector.ExchangeName, asset portfolio.Asset, instrument connector.Instrument) (*depth.Snapshot, bool) {
+	ret := _m.Called(exchange, asset, instrument)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Latest")
+	}
+
+	var r0 *depth.Snapshot
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, portfolio.Asset, connector.Instrument) (*depth.Snapshot, bool)); ok {
+		return rf(exchange, asset, instrument)
+	}
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, portfolio.Asset, connector.Instrument) *depth.Snapshot); ok {
+		r0 = rf(exchange, asset, instrument)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*depth.Snapshot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.ExchangeName, portfolio.Asset, connector.Instrument) bool); ok {
+		r1 = rf(exchange, asset, instrument)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// SnapshotService_Latest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Latest'
+type SnapshotService_Latest_Call struct {
+	*mock.Call
+}
+
+// Latest is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - asset portfolio.Asset
+//   - instrument connector.Instrument
+func (_e *SnapshotService_Expecter) Latest(exchange interface{}, asset interface{}, instrument interface{}) *SnapshotService_Latest_Call {
+	return &SnapshotService_Latest_Call{Call: _e.mock.On("Latest", exchange, asset, instrument)}
+}
+
+func (_c *SnapshotService_Latest_Call) Run(run func(exchange connector.ExchangeName, asset portfolio.Asset, instrument connector.Instrument)) *SnapshotService_Latest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(portfolio.Asset), args[2].(connector.Instrument))
+	})
+	return _c
+}
+
+func (_c *SnapshotService_Latest_Call) Return(_a0 *depth.Snapshot, _a1 bool) *SnapshotService_Latest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SnapshotService_Latest_Call) RunAndReturn(run func(connector.ExchangeName, portfolio.Asset, connector.Instrument) (*depth.Snapshot, bool)) *SnapshotService_Latest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Snapshot provides a mock function with given fields: exchange, asset, instrument, _a3
+func (_m *SnapshotService) Snapshot(exchange connector.ExchangeName, asset portfolio.Asset, instrument connector.Instrument, _a3 int) (*depth.Snapshot, error) {
+	ret := _m.Called(exchange, asset, instrument, _a3)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Snapshot")
+	}
+
+	var r0 *depth.Snapshot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, portfolio.Asset, connector.Instrument, int) (*depth.Snapshot, error)); ok {
+		return rf(exchange, asset, instrument, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, portfolio.Asset, connector.Instrument, int) *depth.Snapshot); ok {
+		r0 = rf(exchange, asset, instrument, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*depth.Snapshot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.ExchangeName, portfolio.Asset, connector.Instrument, int) error); ok {
+		r1 = rf(exchange, asset, instrument, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SnapshotService_Snapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Snapshot'
+type SnapshotService_Snapshot_Call struct {
+	*mock.Call
+}
+
+// Snapshot is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - asset portfolio.Asset
+//   - instrument connector.Instrument
+//   - _a3 int
+func (_e *SnapshotService_Expecter) Snapshot(exchange interface{}, asset interface{}, instrument interface{}, _a3 interface{}) *SnapshotService_Snapshot_Call {
+	return &SnapshotService_Snapshot_Call{Call: _e.mock.On("Snapshot", exchange, asset, instrument, _a3)}
+}
+
+func (_c *SnapshotService_Snapshot_Call) Run(run func(exchange connector.ExchangeName, asset portfolio.Asset, instrument connector.Instrument, _a3 int)) *SnapshotService_Snapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(portfolio.Asset), args[2].(connector.Instrument), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *SnapshotService_Snapshot_Call) Return(_a0 *depth.Snapshot, _a1 error) *SnapshotService_Snapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SnapshotService_Snapshot_Call) RunAndReturn(run func(connector.ExchangeName, portfolio.Asset, connector.Instrument, int) (*depth.Snapshot, error)) *SnapshotService_Snapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSnapshotService creates a new instance of SnapshotService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSnapshotService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SnapshotService {
+	mock := &SnapshotService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,614 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package execution
+
+import (
+	execution "github.com/backtesting-org/live-trading/pkg/connectors/execution"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AlgoExecutor is an autogenerated mock type for the AlgoExecutor type
+type AlgoExecutor struct {
+	mock.Mock
+}
+
+type AlgoExecutor_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AlgoExecutor) EXPECT() *AlgoExecutor_Expecter {
+	return &AlgoExecutor_Expecter{mock: &_m.Mock}
+}
+
+// Active provides a mock function with no fields
+func (_m *AlgoExecutor) Active() []execution.ParentState {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Active")
+	}
+
+	var r0 []execution.ParentState
+	if rf, ok := ret.Get(0).(func() []execution.ParentState); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]execution.ParentState)
+		}
+	}
+
+	return r0
+}
+
+// AlgoExecutor_Active_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Active'
+type AlgoExecutor_Active_Call struct {
+	*mock.Call
+}
+
+// Active is a helper method to define mock.On call
+func (_e *AlgoExecutor_Expecter) Active() *AlgoExecutor_Active_Call {
+	return &AlgoExecutor_Active_Call{Call: _e.mock.On("Active")}
+}
+
+func (_c *AlgoExecutor_Active_Call) Run(run func()) *AlgoExecutor_Active_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *AlgoExecutor_Active_Call) Return(_a0 []execution.ParentState) *AlgoExecutor_Active_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AlgoExecutor_Active_Call) RunAndReturn(run func() []execution.ParentState) *AlgoExecutor_Active_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Advance provides a mock function with no fields
+func (_m *AlgoExecutor) Advance() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Advance")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AlgoExecutor_Advance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Advance'
+type AlgoExecutor_Advance_Call struct {
+	*mock.Call
+}
+
+// Advance is a helper method to define mock.On call
+func (_e *AlgoExecutor_Expecter) Advance() *AlgoExecutor_Advance_Call {
+	return &AlgoExecutor_Advance_Call{Call: _e.mock.On("Advance")}
+}
+
+func (_c *AlgoExecutor_Advance_Call) Run(run func()) *AlgoExecutor_Advance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *AlgoExecutor_Advance_Call) Return(_a0 error) *AlgoExecutor_Advance_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AlgoExecutor_Advance_Call) RunAndReturn(run func() error) *AlgoExecutor_Advance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Cancel provides a mock function with given fields: id
+func (_m *AlgoExecutor) Cancel(id string) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cancel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AlgoExecutor_Cancel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cancel'
+type AlgoExecutor_Cancel_Call struct {
+	*mock.Call
+}
+
+// Cancel is a helper method to define mock.On call
+//   - id string
+func (_e *AlgoExecutor_Expecter) Cancel(id interface{}) *AlgoExecutor_Cancel_Call {
+	return &AlgoExecutor_Cancel_Call{Call: _e.mock.On("Cancel", id)}
+}
+
+func (_c *AlgoExecutor_Cancel_Call) Run(run func(id string)) *AlgoExecutor_Cancel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *AlgoExecutor_Cancel_Call) Return(_a0 error) *AlgoExecutor_Cancel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AlgoExecutor_Cancel_Call) RunAndReturn(run func(string) error) *AlgoExecutor_Cancel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Completions provides a mock function with no fields
+func (_m *AlgoExecutor) Completions() <-chan execution.ParentState {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Completions")
+	}
+
+	var r0 <-chan execution.ParentState
+	if rf, ok := ret.Get(0).(func() <-chan execution.ParentState); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan execution.ParentState)
+		}
+	}
+
+	return r0
+}
+
+// AlgoExecutor_Completions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Completions'
+type AlgoExecutor_Completions_Call struct {
+	*mock.Call
+}
+
+// Completions is a helper method to define mock.On call
+func (_e *AlgoExecutor_Expecter) Completions() *AlgoExecutor_Completions_Call {
+	return &AlgoExecutor_Completions_Call{Call: _e.mock.On("Completions")}
+}
+
+func (_c *AlgoExecutor_Completions_Call) Run(run func()) *AlgoExecutor_Completions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *AlgoExecutor_Completions_Call) Return(_a0 <-chan execution.ParentState) *AlgoExecutor_Completions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AlgoExecutor_Completions_Call) RunAndReturn(run func() <-chan execution.ParentState) *AlgoExecutor_Completions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *AlgoExecutor) Configure(config execution.Config) {
+	_m.Called(config)
+}
+
+// AlgoExecutor_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type AlgoExecutor_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config execution.Config
+func (_e *AlgoExecutor_Expecter) Configure(config interface{}) *AlgoExecutor_Configure_Call {
+	return &AlgoExecutor_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *AlgoExecutor_Configure_Call) Run(run func(config execution.Config)) *AlgoExecutor_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(execution.Config))
+	})
+	return _c
+}
+
+func (_c *AlgoExecutor_Configure_Call) Return() *AlgoExecutor_Configure_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *AlgoExecutor_Configure_Call) RunAndReturn(run func(execution.Config)) *AlgoExecutor_Configure_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Parent provides a mock function with given fields: id
+func (_m *AlgoExecutor) Parent(id string) (execution.ParentState, bool) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Parent")
+	}
+
+	var r0 execution.ParentState
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string) (execution.ParentState, bool)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) execution.ParentState); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(execution.ParentState)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// AlgoExecutor_Parent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Parent'
+type AlgoExecutor_Parent_Call struct {
+	*mock.Call
+}
+
+// Parent is a helper method to define mock.On call
+//   - id string
+func (_e *AlgoExecutor_Expecter) Parent(id interface{}) *AlgoExecutor_Parent_Call {
+	return &AlgoExecutor_Parent_Call{Call: _e.mock.On("Parent", id)}
+}
+
+func (_c *AlgoExecutor_Parent_Call) Run(run func(id string)) *AlgoExecutor_Parent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *AlgoExecutor_Parent_Call) Return(_a0 execution.ParentState, _a1 bool) *AlgoExecutor_Parent_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AlgoExecutor_Parent_Call) RunAndReturn(run func(string) (execution.ParentState, bool)) *AlgoExecutor_Parent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with no fields
+func (_m *AlgoExecutor) Start() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AlgoExecutor_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type AlgoExecutor_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+func (_e *AlgoExecutor_Expecter) Start() *AlgoExecutor_Start_Call {
+	return &AlgoExecutor_Start_Call{Call: _e.mock.On("Start")}
+}
+
+func (_c *AlgoExecutor_Start_Call) Run(run func()) *AlgoExecutor_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *AlgoExecutor_Start_Call) Return(_a0 error) *AlgoExecutor_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AlgoExecutor_Start_Call) RunAndReturn(run func() error) *AlgoExecutor_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with no fields
+func (_m *AlgoExecutor) Stop() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AlgoExecutor_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type AlgoExecutor_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *AlgoExecutor_Expecter) Stop() *AlgoExecutor_Stop_Call {
+	return &AlgoExecutor_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *AlgoExecutor_Stop_Call) Run(run func()) *AlgoExecutor_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *AlgoExecutor_Stop_Call) Return(_a0 error) *AlgoExecutor_Stop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AlgoExecutor_Stop_Call) RunAndReturn(run func() error) *AlgoExecutor_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Submit provides a mock function with given fields: order
+func (_m *AlgoExecutor) Submit(order execution.ParentOrder) (string, error) {
+	ret := _m.Called(order)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Submit")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(execution.ParentOrder) (string, error)); ok {
+		return rf(order)
+	}
+	if rf, ok := ret.Get(0).(func(execution.ParentOrder) string); ok {
+		r0 = rf(order)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(execution.ParentOrder) error); ok {
+		r1 = rf(order)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AlgoExecutor_Submit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Submit'
+type AlgoExecutor_Submit_Call struct {
+	*mock.Call
+}
+
+// Submit is a helper method to define mock.On call
+//   - order execution.ParentOrder
+func (_e *AlgoExecutor_Expecter) Submit(order interface{}) *AlgoExecutor_Submit_Call {
+	return &AlgoExecutor_Submit_Call{Call: _e.mock.On("Submit", order)}
+}
+
+func (_c *AlgoExecutor_Submit_Call) Run(run func(order execution.ParentOrder)) *AlgoExecutor_Submit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(execution.ParentOrder))
+	})
+	return _c
+}
+
+func (_c *AlgoExecutor_Submit_Call) Return(_a0 string, _a1 error) *AlgoExecutor_Submit_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AlgoExecutor_Submit_Call) RunAndReturn(run func(execution.ParentOrder) (string, error)) *AlgoExecutor_Submit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SubmitLegs provides a mock function with given fields: order
+func (_m *AlgoExecutor) SubmitLegs(order execution.MultiLegOrder) (string, error) {
+	ret := _m.Called(order)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubmitLegs")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(execution.MultiLegOrder) (string, error)); ok {
+		return rf(order)
+	}
+	if rf, ok := ret.Get(0).(func(execution.MultiLegOrder) string); ok {
+		r0 = rf(order)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(execution.MultiLegOrder) error); ok {
+		r1 = rf(order)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AlgoExecutor_SubmitLegs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubmitLegs'
+type AlgoExecutor_SubmitLegs_Call struct {
+	*mock.Call
+}
+
+// SubmitLegs is a helper method to define mock.On call
+//   - order execution.MultiLegOrder
+func (_e *AlgoExecutor_Expecter) SubmitLegs(order interface{}) *AlgoExecutor_SubmitLegs_Call {
+	return &AlgoExecutor_SubmitLegs_Call{Call: _e.mock.On("SubmitLegs", order)}
+}
+
+func (_c *AlgoExecutor_SubmitLegs_Call) Run(run func(order execution.MultiLegOrder)) *AlgoExecutor_SubmitLegs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(execution.MultiLegOrder))
+	})
+	return _c
+}
+
+func (_c *AlgoExecutor_SubmitLegs_Call) Return(_a0 string, _a1 error) *AlgoExecutor_SubmitLegs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AlgoExecutor_SubmitLegs_Call) RunAndReturn(run func(execution.MultiLegOrder) (string, error)) *AlgoExecutor_SubmitLegs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Trade provides a mock function with given fields: id
+func (_m *AlgoExecutor) Trade(id string) (execution.MultiLegState, bool) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Trade")
+	}
+
+	var r0 execution.MultiLegState
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string) (execution.MultiLegState, bool)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) execution.MultiLegState); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(execution.MultiLegState)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// AlgoExecutor_Trade_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Trade'
+type AlgoExecutor_Trade_Call struct {
+	*mock.Call
+}
+
+// Trade is a helper method to define mock.On call
+//   - id string
+func (_e *AlgoExecutor_Expecter) Trade(id interface{}) *AlgoExecutor_Trade_Call {
+	return &AlgoExecutor_Trade_Call{Call: _e.mock.On("Trade", id)}
+}
+
+func (_c *AlgoExecutor_Trade_Call) Run(run func(id string)) *AlgoExecutor_Trade_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *AlgoExecutor_Trade_Call) Return(_a0 execution.MultiLegState, _a1 bool) *AlgoExecutor_Trade_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AlgoExecutor_Trade_Call) RunAndReturn(run func(string) (execution.MultiLegState, bool)) *AlgoExecutor_Trade_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TradeCompletions provides a mock function with no fields
+func (_m *AlgoExecutor) TradeCompletions() <-chan execution.MultiLegState {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for TradeCompletions")
+	}
+
+	var r0 <-chan execution.MultiLegState
+	if rf, ok := ret.Get(0).(func() <-chan execution.MultiLegState); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan execution.MultiLegState)
+		}
+	}
+
+	return r0
+}
+
+// AlgoExecutor_TradeCompletions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TradeCompletions'
+type AlgoExecutor_TradeCompletions_Call struct {
+	*mock.Call
+}
+
+// TradeCompletions is a helper method to define mock.On call
+func (_e *AlgoExecutor_Expecter) TradeCompletions() *AlgoExecutor_TradeCompletions_Call {
+	return &AlgoExecutor_TradeCompletions_Call{Call: _e.mock.On("TradeCompletions")}
+}
+
+func (_c *AlgoExecutor_TradeCompletions_Call) Run(run func()) *AlgoExecutor_TradeCompletions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *AlgoExecutor_TradeCompletions_Call) Return(_a0 <-chan execution.MultiLegState) *AlgoExecutor_TradeCompletions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AlgoExecutor_TradeCompletions_Call) RunAndReturn(run func() <-chan execution.MultiLegState) *AlgoExecutor_TradeCompletions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAlgoExecutor creates a new instance of AlgoExecutor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAlgoExecutor(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AlgoExecutor {
+	mock := &AlgoExecutor{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
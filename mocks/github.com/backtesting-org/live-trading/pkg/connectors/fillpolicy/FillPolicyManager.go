@@ -0,0 +1,550 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package fillpolicy
+
+import (
+	connector "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	fillpolicy "github.com/backtesting-org/live-trading/pkg/connectors/fillpolicy"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FillPolicyManager is an autogenerated mock type for the FillPolicyManager type
+type FillPolicyManager struct {
+	mock.Mock
+}
+
+type FillPolicyManager_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FillPolicyManager) EXPECT() *FillPolicyManager_Expecter {
+	return &FillPolicyManager_Expecter{mock: &_m.Mock}
+}
+
+// Active provides a mock function with no fields
+func (_m *FillPolicyManager) Active() []fillpolicy.Watch {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Active")
+	}
+
+	var r0 []fillpolicy.Watch
+	if rf, ok := ret.Get(0).(func() []fillpolicy.Watch); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]fillpolicy.Watch)
+		}
+	}
+
+	return r0
+}
+
+// FillPolicyManager_Active_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Active'
+type FillPolicyManager_Active_Call struct {
+	*mock.Call
+}
+
+// Active is a helper method to define mock.On call
+func (_e *FillPolicyManager_Expecter) Active() *FillPolicyManager_Active_Call {
+	return &FillPolicyManager_Active_Call{Call: _e.mock.On("Active")}
+}
+
+func (_c *FillPolicyManager_Active_Call) Run(run func()) *FillPolicyManager_Active_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *FillPolicyManager_Active_Call) Return(_a0 []fillpolicy.Watch) *FillPolicyManager_Active_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FillPolicyManager_Active_Call) RunAndReturn(run func() []fillpolicy.Watch) *FillPolicyManager_Active_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClearPolicy provides a mock function with given fields: runID
+func (_m *FillPolicyManager) ClearPolicy(runID string) {
+	_m.Called(runID)
+}
+
+// FillPolicyManager_ClearPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearPolicy'
+type FillPolicyManager_ClearPolicy_Call struct {
+	*mock.Call
+}
+
+// ClearPolicy is a helper method to define mock.On call
+//   - runID string
+func (_e *FillPolicyManager_Expecter) ClearPolicy(runID interface{}) *FillPolicyManager_ClearPolicy_Call {
+	return &FillPolicyManager_ClearPolicy_Call{Call: _e.mock.On("ClearPolicy", runID)}
+}
+
+func (_c *FillPolicyManager_ClearPolicy_Call) Run(run func(runID string)) *FillPolicyManager_ClearPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *FillPolicyManager_ClearPolicy_Call) Return() *FillPolicyManager_ClearPolicy_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *FillPolicyManager_ClearPolicy_Call) RunAndReturn(run func(string)) *FillPolicyManager_ClearPolicy_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *FillPolicyManager) Configure(config fillpolicy.Config) {
+	_m.Called(config)
+}
+
+// FillPolicyManager_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type FillPolicyManager_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config fillpolicy.Config
+func (_e *FillPolicyManager_Expecter) Configure(config interface{}) *FillPolicyManager_Configure_Call {
+	return &FillPolicyManager_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *FillPolicyManager_Configure_Call) Run(run func(config fillpolicy.Config)) *FillPolicyManager_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(fillpolicy.Config))
+	})
+	return _c
+}
+
+func (_c *FillPolicyManager_Configure_Call) Return() *FillPolicyManager_Configure_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *FillPolicyManager_Configure_Call) RunAndReturn(run func(fillpolicy.Config)) *FillPolicyManager_Configure_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Policy provides a mock function with given fields: runID
+func (_m *FillPolicyManager) Policy(runID string) (fillpolicy.Policy, bool) {
+	ret := _m.Called(runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Policy")
+	}
+
+	var r0 fillpolicy.Policy
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string) (fillpolicy.Policy, bool)); ok {
+		return rf(runID)
+	}
+	if rf, ok := ret.Get(0).(func(string) fillpolicy.Policy); ok {
+		r0 = rf(runID)
+	} else {
+		r0 = ret.Get(0).(fillpolicy.Policy)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(runID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// FillPolicyManager_Policy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Policy'
+type FillPolicyManager_Policy_Call struct {
+	*mock.Call
+}
+
+// Policy is a helper method to define mock.On call
+//   - runID string
+func (_e *FillPolicyManager_Expecter) Policy(runID interface{}) *FillPolicyManager_Policy_Call {
+	return &FillPolicyManager_Policy_Call{Call: _e.mock.On("Policy", runID)}
+}
+
+func (_c *FillPolicyManager_Policy_Call) Run(run func(runID string)) *FillPolicyManager_Policy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *FillPolicyManager_Policy_Call) Return(_a0 fillpolicy.Policy, _a1 bool) *FillPolicyManager_Policy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FillPolicyManager_Policy_Call) RunAndReturn(run func(string) (fillpolicy.Policy, bool)) *FillPolicyManager_Policy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Poll provides a mock function with no fields
+func (_m *FillPolicyManager) Poll() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Poll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FillPolicyManager_Poll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Poll'
+type FillPolicyManager_Poll_Call struct {
+	*mock.Call
+}
+
+// Poll is a helper method to define mock.On call
+func (_e *FillPolicyManager_Expecter) Poll() *FillPolicyManager_Poll_Call {
+	return &FillPolicyManager_Poll_Call{Call: _e.mock.On("Poll")}
+}
+
+func (_c *FillPolicyManager_Poll_Call) Run(run func()) *FillPolicyManager_Poll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *FillPolicyManager_Poll_Call) Return(_a0 error) *FillPolicyManager_Poll_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FillPolicyManager_Poll_Call) RunAndReturn(run func() error) *FillPolicyManager_Poll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPolicy provides a mock function with given fields: runID, policy
+func (_m *FillPolicyManager) SetPolicy(runID string, policy fillpolicy.Policy) error {
+	ret := _m.Called(runID, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, fillpolicy.Policy) error); ok {
+		r0 = rf(runID, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FillPolicyManager_SetPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPolicy'
+type FillPolicyManager_SetPolicy_Call struct {
+	*mock.Call
+}
+
+// SetPolicy is a helper method to define mock.On call
+//   - runID string
+//   - policy fillpolicy.Policy
+func (_e *FillPolicyManager_Expecter) SetPolicy(runID interface{}, policy interface{}) *FillPolicyManager_SetPolicy_Call {
+	return &FillPolicyManager_SetPolicy_Call{Call: _e.mock.On("SetPolicy", runID, policy)}
+}
+
+func (_c *FillPolicyManager_SetPolicy_Call) Run(run func(runID string, policy fillpolicy.Policy)) *FillPolicyManager_SetPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(fillpolicy.Policy))
+	})
+	return _c
+}
+
+func (_c *FillPolicyManager_SetPolicy_Call) Return(_a0 error) *FillPolicyManager_SetPolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FillPolicyManager_SetPolicy_Call) RunAndReturn(run func(string, fillpolicy.Policy) error) *FillPolicyManager_SetPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with no fields
+func (_m *FillPolicyManager) Start() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FillPolicyManager_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type FillPolicyManager_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+func (_e *FillPolicyManager_Expecter) Start() *FillPolicyManager_Start_Call {
+	return &FillPolicyManager_Start_Call{Call: _e.mock.On("Start")}
+}
+
+func (_c *FillPolicyManager_Start_Call) Run(run func()) *FillPolicyManager_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *FillPolicyManager_Start_Call) Return(_a0 error) *FillPolicyManager_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FillPolicyManager_Start_Call) RunAndReturn(run func() error) *FillPolicyManager_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with no fields
+func (_m *FillPolicyManager) Stop() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FillPolicyManager_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type FillPolicyManager_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *FillPolicyManager_Expecter) Stop() *FillPolicyManager_Stop_Call {
+	return &FillPolicyManager_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *FillPolicyManager_Stop_Call) Run(run func()) *FillPolicyManager_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *FillPolicyManager_Stop_Call) Return(_a0 error) *FillPolicyManager_Stop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FillPolicyManager_Stop_Call) RunAndReturn(run func() error) *FillPolicyManager_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unwatch provides a mock function with given fields: exchange, orderID
+func (_m *FillPolicyManager) Unwatch(exchange connector.ExchangeName, orderID string) error {
+	ret := _m.Called(exchange, orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unwatch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, string) error); ok {
+		r0 = rf(exchange, orderID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FillPolicyManager_Unwatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unwatch'
+type FillPolicyManager_Unwatch_Call struct {
+	*mock.Call
+}
+
+// Unwatch is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - orderID string
+func (_e *FillPolicyManager_Expecter) Unwatch(exchange interface{}, orderID interface{}) *FillPolicyManager_Unwatch_Call {
+	return &FillPolicyManager_Unwatch_Call{Call: _e.mock.On("Unwatch", exchange, orderID)}
+}
+
+func (_c *FillPolicyManager_Unwatch_Call) Run(run func(exchange connector.ExchangeName, orderID string)) *FillPolicyManager_Unwatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *FillPolicyManager_Unwatch_Call) Return(_a0 error) *FillPolicyManager_Unwatch_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FillPolicyManager_Unwatch_Call) RunAndReturn(run func(connector.ExchangeName, string) error) *FillPolicyManager_Unwatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Watch provides a mock function with given fields: exchange, orderID, runID
+func (_m *FillPolicyManager) Watch(exchange connector.ExchangeName, orderID string, runID string) (fillpolicy.Watch, error) {
+	ret := _m.Called(exchange, orderID, runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Watch")
+	}
+
+	var r0 fillpolicy.Watch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, string, string) (fillpolicy.Watch, error)); ok {
+		return rf(exchange, orderID, runID)
+	}
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, string, string) fillpolicy.Watch); ok {
+		r0 = rf(exchange, orderID, runID)
+	} else {
+		r0 = ret.Get(0).(fillpolicy.Watch)
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.ExchangeName, string, string) error); ok {
+		r1 = rf(exchange, orderID, runID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FillPolicyManager_Watch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Watch'
+type FillPolicyManager_Watch_Call struct {
+	*mock.Call
+}
+
+// Watch is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - orderID string
+//   - runID string
+func (_e *FillPolicyManager_Expecter) Watch(exchange interface{}, orderID interface{}, runID interface{}) *FillPolicyManager_Watch_Call {
+	return &FillPolicyManager_Watch_Call{Call: _e.mock.On("Watch", exchange, orderID, runID)}
+}
+
+func (_c *FillPolicyManager_Watch_Call) Run(run func(exchange connector.ExchangeName, orderID string, runID string)) *FillPolicyManager_Watch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *FillPolicyManager_Watch_Call) Return(_a0 fillpolicy.Watch, _a1 error) *FillPolicyManager_Watch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FillPolicyManager_Watch_Call) RunAndReturn(run func(connector.ExchangeName, string, string) (fillpolicy.Watch, error)) *FillPolicyManager_Watch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Watched provides a mock function with given fields: exchange, orderID
+func (_m *FillPolicyManager) Watched(exchange connector.ExchangeName, orderID string) (fillpolicy.Watch, bool) {
+	ret := _m.Called(exchange, orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Watched")
+	}
+
+	var r0 fillpolicy.Watch
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, string) (fillpolicy.Watch, bool)); ok {
+		return rf(exchange, orderID)
+	}
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, string) fillpolicy.Watch); ok {
+		r0 = rf(exchange, orderID)
+	} else {
+		r0 = ret.Get(0).(fillpolicy.Watch)
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.ExchangeName, string) bool); ok {
+		r1 = rf(exchange, orderID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// FillPolicyManager_Watched_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Watched'
+type FillPolicyManager_Watched_Call struct {
+	*mock.Call
+}
+
+// Watched is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - orderID string
+func (_e *FillPolicyManager_Expecter) Watched(exchange interface{}, orderID interface{}) *FillPolicyManager_Watched_Call {
+	return &FillPolicyManager_Watched_Call{Call: _e.mock.On("Watched", exchange, orderID)}
+}
+
+func (_c *FillPolicyManager_Watched_Call) Run(run func(exchange connector.ExchangeName, orderID string)) *FillPolicyManager_Watched_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *FillPolicyManager_Watched_Call) Return(_a0 fillpolicy.Watch, _a1 bool) *FillPolicyManager_Watched_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FillPolicyManager_Watched_Call) RunAndReturn(run func(connector.ExchangeName, string) (fillpolicy.Watch, bool)) *FillPolicyManager_Watched_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFillPolicyManager creates a new instance of FillPolicyManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFillPolicyManager(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FillPolicyManager {
+	mock := &FillPolicyManager{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,513 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package fills
+
+import (
+	connector "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	fills "github.com/backtesting-org/live-trading/pkg/connectors/fills"
+
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// HistorySync is an autogenerated mock type for the HistorySync type
+type HistorySync struct {
+	mock.Mock
+}
+
+type HistorySync_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *HistorySync) EXPECT() *HistorySync_Expecter {
+	return &HistorySync_Expecter{mock: &_m.Mock}
+}
+
+// Close provides a mock function with no fields
+func (_m *HistorySync) Close() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// HistorySync_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type HistorySync_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *HistorySync_Expecter) Close() *HistorySync_Close_Call {
+	return &HistorySync_Close_Call{Call: _e.mock.On("Close")}
+}
+
+func (_c *HistorySync_Close_Call) Run(run func()) *HistorySync_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *HistorySync_Close_Call) Return(_a0 error) *HistorySync_Close_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HistorySync_Close_Call) RunAndReturn(run func() error) *HistorySync_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *HistorySync) Configure(config fills.Config) error {
+	ret := _m.Called(config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Configure")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(fills.Config) error); ok {
+		r0 = rf(config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// HistorySync_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type HistorySync_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config fills.Config
+func (_e *HistorySync_Expecter) Configure(config interface{}) *HistorySync_Configure_Call {
+	return &HistorySync_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *HistorySync_Configure_Call) Run(run func(config fills.Config)) *HistorySync_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(fills.Config))
+	})
+	return _c
+}
+
+func (_c *HistorySync_Configure_Call) Return(_a0 error) *HistorySync_Configure_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HistorySync_Configure_Call) RunAndReturn(run func(fills.Config) error) *HistorySync_Configure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Cursor provides a mock function with given fields: exchange, symbol
+func (_m *HistorySync) Cursor(exchange connector.ExchangeName, symbol string) (fills.Cursor, bool) {
+	ret := _m.Called(exchange, symbol)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cursor")
+	}
+
+	var r0 fills.Cursor
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, string) (fills.Cursor, bool)); ok {
+		return rf(exchange, symbol)
+	}
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, string) fills.Cursor); ok {
+		r0 = rf(exchange, symbol)
+	} else {
+		r0 = ret.Get(0).(fills.Cursor)
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.ExchangeName, string) bool); ok {
+		r1 = rf(exchange, symbol)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// HistorySync_Cursor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cursor'
+type HistorySync_Cursor_Call struct {
+	*mock.Call
+}
+
+// Cursor is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - symbol string
+func (_e *HistorySync_Expecter) Cursor(exchange interface{}, symbol interface{}) *HistorySync_Cursor_Call {
+	return &HistorySync_Cursor_Call{Call: _e.mock.On("Cursor", exchange, symbol)}
+}
+
+func (_c *HistorySync_Cursor_Call) Run(run func(exchange connector.ExchangeName, symbol string)) *HistorySync_Cursor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *HistorySync_Cursor_Call) Return(_a0 fills.Cursor, _a1 bool) *HistorySync_Cursor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *HistorySync_Cursor_Call) RunAndReturn(run func(connector.ExchangeName, string) (fills.Cursor, bool)) *HistorySync_Cursor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Export provides a mock function with given fields: w, since, until
+func (_m *HistorySync) Export(w io.Writer, since time.Time, until time.Time) error {
+	ret := _m.Called(w, since, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Export")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(io.Writer, time.Time, time.Time) error); ok {
+		r0 = rf(w, since, until)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// HistorySync_Export_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Export'
+type HistorySync_Export_Call struct {
+	*mock.Call
+}
+
+// Export is a helper method to define mock.On call
+//   - w io.Writer
+//   - since time.Time
+//   - until time.Time
+func (_e *HistorySync_Expecter) Export(w interface{}, since interface{}, until interface{}) *HistorySync_Export_Call {
+	return &HistorySync_Export_Call{Call: _e.mock.On("Export", w, since, until)}
+}
+
+func (_c *HistorySync_Export_Call) Run(run func(w io.Writer, since time.Time, until time.Time)) *HistorySync_Export_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(io.Writer), args[1].(time.Time), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *HistorySync_Export_Call) Return(_a0 error) *HistorySync_Export_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HistorySync_Export_Call) RunAndReturn(run func(io.Writer, time.Time, time.Time) error) *HistorySync_Export_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Fills provides a mock function with given fields: exchange, symbol, since
+func (_m *HistorySync) Fills(exchange connector.ExchangeName, symbol string, since time.Time) []connector.Trade {
+	ret := _m.Called(exchange, symbol, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Fills")
+	}
+
+	var r0 []connector.Trade
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, string, time.Time) []connector.Trade); ok {
+		r0 = rf(exchange, symbol, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]connector.Trade)
+		}
+	}
+
+	return r0
+}
+
+// HistorySync_Fills_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Fills'
+type HistorySync_Fills_Call struct {
+	*mock.Call
+}
+
+// Fills is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - symbol string
+//   - since time.Time
+func (_e *HistorySync_Expecter) Fills(exchange interface{}, symbol interface{}, since interface{}) *HistorySync_Fills_Call {
+	return &HistorySync_Fills_Call{Call: _e.mock.On("Fills", exchange, symbol, since)}
+}
+
+func (_c *HistorySync_Fills_Call) Run(run func(exchange connector.ExchangeName, symbol string, since time.Time)) *HistorySync_Fills_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *HistorySync_Fills_Call) Return(_a0 []connector.Trade) *HistorySync_Fills_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HistorySync_Fills_Call) RunAndReturn(run func(connector.ExchangeName, string, time.Time) []connector.Trade) *HistorySync_Fills_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with no fields
+func (_m *HistorySync) Start() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// HistorySync_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type HistorySync_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+func (_e *HistorySync_Expecter) Start() *HistorySync_Start_Call {
+	return &HistorySync_Start_Call{Call: _e.mock.On("Start")}
+}
+
+func (_c *HistorySync_Start_Call) Run(run func()) *HistorySync_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *HistorySync_Start_Call) Return(_a0 error) *HistorySync_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HistorySync_Start_Call) RunAndReturn(run func() error) *HistorySync_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with no fields
+func (_m *HistorySync) Stop() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// HistorySync_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type HistorySync_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *HistorySync_Expecter) Stop() *HistorySync_Stop_Call {
+	return &HistorySync_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *HistorySync_Stop_Call) Run(run func()) *HistorySync_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *HistorySync_Stop_Call) Return(_a0 error) *HistorySync_Stop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HistorySync_Stop_Call) RunAndReturn(run func() error) *HistorySync_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Sync provides a mock function with given fields: exchange, symbol
+func (_m *HistorySync) Sync(exchange connector.ExchangeName, symbol string) (int, error) {
+	ret := _m.Called(exchange, symbol)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Sync")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, string) (int, error)); ok {
+		return rf(exchange, symbol)
+	}
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, string) int); ok {
+		r0 = rf(exchange, symbol)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.ExchangeName, string) error); ok {
+		r1 = rf(exchange, symbol)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HistorySync_Sync_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Sync'
+type HistorySync_Sync_Call struct {
+	*mock.Call
+}
+
+// Sync is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - symbol string
+func (_e *HistorySync_Expecter) Sync(exchange interface{}, symbol interface{}) *HistorySync_Sync_Call {
+	return &HistorySync_Sync_Call{Call: _e.mock.On("Sync", exchange, symbol)}
+}
+
+func (_c *HistorySync_Sync_Call) Run(run func(exchange connector.ExchangeName, symbol string)) *HistorySync_Sync_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *HistorySync_Sync_Call) Return(_a0 int, _a1 error) *HistorySync_Sync_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *HistorySync_Sync_Call) RunAndReturn(run func(connector.ExchangeName, string) (int, error)) *HistorySync_Sync_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SyncAll provides a mock function with no fields
+func (_m *HistorySync) SyncAll() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SyncAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// HistorySync_SyncAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SyncAll'
+type HistorySync_SyncAll_Call struct {
+	*mock.Call
+}
+
+// SyncAll is a helper method to define mock.On call
+func (_e *HistorySync_Expecter) SyncAll() *HistorySync_SyncAll_Call {
+	return &HistorySync_SyncAll_Call{Call: _e.mock.On("SyncAll")}
+}
+
+func (_c *HistorySync_SyncAll_Call) Run(run func()) *HistorySync_SyncAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *HistorySync_SyncAll_Call) Return(_a0 error) *HistorySync_SyncAll_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HistorySync_SyncAll_Call) RunAndReturn(run func() error) *HistorySync_SyncAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Track provides a mock function with given fields: exchange, symbol
+func (_m *HistorySync) Track(exchange connector.ExchangeName, symbol string) {
+	_m.Called(exchange, symbol)
+}
+
+// HistorySync_Track_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Track'
+type HistorySync_Track_Call struct {
+	*mock.Call
+}
+
+// Track is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - symbol string
+func (_e *HistorySync_Expecter) Track(exchange interface{}, symbol interface{}) *HistorySync_Track_Call {
+	return &HistorySync_Track_Call{Call: _e.mock.On("Track", exchange, symbol)}
+}
+
+func (_c *HistorySync_Track_Call) Run(run func(exchange connector.ExchangeName, symbol string)) *HistorySync_Track_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *HistorySync_Track_Call) Return() *HistorySync_Track_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *HistorySync_Track_Call) RunAndReturn(run func(connector.ExchangeName, string)) *HistorySync_Track_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewHistorySync creates a new instance of HistorySync. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewHistorySync(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *HistorySync {
+	mock := &HistorySync{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,415 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package funding
+
+import (
+	connector "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	funding "github.com/backtesting-org/live-trading/pkg/connectors/funding"
+
+	mock "github.com/stretchr/testify/mock"
+
+	portfolio "github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+)
+
+// FundingRateFeed is an autogenerated mock type for the FundingRateFeed type
+type FundingRateFeed struct {
+	mock.Mock
+}
+
+type FundingRateFeed_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FundingRateFeed) EXPECT() *FundingRateFeed_Expecter {
+	return &FundingRateFeed_Expecter{mock: &_m.Mock}
+}
+
+// BestSpread provides a mock function with given fields: asset
+func (_m *FundingRateFeed) BestSpread(asset portfolio.Asset) (funding.Spread, bool) {
+	ret := _m.Called(asset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BestSpread")
+	}
+
+	var r0 funding.Spread
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(portfolio.Asset) (funding.Spread, bool)); ok {
+		return rf(asset)
+	}
+	if rf, ok := ret.Get(0).(func(portfolio.Asset) funding.Spread); ok {
+		r0 = rf(asset)
+	} else {
+		r0 = ret.Get(0).(funding.Spread)
+	}
+
+	if rf, ok := ret.Get(1).(func(portfolio.Asset) bool); ok {
+		r1 = rf(asset)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// FundingRateFeed_BestSpread_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BestSpread'
+type FundingRateFeed_BestSpread_Call struct {
+	*mock.Call
+}
+
+// BestSpread is a helper method to define mock.On call
+//   - asset portfolio.Asset
+func (_e *FundingRateFeed_Expecter) BestSpread(asset interface{}) *FundingRateFeed_BestSpread_Call {
+	return &FundingRateFeed_BestSpread_Call{Call: _e.mock.On("BestSpread", asset)}
+}
+
+func (_c *FundingRateFeed_BestSpread_Call) Run(run func(asset portfolio.Asset)) *FundingRateFeed_BestSpread_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(portfolio.Asset))
+	})
+	return _c
+}
+
+func (_c *FundingRateFeed_BestSpread_Call) Return(_a0 funding.Spread, _a1 bool) *FundingRateFeed_BestSpread_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FundingRateFeed_BestSpread_Call) RunAndReturn(run func(portfolio.Asset) (funding.Spread, bool)) *FundingRateFeed_BestSpread_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *FundingRateFeed) Configure(config funding.Config) {
+	_m.Called(config)
+}
+
+// FundingRateFeed_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type FundingRateFeed_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config funding.Config
+func (_e *FundingRateFeed_Expecter) Configure(config interface{}) *FundingRateFeed_Configure_Call {
+	return &FundingRateFeed_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *FundingRateFeed_Configure_Call) Run(run func(config funding.Config)) *FundingRateFeed_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(funding.Config))
+	})
+	return _c
+}
+
+func (_c *FundingRateFeed_Configure_Call) Return() *FundingRateFeed_Configure_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *FundingRateFeed_Configure_Call) RunAndReturn(run func(funding.Config)) *FundingRateFeed_Configure_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Rate provides a mock function with given fields: asset, exchange
+func (_m *FundingRateFeed) Rate(asset portfolio.Asset, exchange connector.ExchangeName) (funding.Rate, bool) {
+	ret := _m.Called(asset, exchange)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rate")
+	}
+
+	var r0 funding.Rate
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(portfolio.Asset, connector.ExchangeName) (funding.Rate, bool)); ok {
+		return rf(asset, exchange)
+	}
+	if rf, ok := ret.Get(0).(func(portfolio.Asset, connector.ExchangeName) funding.Rate); ok {
+		r0 = rf(asset, exchange)
+	} else {
+		r0 = ret.Get(0).(funding.Rate)
+	}
+
+	if rf, ok := ret.Get(1).(func(portfolio.Asset, connector.ExchangeName) bool); ok {
+		r1 = rf(asset, exchange)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// FundingRateFeed_Rate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rate'
+type FundingRateFeed_Rate_Call struct {
+	*mock.Call
+}
+
+// Rate is a helper method to define mock.On call
+//   - asset portfolio.Asset
+//   - exchange connector.ExchangeName
+func (_e *FundingRateFeed_Expecter) Rate(asset interface{}, exchange interface{}) *FundingRateFeed_Rate_Call {
+	return &FundingRateFeed_Rate_Call{Call: _e.mock.On("Rate", asset, exchange)}
+}
+
+func (_c *FundingRateFeed_Rate_Call) Run(run func(asset portfolio.Asset, exchange connector.ExchangeName)) *FundingRateFeed_Rate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(portfolio.Asset), args[1].(connector.ExchangeName))
+	})
+	return _c
+}
+
+func (_c *FundingRateFeed_Rate_Call) Return(_a0 funding.Rate, _a1 bool) *FundingRateFeed_Rate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FundingRateFeed_Rate_Call) RunAndReturn(run func(portfolio.Asset, connector.ExchangeName) (funding.Rate, bool)) *FundingRateFeed_Rate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rates provides a mock function with given fields: asset
+func (_m *FundingRateFeed) Rates(asset portfolio.Asset) []funding.Rate {
+	ret := _m.Called(asset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rates")
+	}
+
+	var r0 []funding.Rate
+	if rf, ok := ret.Get(0).(func(portfolio.Asset) []funding.Rate); ok {
+		r0 = rf(asset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]funding.Rate)
+		}
+	}
+
+	return r0
+}
+
+// FundingRateFeed_Rates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rates'
+type FundingRateFeed_Rates_Call struct {
+	*mock.Call
+}
+
+// Rates is a helper method to define mock.On call
+//   - asset portfolio.Asset
+func (_e *FundingRateFeed_Expecter) Rates(asset interface{}) *FundingRateFeed_Rates_Call {
+	return &FundingRateFeed_Rates_Call{Call: _e.mock.On("Rates", asset)}
+}
+
+func (_c *FundingRateFeed_Rates_Call) Run(run func(asset portfolio.Asset)) *FundingRateFeed_Rates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(portfolio.Asset))
+	})
+	return _c
+}
+
+func (_c *FundingRateFeed_Rates_Call) Return(_a0 []funding.Rate) *FundingRateFeed_Rates_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FundingRateFeed_Rates_Call) RunAndReturn(run func(portfolio.Asset) []funding.Rate) *FundingRateFeed_Rates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Refresh provides a mock function with no fields
+func (_m *FundingRateFeed) Refresh() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Refresh")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FundingRateFeed_Refresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Refresh'
+type FundingRateFeed_Refresh_Call struct {
+	*mock.Call
+}
+
+// Refresh is a helper method to define mock.On call
+func (_e *FundingRateFeed_Expecter) Refresh() *FundingRateFeed_Refresh_Call {
+	return &FundingRateFeed_Refresh_Call{Call: _e.mock.On("Refresh")}
+}
+
+func (_c *FundingRateFeed_Refresh_Call) Run(run func()) *FundingRateFeed_Refresh_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *FundingRateFeed_Refresh_Call) Return(_a0 error) *FundingRateFeed_Refresh_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FundingRateFeed_Refresh_Call) RunAndReturn(run func() error) *FundingRateFeed_Refresh_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Spreads provides a mock function with no fields
+func (_m *FundingRateFeed) Spreads() []funding.Spread {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Spreads")
+	}
+
+	var r0 []funding.Spread
+	if rf, ok := ret.Get(0).(func() []funding.Spread); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]funding.Spread)
+		}
+	}
+
+	return r0
+}
+
+// FundingRateFeed_Spreads_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Spreads'
+type FundingRateFeed_Spreads_Call struct {
+	*mock.Call
+}
+
+// Spreads is a helper method to define mock.On call
+func (_e *FundingRateFeed_Expecter) Spreads() *FundingRateFeed_Spreads_Call {
+	return &FundingRateFeed_Spreads_Call{Call: _e.mock.On("Spreads")}
+}
+
+func (_c *FundingRateFeed_Spreads_Call) Run(run func()) *FundingRateFeed_Spreads_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *FundingRateFeed_Spreads_Call) Return(_a0 []funding.Spread) *FundingRateFeed_Spreads_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FundingRateFeed_Spreads_Call) RunAndReturn(run func() []funding.Spread) *FundingRateFeed_Spreads_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with no fields
+func (_m *FundingRateFeed) Start() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FundingRateFeed_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type FundingRateFeed_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+func (_e *FundingRateFeed_Expecter) Start() *FundingRateFeed_Start_Call {
+	return &FundingRateFeed_Start_Call{Call: _e.mock.On("Start")}
+}
+
+func (_c *FundingRateFeed_Start_Call) Run(run func()) *FundingRateFeed_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *FundingRateFeed_Start_Call) Return(_a0 error) *FundingRateFeed_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FundingRateFeed_Start_Call) RunAndReturn(run func() error) *FundingRateFeed_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with no fields
+func (_m *FundingRateFeed) Stop() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FundingRateFeed_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type FundingRateFeed_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *FundingRateFeed_Expecter) Stop() *FundingRateFeed_Stop_Call {
+	return &FundingRateFeed_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *FundingRateFeed_Stop_Call) Run(run func()) *FundingRateFeed_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *FundingRateFeed_Stop_Call) Return(_a0 error) *FundingRateFeed_Stop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FundingRateFeed_Stop_Call) RunAndReturn(run func() error) *FundingRateFeed_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFundingRateFeed creates a new instance of FundingRateFeed. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFundingRateFeed(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FundingRateFeed {
+	mock := &FundingRateFeed{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
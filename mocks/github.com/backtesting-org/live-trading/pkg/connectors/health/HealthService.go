@@ -0,0 +1,406 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package health
+
+import (
+	connector "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	health "github.com/backtesting-org/live-trading/pkg/connectors/health"
+
+	http "net/http"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// HealthService is an autogenerated mock type for the HealthService type
+type HealthService struct {
+	mock.Mock
+}
+
+type HealthService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *HealthService) EXPECT() *HealthService_Expecter {
+	return &HealthService_Expecter{mock: &_m.Mock}
+}
+
+// Alerts provides a mock function with no fields
+func (_m *HealthService) Alerts() <-chan health.Alert {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Alerts")
+	}
+
+	var r0 <-chan health.Alert
+	if rf, ok := ret.Get(0).(func() <-chan health.Alert); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan health.Alert)
+		}
+	}
+
+	return r0
+}
+
+// HealthService_Alerts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Alerts'
+type HealthService_Alerts_Call struct {
+	*mock.Call
+}
+
+// Alerts is a helper method to define mock.On call
+func (_e *HealthService_Expecter) Alerts() *HealthService_Alerts_Call {
+	return &HealthService_Alerts_Call{Call: _e.mock.On("Alerts")}
+}
+
+func (_c *HealthService_Alerts_Call) Run(run func()) *HealthService_Alerts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *HealthService_Alerts_Call) Return(_a0 <-chan health.Alert) *HealthService_Alerts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HealthService_Alerts_Call) RunAndReturn(run func() <-chan health.Alert) *HealthService_Alerts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Check provides a mock function with no fields
+func (_m *HealthService) Check() []health.ConnectorHealth {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Check")
+	}
+
+	var r0 []health.ConnectorHealth
+	if rf, ok := ret.Get(0).(func() []health.ConnectorHealth); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]health.ConnectorHealth)
+		}
+	}
+
+	return r0
+}
+
+// HealthService_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type HealthService_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+func (_e *HealthService_Expecter) Check() *HealthService_Check_Call {
+	return &HealthService_Check_Call{Call: _e.mock.On("Check")}
+}
+
+func (_c *HealthService_Check_Call) Run(run func()) *HealthService_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *HealthService_Check_Call) Return(_a0 []health.ConnectorHealth) *HealthService_Check_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HealthService_Check_Call) RunAndReturn(run func() []health.ConnectorHealth) *HealthService_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *HealthService) Configure(config health.Config) {
+	_m.Called(config)
+}
+
+// HealthService_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type HealthService_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config health.Config
+func (_e *HealthService_Expecter) Configure(config interface{}) *HealthService_Configure_Call {
+	return &HealthService_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *HealthService_Configure_Call) Run(run func(config health.Config)) *HealthService_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(health.Config))
+	})
+	return _c
+}
+
+func (_c *HealthService_Configure_Call) Return() *HealthService_Configure_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *HealthService_Configure_Call) RunAndReturn(run func(health.Config)) *HealthService_Configure_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Handler provides a mock function with no fields
+func (_m *HealthService) Handler() http.Handler {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handler")
+	}
+
+	var r0 http.Handler
+	if rf, ok := ret.Get(0).(func() http.Handler); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(http.Handler)
+		}
+	}
+
+	return r0
+}
+
+// HealthService_Handler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handler'
+type HealthService_Handler_Call struct {
+	*mock.Call
+}
+
+// Handler is a helper method to define mock.On call
+func (_e *HealthService_Expecter) Handler() *HealthService_Handler_Call {
+	return &HealthService_Handler_Call{Call: _e.mock.On("Handler")}
+}
+
+func (_c *HealthService_Handler_Call) Run(run func()) *HealthService_Handler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *HealthService_Handler_Call) Return(_a0 http.Handler) *HealthService_Handler_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HealthService_Handler_Call) RunAndReturn(run func() http.Handler) *HealthService_Handler_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Health provides a mock function with given fields: exchange
+func (_m *HealthService) Health(exchange connector.ExchangeName) (health.ConnectorHealth, bool) {
+	ret := _m.Called(exchange)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Health")
+	}
+
+	var r0 health.ConnectorHealth
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName) (health.ConnectorHealth, bool)); ok {
+		return rf(exchange)
+	}
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName) health.ConnectorHealth); ok {
+		r0 = rf(exchange)
+	} else {
+		r0 = ret.Get(0).(health.ConnectorHealth)
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.ExchangeName) bool); ok {
+		r1 = rf(exchange)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// HealthService_Health_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Health'
+type HealthService_Health_Call struct {
+	*mock.Call
+}
+
+// Health is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+func (_e *HealthService_Expecter) Health(exchange interface{}) *HealthService_Health_Call {
+	return &HealthService_Health_Call{Call: _e.mock.On("Health", exchange)}
+}
+
+func (_c *HealthService_Health_Call) Run(run func(exchange connector.ExchangeName)) *HealthService_Health_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName))
+	})
+	return _c
+}
+
+func (_c *HealthService_Health_Call) Return(_a0 health.ConnectorHealth, _a1 bool) *HealthService_Health_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *HealthService_Health_Call) RunAndReturn(run func(connector.ExchangeName) (health.ConnectorHealth, bool)) *HealthService_Health_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Snapshot provides a mock function with no fields
+func (_m *HealthService) Snapshot() []health.ConnectorHealth {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Snapshot")
+	}
+
+	var r0 []health.ConnectorHealth
+	if rf, ok := ret.Get(0).(func() []health.ConnectorHealth); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]health.ConnectorHealth)
+		}
+	}
+
+	return r0
+}
+
+// HealthService_Snapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Snapshot'
+type HealthService_Snapshot_Call struct {
+	*mock.Call
+}
+
+// Snapshot is a helper method to define mock.On call
+func (_e *HealthService_Expecter) Snapshot() *HealthService_Snapshot_Call {
+	return &HealthService_Snapshot_Call{Call: _e.mock.On("Snapshot")}
+}
+
+func (_c *HealthService_Snapshot_Call) Run(run func()) *HealthService_Snapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *HealthService_Snapshot_Call) Return(_a0 []health.ConnectorHealth) *HealthService_Snapshot_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HealthService_Snapshot_Call) RunAndReturn(run func() []health.ConnectorHealth) *HealthService_Snapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with no fields
+func (_m *HealthService) Start() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// HealthService_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type HealthService_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+func (_e *HealthService_Expecter) Start() *HealthService_Start_Call {
+	return &HealthService_Start_Call{Call: _e.mock.On("Start")}
+}
+
+func (_c *HealthService_Start_Call) Run(run func()) *HealthService_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *HealthService_Start_Call) Return(_a0 error) *HealthService_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HealthService_Start_Call) RunAndReturn(run func() error) *HealthService_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with no fields
+func (_m *HealthService) Stop() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// HealthService_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type HealthService_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *HealthService_Expecter) Stop() *HealthService_Stop_Call {
+	return &HealthService_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *HealthService_Stop_Call) Run(run func()) *HealthService_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *HealthService_Stop_Call) Return(_a0 error) *HealthService_Stop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HealthService_Stop_Call) RunAndReturn(run func() error) *HealthService_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewHealthService creates a new instance of HealthService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewHealthService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *HealthService {
+	mock := &HealthService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -659,6 +659,64 @@ func (_c *MarketDataService_GetSpotMetaAndAssetCtxs_Call) RunAndReturn(run func(
 	return _c
 }
 
+// GetSubAccounts provides a mock function with given fields: user
+func (_m *MarketDataService) GetSubAccounts(user string) ([]hyperliquid.SubAccount, error) {
+	ret := _m.Called(user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubAccounts")
+	}
+
+	var r0 []hyperliquid.SubAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]hyperliquid.SubAccount, error)); ok {
+		return rf(user)
+	}
+	if rf, ok := ret.Get(0).(func(string) []hyperliquid.SubAccount); ok {
+		r0 = rf(user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]hyperliquid.SubAccount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarketDataService_GetSubAccounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSubAccounts'
+type MarketDataService_GetSubAccounts_Call struct {
+	*mock.Call
+}
+
+// GetSubAccounts is a helper method to define mock.On call
+//   - user string
+func (_e *MarketDataService_Expecter) GetSubAccounts(user interface{}) *MarketDataService_GetSubAccounts_Call {
+	return &MarketDataService_GetSubAccounts_Call{Call: _e.mock.On("GetSubAccounts", user)}
+}
+
+func (_c *MarketDataService_GetSubAccounts_Call) Run(run func(user string)) *MarketDataService_GetSubAccounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MarketDataService_GetSubAccounts_Call) Return(_a0 []hyperliquid.SubAccount, _a1 error) *MarketDataService_GetSubAccounts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MarketDataService_GetSubAccounts_Call) RunAndReturn(run func(string) ([]hyperliquid.SubAccount, error)) *MarketDataService_GetSubAccounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetUserFills provides a mock function with given fields: user
 func (_m *MarketDataService) GetUserFills(user string) ([]hyperliquid.Fill, error) {
 	ret := _m.Called(user)
@@ -20,6 +20,53 @@ func (_m *TradingService) EXPECT() *TradingService_Expecter {
 	return &TradingService_Expecter{mock: &_m.Mock}
 }
 
+// ApproveBuilderFee provides a mock function with given fields: builder, maxFeeRate
+func (_m *TradingService) ApproveBuilderFee(builder string, maxFeeRate string) error {
+	ret := _m.Called(builder, maxFeeRate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveBuilderFee")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(builder, maxFeeRate)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TradingService_ApproveBuilderFee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApproveBuilderFee'
+type TradingService_ApproveBuilderFee_Call struct {
+	*mock.Call
+}
+
+// ApproveBuilderFee is a helper method to define mock.On call
+//   - builder string
+//   - maxFeeRate string
+func (_e *TradingService_Expecter) ApproveBuilderFee(builder interface{}, maxFeeRate interface{}) *TradingService_ApproveBuilderFee_Call {
+	return &TradingService_ApproveBuilderFee_Call{Call: _e.mock.On("ApproveBuilderFee", builder, maxFeeRate)}
+}
+
+func (_c *TradingService_ApproveBuilderFee_Call) Run(run func(builder string, maxFeeRate string)) *TradingService_ApproveBuilderFee_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TradingService_ApproveBuilderFee_Call) Return(_a0 error) *TradingService_ApproveBuilderFee_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TradingService_ApproveBuilderFee_Call) RunAndReturn(run func(string, string) error) *TradingService_ApproveBuilderFee_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CancelOrderByCustomRef provides a mock function with given fields: coin, customRef
 func (_m *TradingService) CancelOrderByCustomRef(coin string, customRef string) (*hyperliquid.APIResponse[hyperliquid.CancelOrderResponse], error) {
 	ret := _m.Called(coin, customRef)
@@ -138,6 +185,64 @@ func (_c *TradingService_CancelOrderByID_Call) RunAndReturn(run func(string, int
 	return _c
 }
 
+// CancelOrdersByID provides a mock function with given fields: requests
+func (_m *TradingService) CancelOrdersByID(requests []hyperliquid.CancelOrderRequest) (*hyperliquid.APIResponse[hyperliquid.CancelOrderResponse], error) {
+	ret := _m.Called(requests)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelOrdersByID")
+	}
+
+	var r0 *hyperliquid.APIResponse[hyperliquid.CancelOrderResponse]
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]hyperliquid.CancelOrderRequest) (*hyperliquid.APIResponse[hyperliquid.CancelOrderResponse], error)); ok {
+		return rf(requests)
+	}
+	if rf, ok := ret.Get(0).(func([]hyperliquid.CancelOrderRequest) *hyperliquid.APIResponse[hyperliquid.CancelOrderResponse]); ok {
+		r0 = rf(requests)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*hyperliquid.APIResponse[hyperliquid.CancelOrderResponse])
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]hyperliquid.CancelOrderRequest) error); ok {
+		r1 = rf(requests)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TradingService_CancelOrdersByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelOrdersByID'
+type TradingService_CancelOrdersByID_Call struct {
+	*mock.Call
+}
+
+// CancelOrdersByID is a helper method to define mock.On call
+//   - requests []hyperliquid.CancelOrderRequest
+func (_e *TradingService_Expecter) CancelOrdersByID(requests interface{}) *TradingService_CancelOrdersByID_Call {
+	return &TradingService_CancelOrdersByID_Call{Call: _e.mock.On("CancelOrdersByID", requests)}
+}
+
+func (_c *TradingService_CancelOrdersByID_Call) Run(run func(requests []hyperliquid.CancelOrderRequest)) *TradingService_CancelOrdersByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]hyperliquid.CancelOrderRequest))
+	})
+	return _c
+}
+
+func (_c *TradingService_CancelOrdersByID_Call) Return(_a0 *hyperliquid.APIResponse[hyperliquid.CancelOrderResponse], _a1 error) *TradingService_CancelOrdersByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TradingService_CancelOrdersByID_Call) RunAndReturn(run func([]hyperliquid.CancelOrderRequest) (*hyperliquid.APIResponse[hyperliquid.CancelOrderResponse], error)) *TradingService_CancelOrdersByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CloseEntirePosition provides a mock function with given fields: coin, slippage
 func (_m *TradingService) CloseEntirePosition(coin string, slippage float64) (hyperliquid.OrderStatus, error) {
 	ret := _m.Called(coin, slippage)
@@ -953,6 +1058,148 @@ func (_c *TradingService_PlaceSellTakeProfit_Call) RunAndReturn(run func(string,
 	return _c
 }
 
+// PlaceTriggerOrder provides a mock function with given fields: coin, size, triggerPrice, limitPrice, isBuy, isMarket, tpsl, reduceOnly
+func (_m *TradingService) PlaceTriggerOrder(coin string, size float64, triggerPrice float64, limitPrice float64, isBuy bool, isMarket bool, tpsl string, reduceOnly bool) (hyperliquid.OrderStatus, error) {
+	ret := _m.Called(coin, size, triggerPrice, limitPrice, isBuy, isMarket, tpsl, reduceOnly)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlaceTriggerOrder")
+	}
+
+	var r0 hyperliquid.OrderStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, float64, float64, float64, bool, bool, string, bool) (hyperliquid.OrderStatus, error)); ok {
+		return rf(coin, size, triggerPrice, limitPrice, isBuy, isMarket, tpsl, reduceOnly)
+	}
+	if rf, ok := ret.Get(0).(func(string, float64, float64, float64, bool, bool, string, bool) hyperliquid.OrderStatus); ok {
+		r0 = rf(coin, size, triggerPrice, limitPrice, isBuy, isMarket, tpsl, reduceOnly)
+	} else {
+		r0 = ret.Get(0).(hyperliquid.OrderStatus)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, float64, float64, float64, bool, bool, string, bool) error); ok {
+		r1 = rf(coin, size, triggerPrice, limitPrice, isBuy, isMarket, tpsl, reduceOnly)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TradingService_PlaceTriggerOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PlaceTriggerOrder'
+type TradingService_PlaceTriggerOrder_Call struct {
+	*mock.Call
+}
+
+// PlaceTriggerOrder is a helper method to define mock.On call
+//   - coin string
+//   - size float64
+//   - triggerPrice float64
+//   - limitPrice float64
+//   - isBuy bool
+//   - isMarket bool
+//   - tpsl string
+//   - reduceOnly bool
+func (_e *TradingService_Expecter) PlaceTriggerOrder(coin interface{}, size interface{}, triggerPrice interface{}, limitPrice interface{}, isBuy interface{}, isMarket interface{}, tpsl interface{}, reduceOnly interface{}) *TradingService_PlaceTriggerOrder_Call {
+	return &TradingService_PlaceTriggerOrder_Call{Call: _e.mock.On("PlaceTriggerOrder", coin, size, triggerPrice, limitPrice, isBuy, isMarket, tpsl, reduceOnly)}
+}
+
+func (_c *TradingService_PlaceTriggerOrder_Call) Run(run func(coin string, size float64, triggerPrice float64, limitPrice float64, isBuy bool, isMarket bool, tpsl string, reduceOnly bool)) *TradingService_PlaceTriggerOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(float64), args[2].(float64), args[3].(float64), args[4].(bool), args[5].(bool), args[6].(string), args[7].(bool))
+	})
+	return _c
+}
+
+func (_c *TradingService_PlaceTriggerOrder_Call) Return(_a0 hyperliquid.OrderStatus, _a1 error) *TradingService_PlaceTriggerOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TradingService_PlaceTriggerOrder_Call) RunAndReturn(run func(string, float64, float64, float64, bool, bool, string, bool) (hyperliquid.OrderStatus, error)) *TradingService_PlaceTriggerOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetBuilder provides a mock function with given fields: builder
+func (_m *TradingService) SetBuilder(builder *hyperliquid.BuilderInfo) {
+	_m.Called(builder)
+}
+
+// TradingService_SetBuilder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetBuilder'
+type TradingService_SetBuilder_Call struct {
+	*mock.Call
+}
+
+// SetBuilder is a helper method to define mock.On call
+//   - builder *hyperliquid.BuilderInfo
+func (_e *TradingService_Expecter) SetBuilder(builder interface{}) *TradingService_SetBuilder_Call {
+	return &TradingService_SetBuilder_Call{Call: _e.mock.On("SetBuilder", builder)}
+}
+
+func (_c *TradingService_SetBuilder_Call) Run(run func(builder *hyperliquid.BuilderInfo)) *TradingService_SetBuilder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*hyperliquid.BuilderInfo))
+	})
+	return _c
+}
+
+func (_c *TradingService_SetBuilder_Call) Return() *TradingService_SetBuilder_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *TradingService_SetBuilder_Call) RunAndReturn(run func(*hyperliquid.BuilderInfo)) *TradingService_SetBuilder_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SetReferrer provides a mock function with given fields: code
+func (_m *TradingService) SetReferrer(code string) error {
+	ret := _m.Called(code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetReferrer")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(code)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TradingService_SetReferrer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetReferrer'
+type TradingService_SetReferrer_Call struct {
+	*mock.Call
+}
+
+// SetReferrer is a helper method to define mock.On call
+//   - code string
+func (_e *TradingService_Expecter) SetReferrer(code interface{}) *TradingService_SetReferrer_Call {
+	return &TradingService_SetReferrer_Call{Call: _e.mock.On("SetReferrer", code)}
+}
+
+func (_c *TradingService_SetReferrer_Call) Run(run func(code string)) *TradingService_SetReferrer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *TradingService_SetReferrer_Call) Return(_a0 error) *TradingService_SetReferrer_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TradingService_SetReferrer_Call) RunAndReturn(run func(string) error) *TradingService_SetReferrer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewTradingService creates a new instance of TradingService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewTradingService(t interface {
@@ -80,6 +80,64 @@ func (_c *MessageParser_ParseAccountBalance_Call) RunAndReturn(run func(hyperliq
 	return _c
 }
 
+// ParseFills provides a mock function with given fields: msg
+func (_m *MessageParser) ParseFills(msg hyperliquid.WSMessage) ([]websocket.FillMessage, error) {
+	ret := _m.Called(msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ParseFills")
+	}
+
+	var r0 []websocket.FillMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(hyperliquid.WSMessage) ([]websocket.FillMessage, error)); ok {
+		return rf(msg)
+	}
+	if rf, ok := ret.Get(0).(func(hyperliquid.WSMessage) []websocket.FillMessage); ok {
+		r0 = rf(msg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]websocket.FillMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(hyperliquid.WSMessage) error); ok {
+		r1 = rf(msg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MessageParser_ParseFills_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ParseFills'
+type MessageParser_ParseFills_Call struct {
+	*mock.Call
+}
+
+// ParseFills is a helper method to define mock.On call
+//   - msg hyperliquid.WSMessage
+func (_e *MessageParser_Expecter) ParseFills(msg interface{}) *MessageParser_ParseFills_Call {
+	return &MessageParser_ParseFills_Call{Call: _e.mock.On("ParseFills", msg)}
+}
+
+func (_c *MessageParser_ParseFills_Call) Run(run func(msg hyperliquid.WSMessage)) *MessageParser_ParseFills_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(hyperliquid.WSMessage))
+	})
+	return _c
+}
+
+func (_c *MessageParser_ParseFills_Call) Return(_a0 []websocket.FillMessage, _a1 error) *MessageParser_ParseFills_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MessageParser_ParseFills_Call) RunAndReturn(run func(hyperliquid.WSMessage) ([]websocket.FillMessage, error)) *MessageParser_ParseFills_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ParseKline provides a mock function with given fields: msg
 func (_m *MessageParser) ParseKline(msg hyperliquid.WSMessage) (*websocket.KlineMessage, error) {
 	ret := _m.Called(msg)
@@ -157,6 +157,53 @@ func (_c *RealTimeService_GetErrorChannel_Call) RunAndReturn(run func() <-chan e
 	return _c
 }
 
+// GetMetrics provides a mock function with no fields
+func (_m *RealTimeService) GetMetrics() map[string]interface{} {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMetrics")
+	}
+
+	var r0 map[string]interface{}
+	if rf, ok := ret.Get(0).(func() map[string]interface{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]interface{})
+		}
+	}
+
+	return r0
+}
+
+// RealTimeService_GetMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMetrics'
+type RealTimeService_GetMetrics_Call struct {
+	*mock.Call
+}
+
+// GetMetrics is a helper method to define mock.On call
+func (_e *RealTimeService_Expecter) GetMetrics() *RealTimeService_GetMetrics_Call {
+	return &RealTimeService_GetMetrics_Call{Call: _e.mock.On("GetMetrics")}
+}
+
+func (_c *RealTimeService_GetMetrics_Call) Run(run func()) *RealTimeService_GetMetrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RealTimeService_GetMetrics_Call) Return(_a0 map[string]interface{}) *RealTimeService_GetMetrics_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RealTimeService_GetMetrics_Call) RunAndReturn(run func() map[string]interface{}) *RealTimeService_GetMetrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IsConnected provides a mock function with no fields
 func (_m *RealTimeService) IsConnected() bool {
 	ret := _m.Called()
@@ -259,6 +306,63 @@ func (_c *RealTimeService_SubscribeToAccountBalance_Call) RunAndReturn(run func(
 	return _c
 }
 
+// SubscribeToFills provides a mock function with given fields: user, callback
+func (_m *RealTimeService) SubscribeToFills(user string, callback func([]websocket.FillMessage)) (int, error) {
+	ret := _m.Called(user, callback)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribeToFills")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, func([]websocket.FillMessage)) (int, error)); ok {
+		return rf(user, callback)
+	}
+	if rf, ok := ret.Get(0).(func(string, func([]websocket.FillMessage)) int); ok {
+		r0 = rf(user, callback)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, func([]websocket.FillMessage)) error); ok {
+		r1 = rf(user, callback)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RealTimeService_SubscribeToFills_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeToFills'
+type RealTimeService_SubscribeToFills_Call struct {
+	*mock.Call
+}
+
+// SubscribeToFills is a helper method to define mock.On call
+//   - user string
+//   - callback func([]websocket.FillMessage)
+func (_e *RealTimeService_Expecter) SubscribeToFills(user interface{}, callback interface{}) *RealTimeService_SubscribeToFills_Call {
+	return &RealTimeService_SubscribeToFills_Call{Call: _e.mock.On("SubscribeToFills", user, callback)}
+}
+
+func (_c *RealTimeService_SubscribeToFills_Call) Run(run func(user string, callback func([]websocket.FillMessage))) *RealTimeService_SubscribeToFills_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(func([]websocket.FillMessage)))
+	})
+	return _c
+}
+
+func (_c *RealTimeService_SubscribeToFills_Call) Return(_a0 int, _a1 error) *RealTimeService_SubscribeToFills_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RealTimeService_SubscribeToFills_Call) RunAndReturn(run func(string, func([]websocket.FillMessage)) (int, error)) *RealTimeService_SubscribeToFills_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SubscribeToKlines provides a mock function with given fields: coin, interval, callback
 func (_m *RealTimeService) SubscribeToKlines(coin string, interval string, callback func(*websocket.KlineMessage)) (int, error) {
 	ret := _m.Called(coin, interval, callback)
@@ -488,6 +592,53 @@ func (_c *RealTimeService_SubscribeToTrades_Call) RunAndReturn(run func(string,
 	return _c
 }
 
+// UnsubscribeFromFills provides a mock function with given fields: user, subscriptionID
+func (_m *RealTimeService) UnsubscribeFromFills(user string, subscriptionID int) error {
+	ret := _m.Called(user, subscriptionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnsubscribeFromFills")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int) error); ok {
+		r0 = rf(user, subscriptionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RealTimeService_UnsubscribeFromFills_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnsubscribeFromFills'
+type RealTimeService_UnsubscribeFromFills_Call struct {
+	*mock.Call
+}
+
+// UnsubscribeFromFills is a helper method to define mock.On call
+//   - user string
+//   - subscriptionID int
+func (_e *RealTimeService_Expecter) UnsubscribeFromFills(user interface{}, subscriptionID interface{}) *RealTimeService_UnsubscribeFromFills_Call {
+	return &RealTimeService_UnsubscribeFromFills_Call{Call: _e.mock.On("UnsubscribeFromFills", user, subscriptionID)}
+}
+
+func (_c *RealTimeService_UnsubscribeFromFills_Call) Run(run func(user string, subscriptionID int)) *RealTimeService_UnsubscribeFromFills_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *RealTimeService_UnsubscribeFromFills_Call) Return(_a0 error) *RealTimeService_UnsubscribeFromFills_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RealTimeService_UnsubscribeFromFills_Call) RunAndReturn(run func(string, int) error) *RealTimeService_UnsubscribeFromFills_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UnsubscribeFromKlines provides a mock function with given fields: coin, interval, subscriptionID
 func (_m *RealTimeService) UnsubscribeFromKlines(coin string, interval string, subscriptionID int) error {
 	ret := _m.Called(coin, interval, subscriptionID)
@@ -0,0 +1,360 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package instruments
+
+import (
+	connector "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	instruments "github.com/backtesting-org/live-trading/pkg/connectors/instruments"
+
+	mock "github.com/stretchr/testify/mock"
+
+	types "github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+// InstrumentMonitor is an autogenerated mock type for the InstrumentMonitor type
+type InstrumentMonitor struct {
+	mock.Mock
+}
+
+type InstrumentMonitor_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *InstrumentMonitor) EXPECT() *InstrumentMonitor_Expecter {
+	return &InstrumentMonitor_Expecter{mock: &_m.Mock}
+}
+
+// Alerts provides a mock function with no fields
+func (_m *InstrumentMonitor) Alerts() <-chan instruments.Alert {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Alerts")
+	}
+
+	var r0 <-chan instruments.Alert
+	if rf, ok := ret.Get(0).(func() <-chan instruments.Alert); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan instruments.Alert)
+		}
+	}
+
+	return r0
+}
+
+// InstrumentMonitor_Alerts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Alerts'
+type InstrumentMonitor_Alerts_Call struct {
+	*mock.Call
+}
+
+// Alerts is a helper method to define mock.On call
+func (_e *InstrumentMonitor_Expecter) Alerts() *InstrumentMonitor_Alerts_Call {
+	return &InstrumentMonitor_Alerts_Call{Call: _e.mock.On("Alerts")}
+}
+
+func (_c *InstrumentMonitor_Alerts_Call) Run(run func()) *InstrumentMonitor_Alerts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *InstrumentMonitor_Alerts_Call) Return(_a0 <-chan instruments.Alert) *InstrumentMonitor_Alerts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InstrumentMonitor_Alerts_Call) RunAndReturn(run func() <-chan instruments.Alert) *InstrumentMonitor_Alerts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *InstrumentMonitor) Configure(config instruments.Config) {
+	_m.Called(config)
+}
+
+// InstrumentMonitor_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type InstrumentMonitor_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config instruments.Config
+func (_e *InstrumentMonitor_Expecter) Configure(config interface{}) *InstrumentMonitor_Configure_Call {
+	return &InstrumentMonitor_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *InstrumentMonitor_Configure_Call) Run(run func(config instruments.Config)) *InstrumentMonitor_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(instruments.Config))
+	})
+	return _c
+}
+
+func (_c *InstrumentMonitor_Configure_Call) Return() *InstrumentMonitor_Configure_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *InstrumentMonitor_Configure_Call) RunAndReturn(run func(instruments.Config)) *InstrumentMonitor_Configure_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Delisting provides a mock function with no fields
+func (_m *InstrumentMonitor) Delisting() map[connector.ExchangeName][]types.InstrumentStatus {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delisting")
+	}
+
+	var r0 map[connector.ExchangeName][]types.InstrumentStatus
+	if rf, ok := ret.Get(0).(func() map[connector.ExchangeName][]types.InstrumentStatus); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[connector.ExchangeName][]types.InstrumentStatus)
+		}
+	}
+
+	return r0
+}
+
+// InstrumentMonitor_Delisting_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delisting'
+type InstrumentMonitor_Delisting_Call struct {
+	*mock.Call
+}
+
+// Delisting is a helper method to define mock.On call
+func (_e *InstrumentMonitor_Expecter) Delisting() *InstrumentMonitor_Delisting_Call {
+	return &InstrumentMonitor_Delisting_Call{Call: _e.mock.On("Delisting")}
+}
+
+func (_c *InstrumentMonitor_Delisting_Call) Run(run func()) *InstrumentMonitor_Delisting_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *InstrumentMonitor_Delisting_Call) Return(_a0 map[connector.ExchangeName][]types.InstrumentStatus) *InstrumentMonitor_Delisting_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InstrumentMonitor_Delisting_Call) RunAndReturn(run func() map[connector.ExchangeName][]types.InstrumentStatus) *InstrumentMonitor_Delisting_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Poll provides a mock function with no fields
+func (_m *InstrumentMonitor) Poll() []instruments.Alert {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Poll")
+	}
+
+	var r0 []instruments.Alert
+	if rf, ok := ret.Get(0).(func() []instruments.Alert); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]instruments.Alert)
+		}
+	}
+
+	return r0
+}
+
+// InstrumentMonitor_Poll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Poll'
+type InstrumentMonitor_Poll_Call struct {
+	*mock.Call
+}
+
+// Poll is a helper method to define mock.On call
+func (_e *InstrumentMonitor_Expecter) Poll() *InstrumentMonitor_Poll_Call {
+	return &InstrumentMonitor_Poll_Call{Call: _e.mock.On("Poll")}
+}
+
+func (_c *InstrumentMonitor_Poll_Call) Run(run func()) *InstrumentMonitor_Poll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *InstrumentMonitor_Poll_Call) Return(_a0 []instruments.Alert) *InstrumentMonitor_Poll_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InstrumentMonitor_Poll_Call) RunAndReturn(run func() []instruments.Alert) *InstrumentMonitor_Poll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with no fields
+func (_m *InstrumentMonitor) Start() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InstrumentMonitor_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type InstrumentMonitor_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+func (_e *InstrumentMonitor_Expecter) Start() *InstrumentMonitor_Start_Call {
+	return &InstrumentMonitor_Start_Call{Call: _e.mock.On("Start")}
+}
+
+func (_c *InstrumentMonitor_Start_Call) Run(run func()) *InstrumentMonitor_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *InstrumentMonitor_Start_Call) Return(_a0 error) *InstrumentMonitor_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InstrumentMonitor_Start_Call) RunAndReturn(run func() error) *InstrumentMonitor_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Status provides a mock function with given fields: exchange, symbol
+func (_m *InstrumentMonitor) Status(exchange connector.ExchangeName, symbol string) (types.InstrumentStatus, bool) {
+	ret := _m.Called(exchange, symbol)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Status")
+	}
+
+	var r0 types.InstrumentStatus
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, string) (types.InstrumentStatus, bool)); ok {
+		return rf(exchange, symbol)
+	}
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, string) types.InstrumentStatus); ok {
+		r0 = rf(exchange, symbol)
+	} else {
+		r0 = ret.Get(0).(types.InstrumentStatus)
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.ExchangeName, string) bool); ok {
+		r1 = rf(exchange, symbol)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// InstrumentMonitor_Status_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Status'
+type InstrumentMonitor_Status_Call struct {
+	*mock.Call
+}
+
+// Status is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - symbol string
+func (_e *InstrumentMonitor_Expecter) Status(exchange interface{}, symbol interface{}) *InstrumentMonitor_Status_Call {
+	return &InstrumentMonitor_Status_Call{Call: _e.mock.On("Status", exchange, symbol)}
+}
+
+func (_c *InstrumentMonitor_Status_Call) Run(run func(exchange connector.ExchangeName, symbol string)) *InstrumentMonitor_Status_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *InstrumentMonitor_Status_Call) Return(_a0 types.InstrumentStatus, _a1 bool) *InstrumentMonitor_Status_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InstrumentMonitor_Status_Call) RunAndReturn(run func(connector.ExchangeName, string) (types.InstrumentStatus, bool)) *InstrumentMonitor_Status_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with no fields
+func (_m *InstrumentMonitor) Stop() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InstrumentMonitor_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type InstrumentMonitor_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *InstrumentMonitor_Expecter) Stop() *InstrumentMonitor_Stop_Call {
+	return &InstrumentMonitor_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *InstrumentMonitor_Stop_Call) Run(run func()) *InstrumentMonitor_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *InstrumentMonitor_Stop_Call) Return(_a0 error) *InstrumentMonitor_Stop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InstrumentMonitor_Stop_Call) RunAndReturn(run func() error) *InstrumentMonitor_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewInstrumentMonitor creates a new instance of InstrumentMonitor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewInstrumentMonitor(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *InstrumentMonitor {
+	mock := &InstrumentMonitor{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,462 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package journal
+
+import (
+	io "io"
+	http "net/http"
+
+	journal "github.com/backtesting-org/live-trading/pkg/connectors/journal"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OrderJournal is an autogenerated mock type for the OrderJournal type
+type OrderJournal struct {
+	mock.Mock
+}
+
+type OrderJournal_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OrderJournal) EXPECT() *OrderJournal_Expecter {
+	return &OrderJournal_Expecter{mock: &_m.Mock}
+}
+
+// Close provides a mock function with no fields
+func (_m *OrderJournal) Close() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderJournal_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type OrderJournal_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *OrderJournal_Expecter) Close() *OrderJournal_Close_Call {
+	return &OrderJournal_Close_Call{Call: _e.mock.On("Close")}
+}
+
+func (_c *OrderJournal_Close_Call) Run(run func()) *OrderJournal_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OrderJournal_Close_Call) Return(_a0 error) *OrderJournal_Close_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderJournal_Close_Call) RunAndReturn(run func() error) *OrderJournal_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *OrderJournal) Configure(config journal.Config) error {
+	ret := _m.Called(config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Configure")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(journal.Config) error); ok {
+		r0 = rf(config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderJournal_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type OrderJournal_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config journal.Config
+func (_e *OrderJournal_Expecter) Configure(config interface{}) *OrderJournal_Configure_Call {
+	return &OrderJournal_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *OrderJournal_Configure_Call) Run(run func(config journal.Config)) *OrderJournal_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(journal.Config))
+	})
+	return _c
+}
+
+func (_c *OrderJournal_Configure_Call) Return(_a0 error) *OrderJournal_Configure_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderJournal_Configure_Call) RunAndReturn(run func(journal.Config) error) *OrderJournal_Configure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Export provides a mock function with given fields: orderID, w
+func (_m *OrderJournal) Export(orderID string, w io.Writer) error {
+	ret := _m.Called(orderID, w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Export")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Writer) error); ok {
+		r0 = rf(orderID, w)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderJournal_Export_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Export'
+type OrderJournal_Export_Call struct {
+	*mock.Call
+}
+
+// Export is a helper method to define mock.On call
+//   - orderID string
+//   - w io.Writer
+func (_e *OrderJournal_Expecter) Export(orderID interface{}, w interface{}) *OrderJournal_Export_Call {
+	return &OrderJournal_Export_Call{Call: _e.mock.On("Export", orderID, w)}
+}
+
+func (_c *OrderJournal_Export_Call) Run(run func(orderID string, w io.Writer)) *OrderJournal_Export_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *OrderJournal_Export_Call) Return(_a0 error) *OrderJournal_Export_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderJournal_Export_Call) RunAndReturn(run func(string, io.Writer) error) *OrderJournal_Export_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Handler provides a mock function with no fields
+func (_m *OrderJournal) Handler() http.Handler {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handler")
+	}
+
+	var r0 http.Handler
+	if rf, ok := ret.Get(0).(func() http.Handler); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(http.Handler)
+		}
+	}
+
+	return r0
+}
+
+// OrderJournal_Handler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handler'
+type OrderJournal_Handler_Call struct {
+	*mock.Call
+}
+
+// Handler is a helper method to define mock.On call
+func (_e *OrderJournal_Expecter) Handler() *OrderJournal_Handler_Call {
+	return &OrderJournal_Handler_Call{Call: _e.mock.On("Handler")}
+}
+
+func (_c *OrderJournal_Handler_Call) Run(run func()) *OrderJournal_Handler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OrderJournal_Handler_Call) Return(_a0 http.Handler) *OrderJournal_Handler_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderJournal_Handler_Call) RunAndReturn(run func() http.Handler) *OrderJournal_Handler_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Prune provides a mock function with no fields
+func (_m *OrderJournal) Prune() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Prune")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderJournal_Prune_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Prune'
+type OrderJournal_Prune_Call struct {
+	*mock.Call
+}
+
+// Prune is a helper method to define mock.On call
+func (_e *OrderJournal_Expecter) Prune() *OrderJournal_Prune_Call {
+	return &OrderJournal_Prune_Call{Call: _e.mock.On("Prune")}
+}
+
+func (_c *OrderJournal_Prune_Call) Run(run func()) *OrderJournal_Prune_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OrderJournal_Prune_Call) Return(_a0 error) *OrderJournal_Prune_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderJournal_Prune_Call) RunAndReturn(run func() error) *OrderJournal_Prune_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Record provides a mock function with given fields: transition
+func (_m *OrderJournal) Record(transition journal.OrderTransition) error {
+	ret := _m.Called(transition)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Record")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(journal.OrderTransition) error); ok {
+		r0 = rf(transition)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderJournal_Record_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Record'
+type OrderJournal_Record_Call struct {
+	*mock.Call
+}
+
+// Record is a helper method to define mock.On call
+//   - transition journal.OrderTransition
+func (_e *OrderJournal_Expecter) Record(transition interface{}) *OrderJournal_Record_Call {
+	return &OrderJournal_Record_Call{Call: _e.mock.On("Record", transition)}
+}
+
+func (_c *OrderJournal_Record_Call) Run(run func(transition journal.OrderTransition)) *OrderJournal_Record_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(journal.OrderTransition))
+	})
+	return _c
+}
+
+func (_c *OrderJournal_Record_Call) Return(_a0 error) *OrderJournal_Record_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderJournal_Record_Call) RunAndReturn(run func(journal.OrderTransition) error) *OrderJournal_Record_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with no fields
+func (_m *OrderJournal) Start() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderJournal_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type OrderJournal_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+func (_e *OrderJournal_Expecter) Start() *OrderJournal_Start_Call {
+	return &OrderJournal_Start_Call{Call: _e.mock.On("Start")}
+}
+
+func (_c *OrderJournal_Start_Call) Run(run func()) *OrderJournal_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OrderJournal_Start_Call) Return(_a0 error) *OrderJournal_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderJournal_Start_Call) RunAndReturn(run func() error) *OrderJournal_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with no fields
+func (_m *OrderJournal) Stop() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderJournal_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type OrderJournal_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *OrderJournal_Expecter) Stop() *OrderJournal_Stop_Call {
+	return &OrderJournal_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *OrderJournal_Stop_Call) Run(run func()) *OrderJournal_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OrderJournal_Stop_Call) Return(_a0 error) *OrderJournal_Stop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderJournal_Stop_Call) RunAndReturn(run func() error) *OrderJournal_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Transitions provides a mock function with given fields: orderID
+func (_m *OrderJournal) Transitions(orderID string) ([]journal.OrderTransition, error) {
+	ret := _m.Called(orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Transitions")
+	}
+
+	var r0 []journal.OrderTransition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]journal.OrderTransition, error)); ok {
+		return rf(orderID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []journal.OrderTransition); ok {
+		r0 = rf(orderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]journal.OrderTransition)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(orderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderJournal_Transitions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Transitions'
+type OrderJournal_Transitions_Call struct {
+	*mock.Call
+}
+
+// Transitions is a helper method to define mock.On call
+//   - orderID string
+func (_e *OrderJournal_Expecter) Transitions(orderID interface{}) *OrderJournal_Transitions_Call {
+	return &OrderJournal_Transitions_Call{Call: _e.mock.On("Transitions", orderID)}
+}
+
+func (_c *OrderJournal_Transitions_Call) Run(run func(orderID string)) *OrderJournal_Transitions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *OrderJournal_Transitions_Call) Return(_a0 []journal.OrderTransition, _a1 error) *OrderJournal_Transitions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderJournal_Transitions_Call) RunAndReturn(run func(string) ([]journal.OrderTransition, error)) *OrderJournal_Transitions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOrderJournal creates a new instance of OrderJournal. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOrderJournal(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OrderJournal {
+	mock := &OrderJournal{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
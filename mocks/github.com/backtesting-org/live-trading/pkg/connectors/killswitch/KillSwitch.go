@@ -0,0 +1,528 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package killswitch
+
+import (
+	http "net/http"
+
+	execution "github.com/backtesting-org/kronos-sdk/pkg/types/execution"
+
+	killswitch "github.com/backtesting-org/live-trading/pkg/connectors/killswitch"
+
+	mock "github.com/stretchr/testify/mock"
+
+	numerical "github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+)
+
+// KillSwitch is an autogenerated mock type for the KillSwitch type
+type KillSwitch struct {
+	mock.Mock
+}
+
+type KillSwitch_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *KillSwitch) EXPECT() *KillSwitch_Expecter {
+	return &KillSwitch_Expecter{mock: &_m.Mock}
+}
+
+// CheckDrawdown provides a mock function with no fields
+func (_m *KillSwitch) CheckDrawdown() (numerical.Decimal, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckDrawdown")
+	}
+
+	var r0 numerical.Decimal
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (numerical.Decimal, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() numerical.Decimal); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(numerical.Decimal)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KillSwitch_CheckDrawdown_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckDrawdown'
+type KillSwitch_CheckDrawdown_Call struct {
+	*mock.Call
+}
+
+// CheckDrawdown is a helper method to define mock.On call
+func (_e *KillSwitch_Expecter) CheckDrawdown() *KillSwitch_CheckDrawdown_Call {
+	return &KillSwitch_CheckDrawdown_Call{Call: _e.mock.On("CheckDrawdown")}
+}
+
+func (_c *KillSwitch_CheckDrawdown_Call) Run(run func()) *KillSwitch_CheckDrawdown_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *KillSwitch_CheckDrawdown_Call) Return(_a0 numerical.Decimal, _a1 error) *KillSwitch_CheckDrawdown_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KillSwitch_CheckDrawdown_Call) RunAndReturn(run func() (numerical.Decimal, error)) *KillSwitch_CheckDrawdown_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *KillSwitch) Configure(config killswitch.Config) {
+	_m.Called(config)
+}
+
+// KillSwitch_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type KillSwitch_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config killswitch.Config
+func (_e *KillSwitch_Expecter) Configure(config interface{}) *KillSwitch_Configure_Call {
+	return &KillSwitch_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *KillSwitch_Configure_Call) Run(run func(config killswitch.Config)) *KillSwitch_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(killswitch.Config))
+	})
+	return _c
+}
+
+func (_c *KillSwitch_Configure_Call) Return() *KillSwitch_Configure_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *KillSwitch_Configure_Call) RunAndReturn(run func(killswitch.Config)) *KillSwitch_Configure_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Engaged provides a mock function with no fields
+func (_m *KillSwitch) Engaged() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Engaged")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// KillSwitch_Engaged_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Engaged'
+type KillSwitch_Engaged_Call struct {
+	*mock.Call
+}
+
+// Engaged is a helper method to define mock.On call
+func (_e *KillSwitch_Expecter) Engaged() *KillSwitch_Engaged_Call {
+	return &KillSwitch_Engaged_Call{Call: _e.mock.On("Engaged")}
+}
+
+func (_c *KillSwitch_Engaged_Call) Run(run func()) *KillSwitch_Engaged_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *KillSwitch_Engaged_Call) Return(_a0 bool) *KillSwitch_Engaged_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *KillSwitch_Engaged_Call) RunAndReturn(run func() bool) *KillSwitch_Engaged_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Handler provides a mock function with no fields
+func (_m *KillSwitch) Handler() http.Handler {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handler")
+	}
+
+	var r0 http.Handler
+	if rf, ok := ret.Get(0).(func() http.Handler); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(http.Handler)
+		}
+	}
+
+	return r0
+}
+
+// KillSwitch_Handler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handler'
+type KillSwitch_Handler_Call struct {
+	*mock.Call
+}
+
+// Handler is a helper method to define mock.On call
+func (_e *KillSwitch_Expecter) Handler() *KillSwitch_Handler_Call {
+	return &KillSwitch_Handler_Call{Call: _e.mock.On("Handler")}
+}
+
+func (_c *KillSwitch_Handler_Call) Run(run func()) *KillSwitch_Handler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *KillSwitch_Handler_Call) Return(_a0 http.Handler) *KillSwitch_Handler_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *KillSwitch_Handler_Call) RunAndReturn(run func() http.Handler) *KillSwitch_Handler_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Hook provides a mock function with no fields
+func (_m *KillSwitch) Hook() execution.ExecutionHook {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Hook")
+	}
+
+	var r0 execution.ExecutionHook
+	if rf, ok := ret.Get(0).(func() execution.ExecutionHook); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(execution.ExecutionHook)
+		}
+	}
+
+	return r0
+}
+
+// KillSwitch_Hook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Hook'
+type KillSwitch_Hook_Call struct {
+	*mock.Call
+}
+
+// Hook is a helper method to define mock.On call
+func (_e *KillSwitch_Expecter) Hook() *KillSwitch_Hook_Call {
+	return &KillSwitch_Hook_Call{Call: _e.mock.On("Hook")}
+}
+
+func (_c *KillSwitch_Hook_Call) Run(run func()) *KillSwitch_Hook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *KillSwitch_Hook_Call) Return(_a0 execution.ExecutionHook) *KillSwitch_Hook_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *KillSwitch_Hook_Call) RunAndReturn(run func() execution.ExecutionHook) *KillSwitch_Hook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reset provides a mock function with no fields
+func (_m *KillSwitch) Reset() {
+	_m.Called()
+}
+
+// KillSwitch_Reset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reset'
+type KillSwitch_Reset_Call struct {
+	*mock.Call
+}
+
+// Reset is a helper method to define mock.On call
+func (_e *KillSwitch_Expecter) Reset() *KillSwitch_Reset_Call {
+	return &KillSwitch_Reset_Call{Call: _e.mock.On("Reset")}
+}
+
+func (_c *KillSwitch_Reset_Call) Run(run func()) *KillSwitch_Reset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *KillSwitch_Reset_Call) Return() *KillSwitch_Reset_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *KillSwitch_Reset_Call) RunAndReturn(run func()) *KillSwitch_Reset_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SetHaltHandler provides a mock function with given fields: handler
+func (_m *KillSwitch) SetHaltHandler(handler killswitch.HaltHandler) {
+	_m.Called(handler)
+}
+
+// KillSwitch_SetHaltHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetHaltHandler'
+type KillSwitch_SetHaltHandler_Call struct {
+	*mock.Call
+}
+
+// SetHaltHandler is a helper method to define mock.On call
+//   - handler killswitch.HaltHandler
+func (_e *KillSwitch_Expecter) SetHaltHandler(handler interface{}) *KillSwitch_SetHaltHandler_Call {
+	return &KillSwitch_SetHaltHandler_Call{Call: _e.mock.On("SetHaltHandler", handler)}
+}
+
+func (_c *KillSwitch_SetHaltHandler_Call) Run(run func(handler killswitch.HaltHandler)) *KillSwitch_SetHaltHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(killswitch.HaltHandler))
+	})
+	return _c
+}
+
+func (_c *KillSwitch_SetHaltHandler_Call) Return() *KillSwitch_SetHaltHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *KillSwitch_SetHaltHandler_Call) RunAndReturn(run func(killswitch.HaltHandler)) *KillSwitch_SetHaltHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Start provides a mock function with no fields
+func (_m *KillSwitch) Start() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// KillSwitch_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type KillSwitch_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+func (_e *KillSwitch_Expecter) Start() *KillSwitch_Start_Call {
+	return &KillSwitch_Start_Call{Call: _e.mock.On("Start")}
+}
+
+func (_c *KillSwitch_Start_Call) Run(run func()) *KillSwitch_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *KillSwitch_Start_Call) Return(_a0 error) *KillSwitch_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *KillSwitch_Start_Call) RunAndReturn(run func() error) *KillSwitch_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Status provides a mock function with no fields
+func (_m *KillSwitch) Status() killswitch.Status {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Status")
+	}
+
+	var r0 killswitch.Status
+	if rf, ok := ret.Get(0).(func() killswitch.Status); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(killswitch.Status)
+	}
+
+	return r0
+}
+
+// KillSwitch_Status_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Status'
+type KillSwitch_Status_Call struct {
+	*mock.Call
+}
+
+// Status is a helper method to define mock.On call
+func (_e *KillSwitch_Expecter) Status() *KillSwitch_Status_Call {
+	return &KillSwitch_Status_Call{Call: _e.mock.On("Status")}
+}
+
+func (_c *KillSwitch_Status_Call) Run(run func()) *KillSwitch_Status_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *KillSwitch_Status_Call) Return(_a0 killswitch.Status) *KillSwitch_Status_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *KillSwitch_Status_Call) RunAndReturn(run func() killswitch.Status) *KillSwitch_Status_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with no fields
+func (_m *KillSwitch) Stop() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// KillSwitch_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type KillSwitch_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *KillSwitch_Expecter) Stop() *KillSwitch_Stop_Call {
+	return &KillSwitch_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *KillSwitch_Stop_Call) Run(run func()) *KillSwitch_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *KillSwitch_Stop_Call) Return(_a0 error) *KillSwitch_Stop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *KillSwitch_Stop_Call) RunAndReturn(run func() error) *KillSwitch_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Trigger provides a mock function with given fields: reason, flatten
+func (_m *KillSwitch) Trigger(reason string, flatten bool) (*killswitch.Report, error) {
+	ret := _m.Called(reason, flatten)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Trigger")
+	}
+
+	var r0 *killswitch.Report
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, bool) (*killswitch.Report, error)); ok {
+		return rf(reason, flatten)
+	}
+	if rf, ok := ret.Get(0).(func(string, bool) *killswitch.Report); ok {
+		r0 = rf(reason, flatten)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*killswitch.Report)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, bool) error); ok {
+		r1 = rf(reason, flatten)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KillSwitch_Trigger_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Trigger'
+type KillSwitch_Trigger_Call struct {
+	*mock.Call
+}
+
+// Trigger is a helper method to define mock.On call
+//   - reason string
+//   - flatten bool
+func (_e *KillSwitch_Expecter) Trigger(reason interface{}, flatten interface{}) *KillSwitch_Trigger_Call {
+	return &KillSwitch_Trigger_Call{Call: _e.mock.On("Trigger", reason, flatten)}
+}
+
+func (_c *KillSwitch_Trigger_Call) Run(run func(reason string, flatten bool)) *KillSwitch_Trigger_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *KillSwitch_Trigger_Call) Return(_a0 *killswitch.Report, _a1 error) *KillSwitch_Trigger_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KillSwitch_Trigger_Call) RunAndReturn(run func(string, bool) (*killswitch.Report, error)) *KillSwitch_Trigger_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewKillSwitch creates a new instance of KillSwitch. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewKillSwitch(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *KillSwitch {
+	mock := &KillSwitch{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
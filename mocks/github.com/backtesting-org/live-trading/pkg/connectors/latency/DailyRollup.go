@@ -0,0 +1,363 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package latency
+
+import (
+	http "net/http"
+
+	latency "github.com/backtesting-org/live-trading/pkg/connectors/latency"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// DailyRollup is an autogenerated mock type for the DailyRollup type
+type DailyRollup struct {
+	mock.Mock
+}
+
+type DailyRollup_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DailyRollup) EXPECT() *DailyRollup_Expecter {
+	return &DailyRollup_Expecter{mock: &_m.Mock}
+}
+
+// Aggregates provides a mock function with given fields: since, until
+func (_m *DailyRollup) Aggregates(since time.Time, until time.Time) []latency.DailyAggregate {
+	ret := _m.Called(since, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Aggregates")
+	}
+
+	var r0 []latency.DailyAggregate
+	if rf, ok := ret.Get(0).(func(time.Time, time.Time) []latency.DailyAggregate); ok {
+		r0 = rf(since, until)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]latency.DailyAggregate)
+		}
+	}
+
+	return r0
+}
+
+// DailyRollup_Aggregates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Aggregates'
+type DailyRollup_Aggregates_Call struct {
+	*mock.Call
+}
+
+// Aggregates is a helper method to define mock.On call
+//   - since time.Time
+//   - until time.Time
+func (_e *DailyRollup_Expecter) Aggregates(since interface{}, until interface{}) *DailyRollup_Aggregates_Call {
+	return &DailyRollup_Aggregates_Call{Call: _e.mock.On("Aggregates", since, until)}
+}
+
+func (_c *DailyRollup_Aggregates_Call) Run(run func(since time.Time, until time.Time)) *DailyRollup_Aggregates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Time), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *DailyRollup_Aggregates_Call) Return(_a0 []latency.DailyAggregate) *DailyRollup_Aggregates_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DailyRollup_Aggregates_Call) RunAndReturn(run func(time.Time, time.Time) []latency.DailyAggregate) *DailyRollup_Aggregates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *DailyRollup) Configure(config latency.Config) error {
+	ret := _m.Called(config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Configure")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(latency.Config) error); ok {
+		r0 = rf(config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DailyRollup_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type DailyRollup_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config latency.Config
+func (_e *DailyRollup_Expecter) Configure(config interface{}) *DailyRollup_Configure_Call {
+	return &DailyRollup_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *DailyRollup_Configure_Call) Run(run func(config latency.Config)) *DailyRollup_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(latency.Config))
+	})
+	return _c
+}
+
+func (_c *DailyRollup_Configure_Call) Return(_a0 error) *DailyRollup_Configure_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DailyRollup_Configure_Call) RunAndReturn(run func(latency.Config) error) *DailyRollup_Configure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Current provides a mock function with no fields
+func (_m *DailyRollup) Current() []latency.Summary {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Current")
+	}
+
+	var r0 []latency.Summary
+	if rf, ok := ret.Get(0).(func() []latency.Summary); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]latency.Summary)
+		}
+	}
+
+	return r0
+}
+
+// DailyRollup_Current_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Current'
+type DailyRollup_Current_Call struct {
+	*mock.Call
+}
+
+// Current is a helper method to define mock.On call
+func (_e *DailyRollup_Expecter) Current() *DailyRollup_Current_Call {
+	return &DailyRollup_Current_Call{Call: _e.mock.On("Current")}
+}
+
+func (_c *DailyRollup_Current_Call) Run(run func()) *DailyRollup_Current_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DailyRollup_Current_Call) Return(_a0 []latency.Summary) *DailyRollup_Current_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DailyRollup_Current_Call) RunAndReturn(run func() []latency.Summary) *DailyRollup_Current_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Handler provides a mock function with no fields
+func (_m *DailyRollup) Handler() http.Handler {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handler")
+	}
+
+	var r0 http.Handler
+	if rf, ok := ret.Get(0).(func() http.Handler); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(http.Handler)
+		}
+	}
+
+	return r0
+}
+
+// DailyRollup_Handler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handler'
+type DailyRollup_Handler_Call struct {
+	*mock.Call
+}
+
+// Handler is a helper method to define mock.On call
+func (_e *DailyRollup_Expecter) Handler() *DailyRollup_Handler_Call {
+	return &DailyRollup_Handler_Call{Call: _e.mock.On("Handler")}
+}
+
+func (_c *DailyRollup_Handler_Call) Run(run func()) *DailyRollup_Handler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DailyRollup_Handler_Call) Return(_a0 http.Handler) *DailyRollup_Handler_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DailyRollup_Handler_Call) RunAndReturn(run func() http.Handler) *DailyRollup_Handler_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Roll provides a mock function with no fields
+func (_m *DailyRollup) Roll() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Roll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DailyRollup_Roll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Roll'
+type DailyRollup_Roll_Call struct {
+	*mock.Call
+}
+
+// Roll is a helper method to define mock.On call
+func (_e *DailyRollup_Expecter) Roll() *DailyRollup_Roll_Call {
+	return &DailyRollup_Roll_Call{Call: _e.mock.On("Roll")}
+}
+
+func (_c *DailyRollup_Roll_Call) Run(run func()) *DailyRollup_Roll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DailyRollup_Roll_Call) Return(_a0 error) *DailyRollup_Roll_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DailyRollup_Roll_Call) RunAndReturn(run func() error) *DailyRollup_Roll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with no fields
+func (_m *DailyRollup) Start() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DailyRollup_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type DailyRollup_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+func (_e *DailyRollup_Expecter) Start() *DailyRollup_Start_Call {
+	return &DailyRollup_Start_Call{Call: _e.mock.On("Start")}
+}
+
+func (_c *DailyRollup_Start_Call) Run(run func()) *DailyRollup_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DailyRollup_Start_Call) Return(_a0 error) *DailyRollup_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DailyRollup_Start_Call) RunAndReturn(run func() error) *DailyRollup_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with no fields
+func (_m *DailyRollup) Stop() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DailyRollup_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type DailyRollup_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *DailyRollup_Expecter) Stop() *DailyRollup_Stop_Call {
+	return &DailyRollup_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *DailyRollup_Stop_Call) Run(run func()) *DailyRollup_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DailyRollup_Stop_Call) Return(_a0 error) *DailyRollup_Stop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DailyRollup_Stop_Call) RunAndReturn(run func() error) *DailyRollup_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDailyRollup creates a new instance of DailyRollup. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDailyRollup(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DailyRollup {
+	mock := &DailyRollup{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,119 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package latency
+
+import (
+	execution "github.com/backtesting-org/kronos-sdk/pkg/types/execution"
+
+	mock "github.com/stretchr/testify/mock"
+
+	tracker "github.com/backtesting-org/live-trading/pkg/connectors/tracker"
+)
+
+// OrderTimer is an autogenerated mock type for the OrderTimer type
+type OrderTimer struct {
+	mock.Mock
+}
+
+type OrderTimer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OrderTimer) EXPECT() *OrderTimer_Expecter {
+	return &OrderTimer_Expecter{mock: &_m.Mock}
+}
+
+// ObserveFill provides a mock function with given fields: fill
+func (_m *OrderTimer) ObserveFill(fill tracker.FillEvent) {
+	_m.Called(fill)
+}
+
+// OrderTimer_ObserveFill_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ObserveFill'
+type OrderTimer_ObserveFill_Call struct {
+	*mock.Call
+}
+
+// ObserveFill is a helper method to define mock.On call
+//   - fill tracker.FillEvent
+func (_e *OrderTimer_Expecter) ObserveFill(fill interface{}) *OrderTimer_ObserveFill_Call {
+	return &OrderTimer_ObserveFill_Call{Call: _e.mock.On("ObserveFill", fill)}
+}
+
+func (_c *OrderTimer_ObserveFill_Call) Run(run func(fill tracker.FillEvent)) *OrderTimer_ObserveFill_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(tracker.FillEvent))
+	})
+	return _c
+}
+
+func (_c *OrderTimer_ObserveFill_Call) Return() *OrderTimer_ObserveFill_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *OrderTimer_ObserveFill_Call) RunAndReturn(run func(tracker.FillEvent)) *OrderTimer_ObserveFill_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Wrap provides a mock function with given fields: inner
+func (_m *OrderTimer) Wrap(inner execution.Executor) execution.Executor {
+	ret := _m.Called(inner)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Wrap")
+	}
+
+	var r0 execution.Executor
+	if rf, ok := ret.Get(0).(func(execution.Executor) execution.Executor); ok {
+		r0 = rf(inner)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(execution.Executor)
+		}
+	}
+
+	return r0
+}
+
+// OrderTimer_Wrap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Wrap'
+type OrderTimer_Wrap_Call struct {
+	*mock.Call
+}
+
+// Wrap is a helper method to define mock.On call
+//   - inner execution.Executor
+func (_e *OrderTimer_Expecter) Wrap(inner interface{}) *OrderTimer_Wrap_Call {
+	return &OrderTimer_Wrap_Call{Call: _e.mock.On("Wrap", inner)}
+}
+
+func (_c *OrderTimer_Wrap_Call) Run(run func(inner execution.Executor)) *OrderTimer_Wrap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(execution.Executor))
+	})
+	return _c
+}
+
+func (_c *OrderTimer_Wrap_Call) Return(_a0 execution.Executor) *OrderTimer_Wrap_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderTimer_Wrap_Call) RunAndReturn(run func(execution.Executor) execution.Executor) *OrderTimer_Wrap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOrderTimer creates a new instance of OrderTimer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOrderTimer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OrderTimer {
+	mock := &OrderTimer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
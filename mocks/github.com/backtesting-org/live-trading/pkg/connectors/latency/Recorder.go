@@ -0,0 +1,380 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package latency
+
+import (
+	connector "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	latency "github.com/backtesting-org/live-trading/pkg/connectors/latency"
+
+	mock "github.com/stretchr/testify/mock"
+
+	performance "github.com/backtesting-org/live-trading/pkg/websocket/performance"
+
+	time "time"
+)
+
+// Recorder is an autogenerated mock type for the Recorder type
+type Recorder struct {
+	mock.Mock
+}
+
+type Recorder_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Recorder) EXPECT() *Recorder_Expecter {
+	return &Recorder_Expecter{mock: &_m.Mock}
+}
+
+// Count provides a mock function with given fields: exchange, op
+func (_m *Recorder) Count(exchange connector.ExchangeName, op latency.Operation) int {
+	ret := _m.Called(exchange, op)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Count")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, latency.Operation) int); ok {
+		r0 = rf(exchange, op)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// Recorder_Count_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Count'
+type Recorder_Count_Call struct {
+	*mock.Call
+}
+
+// Count is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - op latency.Operation
+func (_e *Recorder_Expecter) Count(exchange interface{}, op interface{}) *Recorder_Count_Call {
+	return &Recorder_Count_Call{Call: _e.mock.On("Count", exchange, op)}
+}
+
+func (_c *Recorder_Count_Call) Run(run func(exchange connector.ExchangeName, op latency.Operation)) *Recorder_Count_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(latency.Operation))
+	})
+	return _c
+}
+
+func (_c *Recorder_Count_Call) Return(_a0 int) *Recorder_Count_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Recorder_Count_Call) RunAndReturn(run func(connector.ExchangeName, latency.Operation) int) *Recorder_Count_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Keys provides a mock function with no fields
+func (_m *Recorder) Keys() []latency.Key {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Keys")
+	}
+
+	var r0 []latency.Key
+	if rf, ok := ret.Get(0).(func() []latency.Key); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]latency.Key)
+		}
+	}
+
+	return r0
+}
+
+// Recorder_Keys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Keys'
+type Recorder_Keys_Call struct {
+	*mock.Call
+}
+
+// Keys is a helper method to define mock.On call
+func (_e *Recorder_Expecter) Keys() *Recorder_Keys_Call {
+	return &Recorder_Keys_Call{Call: _e.mock.On("Keys")}
+}
+
+func (_c *Recorder_Keys_Call) Run(run func()) *Recorder_Keys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Recorder_Keys_Call) Return(_a0 []latency.Key) *Recorder_Keys_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Recorder_Keys_Call) RunAndReturn(run func() []latency.Key) *Recorder_Keys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Observe provides a mock function with given fields: exchange, op, _a2
+func (_m *Recorder) Observe(exchange connector.ExchangeName, op latency.Operation, _a2 time.Duration) {
+	_m.Called(exchange, op, _a2)
+}
+
+// Recorder_Observe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Observe'
+type Recorder_Observe_Call struct {
+	*mock.Call
+}
+
+// Observe is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - op latency.Operation
+//   - _a2 time.Duration
+func (_e *Recorder_Expecter) Observe(exchange interface{}, op interface{}, _a2 interface{}) *Recorder_Observe_Call {
+	return &Recorder_Observe_Call{Call: _e.mock.On("Observe", exchange, op, _a2)}
+}
+
+func (_c *Recorder_Observe_Call) Run(run func(exchange connector.ExchangeName, op latency.Operation, _a2 time.Duration)) *Recorder_Observe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(latency.Operation), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *Recorder_Observe_Call) Return() *Recorder_Observe_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Recorder_Observe_Call) RunAndReturn(run func(connector.ExchangeName, latency.Operation, time.Duration)) *Recorder_Observe_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Quantile provides a mock function with given fields: exchange, op, q
+func (_m *Recorder) Quantile(exchange connector.ExchangeName, op latency.Operation, q float64) (time.Duration, bool) {
+	ret := _m.Called(exchange, op, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Quantile")
+	}
+
+	var r0 time.Duration
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, latency.Operation, float64) (time.Duration, bool)); ok {
+		return rf(exchange, op, q)
+	}
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, latency.Operation, float64) time.Duration); ok {
+		r0 = rf(exchange, op, q)
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.ExchangeName, latency.Operation, float64) bool); ok {
+		r1 = rf(exchange, op, q)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// Recorder_Quantile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Quantile'
+type Recorder_Quantile_Call struct {
+	*mock.Call
+}
+
+// Quantile is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - op latency.Operation
+//   - q float64
+func (_e *Recorder_Expecter) Quantile(exchange interface{}, op interface{}, q interface{}) *Recorder_Quantile_Call {
+	return &Recorder_Quantile_Call{Call: _e.mock.On("Quantile", exchange, op, q)}
+}
+
+func (_c *Recorder_Quantile_Call) Run(run func(exchange connector.ExchangeName, op latency.Operation, q float64)) *Recorder_Quantile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(latency.Operation), args[2].(float64))
+	})
+	return _c
+}
+
+func (_c *Recorder_Quantile_Call) Return(_a0 time.Duration, _a1 bool) *Recorder_Quantile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Recorder_Quantile_Call) RunAndReturn(run func(connector.ExchangeName, latency.Operation, float64) (time.Duration, bool)) *Recorder_Quantile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Roll provides a mock function with no fields
+func (_m *Recorder) Roll() map[latency.Key]performance.LatencyHistogram {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Roll")
+	}
+
+	var r0 map[latency.Key]performance.LatencyHistogram
+	if rf, ok := ret.Get(0).(func() map[latency.Key]performance.LatencyHistogram); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[latency.Key]performance.LatencyHistogram)
+		}
+	}
+
+	return r0
+}
+
+// Recorder_Roll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Roll'
+type Recorder_Roll_Call struct {
+	*mock.Call
+}
+
+// Roll is a helper method to define mock.On call
+func (_e *Recorder_Expecter) Roll() *Recorder_Roll_Call {
+	return &Recorder_Roll_Call{Call: _e.mock.On("Roll")}
+}
+
+func (_c *Recorder_Roll_Call) Run(run func()) *Recorder_Roll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Recorder_Roll_Call) Return(_a0 map[latency.Key]performance.LatencyHistogram) *Recorder_Roll_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Recorder_Roll_Call) RunAndReturn(run func() map[latency.Key]performance.LatencyHistogram) *Recorder_Roll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Sample provides a mock function with given fields: exchange, op
+func (_m *Recorder) Sample(exchange connector.ExchangeName, op latency.Operation) (time.Duration, bool) {
+	ret := _m.Called(exchange, op)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Sample")
+	}
+
+	var r0 time.Duration
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, latency.Operation) (time.Duration, bool)); ok {
+		return rf(exchange, op)
+	}
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, latency.Operation) time.Duration); ok {
+		r0 = rf(exchange, op)
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.ExchangeName, latency.Operation) bool); ok {
+		r1 = rf(exchange, op)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// Recorder_Sample_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Sample'
+type Recorder_Sample_Call struct {
+	*mock.Call
+}
+
+// Sample is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - op latency.Operation
+func (_e *Recorder_Expecter) Sample(exchange interface{}, op interface{}) *Recorder_Sample_Call {
+	return &Recorder_Sample_Call{Call: _e.mock.On("Sample", exchange, op)}
+}
+
+func (_c *Recorder_Sample_Call) Run(run func(exchange connector.ExchangeName, op latency.Operation)) *Recorder_Sample_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(latency.Operation))
+	})
+	return _c
+}
+
+func (_c *Recorder_Sample_Call) Return(_a0 time.Duration, _a1 bool) *Recorder_Sample_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Recorder_Sample_Call) RunAndReturn(run func(connector.ExchangeName, latency.Operation) (time.Duration, bool)) *Recorder_Sample_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Time provides a mock function with given fields: conn, op, call
+func (_m *Recorder) Time(conn connector.Connector, op latency.Operation, call func() error) error {
+	ret := _m.Called(conn, op, call)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Time")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(connector.Connector, latency.Operation, func() error) error); ok {
+		r0 = rf(conn, op, call)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Recorder_Time_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Time'
+type Recorder_Time_Call struct {
+	*mock.Call
+}
+
+// Time is a helper method to define mock.On call
+//   - conn connector.Connector
+//   - op latency.Operation
+//   - call func() error
+func (_e *Recorder_Expecter) Time(conn interface{}, op interface{}, call interface{}) *Recorder_Time_Call {
+	return &Recorder_Time_Call{Call: _e.mock.On("Time", conn, op, call)}
+}
+
+func (_c *Recorder_Time_Call) Run(run func(conn connector.Connector, op latency.Operation, call func() error)) *Recorder_Time_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.Connector), args[1].(latency.Operation), args[2].(func() error))
+	})
+	return _c
+}
+
+func (_c *Recorder_Time_Call) Return(_a0 error) *Recorder_Time_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Recorder_Time_Call) RunAndReturn(run func(connector.Connector, latency.Operation, func() error) error) *Recorder_Time_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRecorder creates a new instance of Recorder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRecorder(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Recorder {
+	mock := &Recorder{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,77 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package latency
+
+import mock "github.com/stretchr/testify/mock"
+
+// Simulated is an autogenerated mock type for the Simulated type
+type Simulated struct {
+	mock.Mock
+}
+
+type Simulated_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Simulated) EXPECT() *Simulated_Expecter {
+	return &Simulated_Expecter{mock: &_m.Mock}
+}
+
+// SimulatesExecution provides a mock function with no fields
+func (_m *Simulated) SimulatesExecution() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulatesExecution")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Simulated_SimulatesExecution_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SimulatesExecution'
+type Simulated_SimulatesExecution_Call struct {
+	*mock.Call
+}
+
+// SimulatesExecution is a helper method to define mock.On call
+func (_e *Simulated_Expecter) SimulatesExecution() *Simulated_SimulatesExecution_Call {
+	return &Simulated_SimulatesExecution_Call{Call: _e.mock.On("SimulatesExecution")}
+}
+
+func (_c *Simulated_SimulatesExecution_Call) Run(run func()) *Simulated_SimulatesExecution_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Simulated_SimulatesExecution_Call) Return(_a0 bool) *Simulated_SimulatesExecution_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Simulated_SimulatesExecution_Call) RunAndReturn(run func() bool) *Simulated_SimulatesExecution_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSimulated creates a new instance of Simulated. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSimulated(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Simulated {
+	mock := &Simulated{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
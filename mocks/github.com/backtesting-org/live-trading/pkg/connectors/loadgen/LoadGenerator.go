@@ -0,0 +1,359 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package loadgen
+
+import (
+	connector "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	loadgen "github.com/backtesting-org/live-trading/pkg/connectors/loadgen"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// LoadGenerator is an autogenerated mock type for the LoadGenerator type
+type LoadGenerator struct {
+	mock.Mock
+}
+
+type LoadGenerator_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *LoadGenerator) EXPECT() *LoadGenerator_Expecter {
+	return &LoadGenerator_Expecter{mock: &_m.Mock}
+}
+
+// Books provides a mock function with no fields
+func (_m *LoadGenerator) Books() <-chan connector.OrderBook {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Books")
+	}
+
+	var r0 <-chan connector.OrderBook
+	if rf, ok := ret.Get(0).(func() <-chan connector.OrderBook); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan connector.OrderBook)
+		}
+	}
+
+	return r0
+}
+
+// LoadGenerator_Books_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Books'
+type LoadGenerator_Books_Call struct {
+	*mock.Call
+}
+
+// Books is a helper method to define mock.On call
+func (_e *LoadGenerator_Expecter) Books() *LoadGenerator_Books_Call {
+	return &LoadGenerator_Books_Call{Call: _e.mock.On("Books")}
+}
+
+func (_c *LoadGenerator_Books_Call) Run(run func()) *LoadGenerator_Books_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *LoadGenerator_Books_Call) Return(_a0 <-chan connector.OrderBook) *LoadGenerator_Books_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LoadGenerator_Books_Call) RunAndReturn(run func() <-chan connector.OrderBook) *LoadGenerator_Books_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *LoadGenerator) Configure(config loadgen.Config) error {
+	ret := _m.Called(config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Configure")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(loadgen.Config) error); ok {
+		r0 = rf(config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LoadGenerator_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type LoadGenerator_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config loadgen.Config
+func (_e *LoadGenerator_Expecter) Configure(config interface{}) *LoadGenerator_Configure_Call {
+	return &LoadGenerator_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *LoadGenerator_Configure_Call) Run(run func(config loadgen.Config)) *LoadGenerator_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(loadgen.Config))
+	})
+	return _c
+}
+
+func (_c *LoadGenerator_Configure_Call) Return(_a0 error) *LoadGenerator_Configure_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LoadGenerator_Configure_Call) RunAndReturn(run func(loadgen.Config) error) *LoadGenerator_Configure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Fills provides a mock function with no fields
+func (_m *LoadGenerator) Fills() <-chan connector.Trade {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Fills")
+	}
+
+	var r0 <-chan connector.Trade
+	if rf, ok := ret.Get(0).(func() <-chan connector.Trade); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan connector.Trade)
+		}
+	}
+
+	return r0
+}
+
+// LoadGenerator_Fills_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Fills'
+type LoadGenerator_Fills_Call struct {
+	*mock.Call
+}
+
+// Fills is a helper method to define mock.On call
+func (_e *LoadGenerator_Expecter) Fills() *LoadGenerator_Fills_Call {
+	return &LoadGenerator_Fills_Call{Call: _e.mock.On("Fills")}
+}
+
+func (_c *LoadGenerator_Fills_Call) Run(run func()) *LoadGenerator_Fills_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *LoadGenerator_Fills_Call) Return(_a0 <-chan connector.Trade) *LoadGenerator_Fills_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LoadGenerator_Fills_Call) RunAndReturn(run func() <-chan connector.Trade) *LoadGenerator_Fills_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with no fields
+func (_m *LoadGenerator) Start() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LoadGenerator_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type LoadGenerator_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+func (_e *LoadGenerator_Expecter) Start() *LoadGenerator_Start_Call {
+	return &LoadGenerator_Start_Call{Call: _e.mock.On("Start")}
+}
+
+func (_c *LoadGenerator_Start_Call) Run(run func()) *LoadGenerator_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *LoadGenerator_Start_Call) Return(_a0 error) *LoadGenerator_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LoadGenerator_Start_Call) RunAndReturn(run func() error) *LoadGenerator_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stats provides a mock function with no fields
+func (_m *LoadGenerator) Stats() loadgen.Stats {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stats")
+	}
+
+	var r0 loadgen.Stats
+	if rf, ok := ret.Get(0).(func() loadgen.Stats); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(loadgen.Stats)
+	}
+
+	return r0
+}
+
+// LoadGenerator_Stats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stats'
+type LoadGenerator_Stats_Call struct {
+	*mock.Call
+}
+
+// Stats is a helper method to define mock.On call
+func (_e *LoadGenerator_Expecter) Stats() *LoadGenerator_Stats_Call {
+	return &LoadGenerator_Stats_Call{Call: _e.mock.On("Stats")}
+}
+
+func (_c *LoadGenerator_Stats_Call) Run(run func()) *LoadGenerator_Stats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *LoadGenerator_Stats_Call) Return(_a0 loadgen.Stats) *LoadGenerator_Stats_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LoadGenerator_Stats_Call) RunAndReturn(run func() loadgen.Stats) *LoadGenerator_Stats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with no fields
+func (_m *LoadGenerator) Stop() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LoadGenerator_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type LoadGenerator_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *LoadGenerator_Expecter) Stop() *LoadGenerator_Stop_Call {
+	return &LoadGenerator_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *LoadGenerator_Stop_Call) Run(run func()) *LoadGenerator_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *LoadGenerator_Stop_Call) Return(_a0 error) *LoadGenerator_Stop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LoadGenerator_Stop_Call) RunAndReturn(run func() error) *LoadGenerator_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Trades provides a mock function with no fields
+func (_m *LoadGenerator) Trades() <-chan connector.Trade {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Trades")
+	}
+
+	var r0 <-chan connector.Trade
+	if rf, ok := ret.Get(0).(func() <-chan connector.Trade); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan connector.Trade)
+		}
+	}
+
+	return r0
+}
+
+// LoadGenerator_Trades_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Trades'
+type LoadGenerator_Trades_Call struct {
+	*mock.Call
+}
+
+// Trades is a helper method to define mock.On call
+func (_e *LoadGenerator_Expecter) Trades() *LoadGenerator_Trades_Call {
+	return &LoadGenerator_Trades_Call{Call: _e.mock.On("Trades")}
+}
+
+func (_c *LoadGenerator_Trades_Call) Run(run func()) *LoadGenerator_Trades_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *LoadGenerator_Trades_Call) Return(_a0 <-chan connector.Trade) *LoadGenerator_Trades_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LoadGenerator_Trades_Call) RunAndReturn(run func() <-chan connector.Trade) *LoadGenerator_Trades_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewLoadGenerator creates a new instance of LoadGenerator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLoadGenerator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LoadGenerator {
+	mock := &LoadGenerator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,568 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package markprice
+
+import (
+	connector "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	markprice "github.com/backtesting-org/live-trading/pkg/connectors/markprice"
+
+	mock "github.com/stretchr/testify/mock"
+
+	numerical "github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+
+	portfolio "github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+
+	types "github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+// MarkPriceFeed is an autogenerated mock type for the MarkPriceFeed type
+type MarkPriceFeed struct {
+	mock.Mock
+}
+
+type MarkPriceFeed_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MarkPriceFeed) EXPECT() *MarkPriceFeed_Expecter {
+	return &MarkPriceFeed_Expecter{mock: &_m.Mock}
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *MarkPriceFeed) Configure(config markprice.Config) {
+	_m.Called(config)
+}
+
+// MarkPriceFeed_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type MarkPriceFeed_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config markprice.Config
+func (_e *MarkPriceFeed_Expecter) Configure(config interface{}) *MarkPriceFeed_Configure_Call {
+	return &MarkPriceFeed_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *MarkPriceFeed_Configure_Call) Run(run func(config markprice.Config)) *MarkPriceFeed_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(markprice.Config))
+	})
+	return _c
+}
+
+func (_c *MarkPriceFeed_Configure_Call) Return() *MarkPriceFeed_Configure_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MarkPriceFeed_Configure_Call) RunAndReturn(run func(markprice.Config)) *MarkPriceFeed_Configure_Call {
+	_c.Run(run)
+	return _c
+}
+
+// LiquidationDistance provides a mock function with given fields: position
+func (_m *MarkPriceFeed) LiquidationDistance(position connector.Position) (numerical.Decimal, bool) {
+	ret := _m.Called(position)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LiquidationDistance")
+	}
+
+	var r0 numerical.Decimal
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(connector.Position) (numerical.Decimal, bool)); ok {
+		return rf(position)
+	}
+	if rf, ok := ret.Get(0).(func(connector.Position) numerical.Decimal); ok {
+		r0 = rf(position)
+	} else {
+		r0 = ret.Get(0).(numerical.Decimal)
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.Position) bool); ok {
+		r1 = rf(position)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// MarkPriceFeed_LiquidationDistance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LiquidationDistance'
+type MarkPriceFeed_LiquidationDistance_Call struct {
+	*mock.Call
+}
+
+// LiquidationDistance is a helper method to define mock.On call
+//   - position connector.Position
+func (_e *MarkPriceFeed_Expecter) LiquidationDistance(position interface{}) *MarkPriceFeed_LiquidationDistance_Call {
+	return &MarkPriceFeed_LiquidationDistance_Call{Call: _e.mock.On("LiquidationDistance", position)}
+}
+
+func (_c *MarkPriceFeed_LiquidationDistance_Call) Run(run func(position connector.Position)) *MarkPriceFeed_LiquidationDistance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.Position))
+	})
+	return _c
+}
+
+func (_c *MarkPriceFeed_LiquidationDistance_Call) Return(_a0 numerical.Decimal, _a1 bool) *MarkPriceFeed_LiquidationDistance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MarkPriceFeed_LiquidationDistance_Call) RunAndReturn(run func(connector.Position) (numerical.Decimal, bool)) *MarkPriceFeed_LiquidationDistance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Mark provides a mock function with given fields: exchange, asset
+func (_m *MarkPriceFeed) Mark(exchange connector.ExchangeName, asset portfolio.Asset) (types.MarkPrice, bool) {
+	ret := _m.Called(exchange, asset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Mark")
+	}
+
+	var r0 types.MarkPrice
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, portfolio.Asset) (types.MarkPrice, bool)); ok {
+		return rf(exchange, asset)
+	}
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, portfolio.Asset) types.MarkPrice); ok {
+		r0 = rf(exchange, asset)
+	} else {
+		r0 = ret.Get(0).(types.MarkPrice)
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.ExchangeName, portfolio.Asset) bool); ok {
+		r1 = rf(exchange, asset)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// MarkPriceFeed_Mark_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Mark'
+type MarkPriceFeed_Mark_Call struct {
+	*mock.Call
+}
+
+// Mark is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - asset portfolio.Asset
+func (_e *MarkPriceFeed_Expecter) Mark(exchange interface{}, asset interface{}) *MarkPriceFeed_Mark_Call {
+	return &MarkPriceFeed_Mark_Call{Call: _e.mock.On("Mark", exchange, asset)}
+}
+
+func (_c *MarkPriceFeed_Mark_Call) Run(run func(exchange connector.ExchangeName, asset portfolio.Asset)) *MarkPriceFeed_Mark_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(portfolio.Asset))
+	})
+	return _c
+}
+
+func (_c *MarkPriceFeed_Mark_Call) Return(_a0 types.MarkPrice, _a1 bool) *MarkPriceFeed_Mark_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MarkPriceFeed_Mark_Call) RunAndReturn(run func(connector.ExchangeName, portfolio.Asset) (types.MarkPrice, bool)) *MarkPriceFeed_Mark_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Marks provides a mock function with no fields
+func (_m *MarkPriceFeed) Marks() []types.MarkPrice {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Marks")
+	}
+
+	var r0 []types.MarkPrice
+	if rf, ok := ret.Get(0).(func() []types.MarkPrice); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.MarkPrice)
+		}
+	}
+
+	return r0
+}
+
+// MarkPriceFeed_Marks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Marks'
+type MarkPriceFeed_Marks_Call struct {
+	*mock.Call
+}
+
+// Marks is a helper method to define mock.On call
+func (_e *MarkPriceFeed_Expecter) Marks() *MarkPriceFeed_Marks_Call {
+	return &MarkPriceFeed_Marks_Call{Call: _e.mock.On("Marks")}
+}
+
+func (_c *MarkPriceFeed_Marks_Call) Run(run func()) *MarkPriceFeed_Marks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MarkPriceFeed_Marks_Call) Return(_a0 []types.MarkPrice) *MarkPriceFeed_Marks_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MarkPriceFeed_Marks_Call) RunAndReturn(run func() []types.MarkPrice) *MarkPriceFeed_Marks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Refresh provides a mock function with no fields
+func (_m *MarkPriceFeed) Refresh() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Refresh")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarkPriceFeed_Refresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Refresh'
+type MarkPriceFeed_Refresh_Call struct {
+	*mock.Call
+}
+
+// Refresh is a helper method to define mock.On call
+func (_e *MarkPriceFeed_Expecter) Refresh() *MarkPriceFeed_Refresh_Call {
+	return &MarkPriceFeed_Refresh_Call{Call: _e.mock.On("Refresh")}
+}
+
+func (_c *MarkPriceFeed_Refresh_Call) Run(run func()) *MarkPriceFeed_Refresh_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MarkPriceFeed_Refresh_Call) Return(_a0 error) *MarkPriceFeed_Refresh_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MarkPriceFeed_Refresh_Call) RunAndReturn(run func() error) *MarkPriceFeed_Refresh_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with no fields
+func (_m *MarkPriceFeed) Start() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarkPriceFeed_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type MarkPriceFeed_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+func (_e *MarkPriceFeed_Expecter) Start() *MarkPriceFeed_Start_Call {
+	return &MarkPriceFeed_Start_Call{Call: _e.mock.On("Start")}
+}
+
+func (_c *MarkPriceFeed_Start_Call) Run(run func()) *MarkPriceFeed_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MarkPriceFeed_Start_Call) Return(_a0 error) *MarkPriceFeed_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MarkPriceFeed_Start_Call) RunAndReturn(run func() error) *MarkPriceFeed_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with no fields
+func (_m *MarkPriceFeed) Stop() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarkPriceFeed_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type MarkPriceFeed_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *MarkPriceFeed_Expecter) Stop() *MarkPriceFeed_Stop_Call {
+	return &MarkPriceFeed_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *MarkPriceFeed_Stop_Call) Run(run func()) *MarkPriceFeed_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MarkPriceFeed_Stop_Call) Return(_a0 error) *MarkPriceFeed_Stop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MarkPriceFeed_Stop_Call) RunAndReturn(run func() error) *MarkPriceFeed_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Track provides a mock function with given fields: exchange, asset
+func (_m *MarkPriceFeed) Track(exchange connector.ExchangeName, asset portfolio.Asset) error {
+	ret := _m.Called(exchange, asset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Track")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, portfolio.Asset) error); ok {
+		r0 = rf(exchange, asset)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarkPriceFeed_Track_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Track'
+type MarkPriceFeed_Track_Call struct {
+	*mock.Call
+}
+
+// Track is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - asset portfolio.Asset
+func (_e *MarkPriceFeed_Expecter) Track(exchange interface{}, asset interface{}) *MarkPriceFeed_Track_Call {
+	return &MarkPriceFeed_Track_Call{Call: _e.mock.On("Track", exchange, asset)}
+}
+
+func (_c *MarkPriceFeed_Track_Call) Run(run func(exchange connector.ExchangeName, asset portfolio.Asset)) *MarkPriceFeed_Track_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(portfolio.Asset))
+	})
+	return _c
+}
+
+func (_c *MarkPriceFeed_Track_Call) Return(_a0 error) *MarkPriceFeed_Track_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MarkPriceFeed_Track_Call) RunAndReturn(run func(connector.ExchangeName, portfolio.Asset) error) *MarkPriceFeed_Track_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnrealizedPnL provides a mock function with given fields: position
+func (_m *MarkPriceFeed) UnrealizedPnL(position connector.Position) (numerical.Decimal, bool) {
+	ret := _m.Called(position)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnrealizedPnL")
+	}
+
+	var r0 numerical.Decimal
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(connector.Position) (numerical.Decimal, bool)); ok {
+		return rf(position)
+	}
+	if rf, ok := ret.Get(0).(func(connector.Position) numerical.Decimal); ok {
+		r0 = rf(position)
+	} else {
+		r0 = ret.Get(0).(numerical.Decimal)
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.Position) bool); ok {
+		r1 = rf(position)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// MarkPriceFeed_UnrealizedPnL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnrealizedPnL'
+type MarkPriceFeed_UnrealizedPnL_Call struct {
+	*mock.Call
+}
+
+// UnrealizedPnL is a helper method to define mock.On call
+//   - position connector.Position
+func (_e *MarkPriceFeed_Expecter) UnrealizedPnL(position interface{}) *MarkPriceFeed_UnrealizedPnL_Call {
+	return &MarkPriceFeed_UnrealizedPnL_Call{Call: _e.mock.On("UnrealizedPnL", position)}
+}
+
+func (_c *MarkPriceFeed_UnrealizedPnL_Call) Run(run func(position connector.Position)) *MarkPriceFeed_UnrealizedPnL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.Position))
+	})
+	return _c
+}
+
+func (_c *MarkPriceFeed_UnrealizedPnL_Call) Return(_a0 numerical.Decimal, _a1 bool) *MarkPriceFeed_UnrealizedPnL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MarkPriceFeed_UnrealizedPnL_Call) RunAndReturn(run func(connector.Position) (numerical.Decimal, bool)) *MarkPriceFeed_UnrealizedPnL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Untrack provides a mock function with given fields: exchange, asset
+func (_m *MarkPriceFeed) Untrack(exchange connector.ExchangeName, asset portfolio.Asset) error {
+	ret := _m.Called(exchange, asset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Untrack")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, portfolio.Asset) error); ok {
+		r0 = rf(exchange, asset)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarkPriceFeed_Untrack_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Untrack'
+type MarkPriceFeed_Untrack_Call struct {
+	*mock.Call
+}
+
+// Untrack is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - asset portfolio.Asset
+func (_e *MarkPriceFeed_Expecter) Untrack(exchange interface{}, asset interface{}) *MarkPriceFeed_Untrack_Call {
+	return &MarkPriceFeed_Untrack_Call{Call: _e.mock.On("Untrack", exchange, asset)}
+}
+
+func (_c *MarkPriceFeed_Untrack_Call) Run(run func(exchange connector.ExchangeName, asset portfolio.Asset)) *MarkPriceFeed_Untrack_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(portfolio.Asset))
+	})
+	return _c
+}
+
+func (_c *MarkPriceFeed_Untrack_Call) Return(_a0 error) *MarkPriceFeed_Untrack_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MarkPriceFeed_Untrack_Call) RunAndReturn(run func(connector.ExchangeName, portfolio.Asset) error) *MarkPriceFeed_Untrack_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Updates provides a mock function with no fields
+func (_m *MarkPriceFeed) Updates() <-chan types.MarkPrice {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Updates")
+	}
+
+	var r0 <-chan types.MarkPrice
+	if rf, ok := ret.Get(0).(func() <-chan types.MarkPrice); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan types.MarkPrice)
+		}
+	}
+
+	return r0
+}
+
+// MarkPriceFeed_Updates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Updates'
+type MarkPriceFeed_Updates_Call struct {
+	*mock.Call
+}
+
+// Updates is a helper method to define mock.On call
+func (_e *MarkPriceFeed_Expecter) Updates() *MarkPriceFeed_Updates_Call {
+	return &MarkPriceFeed_Updates_Call{Call: _e.mock.On("Updates")}
+}
+
+func (_c *MarkPriceFeed_Updates_Call) Run(run func()) *MarkPriceFeed_Updates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MarkPriceFeed_Updates_Call) Return(_a0 <-chan types.MarkPrice) *MarkPriceFeed_Updates_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MarkPriceFeed_Updates_Call) RunAndReturn(run func() <-chan types.MarkPrice) *MarkPriceFeed_Updates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMarkPriceFeed creates a new instance of MarkPriceFeed. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMarkPriceFeed(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MarkPriceFeed {
+	mock := &MarkPriceFeed{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,455 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package oco
+
+import (
+	oco "github.com/backtesting-org/live-trading/pkg/connectors/oco"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OCOManager is an autogenerated mock type for the OCOManager type
+type OCOManager struct {
+	mock.Mock
+}
+
+type OCOManager_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OCOManager) EXPECT() *OCOManager_Expecter {
+	return &OCOManager_Expecter{mock: &_m.Mock}
+}
+
+// Active provides a mock function with no fields
+func (_m *OCOManager) Active() []oco.Pair {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Active")
+	}
+
+	var r0 []oco.Pair
+	if rf, ok := ret.Get(0).(func() []oco.Pair); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]oco.Pair)
+		}
+	}
+
+	return r0
+}
+
+// OCOManager_Active_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Active'
+type OCOManager_Active_Call struct {
+	*mock.Call
+}
+
+// Active is a helper method to define mock.On call
+func (_e *OCOManager_Expecter) Active() *OCOManager_Active_Call {
+	return &OCOManager_Active_Call{Call: _e.mock.On("Active")}
+}
+
+func (_c *OCOManager_Active_Call) Run(run func()) *OCOManager_Active_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OCOManager_Active_Call) Return(_a0 []oco.Pair) *OCOManager_Active_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OCOManager_Active_Call) RunAndReturn(run func() []oco.Pair) *OCOManager_Active_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Cancel provides a mock function with given fields: id
+func (_m *OCOManager) Cancel(id string) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cancel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OCOManager_Cancel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cancel'
+type OCOManager_Cancel_Call struct {
+	*mock.Call
+}
+
+// Cancel is a helper method to define mock.On call
+//   - id string
+func (_e *OCOManager_Expecter) Cancel(id interface{}) *OCOManager_Cancel_Call {
+	return &OCOManager_Cancel_Call{Call: _e.mock.On("Cancel", id)}
+}
+
+func (_c *OCOManager_Cancel_Call) Run(run func(id string)) *OCOManager_Cancel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *OCOManager_Cancel_Call) Return(_a0 error) *OCOManager_Cancel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OCOManager_Cancel_Call) RunAndReturn(run func(string) error) *OCOManager_Cancel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Completions provides a mock function with no fields
+func (_m *OCOManager) Completions() <-chan oco.Pair {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Completions")
+	}
+
+	var r0 <-chan oco.Pair
+	if rf, ok := ret.Get(0).(func() <-chan oco.Pair); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan oco.Pair)
+		}
+	}
+
+	return r0
+}
+
+// OCOManager_Completions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Completions'
+type OCOManager_Completions_Call struct {
+	*mock.Call
+}
+
+// Completions is a helper method to define mock.On call
+func (_e *OCOManager_Expecter) Completions() *OCOManager_Completions_Call {
+	return &OCOManager_Completions_Call{Call: _e.mock.On("Completions")}
+}
+
+func (_c *OCOManager_Completions_Call) Run(run func()) *OCOManager_Completions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OCOManager_Completions_Call) Return(_a0 <-chan oco.Pair) *OCOManager_Completions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OCOManager_Completions_Call) RunAndReturn(run func() <-chan oco.Pair) *OCOManager_Completions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *OCOManager) Configure(config oco.Config) {
+	_m.Called(config)
+}
+
+// OCOManager_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type OCOManager_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config oco.Config
+func (_e *OCOManager_Expecter) Configure(config interface{}) *OCOManager_Configure_Call {
+	return &OCOManager_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *OCOManager_Configure_Call) Run(run func(config oco.Config)) *OCOManager_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(oco.Config))
+	})
+	return _c
+}
+
+func (_c *OCOManager_Configure_Call) Return() *OCOManager_Configure_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *OCOManager_Configure_Call) RunAndReturn(run func(oco.Config)) *OCOManager_Configure_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Pair provides a mock function with given fields: id
+func (_m *OCOManager) Pair(id string) (oco.Pair, bool) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Pair")
+	}
+
+	var r0 oco.Pair
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string) (oco.Pair, bool)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) oco.Pair); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(oco.Pair)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// OCOManager_Pair_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Pair'
+type OCOManager_Pair_Call struct {
+	*mock.Call
+}
+
+// Pair is a helper method to define mock.On call
+//   - id string
+func (_e *OCOManager_Expecter) Pair(id interface{}) *OCOManager_Pair_Call {
+	return &OCOManager_Pair_Call{Call: _e.mock.On("Pair", id)}
+}
+
+func (_c *OCOManager_Pair_Call) Run(run func(id string)) *OCOManager_Pair_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *OCOManager_Pair_Call) Return(_a0 oco.Pair, _a1 bool) *OCOManager_Pair_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OCOManager_Pair_Call) RunAndReturn(run func(string) (oco.Pair, bool)) *OCOManager_Pair_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Place provides a mock function with given fields: order
+func (_m *OCOManager) Place(order oco.Order) (oco.Pair, error) {
+	ret := _m.Called(order)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Place")
+	}
+
+	var r0 oco.Pair
+	var r1 error
+	if rf, ok := ret.Get(0).(func(oco.Order) (oco.Pair, error)); ok {
+		return rf(order)
+	}
+	if rf, ok := ret.Get(0).(func(oco.Order) oco.Pair); ok {
+		r0 = rf(order)
+	} else {
+		r0 = ret.Get(0).(oco.Pair)
+	}
+
+	if rf, ok := ret.Get(1).(func(oco.Order) error); ok {
+		r1 = rf(order)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OCOManager_Place_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Place'
+type OCOManager_Place_Call struct {
+	*mock.Call
+}
+
+// Place is a helper method to define mock.On call
+//   - order oco.Order
+func (_e *OCOManager_Expecter) Place(order interface{}) *OCOManager_Place_Call {
+	return &OCOManager_Place_Call{Call: _e.mock.On("Place", order)}
+}
+
+func (_c *OCOManager_Place_Call) Run(run func(order oco.Order)) *OCOManager_Place_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(oco.Order))
+	})
+	return _c
+}
+
+func (_c *OCOManager_Place_Call) Return(_a0 oco.Pair, _a1 error) *OCOManager_Place_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OCOManager_Place_Call) RunAndReturn(run func(oco.Order) (oco.Pair, error)) *OCOManager_Place_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Poll provides a mock function with no fields
+func (_m *OCOManager) Poll() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Poll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OCOManager_Poll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Poll'
+type OCOManager_Poll_Call struct {
+	*mock.Call
+}
+
+// Poll is a helper method to define mock.On call
+func (_e *OCOManager_Expecter) Poll() *OCOManager_Poll_Call {
+	return &OCOManager_Poll_Call{Call: _e.mock.On("Poll")}
+}
+
+func (_c *OCOManager_Poll_Call) Run(run func()) *OCOManager_Poll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OCOManager_Poll_Call) Return(_a0 error) *OCOManager_Poll_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OCOManager_Poll_Call) RunAndReturn(run func() error) *OCOManager_Poll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with no fields
+func (_m *OCOManager) Start() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OCOManager_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type OCOManager_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+func (_e *OCOManager_Expecter) Start() *OCOManager_Start_Call {
+	return &OCOManager_Start_Call{Call: _e.mock.On("Start")}
+}
+
+func (_c *OCOManager_Start_Call) Run(run func()) *OCOManager_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OCOManager_Start_Call) Return(_a0 error) *OCOManager_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OCOManager_Start_Call) RunAndReturn(run func() error) *OCOManager_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with no fields
+func (_m *OCOManager) Stop() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OCOManager_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type OCOManager_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *OCOManager_Expecter) Stop() *OCOManager_Stop_Call {
+	return &OCOManager_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *OCOManager_Stop_Call) Run(run func()) *OCOManager_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OCOManager_Stop_Call) Return(_a0 error) *OCOManager_Stop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OCOManager_Stop_Call) RunAndReturn(run func() error) *OCOManager_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOCOManager creates a new instance of OCOManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOCOManager(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OCOManager {
+	mock := &OCOManager{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,293 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package adaptor
+
+import (
+	context "context"
+
+	adaptor "github.com/backtesting-org/live-trading/pkg/connectors/okx/adaptor"
+
+	mock "github.com/stretchr/testify/mock"
+
+	url "net/url"
+)
+
+// Client is an autogenerated mock type for the Client type
+type Client struct {
+	mock.Mock
+}
+
+type Client_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Client) EXPECT() *Client_Expecter {
+	return &Client_Expecter{mock: &_m.Mock}
+}
+
+// Configure provides a mock function with given fields: baseURL, apiKey, apiSecret, passphrase, simulated
+func (_m *Client) Configure(baseURL string, apiKey string, apiSecret string, passphrase string, simulated bool) error {
+	ret := _m.Called(baseURL, apiKey, apiSecret, passphrase, simulated)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Configure")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, string, bool) error); ok {
+		r0 = rf(baseURL, apiKey, apiSecret, passphrase, simulated)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Client_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type Client_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - baseURL string
+//   - apiKey string
+//   - apiSecret string
+//   - passphrase string
+//   - simulated bool
+func (_e *Client_Expecter) Configure(baseURL interface{}, apiKey interface{}, apiSecret interface{}, passphrase interface{}, simulated interface{}) *Client_Configure_Call {
+	return &Client_Configure_Call{Call: _e.mock.On("Configure", baseURL, apiKey, apiSecret, passphrase, simulated)}
+}
+
+func (_c *Client_Configure_Call) Run(run func(baseURL string, apiKey string, apiSecret string, passphrase string, simulated bool)) *Client_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(string), args[4].(bool))
+	})
+	return _c
+}
+
+func (_c *Client_Configure_Call) Return(_a0 error) *Client_Configure_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Client_Configure_Call) RunAndReturn(run func(string, string, string, string, bool) error) *Client_Configure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsConfigured provides a mock function with no fields
+func (_m *Client) IsConfigured() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsConfigured")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Client_IsConfigured_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsConfigured'
+type Client_IsConfigured_Call struct {
+	*mock.Call
+}
+
+// IsConfigured is a helper method to define mock.On call
+func (_e *Client_Expecter) IsConfigured() *Client_IsConfigured_Call {
+	return &Client_IsConfigured_Call{Call: _e.mock.On("IsConfigured")}
+}
+
+func (_c *Client_IsConfigured_Call) Run(run func()) *Client_IsConfigured_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Client_IsConfigured_Call) Return(_a0 bool) *Client_IsConfigured_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Client_IsConfigured_Call) RunAndReturn(run func() bool) *Client_IsConfigured_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoginArgs provides a mock function with no fields
+func (_m *Client) LoginArgs() (*adaptor.LoginArgs, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoginArgs")
+	}
+
+	var r0 *adaptor.LoginArgs
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*adaptor.LoginArgs, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *adaptor.LoginArgs); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*adaptor.LoginArgs)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Client_LoginArgs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoginArgs'
+type Client_LoginArgs_Call struct {
+	*mock.Call
+}
+
+// LoginArgs is a helper method to define mock.On call
+func (_e *Client_Expecter) LoginArgs() *Client_LoginArgs_Call {
+	return &Client_LoginArgs_Call{Call: _e.mock.On("LoginArgs")}
+}
+
+func (_c *Client_LoginArgs_Call) Run(run func()) *Client_LoginArgs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Client_LoginArgs_Call) Return(_a0 *adaptor.LoginArgs, _a1 error) *Client_LoginArgs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Client_LoginArgs_Call) RunAndReturn(run func() (*adaptor.LoginArgs, error)) *Client_LoginArgs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Public provides a mock function with given fields: ctx, method, path, params, out
+func (_m *Client) Public(ctx context.Context, method string, path string, params url.Values, out interface{}) error {
+	ret := _m.Called(ctx, method, path, params, out)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Public")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, url.Values, interface{}) error); ok {
+		r0 = rf(ctx, method, path, params, out)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Client_Public_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Public'
+type Client_Public_Call struct {
+	*mock.Call
+}
+
+// Public is a helper method to define mock.On call
+//   - ctx context.Context
+//   - method string
+//   - path string
+//   - params url.Values
+//   - out interface{}
+func (_e *Client_Expecter) Public(ctx interface{}, method interface{}, path interface{}, params interface{}, out interface{}) *Client_Public_Call {
+	return &Client_Public_Call{Call: _e.mock.On("Public", ctx, method, path, params, out)}
+}
+
+func (_c *Client_Public_Call) Run(run func(ctx context.Context, method string, path string, params url.Values, out interface{})) *Client_Public_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(url.Values), args[4].(interface{}))
+	})
+	return _c
+}
+
+func (_c *Client_Public_Call) Return(_a0 error) *Client_Public_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Client_Public_Call) RunAndReturn(run func(context.Context, string, string, url.Values, interface{}) error) *Client_Public_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Signed provides a mock function with given fields: ctx, method, path, params, body, out
+func (_m *Client) Signed(ctx context.Context, method string, path string, params url.Values, body interface{}, out interface{}) error {
+	ret := _m.Called(ctx, method, path, params, body, out)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Signed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, url.Values, interface{}, interface{}) error); ok {
+		r0 = rf(ctx, method, path, params, body, out)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Client_Signed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Signed'
+type Client_Signed_Call struct {
+	*mock.Call
+}
+
+// Signed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - method string
+//   - path string
+//   - params url.Values
+//   - body interface{}
+//   - out interface{}
+func (_e *Client_Expecter) Signed(ctx interface{}, method interface{}, path interface{}, params interface{}, body interface{}, out interface{}) *Client_Signed_Call {
+	return &Client_Signed_Call{Call: _e.mock.On("Signed", ctx, method, path, params, body, out)}
+}
+
+func (_c *Client_Signed_Call) Run(run func(ctx context.Context, method string, path string, params url.Values, body interface{}, out interface{})) *Client_Signed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(url.Values), args[4].(interface{}), args[5].(interface{}))
+	})
+	return _c
+}
+
+func (_c *Client_Signed_Call) Return(_a0 error) *Client_Signed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Client_Signed_Call) RunAndReturn(run func(context.Context, string, string, url.Values, interface{}, interface{}) error) *Client_Signed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewClient creates a new instance of Client. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Client {
+	mock := &Client{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
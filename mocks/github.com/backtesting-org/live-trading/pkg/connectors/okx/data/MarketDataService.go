@@ -0,0 +1,860 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package data
+
+import (
+	connector "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+
+	mock "github.com/stretchr/testify/mock"
+
+	numerical "github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+
+	portfolio "github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+
+	time "time"
+
+	types "github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+// MarketDataService is an autogenerated mock type for the MarketDataService type
+type MarketDataService struct {
+	mock.Mock
+}
+
+type MarketDataService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MarketDataService) EXPECT() *MarketDataService_Expecter {
+	return &MarketDataService_Expecter{mock: &_m.Mock}
+}
+
+// ContractValue provides a mock function with given fields: symbol
+func (_m *MarketDataService) ContractValue(symbol string) (numerical.Decimal, error) {
+	ret := _m.Called(symbol)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ContractValue")
+	}
+
+	var r0 numerical.Decimal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (numerical.Decimal, error)); ok {
+		return rf(symbol)
+	}
+	if rf, ok := ret.Get(0).(func(string) numerical.Decimal); ok {
+		r0 = rf(symbol)
+	} else {
+		r0 = ret.Get(0).(numerical.Decimal)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(symbol)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarketDataService_ContractValue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ContractValue'
+type MarketDataService_ContractValue_Call struct {
+	*mock.Call
+}
+
+// ContractValue is a helper method to define mock.On call
+//   - symbol string
+func (_e *MarketDataService_Expecter) ContractValue(symbol interface{}) *MarketDataService_ContractValue_Call {
+	return &MarketDataService_ContractValue_Call{Call: _e.mock.On("ContractValue", symbol)}
+}
+
+func (_c *MarketDataService_ContractValue_Call) Run(run func(symbol string)) *MarketDataService_ContractValue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MarketDataService_ContractValue_Call) Return(_a0 numerical.Decimal, _a1 error) *MarketDataService_ContractValue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MarketDataService_ContractValue_Call) RunAndReturn(run func(string) (numerical.Decimal, error)) *MarketDataService_ContractValue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FetchAvailablePerpetualAssets provides a mock function with no fields
+func (_m *MarketDataService) FetchAvailablePerpetualAssets() ([]portfolio.Asset, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchAvailablePerpetualAssets")
+	}
+
+	var r0 []portfolio.Asset
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]portfolio.Asset, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []portfolio.Asset); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]portfolio.Asset)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarketDataService_FetchAvailablePerpetualAssets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchAvailablePerpetualAssets'
+type MarketDataService_FetchAvailablePerpetualAssets_Call struct {
+	*mock.Call
+}
+
+// FetchAvailablePerpetualAssets is a helper method to define mock.On call
+func (_e *MarketDataService_Expecter) FetchAvailablePerpetualAssets() *MarketDataService_FetchAvailablePerpetualAssets_Call {
+	return &MarketDataService_FetchAvailablePerpetualAssets_Call{Call: _e.mock.On("FetchAvailablePerpetualAssets")}
+}
+
+func (_c *MarketDataService_FetchAvailablePerpetualAssets_Call) Run(run func()) *MarketDataService_FetchAvailablePerpetualAssets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MarketDataService_FetchAvailablePerpetualAssets_Call) Return(_a0 []portfolio.Asset, _a1 error) *MarketDataService_FetchAvailablePerpetualAssets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MarketDataService_FetchAvailablePerpetualAssets_Call) RunAndReturn(run func() ([]portfolio.Asset, error)) *MarketDataService_FetchAvailablePerpetualAssets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FetchAvailableSpotAssets provides a mock function with no fields
+func (_m *MarketDataService) FetchAvailableSpotAssets() ([]portfolio.Asset, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchAvailableSpotAssets")
+	}
+
+	var r0 []portfolio.Asset
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]portfolio.Asset, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []portfolio.Asset); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]portfolio.Asset)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarketDataService_FetchAvailableSpotAssets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchAvailableSpotAssets'
+type MarketDataService_FetchAvailableSpotAssets_Call struct {
+	*mock.Call
+}
+
+// FetchAvailableSpotAssets is a helper method to define mock.On call
+func (_e *MarketDataService_Expecter) FetchAvailableSpotAssets() *MarketDataService_FetchAvailableSpotAssets_Call {
+	return &MarketDataService_FetchAvailableSpotAssets_Call{Call: _e.mock.On("FetchAvailableSpotAssets")}
+}
+
+func (_c *MarketDataService_FetchAvailableSpotAssets_Call) Run(run func()) *MarketDataService_FetchAvailableSpotAssets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MarketDataService_FetchAvailableSpotAssets_Call) Return(_a0 []portfolio.Asset, _a1 error) *MarketDataService_FetchAvailableSpotAssets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MarketDataService_FetchAvailableSpotAssets_Call) RunAndReturn(run func() ([]portfolio.Asset, error)) *MarketDataService_FetchAvailableSpotAssets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FetchContracts provides a mock function with no fields
+func (_m *MarketDataService) FetchContracts() ([]connector.ContractInfo, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchContracts")
+	}
+
+	var r0 []connector.ContractInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]connector.ContractInfo, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []connector.ContractInfo); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]connector.ContractInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarketDataService_FetchContracts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchContracts'
+type MarketDataService_FetchContracts_Call struct {
+	*mock.Call
+}
+
+// FetchContracts is a helper method to define mock.On call
+func (_e *MarketDataService_Expecter) FetchContracts() *MarketDataService_FetchContracts_Call {
+	return &MarketDataService_FetchContracts_Call{Call: _e.mock.On("FetchContracts")}
+}
+
+func (_c *MarketDataService_FetchContracts_Call) Run(run func()) *MarketDataService_FetchContracts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MarketDataService_FetchContracts_Call) Return(_a0 []connector.ContractInfo, _a1 error) *MarketDataService_FetchContracts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MarketDataService_FetchContracts_Call) RunAndReturn(run func() ([]connector.ContractInfo, error)) *MarketDataService_FetchContracts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FetchCurrentFundingRates provides a mock function with no fields
+func (_m *MarketDataService) FetchCurrentFundingRates() (map[portfolio.Asset]connector.FundingRate, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchCurrentFundingRates")
+	}
+
+	var r0 map[portfolio.Asset]connector.FundingRate
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (map[portfolio.Asset]connector.FundingRate, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() map[portfolio.Asset]connector.FundingRate); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[portfolio.Asset]connector.FundingRate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarketDataService_FetchCurrentFundingRates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchCurrentFundingRates'
+type MarketDataService_FetchCurrentFundingRates_Call struct {
+	*mock.Call
+}
+
+// FetchCurrentFundingRates is a helper method to define mock.On call
+func (_e *MarketDataService_Expecter) FetchCurrentFundingRates() *MarketDataService_FetchCurrentFundingRates_Call {
+	return &MarketDataService_FetchCurrentFundingRates_Call{Call: _e.mock.On("FetchCurrentFundingRates")}
+}
+
+func (_c *MarketDataService_FetchCurrentFundingRates_Call) Run(run func()) *MarketDataService_FetchCurrentFundingRates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MarketDataService_FetchCurrentFundingRates_Call) Return(_a0 map[portfolio.Asset]connector.FundingRate, _a1 error) *MarketDataService_FetchCurrentFundingRates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MarketDataService_FetchCurrentFundingRates_Call) RunAndReturn(run func() (map[portfolio.Asset]connector.FundingRate, error)) *MarketDataService_FetchCurrentFundingRates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FetchFundingRate provides a mock function with given fields: symbol
+func (_m *MarketDataService) FetchFundingRate(symbol string) (*connector.FundingRate, error) {
+	ret := _m.Called(symbol)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchFundingRate")
+	}
+
+	var r0 *connector.FundingRate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*connector.FundingRate, error)); ok {
+		return rf(symbol)
+	}
+	if rf, ok := ret.Get(0).(func(string) *connector.FundingRate); ok {
+		r0 = rf(symbol)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connector.FundingRate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(symbol)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarketDataService_FetchFundingRate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchFundingRate'
+type MarketDataService_FetchFundingRate_Call struct {
+	*mock.Call
+}
+
+// FetchFundingRate is a helper method to define mock.On call
+//   - symbol string
+func (_e *MarketDataService_Expecter) FetchFundingRate(symbol interface{}) *MarketDataService_FetchFundingRate_Call {
+	return &MarketDataService_FetchFundingRate_Call{Call: _e.mock.On("FetchFundingRate", symbol)}
+}
+
+func (_c *MarketDataService_FetchFundingRate_Call) Run(run func(symbol string)) *MarketDataService_FetchFundingRate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MarketDataService_FetchFundingRate_Call) Return(_a0 *connector.FundingRate, _a1 error) *MarketDataService_FetchFundingRate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MarketDataService_FetchFundingRate_Call) RunAndReturn(run func(string) (*connector.FundingRate, error)) *MarketDataService_FetchFundingRate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FetchHistoricalFundingRates provides a mock function with given fields: symbol, startTime, endTime
+func (_m *MarketDataService) FetchHistoricalFundingRates(symbol string, startTime int64, endTime int64) ([]connector.HistoricalFundingRate, error) {
+	ret := _m.Called(symbol, startTime, endTime)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchHistoricalFundingRates")
+	}
+
+	var r0 []connector.HistoricalFundingRate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int64, int64) ([]connector.HistoricalFundingRate, error)); ok {
+		return rf(symbol, startTime, endTime)
+	}
+	if rf, ok := ret.Get(0).(func(string, int64, int64) []connector.HistoricalFundingRate); ok {
+		r0 = rf(symbol, startTime, endTime)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]connector.HistoricalFundingRate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int64, int64) error); ok {
+		r1 = rf(symbol, startTime, endTime)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarketDataService_FetchHistoricalFundingRates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchHistoricalFundingRates'
+type MarketDataService_FetchHistoricalFundingRates_Call struct {
+	*mock.Call
+}
+
+// FetchHistoricalFundingRates is a helper method to define mock.On call
+//   - symbol string
+//   - startTime int64
+//   - endTime int64
+func (_e *MarketDataService_Expecter) FetchHistoricalFundingRates(symbol interface{}, startTime interface{}, endTime interface{}) *MarketDataService_FetchHistoricalFundingRates_Call {
+	return &MarketDataService_FetchHistoricalFundingRates_Call{Call: _e.mock.On("FetchHistoricalFundingRates", symbol, startTime, endTime)}
+}
+
+func (_c *MarketDataService_FetchHistoricalFundingRates_Call) Run(run func(symbol string, startTime int64, endTime int64)) *MarketDataService_FetchHistoricalFundingRates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int64), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MarketDataService_FetchHistoricalFundingRates_Call) Return(_a0 []connector.HistoricalFundingRate, _a1 error) *MarketDataService_FetchHistoricalFundingRates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MarketDataService_FetchHistoricalFundingRates_Call) RunAndReturn(run func(string, int64, int64) ([]connector.HistoricalFundingRate, error)) *MarketDataService_FetchHistoricalFundingRates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FetchInstrumentStatuses provides a mock function with no fields
+func (_m *MarketDataService) FetchInstrumentStatuses() ([]types.InstrumentStatus, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchInstrumentStatuses")
+	}
+
+	var r0 []types.InstrumentStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]types.InstrumentStatus, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []types.InstrumentStatus); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.InstrumentStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarketDataService_FetchInstrumentStatuses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchInstrumentStatuses'
+type MarketDataService_FetchInstrumentStatuses_Call struct {
+	*mock.Call
+}
+
+// FetchInstrumentStatuses is a helper method to define mock.On call
+func (_e *MarketDataService_Expecter) FetchInstrumentStatuses() *MarketDataService_FetchInstrumentStatuses_Call {
+	return &MarketDataService_FetchInstrumentStatuses_Call{Call: _e.mock.On("FetchInstrumentStatuses")}
+}
+
+func (_c *MarketDataService_FetchInstrumentStatuses_Call) Run(run func()) *MarketDataService_FetchInstrumentStatuses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MarketDataService_FetchInstrumentStatuses_Call) Return(_a0 []types.InstrumentStatus, _a1 error) *MarketDataService_FetchInstrumentStatuses_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MarketDataService_FetchInstrumentStatuses_Call) RunAndReturn(run func() ([]types.InstrumentStatus, error)) *MarketDataService_FetchInstrumentStatuses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FetchKlines provides a mock function with given fields: instrument, symbol, interval, limit
+func (_m *MarketDataService) FetchKlines(instrument connector.Instrument, symbol string, interval string, limit int) ([]connector.Kline, error) {
+	ret := _m.Called(instrument, symbol, interval, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchKlines")
+	}
+
+	var r0 []connector.Kline
+	var r1 error
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string, string, int) ([]connector.Kline, error)); ok {
+		return rf(instrument, symbol, interval, limit)
+	}
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string, string, int) []connector.Kline); ok {
+		r0 = rf(instrument, symbol, interval, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]connector.Kline)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.Instrument, string, string, int) error); ok {
+		r1 = rf(instrument, symbol, interval, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarketDataService_FetchKlines_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchKlines'
+type MarketDataService_FetchKlines_Call struct {
+	*mock.Call
+}
+
+// FetchKlines is a helper method to define mock.On call
+//   - instrument connector.Instrument
+//   - symbol string
+//   - interval string
+//   - limit int
+func (_e *MarketDataService_Expecter) FetchKlines(instrument interface{}, symbol interface{}, interval interface{}, limit interface{}) *MarketDataService_FetchKlines_Call {
+	return &MarketDataService_FetchKlines_Call{Call: _e.mock.On("FetchKlines", instrument, symbol, interval, limit)}
+}
+
+func (_c *MarketDataService_FetchKlines_Call) Run(run func(instrument connector.Instrument, symbol string, interval string, limit int)) *MarketDataService_FetchKlines_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.Instrument), args[1].(string), args[2].(string), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *MarketDataService_FetchKlines_Call) Return(_a0 []connector.Kline, _a1 error) *MarketDataService_FetchKlines_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MarketDataService_FetchKlines_Call) RunAndReturn(run func(connector.Instrument, string, string, int) ([]connector.Kline, error)) *MarketDataService_FetchKlines_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FetchKlinesRange provides a mock function with given fields: symbol, interval, start, end, limit
+func (_m *MarketDataService) FetchKlinesRange(symbol string, interval string, start time.Time, end time.Time, limit int) ([]connector.Kline, error) {
+	ret := _m.Called(symbol, interval, start, end, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchKlinesRange")
+	}
+
+	var r0 []connector.Kline
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, time.Time, time.Time, int) ([]connector.Kline, error)); ok {
+		return rf(symbol, interval, start, end, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, time.Time, time.Time, int) []connector.Kline); ok {
+		r0 = rf(symbol, interval, start, end, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]connector.Kline)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, time.Time, time.Time, int) error); ok {
+		r1 = rf(symbol, interval, start, end, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarketDataService_FetchKlinesRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchKlinesRange'
+type MarketDataService_FetchKlinesRange_Call struct {
+	*mock.Call
+}
+
+// FetchKlinesRange is a helper method to define mock.On call
+//   - symbol string
+//   - interval string
+//   - start time.Time
+//   - end time.Time
+//   - limit int
+func (_e *MarketDataService_Expecter) FetchKlinesRange(symbol interface{}, interval interface{}, start interface{}, end interface{}, limit interface{}) *MarketDataService_FetchKlinesRange_Call {
+	return &MarketDataService_FetchKlinesRange_Call{Call: _e.mock.On("FetchKlinesRange", symbol, interval, start, end, limit)}
+}
+
+func (_c *MarketDataService_FetchKlinesRange_Call) Run(run func(symbol string, interval string, start time.Time, end time.Time, limit int)) *MarketDataService_FetchKlinesRange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(time.Time), args[3].(time.Time), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *MarketDataService_FetchKlinesRange_Call) Return(_a0 []connector.Kline, _a1 error) *MarketDataService_FetchKlinesRange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MarketDataService_FetchKlinesRange_Call) RunAndReturn(run func(string, string, time.Time, time.Time, int) ([]connector.Kline, error)) *MarketDataService_FetchKlinesRange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FetchOrderBook provides a mock function with given fields: instrument, symbol, depth
+func (_m *MarketDataService) FetchOrderBook(instrument connector.Instrument, symbol string, depth int) (*connector.OrderBook, error) {
+	ret := _m.Called(instrument, symbol, depth)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchOrderBook")
+	}
+
+	var r0 *connector.OrderBook
+	var r1 error
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string, int) (*connector.OrderBook, error)); ok {
+		return rf(instrument, symbol, depth)
+	}
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string, int) *connector.OrderBook); ok {
+		r0 = rf(instrument, symbol, depth)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connector.OrderBook)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.Instrument, string, int) error); ok {
+		r1 = rf(instrument, symbol, depth)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarketDataService_FetchOrderBook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchOrderBook'
+type MarketDataService_FetchOrderBook_Call struct {
+	*mock.Call
+}
+
+// FetchOrderBook is a helper method to define mock.On call
+//   - instrument connector.Instrument
+//   - symbol string
+//   - depth int
+func (_e *MarketDataService_Expecter) FetchOrderBook(instrument interface{}, symbol interface{}, depth interface{}) *MarketDataService_FetchOrderBook_Call {
+	return &MarketDataService_FetchOrderBook_Call{Call: _e.mock.On("FetchOrderBook", instrument, symbol, depth)}
+}
+
+func (_c *MarketDataService_FetchOrderBook_Call) Run(run func(instrument connector.Instrument, symbol string, depth int)) *MarketDataService_FetchOrderBook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.Instrument), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MarketDataService_FetchOrderBook_Call) Return(_a0 *connector.OrderBook, _a1 error) *MarketDataService_FetchOrderBook_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MarketDataService_FetchOrderBook_Call) RunAndReturn(run func(connector.Instrument, string, int) (*connector.OrderBook, error)) *MarketDataService_FetchOrderBook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FetchPrice provides a mock function with given fields: instrument, symbol
+func (_m *MarketDataService) FetchPrice(instrument connector.Instrument, symbol string) (*connector.Price, error) {
+	ret := _m.Called(instrument, symbol)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchPrice")
+	}
+
+	var r0 *connector.Price
+	var r1 error
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string) (*connector.Price, error)); ok {
+		return rf(instrument, symbol)
+	}
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string) *connector.Price); ok {
+		r0 = rf(instrument, symbol)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connector.Price)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.Instrument, string) error); ok {
+		r1 = rf(instrument, symbol)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarketDataService_FetchPrice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchPrice'
+type MarketDataService_FetchPrice_Call struct {
+	*mock.Call
+}
+
+// FetchPrice is a helper method to define mock.On call
+//   - instrument connector.Instrument
+//   - symbol string
+func (_e *MarketDataService_Expecter) FetchPrice(instrument interface{}, symbol interface{}) *MarketDataService_FetchPrice_Call {
+	return &MarketDataService_FetchPrice_Call{Call: _e.mock.On("FetchPrice", instrument, symbol)}
+}
+
+func (_c *MarketDataService_FetchPrice_Call) Run(run func(instrument connector.Instrument, symbol string)) *MarketDataService_FetchPrice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.Instrument), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MarketDataService_FetchPrice_Call) Return(_a0 *connector.Price, _a1 error) *MarketDataService_FetchPrice_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MarketDataService_FetchPrice_Call) RunAndReturn(run func(connector.Instrument, string) (*connector.Price, error)) *MarketDataService_FetchPrice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FetchRecentTrades provides a mock function with given fields: instrument, symbol, limit
+func (_m *MarketDataService) FetchRecentTrades(instrument connector.Instrument, symbol string, limit int) ([]connector.Trade, error) {
+	ret := _m.Called(instrument, symbol, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchRecentTrades")
+	}
+
+	var r0 []connector.Trade
+	var r1 error
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string, int) ([]connector.Trade, error)); ok {
+		return rf(instrument, symbol, limit)
+	}
+	if rf, ok := ret.Get(0).(func(connector.Instrument, string, int) []connector.Trade); ok {
+		r0 = rf(instrument, symbol, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]connector.Trade)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.Instrument, string, int) error); ok {
+		r1 = rf(instrument, symbol, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarketDataService_FetchRecentTrades_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchRecentTrades'
+type MarketDataService_FetchRecentTrades_Call struct {
+	*mock.Call
+}
+
+// FetchRecentTrades is a helper method to define mock.On call
+//   - instrument connector.Instrument
+//   - symbol string
+//   - limit int
+func (_e *MarketDataService_Expecter) FetchRecentTrades(instrument interface{}, symbol interface{}, limit interface{}) *MarketDataService_FetchRecentTrades_Call {
+	return &MarketDataService_FetchRecentTrades_Call{Call: _e.mock.On("FetchRecentTrades", instrument, symbol, limit)}
+}
+
+func (_c *MarketDataService_FetchRecentTrades_Call) Run(run func(instrument connector.Instrument, symbol string, limit int)) *MarketDataService_FetchRecentTrades_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.Instrument), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *MarketDataService_FetchRecentTrades_Call) Return(_a0 []connector.Trade, _a1 error) *MarketDataService_FetchRecentTrades_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MarketDataService_FetchRecentTrades_Call) RunAndReturn(run func(connector.Instrument, string, int) ([]connector.Trade, error)) *MarketDataService_FetchRecentTrades_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FetchServerTime provides a mock function with no fields
+func (_m *MarketDataService) FetchServerTime() (time.Time, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchServerTime")
+	}
+
+	var r0 time.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (time.Time, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() time.Time); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarketDataService_FetchServerTime_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchServerTime'
+type MarketDataService_FetchServerTime_Call struct {
+	*mock.Call
+}
+
+// FetchServerTime is a helper method to define mock.On call
+func (_e *MarketDataService_Expecter) FetchServerTime() *MarketDataService_FetchServerTime_Call {
+	return &MarketDataService_FetchServerTime_Call{Call: _e.mock.On("FetchServerTime")}
+}
+
+func (_c *MarketDataService_FetchServerTime_Call) Run(run func()) *MarketDataService_FetchServerTime_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MarketDataService_FetchServerTime_Call) Return(_a0 time.Time, _a1 error) *MarketDataService_FetchServerTime_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MarketDataService_FetchServerTime_Call) RunAndReturn(run func() (time.Time, error)) *MarketDataService_FetchServerTime_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMarketDataService creates a new instance of MarketDataService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMarketDataService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MarketDataService {
+	mock := &MarketDataService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
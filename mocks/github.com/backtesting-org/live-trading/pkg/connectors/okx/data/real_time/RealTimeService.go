@@ -0,0 +1,817 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package real_time
+
+import (
+	real_time "github.com/backtesting-org/live-trading/pkg/connectors/okx/data/real_time"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RealTimeService is an autogenerated mock type for the RealTimeService type
+type RealTimeService struct {
+	mock.Mock
+}
+
+type RealTimeService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RealTimeService) EXPECT() *RealTimeService_Expecter {
+	return &RealTimeService_Expecter{mock: &_m.Mock}
+}
+
+// Connect provides a mock function with no fields
+func (_m *RealTimeService) Connect() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Connect")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RealTimeService_Connect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Connect'
+type RealTimeService_Connect_Call struct {
+	*mock.Call
+}
+
+// Connect is a helper method to define mock.On call
+func (_e *RealTimeService_Expecter) Connect() *RealTimeService_Connect_Call {
+	return &RealTimeService_Connect_Call{Call: _e.mock.On("Connect")}
+}
+
+func (_c *RealTimeService_Connect_Call) Run(run func()) *RealTimeService_Connect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RealTimeService_Connect_Call) Return(_a0 error) *RealTimeService_Connect_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RealTimeService_Connect_Call) RunAndReturn(run func() error) *RealTimeService_Connect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Disconnect provides a mock function with no fields
+func (_m *RealTimeService) Disconnect() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Disconnect")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RealTimeService_Disconnect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Disconnect'
+type RealTimeService_Disconnect_Call struct {
+	*mock.Call
+}
+
+// Disconnect is a helper method to define mock.On call
+func (_e *RealTimeService_Expecter) Disconnect() *RealTimeService_Disconnect_Call {
+	return &RealTimeService_Disconnect_Call{Call: _e.mock.On("Disconnect")}
+}
+
+func (_c *RealTimeService_Disconnect_Call) Run(run func()) *RealTimeService_Disconnect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RealTimeService_Disconnect_Call) Return(_a0 error) *RealTimeService_Disconnect_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RealTimeService_Disconnect_Call) RunAndReturn(run func() error) *RealTimeService_Disconnect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetErrorChannel provides a mock function with no fields
+func (_m *RealTimeService) GetErrorChannel() <-chan error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetErrorChannel")
+	}
+
+	var r0 <-chan error
+	if rf, ok := ret.Get(0).(func() <-chan error); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan error)
+		}
+	}
+
+	return r0
+}
+
+// RealTimeService_GetErrorChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetErrorChannel'
+type RealTimeService_GetErrorChannel_Call struct {
+	*mock.Call
+}
+
+// GetErrorChannel is a helper method to define mock.On call
+func (_e *RealTimeService_Expecter) GetErrorChannel() *RealTimeService_GetErrorChannel_Call {
+	return &RealTimeService_GetErrorChannel_Call{Call: _e.mock.On("GetErrorChannel")}
+}
+
+func (_c *RealTimeService_GetErrorChannel_Call) Run(run func()) *RealTimeService_GetErrorChannel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RealTimeService_GetErrorChannel_Call) Return(_a0 <-chan error) *RealTimeService_GetErrorChannel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RealTimeService_GetErrorChannel_Call) RunAndReturn(run func() <-chan error) *RealTimeService_GetErrorChannel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Initialize provides a mock function with given fields: config
+func (_m *RealTimeService) Initialize(config *real_time.Config) error {
+	ret := _m.Called(config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Initialize")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*real_time.Config) error); ok {
+		r0 = rf(config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RealTimeService_Initialize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Initialize'
+type RealTimeService_Initialize_Call struct {
+	*mock.Call
+}
+
+// Initialize is a helper method to define mock.On call
+//   - config *real_time.Config
+func (_e *RealTimeService_Expecter) Initialize(config interface{}) *RealTimeService_Initialize_Call {
+	return &RealTimeService_Initialize_Call{Call: _e.mock.On("Initialize", config)}
+}
+
+func (_c *RealTimeService_Initialize_Call) Run(run func(config *real_time.Config)) *RealTimeService_Initialize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*real_time.Config))
+	})
+	return _c
+}
+
+func (_c *RealTimeService_Initialize_Call) Return(_a0 error) *RealTimeService_Initialize_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RealTimeService_Initialize_Call) RunAndReturn(run func(*real_time.Config) error) *RealTimeService_Initialize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsConnected provides a mock function with no fields
+func (_m *RealTimeService) IsConnected() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsConnected")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// RealTimeService_IsConnected_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsConnected'
+type RealTimeService_IsConnected_Call struct {
+	*mock.Call
+}
+
+// IsConnected is a helper method to define mock.On call
+func (_e *RealTimeService_Expecter) IsConnected() *RealTimeService_IsConnected_Call {
+	return &RealTimeService_IsConnected_Call{Call: _e.mock.On("IsConnected")}
+}
+
+func (_c *RealTimeService_IsConnected_Call) Run(run func()) *RealTimeService_IsConnected_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RealTimeService_IsConnected_Call) Return(_a0 bool) *RealTimeService_IsConnected_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RealTimeService_IsConnected_Call) RunAndReturn(run func() bool) *RealTimeService_IsConnected_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SubscribeAccount provides a mock function with given fields: callback
+func (_m *RealTimeService) SubscribeAccount(callback func(*real_time.AccountMessage)) error {
+	ret := _m.Called(callback)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribeAccount")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(func(*real_time.AccountMessage)) error); ok {
+		r0 = rf(callback)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RealTimeService_SubscribeAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeAccount'
+type RealTimeService_SubscribeAccount_Call struct {
+	*mock.Call
+}
+
+// SubscribeAccount is a helper method to define mock.On call
+//   - callback func(*real_time.AccountMessage)
+func (_e *RealTimeService_Expecter) SubscribeAccount(callback interface{}) *RealTimeService_SubscribeAccount_Call {
+	return &RealTimeService_SubscribeAccount_Call{Call: _e.mock.On("SubscribeAccount", callback)}
+}
+
+func (_c *RealTimeService_SubscribeAccount_Call) Run(run func(callback func(*real_time.AccountMessage))) *RealTimeService_SubscribeAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(func(*real_time.AccountMessage)))
+	})
+	return _c
+}
+
+func (_c *RealTimeService_SubscribeAccount_Call) Return(_a0 error) *RealTimeService_SubscribeAccount_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RealTimeService_SubscribeAccount_Call) RunAndReturn(run func(func(*real_time.AccountMessage)) error) *RealTimeService_SubscribeAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SubscribeFills provides a mock function with given fields: callback
+func (_m *RealTimeService) SubscribeFills(callback func(*real_time.FillMessage)) error {
+	ret := _m.Called(callback)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribeFills")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(func(*real_time.FillMessage)) error); ok {
+		r0 = rf(callback)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RealTimeService_SubscribeFills_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeFills'
+type RealTimeService_SubscribeFills_Call struct {
+	*mock.Call
+}
+
+// SubscribeFills is a helper method to define mock.On call
+//   - callback func(*real_time.FillMessage)
+func (_e *RealTimeService_Expecter) SubscribeFills(callback interface{}) *RealTimeService_SubscribeFills_Call {
+	return &RealTimeService_SubscribeFills_Call{Call: _e.mock.On("SubscribeFills", callback)}
+}
+
+func (_c *RealTimeService_SubscribeFills_Call) Run(run func(callback func(*real_time.FillMessage))) *RealTimeService_SubscribeFills_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(func(*real_time.FillMessage)))
+	})
+	return _c
+}
+
+func (_c *RealTimeService_SubscribeFills_Call) Return(_a0 error) *RealTimeService_SubscribeFills_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RealTimeService_SubscribeFills_Call) RunAndReturn(run func(func(*real_time.FillMessage)) error) *RealTimeService_SubscribeFills_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SubscribeKlines provides a mock function with given fields: symbol, interval, callback
+func (_m *RealTimeService) SubscribeKlines(symbol string, interval string, callback func(*real_time.KlineMessage)) error {
+	ret := _m.Called(symbol, interval, callback)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribeKlines")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, func(*real_time.KlineMessage)) error); ok {
+		r0 = rf(symbol, interval, callback)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RealTimeService_SubscribeKlines_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeKlines'
+type RealTimeService_SubscribeKlines_Call struct {
+	*mock.Call
+}
+
+// SubscribeKlines is a helper method to define mock.On call
+//   - symbol string
+//   - interval string
+//   - callback func(*real_time.KlineMessage)
+func (_e *RealTimeService_Expecter) SubscribeKlines(symbol interface{}, interval interface{}, callback interface{}) *RealTimeService_SubscribeKlines_Call {
+	return &RealTimeService_SubscribeKlines_Call{Call: _e.mock.On("SubscribeKlines", symbol, interval, callback)}
+}
+
+func (_c *RealTimeService_SubscribeKlines_Call) Run(run func(symbol string, interval string, callback func(*real_time.KlineMessage))) *RealTimeService_SubscribeKlines_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(func(*real_time.KlineMessage)))
+	})
+	return _c
+}
+
+func (_c *RealTimeService_SubscribeKlines_Call) Return(_a0 error) *RealTimeService_SubscribeKlines_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RealTimeService_SubscribeKlines_Call) RunAndReturn(run func(string, string, func(*real_time.KlineMessage)) error) *RealTimeService_SubscribeKlines_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SubscribeOrderBook provides a mock function with given fields: symbol, callback
+func (_m *RealTimeService) SubscribeOrderBook(symbol string, callback func(*real_time.OrderBookMessage)) error {
+	ret := _m.Called(symbol, callback)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribeOrderBook")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, func(*real_time.OrderBookMessage)) error); ok {
+		r0 = rf(symbol, callback)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RealTimeService_SubscribeOrderBook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeOrderBook'
+type RealTimeService_SubscribeOrderBook_Call struct {
+	*mock.Call
+}
+
+// SubscribeOrderBook is a helper method to define mock.On call
+//   - symbol string
+//   - callback func(*real_time.OrderBookMessage)
+func (_e *RealTimeService_Expecter) SubscribeOrderBook(symbol interface{}, callback interface{}) *RealTimeService_SubscribeOrderBook_Call {
+	return &RealTimeService_SubscribeOrderBook_Call{Call: _e.mock.On("SubscribeOrderBook", symbol, callback)}
+}
+
+func (_c *RealTimeService_SubscribeOrderBook_Call) Run(run func(symbol string, callback func(*real_time.OrderBookMessage))) *RealTimeService_SubscribeOrderBook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(func(*real_time.OrderBookMessage)))
+	})
+	return _c
+}
+
+func (_c *RealTimeService_SubscribeOrderBook_Call) Return(_a0 error) *RealTimeService_SubscribeOrderBook_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RealTimeService_SubscribeOrderBook_Call) RunAndReturn(run func(string, func(*real_time.OrderBookMessage)) error) *RealTimeService_SubscribeOrderBook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SubscribePositions provides a mock function with given fields: callback
+func (_m *RealTimeService) SubscribePositions(callback func(*real_time.PositionMessage)) error {
+	ret := _m.Called(callback)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribePositions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(func(*real_time.PositionMessage)) error); ok {
+		r0 = rf(callback)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RealTimeService_SubscribePositions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribePositions'
+type RealTimeService_SubscribePositions_Call struct {
+	*mock.Call
+}
+
+// SubscribePositions is a helper method to define mock.On call
+//   - callback func(*real_time.PositionMessage)
+func (_e *RealTimeService_Expecter) SubscribePositions(callback interface{}) *RealTimeService_SubscribePositions_Call {
+	return &RealTimeService_SubscribePositions_Call{Call: _e.mock.On("SubscribePositions", callback)}
+}
+
+func (_c *RealTimeService_SubscribePositions_Call) Run(run func(callback func(*real_time.PositionMessage))) *RealTimeService_SubscribePositions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(func(*real_time.PositionMessage)))
+	})
+	return _c
+}
+
+func (_c *RealTimeService_SubscribePositions_Call) Return(_a0 error) *RealTimeService_SubscribePositions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RealTimeService_SubscribePositions_Call) RunAndReturn(run func(func(*real_time.PositionMessage)) error) *RealTimeService_SubscribePositions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SubscribeTrades provides a mock function with given fields: symbol, callback
+func (_m *RealTimeService) SubscribeTrades(symbol string, callback func(*real_time.TradeMessage)) error {
+	ret := _m.Called(symbol, callback)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribeTrades")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, func(*real_time.TradeMessage)) error); ok {
+		r0 = rf(symbol, callback)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RealTimeService_SubscribeTrades_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeTrades'
+type RealTimeService_SubscribeTrades_Call struct {
+	*mock.Call
+}
+
+// SubscribeTrades is a helper method to define mock.On call
+//   - symbol string
+//   - callback func(*real_time.TradeMessage)
+func (_e *RealTimeService_Expecter) SubscribeTrades(symbol interface{}, callback interface{}) *RealTimeService_SubscribeTrades_Call {
+	return &RealTimeService_SubscribeTrades_Call{Call: _e.mock.On("SubscribeTrades", symbol, callback)}
+}
+
+func (_c *RealTimeService_SubscribeTrades_Call) Run(run func(symbol string, callback func(*real_time.TradeMessage))) *RealTimeService_SubscribeTrades_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(func(*real_time.TradeMessage)))
+	})
+	return _c
+}
+
+func (_c *RealTimeService_SubscribeTrades_Call) Return(_a0 error) *RealTimeService_SubscribeTrades_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RealTimeService_SubscribeTrades_Call) RunAndReturn(run func(string, func(*real_time.TradeMessage)) error) *RealTimeService_SubscribeTrades_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnsubscribeAccount provides a mock function with no fields
+func (_m *RealTimeService) UnsubscribeAccount() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnsubscribeAccount")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RealTimeService_UnsubscribeAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnsubscribeAccount'
+type RealTimeService_UnsubscribeAccount_Call struct {
+	*mock.Call
+}
+
+// UnsubscribeAccount is a helper method to define mock.On call
+func (_e *RealTimeService_Expecter) UnsubscribeAccount() *RealTimeService_UnsubscribeAccount_Call {
+	return &RealTimeService_UnsubscribeAccount_Call{Call: _e.mock.On("UnsubscribeAccount")}
+}
+
+func (_c *RealTimeService_UnsubscribeAccount_Call) Run(run func()) *RealTimeService_UnsubscribeAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RealTimeService_UnsubscribeAccount_Call) Return(_a0 error) *RealTimeService_UnsubscribeAccount_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RealTimeService_UnsubscribeAccount_Call) RunAndReturn(run func() error) *RealTimeService_UnsubscribeAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnsubscribeFills provides a mock function with no fields
+func (_m *RealTimeService) UnsubscribeFills() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnsubscribeFills")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RealTimeService_UnsubscribeFills_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnsubscribeFills'
+type RealTimeService_UnsubscribeFills_Call struct {
+	*mock.Call
+}
+
+// UnsubscribeFills is a helper method to define mock.On call
+func (_e *RealTimeService_Expecter) UnsubscribeFills() *RealTimeService_UnsubscribeFills_Call {
+	return &RealTimeService_UnsubscribeFills_Call{Call: _e.mock.On("UnsubscribeFills")}
+}
+
+func (_c *RealTimeService_UnsubscribeFills_Call) Run(run func()) *RealTimeService_UnsubscribeFills_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RealTimeService_UnsubscribeFills_Call) Return(_a0 error) *RealTimeService_UnsubscribeFills_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RealTimeService_UnsubscribeFills_Call) RunAndReturn(run func() error) *RealTimeService_UnsubscribeFills_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnsubscribeKlines provides a mock function with given fields: symbol, interval
+func (_m *RealTimeService) UnsubscribeKlines(symbol string, interval string) error {
+	ret := _m.Called(symbol, interval)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnsubscribeKlines")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(symbol, interval)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RealTimeService_UnsubscribeKlines_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnsubscribeKlines'
+type RealTimeService_UnsubscribeKlines_Call struct {
+	*mock.Call
+}
+
+// UnsubscribeKlines is a helper method to define mock.On call
+//   - symbol string
+//   - interval string
+func (_e *RealTimeService_Expecter) UnsubscribeKlines(symbol interface{}, interval interface{}) *RealTimeService_UnsubscribeKlines_Call {
+	return &RealTimeService_UnsubscribeKlines_Call{Call: _e.mock.On("UnsubscribeKlines", symbol, interval)}
+}
+
+func (_c *RealTimeService_UnsubscribeKlines_Call) Run(run func(symbol string, interval string)) *RealTimeService_UnsubscribeKlines_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RealTimeService_UnsubscribeKlines_Call) Return(_a0 error) *RealTimeService_UnsubscribeKlines_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RealTimeService_UnsubscribeKlines_Call) RunAndReturn(run func(string, string) error) *RealTimeService_UnsubscribeKlines_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnsubscribeOrderBook provides a mock function with given fields: symbol
+func (_m *RealTimeService) UnsubscribeOrderBook(symbol string) error {
+	ret := _m.Called(symbol)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnsubscribeOrderBook")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(symbol)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RealTimeService_UnsubscribeOrderBook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnsubscribeOrderBook'
+type RealTimeService_UnsubscribeOrderBook_Call struct {
+	*mock.Call
+}
+
+// UnsubscribeOrderBook is a helper method to define mock.On call
+//   - symbol string
+func (_e *RealTimeService_Expecter) UnsubscribeOrderBook(symbol interface{}) *RealTimeService_UnsubscribeOrderBook_Call {
+	return &RealTimeService_UnsubscribeOrderBook_Call{Call: _e.mock.On("UnsubscribeOrderBook", symbol)}
+}
+
+func (_c *RealTimeService_UnsubscribeOrderBook_Call) Run(run func(symbol string)) *RealTimeService_UnsubscribeOrderBook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *RealTimeService_UnsubscribeOrderBook_Call) Return(_a0 error) *RealTimeService_UnsubscribeOrderBook_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RealTimeService_UnsubscribeOrderBook_Call) RunAndReturn(run func(string) error) *RealTimeService_UnsubscribeOrderBook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnsubscribePositions provides a mock function with no fields
+func (_m *RealTimeService) UnsubscribePositions() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnsubscribePositions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RealTimeService_UnsubscribePositions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnsubscribePositions'
+type RealTimeService_UnsubscribePositions_Call struct {
+	*mock.Call
+}
+
+// UnsubscribePositions is a helper method to define mock.On call
+func (_e *RealTimeService_Expecter) UnsubscribePositions() *RealTimeService_UnsubscribePositions_Call {
+	return &RealTimeService_UnsubscribePositions_Call{Call: _e.mock.On("UnsubscribePositions")}
+}
+
+func (_c *RealTimeService_UnsubscribePositions_Call) Run(run func()) *RealTimeService_UnsubscribePositions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RealTimeService_UnsubscribePositions_Call) Return(_a0 error) *RealTimeService_UnsubscribePositions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RealTimeService_UnsubscribePositions_Call) RunAndReturn(run func() error) *RealTimeService_UnsubscribePositions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnsubscribeTrades provides a mock function with given fields: symbol
+func (_m *RealTimeService) UnsubscribeTrades(symbol string) error {
+	ret := _m.Called(symbol)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnsubscribeTrades")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(symbol)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RealTimeService_UnsubscribeTrades_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnsubscribeTrades'
+type RealTimeService_UnsubscribeTrades_Call struct {
+	*mock.Call
+}
+
+// UnsubscribeTrades is a helper method to define mock.On call
+//   - symbol string
+func (_e *RealTimeService_Expecter) UnsubscribeTrades(symbol interface{}) *RealTimeService_UnsubscribeTrades_Call {
+	return &RealTimeService_UnsubscribeTrades_Call{Call: _e.mock.On("UnsubscribeTrades", symbol)}
+}
+
+func (_c *RealTimeService_UnsubscribeTrades_Call) Run(run func(symbol string)) *RealTimeService_UnsubscribeTrades_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *RealTimeService_UnsubscribeTrades_Call) Return(_a0 error) *RealTimeService_UnsubscribeTrades_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RealTimeService_UnsubscribeTrades_Call) RunAndReturn(run func(string) error) *RealTimeService_UnsubscribeTrades_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRealTimeService creates a new instance of RealTimeService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRealTimeService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RealTimeService {
+	mock := &RealTimeService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
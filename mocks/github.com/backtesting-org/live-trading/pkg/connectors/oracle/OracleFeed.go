@@ -0,0 +1,438 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package oracle
+
+import (
+	numerical "github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	oracle "github.com/backtesting-org/live-trading/pkg/connectors/oracle"
+	mock "github.com/stretchr/testify/mock"
+
+	portfolio "github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+)
+
+// OracleFeed is an autogenerated mock type for the OracleFeed type
+type OracleFeed struct {
+	mock.Mock
+}
+
+type OracleFeed_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OracleFeed) EXPECT() *OracleFeed_Expecter {
+	return &OracleFeed_Expecter{mock: &_m.Mock}
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *OracleFeed) Configure(config oracle.Config) error {
+	ret := _m.Called(config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Configure")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(oracle.Config) error); ok {
+		r0 = rf(config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OracleFeed_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type OracleFeed_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config oracle.Config
+func (_e *OracleFeed_Expecter) Configure(config interface{}) *OracleFeed_Configure_Call {
+	return &OracleFeed_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *OracleFeed_Configure_Call) Run(run func(config oracle.Config)) *OracleFeed_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(oracle.Config))
+	})
+	return _c
+}
+
+func (_c *OracleFeed_Configure_Call) Return(_a0 error) *OracleFeed_Configure_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OracleFeed_Configure_Call) RunAndReturn(run func(oracle.Config) error) *OracleFeed_Configure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Price provides a mock function with given fields: asset
+func (_m *OracleFeed) Price(asset portfolio.Asset) (*oracle.Price, bool) {
+	ret := _m.Called(asset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Price")
+	}
+
+	var r0 *oracle.Price
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(portfolio.Asset) (*oracle.Price, bool)); ok {
+		return rf(asset)
+	}
+	if rf, ok := ret.Get(0).(func(portfolio.Asset) *oracle.Price); ok {
+		r0 = rf(asset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*oracle.Price)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(portfolio.Asset) bool); ok {
+		r1 = rf(asset)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// OracleFeed_Price_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Price'
+type OracleFeed_Price_Call struct {
+	*mock.Call
+}
+
+// Price is a helper method to define mock.On call
+//   - asset portfolio.Asset
+func (_e *OracleFeed_Expecter) Price(asset interface{}) *OracleFeed_Price_Call {
+	return &OracleFeed_Price_Call{Call: _e.mock.On("Price", asset)}
+}
+
+func (_c *OracleFeed_Price_Call) Run(run func(asset portfolio.Asset)) *OracleFeed_Price_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(portfolio.Asset))
+	})
+	return _c
+}
+
+func (_c *OracleFeed_Price_Call) Return(_a0 *oracle.Price, _a1 bool) *OracleFeed_Price_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OracleFeed_Price_Call) RunAndReturn(run func(portfolio.Asset) (*oracle.Price, bool)) *OracleFeed_Price_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReferencePrice provides a mock function with given fields: asset
+func (_m *OracleFeed) ReferencePrice(asset portfolio.Asset) (numerical.Decimal, error) {
+	ret := _m.Called(asset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReferencePrice")
+	}
+
+	var r0 numerical.Decimal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(portfolio.Asset) (numerical.Decimal, error)); ok {
+		return rf(asset)
+	}
+	if rf, ok := ret.Get(0).(func(portfolio.Asset) numerical.Decimal); ok {
+		r0 = rf(asset)
+	} else {
+		r0 = ret.Get(0).(numerical.Decimal)
+	}
+
+	if rf, ok := ret.Get(1).(func(portfolio.Asset) error); ok {
+		r1 = rf(asset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OracleFeed_ReferencePrice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReferencePrice'
+type OracleFeed_ReferencePrice_Call struct {
+	*mock.Call
+}
+
+// ReferencePrice is a helper method to define mock.On call
+//   - asset portfolio.Asset
+func (_e *OracleFeed_Expecter) ReferencePrice(asset interface{}) *OracleFeed_ReferencePrice_Call {
+	return &OracleFeed_ReferencePrice_Call{Call: _e.mock.On("ReferencePrice", asset)}
+}
+
+func (_c *OracleFeed_ReferencePrice_Call) Run(run func(asset portfolio.Asset)) *OracleFeed_ReferencePrice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(portfolio.Asset))
+	})
+	return _c
+}
+
+func (_c *OracleFeed_ReferencePrice_Call) Return(_a0 numerical.Decimal, _a1 error) *OracleFeed_ReferencePrice_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OracleFeed_ReferencePrice_Call) RunAndReturn(run func(portfolio.Asset) (numerical.Decimal, error)) *OracleFeed_ReferencePrice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Refresh provides a mock function with no fields
+func (_m *OracleFeed) Refresh() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Refresh")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OracleFeed_Refresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Refresh'
+type OracleFeed_Refresh_Call struct {
+	*mock.Call
+}
+
+// Refresh is a helper method to define mock.On call
+func (_e *OracleFeed_Expecter) Refresh() *OracleFeed_Refresh_Call {
+	return &OracleFeed_Refresh_Call{Call: _e.mock.On("Refresh")}
+}
+
+func (_c *OracleFeed_Refresh_Call) Run(run func()) *OracleFeed_Refresh_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OracleFeed_Refresh_Call) Return(_a0 error) *OracleFeed_Refresh_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OracleFeed_Refresh_Call) RunAndReturn(run func() error) *OracleFeed_Refresh_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Snapshot provides a mock function with given fields: asset
+func (_m *OracleFeed) Snapshot(asset portfolio.Asset) (*oracle.Snapshot, bool) {
+	ret := _m.Called(asset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Snapshot")
+	}
+
+	var r0 *oracle.Snapshot
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(portfolio.Asset) (*oracle.Snapshot, bool)); ok {
+		return rf(asset)
+	}
+	if rf, ok := ret.Get(0).(func(portfolio.Asset) *oracle.Snapshot); ok {
+		r0 = rf(asset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*oracle.Snapshot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(portfolio.Asset) bool); ok {
+		r1 = rf(asset)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// OracleFeed_Snapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Snapshot'
+type OracleFeed_Snapshot_Call struct {
+	*mock.Call
+}
+
+// Snapshot is a helper method to define mock.On call
+//   - asset portfolio.Asset
+func (_e *OracleFeed_Expecter) Snapshot(asset interface{}) *OracleFeed_Snapshot_Call {
+	return &OracleFeed_Snapshot_Call{Call: _e.mock.On("Snapshot", asset)}
+}
+
+func (_c *OracleFeed_Snapshot_Call) Run(run func(asset portfolio.Asset)) *OracleFeed_Snapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(portfolio.Asset))
+	})
+	return _c
+}
+
+func (_c *OracleFeed_Snapshot_Call) Return(_a0 *oracle.Snapshot, _a1 bool) *OracleFeed_Snapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OracleFeed_Snapshot_Call) RunAndReturn(run func(portfolio.Asset) (*oracle.Snapshot, bool)) *OracleFeed_Snapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Snapshots provides a mock function with no fields
+func (_m *OracleFeed) Snapshots() []oracle.Snapshot {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Snapshots")
+	}
+
+	var r0 []oracle.Snapshot
+	if rf, ok := ret.Get(0).(func() []oracle.Snapshot); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]oracle.Snapshot)
+		}
+	}
+
+	return r0
+}
+
+// OracleFeed_Snapshots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Snapshots'
+type OracleFeed_Snapshots_Call struct {
+	*mock.Call
+}
+
+// Snapshots is a helper method to define mock.On call
+func (_e *OracleFeed_Expecter) Snapshots() *OracleFeed_Snapshots_Call {
+	return &OracleFeed_Snapshots_Call{Call: _e.mock.On("Snapshots")}
+}
+
+func (_c *OracleFeed_Snapshots_Call) Run(run func()) *OracleFeed_Snapshots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OracleFeed_Snapshots_Call) Return(_a0 []oracle.Snapshot) *OracleFeed_Snapshots_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OracleFeed_Snapshots_Call) RunAndReturn(run func() []oracle.Snapshot) *OracleFeed_Snapshots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with no fields
+func (_m *OracleFeed) Start() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OracleFeed_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type OracleFeed_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+func (_e *OracleFeed_Expecter) Start() *OracleFeed_Start_Call {
+	return &OracleFeed_Start_Call{Call: _e.mock.On("Start")}
+}
+
+func (_c *OracleFeed_Start_Call) Run(run func()) *OracleFeed_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OracleFeed_Start_Call) Return(_a0 error) *OracleFeed_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OracleFeed_Start_Call) RunAndReturn(run func() error) *OracleFeed_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with no fields
+func (_m *OracleFeed) Stop() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OracleFeed_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type OracleFeed_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *OracleFeed_Expecter) Stop() *OracleFeed_Stop_Call {
+	return &OracleFeed_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *OracleFeed_Stop_Call) Run(run func()) *OracleFeed_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OracleFeed_Stop_Call) Return(_a0 error) *OracleFeed_Stop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OracleFeed_Stop_Call) RunAndReturn(run func() error) *OracleFeed_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOracleFeed creates a new instance of OracleFeed. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOracleFeed(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OracleFeed {
+	mock := &OracleFeed{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
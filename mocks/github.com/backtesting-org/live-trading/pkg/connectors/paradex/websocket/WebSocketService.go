@@ -3,8 +3,10 @@
 package websockets
 
 import (
-	websockets "github.com/backtesting-org/live-trading/pkg/connectors/paradex/websocket"
+	connector "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
 	mock "github.com/stretchr/testify/mock"
+
+	websockets "github.com/backtesting-org/live-trading/pkg/connectors/paradex/websocket"
 )
 
 // WebSocketService is an autogenerated mock type for the WebSocketService type
@@ -342,19 +344,19 @@ func (_c *WebSocketService_IsWebSocketConnected_Call) RunAndReturn(run func() bo
 }
 
 // KlineUpdates provides a mock function with no fields
-func (_m *WebSocketService) KlineUpdates() <-chan websockets.KlineUpdate {
+func (_m *WebSocketService) KlineUpdates() <-chan connector.Kline {
 	ret := _m.Called()
 
 	if len(ret) == 0 {
 		panic("no return value specified for KlineUpdates")
 	}
 
-	var r0 <-chan websockets.KlineUpdate
-	if rf, ok := ret.Get(0).(func() <-chan websockets.KlineUpdate); ok {
+	var r0 <-chan connector.Kline
+	if rf, ok := ret.Get(0).(func() <-chan connector.Kline); ok {
 		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(<-chan websockets.KlineUpdate)
+			r0 = ret.Get(0).(<-chan connector.Kline)
 		}
 	}
 
@@ -378,12 +380,12 @@ func (_c *WebSocketService_KlineUpdates_Call) Run(run func()) *WebSocketService_
 	return _c
 }
 
-func (_c *WebSocketService_KlineUpdates_Call) Return(_a0 <-chan websockets.KlineUpdate) *WebSocketService_KlineUpdates_Call {
+func (_c *WebSocketService_KlineUpdates_Call) Return(_a0 <-chan connector.Kline) *WebSocketService_KlineUpdates_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *WebSocketService_KlineUpdates_Call) RunAndReturn(run func() <-chan websockets.KlineUpdate) *WebSocketService_KlineUpdates_Call {
+func (_c *WebSocketService_KlineUpdates_Call) RunAndReturn(run func() <-chan connector.Kline) *WebSocketService_KlineUpdates_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -662,6 +664,53 @@ func (_c *WebSocketService_SubscribeTrades_Call) RunAndReturn(run func(string) e
 	return _c
 }
 
+// TrackKlines provides a mock function with given fields: symbol, interval
+func (_m *WebSocketService) TrackKlines(symbol string, interval string) error {
+	ret := _m.Called(symbol, interval)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TrackKlines")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(symbol, interval)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebSocketService_TrackKlines_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TrackKlines'
+type WebSocketService_TrackKlines_Call struct {
+	*mock.Call
+}
+
+// TrackKlines is a helper method to define mock.On call
+//   - symbol string
+//   - interval string
+func (_e *WebSocketService_Expecter) TrackKlines(symbol interface{}, interval interface{}) *WebSocketService_TrackKlines_Call {
+	return &WebSocketService_TrackKlines_Call{Call: _e.mock.On("TrackKlines", symbol, interval)}
+}
+
+func (_c *WebSocketService_TrackKlines_Call) Run(run func(symbol string, interval string)) *WebSocketService_TrackKlines_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *WebSocketService_TrackKlines_Call) Return(_a0 error) *WebSocketService_TrackKlines_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebSocketService_TrackKlines_Call) RunAndReturn(run func(string, string) error) *WebSocketService_TrackKlines_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TradeUpdates provides a mock function with no fields
 func (_m *WebSocketService) TradeUpdates() <-chan websockets.TradeUpdate {
 	ret := _m.Called()
@@ -846,6 +895,40 @@ func (_c *WebSocketService_UnsubscribeTrades_Call) RunAndReturn(run func(string)
 	return _c
 }
 
+// UntrackKlines provides a mock function with given fields: symbol, interval
+func (_m *WebSocketService) UntrackKlines(symbol string, interval string) {
+	_m.Called(symbol, interval)
+}
+
+// WebSocketService_UntrackKlines_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UntrackKlines'
+type WebSocketService_UntrackKlines_Call struct {
+	*mock.Call
+}
+
+// UntrackKlines is a helper method to define mock.On call
+//   - symbol string
+//   - interval string
+func (_e *WebSocketService_Expecter) UntrackKlines(symbol interface{}, interval interface{}) *WebSocketService_UntrackKlines_Call {
+	return &WebSocketService_UntrackKlines_Call{Call: _e.mock.On("UntrackKlines", symbol, interval)}
+}
+
+func (_c *WebSocketService_UntrackKlines_Call) Run(run func(symbol string, interval string)) *WebSocketService_UntrackKlines_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *WebSocketService_UntrackKlines_Call) Return() *WebSocketService_UntrackKlines_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *WebSocketService_UntrackKlines_Call) RunAndReturn(run func(string, string)) *WebSocketService_UntrackKlines_Call {
+	_c.Run(run)
+	return _c
+}
+
 // NewWebSocketService creates a new instance of WebSocketService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewWebSocketService(t interface {
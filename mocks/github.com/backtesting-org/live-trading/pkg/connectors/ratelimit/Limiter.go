@@ -0,0 +1,127 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package ratelimit
+
+import (
+	ratelimit "github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	types "github.com/backtesting-org/live-trading/pkg/connectors/types"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Limiter is an autogenerated mock type for the Limiter type
+type Limiter struct {
+	mock.Mock
+}
+
+type Limiter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Limiter) EXPECT() *Limiter_Expecter {
+	return &Limiter_Expecter{mock: &_m.Mock}
+}
+
+// Status provides a mock function with no fields
+func (_m *Limiter) Status() types.RateLimitStatus {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Status")
+	}
+
+	var r0 types.RateLimitStatus
+	if rf, ok := ret.Get(0).(func() types.RateLimitStatus); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(types.RateLimitStatus)
+	}
+
+	return r0
+}
+
+// Limiter_Status_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Status'
+type Limiter_Status_Call struct {
+	*mock.Call
+}
+
+// Status is a helper method to define mock.On call
+func (_e *Limiter_Expecter) Status() *Limiter_Status_Call {
+	return &Limiter_Status_Call{Call: _e.mock.On("Status")}
+}
+
+func (_c *Limiter_Status_Call) Run(run func()) *Limiter_Status_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Limiter_Status_Call) Return(_a0 types.RateLimitStatus) *Limiter_Status_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Limiter_Status_Call) RunAndReturn(run func() types.RateLimitStatus) *Limiter_Status_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Wait provides a mock function with given fields: endpoint
+func (_m *Limiter) Wait(endpoint ratelimit.Endpoint) error {
+	ret := _m.Called(endpoint)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Wait")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(ratelimit.Endpoint) error); ok {
+		r0 = rf(endpoint)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Limiter_Wait_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Wait'
+type Limiter_Wait_Call struct {
+	*mock.Call
+}
+
+// Wait is a helper method to define mock.On call
+//   - endpoint ratelimit.Endpoint
+func (_e *Limiter_Expecter) Wait(endpoint interface{}) *Limiter_Wait_Call {
+	return &Limiter_Wait_Call{Call: _e.mock.On("Wait", endpoint)}
+}
+
+func (_c *Limiter_Wait_Call) Run(run func(endpoint ratelimit.Endpoint)) *Limiter_Wait_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(ratelimit.Endpoint))
+	})
+	return _c
+}
+
+func (_c *Limiter_Wait_Call) Return(_a0 error) *Limiter_Wait_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Limiter_Wait_Call) RunAndReturn(run func(ratelimit.Endpoint) error) *Limiter_Wait_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewLimiter creates a new instance of Limiter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLimiter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Limiter {
+	mock := &Limiter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
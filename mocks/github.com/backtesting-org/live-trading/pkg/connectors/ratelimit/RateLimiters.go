@@ -0,0 +1,168 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package ratelimit
+
+import (
+	connector "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	mock "github.com/stretchr/testify/mock"
+
+	ratelimit "github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+
+	types "github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+// RateLimiters is an autogenerated mock type for the RateLimiters type
+type RateLimiters struct {
+	mock.Mock
+}
+
+type RateLimiters_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RateLimiters) EXPECT() *RateLimiters_Expecter {
+	return &RateLimiters_Expecter{mock: &_m.Mock}
+}
+
+// Configure provides a mock function with given fields: exchange, config
+func (_m *RateLimiters) Configure(exchange connector.ExchangeName, config ratelimit.Config) {
+	_m.Called(exchange, config)
+}
+
+// RateLimiters_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type RateLimiters_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - config ratelimit.Config
+func (_e *RateLimiters_Expecter) Configure(exchange interface{}, config interface{}) *RateLimiters_Configure_Call {
+	return &RateLimiters_Configure_Call{Call: _e.mock.On("Configure", exchange, config)}
+}
+
+func (_c *RateLimiters_Configure_Call) Run(run func(exchange connector.ExchangeName, config ratelimit.Config)) *RateLimiters_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(ratelimit.Config))
+	})
+	return _c
+}
+
+func (_c *RateLimiters_Configure_Call) Return() *RateLimiters_Configure_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *RateLimiters_Configure_Call) RunAndReturn(run func(connector.ExchangeName, ratelimit.Config)) *RateLimiters_Configure_Call {
+	_c.Run(run)
+	return _c
+}
+
+// For provides a mock function with given fields: exchange
+func (_m *RateLimiters) For(exchange connector.ExchangeName) ratelimit.Limiter {
+	ret := _m.Called(exchange)
+
+	if len(ret) == 0 {
+		panic("no return value specified for For")
+	}
+
+	var r0 ratelimit.Limiter
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName) ratelimit.Limiter); ok {
+		r0 = rf(exchange)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(ratelimit.Limiter)
+		}
+	}
+
+	return r0
+}
+
+// RateLimiters_For_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'For'
+type RateLimiters_For_Call struct {
+	*mock.Call
+}
+
+// For is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+func (_e *RateLimiters_Expecter) For(exchange interface{}) *RateLimiters_For_Call {
+	return &RateLimiters_For_Call{Call: _e.mock.On("For", exchange)}
+}
+
+func (_c *RateLimiters_For_Call) Run(run func(exchange connector.ExchangeName)) *RateLimiters_For_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName))
+	})
+	return _c
+}
+
+func (_c *RateLimiters_For_Call) Return(_a0 ratelimit.Limiter) *RateLimiters_For_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RateLimiters_For_Call) RunAndReturn(run func(connector.ExchangeName) ratelimit.Limiter) *RateLimiters_For_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Statuses provides a mock function with no fields
+func (_m *RateLimiters) Statuses() map[connector.ExchangeName]types.RateLimitStatus {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Statuses")
+	}
+
+	var r0 map[connector.ExchangeName]types.RateLimitStatus
+	if rf, ok := ret.Get(0).(func() map[connector.ExchangeName]types.RateLimitStatus); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[connector.ExchangeName]types.RateLimitStatus)
+		}
+	}
+
+	return r0
+}
+
+// RateLimiters_Statuses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Statuses'
+type RateLimiters_Statuses_Call struct {
+	*mock.Call
+}
+
+// Statuses is a helper method to define mock.On call
+func (_e *RateLimiters_Expecter) Statuses() *RateLimiters_Statuses_Call {
+	return &RateLimiters_Statuses_Call{Call: _e.mock.On("Statuses")}
+}
+
+func (_c *RateLimiters_Statuses_Call) Run(run func()) *RateLimiters_Statuses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RateLimiters_Statuses_Call) Return(_a0 map[connector.ExchangeName]types.RateLimitStatus) *RateLimiters_Statuses_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RateLimiters_Statuses_Call) RunAndReturn(run func() map[connector.ExchangeName]types.RateLimitStatus) *RateLimiters_Statuses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRateLimiters creates a new instance of RateLimiters. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRateLimiters(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RateLimiters {
+	mock := &RateLimiters{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,438 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package reconcile
+
+import (
+	connector "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	mock "github.com/stretchr/testify/mock"
+
+	reconcile "github.com/backtesting-org/live-trading/pkg/connectors/reconcile"
+
+	time "time"
+)
+
+// BalanceReconciler is an autogenerated mock type for the BalanceReconciler type
+type BalanceReconciler struct {
+	mock.Mock
+}
+
+type BalanceReconciler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *BalanceReconciler) EXPECT() *BalanceReconciler_Expecter {
+	return &BalanceReconciler_Expecter{mock: &_m.Mock}
+}
+
+// Close provides a mock function with no fields
+func (_m *BalanceReconciler) Close() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BalanceReconciler_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type BalanceReconciler_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *BalanceReconciler_Expecter) Close() *BalanceReconciler_Close_Call {
+	return &BalanceReconciler_Close_Call{Call: _e.mock.On("Close")}
+}
+
+func (_c *BalanceReconciler_Close_Call) Run(run func()) *BalanceReconciler_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *BalanceReconciler_Close_Call) Return(_a0 error) *BalanceReconciler_Close_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BalanceReconciler_Close_Call) RunAndReturn(run func() error) *BalanceReconciler_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *BalanceReconciler) Configure(config reconcile.Config) error {
+	ret := _m.Called(config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Configure")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(reconcile.Config) error); ok {
+		r0 = rf(config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BalanceReconciler_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type BalanceReconciler_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config reconcile.Config
+func (_e *BalanceReconciler_Expecter) Configure(config interface{}) *BalanceReconciler_Configure_Call {
+	return &BalanceReconciler_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *BalanceReconciler_Configure_Call) Run(run func(config reconcile.Config)) *BalanceReconciler_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(reconcile.Config))
+	})
+	return _c
+}
+
+func (_c *BalanceReconciler_Configure_Call) Return(_a0 error) *BalanceReconciler_Configure_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BalanceReconciler_Configure_Call) RunAndReturn(run func(reconcile.Config) error) *BalanceReconciler_Configure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Discrepancies provides a mock function with given fields: runID, since
+func (_m *BalanceReconciler) Discrepancies(runID string, since time.Time) []reconcile.Discrepancy {
+	ret := _m.Called(runID, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Discrepancies")
+	}
+
+	var r0 []reconcile.Discrepancy
+	if rf, ok := ret.Get(0).(func(string, time.Time) []reconcile.Discrepancy); ok {
+		r0 = rf(runID, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]reconcile.Discrepancy)
+		}
+	}
+
+	return r0
+}
+
+// BalanceReconciler_Discrepancies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Discrepancies'
+type BalanceReconciler_Discrepancies_Call struct {
+	*mock.Call
+}
+
+// Discrepancies is a helper method to define mock.On call
+//   - runID string
+//   - since time.Time
+func (_e *BalanceReconciler_Expecter) Discrepancies(runID interface{}, since interface{}) *BalanceReconciler_Discrepancies_Call {
+	return &BalanceReconciler_Discrepancies_Call{Call: _e.mock.On("Discrepancies", runID, since)}
+}
+
+func (_c *BalanceReconciler_Discrepancies_Call) Run(run func(runID string, since time.Time)) *BalanceReconciler_Discrepancies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *BalanceReconciler_Discrepancies_Call) Return(_a0 []reconcile.Discrepancy) *BalanceReconciler_Discrepancies_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BalanceReconciler_Discrepancies_Call) RunAndReturn(run func(string, time.Time) []reconcile.Discrepancy) *BalanceReconciler_Discrepancies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rebase provides a mock function with given fields: exchange
+func (_m *BalanceReconciler) Rebase(exchange connector.ExchangeName) {
+	_m.Called(exchange)
+}
+
+// BalanceReconciler_Rebase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rebase'
+type BalanceReconciler_Rebase_Call struct {
+	*mock.Call
+}
+
+// Rebase is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+func (_e *BalanceReconciler_Expecter) Rebase(exchange interface{}) *BalanceReconciler_Rebase_Call {
+	return &BalanceReconciler_Rebase_Call{Call: _e.mock.On("Rebase", exchange)}
+}
+
+func (_c *BalanceReconciler_Rebase_Call) Run(run func(exchange connector.ExchangeName)) *BalanceReconciler_Rebase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName))
+	})
+	return _c
+}
+
+func (_c *BalanceReconciler_Rebase_Call) Return() *BalanceReconciler_Rebase_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *BalanceReconciler_Rebase_Call) RunAndReturn(run func(connector.ExchangeName)) *BalanceReconciler_Rebase_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Reconcile provides a mock function with given fields: exchange
+func (_m *BalanceReconciler) Reconcile(exchange connector.ExchangeName) ([]reconcile.Discrepancy, error) {
+	ret := _m.Called(exchange)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reconcile")
+	}
+
+	var r0 []reconcile.Discrepancy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName) ([]reconcile.Discrepancy, error)); ok {
+		return rf(exchange)
+	}
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName) []reconcile.Discrepancy); ok {
+		r0 = rf(exchange)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]reconcile.Discrepancy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.ExchangeName) error); ok {
+		r1 = rf(exchange)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BalanceReconciler_Reconcile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reconcile'
+type BalanceReconciler_Reconcile_Call struct {
+	*mock.Call
+}
+
+// Reconcile is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+func (_e *BalanceReconciler_Expecter) Reconcile(exchange interface{}) *BalanceReconciler_Reconcile_Call {
+	return &BalanceReconciler_Reconcile_Call{Call: _e.mock.On("Reconcile", exchange)}
+}
+
+func (_c *BalanceReconciler_Reconcile_Call) Run(run func(exchange connector.ExchangeName)) *BalanceReconciler_Reconcile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName))
+	})
+	return _c
+}
+
+func (_c *BalanceReconciler_Reconcile_Call) Return(_a0 []reconcile.Discrepancy, _a1 error) *BalanceReconciler_Reconcile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BalanceReconciler_Reconcile_Call) RunAndReturn(run func(connector.ExchangeName) ([]reconcile.Discrepancy, error)) *BalanceReconciler_Reconcile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReconcileAll provides a mock function with no fields
+func (_m *BalanceReconciler) ReconcileAll() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReconcileAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BalanceReconciler_ReconcileAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReconcileAll'
+type BalanceReconciler_ReconcileAll_Call struct {
+	*mock.Call
+}
+
+// ReconcileAll is a helper method to define mock.On call
+func (_e *BalanceReconciler_Expecter) ReconcileAll() *BalanceReconciler_ReconcileAll_Call {
+	return &BalanceReconciler_ReconcileAll_Call{Call: _e.mock.On("ReconcileAll")}
+}
+
+func (_c *BalanceReconciler_ReconcileAll_Call) Run(run func()) *BalanceReconciler_ReconcileAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *BalanceReconciler_ReconcileAll_Call) Return(_a0 error) *BalanceReconciler_ReconcileAll_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BalanceReconciler_ReconcileAll_Call) RunAndReturn(run func() error) *BalanceReconciler_ReconcileAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with no fields
+func (_m *BalanceReconciler) Start() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BalanceReconciler_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type BalanceReconciler_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+func (_e *BalanceReconciler_Expecter) Start() *BalanceReconciler_Start_Call {
+	return &BalanceReconciler_Start_Call{Call: _e.mock.On("Start")}
+}
+
+func (_c *BalanceReconciler_Start_Call) Run(run func()) *BalanceReconciler_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *BalanceReconciler_Start_Call) Return(_a0 error) *BalanceReconciler_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BalanceReconciler_Start_Call) RunAndReturn(run func() error) *BalanceReconciler_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with no fields
+func (_m *BalanceReconciler) Stop() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BalanceReconciler_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type BalanceReconciler_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *BalanceReconciler_Expecter) Stop() *BalanceReconciler_Stop_Call {
+	return &BalanceReconciler_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *BalanceReconciler_Stop_Call) Run(run func()) *BalanceReconciler_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *BalanceReconciler_Stop_Call) Return(_a0 error) *BalanceReconciler_Stop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BalanceReconciler_Stop_Call) RunAndReturn(run func() error) *BalanceReconciler_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Track provides a mock function with given fields: exchange
+func (_m *BalanceReconciler) Track(exchange connector.ExchangeName) {
+	_m.Called(exchange)
+}
+
+// BalanceReconciler_Track_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Track'
+type BalanceReconciler_Track_Call struct {
+	*mock.Call
+}
+
+// Track is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+func (_e *BalanceReconciler_Expecter) Track(exchange interface{}) *BalanceReconciler_Track_Call {
+	return &BalanceReconciler_Track_Call{Call: _e.mock.On("Track", exchange)}
+}
+
+func (_c *BalanceReconciler_Track_Call) Run(run func(exchange connector.ExchangeName)) *BalanceReconciler_Track_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName))
+	})
+	return _c
+}
+
+func (_c *BalanceReconciler_Track_Call) Return() *BalanceReconciler_Track_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *BalanceReconciler_Track_Call) RunAndReturn(run func(connector.ExchangeName)) *BalanceReconciler_Track_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewBalanceReconciler creates a new instance of BalanceReconciler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBalanceReconciler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BalanceReconciler {
+	mock := &BalanceReconciler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
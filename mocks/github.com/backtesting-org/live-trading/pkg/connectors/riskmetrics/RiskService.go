@@ -0,0 +1,332 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package riskmetrics
+
+import (
+	riskmetrics "github.com/backtesting-org/live-trading/pkg/connectors/riskmetrics"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RiskService is an autogenerated mock type for the RiskService type
+type RiskService struct {
+	mock.Mock
+}
+
+type RiskService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RiskService) EXPECT() *RiskService_Expecter {
+	return &RiskService_Expecter{mock: &_m.Mock}
+}
+
+// Calculate provides a mock function with no fields
+func (_m *RiskService) Calculate() (*riskmetrics.Report, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Calculate")
+	}
+
+	var r0 *riskmetrics.Report
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*riskmetrics.Report, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *riskmetrics.Report); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*riskmetrics.Report)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RiskService_Calculate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Calculate'
+type RiskService_Calculate_Call struct {
+	*mock.Call
+}
+
+// Calculate is a helper method to define mock.On call
+func (_e *RiskService_Expecter) Calculate() *RiskService_Calculate_Call {
+	return &RiskService_Calculate_Call{Call: _e.mock.On("Calculate")}
+}
+
+func (_c *RiskService_Calculate_Call) Run(run func()) *RiskService_Calculate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RiskService_Calculate_Call) Return(_a0 *riskmetrics.Report, _a1 error) *RiskService_Calculate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RiskService_Calculate_Call) RunAndReturn(run func() (*riskmetrics.Report, error)) *RiskService_Calculate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *RiskService) Configure(config riskmetrics.Config) error {
+	ret := _m.Called(config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Configure")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(riskmetrics.Config) error); ok {
+		r0 = rf(config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RiskService_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type RiskService_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config riskmetrics.Config
+func (_e *RiskService_Expecter) Configure(config interface{}) *RiskService_Configure_Call {
+	return &RiskService_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *RiskService_Configure_Call) Run(run func(config riskmetrics.Config)) *RiskService_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(riskmetrics.Config))
+	})
+	return _c
+}
+
+func (_c *RiskService_Configure_Call) Return(_a0 error) *RiskService_Configure_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RiskService_Configure_Call) RunAndReturn(run func(riskmetrics.Config) error) *RiskService_Configure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Latest provides a mock function with no fields
+func (_m *RiskService) Latest() (*riskmetrics.Report, bool) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Latest")
+	}
+
+	var r0 *riskmetrics.Report
+	var r1 bool
+	if rf, ok := ret.Get(0).(func() (*riskmetrics.Report, bool)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *riskmetrics.Report); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*riskmetrics.Report)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// RiskService_Latest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Latest'
+type RiskService_Latest_Call struct {
+	*mock.Call
+}
+
+// Latest is a helper method to define mock.On call
+func (_e *RiskService_Expecter) Latest() *RiskService_Latest_Call {
+	return &RiskService_Latest_Call{Call: _e.mock.On("Latest")}
+}
+
+func (_c *RiskService_Latest_Call) Run(run func()) *RiskService_Latest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RiskService_Latest_Call) Return(_a0 *riskmetrics.Report, _a1 bool) *RiskService_Latest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RiskService_Latest_Call) RunAndReturn(run func() (*riskmetrics.Report, bool)) *RiskService_Latest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reports provides a mock function with no fields
+func (_m *RiskService) Reports() <-chan riskmetrics.Report {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reports")
+	}
+
+	var r0 <-chan riskmetrics.Report
+	if rf, ok := ret.Get(0).(func() <-chan riskmetrics.Report); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan riskmetrics.Report)
+		}
+	}
+
+	return r0
+}
+
+// RiskService_Reports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reports'
+type RiskService_Reports_Call struct {
+	*mock.Call
+}
+
+// Reports is a helper method to define mock.On call
+func (_e *RiskService_Expecter) Reports() *RiskService_Reports_Call {
+	return &RiskService_Reports_Call{Call: _e.mock.On("Reports")}
+}
+
+func (_c *RiskService_Reports_Call) Run(run func()) *RiskService_Reports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RiskService_Reports_Call) Return(_a0 <-chan riskmetrics.Report) *RiskService_Reports_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RiskService_Reports_Call) RunAndReturn(run func() <-chan riskmetrics.Report) *RiskService_Reports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with no fields
+func (_m *RiskService) Start() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RiskService_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type RiskService_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+func (_e *RiskService_Expecter) Start() *RiskService_Start_Call {
+	return &RiskService_Start_Call{Call: _e.mock.On("Start")}
+}
+
+func (_c *RiskService_Start_Call) Run(run func()) *RiskService_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RiskService_Start_Call) Return(_a0 error) *RiskService_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RiskService_Start_Call) RunAndReturn(run func() error) *RiskService_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with no fields
+func (_m *RiskService) Stop() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RiskService_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type RiskService_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *RiskService_Expecter) Stop() *RiskService_Stop_Call {
+	return &RiskService_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *RiskService_Stop_Call) Run(run func()) *RiskService_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RiskService_Stop_Call) Return(_a0 error) *RiskService_Stop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RiskService_Stop_Call) RunAndReturn(run func() error) *RiskService_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRiskService creates a new instance of RiskService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRiskService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RiskService {
+	mock := &RiskService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,442 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package sanity
+
+import (
+	connector "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	execution "github.com/backtesting-org/kronos-sdk/pkg/types/execution"
+
+	mock "github.com/stretchr/testify/mock"
+
+	portfolio "github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+
+	sanity "github.com/backtesting-org/live-trading/pkg/connectors/sanity"
+)
+
+// PriceSanityChecker is an autogenerated mock type for the PriceSanityChecker type
+type PriceSanityChecker struct {
+	mock.Mock
+}
+
+type PriceSanityChecker_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PriceSanityChecker) EXPECT() *PriceSanityChecker_Expecter {
+	return &PriceSanityChecker_Expecter{mock: &_m.Mock}
+}
+
+// Check provides a mock function with given fields: asset
+func (_m *PriceSanityChecker) Check(asset portfolio.Asset) (*sanity.CheckResult, error) {
+	ret := _m.Called(asset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Check")
+	}
+
+	var r0 *sanity.CheckResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(portfolio.Asset) (*sanity.CheckResult, error)); ok {
+		return rf(asset)
+	}
+	if rf, ok := ret.Get(0).(func(portfolio.Asset) *sanity.CheckResult); ok {
+		r0 = rf(asset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sanity.CheckResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(portfolio.Asset) error); ok {
+		r1 = rf(asset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PriceSanityChecker_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type PriceSanityChecker_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+//   - asset portfolio.Asset
+func (_e *PriceSanityChecker_Expecter) Check(asset interface{}) *PriceSanityChecker_Check_Call {
+	return &PriceSanityChecker_Check_Call{Call: _e.mock.On("Check", asset)}
+}
+
+func (_c *PriceSanityChecker_Check_Call) Run(run func(asset portfolio.Asset)) *PriceSanityChecker_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(portfolio.Asset))
+	})
+	return _c
+}
+
+func (_c *PriceSanityChecker_Check_Call) Return(_a0 *sanity.CheckResult, _a1 error) *PriceSanityChecker_Check_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PriceSanityChecker_Check_Call) RunAndReturn(run func(portfolio.Asset) (*sanity.CheckResult, error)) *PriceSanityChecker_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckAll provides a mock function with no fields
+func (_m *PriceSanityChecker) CheckAll() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PriceSanityChecker_CheckAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckAll'
+type PriceSanityChecker_CheckAll_Call struct {
+	*mock.Call
+}
+
+// CheckAll is a helper method to define mock.On call
+func (_e *PriceSanityChecker_Expecter) CheckAll() *PriceSanityChecker_CheckAll_Call {
+	return &PriceSanityChecker_CheckAll_Call{Call: _e.mock.On("CheckAll")}
+}
+
+func (_c *PriceSanityChecker_CheckAll_Call) Run(run func()) *PriceSanityChecker_CheckAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PriceSanityChecker_CheckAll_Call) Return(_a0 error) *PriceSanityChecker_CheckAll_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PriceSanityChecker_CheckAll_Call) RunAndReturn(run func() error) *PriceSanityChecker_CheckAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *PriceSanityChecker) Configure(config sanity.Config) {
+	_m.Called(config)
+}
+
+// PriceSanityChecker_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type PriceSanityChecker_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config sanity.Config
+func (_e *PriceSanityChecker_Expecter) Configure(config interface{}) *PriceSanityChecker_Configure_Call {
+	return &PriceSanityChecker_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *PriceSanityChecker_Configure_Call) Run(run func(config sanity.Config)) *PriceSanityChecker_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(sanity.Config))
+	})
+	return _c
+}
+
+func (_c *PriceSanityChecker_Configure_Call) Return() *PriceSanityChecker_Configure_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *PriceSanityChecker_Configure_Call) RunAndReturn(run func(sanity.Config)) *PriceSanityChecker_Configure_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Guard provides a mock function with given fields: asset, exchange
+func (_m *PriceSanityChecker) Guard(asset portfolio.Asset, exchange connector.ExchangeName) error {
+	ret := _m.Called(asset, exchange)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Guard")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(portfolio.Asset, connector.ExchangeName) error); ok {
+		r0 = rf(asset, exchange)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PriceSanityChecker_Guard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Guard'
+type PriceSanityChecker_Guard_Call struct {
+	*mock.Call
+}
+
+// Guard is a helper method to define mock.On call
+//   - asset portfolio.Asset
+//   - exchange connector.ExchangeName
+func (_e *PriceSanityChecker_Expecter) Guard(asset interface{}, exchange interface{}) *PriceSanityChecker_Guard_Call {
+	return &PriceSanityChecker_Guard_Call{Call: _e.mock.On("Guard", asset, exchange)}
+}
+
+func (_c *PriceSanityChecker_Guard_Call) Run(run func(asset portfolio.Asset, exchange connector.ExchangeName)) *PriceSanityChecker_Guard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(portfolio.Asset), args[1].(connector.ExchangeName))
+	})
+	return _c
+}
+
+func (_c *PriceSanityChecker_Guard_Call) Return(_a0 error) *PriceSanityChecker_Guard_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PriceSanityChecker_Guard_Call) RunAndReturn(run func(portfolio.Asset, connector.ExchangeName) error) *PriceSanityChecker_Guard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsSuspect provides a mock function with given fields: asset, exchange
+func (_m *PriceSanityChecker) IsSuspect(asset portfolio.Asset, exchange connector.ExchangeName) bool {
+	ret := _m.Called(asset, exchange)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsSuspect")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(portfolio.Asset, connector.ExchangeName) bool); ok {
+		r0 = rf(asset, exchange)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// PriceSanityChecker_IsSuspect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsSuspect'
+type PriceSanityChecker_IsSuspect_Call struct {
+	*mock.Call
+}
+
+// IsSuspect is a helper method to define mock.On call
+//   - asset portfolio.Asset
+//   - exchange connector.ExchangeName
+func (_e *PriceSanityChecker_Expecter) IsSuspect(asset interface{}, exchange interface{}) *PriceSanityChecker_IsSuspect_Call {
+	return &PriceSanityChecker_IsSuspect_Call{Call: _e.mock.On("IsSuspect", asset, exchange)}
+}
+
+func (_c *PriceSanityChecker_IsSuspect_Call) Run(run func(asset portfolio.Asset, exchange connector.ExchangeName)) *PriceSanityChecker_IsSuspect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(portfolio.Asset), args[1].(connector.ExchangeName))
+	})
+	return _c
+}
+
+func (_c *PriceSanityChecker_IsSuspect_Call) Return(_a0 bool) *PriceSanityChecker_IsSuspect_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PriceSanityChecker_IsSuspect_Call) RunAndReturn(run func(portfolio.Asset, connector.ExchangeName) bool) *PriceSanityChecker_IsSuspect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetReferenceSource provides a mock function with given fields: source
+func (_m *PriceSanityChecker) SetReferenceSource(source sanity.ReferencePriceSource) {
+	_m.Called(source)
+}
+
+// PriceSanityChecker_SetReferenceSource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetReferenceSource'
+type PriceSanityChecker_SetReferenceSource_Call struct {
+	*mock.Call
+}
+
+// SetReferenceSource is a helper method to define mock.On call
+//   - source sanity.ReferencePriceSource
+func (_e *PriceSanityChecker_Expecter) SetReferenceSource(source interface{}) *PriceSanityChecker_SetReferenceSource_Call {
+	return &PriceSanityChecker_SetReferenceSource_Call{Call: _e.mock.On("SetReferenceSource", source)}
+}
+
+func (_c *PriceSanityChecker_SetReferenceSource_Call) Run(run func(source sanity.ReferencePriceSource)) *PriceSanityChecker_SetReferenceSource_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(sanity.ReferencePriceSource))
+	})
+	return _c
+}
+
+func (_c *PriceSanityChecker_SetReferenceSource_Call) Return() *PriceSanityChecker_SetReferenceSource_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *PriceSanityChecker_SetReferenceSource_Call) RunAndReturn(run func(sanity.ReferencePriceSource)) *PriceSanityChecker_SetReferenceSource_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Start provides a mock function with no fields
+func (_m *PriceSanityChecker) Start() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PriceSanityChecker_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type PriceSanityChecker_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+func (_e *PriceSanityChecker_Expecter) Start() *PriceSanityChecker_Start_Call {
+	return &PriceSanityChecker_Start_Call{Call: _e.mock.On("Start")}
+}
+
+func (_c *PriceSanityChecker_Start_Call) Run(run func()) *PriceSanityChecker_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PriceSanityChecker_Start_Call) Return(_a0 error) *PriceSanityChecker_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PriceSanityChecker_Start_Call) RunAndReturn(run func() error) *PriceSanityChecker_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with no fields
+func (_m *PriceSanityChecker) Stop() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PriceSanityChecker_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type PriceSanityChecker_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *PriceSanityChecker_Expecter) Stop() *PriceSanityChecker_Stop_Call {
+	return &PriceSanityChecker_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *PriceSanityChecker_Stop_Call) Run(run func()) *PriceSanityChecker_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PriceSanityChecker_Stop_Call) Return(_a0 error) *PriceSanityChecker_Stop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PriceSanityChecker_Stop_Call) RunAndReturn(run func() error) *PriceSanityChecker_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Wrap provides a mock function with given fields: inner
+func (_m *PriceSanityChecker) Wrap(inner execution.Executor) execution.Executor {
+	ret := _m.Called(inner)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Wrap")
+	}
+
+	var r0 execution.Executor
+	if rf, ok := ret.Get(0).(func(execution.Executor) execution.Executor); ok {
+		r0 = rf(inner)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(execution.Executor)
+		}
+	}
+
+	return r0
+}
+
+// PriceSanityChecker_Wrap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Wrap'
+type PriceSanityChecker_Wrap_Call struct {
+	*mock.Call
+}
+
+// Wrap is a helper method to define mock.On call
+//   - inner execution.Executor
+func (_e *PriceSanityChecker_Expecter) Wrap(inner interface{}) *PriceSanityChecker_Wrap_Call {
+	return &PriceSanityChecker_Wrap_Call{Call: _e.mock.On("Wrap", inner)}
+}
+
+func (_c *PriceSanityChecker_Wrap_Call) Run(run func(inner execution.Executor)) *PriceSanityChecker_Wrap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(execution.Executor))
+	})
+	return _c
+}
+
+func (_c *PriceSanityChecker_Wrap_Call) Return(_a0 execution.Executor) *PriceSanityChecker_Wrap_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PriceSanityChecker_Wrap_Call) RunAndReturn(run func(execution.Executor) execution.Executor) *PriceSanityChecker_Wrap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPriceSanityChecker creates a new instance of PriceSanityChecker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPriceSanityChecker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PriceSanityChecker {
+	mock := &PriceSanityChecker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
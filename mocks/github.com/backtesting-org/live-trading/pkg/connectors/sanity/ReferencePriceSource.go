@@ -0,0 +1,92 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package sanity
+
+import (
+	numerical "github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	portfolio "github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReferencePriceSource is an autogenerated mock type for the ReferencePriceSource type
+type ReferencePriceSource struct {
+	mock.Mock
+}
+
+type ReferencePriceSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ReferencePriceSource) EXPECT() *ReferencePriceSource_Expecter {
+	return &ReferencePriceSource_Expecter{mock: &_m.Mock}
+}
+
+// ReferencePrice provides a mock function with given fields: asset
+func (_m *ReferencePriceSource) ReferencePrice(asset portfolio.Asset) (numerical.Decimal, error) {
+	ret := _m.Called(asset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReferencePrice")
+	}
+
+	var r0 numerical.Decimal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(portfolio.Asset) (numerical.Decimal, error)); ok {
+		return rf(asset)
+	}
+	if rf, ok := ret.Get(0).(func(portfolio.Asset) numerical.Decimal); ok {
+		r0 = rf(asset)
+	} else {
+		r0 = ret.Get(0).(numerical.Decimal)
+	}
+
+	if rf, ok := ret.Get(1).(func(portfolio.Asset) error); ok {
+		r1 = rf(asset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReferencePriceSource_ReferencePrice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReferencePrice'
+type ReferencePriceSource_ReferencePrice_Call struct {
+	*mock.Call
+}
+
+// ReferencePrice is a helper method to define mock.On call
+//   - asset portfolio.Asset
+func (_e *ReferencePriceSource_Expecter) ReferencePrice(asset interface{}) *ReferencePriceSource_ReferencePrice_Call {
+	return &ReferencePriceSource_ReferencePrice_Call{Call: _e.mock.On("ReferencePrice", asset)}
+}
+
+func (_c *ReferencePriceSource_ReferencePrice_Call) Run(run func(asset portfolio.Asset)) *ReferencePriceSource_ReferencePrice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(portfolio.Asset))
+	})
+	return _c
+}
+
+func (_c *ReferencePriceSource_ReferencePrice_Call) Return(_a0 numerical.Decimal, _a1 error) *ReferencePriceSource_ReferencePrice_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReferencePriceSource_ReferencePrice_Call) RunAndReturn(run func(portfolio.Asset) (numerical.Decimal, error)) *ReferencePriceSource_ReferencePrice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewReferencePriceSource creates a new instance of ReferencePriceSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReferencePriceSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReferencePriceSource {
+	mock := &ReferencePriceSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,232 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package switches
+
+import (
+	connector "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	mock "github.com/stretchr/testify/mock"
+
+	switches "github.com/backtesting-org/live-trading/pkg/connectors/switches"
+)
+
+// Store is an autogenerated mock type for the Store type
+type Store struct {
+	mock.Mock
+}
+
+type Store_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Store) EXPECT() *Store_Expecter {
+	return &Store_Expecter{mock: &_m.Mock}
+}
+
+// Append provides a mock function with given fields: entry
+func (_m *Store) Append(entry switches.AuditEntry) {
+	_m.Called(entry)
+}
+
+// Store_Append_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Append'
+type Store_Append_Call struct {
+	*mock.Call
+}
+
+// Append is a helper method to define mock.On call
+//   - entry switches.AuditEntry
+func (_e *Store_Expecter) Append(entry interface{}) *Store_Append_Call {
+	return &Store_Append_Call{Call: _e.mock.On("Append", entry)}
+}
+
+func (_c *Store_Append_Call) Run(run func(entry switches.AuditEntry)) *Store_Append_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(switches.AuditEntry))
+	})
+	return _c
+}
+
+func (_c *Store_Append_Call) Return() *Store_Append_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Store_Append_Call) RunAndReturn(run func(switches.AuditEntry)) *Store_Append_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Audit provides a mock function with given fields: limit
+func (_m *Store) Audit(limit int) []switches.AuditEntry {
+	ret := _m.Called(limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Audit")
+	}
+
+	var r0 []switches.AuditEntry
+	if rf, ok := ret.Get(0).(func(int) []switches.AuditEntry); ok {
+		r0 = rf(limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]switches.AuditEntry)
+		}
+	}
+
+	return r0
+}
+
+// Store_Audit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Audit'
+type Store_Audit_Call struct {
+	*mock.Call
+}
+
+// Audit is a helper method to define mock.On call
+//   - limit int
+func (_e *Store_Expecter) Audit(limit interface{}) *Store_Audit_Call {
+	return &Store_Audit_Call{Call: _e.mock.On("Audit", limit)}
+}
+
+func (_c *Store_Audit_Call) Run(run func(limit int)) *Store_Audit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *Store_Audit_Call) Return(_a0 []switches.AuditEntry) *Store_Audit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Store_Audit_Call) RunAndReturn(run func(int) []switches.AuditEntry) *Store_Audit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: asset, exchange
+func (_m *Store) Delete(asset string, exchange connector.ExchangeName) {
+	_m.Called(asset, exchange)
+}
+
+// Store_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type Store_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - asset string
+//   - exchange connector.ExchangeName
+func (_e *Store_Expecter) Delete(asset interface{}, exchange interface{}) *Store_Delete_Call {
+	return &Store_Delete_Call{Call: _e.mock.On("Delete", asset, exchange)}
+}
+
+func (_c *Store_Delete_Call) Run(run func(asset string, exchange connector.ExchangeName)) *Store_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(connector.ExchangeName))
+	})
+	return _c
+}
+
+func (_c *Store_Delete_Call) Return() *Store_Delete_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Store_Delete_Call) RunAndReturn(run func(string, connector.ExchangeName)) *Store_Delete_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Put provides a mock function with given fields: sw
+func (_m *Store) Put(sw switches.Switch) {
+	_m.Called(sw)
+}
+
+// Store_Put_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Put'
+type Store_Put_Call struct {
+	*mock.Call
+}
+
+// Put is a helper method to define mock.On call
+//   - sw switches.Switch
+func (_e *Store_Expecter) Put(sw interface{}) *Store_Put_Call {
+	return &Store_Put_Call{Call: _e.mock.On("Put", sw)}
+}
+
+func (_c *Store_Put_Call) Run(run func(sw switches.Switch)) *Store_Put_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(switches.Switch))
+	})
+	return _c
+}
+
+func (_c *Store_Put_Call) Return() *Store_Put_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Store_Put_Call) RunAndReturn(run func(switches.Switch)) *Store_Put_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Switches provides a mock function with no fields
+func (_m *Store) Switches() []switches.Switch {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Switches")
+	}
+
+	var r0 []switches.Switch
+	if rf, ok := ret.Get(0).(func() []switches.Switch); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]switches.Switch)
+		}
+	}
+
+	return r0
+}
+
+// Store_Switches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Switches'
+type Store_Switches_Call struct {
+	*mock.Call
+}
+
+// Switches is a helper method to define mock.On call
+func (_e *Store_Expecter) Switches() *Store_Switches_Call {
+	return &Store_Switches_Call{Call: _e.mock.On("Switches")}
+}
+
+func (_c *Store_Switches_Call) Run(run func()) *Store_Switches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Store_Switches_Call) Return(_a0 []switches.Switch) *Store_Switches_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Store_Switches_Call) RunAndReturn(run func() []switches.Switch) *Store_Switches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStore creates a new instance of Store. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Store {
+	mock := &Store{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
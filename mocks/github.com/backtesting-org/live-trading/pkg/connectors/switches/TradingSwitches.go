@@ -0,0 +1,552 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package switches
+
+import (
+	connector "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	execution "github.com/backtesting-org/kronos-sdk/pkg/types/execution"
+
+	mock "github.com/stretchr/testify/mock"
+
+	portfolio "github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+
+	strategy "github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+
+	switches "github.com/backtesting-org/live-trading/pkg/connectors/switches"
+)
+
+// TradingSwitches is an autogenerated mock type for the TradingSwitches type
+type TradingSwitches struct {
+	mock.Mock
+}
+
+type TradingSwitches_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TradingSwitches) EXPECT() *TradingSwitches_Expecter {
+	return &TradingSwitches_Expecter{mock: &_m.Mock}
+}
+
+// Audit provides a mock function with given fields: limit
+func (_m *TradingSwitches) Audit(limit int) []switches.AuditEntry {
+	ret := _m.Called(limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Audit")
+	}
+
+	var r0 []switches.AuditEntry
+	if rf, ok := ret.Get(0).(func(int) []switches.AuditEntry); ok {
+		r0 = rf(limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]switches.AuditEntry)
+		}
+	}
+
+	return r0
+}
+
+// TradingSwitches_Audit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Audit'
+type TradingSwitches_Audit_Call struct {
+	*mock.Call
+}
+
+// Audit is a helper method to define mock.On call
+//   - limit int
+func (_e *TradingSwitches_Expecter) Audit(limit interface{}) *TradingSwitches_Audit_Call {
+	return &TradingSwitches_Audit_Call{Call: _e.mock.On("Audit", limit)}
+}
+
+func (_c *TradingSwitches_Audit_Call) Run(run func(limit int)) *TradingSwitches_Audit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *TradingSwitches_Audit_Call) Return(_a0 []switches.AuditEntry) *TradingSwitches_Audit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TradingSwitches_Audit_Call) RunAndReturn(run func(int) []switches.AuditEntry) *TradingSwitches_Audit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Check provides a mock function with given fields: asset, exchange
+func (_m *TradingSwitches) Check(asset portfolio.Asset, exchange connector.ExchangeName) error {
+	ret := _m.Called(asset, exchange)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Check")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(portfolio.Asset, connector.ExchangeName) error); ok {
+		r0 = rf(asset, exchange)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TradingSwitches_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type TradingSwitches_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+//   - asset portfolio.Asset
+//   - exchange connector.ExchangeName
+func (_e *TradingSwitches_Expecter) Check(asset interface{}, exchange interface{}) *TradingSwitches_Check_Call {
+	return &TradingSwitches_Check_Call{Call: _e.mock.On("Check", asset, exchange)}
+}
+
+func (_c *TradingSwitches_Check_Call) Run(run func(asset portfolio.Asset, exchange connector.ExchangeName)) *TradingSwitches_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(portfolio.Asset), args[1].(connector.ExchangeName))
+	})
+	return _c
+}
+
+func (_c *TradingSwitches_Check_Call) Return(_a0 error) *TradingSwitches_Check_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TradingSwitches_Check_Call) RunAndReturn(run func(portfolio.Asset, connector.ExchangeName) error) *TradingSwitches_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckOrder provides a mock function with given fields: exchange, symbol, reduceOnly
+func (_m *TradingSwitches) CheckOrder(exchange connector.ExchangeName, symbol string, reduceOnly bool) error {
+	ret := _m.Called(exchange, symbol, reduceOnly)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckOrder")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, string, bool) error); ok {
+		r0 = rf(exchange, symbol, reduceOnly)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TradingSwitches_CheckOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckOrder'
+type TradingSwitches_CheckOrder_Call struct {
+	*mock.Call
+}
+
+// CheckOrder is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - symbol string
+//   - reduceOnly bool
+func (_e *TradingSwitches_Expecter) CheckOrder(exchange interface{}, symbol interface{}, reduceOnly interface{}) *TradingSwitches_CheckOrder_Call {
+	return &TradingSwitches_CheckOrder_Call{Call: _e.mock.On("CheckOrder", exchange, symbol, reduceOnly)}
+}
+
+func (_c *TradingSwitches_CheckOrder_Call) Run(run func(exchange connector.ExchangeName, symbol string, reduceOnly bool)) *TradingSwitches_CheckOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *TradingSwitches_CheckOrder_Call) Return(_a0 error) *TradingSwitches_CheckOrder_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TradingSwitches_CheckOrder_Call) RunAndReturn(run func(connector.ExchangeName, string, bool) error) *TradingSwitches_CheckOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckSignal provides a mock function with given fields: signal
+func (_m *TradingSwitches) CheckSignal(signal *strategy.Signal) error {
+	ret := _m.Called(signal)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckSignal")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*strategy.Signal) error); ok {
+		r0 = rf(signal)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TradingSwitches_CheckSignal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckSignal'
+type TradingSwitches_CheckSignal_Call struct {
+	*mock.Call
+}
+
+// CheckSignal is a helper method to define mock.On call
+//   - signal *strategy.Signal
+func (_e *TradingSwitches_Expecter) CheckSignal(signal interface{}) *TradingSwitches_CheckSignal_Call {
+	return &TradingSwitches_CheckSignal_Call{Call: _e.mock.On("CheckSignal", signal)}
+}
+
+func (_c *TradingSwitches_CheckSignal_Call) Run(run func(signal *strategy.Signal)) *TradingSwitches_CheckSignal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*strategy.Signal))
+	})
+	return _c
+}
+
+func (_c *TradingSwitches_CheckSignal_Call) Return(_a0 error) *TradingSwitches_CheckSignal_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TradingSwitches_CheckSignal_Call) RunAndReturn(run func(*strategy.Signal) error) *TradingSwitches_CheckSignal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckSymbol provides a mock function with given fields: exchange, symbol
+func (_m *TradingSwitches) CheckSymbol(exchange connector.ExchangeName, symbol string) error {
+	ret := _m.Called(exchange, symbol)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckSymbol")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, string) error); ok {
+		r0 = rf(exchange, symbol)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TradingSwitches_CheckSymbol_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckSymbol'
+type TradingSwitches_CheckSymbol_Call struct {
+	*mock.Call
+}
+
+// CheckSymbol is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - symbol string
+func (_e *TradingSwitches_Expecter) CheckSymbol(exchange interface{}, symbol interface{}) *TradingSwitches_CheckSymbol_Call {
+	return &TradingSwitches_CheckSymbol_Call{Call: _e.mock.On("CheckSymbol", exchange, symbol)}
+}
+
+func (_c *TradingSwitches_CheckSymbol_Call) Run(run func(exchange connector.ExchangeName, symbol string)) *TradingSwitches_CheckSymbol_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TradingSwitches_CheckSymbol_Call) Return(_a0 error) *TradingSwitches_CheckSymbol_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TradingSwitches_CheckSymbol_Call) RunAndReturn(run func(connector.ExchangeName, string) error) *TradingSwitches_CheckSymbol_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Disable provides a mock function with given fields: asset, exchange, reason, actor
+func (_m *TradingSwitches) Disable(asset portfolio.Asset, exchange connector.ExchangeName, reason string, actor string) error {
+	ret := _m.Called(asset, exchange, reason, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Disable")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(portfolio.Asset, connector.ExchangeName, string, string) error); ok {
+		r0 = rf(asset, exchange, reason, actor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TradingSwitches_Disable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Disable'
+type TradingSwitches_Disable_Call struct {
+	*mock.Call
+}
+
+// Disable is a helper method to define mock.On call
+//   - asset portfolio.Asset
+//   - exchange connector.ExchangeName
+//   - reason string
+//   - actor string
+func (_e *TradingSwitches_Expecter) Disable(asset interface{}, exchange interface{}, reason interface{}, actor interface{}) *TradingSwitches_Disable_Call {
+	return &TradingSwitches_Disable_Call{Call: _e.mock.On("Disable", asset, exchange, reason, actor)}
+}
+
+func (_c *TradingSwitches_Disable_Call) Run(run func(asset portfolio.Asset, exchange connector.ExchangeName, reason string, actor string)) *TradingSwitches_Disable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(portfolio.Asset), args[1].(connector.ExchangeName), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *TradingSwitches_Disable_Call) Return(_a0 error) *TradingSwitches_Disable_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TradingSwitches_Disable_Call) RunAndReturn(run func(portfolio.Asset, connector.ExchangeName, string, string) error) *TradingSwitches_Disable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Enable provides a mock function with given fields: asset, exchange, actor
+func (_m *TradingSwitches) Enable(asset portfolio.Asset, exchange connector.ExchangeName, actor string) error {
+	ret := _m.Called(asset, exchange, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Enable")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(portfolio.Asset, connector.ExchangeName, string) error); ok {
+		r0 = rf(asset, exchange, actor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TradingSwitches_Enable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Enable'
+type TradingSwitches_Enable_Call struct {
+	*mock.Call
+}
+
+// Enable is a helper method to define mock.On call
+//   - asset portfolio.Asset
+//   - exchange connector.ExchangeName
+//   - actor string
+func (_e *TradingSwitches_Expecter) Enable(asset interface{}, exchange interface{}, actor interface{}) *TradingSwitches_Enable_Call {
+	return &TradingSwitches_Enable_Call{Call: _e.mock.On("Enable", asset, exchange, actor)}
+}
+
+func (_c *TradingSwitches_Enable_Call) Run(run func(asset portfolio.Asset, exchange connector.ExchangeName, actor string)) *TradingSwitches_Enable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(portfolio.Asset), args[1].(connector.ExchangeName), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TradingSwitches_Enable_Call) Return(_a0 error) *TradingSwitches_Enable_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TradingSwitches_Enable_Call) RunAndReturn(run func(portfolio.Asset, connector.ExchangeName, string) error) *TradingSwitches_Enable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Hook provides a mock function with no fields
+func (_m *TradingSwitches) Hook() execution.ExecutionHook {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Hook")
+	}
+
+	var r0 execution.ExecutionHook
+	if rf, ok := ret.Get(0).(func() execution.ExecutionHook); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(execution.ExecutionHook)
+		}
+	}
+
+	return r0
+}
+
+// TradingSwitches_Hook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Hook'
+type TradingSwitches_Hook_Call struct {
+	*mock.Call
+}
+
+// Hook is a helper method to define mock.On call
+func (_e *TradingSwitches_Expecter) Hook() *TradingSwitches_Hook_Call {
+	return &TradingSwitches_Hook_Call{Call: _e.mock.On("Hook")}
+}
+
+func (_c *TradingSwitches_Hook_Call) Run(run func()) *TradingSwitches_Hook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TradingSwitches_Hook_Call) Return(_a0 execution.ExecutionHook) *TradingSwitches_Hook_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TradingSwitches_Hook_Call) RunAndReturn(run func() execution.ExecutionHook) *TradingSwitches_Hook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestrictToReduceOnly provides a mock function with given fields: asset, exchange, reason, actor
+func (_m *TradingSwitches) RestrictToReduceOnly(asset portfolio.Asset, exchange connector.ExchangeName, reason string, actor string) error {
+	ret := _m.Called(asset, exchange, reason, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestrictToReduceOnly")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(portfolio.Asset, connector.ExchangeName, string, string) error); ok {
+		r0 = rf(asset, exchange, reason, actor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TradingSwitches_RestrictToReduceOnly_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestrictToReduceOnly'
+type TradingSwitches_RestrictToReduceOnly_Call struct {
+	*mock.Call
+}
+
+// RestrictToReduceOnly is a helper method to define mock.On call
+//   - asset portfolio.Asset
+//   - exchange connector.ExchangeName
+//   - reason string
+//   - actor string
+func (_e *TradingSwitches_Expecter) RestrictToReduceOnly(asset interface{}, exchange interface{}, reason interface{}, actor interface{}) *TradingSwitches_RestrictToReduceOnly_Call {
+	return &TradingSwitches_RestrictToReduceOnly_Call{Call: _e.mock.On("RestrictToReduceOnly", asset, exchange, reason, actor)}
+}
+
+func (_c *TradingSwitches_RestrictToReduceOnly_Call) Run(run func(asset portfolio.Asset, exchange connector.ExchangeName, reason string, actor string)) *TradingSwitches_RestrictToReduceOnly_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(portfolio.Asset), args[1].(connector.ExchangeName), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *TradingSwitches_RestrictToReduceOnly_Call) Return(_a0 error) *TradingSwitches_RestrictToReduceOnly_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TradingSwitches_RestrictToReduceOnly_Call) RunAndReturn(run func(portfolio.Asset, connector.ExchangeName, string, string) error) *TradingSwitches_RestrictToReduceOnly_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetStore provides a mock function with given fields: store
+func (_m *TradingSwitches) SetStore(store switches.Store) {
+	_m.Called(store)
+}
+
+// TradingSwitches_SetStore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetStore'
+type TradingSwitches_SetStore_Call struct {
+	*mock.Call
+}
+
+// SetStore is a helper method to define mock.On call
+//   - store switches.Store
+func (_e *TradingSwitches_Expecter) SetStore(store interface{}) *TradingSwitches_SetStore_Call {
+	return &TradingSwitches_SetStore_Call{Call: _e.mock.On("SetStore", store)}
+}
+
+func (_c *TradingSwitches_SetStore_Call) Run(run func(store switches.Store)) *TradingSwitches_SetStore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(switches.Store))
+	})
+	return _c
+}
+
+func (_c *TradingSwitches_SetStore_Call) Return() *TradingSwitches_SetStore_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *TradingSwitches_SetStore_Call) RunAndReturn(run func(switches.Store)) *TradingSwitches_SetStore_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Switches provides a mock function with no fields
+func (_m *TradingSwitches) Switches() []switches.Switch {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Switches")
+	}
+
+	var r0 []switches.Switch
+	if rf, ok := ret.Get(0).(func() []switches.Switch); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]switches.Switch)
+		}
+	}
+
+	return r0
+}
+
+// TradingSwitches_Switches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Switches'
+type TradingSwitches_Switches_Call struct {
+	*mock.Call
+}
+
+// Switches is a helper method to define mock.On call
+func (_e *TradingSwitches_Expecter) Switches() *TradingSwitches_Switches_Call {
+	return &TradingSwitches_Switches_Call{Call: _e.mock.On("Switches")}
+}
+
+func (_c *TradingSwitches_Switches_Call) Run(run func()) *TradingSwitches_Switches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TradingSwitches_Switches_Call) Return(_a0 []switches.Switch) *TradingSwitches_Switches_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TradingSwitches_Switches_Call) RunAndReturn(run func() []switches.Switch) *TradingSwitches_Switches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTradingSwitches creates a new instance of TradingSwitches. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTradingSwitches(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TradingSwitches {
+	mock := &TradingSwitches{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
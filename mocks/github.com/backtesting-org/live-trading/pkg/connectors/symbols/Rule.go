@@ -0,0 +1,214 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package symbols
+
+import (
+	connector "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	mock "github.com/stretchr/testify/mock"
+
+	portfolio "github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+)
+
+// Rule is an autogenerated mock type for the Rule type
+type Rule struct {
+	mock.Mock
+}
+
+type Rule_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Rule) EXPECT() *Rule_Expecter {
+	return &Rule_Expecter{mock: &_m.Mock}
+}
+
+// FromNative provides a mock function with given fields: symbol
+func (_m *Rule) FromNative(symbol string) (portfolio.Asset, connector.Instrument, error) {
+	ret := _m.Called(symbol)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FromNative")
+	}
+
+	var r0 portfolio.Asset
+	var r1 connector.Instrument
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string) (portfolio.Asset, connector.Instrument, error)); ok {
+		return rf(symbol)
+	}
+	if rf, ok := ret.Get(0).(func(string) portfolio.Asset); ok {
+		r0 = rf(symbol)
+	} else {
+		r0 = ret.Get(0).(portfolio.Asset)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) connector.Instrument); ok {
+		r1 = rf(symbol)
+	} else {
+		r1 = ret.Get(1).(connector.Instrument)
+	}
+
+	if rf, ok := ret.Get(2).(func(string) error); ok {
+		r2 = rf(symbol)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Rule_FromNative_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FromNative'
+type Rule_FromNative_Call struct {
+	*mock.Call
+}
+
+// FromNative is a helper method to define mock.On call
+//   - symbol string
+func (_e *Rule_Expecter) FromNative(symbol interface{}) *Rule_FromNative_Call {
+	return &Rule_FromNative_Call{Call: _e.mock.On("FromNative", symbol)}
+}
+
+func (_c *Rule_FromNative_Call) Run(run func(symbol string)) *Rule_FromNative_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Rule_FromNative_Call) Return(_a0 portfolio.Asset, _a1 connector.Instrument, _a2 error) *Rule_FromNative_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *Rule_FromNative_Call) RunAndReturn(run func(string) (portfolio.Asset, connector.Instrument, error)) *Rule_FromNative_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Resolve provides a mock function with given fields: symbol, instrument
+func (_m *Rule) Resolve(symbol string, instrument connector.Instrument) (string, error) {
+	ret := _m.Called(symbol, instrument)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Resolve")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, connector.Instrument) (string, error)); ok {
+		return rf(symbol, instrument)
+	}
+	if rf, ok := ret.Get(0).(func(string, connector.Instrument) string); ok {
+		r0 = rf(symbol, instrument)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, connector.Instrument) error); ok {
+		r1 = rf(symbol, instrument)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Rule_Resolve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resolve'
+type Rule_Resolve_Call struct {
+	*mock.Call
+}
+
+// Resolve is a helper method to define mock.On call
+//   - symbol string
+//   - instrument connector.Instrument
+func (_e *Rule_Expecter) Resolve(symbol interface{}, instrument interface{}) *Rule_Resolve_Call {
+	return &Rule_Resolve_Call{Call: _e.mock.On("Resolve", symbol, instrument)}
+}
+
+func (_c *Rule_Resolve_Call) Run(run func(symbol string, instrument connector.Instrument)) *Rule_Resolve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(connector.Instrument))
+	})
+	return _c
+}
+
+func (_c *Rule_Resolve_Call) Return(_a0 string, _a1 error) *Rule_Resolve_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Rule_Resolve_Call) RunAndReturn(run func(string, connector.Instrument) (string, error)) *Rule_Resolve_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ToNative provides a mock function with given fields: asset, instrument
+func (_m *Rule) ToNative(asset portfolio.Asset, instrument connector.Instrument) (string, error) {
+	ret := _m.Called(asset, instrument)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ToNative")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(portfolio.Asset, connector.Instrument) (string, error)); ok {
+		return rf(asset, instrument)
+	}
+	if rf, ok := ret.Get(0).(func(portfolio.Asset, connector.Instrument) string); ok {
+		r0 = rf(asset, instrument)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(portfolio.Asset, connector.Instrument) error); ok {
+		r1 = rf(asset, instrument)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Rule_ToNative_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ToNative'
+type Rule_ToNative_Call struct {
+	*mock.Call
+}
+
+// ToNative is a helper method to define mock.On call
+//   - asset portfolio.Asset
+//   - instrument connector.Instrument
+func (_e *Rule_Expecter) ToNative(asset interface{}, instrument interface{}) *Rule_ToNative_Call {
+	return &Rule_ToNative_Call{Call: _e.mock.On("ToNative", asset, instrument)}
+}
+
+func (_c *Rule_ToNative_Call) Run(run func(asset portfolio.Asset, instrument connector.Instrument)) *Rule_ToNative_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(portfolio.Asset), args[1].(connector.Instrument))
+	})
+	return _c
+}
+
+func (_c *Rule_ToNative_Call) Return(_a0 string, _a1 error) *Rule_ToNative_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Rule_ToNative_Call) RunAndReturn(run func(portfolio.Asset, connector.Instrument) (string, error)) *Rule_ToNative_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRule creates a new instance of Rule. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRule(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Rule {
+	mock := &Rule{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,300 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package symbols
+
+import (
+	connector "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	mock "github.com/stretchr/testify/mock"
+
+	portfolio "github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+
+	symbols "github.com/backtesting-org/live-trading/pkg/connectors/symbols"
+)
+
+// SymbolMapper is an autogenerated mock type for the SymbolMapper type
+type SymbolMapper struct {
+	mock.Mock
+}
+
+type SymbolMapper_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SymbolMapper) EXPECT() *SymbolMapper_Expecter {
+	return &SymbolMapper_Expecter{mock: &_m.Mock}
+}
+
+// Exchanges provides a mock function with no fields
+func (_m *SymbolMapper) Exchanges() []connector.ExchangeName {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exchanges")
+	}
+
+	var r0 []connector.ExchangeName
+	if rf, ok := ret.Get(0).(func() []connector.ExchangeName); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]connector.ExchangeName)
+		}
+	}
+
+	return r0
+}
+
+// SymbolMapper_Exchanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exchanges'
+type SymbolMapper_Exchanges_Call struct {
+	*mock.Call
+}
+
+// Exchanges is a helper method to define mock.On call
+func (_e *SymbolMapper_Expecter) Exchanges() *SymbolMapper_Exchanges_Call {
+	return &SymbolMapper_Exchanges_Call{Call: _e.mock.On("Exchanges")}
+}
+
+func (_c *SymbolMapper_Exchanges_Call) Run(run func()) *SymbolMapper_Exchanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SymbolMapper_Exchanges_Call) Return(_a0 []connector.ExchangeName) *SymbolMapper_Exchanges_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SymbolMapper_Exchanges_Call) RunAndReturn(run func() []connector.ExchangeName) *SymbolMapper_Exchanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FromNative provides a mock function with given fields: exchange, symbol
+func (_m *SymbolMapper) FromNative(exchange connector.ExchangeName, symbol string) (portfolio.Asset, connector.Instrument, error) {
+	ret := _m.Called(exchange, symbol)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FromNative")
+	}
+
+	var r0 portfolio.Asset
+	var r1 connector.Instrument
+	var r2 error
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, string) (portfolio.Asset, connector.Instrument, error)); ok {
+		return rf(exchange, symbol)
+	}
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, string) portfolio.Asset); ok {
+		r0 = rf(exchange, symbol)
+	} else {
+		r0 = ret.Get(0).(portfolio.Asset)
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.ExchangeName, string) connector.Instrument); ok {
+		r1 = rf(exchange, symbol)
+	} else {
+		r1 = ret.Get(1).(connector.Instrument)
+	}
+
+	if rf, ok := ret.Get(2).(func(connector.ExchangeName, string) error); ok {
+		r2 = rf(exchange, symbol)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// SymbolMapper_FromNative_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FromNative'
+type SymbolMapper_FromNative_Call struct {
+	*mock.Call
+}
+
+// FromNative is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - symbol string
+func (_e *SymbolMapper_Expecter) FromNative(exchange interface{}, symbol interface{}) *SymbolMapper_FromNative_Call {
+	return &SymbolMapper_FromNative_Call{Call: _e.mock.On("FromNative", exchange, symbol)}
+}
+
+func (_c *SymbolMapper_FromNative_Call) Run(run func(exchange connector.ExchangeName, symbol string)) *SymbolMapper_FromNative_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *SymbolMapper_FromNative_Call) Return(_a0 portfolio.Asset, _a1 connector.Instrument, _a2 error) *SymbolMapper_FromNative_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *SymbolMapper_FromNative_Call) RunAndReturn(run func(connector.ExchangeName, string) (portfolio.Asset, connector.Instrument, error)) *SymbolMapper_FromNative_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Register provides a mock function with given fields: exchange, rule
+func (_m *SymbolMapper) Register(exchange connector.ExchangeName, rule symbols.Rule) {
+	_m.Called(exchange, rule)
+}
+
+// SymbolMapper_Register_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Register'
+type SymbolMapper_Register_Call struct {
+	*mock.Call
+}
+
+// Register is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - rule symbols.Rule
+func (_e *SymbolMapper_Expecter) Register(exchange interface{}, rule interface{}) *SymbolMapper_Register_Call {
+	return &SymbolMapper_Register_Call{Call: _e.mock.On("Register", exchange, rule)}
+}
+
+func (_c *SymbolMapper_Register_Call) Run(run func(exchange connector.ExchangeName, rule symbols.Rule)) *SymbolMapper_Register_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(symbols.Rule))
+	})
+	return _c
+}
+
+func (_c *SymbolMapper_Register_Call) Return() *SymbolMapper_Register_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *SymbolMapper_Register_Call) RunAndReturn(run func(connector.ExchangeName, symbols.Rule)) *SymbolMapper_Register_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Resolve provides a mock function with given fields: exchange, symbol, instrument
+func (_m *SymbolMapper) Resolve(exchange connector.ExchangeName, symbol string, instrument connector.Instrument) (string, error) {
+	ret := _m.Called(exchange, symbol, instrument)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Resolve")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, string, connector.Instrument) (string, error)); ok {
+		return rf(exchange, symbol, instrument)
+	}
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, string, connector.Instrument) string); ok {
+		r0 = rf(exchange, symbol, instrument)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.ExchangeName, string, connector.Instrument) error); ok {
+		r1 = rf(exchange, symbol, instrument)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SymbolMapper_Resolve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resolve'
+type SymbolMapper_Resolve_Call struct {
+	*mock.Call
+}
+
+// Resolve is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - symbol string
+//   - instrument connector.Instrument
+func (_e *SymbolMapper_Expecter) Resolve(exchange interface{}, symbol interface{}, instrument interface{}) *SymbolMapper_Resolve_Call {
+	return &SymbolMapper_Resolve_Call{Call: _e.mock.On("Resolve", exchange, symbol, instrument)}
+}
+
+func (_c *SymbolMapper_Resolve_Call) Run(run func(exchange connector.ExchangeName, symbol string, instrument connector.Instrument)) *SymbolMapper_Resolve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(string), args[2].(connector.Instrument))
+	})
+	return _c
+}
+
+func (_c *SymbolMapper_Resolve_Call) Return(_a0 string, _a1 error) *SymbolMapper_Resolve_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SymbolMapper_Resolve_Call) RunAndReturn(run func(connector.ExchangeName, string, connector.Instrument) (string, error)) *SymbolMapper_Resolve_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ToNative provides a mock function with given fields: exchange, asset, instrument
+func (_m *SymbolMapper) ToNative(exchange connector.ExchangeName, asset portfolio.Asset, instrument connector.Instrument) (string, error) {
+	ret := _m.Called(exchange, asset, instrument)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ToNative")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, portfolio.Asset, connector.Instrument) (string, error)); ok {
+		return rf(exchange, asset, instrument)
+	}
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, portfolio.Asset, connector.Instrument) string); ok {
+		r0 = rf(exchange, asset, instrument)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.ExchangeName, portfolio.Asset, connector.Instrument) error); ok {
+		r1 = rf(exchange, asset, instrument)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SymbolMapper_ToNative_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ToNative'
+type SymbolMapper_ToNative_Call struct {
+	*mock.Call
+}
+
+// ToNative is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - asset portfolio.Asset
+//   - instrument connector.Instrument
+func (_e *SymbolMapper_Expecter) ToNative(exchange interface{}, asset interface{}, instrument interface{}) *SymbolMapper_ToNative_Call {
+	return &SymbolMapper_ToNative_Call{Call: _e.mock.On("ToNative", exchange, asset, instrument)}
+}
+
+func (_c *SymbolMapper_ToNative_Call) Run(run func(exchange connector.ExchangeName, asset portfolio.Asset, instrument connector.Instrument)) *SymbolMapper_ToNative_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(portfolio.Asset), args[2].(connector.Instrument))
+	})
+	return _c
+}
+
+func (_c *SymbolMapper_ToNative_Call) Return(_a0 string, _a1 error) *SymbolMapper_ToNative_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SymbolMapper_ToNative_Call) RunAndReturn(run func(connector.ExchangeName, portfolio.Asset, connector.Instrument) (string, error)) *SymbolMapper_ToNative_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSymbolMapper creates a new instance of SymbolMapper. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSymbolMapper(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SymbolMapper {
+	mock := &SymbolMapper{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,452 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package tracker
+
+import (
+	connector "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	mock "github.com/stretchr/testify/mock"
+
+	tracker "github.com/backtesting-org/live-trading/pkg/connectors/tracker"
+)
+
+// OrderTracker is an autogenerated mock type for the OrderTracker type
+type OrderTracker struct {
+	mock.Mock
+}
+
+type OrderTracker_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OrderTracker) EXPECT() *OrderTracker_Expecter {
+	return &OrderTracker_Expecter{mock: &_m.Mock}
+}
+
+// Active provides a mock function with no fields
+func (_m *OrderTracker) Active() []tracker.TrackedOrder {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Active")
+	}
+
+	var r0 []tracker.TrackedOrder
+	if rf, ok := ret.Get(0).(func() []tracker.TrackedOrder); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]tracker.TrackedOrder)
+		}
+	}
+
+	return r0
+}
+
+// OrderTracker_Active_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Active'
+type OrderTracker_Active_Call struct {
+	*mock.Call
+}
+
+// Active is a helper method to define mock.On call
+func (_e *OrderTracker_Expecter) Active() *OrderTracker_Active_Call {
+	return &OrderTracker_Active_Call{Call: _e.mock.On("Active")}
+}
+
+func (_c *OrderTracker_Active_Call) Run(run func()) *OrderTracker_Active_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OrderTracker_Active_Call) Return(_a0 []tracker.TrackedOrder) *OrderTracker_Active_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderTracker_Active_Call) RunAndReturn(run func() []tracker.TrackedOrder) *OrderTracker_Active_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Adopt provides a mock function with given fields: exchange, order
+func (_m *OrderTracker) Adopt(exchange connector.ExchangeName, order connector.Order) error {
+	ret := _m.Called(exchange, order)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Adopt")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, connector.Order) error); ok {
+		r0 = rf(exchange, order)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderTracker_Adopt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Adopt'
+type OrderTracker_Adopt_Call struct {
+	*mock.Call
+}
+
+// Adopt is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - order connector.Order
+func (_e *OrderTracker_Expecter) Adopt(exchange interface{}, order interface{}) *OrderTracker_Adopt_Call {
+	return &OrderTracker_Adopt_Call{Call: _e.mock.On("Adopt", exchange, order)}
+}
+
+func (_c *OrderTracker_Adopt_Call) Run(run func(exchange connector.ExchangeName, order connector.Order)) *OrderTracker_Adopt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(connector.Order))
+	})
+	return _c
+}
+
+func (_c *OrderTracker_Adopt_Call) Return(_a0 error) *OrderTracker_Adopt_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderTracker_Adopt_Call) RunAndReturn(run func(connector.ExchangeName, connector.Order) error) *OrderTracker_Adopt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Annotate provides a mock function with given fields: exchange, orderID, event, detail
+func (_m *OrderTracker) Annotate(exchange connector.ExchangeName, orderID string, event string, detail interface{}) error {
+	ret := _m.Called(exchange, orderID, event, detail)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Annotate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, string, string, interface{}) error); ok {
+		r0 = rf(exchange, orderID, event, detail)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderTracker_Annotate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Annotate'
+type OrderTracker_Annotate_Call struct {
+	*mock.Call
+}
+
+// Annotate is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - orderID string
+//   - event string
+//   - detail interface{}
+func (_e *OrderTracker_Expecter) Annotate(exchange interface{}, orderID interface{}, event interface{}, detail interface{}) *OrderTracker_Annotate_Call {
+	return &OrderTracker_Annotate_Call{Call: _e.mock.On("Annotate", exchange, orderID, event, detail)}
+}
+
+func (_c *OrderTracker_Annotate_Call) Run(run func(exchange connector.ExchangeName, orderID string, event string, detail interface{})) *OrderTracker_Annotate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(string), args[2].(string), args[3].(interface{}))
+	})
+	return _c
+}
+
+func (_c *OrderTracker_Annotate_Call) Return(_a0 error) *OrderTracker_Annotate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderTracker_Annotate_Call) RunAndReturn(run func(connector.ExchangeName, string, string, interface{}) error) *OrderTracker_Annotate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *OrderTracker) Configure(config tracker.Config) {
+	_m.Called(config)
+}
+
+// OrderTracker_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type OrderTracker_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config tracker.Config
+func (_e *OrderTracker_Expecter) Configure(config interface{}) *OrderTracker_Configure_Call {
+	return &OrderTracker_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *OrderTracker_Configure_Call) Run(run func(config tracker.Config)) *OrderTracker_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(tracker.Config))
+	})
+	return _c
+}
+
+func (_c *OrderTracker_Configure_Call) Return() *OrderTracker_Configure_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *OrderTracker_Configure_Call) RunAndReturn(run func(tracker.Config)) *OrderTracker_Configure_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Order provides a mock function with given fields: exchange, orderID
+func (_m *OrderTracker) Order(exchange connector.ExchangeName, orderID string) (tracker.TrackedOrder, bool) {
+	ret := _m.Called(exchange, orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Order")
+	}
+
+	var r0 tracker.TrackedOrder
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, string) (tracker.TrackedOrder, bool)); ok {
+		return rf(exchange, orderID)
+	}
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, string) tracker.TrackedOrder); ok {
+		r0 = rf(exchange, orderID)
+	} else {
+		r0 = ret.Get(0).(tracker.TrackedOrder)
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.ExchangeName, string) bool); ok {
+		r1 = rf(exchange, orderID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// OrderTracker_Order_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Order'
+type OrderTracker_Order_Call struct {
+	*mock.Call
+}
+
+// Order is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - orderID string
+func (_e *OrderTracker_Expecter) Order(exchange interface{}, orderID interface{}) *OrderTracker_Order_Call {
+	return &OrderTracker_Order_Call{Call: _e.mock.On("Order", exchange, orderID)}
+}
+
+func (_c *OrderTracker_Order_Call) Run(run func(exchange connector.ExchangeName, orderID string)) *OrderTracker_Order_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *OrderTracker_Order_Call) Return(_a0 tracker.TrackedOrder, _a1 bool) *OrderTracker_Order_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderTracker_Order_Call) RunAndReturn(run func(connector.ExchangeName, string) (tracker.TrackedOrder, bool)) *OrderTracker_Order_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Poll provides a mock function with no fields
+func (_m *OrderTracker) Poll() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Poll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderTracker_Poll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Poll'
+type OrderTracker_Poll_Call struct {
+	*mock.Call
+}
+
+// Poll is a helper method to define mock.On call
+func (_e *OrderTracker_Expecter) Poll() *OrderTracker_Poll_Call {
+	return &OrderTracker_Poll_Call{Call: _e.mock.On("Poll")}
+}
+
+func (_c *OrderTracker_Poll_Call) Run(run func()) *OrderTracker_Poll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OrderTracker_Poll_Call) Return(_a0 error) *OrderTracker_Poll_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderTracker_Poll_Call) RunAndReturn(run func() error) *OrderTracker_Poll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with no fields
+func (_m *OrderTracker) Start() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderTracker_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type OrderTracker_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+func (_e *OrderTracker_Expecter) Start() *OrderTracker_Start_Call {
+	return &OrderTracker_Start_Call{Call: _e.mock.On("Start")}
+}
+
+func (_c *OrderTracker_Start_Call) Run(run func()) *OrderTracker_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OrderTracker_Start_Call) Return(_a0 error) *OrderTracker_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderTracker_Start_Call) RunAndReturn(run func() error) *OrderTracker_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with no fields
+func (_m *OrderTracker) Stop() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderTracker_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type OrderTracker_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *OrderTracker_Expecter) Stop() *OrderTracker_Stop_Call {
+	return &OrderTracker_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *OrderTracker_Stop_Call) Run(run func()) *OrderTracker_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *OrderTracker_Stop_Call) Return(_a0 error) *OrderTracker_Stop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderTracker_Stop_Call) RunAndReturn(run func() error) *OrderTracker_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Track provides a mock function with given fields: exchange, response
+func (_m *OrderTracker) Track(exchange connector.ExchangeName, response *connector.OrderResponse) error {
+	ret := _m.Called(exchange, response)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Track")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, *connector.OrderResponse) error); ok {
+		r0 = rf(exchange, response)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderTracker_Track_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Track'
+type OrderTracker_Track_Call struct {
+	*mock.Call
+}
+
+// Track is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - response *connector.OrderResponse
+func (_e *OrderTracker_Expecter) Track(exchange interface{}, response interface{}) *OrderTracker_Track_Call {
+	return &OrderTracker_Track_Call{Call: _e.mock.On("Track", exchange, response)}
+}
+
+func (_c *OrderTracker_Track_Call) Run(run func(exchange connector.ExchangeName, response *connector.OrderResponse)) *OrderTracker_Track_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(*connector.OrderResponse))
+	})
+	return _c
+}
+
+func (_c *OrderTracker_Track_Call) Return(_a0 error) *OrderTracker_Track_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderTracker_Track_Call) RunAndReturn(run func(connector.ExchangeName, *connector.OrderResponse) error) *OrderTracker_Track_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOrderTracker creates a new instance of OrderTracker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOrderTracker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OrderTracker {
+	mock := &OrderTracker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,92 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package types
+
+import (
+	types "github.com/backtesting-org/live-trading/pkg/connectors/types"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// APIKeyInspector is an autogenerated mock type for the APIKeyInspector type
+type APIKeyInspector struct {
+	mock.Mock
+}
+
+type APIKeyInspector_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *APIKeyInspector) EXPECT() *APIKeyInspector_Expecter {
+	return &APIKeyInspector_Expecter{mock: &_m.Mock}
+}
+
+// FetchAPIKeyPermissions provides a mock function with no fields
+func (_m *APIKeyInspector) FetchAPIKeyPermissions() (*types.APIKeyPermissions, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchAPIKeyPermissions")
+	}
+
+	var r0 *types.APIKeyPermissions
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*types.APIKeyPermissions, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *types.APIKeyPermissions); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.APIKeyPermissions)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// APIKeyInspector_FetchAPIKeyPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchAPIKeyPermissions'
+type APIKeyInspector_FetchAPIKeyPermissions_Call struct {
+	*mock.Call
+}
+
+// FetchAPIKeyPermissions is a helper method to define mock.On call
+func (_e *APIKeyInspector_Expecter) FetchAPIKeyPermissions() *APIKeyInspector_FetchAPIKeyPermissions_Call {
+	return &APIKeyInspector_FetchAPIKeyPermissions_Call{Call: _e.mock.On("FetchAPIKeyPermissions")}
+}
+
+func (_c *APIKeyInspector_FetchAPIKeyPermissions_Call) Run(run func()) *APIKeyInspector_FetchAPIKeyPermissions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *APIKeyInspector_FetchAPIKeyPermissions_Call) Return(_a0 *types.APIKeyPermissions, _a1 error) *APIKeyInspector_FetchAPIKeyPermissions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *APIKeyInspector_FetchAPIKeyPermissions_Call) RunAndReturn(run func() (*types.APIKeyPermissions, error)) *APIKeyInspector_FetchAPIKeyPermissions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAPIKeyInspector creates a new instance of APIKeyInspector. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAPIKeyInspector(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *APIKeyInspector {
+	mock := &APIKeyInspector{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
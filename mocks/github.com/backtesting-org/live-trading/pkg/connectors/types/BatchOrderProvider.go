@@ -0,0 +1,197 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package types
+
+import (
+	types "github.com/backtesting-org/live-trading/pkg/connectors/types"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// BatchOrderProvider is an autogenerated mock type for the BatchOrderProvider type
+type BatchOrderProvider struct {
+	mock.Mock
+}
+
+type BatchOrderProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *BatchOrderProvider) EXPECT() *BatchOrderProvider_Expecter {
+	return &BatchOrderProvider_Expecter{mock: &_m.Mock}
+}
+
+// CancelOrders provides a mock function with given fields: symbol, orderIDs
+func (_m *BatchOrderProvider) CancelOrders(symbol string, orderIDs []string) ([]types.CancelResult, error) {
+	ret := _m.Called(symbol, orderIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelOrders")
+	}
+
+	var r0 []types.CancelResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, []string) ([]types.CancelResult, error)); ok {
+		return rf(symbol, orderIDs)
+	}
+	if rf, ok := ret.Get(0).(func(string, []string) []types.CancelResult); ok {
+		r0 = rf(symbol, orderIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.CancelResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []string) error); ok {
+		r1 = rf(symbol, orderIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BatchOrderProvider_CancelOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelOrders'
+type BatchOrderProvider_CancelOrders_Call struct {
+	*mock.Call
+}
+
+// CancelOrders is a helper method to define mock.On call
+//   - symbol string
+//   - orderIDs []string
+func (_e *BatchOrderProvider_Expecter) CancelOrders(symbol interface{}, orderIDs interface{}) *BatchOrderProvider_CancelOrders_Call {
+	return &BatchOrderProvider_CancelOrders_Call{Call: _e.mock.On("CancelOrders", symbol, orderIDs)}
+}
+
+func (_c *BatchOrderProvider_CancelOrders_Call) Run(run func(symbol string, orderIDs []string)) *BatchOrderProvider_CancelOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *BatchOrderProvider_CancelOrders_Call) Return(_a0 []types.CancelResult, _a1 error) *BatchOrderProvider_CancelOrders_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BatchOrderProvider_CancelOrders_Call) RunAndReturn(run func(string, []string) ([]types.CancelResult, error)) *BatchOrderProvider_CancelOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MaxBatchSize provides a mock function with no fields
+func (_m *BatchOrderProvider) MaxBatchSize() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MaxBatchSize")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// BatchOrderProvider_MaxBatchSize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MaxBatchSize'
+type BatchOrderProvider_MaxBatchSize_Call struct {
+	*mock.Call
+}
+
+// MaxBatchSize is a helper method to define mock.On call
+func (_e *BatchOrderProvider_Expecter) MaxBatchSize() *BatchOrderProvider_MaxBatchSize_Call {
+	return &BatchOrderProvider_MaxBatchSize_Call{Call: _e.mock.On("MaxBatchSize")}
+}
+
+func (_c *BatchOrderProvider_MaxBatchSize_Call) Run(run func()) *BatchOrderProvider_MaxBatchSize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *BatchOrderProvider_MaxBatchSize_Call) Return(_a0 int) *BatchOrderProvider_MaxBatchSize_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BatchOrderProvider_MaxBatchSize_Call) RunAndReturn(run func() int) *BatchOrderProvider_MaxBatchSize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PlaceOrders provides a mock function with given fields: requests
+func (_m *BatchOrderProvider) PlaceOrders(requests []types.OrderRequest) ([]types.OrderResult, error) {
+	ret := _m.Called(requests)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlaceOrders")
+	}
+
+	var r0 []types.OrderResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]types.OrderRequest) ([]types.OrderResult, error)); ok {
+		return rf(requests)
+	}
+	if rf, ok := ret.Get(0).(func([]types.OrderRequest) []types.OrderResult); ok {
+		r0 = rf(requests)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.OrderResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]types.OrderRequest) error); ok {
+		r1 = rf(requests)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BatchOrderProvider_PlaceOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PlaceOrders'
+type BatchOrderProvider_PlaceOrders_Call struct {
+	*mock.Call
+}
+
+// PlaceOrders is a helper method to define mock.On call
+//   - requests []types.OrderRequest
+func (_e *BatchOrderProvider_Expecter) PlaceOrders(requests interface{}) *BatchOrderProvider_PlaceOrders_Call {
+	return &BatchOrderProvider_PlaceOrders_Call{Call: _e.mock.On("PlaceOrders", requests)}
+}
+
+func (_c *BatchOrderProvider_PlaceOrders_Call) Run(run func(requests []types.OrderRequest)) *BatchOrderProvider_PlaceOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]types.OrderRequest))
+	})
+	return _c
+}
+
+func (_c *BatchOrderProvider_PlaceOrders_Call) Return(_a0 []types.OrderResult, _a1 error) *BatchOrderProvider_PlaceOrders_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BatchOrderProvider_PlaceOrders_Call) RunAndReturn(run func([]types.OrderRequest) ([]types.OrderResult, error)) *BatchOrderProvider_PlaceOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewBatchOrderProvider creates a new instance of BatchOrderProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBatchOrderProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BatchOrderProvider {
+	mock := &BatchOrderProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
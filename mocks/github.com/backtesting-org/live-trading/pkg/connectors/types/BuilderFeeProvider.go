@@ -0,0 +1,91 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package types
+
+import (
+	types "github.com/backtesting-org/live-trading/pkg/connectors/types"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// BuilderFeeProvider is an autogenerated mock type for the BuilderFeeProvider type
+type BuilderFeeProvider struct {
+	mock.Mock
+}
+
+type BuilderFeeProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *BuilderFeeProvider) EXPECT() *BuilderFeeProvider_Expecter {
+	return &BuilderFeeProvider_Expecter{mock: &_m.Mock}
+}
+
+// AppliedBuilderFee provides a mock function with given fields: orderID
+func (_m *BuilderFeeProvider) AppliedBuilderFee(orderID string) (types.BuilderFee, bool) {
+	ret := _m.Called(orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AppliedBuilderFee")
+	}
+
+	var r0 types.BuilderFee
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string) (types.BuilderFee, bool)); ok {
+		return rf(orderID)
+	}
+	if rf, ok := ret.Get(0).(func(string) types.BuilderFee); ok {
+		r0 = rf(orderID)
+	} else {
+		r0 = ret.Get(0).(types.BuilderFee)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(orderID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// BuilderFeeProvider_AppliedBuilderFee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AppliedBuilderFee'
+type BuilderFeeProvider_AppliedBuilderFee_Call struct {
+	*mock.Call
+}
+
+// AppliedBuilderFee is a helper method to define mock.On call
+//   - orderID string
+func (_e *BuilderFeeProvider_Expecter) AppliedBuilderFee(orderID interface{}) *BuilderFeeProvider_AppliedBuilderFee_Call {
+	return &BuilderFeeProvider_AppliedBuilderFee_Call{Call: _e.mock.On("AppliedBuilderFee", orderID)}
+}
+
+func (_c *BuilderFeeProvider_AppliedBuilderFee_Call) Run(run func(orderID string)) *BuilderFeeProvider_AppliedBuilderFee_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *BuilderFeeProvider_AppliedBuilderFee_Call) Return(_a0 types.BuilderFee, _a1 bool) *BuilderFeeProvider_AppliedBuilderFee_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BuilderFeeProvider_AppliedBuilderFee_Call) RunAndReturn(run func(string) (types.BuilderFee, bool)) *BuilderFeeProvider_AppliedBuilderFee_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewBuilderFeeProvider creates a new instance of BuilderFeeProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBuilderFeeProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BuilderFeeProvider {
+	mock := &BuilderFeeProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,77 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package types
+
+import mock "github.com/stretchr/testify/mock"
+
+// CancelAllProvider is an autogenerated mock type for the CancelAllProvider type
+type CancelAllProvider struct {
+	mock.Mock
+}
+
+type CancelAllProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CancelAllProvider) EXPECT() *CancelAllProvider_Expecter {
+	return &CancelAllProvider_Expecter{mock: &_m.Mock}
+}
+
+// CancelAllOrders provides a mock function with no fields
+func (_m *CancelAllProvider) CancelAllOrders() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelAllOrders")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CancelAllProvider_CancelAllOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelAllOrders'
+type CancelAllProvider_CancelAllOrders_Call struct {
+	*mock.Call
+}
+
+// CancelAllOrders is a helper method to define mock.On call
+func (_e *CancelAllProvider_Expecter) CancelAllOrders() *CancelAllProvider_CancelAllOrders_Call {
+	return &CancelAllProvider_CancelAllOrders_Call{Call: _e.mock.On("CancelAllOrders")}
+}
+
+func (_c *CancelAllProvider_CancelAllOrders_Call) Run(run func()) *CancelAllProvider_CancelAllOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *CancelAllProvider_CancelAllOrders_Call) Return(_a0 error) *CancelAllProvider_CancelAllOrders_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CancelAllProvider_CancelAllOrders_Call) RunAndReturn(run func() error) *CancelAllProvider_CancelAllOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCancelAllProvider creates a new instance of CancelAllProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCancelAllProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CancelAllProvider {
+	mock := &CancelAllProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
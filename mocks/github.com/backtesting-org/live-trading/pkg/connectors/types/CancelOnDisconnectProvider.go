@@ -0,0 +1,82 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package types
+
+import (
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CancelOnDisconnectProvider is an autogenerated mock type for the CancelOnDisconnectProvider type
+type CancelOnDisconnectProvider struct {
+	mock.Mock
+}
+
+type CancelOnDisconnectProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CancelOnDisconnectProvider) EXPECT() *CancelOnDisconnectProvider_Expecter {
+	return &CancelOnDisconnectProvider_Expecter{mock: &_m.Mock}
+}
+
+// ArmCancelOnDisconnect provides a mock function with given fields: window
+func (_m *CancelOnDisconnectProvider) ArmCancelOnDisconnect(window time.Duration) error {
+	ret := _m.Called(window)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArmCancelOnDisconnect")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(time.Duration) error); ok {
+		r0 = rf(window)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CancelOnDisconnectProvider_ArmCancelOnDisconnect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArmCancelOnDisconnect'
+type CancelOnDisconnectProvider_ArmCancelOnDisconnect_Call struct {
+	*mock.Call
+}
+
+// ArmCancelOnDisconnect is a helper method to define mock.On call
+//   - window time.Duration
+func (_e *CancelOnDisconnectProvider_Expecter) ArmCancelOnDisconnect(window interface{}) *CancelOnDisconnectProvider_ArmCancelOnDisconnect_Call {
+	return &CancelOnDisconnectProvider_ArmCancelOnDisconnect_Call{Call: _e.mock.On("ArmCancelOnDisconnect", window)}
+}
+
+func (_c *CancelOnDisconnectProvider_ArmCancelOnDisconnect_Call) Run(run func(window time.Duration)) *CancelOnDisconnectProvider_ArmCancelOnDisconnect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *CancelOnDisconnectProvider_ArmCancelOnDisconnect_Call) Return(_a0 error) *CancelOnDisconnectProvider_ArmCancelOnDisconnect_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CancelOnDisconnectProvider_ArmCancelOnDisconnect_Call) RunAndReturn(run func(time.Duration) error) *CancelOnDisconnectProvider_ArmCancelOnDisconnect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCancelOnDisconnectProvider creates a new instance of CancelOnDisconnectProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCancelOnDisconnectProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CancelOnDisconnectProvider {
+	mock := &CancelOnDisconnectProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
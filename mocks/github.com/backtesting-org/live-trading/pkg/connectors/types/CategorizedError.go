@@ -0,0 +1,80 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package types
+
+import (
+	types "github.com/backtesting-org/live-trading/pkg/connectors/types"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CategorizedError is an autogenerated mock type for the CategorizedError type
+type CategorizedError struct {
+	mock.Mock
+}
+
+type CategorizedError_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CategorizedError) EXPECT() *CategorizedError_Expecter {
+	return &CategorizedError_Expecter{mock: &_m.Mock}
+}
+
+// ErrorCategory provides a mock function with no fields
+func (_m *CategorizedError) ErrorCategory() types.ErrorCategory {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ErrorCategory")
+	}
+
+	var r0 types.ErrorCategory
+	if rf, ok := ret.Get(0).(func() types.ErrorCategory); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(types.ErrorCategory)
+	}
+
+	return r0
+}
+
+// CategorizedError_ErrorCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ErrorCategory'
+type CategorizedError_ErrorCategory_Call struct {
+	*mock.Call
+}
+
+// ErrorCategory is a helper method to define mock.On call
+func (_e *CategorizedError_Expecter) ErrorCategory() *CategorizedError_ErrorCategory_Call {
+	return &CategorizedError_ErrorCategory_Call{Call: _e.mock.On("ErrorCategory")}
+}
+
+func (_c *CategorizedError_ErrorCategory_Call) Run(run func()) *CategorizedError_ErrorCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *CategorizedError_ErrorCategory_Call) Return(_a0 types.ErrorCategory) *CategorizedError_ErrorCategory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CategorizedError_ErrorCategory_Call) RunAndReturn(run func() types.ErrorCategory) *CategorizedError_ErrorCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCategorizedError creates a new instance of CategorizedError. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCategorizedError(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CategorizedError {
+	mock := &CategorizedError{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,81 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package types
+
+import (
+	connector "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DepthSnapshotProvider is an autogenerated mock type for the DepthSnapshotProvider type
+type DepthSnapshotProvider struct {
+	mock.Mock
+}
+
+type DepthSnapshotProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DepthSnapshotProvider) EXPECT() *DepthSnapshotProvider_Expecter {
+	return &DepthSnapshotProvider_Expecter{mock: &_m.Mock}
+}
+
+// MaxSnapshotDepth provides a mock function with given fields: instrument
+func (_m *DepthSnapshotProvider) MaxSnapshotDepth(instrument connector.Instrument) int {
+	ret := _m.Called(instrument)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MaxSnapshotDepth")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(connector.Instrument) int); ok {
+		r0 = rf(instrument)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// DepthSnapshotProvider_MaxSnapshotDepth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MaxSnapshotDepth'
+type DepthSnapshotProvider_MaxSnapshotDepth_Call struct {
+	*mock.Call
+}
+
+// MaxSnapshotDepth is a helper method to define mock.On call
+//   - instrument connector.Instrument
+func (_e *DepthSnapshotProvider_Expecter) MaxSnapshotDepth(instrument interface{}) *DepthSnapshotProvider_MaxSnapshotDepth_Call {
+	return &DepthSnapshotProvider_MaxSnapshotDepth_Call{Call: _e.mock.On("MaxSnapshotDepth", instrument)}
+}
+
+func (_c *DepthSnapshotProvider_MaxSnapshotDepth_Call) Run(run func(instrument connector.Instrument)) *DepthSnapshotProvider_MaxSnapshotDepth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.Instrument))
+	})
+	return _c
+}
+
+func (_c *DepthSnapshotProvider_MaxSnapshotDepth_Call) Return(_a0 int) *DepthSnapshotProvider_MaxSnapshotDepth_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DepthSnapshotProvider_MaxSnapshotDepth_Call) RunAndReturn(run func(connector.Instrument) int) *DepthSnapshotProvider_MaxSnapshotDepth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDepthSnapshotProvider creates a new instance of DepthSnapshotProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDepthSnapshotProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DepthSnapshotProvider {
+	mock := &DepthSnapshotProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,172 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package types
+
+import (
+	connector "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FillStreamer is an autogenerated mock type for the FillStreamer type
+type FillStreamer struct {
+	mock.Mock
+}
+
+type FillStreamer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FillStreamer) EXPECT() *FillStreamer_Expecter {
+	return &FillStreamer_Expecter{mock: &_m.Mock}
+}
+
+// FillUpdates provides a mock function with no fields
+func (_m *FillStreamer) FillUpdates() <-chan connector.Trade {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FillUpdates")
+	}
+
+	var r0 <-chan connector.Trade
+	if rf, ok := ret.Get(0).(func() <-chan connector.Trade); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan connector.Trade)
+		}
+	}
+
+	return r0
+}
+
+// FillStreamer_FillUpdates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FillUpdates'
+type FillStreamer_FillUpdates_Call struct {
+	*mock.Call
+}
+
+// FillUpdates is a helper method to define mock.On call
+func (_e *FillStreamer_Expecter) FillUpdates() *FillStreamer_FillUpdates_Call {
+	return &FillStreamer_FillUpdates_Call{Call: _e.mock.On("FillUpdates")}
+}
+
+func (_c *FillStreamer_FillUpdates_Call) Run(run func()) *FillStreamer_FillUpdates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *FillStreamer_FillUpdates_Call) Return(_a0 <-chan connector.Trade) *FillStreamer_FillUpdates_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FillStreamer_FillUpdates_Call) RunAndReturn(run func() <-chan connector.Trade) *FillStreamer_FillUpdates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SubscribeFills provides a mock function with no fields
+func (_m *FillStreamer) SubscribeFills() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribeFills")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FillStreamer_SubscribeFills_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeFills'
+type FillStreamer_SubscribeFills_Call struct {
+	*mock.Call
+}
+
+// SubscribeFills is a helper method to define mock.On call
+func (_e *FillStreamer_Expecter) SubscribeFills() *FillStreamer_SubscribeFills_Call {
+	return &FillStreamer_SubscribeFills_Call{Call: _e.mock.On("SubscribeFills")}
+}
+
+func (_c *FillStreamer_SubscribeFills_Call) Run(run func()) *FillStreamer_SubscribeFills_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *FillStreamer_SubscribeFills_Call) Return(_a0 error) *FillStreamer_SubscribeFills_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FillStreamer_SubscribeFills_Call) RunAndReturn(run func() error) *FillStreamer_SubscribeFills_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnsubscribeFills provides a mock function with no fields
+func (_m *FillStreamer) UnsubscribeFills() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnsubscribeFills")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FillStreamer_UnsubscribeFills_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnsubscribeFills'
+type FillStreamer_UnsubscribeFills_Call struct {
+	*mock.Call
+}
+
+// UnsubscribeFills is a helper method to define mock.On call
+func (_e *FillStreamer_Expecter) UnsubscribeFills() *FillStreamer_UnsubscribeFills_Call {
+	return &FillStreamer_UnsubscribeFills_Call{Call: _e.mock.On("UnsubscribeFills")}
+}
+
+func (_c *FillStreamer_UnsubscribeFills_Call) Run(run func()) *FillStreamer_UnsubscribeFills_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *FillStreamer_UnsubscribeFills_Call) Return(_a0 error) *FillStreamer_UnsubscribeFills_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FillStreamer_UnsubscribeFills_Call) RunAndReturn(run func() error) *FillStreamer_UnsubscribeFills_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFillStreamer creates a new instance of FillStreamer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFillStreamer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FillStreamer {
+	mock := &FillStreamer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,92 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package types
+
+import (
+	types "github.com/backtesting-org/live-trading/pkg/connectors/types"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// InstrumentStatusProvider is an autogenerated mock type for the InstrumentStatusProvider type
+type InstrumentStatusProvider struct {
+	mock.Mock
+}
+
+type InstrumentStatusProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *InstrumentStatusProvider) EXPECT() *InstrumentStatusProvider_Expecter {
+	return &InstrumentStatusProvider_Expecter{mock: &_m.Mock}
+}
+
+// FetchInstrumentStatuses provides a mock function with no fields
+func (_m *InstrumentStatusProvider) FetchInstrumentStatuses() ([]types.InstrumentStatus, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchInstrumentStatuses")
+	}
+
+	var r0 []types.InstrumentStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]types.InstrumentStatus, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []types.InstrumentStatus); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.InstrumentStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InstrumentStatusProvider_FetchInstrumentStatuses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchInstrumentStatuses'
+type InstrumentStatusProvider_FetchInstrumentStatuses_Call struct {
+	*mock.Call
+}
+
+// FetchInstrumentStatuses is a helper method to define mock.On call
+func (_e *InstrumentStatusProvider_Expecter) FetchInstrumentStatuses() *InstrumentStatusProvider_FetchInstrumentStatuses_Call {
+	return &InstrumentStatusProvider_FetchInstrumentStatuses_Call{Call: _e.mock.On("FetchInstrumentStatuses")}
+}
+
+func (_c *InstrumentStatusProvider_FetchInstrumentStatuses_Call) Run(run func()) *InstrumentStatusProvider_FetchInstrumentStatuses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *InstrumentStatusProvider_FetchInstrumentStatuses_Call) Return(_a0 []types.InstrumentStatus, _a1 error) *InstrumentStatusProvider_FetchInstrumentStatuses_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InstrumentStatusProvider_FetchInstrumentStatuses_Call) RunAndReturn(run func() ([]types.InstrumentStatus, error)) *InstrumentStatusProvider_FetchInstrumentStatuses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewInstrumentStatusProvider creates a new instance of InstrumentStatusProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewInstrumentStatusProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *InstrumentStatusProvider {
+	mock := &InstrumentStatusProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
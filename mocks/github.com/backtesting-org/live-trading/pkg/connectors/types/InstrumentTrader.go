@@ -0,0 +1,398 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package types
+
+import (
+	connector "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	mock "github.com/stretchr/testify/mock"
+
+	numerical "github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+
+	portfolio "github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+
+	types "github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+// InstrumentTrader is an autogenerated mock type for the InstrumentTrader type
+type InstrumentTrader struct {
+	mock.Mock
+}
+
+type InstrumentTrader_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *InstrumentTrader) EXPECT() *InstrumentTrader_Expecter {
+	return &InstrumentTrader_Expecter{mock: &_m.Mock}
+}
+
+// CancelOrderFor provides a mock function with given fields: instrument, asset, orderID
+func (_m *InstrumentTrader) CancelOrderFor(instrument connector.Instrument, asset portfolio.Asset, orderID string) (*connector.CancelResponse, error) {
+	ret := _m.Called(instrument, asset, orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelOrderFor")
+	}
+
+	var r0 *connector.CancelResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(connector.Instrument, portfolio.Asset, string) (*connector.CancelResponse, error)); ok {
+		return rf(instrument, asset, orderID)
+	}
+	if rf, ok := ret.Get(0).(func(connector.Instrument, portfolio.Asset, string) *connector.CancelResponse); ok {
+		r0 = rf(instrument, asset, orderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connector.CancelResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.Instrument, portfolio.Asset, string) error); ok {
+		r1 = rf(instrument, asset, orderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InstrumentTrader_CancelOrderFor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelOrderFor'
+type InstrumentTrader_CancelOrderFor_Call struct {
+	*mock.Call
+}
+
+// CancelOrderFor is a helper method to define mock.On call
+//   - instrument connector.Instrument
+//   - asset portfolio.Asset
+//   - orderID string
+func (_e *InstrumentTrader_Expecter) CancelOrderFor(instrument interface{}, asset interface{}, orderID interface{}) *InstrumentTrader_CancelOrderFor_Call {
+	return &InstrumentTrader_CancelOrderFor_Call{Call: _e.mock.On("CancelOrderFor", instrument, asset, orderID)}
+}
+
+func (_c *InstrumentTrader_CancelOrderFor_Call) Run(run func(instrument connector.Instrument, asset portfolio.Asset, orderID string)) *InstrumentTrader_CancelOrderFor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.Instrument), args[1].(portfolio.Asset), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *InstrumentTrader_CancelOrderFor_Call) Return(_a0 *connector.CancelResponse, _a1 error) *InstrumentTrader_CancelOrderFor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InstrumentTrader_CancelOrderFor_Call) RunAndReturn(run func(connector.Instrument, portfolio.Asset, string) (*connector.CancelResponse, error)) *InstrumentTrader_CancelOrderFor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FetchPriceFor provides a mock function with given fields: instrument, asset
+func (_m *InstrumentTrader) FetchPriceFor(instrument connector.Instrument, asset portfolio.Asset) (*connector.Price, error) {
+	ret := _m.Called(instrument, asset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchPriceFor")
+	}
+
+	var r0 *connector.Price
+	var r1 error
+	if rf, ok := ret.Get(0).(func(connector.Instrument, portfolio.Asset) (*connector.Price, error)); ok {
+		return rf(instrument, asset)
+	}
+	if rf, ok := ret.Get(0).(func(connector.Instrument, portfolio.Asset) *connector.Price); ok {
+		r0 = rf(instrument, asset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connector.Price)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.Instrument, portfolio.Asset) error); ok {
+		r1 = rf(instrument, asset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InstrumentTrader_FetchPriceFor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchPriceFor'
+type InstrumentTrader_FetchPriceFor_Call struct {
+	*mock.Call
+}
+
+// FetchPriceFor is a helper method to define mock.On call
+//   - instrument connector.Instrument
+//   - asset portfolio.Asset
+func (_e *InstrumentTrader_Expecter) FetchPriceFor(instrument interface{}, asset interface{}) *InstrumentTrader_FetchPriceFor_Call {
+	return &InstrumentTrader_FetchPriceFor_Call{Call: _e.mock.On("FetchPriceFor", instrument, asset)}
+}
+
+func (_c *InstrumentTrader_FetchPriceFor_Call) Run(run func(instrument connector.Instrument, asset portfolio.Asset)) *InstrumentTrader_FetchPriceFor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.Instrument), args[1].(portfolio.Asset))
+	})
+	return _c
+}
+
+func (_c *InstrumentTrader_FetchPriceFor_Call) Return(_a0 *connector.Price, _a1 error) *InstrumentTrader_FetchPriceFor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InstrumentTrader_FetchPriceFor_Call) RunAndReturn(run func(connector.Instrument, portfolio.Asset) (*connector.Price, error)) *InstrumentTrader_FetchPriceFor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOpenOrdersFor provides a mock function with given fields: instrument
+func (_m *InstrumentTrader) GetOpenOrdersFor(instrument connector.Instrument) ([]connector.Order, error) {
+	ret := _m.Called(instrument)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOpenOrdersFor")
+	}
+
+	var r0 []connector.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(connector.Instrument) ([]connector.Order, error)); ok {
+		return rf(instrument)
+	}
+	if rf, ok := ret.Get(0).(func(connector.Instrument) []connector.Order); ok {
+		r0 = rf(instrument)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]connector.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.Instrument) error); ok {
+		r1 = rf(instrument)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InstrumentTrader_GetOpenOrdersFor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOpenOrdersFor'
+type InstrumentTrader_GetOpenOrdersFor_Call struct {
+	*mock.Call
+}
+
+// GetOpenOrdersFor is a helper method to define mock.On call
+//   - instrument connector.Instrument
+func (_e *InstrumentTrader_Expecter) GetOpenOrdersFor(instrument interface{}) *InstrumentTrader_GetOpenOrdersFor_Call {
+	return &InstrumentTrader_GetOpenOrdersFor_Call{Call: _e.mock.On("GetOpenOrdersFor", instrument)}
+}
+
+func (_c *InstrumentTrader_GetOpenOrdersFor_Call) Run(run func(instrument connector.Instrument)) *InstrumentTrader_GetOpenOrdersFor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.Instrument))
+	})
+	return _c
+}
+
+func (_c *InstrumentTrader_GetOpenOrdersFor_Call) Return(_a0 []connector.Order, _a1 error) *InstrumentTrader_GetOpenOrdersFor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InstrumentTrader_GetOpenOrdersFor_Call) RunAndReturn(run func(connector.Instrument) ([]connector.Order, error)) *InstrumentTrader_GetOpenOrdersFor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSpotBalances provides a mock function with no fields
+func (_m *InstrumentTrader) GetSpotBalances() ([]types.SpotBalance, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSpotBalances")
+	}
+
+	var r0 []types.SpotBalance
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]types.SpotBalance, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []types.SpotBalance); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.SpotBalance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InstrumentTrader_GetSpotBalances_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSpotBalances'
+type InstrumentTrader_GetSpotBalances_Call struct {
+	*mock.Call
+}
+
+// GetSpotBalances is a helper method to define mock.On call
+func (_e *InstrumentTrader_Expecter) GetSpotBalances() *InstrumentTrader_GetSpotBalances_Call {
+	return &InstrumentTrader_GetSpotBalances_Call{Call: _e.mock.On("GetSpotBalances")}
+}
+
+func (_c *InstrumentTrader_GetSpotBalances_Call) Run(run func()) *InstrumentTrader_GetSpotBalances_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *InstrumentTrader_GetSpotBalances_Call) Return(_a0 []types.SpotBalance, _a1 error) *InstrumentTrader_GetSpotBalances_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InstrumentTrader_GetSpotBalances_Call) RunAndReturn(run func() ([]types.SpotBalance, error)) *InstrumentTrader_GetSpotBalances_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PlaceLimitOrderFor provides a mock function with given fields: instrument, asset, side, quantity, price
+func (_m *InstrumentTrader) PlaceLimitOrderFor(instrument connector.Instrument, asset portfolio.Asset, side connector.OrderSide, quantity numerical.Decimal, price numerical.Decimal) (*connector.OrderResponse, error) {
+	ret := _m.Called(instrument, asset, side, quantity, price)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlaceLimitOrderFor")
+	}
+
+	var r0 *connector.OrderResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(connector.Instrument, portfolio.Asset, connector.OrderSide, numerical.Decimal, numerical.Decimal) (*connector.OrderResponse, error)); ok {
+		return rf(instrument, asset, side, quantity, price)
+	}
+	if rf, ok := ret.Get(0).(func(connector.Instrument, portfolio.Asset, connector.OrderSide, numerical.Decimal, numerical.Decimal) *connector.OrderResponse); ok {
+		r0 = rf(instrument, asset, side, quantity, price)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connector.OrderResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.Instrument, portfolio.Asset, connector.OrderSide, numerical.Decimal, numerical.Decimal) error); ok {
+		r1 = rf(instrument, asset, side, quantity, price)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InstrumentTrader_PlaceLimitOrderFor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PlaceLimitOrderFor'
+type InstrumentTrader_PlaceLimitOrderFor_Call struct {
+	*mock.Call
+}
+
+// PlaceLimitOrderFor is a helper method to define mock.On call
+//   - instrument connector.Instrument
+//   - asset portfolio.Asset
+//   - side connector.OrderSide
+//   - quantity numerical.Decimal
+//   - price numerical.Decimal
+func (_e *InstrumentTrader_Expecter) PlaceLimitOrderFor(instrument interface{}, asset interface{}, side interface{}, quantity interface{}, price interface{}) *InstrumentTrader_PlaceLimitOrderFor_Call {
+	return &InstrumentTrader_PlaceLimitOrderFor_Call{Call: _e.mock.On("PlaceLimitOrderFor", instrument, asset, side, quantity, price)}
+}
+
+func (_c *InstrumentTrader_PlaceLimitOrderFor_Call) Run(run func(instrument connector.Instrument, asset portfolio.Asset, side connector.OrderSide, quantity numerical.Decimal, price numerical.Decimal)) *InstrumentTrader_PlaceLimitOrderFor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.Instrument), args[1].(portfolio.Asset), args[2].(connector.OrderSide), args[3].(numerical.Decimal), args[4].(numerical.Decimal))
+	})
+	return _c
+}
+
+func (_c *InstrumentTrader_PlaceLimitOrderFor_Call) Return(_a0 *connector.OrderResponse, _a1 error) *InstrumentTrader_PlaceLimitOrderFor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InstrumentTrader_PlaceLimitOrderFor_Call) RunAndReturn(run func(connector.Instrument, portfolio.Asset, connector.OrderSide, numerical.Decimal, numerical.Decimal) (*connector.OrderResponse, error)) *InstrumentTrader_PlaceLimitOrderFor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PlaceMarketOrderFor provides a mock function with given fields: instrument, asset, side, quantity
+func (_m *InstrumentTrader) PlaceMarketOrderFor(instrument connector.Instrument, asset portfolio.Asset, side connector.OrderSide, quantity numerical.Decimal) (*connector.OrderResponse, error) {
+	ret := _m.Called(instrument, asset, side, quantity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlaceMarketOrderFor")
+	}
+
+	var r0 *connector.OrderResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(connector.Instrument, portfolio.Asset, connector.OrderSide, numerical.Decimal) (*connector.OrderResponse, error)); ok {
+		return rf(instrument, asset, side, quantity)
+	}
+	if rf, ok := ret.Get(0).(func(connector.Instrument, portfolio.Asset, connector.OrderSide, numerical.Decimal) *connector.OrderResponse); ok {
+		r0 = rf(instrument, asset, side, quantity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connector.OrderResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.Instrument, portfolio.Asset, connector.OrderSide, numerical.Decimal) error); ok {
+		r1 = rf(instrument, asset, side, quantity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InstrumentTrader_PlaceMarketOrderFor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PlaceMarketOrderFor'
+type InstrumentTrader_PlaceMarketOrderFor_Call struct {
+	*mock.Call
+}
+
+// PlaceMarketOrderFor is a helper method to define mock.On call
+//   - instrument connector.Instrument
+//   - asset portfolio.Asset
+//   - side connector.OrderSide
+//   - quantity numerical.Decimal
+func (_e *InstrumentTrader_Expecter) PlaceMarketOrderFor(instrument interface{}, asset interface{}, side interface{}, quantity interface{}) *InstrumentTrader_PlaceMarketOrderFor_Call {
+	return &InstrumentTrader_PlaceMarketOrderFor_Call{Call: _e.mock.On("PlaceMarketOrderFor", instrument, asset, side, quantity)}
+}
+
+func (_c *InstrumentTrader_PlaceMarketOrderFor_Call) Run(run func(instrument connector.Instrument, asset portfolio.Asset, side connector.OrderSide, quantity numerical.Decimal)) *InstrumentTrader_PlaceMarketOrderFor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.Instrument), args[1].(portfolio.Asset), args[2].(connector.OrderSide), args[3].(numerical.Decimal))
+	})
+	return _c
+}
+
+func (_c *InstrumentTrader_PlaceMarketOrderFor_Call) Return(_a0 *connector.OrderResponse, _a1 error) *InstrumentTrader_PlaceMarketOrderFor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InstrumentTrader_PlaceMarketOrderFor_Call) RunAndReturn(run func(connector.Instrument, portfolio.Asset, connector.OrderSide, numerical.Decimal) (*connector.OrderResponse, error)) *InstrumentTrader_PlaceMarketOrderFor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewInstrumentTrader creates a new instance of InstrumentTrader. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewInstrumentTrader(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *InstrumentTrader {
+	mock := &InstrumentTrader{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,99 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package types
+
+import (
+	connector "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// KlineRangeProvider is an autogenerated mock type for the KlineRangeProvider type
+type KlineRangeProvider struct {
+	mock.Mock
+}
+
+type KlineRangeProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *KlineRangeProvider) EXPECT() *KlineRangeProvider_Expecter {
+	return &KlineRangeProvider_Expecter{mock: &_m.Mock}
+}
+
+// FetchKlinesRange provides a mock function with given fields: symbol, interval, start, end, limit
+func (_m *KlineRangeProvider) FetchKlinesRange(symbol string, interval string, start time.Time, end time.Time, limit int) ([]connector.Kline, error) {
+	ret := _m.Called(symbol, interval, start, end, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchKlinesRange")
+	}
+
+	var r0 []connector.Kline
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, time.Time, time.Time, int) ([]connector.Kline, error)); ok {
+		return rf(symbol, interval, start, end, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, time.Time, time.Time, int) []connector.Kline); ok {
+		r0 = rf(symbol, interval, start, end, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]connector.Kline)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, time.Time, time.Time, int) error); ok {
+		r1 = rf(symbol, interval, start, end, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KlineRangeProvider_FetchKlinesRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchKlinesRange'
+type KlineRangeProvider_FetchKlinesRange_Call struct {
+	*mock.Call
+}
+
+// FetchKlinesRange is a helper method to define mock.On call
+//   - symbol string
+//   - interval string
+//   - start time.Time
+//   - end time.Time
+//   - limit int
+func (_e *KlineRangeProvider_Expecter) FetchKlinesRange(symbol interface{}, interval interface{}, start interface{}, end interface{}, limit interface{}) *KlineRangeProvider_FetchKlinesRange_Call {
+	return &KlineRangeProvider_FetchKlinesRange_Call{Call: _e.mock.On("FetchKlinesRange", symbol, interval, start, end, limit)}
+}
+
+func (_c *KlineRangeProvider_FetchKlinesRange_Call) Run(run func(symbol string, interval string, start time.Time, end time.Time, limit int)) *KlineRangeProvider_FetchKlinesRange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(time.Time), args[3].(time.Time), args[4].(int))
+	})
+	return _c
+}
+
+func (_c *KlineRangeProvider_FetchKlinesRange_Call) Return(_a0 []connector.Kline, _a1 error) *KlineRangeProvider_FetchKlinesRange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KlineRangeProvider_FetchKlinesRange_Call) RunAndReturn(run func(string, string, time.Time, time.Time, int) ([]connector.Kline, error)) *KlineRangeProvider_FetchKlinesRange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewKlineRangeProvider creates a new instance of KlineRangeProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewKlineRangeProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *KlineRangeProvider {
+	mock := &KlineRangeProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
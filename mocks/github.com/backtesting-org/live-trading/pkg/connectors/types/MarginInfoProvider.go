@@ -0,0 +1,92 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package types
+
+import (
+	types "github.com/backtesting-org/live-trading/pkg/connectors/types"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MarginInfoProvider is an autogenerated mock type for the MarginInfoProvider type
+type MarginInfoProvider struct {
+	mock.Mock
+}
+
+type MarginInfoProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MarginInfoProvider) EXPECT() *MarginInfoProvider_Expecter {
+	return &MarginInfoProvider_Expecter{mock: &_m.Mock}
+}
+
+// FetchMarginInfo provides a mock function with no fields
+func (_m *MarginInfoProvider) FetchMarginInfo() (*types.MarginInfo, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchMarginInfo")
+	}
+
+	var r0 *types.MarginInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*types.MarginInfo, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *types.MarginInfo); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.MarginInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarginInfoProvider_FetchMarginInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchMarginInfo'
+type MarginInfoProvider_FetchMarginInfo_Call struct {
+	*mock.Call
+}
+
+// FetchMarginInfo is a helper method to define mock.On call
+func (_e *MarginInfoProvider_Expecter) FetchMarginInfo() *MarginInfoProvider_FetchMarginInfo_Call {
+	return &MarginInfoProvider_FetchMarginInfo_Call{Call: _e.mock.On("FetchMarginInfo")}
+}
+
+func (_c *MarginInfoProvider_FetchMarginInfo_Call) Run(run func()) *MarginInfoProvider_FetchMarginInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MarginInfoProvider_FetchMarginInfo_Call) Return(_a0 *types.MarginInfo, _a1 error) *MarginInfoProvider_FetchMarginInfo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MarginInfoProvider_FetchMarginInfo_Call) RunAndReturn(run func() (*types.MarginInfo, error)) *MarginInfoProvider_FetchMarginInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMarginInfoProvider creates a new instance of MarginInfoProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMarginInfoProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MarginInfoProvider {
+	mock := &MarginInfoProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
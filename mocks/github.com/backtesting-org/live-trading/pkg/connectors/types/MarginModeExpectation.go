@@ -0,0 +1,80 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package types
+
+import (
+	types "github.com/backtesting-org/live-trading/pkg/connectors/types"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MarginModeExpectation is an autogenerated mock type for the MarginModeExpectation type
+type MarginModeExpectation struct {
+	mock.Mock
+}
+
+type MarginModeExpectation_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MarginModeExpectation) EXPECT() *MarginModeExpectation_Expecter {
+	return &MarginModeExpectation_Expecter{mock: &_m.Mock}
+}
+
+// ExpectedMarginMode provides a mock function with no fields
+func (_m *MarginModeExpectation) ExpectedMarginMode() types.MarginMode {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpectedMarginMode")
+	}
+
+	var r0 types.MarginMode
+	if rf, ok := ret.Get(0).(func() types.MarginMode); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(types.MarginMode)
+	}
+
+	return r0
+}
+
+// MarginModeExpectation_ExpectedMarginMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpectedMarginMode'
+type MarginModeExpectation_ExpectedMarginMode_Call struct {
+	*mock.Call
+}
+
+// ExpectedMarginMode is a helper method to define mock.On call
+func (_e *MarginModeExpectation_Expecter) ExpectedMarginMode() *MarginModeExpectation_ExpectedMarginMode_Call {
+	return &MarginModeExpectation_ExpectedMarginMode_Call{Call: _e.mock.On("ExpectedMarginMode")}
+}
+
+func (_c *MarginModeExpectation_ExpectedMarginMode_Call) Run(run func()) *MarginModeExpectation_ExpectedMarginMode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MarginModeExpectation_ExpectedMarginMode_Call) Return(_a0 types.MarginMode) *MarginModeExpectation_ExpectedMarginMode_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MarginModeExpectation_ExpectedMarginMode_Call) RunAndReturn(run func() types.MarginMode) *MarginModeExpectation_ExpectedMarginMode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMarginModeExpectation creates a new instance of MarginModeExpectation. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMarginModeExpectation(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MarginModeExpectation {
+	mock := &MarginModeExpectation{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package types
+
+import (
+	portfolio "github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	types "github.com/backtesting-org/live-trading/pkg/connectors/types"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MarkPriceProvider is an autogenerated mock type for the MarkPriceProvider type
+type MarkPriceProvider struct {
+	mock.Mock
+}
+
+type MarkPriceProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MarkPriceProvider) EXPECT() *MarkPriceProvider_Expecter {
+	return &MarkPriceProvider_Expecter{mock: &_m.Mock}
+}
+
+// FetchMarkPrice provides a mock function with given fields: asset
+func (_m *MarkPriceProvider) FetchMarkPrice(asset portfolio.Asset) (*types.MarkPrice, error) {
+	ret := _m.Called(asset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchMarkPrice")
+	}
+
+	var r0 *types.MarkPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(portfolio.Asset) (*types.MarkPrice, error)); ok {
+		return rf(asset)
+	}
+	if rf, ok := ret.Get(0).(func(portfolio.Asset) *types.MarkPrice); ok {
+		r0 = rf(asset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.MarkPrice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(portfolio.Asset) error); ok {
+		r1 = rf(asset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkPriceProvider_FetchMarkPrice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchMarkPrice'
+type MarkPriceProvider_FetchMarkPrice_Call struct {
+	*mock.Call
+}
+
+// FetchMarkPrice is a helper method to define mock.On call
+//   - asset portfolio.Asset
+func (_e *MarkPriceProvider_Expecter) FetchMarkPrice(asset interface{}) *MarkPriceProvider_FetchMarkPrice_Call {
+	return &MarkPriceProvider_FetchMarkPrice_Call{Call: _e.mock.On("FetchMarkPrice", asset)}
+}
+
+func (_c *MarkPriceProvider_FetchMarkPrice_Call) Run(run func(asset portfolio.Asset)) *MarkPriceProvider_FetchMarkPrice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(portfolio.Asset))
+	})
+	return _c
+}
+
+func (_c *MarkPriceProvider_FetchMarkPrice_Call) Return(_a0 *types.MarkPrice, _a1 error) *MarkPriceProvider_FetchMarkPrice_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MarkPriceProvider_FetchMarkPrice_Call) RunAndReturn(run func(portfolio.Asset) (*types.MarkPrice, error)) *MarkPriceProvider_FetchMarkPrice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMarkPriceProvider creates a new instance of MarkPriceProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMarkPriceProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MarkPriceProvider {
+	mock := &MarkPriceProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
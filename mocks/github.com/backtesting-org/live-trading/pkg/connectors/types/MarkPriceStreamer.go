@@ -0,0 +1,175 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package types
+
+import (
+	portfolio "github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	types "github.com/backtesting-org/live-trading/pkg/connectors/types"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MarkPriceStreamer is an autogenerated mock type for the MarkPriceStreamer type
+type MarkPriceStreamer struct {
+	mock.Mock
+}
+
+type MarkPriceStreamer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MarkPriceStreamer) EXPECT() *MarkPriceStreamer_Expecter {
+	return &MarkPriceStreamer_Expecter{mock: &_m.Mock}
+}
+
+// MarkPriceUpdates provides a mock function with no fields
+func (_m *MarkPriceStreamer) MarkPriceUpdates() <-chan types.MarkPrice {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkPriceUpdates")
+	}
+
+	var r0 <-chan types.MarkPrice
+	if rf, ok := ret.Get(0).(func() <-chan types.MarkPrice); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan types.MarkPrice)
+		}
+	}
+
+	return r0
+}
+
+// MarkPriceStreamer_MarkPriceUpdates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkPriceUpdates'
+type MarkPriceStreamer_MarkPriceUpdates_Call struct {
+	*mock.Call
+}
+
+// MarkPriceUpdates is a helper method to define mock.On call
+func (_e *MarkPriceStreamer_Expecter) MarkPriceUpdates() *MarkPriceStreamer_MarkPriceUpdates_Call {
+	return &MarkPriceStreamer_MarkPriceUpdates_Call{Call: _e.mock.On("MarkPriceUpdates")}
+}
+
+func (_c *MarkPriceStreamer_MarkPriceUpdates_Call) Run(run func()) *MarkPriceStreamer_MarkPriceUpdates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MarkPriceStreamer_MarkPriceUpdates_Call) Return(_a0 <-chan types.MarkPrice) *MarkPriceStreamer_MarkPriceUpdates_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MarkPriceStreamer_MarkPriceUpdates_Call) RunAndReturn(run func() <-chan types.MarkPrice) *MarkPriceStreamer_MarkPriceUpdates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SubscribeMarkPrice provides a mock function with given fields: asset
+func (_m *MarkPriceStreamer) SubscribeMarkPrice(asset portfolio.Asset) error {
+	ret := _m.Called(asset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribeMarkPrice")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(portfolio.Asset) error); ok {
+		r0 = rf(asset)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarkPriceStreamer_SubscribeMarkPrice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeMarkPrice'
+type MarkPriceStreamer_SubscribeMarkPrice_Call struct {
+	*mock.Call
+}
+
+// SubscribeMarkPrice is a helper method to define mock.On call
+//   - asset portfolio.Asset
+func (_e *MarkPriceStreamer_Expecter) SubscribeMarkPrice(asset interface{}) *MarkPriceStreamer_SubscribeMarkPrice_Call {
+	return &MarkPriceStreamer_SubscribeMarkPrice_Call{Call: _e.mock.On("SubscribeMarkPrice", asset)}
+}
+
+func (_c *MarkPriceStreamer_SubscribeMarkPrice_Call) Run(run func(asset portfolio.Asset)) *MarkPriceStreamer_SubscribeMarkPrice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(portfolio.Asset))
+	})
+	return _c
+}
+
+func (_c *MarkPriceStreamer_SubscribeMarkPrice_Call) Return(_a0 error) *MarkPriceStreamer_SubscribeMarkPrice_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MarkPriceStreamer_SubscribeMarkPrice_Call) RunAndReturn(run func(portfolio.Asset) error) *MarkPriceStreamer_SubscribeMarkPrice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnsubscribeMarkPrice provides a mock function with given fields: asset
+func (_m *MarkPriceStreamer) UnsubscribeMarkPrice(asset portfolio.Asset) error {
+	ret := _m.Called(asset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnsubscribeMarkPrice")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(portfolio.Asset) error); ok {
+		r0 = rf(asset)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarkPriceStreamer_UnsubscribeMarkPrice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnsubscribeMarkPrice'
+type MarkPriceStreamer_UnsubscribeMarkPrice_Call struct {
+	*mock.Call
+}
+
+// UnsubscribeMarkPrice is a helper method to define mock.On call
+//   - asset portfolio.Asset
+func (_e *MarkPriceStreamer_Expecter) UnsubscribeMarkPrice(asset interface{}) *MarkPriceStreamer_UnsubscribeMarkPrice_Call {
+	return &MarkPriceStreamer_UnsubscribeMarkPrice_Call{Call: _e.mock.On("UnsubscribeMarkPrice", asset)}
+}
+
+func (_c *MarkPriceStreamer_UnsubscribeMarkPrice_Call) Run(run func(asset portfolio.Asset)) *MarkPriceStreamer_UnsubscribeMarkPrice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(portfolio.Asset))
+	})
+	return _c
+}
+
+func (_c *MarkPriceStreamer_UnsubscribeMarkPrice_Call) Return(_a0 error) *MarkPriceStreamer_UnsubscribeMarkPrice_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MarkPriceStreamer_UnsubscribeMarkPrice_Call) RunAndReturn(run func(portfolio.Asset) error) *MarkPriceStreamer_UnsubscribeMarkPrice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMarkPriceStreamer creates a new instance of MarkPriceStreamer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMarkPriceStreamer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MarkPriceStreamer {
+	mock := &MarkPriceStreamer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,77 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package types
+
+import mock "github.com/stretchr/testify/mock"
+
+// NetworkConfig is an autogenerated mock type for the NetworkConfig type
+type NetworkConfig struct {
+	mock.Mock
+}
+
+type NetworkConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *NetworkConfig) EXPECT() *NetworkConfig_Expecter {
+	return &NetworkConfig_Expecter{mock: &_m.Mock}
+}
+
+// UsesTestnet provides a mock function with no fields
+func (_m *NetworkConfig) UsesTestnet() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for UsesTestnet")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// NetworkConfig_UsesTestnet_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UsesTestnet'
+type NetworkConfig_UsesTestnet_Call struct {
+	*mock.Call
+}
+
+// UsesTestnet is a helper method to define mock.On call
+func (_e *NetworkConfig_Expecter) UsesTestnet() *NetworkConfig_UsesTestnet_Call {
+	return &NetworkConfig_UsesTestnet_Call{Call: _e.mock.On("UsesTestnet")}
+}
+
+func (_c *NetworkConfig_UsesTestnet_Call) Run(run func()) *NetworkConfig_UsesTestnet_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *NetworkConfig_UsesTestnet_Call) Return(_a0 bool) *NetworkConfig_UsesTestnet_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NetworkConfig_UsesTestnet_Call) RunAndReturn(run func() bool) *NetworkConfig_UsesTestnet_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewNetworkConfig creates a new instance of NetworkConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNetworkConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NetworkConfig {
+	mock := &NetworkConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package types
+
+import (
+	jsontext "encoding/json/jsontext"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OrderPayloadSource is an autogenerated mock type for the OrderPayloadSource type
+type OrderPayloadSource struct {
+	mock.Mock
+}
+
+type OrderPayloadSource_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OrderPayloadSource) EXPECT() *OrderPayloadSource_Expecter {
+	return &OrderPayloadSource_Expecter{mock: &_m.Mock}
+}
+
+// OrderPayload provides a mock function with given fields: orderID
+func (_m *OrderPayloadSource) OrderPayload(orderID string) (jsontext.Value, bool) {
+	ret := _m.Called(orderID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OrderPayload")
+	}
+
+	var r0 jsontext.Value
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string) (jsontext.Value, bool)); ok {
+		return rf(orderID)
+	}
+	if rf, ok := ret.Get(0).(func(string) jsontext.Value); ok {
+		r0 = rf(orderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(jsontext.Value)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(orderID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// OrderPayloadSource_OrderPayload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrderPayload'
+type OrderPayloadSource_OrderPayload_Call struct {
+	*mock.Call
+}
+
+// OrderPayload is a helper method to define mock.On call
+//   - orderID string
+func (_e *OrderPayloadSource_Expecter) OrderPayload(orderID interface{}) *OrderPayloadSource_OrderPayload_Call {
+	return &OrderPayloadSource_OrderPayload_Call{Call: _e.mock.On("OrderPayload", orderID)}
+}
+
+func (_c *OrderPayloadSource_OrderPayload_Call) Run(run func(orderID string)) *OrderPayloadSource_OrderPayload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *OrderPayloadSource_OrderPayload_Call) Return(_a0 jsontext.Value, _a1 bool) *OrderPayloadSource_OrderPayload_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderPayloadSource_OrderPayload_Call) RunAndReturn(run func(string) (jsontext.Value, bool)) *OrderPayloadSource_OrderPayload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOrderPayloadSource creates a new instance of OrderPayloadSource. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOrderPayloadSource(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OrderPayloadSource {
+	mock := &OrderPayloadSource{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,80 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package types
+
+import (
+	types "github.com/backtesting-org/live-trading/pkg/connectors/types"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RateLimitProvider is an autogenerated mock type for the RateLimitProvider type
+type RateLimitProvider struct {
+	mock.Mock
+}
+
+type RateLimitProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RateLimitProvider) EXPECT() *RateLimitProvider_Expecter {
+	return &RateLimitProvider_Expecter{mock: &_m.Mock}
+}
+
+// RateLimitStatus provides a mock function with no fields
+func (_m *RateLimitProvider) RateLimitStatus() types.RateLimitStatus {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RateLimitStatus")
+	}
+
+	var r0 types.RateLimitStatus
+	if rf, ok := ret.Get(0).(func() types.RateLimitStatus); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(types.RateLimitStatus)
+	}
+
+	return r0
+}
+
+// RateLimitProvider_RateLimitStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RateLimitStatus'
+type RateLimitProvider_RateLimitStatus_Call struct {
+	*mock.Call
+}
+
+// RateLimitStatus is a helper method to define mock.On call
+func (_e *RateLimitProvider_Expecter) RateLimitStatus() *RateLimitProvider_RateLimitStatus_Call {
+	return &RateLimitProvider_RateLimitStatus_Call{Call: _e.mock.On("RateLimitStatus")}
+}
+
+func (_c *RateLimitProvider_RateLimitStatus_Call) Run(run func()) *RateLimitProvider_RateLimitStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RateLimitProvider_RateLimitStatus_Call) Return(_a0 types.RateLimitStatus) *RateLimitProvider_RateLimitStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RateLimitProvider_RateLimitStatus_Call) RunAndReturn(run func() types.RateLimitStatus) *RateLimitProvider_RateLimitStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRateLimitProvider creates a new instance of RateLimitProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRateLimitProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RateLimitProvider {
+	mock := &RateLimitProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
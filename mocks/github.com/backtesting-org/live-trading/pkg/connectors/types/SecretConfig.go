@@ -0,0 +1,79 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package types
+
+import mock "github.com/stretchr/testify/mock"
+
+// SecretConfig is an autogenerated mock type for the SecretConfig type
+type SecretConfig struct {
+	mock.Mock
+}
+
+type SecretConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SecretConfig) EXPECT() *SecretConfig_Expecter {
+	return &SecretConfig_Expecter{mock: &_m.Mock}
+}
+
+// SecretFields provides a mock function with no fields
+func (_m *SecretConfig) SecretFields() map[string]*string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SecretFields")
+	}
+
+	var r0 map[string]*string
+	if rf, ok := ret.Get(0).(func() map[string]*string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*string)
+		}
+	}
+
+	return r0
+}
+
+// SecretConfig_SecretFields_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SecretFields'
+type SecretConfig_SecretFields_Call struct {
+	*mock.Call
+}
+
+// SecretFields is a helper method to define mock.On call
+func (_e *SecretConfig_Expecter) SecretFields() *SecretConfig_SecretFields_Call {
+	return &SecretConfig_SecretFields_Call{Call: _e.mock.On("SecretFields")}
+}
+
+func (_c *SecretConfig_SecretFields_Call) Run(run func()) *SecretConfig_SecretFields_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SecretConfig_SecretFields_Call) Return(_a0 map[string]*string) *SecretConfig_SecretFields_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SecretConfig_SecretFields_Call) RunAndReturn(run func() map[string]*string) *SecretConfig_SecretFields_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSecretConfig creates a new instance of SecretConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSecretConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SecretConfig {
+	mock := &SecretConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
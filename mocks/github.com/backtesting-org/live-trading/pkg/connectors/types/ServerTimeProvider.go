@@ -0,0 +1,91 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package types
+
+import (
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ServerTimeProvider is an autogenerated mock type for the ServerTimeProvider type
+type ServerTimeProvider struct {
+	mock.Mock
+}
+
+type ServerTimeProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ServerTimeProvider) EXPECT() *ServerTimeProvider_Expecter {
+	return &ServerTimeProvider_Expecter{mock: &_m.Mock}
+}
+
+// FetchServerTime provides a mock function with no fields
+func (_m *ServerTimeProvider) FetchServerTime() (time.Time, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchServerTime")
+	}
+
+	var r0 time.Time
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (time.Time, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() time.Time); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServerTimeProvider_FetchServerTime_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchServerTime'
+type ServerTimeProvider_FetchServerTime_Call struct {
+	*mock.Call
+}
+
+// FetchServerTime is a helper method to define mock.On call
+func (_e *ServerTimeProvider_Expecter) FetchServerTime() *ServerTimeProvider_FetchServerTime_Call {
+	return &ServerTimeProvider_FetchServerTime_Call{Call: _e.mock.On("FetchServerTime")}
+}
+
+func (_c *ServerTimeProvider_FetchServerTime_Call) Run(run func()) *ServerTimeProvider_FetchServerTime_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ServerTimeProvider_FetchServerTime_Call) Return(_a0 time.Time, _a1 error) *ServerTimeProvider_FetchServerTime_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServerTimeProvider_FetchServerTime_Call) RunAndReturn(run func() (time.Time, error)) *ServerTimeProvider_FetchServerTime_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewServerTimeProvider creates a new instance of ServerTimeProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewServerTimeProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ServerTimeProvider {
+	mock := &ServerTimeProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,97 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package types
+
+import (
+	connector "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// TradeHistoryProvider is an autogenerated mock type for the TradeHistoryProvider type
+type TradeHistoryProvider struct {
+	mock.Mock
+}
+
+type TradeHistoryProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TradeHistoryProvider) EXPECT() *TradeHistoryProvider_Expecter {
+	return &TradeHistoryProvider_Expecter{mock: &_m.Mock}
+}
+
+// FetchTradingHistorySince provides a mock function with given fields: symbol, since, limit
+func (_m *TradeHistoryProvider) FetchTradingHistorySince(symbol string, since time.Time, limit int) ([]connector.Trade, error) {
+	ret := _m.Called(symbol, since, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchTradingHistorySince")
+	}
+
+	var r0 []connector.Trade
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time, int) ([]connector.Trade, error)); ok {
+		return rf(symbol, since, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time, int) []connector.Trade); ok {
+		r0 = rf(symbol, since, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]connector.Trade)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time, int) error); ok {
+		r1 = rf(symbol, since, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TradeHistoryProvider_FetchTradingHistorySince_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchTradingHistorySince'
+type TradeHistoryProvider_FetchTradingHistorySince_Call struct {
+	*mock.Call
+}
+
+// FetchTradingHistorySince is a helper method to define mock.On call
+//   - symbol string
+//   - since time.Time
+//   - limit int
+func (_e *TradeHistoryProvider_Expecter) FetchTradingHistorySince(symbol interface{}, since interface{}, limit interface{}) *TradeHistoryProvider_FetchTradingHistorySince_Call {
+	return &TradeHistoryProvider_FetchTradingHistorySince_Call{Call: _e.mock.On("FetchTradingHistorySince", symbol, since, limit)}
+}
+
+func (_c *TradeHistoryProvider_FetchTradingHistorySince_Call) Run(run func(symbol string, since time.Time, limit int)) *TradeHistoryProvider_FetchTradingHistorySince_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *TradeHistoryProvider_FetchTradingHistorySince_Call) Return(_a0 []connector.Trade, _a1 error) *TradeHistoryProvider_FetchTradingHistorySince_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TradeHistoryProvider_FetchTradingHistorySince_Call) RunAndReturn(run func(string, time.Time, int) ([]connector.Trade, error)) *TradeHistoryProvider_FetchTradingHistorySince_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTradeHistoryProvider creates a new instance of TradeHistoryProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTradeHistoryProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TradeHistoryProvider {
+	mock := &TradeHistoryProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
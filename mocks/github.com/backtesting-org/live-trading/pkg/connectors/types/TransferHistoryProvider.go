@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package types
+
+import (
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+
+	types "github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+// TransferHistoryProvider is an autogenerated mock type for the TransferHistoryProvider type
+type TransferHistoryProvider struct {
+	mock.Mock
+}
+
+type TransferHistoryProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TransferHistoryProvider) EXPECT() *TransferHistoryProvider_Expecter {
+	return &TransferHistoryProvider_Expecter{mock: &_m.Mock}
+}
+
+// FetchTransferEvents provides a mock function with given fields: since
+func (_m *TransferHistoryProvider) FetchTransferEvents(since time.Time) ([]types.TransferEvent, error) {
+	ret := _m.Called(since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchTransferEvents")
+	}
+
+	var r0 []types.TransferEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time) ([]types.TransferEvent, error)); ok {
+		return rf(since)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time) []types.TransferEvent); ok {
+		r0 = rf(since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.TransferEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = rf(since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransferHistoryProvider_FetchTransferEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchTransferEvents'
+type TransferHistoryProvider_FetchTransferEvents_Call struct {
+	*mock.Call
+}
+
+// FetchTransferEvents is a helper method to define mock.On call
+//   - since time.Time
+func (_e *TransferHistoryProvider_Expecter) FetchTransferEvents(since interface{}) *TransferHistoryProvider_FetchTransferEvents_Call {
+	return &TransferHistoryProvider_FetchTransferEvents_Call{Call: _e.mock.On("FetchTransferEvents", since)}
+}
+
+func (_c *TransferHistoryProvider_FetchTransferEvents_Call) Run(run func(since time.Time)) *TransferHistoryProvider_FetchTransferEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Time))
+	})
+	return _c
+}
+
+func (_c *TransferHistoryProvider_FetchTransferEvents_Call) Return(_a0 []types.TransferEvent, _a1 error) *TransferHistoryProvider_FetchTransferEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TransferHistoryProvider_FetchTransferEvents_Call) RunAndReturn(run func(time.Time) ([]types.TransferEvent, error)) *TransferHistoryProvider_FetchTransferEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTransferHistoryProvider creates a new instance of TransferHistoryProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTransferHistoryProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TransferHistoryProvider {
+	mock := &TransferHistoryProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
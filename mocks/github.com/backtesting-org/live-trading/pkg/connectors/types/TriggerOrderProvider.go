@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package types
+
+import (
+	connector "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	mock "github.com/stretchr/testify/mock"
+
+	types "github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+// TriggerOrderProvider is an autogenerated mock type for the TriggerOrderProvider type
+type TriggerOrderProvider struct {
+	mock.Mock
+}
+
+type TriggerOrderProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TriggerOrderProvider) EXPECT() *TriggerOrderProvider_Expecter {
+	return &TriggerOrderProvider_Expecter{mock: &_m.Mock}
+}
+
+// PlaceTriggerOrder provides a mock function with given fields: order
+func (_m *TriggerOrderProvider) PlaceTriggerOrder(order types.TriggerOrder) (*connector.OrderResponse, error) {
+	ret := _m.Called(order)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlaceTriggerOrder")
+	}
+
+	var r0 *connector.OrderResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.TriggerOrder) (*connector.OrderResponse, error)); ok {
+		return rf(order)
+	}
+	if rf, ok := ret.Get(0).(func(types.TriggerOrder) *connector.OrderResponse); ok {
+		r0 = rf(order)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*connector.OrderResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(types.TriggerOrder) error); ok {
+		r1 = rf(order)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TriggerOrderProvider_PlaceTriggerOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PlaceTriggerOrder'
+type TriggerOrderProvider_PlaceTriggerOrder_Call struct {
+	*mock.Call
+}
+
+// PlaceTriggerOrder is a helper method to define mock.On call
+//   - order types.TriggerOrder
+func (_e *TriggerOrderProvider_Expecter) PlaceTriggerOrder(order interface{}) *TriggerOrderProvider_PlaceTriggerOrder_Call {
+	return &TriggerOrderProvider_PlaceTriggerOrder_Call{Call: _e.mock.On("PlaceTriggerOrder", order)}
+}
+
+func (_c *TriggerOrderProvider_PlaceTriggerOrder_Call) Run(run func(order types.TriggerOrder)) *TriggerOrderProvider_PlaceTriggerOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(types.TriggerOrder))
+	})
+	return _c
+}
+
+func (_c *TriggerOrderProvider_PlaceTriggerOrder_Call) Return(_a0 *connector.OrderResponse, _a1 error) *TriggerOrderProvider_PlaceTriggerOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TriggerOrderProvider_PlaceTriggerOrder_Call) RunAndReturn(run func(types.TriggerOrder) (*connector.OrderResponse, error)) *TriggerOrderProvider_PlaceTriggerOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTriggerOrderProvider creates a new instance of TriggerOrderProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTriggerOrderProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TriggerOrderProvider {
+	mock := &TriggerOrderProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
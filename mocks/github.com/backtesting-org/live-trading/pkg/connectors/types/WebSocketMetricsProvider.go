@@ -0,0 +1,79 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package types
+
+import mock "github.com/stretchr/testify/mock"
+
+// WebSocketMetricsProvider is an autogenerated mock type for the WebSocketMetricsProvider type
+type WebSocketMetricsProvider struct {
+	mock.Mock
+}
+
+type WebSocketMetricsProvider_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebSocketMetricsProvider) EXPECT() *WebSocketMetricsProvider_Expecter {
+	return &WebSocketMetricsProvider_Expecter{mock: &_m.Mock}
+}
+
+// GetWebSocketMetrics provides a mock function with no fields
+func (_m *WebSocketMetricsProvider) GetWebSocketMetrics() map[string]interface{} {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebSocketMetrics")
+	}
+
+	var r0 map[string]interface{}
+	if rf, ok := ret.Get(0).(func() map[string]interface{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]interface{})
+		}
+	}
+
+	return r0
+}
+
+// WebSocketMetricsProvider_GetWebSocketMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebSocketMetrics'
+type WebSocketMetricsProvider_GetWebSocketMetrics_Call struct {
+	*mock.Call
+}
+
+// GetWebSocketMetrics is a helper method to define mock.On call
+func (_e *WebSocketMetricsProvider_Expecter) GetWebSocketMetrics() *WebSocketMetricsProvider_GetWebSocketMetrics_Call {
+	return &WebSocketMetricsProvider_GetWebSocketMetrics_Call{Call: _e.mock.On("GetWebSocketMetrics")}
+}
+
+func (_c *WebSocketMetricsProvider_GetWebSocketMetrics_Call) Run(run func()) *WebSocketMetricsProvider_GetWebSocketMetrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *WebSocketMetricsProvider_GetWebSocketMetrics_Call) Return(_a0 map[string]interface{}) *WebSocketMetricsProvider_GetWebSocketMetrics_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebSocketMetricsProvider_GetWebSocketMetrics_Call) RunAndReturn(run func() map[string]interface{}) *WebSocketMetricsProvider_GetWebSocketMetrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWebSocketMetricsProvider creates a new instance of WebSocketMetricsProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebSocketMetricsProvider(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebSocketMetricsProvider {
+	mock := &WebSocketMetricsProvider{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
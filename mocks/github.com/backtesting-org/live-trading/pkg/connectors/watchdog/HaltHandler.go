@@ -0,0 +1,65 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package watchdog
+
+import mock "github.com/stretchr/testify/mock"
+
+// HaltHandler is an autogenerated mock type for the HaltHandler type
+type HaltHandler struct {
+	mock.Mock
+}
+
+type HaltHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *HaltHandler) EXPECT() *HaltHandler_Expecter {
+	return &HaltHandler_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: reason
+func (_m *HaltHandler) Execute(reason string) {
+	_m.Called(reason)
+}
+
+// HaltHandler_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type HaltHandler_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - reason string
+func (_e *HaltHandler_Expecter) Execute(reason interface{}) *HaltHandler_Execute_Call {
+	return &HaltHandler_Execute_Call{Call: _e.mock.On("Execute", reason)}
+}
+
+func (_c *HaltHandler_Execute_Call) Run(run func(reason string)) *HaltHandler_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *HaltHandler_Execute_Call) Return() *HaltHandler_Execute_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *HaltHandler_Execute_Call) RunAndReturn(run func(string)) *HaltHandler_Execute_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewHaltHandler creates a new instance of HaltHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewHaltHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *HaltHandler {
+	mock := &HaltHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,295 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package watchdog
+
+import (
+	watchdog "github.com/backtesting-org/live-trading/pkg/connectors/watchdog"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TransferWatchdog is an autogenerated mock type for the TransferWatchdog type
+type TransferWatchdog struct {
+	mock.Mock
+}
+
+type TransferWatchdog_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TransferWatchdog) EXPECT() *TransferWatchdog_Expecter {
+	return &TransferWatchdog_Expecter{mock: &_m.Mock}
+}
+
+// Alerts provides a mock function with no fields
+func (_m *TransferWatchdog) Alerts() <-chan watchdog.Alert {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Alerts")
+	}
+
+	var r0 <-chan watchdog.Alert
+	if rf, ok := ret.Get(0).(func() <-chan watchdog.Alert); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan watchdog.Alert)
+		}
+	}
+
+	return r0
+}
+
+// TransferWatchdog_Alerts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Alerts'
+type TransferWatchdog_Alerts_Call struct {
+	*mock.Call
+}
+
+// Alerts is a helper method to define mock.On call
+func (_e *TransferWatchdog_Expecter) Alerts() *TransferWatchdog_Alerts_Call {
+	return &TransferWatchdog_Alerts_Call{Call: _e.mock.On("Alerts")}
+}
+
+func (_c *TransferWatchdog_Alerts_Call) Run(run func()) *TransferWatchdog_Alerts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TransferWatchdog_Alerts_Call) Return(_a0 <-chan watchdog.Alert) *TransferWatchdog_Alerts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TransferWatchdog_Alerts_Call) RunAndReturn(run func() <-chan watchdog.Alert) *TransferWatchdog_Alerts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Check provides a mock function with no fields
+func (_m *TransferWatchdog) Check() ([]watchdog.Alert, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Check")
+	}
+
+	var r0 []watchdog.Alert
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]watchdog.Alert, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []watchdog.Alert); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]watchdog.Alert)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransferWatchdog_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type TransferWatchdog_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+func (_e *TransferWatchdog_Expecter) Check() *TransferWatchdog_Check_Call {
+	return &TransferWatchdog_Check_Call{Call: _e.mock.On("Check")}
+}
+
+func (_c *TransferWatchdog_Check_Call) Run(run func()) *TransferWatchdog_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TransferWatchdog_Check_Call) Return(_a0 []watchdog.Alert, _a1 error) *TransferWatchdog_Check_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TransferWatchdog_Check_Call) RunAndReturn(run func() ([]watchdog.Alert, error)) *TransferWatchdog_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *TransferWatchdog) Configure(config watchdog.Config) {
+	_m.Called(config)
+}
+
+// TransferWatchdog_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type TransferWatchdog_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config watchdog.Config
+func (_e *TransferWatchdog_Expecter) Configure(config interface{}) *TransferWatchdog_Configure_Call {
+	return &TransferWatchdog_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *TransferWatchdog_Configure_Call) Run(run func(config watchdog.Config)) *TransferWatchdog_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(watchdog.Config))
+	})
+	return _c
+}
+
+func (_c *TransferWatchdog_Configure_Call) Return() *TransferWatchdog_Configure_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *TransferWatchdog_Configure_Call) RunAndReturn(run func(watchdog.Config)) *TransferWatchdog_Configure_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SetHaltHandler provides a mock function with given fields: handler
+func (_m *TransferWatchdog) SetHaltHandler(handler watchdog.HaltHandler) {
+	_m.Called(handler)
+}
+
+// TransferWatchdog_SetHaltHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetHaltHandler'
+type TransferWatchdog_SetHaltHandler_Call struct {
+	*mock.Call
+}
+
+// SetHaltHandler is a helper method to define mock.On call
+//   - handler watchdog.HaltHandler
+func (_e *TransferWatchdog_Expecter) SetHaltHandler(handler interface{}) *TransferWatchdog_SetHaltHandler_Call {
+	return &TransferWatchdog_SetHaltHandler_Call{Call: _e.mock.On("SetHaltHandler", handler)}
+}
+
+func (_c *TransferWatchdog_SetHaltHandler_Call) Run(run func(handler watchdog.HaltHandler)) *TransferWatchdog_SetHaltHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(watchdog.HaltHandler))
+	})
+	return _c
+}
+
+func (_c *TransferWatchdog_SetHaltHandler_Call) Return() *TransferWatchdog_SetHaltHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *TransferWatchdog_SetHaltHandler_Call) RunAndReturn(run func(watchdog.HaltHandler)) *TransferWatchdog_SetHaltHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Start provides a mock function with no fields
+func (_m *TransferWatchdog) Start() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TransferWatchdog_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type TransferWatchdog_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+func (_e *TransferWatchdog_Expecter) Start() *TransferWatchdog_Start_Call {
+	return &TransferWatchdog_Start_Call{Call: _e.mock.On("Start")}
+}
+
+func (_c *TransferWatchdog_Start_Call) Run(run func()) *TransferWatchdog_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TransferWatchdog_Start_Call) Return(_a0 error) *TransferWatchdog_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TransferWatchdog_Start_Call) RunAndReturn(run func() error) *TransferWatchdog_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with no fields
+func (_m *TransferWatchdog) Stop() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TransferWatchdog_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type TransferWatchdog_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *TransferWatchdog_Expecter) Stop() *TransferWatchdog_Stop_Call {
+	return &TransferWatchdog_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *TransferWatchdog_Stop_Call) Run(run func()) *TransferWatchdog_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TransferWatchdog_Stop_Call) Return(_a0 error) *TransferWatchdog_Stop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TransferWatchdog_Stop_Call) RunAndReturn(run func() error) *TransferWatchdog_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTransferWatchdog creates a new instance of TransferWatchdog. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTransferWatchdog(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TransferWatchdog {
+	mock := &TransferWatchdog{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
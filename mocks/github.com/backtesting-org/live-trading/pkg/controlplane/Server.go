@@ -0,0 +1,219 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package controlplane
+
+import (
+	context "context"
+
+	controlplane "github.com/backtesting-org/live-trading/pkg/controlplane"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Server is an autogenerated mock type for the Server type
+type Server struct {
+	mock.Mock
+}
+
+type Server_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Server) EXPECT() *Server_Expecter {
+	return &Server_Expecter{mock: &_m.Mock}
+}
+
+// Address provides a mock function with no fields
+func (_m *Server) Address() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Address")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Server_Address_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Address'
+type Server_Address_Call struct {
+	*mock.Call
+}
+
+// Address is a helper method to define mock.On call
+func (_e *Server_Expecter) Address() *Server_Address_Call {
+	return &Server_Address_Call{Call: _e.mock.On("Address")}
+}
+
+func (_c *Server_Address_Call) Run(run func()) *Server_Address_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Server_Address_Call) Return(_a0 string) *Server_Address_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Server_Address_Call) RunAndReturn(run func() string) *Server_Address_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *Server) Configure(config controlplane.Config) error {
+	ret := _m.Called(config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Configure")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(controlplane.Config) error); ok {
+		r0 = rf(config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Server_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type Server_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config controlplane.Config
+func (_e *Server_Expecter) Configure(config interface{}) *Server_Configure_Call {
+	return &Server_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *Server_Configure_Call) Run(run func(config controlplane.Config)) *Server_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(controlplane.Config))
+	})
+	return _c
+}
+
+func (_c *Server_Configure_Call) Return(_a0 error) *Server_Configure_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Server_Configure_Call) RunAndReturn(run func(controlplane.Config) error) *Server_Configure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with no fields
+func (_m *Server) Start() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Server_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type Server_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+func (_e *Server_Expecter) Start() *Server_Start_Call {
+	return &Server_Start_Call{Call: _e.mock.On("Start")}
+}
+
+func (_c *Server_Start_Call) Run(run func()) *Server_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Server_Start_Call) Return(_a0 error) *Server_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Server_Start_Call) RunAndReturn(run func() error) *Server_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with given fields: ctx
+func (_m *Server) Stop(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Server_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type Server_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Server_Expecter) Stop(ctx interface{}) *Server_Stop_Call {
+	return &Server_Stop_Call{Call: _e.mock.On("Stop", ctx)}
+}
+
+func (_c *Server_Stop_Call) Run(run func(ctx context.Context)) *Server_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Server_Stop_Call) Return(_a0 error) *Server_Stop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Server_Stop_Call) RunAndReturn(run func(context.Context) error) *Server_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewServer creates a new instance of Server. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewServer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Server {
+	mock := &Server{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,310 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package drift
+
+import (
+	drift "github.com/backtesting-org/live-trading/pkg/drift"
+	mock "github.com/stretchr/testify/mock"
+
+	strategy "github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+)
+
+// DriftDetector is an autogenerated mock type for the DriftDetector type
+type DriftDetector struct {
+	mock.Mock
+}
+
+type DriftDetector_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DriftDetector) EXPECT() *DriftDetector_Expecter {
+	return &DriftDetector_Expecter{mock: &_m.Mock}
+}
+
+// Check provides a mock function with no fields
+func (_m *DriftDetector) Check() ([]drift.Divergence, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Check")
+	}
+
+	var r0 []drift.Divergence
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]drift.Divergence, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []drift.Divergence); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]drift.Divergence)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DriftDetector_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type DriftDetector_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+func (_e *DriftDetector_Expecter) Check() *DriftDetector_Check_Call {
+	return &DriftDetector_Check_Call{Call: _e.mock.On("Check")}
+}
+
+func (_c *DriftDetector_Check_Call) Run(run func()) *DriftDetector_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DriftDetector_Check_Call) Return(_a0 []drift.Divergence, _a1 error) *DriftDetector_Check_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DriftDetector_Check_Call) RunAndReturn(run func() ([]drift.Divergence, error)) *DriftDetector_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *DriftDetector) Configure(config drift.Config) {
+	_m.Called(config)
+}
+
+// DriftDetector_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type DriftDetector_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config drift.Config
+func (_e *DriftDetector_Expecter) Configure(config interface{}) *DriftDetector_Configure_Call {
+	return &DriftDetector_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *DriftDetector_Configure_Call) Run(run func(config drift.Config)) *DriftDetector_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(drift.Config))
+	})
+	return _c
+}
+
+func (_c *DriftDetector_Configure_Call) Return() *DriftDetector_Configure_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *DriftDetector_Configure_Call) RunAndReturn(run func(drift.Config)) *DriftDetector_Configure_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Paused provides a mock function with no fields
+func (_m *DriftDetector) Paused() []strategy.StrategyName {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Paused")
+	}
+
+	var r0 []strategy.StrategyName
+	if rf, ok := ret.Get(0).(func() []strategy.StrategyName); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]strategy.StrategyName)
+		}
+	}
+
+	return r0
+}
+
+// DriftDetector_Paused_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Paused'
+type DriftDetector_Paused_Call struct {
+	*mock.Call
+}
+
+// Paused is a helper method to define mock.On call
+func (_e *DriftDetector_Expecter) Paused() *DriftDetector_Paused_Call {
+	return &DriftDetector_Paused_Call{Call: _e.mock.On("Paused")}
+}
+
+func (_c *DriftDetector_Paused_Call) Run(run func()) *DriftDetector_Paused_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DriftDetector_Paused_Call) Return(_a0 []strategy.StrategyName) *DriftDetector_Paused_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DriftDetector_Paused_Call) RunAndReturn(run func() []strategy.StrategyName) *DriftDetector_Paused_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Resume provides a mock function with given fields: name
+func (_m *DriftDetector) Resume(name strategy.StrategyName) error {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Resume")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(strategy.StrategyName) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DriftDetector_Resume_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resume'
+type DriftDetector_Resume_Call struct {
+	*mock.Call
+}
+
+// Resume is a helper method to define mock.On call
+//   - name strategy.StrategyName
+func (_e *DriftDetector_Expecter) Resume(name interface{}) *DriftDetector_Resume_Call {
+	return &DriftDetector_Resume_Call{Call: _e.mock.On("Resume", name)}
+}
+
+func (_c *DriftDetector_Resume_Call) Run(run func(name strategy.StrategyName)) *DriftDetector_Resume_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(strategy.StrategyName))
+	})
+	return _c
+}
+
+func (_c *DriftDetector_Resume_Call) Return(_a0 error) *DriftDetector_Resume_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DriftDetector_Resume_Call) RunAndReturn(run func(strategy.StrategyName) error) *DriftDetector_Resume_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with no fields
+func (_m *DriftDetector) Start() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DriftDetector_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type DriftDetector_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+func (_e *DriftDetector_Expecter) Start() *DriftDetector_Start_Call {
+	return &DriftDetector_Start_Call{Call: _e.mock.On("Start")}
+}
+
+func (_c *DriftDetector_Start_Call) Run(run func()) *DriftDetector_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DriftDetector_Start_Call) Return(_a0 error) *DriftDetector_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DriftDetector_Start_Call) RunAndReturn(run func() error) *DriftDetector_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with no fields
+func (_m *DriftDetector) Stop() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DriftDetector_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type DriftDetector_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *DriftDetector_Expecter) Stop() *DriftDetector_Stop_Call {
+	return &DriftDetector_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *DriftDetector_Stop_Call) Run(run func()) *DriftDetector_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DriftDetector_Stop_Call) Return(_a0 error) *DriftDetector_Stop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DriftDetector_Stop_Call) RunAndReturn(run func() error) *DriftDetector_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDriftDetector creates a new instance of DriftDetector. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDriftDetector(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DriftDetector {
+	mock := &DriftDetector{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
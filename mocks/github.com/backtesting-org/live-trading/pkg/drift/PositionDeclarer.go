@@ -0,0 +1,82 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package drift
+
+import (
+	drift "github.com/backtesting-org/live-trading/pkg/drift"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PositionDeclarer is an autogenerated mock type for the PositionDeclarer type
+type PositionDeclarer struct {
+	mock.Mock
+}
+
+type PositionDeclarer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PositionDeclarer) EXPECT() *PositionDeclarer_Expecter {
+	return &PositionDeclarer_Expecter{mock: &_m.Mock}
+}
+
+// ExpectedPositions provides a mock function with no fields
+func (_m *PositionDeclarer) ExpectedPositions() []drift.ExpectedPosition {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpectedPositions")
+	}
+
+	var r0 []drift.ExpectedPosition
+	if rf, ok := ret.Get(0).(func() []drift.ExpectedPosition); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]drift.ExpectedPosition)
+		}
+	}
+
+	return r0
+}
+
+// PositionDeclarer_ExpectedPositions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpectedPositions'
+type PositionDeclarer_ExpectedPositions_Call struct {
+	*mock.Call
+}
+
+// ExpectedPositions is a helper method to define mock.On call
+func (_e *PositionDeclarer_Expecter) ExpectedPositions() *PositionDeclarer_ExpectedPositions_Call {
+	return &PositionDeclarer_ExpectedPositions_Call{Call: _e.mock.On("ExpectedPositions")}
+}
+
+func (_c *PositionDeclarer_ExpectedPositions_Call) Run(run func()) *PositionDeclarer_ExpectedPositions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PositionDeclarer_ExpectedPositions_Call) Return(_a0 []drift.ExpectedPosition) *PositionDeclarer_ExpectedPositions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PositionDeclarer_ExpectedPositions_Call) RunAndReturn(run func() []drift.ExpectedPosition) *PositionDeclarer_ExpectedPositions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPositionDeclarer creates a new instance of PositionDeclarer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPositionDeclarer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PositionDeclarer {
+	mock := &PositionDeclarer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
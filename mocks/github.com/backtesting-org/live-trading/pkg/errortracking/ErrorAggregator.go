@@ -0,0 +1,230 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package errortracking
+
+import (
+	errortracking "github.com/backtesting-org/live-trading/pkg/errortracking"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ErrorAggregator is an autogenerated mock type for the ErrorAggregator type
+type ErrorAggregator struct {
+	mock.Mock
+}
+
+type ErrorAggregator_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ErrorAggregator) EXPECT() *ErrorAggregator_Expecter {
+	return &ErrorAggregator_Expecter{mock: &_m.Mock}
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *ErrorAggregator) Configure(config errortracking.Config) {
+	_m.Called(config)
+}
+
+// ErrorAggregator_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type ErrorAggregator_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config errortracking.Config
+func (_e *ErrorAggregator_Expecter) Configure(config interface{}) *ErrorAggregator_Configure_Call {
+	return &ErrorAggregator_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *ErrorAggregator_Configure_Call) Run(run func(config errortracking.Config)) *ErrorAggregator_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(errortracking.Config))
+	})
+	return _c
+}
+
+func (_c *ErrorAggregator_Configure_Call) Return() *ErrorAggregator_Configure_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ErrorAggregator_Configure_Call) RunAndReturn(run func(errortracking.Config)) *ErrorAggregator_Configure_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Record provides a mock function with given fields: runID, source, err
+func (_m *ErrorAggregator) Record(runID string, source string, err error) errortracking.Occurrence {
+	ret := _m.Called(runID, source, err)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Record")
+	}
+
+	var r0 errortracking.Occurrence
+	if rf, ok := ret.Get(0).(func(string, string, error) errortracking.Occurrence); ok {
+		r0 = rf(runID, source, err)
+	} else {
+		r0 = ret.Get(0).(errortracking.Occurrence)
+	}
+
+	return r0
+}
+
+// ErrorAggregator_Record_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Record'
+type ErrorAggregator_Record_Call struct {
+	*mock.Call
+}
+
+// Record is a helper method to define mock.On call
+//   - runID string
+//   - source string
+//   - err error
+func (_e *ErrorAggregator_Expecter) Record(runID interface{}, source interface{}, err interface{}) *ErrorAggregator_Record_Call {
+	return &ErrorAggregator_Record_Call{Call: _e.mock.On("Record", runID, source, err)}
+}
+
+func (_c *ErrorAggregator_Record_Call) Run(run func(runID string, source string, err error)) *ErrorAggregator_Record_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(error))
+	})
+	return _c
+}
+
+func (_c *ErrorAggregator_Record_Call) Return(_a0 errortracking.Occurrence) *ErrorAggregator_Record_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ErrorAggregator_Record_Call) RunAndReturn(run func(string, string, error) errortracking.Occurrence) *ErrorAggregator_Record_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reset provides a mock function with given fields: runID
+func (_m *ErrorAggregator) Reset(runID string) {
+	_m.Called(runID)
+}
+
+// ErrorAggregator_Reset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reset'
+type ErrorAggregator_Reset_Call struct {
+	*mock.Call
+}
+
+// Reset is a helper method to define mock.On call
+//   - runID string
+func (_e *ErrorAggregator_Expecter) Reset(runID interface{}) *ErrorAggregator_Reset_Call {
+	return &ErrorAggregator_Reset_Call{Call: _e.mock.On("Reset", runID)}
+}
+
+func (_c *ErrorAggregator_Reset_Call) Run(run func(runID string)) *ErrorAggregator_Reset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *ErrorAggregator_Reset_Call) Return() *ErrorAggregator_Reset_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ErrorAggregator_Reset_Call) RunAndReturn(run func(string)) *ErrorAggregator_Reset_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SetStore provides a mock function with given fields: store
+func (_m *ErrorAggregator) SetStore(store errortracking.GroupStore) {
+	_m.Called(store)
+}
+
+// ErrorAggregator_SetStore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetStore'
+type ErrorAggregator_SetStore_Call struct {
+	*mock.Call
+}
+
+// SetStore is a helper method to define mock.On call
+//   - store errortracking.GroupStore
+func (_e *ErrorAggregator_Expecter) SetStore(store interface{}) *ErrorAggregator_SetStore_Call {
+	return &ErrorAggregator_SetStore_Call{Call: _e.mock.On("SetStore", store)}
+}
+
+func (_c *ErrorAggregator_SetStore_Call) Run(run func(store errortracking.GroupStore)) *ErrorAggregator_SetStore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(errortracking.GroupStore))
+	})
+	return _c
+}
+
+func (_c *ErrorAggregator_SetStore_Call) Return() *ErrorAggregator_SetStore_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ErrorAggregator_SetStore_Call) RunAndReturn(run func(errortracking.GroupStore)) *ErrorAggregator_SetStore_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Summary provides a mock function with given fields: runID
+func (_m *ErrorAggregator) Summary(runID string) []errortracking.Group {
+	ret := _m.Called(runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Summary")
+	}
+
+	var r0 []errortracking.Group
+	if rf, ok := ret.Get(0).(func(string) []errortracking.Group); ok {
+		r0 = rf(runID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]errortracking.Group)
+		}
+	}
+
+	return r0
+}
+
+// ErrorAggregator_Summary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Summary'
+type ErrorAggregator_Summary_Call struct {
+	*mock.Call
+}
+
+// Summary is a helper method to define mock.On call
+//   - runID string
+func (_e *ErrorAggregator_Expecter) Summary(runID interface{}) *ErrorAggregator_Summary_Call {
+	return &ErrorAggregator_Summary_Call{Call: _e.mock.On("Summary", runID)}
+}
+
+func (_c *ErrorAggregator_Summary_Call) Run(run func(runID string)) *ErrorAggregator_Summary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *ErrorAggregator_Summary_Call) Return(_a0 []errortracking.Group) *ErrorAggregator_Summary_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ErrorAggregator_Summary_Call) RunAndReturn(run func(string) []errortracking.Group) *ErrorAggregator_Summary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewErrorAggregator creates a new instance of ErrorAggregator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewErrorAggregator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ErrorAggregator {
+	mock := &ErrorAggregator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
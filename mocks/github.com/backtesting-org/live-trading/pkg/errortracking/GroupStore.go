@@ -0,0 +1,252 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package errortracking
+
+import (
+	errortracking "github.com/backtesting-org/live-trading/pkg/errortracking"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// GroupStore is an autogenerated mock type for the GroupStore type
+type GroupStore struct {
+	mock.Mock
+}
+
+type GroupStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *GroupStore) EXPECT() *GroupStore_Expecter {
+	return &GroupStore_Expecter{mock: &_m.Mock}
+}
+
+// Count provides a mock function with given fields: runID
+func (_m *GroupStore) Count(runID string) int {
+	ret := _m.Called(runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Count")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(string) int); ok {
+		r0 = rf(runID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// GroupStore_Count_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Count'
+type GroupStore_Count_Call struct {
+	*mock.Call
+}
+
+// Count is a helper method to define mock.On call
+//   - runID string
+func (_e *GroupStore_Expecter) Count(runID interface{}) *GroupStore_Count_Call {
+	return &GroupStore_Count_Call{Call: _e.mock.On("Count", runID)}
+}
+
+func (_c *GroupStore_Count_Call) Run(run func(runID string)) *GroupStore_Count_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *GroupStore_Count_Call) Return(_a0 int) *GroupStore_Count_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GroupStore_Count_Call) RunAndReturn(run func(string) int) *GroupStore_Count_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: runID
+func (_m *GroupStore) Delete(runID string) {
+	_m.Called(runID)
+}
+
+// GroupStore_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type GroupStore_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - runID string
+func (_e *GroupStore_Expecter) Delete(runID interface{}) *GroupStore_Delete_Call {
+	return &GroupStore_Delete_Call{Call: _e.mock.On("Delete", runID)}
+}
+
+func (_c *GroupStore_Delete_Call) Run(run func(runID string)) *GroupStore_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *GroupStore_Delete_Call) Return() *GroupStore_Delete_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *GroupStore_Delete_Call) RunAndReturn(run func(string)) *GroupStore_Delete_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: runID, fingerprint
+func (_m *GroupStore) Get(runID string, fingerprint string) (errortracking.Group, bool) {
+	ret := _m.Called(runID, fingerprint)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 errortracking.Group
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string, string) (errortracking.Group, bool)); ok {
+		return rf(runID, fingerprint)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) errortracking.Group); ok {
+		r0 = rf(runID, fingerprint)
+	} else {
+		r0 = ret.Get(0).(errortracking.Group)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) bool); ok {
+		r1 = rf(runID, fingerprint)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GroupStore_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type GroupStore_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - runID string
+//   - fingerprint string
+func (_e *GroupStore_Expecter) Get(runID interface{}, fingerprint interface{}) *GroupStore_Get_Call {
+	return &GroupStore_Get_Call{Call: _e.mock.On("Get", runID, fingerprint)}
+}
+
+func (_c *GroupStore_Get_Call) Run(run func(runID string, fingerprint string)) *GroupStore_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *GroupStore_Get_Call) Return(_a0 errortracking.Group, _a1 bool) *GroupStore_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupStore_Get_Call) RunAndReturn(run func(string, string) (errortracking.Group, bool)) *GroupStore_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Groups provides a mock function with given fields: runID
+func (_m *GroupStore) Groups(runID string) []errortracking.Group {
+	ret := _m.Called(runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Groups")
+	}
+
+	var r0 []errortracking.Group
+	if rf, ok := ret.Get(0).(func(string) []errortracking.Group); ok {
+		r0 = rf(runID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]errortracking.Group)
+		}
+	}
+
+	return r0
+}
+
+// GroupStore_Groups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Groups'
+type GroupStore_Groups_Call struct {
+	*mock.Call
+}
+
+// Groups is a helper method to define mock.On call
+//   - runID string
+func (_e *GroupStore_Expecter) Groups(runID interface{}) *GroupStore_Groups_Call {
+	return &GroupStore_Groups_Call{Call: _e.mock.On("Groups", runID)}
+}
+
+func (_c *GroupStore_Groups_Call) Run(run func(runID string)) *GroupStore_Groups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *GroupStore_Groups_Call) Return(_a0 []errortracking.Group) *GroupStore_Groups_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GroupStore_Groups_Call) RunAndReturn(run func(string) []errortracking.Group) *GroupStore_Groups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Put provides a mock function with given fields: group
+func (_m *GroupStore) Put(group errortracking.Group) {
+	_m.Called(group)
+}
+
+// GroupStore_Put_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Put'
+type GroupStore_Put_Call struct {
+	*mock.Call
+}
+
+// Put is a helper method to define mock.On call
+//   - group errortracking.Group
+func (_e *GroupStore_Expecter) Put(group interface{}) *GroupStore_Put_Call {
+	return &GroupStore_Put_Call{Call: _e.mock.On("Put", group)}
+}
+
+func (_c *GroupStore_Put_Call) Run(run func(group errortracking.Group)) *GroupStore_Put_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(errortracking.Group))
+	})
+	return _c
+}
+
+func (_c *GroupStore_Put_Call) Return() *GroupStore_Put_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *GroupStore_Put_Call) RunAndReturn(run func(errortracking.Group)) *GroupStore_Put_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewGroupStore creates a new instance of GroupStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewGroupStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *GroupStore {
+	mock := &GroupStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,90 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package eventschema
+
+import mock "github.com/stretchr/testify/mock"
+
+// Downgrade is an autogenerated mock type for the Downgrade type
+type Downgrade struct {
+	mock.Mock
+}
+
+type Downgrade_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Downgrade) EXPECT() *Downgrade_Expecter {
+	return &Downgrade_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: payload
+func (_m *Downgrade) Execute(payload map[string]interface{}) (map[string]interface{}, error) {
+	ret := _m.Called(payload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 map[string]interface{}
+	var r1 error
+	if rf, ok := ret.Get(0).(func(map[string]interface{}) (map[string]interface{}, error)); ok {
+		return rf(payload)
+	}
+	if rf, ok := ret.Get(0).(func(map[string]interface{}) map[string]interface{}); ok {
+		r0 = rf(payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]interface{})
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(map[string]interface{}) error); ok {
+		r1 = rf(payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Downgrade_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type Downgrade_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - payload map[string]interface{}
+func (_e *Downgrade_Expecter) Execute(payload interface{}) *Downgrade_Execute_Call {
+	return &Downgrade_Execute_Call{Call: _e.mock.On("Execute", payload)}
+}
+
+func (_c *Downgrade_Execute_Call) Run(run func(payload map[string]interface{})) *Downgrade_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(map[string]interface{}))
+	})
+	return _c
+}
+
+func (_c *Downgrade_Execute_Call) Return(_a0 map[string]interface{}, _a1 error) *Downgrade_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Downgrade_Execute_Call) RunAndReturn(run func(map[string]interface{}) (map[string]interface{}, error)) *Downgrade_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDowngrade creates a new instance of Downgrade. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDowngrade(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Downgrade {
+	mock := &Downgrade{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,468 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package eventschema
+
+import (
+	events "github.com/backtesting-org/kronos-sdk/pkg/events"
+	eventschema "github.com/backtesting-org/live-trading/pkg/eventschema"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SchemaRegistry is an autogenerated mock type for the SchemaRegistry type
+type SchemaRegistry struct {
+	mock.Mock
+}
+
+type SchemaRegistry_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SchemaRegistry) EXPECT() *SchemaRegistry_Expecter {
+	return &SchemaRegistry_Expecter{mock: &_m.Mock}
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *SchemaRegistry) Configure(config eventschema.Config) {
+	_m.Called(config)
+}
+
+// SchemaRegistry_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type SchemaRegistry_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config eventschema.Config
+func (_e *SchemaRegistry_Expecter) Configure(config interface{}) *SchemaRegistry_Configure_Call {
+	return &SchemaRegistry_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *SchemaRegistry_Configure_Call) Run(run func(config eventschema.Config)) *SchemaRegistry_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(eventschema.Config))
+	})
+	return _c
+}
+
+func (_c *SchemaRegistry_Configure_Call) Return() *SchemaRegistry_Configure_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *SchemaRegistry_Configure_Call) RunAndReturn(run func(eventschema.Config)) *SchemaRegistry_Configure_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Current provides a mock function with given fields: topic
+func (_m *SchemaRegistry) Current(topic string) (eventschema.Schema, bool) {
+	ret := _m.Called(topic)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Current")
+	}
+
+	var r0 eventschema.Schema
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string) (eventschema.Schema, bool)); ok {
+		return rf(topic)
+	}
+	if rf, ok := ret.Get(0).(func(string) eventschema.Schema); ok {
+		r0 = rf(topic)
+	} else {
+		r0 = ret.Get(0).(eventschema.Schema)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(topic)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// SchemaRegistry_Current_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Current'
+type SchemaRegistry_Current_Call struct {
+	*mock.Call
+}
+
+// Current is a helper method to define mock.On call
+//   - topic string
+func (_e *SchemaRegistry_Expecter) Current(topic interface{}) *SchemaRegistry_Current_Call {
+	return &SchemaRegistry_Current_Call{Call: _e.mock.On("Current", topic)}
+}
+
+func (_c *SchemaRegistry_Current_Call) Run(run func(topic string)) *SchemaRegistry_Current_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *SchemaRegistry_Current_Call) Return(_a0 eventschema.Schema, _a1 bool) *SchemaRegistry_Current_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SchemaRegistry_Current_Call) RunAndReturn(run func(string) (eventschema.Schema, bool)) *SchemaRegistry_Current_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Downgrade provides a mock function with given fields: envelope, version
+func (_m *SchemaRegistry) Downgrade(envelope eventschema.Envelope, version int) (eventschema.Envelope, error) {
+	ret := _m.Called(envelope, version)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Downgrade")
+	}
+
+	var r0 eventschema.Envelope
+	var r1 error
+	if rf, ok := ret.Get(0).(func(eventschema.Envelope, int) (eventschema.Envelope, error)); ok {
+		return rf(envelope, version)
+	}
+	if rf, ok := ret.Get(0).(func(eventschema.Envelope, int) eventschema.Envelope); ok {
+		r0 = rf(envelope, version)
+	} else {
+		r0 = ret.Get(0).(eventschema.Envelope)
+	}
+
+	if rf, ok := ret.Get(1).(func(eventschema.Envelope, int) error); ok {
+		r1 = rf(envelope, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SchemaRegistry_Downgrade_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Downgrade'
+type SchemaRegistry_Downgrade_Call struct {
+	*mock.Call
+}
+
+// Downgrade is a helper method to define mock.On call
+//   - envelope eventschema.Envelope
+//   - version int
+func (_e *SchemaRegistry_Expecter) Downgrade(envelope interface{}, version interface{}) *SchemaRegistry_Downgrade_Call {
+	return &SchemaRegistry_Downgrade_Call{Call: _e.mock.On("Downgrade", envelope, version)}
+}
+
+func (_c *SchemaRegistry_Downgrade_Call) Run(run func(envelope eventschema.Envelope, version int)) *SchemaRegistry_Downgrade_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(eventschema.Envelope), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *SchemaRegistry_Downgrade_Call) Return(_a0 eventschema.Envelope, _a1 error) *SchemaRegistry_Downgrade_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SchemaRegistry_Downgrade_Call) RunAndReturn(run func(eventschema.Envelope, int) (eventschema.Envelope, error)) *SchemaRegistry_Downgrade_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Register provides a mock function with given fields: schema
+func (_m *SchemaRegistry) Register(schema eventschema.Schema) error {
+	ret := _m.Called(schema)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Register")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(eventschema.Schema) error); ok {
+		r0 = rf(schema)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SchemaRegistry_Register_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Register'
+type SchemaRegistry_Register_Call struct {
+	*mock.Call
+}
+
+// Register is a helper method to define mock.On call
+//   - schema eventschema.Schema
+func (_e *SchemaRegistry_Expecter) Register(schema interface{}) *SchemaRegistry_Register_Call {
+	return &SchemaRegistry_Register_Call{Call: _e.mock.On("Register", schema)}
+}
+
+func (_c *SchemaRegistry_Register_Call) Run(run func(schema eventschema.Schema)) *SchemaRegistry_Register_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(eventschema.Schema))
+	})
+	return _c
+}
+
+func (_c *SchemaRegistry_Register_Call) Return(_a0 error) *SchemaRegistry_Register_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SchemaRegistry_Register_Call) RunAndReturn(run func(eventschema.Schema) error) *SchemaRegistry_Register_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RegisterDowngrade provides a mock function with given fields: topic, from, convert
+func (_m *SchemaRegistry) RegisterDowngrade(topic string, from int, convert eventschema.Downgrade) error {
+	ret := _m.Called(topic, from, convert)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterDowngrade")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int, eventschema.Downgrade) error); ok {
+		r0 = rf(topic, from, convert)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SchemaRegistry_RegisterDowngrade_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterDowngrade'
+type SchemaRegistry_RegisterDowngrade_Call struct {
+	*mock.Call
+}
+
+// RegisterDowngrade is a helper method to define mock.On call
+//   - topic string
+//   - from int
+//   - convert eventschema.Downgrade
+func (_e *SchemaRegistry_Expecter) RegisterDowngrade(topic interface{}, from interface{}, convert interface{}) *SchemaRegistry_RegisterDowngrade_Call {
+	return &SchemaRegistry_RegisterDowngrade_Call{Call: _e.mock.On("RegisterDowngrade", topic, from, convert)}
+}
+
+func (_c *SchemaRegistry_RegisterDowngrade_Call) Run(run func(topic string, from int, convert eventschema.Downgrade)) *SchemaRegistry_RegisterDowngrade_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int), args[2].(eventschema.Downgrade))
+	})
+	return _c
+}
+
+func (_c *SchemaRegistry_RegisterDowngrade_Call) Return(_a0 error) *SchemaRegistry_RegisterDowngrade_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SchemaRegistry_RegisterDowngrade_Call) RunAndReturn(run func(string, int, eventschema.Downgrade) error) *SchemaRegistry_RegisterDowngrade_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Schemas provides a mock function with no fields
+func (_m *SchemaRegistry) Schemas() []eventschema.Schema {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Schemas")
+	}
+
+	var r0 []eventschema.Schema
+	if rf, ok := ret.Get(0).(func() []eventschema.Schema); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]eventschema.Schema)
+		}
+	}
+
+	return r0
+}
+
+// SchemaRegistry_Schemas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Schemas'
+type SchemaRegistry_Schemas_Call struct {
+	*mock.Call
+}
+
+// Schemas is a helper method to define mock.On call
+func (_e *SchemaRegistry_Expecter) Schemas() *SchemaRegistry_Schemas_Call {
+	return &SchemaRegistry_Schemas_Call{Call: _e.mock.On("Schemas")}
+}
+
+func (_c *SchemaRegistry_Schemas_Call) Run(run func()) *SchemaRegistry_Schemas_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SchemaRegistry_Schemas_Call) Return(_a0 []eventschema.Schema) *SchemaRegistry_Schemas_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SchemaRegistry_Schemas_Call) RunAndReturn(run func() []eventschema.Schema) *SchemaRegistry_Schemas_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Versioned provides a mock function with given fields: version, handler
+func (_m *SchemaRegistry) Versioned(version int, handler func(eventschema.Envelope)) func(interface{}) {
+	ret := _m.Called(version, handler)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Versioned")
+	}
+
+	var r0 func(interface{})
+	if rf, ok := ret.Get(0).(func(int, func(eventschema.Envelope)) func(interface{})); ok {
+		r0 = rf(version, handler)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(func(interface{}))
+		}
+	}
+
+	return r0
+}
+
+// SchemaRegistry_Versioned_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Versioned'
+type SchemaRegistry_Versioned_Call struct {
+	*mock.Call
+}
+
+// Versioned is a helper method to define mock.On call
+//   - version int
+//   - handler func(eventschema.Envelope)
+func (_e *SchemaRegistry_Expecter) Versioned(version interface{}, handler interface{}) *SchemaRegistry_Versioned_Call {
+	return &SchemaRegistry_Versioned_Call{Call: _e.mock.On("Versioned", version, handler)}
+}
+
+func (_c *SchemaRegistry_Versioned_Call) Run(run func(version int, handler func(eventschema.Envelope))) *SchemaRegistry_Versioned_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(func(eventschema.Envelope)))
+	})
+	return _c
+}
+
+func (_c *SchemaRegistry_Versioned_Call) Return(_a0 func(interface{})) *SchemaRegistry_Versioned_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SchemaRegistry_Versioned_Call) RunAndReturn(run func(int, func(eventschema.Envelope)) func(interface{})) *SchemaRegistry_Versioned_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Violations provides a mock function with no fields
+func (_m *SchemaRegistry) Violations() []eventschema.Violation {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Violations")
+	}
+
+	var r0 []eventschema.Violation
+	if rf, ok := ret.Get(0).(func() []eventschema.Violation); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]eventschema.Violation)
+		}
+	}
+
+	return r0
+}
+
+// SchemaRegistry_Violations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Violations'
+type SchemaRegistry_Violations_Call struct {
+	*mock.Call
+}
+
+// Violations is a helper method to define mock.On call
+func (_e *SchemaRegistry_Expecter) Violations() *SchemaRegistry_Violations_Call {
+	return &SchemaRegistry_Violations_Call{Call: _e.mock.On("Violations")}
+}
+
+func (_c *SchemaRegistry_Violations_Call) Run(run func()) *SchemaRegistry_Violations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SchemaRegistry_Violations_Call) Return(_a0 []eventschema.Violation) *SchemaRegistry_Violations_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SchemaRegistry_Violations_Call) RunAndReturn(run func() []eventschema.Violation) *SchemaRegistry_Violations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Wrap provides a mock function with given fields: inner
+func (_m *SchemaRegistry) Wrap(inner events.EventBus) events.EventBus {
+	ret := _m.Called(inner)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Wrap")
+	}
+
+	var r0 events.EventBus
+	if rf, ok := ret.Get(0).(func(events.EventBus) events.EventBus); ok {
+		r0 = rf(inner)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(events.EventBus)
+		}
+	}
+
+	return r0
+}
+
+// SchemaRegistry_Wrap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Wrap'
+type SchemaRegistry_Wrap_Call struct {
+	*mock.Call
+}
+
+// Wrap is a helper method to define mock.On call
+//   - inner events.EventBus
+func (_e *SchemaRegistry_Expecter) Wrap(inner interface{}) *SchemaRegistry_Wrap_Call {
+	return &SchemaRegistry_Wrap_Call{Call: _e.mock.On("Wrap", inner)}
+}
+
+func (_c *SchemaRegistry_Wrap_Call) Run(run func(inner events.EventBus)) *SchemaRegistry_Wrap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(events.EventBus))
+	})
+	return _c
+}
+
+func (_c *SchemaRegistry_Wrap_Call) Return(_a0 events.EventBus) *SchemaRegistry_Wrap_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SchemaRegistry_Wrap_Call) RunAndReturn(run func(events.EventBus) events.EventBus) *SchemaRegistry_Wrap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSchemaRegistry creates a new instance of SchemaRegistry. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSchemaRegistry(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SchemaRegistry {
+	mock := &SchemaRegistry{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,117 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package flags
+
+import (
+	flags "github.com/backtesting-org/live-trading/pkg/flags"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EvaluationStore is an autogenerated mock type for the EvaluationStore type
+type EvaluationStore struct {
+	mock.Mock
+}
+
+type EvaluationStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *EvaluationStore) EXPECT() *EvaluationStore_Expecter {
+	return &EvaluationStore_Expecter{mock: &_m.Mock}
+}
+
+// Recent provides a mock function with given fields: flag, limit
+func (_m *EvaluationStore) Recent(flag string, limit int) []flags.Evaluation {
+	ret := _m.Called(flag, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Recent")
+	}
+
+	var r0 []flags.Evaluation
+	if rf, ok := ret.Get(0).(func(string, int) []flags.Evaluation); ok {
+		r0 = rf(flag, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]flags.Evaluation)
+		}
+	}
+
+	return r0
+}
+
+// EvaluationStore_Recent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Recent'
+type EvaluationStore_Recent_Call struct {
+	*mock.Call
+}
+
+// Recent is a helper method to define mock.On call
+//   - flag string
+//   - limit int
+func (_e *EvaluationStore_Expecter) Recent(flag interface{}, limit interface{}) *EvaluationStore_Recent_Call {
+	return &EvaluationStore_Recent_Call{Call: _e.mock.On("Recent", flag, limit)}
+}
+
+func (_c *EvaluationStore_Recent_Call) Run(run func(flag string, limit int)) *EvaluationStore_Recent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *EvaluationStore_Recent_Call) Return(_a0 []flags.Evaluation) *EvaluationStore_Recent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *EvaluationStore_Recent_Call) RunAndReturn(run func(string, int) []flags.Evaluation) *EvaluationStore_Recent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Record provides a mock function with given fields: evaluation
+func (_m *EvaluationStore) Record(evaluation flags.Evaluation) {
+	_m.Called(evaluation)
+}
+
+// EvaluationStore_Record_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Record'
+type EvaluationStore_Record_Call struct {
+	*mock.Call
+}
+
+// Record is a helper method to define mock.On call
+//   - evaluation flags.Evaluation
+func (_e *EvaluationStore_Expecter) Record(evaluation interface{}) *EvaluationStore_Record_Call {
+	return &EvaluationStore_Record_Call{Call: _e.mock.On("Record", evaluation)}
+}
+
+func (_c *EvaluationStore_Record_Call) Run(run func(evaluation flags.Evaluation)) *EvaluationStore_Record_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(flags.Evaluation))
+	})
+	return _c
+}
+
+func (_c *EvaluationStore_Record_Call) Return() *EvaluationStore_Record_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *EvaluationStore_Record_Call) RunAndReturn(run func(flags.Evaluation)) *EvaluationStore_Record_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewEvaluationStore creates a new instance of EvaluationStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEvaluationStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EvaluationStore {
+	mock := &EvaluationStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
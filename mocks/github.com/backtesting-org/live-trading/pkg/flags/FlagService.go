@@ -0,0 +1,507 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package flags
+
+import (
+	flags "github.com/backtesting-org/live-trading/pkg/flags"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FlagService is an autogenerated mock type for the FlagService type
+type FlagService struct {
+	mock.Mock
+}
+
+type FlagService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FlagService) EXPECT() *FlagService_Expecter {
+	return &FlagService_Expecter{mock: &_m.Mock}
+}
+
+// ClearRunOverrides provides a mock function with given fields: runID
+func (_m *FlagService) ClearRunOverrides(runID string) {
+	_m.Called(runID)
+}
+
+// FlagService_ClearRunOverrides_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClearRunOverrides'
+type FlagService_ClearRunOverrides_Call struct {
+	*mock.Call
+}
+
+// ClearRunOverrides is a helper method to define mock.On call
+//   - runID string
+func (_e *FlagService_Expecter) ClearRunOverrides(runID interface{}) *FlagService_ClearRunOverrides_Call {
+	return &FlagService_ClearRunOverrides_Call{Call: _e.mock.On("ClearRunOverrides", runID)}
+}
+
+func (_c *FlagService_ClearRunOverrides_Call) Run(run func(runID string)) *FlagService_ClearRunOverrides_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *FlagService_ClearRunOverrides_Call) Return() *FlagService_ClearRunOverrides_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *FlagService_ClearRunOverrides_Call) RunAndReturn(run func(string)) *FlagService_ClearRunOverrides_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Define provides a mock function with given fields: flag
+func (_m *FlagService) Define(flag flags.Flag) error {
+	ret := _m.Called(flag)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Define")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(flags.Flag) error); ok {
+		r0 = rf(flag)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FlagService_Define_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Define'
+type FlagService_Define_Call struct {
+	*mock.Call
+}
+
+// Define is a helper method to define mock.On call
+//   - flag flags.Flag
+func (_e *FlagService_Expecter) Define(flag interface{}) *FlagService_Define_Call {
+	return &FlagService_Define_Call{Call: _e.mock.On("Define", flag)}
+}
+
+func (_c *FlagService_Define_Call) Run(run func(flag flags.Flag)) *FlagService_Define_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(flags.Flag))
+	})
+	return _c
+}
+
+func (_c *FlagService_Define_Call) Return(_a0 error) *FlagService_Define_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FlagService_Define_Call) RunAndReturn(run func(flags.Flag) error) *FlagService_Define_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Evaluate provides a mock function with given fields: name, ctx
+func (_m *FlagService) Evaluate(name string, ctx flags.Context) flags.Evaluation {
+	ret := _m.Called(name, ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Evaluate")
+	}
+
+	var r0 flags.Evaluation
+	if rf, ok := ret.Get(0).(func(string, flags.Context) flags.Evaluation); ok {
+		r0 = rf(name, ctx)
+	} else {
+		r0 = ret.Get(0).(flags.Evaluation)
+	}
+
+	return r0
+}
+
+// FlagService_Evaluate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Evaluate'
+type FlagService_Evaluate_Call struct {
+	*mock.Call
+}
+
+// Evaluate is a helper method to define mock.On call
+//   - name string
+//   - ctx flags.Context
+func (_e *FlagService_Expecter) Evaluate(name interface{}, ctx interface{}) *FlagService_Evaluate_Call {
+	return &FlagService_Evaluate_Call{Call: _e.mock.On("Evaluate", name, ctx)}
+}
+
+func (_c *FlagService_Evaluate_Call) Run(run func(name string, ctx flags.Context)) *FlagService_Evaluate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(flags.Context))
+	})
+	return _c
+}
+
+func (_c *FlagService_Evaluate_Call) Return(_a0 flags.Evaluation) *FlagService_Evaluate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FlagService_Evaluate_Call) RunAndReturn(run func(string, flags.Context) flags.Evaluation) *FlagService_Evaluate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Evaluations provides a mock function with given fields: name, limit
+func (_m *FlagService) Evaluations(name string, limit int) []flags.Evaluation {
+	ret := _m.Called(name, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Evaluations")
+	}
+
+	var r0 []flags.Evaluation
+	if rf, ok := ret.Get(0).(func(string, int) []flags.Evaluation); ok {
+		r0 = rf(name, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]flags.Evaluation)
+		}
+	}
+
+	return r0
+}
+
+// FlagService_Evaluations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Evaluations'
+type FlagService_Evaluations_Call struct {
+	*mock.Call
+}
+
+// Evaluations is a helper method to define mock.On call
+//   - name string
+//   - limit int
+func (_e *FlagService_Expecter) Evaluations(name interface{}, limit interface{}) *FlagService_Evaluations_Call {
+	return &FlagService_Evaluations_Call{Call: _e.mock.On("Evaluations", name, limit)}
+}
+
+func (_c *FlagService_Evaluations_Call) Run(run func(name string, limit int)) *FlagService_Evaluations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *FlagService_Evaluations_Call) Return(_a0 []flags.Evaluation) *FlagService_Evaluations_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FlagService_Evaluations_Call) RunAndReturn(run func(string, int) []flags.Evaluation) *FlagService_Evaluations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Flag provides a mock function with given fields: name
+func (_m *FlagService) Flag(name string) (flags.Flag, bool) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Flag")
+	}
+
+	var r0 flags.Flag
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string) (flags.Flag, bool)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) flags.Flag); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(flags.Flag)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// FlagService_Flag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Flag'
+type FlagService_Flag_Call struct {
+	*mock.Call
+}
+
+// Flag is a helper method to define mock.On call
+//   - name string
+func (_e *FlagService_Expecter) Flag(name interface{}) *FlagService_Flag_Call {
+	return &FlagService_Flag_Call{Call: _e.mock.On("Flag", name)}
+}
+
+func (_c *FlagService_Flag_Call) Run(run func(name string)) *FlagService_Flag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *FlagService_Flag_Call) Return(_a0 flags.Flag, _a1 bool) *FlagService_Flag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FlagService_Flag_Call) RunAndReturn(run func(string) (flags.Flag, bool)) *FlagService_Flag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Flags provides a mock function with no fields
+func (_m *FlagService) Flags() []flags.Flag {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Flags")
+	}
+
+	var r0 []flags.Flag
+	if rf, ok := ret.Get(0).(func() []flags.Flag); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]flags.Flag)
+		}
+	}
+
+	return r0
+}
+
+// FlagService_Flags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Flags'
+type FlagService_Flags_Call struct {
+	*mock.Call
+}
+
+// Flags is a helper method to define mock.On call
+func (_e *FlagService_Expecter) Flags() *FlagService_Flags_Call {
+	return &FlagService_Flags_Call{Call: _e.mock.On("Flags")}
+}
+
+func (_c *FlagService_Flags_Call) Run(run func()) *FlagService_Flags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *FlagService_Flags_Call) Return(_a0 []flags.Flag) *FlagService_Flags_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FlagService_Flags_Call) RunAndReturn(run func() []flags.Flag) *FlagService_Flags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsEnabled provides a mock function with given fields: name, ctx
+func (_m *FlagService) IsEnabled(name string, ctx flags.Context) bool {
+	ret := _m.Called(name, ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsEnabled")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, flags.Context) bool); ok {
+		r0 = rf(name, ctx)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// FlagService_IsEnabled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsEnabled'
+type FlagService_IsEnabled_Call struct {
+	*mock.Call
+}
+
+// IsEnabled is a helper method to define mock.On call
+//   - name string
+//   - ctx flags.Context
+func (_e *FlagService_Expecter) IsEnabled(name interface{}, ctx interface{}) *FlagService_IsEnabled_Call {
+	return &FlagService_IsEnabled_Call{Call: _e.mock.On("IsEnabled", name, ctx)}
+}
+
+func (_c *FlagService_IsEnabled_Call) Run(run func(name string, ctx flags.Context)) *FlagService_IsEnabled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(flags.Context))
+	})
+	return _c
+}
+
+func (_c *FlagService_IsEnabled_Call) Return(_a0 bool) *FlagService_IsEnabled_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FlagService_IsEnabled_Call) RunAndReturn(run func(string, flags.Context) bool) *FlagService_IsEnabled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Remove provides a mock function with given fields: name
+func (_m *FlagService) Remove(name string) {
+	_m.Called(name)
+}
+
+// FlagService_Remove_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Remove'
+type FlagService_Remove_Call struct {
+	*mock.Call
+}
+
+// Remove is a helper method to define mock.On call
+//   - name string
+func (_e *FlagService_Expecter) Remove(name interface{}) *FlagService_Remove_Call {
+	return &FlagService_Remove_Call{Call: _e.mock.On("Remove", name)}
+}
+
+func (_c *FlagService_Remove_Call) Run(run func(name string)) *FlagService_Remove_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *FlagService_Remove_Call) Return() *FlagService_Remove_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *FlagService_Remove_Call) RunAndReturn(run func(string)) *FlagService_Remove_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SetDeployment provides a mock function with given fields: name
+func (_m *FlagService) SetDeployment(name string) {
+	_m.Called(name)
+}
+
+// FlagService_SetDeployment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetDeployment'
+type FlagService_SetDeployment_Call struct {
+	*mock.Call
+}
+
+// SetDeployment is a helper method to define mock.On call
+//   - name string
+func (_e *FlagService_Expecter) SetDeployment(name interface{}) *FlagService_SetDeployment_Call {
+	return &FlagService_SetDeployment_Call{Call: _e.mock.On("SetDeployment", name)}
+}
+
+func (_c *FlagService_SetDeployment_Call) Run(run func(name string)) *FlagService_SetDeployment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *FlagService_SetDeployment_Call) Return() *FlagService_SetDeployment_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *FlagService_SetDeployment_Call) RunAndReturn(run func(string)) *FlagService_SetDeployment_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SetEvaluationStore provides a mock function with given fields: store
+func (_m *FlagService) SetEvaluationStore(store flags.EvaluationStore) {
+	_m.Called(store)
+}
+
+// FlagService_SetEvaluationStore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetEvaluationStore'
+type FlagService_SetEvaluationStore_Call struct {
+	*mock.Call
+}
+
+// SetEvaluationStore is a helper method to define mock.On call
+//   - store flags.EvaluationStore
+func (_e *FlagService_Expecter) SetEvaluationStore(store interface{}) *FlagService_SetEvaluationStore_Call {
+	return &FlagService_SetEvaluationStore_Call{Call: _e.mock.On("SetEvaluationStore", store)}
+}
+
+func (_c *FlagService_SetEvaluationStore_Call) Run(run func(store flags.EvaluationStore)) *FlagService_SetEvaluationStore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(flags.EvaluationStore))
+	})
+	return _c
+}
+
+func (_c *FlagService_SetEvaluationStore_Call) Return() *FlagService_SetEvaluationStore_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *FlagService_SetEvaluationStore_Call) RunAndReturn(run func(flags.EvaluationStore)) *FlagService_SetEvaluationStore_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SetRunOverride provides a mock function with given fields: runID, name, enabled
+func (_m *FlagService) SetRunOverride(runID string, name string, enabled bool) error {
+	ret := _m.Called(runID, name, enabled)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRunOverride")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, bool) error); ok {
+		r0 = rf(runID, name, enabled)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FlagService_SetRunOverride_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRunOverride'
+type FlagService_SetRunOverride_Call struct {
+	*mock.Call
+}
+
+// SetRunOverride is a helper method to define mock.On call
+//   - runID string
+//   - name string
+//   - enabled bool
+func (_e *FlagService_Expecter) SetRunOverride(runID interface{}, name interface{}, enabled interface{}) *FlagService_SetRunOverride_Call {
+	return &FlagService_SetRunOverride_Call{Call: _e.mock.On("SetRunOverride", runID, name, enabled)}
+}
+
+func (_c *FlagService_SetRunOverride_Call) Run(run func(runID string, name string, enabled bool)) *FlagService_SetRunOverride_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *FlagService_SetRunOverride_Call) Return(_a0 error) *FlagService_SetRunOverride_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FlagService_SetRunOverride_Call) RunAndReturn(run func(string, string, bool) error) *FlagService_SetRunOverride_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFlagService creates a new instance of FlagService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFlagService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FlagService {
+	mock := &FlagService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
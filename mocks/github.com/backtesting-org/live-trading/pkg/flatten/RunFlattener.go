@@ -0,0 +1,176 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package flatten
+
+import (
+	http "net/http"
+
+	flatten "github.com/backtesting-org/live-trading/pkg/flatten"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RunFlattener is an autogenerated mock type for the RunFlattener type
+type RunFlattener struct {
+	mock.Mock
+}
+
+type RunFlattener_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RunFlattener) EXPECT() *RunFlattener_Expecter {
+	return &RunFlattener_Expecter{mock: &_m.Mock}
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *RunFlattener) Configure(config flatten.Config) {
+	_m.Called(config)
+}
+
+// RunFlattener_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type RunFlattener_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config flatten.Config
+func (_e *RunFlattener_Expecter) Configure(config interface{}) *RunFlattener_Configure_Call {
+	return &RunFlattener_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *RunFlattener_Configure_Call) Run(run func(config flatten.Config)) *RunFlattener_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(flatten.Config))
+	})
+	return _c
+}
+
+func (_c *RunFlattener_Configure_Call) Return() *RunFlattener_Configure_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *RunFlattener_Configure_Call) RunAndReturn(run func(flatten.Config)) *RunFlattener_Configure_Call {
+	_c.Run(run)
+	return _c
+}
+
+// FlattenRun provides a mock function with given fields: runID
+func (_m *RunFlattener) FlattenRun(runID string) (*flatten.Result, error) {
+	ret := _m.Called(runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FlattenRun")
+	}
+
+	var r0 *flatten.Result
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*flatten.Result, error)); ok {
+		return rf(runID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *flatten.Result); ok {
+		r0 = rf(runID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flatten.Result)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(runID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RunFlattener_FlattenRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FlattenRun'
+type RunFlattener_FlattenRun_Call struct {
+	*mock.Call
+}
+
+// FlattenRun is a helper method to define mock.On call
+//   - runID string
+func (_e *RunFlattener_Expecter) FlattenRun(runID interface{}) *RunFlattener_FlattenRun_Call {
+	return &RunFlattener_FlattenRun_Call{Call: _e.mock.On("FlattenRun", runID)}
+}
+
+func (_c *RunFlattener_FlattenRun_Call) Run(run func(runID string)) *RunFlattener_FlattenRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *RunFlattener_FlattenRun_Call) Return(_a0 *flatten.Result, _a1 error) *RunFlattener_FlattenRun_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RunFlattener_FlattenRun_Call) RunAndReturn(run func(string) (*flatten.Result, error)) *RunFlattener_FlattenRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Handler provides a mock function with no fields
+func (_m *RunFlattener) Handler() http.Handler {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handler")
+	}
+
+	var r0 http.Handler
+	if rf, ok := ret.Get(0).(func() http.Handler); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(http.Handler)
+		}
+	}
+
+	return r0
+}
+
+// RunFlattener_Handler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handler'
+type RunFlattener_Handler_Call struct {
+	*mock.Call
+}
+
+// Handler is a helper method to define mock.On call
+func (_e *RunFlattener_Expecter) Handler() *RunFlattener_Handler_Call {
+	return &RunFlattener_Handler_Call{Call: _e.mock.On("Handler")}
+}
+
+func (_c *RunFlattener_Handler_Call) Run(run func()) *RunFlattener_Handler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RunFlattener_Handler_Call) Return(_a0 http.Handler) *RunFlattener_Handler_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RunFlattener_Handler_Call) RunAndReturn(run func() http.Handler) *RunFlattener_Handler_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRunFlattener creates a new instance of RunFlattener. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRunFlattener(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RunFlattener {
+	mock := &RunFlattener{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
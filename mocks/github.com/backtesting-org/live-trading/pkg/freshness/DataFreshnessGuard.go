@@ -0,0 +1,179 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package freshness
+
+import (
+	execution "github.com/backtesting-org/kronos-sdk/pkg/types/execution"
+	freshness "github.com/backtesting-org/live-trading/pkg/freshness"
+
+	mock "github.com/stretchr/testify/mock"
+
+	strategy "github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+)
+
+// DataFreshnessGuard is an autogenerated mock type for the DataFreshnessGuard type
+type DataFreshnessGuard struct {
+	mock.Mock
+}
+
+type DataFreshnessGuard_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DataFreshnessGuard) EXPECT() *DataFreshnessGuard_Expecter {
+	return &DataFreshnessGuard_Expecter{mock: &_m.Mock}
+}
+
+// Check provides a mock function with given fields: signal
+func (_m *DataFreshnessGuard) Check(signal *strategy.Signal) error {
+	ret := _m.Called(signal)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Check")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*strategy.Signal) error); ok {
+		r0 = rf(signal)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataFreshnessGuard_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type DataFreshnessGuard_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+//   - signal *strategy.Signal
+func (_e *DataFreshnessGuard_Expecter) Check(signal interface{}) *DataFreshnessGuard_Check_Call {
+	return &DataFreshnessGuard_Check_Call{Call: _e.mock.On("Check", signal)}
+}
+
+func (_c *DataFreshnessGuard_Check_Call) Run(run func(signal *strategy.Signal)) *DataFreshnessGuard_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*strategy.Signal))
+	})
+	return _c
+}
+
+func (_c *DataFreshnessGuard_Check_Call) Return(_a0 error) *DataFreshnessGuard_Check_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataFreshnessGuard_Check_Call) RunAndReturn(run func(*strategy.Signal) error) *DataFreshnessGuard_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *DataFreshnessGuard) Configure(config freshness.Config) error {
+	ret := _m.Called(config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Configure")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(freshness.Config) error); ok {
+		r0 = rf(config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataFreshnessGuard_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type DataFreshnessGuard_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config freshness.Config
+func (_e *DataFreshnessGuard_Expecter) Configure(config interface{}) *DataFreshnessGuard_Configure_Call {
+	return &DataFreshnessGuard_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *DataFreshnessGuard_Configure_Call) Run(run func(config freshness.Config)) *DataFreshnessGuard_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(freshness.Config))
+	})
+	return _c
+}
+
+func (_c *DataFreshnessGuard_Configure_Call) Return(_a0 error) *DataFreshnessGuard_Configure_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataFreshnessGuard_Configure_Call) RunAndReturn(run func(freshness.Config) error) *DataFreshnessGuard_Configure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Wrap provides a mock function with given fields: inner
+func (_m *DataFreshnessGuard) Wrap(inner execution.Executor) execution.Executor {
+	ret := _m.Called(inner)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Wrap")
+	}
+
+	var r0 execution.Executor
+	if rf, ok := ret.Get(0).(func(execution.Executor) execution.Executor); ok {
+		r0 = rf(inner)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(execution.Executor)
+		}
+	}
+
+	return r0
+}
+
+// DataFreshnessGuard_Wrap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Wrap'
+type DataFreshnessGuard_Wrap_Call struct {
+	*mock.Call
+}
+
+// Wrap is a helper method to define mock.On call
+//   - inner execution.Executor
+func (_e *DataFreshnessGuard_Expecter) Wrap(inner interface{}) *DataFreshnessGuard_Wrap_Call {
+	return &DataFreshnessGuard_Wrap_Call{Call: _e.mock.On("Wrap", inner)}
+}
+
+func (_c *DataFreshnessGuard_Wrap_Call) Run(run func(inner execution.Executor)) *DataFreshnessGuard_Wrap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(execution.Executor))
+	})
+	return _c
+}
+
+func (_c *DataFreshnessGuard_Wrap_Call) Return(_a0 execution.Executor) *DataFreshnessGuard_Wrap_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataFreshnessGuard_Wrap_Call) RunAndReturn(run func(execution.Executor) execution.Executor) *DataFreshnessGuard_Wrap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDataFreshnessGuard creates a new instance of DataFreshnessGuard. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDataFreshnessGuard(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DataFreshnessGuard {
+	mock := &DataFreshnessGuard{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
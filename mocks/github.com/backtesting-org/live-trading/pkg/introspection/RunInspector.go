@@ -0,0 +1,175 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package introspection
+
+import (
+	http "net/http"
+
+	introspection "github.com/backtesting-org/live-trading/pkg/introspection"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RunInspector is an autogenerated mock type for the RunInspector type
+type RunInspector struct {
+	mock.Mock
+}
+
+type RunInspector_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RunInspector) EXPECT() *RunInspector_Expecter {
+	return &RunInspector_Expecter{mock: &_m.Mock}
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *RunInspector) Configure(config introspection.Config) {
+	_m.Called(config)
+}
+
+// RunInspector_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type RunInspector_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config introspection.Config
+func (_e *RunInspector_Expecter) Configure(config interface{}) *RunInspector_Configure_Call {
+	return &RunInspector_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *RunInspector_Configure_Call) Run(run func(config introspection.Config)) *RunInspector_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(introspection.Config))
+	})
+	return _c
+}
+
+func (_c *RunInspector_Configure_Call) Return() *RunInspector_Configure_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *RunInspector_Configure_Call) RunAndReturn(run func(introspection.Config)) *RunInspector_Configure_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Handler provides a mock function with no fields
+func (_m *RunInspector) Handler() http.Handler {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handler")
+	}
+
+	var r0 http.Handler
+	if rf, ok := ret.Get(0).(func() http.Handler); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(http.Handler)
+		}
+	}
+
+	return r0
+}
+
+// RunInspector_Handler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handler'
+type RunInspector_Handler_Call struct {
+	*mock.Call
+}
+
+// Handler is a helper method to define mock.On call
+func (_e *RunInspector_Expecter) Handler() *RunInspector_Handler_Call {
+	return &RunInspector_Handler_Call{Call: _e.mock.On("Handler")}
+}
+
+func (_c *RunInspector_Handler_Call) Run(run func()) *RunInspector_Handler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RunInspector_Handler_Call) Return(_a0 http.Handler) *RunInspector_Handler_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RunInspector_Handler_Call) RunAndReturn(run func() http.Handler) *RunInspector_Handler_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Inspect provides a mock function with given fields: runID
+func (_m *RunInspector) Inspect(runID string) (*introspection.Document, error) {
+	ret := _m.Called(runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Inspect")
+	}
+
+	var r0 *introspection.Document
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*introspection.Document, error)); ok {
+		return rf(runID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *introspection.Document); ok {
+		r0 = rf(runID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*introspection.Document)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(runID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RunInspector_Inspect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Inspect'
+type RunInspector_Inspect_Call struct {
+	*mock.Call
+}
+
+// Inspect is a helper method to define mock.On call
+//   - runID string
+func (_e *RunInspector_Expecter) Inspect(runID interface{}) *RunInspector_Inspect_Call {
+	return &RunInspector_Inspect_Call{Call: _e.mock.On("Inspect", runID)}
+}
+
+func (_c *RunInspector_Inspect_Call) Run(run func(runID string)) *RunInspector_Inspect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *RunInspector_Inspect_Call) Return(_a0 *introspection.Document, _a1 error) *RunInspector_Inspect_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RunInspector_Inspect_Call) RunAndReturn(run func(string) (*introspection.Document, error)) *RunInspector_Inspect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRunInspector creates a new instance of RunInspector. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRunInspector(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RunInspector {
+	mock := &RunInspector{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,446 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package margin
+
+import (
+	execution "github.com/backtesting-org/kronos-sdk/pkg/types/execution"
+	margin "github.com/backtesting-org/live-trading/pkg/margin"
+
+	mock "github.com/stretchr/testify/mock"
+
+	numerical "github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+
+	strategy "github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+)
+
+// MarginCalculator is an autogenerated mock type for the MarginCalculator type
+type MarginCalculator struct {
+	mock.Mock
+}
+
+type MarginCalculator_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MarginCalculator) EXPECT() *MarginCalculator_Expecter {
+	return &MarginCalculator_Expecter{mock: &_m.Mock}
+}
+
+// BindStrategy provides a mock function with given fields: runID, name
+func (_m *MarginCalculator) BindStrategy(runID string, name strategy.StrategyName) error {
+	ret := _m.Called(runID, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BindStrategy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, strategy.StrategyName) error); ok {
+		r0 = rf(runID, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarginCalculator_BindStrategy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BindStrategy'
+type MarginCalculator_BindStrategy_Call struct {
+	*mock.Call
+}
+
+// BindStrategy is a helper method to define mock.On call
+//   - runID string
+//   - name strategy.StrategyName
+func (_e *MarginCalculator_Expecter) BindStrategy(runID interface{}, name interface{}) *MarginCalculator_BindStrategy_Call {
+	return &MarginCalculator_BindStrategy_Call{Call: _e.mock.On("BindStrategy", runID, name)}
+}
+
+func (_c *MarginCalculator_BindStrategy_Call) Run(run func(runID string, name strategy.StrategyName)) *MarginCalculator_BindStrategy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(strategy.StrategyName))
+	})
+	return _c
+}
+
+func (_c *MarginCalculator_BindStrategy_Call) Return(_a0 error) *MarginCalculator_BindStrategy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MarginCalculator_BindStrategy_Call) RunAndReturn(run func(string, strategy.StrategyName) error) *MarginCalculator_BindStrategy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Check provides a mock function with given fields: signal
+func (_m *MarginCalculator) Check(signal *strategy.Signal) (*strategy.Signal, error) {
+	ret := _m.Called(signal)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Check")
+	}
+
+	var r0 *strategy.Signal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*strategy.Signal) (*strategy.Signal, error)); ok {
+		return rf(signal)
+	}
+	if rf, ok := ret.Get(0).(func(*strategy.Signal) *strategy.Signal); ok {
+		r0 = rf(signal)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*strategy.Signal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*strategy.Signal) error); ok {
+		r1 = rf(signal)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarginCalculator_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type MarginCalculator_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+//   - signal *strategy.Signal
+func (_e *MarginCalculator_Expecter) Check(signal interface{}) *MarginCalculator_Check_Call {
+	return &MarginCalculator_Check_Call{Call: _e.mock.On("Check", signal)}
+}
+
+func (_c *MarginCalculator_Check_Call) Run(run func(signal *strategy.Signal)) *MarginCalculator_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*strategy.Signal))
+	})
+	return _c
+}
+
+func (_c *MarginCalculator_Check_Call) Return(_a0 *strategy.Signal, _a1 error) *MarginCalculator_Check_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MarginCalculator_Check_Call) RunAndReturn(run func(*strategy.Signal) (*strategy.Signal, error)) *MarginCalculator_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *MarginCalculator) Configure(config margin.Config) error {
+	ret := _m.Called(config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Configure")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(margin.Config) error); ok {
+		r0 = rf(config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarginCalculator_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type MarginCalculator_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config margin.Config
+func (_e *MarginCalculator_Expecter) Configure(config interface{}) *MarginCalculator_Configure_Call {
+	return &MarginCalculator_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *MarginCalculator_Configure_Call) Run(run func(config margin.Config)) *MarginCalculator_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(margin.Config))
+	})
+	return _c
+}
+
+func (_c *MarginCalculator_Configure_Call) Return(_a0 error) *MarginCalculator_Configure_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MarginCalculator_Configure_Call) RunAndReturn(run func(margin.Config) error) *MarginCalculator_Configure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Estimate provides a mock function with given fields: name, action
+func (_m *MarginCalculator) Estimate(name strategy.StrategyName, action strategy.TradeAction) (margin.Estimate, error) {
+	ret := _m.Called(name, action)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Estimate")
+	}
+
+	var r0 margin.Estimate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(strategy.StrategyName, strategy.TradeAction) (margin.Estimate, error)); ok {
+		return rf(name, action)
+	}
+	if rf, ok := ret.Get(0).(func(strategy.StrategyName, strategy.TradeAction) margin.Estimate); ok {
+		r0 = rf(name, action)
+	} else {
+		r0 = ret.Get(0).(margin.Estimate)
+	}
+
+	if rf, ok := ret.Get(1).(func(strategy.StrategyName, strategy.TradeAction) error); ok {
+		r1 = rf(name, action)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarginCalculator_Estimate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Estimate'
+type MarginCalculator_Estimate_Call struct {
+	*mock.Call
+}
+
+// Estimate is a helper method to define mock.On call
+//   - name strategy.StrategyName
+//   - action strategy.TradeAction
+func (_e *MarginCalculator_Expecter) Estimate(name interface{}, action interface{}) *MarginCalculator_Estimate_Call {
+	return &MarginCalculator_Estimate_Call{Call: _e.mock.On("Estimate", name, action)}
+}
+
+func (_c *MarginCalculator_Estimate_Call) Run(run func(name strategy.StrategyName, action strategy.TradeAction)) *MarginCalculator_Estimate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(strategy.StrategyName), args[1].(strategy.TradeAction))
+	})
+	return _c
+}
+
+func (_c *MarginCalculator_Estimate_Call) Return(_a0 margin.Estimate, _a1 error) *MarginCalculator_Estimate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MarginCalculator_Estimate_Call) RunAndReturn(run func(strategy.StrategyName, strategy.TradeAction) (margin.Estimate, error)) *MarginCalculator_Estimate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MaxLeverage provides a mock function with given fields: runID
+func (_m *MarginCalculator) MaxLeverage(runID string) numerical.Decimal {
+	ret := _m.Called(runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MaxLeverage")
+	}
+
+	var r0 numerical.Decimal
+	if rf, ok := ret.Get(0).(func(string) numerical.Decimal); ok {
+		r0 = rf(runID)
+	} else {
+		r0 = ret.Get(0).(numerical.Decimal)
+	}
+
+	return r0
+}
+
+// MarginCalculator_MaxLeverage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MaxLeverage'
+type MarginCalculator_MaxLeverage_Call struct {
+	*mock.Call
+}
+
+// MaxLeverage is a helper method to define mock.On call
+//   - runID string
+func (_e *MarginCalculator_Expecter) MaxLeverage(runID interface{}) *MarginCalculator_MaxLeverage_Call {
+	return &MarginCalculator_MaxLeverage_Call{Call: _e.mock.On("MaxLeverage", runID)}
+}
+
+func (_c *MarginCalculator_MaxLeverage_Call) Run(run func(runID string)) *MarginCalculator_MaxLeverage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MarginCalculator_MaxLeverage_Call) Return(_a0 numerical.Decimal) *MarginCalculator_MaxLeverage_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MarginCalculator_MaxLeverage_Call) RunAndReturn(run func(string) numerical.Decimal) *MarginCalculator_MaxLeverage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunFor provides a mock function with given fields: name
+func (_m *MarginCalculator) RunFor(name strategy.StrategyName) (string, bool) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunFor")
+	}
+
+	var r0 string
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(strategy.StrategyName) (string, bool)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(strategy.StrategyName) string); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(strategy.StrategyName) bool); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// MarginCalculator_RunFor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunFor'
+type MarginCalculator_RunFor_Call struct {
+	*mock.Call
+}
+
+// RunFor is a helper method to define mock.On call
+//   - name strategy.StrategyName
+func (_e *MarginCalculator_Expecter) RunFor(name interface{}) *MarginCalculator_RunFor_Call {
+	return &MarginCalculator_RunFor_Call{Call: _e.mock.On("RunFor", name)}
+}
+
+func (_c *MarginCalculator_RunFor_Call) Run(run func(name strategy.StrategyName)) *MarginCalculator_RunFor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(strategy.StrategyName))
+	})
+	return _c
+}
+
+func (_c *MarginCalculator_RunFor_Call) Return(_a0 string, _a1 bool) *MarginCalculator_RunFor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MarginCalculator_RunFor_Call) RunAndReturn(run func(strategy.StrategyName) (string, bool)) *MarginCalculator_RunFor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetMaxLeverage provides a mock function with given fields: runID, leverage
+func (_m *MarginCalculator) SetMaxLeverage(runID string, leverage numerical.Decimal) error {
+	ret := _m.Called(runID, leverage)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetMaxLeverage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, numerical.Decimal) error); ok {
+		r0 = rf(runID, leverage)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarginCalculator_SetMaxLeverage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetMaxLeverage'
+type MarginCalculator_SetMaxLeverage_Call struct {
+	*mock.Call
+}
+
+// SetMaxLeverage is a helper method to define mock.On call
+//   - runID string
+//   - leverage numerical.Decimal
+func (_e *MarginCalculator_Expecter) SetMaxLeverage(runID interface{}, leverage interface{}) *MarginCalculator_SetMaxLeverage_Call {
+	return &MarginCalculator_SetMaxLeverage_Call{Call: _e.mock.On("SetMaxLeverage", runID, leverage)}
+}
+
+func (_c *MarginCalculator_SetMaxLeverage_Call) Run(run func(runID string, leverage numerical.Decimal)) *MarginCalculator_SetMaxLeverage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(numerical.Decimal))
+	})
+	return _c
+}
+
+func (_c *MarginCalculator_SetMaxLeverage_Call) Return(_a0 error) *MarginCalculator_SetMaxLeverage_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MarginCalculator_SetMaxLeverage_Call) RunAndReturn(run func(string, numerical.Decimal) error) *MarginCalculator_SetMaxLeverage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Wrap provides a mock function with given fields: inner
+func (_m *MarginCalculator) Wrap(inner execution.Executor) execution.Executor {
+	ret := _m.Called(inner)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Wrap")
+	}
+
+	var r0 execution.Executor
+	if rf, ok := ret.Get(0).(func(execution.Executor) execution.Executor); ok {
+		r0 = rf(inner)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(execution.Executor)
+		}
+	}
+
+	return r0
+}
+
+// MarginCalculator_Wrap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Wrap'
+type MarginCalculator_Wrap_Call struct {
+	*mock.Call
+}
+
+// Wrap is a helper method to define mock.On call
+//   - inner execution.Executor
+func (_e *MarginCalculator_Expecter) Wrap(inner interface{}) *MarginCalculator_Wrap_Call {
+	return &MarginCalculator_Wrap_Call{Call: _e.mock.On("Wrap", inner)}
+}
+
+func (_c *MarginCalculator_Wrap_Call) Run(run func(inner execution.Executor)) *MarginCalculator_Wrap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(execution.Executor))
+	})
+	return _c
+}
+
+func (_c *MarginCalculator_Wrap_Call) Return(_a0 execution.Executor) *MarginCalculator_Wrap_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MarginCalculator_Wrap_Call) RunAndReturn(run func(execution.Executor) execution.Executor) *MarginCalculator_Wrap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMarginCalculator creates a new instance of MarginCalculator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMarginCalculator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MarginCalculator {
+	mock := &MarginCalculator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
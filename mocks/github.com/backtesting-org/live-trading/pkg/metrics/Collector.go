@@ -0,0 +1,82 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package metrics
+
+import (
+	metrics "github.com/backtesting-org/live-trading/pkg/metrics"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Collector is an autogenerated mock type for the Collector type
+type Collector struct {
+	mock.Mock
+}
+
+type Collector_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Collector) EXPECT() *Collector_Expecter {
+	return &Collector_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with no fields
+func (_m *Collector) Execute() []metrics.Sample {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 []metrics.Sample
+	if rf, ok := ret.Get(0).(func() []metrics.Sample); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]metrics.Sample)
+		}
+	}
+
+	return r0
+}
+
+// Collector_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type Collector_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+func (_e *Collector_Expecter) Execute() *Collector_Execute_Call {
+	return &Collector_Execute_Call{Call: _e.mock.On("Execute")}
+}
+
+func (_c *Collector_Execute_Call) Run(run func()) *Collector_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Collector_Execute_Call) Return(_a0 []metrics.Sample) *Collector_Execute_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Collector_Execute_Call) RunAndReturn(run func() []metrics.Sample) *Collector_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCollector creates a new instance of Collector. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCollector(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Collector {
+	mock := &Collector{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
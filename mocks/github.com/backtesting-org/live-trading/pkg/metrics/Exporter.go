@@ -0,0 +1,198 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package metrics
+
+import (
+	http "net/http"
+
+	metrics "github.com/backtesting-org/live-trading/pkg/metrics"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Exporter is an autogenerated mock type for the Exporter type
+type Exporter struct {
+	mock.Mock
+}
+
+type Exporter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Exporter) EXPECT() *Exporter_Expecter {
+	return &Exporter_Expecter{mock: &_m.Mock}
+}
+
+// Gather provides a mock function with no fields
+func (_m *Exporter) Gather() []metrics.Sample {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Gather")
+	}
+
+	var r0 []metrics.Sample
+	if rf, ok := ret.Get(0).(func() []metrics.Sample); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]metrics.Sample)
+		}
+	}
+
+	return r0
+}
+
+// Exporter_Gather_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Gather'
+type Exporter_Gather_Call struct {
+	*mock.Call
+}
+
+// Gather is a helper method to define mock.On call
+func (_e *Exporter_Expecter) Gather() *Exporter_Gather_Call {
+	return &Exporter_Gather_Call{Call: _e.mock.On("Gather")}
+}
+
+func (_c *Exporter_Gather_Call) Run(run func()) *Exporter_Gather_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Exporter_Gather_Call) Return(_a0 []metrics.Sample) *Exporter_Gather_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Exporter_Gather_Call) RunAndReturn(run func() []metrics.Sample) *Exporter_Gather_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Handler provides a mock function with no fields
+func (_m *Exporter) Handler() http.Handler {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handler")
+	}
+
+	var r0 http.Handler
+	if rf, ok := ret.Get(0).(func() http.Handler); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(http.Handler)
+		}
+	}
+
+	return r0
+}
+
+// Exporter_Handler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handler'
+type Exporter_Handler_Call struct {
+	*mock.Call
+}
+
+// Handler is a helper method to define mock.On call
+func (_e *Exporter_Expecter) Handler() *Exporter_Handler_Call {
+	return &Exporter_Handler_Call{Call: _e.mock.On("Handler")}
+}
+
+func (_c *Exporter_Handler_Call) Run(run func()) *Exporter_Handler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Exporter_Handler_Call) Return(_a0 http.Handler) *Exporter_Handler_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Exporter_Handler_Call) RunAndReturn(run func() http.Handler) *Exporter_Handler_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RegisterCollector provides a mock function with given fields: name, collector
+func (_m *Exporter) RegisterCollector(name string, collector metrics.Collector) {
+	_m.Called(name, collector)
+}
+
+// Exporter_RegisterCollector_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterCollector'
+type Exporter_RegisterCollector_Call struct {
+	*mock.Call
+}
+
+// RegisterCollector is a helper method to define mock.On call
+//   - name string
+//   - collector metrics.Collector
+func (_e *Exporter_Expecter) RegisterCollector(name interface{}, collector interface{}) *Exporter_RegisterCollector_Call {
+	return &Exporter_RegisterCollector_Call{Call: _e.mock.On("RegisterCollector", name, collector)}
+}
+
+func (_c *Exporter_RegisterCollector_Call) Run(run func(name string, collector metrics.Collector)) *Exporter_RegisterCollector_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(metrics.Collector))
+	})
+	return _c
+}
+
+func (_c *Exporter_RegisterCollector_Call) Return() *Exporter_RegisterCollector_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Exporter_RegisterCollector_Call) RunAndReturn(run func(string, metrics.Collector)) *Exporter_RegisterCollector_Call {
+	_c.Run(run)
+	return _c
+}
+
+// UnregisterCollector provides a mock function with given fields: name
+func (_m *Exporter) UnregisterCollector(name string) {
+	_m.Called(name)
+}
+
+// Exporter_UnregisterCollector_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnregisterCollector'
+type Exporter_UnregisterCollector_Call struct {
+	*mock.Call
+}
+
+// UnregisterCollector is a helper method to define mock.On call
+//   - name string
+func (_e *Exporter_Expecter) UnregisterCollector(name interface{}) *Exporter_UnregisterCollector_Call {
+	return &Exporter_UnregisterCollector_Call{Call: _e.mock.On("UnregisterCollector", name)}
+}
+
+func (_c *Exporter_UnregisterCollector_Call) Run(run func(name string)) *Exporter_UnregisterCollector_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Exporter_UnregisterCollector_Call) Return() *Exporter_UnregisterCollector_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Exporter_UnregisterCollector_Call) RunAndReturn(run func(string)) *Exporter_UnregisterCollector_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewExporter creates a new instance of Exporter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewExporter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Exporter {
+	mock := &Exporter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,581 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package overrides
+
+import (
+	http "net/http"
+
+	overrides "github.com/backtesting-org/live-trading/pkg/overrides"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ParameterOverrides is an autogenerated mock type for the ParameterOverrides type
+type ParameterOverrides struct {
+	mock.Mock
+}
+
+type ParameterOverrides_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ParameterOverrides) EXPECT() *ParameterOverrides_Expecter {
+	return &ParameterOverrides_Expecter{mock: &_m.Mock}
+}
+
+// Active provides a mock function with given fields: runID
+func (_m *ParameterOverrides) Active(runID string) []overrides.Override {
+	ret := _m.Called(runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Active")
+	}
+
+	var r0 []overrides.Override
+	if rf, ok := ret.Get(0).(func(string) []overrides.Override); ok {
+		r0 = rf(runID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]overrides.Override)
+		}
+	}
+
+	return r0
+}
+
+// ParameterOverrides_Active_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Active'
+type ParameterOverrides_Active_Call struct {
+	*mock.Call
+}
+
+// Active is a helper method to define mock.On call
+//   - runID string
+func (_e *ParameterOverrides_Expecter) Active(runID interface{}) *ParameterOverrides_Active_Call {
+	return &ParameterOverrides_Active_Call{Call: _e.mock.On("Active", runID)}
+}
+
+func (_c *ParameterOverrides_Active_Call) Run(run func(runID string)) *ParameterOverrides_Active_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *ParameterOverrides_Active_Call) Return(_a0 []overrides.Override) *ParameterOverrides_Active_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ParameterOverrides_Active_Call) RunAndReturn(run func(string) []overrides.Override) *ParameterOverrides_Active_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Audit provides a mock function with given fields: runID
+func (_m *ParameterOverrides) Audit(runID string) []overrides.AuditEntry {
+	ret := _m.Called(runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Audit")
+	}
+
+	var r0 []overrides.AuditEntry
+	if rf, ok := ret.Get(0).(func(string) []overrides.AuditEntry); ok {
+		r0 = rf(runID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]overrides.AuditEntry)
+		}
+	}
+
+	return r0
+}
+
+// ParameterOverrides_Audit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Audit'
+type ParameterOverrides_Audit_Call struct {
+	*mock.Call
+}
+
+// Audit is a helper method to define mock.On call
+//   - runID string
+func (_e *ParameterOverrides_Expecter) Audit(runID interface{}) *ParameterOverrides_Audit_Call {
+	return &ParameterOverrides_Audit_Call{Call: _e.mock.On("Audit", runID)}
+}
+
+func (_c *ParameterOverrides_Audit_Call) Run(run func(runID string)) *ParameterOverrides_Audit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *ParameterOverrides_Audit_Call) Return(_a0 []overrides.AuditEntry) *ParameterOverrides_Audit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ParameterOverrides_Audit_Call) RunAndReturn(run func(string) []overrides.AuditEntry) *ParameterOverrides_Audit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *ParameterOverrides) Configure(config overrides.Config) error {
+	ret := _m.Called(config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Configure")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(overrides.Config) error); ok {
+		r0 = rf(config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ParameterOverrides_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type ParameterOverrides_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config overrides.Config
+func (_e *ParameterOverrides_Expecter) Configure(config interface{}) *ParameterOverrides_Configure_Call {
+	return &ParameterOverrides_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *ParameterOverrides_Configure_Call) Run(run func(config overrides.Config)) *ParameterOverrides_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(overrides.Config))
+	})
+	return _c
+}
+
+func (_c *ParameterOverrides_Configure_Call) Return(_a0 error) *ParameterOverrides_Configure_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ParameterOverrides_Configure_Call) RunAndReturn(run func(overrides.Config) error) *ParameterOverrides_Configure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Effective provides a mock function with given fields: runID
+func (_m *ParameterOverrides) Effective(runID string) (map[string]string, error) {
+	ret := _m.Called(runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Effective")
+	}
+
+	var r0 map[string]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (map[string]string, error)); ok {
+		return rf(runID)
+	}
+	if rf, ok := ret.Get(0).(func(string) map[string]string); ok {
+		r0 = rf(runID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(runID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ParameterOverrides_Effective_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Effective'
+type ParameterOverrides_Effective_Call struct {
+	*mock.Call
+}
+
+// Effective is a helper method to define mock.On call
+//   - runID string
+func (_e *ParameterOverrides_Expecter) Effective(runID interface{}) *ParameterOverrides_Effective_Call {
+	return &ParameterOverrides_Effective_Call{Call: _e.mock.On("Effective", runID)}
+}
+
+func (_c *ParameterOverrides_Effective_Call) Run(run func(runID string)) *ParameterOverrides_Effective_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *ParameterOverrides_Effective_Call) Return(_a0 map[string]string, _a1 error) *ParameterOverrides_Effective_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ParameterOverrides_Effective_Call) RunAndReturn(run func(string) (map[string]string, error)) *ParameterOverrides_Effective_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Expire provides a mock function with no fields
+func (_m *ParameterOverrides) Expire() int {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Expire")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// ParameterOverrides_Expire_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Expire'
+type ParameterOverrides_Expire_Call struct {
+	*mock.Call
+}
+
+// Expire is a helper method to define mock.On call
+func (_e *ParameterOverrides_Expecter) Expire() *ParameterOverrides_Expire_Call {
+	return &ParameterOverrides_Expire_Call{Call: _e.mock.On("Expire")}
+}
+
+func (_c *ParameterOverrides_Expire_Call) Run(run func()) *ParameterOverrides_Expire_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ParameterOverrides_Expire_Call) Return(_a0 int) *ParameterOverrides_Expire_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ParameterOverrides_Expire_Call) RunAndReturn(run func() int) *ParameterOverrides_Expire_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Handler provides a mock function with no fields
+func (_m *ParameterOverrides) Handler() http.Handler {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handler")
+	}
+
+	var r0 http.Handler
+	if rf, ok := ret.Get(0).(func() http.Handler); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(http.Handler)
+		}
+	}
+
+	return r0
+}
+
+// ParameterOverrides_Handler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handler'
+type ParameterOverrides_Handler_Call struct {
+	*mock.Call
+}
+
+// Handler is a helper method to define mock.On call
+func (_e *ParameterOverrides_Expecter) Handler() *ParameterOverrides_Handler_Call {
+	return &ParameterOverrides_Handler_Call{Call: _e.mock.On("Handler")}
+}
+
+func (_c *ParameterOverrides_Handler_Call) Run(run func()) *ParameterOverrides_Handler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ParameterOverrides_Handler_Call) Return(_a0 http.Handler) *ParameterOverrides_Handler_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ParameterOverrides_Handler_Call) RunAndReturn(run func() http.Handler) *ParameterOverrides_Handler_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Revert provides a mock function with given fields: runID, id, actor
+func (_m *ParameterOverrides) Revert(runID string, id string, actor string) error {
+	ret := _m.Called(runID, id, actor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Revert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = rf(runID, id, actor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ParameterOverrides_Revert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Revert'
+type ParameterOverrides_Revert_Call struct {
+	*mock.Call
+}
+
+// Revert is a helper method to define mock.On call
+//   - runID string
+//   - id string
+//   - actor string
+func (_e *ParameterOverrides_Expecter) Revert(runID interface{}, id interface{}, actor interface{}) *ParameterOverrides_Revert_Call {
+	return &ParameterOverrides_Revert_Call{Call: _e.mock.On("Revert", runID, id, actor)}
+}
+
+func (_c *ParameterOverrides_Revert_Call) Run(run func(runID string, id string, actor string)) *ParameterOverrides_Revert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ParameterOverrides_Revert_Call) Return(_a0 error) *ParameterOverrides_Revert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ParameterOverrides_Revert_Call) RunAndReturn(run func(string, string, string) error) *ParameterOverrides_Revert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Set provides a mock function with given fields: runID, request
+func (_m *ParameterOverrides) Set(runID string, request overrides.Request) (overrides.Override, error) {
+	ret := _m.Called(runID, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Set")
+	}
+
+	var r0 overrides.Override
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, overrides.Request) (overrides.Override, error)); ok {
+		return rf(runID, request)
+	}
+	if rf, ok := ret.Get(0).(func(string, overrides.Request) overrides.Override); ok {
+		r0 = rf(runID, request)
+	} else {
+		r0 = ret.Get(0).(overrides.Override)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, overrides.Request) error); ok {
+		r1 = rf(runID, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ParameterOverrides_Set_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Set'
+type ParameterOverrides_Set_Call struct {
+	*mock.Call
+}
+
+// Set is a helper method to define mock.On call
+//   - runID string
+//   - request overrides.Request
+func (_e *ParameterOverrides_Expecter) Set(runID interface{}, request interface{}) *ParameterOverrides_Set_Call {
+	return &ParameterOverrides_Set_Call{Call: _e.mock.On("Set", runID, request)}
+}
+
+func (_c *ParameterOverrides_Set_Call) Run(run func(runID string, request overrides.Request)) *ParameterOverrides_Set_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(overrides.Request))
+	})
+	return _c
+}
+
+func (_c *ParameterOverrides_Set_Call) Return(_a0 overrides.Override, _a1 error) *ParameterOverrides_Set_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ParameterOverrides_Set_Call) RunAndReturn(run func(string, overrides.Request) (overrides.Override, error)) *ParameterOverrides_Set_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with no fields
+func (_m *ParameterOverrides) Start() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ParameterOverrides_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type ParameterOverrides_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+func (_e *ParameterOverrides_Expecter) Start() *ParameterOverrides_Start_Call {
+	return &ParameterOverrides_Start_Call{Call: _e.mock.On("Start")}
+}
+
+func (_c *ParameterOverrides_Start_Call) Run(run func()) *ParameterOverrides_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ParameterOverrides_Start_Call) Return(_a0 error) *ParameterOverrides_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ParameterOverrides_Start_Call) RunAndReturn(run func() error) *ParameterOverrides_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with no fields
+func (_m *ParameterOverrides) Stop() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ParameterOverrides_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type ParameterOverrides_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *ParameterOverrides_Expecter) Stop() *ParameterOverrides_Stop_Call {
+	return &ParameterOverrides_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *ParameterOverrides_Stop_Call) Run(run func()) *ParameterOverrides_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ParameterOverrides_Stop_Call) Return(_a0 error) *ParameterOverrides_Stop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ParameterOverrides_Stop_Call) RunAndReturn(run func() error) *ParameterOverrides_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Value provides a mock function with given fields: runID, parameter
+func (_m *ParameterOverrides) Value(runID string, parameter string) (string, bool) {
+	ret := _m.Called(runID, parameter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Value")
+	}
+
+	var r0 string
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string, string) (string, bool)); ok {
+		return rf(runID, parameter)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = rf(runID, parameter)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) bool); ok {
+		r1 = rf(runID, parameter)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// ParameterOverrides_Value_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Value'
+type ParameterOverrides_Value_Call struct {
+	*mock.Call
+}
+
+// Value is a helper method to define mock.On call
+//   - runID string
+//   - parameter string
+func (_e *ParameterOverrides_Expecter) Value(runID interface{}, parameter interface{}) *ParameterOverrides_Value_Call {
+	return &ParameterOverrides_Value_Call{Call: _e.mock.On("Value", runID, parameter)}
+}
+
+func (_c *ParameterOverrides_Value_Call) Run(run func(runID string, parameter string)) *ParameterOverrides_Value_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ParameterOverrides_Value_Call) Return(_a0 string, _a1 bool) *ParameterOverrides_Value_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ParameterOverrides_Value_Call) RunAndReturn(run func(string, string) (string, bool)) *ParameterOverrides_Value_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewParameterOverrides creates a new instance of ParameterOverrides. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewParameterOverrides(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ParameterOverrides {
+	mock := &ParameterOverrides{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
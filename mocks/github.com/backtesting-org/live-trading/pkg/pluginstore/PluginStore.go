@@ -0,0 +1,285 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package pluginstore
+
+import (
+	io "io"
+	http "net/http"
+
+	mock "github.com/stretchr/testify/mock"
+
+	pluginstore "github.com/backtesting-org/live-trading/pkg/pluginstore"
+)
+
+// PluginStore is an autogenerated mock type for the PluginStore type
+type PluginStore struct {
+	mock.Mock
+}
+
+type PluginStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PluginStore) EXPECT() *PluginStore_Expecter {
+	return &PluginStore_Expecter{mock: &_m.Mock}
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *PluginStore) Configure(config pluginstore.Config) error {
+	ret := _m.Called(config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Configure")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(pluginstore.Config) error); ok {
+		r0 = rf(config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PluginStore_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type PluginStore_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config pluginstore.Config
+func (_e *PluginStore_Expecter) Configure(config interface{}) *PluginStore_Configure_Call {
+	return &PluginStore_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *PluginStore_Configure_Call) Run(run func(config pluginstore.Config)) *PluginStore_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(pluginstore.Config))
+	})
+	return _c
+}
+
+func (_c *PluginStore_Configure_Call) Return(_a0 error) *PluginStore_Configure_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PluginStore_Configure_Call) RunAndReturn(run func(pluginstore.Config) error) *PluginStore_Configure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: name
+func (_m *PluginStore) Delete(name string) error {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PluginStore_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type PluginStore_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - name string
+func (_e *PluginStore_Expecter) Delete(name interface{}) *PluginStore_Delete_Call {
+	return &PluginStore_Delete_Call{Call: _e.mock.On("Delete", name)}
+}
+
+func (_c *PluginStore_Delete_Call) Run(run func(name string)) *PluginStore_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *PluginStore_Delete_Call) Return(_a0 error) *PluginStore_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PluginStore_Delete_Call) RunAndReturn(run func(string) error) *PluginStore_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Handler provides a mock function with no fields
+func (_m *PluginStore) Handler() http.Handler {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handler")
+	}
+
+	var r0 http.Handler
+	if rf, ok := ret.Get(0).(func() http.Handler); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(http.Handler)
+		}
+	}
+
+	return r0
+}
+
+// PluginStore_Handler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handler'
+type PluginStore_Handler_Call struct {
+	*mock.Call
+}
+
+// Handler is a helper method to define mock.On call
+func (_e *PluginStore_Expecter) Handler() *PluginStore_Handler_Call {
+	return &PluginStore_Handler_Call{Call: _e.mock.On("Handler")}
+}
+
+func (_c *PluginStore_Handler_Call) Run(run func()) *PluginStore_Handler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PluginStore_Handler_Call) Return(_a0 http.Handler) *PluginStore_Handler_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PluginStore_Handler_Call) RunAndReturn(run func() http.Handler) *PluginStore_Handler_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Plugins provides a mock function with no fields
+func (_m *PluginStore) Plugins() []pluginstore.Plugin {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Plugins")
+	}
+
+	var r0 []pluginstore.Plugin
+	if rf, ok := ret.Get(0).(func() []pluginstore.Plugin); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pluginstore.Plugin)
+		}
+	}
+
+	return r0
+}
+
+// PluginStore_Plugins_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Plugins'
+type PluginStore_Plugins_Call struct {
+	*mock.Call
+}
+
+// Plugins is a helper method to define mock.On call
+func (_e *PluginStore_Expecter) Plugins() *PluginStore_Plugins_Call {
+	return &PluginStore_Plugins_Call{Call: _e.mock.On("Plugins")}
+}
+
+func (_c *PluginStore_Plugins_Call) Run(run func()) *PluginStore_Plugins_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PluginStore_Plugins_Call) Return(_a0 []pluginstore.Plugin) *PluginStore_Plugins_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PluginStore_Plugins_Call) RunAndReturn(run func() []pluginstore.Plugin) *PluginStore_Plugins_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Upload provides a mock function with given fields: name, kind, content
+func (_m *PluginStore) Upload(name string, kind pluginstore.Kind, content io.Reader) (*pluginstore.Plugin, error) {
+	ret := _m.Called(name, kind, content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upload")
+	}
+
+	var r0 *pluginstore.Plugin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, pluginstore.Kind, io.Reader) (*pluginstore.Plugin, error)); ok {
+		return rf(name, kind, content)
+	}
+	if rf, ok := ret.Get(0).(func(string, pluginstore.Kind, io.Reader) *pluginstore.Plugin); ok {
+		r0 = rf(name, kind, content)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pluginstore.Plugin)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, pluginstore.Kind, io.Reader) error); ok {
+		r1 = rf(name, kind, content)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PluginStore_Upload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upload'
+type PluginStore_Upload_Call struct {
+	*mock.Call
+}
+
+// Upload is a helper method to define mock.On call
+//   - name string
+//   - kind pluginstore.Kind
+//   - content io.Reader
+func (_e *PluginStore_Expecter) Upload(name interface{}, kind interface{}, content interface{}) *PluginStore_Upload_Call {
+	return &PluginStore_Upload_Call{Call: _e.mock.On("Upload", name, kind, content)}
+}
+
+func (_c *PluginStore_Upload_Call) Run(run func(name string, kind pluginstore.Kind, content io.Reader)) *PluginStore_Upload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(pluginstore.Kind), args[2].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *PluginStore_Upload_Call) Return(_a0 *pluginstore.Plugin, _a1 error) *PluginStore_Upload_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PluginStore_Upload_Call) RunAndReturn(run func(string, pluginstore.Kind, io.Reader) (*pluginstore.Plugin, error)) *PluginStore_Upload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPluginStore creates a new instance of PluginStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPluginStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PluginStore {
+	mock := &PluginStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
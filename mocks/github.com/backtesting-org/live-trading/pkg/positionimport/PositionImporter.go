@@ -0,0 +1,247 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package positionimport
+
+import (
+	http "net/http"
+
+	positionimport "github.com/backtesting-org/live-trading/pkg/positionimport"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PositionImporter is an autogenerated mock type for the PositionImporter type
+type PositionImporter struct {
+	mock.Mock
+}
+
+type PositionImporter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PositionImporter) EXPECT() *PositionImporter_Expecter {
+	return &PositionImporter_Expecter{mock: &_m.Mock}
+}
+
+// Candidates provides a mock function with no fields
+func (_m *PositionImporter) Candidates() ([]positionimport.Candidate, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Candidates")
+	}
+
+	var r0 []positionimport.Candidate
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]positionimport.Candidate, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []positionimport.Candidate); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]positionimport.Candidate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PositionImporter_Candidates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Candidates'
+type PositionImporter_Candidates_Call struct {
+	*mock.Call
+}
+
+// Candidates is a helper method to define mock.On call
+func (_e *PositionImporter_Expecter) Candidates() *PositionImporter_Candidates_Call {
+	return &PositionImporter_Candidates_Call{Call: _e.mock.On("Candidates")}
+}
+
+func (_c *PositionImporter_Candidates_Call) Run(run func()) *PositionImporter_Candidates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PositionImporter_Candidates_Call) Return(_a0 []positionimport.Candidate, _a1 error) *PositionImporter_Candidates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PositionImporter_Candidates_Call) RunAndReturn(run func() ([]positionimport.Candidate, error)) *PositionImporter_Candidates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Handler provides a mock function with no fields
+func (_m *PositionImporter) Handler() http.Handler {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handler")
+	}
+
+	var r0 http.Handler
+	if rf, ok := ret.Get(0).(func() http.Handler); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(http.Handler)
+		}
+	}
+
+	return r0
+}
+
+// PositionImporter_Handler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handler'
+type PositionImporter_Handler_Call struct {
+	*mock.Call
+}
+
+// Handler is a helper method to define mock.On call
+func (_e *PositionImporter_Expecter) Handler() *PositionImporter_Handler_Call {
+	return &PositionImporter_Handler_Call{Call: _e.mock.On("Handler")}
+}
+
+func (_c *PositionImporter_Handler_Call) Run(run func()) *PositionImporter_Handler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PositionImporter_Handler_Call) Return(_a0 http.Handler) *PositionImporter_Handler_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PositionImporter_Handler_Call) RunAndReturn(run func() http.Handler) *PositionImporter_Handler_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Import provides a mock function with given fields: request
+func (_m *PositionImporter) Import(request positionimport.Request) ([]positionimport.Import, error) {
+	ret := _m.Called(request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Import")
+	}
+
+	var r0 []positionimport.Import
+	var r1 error
+	if rf, ok := ret.Get(0).(func(positionimport.Request) ([]positionimport.Import, error)); ok {
+		return rf(request)
+	}
+	if rf, ok := ret.Get(0).(func(positionimport.Request) []positionimport.Import); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]positionimport.Import)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(positionimport.Request) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PositionImporter_Import_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Import'
+type PositionImporter_Import_Call struct {
+	*mock.Call
+}
+
+// Import is a helper method to define mock.On call
+//   - request positionimport.Request
+func (_e *PositionImporter_Expecter) Import(request interface{}) *PositionImporter_Import_Call {
+	return &PositionImporter_Import_Call{Call: _e.mock.On("Import", request)}
+}
+
+func (_c *PositionImporter_Import_Call) Run(run func(request positionimport.Request)) *PositionImporter_Import_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(positionimport.Request))
+	})
+	return _c
+}
+
+func (_c *PositionImporter_Import_Call) Return(_a0 []positionimport.Import, _a1 error) *PositionImporter_Import_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PositionImporter_Import_Call) RunAndReturn(run func(positionimport.Request) ([]positionimport.Import, error)) *PositionImporter_Import_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Imports provides a mock function with given fields: runID
+func (_m *PositionImporter) Imports(runID string) []positionimport.Import {
+	ret := _m.Called(runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Imports")
+	}
+
+	var r0 []positionimport.Import
+	if rf, ok := ret.Get(0).(func(string) []positionimport.Import); ok {
+		r0 = rf(runID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]positionimport.Import)
+		}
+	}
+
+	return r0
+}
+
+// PositionImporter_Imports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Imports'
+type PositionImporter_Imports_Call struct {
+	*mock.Call
+}
+
+// Imports is a helper method to define mock.On call
+//   - runID string
+func (_e *PositionImporter_Expecter) Imports(runID interface{}) *PositionImporter_Imports_Call {
+	return &PositionImporter_Imports_Call{Call: _e.mock.On("Imports", runID)}
+}
+
+func (_c *PositionImporter_Imports_Call) Run(run func(runID string)) *PositionImporter_Imports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *PositionImporter_Imports_Call) Return(_a0 []positionimport.Import) *PositionImporter_Imports_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PositionImporter_Imports_Call) RunAndReturn(run func(string) []positionimport.Import) *PositionImporter_Imports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPositionImporter creates a new instance of PositionImporter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPositionImporter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PositionImporter {
+	mock := &PositionImporter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
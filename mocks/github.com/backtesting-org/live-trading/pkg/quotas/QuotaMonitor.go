@@ -0,0 +1,391 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package quotas
+
+import (
+	strategy "github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+	quotas "github.com/backtesting-org/live-trading/pkg/quotas"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// QuotaMonitor is an autogenerated mock type for the QuotaMonitor type
+type QuotaMonitor struct {
+	mock.Mock
+}
+
+type QuotaMonitor_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *QuotaMonitor) EXPECT() *QuotaMonitor_Expecter {
+	return &QuotaMonitor_Expecter{mock: &_m.Mock}
+}
+
+// Check provides a mock function with no fields
+func (_m *QuotaMonitor) Check() []quotas.Violation {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Check")
+	}
+
+	var r0 []quotas.Violation
+	if rf, ok := ret.Get(0).(func() []quotas.Violation); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]quotas.Violation)
+		}
+	}
+
+	return r0
+}
+
+// QuotaMonitor_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type QuotaMonitor_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+func (_e *QuotaMonitor_Expecter) Check() *QuotaMonitor_Check_Call {
+	return &QuotaMonitor_Check_Call{Call: _e.mock.On("Check")}
+}
+
+func (_c *QuotaMonitor_Check_Call) Run(run func()) *QuotaMonitor_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *QuotaMonitor_Check_Call) Return(_a0 []quotas.Violation) *QuotaMonitor_Check_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *QuotaMonitor_Check_Call) RunAndReturn(run func() []quotas.Violation) *QuotaMonitor_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *QuotaMonitor) Configure(config quotas.Config) {
+	_m.Called(config)
+}
+
+// QuotaMonitor_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type QuotaMonitor_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config quotas.Config
+func (_e *QuotaMonitor_Expecter) Configure(config interface{}) *QuotaMonitor_Configure_Call {
+	return &QuotaMonitor_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *QuotaMonitor_Configure_Call) Run(run func(config quotas.Config)) *QuotaMonitor_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(quotas.Config))
+	})
+	return _c
+}
+
+func (_c *QuotaMonitor_Configure_Call) Return() *QuotaMonitor_Configure_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *QuotaMonitor_Configure_Call) RunAndReturn(run func(quotas.Config)) *QuotaMonitor_Configure_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Resume provides a mock function with given fields: name
+func (_m *QuotaMonitor) Resume(name strategy.StrategyName) error {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Resume")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(strategy.StrategyName) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// QuotaMonitor_Resume_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resume'
+type QuotaMonitor_Resume_Call struct {
+	*mock.Call
+}
+
+// Resume is a helper method to define mock.On call
+//   - name strategy.StrategyName
+func (_e *QuotaMonitor_Expecter) Resume(name interface{}) *QuotaMonitor_Resume_Call {
+	return &QuotaMonitor_Resume_Call{Call: _e.mock.On("Resume", name)}
+}
+
+func (_c *QuotaMonitor_Resume_Call) Run(run func(name strategy.StrategyName)) *QuotaMonitor_Resume_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(strategy.StrategyName))
+	})
+	return _c
+}
+
+func (_c *QuotaMonitor_Resume_Call) Return(_a0 error) *QuotaMonitor_Resume_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *QuotaMonitor_Resume_Call) RunAndReturn(run func(strategy.StrategyName) error) *QuotaMonitor_Resume_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with no fields
+func (_m *QuotaMonitor) Start() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// QuotaMonitor_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type QuotaMonitor_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+func (_e *QuotaMonitor_Expecter) Start() *QuotaMonitor_Start_Call {
+	return &QuotaMonitor_Start_Call{Call: _e.mock.On("Start")}
+}
+
+func (_c *QuotaMonitor_Start_Call) Run(run func()) *QuotaMonitor_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *QuotaMonitor_Start_Call) Return(_a0 error) *QuotaMonitor_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *QuotaMonitor_Start_Call) RunAndReturn(run func() error) *QuotaMonitor_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with no fields
+func (_m *QuotaMonitor) Stop() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// QuotaMonitor_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type QuotaMonitor_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *QuotaMonitor_Expecter) Stop() *QuotaMonitor_Stop_Call {
+	return &QuotaMonitor_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *QuotaMonitor_Stop_Call) Run(run func()) *QuotaMonitor_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *QuotaMonitor_Stop_Call) Return(_a0 error) *QuotaMonitor_Stop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *QuotaMonitor_Stop_Call) RunAndReturn(run func() error) *QuotaMonitor_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Suspended provides a mock function with no fields
+func (_m *QuotaMonitor) Suspended() []strategy.StrategyName {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Suspended")
+	}
+
+	var r0 []strategy.StrategyName
+	if rf, ok := ret.Get(0).(func() []strategy.StrategyName); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]strategy.StrategyName)
+		}
+	}
+
+	return r0
+}
+
+// QuotaMonitor_Suspended_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Suspended'
+type QuotaMonitor_Suspended_Call struct {
+	*mock.Call
+}
+
+// Suspended is a helper method to define mock.On call
+func (_e *QuotaMonitor_Expecter) Suspended() *QuotaMonitor_Suspended_Call {
+	return &QuotaMonitor_Suspended_Call{Call: _e.mock.On("Suspended")}
+}
+
+func (_c *QuotaMonitor_Suspended_Call) Run(run func()) *QuotaMonitor_Suspended_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *QuotaMonitor_Suspended_Call) Return(_a0 []strategy.StrategyName) *QuotaMonitor_Suspended_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *QuotaMonitor_Suspended_Call) RunAndReturn(run func() []strategy.StrategyName) *QuotaMonitor_Suspended_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Usage provides a mock function with no fields
+func (_m *QuotaMonitor) Usage() quotas.Usage {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Usage")
+	}
+
+	var r0 quotas.Usage
+	if rf, ok := ret.Get(0).(func() quotas.Usage); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(quotas.Usage)
+	}
+
+	return r0
+}
+
+// QuotaMonitor_Usage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Usage'
+type QuotaMonitor_Usage_Call struct {
+	*mock.Call
+}
+
+// Usage is a helper method to define mock.On call
+func (_e *QuotaMonitor_Expecter) Usage() *QuotaMonitor_Usage_Call {
+	return &QuotaMonitor_Usage_Call{Call: _e.mock.On("Usage")}
+}
+
+func (_c *QuotaMonitor_Usage_Call) Run(run func()) *QuotaMonitor_Usage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *QuotaMonitor_Usage_Call) Return(_a0 quotas.Usage) *QuotaMonitor_Usage_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *QuotaMonitor_Usage_Call) RunAndReturn(run func() quotas.Usage) *QuotaMonitor_Usage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Violations provides a mock function with no fields
+func (_m *QuotaMonitor) Violations() <-chan quotas.Violation {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Violations")
+	}
+
+	var r0 <-chan quotas.Violation
+	if rf, ok := ret.Get(0).(func() <-chan quotas.Violation); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan quotas.Violation)
+		}
+	}
+
+	return r0
+}
+
+// QuotaMonitor_Violations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Violations'
+type QuotaMonitor_Violations_Call struct {
+	*mock.Call
+}
+
+// Violations is a helper method to define mock.On call
+func (_e *QuotaMonitor_Expecter) Violations() *QuotaMonitor_Violations_Call {
+	return &QuotaMonitor_Violations_Call{Call: _e.mock.On("Violations")}
+}
+
+func (_c *QuotaMonitor_Violations_Call) Run(run func()) *QuotaMonitor_Violations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *QuotaMonitor_Violations_Call) Return(_a0 <-chan quotas.Violation) *QuotaMonitor_Violations_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *QuotaMonitor_Violations_Call) RunAndReturn(run func() <-chan quotas.Violation) *QuotaMonitor_Violations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewQuotaMonitor creates a new instance of QuotaMonitor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewQuotaMonitor(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *QuotaMonitor {
+	mock := &QuotaMonitor{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,655 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package riskprofiles
+
+import (
+	http "net/http"
+
+	execution "github.com/backtesting-org/kronos-sdk/pkg/types/execution"
+
+	mock "github.com/stretchr/testify/mock"
+
+	riskprofiles "github.com/backtesting-org/live-trading/pkg/riskprofiles"
+
+	strategy "github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+)
+
+// RiskProfiles is an autogenerated mock type for the RiskProfiles type
+type RiskProfiles struct {
+	mock.Mock
+}
+
+type RiskProfiles_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RiskProfiles) EXPECT() *RiskProfiles_Expecter {
+	return &RiskProfiles_Expecter{mock: &_m.Mock}
+}
+
+// Apply provides a mock function with given fields: runID, pluginID, strategies
+func (_m *RiskProfiles) Apply(runID string, pluginID string, strategies ...strategy.StrategyName) (riskprofiles.Assignment, error) {
+	_va := make([]interface{}, len(strategies))
+	for _i := range strategies {
+		_va[_i] = strategies[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, runID, pluginID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Apply")
+	}
+
+	var r0 riskprofiles.Assignment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, ...strategy.StrategyName) (riskprofiles.Assignment, error)); ok {
+		return rf(runID, pluginID, strategies...)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, ...strategy.StrategyName) riskprofiles.Assignment); ok {
+		r0 = rf(runID, pluginID, strategies...)
+	} else {
+		r0 = ret.Get(0).(riskprofiles.Assignment)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, ...strategy.StrategyName) error); ok {
+		r1 = rf(runID, pluginID, strategies...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RiskProfiles_Apply_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Apply'
+type RiskProfiles_Apply_Call struct {
+	*mock.Call
+}
+
+// Apply is a helper method to define mock.On call
+//   - runID string
+//   - pluginID string
+//   - strategies ...strategy.StrategyName
+func (_e *RiskProfiles_Expecter) Apply(runID interface{}, pluginID interface{}, strategies ...interface{}) *RiskProfiles_Apply_Call {
+	return &RiskProfiles_Apply_Call{Call: _e.mock.On("Apply",
+		append([]interface{}{runID, pluginID}, strategies...)...)}
+}
+
+func (_c *RiskProfiles_Apply_Call) Run(run func(runID string, pluginID string, strategies ...strategy.StrategyName)) *RiskProfiles_Apply_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]strategy.StrategyName, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(strategy.StrategyName)
+			}
+		}
+		run(args[0].(string), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *RiskProfiles_Apply_Call) Return(_a0 riskprofiles.Assignment, _a1 error) *RiskProfiles_Apply_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RiskProfiles_Apply_Call) RunAndReturn(run func(string, string, ...strategy.StrategyName) (riskprofiles.Assignment, error)) *RiskProfiles_Apply_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Assignment provides a mock function with given fields: runID
+func (_m *RiskProfiles) Assignment(runID string) (riskprofiles.Assignment, bool) {
+	ret := _m.Called(runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Assignment")
+	}
+
+	var r0 riskprofiles.Assignment
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string) (riskprofiles.Assignment, bool)); ok {
+		return rf(runID)
+	}
+	if rf, ok := ret.Get(0).(func(string) riskprofiles.Assignment); ok {
+		r0 = rf(runID)
+	} else {
+		r0 = ret.Get(0).(riskprofiles.Assignment)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(runID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// RiskProfiles_Assignment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Assignment'
+type RiskProfiles_Assignment_Call struct {
+	*mock.Call
+}
+
+// Assignment is a helper method to define mock.On call
+//   - runID string
+func (_e *RiskProfiles_Expecter) Assignment(runID interface{}) *RiskProfiles_Assignment_Call {
+	return &RiskProfiles_Assignment_Call{Call: _e.mock.On("Assignment", runID)}
+}
+
+func (_c *RiskProfiles_Assignment_Call) Run(run func(runID string)) *RiskProfiles_Assignment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *RiskProfiles_Assignment_Call) Return(_a0 riskprofiles.Assignment, _a1 bool) *RiskProfiles_Assignment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RiskProfiles_Assignment_Call) RunAndReturn(run func(string) (riskprofiles.Assignment, bool)) *RiskProfiles_Assignment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Assignments provides a mock function with no fields
+func (_m *RiskProfiles) Assignments() []riskprofiles.Assignment {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Assignments")
+	}
+
+	var r0 []riskprofiles.Assignment
+	if rf, ok := ret.Get(0).(func() []riskprofiles.Assignment); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]riskprofiles.Assignment)
+		}
+	}
+
+	return r0
+}
+
+// RiskProfiles_Assignments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Assignments'
+type RiskProfiles_Assignments_Call struct {
+	*mock.Call
+}
+
+// Assignments is a helper method to define mock.On call
+func (_e *RiskProfiles_Expecter) Assignments() *RiskProfiles_Assignments_Call {
+	return &RiskProfiles_Assignments_Call{Call: _e.mock.On("Assignments")}
+}
+
+func (_c *RiskProfiles_Assignments_Call) Run(run func()) *RiskProfiles_Assignments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RiskProfiles_Assignments_Call) Return(_a0 []riskprofiles.Assignment) *RiskProfiles_Assignments_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RiskProfiles_Assignments_Call) RunAndReturn(run func() []riskprofiles.Assignment) *RiskProfiles_Assignments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Check provides a mock function with given fields: signal
+func (_m *RiskProfiles) Check(signal *strategy.Signal) error {
+	ret := _m.Called(signal)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Check")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*strategy.Signal) error); ok {
+		r0 = rf(signal)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RiskProfiles_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type RiskProfiles_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+//   - signal *strategy.Signal
+func (_e *RiskProfiles_Expecter) Check(signal interface{}) *RiskProfiles_Check_Call {
+	return &RiskProfiles_Check_Call{Call: _e.mock.On("Check", signal)}
+}
+
+func (_c *RiskProfiles_Check_Call) Run(run func(signal *strategy.Signal)) *RiskProfiles_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*strategy.Signal))
+	})
+	return _c
+}
+
+func (_c *RiskProfiles_Check_Call) Return(_a0 error) *RiskProfiles_Check_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RiskProfiles_Check_Call) RunAndReturn(run func(*strategy.Signal) error) *RiskProfiles_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *RiskProfiles) Configure(config riskprofiles.Config) error {
+	ret := _m.Called(config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Configure")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(riskprofiles.Config) error); ok {
+		r0 = rf(config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RiskProfiles_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type RiskProfiles_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config riskprofiles.Config
+func (_e *RiskProfiles_Expecter) Configure(config interface{}) *RiskProfiles_Configure_Call {
+	return &RiskProfiles_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *RiskProfiles_Configure_Call) Run(run func(config riskprofiles.Config)) *RiskProfiles_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(riskprofiles.Config))
+	})
+	return _c
+}
+
+func (_c *RiskProfiles_Configure_Call) Return(_a0 error) *RiskProfiles_Configure_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RiskProfiles_Configure_Call) RunAndReturn(run func(riskprofiles.Config) error) *RiskProfiles_Configure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteProfile provides a mock function with given fields: key
+func (_m *RiskProfiles) DeleteProfile(key string) error {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteProfile")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RiskProfiles_DeleteProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteProfile'
+type RiskProfiles_DeleteProfile_Call struct {
+	*mock.Call
+}
+
+// DeleteProfile is a helper method to define mock.On call
+//   - key string
+func (_e *RiskProfiles_Expecter) DeleteProfile(key interface{}) *RiskProfiles_DeleteProfile_Call {
+	return &RiskProfiles_DeleteProfile_Call{Call: _e.mock.On("DeleteProfile", key)}
+}
+
+func (_c *RiskProfiles_DeleteProfile_Call) Run(run func(key string)) *RiskProfiles_DeleteProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *RiskProfiles_DeleteProfile_Call) Return(_a0 error) *RiskProfiles_DeleteProfile_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RiskProfiles_DeleteProfile_Call) RunAndReturn(run func(string) error) *RiskProfiles_DeleteProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Handler provides a mock function with no fields
+func (_m *RiskProfiles) Handler() http.Handler {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handler")
+	}
+
+	var r0 http.Handler
+	if rf, ok := ret.Get(0).(func() http.Handler); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(http.Handler)
+		}
+	}
+
+	return r0
+}
+
+// RiskProfiles_Handler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handler'
+type RiskProfiles_Handler_Call struct {
+	*mock.Call
+}
+
+// Handler is a helper method to define mock.On call
+func (_e *RiskProfiles_Expecter) Handler() *RiskProfiles_Handler_Call {
+	return &RiskProfiles_Handler_Call{Call: _e.mock.On("Handler")}
+}
+
+func (_c *RiskProfiles_Handler_Call) Run(run func()) *RiskProfiles_Handler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RiskProfiles_Handler_Call) Return(_a0 http.Handler) *RiskProfiles_Handler_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RiskProfiles_Handler_Call) RunAndReturn(run func() http.Handler) *RiskProfiles_Handler_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Profile provides a mock function with given fields: key
+func (_m *RiskProfiles) Profile(key string) (riskprofiles.Profile, bool) {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Profile")
+	}
+
+	var r0 riskprofiles.Profile
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string) (riskprofiles.Profile, bool)); ok {
+		return rf(key)
+	}
+	if rf, ok := ret.Get(0).(func(string) riskprofiles.Profile); ok {
+		r0 = rf(key)
+	} else {
+		r0 = ret.Get(0).(riskprofiles.Profile)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(key)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// RiskProfiles_Profile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Profile'
+type RiskProfiles_Profile_Call struct {
+	*mock.Call
+}
+
+// Profile is a helper method to define mock.On call
+//   - key string
+func (_e *RiskProfiles_Expecter) Profile(key interface{}) *RiskProfiles_Profile_Call {
+	return &RiskProfiles_Profile_Call{Call: _e.mock.On("Profile", key)}
+}
+
+func (_c *RiskProfiles_Profile_Call) Run(run func(key string)) *RiskProfiles_Profile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *RiskProfiles_Profile_Call) Return(_a0 riskprofiles.Profile, _a1 bool) *RiskProfiles_Profile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RiskProfiles_Profile_Call) RunAndReturn(run func(string) (riskprofiles.Profile, bool)) *RiskProfiles_Profile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Profiles provides a mock function with no fields
+func (_m *RiskProfiles) Profiles() []riskprofiles.Profile {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Profiles")
+	}
+
+	var r0 []riskprofiles.Profile
+	if rf, ok := ret.Get(0).(func() []riskprofiles.Profile); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]riskprofiles.Profile)
+		}
+	}
+
+	return r0
+}
+
+// RiskProfiles_Profiles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Profiles'
+type RiskProfiles_Profiles_Call struct {
+	*mock.Call
+}
+
+// Profiles is a helper method to define mock.On call
+func (_e *RiskProfiles_Expecter) Profiles() *RiskProfiles_Profiles_Call {
+	return &RiskProfiles_Profiles_Call{Call: _e.mock.On("Profiles")}
+}
+
+func (_c *RiskProfiles_Profiles_Call) Run(run func()) *RiskProfiles_Profiles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RiskProfiles_Profiles_Call) Return(_a0 []riskprofiles.Profile) *RiskProfiles_Profiles_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RiskProfiles_Profiles_Call) RunAndReturn(run func() []riskprofiles.Profile) *RiskProfiles_Profiles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Release provides a mock function with given fields: runID
+func (_m *RiskProfiles) Release(runID string) error {
+	ret := _m.Called(runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Release")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(runID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RiskProfiles_Release_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Release'
+type RiskProfiles_Release_Call struct {
+	*mock.Call
+}
+
+// Release is a helper method to define mock.On call
+//   - runID string
+func (_e *RiskProfiles_Expecter) Release(runID interface{}) *RiskProfiles_Release_Call {
+	return &RiskProfiles_Release_Call{Call: _e.mock.On("Release", runID)}
+}
+
+func (_c *RiskProfiles_Release_Call) Run(run func(runID string)) *RiskProfiles_Release_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *RiskProfiles_Release_Call) Return(_a0 error) *RiskProfiles_Release_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RiskProfiles_Release_Call) RunAndReturn(run func(string) error) *RiskProfiles_Release_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveProfile provides a mock function with given fields: profile
+func (_m *RiskProfiles) SaveProfile(profile riskprofiles.Profile) (riskprofiles.Profile, error) {
+	ret := _m.Called(profile)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveProfile")
+	}
+
+	var r0 riskprofiles.Profile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(riskprofiles.Profile) (riskprofiles.Profile, error)); ok {
+		return rf(profile)
+	}
+	if rf, ok := ret.Get(0).(func(riskprofiles.Profile) riskprofiles.Profile); ok {
+		r0 = rf(profile)
+	} else {
+		r0 = ret.Get(0).(riskprofiles.Profile)
+	}
+
+	if rf, ok := ret.Get(1).(func(riskprofiles.Profile) error); ok {
+		r1 = rf(profile)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RiskProfiles_SaveProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveProfile'
+type RiskProfiles_SaveProfile_Call struct {
+	*mock.Call
+}
+
+// SaveProfile is a helper method to define mock.On call
+//   - profile riskprofiles.Profile
+func (_e *RiskProfiles_Expecter) SaveProfile(profile interface{}) *RiskProfiles_SaveProfile_Call {
+	return &RiskProfiles_SaveProfile_Call{Call: _e.mock.On("SaveProfile", profile)}
+}
+
+func (_c *RiskProfiles_SaveProfile_Call) Run(run func(profile riskprofiles.Profile)) *RiskProfiles_SaveProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(riskprofiles.Profile))
+	})
+	return _c
+}
+
+func (_c *RiskProfiles_SaveProfile_Call) Return(_a0 riskprofiles.Profile, _a1 error) *RiskProfiles_SaveProfile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RiskProfiles_SaveProfile_Call) RunAndReturn(run func(riskprofiles.Profile) (riskprofiles.Profile, error)) *RiskProfiles_SaveProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Wrap provides a mock function with given fields: inner
+func (_m *RiskProfiles) Wrap(inner execution.Executor) execution.Executor {
+	ret := _m.Called(inner)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Wrap")
+	}
+
+	var r0 execution.Executor
+	if rf, ok := ret.Get(0).(func(execution.Executor) execution.Executor); ok {
+		r0 = rf(inner)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(execution.Executor)
+		}
+	}
+
+	return r0
+}
+
+// RiskProfiles_Wrap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Wrap'
+type RiskProfiles_Wrap_Call struct {
+	*mock.Call
+}
+
+// Wrap is a helper method to define mock.On call
+//   - inner execution.Executor
+func (_e *RiskProfiles_Expecter) Wrap(inner interface{}) *RiskProfiles_Wrap_Call {
+	return &RiskProfiles_Wrap_Call{Call: _e.mock.On("Wrap", inner)}
+}
+
+func (_c *RiskProfiles_Wrap_Call) Run(run func(inner execution.Executor)) *RiskProfiles_Wrap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(execution.Executor))
+	})
+	return _c
+}
+
+func (_c *RiskProfiles_Wrap_Call) Return(_a0 execution.Executor) *RiskProfiles_Wrap_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RiskProfiles_Wrap_Call) RunAndReturn(run func(execution.Executor) execution.Executor) *RiskProfiles_Wrap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRiskProfiles creates a new instance of RiskProfiles. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRiskProfiles(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RiskProfiles {
+	mock := &RiskProfiles{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
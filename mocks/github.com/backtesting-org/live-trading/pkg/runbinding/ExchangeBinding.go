@@ -0,0 +1,291 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package runbinding
+
+import (
+	connector "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	execution "github.com/backtesting-org/kronos-sdk/pkg/types/execution"
+
+	mock "github.com/stretchr/testify/mock"
+
+	strategy "github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+)
+
+// ExchangeBinding is an autogenerated mock type for the ExchangeBinding type
+type ExchangeBinding struct {
+	mock.Mock
+}
+
+type ExchangeBinding_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ExchangeBinding) EXPECT() *ExchangeBinding_Expecter {
+	return &ExchangeBinding_Expecter{mock: &_m.Mock}
+}
+
+// Bind provides a mock function with given fields: exchanges
+func (_m *ExchangeBinding) Bind(exchanges []connector.ExchangeName) {
+	_m.Called(exchanges)
+}
+
+// ExchangeBinding_Bind_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Bind'
+type ExchangeBinding_Bind_Call struct {
+	*mock.Call
+}
+
+// Bind is a helper method to define mock.On call
+//   - exchanges []connector.ExchangeName
+func (_e *ExchangeBinding_Expecter) Bind(exchanges interface{}) *ExchangeBinding_Bind_Call {
+	return &ExchangeBinding_Bind_Call{Call: _e.mock.On("Bind", exchanges)}
+}
+
+func (_c *ExchangeBinding_Bind_Call) Run(run func(exchanges []connector.ExchangeName)) *ExchangeBinding_Bind_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]connector.ExchangeName))
+	})
+	return _c
+}
+
+func (_c *ExchangeBinding_Bind_Call) Return() *ExchangeBinding_Bind_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ExchangeBinding_Bind_Call) RunAndReturn(run func([]connector.ExchangeName)) *ExchangeBinding_Bind_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Check provides a mock function with given fields: signal
+func (_m *ExchangeBinding) Check(signal *strategy.Signal) error {
+	ret := _m.Called(signal)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Check")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*strategy.Signal) error); ok {
+		r0 = rf(signal)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExchangeBinding_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type ExchangeBinding_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+//   - signal *strategy.Signal
+func (_e *ExchangeBinding_Expecter) Check(signal interface{}) *ExchangeBinding_Check_Call {
+	return &ExchangeBinding_Check_Call{Call: _e.mock.On("Check", signal)}
+}
+
+func (_c *ExchangeBinding_Check_Call) Run(run func(signal *strategy.Signal)) *ExchangeBinding_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*strategy.Signal))
+	})
+	return _c
+}
+
+func (_c *ExchangeBinding_Check_Call) Return(_a0 error) *ExchangeBinding_Check_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ExchangeBinding_Check_Call) RunAndReturn(run func(*strategy.Signal) error) *ExchangeBinding_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Exchanges provides a mock function with no fields
+func (_m *ExchangeBinding) Exchanges() []connector.ExchangeName {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exchanges")
+	}
+
+	var r0 []connector.ExchangeName
+	if rf, ok := ret.Get(0).(func() []connector.ExchangeName); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]connector.ExchangeName)
+		}
+	}
+
+	return r0
+}
+
+// ExchangeBinding_Exchanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exchanges'
+type ExchangeBinding_Exchanges_Call struct {
+	*mock.Call
+}
+
+// Exchanges is a helper method to define mock.On call
+func (_e *ExchangeBinding_Expecter) Exchanges() *ExchangeBinding_Exchanges_Call {
+	return &ExchangeBinding_Exchanges_Call{Call: _e.mock.On("Exchanges")}
+}
+
+func (_c *ExchangeBinding_Exchanges_Call) Run(run func()) *ExchangeBinding_Exchanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ExchangeBinding_Exchanges_Call) Return(_a0 []connector.ExchangeName) *ExchangeBinding_Exchanges_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ExchangeBinding_Exchanges_Call) RunAndReturn(run func() []connector.ExchangeName) *ExchangeBinding_Exchanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsBound provides a mock function with given fields: exchange
+func (_m *ExchangeBinding) IsBound(exchange connector.ExchangeName) bool {
+	ret := _m.Called(exchange)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsBound")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName) bool); ok {
+		r0 = rf(exchange)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// ExchangeBinding_IsBound_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsBound'
+type ExchangeBinding_IsBound_Call struct {
+	*mock.Call
+}
+
+// IsBound is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+func (_e *ExchangeBinding_Expecter) IsBound(exchange interface{}) *ExchangeBinding_IsBound_Call {
+	return &ExchangeBinding_IsBound_Call{Call: _e.mock.On("IsBound", exchange)}
+}
+
+func (_c *ExchangeBinding_IsBound_Call) Run(run func(exchange connector.ExchangeName)) *ExchangeBinding_IsBound_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName))
+	})
+	return _c
+}
+
+func (_c *ExchangeBinding_IsBound_Call) Return(_a0 bool) *ExchangeBinding_IsBound_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ExchangeBinding_IsBound_Call) RunAndReturn(run func(connector.ExchangeName) bool) *ExchangeBinding_IsBound_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Release provides a mock function with no fields
+func (_m *ExchangeBinding) Release() {
+	_m.Called()
+}
+
+// ExchangeBinding_Release_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Release'
+type ExchangeBinding_Release_Call struct {
+	*mock.Call
+}
+
+// Release is a helper method to define mock.On call
+func (_e *ExchangeBinding_Expecter) Release() *ExchangeBinding_Release_Call {
+	return &ExchangeBinding_Release_Call{Call: _e.mock.On("Release")}
+}
+
+func (_c *ExchangeBinding_Release_Call) Run(run func()) *ExchangeBinding_Release_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ExchangeBinding_Release_Call) Return() *ExchangeBinding_Release_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ExchangeBinding_Release_Call) RunAndReturn(run func()) *ExchangeBinding_Release_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Wrap provides a mock function with given fields: inner
+func (_m *ExchangeBinding) Wrap(inner execution.Executor) execution.Executor {
+	ret := _m.Called(inner)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Wrap")
+	}
+
+	var r0 execution.Executor
+	if rf, ok := ret.Get(0).(func(execution.Executor) execution.Executor); ok {
+		r0 = rf(inner)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(execution.Executor)
+		}
+	}
+
+	return r0
+}
+
+// ExchangeBinding_Wrap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Wrap'
+type ExchangeBinding_Wrap_Call struct {
+	*mock.Call
+}
+
+// Wrap is a helper method to define mock.On call
+//   - inner execution.Executor
+func (_e *ExchangeBinding_Expecter) Wrap(inner interface{}) *ExchangeBinding_Wrap_Call {
+	return &ExchangeBinding_Wrap_Call{Call: _e.mock.On("Wrap", inner)}
+}
+
+func (_c *ExchangeBinding_Wrap_Call) Run(run func(inner execution.Executor)) *ExchangeBinding_Wrap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(execution.Executor))
+	})
+	return _c
+}
+
+func (_c *ExchangeBinding_Wrap_Call) Return(_a0 execution.Executor) *ExchangeBinding_Wrap_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ExchangeBinding_Wrap_Call) RunAndReturn(run func(execution.Executor) execution.Executor) *ExchangeBinding_Wrap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewExchangeBinding creates a new instance of ExchangeBinding. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewExchangeBinding(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ExchangeBinding {
+	mock := &ExchangeBinding{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,184 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package runlog
+
+import (
+	runlog "github.com/backtesting-org/live-trading/pkg/runlog"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// QueryableSink is an autogenerated mock type for the QueryableSink type
+type QueryableSink struct {
+	mock.Mock
+}
+
+type QueryableSink_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *QueryableSink) EXPECT() *QueryableSink_Expecter {
+	return &QueryableSink_Expecter{mock: &_m.Mock}
+}
+
+// Name provides a mock function with no fields
+func (_m *QueryableSink) Name() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Name")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// QueryableSink_Name_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Name'
+type QueryableSink_Name_Call struct {
+	*mock.Call
+}
+
+// Name is a helper method to define mock.On call
+func (_e *QueryableSink_Expecter) Name() *QueryableSink_Name_Call {
+	return &QueryableSink_Name_Call{Call: _e.mock.On("Name")}
+}
+
+func (_c *QueryableSink_Name_Call) Run(run func()) *QueryableSink_Name_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *QueryableSink_Name_Call) Return(_a0 string) *QueryableSink_Name_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *QueryableSink_Name_Call) RunAndReturn(run func() string) *QueryableSink_Name_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Query provides a mock function with given fields: filter
+func (_m *QueryableSink) Query(filter runlog.Filter) ([]runlog.Entry, error) {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Query")
+	}
+
+	var r0 []runlog.Entry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(runlog.Filter) ([]runlog.Entry, error)); ok {
+		return rf(filter)
+	}
+	if rf, ok := ret.Get(0).(func(runlog.Filter) []runlog.Entry); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]runlog.Entry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(runlog.Filter) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QueryableSink_Query_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Query'
+type QueryableSink_Query_Call struct {
+	*mock.Call
+}
+
+// Query is a helper method to define mock.On call
+//   - filter runlog.Filter
+func (_e *QueryableSink_Expecter) Query(filter interface{}) *QueryableSink_Query_Call {
+	return &QueryableSink_Query_Call{Call: _e.mock.On("Query", filter)}
+}
+
+func (_c *QueryableSink_Query_Call) Run(run func(filter runlog.Filter)) *QueryableSink_Query_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(runlog.Filter))
+	})
+	return _c
+}
+
+func (_c *QueryableSink_Query_Call) Return(_a0 []runlog.Entry, _a1 error) *QueryableSink_Query_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *QueryableSink_Query_Call) RunAndReturn(run func(runlog.Filter) ([]runlog.Entry, error)) *QueryableSink_Query_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Write provides a mock function with given fields: entries
+func (_m *QueryableSink) Write(entries []runlog.Entry) error {
+	ret := _m.Called(entries)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Write")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]runlog.Entry) error); ok {
+		r0 = rf(entries)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// QueryableSink_Write_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Write'
+type QueryableSink_Write_Call struct {
+	*mock.Call
+}
+
+// Write is a helper method to define mock.On call
+//   - entries []runlog.Entry
+func (_e *QueryableSink_Expecter) Write(entries interface{}) *QueryableSink_Write_Call {
+	return &QueryableSink_Write_Call{Call: _e.mock.On("Write", entries)}
+}
+
+func (_c *QueryableSink_Write_Call) Run(run func(entries []runlog.Entry)) *QueryableSink_Write_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]runlog.Entry))
+	})
+	return _c
+}
+
+func (_c *QueryableSink_Write_Call) Return(_a0 error) *QueryableSink_Write_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *QueryableSink_Write_Call) RunAndReturn(run func([]runlog.Entry) error) *QueryableSink_Write_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewQueryableSink creates a new instance of QueryableSink. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewQueryableSink(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *QueryableSink {
+	mock := &QueryableSink{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
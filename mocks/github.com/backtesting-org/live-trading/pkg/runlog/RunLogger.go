@@ -0,0 +1,577 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package runlog
+
+import (
+	http "net/http"
+
+	logging "github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	mock "github.com/stretchr/testify/mock"
+
+	runlog "github.com/backtesting-org/live-trading/pkg/runlog"
+)
+
+// RunLogger is an autogenerated mock type for the RunLogger type
+type RunLogger struct {
+	mock.Mock
+}
+
+type RunLogger_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RunLogger) EXPECT() *RunLogger_Expecter {
+	return &RunLogger_Expecter{mock: &_m.Mock}
+}
+
+// AddSink provides a mock function with given fields: sink
+func (_m *RunLogger) AddSink(sink runlog.Sink) {
+	_m.Called(sink)
+}
+
+// RunLogger_AddSink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddSink'
+type RunLogger_AddSink_Call struct {
+	*mock.Call
+}
+
+// AddSink is a helper method to define mock.On call
+//   - sink runlog.Sink
+func (_e *RunLogger_Expecter) AddSink(sink interface{}) *RunLogger_AddSink_Call {
+	return &RunLogger_AddSink_Call{Call: _e.mock.On("AddSink", sink)}
+}
+
+func (_c *RunLogger_AddSink_Call) Run(run func(sink runlog.Sink)) *RunLogger_AddSink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(runlog.Sink))
+	})
+	return _c
+}
+
+func (_c *RunLogger_AddSink_Call) Return() *RunLogger_AddSink_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *RunLogger_AddSink_Call) RunAndReturn(run func(runlog.Sink)) *RunLogger_AddSink_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Begin provides a mock function with given fields: runID, pluginID
+func (_m *RunLogger) Begin(runID string, pluginID string) error {
+	ret := _m.Called(runID, pluginID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Begin")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(runID, pluginID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RunLogger_Begin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Begin'
+type RunLogger_Begin_Call struct {
+	*mock.Call
+}
+
+// Begin is a helper method to define mock.On call
+//   - runID string
+//   - pluginID string
+func (_e *RunLogger_Expecter) Begin(runID interface{}, pluginID interface{}) *RunLogger_Begin_Call {
+	return &RunLogger_Begin_Call{Call: _e.mock.On("Begin", runID, pluginID)}
+}
+
+func (_c *RunLogger_Begin_Call) Run(run func(runID string, pluginID string)) *RunLogger_Begin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RunLogger_Begin_Call) Return(_a0 error) *RunLogger_Begin_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RunLogger_Begin_Call) RunAndReturn(run func(string, string) error) *RunLogger_Begin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *RunLogger) Configure(config runlog.Config) {
+	_m.Called(config)
+}
+
+// RunLogger_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type RunLogger_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config runlog.Config
+func (_e *RunLogger_Expecter) Configure(config interface{}) *RunLogger_Configure_Call {
+	return &RunLogger_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *RunLogger_Configure_Call) Run(run func(config runlog.Config)) *RunLogger_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(runlog.Config))
+	})
+	return _c
+}
+
+func (_c *RunLogger_Configure_Call) Return() *RunLogger_Configure_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *RunLogger_Configure_Call) RunAndReturn(run func(runlog.Config)) *RunLogger_Configure_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Dropped provides a mock function with no fields
+func (_m *RunLogger) Dropped() int64 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Dropped")
+	}
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	return r0
+}
+
+// RunLogger_Dropped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Dropped'
+type RunLogger_Dropped_Call struct {
+	*mock.Call
+}
+
+// Dropped is a helper method to define mock.On call
+func (_e *RunLogger_Expecter) Dropped() *RunLogger_Dropped_Call {
+	return &RunLogger_Dropped_Call{Call: _e.mock.On("Dropped")}
+}
+
+func (_c *RunLogger_Dropped_Call) Run(run func()) *RunLogger_Dropped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RunLogger_Dropped_Call) Return(_a0 int64) *RunLogger_Dropped_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RunLogger_Dropped_Call) RunAndReturn(run func() int64) *RunLogger_Dropped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// End provides a mock function with no fields
+func (_m *RunLogger) End() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for End")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RunLogger_End_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'End'
+type RunLogger_End_Call struct {
+	*mock.Call
+}
+
+// End is a helper method to define mock.On call
+func (_e *RunLogger_Expecter) End() *RunLogger_End_Call {
+	return &RunLogger_End_Call{Call: _e.mock.On("End")}
+}
+
+func (_c *RunLogger_End_Call) Run(run func()) *RunLogger_End_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RunLogger_End_Call) Return(_a0 error) *RunLogger_End_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RunLogger_End_Call) RunAndReturn(run func() error) *RunLogger_End_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Flush provides a mock function with no fields
+func (_m *RunLogger) Flush() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Flush")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RunLogger_Flush_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Flush'
+type RunLogger_Flush_Call struct {
+	*mock.Call
+}
+
+// Flush is a helper method to define mock.On call
+func (_e *RunLogger_Expecter) Flush() *RunLogger_Flush_Call {
+	return &RunLogger_Flush_Call{Call: _e.mock.On("Flush")}
+}
+
+func (_c *RunLogger_Flush_Call) Run(run func()) *RunLogger_Flush_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RunLogger_Flush_Call) Return(_a0 error) *RunLogger_Flush_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RunLogger_Flush_Call) RunAndReturn(run func() error) *RunLogger_Flush_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Handler provides a mock function with no fields
+func (_m *RunLogger) Handler() http.Handler {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handler")
+	}
+
+	var r0 http.Handler
+	if rf, ok := ret.Get(0).(func() http.Handler); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(http.Handler)
+		}
+	}
+
+	return r0
+}
+
+// RunLogger_Handler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handler'
+type RunLogger_Handler_Call struct {
+	*mock.Call
+}
+
+// Handler is a helper method to define mock.On call
+func (_e *RunLogger_Expecter) Handler() *RunLogger_Handler_Call {
+	return &RunLogger_Handler_Call{Call: _e.mock.On("Handler")}
+}
+
+func (_c *RunLogger_Handler_Call) Run(run func()) *RunLogger_Handler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RunLogger_Handler_Call) Return(_a0 http.Handler) *RunLogger_Handler_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RunLogger_Handler_Call) RunAndReturn(run func() http.Handler) *RunLogger_Handler_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Query provides a mock function with given fields: filter
+func (_m *RunLogger) Query(filter runlog.Filter) ([]runlog.Entry, error) {
+	ret := _m.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Query")
+	}
+
+	var r0 []runlog.Entry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(runlog.Filter) ([]runlog.Entry, error)); ok {
+		return rf(filter)
+	}
+	if rf, ok := ret.Get(0).(func(runlog.Filter) []runlog.Entry); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]runlog.Entry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(runlog.Filter) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RunLogger_Query_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Query'
+type RunLogger_Query_Call struct {
+	*mock.Call
+}
+
+// Query is a helper method to define mock.On call
+//   - filter runlog.Filter
+func (_e *RunLogger_Expecter) Query(filter interface{}) *RunLogger_Query_Call {
+	return &RunLogger_Query_Call{Call: _e.mock.On("Query", filter)}
+}
+
+func (_c *RunLogger_Query_Call) Run(run func(filter runlog.Filter)) *RunLogger_Query_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(runlog.Filter))
+	})
+	return _c
+}
+
+func (_c *RunLogger_Query_Call) Return(_a0 []runlog.Entry, _a1 error) *RunLogger_Query_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RunLogger_Query_Call) RunAndReturn(run func(runlog.Filter) ([]runlog.Entry, error)) *RunLogger_Query_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Record provides a mock function with given fields: level, kind, fields, msg, args
+func (_m *RunLogger) Record(level runlog.Level, kind string, fields runlog.Fields, msg string, args ...interface{}) {
+	var _ca []interface{}
+	_ca = append(_ca, level, kind, fields, msg)
+	_ca = append(_ca, args...)
+	_m.Called(_ca...)
+}
+
+// RunLogger_Record_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Record'
+type RunLogger_Record_Call struct {
+	*mock.Call
+}
+
+// Record is a helper method to define mock.On call
+//   - level runlog.Level
+//   - kind string
+//   - fields runlog.Fields
+//   - msg string
+//   - args ...interface{}
+func (_e *RunLogger_Expecter) Record(level interface{}, kind interface{}, fields interface{}, msg interface{}, args ...interface{}) *RunLogger_Record_Call {
+	return &RunLogger_Record_Call{Call: _e.mock.On("Record",
+		append([]interface{}{level, kind, fields, msg}, args...)...)}
+}
+
+func (_c *RunLogger_Record_Call) Run(run func(level runlog.Level, kind string, fields runlog.Fields, msg string, args ...interface{})) *RunLogger_Record_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]interface{}, len(args)-4)
+		for i, a := range args[4:] {
+			if a != nil {
+				variadicArgs[i] = a.(interface{})
+			}
+		}
+		run(args[0].(runlog.Level), args[1].(string), args[2].(runlog.Fields), args[3].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *RunLogger_Record_Call) Return() *RunLogger_Record_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *RunLogger_Record_Call) RunAndReturn(run func(runlog.Level, string, runlog.Fields, string, ...interface{})) *RunLogger_Record_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Start provides a mock function with no fields
+func (_m *RunLogger) Start() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RunLogger_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type RunLogger_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+func (_e *RunLogger_Expecter) Start() *RunLogger_Start_Call {
+	return &RunLogger_Start_Call{Call: _e.mock.On("Start")}
+}
+
+func (_c *RunLogger_Start_Call) Run(run func()) *RunLogger_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RunLogger_Start_Call) Return(_a0 error) *RunLogger_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RunLogger_Start_Call) RunAndReturn(run func() error) *RunLogger_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with no fields
+func (_m *RunLogger) Stop() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RunLogger_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type RunLogger_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *RunLogger_Expecter) Stop() *RunLogger_Stop_Call {
+	return &RunLogger_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *RunLogger_Stop_Call) Run(run func()) *RunLogger_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RunLogger_Stop_Call) Return(_a0 error) *RunLogger_Stop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RunLogger_Stop_Call) RunAndReturn(run func() error) *RunLogger_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Wrap provides a mock function with given fields: inner
+func (_m *RunLogger) Wrap(inner logging.TradingLogger) logging.TradingLogger {
+	ret := _m.Called(inner)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Wrap")
+	}
+
+	var r0 logging.TradingLogger
+	if rf, ok := ret.Get(0).(func(logging.TradingLogger) logging.TradingLogger); ok {
+		r0 = rf(inner)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(logging.TradingLogger)
+		}
+	}
+
+	return r0
+}
+
+// RunLogger_Wrap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Wrap'
+type RunLogger_Wrap_Call struct {
+	*mock.Call
+}
+
+// Wrap is a helper method to define mock.On call
+//   - inner logging.TradingLogger
+func (_e *RunLogger_Expecter) Wrap(inner interface{}) *RunLogger_Wrap_Call {
+	return &RunLogger_Wrap_Call{Call: _e.mock.On("Wrap", inner)}
+}
+
+func (_c *RunLogger_Wrap_Call) Run(run func(inner logging.TradingLogger)) *RunLogger_Wrap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(logging.TradingLogger))
+	})
+	return _c
+}
+
+func (_c *RunLogger_Wrap_Call) Return(_a0 logging.TradingLogger) *RunLogger_Wrap_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RunLogger_Wrap_Call) RunAndReturn(run func(logging.TradingLogger) logging.TradingLogger) *RunLogger_Wrap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRunLogger creates a new instance of RunLogger. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRunLogger(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RunLogger {
+	mock := &RunLogger{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
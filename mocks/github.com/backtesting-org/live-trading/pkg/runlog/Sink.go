@@ -0,0 +1,126 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package runlog
+
+import (
+	runlog "github.com/backtesting-org/live-trading/pkg/runlog"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Sink is an autogenerated mock type for the Sink type
+type Sink struct {
+	mock.Mock
+}
+
+type Sink_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Sink) EXPECT() *Sink_Expecter {
+	return &Sink_Expecter{mock: &_m.Mock}
+}
+
+// Name provides a mock function with no fields
+func (_m *Sink) Name() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Name")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Sink_Name_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Name'
+type Sink_Name_Call struct {
+	*mock.Call
+}
+
+// Name is a helper method to define mock.On call
+func (_e *Sink_Expecter) Name() *Sink_Name_Call {
+	return &Sink_Name_Call{Call: _e.mock.On("Name")}
+}
+
+func (_c *Sink_Name_Call) Run(run func()) *Sink_Name_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Sink_Name_Call) Return(_a0 string) *Sink_Name_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Sink_Name_Call) RunAndReturn(run func() string) *Sink_Name_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Write provides a mock function with given fields: entries
+func (_m *Sink) Write(entries []runlog.Entry) error {
+	ret := _m.Called(entries)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Write")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]runlog.Entry) error); ok {
+		r0 = rf(entries)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Sink_Write_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Write'
+type Sink_Write_Call struct {
+	*mock.Call
+}
+
+// Write is a helper method to define mock.On call
+//   - entries []runlog.Entry
+func (_e *Sink_Expecter) Write(entries interface{}) *Sink_Write_Call {
+	return &Sink_Write_Call{Call: _e.mock.On("Write", entries)}
+}
+
+func (_c *Sink_Write_Call) Run(run func(entries []runlog.Entry)) *Sink_Write_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]runlog.Entry))
+	})
+	return _c
+}
+
+func (_c *Sink_Write_Call) Return(_a0 error) *Sink_Write_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Sink_Write_Call) RunAndReturn(run func([]runlog.Entry) error) *Sink_Write_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSink creates a new instance of Sink. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSink(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Sink {
+	mock := &Sink{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,593 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package runmetrics
+
+import (
+	http "net/http"
+
+	runmetrics "github.com/backtesting-org/live-trading/pkg/runmetrics"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// SeriesStore is an autogenerated mock type for the SeriesStore type
+type SeriesStore struct {
+	mock.Mock
+}
+
+type SeriesStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SeriesStore) EXPECT() *SeriesStore_Expecter {
+	return &SeriesStore_Expecter{mock: &_m.Mock}
+}
+
+// Compact provides a mock function with no fields
+func (_m *SeriesStore) Compact() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Compact")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SeriesStore_Compact_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Compact'
+type SeriesStore_Compact_Call struct {
+	*mock.Call
+}
+
+// Compact is a helper method to define mock.On call
+func (_e *SeriesStore_Expecter) Compact() *SeriesStore_Compact_Call {
+	return &SeriesStore_Compact_Call{Call: _e.mock.On("Compact")}
+}
+
+func (_c *SeriesStore_Compact_Call) Run(run func()) *SeriesStore_Compact_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SeriesStore_Compact_Call) Return(_a0 error) *SeriesStore_Compact_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SeriesStore_Compact_Call) RunAndReturn(run func() error) *SeriesStore_Compact_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *SeriesStore) Configure(config runmetrics.Config) error {
+	ret := _m.Called(config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Configure")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(runmetrics.Config) error); ok {
+		r0 = rf(config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SeriesStore_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type SeriesStore_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config runmetrics.Config
+func (_e *SeriesStore_Expecter) Configure(config interface{}) *SeriesStore_Configure_Call {
+	return &SeriesStore_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *SeriesStore_Configure_Call) Run(run func(config runmetrics.Config)) *SeriesStore_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(runmetrics.Config))
+	})
+	return _c
+}
+
+func (_c *SeriesStore_Configure_Call) Return(_a0 error) *SeriesStore_Configure_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SeriesStore_Configure_Call) RunAndReturn(run func(runmetrics.Config) error) *SeriesStore_Configure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: runID
+func (_m *SeriesStore) Delete(runID string) error {
+	ret := _m.Called(runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(runID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SeriesStore_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type SeriesStore_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - runID string
+func (_e *SeriesStore_Expecter) Delete(runID interface{}) *SeriesStore_Delete_Call {
+	return &SeriesStore_Delete_Call{Call: _e.mock.On("Delete", runID)}
+}
+
+func (_c *SeriesStore_Delete_Call) Run(run func(runID string)) *SeriesStore_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *SeriesStore_Delete_Call) Return(_a0 error) *SeriesStore_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SeriesStore_Delete_Call) RunAndReturn(run func(string) error) *SeriesStore_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Handler provides a mock function with no fields
+func (_m *SeriesStore) Handler() http.Handler {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handler")
+	}
+
+	var r0 http.Handler
+	if rf, ok := ret.Get(0).(func() http.Handler); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(http.Handler)
+		}
+	}
+
+	return r0
+}
+
+// SeriesStore_Handler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handler'
+type SeriesStore_Handler_Call struct {
+	*mock.Call
+}
+
+// Handler is a helper method to define mock.On call
+func (_e *SeriesStore_Expecter) Handler() *SeriesStore_Handler_Call {
+	return &SeriesStore_Handler_Call{Call: _e.mock.On("Handler")}
+}
+
+func (_c *SeriesStore_Handler_Call) Run(run func()) *SeriesStore_Handler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SeriesStore_Handler_Call) Return(_a0 http.Handler) *SeriesStore_Handler_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SeriesStore_Handler_Call) RunAndReturn(run func() http.Handler) *SeriesStore_Handler_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Metrics provides a mock function with given fields: runID
+func (_m *SeriesStore) Metrics(runID string) []string {
+	ret := _m.Called(runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Metrics")
+	}
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(runID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// SeriesStore_Metrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Metrics'
+type SeriesStore_Metrics_Call struct {
+	*mock.Call
+}
+
+// Metrics is a helper method to define mock.On call
+//   - runID string
+func (_e *SeriesStore_Expecter) Metrics(runID interface{}) *SeriesStore_Metrics_Call {
+	return &SeriesStore_Metrics_Call{Call: _e.mock.On("Metrics", runID)}
+}
+
+func (_c *SeriesStore_Metrics_Call) Run(run func(runID string)) *SeriesStore_Metrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *SeriesStore_Metrics_Call) Return(_a0 []string) *SeriesStore_Metrics_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SeriesStore_Metrics_Call) RunAndReturn(run func(string) []string) *SeriesStore_Metrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Query provides a mock function with given fields: query
+func (_m *SeriesStore) Query(query runmetrics.Query) (*runmetrics.Series, error) {
+	ret := _m.Called(query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Query")
+	}
+
+	var r0 *runmetrics.Series
+	var r1 error
+	if rf, ok := ret.Get(0).(func(runmetrics.Query) (*runmetrics.Series, error)); ok {
+		return rf(query)
+	}
+	if rf, ok := ret.Get(0).(func(runmetrics.Query) *runmetrics.Series); ok {
+		r0 = rf(query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*runmetrics.Series)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(runmetrics.Query) error); ok {
+		r1 = rf(query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SeriesStore_Query_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Query'
+type SeriesStore_Query_Call struct {
+	*mock.Call
+}
+
+// Query is a helper method to define mock.On call
+//   - query runmetrics.Query
+func (_e *SeriesStore_Expecter) Query(query interface{}) *SeriesStore_Query_Call {
+	return &SeriesStore_Query_Call{Call: _e.mock.On("Query", query)}
+}
+
+func (_c *SeriesStore_Query_Call) Run(run func(query runmetrics.Query)) *SeriesStore_Query_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(runmetrics.Query))
+	})
+	return _c
+}
+
+func (_c *SeriesStore_Query_Call) Return(_a0 *runmetrics.Series, _a1 error) *SeriesStore_Query_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SeriesStore_Query_Call) RunAndReturn(run func(runmetrics.Query) (*runmetrics.Series, error)) *SeriesStore_Query_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Record provides a mock function with given fields: runID, metric, value
+func (_m *SeriesStore) Record(runID string, metric string, value float64) {
+	_m.Called(runID, metric, value)
+}
+
+// SeriesStore_Record_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Record'
+type SeriesStore_Record_Call struct {
+	*mock.Call
+}
+
+// Record is a helper method to define mock.On call
+//   - runID string
+//   - metric string
+//   - value float64
+func (_e *SeriesStore_Expecter) Record(runID interface{}, metric interface{}, value interface{}) *SeriesStore_Record_Call {
+	return &SeriesStore_Record_Call{Call: _e.mock.On("Record", runID, metric, value)}
+}
+
+func (_c *SeriesStore_Record_Call) Run(run func(runID string, metric string, value float64)) *SeriesStore_Record_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(float64))
+	})
+	return _c
+}
+
+func (_c *SeriesStore_Record_Call) Return() *SeriesStore_Record_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *SeriesStore_Record_Call) RunAndReturn(run func(string, string, float64)) *SeriesStore_Record_Call {
+	_c.Run(run)
+	return _c
+}
+
+// RecordAt provides a mock function with given fields: runID, metric, at, value
+func (_m *SeriesStore) RecordAt(runID string, metric string, at time.Time, value float64) {
+	_m.Called(runID, metric, at, value)
+}
+
+// SeriesStore_RecordAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordAt'
+type SeriesStore_RecordAt_Call struct {
+	*mock.Call
+}
+
+// RecordAt is a helper method to define mock.On call
+//   - runID string
+//   - metric string
+//   - at time.Time
+//   - value float64
+func (_e *SeriesStore_Expecter) RecordAt(runID interface{}, metric interface{}, at interface{}, value interface{}) *SeriesStore_RecordAt_Call {
+	return &SeriesStore_RecordAt_Call{Call: _e.mock.On("RecordAt", runID, metric, at, value)}
+}
+
+func (_c *SeriesStore_RecordAt_Call) Run(run func(runID string, metric string, at time.Time, value float64)) *SeriesStore_RecordAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(time.Time), args[3].(float64))
+	})
+	return _c
+}
+
+func (_c *SeriesStore_RecordAt_Call) Return() *SeriesStore_RecordAt_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *SeriesStore_RecordAt_Call) RunAndReturn(run func(string, string, time.Time, float64)) *SeriesStore_RecordAt_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Runs provides a mock function with no fields
+func (_m *SeriesStore) Runs() []string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Runs")
+	}
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// SeriesStore_Runs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Runs'
+type SeriesStore_Runs_Call struct {
+	*mock.Call
+}
+
+// Runs is a helper method to define mock.On call
+func (_e *SeriesStore_Expecter) Runs() *SeriesStore_Runs_Call {
+	return &SeriesStore_Runs_Call{Call: _e.mock.On("Runs")}
+}
+
+func (_c *SeriesStore_Runs_Call) Run(run func()) *SeriesStore_Runs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SeriesStore_Runs_Call) Return(_a0 []string) *SeriesStore_Runs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SeriesStore_Runs_Call) RunAndReturn(run func() []string) *SeriesStore_Runs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with no fields
+func (_m *SeriesStore) Start() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SeriesStore_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type SeriesStore_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+func (_e *SeriesStore_Expecter) Start() *SeriesStore_Start_Call {
+	return &SeriesStore_Start_Call{Call: _e.mock.On("Start")}
+}
+
+func (_c *SeriesStore_Start_Call) Run(run func()) *SeriesStore_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SeriesStore_Start_Call) Return(_a0 error) *SeriesStore_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SeriesStore_Start_Call) RunAndReturn(run func() error) *SeriesStore_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with no fields
+func (_m *SeriesStore) Stop() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SeriesStore_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type SeriesStore_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *SeriesStore_Expecter) Stop() *SeriesStore_Stop_Call {
+	return &SeriesStore_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *SeriesStore_Stop_Call) Run(run func()) *SeriesStore_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SeriesStore_Stop_Call) Return(_a0 error) *SeriesStore_Stop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SeriesStore_Stop_Call) RunAndReturn(run func() error) *SeriesStore_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Usage provides a mock function with given fields: runID
+func (_m *SeriesStore) Usage(runID string) (runmetrics.Usage, bool) {
+	ret := _m.Called(runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Usage")
+	}
+
+	var r0 runmetrics.Usage
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string) (runmetrics.Usage, bool)); ok {
+		return rf(runID)
+	}
+	if rf, ok := ret.Get(0).(func(string) runmetrics.Usage); ok {
+		r0 = rf(runID)
+	} else {
+		r0 = ret.Get(0).(runmetrics.Usage)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(runID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// SeriesStore_Usage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Usage'
+type SeriesStore_Usage_Call struct {
+	*mock.Call
+}
+
+// Usage is a helper method to define mock.On call
+//   - runID string
+func (_e *SeriesStore_Expecter) Usage(runID interface{}) *SeriesStore_Usage_Call {
+	return &SeriesStore_Usage_Call{Call: _e.mock.On("Usage", runID)}
+}
+
+func (_c *SeriesStore_Usage_Call) Run(run func(runID string)) *SeriesStore_Usage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *SeriesStore_Usage_Call) Return(_a0 runmetrics.Usage, _a1 bool) *SeriesStore_Usage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SeriesStore_Usage_Call) RunAndReturn(run func(string) (runmetrics.Usage, bool)) *SeriesStore_Usage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSeriesStore creates a new instance of SeriesStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSeriesStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SeriesStore {
+	mock := &SeriesStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,81 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package runreport
+
+import (
+	runreport "github.com/backtesting-org/live-trading/pkg/runreport"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Deliverer is an autogenerated mock type for the Deliverer type
+type Deliverer struct {
+	mock.Mock
+}
+
+type Deliverer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Deliverer) EXPECT() *Deliverer_Expecter {
+	return &Deliverer_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: report
+func (_m *Deliverer) Execute(report *runreport.Report) error {
+	ret := _m.Called(report)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*runreport.Report) error); ok {
+		r0 = rf(report)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Deliverer_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type Deliverer_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - report *runreport.Report
+func (_e *Deliverer_Expecter) Execute(report interface{}) *Deliverer_Execute_Call {
+	return &Deliverer_Execute_Call{Call: _e.mock.On("Execute", report)}
+}
+
+func (_c *Deliverer_Execute_Call) Run(run func(report *runreport.Report)) *Deliverer_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*runreport.Report))
+	})
+	return _c
+}
+
+func (_c *Deliverer_Execute_Call) Return(_a0 error) *Deliverer_Execute_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Deliverer_Execute_Call) RunAndReturn(run func(*runreport.Report) error) *Deliverer_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDeliverer creates a new instance of Deliverer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDeliverer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Deliverer {
+	mock := &Deliverer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,684 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package runreport
+
+import (
+	http "net/http"
+
+	connector "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+
+	mock "github.com/stretchr/testify/mock"
+
+	numerical "github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+
+	runreport "github.com/backtesting-org/live-trading/pkg/runreport"
+)
+
+// RunReporter is an autogenerated mock type for the RunReporter type
+type RunReporter struct {
+	mock.Mock
+}
+
+type RunReporter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RunReporter) EXPECT() *RunReporter_Expecter {
+	return &RunReporter_Expecter{mock: &_m.Mock}
+}
+
+// Active provides a mock function with no fields
+func (_m *RunReporter) Active() (string, bool) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Active")
+	}
+
+	var r0 string
+	var r1 bool
+	if rf, ok := ret.Get(0).(func() (string, bool)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// RunReporter_Active_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Active'
+type RunReporter_Active_Call struct {
+	*mock.Call
+}
+
+// Active is a helper method to define mock.On call
+func (_e *RunReporter_Expecter) Active() *RunReporter_Active_Call {
+	return &RunReporter_Active_Call{Call: _e.mock.On("Active")}
+}
+
+func (_c *RunReporter_Active_Call) Run(run func()) *RunReporter_Active_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RunReporter_Active_Call) Return(_a0 string, _a1 bool) *RunReporter_Active_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RunReporter_Active_Call) RunAndReturn(run func() (string, bool)) *RunReporter_Active_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddDeliverer provides a mock function with given fields: deliverer
+func (_m *RunReporter) AddDeliverer(deliverer runreport.Deliverer) {
+	_m.Called(deliverer)
+}
+
+// RunReporter_AddDeliverer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddDeliverer'
+type RunReporter_AddDeliverer_Call struct {
+	*mock.Call
+}
+
+// AddDeliverer is a helper method to define mock.On call
+//   - deliverer runreport.Deliverer
+func (_e *RunReporter_Expecter) AddDeliverer(deliverer interface{}) *RunReporter_AddDeliverer_Call {
+	return &RunReporter_AddDeliverer_Call{Call: _e.mock.On("AddDeliverer", deliverer)}
+}
+
+func (_c *RunReporter_AddDeliverer_Call) Run(run func(deliverer runreport.Deliverer)) *RunReporter_AddDeliverer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(runreport.Deliverer))
+	})
+	return _c
+}
+
+func (_c *RunReporter_AddDeliverer_Call) Return() *RunReporter_AddDeliverer_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *RunReporter_AddDeliverer_Call) RunAndReturn(run func(runreport.Deliverer)) *RunReporter_AddDeliverer_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Adopt provides a mock function with given fields: runID, exchange, symbol, size
+func (_m *RunReporter) Adopt(runID string, exchange connector.ExchangeName, symbol string, size numerical.Decimal) error {
+	ret := _m.Called(runID, exchange, symbol, size)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Adopt")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, connector.ExchangeName, string, numerical.Decimal) error); ok {
+		r0 = rf(runID, exchange, symbol, size)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RunReporter_Adopt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Adopt'
+type RunReporter_Adopt_Call struct {
+	*mock.Call
+}
+
+// Adopt is a helper method to define mock.On call
+//   - runID string
+//   - exchange connector.ExchangeName
+//   - symbol string
+//   - size numerical.Decimal
+func (_e *RunReporter_Expecter) Adopt(runID interface{}, exchange interface{}, symbol interface{}, size interface{}) *RunReporter_Adopt_Call {
+	return &RunReporter_Adopt_Call{Call: _e.mock.On("Adopt", runID, exchange, symbol, size)}
+}
+
+func (_c *RunReporter_Adopt_Call) Run(run func(runID string, exchange connector.ExchangeName, symbol string, size numerical.Decimal)) *RunReporter_Adopt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(connector.ExchangeName), args[2].(string), args[3].(numerical.Decimal))
+	})
+	return _c
+}
+
+func (_c *RunReporter_Adopt_Call) Return(_a0 error) *RunReporter_Adopt_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RunReporter_Adopt_Call) RunAndReturn(run func(string, connector.ExchangeName, string, numerical.Decimal) error) *RunReporter_Adopt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Begin provides a mock function with given fields: runID, config
+func (_m *RunReporter) Begin(runID string, config map[string]string) error {
+	ret := _m.Called(runID, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Begin")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, map[string]string) error); ok {
+		r0 = rf(runID, config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RunReporter_Begin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Begin'
+type RunReporter_Begin_Call struct {
+	*mock.Call
+}
+
+// Begin is a helper method to define mock.On call
+//   - runID string
+//   - config map[string]string
+func (_e *RunReporter_Expecter) Begin(runID interface{}, config interface{}) *RunReporter_Begin_Call {
+	return &RunReporter_Begin_Call{Call: _e.mock.On("Begin", runID, config)}
+}
+
+func (_c *RunReporter_Begin_Call) Run(run func(runID string, config map[string]string)) *RunReporter_Begin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(map[string]string))
+	})
+	return _c
+}
+
+func (_c *RunReporter_Begin_Call) Return(_a0 error) *RunReporter_Begin_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RunReporter_Begin_Call) RunAndReturn(run func(string, map[string]string) error) *RunReporter_Begin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *RunReporter) Configure(config runreport.Config) {
+	_m.Called(config)
+}
+
+// RunReporter_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type RunReporter_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config runreport.Config
+func (_e *RunReporter_Expecter) Configure(config interface{}) *RunReporter_Configure_Call {
+	return &RunReporter_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *RunReporter_Configure_Call) Run(run func(config runreport.Config)) *RunReporter_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(runreport.Config))
+	})
+	return _c
+}
+
+func (_c *RunReporter_Configure_Call) Return() *RunReporter_Configure_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *RunReporter_Configure_Call) RunAndReturn(run func(runreport.Config)) *RunReporter_Configure_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Current provides a mock function with no fields
+func (_m *RunReporter) Current() (*runreport.Report, bool) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Current")
+	}
+
+	var r0 *runreport.Report
+	var r1 bool
+	if rf, ok := ret.Get(0).(func() (*runreport.Report, bool)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *runreport.Report); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*runreport.Report)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// RunReporter_Current_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Current'
+type RunReporter_Current_Call struct {
+	*mock.Call
+}
+
+// Current is a helper method to define mock.On call
+func (_e *RunReporter_Expecter) Current() *RunReporter_Current_Call {
+	return &RunReporter_Current_Call{Call: _e.mock.On("Current")}
+}
+
+func (_c *RunReporter_Current_Call) Run(run func()) *RunReporter_Current_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RunReporter_Current_Call) Return(_a0 *runreport.Report, _a1 bool) *RunReporter_Current_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RunReporter_Current_Call) RunAndReturn(run func() (*runreport.Report, bool)) *RunReporter_Current_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Event provides a mock function with given fields: kind, message
+func (_m *RunReporter) Event(kind string, message string) {
+	_m.Called(kind, message)
+}
+
+// RunReporter_Event_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Event'
+type RunReporter_Event_Call struct {
+	*mock.Call
+}
+
+// Event is a helper method to define mock.On call
+//   - kind string
+//   - message string
+func (_e *RunReporter_Expecter) Event(kind interface{}, message interface{}) *RunReporter_Event_Call {
+	return &RunReporter_Event_Call{Call: _e.mock.On("Event", kind, message)}
+}
+
+func (_c *RunReporter_Event_Call) Run(run func(kind string, message string)) *RunReporter_Event_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RunReporter_Event_Call) Return() *RunReporter_Event_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *RunReporter_Event_Call) RunAndReturn(run func(string, string)) *RunReporter_Event_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Finish provides a mock function with given fields: runErr
+func (_m *RunReporter) Finish(runErr error) (*runreport.Report, error) {
+	ret := _m.Called(runErr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Finish")
+	}
+
+	var r0 *runreport.Report
+	var r1 error
+	if rf, ok := ret.Get(0).(func(error) (*runreport.Report, error)); ok {
+		return rf(runErr)
+	}
+	if rf, ok := ret.Get(0).(func(error) *runreport.Report); ok {
+		r0 = rf(runErr)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*runreport.Report)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(error) error); ok {
+		r1 = rf(runErr)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RunReporter_Finish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Finish'
+type RunReporter_Finish_Call struct {
+	*mock.Call
+}
+
+// Finish is a helper method to define mock.On call
+//   - runErr error
+func (_e *RunReporter_Expecter) Finish(runErr interface{}) *RunReporter_Finish_Call {
+	return &RunReporter_Finish_Call{Call: _e.mock.On("Finish", runErr)}
+}
+
+func (_c *RunReporter_Finish_Call) Run(run func(runErr error)) *RunReporter_Finish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(error))
+	})
+	return _c
+}
+
+func (_c *RunReporter_Finish_Call) Return(_a0 *runreport.Report, _a1 error) *RunReporter_Finish_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RunReporter_Finish_Call) RunAndReturn(run func(error) (*runreport.Report, error)) *RunReporter_Finish_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Handler provides a mock function with no fields
+func (_m *RunReporter) Handler() http.Handler {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handler")
+	}
+
+	var r0 http.Handler
+	if rf, ok := ret.Get(0).(func() http.Handler); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(http.Handler)
+		}
+	}
+
+	return r0
+}
+
+// RunReporter_Handler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handler'
+type RunReporter_Handler_Call struct {
+	*mock.Call
+}
+
+// Handler is a helper method to define mock.On call
+func (_e *RunReporter_Expecter) Handler() *RunReporter_Handler_Call {
+	return &RunReporter_Handler_Call{Call: _e.mock.On("Handler")}
+}
+
+func (_c *RunReporter_Handler_Call) Run(run func()) *RunReporter_Handler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RunReporter_Handler_Call) Return(_a0 http.Handler) *RunReporter_Handler_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RunReporter_Handler_Call) RunAndReturn(run func() http.Handler) *RunReporter_Handler_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Latest provides a mock function with no fields
+func (_m *RunReporter) Latest() (*runreport.Report, bool) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Latest")
+	}
+
+	var r0 *runreport.Report
+	var r1 bool
+	if rf, ok := ret.Get(0).(func() (*runreport.Report, bool)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *runreport.Report); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*runreport.Report)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// RunReporter_Latest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Latest'
+type RunReporter_Latest_Call struct {
+	*mock.Call
+}
+
+// Latest is a helper method to define mock.On call
+func (_e *RunReporter_Expecter) Latest() *RunReporter_Latest_Call {
+	return &RunReporter_Latest_Call{Call: _e.mock.On("Latest")}
+}
+
+func (_c *RunReporter_Latest_Call) Run(run func()) *RunReporter_Latest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RunReporter_Latest_Call) Return(_a0 *runreport.Report, _a1 bool) *RunReporter_Latest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RunReporter_Latest_Call) RunAndReturn(run func() (*runreport.Report, bool)) *RunReporter_Latest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Positions provides a mock function with given fields: runID
+func (_m *RunReporter) Positions(runID string) ([]runreport.Position, error) {
+	ret := _m.Called(runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Positions")
+	}
+
+	var r0 []runreport.Position
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]runreport.Position, error)); ok {
+		return rf(runID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []runreport.Position); ok {
+		r0 = rf(runID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]runreport.Position)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(runID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RunReporter_Positions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Positions'
+type RunReporter_Positions_Call struct {
+	*mock.Call
+}
+
+// Positions is a helper method to define mock.On call
+//   - runID string
+func (_e *RunReporter_Expecter) Positions(runID interface{}) *RunReporter_Positions_Call {
+	return &RunReporter_Positions_Call{Call: _e.mock.On("Positions", runID)}
+}
+
+func (_c *RunReporter_Positions_Call) Run(run func(runID string)) *RunReporter_Positions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *RunReporter_Positions_Call) Return(_a0 []runreport.Position, _a1 error) *RunReporter_Positions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RunReporter_Positions_Call) RunAndReturn(run func(string) ([]runreport.Position, error)) *RunReporter_Positions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RealizedPnL provides a mock function with given fields: runID
+func (_m *RunReporter) RealizedPnL(runID string) (numerical.Decimal, error) {
+	ret := _m.Called(runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RealizedPnL")
+	}
+
+	var r0 numerical.Decimal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (numerical.Decimal, error)); ok {
+		return rf(runID)
+	}
+	if rf, ok := ret.Get(0).(func(string) numerical.Decimal); ok {
+		r0 = rf(runID)
+	} else {
+		r0 = ret.Get(0).(numerical.Decimal)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(runID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RunReporter_RealizedPnL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RealizedPnL'
+type RunReporter_RealizedPnL_Call struct {
+	*mock.Call
+}
+
+// RealizedPnL is a helper method to define mock.On call
+//   - runID string
+func (_e *RunReporter_Expecter) RealizedPnL(runID interface{}) *RunReporter_RealizedPnL_Call {
+	return &RunReporter_RealizedPnL_Call{Call: _e.mock.On("RealizedPnL", runID)}
+}
+
+func (_c *RunReporter_RealizedPnL_Call) Run(run func(runID string)) *RunReporter_RealizedPnL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *RunReporter_RealizedPnL_Call) Return(_a0 numerical.Decimal, _a1 error) *RunReporter_RealizedPnL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RunReporter_RealizedPnL_Call) RunAndReturn(run func(string) (numerical.Decimal, error)) *RunReporter_RealizedPnL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Report provides a mock function with given fields: runID
+func (_m *RunReporter) Report(runID string) (*runreport.Report, bool) {
+	ret := _m.Called(runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Report")
+	}
+
+	var r0 *runreport.Report
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string) (*runreport.Report, bool)); ok {
+		return rf(runID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *runreport.Report); ok {
+		r0 = rf(runID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*runreport.Report)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(runID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// RunReporter_Report_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Report'
+type RunReporter_Report_Call struct {
+	*mock.Call
+}
+
+// Report is a helper method to define mock.On call
+//   - runID string
+func (_e *RunReporter_Expecter) Report(runID interface{}) *RunReporter_Report_Call {
+	return &RunReporter_Report_Call{Call: _e.mock.On("Report", runID)}
+}
+
+func (_c *RunReporter_Report_Call) Run(run func(runID string)) *RunReporter_Report_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *RunReporter_Report_Call) Return(_a0 *runreport.Report, _a1 bool) *RunReporter_Report_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RunReporter_Report_Call) RunAndReturn(run func(string) (*runreport.Report, bool)) *RunReporter_Report_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRunReporter creates a new instance of RunReporter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRunReporter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RunReporter {
+	mock := &RunReporter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
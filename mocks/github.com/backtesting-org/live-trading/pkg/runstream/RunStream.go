@@ -0,0 +1,333 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package runstream
+
+import (
+	http "net/http"
+
+	runstream "github.com/backtesting-org/live-trading/pkg/runstream"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RunStream is an autogenerated mock type for the RunStream type
+type RunStream struct {
+	mock.Mock
+}
+
+type RunStream_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RunStream) EXPECT() *RunStream_Expecter {
+	return &RunStream_Expecter{mock: &_m.Mock}
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *RunStream) Configure(config runstream.Config) error {
+	ret := _m.Called(config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Configure")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(runstream.Config) error); ok {
+		r0 = rf(config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RunStream_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type RunStream_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config runstream.Config
+func (_e *RunStream_Expecter) Configure(config interface{}) *RunStream_Configure_Call {
+	return &RunStream_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *RunStream_Configure_Call) Run(run func(config runstream.Config)) *RunStream_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(runstream.Config))
+	})
+	return _c
+}
+
+func (_c *RunStream_Configure_Call) Return(_a0 error) *RunStream_Configure_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RunStream_Configure_Call) RunAndReturn(run func(runstream.Config) error) *RunStream_Configure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Handler provides a mock function with no fields
+func (_m *RunStream) Handler() http.Handler {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handler")
+	}
+
+	var r0 http.Handler
+	if rf, ok := ret.Get(0).(func() http.Handler); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(http.Handler)
+		}
+	}
+
+	return r0
+}
+
+// RunStream_Handler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handler'
+type RunStream_Handler_Call struct {
+	*mock.Call
+}
+
+// Handler is a helper method to define mock.On call
+func (_e *RunStream_Expecter) Handler() *RunStream_Handler_Call {
+	return &RunStream_Handler_Call{Call: _e.mock.On("Handler")}
+}
+
+func (_c *RunStream_Handler_Call) Run(run func()) *RunStream_Handler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RunStream_Handler_Call) Return(_a0 http.Handler) *RunStream_Handler_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RunStream_Handler_Call) RunAndReturn(run func() http.Handler) *RunStream_Handler_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with no fields
+func (_m *RunStream) Start() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RunStream_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type RunStream_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+func (_e *RunStream_Expecter) Start() *RunStream_Start_Call {
+	return &RunStream_Start_Call{Call: _e.mock.On("Start")}
+}
+
+func (_c *RunStream_Start_Call) Run(run func()) *RunStream_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RunStream_Start_Call) Return(_a0 error) *RunStream_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RunStream_Start_Call) RunAndReturn(run func() error) *RunStream_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stats provides a mock function with no fields
+func (_m *RunStream) Stats() runstream.Stats {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stats")
+	}
+
+	var r0 runstream.Stats
+	if rf, ok := ret.Get(0).(func() runstream.Stats); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(runstream.Stats)
+	}
+
+	return r0
+}
+
+// RunStream_Stats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stats'
+type RunStream_Stats_Call struct {
+	*mock.Call
+}
+
+// Stats is a helper method to define mock.On call
+func (_e *RunStream_Expecter) Stats() *RunStream_Stats_Call {
+	return &RunStream_Stats_Call{Call: _e.mock.On("Stats")}
+}
+
+func (_c *RunStream_Stats_Call) Run(run func()) *RunStream_Stats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RunStream_Stats_Call) Return(_a0 runstream.Stats) *RunStream_Stats_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RunStream_Stats_Call) RunAndReturn(run func() runstream.Stats) *RunStream_Stats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with no fields
+func (_m *RunStream) Stop() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RunStream_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type RunStream_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *RunStream_Expecter) Stop() *RunStream_Stop_Call {
+	return &RunStream_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *RunStream_Stop_Call) Run(run func()) *RunStream_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RunStream_Stop_Call) Return(_a0 error) *RunStream_Stop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RunStream_Stop_Call) RunAndReturn(run func() error) *RunStream_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Subscribe provides a mock function with given fields: runID, kinds
+func (_m *RunStream) Subscribe(runID string, kinds []string) (<-chan runstream.Event, func(), error) {
+	ret := _m.Called(runID, kinds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 <-chan runstream.Event
+	var r1 func()
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, []string) (<-chan runstream.Event, func(), error)); ok {
+		return rf(runID, kinds)
+	}
+	if rf, ok := ret.Get(0).(func(string, []string) <-chan runstream.Event); ok {
+		r0 = rf(runID, kinds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan runstream.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, []string) func()); ok {
+		r1 = rf(runID, kinds)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(string, []string) error); ok {
+		r2 = rf(runID, kinds)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// RunStream_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type RunStream_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - runID string
+//   - kinds []string
+func (_e *RunStream_Expecter) Subscribe(runID interface{}, kinds interface{}) *RunStream_Subscribe_Call {
+	return &RunStream_Subscribe_Call{Call: _e.mock.On("Subscribe", runID, kinds)}
+}
+
+func (_c *RunStream_Subscribe_Call) Run(run func(runID string, kinds []string)) *RunStream_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *RunStream_Subscribe_Call) Return(_a0 <-chan runstream.Event, _a1 func(), _a2 error) *RunStream_Subscribe_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *RunStream_Subscribe_Call) RunAndReturn(run func(string, []string) (<-chan runstream.Event, func(), error)) *RunStream_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRunStream creates a new instance of RunStream. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRunStream(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RunStream {
+	mock := &RunStream{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,117 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package scheduler
+
+import (
+	scheduler "github.com/backtesting-org/live-trading/pkg/scheduler"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// HistoryStore is an autogenerated mock type for the HistoryStore type
+type HistoryStore struct {
+	mock.Mock
+}
+
+type HistoryStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *HistoryStore) EXPECT() *HistoryStore_Expecter {
+	return &HistoryStore_Expecter{mock: &_m.Mock}
+}
+
+// Recent provides a mock function with given fields: job, limit
+func (_m *HistoryStore) Recent(job string, limit int) []scheduler.RunRecord {
+	ret := _m.Called(job, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Recent")
+	}
+
+	var r0 []scheduler.RunRecord
+	if rf, ok := ret.Get(0).(func(string, int) []scheduler.RunRecord); ok {
+		r0 = rf(job, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]scheduler.RunRecord)
+		}
+	}
+
+	return r0
+}
+
+// HistoryStore_Recent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Recent'
+type HistoryStore_Recent_Call struct {
+	*mock.Call
+}
+
+// Recent is a helper method to define mock.On call
+//   - job string
+//   - limit int
+func (_e *HistoryStore_Expecter) Recent(job interface{}, limit interface{}) *HistoryStore_Recent_Call {
+	return &HistoryStore_Recent_Call{Call: _e.mock.On("Recent", job, limit)}
+}
+
+func (_c *HistoryStore_Recent_Call) Run(run func(job string, limit int)) *HistoryStore_Recent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *HistoryStore_Recent_Call) Return(_a0 []scheduler.RunRecord) *HistoryStore_Recent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *HistoryStore_Recent_Call) RunAndReturn(run func(string, int) []scheduler.RunRecord) *HistoryStore_Recent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Record provides a mock function with given fields: record
+func (_m *HistoryStore) Record(record scheduler.RunRecord) {
+	_m.Called(record)
+}
+
+// HistoryStore_Record_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Record'
+type HistoryStore_Record_Call struct {
+	*mock.Call
+}
+
+// Record is a helper method to define mock.On call
+//   - record scheduler.RunRecord
+func (_e *HistoryStore_Expecter) Record(record interface{}) *HistoryStore_Record_Call {
+	return &HistoryStore_Record_Call{Call: _e.mock.On("Record", record)}
+}
+
+func (_c *HistoryStore_Record_Call) Run(run func(record scheduler.RunRecord)) *HistoryStore_Record_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(scheduler.RunRecord))
+	})
+	return _c
+}
+
+func (_c *HistoryStore_Record_Call) Return() *HistoryStore_Record_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *HistoryStore_Record_Call) RunAndReturn(run func(scheduler.RunRecord)) *HistoryStore_Record_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewHistoryStore creates a new instance of HistoryStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewHistoryStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *HistoryStore {
+	mock := &HistoryStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
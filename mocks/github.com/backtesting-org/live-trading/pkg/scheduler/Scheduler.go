@@ -0,0 +1,484 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package scheduler
+
+import (
+	context "context"
+
+	scheduler "github.com/backtesting-org/live-trading/pkg/scheduler"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Scheduler is an autogenerated mock type for the Scheduler type
+type Scheduler struct {
+	mock.Mock
+}
+
+type Scheduler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Scheduler) EXPECT() *Scheduler_Expecter {
+	return &Scheduler_Expecter{mock: &_m.Mock}
+}
+
+// Disable provides a mock function with given fields: name
+func (_m *Scheduler) Disable(name string) error {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Disable")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Scheduler_Disable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Disable'
+type Scheduler_Disable_Call struct {
+	*mock.Call
+}
+
+// Disable is a helper method to define mock.On call
+//   - name string
+func (_e *Scheduler_Expecter) Disable(name interface{}) *Scheduler_Disable_Call {
+	return &Scheduler_Disable_Call{Call: _e.mock.On("Disable", name)}
+}
+
+func (_c *Scheduler_Disable_Call) Run(run func(name string)) *Scheduler_Disable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Scheduler_Disable_Call) Return(_a0 error) *Scheduler_Disable_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Scheduler_Disable_Call) RunAndReturn(run func(string) error) *Scheduler_Disable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Enable provides a mock function with given fields: name
+func (_m *Scheduler) Enable(name string) error {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Enable")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Scheduler_Enable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Enable'
+type Scheduler_Enable_Call struct {
+	*mock.Call
+}
+
+// Enable is a helper method to define mock.On call
+//   - name string
+func (_e *Scheduler_Expecter) Enable(name interface{}) *Scheduler_Enable_Call {
+	return &Scheduler_Enable_Call{Call: _e.mock.On("Enable", name)}
+}
+
+func (_c *Scheduler_Enable_Call) Run(run func(name string)) *Scheduler_Enable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Scheduler_Enable_Call) Return(_a0 error) *Scheduler_Enable_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Scheduler_Enable_Call) RunAndReturn(run func(string) error) *Scheduler_Enable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// History provides a mock function with given fields: name, limit
+func (_m *Scheduler) History(name string, limit int) ([]scheduler.RunRecord, error) {
+	ret := _m.Called(name, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for History")
+	}
+
+	var r0 []scheduler.RunRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int) ([]scheduler.RunRecord, error)); ok {
+		return rf(name, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) []scheduler.RunRecord); ok {
+		r0 = rf(name, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]scheduler.RunRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(name, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Scheduler_History_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'History'
+type Scheduler_History_Call struct {
+	*mock.Call
+}
+
+// History is a helper method to define mock.On call
+//   - name string
+//   - limit int
+func (_e *Scheduler_Expecter) History(name interface{}, limit interface{}) *Scheduler_History_Call {
+	return &Scheduler_History_Call{Call: _e.mock.On("History", name, limit)}
+}
+
+func (_c *Scheduler_History_Call) Run(run func(name string, limit int)) *Scheduler_History_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *Scheduler_History_Call) Return(_a0 []scheduler.RunRecord, _a1 error) *Scheduler_History_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Scheduler_History_Call) RunAndReturn(run func(string, int) ([]scheduler.RunRecord, error)) *Scheduler_History_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Jobs provides a mock function with no fields
+func (_m *Scheduler) Jobs() []scheduler.JobStatus {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Jobs")
+	}
+
+	var r0 []scheduler.JobStatus
+	if rf, ok := ret.Get(0).(func() []scheduler.JobStatus); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]scheduler.JobStatus)
+		}
+	}
+
+	return r0
+}
+
+// Scheduler_Jobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Jobs'
+type Scheduler_Jobs_Call struct {
+	*mock.Call
+}
+
+// Jobs is a helper method to define mock.On call
+func (_e *Scheduler_Expecter) Jobs() *Scheduler_Jobs_Call {
+	return &Scheduler_Jobs_Call{Call: _e.mock.On("Jobs")}
+}
+
+func (_c *Scheduler_Jobs_Call) Run(run func()) *Scheduler_Jobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Scheduler_Jobs_Call) Return(_a0 []scheduler.JobStatus) *Scheduler_Jobs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Scheduler_Jobs_Call) RunAndReturn(run func() []scheduler.JobStatus) *Scheduler_Jobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Register provides a mock function with given fields: job
+func (_m *Scheduler) Register(job scheduler.Job) error {
+	ret := _m.Called(job)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Register")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(scheduler.Job) error); ok {
+		r0 = rf(job)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Scheduler_Register_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Register'
+type Scheduler_Register_Call struct {
+	*mock.Call
+}
+
+// Register is a helper method to define mock.On call
+//   - job scheduler.Job
+func (_e *Scheduler_Expecter) Register(job interface{}) *Scheduler_Register_Call {
+	return &Scheduler_Register_Call{Call: _e.mock.On("Register", job)}
+}
+
+func (_c *Scheduler_Register_Call) Run(run func(job scheduler.Job)) *Scheduler_Register_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(scheduler.Job))
+	})
+	return _c
+}
+
+func (_c *Scheduler_Register_Call) Return(_a0 error) *Scheduler_Register_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Scheduler_Register_Call) RunAndReturn(run func(scheduler.Job) error) *Scheduler_Register_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetHistoryStore provides a mock function with given fields: store
+func (_m *Scheduler) SetHistoryStore(store scheduler.HistoryStore) {
+	_m.Called(store)
+}
+
+// Scheduler_SetHistoryStore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetHistoryStore'
+type Scheduler_SetHistoryStore_Call struct {
+	*mock.Call
+}
+
+// SetHistoryStore is a helper method to define mock.On call
+//   - store scheduler.HistoryStore
+func (_e *Scheduler_Expecter) SetHistoryStore(store interface{}) *Scheduler_SetHistoryStore_Call {
+	return &Scheduler_SetHistoryStore_Call{Call: _e.mock.On("SetHistoryStore", store)}
+}
+
+func (_c *Scheduler_SetHistoryStore_Call) Run(run func(store scheduler.HistoryStore)) *Scheduler_SetHistoryStore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(scheduler.HistoryStore))
+	})
+	return _c
+}
+
+func (_c *Scheduler_SetHistoryStore_Call) Return() *Scheduler_SetHistoryStore_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Scheduler_SetHistoryStore_Call) RunAndReturn(run func(scheduler.HistoryStore)) *Scheduler_SetHistoryStore_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Start provides a mock function with given fields: ctx
+func (_m *Scheduler) Start(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Scheduler_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type Scheduler_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Scheduler_Expecter) Start(ctx interface{}) *Scheduler_Start_Call {
+	return &Scheduler_Start_Call{Call: _e.mock.On("Start", ctx)}
+}
+
+func (_c *Scheduler_Start_Call) Run(run func(ctx context.Context)) *Scheduler_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Scheduler_Start_Call) Return(_a0 error) *Scheduler_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Scheduler_Start_Call) RunAndReturn(run func(context.Context) error) *Scheduler_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with no fields
+func (_m *Scheduler) Stop() {
+	_m.Called()
+}
+
+// Scheduler_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type Scheduler_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *Scheduler_Expecter) Stop() *Scheduler_Stop_Call {
+	return &Scheduler_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *Scheduler_Stop_Call) Run(run func()) *Scheduler_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Scheduler_Stop_Call) Return() *Scheduler_Stop_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Scheduler_Stop_Call) RunAndReturn(run func()) *Scheduler_Stop_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Trigger provides a mock function with given fields: name
+func (_m *Scheduler) Trigger(name string) error {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Trigger")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Scheduler_Trigger_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Trigger'
+type Scheduler_Trigger_Call struct {
+	*mock.Call
+}
+
+// Trigger is a helper method to define mock.On call
+//   - name string
+func (_e *Scheduler_Expecter) Trigger(name interface{}) *Scheduler_Trigger_Call {
+	return &Scheduler_Trigger_Call{Call: _e.mock.On("Trigger", name)}
+}
+
+func (_c *Scheduler_Trigger_Call) Run(run func(name string)) *Scheduler_Trigger_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Scheduler_Trigger_Call) Return(_a0 error) *Scheduler_Trigger_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Scheduler_Trigger_Call) RunAndReturn(run func(string) error) *Scheduler_Trigger_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unregister provides a mock function with given fields: name
+func (_m *Scheduler) Unregister(name string) error {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unregister")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Scheduler_Unregister_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unregister'
+type Scheduler_Unregister_Call struct {
+	*mock.Call
+}
+
+// Unregister is a helper method to define mock.On call
+//   - name string
+func (_e *Scheduler_Expecter) Unregister(name interface{}) *Scheduler_Unregister_Call {
+	return &Scheduler_Unregister_Call{Call: _e.mock.On("Unregister", name)}
+}
+
+func (_c *Scheduler_Unregister_Call) Run(run func(name string)) *Scheduler_Unregister_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Scheduler_Unregister_Call) Return(_a0 error) *Scheduler_Unregister_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Scheduler_Unregister_Call) RunAndReturn(run func(string) error) *Scheduler_Unregister_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewScheduler creates a new instance of Scheduler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewScheduler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Scheduler {
+	mock := &Scheduler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
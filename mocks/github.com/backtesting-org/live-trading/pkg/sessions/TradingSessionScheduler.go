@@ -0,0 +1,320 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package sessions
+
+import (
+	sessions "github.com/backtesting-org/live-trading/pkg/sessions"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TradingSessionScheduler is an autogenerated mock type for the TradingSessionScheduler type
+type TradingSessionScheduler struct {
+	mock.Mock
+}
+
+type TradingSessionScheduler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TradingSessionScheduler) EXPECT() *TradingSessionScheduler_Expecter {
+	return &TradingSessionScheduler_Expecter{mock: &_m.Mock}
+}
+
+// Check provides a mock function with no fields
+func (_m *TradingSessionScheduler) Check() (*sessions.Transition, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Check")
+	}
+
+	var r0 *sessions.Transition
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*sessions.Transition, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *sessions.Transition); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sessions.Transition)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TradingSessionScheduler_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type TradingSessionScheduler_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+func (_e *TradingSessionScheduler_Expecter) Check() *TradingSessionScheduler_Check_Call {
+	return &TradingSessionScheduler_Check_Call{Call: _e.mock.On("Check")}
+}
+
+func (_c *TradingSessionScheduler_Check_Call) Run(run func()) *TradingSessionScheduler_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TradingSessionScheduler_Check_Call) Return(_a0 *sessions.Transition, _a1 error) *TradingSessionScheduler_Check_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TradingSessionScheduler_Check_Call) RunAndReturn(run func() (*sessions.Transition, error)) *TradingSessionScheduler_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *TradingSessionScheduler) Configure(config sessions.Config) error {
+	ret := _m.Called(config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Configure")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(sessions.Config) error); ok {
+		r0 = rf(config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TradingSessionScheduler_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type TradingSessionScheduler_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config sessions.Config
+func (_e *TradingSessionScheduler_Expecter) Configure(config interface{}) *TradingSessionScheduler_Configure_Call {
+	return &TradingSessionScheduler_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *TradingSessionScheduler_Configure_Call) Run(run func(config sessions.Config)) *TradingSessionScheduler_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(sessions.Config))
+	})
+	return _c
+}
+
+func (_c *TradingSessionScheduler_Configure_Call) Return(_a0 error) *TradingSessionScheduler_Configure_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TradingSessionScheduler_Configure_Call) RunAndReturn(run func(sessions.Config) error) *TradingSessionScheduler_Configure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Open provides a mock function with no fields
+func (_m *TradingSessionScheduler) Open() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Open")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// TradingSessionScheduler_Open_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Open'
+type TradingSessionScheduler_Open_Call struct {
+	*mock.Call
+}
+
+// Open is a helper method to define mock.On call
+func (_e *TradingSessionScheduler_Expecter) Open() *TradingSessionScheduler_Open_Call {
+	return &TradingSessionScheduler_Open_Call{Call: _e.mock.On("Open")}
+}
+
+func (_c *TradingSessionScheduler_Open_Call) Run(run func()) *TradingSessionScheduler_Open_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TradingSessionScheduler_Open_Call) Return(_a0 bool) *TradingSessionScheduler_Open_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TradingSessionScheduler_Open_Call) RunAndReturn(run func() bool) *TradingSessionScheduler_Open_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with no fields
+func (_m *TradingSessionScheduler) Start() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TradingSessionScheduler_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type TradingSessionScheduler_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+func (_e *TradingSessionScheduler_Expecter) Start() *TradingSessionScheduler_Start_Call {
+	return &TradingSessionScheduler_Start_Call{Call: _e.mock.On("Start")}
+}
+
+func (_c *TradingSessionScheduler_Start_Call) Run(run func()) *TradingSessionScheduler_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TradingSessionScheduler_Start_Call) Return(_a0 error) *TradingSessionScheduler_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TradingSessionScheduler_Start_Call) RunAndReturn(run func() error) *TradingSessionScheduler_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with no fields
+func (_m *TradingSessionScheduler) Stop() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TradingSessionScheduler_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type TradingSessionScheduler_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *TradingSessionScheduler_Expecter) Stop() *TradingSessionScheduler_Stop_Call {
+	return &TradingSessionScheduler_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *TradingSessionScheduler_Stop_Call) Run(run func()) *TradingSessionScheduler_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TradingSessionScheduler_Stop_Call) Return(_a0 error) *TradingSessionScheduler_Stop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TradingSessionScheduler_Stop_Call) RunAndReturn(run func() error) *TradingSessionScheduler_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Transitions provides a mock function with no fields
+func (_m *TradingSessionScheduler) Transitions() <-chan sessions.Transition {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Transitions")
+	}
+
+	var r0 <-chan sessions.Transition
+	if rf, ok := ret.Get(0).(func() <-chan sessions.Transition); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan sessions.Transition)
+		}
+	}
+
+	return r0
+}
+
+// TradingSessionScheduler_Transitions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Transitions'
+type TradingSessionScheduler_Transitions_Call struct {
+	*mock.Call
+}
+
+// Transitions is a helper method to define mock.On call
+func (_e *TradingSessionScheduler_Expecter) Transitions() *TradingSessionScheduler_Transitions_Call {
+	return &TradingSessionScheduler_Transitions_Call{Call: _e.mock.On("Transitions")}
+}
+
+func (_c *TradingSessionScheduler_Transitions_Call) Run(run func()) *TradingSessionScheduler_Transitions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TradingSessionScheduler_Transitions_Call) Return(_a0 <-chan sessions.Transition) *TradingSessionScheduler_Transitions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TradingSessionScheduler_Transitions_Call) RunAndReturn(run func() <-chan sessions.Transition) *TradingSessionScheduler_Transitions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTradingSessionScheduler creates a new instance of TradingSessionScheduler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTradingSessionScheduler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TradingSessionScheduler {
+	mock := &TradingSessionScheduler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,494 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package shortfall
+
+import (
+	http "net/http"
+
+	execution "github.com/backtesting-org/kronos-sdk/pkg/types/execution"
+
+	mock "github.com/stretchr/testify/mock"
+
+	shortfall "github.com/backtesting-org/live-trading/pkg/shortfall"
+
+	strategy "github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+)
+
+// ShortfallPolicy is an autogenerated mock type for the ShortfallPolicy type
+type ShortfallPolicy struct {
+	mock.Mock
+}
+
+type ShortfallPolicy_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ShortfallPolicy) EXPECT() *ShortfallPolicy_Expecter {
+	return &ShortfallPolicy_Expecter{mock: &_m.Mock}
+}
+
+// Check provides a mock function with given fields: signal
+func (_m *ShortfallPolicy) Check(signal *strategy.Signal) (*strategy.Signal, error) {
+	ret := _m.Called(signal)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Check")
+	}
+
+	var r0 *strategy.Signal
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*strategy.Signal) (*strategy.Signal, error)); ok {
+		return rf(signal)
+	}
+	if rf, ok := ret.Get(0).(func(*strategy.Signal) *strategy.Signal); ok {
+		r0 = rf(signal)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*strategy.Signal)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*strategy.Signal) error); ok {
+		r1 = rf(signal)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ShortfallPolicy_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type ShortfallPolicy_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+//   - signal *strategy.Signal
+func (_e *ShortfallPolicy_Expecter) Check(signal interface{}) *ShortfallPolicy_Check_Call {
+	return &ShortfallPolicy_Check_Call{Call: _e.mock.On("Check", signal)}
+}
+
+func (_c *ShortfallPolicy_Check_Call) Run(run func(signal *strategy.Signal)) *ShortfallPolicy_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*strategy.Signal))
+	})
+	return _c
+}
+
+func (_c *ShortfallPolicy_Check_Call) Return(_a0 *strategy.Signal, _a1 error) *ShortfallPolicy_Check_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ShortfallPolicy_Check_Call) RunAndReturn(run func(*strategy.Signal) (*strategy.Signal, error)) *ShortfallPolicy_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *ShortfallPolicy) Configure(config shortfall.Config) error {
+	ret := _m.Called(config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Configure")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(shortfall.Config) error); ok {
+		r0 = rf(config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ShortfallPolicy_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type ShortfallPolicy_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config shortfall.Config
+func (_e *ShortfallPolicy_Expecter) Configure(config interface{}) *ShortfallPolicy_Configure_Call {
+	return &ShortfallPolicy_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *ShortfallPolicy_Configure_Call) Run(run func(config shortfall.Config)) *ShortfallPolicy_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(shortfall.Config))
+	})
+	return _c
+}
+
+func (_c *ShortfallPolicy_Configure_Call) Return(_a0 error) *ShortfallPolicy_Configure_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ShortfallPolicy_Configure_Call) RunAndReturn(run func(shortfall.Config) error) *ShortfallPolicy_Configure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Handler provides a mock function with no fields
+func (_m *ShortfallPolicy) Handler() http.Handler {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handler")
+	}
+
+	var r0 http.Handler
+	if rf, ok := ret.Get(0).(func() http.Handler); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(http.Handler)
+		}
+	}
+
+	return r0
+}
+
+// ShortfallPolicy_Handler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handler'
+type ShortfallPolicy_Handler_Call struct {
+	*mock.Call
+}
+
+// Handler is a helper method to define mock.On call
+func (_e *ShortfallPolicy_Expecter) Handler() *ShortfallPolicy_Handler_Call {
+	return &ShortfallPolicy_Handler_Call{Call: _e.mock.On("Handler")}
+}
+
+func (_c *ShortfallPolicy_Handler_Call) Run(run func()) *ShortfallPolicy_Handler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ShortfallPolicy_Handler_Call) Return(_a0 http.Handler) *ShortfallPolicy_Handler_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ShortfallPolicy_Handler_Call) RunAndReturn(run func() http.Handler) *ShortfallPolicy_Handler_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Record provides a mock function with given fields: signal, err
+func (_m *ShortfallPolicy) Record(signal *strategy.Signal, err error) (shortfall.Rejection, bool) {
+	ret := _m.Called(signal, err)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Record")
+	}
+
+	var r0 shortfall.Rejection
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(*strategy.Signal, error) (shortfall.Rejection, bool)); ok {
+		return rf(signal, err)
+	}
+	if rf, ok := ret.Get(0).(func(*strategy.Signal, error) shortfall.Rejection); ok {
+		r0 = rf(signal, err)
+	} else {
+		r0 = ret.Get(0).(shortfall.Rejection)
+	}
+
+	if rf, ok := ret.Get(1).(func(*strategy.Signal, error) bool); ok {
+		r1 = rf(signal, err)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// ShortfallPolicy_Record_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Record'
+type ShortfallPolicy_Record_Call struct {
+	*mock.Call
+}
+
+// Record is a helper method to define mock.On call
+//   - signal *strategy.Signal
+//   - err error
+func (_e *ShortfallPolicy_Expecter) Record(signal interface{}, err interface{}) *ShortfallPolicy_Record_Call {
+	return &ShortfallPolicy_Record_Call{Call: _e.mock.On("Record", signal, err)}
+}
+
+func (_c *ShortfallPolicy_Record_Call) Run(run func(signal *strategy.Signal, err error)) *ShortfallPolicy_Record_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*strategy.Signal), args[1].(error))
+	})
+	return _c
+}
+
+func (_c *ShortfallPolicy_Record_Call) Return(_a0 shortfall.Rejection, _a1 bool) *ShortfallPolicy_Record_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ShortfallPolicy_Record_Call) RunAndReturn(run func(*strategy.Signal, error) (shortfall.Rejection, bool)) *ShortfallPolicy_Record_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rejections provides a mock function with no fields
+func (_m *ShortfallPolicy) Rejections() []shortfall.Rejection {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rejections")
+	}
+
+	var r0 []shortfall.Rejection
+	if rf, ok := ret.Get(0).(func() []shortfall.Rejection); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]shortfall.Rejection)
+		}
+	}
+
+	return r0
+}
+
+// ShortfallPolicy_Rejections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rejections'
+type ShortfallPolicy_Rejections_Call struct {
+	*mock.Call
+}
+
+// Rejections is a helper method to define mock.On call
+func (_e *ShortfallPolicy_Expecter) Rejections() *ShortfallPolicy_Rejections_Call {
+	return &ShortfallPolicy_Rejections_Call{Call: _e.mock.On("Rejections")}
+}
+
+func (_c *ShortfallPolicy_Rejections_Call) Run(run func()) *ShortfallPolicy_Rejections_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ShortfallPolicy_Rejections_Call) Return(_a0 []shortfall.Rejection) *ShortfallPolicy_Rejections_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ShortfallPolicy_Rejections_Call) RunAndReturn(run func() []shortfall.Rejection) *ShortfallPolicy_Rejections_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Resume provides a mock function with given fields: runID
+func (_m *ShortfallPolicy) Resume(runID string) error {
+	ret := _m.Called(runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Resume")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(runID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ShortfallPolicy_Resume_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Resume'
+type ShortfallPolicy_Resume_Call struct {
+	*mock.Call
+}
+
+// Resume is a helper method to define mock.On call
+//   - runID string
+func (_e *ShortfallPolicy_Expecter) Resume(runID interface{}) *ShortfallPolicy_Resume_Call {
+	return &ShortfallPolicy_Resume_Call{Call: _e.mock.On("Resume", runID)}
+}
+
+func (_c *ShortfallPolicy_Resume_Call) Run(run func(runID string)) *ShortfallPolicy_Resume_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *ShortfallPolicy_Resume_Call) Return(_a0 error) *ShortfallPolicy_Resume_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ShortfallPolicy_Resume_Call) RunAndReturn(run func(string) error) *ShortfallPolicy_Resume_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// State provides a mock function with given fields: runID
+func (_m *ShortfallPolicy) State(runID string) (shortfall.State, bool) {
+	ret := _m.Called(runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for State")
+	}
+
+	var r0 shortfall.State
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string) (shortfall.State, bool)); ok {
+		return rf(runID)
+	}
+	if rf, ok := ret.Get(0).(func(string) shortfall.State); ok {
+		r0 = rf(runID)
+	} else {
+		r0 = ret.Get(0).(shortfall.State)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(runID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// ShortfallPolicy_State_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'State'
+type ShortfallPolicy_State_Call struct {
+	*mock.Call
+}
+
+// State is a helper method to define mock.On call
+//   - runID string
+func (_e *ShortfallPolicy_Expecter) State(runID interface{}) *ShortfallPolicy_State_Call {
+	return &ShortfallPolicy_State_Call{Call: _e.mock.On("State", runID)}
+}
+
+func (_c *ShortfallPolicy_State_Call) Run(run func(runID string)) *ShortfallPolicy_State_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *ShortfallPolicy_State_Call) Return(_a0 shortfall.State, _a1 bool) *ShortfallPolicy_State_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ShortfallPolicy_State_Call) RunAndReturn(run func(string) (shortfall.State, bool)) *ShortfallPolicy_State_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// States provides a mock function with no fields
+func (_m *ShortfallPolicy) States() []shortfall.State {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for States")
+	}
+
+	var r0 []shortfall.State
+	if rf, ok := ret.Get(0).(func() []shortfall.State); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]shortfall.State)
+		}
+	}
+
+	return r0
+}
+
+// ShortfallPolicy_States_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'States'
+type ShortfallPolicy_States_Call struct {
+	*mock.Call
+}
+
+// States is a helper method to define mock.On call
+func (_e *ShortfallPolicy_Expecter) States() *ShortfallPolicy_States_Call {
+	return &ShortfallPolicy_States_Call{Call: _e.mock.On("States")}
+}
+
+func (_c *ShortfallPolicy_States_Call) Run(run func()) *ShortfallPolicy_States_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ShortfallPolicy_States_Call) Return(_a0 []shortfall.State) *ShortfallPolicy_States_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ShortfallPolicy_States_Call) RunAndReturn(run func() []shortfall.State) *ShortfallPolicy_States_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Wrap provides a mock function with given fields: inner
+func (_m *ShortfallPolicy) Wrap(inner execution.Executor) execution.Executor {
+	ret := _m.Called(inner)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Wrap")
+	}
+
+	var r0 execution.Executor
+	if rf, ok := ret.Get(0).(func(execution.Executor) execution.Executor); ok {
+		r0 = rf(inner)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(execution.Executor)
+		}
+	}
+
+	return r0
+}
+
+// ShortfallPolicy_Wrap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Wrap'
+type ShortfallPolicy_Wrap_Call struct {
+	*mock.Call
+}
+
+// Wrap is a helper method to define mock.On call
+//   - inner execution.Executor
+func (_e *ShortfallPolicy_Expecter) Wrap(inner interface{}) *ShortfallPolicy_Wrap_Call {
+	return &ShortfallPolicy_Wrap_Call{Call: _e.mock.On("Wrap", inner)}
+}
+
+func (_c *ShortfallPolicy_Wrap_Call) Run(run func(inner execution.Executor)) *ShortfallPolicy_Wrap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(execution.Executor))
+	})
+	return _c
+}
+
+func (_c *ShortfallPolicy_Wrap_Call) Return(_a0 execution.Executor) *ShortfallPolicy_Wrap_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ShortfallPolicy_Wrap_Call) RunAndReturn(run func(execution.Executor) execution.Executor) *ShortfallPolicy_Wrap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewShortfallPolicy creates a new instance of ShortfallPolicy. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewShortfallPolicy(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ShortfallPolicy {
+	mock := &ShortfallPolicy{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,221 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package shutdown
+
+import (
+	context "context"
+
+	shutdown "github.com/backtesting-org/live-trading/pkg/shutdown"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Coordinator is an autogenerated mock type for the Coordinator type
+type Coordinator struct {
+	mock.Mock
+}
+
+type Coordinator_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Coordinator) EXPECT() *Coordinator_Expecter {
+	return &Coordinator_Expecter{mock: &_m.Mock}
+}
+
+// Apply provides a mock function with given fields: runID, config
+func (_m *Coordinator) Apply(runID string, config shutdown.Config) error {
+	ret := _m.Called(runID, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Apply")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, shutdown.Config) error); ok {
+		r0 = rf(runID, config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Coordinator_Apply_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Apply'
+type Coordinator_Apply_Call struct {
+	*mock.Call
+}
+
+// Apply is a helper method to define mock.On call
+//   - runID string
+//   - config shutdown.Config
+func (_e *Coordinator_Expecter) Apply(runID interface{}, config interface{}) *Coordinator_Apply_Call {
+	return &Coordinator_Apply_Call{Call: _e.mock.On("Apply", runID, config)}
+}
+
+func (_c *Coordinator_Apply_Call) Run(run func(runID string, config shutdown.Config)) *Coordinator_Apply_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(shutdown.Config))
+	})
+	return _c
+}
+
+func (_c *Coordinator_Apply_Call) Return(_a0 error) *Coordinator_Apply_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Coordinator_Apply_Call) RunAndReturn(run func(string, shutdown.Config) error) *Coordinator_Apply_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Policy provides a mock function with given fields: runID
+func (_m *Coordinator) Policy(runID string) shutdown.Policy {
+	ret := _m.Called(runID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Policy")
+	}
+
+	var r0 shutdown.Policy
+	if rf, ok := ret.Get(0).(func(string) shutdown.Policy); ok {
+		r0 = rf(runID)
+	} else {
+		r0 = ret.Get(0).(shutdown.Policy)
+	}
+
+	return r0
+}
+
+// Coordinator_Policy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Policy'
+type Coordinator_Policy_Call struct {
+	*mock.Call
+}
+
+// Policy is a helper method to define mock.On call
+//   - runID string
+func (_e *Coordinator_Expecter) Policy(runID interface{}) *Coordinator_Policy_Call {
+	return &Coordinator_Policy_Call{Call: _e.mock.On("Policy", runID)}
+}
+
+func (_c *Coordinator_Policy_Call) Run(run func(runID string)) *Coordinator_Policy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Coordinator_Policy_Call) Return(_a0 shutdown.Policy) *Coordinator_Policy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Coordinator_Policy_Call) RunAndReturn(run func(string) shutdown.Policy) *Coordinator_Policy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Release provides a mock function with given fields: runID
+func (_m *Coordinator) Release(runID string) {
+	_m.Called(runID)
+}
+
+// Coordinator_Release_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Release'
+type Coordinator_Release_Call struct {
+	*mock.Call
+}
+
+// Release is a helper method to define mock.On call
+//   - runID string
+func (_e *Coordinator_Expecter) Release(runID interface{}) *Coordinator_Release_Call {
+	return &Coordinator_Release_Call{Call: _e.mock.On("Release", runID)}
+}
+
+func (_c *Coordinator_Release_Call) Run(run func(runID string)) *Coordinator_Release_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Coordinator_Release_Call) Return() *Coordinator_Release_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Coordinator_Release_Call) RunAndReturn(run func(string)) *Coordinator_Release_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Teardown provides a mock function with given fields: ctx
+func (_m *Coordinator) Teardown(ctx context.Context) (*shutdown.Report, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Teardown")
+	}
+
+	var r0 *shutdown.Report
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*shutdown.Report, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *shutdown.Report); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shutdown.Report)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Coordinator_Teardown_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Teardown'
+type Coordinator_Teardown_Call struct {
+	*mock.Call
+}
+
+// Teardown is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Coordinator_Expecter) Teardown(ctx interface{}) *Coordinator_Teardown_Call {
+	return &Coordinator_Teardown_Call{Call: _e.mock.On("Teardown", ctx)}
+}
+
+func (_c *Coordinator_Teardown_Call) Run(run func(ctx context.Context)) *Coordinator_Teardown_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Coordinator_Teardown_Call) Return(_a0 *shutdown.Report, _a1 error) *Coordinator_Teardown_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Coordinator_Teardown_Call) RunAndReturn(run func(context.Context) (*shutdown.Report, error)) *Coordinator_Teardown_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCoordinator creates a new instance of Coordinator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCoordinator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Coordinator {
+	mock := &Coordinator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,163 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package signalarbiter
+
+import (
+	execution "github.com/backtesting-org/kronos-sdk/pkg/types/execution"
+	mock "github.com/stretchr/testify/mock"
+
+	signalarbiter "github.com/backtesting-org/live-trading/pkg/signalarbiter"
+)
+
+// SignalArbiter is an autogenerated mock type for the SignalArbiter type
+type SignalArbiter struct {
+	mock.Mock
+}
+
+type SignalArbiter_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SignalArbiter) EXPECT() *SignalArbiter_Expecter {
+	return &SignalArbiter_Expecter{mock: &_m.Mock}
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *SignalArbiter) Configure(config signalarbiter.Config) error {
+	ret := _m.Called(config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Configure")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(signalarbiter.Config) error); ok {
+		r0 = rf(config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SignalArbiter_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type SignalArbiter_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config signalarbiter.Config
+func (_e *SignalArbiter_Expecter) Configure(config interface{}) *SignalArbiter_Configure_Call {
+	return &SignalArbiter_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *SignalArbiter_Configure_Call) Run(run func(config signalarbiter.Config)) *SignalArbiter_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(signalarbiter.Config))
+	})
+	return _c
+}
+
+func (_c *SignalArbiter_Configure_Call) Return(_a0 error) *SignalArbiter_Configure_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SignalArbiter_Configure_Call) RunAndReturn(run func(signalarbiter.Config) error) *SignalArbiter_Configure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Flush provides a mock function with no fields
+func (_m *SignalArbiter) Flush() {
+	_m.Called()
+}
+
+// SignalArbiter_Flush_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Flush'
+type SignalArbiter_Flush_Call struct {
+	*mock.Call
+}
+
+// Flush is a helper method to define mock.On call
+func (_e *SignalArbiter_Expecter) Flush() *SignalArbiter_Flush_Call {
+	return &SignalArbiter_Flush_Call{Call: _e.mock.On("Flush")}
+}
+
+func (_c *SignalArbiter_Flush_Call) Run(run func()) *SignalArbiter_Flush_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SignalArbiter_Flush_Call) Return() *SignalArbiter_Flush_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *SignalArbiter_Flush_Call) RunAndReturn(run func()) *SignalArbiter_Flush_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Wrap provides a mock function with given fields: inner
+func (_m *SignalArbiter) Wrap(inner execution.Executor) execution.Executor {
+	ret := _m.Called(inner)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Wrap")
+	}
+
+	var r0 execution.Executor
+	if rf, ok := ret.Get(0).(func(execution.Executor) execution.Executor); ok {
+		r0 = rf(inner)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(execution.Executor)
+		}
+	}
+
+	return r0
+}
+
+// SignalArbiter_Wrap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Wrap'
+type SignalArbiter_Wrap_Call struct {
+	*mock.Call
+}
+
+// Wrap is a helper method to define mock.On call
+//   - inner execution.Executor
+func (_e *SignalArbiter_Expecter) Wrap(inner interface{}) *SignalArbiter_Wrap_Call {
+	return &SignalArbiter_Wrap_Call{Call: _e.mock.On("Wrap", inner)}
+}
+
+func (_c *SignalArbiter_Wrap_Call) Run(run func(inner execution.Executor)) *SignalArbiter_Wrap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(execution.Executor))
+	})
+	return _c
+}
+
+func (_c *SignalArbiter_Wrap_Call) Return(_a0 execution.Executor) *SignalArbiter_Wrap_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SignalArbiter_Wrap_Call) RunAndReturn(run func(execution.Executor) execution.Executor) *SignalArbiter_Wrap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSignalArbiter creates a new instance of SignalArbiter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSignalArbiter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SignalArbiter {
+	mock := &SignalArbiter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,330 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package signaljournal
+
+import (
+	connector "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	mock "github.com/stretchr/testify/mock"
+
+	signaljournal "github.com/backtesting-org/live-trading/pkg/signaljournal"
+
+	strategy "github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+
+	uuid "github.com/google/uuid"
+)
+
+// SignalJournal is an autogenerated mock type for the SignalJournal type
+type SignalJournal struct {
+	mock.Mock
+}
+
+type SignalJournal_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SignalJournal) EXPECT() *SignalJournal_Expecter {
+	return &SignalJournal_Expecter{mock: &_m.Mock}
+}
+
+// Close provides a mock function with no fields
+func (_m *SignalJournal) Close() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SignalJournal_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type SignalJournal_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *SignalJournal_Expecter) Close() *SignalJournal_Close_Call {
+	return &SignalJournal_Close_Call{Call: _e.mock.On("Close")}
+}
+
+func (_c *SignalJournal_Close_Call) Run(run func()) *SignalJournal_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SignalJournal_Close_Call) Return(_a0 error) *SignalJournal_Close_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SignalJournal_Close_Call) RunAndReturn(run func() error) *SignalJournal_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *SignalJournal) Configure(config signaljournal.Config) error {
+	ret := _m.Called(config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Configure")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(signaljournal.Config) error); ok {
+		r0 = rf(config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SignalJournal_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type SignalJournal_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config signaljournal.Config
+func (_e *SignalJournal_Expecter) Configure(config interface{}) *SignalJournal_Configure_Call {
+	return &SignalJournal_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *SignalJournal_Configure_Call) Run(run func(config signaljournal.Config)) *SignalJournal_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(signaljournal.Config))
+	})
+	return _c
+}
+
+func (_c *SignalJournal_Configure_Call) Return(_a0 error) *SignalJournal_Configure_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SignalJournal_Configure_Call) RunAndReturn(run func(signaljournal.Config) error) *SignalJournal_Configure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InDoubt provides a mock function with no fields
+func (_m *SignalJournal) InDoubt() []signaljournal.Entry {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for InDoubt")
+	}
+
+	var r0 []signaljournal.Entry
+	if rf, ok := ret.Get(0).(func() []signaljournal.Entry); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]signaljournal.Entry)
+		}
+	}
+
+	return r0
+}
+
+// SignalJournal_InDoubt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InDoubt'
+type SignalJournal_InDoubt_Call struct {
+	*mock.Call
+}
+
+// InDoubt is a helper method to define mock.On call
+func (_e *SignalJournal_Expecter) InDoubt() *SignalJournal_InDoubt_Call {
+	return &SignalJournal_InDoubt_Call{Call: _e.mock.On("InDoubt")}
+}
+
+func (_c *SignalJournal_InDoubt_Call) Run(run func()) *SignalJournal_InDoubt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SignalJournal_InDoubt_Call) Return(_a0 []signaljournal.Entry) *SignalJournal_InDoubt_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SignalJournal_InDoubt_Call) RunAndReturn(run func() []signaljournal.Entry) *SignalJournal_InDoubt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Owner provides a mock function with given fields: exchange, asset
+func (_m *SignalJournal) Owner(exchange connector.ExchangeName, asset string) (strategy.StrategyName, bool) {
+	ret := _m.Called(exchange, asset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Owner")
+	}
+
+	var r0 strategy.StrategyName
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, string) (strategy.StrategyName, bool)); ok {
+		return rf(exchange, asset)
+	}
+	if rf, ok := ret.Get(0).(func(connector.ExchangeName, string) strategy.StrategyName); ok {
+		r0 = rf(exchange, asset)
+	} else {
+		r0 = ret.Get(0).(strategy.StrategyName)
+	}
+
+	if rf, ok := ret.Get(1).(func(connector.ExchangeName, string) bool); ok {
+		r1 = rf(exchange, asset)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// SignalJournal_Owner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Owner'
+type SignalJournal_Owner_Call struct {
+	*mock.Call
+}
+
+// Owner is a helper method to define mock.On call
+//   - exchange connector.ExchangeName
+//   - asset string
+func (_e *SignalJournal_Expecter) Owner(exchange interface{}, asset interface{}) *SignalJournal_Owner_Call {
+	return &SignalJournal_Owner_Call{Call: _e.mock.On("Owner", exchange, asset)}
+}
+
+func (_c *SignalJournal_Owner_Call) Run(run func(exchange connector.ExchangeName, asset string)) *SignalJournal_Owner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(connector.ExchangeName), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *SignalJournal_Owner_Call) Return(_a0 strategy.StrategyName, _a1 bool) *SignalJournal_Owner_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SignalJournal_Owner_Call) RunAndReturn(run func(connector.ExchangeName, string) (strategy.StrategyName, bool)) *SignalJournal_Owner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Pending provides a mock function with given fields: signal
+func (_m *SignalJournal) Pending(signal *strategy.Signal) error {
+	ret := _m.Called(signal)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Pending")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*strategy.Signal) error); ok {
+		r0 = rf(signal)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SignalJournal_Pending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Pending'
+type SignalJournal_Pending_Call struct {
+	*mock.Call
+}
+
+// Pending is a helper method to define mock.On call
+//   - signal *strategy.Signal
+func (_e *SignalJournal_Expecter) Pending(signal interface{}) *SignalJournal_Pending_Call {
+	return &SignalJournal_Pending_Call{Call: _e.mock.On("Pending", signal)}
+}
+
+func (_c *SignalJournal_Pending_Call) Run(run func(signal *strategy.Signal)) *SignalJournal_Pending_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*strategy.Signal))
+	})
+	return _c
+}
+
+func (_c *SignalJournal_Pending_Call) Return(_a0 error) *SignalJournal_Pending_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SignalJournal_Pending_Call) RunAndReturn(run func(*strategy.Signal) error) *SignalJournal_Pending_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Transition provides a mock function with given fields: signalID, status, detail
+func (_m *SignalJournal) Transition(signalID uuid.UUID, status signaljournal.Status, detail string) error {
+	ret := _m.Called(signalID, status, detail)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Transition")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, signaljournal.Status, string) error); ok {
+		r0 = rf(signalID, status, detail)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SignalJournal_Transition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Transition'
+type SignalJournal_Transition_Call struct {
+	*mock.Call
+}
+
+// Transition is a helper method to define mock.On call
+//   - signalID uuid.UUID
+//   - status signaljournal.Status
+//   - detail string
+func (_e *SignalJournal_Expecter) Transition(signalID interface{}, status interface{}, detail interface{}) *SignalJournal_Transition_Call {
+	return &SignalJournal_Transition_Call{Call: _e.mock.On("Transition", signalID, status, detail)}
+}
+
+func (_c *SignalJournal_Transition_Call) Run(run func(signalID uuid.UUID, status signaljournal.Status, detail string)) *SignalJournal_Transition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].(signaljournal.Status), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *SignalJournal_Transition_Call) Return(_a0 error) *SignalJournal_Transition_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SignalJournal_Transition_Call) RunAndReturn(run func(uuid.UUID, signaljournal.Status, string) error) *SignalJournal_Transition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSignalJournal creates a new instance of SignalJournal. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSignalJournal(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SignalJournal {
+	mock := &SignalJournal{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,380 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package signallatency
+
+import (
+	http "net/http"
+
+	execution "github.com/backtesting-org/kronos-sdk/pkg/types/execution"
+
+	mock "github.com/stretchr/testify/mock"
+
+	signallatency "github.com/backtesting-org/live-trading/pkg/signallatency"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// LatencyTracker is an autogenerated mock type for the LatencyTracker type
+type LatencyTracker struct {
+	mock.Mock
+}
+
+type LatencyTracker_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *LatencyTracker) EXPECT() *LatencyTracker_Expecter {
+	return &LatencyTracker_Expecter{mock: &_m.Mock}
+}
+
+// Close provides a mock function with no fields
+func (_m *LatencyTracker) Close() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LatencyTracker_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type LatencyTracker_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *LatencyTracker_Expecter) Close() *LatencyTracker_Close_Call {
+	return &LatencyTracker_Close_Call{Call: _e.mock.On("Close")}
+}
+
+func (_c *LatencyTracker_Close_Call) Run(run func()) *LatencyTracker_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *LatencyTracker_Close_Call) Return(_a0 error) *LatencyTracker_Close_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LatencyTracker_Close_Call) RunAndReturn(run func() error) *LatencyTracker_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *LatencyTracker) Configure(config signallatency.Config) error {
+	ret := _m.Called(config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Configure")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(signallatency.Config) error); ok {
+		r0 = rf(config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LatencyTracker_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type LatencyTracker_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config signallatency.Config
+func (_e *LatencyTracker_Expecter) Configure(config interface{}) *LatencyTracker_Configure_Call {
+	return &LatencyTracker_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *LatencyTracker_Configure_Call) Run(run func(config signallatency.Config)) *LatencyTracker_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(signallatency.Config))
+	})
+	return _c
+}
+
+func (_c *LatencyTracker_Configure_Call) Return(_a0 error) *LatencyTracker_Configure_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LatencyTracker_Configure_Call) RunAndReturn(run func(signallatency.Config) error) *LatencyTracker_Configure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Handler provides a mock function with no fields
+func (_m *LatencyTracker) Handler() http.Handler {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Handler")
+	}
+
+	var r0 http.Handler
+	if rf, ok := ret.Get(0).(func() http.Handler); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(http.Handler)
+		}
+	}
+
+	return r0
+}
+
+// LatencyTracker_Handler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Handler'
+type LatencyTracker_Handler_Call struct {
+	*mock.Call
+}
+
+// Handler is a helper method to define mock.On call
+func (_e *LatencyTracker_Expecter) Handler() *LatencyTracker_Handler_Call {
+	return &LatencyTracker_Handler_Call{Call: _e.mock.On("Handler")}
+}
+
+func (_c *LatencyTracker_Handler_Call) Run(run func()) *LatencyTracker_Handler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *LatencyTracker_Handler_Call) Return(_a0 http.Handler) *LatencyTracker_Handler_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LatencyTracker_Handler_Call) RunAndReturn(run func() http.Handler) *LatencyTracker_Handler_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Hook provides a mock function with no fields
+func (_m *LatencyTracker) Hook() execution.ExecutionHook {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Hook")
+	}
+
+	var r0 execution.ExecutionHook
+	if rf, ok := ret.Get(0).(func() execution.ExecutionHook); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(execution.ExecutionHook)
+		}
+	}
+
+	return r0
+}
+
+// LatencyTracker_Hook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Hook'
+type LatencyTracker_Hook_Call struct {
+	*mock.Call
+}
+
+// Hook is a helper method to define mock.On call
+func (_e *LatencyTracker_Expecter) Hook() *LatencyTracker_Hook_Call {
+	return &LatencyTracker_Hook_Call{Call: _e.mock.On("Hook")}
+}
+
+func (_c *LatencyTracker_Hook_Call) Run(run func()) *LatencyTracker_Hook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *LatencyTracker_Hook_Call) Return(_a0 execution.ExecutionHook) *LatencyTracker_Hook_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LatencyTracker_Hook_Call) RunAndReturn(run func() execution.ExecutionHook) *LatencyTracker_Hook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Summary provides a mock function with given fields: since, until
+func (_m *LatencyTracker) Summary(since time.Time, until time.Time) signallatency.Summary {
+	ret := _m.Called(since, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Summary")
+	}
+
+	var r0 signallatency.Summary
+	if rf, ok := ret.Get(0).(func(time.Time, time.Time) signallatency.Summary); ok {
+		r0 = rf(since, until)
+	} else {
+		r0 = ret.Get(0).(signallatency.Summary)
+	}
+
+	return r0
+}
+
+// LatencyTracker_Summary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Summary'
+type LatencyTracker_Summary_Call struct {
+	*mock.Call
+}
+
+// Summary is a helper method to define mock.On call
+//   - since time.Time
+//   - until time.Time
+func (_e *LatencyTracker_Expecter) Summary(since interface{}, until interface{}) *LatencyTracker_Summary_Call {
+	return &LatencyTracker_Summary_Call{Call: _e.mock.On("Summary", since, until)}
+}
+
+func (_c *LatencyTracker_Summary_Call) Run(run func(since time.Time, until time.Time)) *LatencyTracker_Summary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Time), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *LatencyTracker_Summary_Call) Return(_a0 signallatency.Summary) *LatencyTracker_Summary_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LatencyTracker_Summary_Call) RunAndReturn(run func(time.Time, time.Time) signallatency.Summary) *LatencyTracker_Summary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Timing provides a mock function with given fields: signalID
+func (_m *LatencyTracker) Timing(signalID uuid.UUID) (signallatency.Timing, bool) {
+	ret := _m.Called(signalID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Timing")
+	}
+
+	var r0 signallatency.Timing
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(uuid.UUID) (signallatency.Timing, bool)); ok {
+		return rf(signalID)
+	}
+	if rf, ok := ret.Get(0).(func(uuid.UUID) signallatency.Timing); ok {
+		r0 = rf(signalID)
+	} else {
+		r0 = ret.Get(0).(signallatency.Timing)
+	}
+
+	if rf, ok := ret.Get(1).(func(uuid.UUID) bool); ok {
+		r1 = rf(signalID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// LatencyTracker_Timing_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Timing'
+type LatencyTracker_Timing_Call struct {
+	*mock.Call
+}
+
+// Timing is a helper method to define mock.On call
+//   - signalID uuid.UUID
+func (_e *LatencyTracker_Expecter) Timing(signalID interface{}) *LatencyTracker_Timing_Call {
+	return &LatencyTracker_Timing_Call{Call: _e.mock.On("Timing", signalID)}
+}
+
+func (_c *LatencyTracker_Timing_Call) Run(run func(signalID uuid.UUID)) *LatencyTracker_Timing_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *LatencyTracker_Timing_Call) Return(_a0 signallatency.Timing, _a1 bool) *LatencyTracker_Timing_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LatencyTracker_Timing_Call) RunAndReturn(run func(uuid.UUID) (signallatency.Timing, bool)) *LatencyTracker_Timing_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Wrap provides a mock function with given fields: inner
+func (_m *LatencyTracker) Wrap(inner execution.Executor) execution.Executor {
+	ret := _m.Called(inner)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Wrap")
+	}
+
+	var r0 execution.Executor
+	if rf, ok := ret.Get(0).(func(execution.Executor) execution.Executor); ok {
+		r0 = rf(inner)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(execution.Executor)
+		}
+	}
+
+	return r0
+}
+
+// LatencyTracker_Wrap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Wrap'
+type LatencyTracker_Wrap_Call struct {
+	*mock.Call
+}
+
+// Wrap is a helper method to define mock.On call
+//   - inner execution.Executor
+func (_e *LatencyTracker_Expecter) Wrap(inner interface{}) *LatencyTracker_Wrap_Call {
+	return &LatencyTracker_Wrap_Call{Call: _e.mock.On("Wrap", inner)}
+}
+
+func (_c *LatencyTracker_Wrap_Call) Run(run func(inner execution.Executor)) *LatencyTracker_Wrap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(execution.Executor))
+	})
+	return _c
+}
+
+func (_c *LatencyTracker_Wrap_Call) Return(_a0 execution.Executor) *LatencyTracker_Wrap_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LatencyTracker_Wrap_Call) RunAndReturn(run func(execution.Executor) execution.Executor) *LatencyTracker_Wrap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewLatencyTracker creates a new instance of LatencyTracker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLatencyTracker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LatencyTracker {
+	mock := &LatencyTracker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,305 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package signalqueue
+
+import (
+	context "context"
+
+	execution "github.com/backtesting-org/kronos-sdk/pkg/types/execution"
+	metrics "github.com/backtesting-org/live-trading/pkg/metrics"
+
+	mock "github.com/stretchr/testify/mock"
+
+	signalqueue "github.com/backtesting-org/live-trading/pkg/signalqueue"
+)
+
+// SignalQueue is an autogenerated mock type for the SignalQueue type
+type SignalQueue struct {
+	mock.Mock
+}
+
+type SignalQueue_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SignalQueue) EXPECT() *SignalQueue_Expecter {
+	return &SignalQueue_Expecter{mock: &_m.Mock}
+}
+
+// Collector provides a mock function with no fields
+func (_m *SignalQueue) Collector() metrics.Collector {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Collector")
+	}
+
+	var r0 metrics.Collector
+	if rf, ok := ret.Get(0).(func() metrics.Collector); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(metrics.Collector)
+		}
+	}
+
+	return r0
+}
+
+// SignalQueue_Collector_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Collector'
+type SignalQueue_Collector_Call struct {
+	*mock.Call
+}
+
+// Collector is a helper method to define mock.On call
+func (_e *SignalQueue_Expecter) Collector() *SignalQueue_Collector_Call {
+	return &SignalQueue_Collector_Call{Call: _e.mock.On("Collector")}
+}
+
+func (_c *SignalQueue_Collector_Call) Run(run func()) *SignalQueue_Collector_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SignalQueue_Collector_Call) Return(_a0 metrics.Collector) *SignalQueue_Collector_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SignalQueue_Collector_Call) RunAndReturn(run func() metrics.Collector) *SignalQueue_Collector_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *SignalQueue) Configure(config signalqueue.Config) {
+	_m.Called(config)
+}
+
+// SignalQueue_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type SignalQueue_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config signalqueue.Config
+func (_e *SignalQueue_Expecter) Configure(config interface{}) *SignalQueue_Configure_Call {
+	return &SignalQueue_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *SignalQueue_Configure_Call) Run(run func(config signalqueue.Config)) *SignalQueue_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(signalqueue.Config))
+	})
+	return _c
+}
+
+func (_c *SignalQueue_Configure_Call) Return() *SignalQueue_Configure_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *SignalQueue_Configure_Call) RunAndReturn(run func(signalqueue.Config)) *SignalQueue_Configure_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Start provides a mock function with no fields
+func (_m *SignalQueue) Start() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SignalQueue_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type SignalQueue_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+func (_e *SignalQueue_Expecter) Start() *SignalQueue_Start_Call {
+	return &SignalQueue_Start_Call{Call: _e.mock.On("Start")}
+}
+
+func (_c *SignalQueue_Start_Call) Run(run func()) *SignalQueue_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SignalQueue_Start_Call) Return(_a0 error) *SignalQueue_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SignalQueue_Start_Call) RunAndReturn(run func() error) *SignalQueue_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stats provides a mock function with no fields
+func (_m *SignalQueue) Stats() signalqueue.Stats {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stats")
+	}
+
+	var r0 signalqueue.Stats
+	if rf, ok := ret.Get(0).(func() signalqueue.Stats); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(signalqueue.Stats)
+	}
+
+	return r0
+}
+
+// SignalQueue_Stats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stats'
+type SignalQueue_Stats_Call struct {
+	*mock.Call
+}
+
+// Stats is a helper method to define mock.On call
+func (_e *SignalQueue_Expecter) Stats() *SignalQueue_Stats_Call {
+	return &SignalQueue_Stats_Call{Call: _e.mock.On("Stats")}
+}
+
+func (_c *SignalQueue_Stats_Call) Run(run func()) *SignalQueue_Stats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SignalQueue_Stats_Call) Return(_a0 signalqueue.Stats) *SignalQueue_Stats_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SignalQueue_Stats_Call) RunAndReturn(run func() signalqueue.Stats) *SignalQueue_Stats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with given fields: ctx
+func (_m *SignalQueue) Stop(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SignalQueue_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type SignalQueue_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SignalQueue_Expecter) Stop(ctx interface{}) *SignalQueue_Stop_Call {
+	return &SignalQueue_Stop_Call{Call: _e.mock.On("Stop", ctx)}
+}
+
+func (_c *SignalQueue_Stop_Call) Run(run func(ctx context.Context)) *SignalQueue_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SignalQueue_Stop_Call) Return(_a0 error) *SignalQueue_Stop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SignalQueue_Stop_Call) RunAndReturn(run func(context.Context) error) *SignalQueue_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Wrap provides a mock function with given fields: inner
+func (_m *SignalQueue) Wrap(inner execution.Executor) execution.Executor {
+	ret := _m.Called(inner)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Wrap")
+	}
+
+	var r0 execution.Executor
+	if rf, ok := ret.Get(0).(func(execution.Executor) execution.Executor); ok {
+		r0 = rf(inner)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(execution.Executor)
+		}
+	}
+
+	return r0
+}
+
+// SignalQueue_Wrap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Wrap'
+type SignalQueue_Wrap_Call struct {
+	*mock.Call
+}
+
+// Wrap is a helper method to define mock.On call
+//   - inner execution.Executor
+func (_e *SignalQueue_Expecter) Wrap(inner interface{}) *SignalQueue_Wrap_Call {
+	return &SignalQueue_Wrap_Call{Call: _e.mock.On("Wrap", inner)}
+}
+
+func (_c *SignalQueue_Wrap_Call) Run(run func(inner execution.Executor)) *SignalQueue_Wrap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(execution.Executor))
+	})
+	return _c
+}
+
+func (_c *SignalQueue_Wrap_Call) Return(_a0 execution.Executor) *SignalQueue_Wrap_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SignalQueue_Wrap_Call) RunAndReturn(run func(execution.Executor) execution.Executor) *SignalQueue_Wrap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSignalQueue creates a new instance of SignalQueue. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSignalQueue(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SignalQueue {
+	mock := &SignalQueue{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -7,6 +7,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	portfolio "github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+
+	startup "github.com/backtesting-org/live-trading/pkg/startup"
 )
 
 // Startup is an autogenerated mock type for the Startup type
@@ -22,6 +24,220 @@ func (_m *Startup) EXPECT() *Startup_Expecter {
 	return &Startup_Expecter{mock: &_m.Mock}
 }
 
+// Bootstrap provides a mock function with given fields: connectors, config
+func (_m *Startup) Bootstrap(connectors map[connector.ExchangeName]connector.Config, config startup.BootstrapConfig) (*startup.BootstrapReport, error) {
+	ret := _m.Called(connectors, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Bootstrap")
+	}
+
+	var r0 *startup.BootstrapReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(map[connector.ExchangeName]connector.Config, startup.BootstrapConfig) (*startup.BootstrapReport, error)); ok {
+		return rf(connectors, config)
+	}
+	if rf, ok := ret.Get(0).(func(map[connector.ExchangeName]connector.Config, startup.BootstrapConfig) *startup.BootstrapReport); ok {
+		r0 = rf(connectors, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*startup.BootstrapReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(map[connector.ExchangeName]connector.Config, startup.BootstrapConfig) error); ok {
+		r1 = rf(connectors, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Startup_Bootstrap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Bootstrap'
+type Startup_Bootstrap_Call struct {
+	*mock.Call
+}
+
+// Bootstrap is a helper method to define mock.On call
+//   - connectors map[connector.ExchangeName]connector.Config
+//   - config startup.BootstrapConfig
+func (_e *Startup_Expecter) Bootstrap(connectors interface{}, config interface{}) *Startup_Bootstrap_Call {
+	return &Startup_Bootstrap_Call{Call: _e.mock.On("Bootstrap", connectors, config)}
+}
+
+func (_c *Startup_Bootstrap_Call) Run(run func(connectors map[connector.ExchangeName]connector.Config, config startup.BootstrapConfig)) *Startup_Bootstrap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(map[connector.ExchangeName]connector.Config), args[1].(startup.BootstrapConfig))
+	})
+	return _c
+}
+
+func (_c *Startup_Bootstrap_Call) Return(_a0 *startup.BootstrapReport, _a1 error) *Startup_Bootstrap_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Startup_Bootstrap_Call) RunAndReturn(run func(map[connector.ExchangeName]connector.Config, startup.BootstrapConfig) (*startup.BootstrapReport, error)) *Startup_Bootstrap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Preflight provides a mock function with given fields: strategyPath, connectors, assets, config
+func (_m *Startup) Preflight(strategyPath string, connectors map[connector.ExchangeName]connector.Config, assets map[portfolio.Asset][]connector.Instrument, config startup.PreflightConfig) (*startup.PreflightReport, error) {
+	ret := _m.Called(strategyPath, connectors, assets, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Preflight")
+	}
+
+	var r0 *startup.PreflightReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, map[connector.ExchangeName]connector.Config, map[portfolio.Asset][]connector.Instrument, startup.PreflightConfig) (*startup.PreflightReport, error)); ok {
+		return rf(strategyPath, connectors, assets, config)
+	}
+	if rf, ok := ret.Get(0).(func(string, map[connector.ExchangeName]connector.Config, map[portfolio.Asset][]connector.Instrument, startup.PreflightConfig) *startup.PreflightReport); ok {
+		r0 = rf(strategyPath, connectors, assets, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*startup.PreflightReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, map[connector.ExchangeName]connector.Config, map[portfolio.Asset][]connector.Instrument, startup.PreflightConfig) error); ok {
+		r1 = rf(strategyPath, connectors, assets, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Startup_Preflight_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Preflight'
+type Startup_Preflight_Call struct {
+	*mock.Call
+}
+
+// Preflight is a helper method to define mock.On call
+//   - strategyPath string
+//   - connectors map[connector.ExchangeName]connector.Config
+//   - assets map[portfolio.Asset][]connector.Instrument
+//   - config startup.PreflightConfig
+func (_e *Startup_Expecter) Preflight(strategyPath interface{}, connectors interface{}, assets interface{}, config interface{}) *Startup_Preflight_Call {
+	return &Startup_Preflight_Call{Call: _e.mock.On("Preflight", strategyPath, connectors, assets, config)}
+}
+
+func (_c *Startup_Preflight_Call) Run(run func(strategyPath string, connectors map[connector.ExchangeName]connector.Config, assets map[portfolio.Asset][]connector.Instrument, config startup.PreflightConfig)) *Startup_Preflight_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(map[connector.ExchangeName]connector.Config), args[2].(map[portfolio.Asset][]connector.Instrument), args[3].(startup.PreflightConfig))
+	})
+	return _c
+}
+
+func (_c *Startup_Preflight_Call) Return(_a0 *startup.PreflightReport, _a1 error) *Startup_Preflight_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Startup_Preflight_Call) RunAndReturn(run func(string, map[connector.ExchangeName]connector.Config, map[portfolio.Asset][]connector.Instrument, startup.PreflightConfig) (*startup.PreflightReport, error)) *Startup_Preflight_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecoveredSignals provides a mock function with no fields
+func (_m *Startup) RecoveredSignals() []startup.InDoubtSignal {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecoveredSignals")
+	}
+
+	var r0 []startup.InDoubtSignal
+	if rf, ok := ret.Get(0).(func() []startup.InDoubtSignal); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]startup.InDoubtSignal)
+		}
+	}
+
+	return r0
+}
+
+// Startup_RecoveredSignals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecoveredSignals'
+type Startup_RecoveredSignals_Call struct {
+	*mock.Call
+}
+
+// RecoveredSignals is a helper method to define mock.On call
+func (_e *Startup_Expecter) RecoveredSignals() *Startup_RecoveredSignals_Call {
+	return &Startup_RecoveredSignals_Call{Call: _e.mock.On("RecoveredSignals")}
+}
+
+func (_c *Startup_RecoveredSignals_Call) Run(run func()) *Startup_RecoveredSignals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Startup_RecoveredSignals_Call) Return(_a0 []startup.InDoubtSignal) *Startup_RecoveredSignals_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Startup_RecoveredSignals_Call) RunAndReturn(run func() []startup.InDoubtSignal) *Startup_RecoveredSignals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecoveredState provides a mock function with no fields
+func (_m *Startup) RecoveredState() map[connector.ExchangeName]*startup.ExchangeState {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecoveredState")
+	}
+
+	var r0 map[connector.ExchangeName]*startup.ExchangeState
+	if rf, ok := ret.Get(0).(func() map[connector.ExchangeName]*startup.ExchangeState); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[connector.ExchangeName]*startup.ExchangeState)
+		}
+	}
+
+	return r0
+}
+
+// Startup_RecoveredState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecoveredState'
+type Startup_RecoveredState_Call struct {
+	*mock.Call
+}
+
+// RecoveredState is a helper method to define mock.On call
+func (_e *Startup_Expecter) RecoveredState() *Startup_RecoveredState_Call {
+	return &Startup_RecoveredState_Call{Call: _e.mock.On("RecoveredState")}
+}
+
+func (_c *Startup_RecoveredState_Call) Run(run func()) *Startup_RecoveredState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Startup_RecoveredState_Call) Return(_a0 map[connector.ExchangeName]*startup.ExchangeState) *Startup_RecoveredState_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Startup_RecoveredState_Call) RunAndReturn(run func() map[connector.ExchangeName]*startup.ExchangeState) *Startup_RecoveredState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: strategyPath, connectors, assets
 func (_m *Startup) Start(strategyPath string, connectors map[connector.ExchangeName]connector.Config, assets map[portfolio.Asset][]connector.Instrument) error {
 	ret := _m.Called(strategyPath, connectors, assets)
@@ -70,6 +286,67 @@ func (_c *Startup_Start_Call) RunAndReturn(run func(string, map[connector.Exchan
 	return _c
 }
 
+// StartLive provides a mock function with given fields: strategyPath, connectors, assets, config
+func (_m *Startup) StartLive(strategyPath string, connectors map[connector.ExchangeName]connector.Config, assets map[portfolio.Asset][]connector.Instrument, config startup.PreflightConfig) (*startup.PreflightReport, error) {
+	ret := _m.Called(strategyPath, connectors, assets, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartLive")
+	}
+
+	var r0 *startup.PreflightReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, map[connector.ExchangeName]connector.Config, map[portfolio.Asset][]connector.Instrument, startup.PreflightConfig) (*startup.PreflightReport, error)); ok {
+		return rf(strategyPath, connectors, assets, config)
+	}
+	if rf, ok := ret.Get(0).(func(string, map[connector.ExchangeName]connector.Config, map[portfolio.Asset][]connector.Instrument, startup.PreflightConfig) *startup.PreflightReport); ok {
+		r0 = rf(strategyPath, connectors, assets, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*startup.PreflightReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, map[connector.ExchangeName]connector.Config, map[portfolio.Asset][]connector.Instrument, startup.PreflightConfig) error); ok {
+		r1 = rf(strategyPath, connectors, assets, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Startup_StartLive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartLive'
+type Startup_StartLive_Call struct {
+	*mock.Call
+}
+
+// StartLive is a helper method to define mock.On call
+//   - strategyPath string
+//   - connectors map[connector.ExchangeName]connector.Config
+//   - assets map[portfolio.Asset][]connector.Instrument
+//   - config startup.PreflightConfig
+func (_e *Startup_Expecter) StartLive(strategyPath interface{}, connectors interface{}, assets interface{}, config interface{}) *Startup_StartLive_Call {
+	return &Startup_StartLive_Call{Call: _e.mock.On("StartLive", strategyPath, connectors, assets, config)}
+}
+
+func (_c *Startup_StartLive_Call) Run(run func(strategyPath string, connectors map[connector.ExchangeName]connector.Config, assets map[portfolio.Asset][]connector.Instrument, config startup.PreflightConfig)) *Startup_StartLive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(map[connector.ExchangeName]connector.Config), args[2].(map[portfolio.Asset][]connector.Instrument), args[3].(startup.PreflightConfig))
+	})
+	return _c
+}
+
+func (_c *Startup_StartLive_Call) Return(_a0 *startup.PreflightReport, _a1 error) *Startup_StartLive_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Startup_StartLive_Call) RunAndReturn(run func(string, map[connector.ExchangeName]connector.Config, map[portfolio.Asset][]connector.Instrument, startup.PreflightConfig) (*startup.PreflightReport, error)) *Startup_StartLive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Stop provides a mock function with no fields
 func (_m *Startup) Stop() error {
 	ret := _m.Called()
@@ -0,0 +1,68 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package supervisor
+
+import (
+	supervisor "github.com/backtesting-org/live-trading/pkg/supervisor"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EscalationHandler is an autogenerated mock type for the EscalationHandler type
+type EscalationHandler struct {
+	mock.Mock
+}
+
+type EscalationHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *EscalationHandler) EXPECT() *EscalationHandler_Expecter {
+	return &EscalationHandler_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: records
+func (_m *EscalationHandler) Execute(records []supervisor.RestartRecord) {
+	_m.Called(records)
+}
+
+// EscalationHandler_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type EscalationHandler_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - records []supervisor.RestartRecord
+func (_e *EscalationHandler_Expecter) Execute(records interface{}) *EscalationHandler_Execute_Call {
+	return &EscalationHandler_Execute_Call{Call: _e.mock.On("Execute", records)}
+}
+
+func (_c *EscalationHandler_Execute_Call) Run(run func(records []supervisor.RestartRecord)) *EscalationHandler_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]supervisor.RestartRecord))
+	})
+	return _c
+}
+
+func (_c *EscalationHandler_Execute_Call) Return() *EscalationHandler_Execute_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *EscalationHandler_Execute_Call) RunAndReturn(run func([]supervisor.RestartRecord)) *EscalationHandler_Execute_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewEscalationHandler creates a new instance of EscalationHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEscalationHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EscalationHandler {
+	mock := &EscalationHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
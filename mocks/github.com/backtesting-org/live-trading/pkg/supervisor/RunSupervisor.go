@@ -0,0 +1,457 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package supervisor
+
+import (
+	supervisor "github.com/backtesting-org/live-trading/pkg/supervisor"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RunSupervisor is an autogenerated mock type for the RunSupervisor type
+type RunSupervisor struct {
+	mock.Mock
+}
+
+type RunSupervisor_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RunSupervisor) EXPECT() *RunSupervisor_Expecter {
+	return &RunSupervisor_Expecter{mock: &_m.Mock}
+}
+
+// Check provides a mock function with no fields
+func (_m *RunSupervisor) Check() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Check")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RunSupervisor_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type RunSupervisor_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+func (_e *RunSupervisor_Expecter) Check() *RunSupervisor_Check_Call {
+	return &RunSupervisor_Check_Call{Call: _e.mock.On("Check")}
+}
+
+func (_c *RunSupervisor_Check_Call) Run(run func()) *RunSupervisor_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RunSupervisor_Check_Call) Return(_a0 error) *RunSupervisor_Check_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RunSupervisor_Check_Call) RunAndReturn(run func() error) *RunSupervisor_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *RunSupervisor) Configure(config supervisor.RunConfig) error {
+	ret := _m.Called(config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Configure")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(supervisor.RunConfig) error); ok {
+		r0 = rf(config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RunSupervisor_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type RunSupervisor_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config supervisor.RunConfig
+func (_e *RunSupervisor_Expecter) Configure(config interface{}) *RunSupervisor_Configure_Call {
+	return &RunSupervisor_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *RunSupervisor_Configure_Call) Run(run func(config supervisor.RunConfig)) *RunSupervisor_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(supervisor.RunConfig))
+	})
+	return _c
+}
+
+func (_c *RunSupervisor_Configure_Call) Return(_a0 error) *RunSupervisor_Configure_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RunSupervisor_Configure_Call) RunAndReturn(run func(supervisor.RunConfig) error) *RunSupervisor_Configure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Register provides a mock function with given fields: spec
+func (_m *RunSupervisor) Register(spec supervisor.RunSpec) error {
+	ret := _m.Called(spec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Register")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(supervisor.RunSpec) error); ok {
+		r0 = rf(spec)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RunSupervisor_Register_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Register'
+type RunSupervisor_Register_Call struct {
+	*mock.Call
+}
+
+// Register is a helper method to define mock.On call
+//   - spec supervisor.RunSpec
+func (_e *RunSupervisor_Expecter) Register(spec interface{}) *RunSupervisor_Register_Call {
+	return &RunSupervisor_Register_Call{Call: _e.mock.On("Register", spec)}
+}
+
+func (_c *RunSupervisor_Register_Call) Run(run func(spec supervisor.RunSpec)) *RunSupervisor_Register_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(supervisor.RunSpec))
+	})
+	return _c
+}
+
+func (_c *RunSupervisor_Register_Call) Return(_a0 error) *RunSupervisor_Register_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RunSupervisor_Register_Call) RunAndReturn(run func(supervisor.RunSpec) error) *RunSupervisor_Register_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with no fields
+func (_m *RunSupervisor) Start() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RunSupervisor_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type RunSupervisor_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+func (_e *RunSupervisor_Expecter) Start() *RunSupervisor_Start_Call {
+	return &RunSupervisor_Start_Call{Call: _e.mock.On("Start")}
+}
+
+func (_c *RunSupervisor_Start_Call) Run(run func()) *RunSupervisor_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RunSupervisor_Start_Call) Return(_a0 error) *RunSupervisor_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RunSupervisor_Start_Call) RunAndReturn(run func() error) *RunSupervisor_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartRun provides a mock function with given fields: name
+func (_m *RunSupervisor) StartRun(name string) error {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartRun")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RunSupervisor_StartRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartRun'
+type RunSupervisor_StartRun_Call struct {
+	*mock.Call
+}
+
+// StartRun is a helper method to define mock.On call
+//   - name string
+func (_e *RunSupervisor_Expecter) StartRun(name interface{}) *RunSupervisor_StartRun_Call {
+	return &RunSupervisor_StartRun_Call{Call: _e.mock.On("StartRun", name)}
+}
+
+func (_c *RunSupervisor_StartRun_Call) Run(run func(name string)) *RunSupervisor_StartRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *RunSupervisor_StartRun_Call) Return(_a0 error) *RunSupervisor_StartRun_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RunSupervisor_StartRun_Call) RunAndReturn(run func(string) error) *RunSupervisor_StartRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Status provides a mock function with given fields: name
+func (_m *RunSupervisor) Status(name string) (supervisor.RunStatus, bool) {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Status")
+	}
+
+	var r0 supervisor.RunStatus
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string) (supervisor.RunStatus, bool)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) supervisor.RunStatus); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(supervisor.RunStatus)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// RunSupervisor_Status_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Status'
+type RunSupervisor_Status_Call struct {
+	*mock.Call
+}
+
+// Status is a helper method to define mock.On call
+//   - name string
+func (_e *RunSupervisor_Expecter) Status(name interface{}) *RunSupervisor_Status_Call {
+	return &RunSupervisor_Status_Call{Call: _e.mock.On("Status", name)}
+}
+
+func (_c *RunSupervisor_Status_Call) Run(run func(name string)) *RunSupervisor_Status_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *RunSupervisor_Status_Call) Return(_a0 supervisor.RunStatus, _a1 bool) *RunSupervisor_Status_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RunSupervisor_Status_Call) RunAndReturn(run func(string) (supervisor.RunStatus, bool)) *RunSupervisor_Status_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Statuses provides a mock function with no fields
+func (_m *RunSupervisor) Statuses() []supervisor.RunStatus {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Statuses")
+	}
+
+	var r0 []supervisor.RunStatus
+	if rf, ok := ret.Get(0).(func() []supervisor.RunStatus); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]supervisor.RunStatus)
+		}
+	}
+
+	return r0
+}
+
+// RunSupervisor_Statuses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Statuses'
+type RunSupervisor_Statuses_Call struct {
+	*mock.Call
+}
+
+// Statuses is a helper method to define mock.On call
+func (_e *RunSupervisor_Expecter) Statuses() *RunSupervisor_Statuses_Call {
+	return &RunSupervisor_Statuses_Call{Call: _e.mock.On("Statuses")}
+}
+
+func (_c *RunSupervisor_Statuses_Call) Run(run func()) *RunSupervisor_Statuses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RunSupervisor_Statuses_Call) Return(_a0 []supervisor.RunStatus) *RunSupervisor_Statuses_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RunSupervisor_Statuses_Call) RunAndReturn(run func() []supervisor.RunStatus) *RunSupervisor_Statuses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with no fields
+func (_m *RunSupervisor) Stop() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RunSupervisor_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type RunSupervisor_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+func (_e *RunSupervisor_Expecter) Stop() *RunSupervisor_Stop_Call {
+	return &RunSupervisor_Stop_Call{Call: _e.mock.On("Stop")}
+}
+
+func (_c *RunSupervisor_Stop_Call) Run(run func()) *RunSupervisor_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RunSupervisor_Stop_Call) Return(_a0 error) *RunSupervisor_Stop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RunSupervisor_Stop_Call) RunAndReturn(run func() error) *RunSupervisor_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StopRun provides a mock function with given fields: name
+func (_m *RunSupervisor) StopRun(name string) error {
+	ret := _m.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StopRun")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RunSupervisor_StopRun_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StopRun'
+type RunSupervisor_StopRun_Call struct {
+	*mock.Call
+}
+
+// StopRun is a helper method to define mock.On call
+//   - name string
+func (_e *RunSupervisor_Expecter) StopRun(name interface{}) *RunSupervisor_StopRun_Call {
+	return &RunSupervisor_StopRun_Call{Call: _e.mock.On("StopRun", name)}
+}
+
+func (_c *RunSupervisor_StopRun_Call) Run(run func(name string)) *RunSupervisor_StopRun_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *RunSupervisor_StopRun_Call) Return(_a0 error) *RunSupervisor_StopRun_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RunSupervisor_StopRun_Call) RunAndReturn(run func(string) error) *RunSupervisor_StopRun_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRunSupervisor creates a new instance of RunSupervisor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRunSupervisor(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RunSupervisor {
+	mock := &RunSupervisor{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
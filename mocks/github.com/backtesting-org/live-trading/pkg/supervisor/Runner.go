@@ -0,0 +1,183 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package supervisor
+
+import (
+	supervisor "github.com/backtesting-org/live-trading/pkg/supervisor"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Runner is an autogenerated mock type for the Runner type
+type Runner struct {
+	mock.Mock
+}
+
+type Runner_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Runner) EXPECT() *Runner_Expecter {
+	return &Runner_Expecter{mock: &_m.Mock}
+}
+
+// Exited provides a mock function with given fields: spec
+func (_m *Runner) Exited(spec supervisor.RunSpec) (bool, error) {
+	ret := _m.Called(spec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exited")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(supervisor.RunSpec) (bool, error)); ok {
+		return rf(spec)
+	}
+	if rf, ok := ret.Get(0).(func(supervisor.RunSpec) bool); ok {
+		r0 = rf(spec)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(supervisor.RunSpec) error); ok {
+		r1 = rf(spec)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Runner_Exited_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exited'
+type Runner_Exited_Call struct {
+	*mock.Call
+}
+
+// Exited is a helper method to define mock.On call
+//   - spec supervisor.RunSpec
+func (_e *Runner_Expecter) Exited(spec interface{}) *Runner_Exited_Call {
+	return &Runner_Exited_Call{Call: _e.mock.On("Exited", spec)}
+}
+
+func (_c *Runner_Exited_Call) Run(run func(spec supervisor.RunSpec)) *Runner_Exited_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(supervisor.RunSpec))
+	})
+	return _c
+}
+
+func (_c *Runner_Exited_Call) Return(_a0 bool, _a1 error) *Runner_Exited_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Runner_Exited_Call) RunAndReturn(run func(supervisor.RunSpec) (bool, error)) *Runner_Exited_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with given fields: spec
+func (_m *Runner) Start(spec supervisor.RunSpec) error {
+	ret := _m.Called(spec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(supervisor.RunSpec) error); ok {
+		r0 = rf(spec)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Runner_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type Runner_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+//   - spec supervisor.RunSpec
+func (_e *Runner_Expecter) Start(spec interface{}) *Runner_Start_Call {
+	return &Runner_Start_Call{Call: _e.mock.On("Start", spec)}
+}
+
+func (_c *Runner_Start_Call) Run(run func(spec supervisor.RunSpec)) *Runner_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(supervisor.RunSpec))
+	})
+	return _c
+}
+
+func (_c *Runner_Start_Call) Return(_a0 error) *Runner_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Runner_Start_Call) RunAndReturn(run func(supervisor.RunSpec) error) *Runner_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with given fields: spec
+func (_m *Runner) Stop(spec supervisor.RunSpec) error {
+	ret := _m.Called(spec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(supervisor.RunSpec) error); ok {
+		r0 = rf(spec)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Runner_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type Runner_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+//   - spec supervisor.RunSpec
+func (_e *Runner_Expecter) Stop(spec interface{}) *Runner_Stop_Call {
+	return &Runner_Stop_Call{Call: _e.mock.On("Stop", spec)}
+}
+
+func (_c *Runner_Stop_Call) Run(run func(spec supervisor.RunSpec)) *Runner_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(supervisor.RunSpec))
+	})
+	return _c
+}
+
+func (_c *Runner_Stop_Call) Return(_a0 error) *Runner_Stop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Runner_Stop_Call) RunAndReturn(run func(supervisor.RunSpec) error) *Runner_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRunner creates a new instance of Runner. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRunner(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Runner {
+	mock := &Runner{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
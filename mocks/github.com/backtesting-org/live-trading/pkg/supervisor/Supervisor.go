@@ -0,0 +1,209 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package supervisor
+
+import (
+	context "context"
+
+	supervisor "github.com/backtesting-org/live-trading/pkg/supervisor"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Supervisor is an autogenerated mock type for the Supervisor type
+type Supervisor struct {
+	mock.Mock
+}
+
+type Supervisor_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Supervisor) EXPECT() *Supervisor_Expecter {
+	return &Supervisor_Expecter{mock: &_m.Mock}
+}
+
+// Configure provides a mock function with given fields: config
+func (_m *Supervisor) Configure(config supervisor.Config) error {
+	ret := _m.Called(config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Configure")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(supervisor.Config) error); ok {
+		r0 = rf(config)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Supervisor_Configure_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Configure'
+type Supervisor_Configure_Call struct {
+	*mock.Call
+}
+
+// Configure is a helper method to define mock.On call
+//   - config supervisor.Config
+func (_e *Supervisor_Expecter) Configure(config interface{}) *Supervisor_Configure_Call {
+	return &Supervisor_Configure_Call{Call: _e.mock.On("Configure", config)}
+}
+
+func (_c *Supervisor_Configure_Call) Run(run func(config supervisor.Config)) *Supervisor_Configure_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(supervisor.Config))
+	})
+	return _c
+}
+
+func (_c *Supervisor_Configure_Call) Return(_a0 error) *Supervisor_Configure_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Supervisor_Configure_Call) RunAndReturn(run func(supervisor.Config) error) *Supervisor_Configure_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// History provides a mock function with no fields
+func (_m *Supervisor) History() []supervisor.RestartRecord {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for History")
+	}
+
+	var r0 []supervisor.RestartRecord
+	if rf, ok := ret.Get(0).(func() []supervisor.RestartRecord); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]supervisor.RestartRecord)
+		}
+	}
+
+	return r0
+}
+
+// Supervisor_History_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'History'
+type Supervisor_History_Call struct {
+	*mock.Call
+}
+
+// History is a helper method to define mock.On call
+func (_e *Supervisor_Expecter) History() *Supervisor_History_Call {
+	return &Supervisor_History_Call{Call: _e.mock.On("History")}
+}
+
+func (_c *Supervisor_History_Call) Run(run func()) *Supervisor_History_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Supervisor_History_Call) Return(_a0 []supervisor.RestartRecord) *Supervisor_History_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Supervisor_History_Call) RunAndReturn(run func() []supervisor.RestartRecord) *Supervisor_History_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Run provides a mock function with given fields: ctx
+func (_m *Supervisor) Run(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Run")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Supervisor_Run_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Run'
+type Supervisor_Run_Call struct {
+	*mock.Call
+}
+
+// Run is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Supervisor_Expecter) Run(ctx interface{}) *Supervisor_Run_Call {
+	return &Supervisor_Run_Call{Call: _e.mock.On("Run", ctx)}
+}
+
+func (_c *Supervisor_Run_Call) Run(run func(ctx context.Context)) *Supervisor_Run_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Supervisor_Run_Call) Return(_a0 error) *Supervisor_Run_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Supervisor_Run_Call) RunAndReturn(run func(context.Context) error) *Supervisor_Run_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetEscalationHandler provides a mock function with given fields: handler
+func (_m *Supervisor) SetEscalationHandler(handler supervisor.EscalationHandler) {
+	_m.Called(handler)
+}
+
+// Supervisor_SetEscalationHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetEscalationHandler'
+type Supervisor_SetEscalationHandler_Call struct {
+	*mock.Call
+}
+
+// SetEscalationHandler is a helper method to define mock.On call
+//   - handler supervisor.EscalationHandler
+func (_e *Supervisor_Expecter) SetEscalationHandler(handler interface{}) *Supervisor_SetEscalationHandler_Call {
+	return &Supervisor_SetEscalationHandler_Call{Call: _e.mock.On("SetEscalationHandler", handler)}
+}
+
+func (_c *Supervisor_SetEscalationHandler_Call) Run(run func(handler supervisor.EscalationHandler)) *Supervisor_SetEscalationHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(supervisor.EscalationHandler))
+	})
+	return _c
+}
+
+func (_c *Supervisor_SetEscalationHandler_Call) Return() *Supervisor_SetEscalationHandler_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Supervisor_SetEscalationHandler_Call) RunAndReturn(run func(supervisor.EscalationHandler)) *Supervisor_SetEscalationHandler_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewSupervisor creates a new instance of Supervisor. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSupervisor(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Supervisor {
+	mock := &Supervisor{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
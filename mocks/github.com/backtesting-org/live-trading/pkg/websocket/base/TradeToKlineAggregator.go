@@ -0,0 +1,271 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package base
+
+import (
+	context "context"
+
+	connector "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+
+	mock "github.com/stretchr/testify/mock"
+
+	numerical "github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+
+	time "time"
+)
+
+// TradeToKlineAggregator is an autogenerated mock type for the TradeToKlineAggregator type
+type TradeToKlineAggregator struct {
+	mock.Mock
+}
+
+type TradeToKlineAggregator_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TradeToKlineAggregator) EXPECT() *TradeToKlineAggregator_Expecter {
+	return &TradeToKlineAggregator_Expecter{mock: &_m.Mock}
+}
+
+// AddTrade provides a mock function with given fields: symbol, price, quantity, at
+func (_m *TradeToKlineAggregator) AddTrade(symbol string, price numerical.Decimal, quantity numerical.Decimal, at time.Time) {
+	_m.Called(symbol, price, quantity, at)
+}
+
+// TradeToKlineAggregator_AddTrade_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTrade'
+type TradeToKlineAggregator_AddTrade_Call struct {
+	*mock.Call
+}
+
+// AddTrade is a helper method to define mock.On call
+//   - symbol string
+//   - price numerical.Decimal
+//   - quantity numerical.Decimal
+//   - at time.Time
+func (_e *TradeToKlineAggregator_Expecter) AddTrade(symbol interface{}, price interface{}, quantity interface{}, at interface{}) *TradeToKlineAggregator_AddTrade_Call {
+	return &TradeToKlineAggregator_AddTrade_Call{Call: _e.mock.On("AddTrade", symbol, price, quantity, at)}
+}
+
+func (_c *TradeToKlineAggregator_AddTrade_Call) Run(run func(symbol string, price numerical.Decimal, quantity numerical.Decimal, at time.Time)) *TradeToKlineAggregator_AddTrade_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(numerical.Decimal), args[2].(numerical.Decimal), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *TradeToKlineAggregator_AddTrade_Call) Return() *TradeToKlineAggregator_AddTrade_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *TradeToKlineAggregator_AddTrade_Call) RunAndReturn(run func(string, numerical.Decimal, numerical.Decimal, time.Time)) *TradeToKlineAggregator_AddTrade_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Flush provides a mock function with no fields
+func (_m *TradeToKlineAggregator) Flush() {
+	_m.Called()
+}
+
+// TradeToKlineAggregator_Flush_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Flush'
+type TradeToKlineAggregator_Flush_Call struct {
+	*mock.Call
+}
+
+// Flush is a helper method to define mock.On call
+func (_e *TradeToKlineAggregator_Expecter) Flush() *TradeToKlineAggregator_Flush_Call {
+	return &TradeToKlineAggregator_Flush_Call{Call: _e.mock.On("Flush")}
+}
+
+func (_c *TradeToKlineAggregator_Flush_Call) Run(run func()) *TradeToKlineAggregator_Flush_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TradeToKlineAggregator_Flush_Call) Return() *TradeToKlineAggregator_Flush_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *TradeToKlineAggregator_Flush_Call) RunAndReturn(run func()) *TradeToKlineAggregator_Flush_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Klines provides a mock function with no fields
+func (_m *TradeToKlineAggregator) Klines() <-chan connector.Kline {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Klines")
+	}
+
+	var r0 <-chan connector.Kline
+	if rf, ok := ret.Get(0).(func() <-chan connector.Kline); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan connector.Kline)
+		}
+	}
+
+	return r0
+}
+
+// TradeToKlineAggregator_Klines_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Klines'
+type TradeToKlineAggregator_Klines_Call struct {
+	*mock.Call
+}
+
+// Klines is a helper method to define mock.On call
+func (_e *TradeToKlineAggregator_Expecter) Klines() *TradeToKlineAggregator_Klines_Call {
+	return &TradeToKlineAggregator_Klines_Call{Call: _e.mock.On("Klines")}
+}
+
+func (_c *TradeToKlineAggregator_Klines_Call) Run(run func()) *TradeToKlineAggregator_Klines_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *TradeToKlineAggregator_Klines_Call) Return(_a0 <-chan connector.Kline) *TradeToKlineAggregator_Klines_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TradeToKlineAggregator_Klines_Call) RunAndReturn(run func() <-chan connector.Kline) *TradeToKlineAggregator_Klines_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with given fields: ctx
+func (_m *TradeToKlineAggregator) Start(ctx context.Context) {
+	_m.Called(ctx)
+}
+
+// TradeToKlineAggregator_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type TradeToKlineAggregator_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *TradeToKlineAggregator_Expecter) Start(ctx interface{}) *TradeToKlineAggregator_Start_Call {
+	return &TradeToKlineAggregator_Start_Call{Call: _e.mock.On("Start", ctx)}
+}
+
+func (_c *TradeToKlineAggregator_Start_Call) Run(run func(ctx context.Context)) *TradeToKlineAggregator_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *TradeToKlineAggregator_Start_Call) Return() *TradeToKlineAggregator_Start_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *TradeToKlineAggregator_Start_Call) RunAndReturn(run func(context.Context)) *TradeToKlineAggregator_Start_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Track provides a mock function with given fields: symbol, interval
+func (_m *TradeToKlineAggregator) Track(symbol string, interval string) error {
+	ret := _m.Called(symbol, interval)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Track")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(symbol, interval)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TradeToKlineAggregator_Track_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Track'
+type TradeToKlineAggregator_Track_Call struct {
+	*mock.Call
+}
+
+// Track is a helper method to define mock.On call
+//   - symbol string
+//   - interval string
+func (_e *TradeToKlineAggregator_Expecter) Track(symbol interface{}, interval interface{}) *TradeToKlineAggregator_Track_Call {
+	return &TradeToKlineAggregator_Track_Call{Call: _e.mock.On("Track", symbol, interval)}
+}
+
+func (_c *TradeToKlineAggregator_Track_Call) Run(run func(symbol string, interval string)) *TradeToKlineAggregator_Track_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TradeToKlineAggregator_Track_Call) Return(_a0 error) *TradeToKlineAggregator_Track_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TradeToKlineAggregator_Track_Call) RunAndReturn(run func(string, string) error) *TradeToKlineAggregator_Track_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Untrack provides a mock function with given fields: symbol, interval
+func (_m *TradeToKlineAggregator) Untrack(symbol string, interval string) {
+	_m.Called(symbol, interval)
+}
+
+// TradeToKlineAggregator_Untrack_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Untrack'
+type TradeToKlineAggregator_Untrack_Call struct {
+	*mock.Call
+}
+
+// Untrack is a helper method to define mock.On call
+//   - symbol string
+//   - interval string
+func (_e *TradeToKlineAggregator_Expecter) Untrack(symbol interface{}, interval interface{}) *TradeToKlineAggregator_Untrack_Call {
+	return &TradeToKlineAggregator_Untrack_Call{Call: _e.mock.On("Untrack", symbol, interval)}
+}
+
+func (_c *TradeToKlineAggregator_Untrack_Call) Run(run func(symbol string, interval string)) *TradeToKlineAggregator_Untrack_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TradeToKlineAggregator_Untrack_Call) Return() *TradeToKlineAggregator_Untrack_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *TradeToKlineAggregator_Untrack_Call) RunAndReturn(run func(string, string)) *TradeToKlineAggregator_Untrack_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewTradeToKlineAggregator creates a new instance of TradeToKlineAggregator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTradeToKlineAggregator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TradeToKlineAggregator {
+	mock := &TradeToKlineAggregator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,65 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package broker
+
+import mock "github.com/stretchr/testify/mock"
+
+// Handler is an autogenerated mock type for the Handler type
+type Handler struct {
+	mock.Mock
+}
+
+type Handler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Handler) EXPECT() *Handler_Expecter {
+	return &Handler_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: message
+func (_m *Handler) Execute(message interface{}) {
+	_m.Called(message)
+}
+
+// Handler_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type Handler_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - message interface{}
+func (_e *Handler_Expecter) Execute(message interface{}) *Handler_Execute_Call {
+	return &Handler_Execute_Call{Call: _e.mock.On("Execute", message)}
+}
+
+func (_c *Handler_Execute_Call) Run(run func(message interface{})) *Handler_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(interface{}))
+	})
+	return _c
+}
+
+func (_c *Handler_Execute_Call) Return() *Handler_Execute_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Handler_Execute_Call) RunAndReturn(run func(interface{})) *Handler_Execute_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewHandler creates a new instance of Handler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Handler {
+	mock := &Handler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,312 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package broker
+
+import (
+	broker "github.com/backtesting-org/live-trading/pkg/websocket/broker"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SubscriptionBroker is an autogenerated mock type for the SubscriptionBroker type
+type SubscriptionBroker struct {
+	mock.Mock
+}
+
+type SubscriptionBroker_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SubscriptionBroker) EXPECT() *SubscriptionBroker_Expecter {
+	return &SubscriptionBroker_Expecter{mock: &_m.Mock}
+}
+
+// Attach provides a mock function with given fields: key, handler
+func (_m *SubscriptionBroker) Attach(key broker.Key, handler broker.Handler) (int, error) {
+	ret := _m.Called(key, handler)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Attach")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(broker.Key, broker.Handler) (int, error)); ok {
+		return rf(key, handler)
+	}
+	if rf, ok := ret.Get(0).(func(broker.Key, broker.Handler) int); ok {
+		r0 = rf(key, handler)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(broker.Key, broker.Handler) error); ok {
+		r1 = rf(key, handler)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubscriptionBroker_Attach_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Attach'
+type SubscriptionBroker_Attach_Call struct {
+	*mock.Call
+}
+
+// Attach is a helper method to define mock.On call
+//   - key broker.Key
+//   - handler broker.Handler
+func (_e *SubscriptionBroker_Expecter) Attach(key interface{}, handler interface{}) *SubscriptionBroker_Attach_Call {
+	return &SubscriptionBroker_Attach_Call{Call: _e.mock.On("Attach", key, handler)}
+}
+
+func (_c *SubscriptionBroker_Attach_Call) Run(run func(key broker.Key, handler broker.Handler)) *SubscriptionBroker_Attach_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(broker.Key), args[1].(broker.Handler))
+	})
+	return _c
+}
+
+func (_c *SubscriptionBroker_Attach_Call) Return(_a0 int, _a1 error) *SubscriptionBroker_Attach_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SubscriptionBroker_Attach_Call) RunAndReturn(run func(broker.Key, broker.Handler) (int, error)) *SubscriptionBroker_Attach_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Detach provides a mock function with given fields: key, consumerID
+func (_m *SubscriptionBroker) Detach(key broker.Key, consumerID int) error {
+	ret := _m.Called(key, consumerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Detach")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(broker.Key, int) error); ok {
+		r0 = rf(key, consumerID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SubscriptionBroker_Detach_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Detach'
+type SubscriptionBroker_Detach_Call struct {
+	*mock.Call
+}
+
+// Detach is a helper method to define mock.On call
+//   - key broker.Key
+//   - consumerID int
+func (_e *SubscriptionBroker_Expecter) Detach(key interface{}, consumerID interface{}) *SubscriptionBroker_Detach_Call {
+	return &SubscriptionBroker_Detach_Call{Call: _e.mock.On("Detach", key, consumerID)}
+}
+
+func (_c *SubscriptionBroker_Detach_Call) Run(run func(key broker.Key, consumerID int)) *SubscriptionBroker_Detach_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(broker.Key), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *SubscriptionBroker_Detach_Call) Return(_a0 error) *SubscriptionBroker_Detach_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SubscriptionBroker_Detach_Call) RunAndReturn(run func(broker.Key, int) error) *SubscriptionBroker_Detach_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Keys provides a mock function with no fields
+func (_m *SubscriptionBroker) Keys() []broker.Key {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Keys")
+	}
+
+	var r0 []broker.Key
+	if rf, ok := ret.Get(0).(func() []broker.Key); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]broker.Key)
+		}
+	}
+
+	return r0
+}
+
+// SubscriptionBroker_Keys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Keys'
+type SubscriptionBroker_Keys_Call struct {
+	*mock.Call
+}
+
+// Keys is a helper method to define mock.On call
+func (_e *SubscriptionBroker_Expecter) Keys() *SubscriptionBroker_Keys_Call {
+	return &SubscriptionBroker_Keys_Call{Call: _e.mock.On("Keys")}
+}
+
+func (_c *SubscriptionBroker_Keys_Call) Run(run func()) *SubscriptionBroker_Keys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *SubscriptionBroker_Keys_Call) Return(_a0 []broker.Key) *SubscriptionBroker_Keys_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SubscriptionBroker_Keys_Call) RunAndReturn(run func() []broker.Key) *SubscriptionBroker_Keys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Publish provides a mock function with given fields: key, message
+func (_m *SubscriptionBroker) Publish(key broker.Key, message interface{}) int {
+	ret := _m.Called(key, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Publish")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(broker.Key, interface{}) int); ok {
+		r0 = rf(key, message)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// SubscriptionBroker_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type SubscriptionBroker_Publish_Call struct {
+	*mock.Call
+}
+
+// Publish is a helper method to define mock.On call
+//   - key broker.Key
+//   - message interface{}
+func (_e *SubscriptionBroker_Expecter) Publish(key interface{}, message interface{}) *SubscriptionBroker_Publish_Call {
+	return &SubscriptionBroker_Publish_Call{Call: _e.mock.On("Publish", key, message)}
+}
+
+func (_c *SubscriptionBroker_Publish_Call) Run(run func(key broker.Key, message interface{})) *SubscriptionBroker_Publish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(broker.Key), args[1].(interface{}))
+	})
+	return _c
+}
+
+func (_c *SubscriptionBroker_Publish_Call) Return(_a0 int) *SubscriptionBroker_Publish_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SubscriptionBroker_Publish_Call) RunAndReturn(run func(broker.Key, interface{}) int) *SubscriptionBroker_Publish_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefCount provides a mock function with given fields: key
+func (_m *SubscriptionBroker) RefCount(key broker.Key) int {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefCount")
+	}
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(broker.Key) int); ok {
+		r0 = rf(key)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// SubscriptionBroker_RefCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefCount'
+type SubscriptionBroker_RefCount_Call struct {
+	*mock.Call
+}
+
+// RefCount is a helper method to define mock.On call
+//   - key broker.Key
+func (_e *SubscriptionBroker_Expecter) RefCount(key interface{}) *SubscriptionBroker_RefCount_Call {
+	return &SubscriptionBroker_RefCount_Call{Call: _e.mock.On("RefCount", key)}
+}
+
+func (_c *SubscriptionBroker_RefCount_Call) Run(run func(key broker.Key)) *SubscriptionBroker_RefCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(broker.Key))
+	})
+	return _c
+}
+
+func (_c *SubscriptionBroker_RefCount_Call) Return(_a0 int) *SubscriptionBroker_RefCount_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SubscriptionBroker_RefCount_Call) RunAndReturn(run func(broker.Key) int) *SubscriptionBroker_RefCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetUpstream provides a mock function with given fields: upstream
+func (_m *SubscriptionBroker) SetUpstream(upstream broker.Upstream) {
+	_m.Called(upstream)
+}
+
+// SubscriptionBroker_SetUpstream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUpstream'
+type SubscriptionBroker_SetUpstream_Call struct {
+	*mock.Call
+}
+
+// SetUpstream is a helper method to define mock.On call
+//   - upstream broker.Upstream
+func (_e *SubscriptionBroker_Expecter) SetUpstream(upstream interface{}) *SubscriptionBroker_SetUpstream_Call {
+	return &SubscriptionBroker_SetUpstream_Call{Call: _e.mock.On("SetUpstream", upstream)}
+}
+
+func (_c *SubscriptionBroker_SetUpstream_Call) Run(run func(upstream broker.Upstream)) *SubscriptionBroker_SetUpstream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(broker.Upstream))
+	})
+	return _c
+}
+
+func (_c *SubscriptionBroker_SetUpstream_Call) Return() *SubscriptionBroker_SetUpstream_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *SubscriptionBroker_SetUpstream_Call) RunAndReturn(run func(broker.Upstream)) *SubscriptionBroker_SetUpstream_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewSubscriptionBroker creates a new instance of SubscriptionBroker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSubscriptionBroker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SubscriptionBroker {
+	mock := &SubscriptionBroker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
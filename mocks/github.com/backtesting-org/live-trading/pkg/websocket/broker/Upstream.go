@@ -0,0 +1,127 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package broker
+
+import (
+	broker "github.com/backtesting-org/live-trading/pkg/websocket/broker"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Upstream is an autogenerated mock type for the Upstream type
+type Upstream struct {
+	mock.Mock
+}
+
+type Upstream_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Upstream) EXPECT() *Upstream_Expecter {
+	return &Upstream_Expecter{mock: &_m.Mock}
+}
+
+// Subscribe provides a mock function with given fields: key
+func (_m *Upstream) Subscribe(key broker.Key) error {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(broker.Key) error); ok {
+		r0 = rf(key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Upstream_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type Upstream_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - key broker.Key
+func (_e *Upstream_Expecter) Subscribe(key interface{}) *Upstream_Subscribe_Call {
+	return &Upstream_Subscribe_Call{Call: _e.mock.On("Subscribe", key)}
+}
+
+func (_c *Upstream_Subscribe_Call) Run(run func(key broker.Key)) *Upstream_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(broker.Key))
+	})
+	return _c
+}
+
+func (_c *Upstream_Subscribe_Call) Return(_a0 error) *Upstream_Subscribe_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Upstream_Subscribe_Call) RunAndReturn(run func(broker.Key) error) *Upstream_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unsubscribe provides a mock function with given fields: key
+func (_m *Upstream) Unsubscribe(key broker.Key) error {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unsubscribe")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(broker.Key) error); ok {
+		r0 = rf(key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Upstream_Unsubscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unsubscribe'
+type Upstream_Unsubscribe_Call struct {
+	*mock.Call
+}
+
+// Unsubscribe is a helper method to define mock.On call
+//   - key broker.Key
+func (_e *Upstream_Expecter) Unsubscribe(key interface{}) *Upstream_Unsubscribe_Call {
+	return &Upstream_Unsubscribe_Call{Call: _e.mock.On("Unsubscribe", key)}
+}
+
+func (_c *Upstream_Unsubscribe_Call) Run(run func(key broker.Key)) *Upstream_Unsubscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(broker.Key))
+	})
+	return _c
+}
+
+func (_c *Upstream_Unsubscribe_Call) Return(_a0 error) *Upstream_Unsubscribe_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Upstream_Unsubscribe_Call) RunAndReturn(run func(broker.Key) error) *Upstream_Unsubscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUpstream creates a new instance of Upstream. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUpstream(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Upstream {
+	mock := &Upstream{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
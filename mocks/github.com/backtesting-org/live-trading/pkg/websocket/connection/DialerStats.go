@@ -0,0 +1,79 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package connection
+
+import mock "github.com/stretchr/testify/mock"
+
+// DialerStats is an autogenerated mock type for the DialerStats type
+type DialerStats struct {
+	mock.Mock
+}
+
+type DialerStats_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DialerStats) EXPECT() *DialerStats_Expecter {
+	return &DialerStats_Expecter{mock: &_m.Mock}
+}
+
+// Stats provides a mock function with no fields
+func (_m *DialerStats) Stats() map[string]interface{} {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stats")
+	}
+
+	var r0 map[string]interface{}
+	if rf, ok := ret.Get(0).(func() map[string]interface{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]interface{})
+		}
+	}
+
+	return r0
+}
+
+// DialerStats_Stats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stats'
+type DialerStats_Stats_Call struct {
+	*mock.Call
+}
+
+// Stats is a helper method to define mock.On call
+func (_e *DialerStats_Expecter) Stats() *DialerStats_Stats_Call {
+	return &DialerStats_Stats_Call{Call: _e.mock.On("Stats")}
+}
+
+func (_c *DialerStats_Stats_Call) Run(run func()) *DialerStats_Stats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *DialerStats_Stats_Call) Return(_a0 map[string]interface{}) *DialerStats_Stats_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DialerStats_Stats_Call) RunAndReturn(run func() map[string]interface{}) *DialerStats_Stats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDialerStats creates a new instance of DialerStats. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDialerStats(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DialerStats {
+	mock := &DialerStats{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
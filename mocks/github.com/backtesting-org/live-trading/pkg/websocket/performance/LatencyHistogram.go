@@ -0,0 +1,192 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package performance
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// LatencyHistogram is an autogenerated mock type for the LatencyHistogram type
+type LatencyHistogram struct {
+	mock.Mock
+}
+
+type LatencyHistogram_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *LatencyHistogram) EXPECT() *LatencyHistogram_Expecter {
+	return &LatencyHistogram_Expecter{mock: &_m.Mock}
+}
+
+// Count provides a mock function with no fields
+func (_m *LatencyHistogram) Count() int64 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Count")
+	}
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	return r0
+}
+
+// LatencyHistogram_Count_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Count'
+type LatencyHistogram_Count_Call struct {
+	*mock.Call
+}
+
+// Count is a helper method to define mock.On call
+func (_e *LatencyHistogram_Expecter) Count() *LatencyHistogram_Count_Call {
+	return &LatencyHistogram_Count_Call{Call: _e.mock.On("Count")}
+}
+
+func (_c *LatencyHistogram_Count_Call) Run(run func()) *LatencyHistogram_Count_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *LatencyHistogram_Count_Call) Return(_a0 int64) *LatencyHistogram_Count_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LatencyHistogram_Count_Call) RunAndReturn(run func() int64) *LatencyHistogram_Count_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Percentile provides a mock function with given fields: q
+func (_m *LatencyHistogram) Percentile(q float64) time.Duration {
+	ret := _m.Called(q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Percentile")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func(float64) time.Duration); ok {
+		r0 = rf(q)
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// LatencyHistogram_Percentile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Percentile'
+type LatencyHistogram_Percentile_Call struct {
+	*mock.Call
+}
+
+// Percentile is a helper method to define mock.On call
+//   - q float64
+func (_e *LatencyHistogram_Expecter) Percentile(q interface{}) *LatencyHistogram_Percentile_Call {
+	return &LatencyHistogram_Percentile_Call{Call: _e.mock.On("Percentile", q)}
+}
+
+func (_c *LatencyHistogram_Percentile_Call) Run(run func(q float64)) *LatencyHistogram_Percentile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64))
+	})
+	return _c
+}
+
+func (_c *LatencyHistogram_Percentile_Call) Return(_a0 time.Duration) *LatencyHistogram_Percentile_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LatencyHistogram_Percentile_Call) RunAndReturn(run func(float64) time.Duration) *LatencyHistogram_Percentile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Record provides a mock function with given fields: latency
+func (_m *LatencyHistogram) Record(latency time.Duration) {
+	_m.Called(latency)
+}
+
+// LatencyHistogram_Record_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Record'
+type LatencyHistogram_Record_Call struct {
+	*mock.Call
+}
+
+// Record is a helper method to define mock.On call
+//   - latency time.Duration
+func (_e *LatencyHistogram_Expecter) Record(latency interface{}) *LatencyHistogram_Record_Call {
+	return &LatencyHistogram_Record_Call{Call: _e.mock.On("Record", latency)}
+}
+
+func (_c *LatencyHistogram_Record_Call) Run(run func(latency time.Duration)) *LatencyHistogram_Record_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *LatencyHistogram_Record_Call) Return() *LatencyHistogram_Record_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *LatencyHistogram_Record_Call) RunAndReturn(run func(time.Duration)) *LatencyHistogram_Record_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Reset provides a mock function with no fields
+func (_m *LatencyHistogram) Reset() {
+	_m.Called()
+}
+
+// LatencyHistogram_Reset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reset'
+type LatencyHistogram_Reset_Call struct {
+	*mock.Call
+}
+
+// Reset is a helper method to define mock.On call
+func (_e *LatencyHistogram_Expecter) Reset() *LatencyHistogram_Reset_Call {
+	return &LatencyHistogram_Reset_Call{Call: _e.mock.On("Reset")}
+}
+
+func (_c *LatencyHistogram_Reset_Call) Run(run func()) *LatencyHistogram_Reset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *LatencyHistogram_Reset_Call) Return() *LatencyHistogram_Reset_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *LatencyHistogram_Reset_Call) RunAndReturn(run func()) *LatencyHistogram_Reset_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewLatencyHistogram creates a new instance of LatencyHistogram. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLatencyHistogram(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LatencyHistogram {
+	mock := &LatencyHistogram{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -229,6 +229,39 @@ func (_c *Metrics_IncrementReconnection_Call) RunAndReturn(run func()) *Metrics_
 	return _c
 }
 
+// RecordConnectionDuration provides a mock function with given fields: duration
+func (_m *Metrics) RecordConnectionDuration(duration time.Duration) {
+	_m.Called(duration)
+}
+
+// Metrics_RecordConnectionDuration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordConnectionDuration'
+type Metrics_RecordConnectionDuration_Call struct {
+	*mock.Call
+}
+
+// RecordConnectionDuration is a helper method to define mock.On call
+//   - duration time.Duration
+func (_e *Metrics_Expecter) RecordConnectionDuration(duration interface{}) *Metrics_RecordConnectionDuration_Call {
+	return &Metrics_RecordConnectionDuration_Call{Call: _e.mock.On("RecordConnectionDuration", duration)}
+}
+
+func (_c *Metrics_RecordConnectionDuration_Call) Run(run func(duration time.Duration)) *Metrics_RecordConnectionDuration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *Metrics_RecordConnectionDuration_Call) Return() *Metrics_RecordConnectionDuration_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Metrics_RecordConnectionDuration_Call) RunAndReturn(run func(time.Duration)) *Metrics_RecordConnectionDuration_Call {
+	_c.Run(run)
+	return _c
+}
+
 // NewMetrics creates a new instance of Metrics. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMetrics(t interface {
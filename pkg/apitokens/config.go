@@ -0,0 +1,64 @@
+// Package apitokens issues read-only API tokens scoped to plugins or runs,
+// so strategy authors can query their own runs without platform credentials
+package apitokens
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultTTL is how long a token lives when issued without an expiry
+	DefaultTTL = 30 * 24 * time.Hour
+
+	// DefaultMaxTTL caps how far ahead a token may expire
+	DefaultMaxTTL = 365 * 24 * time.Hour
+
+	// ConfigKeyPlugin is the run config key, passed to RunReporter.Begin,
+	// naming the plugin a run belongs to; plugin-bound tokens cover every
+	// run that carries it
+	ConfigKeyPlugin = "plugin_id"
+)
+
+// Config controls who may administer tokens and where they are kept
+type Config struct {
+	// AdminToken authorises issuing and revoking tokens and passes every
+	// Protect check; empty disables the admin API
+	AdminToken string
+
+	// Path receives every issue and revocation as JSON lines and is replayed
+	// on Configure, so tokens survive a restart; empty keeps them in memory
+	// only. Secrets are stored hashed.
+	Path string
+
+	DefaultTTL time.Duration
+	MaxTTL     time.Duration
+}
+
+// DefaultConfig keeps tokens in memory, valid for 30 days unless asked
+// otherwise, with the admin API disabled
+func DefaultConfig() Config {
+	return Config{
+		DefaultTTL: DefaultTTL,
+		MaxTTL:     DefaultMaxTTL,
+	}
+}
+
+func (c *Config) applyDefaults() error {
+	if c.DefaultTTL < 0 {
+		return fmt.Errorf("default TTL must not be negative, got %s", c.DefaultTTL)
+	}
+	if c.MaxTTL < 0 {
+		return fmt.Errorf("max TTL must not be negative, got %s", c.MaxTTL)
+	}
+	if c.DefaultTTL == 0 {
+		c.DefaultTTL = DefaultTTL
+	}
+	if c.MaxTTL == 0 {
+		c.MaxTTL = DefaultMaxTTL
+	}
+	if c.DefaultTTL > c.MaxTTL {
+		return fmt.Errorf("default TTL %s exceeds max TTL %s", c.DefaultTTL, c.MaxTTL)
+	}
+	return nil
+}
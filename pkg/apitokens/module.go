@@ -0,0 +1,9 @@
+package apitokens
+
+import "go.uber.org/fx"
+
+// Module provides the token store; the host wraps the handlers it mounts
+// with Protect
+var Module = fx.Options(
+	fx.Provide(NewTokenStore),
+)
@@ -0,0 +1,515 @@
+package apitokens
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/jsonl"
+	"github.com/backtesting-org/live-trading/pkg/runreport"
+	"github.com/google/uuid"
+)
+
+// Scope is one kind of run data a token may read
+type Scope string
+
+const (
+	// ScopeStatus covers the run report, inspection and live stream
+	ScopeStatus Scope = "status"
+
+	// ScopeLogs covers the run log
+	ScopeLogs Scope = "logs"
+
+	// ScopeSignals covers signal timings and the run log's signal entries
+	ScopeSignals Scope = "signals"
+
+	// ScopePerformance covers the run metrics series and PnL
+	ScopePerformance Scope = "performance"
+)
+
+var scopes = map[Scope]bool{ScopeStatus: true, ScopeLogs: true, ScopeSignals: true, ScopePerformance: true}
+
+var (
+	// ErrUnauthorized is a missing, unknown, expired or revoked token
+	ErrUnauthorized = errors.New("invalid API token")
+
+	// ErrForbidden is a valid token used outside its runs or scopes
+	ErrForbidden = errors.New("API token does not grant this access")
+)
+
+// Token is an issued token; its secret is only ever returned by Issue
+type Token struct {
+	ID   string
+	Name string
+
+	// Runs and Plugins are what the token may read; a plugin covers every
+	// run whose config names it under ConfigKeyPlugin
+	Runs    []string
+	Plugins []string
+	Scopes  []Scope
+
+	IssuedBy   string
+	IssuedAt   time.Time
+	ExpiresAt  time.Time
+	RevokedBy  string
+	RevokedAt  time.Time
+	LastUsedAt time.Time
+}
+
+// Active reports whether the token may still be used at now
+func (t Token) Active(now time.Time) bool {
+	return t.RevokedAt.IsZero() && now.Before(t.ExpiresAt)
+}
+
+// Request is an admin's ask for a token; a zero ExpiresAt uses the
+// configured default TTL
+type Request struct {
+	Name      string
+	Runs      []string
+	Plugins   []string
+	Scopes    []Scope
+	ExpiresAt time.Time
+	Actor     string
+}
+
+// Issued is a new token and the secret to present as a bearer token
+type Issued struct {
+	Token
+	Secret string
+}
+
+// TokenStore issues, revokes and checks scoped read-only tokens. Protect
+// puts the check in front of any handler that selects its run with ?run=,
+// so the same handlers mounted on the metrics server can be opened up to
+// strategy authors without exposing other runs or anything that writes.
+type TokenStore interface {
+	Configure(config Config) error
+
+	Issue(request Request) (Issued, error)
+	Revoke(id, actor string) error
+
+	// Tokens lists every token, revoked and expired ones included, newest
+	// first
+	Tokens() []Token
+
+	// Authorize checks that secret may read scope of runID and records
+	// the use
+	Authorize(secret, runID string, scope Scope) (Token, error)
+
+	// Admin reports whether secret is the configured admin token
+	Admin(secret string) bool
+
+	// Protect serves handler to the admin token, and to scoped tokens on
+	// GET for runs they cover. ?run= is filled in with the active run when
+	// absent, so the handler cannot fall back to a run the token does not
+	// cover.
+	Protect(scope Scope, handler http.Handler) http.Handler
+
+	// Handler is the admin API: GET lists tokens, POST issues one from a
+	// JSON Request and DELETE revokes ?id=
+	Handler() http.Handler
+}
+
+// record is one line of the token log
+type record struct {
+	Action string
+	Token  Token
+	Hash   string `json:",omitempty"`
+	At     time.Time
+}
+
+const (
+	actionIssue  = "issue"
+	actionRevoke = "revoke"
+)
+
+type tokenStore struct {
+	reporter     runreport.RunReporter
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config Config
+	tokens map[string]*Token
+	hashes map[string]string
+	log    *os.File
+	mu     sync.Mutex
+}
+
+func NewTokenStore(
+	reporter runreport.RunReporter,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) TokenStore {
+	return &tokenStore{
+		reporter:     reporter,
+		timeProvider: timeProvider,
+		logger:       logger,
+		config:       DefaultConfig(),
+		tokens:       make(map[string]*Token),
+		hashes:       make(map[string]string),
+	}
+}
+
+func (s *tokenStore) Configure(config Config) error {
+	if err := config.applyDefaults(); err != nil {
+		return err
+	}
+
+	tokens := make(map[string]*Token)
+	hashes := make(map[string]string)
+	var log *os.File
+	if config.Path != "" {
+		err := jsonl.ScanFile(config.Path, func(number int, line []byte) error {
+			var rec record
+			if err := json.Unmarshal(line, &rec); err != nil {
+				return fmt.Errorf("token log line %d: %w", number, err)
+			}
+			replay(tokens, hashes, rec)
+			return nil
+		})
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to load API tokens: %w", err)
+		}
+
+		if log, err = jsonl.OpenAppend(config.Path, 0o600); err != nil {
+			return fmt.Errorf("failed to open API token log: %w", err)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.log != nil {
+		_ = s.log.Close()
+	}
+	s.config = config
+	s.log = log
+	if config.Path != "" {
+		s.tokens = tokens
+		s.hashes = hashes
+	}
+	return nil
+}
+
+func replay(tokens map[string]*Token, hashes map[string]string, rec record) {
+	switch rec.Action {
+	case actionIssue:
+		token := rec.Token
+		tokens[token.ID] = &token
+		hashes[rec.Hash] = token.ID
+	case actionRevoke:
+		if token, ok := tokens[rec.Token.ID]; ok {
+			token.RevokedBy = rec.Token.RevokedBy
+			token.RevokedAt = rec.Token.RevokedAt
+		}
+	}
+}
+
+func (s *tokenStore) Issue(request Request) (Issued, error) {
+	if request.Name == "" {
+		return Issued{}, fmt.Errorf("token name is required")
+	}
+	if request.Actor == "" {
+		return Issued{}, fmt.Errorf("actor is required to issue a token")
+	}
+	if len(request.Runs) == 0 && len(request.Plugins) == 0 {
+		return Issued{}, fmt.Errorf("token %s must be bound to at least one run or plugin", request.Name)
+	}
+	if len(request.Scopes) == 0 {
+		return Issued{}, fmt.Errorf("token %s needs at least one scope", request.Name)
+	}
+	for _, scope := range request.Scopes {
+		if !scopes[scope] {
+			return Issued{}, fmt.Errorf("unknown scope %q", scope)
+		}
+	}
+
+	now := s.timeProvider.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	expires := request.ExpiresAt
+	if expires.IsZero() {
+		expires = now.Add(s.config.DefaultTTL)
+	}
+	if !expires.After(now) {
+		return Issued{}, fmt.Errorf("token %s would already be expired at %s", request.Name, expires.Format(time.RFC3339))
+	}
+	if expires.Sub(now) > s.config.MaxTTL {
+		return Issued{}, fmt.Errorf("token %s may expire at most %s ahead", request.Name, s.config.MaxTTL)
+	}
+
+	secret, err := newSecret()
+	if err != nil {
+		return Issued{}, err
+	}
+	token := Token{
+		ID:        uuid.New().String(),
+		Name:      request.Name,
+		Runs:      append([]string(nil), request.Runs...),
+		Plugins:   append([]string(nil), request.Plugins...),
+		Scopes:    append([]Scope(nil), request.Scopes...),
+		IssuedBy:  request.Actor,
+		IssuedAt:  now,
+		ExpiresAt: expires,
+	}
+
+	hash := hashSecret(secret)
+	rec := record{Action: actionIssue, Token: token, Hash: hash, At: now}
+	if err := s.appendLocked(rec); err != nil {
+		return Issued{}, err
+	}
+	replay(s.tokens, s.hashes, rec)
+
+	s.logger.Info("🔑 %s issued API token %s (%s) for %d runs and %d plugins, expiring %s",
+		request.Actor, token.ID, token.Name, len(token.Runs), len(token.Plugins), expires.Format(time.RFC3339))
+	return Issued{Token: token, Secret: secret}, nil
+}
+
+func (s *tokenStore) Revoke(id, actor string) error {
+	if actor == "" {
+		return fmt.Errorf("actor is required to revoke a token")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	token, ok := s.tokens[id]
+	if !ok {
+		return fmt.Errorf("API token %s not found", id)
+	}
+	if !token.RevokedAt.IsZero() {
+		return nil
+	}
+
+	revoked := *token
+	revoked.RevokedBy = actor
+	revoked.RevokedAt = s.timeProvider.Now()
+	rec := record{Action: actionRevoke, Token: revoked, At: revoked.RevokedAt}
+	if err := s.appendLocked(rec); err != nil {
+		return err
+	}
+	replay(s.tokens, s.hashes, rec)
+
+	s.logger.Info("🔑 %s revoked API token %s (%s)", actor, id, token.Name)
+	return nil
+}
+
+// appendLocked persists rec before it is applied, so a token is never
+// usable without being on disk, nor revoked only in memory
+func (s *tokenStore) appendLocked(rec record) error {
+	if s.log == nil {
+		return nil
+	}
+	if err := jsonl.Append(s.log, rec); err != nil {
+		return fmt.Errorf("failed to write API token log: %w", err)
+	}
+	return nil
+}
+
+func (s *tokenStore) Tokens() []Token {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tokens := make([]Token, 0, len(s.tokens))
+	for _, token := range s.tokens {
+		tokens = append(tokens, *token)
+	}
+	sort.Slice(tokens, func(i, j int) bool { return tokens[i].IssuedAt.After(tokens[j].IssuedAt) })
+	return tokens
+}
+
+func (s *tokenStore) Authorize(secret, runID string, scope Scope) (Token, error) {
+	if secret == "" {
+		return Token{}, ErrUnauthorized
+	}
+	now := s.timeProvider.Now()
+
+	s.mu.Lock()
+	id, ok := s.hashes[hashSecret(secret)]
+	if !ok {
+		s.mu.Unlock()
+		return Token{}, ErrUnauthorized
+	}
+	token := s.tokens[id]
+	if !token.Active(now) {
+		s.mu.Unlock()
+		return Token{}, fmt.Errorf("token %s is revoked or expired: %w", id, ErrUnauthorized)
+	}
+	granted := *token
+	s.mu.Unlock()
+
+	if !hasScope(granted.Scopes, scope) {
+		return granted, fmt.Errorf("token %s lacks the %s scope: %w", id, scope, ErrForbidden)
+	}
+	if !s.covers(granted, runID) {
+		return granted, fmt.Errorf("token %s does not cover run %s: %w", id, runID, ErrForbidden)
+	}
+
+	s.mu.Lock()
+	token.LastUsedAt = now
+	granted.LastUsedAt = now
+	s.mu.Unlock()
+	return granted, nil
+}
+
+// covers reports whether runID is bound to the token directly or through
+// its plugin
+func (s *tokenStore) covers(token Token, runID string) bool {
+	if runID == "" {
+		return false
+	}
+	for _, run := range token.Runs {
+		if run == runID {
+			return true
+		}
+	}
+	if len(token.Plugins) == 0 {
+		return false
+	}
+
+	plugin := s.pluginOf(runID)
+	for _, p := range token.Plugins {
+		if plugin != "" && p == plugin {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *tokenStore) pluginOf(runID string) string {
+	if current, ok := s.reporter.Current(); ok && current.RunID == runID {
+		return current.Config[ConfigKeyPlugin]
+	}
+	if report, ok := s.reporter.Report(runID); ok {
+		return report.Config[ConfigKeyPlugin]
+	}
+	return ""
+}
+
+func (s *tokenStore) Admin(secret string) bool {
+	s.mu.Lock()
+	admin := s.config.AdminToken
+	s.mu.Unlock()
+	return admin != "" && subtle.ConstantTimeCompare([]byte(secret), []byte(admin)) == 1
+}
+
+func (s *tokenStore) Protect(scope Scope, handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		secret := bearer(req)
+		if s.Admin(secret) {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		if req.Method != http.MethodGet && req.Method != http.MethodHead {
+			http.Error(w, "API tokens are read-only", http.StatusForbidden)
+			return
+		}
+
+		query := req.URL.Query()
+		runID := query.Get("run")
+		if runID == "" {
+			runID, _ = s.reporter.Active()
+		}
+		if runID == "" {
+			http.Error(w, "no active run", http.StatusNotFound)
+			return
+		}
+
+		if _, err := s.Authorize(secret, runID, scope); err != nil {
+			if errors.Is(err, ErrForbidden) {
+				http.Error(w, err.Error(), http.StatusForbidden)
+				return
+			}
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, ErrUnauthorized.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		query.Set("run", runID)
+		scoped := req.Clone(req.Context())
+		scoped.URL.RawQuery = query.Encode()
+		handler.ServeHTTP(w, scoped)
+	})
+}
+
+func (s *tokenStore) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if !s.Admin(bearer(req)) {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "admin token required", http.StatusUnauthorized)
+			return
+		}
+
+		switch req.Method {
+		case http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(s.Tokens())
+		case http.MethodPost:
+			var request Request
+			if err := json.NewDecoder(req.Body).Decode(&request); err != nil {
+				http.Error(w, fmt.Sprintf("invalid token request: %v", err), http.StatusBadRequest)
+				return
+			}
+			issued, err := s.Issue(request)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(issued)
+		case http.MethodDelete:
+			if err := s.Revoke(req.URL.Query().Get("id"), req.URL.Query().Get("actor")); err != nil {
+				http.Error(w, err.Error(), http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+func bearer(req *http.Request) string {
+	header := req.Header.Get("Authorization")
+	if len(header) > 7 && strings.EqualFold(header[:7], "bearer ") {
+		return strings.TrimSpace(header[7:])
+	}
+	return ""
+}
+
+func hasScope(granted []Scope, scope Scope) bool {
+	for _, s := range granted {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+func newSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate token secret: %w", err)
+	}
+	return "lt_" + hex.EncodeToString(buf), nil
+}
+
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
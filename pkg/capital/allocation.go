@@ -0,0 +1,100 @@
+package capital
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+)
+
+// Sweep is one move of profit into the reserve
+type Sweep struct {
+	RunID  string
+	Mode   SweepMode
+	Amount numerical.Decimal
+
+	// Reserve is the reserve after the sweep
+	Reserve numerical.Decimal
+	At      time.Time
+}
+
+// Allocation is the capital a run may trade with under its policy
+type Allocation struct {
+	RunID     string
+	Policy    Policy
+	SweepMode SweepMode
+
+	Initial numerical.Decimal
+
+	// RealizedPnL is net realized PnL booked since the run began
+	RealizedPnL numerical.Decimal
+
+	// Equity is initial plus realized PnL; Allocation is the part of it
+	// the strategy may trade and Reserve the part swept away from it
+	Equity     numerical.Decimal
+	Allocation numerical.Decimal
+	Reserve    numerical.Decimal
+
+	Sweeps    []Sweep
+	StartedAt time.Time
+	UpdatedAt time.Time
+}
+
+// apply recomputes the allocation from realized PnL and returns the sweep
+// it made, if any
+func (a *Allocation) apply(config Config, pnl numerical.Decimal, now time.Time) *Sweep {
+	a.RealizedPnL = pnl
+	a.Equity = a.Initial.Add(pnl)
+	a.UpdatedAt = now
+
+	var sweep *Sweep
+	switch a.Policy {
+	case PolicyCompound:
+		a.Allocation = a.Equity
+	case PolicySweep:
+		// A swept reserve is not handed back after later losses
+		excess := a.Equity.Sub(a.Reserve).Sub(a.Initial)
+		if excess.IsPositive() && excess.GreaterThan(config.MinSweep) {
+			a.Reserve = a.Reserve.Add(excess)
+			sweep = &Sweep{RunID: a.RunID, Mode: a.SweepMode, Amount: excess, Reserve: a.Reserve, At: now}
+			a.Sweeps = append(a.Sweeps, *sweep)
+		}
+		a.Allocation = a.Equity.Sub(a.Reserve)
+	default:
+		a.Allocation = a.Initial
+	}
+
+	if a.Allocation.IsNegative() {
+		a.Allocation = numerical.Zero()
+	}
+	return sweep
+}
+
+// WriteMarkdown renders the allocation report
+func (a Allocation) WriteMarkdown(w io.Writer) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "## Capital\n\n")
+	fmt.Fprintf(&b, "- Policy: %s", a.Policy)
+	if a.Policy == PolicySweep {
+		fmt.Fprintf(&b, " (%s)", a.SweepMode)
+	}
+	b.WriteString("\n")
+	fmt.Fprintf(&b, "- Initial: %s\n", a.Initial.Round(2))
+	fmt.Fprintf(&b, "- Realized PnL: %s\n", a.RealizedPnL.Round(2))
+	fmt.Fprintf(&b, "- Allocation: %s\n", a.Allocation.Round(2))
+	if a.Policy == PolicySweep {
+		fmt.Fprintf(&b, "- Reserve: %s over %d sweeps\n", a.Reserve.Round(2), len(a.Sweeps))
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func (a *Allocation) copy() Allocation {
+	copied := *a
+	copied.Sweeps = append([]Sweep(nil), a.Sweeps...)
+	return copied
+}
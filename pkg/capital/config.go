@@ -0,0 +1,67 @@
+// Package capital applies a per-run capital policy to realized PnL, deciding
+// how much of a strategy's profit it may trade with
+package capital
+
+import (
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+)
+
+// Policy is what happens to realized profit
+type Policy string
+
+const (
+	// PolicyFixed keeps the allocation at the initial amount
+	PolicyFixed Policy = "fixed"
+
+	// PolicyCompound adds realized PnL, profit or loss, to the allocation
+	PolicyCompound Policy = "compound"
+
+	// PolicySweep moves profit above the initial allocation to a reserve
+	// the strategy may not trade with
+	PolicySweep Policy = "sweep"
+)
+
+// SweepMode is how a sweep reaches the reserve
+type SweepMode string
+
+const (
+	// SweepVirtual only tracks the reserve; funds stay in the trading account
+	SweepVirtual SweepMode = "virtual"
+
+	// SweepAlert also publishes each sweep as a recommended transfer
+	SweepAlert SweepMode = "alert"
+)
+
+const (
+	// DefaultInterval is how often the allocation is recomputed
+	DefaultInterval = time.Minute
+
+	// JobName is the scheduler job that recomputes the allocation
+	JobName = "capital-policy"
+)
+
+// Config is one run's capital policy
+type Config struct {
+	Policy  Policy
+	Initial numerical.Decimal
+
+	// SweepMode and MinSweep apply to PolicySweep only; profit is swept once
+	// it exceeds MinSweep, so small gains are not swept one by one
+	SweepMode SweepMode
+	MinSweep  numerical.Decimal
+
+	Interval time.Duration
+}
+
+// DefaultConfig keeps the allocation fixed at initial
+func DefaultConfig(initial numerical.Decimal) Config {
+	return Config{
+		Policy:    PolicyFixed,
+		Initial:   initial,
+		SweepMode: SweepVirtual,
+		MinSweep:  numerical.Zero(),
+		Interval:  DefaultInterval,
+	}
+}
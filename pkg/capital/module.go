@@ -0,0 +1,7 @@
+package capital
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(NewCapitalManager),
+)
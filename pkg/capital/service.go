@@ -0,0 +1,232 @@
+package capital
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/events"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/accounting"
+	"github.com/backtesting-org/live-trading/pkg/eventschema"
+	"github.com/backtesting-org/live-trading/pkg/scheduler"
+)
+
+// CapitalManager tracks the active run's allocation under its policy.
+// Strategies size from Current; the run report picks up the final
+// allocation by run ID.
+type CapitalManager interface {
+	// Begin starts a run with the given policy; PnL booked before it is
+	// not counted
+	Begin(runID string, config Config) error
+
+	// Update recomputes the active run's allocation, sweeping any profit due
+	Update() (Allocation, error)
+
+	// Finish makes a last update and stops tracking the run
+	Finish() (Allocation, error)
+
+	Current() (Allocation, bool)
+
+	// Allocation returns a run's allocation, active or finished
+	Allocation(runID string) (Allocation, bool)
+
+	// Handler serves an allocation as JSON, or as markdown with
+	// ?format=md; ?run= selects the run and defaults to the active one
+	Handler() http.Handler
+}
+
+type capitalRun struct {
+	config     Config
+	allocation *Allocation
+	baseline   numerical.Decimal
+}
+
+type capitalManager struct {
+	ledger       accounting.Ledger
+	bus          events.EventBus
+	scheduler    scheduler.Scheduler
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	active   *capitalRun
+	finished map[string]Allocation
+	mu       sync.Mutex
+}
+
+func NewCapitalManager(
+	ledger accounting.Ledger,
+	bus events.EventBus,
+	jobScheduler scheduler.Scheduler,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) CapitalManager {
+	return &capitalManager{
+		ledger:       ledger,
+		bus:          bus,
+		scheduler:    jobScheduler,
+		timeProvider: timeProvider,
+		logger:       logger,
+		finished:     make(map[string]Allocation),
+	}
+}
+
+func (c *capitalManager) Begin(runID string, config Config) error {
+	if runID == "" {
+		return fmt.Errorf("run ID is required")
+	}
+	if !config.Initial.IsPositive() {
+		return fmt.Errorf("initial allocation must be positive, got %s", config.Initial)
+	}
+	switch config.Policy {
+	case "":
+		config.Policy = PolicyFixed
+	case PolicyFixed, PolicyCompound, PolicySweep:
+	default:
+		return fmt.Errorf("unknown capital policy %q", config.Policy)
+	}
+	switch config.SweepMode {
+	case "":
+		config.SweepMode = SweepVirtual
+	case SweepVirtual, SweepAlert:
+	default:
+		return fmt.Errorf("unknown sweep mode %q", config.SweepMode)
+	}
+	if config.MinSweep.IsNegative() {
+		return fmt.Errorf("minimum sweep must not be negative")
+	}
+	if config.Interval <= 0 {
+		config.Interval = DefaultInterval
+	}
+
+	now := c.timeProvider.Now()
+	allocation := &Allocation{
+		RunID:      runID,
+		Policy:     config.Policy,
+		Initial:    config.Initial,
+		Allocation: config.Initial,
+		Equity:     config.Initial,
+		Reserve:    numerical.Zero(),
+		StartedAt:  now,
+		UpdatedAt:  now,
+	}
+	if config.Policy == PolicySweep {
+		allocation.SweepMode = config.SweepMode
+	}
+
+	c.mu.Lock()
+	if c.active != nil {
+		active := c.active.allocation.RunID
+		c.mu.Unlock()
+		return fmt.Errorf("run %s already has a capital policy", active)
+	}
+	c.active = &capitalRun{config: config, allocation: allocation, baseline: c.realized()}
+	c.mu.Unlock()
+
+	c.logger.Info("Run %s capital policy %s with %s allocated", runID, config.Policy, config.Initial)
+
+	return c.scheduler.Register(scheduler.Job{
+		Name:     JobName,
+		Interval: config.Interval,
+		Run: func(_ context.Context) error {
+			_, err := c.Update()
+			return err
+		},
+	})
+}
+
+func (c *capitalManager) Update() (Allocation, error) {
+	realized := c.realized()
+
+	c.mu.Lock()
+	if c.active == nil {
+		c.mu.Unlock()
+		return Allocation{}, fmt.Errorf("no run has a capital policy")
+	}
+	active := c.active
+	sweep := active.allocation.apply(active.config, realized.Sub(active.baseline), c.timeProvider.Now())
+	allocation := active.allocation.copy()
+	c.mu.Unlock()
+
+	if sweep != nil {
+		c.logger.Info("Run %s swept %s to reserve, %s now allocated", sweep.RunID, sweep.Amount, allocation.Allocation)
+		if sweep.Mode == SweepAlert {
+			c.bus.Publish(eventschema.TopicCapitalSweep, *sweep)
+		}
+	}
+	return allocation, nil
+}
+
+func (c *capitalManager) Finish() (Allocation, error) {
+	if err := c.scheduler.Unregister(JobName); err != nil {
+		c.logger.Debug("Capital policy job not registered: %v", err)
+	}
+
+	allocation, err := c.Update()
+	if err != nil {
+		return Allocation{}, err
+	}
+
+	c.mu.Lock()
+	c.active = nil
+	c.finished[allocation.RunID] = allocation
+	c.mu.Unlock()
+
+	return allocation, nil
+}
+
+func (c *capitalManager) Current() (Allocation, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.active == nil {
+		return Allocation{}, false
+	}
+	return c.active.allocation.copy(), true
+}
+
+func (c *capitalManager) Allocation(runID string) (Allocation, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.active != nil && c.active.allocation.RunID == runID {
+		return c.active.allocation.copy(), true
+	}
+	allocation, ok := c.finished[runID]
+	return allocation, ok
+}
+
+func (c *capitalManager) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		allocation, ok := c.Current()
+		if runID := req.URL.Query().Get("run"); runID != "" {
+			allocation, ok = c.Allocation(runID)
+		}
+		if !ok {
+			http.Error(w, "allocation not found", http.StatusNotFound)
+			return
+		}
+
+		if req.URL.Query().Get("format") == "md" {
+			w.Header().Set("Content-Type", "text/markdown")
+			_ = allocation.WriteMarkdown(w)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(allocation)
+	})
+}
+
+// realized is net realized PnL across every book in the ledger
+func (c *capitalManager) realized() numerical.Decimal {
+	total := numerical.Zero()
+	for _, summary := range c.ledger.Summaries() {
+		total = total.Add(summary.NetRealizedPnL)
+	}
+	return total
+}
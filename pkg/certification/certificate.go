@@ -0,0 +1,140 @@
+package certification
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// Violation kinds
+const (
+	ViolationPanic         = "panic"
+	ViolationTimeout       = "timeout"
+	ViolationOrderRate     = "order_rate"
+	ViolationOrderQuantity = "order_quantity"
+	ViolationPosition      = "position"
+	ViolationUnknownMarket = "unknown_market"
+	ViolationInvalidAction = "invalid_action"
+)
+
+// Violation is one way a strategy broke the rules during a scenario
+type Violation struct {
+	Step   int    `json:"step"`
+	Kind   string `json:"kind"`
+	Detail string `json:"detail"`
+}
+
+// ScenarioResult is what a strategy did in one scenario. Errors are
+// GetSignals calls that returned an error, which is how a strategy should
+// report trouble; they are counted but do not fail the scenario.
+type ScenarioResult struct {
+	Name       string        `json:"name"`
+	Passed     bool          `json:"passed"`
+	Steps      int           `json:"steps"`
+	Signals    int           `json:"signals"`
+	Orders     int           `json:"orders"`
+	Rejected   int           `json:"rejected"`
+	Errors     int           `json:"errors"`
+	Violations []Violation   `json:"violations,omitempty"`
+	Dropped    int           `json:"dropped_violations,omitempty"`
+	Duration   time.Duration `json:"duration"`
+}
+
+// Certificate records one plugin version's gauntlet run. Digest is the
+// SHA-256 of the plugin file, so a rebuilt plugin under the same version
+// must certify again.
+type Certificate struct {
+	Plugin     string           `json:"plugin"`
+	Version    string           `json:"version"`
+	Digest     string           `json:"digest"`
+	Strategy   string           `json:"strategy"`
+	Limits     Limits           `json:"limits"`
+	Scenarios  []ScenarioResult `json:"scenarios"`
+	Passed     bool             `json:"passed"`
+	StartedAt  time.Time        `json:"started_at"`
+	FinishedAt time.Time        `json:"finished_at"`
+}
+
+// Failed returns the scenarios that did not pass
+func (c *Certificate) Failed() []ScenarioResult {
+	var failed []ScenarioResult
+	for _, scenario := range c.Scenarios {
+		if !scenario.Passed {
+			failed = append(failed, scenario)
+		}
+	}
+	return failed
+}
+
+// pluginName is the key certificates are filed under
+func pluginName(pluginPath string) string {
+	return filepath.Base(pluginPath)
+}
+
+func digestFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to open plugin: %w", err)
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", fmt.Errorf("failed to read plugin: %w", err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func loadCertificates(path string) ([]Certificate, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read certificates: %w", err)
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var certificates []Certificate
+	if err := json.Unmarshal(data, &certificates); err != nil {
+		return nil, fmt.Errorf("failed to decode certificates: %w", err)
+	}
+	return certificates, nil
+}
+
+// saveCertificates rewrites the certificate file through a rename so a
+// crash leaves either the old or the new file
+func saveCertificates(path string, certificates []Certificate) error {
+	sorted := append([]Certificate(nil), certificates...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Plugin != sorted[j].Plugin {
+			return sorted[i].Plugin < sorted[j].Plugin
+		}
+		return sorted[i].FinishedAt.Before(sorted[j].FinishedAt)
+	})
+
+	data, err := json.MarshalIndent(sorted, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode certificates: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("failed to create certificate directory: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o640); err != nil {
+		return fmt.Errorf("failed to write certificates: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to replace certificates: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,299 @@
+package certification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/data/stores/market"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/plugin"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/fake"
+)
+
+// ErrNotCertified is returned by Verify when a plugin version has no
+// passing certificate for the file on disk
+var ErrNotCertified = errors.New("plugin is not certified")
+
+// Request names the plugin version to certify and the limits it must keep
+type Request struct {
+	PluginPath string
+	Version    string
+
+	// Exchanges and Assets are simulated under their real names, so the
+	// plugin trades them exactly as its config would have it do live
+	Exchanges []connector.ExchangeName
+	Assets    []portfolio.Asset
+
+	Limits Limits
+}
+
+// Certifier runs plugins through the gauntlet and answers whether a plugin
+// version may trade real funds.
+//
+// Certify registers simulated venues in the connector registry and feeds
+// the market data store the plugin reads through, so it must run in its
+// own process rather than alongside a live run; it refuses to start when
+// real connectors are ready.
+type Certifier interface {
+	Configure(config Config) error
+
+	// Certify loads the plugin, runs every scenario and records the
+	// certificate, passed or not. The error is for runs that could not
+	// take place.
+	Certify(request Request) (*Certificate, error)
+
+	// Verify returns the plugin version's latest certificate, or
+	// ErrNotCertified, wrapped, when it failed, is missing, or was issued
+	// for a different build of the file
+	Verify(pluginPath, version string) (*Certificate, error)
+
+	// Certificates returns every recorded certificate
+	Certificates() ([]Certificate, error)
+}
+
+type certifier struct {
+	registry      registry.ConnectorRegistry
+	marketData    market.MarketData
+	pluginManager plugin.Manager
+	timeProvider  temporal.TimeProvider
+	logger        logging.ApplicationLogger
+
+	config Config
+	mu     sync.Mutex
+}
+
+func NewCertifier(
+	connectorRegistry registry.ConnectorRegistry,
+	marketData market.MarketData,
+	pluginManager plugin.Manager,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) Certifier {
+	return &certifier{
+		registry:      connectorRegistry,
+		marketData:    marketData,
+		pluginManager: pluginManager,
+		timeProvider:  timeProvider,
+		logger:        logger,
+		config:        DefaultConfig(),
+	}
+}
+
+func (c *certifier) Configure(config Config) error {
+	if err := config.applyDefaults(); err != nil {
+		return err
+	}
+	config.Scenarios = append([]Scenario(nil), config.Scenarios...)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.config = config
+	return nil
+}
+
+func (c *certifier) Certify(request Request) (*Certificate, error) {
+	if request.PluginPath == "" || request.Version == "" {
+		return nil, fmt.Errorf("plugin path and version are required")
+	}
+	if len(request.Exchanges) == 0 || len(request.Assets) == 0 {
+		return nil, fmt.Errorf("at least one exchange and asset are required")
+	}
+	if err := request.Limits.applyDefaults(); err != nil {
+		return nil, err
+	}
+	for _, conn := range c.registry.GetReadyConnectors() {
+		if _, simulated := conn.(*fake.Connector); !simulated {
+			return nil, fmt.Errorf("connector %s is live; certification must run in its own process",
+				conn.GetConnectorInfo().Name)
+		}
+	}
+
+	c.mu.Lock()
+	config := c.config
+	c.mu.Unlock()
+
+	digest, err := digestFile(request.PluginPath)
+	if err != nil {
+		return nil, err
+	}
+
+	certificate := &Certificate{
+		Plugin:    pluginName(request.PluginPath),
+		Version:   request.Version,
+		Digest:    digest,
+		Limits:    request.Limits,
+		StartedAt: c.timeProvider.Now(),
+	}
+	// The first venue is registered before the plugin loads so a strategy
+	// that looks its connectors up on construction finds them
+	first := &ScenarioResult{Name: config.Scenarios[0].Name}
+	v, err := newVenue(c.registry, c.marketData, c.timeProvider, request, config, first)
+	if err != nil {
+		return nil, err
+	}
+	v.apply(config.Scenarios[0].Conditions(0, config.Steps))
+
+	strat, err := c.pluginManager.LoadStrategyPlugin(request.PluginPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load plugin: %w", err)
+	}
+	certificate.Strategy = string(strat.GetName())
+	if !strat.IsEnabled() {
+		if err := strat.Enable(); err != nil {
+			return nil, fmt.Errorf("failed to enable %s: %w", strat.GetName(), err)
+		}
+	}
+
+	for i, scenario := range config.Scenarios {
+		result := first
+		if i > 0 {
+			result = &ScenarioResult{Name: scenario.Name}
+			if v, err = newVenue(c.registry, c.marketData, c.timeProvider, request, config, result); err != nil {
+				return nil, err
+			}
+		}
+		c.run(strat, scenario, v, config)
+		certificate.Scenarios = append(certificate.Scenarios, *result)
+
+		if result.Passed {
+			c.logger.Info("certification: %s %s passed %s (%d orders, %d rejected)",
+				certificate.Plugin, request.Version, scenario.Name, result.Orders, result.Rejected)
+		} else {
+			c.logger.Warn("certification: %s %s failed %s with %d violation(s); first: %s",
+				certificate.Plugin, request.Version, scenario.Name, len(result.Violations), result.Violations[0].Detail)
+		}
+	}
+
+	certificate.FinishedAt = c.timeProvider.Now()
+	certificate.Passed = len(certificate.Failed()) == 0
+
+	if err := c.record(config.Path, *certificate); err != nil {
+		return certificate, err
+	}
+	return certificate, nil
+}
+
+// run drives one scenario to the end; a strategy that panics or hangs
+// ends the scenario at that round
+func (c *certifier) run(strat strategy.Strategy, scenario Scenario, v *venue, config Config) {
+	started := c.timeProvider.Now()
+	defer func() {
+		v.result.Duration = c.timeProvider.Now().Sub(started)
+		v.result.Passed = len(v.result.Violations) == 0
+	}()
+
+	for step := 0; step < config.Steps; step++ {
+		v.apply(scenario.Conditions(step, config.Steps))
+		v.result.Steps++
+
+		signals, violation, err := c.signals(strat, config)
+		if violation != nil {
+			v.violate(step, violation.Kind, "%s", violation.Detail)
+			return
+		}
+		if err != nil {
+			v.result.Errors++
+			continue
+		}
+		v.execute(step, signals)
+	}
+}
+
+// signals calls GetSignals with the step timeout, turning a panic or a
+// hang into a violation
+func (c *certifier) signals(strat strategy.Strategy, config Config) ([]*strategy.Signal, *Violation, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), config.StepTimeout)
+	defer cancel()
+
+	type outcome struct {
+		signals []*strategy.Signal
+		err     error
+		panic   interface{}
+	}
+	done := make(chan outcome, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- outcome{panic: r}
+			}
+		}()
+		signals, err := strat.GetSignals(ctx)
+		done <- outcome{signals: signals, err: err}
+	}()
+
+	select {
+	case result := <-done:
+		if result.panic != nil {
+			return nil, &Violation{Kind: ViolationPanic, Detail: fmt.Sprintf("GetSignals panicked: %v", result.panic)}, nil
+		}
+		return result.signals, nil, result.err
+	case <-c.timeProvider.After(config.StepTimeout):
+		return nil, &Violation{Kind: ViolationTimeout, Detail: fmt.Sprintf("GetSignals did not return within %s", config.StepTimeout)}, nil
+	}
+}
+
+func (c *certifier) record(path string, certificate Certificate) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	certificates, err := loadCertificates(path)
+	if err != nil {
+		return err
+	}
+	return saveCertificates(path, append(certificates, certificate))
+}
+
+func (c *certifier) Verify(pluginPath, version string) (*Certificate, error) {
+	if version == "" {
+		return nil, fmt.Errorf("%w: no plugin version given", ErrNotCertified)
+	}
+
+	certificates, err := c.Certificates()
+	if err != nil {
+		return nil, err
+	}
+
+	name := pluginName(pluginPath)
+	var latest *Certificate
+	for i := range certificates {
+		cert := &certificates[i]
+		if cert.Plugin == name && cert.Version == version &&
+			(latest == nil || cert.FinishedAt.After(latest.FinishedAt)) {
+			latest = cert
+		}
+	}
+	if latest == nil {
+		return nil, fmt.Errorf("%w: %s %s has never been certified", ErrNotCertified, name, version)
+	}
+	if !latest.Passed {
+		failed := make([]string, 0, len(latest.Failed()))
+		for _, scenario := range latest.Failed() {
+			failed = append(failed, scenario.Name)
+		}
+		return latest, fmt.Errorf("%w: %s %s failed %s", ErrNotCertified, name, version, strings.Join(failed, ", "))
+	}
+
+	digest, err := digestFile(pluginPath)
+	if err != nil {
+		return latest, err
+	}
+	if digest != latest.Digest {
+		return latest, fmt.Errorf("%w: %s differs from the build certified as %s", ErrNotCertified, name, version)
+	}
+	return latest, nil
+}
+
+func (c *certifier) Certificates() ([]Certificate, error) {
+	c.mu.Lock()
+	path := c.config.Path
+	c.mu.Unlock()
+	return loadCertificates(path)
+}
@@ -0,0 +1,122 @@
+// Package certification runs strategy plugins through scripted scenarios on
+// a simulated venue and records which plugin versions passed, so a plugin
+// can be required to certify before it trades real funds
+package certification
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+)
+
+const (
+	// DefaultSteps is how many GetSignals rounds each scenario runs
+	DefaultSteps = 40
+
+	// DefaultStepTimeout bounds one GetSignals call
+	DefaultStepTimeout = 5 * time.Second
+
+	// DefaultMaxOrdersPerStep is the order rate a strategy may send in one
+	// round before the gauntlet counts it as runaway
+	DefaultMaxOrdersPerStep = 10
+
+	// DefaultPath is where certificates are kept
+	DefaultPath = "certifications.json"
+)
+
+var (
+	// DefaultBasePrice is every simulated market's starting price
+	DefaultBasePrice = numerical.NewFromInt(100)
+
+	// DefaultBalance is the simulated account's starting balance
+	DefaultBalance = numerical.NewFromInt(100000)
+)
+
+// Config controls the gauntlet and where certificates are stored
+type Config struct {
+	// Path is the certificate file; certification runs and live preflights
+	// share it, so both processes must point at the same file
+	Path string
+
+	Steps       int
+	StepTimeout time.Duration
+	BasePrice   numerical.Decimal
+	Balance     numerical.Decimal
+
+	// Scenarios replaces DefaultScenarios when set
+	Scenarios []Scenario
+}
+
+// DefaultConfig runs the default scenarios for forty rounds each
+func DefaultConfig() Config {
+	return Config{
+		Path:        DefaultPath,
+		Steps:       DefaultSteps,
+		StepTimeout: DefaultStepTimeout,
+		BasePrice:   DefaultBasePrice,
+		Balance:     DefaultBalance,
+		Scenarios:   DefaultScenarios(),
+	}
+}
+
+func (c *Config) applyDefaults() error {
+	if c.Path == "" {
+		c.Path = DefaultPath
+	}
+	if c.Steps <= 0 {
+		c.Steps = DefaultSteps
+	}
+	if c.StepTimeout <= 0 {
+		c.StepTimeout = DefaultStepTimeout
+	}
+	if !c.BasePrice.IsPositive() {
+		c.BasePrice = DefaultBasePrice
+	}
+	if !c.Balance.IsPositive() {
+		c.Balance = DefaultBalance
+	}
+	if len(c.Scenarios) == 0 {
+		c.Scenarios = DefaultScenarios()
+	}
+
+	seen := make(map[string]bool, len(c.Scenarios))
+	for _, scenario := range c.Scenarios {
+		if scenario.Name == "" || scenario.Conditions == nil {
+			return fmt.Errorf("scenarios need a name and conditions")
+		}
+		if seen[scenario.Name] {
+			return fmt.Errorf("duplicate scenario %q", scenario.Name)
+		}
+		seen[scenario.Name] = true
+	}
+	return nil
+}
+
+// Limits are the risk limits a strategy must respect throughout the
+// gauntlet. Orders that would breach them are blocked and counted as
+// violations.
+type Limits struct {
+	// MaxPosition caps the absolute position per market; required
+	MaxPosition numerical.Decimal
+
+	// MaxOrderQuantity caps a single order; zero uses MaxPosition
+	MaxOrderQuantity numerical.Decimal
+
+	// MaxOrdersPerStep caps the orders sent per round; zero uses
+	// DefaultMaxOrdersPerStep
+	MaxOrdersPerStep int
+}
+
+func (l *Limits) applyDefaults() error {
+	if !l.MaxPosition.IsPositive() {
+		return fmt.Errorf("a positive max position is required")
+	}
+	if !l.MaxOrderQuantity.IsPositive() {
+		l.MaxOrderQuantity = l.MaxPosition
+	}
+	if l.MaxOrdersPerStep <= 0 {
+		l.MaxOrdersPerStep = DefaultMaxOrdersPerStep
+	}
+	return nil
+}
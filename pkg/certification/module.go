@@ -0,0 +1,11 @@
+package certification
+
+import (
+	"go.uber.org/fx"
+)
+
+var Module = fx.Options(
+	fx.Provide(
+		NewCertifier,
+	),
+)
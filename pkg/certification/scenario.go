@@ -0,0 +1,101 @@
+package certification
+
+// Conditions is what the simulated venue does during one round
+type Conditions struct {
+	// Move is the price as a multiple of the base price; zero holds the
+	// last price
+	Move float64
+
+	// Silent stops market data: prices are not updated and price and book
+	// requests fail, as during a feed outage
+	Silent bool
+
+	// Reject fails every order placement
+	Reject bool
+
+	// FillRatio fills that share of each order and cancels the rest; zero
+	// fills in full
+	FillRatio float64
+}
+
+// Scenario scripts the venue round by round
+type Scenario struct {
+	Name        string
+	Description string
+
+	// Conditions returns the venue's behaviour for a round, counted from
+	// zero, out of steps
+	Conditions func(step, steps int) Conditions
+}
+
+// Default scenario names
+const (
+	ScenarioBaseline     = "baseline"
+	ScenarioGap          = "gap"
+	ScenarioSpike        = "spike"
+	ScenarioRejects      = "rejects"
+	ScenarioPartialFills = "partial_fills"
+)
+
+// DefaultScenarios is the standard gauntlet: a quiet market, a feed gap
+// that resumes at a different level, a one-round spike, a run of rejected
+// orders and partial fills throughout
+func DefaultScenarios() []Scenario {
+	return []Scenario{
+		{
+			Name:        ScenarioBaseline,
+			Description: "slow drift with full fills",
+			Conditions: func(step, _ int) Conditions {
+				return Conditions{Move: drift(step)}
+			},
+		},
+		{
+			Name:        ScenarioGap,
+			Description: "market data stops for a sixth of the run and resumes 8% lower",
+			Conditions: func(step, steps int) Conditions {
+				start, end := steps/3, steps/2
+				switch {
+				case step < start:
+					return Conditions{Move: drift(step)}
+				case step < end:
+					return Conditions{Silent: true}
+				default:
+					return Conditions{Move: drift(step) * 0.92}
+				}
+			},
+		},
+		{
+			Name:        ScenarioSpike,
+			Description: "price jumps 25% for one round and reverts",
+			Conditions: func(step, steps int) Conditions {
+				if step == steps/2 {
+					return Conditions{Move: drift(step) * 1.25}
+				}
+				return Conditions{Move: drift(step)}
+			},
+		},
+		{
+			Name:        ScenarioRejects,
+			Description: "the venue rejects every order through the middle half of the run",
+			Conditions: func(step, steps int) Conditions {
+				return Conditions{Move: drift(step), Reject: step >= steps/4 && step < 3*steps/4}
+			},
+		},
+		{
+			Name:        ScenarioPartialFills,
+			Description: "every order fills 40% and the remainder is canceled",
+			Conditions: func(step, _ int) Conditions {
+				return Conditions{Move: drift(step), FillRatio: 0.4}
+			},
+		},
+	}
+}
+
+// drift moves the price up and down by half a percent on a ten-round cycle
+func drift(step int) float64 {
+	phase := step % 10
+	if phase > 5 {
+		phase = 10 - phase
+	}
+	return 1 + 0.001*float64(phase)
+}
@@ -0,0 +1,252 @@
+package certification
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/data/stores/market"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/fake"
+)
+
+var (
+	errFeedSilent = errors.New("simulated market data outage")
+	errRejected   = errors.New("simulated venue rejected the order")
+)
+
+// maxViolations bounds the violations kept per scenario so a strategy
+// that breaks a limit every round does not bloat the certificate
+const maxViolations = 20
+
+// partialFillOffset keeps a partially filled order resting just off the
+// market so the fake venue does not fill it in full on placement
+var partialFillOffset = numerical.NewFromFloat(0.001)
+
+// venue is one scenario's simulated exchanges, registered under the names
+// the strategy trades on so it reads and trades through them as it would
+// through real connectors
+type venue struct {
+	connectors map[connector.ExchangeName]*fake.Connector
+	assets     map[portfolio.Asset]bool
+	marketData market.MarketData
+	limits     Limits
+	base       numerical.Decimal
+
+	conditions Conditions
+	result     *ScenarioResult
+}
+
+func newVenue(
+	connectorRegistry registry.ConnectorRegistry,
+	marketData market.MarketData,
+	timeProvider temporal.TimeProvider,
+	request Request,
+	config Config,
+	result *ScenarioResult,
+) (*venue, error) {
+	v := &venue{
+		connectors: make(map[connector.ExchangeName]*fake.Connector, len(request.Exchanges)),
+		assets:     make(map[portfolio.Asset]bool, len(request.Assets)),
+		marketData: marketData,
+		limits:     request.Limits,
+		base:       config.BasePrice,
+		result:     result,
+	}
+	for _, asset := range request.Assets {
+		v.assets[asset] = true
+	}
+
+	for _, name := range request.Exchanges {
+		conn := fake.NewConnector(name, timeProvider)
+		for _, asset := range request.Assets {
+			conn.AddAsset(asset)
+		}
+		conn.SetBalance(connector.AccountBalance{
+			TotalBalance:     config.Balance,
+			AvailableBalance: config.Balance,
+			Currency:         fake.DefaultCurrency,
+		})
+		if err := conn.Initialize(&fake.Config{Exchange: name, Testnet: true}); err != nil {
+			return nil, fmt.Errorf("failed to initialize simulated %s: %w", name, err)
+		}
+
+		connectorRegistry.RegisterConnector(name, conn)
+		if err := connectorRegistry.MarkConnectorReady(name); err != nil {
+			return nil, fmt.Errorf("failed to register simulated %s: %w", name, err)
+		}
+		v.connectors[name] = conn
+	}
+	return v, nil
+}
+
+// apply sets the venue up for a round
+func (v *venue) apply(conditions Conditions) {
+	v.conditions = conditions
+
+	for name, conn := range v.connectors {
+		conn.ClearFaults()
+		if conditions.Reject {
+			conn.Fail(fake.OpPlaceOrder, errRejected, 0)
+		}
+		if conditions.Silent {
+			conn.Fail(fake.OpFetchPrice, errFeedSilent, 0)
+			conn.Fail(fake.OpFetchOrderBook, errFeedSilent, 0)
+			continue
+		}
+		if conditions.Move <= 0 {
+			continue
+		}
+
+		price := v.base.Mul(numerical.NewFromFloat(conditions.Move))
+		for asset := range v.assets {
+			conn.SetPrice(asset, price)
+			if quote, err := conn.FetchPrice(conn.GetPerpSymbol(asset)); err == nil {
+				v.marketData.UpdateAssetPrice(asset, name, *quote)
+			}
+			if book, err := conn.FetchOrderBook(asset, connector.TypePerpetual, 0); err == nil {
+				v.marketData.UpdateOrderBook(asset, name, connector.TypePerpetual, *book)
+			}
+		}
+	}
+}
+
+// execute trades a round's signals, blocking and recording every order
+// that breaks the limits
+func (v *venue) execute(step int, signals []*strategy.Signal) {
+	orders := 0
+	for _, signal := range signals {
+		if signal == nil {
+			continue
+		}
+		v.result.Signals++
+
+		for _, action := range signal.Actions {
+			if action.Action == strategy.ActionHold {
+				continue
+			}
+			if orders >= v.limits.MaxOrdersPerStep {
+				v.violate(step, ViolationOrderRate, "more than %d orders in one round", v.limits.MaxOrdersPerStep)
+				return
+			}
+			if v.place(step, action) {
+				orders++
+			}
+		}
+	}
+}
+
+// place sends one action's order; sent is false when the order was blocked
+// before reaching the venue
+func (v *venue) place(step int, action strategy.TradeAction) (sent bool) {
+	conn, ok := v.connectors[action.Exchange]
+	if !ok || !v.assets[action.Asset] {
+		v.violate(step, ViolationUnknownMarket, "%s %s is not a simulated market", action.Exchange, action.Asset.Symbol())
+		return false
+	}
+	symbol := conn.GetPerpSymbol(action.Asset)
+	held := v.position(conn, action.Asset)
+
+	quantity := action.Quantity
+	var side connector.OrderSide
+	switch action.Action {
+	case strategy.ActionBuy, strategy.ActionCover:
+		side = connector.OrderSideBuy
+	case strategy.ActionSell, strategy.ActionSellShort:
+		side = connector.OrderSideSell
+	case strategy.ActionClose:
+		if held.IsZero() {
+			return false
+		}
+		side, quantity = connector.OrderSideSell, held.Abs()
+		if held.IsNegative() {
+			side = connector.OrderSideBuy
+		}
+	default:
+		v.violate(step, ViolationInvalidAction, "unknown action %q", action.Action)
+		return false
+	}
+
+	if !quantity.IsPositive() {
+		v.violate(step, ViolationInvalidAction, "%s %s with quantity %s", action.Action, symbol, quantity.String())
+		return false
+	}
+	if quantity.GreaterThan(v.limits.MaxOrderQuantity) {
+		v.violate(step, ViolationOrderQuantity, "%s %s %s exceeds the %s order limit",
+			action.Action, quantity.String(), symbol, v.limits.MaxOrderQuantity.String())
+		return false
+	}
+
+	signed := quantity
+	if side == connector.OrderSideSell {
+		signed = quantity.Neg()
+	}
+	if next := held.Add(signed); next.Abs().GreaterThan(v.limits.MaxPosition) {
+		v.violate(step, ViolationPosition, "%s %s %s would take the position to %s, over the %s limit",
+			action.Action, quantity.String(), symbol, next.String(), v.limits.MaxPosition.String())
+		return false
+	}
+
+	v.result.Orders++
+	if err := v.send(conn, symbol, side, quantity); err != nil {
+		v.result.Rejected++
+	}
+	return true
+}
+
+// send places the order the way the round's conditions fill it
+func (v *venue) send(conn *fake.Connector, symbol string, side connector.OrderSide, quantity numerical.Decimal) error {
+	ratio := v.conditions.FillRatio
+	if ratio <= 0 || ratio >= 1 {
+		_, err := conn.PlaceMarketOrder(symbol, side, quantity)
+		return err
+	}
+
+	quote, err := conn.FetchPrice(symbol)
+	if err != nil {
+		return err
+	}
+	offset := numerical.NewFromInt(1).Sub(partialFillOffset)
+	if side == connector.OrderSideSell {
+		offset = numerical.NewFromInt(1).Add(partialFillOffset)
+	}
+
+	response, err := conn.PlaceLimitOrder(symbol, side, quantity, quote.Price.Mul(offset))
+	if err != nil {
+		return err
+	}
+	if err := conn.Fill(response.OrderID, quantity.Mul(numerical.NewFromFloat(ratio))); err != nil {
+		return err
+	}
+	_, err = conn.CancelOrder(symbol, response.OrderID)
+	return err
+}
+
+func (v *venue) position(conn *fake.Connector, asset portfolio.Asset) numerical.Decimal {
+	positions, err := conn.GetPositions()
+	if err != nil {
+		return numerical.Zero()
+	}
+	for _, position := range positions {
+		if position.Symbol == asset {
+			if position.Side == connector.OrderSideSell {
+				return position.Size.Abs().Neg()
+			}
+			return position.Size.Abs()
+		}
+	}
+	return numerical.Zero()
+}
+
+// violate records a violation, keeping the first maxViolations in full
+func (v *venue) violate(step int, kind, format string, args ...interface{}) {
+	if len(v.result.Violations) >= maxViolations {
+		v.result.Dropped++
+		return
+	}
+	v.result.Violations = append(v.result.Violations, Violation{Step: step, Kind: kind, Detail: fmt.Sprintf(format, args...)})
+}
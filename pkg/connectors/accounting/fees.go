@@ -0,0 +1,63 @@
+package accounting
+
+import (
+	"fmt"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+// Liquidity is whether a fill added liquidity to the book or took it
+type Liquidity string
+
+const (
+	LiquidityMaker Liquidity = "maker"
+	LiquidityTaker Liquidity = "taker"
+)
+
+// Fees is an exchange's fee rate in basis points of fill notional. A
+// negative maker rate is a rebate.
+type Fees struct {
+	MakerBps float64
+	TakerBps float64
+}
+
+func (f Fees) rate(liquidity Liquidity) numerical.Decimal {
+	bps := f.TakerBps
+	if liquidity == LiquidityMaker {
+		bps = f.MakerBps
+	}
+	return numerical.NewFromFloat(bps / 10000)
+}
+
+// DefaultFees are each exchange's base-tier perpetual rates; accounts on
+// higher volume tiers should override them with SetFees
+var DefaultFees = map[connector.ExchangeName]Fees{
+	types.Binance:     {MakerBps: 2, TakerBps: 5},
+	types.Bybit:       {MakerBps: 2, TakerBps: 5.5},
+	types.Hyperliquid: {MakerBps: 1.5, TakerBps: 4.5},
+	types.Okx:         {MakerBps: 2, TakerBps: 5},
+	types.Paradex:     {MakerBps: 0, TakerBps: 3},
+}
+
+// FeeSchedule holds the fee rates applied per exchange
+type FeeSchedule map[connector.ExchangeName]Fees
+
+// NewFeeSchedule starts from DefaultFees
+func NewFeeSchedule() FeeSchedule {
+	schedule := make(FeeSchedule, len(DefaultFees))
+	for exchange, fees := range DefaultFees {
+		schedule[exchange] = fees
+	}
+	return schedule
+}
+
+// Fee returns the fee charged on a fill, or an error when the exchange has no schedule
+func (s FeeSchedule) Fee(exchange connector.ExchangeName, liquidity Liquidity, quantity, price numerical.Decimal) (numerical.Decimal, error) {
+	fees, ok := s[exchange]
+	if !ok {
+		return numerical.Zero(), fmt.Errorf("no fee schedule for %s", exchange)
+	}
+	return quantity.Mul(price).Mul(fees.rate(liquidity)), nil
+}
@@ -0,0 +1,214 @@
+package accounting
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+)
+
+// defaultClosedLotCapacity bounds how many closed lots are kept for stats
+const defaultClosedLotCapacity = 10000
+
+// Lot is an open slice of a position, opened by one fill. Lots are closed
+// first in, first out.
+type Lot struct {
+	Exchange connector.ExchangeName
+	Symbol   string
+	Side     connector.OrderSide
+	Quantity numerical.Decimal
+	Price    numerical.Decimal
+	OpenedAt time.Time
+}
+
+// ClosedLot is a lot, or the part of one, that a later fill closed
+type ClosedLot struct {
+	Lot
+	ClosePrice numerical.Decimal
+	ClosedAt   time.Time
+	Holding    time.Duration
+}
+
+// HoldingStats summarize how long positions were held, to verify that a
+// strategy meant to be short-horizon really trades that way
+type HoldingStats struct {
+	ClosedLots int
+
+	Average time.Duration
+	Median  time.Duration
+	Max     time.Duration
+
+	// QuantityWeighted weights each lot's holding time by its size
+	QuantityWeighted time.Duration
+
+	// Turnover is closed notional over the average notional held across the
+	// window; higher means capital was recycled more often
+	Turnover numerical.Decimal
+	Window   time.Duration
+}
+
+// openLot books a new lot at the back of the queue; caller must hold l.mu
+func (b *book) openLot(fill Fill, quantity numerical.Decimal) {
+	b.lots = append(b.lots, Lot{
+		Exchange: fill.Exchange,
+		Symbol:   fill.Symbol,
+		Side:     fill.Side,
+		Quantity: quantity,
+		Price:    fill.Price,
+		OpenedAt: fill.Timestamp,
+	})
+}
+
+// applyLots mirrors a fill onto the lot queue, closing FIFO against lots on
+// the other side, and returns the lots it closed; caller must hold l.mu
+func (b *book) applyLots(fill Fill) []ClosedLot {
+	remaining := fill.Quantity
+	var closed []ClosedLot
+
+	for len(b.lots) > 0 && b.lots[0].Side != fill.Side && remaining.IsPositive() {
+		head := &b.lots[0]
+		take := head.Quantity
+		if remaining.LessThan(take) {
+			take = remaining
+		}
+
+		portion := *head
+		portion.Quantity = take
+		closed = append(closed, ClosedLot{
+			Lot:        portion,
+			ClosePrice: fill.Price,
+			ClosedAt:   fill.Timestamp,
+			Holding:    fill.Timestamp.Sub(head.OpenedAt),
+		})
+
+		remaining = remaining.Sub(take)
+		head.Quantity = head.Quantity.Sub(take)
+		if !head.Quantity.IsPositive() {
+			b.lots = b.lots[1:]
+		}
+	}
+
+	// Whatever the fill did not close opens or adds to the position
+	if remaining.IsPositive() {
+		b.openLot(fill, remaining)
+	}
+	return closed
+}
+
+func (l *ledger) OpenLots() []Lot {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var lots []Lot
+	for _, b := range l.books {
+		lots = append(lots, b.lots...)
+	}
+	sort.Slice(lots, func(i, j int) bool { return lots[i].OpenedAt.Before(lots[j].OpenedAt) })
+	return lots
+}
+
+func (l *ledger) ClosedLots(since time.Time) []ClosedLot {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	lots := make([]ClosedLot, 0)
+	for _, lot := range l.closed {
+		if !lot.ClosedAt.Before(since) {
+			lots = append(lots, lot)
+		}
+	}
+	return lots
+}
+
+func (l *ledger) HoldingPeriods(since, until time.Time) HoldingStats {
+	return Holding(l.ClosedLots(since), since, until)
+}
+
+// Holding computes holding-period stats for lots closed between since and until
+func Holding(lots []ClosedLot, since, until time.Time) HoldingStats {
+	stats := HoldingStats{Turnover: numerical.Zero(), Window: until.Sub(since)}
+
+	durations := make([]time.Duration, 0, len(lots))
+	var total, weighted float64
+	var quantity float64
+	closedNotional := numerical.Zero()
+	exposure := 0.0 // notional × seconds held within the window
+
+	for _, lot := range lots {
+		if lot.ClosedAt.After(until) {
+			continue
+		}
+		durations = append(durations, lot.Holding)
+		total += lot.Holding.Seconds()
+
+		size := lot.Quantity.InexactFloat64()
+		weighted += lot.Holding.Seconds() * size
+		quantity += size
+
+		notional := lot.Quantity.Mul(lot.Price)
+		closedNotional = closedNotional.Add(notional)
+
+		opened := lot.OpenedAt
+		if opened.Before(since) {
+			opened = since
+		}
+		exposure += notional.InexactFloat64() * lot.ClosedAt.Sub(opened).Seconds()
+	}
+
+	stats.ClosedLots = len(durations)
+	if stats.ClosedLots == 0 {
+		return stats
+	}
+
+	sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+	stats.Average = time.Duration(total / float64(len(durations)) * float64(time.Second))
+	stats.Max = durations[len(durations)-1]
+	mid := len(durations) / 2
+	stats.Median = durations[mid]
+	if len(durations)%2 == 0 {
+		stats.Median = (durations[mid-1] + durations[mid]) / 2
+	}
+	if quantity > 0 {
+		stats.QuantityWeighted = time.Duration(weighted / quantity * float64(time.Second))
+	}
+
+	if window := stats.Window.Seconds(); window > 0 && exposure > 0 {
+		averageHeld := exposure / window
+		stats.Turnover = closedNotional.Div(numerical.NewFromFloat(averageHeld))
+	}
+	return stats
+}
+
+// ExportClosedLots writes closed lots as CSV, one row per lot
+func ExportClosedLots(w io.Writer, lots []ClosedLot) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{
+		"exchange", "symbol", "side", "quantity", "open_price", "close_price", "opened_at", "closed_at", "holding_seconds",
+	}); err != nil {
+		return fmt.Errorf("failed to write holdings header: %w", err)
+	}
+
+	for _, lot := range lots {
+		if err := writer.Write([]string{
+			string(lot.Exchange),
+			lot.Symbol,
+			string(lot.Side),
+			lot.Quantity.String(),
+			lot.Price.String(),
+			lot.ClosePrice.String(),
+			lot.OpenedAt.UTC().Format(time.RFC3339Nano),
+			lot.ClosedAt.UTC().Format(time.RFC3339Nano),
+			strconv.FormatFloat(lot.Holding.Seconds(), 'f', 3, 64),
+		}); err != nil {
+			return fmt.Errorf("failed to write holding: %w", err)
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
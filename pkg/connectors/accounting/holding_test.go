@@ -0,0 +1,147 @@
+package accounting_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	mockregistry "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	logger "github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	mocktracker "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/connectors/tracker"
+	"github.com/backtesting-org/live-trading/pkg/connectors/accounting"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+var _ = Describe("Ledger lots", func() {
+	var (
+		ledger accounting.Ledger
+		start  time.Time
+	)
+
+	BeforeEach(func() {
+		start = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		connectors := mockregistry.NewConnectorRegistry(GinkgoT())
+		connectors.On("GetConnector", mock.Anything).Return(nil, false).Maybe()
+
+		ledger = accounting.NewLedger(connectors, mocktracker.NewOrderTracker(GinkgoT()), logger.NewNoOpLogger())
+	})
+
+	record := func(side connector.OrderSide, quantity, price float64, at time.Duration, liquidity accounting.Liquidity) *accounting.Entry {
+		entry, err := ledger.Record(accounting.Fill{
+			Exchange:  types.Binance,
+			Symbol:    "BTCUSDT",
+			Side:      side,
+			Quantity:  numerical.NewFromFloat(quantity),
+			Price:     numerical.NewFromFloat(price),
+			Liquidity: liquidity,
+			Timestamp: start.Add(at),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return entry
+	}
+
+	It("closes the oldest lots first and keeps the rest open", func() {
+		record(connector.OrderSideBuy, 1, 100, 0, accounting.LiquidityTaker)
+		record(connector.OrderSideBuy, 2, 110, time.Minute, accounting.LiquidityTaker)
+		record(connector.OrderSideSell, 2, 120, 3*time.Minute, accounting.LiquidityTaker)
+
+		closed := ledger.ClosedLots(start)
+		Expect(closed).To(HaveLen(2))
+		Expect(closed[0].Quantity.Equal(numerical.NewFromInt(1))).To(BeTrue())
+		Expect(closed[0].Price.Equal(numerical.NewFromInt(100))).To(BeTrue())
+		Expect(closed[0].Holding).To(Equal(3 * time.Minute))
+		Expect(closed[1].Quantity.Equal(numerical.NewFromInt(1))).To(BeTrue())
+		Expect(closed[1].Price.Equal(numerical.NewFromInt(110))).To(BeTrue())
+		Expect(closed[1].Holding).To(Equal(2 * time.Minute))
+
+		open := ledger.OpenLots()
+		Expect(open).To(HaveLen(1))
+		Expect(open[0].Quantity.Equal(numerical.NewFromInt(1))).To(BeTrue())
+		Expect(open[0].OpenedAt).To(Equal(start.Add(time.Minute)))
+	})
+
+	It("opens a lot on the other side with what a flip leaves over", func() {
+		record(connector.OrderSideBuy, 1, 100, 0, accounting.LiquidityTaker)
+		record(connector.OrderSideSell, 3, 105, time.Minute, accounting.LiquidityTaker)
+
+		open := ledger.OpenLots()
+		Expect(open).To(HaveLen(1))
+		Expect(open[0].Side).To(Equal(connector.OrderSideSell))
+		Expect(open[0].Quantity.Equal(numerical.NewFromInt(2))).To(BeTrue())
+
+		summary, ok := ledger.Summary(types.Binance, "BTCUSDT")
+		Expect(ok).To(BeTrue())
+		Expect(summary.Position.Equal(numerical.NewFromInt(-2))).To(BeTrue())
+		Expect(summary.OpenedAt).To(Equal(start.Add(time.Minute)))
+	})
+
+	It("reports realized PnL net of maker and taker fees", func() {
+		record(connector.OrderSideBuy, 1, 100, 0, accounting.LiquidityTaker)
+		entry := record(connector.OrderSideSell, 1, 110, time.Minute, accounting.LiquidityMaker)
+		Expect(entry.RealizedPnL.Equal(numerical.NewFromInt(10))).To(BeTrue())
+
+		// Binance base tier: 5 bps taker on 100, 2 bps maker on 110
+		summary, _ := ledger.Summary(types.Binance, "BTCUSDT")
+		Expect(summary.Fees.Equal(numerical.NewFromFloat(0.072))).To(BeTrue())
+		Expect(summary.NetRealizedPnL.Equal(numerical.NewFromFloat(9.928))).To(BeTrue())
+		Expect(summary.OpenedAt.IsZero()).To(BeTrue())
+		Expect(ledger.OpenLots()).To(BeEmpty())
+	})
+
+	It("closes an adopted position like any other lot", func() {
+		Expect(ledger.Adopt(accounting.Fill{
+			Exchange:  types.Binance,
+			Symbol:    "BTCUSDT",
+			Side:      connector.OrderSideBuy,
+			Quantity:  numerical.NewFromInt(1),
+			Price:     numerical.NewFromInt(90),
+			Timestamp: start,
+		})).To(Succeed())
+
+		entry := record(connector.OrderSideSell, 1, 100, time.Hour, accounting.LiquidityTaker)
+		Expect(entry.RealizedPnL.Equal(numerical.NewFromInt(10))).To(BeTrue())
+		Expect(ledger.ClosedLots(start)[0].Holding).To(Equal(time.Hour))
+
+		summary, _ := ledger.Summary(types.Binance, "BTCUSDT")
+		Expect(summary.Fills).To(Equal(1))
+	})
+
+	It("summarizes holding periods over the closed lots", func() {
+		record(connector.OrderSideBuy, 1, 100, 0, accounting.LiquidityTaker)
+		record(connector.OrderSideBuy, 3, 100, time.Minute, accounting.LiquidityTaker)
+		record(connector.OrderSideSell, 4, 100, 5*time.Minute, accounting.LiquidityTaker)
+
+		stats := ledger.HoldingPeriods(start, start.Add(10*time.Minute))
+		Expect(stats.ClosedLots).To(Equal(2))
+		Expect(stats.Max).To(Equal(5 * time.Minute))
+		Expect(stats.Median).To(Equal(4*time.Minute + 30*time.Second))
+		Expect(stats.Average).To(Equal(4*time.Minute + 30*time.Second))
+		// (1 × 5m + 3 × 4m) / 4
+		Expect(stats.QuantityWeighted).To(Equal(4*time.Minute + 15*time.Second))
+		Expect(stats.Turnover.IsPositive()).To(BeTrue())
+	})
+
+	It("exports closed lots as CSV", func() {
+		record(connector.OrderSideBuy, 1, 100, 0, accounting.LiquidityTaker)
+		record(connector.OrderSideSell, 1, 101, 90*time.Second, accounting.LiquidityTaker)
+
+		var out bytes.Buffer
+		Expect(accounting.ExportClosedLots(&out, ledger.ClosedLots(start))).To(Succeed())
+
+		rows, err := csv.NewReader(&out).ReadAll()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rows).To(HaveLen(2))
+		Expect(rows[0][0]).To(Equal("exchange"))
+		Expect(rows[1]).To(Equal([]string{
+			"binance", "BTCUSDT", string(connector.OrderSideBuy), "1", "100", "101",
+			"2024-01-01T00:00:00Z", "2024-01-01T00:01:30Z", "90.000",
+		}))
+	})
+})
@@ -0,0 +1,587 @@
+package accounting
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/live-trading/pkg/connectors/tracker"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+// Fill is an executed trade at its actual price
+type Fill struct {
+	Exchange  connector.ExchangeName
+	OrderID   string
+	Symbol    string
+	Side      connector.OrderSide
+	Quantity  numerical.Decimal
+	Price     numerical.Decimal
+	Liquidity Liquidity
+
+	// LiquidityReported is set when the exchange's fill report said maker
+	// or taker; otherwise Liquidity was inferred from the order type
+	LiquidityReported bool
+
+	// ExpectedPrice is the price the decision was made at, e.g. the signal
+	// price; slippage is measured against it when set
+	ExpectedPrice numerical.Decimal
+
+	// Fee overrides the schedule when the exchange reported the actual fee
+	Fee *numerical.Decimal
+
+	// BuilderFeeBps is a third-party builder fee the order was placed with,
+	// charged on top of Fee
+	BuilderFeeBps float64
+	Timestamp     time.Time
+}
+
+// Entry is a recorded fill with the PnL it realized
+type Entry struct {
+	Fill
+
+	// Fee is the total charged, including BuilderFee
+	Fee         numerical.Decimal
+	BuilderFee  numerical.Decimal
+	RealizedPnL numerical.Decimal
+
+	// Slippage is the cost of filling away from ExpectedPrice, positive
+	// when the fill was worse
+	Slippage numerical.Decimal
+}
+
+// Summary is net PnL for one symbol on one exchange
+type Summary struct {
+	Exchange connector.ExchangeName
+	Symbol   string
+
+	Position   numerical.Decimal
+	EntryPrice numerical.Decimal
+
+	GrossRealizedPnL numerical.Decimal
+	Fees             numerical.Decimal
+	Funding          numerical.Decimal
+	Slippage         numerical.Decimal
+
+	// BuilderFees is the part of Fees paid to builders
+	BuilderFees numerical.Decimal
+
+	// NetRealizedPnL is gross realized PnL less fees plus funding
+	NetRealizedPnL numerical.Decimal
+
+	Fills int
+
+	// Maker and taker totals; InferredFills counts fills whose liquidity
+	// was guessed from the order type rather than reported
+	MakerFills    int
+	TakerFills    int
+	InferredFills int
+	MakerNotional numerical.Decimal
+	TakerNotional numerical.Decimal
+	MakerFees     numerical.Decimal
+	TakerFees     numerical.Decimal
+
+	// OpenedAt is when the current position was opened from flat; zero
+	// while flat
+	OpenedAt time.Time
+}
+
+// Age is how long the current position has been open
+func (s Summary) Age(now time.Time) time.Duration {
+	if s.OpenedAt.IsZero() {
+		return 0
+	}
+	return now.Sub(s.OpenedAt)
+}
+
+// PassiveFillRatio is the maker share of filled notional
+func (s Summary) PassiveFillRatio() numerical.Decimal {
+	total := s.MakerNotional.Add(s.TakerNotional)
+	if !total.IsPositive() {
+		return numerical.Zero()
+	}
+	return s.MakerNotional.Div(total)
+}
+
+// Sub returns the activity booked between before and s; position and
+// entry price are left as they are in s
+func (s Summary) Sub(before Summary) Summary {
+	s.GrossRealizedPnL = s.GrossRealizedPnL.Sub(before.GrossRealizedPnL)
+	s.Fees = s.Fees.Sub(before.Fees)
+	s.BuilderFees = s.BuilderFees.Sub(before.BuilderFees)
+	s.Funding = s.Funding.Sub(before.Funding)
+	s.Slippage = s.Slippage.Sub(before.Slippage)
+	s.NetRealizedPnL = s.NetRealizedPnL.Sub(before.NetRealizedPnL)
+	s.Fills -= before.Fills
+	s.MakerFills -= before.MakerFills
+	s.TakerFills -= before.TakerFills
+	s.InferredFills -= before.InferredFills
+	s.MakerNotional = s.MakerNotional.Sub(before.MakerNotional)
+	s.TakerNotional = s.TakerNotional.Sub(before.TakerNotional)
+	s.MakerFees = s.MakerFees.Sub(before.MakerFees)
+	s.TakerFees = s.TakerFees.Sub(before.TakerFees)
+	return s
+}
+
+// ExecutionQuality rolls maker/taker activity up across summaries so
+// passive strategies can check they are really earning maker rates
+type ExecutionQuality struct {
+	Fills         int
+	MakerFills    int
+	TakerFills    int
+	InferredFills int
+
+	MakerNotional numerical.Decimal
+	TakerNotional numerical.Decimal
+	MakerFees     numerical.Decimal
+	TakerFees     numerical.Decimal
+	Slippage      numerical.Decimal
+
+	// PassiveFillRatio is the maker share of filled notional
+	PassiveFillRatio numerical.Decimal
+
+	// FeeBps is fees paid per filled notional; negative when rebates
+	// outweigh taker fees
+	FeeBps numerical.Decimal
+}
+
+// Quality aggregates summaries into execution-quality stats
+func Quality(summaries []Summary) ExecutionQuality {
+	quality := ExecutionQuality{
+		MakerNotional:    numerical.Zero(),
+		TakerNotional:    numerical.Zero(),
+		MakerFees:        numerical.Zero(),
+		TakerFees:        numerical.Zero(),
+		Slippage:         numerical.Zero(),
+		PassiveFillRatio: numerical.Zero(),
+		FeeBps:           numerical.Zero(),
+	}
+
+	for _, s := range summaries {
+		quality.Fills += s.Fills
+		quality.MakerFills += s.MakerFills
+		quality.TakerFills += s.TakerFills
+		quality.InferredFills += s.InferredFills
+		quality.MakerNotional = quality.MakerNotional.Add(s.MakerNotional)
+		quality.TakerNotional = quality.TakerNotional.Add(s.TakerNotional)
+		quality.MakerFees = quality.MakerFees.Add(s.MakerFees)
+		quality.TakerFees = quality.TakerFees.Add(s.TakerFees)
+		quality.Slippage = quality.Slippage.Add(s.Slippage)
+	}
+
+	total := quality.MakerNotional.Add(quality.TakerNotional)
+	if total.IsPositive() {
+		quality.PassiveFillRatio = quality.MakerNotional.Div(total)
+		quality.FeeBps = quality.MakerFees.Add(quality.TakerFees).Div(total).Mul(numerical.NewFromInt(10000))
+	}
+	return quality
+}
+
+// Ledger records fills at their actual execution price and fee so realized
+// PnL is reported net of costs rather than at signal prices
+type Ledger interface {
+	SetFees(exchange connector.ExchangeName, fees Fees)
+	Fees() FeeSchedule
+
+	Record(fill Fill) (*Entry, error)
+
+	// RecordFill books a tracker fill; limit orders are treated as maker
+	// and everything else as taker
+	RecordFill(event tracker.FillEvent) (*Entry, error)
+
+	// RecordTrade books an exchange fill report, taking maker/taker and,
+	// when non-zero, the fee from the report. Only use it for venues that
+	// set IsMaker.
+	RecordTrade(trade connector.Trade) (*Entry, error)
+
+	// RecordFunding books a funding payment; positive amounts were received
+	RecordFunding(exchange connector.ExchangeName, symbol string, amount numerical.Decimal)
+
+	// Adopt opens a position that was not traded through the ledger, such
+	// as a manual position handed to a run, at the fill's price as cost
+	// basis. It books no fee, slippage or fill count, so execution stats
+	// only cover orders the process placed.
+	Adopt(fill Fill) error
+
+	Summary(exchange connector.ExchangeName, symbol string) (Summary, bool)
+	Summaries() []Summary
+	Entries(limit int) []Entry
+
+	// ExecutionQuality rolls up one exchange, or all of them when exchange is empty
+	ExecutionQuality(exchange connector.ExchangeName) ExecutionQuality
+
+	// OpenLots returns every open lot, oldest first
+	OpenLots() []Lot
+
+	// ClosedLots returns lots closed at or after since; only the most
+	// recent closed lots are retained
+	ClosedLots(since time.Time) []ClosedLot
+
+	// HoldingPeriods summarizes lots closed between since and until
+	HoldingPeriods(since, until time.Time) HoldingStats
+}
+
+const defaultEntryCapacity = 10000
+
+type book struct {
+	summary  Summary
+	position *position
+	lots     []Lot
+	openSide connector.OrderSide
+}
+
+type ledger struct {
+	registry registry.ConnectorRegistry
+	tracker  tracker.OrderTracker
+	logger   logging.ApplicationLogger
+
+	fees    FeeSchedule
+	books   map[string]*book
+	entries []Entry
+	closed  []ClosedLot
+	mu      sync.Mutex
+}
+
+func NewLedger(
+	connectorRegistry registry.ConnectorRegistry,
+	orderTracker tracker.OrderTracker,
+	logger logging.ApplicationLogger,
+) Ledger {
+	return &ledger{
+		registry: connectorRegistry,
+		tracker:  orderTracker,
+		logger:   logger,
+		fees:     NewFeeSchedule(),
+		books:    make(map[string]*book),
+	}
+}
+
+func (l *ledger) SetFees(exchange connector.ExchangeName, fees Fees) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.fees[exchange] = fees
+}
+
+func (l *ledger) Fees() FeeSchedule {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	schedule := make(FeeSchedule, len(l.fees))
+	for exchange, fees := range l.fees {
+		schedule[exchange] = fees
+	}
+	return schedule
+}
+
+func (l *ledger) Record(fill Fill) (*Entry, error) {
+	if !fill.Side.IsValid() {
+		return nil, fmt.Errorf("invalid fill side %q", fill.Side)
+	}
+	if !fill.Quantity.IsPositive() || !fill.Price.IsPositive() {
+		return nil, fmt.Errorf("fill quantity and price must be positive")
+	}
+	if fill.Liquidity == "" {
+		fill.Liquidity = LiquidityTaker
+	}
+	// Holding periods need a time on every fill
+	if fill.Timestamp.IsZero() {
+		fill.Timestamp = time.Now()
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	fee := numerical.Zero()
+	if fill.Fee != nil {
+		fee = *fill.Fee
+	} else {
+		var err error
+		fee, err = l.fees.Fee(fill.Exchange, fill.Liquidity, fill.Quantity, fill.Price)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	notional := fill.Quantity.Mul(fill.Price)
+	builderFee := notional.Mul(numerical.NewFromFloat(fill.BuilderFeeBps / 10000))
+	fee = fee.Add(builderFee)
+
+	b := l.bookLocked(fill.Exchange, fill.Symbol)
+	entry := Entry{
+		Fill:        fill,
+		Fee:         fee,
+		BuilderFee:  builderFee,
+		RealizedPnL: b.position.apply(fill.Side, fill.Quantity, fill.Price),
+		Slippage:    slippage(fill),
+	}
+
+	b.summary.GrossRealizedPnL = b.summary.GrossRealizedPnL.Add(entry.RealizedPnL)
+	b.summary.Fees = b.summary.Fees.Add(fee)
+	b.summary.BuilderFees = b.summary.BuilderFees.Add(builderFee)
+	b.summary.Slippage = b.summary.Slippage.Add(entry.Slippage)
+	b.summary.Fills++
+
+	if fill.Liquidity == LiquidityMaker {
+		b.summary.MakerFills++
+		b.summary.MakerNotional = b.summary.MakerNotional.Add(notional)
+		b.summary.MakerFees = b.summary.MakerFees.Add(fee)
+	} else {
+		b.summary.TakerFills++
+		b.summary.TakerNotional = b.summary.TakerNotional.Add(notional)
+		b.summary.TakerFees = b.summary.TakerFees.Add(fee)
+	}
+	if !fill.LiquidityReported {
+		b.summary.InferredFills++
+	}
+	l.refreshLocked(b)
+
+	l.closed = append(l.closed, b.applyLots(fill)...)
+	if len(l.closed) > defaultClosedLotCapacity {
+		l.closed = l.closed[len(l.closed)-defaultClosedLotCapacity:]
+	}
+	switch {
+	case b.position.quantity.IsZero():
+		b.summary.OpenedAt = time.Time{}
+	case len(b.lots) > 0 && (b.summary.OpenedAt.IsZero() || b.lots[0].Side != b.openSide):
+		// Opened from flat or flipped through zero
+		b.summary.OpenedAt = b.lots[0].OpenedAt
+	}
+	if len(b.lots) > 0 {
+		b.openSide = b.lots[0].Side
+	}
+
+	l.entries = append(l.entries, entry)
+	if len(l.entries) > defaultEntryCapacity {
+		l.entries = l.entries[len(l.entries)-defaultEntryCapacity:]
+	}
+
+	return &entry, nil
+}
+
+func (l *ledger) RecordFill(event tracker.FillEvent) (*Entry, error) {
+	liquidity := LiquidityTaker
+	var expected numerical.Decimal
+	tracked, ok := l.tracker.Order(event.Exchange, event.OrderID)
+	switch {
+	case !ok:
+		l.logger.Debug("Ledger: order %s on %s not tracked, booking fill as taker", event.OrderID, event.Exchange)
+	case tracked.Order.Type == connector.OrderTypeLimit:
+		liquidity = LiquidityMaker
+		expected = tracked.Order.Price
+	}
+
+	return l.Record(Fill{
+		Exchange:      event.Exchange,
+		OrderID:       event.OrderID,
+		Symbol:        event.Symbol,
+		Side:          event.Side,
+		Quantity:      event.Quantity,
+		Price:         event.Price,
+		Liquidity:     liquidity,
+		ExpectedPrice: expected,
+		BuilderFeeBps: l.builderFeeBps(event.Exchange, event.OrderID),
+		Timestamp:     event.Timestamp,
+	})
+}
+
+func (l *ledger) RecordTrade(trade connector.Trade) (*Entry, error) {
+	liquidity := LiquidityTaker
+	if trade.IsMaker {
+		liquidity = LiquidityMaker
+	}
+
+	var fee *numerical.Decimal
+	if !trade.Fee.IsZero() {
+		reported := trade.Fee
+		fee = &reported
+	}
+
+	var expected numerical.Decimal
+	if tracked, ok := l.tracker.Order(trade.Exchange, trade.OrderID); ok && tracked.Order.Type == connector.OrderTypeLimit {
+		expected = tracked.Order.Price
+	}
+
+	return l.Record(Fill{
+		Exchange:          trade.Exchange,
+		OrderID:           trade.OrderID,
+		Symbol:            trade.Symbol,
+		Side:              trade.Side,
+		Quantity:          trade.Quantity,
+		Price:             trade.Price,
+		Liquidity:         liquidity,
+		LiquidityReported: true,
+		ExpectedPrice:     expected,
+		Fee:               fee,
+		BuilderFeeBps:     l.builderFeeBps(trade.Exchange, trade.OrderID),
+		Timestamp:         trade.Timestamp,
+	})
+}
+
+// builderFeeBps looks up the builder fee the connector placed an order with.
+// Exchange fill reports carry the exchange fee only, so the builder fee is
+// added to reported and scheduled fees alike.
+func (l *ledger) builderFeeBps(exchange connector.ExchangeName, orderID string) float64 {
+	conn, ok := l.registry.GetConnector(exchange)
+	if !ok {
+		return 0
+	}
+	provider, ok := conn.(types.BuilderFeeProvider)
+	if !ok {
+		return 0
+	}
+	fee, ok := provider.AppliedBuilderFee(orderID)
+	if !ok {
+		return 0
+	}
+	return fee.Bps()
+}
+
+func (l *ledger) RecordFunding(exchange connector.ExchangeName, symbol string, amount numerical.Decimal) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b := l.bookLocked(exchange, symbol)
+	b.summary.Funding = b.summary.Funding.Add(amount)
+	l.refreshLocked(b)
+}
+
+func (l *ledger) Adopt(fill Fill) error {
+	if !fill.Side.IsValid() {
+		return fmt.Errorf("invalid adopted side %q", fill.Side)
+	}
+	if !fill.Quantity.IsPositive() || !fill.Price.IsPositive() {
+		return fmt.Errorf("adopted quantity and price must be positive")
+	}
+	if fill.Timestamp.IsZero() {
+		fill.Timestamp = time.Now()
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b := l.bookLocked(fill.Exchange, fill.Symbol)
+	if !b.position.quantity.IsZero() && b.position.quantity.IsNegative() != (fill.Side == connector.OrderSideSell) {
+		return fmt.Errorf("adopted %s would reduce the %s position on %s", fill.Side, fill.Symbol, fill.Exchange)
+	}
+
+	b.position.apply(fill.Side, fill.Quantity, fill.Price)
+	l.refreshLocked(b)
+	b.openLot(fill, fill.Quantity)
+	if b.summary.OpenedAt.IsZero() {
+		b.summary.OpenedAt = fill.Timestamp
+	}
+	b.openSide = fill.Side
+	return nil
+}
+
+func (l *ledger) Summary(exchange connector.ExchangeName, symbol string) (Summary, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b, ok := l.books[bookKey(exchange, symbol)]
+	if !ok {
+		return Summary{}, false
+	}
+	return b.summary, true
+}
+
+func (l *ledger) Summaries() []Summary {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	summaries := make([]Summary, 0, len(l.books))
+	for _, b := range l.books {
+		summaries = append(summaries, b.summary)
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		if summaries[i].Exchange != summaries[j].Exchange {
+			return summaries[i].Exchange < summaries[j].Exchange
+		}
+		return summaries[i].Symbol < summaries[j].Symbol
+	})
+	return summaries
+}
+
+func (l *ledger) ExecutionQuality(exchange connector.ExchangeName) ExecutionQuality {
+	summaries := l.Summaries()
+	if exchange == "" {
+		return Quality(summaries)
+	}
+
+	selected := summaries[:0]
+	for _, s := range summaries {
+		if s.Exchange == exchange {
+			selected = append(selected, s)
+		}
+	}
+	return Quality(selected)
+}
+
+func (l *ledger) Entries(limit int) []Entry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entries := l.entries
+	if limit > 0 && len(entries) > limit {
+		entries = entries[len(entries)-limit:]
+	}
+	return append([]Entry(nil), entries...)
+}
+
+// bookLocked returns the symbol's book, creating it; caller must hold l.mu
+func (l *ledger) bookLocked(exchange connector.ExchangeName, symbol string) *book {
+	key := bookKey(exchange, symbol)
+	b, ok := l.books[key]
+	if !ok {
+		b = &book{
+			summary: Summary{
+				Exchange:         exchange,
+				Symbol:           symbol,
+				Position:         numerical.Zero(),
+				EntryPrice:       numerical.Zero(),
+				GrossRealizedPnL: numerical.Zero(),
+				Fees:             numerical.Zero(),
+				Funding:          numerical.Zero(),
+				Slippage:         numerical.Zero(),
+				BuilderFees:      numerical.Zero(),
+				NetRealizedPnL:   numerical.Zero(),
+				MakerNotional:    numerical.Zero(),
+				TakerNotional:    numerical.Zero(),
+				MakerFees:        numerical.Zero(),
+				TakerFees:        numerical.Zero(),
+			},
+			position: newPosition(),
+		}
+		l.books[key] = b
+	}
+	return b
+}
+
+// refreshLocked recomputes derived summary fields; caller must hold l.mu
+func (l *ledger) refreshLocked(b *book) {
+	b.summary.Position = b.position.quantity
+	b.summary.EntryPrice = b.position.entryPrice
+	b.summary.NetRealizedPnL = b.summary.GrossRealizedPnL.Sub(b.summary.Fees).Add(b.summary.Funding)
+}
+
+// slippage is the extra cost versus the expected price: paying more on a
+// buy or receiving less on a sell is positive
+func slippage(fill Fill) numerical.Decimal {
+	if !fill.ExpectedPrice.IsPositive() {
+		return numerical.Zero()
+	}
+	cost := fill.Price.Sub(fill.ExpectedPrice).Mul(fill.Quantity)
+	if fill.Side == connector.OrderSideSell {
+		cost = cost.Neg()
+	}
+	return cost
+}
+
+func bookKey(exchange connector.ExchangeName, symbol string) string {
+	return fmt.Sprintf("%s:%s", exchange, symbol)
+}
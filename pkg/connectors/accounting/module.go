@@ -0,0 +1,32 @@
+package accounting
+
+import (
+	"github.com/backtesting-org/kronos-sdk/pkg/events"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/live-trading/pkg/connectors/tracker"
+	"github.com/backtesting-org/live-trading/pkg/eventschema"
+	"go.uber.org/fx"
+)
+
+var Module = fx.Options(
+	fx.Provide(NewLedger),
+	fx.Invoke(subscribeFills),
+)
+
+// subscribeFills books every fill the order tracker publishes. Events
+// arrive sealed in an envelope on the versioned bus, bare otherwise.
+func subscribeFills(bus events.EventBus, ledger Ledger, logger logging.ApplicationLogger) {
+	bus.Subscribe(eventschema.TopicFill, func(event interface{}) {
+		if envelope, ok := event.(eventschema.Envelope); ok {
+			event = envelope.Event
+		}
+		fill, ok := event.(tracker.FillEvent)
+		if !ok {
+			return
+		}
+
+		if _, err := ledger.RecordFill(fill); err != nil {
+			logger.Warn("Ledger: failed to book fill for order %s on %s: %v", fill.OrderID, fill.Exchange, err)
+		}
+	})
+}
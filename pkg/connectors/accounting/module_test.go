@@ -0,0 +1,80 @@
+package accounting_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+
+	mockregistry "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/events"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	mocktracker "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/connectors/tracker"
+	"github.com/backtesting-org/live-trading/pkg/connectors/accounting"
+	"github.com/backtesting-org/live-trading/pkg/connectors/fake"
+	"github.com/backtesting-org/live-trading/pkg/connectors/tracker"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+	"github.com/backtesting-org/live-trading/pkg/eventschema"
+)
+
+var _ = Describe("Module", func() {
+	It("books fills published on the versioned bus in the ledger", func() {
+		at := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		connectors := mockregistry.NewConnectorRegistry(GinkgoT())
+		connectors.On("GetConnector", mock.Anything).Return(nil, false).Maybe()
+
+		orders := mocktracker.NewOrderTracker(GinkgoT())
+		orders.On("Order", types.Binance, "42").Return(tracker.TrackedOrder{
+			Exchange: types.Binance,
+			Order:    connector.Order{ID: "42", Type: connector.OrderTypeLimit, Price: numerical.NewFromInt(100)},
+		}, true).Maybe()
+
+		var bus events.EventBus
+		var ledger accounting.Ledger
+
+		app := fxtest.New(GinkgoT(),
+			accounting.Module,
+			eventschema.Module,
+			fx.Provide(
+				func() events.EventBus { return events.NewEventBus() },
+				func() registry.ConnectorRegistry { return connectors },
+				func() tracker.OrderTracker { return orders },
+				func() temporal.TimeProvider { return fake.NewClock(at) },
+				func() logging.ApplicationLogger { return logging.NewNoOpLogger() },
+			),
+			fx.Populate(&bus, &ledger),
+		)
+		app.RequireStart()
+		defer app.RequireStop()
+
+		bus.Publish(eventschema.TopicFill, tracker.FillEvent{
+			Exchange:  types.Binance,
+			OrderID:   "42",
+			Symbol:    "BTCUSDT",
+			Side:      connector.OrderSideBuy,
+			Quantity:  numerical.NewFromFloat(0.5),
+			Price:     numerical.NewFromInt(100),
+			FilledQty: numerical.NewFromFloat(0.5),
+			Status:    connector.OrderStatusPartiallyFilled,
+			Timestamp: at,
+		})
+
+		Eventually(func() []accounting.Entry { return ledger.Entries(0) }).Should(HaveLen(1))
+		entry := ledger.Entries(0)[0]
+		Expect(entry.Fill.OrderID).To(Equal("42"))
+		Expect(entry.Fill.Liquidity).To(Equal(accounting.LiquidityMaker))
+		Expect(entry.Fill.Quantity.Equal(numerical.NewFromFloat(0.5))).To(BeTrue())
+
+		summary, ok := ledger.Summary(types.Binance, "BTCUSDT")
+		Expect(ok).To(BeTrue())
+		Expect(summary.Fills).To(Equal(1))
+	})
+})
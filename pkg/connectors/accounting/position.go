@@ -0,0 +1,56 @@
+package accounting
+
+import (
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+)
+
+// position is a net position built from recorded fills
+type position struct {
+	quantity   numerical.Decimal
+	entryPrice numerical.Decimal
+}
+
+func newPosition() *position {
+	return &position{quantity: numerical.Zero(), entryPrice: numerical.Zero()}
+}
+
+// apply books a fill into the position and returns the gross realized PnL
+// it produced, before fees
+func (p *position) apply(side connector.OrderSide, quantity, price numerical.Decimal) numerical.Decimal {
+	delta := quantity
+	if side == connector.OrderSideSell {
+		delta = quantity.Neg()
+	}
+
+	if p.quantity.IsZero() || p.quantity.IsNegative() == delta.IsNegative() {
+		// Opening or adding: average the entry
+		total := p.quantity.Abs().Add(delta.Abs())
+		p.entryPrice = p.quantity.Abs().Mul(p.entryPrice).Add(delta.Abs().Mul(price)).Div(total)
+		p.quantity = p.quantity.Add(delta)
+		return numerical.Zero()
+	}
+
+	// Reducing, closing or flipping
+	closing := delta.Abs()
+	if p.quantity.Abs().LessThan(closing) {
+		closing = p.quantity.Abs()
+	}
+
+	realized := price.Sub(p.entryPrice).Mul(closing)
+	if p.quantity.IsNegative() {
+		realized = realized.Neg()
+	}
+
+	previous := p.quantity
+	p.quantity = p.quantity.Add(delta)
+
+	switch {
+	case p.quantity.IsZero():
+		p.entryPrice = numerical.Zero()
+	case p.quantity.IsNegative() != previous.IsNegative():
+		p.entryPrice = price
+	}
+
+	return realized
+}
@@ -0,0 +1,29 @@
+package backfill
+
+const (
+	// DefaultDepth is how many bars a backfill fetches when none is given
+	DefaultDepth = 1000
+
+	// DefaultPageSize is the number of bars requested per range call
+	DefaultPageSize = 500
+
+	// DefaultMaxPages bounds a single backfill so a bad interval cannot
+	// page through an exchange's entire history
+	DefaultMaxPages = 50
+)
+
+// Config controls how deep backfills go and how they page
+type Config struct {
+	Depth    int
+	PageSize int
+	MaxPages int
+}
+
+// DefaultConfig fetches 1000 bars in pages of 500
+func DefaultConfig() Config {
+	return Config{
+		Depth:    DefaultDepth,
+		PageSize: DefaultPageSize,
+		MaxPages: DefaultMaxPages,
+	}
+}
@@ -0,0 +1,49 @@
+package backfill
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseInterval converts a kline interval to its duration. It accepts the
+// common "1m"/"4h"/"1d"/"1w" form and Bybit's minute counts, "D" and "W".
+func ParseInterval(interval string) (time.Duration, error) {
+	switch interval {
+	case "D":
+		return 24 * time.Hour, nil
+	case "W":
+		return 7 * 24 * time.Hour, nil
+	case "":
+		return 0, fmt.Errorf("interval is required")
+	}
+
+	// Bare numbers are minutes
+	if minutes, err := strconv.Atoi(interval); err == nil && minutes > 0 {
+		return time.Duration(minutes) * time.Minute, nil
+	}
+
+	unit := interval[len(interval)-1:]
+	count, err := strconv.Atoi(interval[:len(interval)-1])
+	if err != nil || count <= 0 {
+		return 0, fmt.Errorf("unsupported interval %q", interval)
+	}
+
+	switch strings.ToLower(unit) {
+	case "m":
+		// "1M" is a calendar month, which has no fixed duration
+		if unit == "M" {
+			return 0, fmt.Errorf("monthly interval %q has no fixed duration", interval)
+		}
+		return time.Duration(count) * time.Minute, nil
+	case "h":
+		return time.Duration(count) * time.Hour, nil
+	case "d":
+		return time.Duration(count) * 24 * time.Hour, nil
+	case "w":
+		return time.Duration(count) * 7 * 24 * time.Hour, nil
+	}
+
+	return 0, fmt.Errorf("unsupported interval %q", interval)
+}
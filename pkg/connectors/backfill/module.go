@@ -0,0 +1,7 @@
+package backfill
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(NewBackfiller),
+)
@@ -0,0 +1,225 @@
+package backfill
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+// Gap is a run of missing bars; From is the open time of the first missing
+// bar and To the open time of the last
+type Gap struct {
+	From    time.Time
+	To      time.Time
+	Missing int
+}
+
+// Backfiller pages historical klines from exchanges and repairs holes in
+// kline history, e.g. after a WebSocket reconnect dropped updates
+type Backfiller interface {
+	Configure(config Config)
+
+	// Backfill returns up to depth bars ending now, oldest first; a depth of
+	// zero uses the configured default
+	Backfill(exchange connector.ExchangeName, symbol, interval string, depth int) ([]connector.Kline, error)
+
+	// Repair re-fetches every gap in history and returns the merged series,
+	// oldest first, along with the gaps that could not be filled
+	Repair(exchange connector.ExchangeName, symbol, interval string, history []connector.Kline) ([]connector.Kline, []Gap, error)
+}
+
+type backfiller struct {
+	registry     registry.ConnectorRegistry
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config Config
+	mu     sync.Mutex
+}
+
+func NewBackfiller(
+	connectorRegistry registry.ConnectorRegistry,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) Backfiller {
+	return &backfiller{
+		registry:     connectorRegistry,
+		timeProvider: timeProvider,
+		logger:       logger,
+		config:       DefaultConfig(),
+	}
+}
+
+func (b *backfiller) Configure(config Config) {
+	defaults := DefaultConfig()
+	if config.Depth <= 0 {
+		config.Depth = defaults.Depth
+	}
+	if config.PageSize <= 0 {
+		config.PageSize = defaults.PageSize
+	}
+	if config.MaxPages <= 0 {
+		config.MaxPages = defaults.MaxPages
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.config = config
+}
+
+func (b *backfiller) Backfill(exchange connector.ExchangeName, symbol, interval string, depth int) ([]connector.Kline, error) {
+	b.mu.Lock()
+	config := b.config
+	b.mu.Unlock()
+
+	if depth <= 0 {
+		depth = config.Depth
+	}
+
+	conn, ok := b.registry.GetConnector(exchange)
+	if !ok {
+		return nil, fmt.Errorf("connector %s not registered", exchange)
+	}
+
+	provider, ok := conn.(types.KlineRangeProvider)
+	if !ok {
+		// Without range queries the most recent page is all we can get
+		klines, err := conn.FetchKlines(symbol, interval, depth)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch klines: %w", err)
+		}
+		return normalise(klines), nil
+	}
+
+	step, err := ParseInterval(interval)
+	if err != nil {
+		return nil, err
+	}
+
+	end := b.timeProvider.Now()
+	var collected []connector.Kline
+
+	for page := 0; page < config.MaxPages && len(collected) < depth; page++ {
+		size := config.PageSize
+		if remaining := depth - len(collected); remaining < size {
+			size = remaining
+		}
+		start := end.Add(-step * time.Duration(size))
+
+		klines, err := provider.FetchKlinesRange(symbol, interval, start, end, size)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch klines %s - %s: %w", start.Format(time.RFC3339), end.Format(time.RFC3339), err)
+		}
+		klines = normalise(klines)
+		if len(klines) == 0 {
+			break
+		}
+
+		collected = merge(klines, collected)
+		end = klines[0].OpenTime.Add(-time.Millisecond)
+	}
+
+	if len(collected) > depth {
+		collected = collected[len(collected)-depth:]
+	}
+
+	b.logger.Info("Backfilled %d %s bars for %s on %s", len(collected), interval, symbol, exchange)
+	return collected, nil
+}
+
+func (b *backfiller) Repair(exchange connector.ExchangeName, symbol, interval string, history []connector.Kline) ([]connector.Kline, []Gap, error) {
+	step, err := ParseInterval(interval)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	history = normalise(history)
+	gaps := FindGaps(history, step)
+	if len(gaps) == 0 {
+		return history, nil, nil
+	}
+
+	conn, ok := b.registry.GetConnector(exchange)
+	if !ok {
+		return history, gaps, fmt.Errorf("connector %s not registered", exchange)
+	}
+	provider, ok := conn.(types.KlineRangeProvider)
+	if !ok {
+		return history, gaps, fmt.Errorf("connector %s cannot fetch kline ranges", exchange)
+	}
+
+	b.mu.Lock()
+	pageSize := b.config.PageSize
+	b.mu.Unlock()
+
+	for _, gap := range gaps {
+		// Page through long gaps in PageSize chunks
+		for from := gap.From; !from.After(gap.To); from = from.Add(step * time.Duration(pageSize)) {
+			to := from.Add(step * time.Duration(pageSize-1))
+			if to.After(gap.To) {
+				to = gap.To
+			}
+
+			klines, err := provider.FetchKlinesRange(symbol, interval, from, to, pageSize)
+			if err != nil {
+				return history, FindGaps(history, step), fmt.Errorf("failed to refetch %s - %s: %w", from.Format(time.RFC3339), to.Format(time.RFC3339), err)
+			}
+			history = merge(normalise(klines), history)
+		}
+	}
+
+	remaining := FindGaps(history, step)
+	b.logger.Info("Repaired %d of %d kline gaps for %s %s on %s", len(gaps)-len(remaining), len(gaps), symbol, interval, exchange)
+	return history, remaining, nil
+}
+
+// FindGaps returns runs of missing bars in an oldest-first series spaced by step
+func FindGaps(klines []connector.Kline, step time.Duration) []Gap {
+	var gaps []Gap
+	for i := 1; i < len(klines); i++ {
+		expected := klines[i-1].OpenTime.Add(step)
+		if klines[i].OpenTime.After(expected) {
+			missing := int(klines[i].OpenTime.Sub(expected) / step)
+			if missing == 0 {
+				continue
+			}
+			gaps = append(gaps, Gap{
+				From:    expected,
+				To:      klines[i].OpenTime.Add(-step),
+				Missing: missing,
+			})
+		}
+	}
+	return gaps
+}
+
+// normalise sorts oldest first and drops duplicate open times, since
+// exchanges disagree on ordering
+func normalise(klines []connector.Kline) []connector.Kline {
+	return merge(klines, nil)
+}
+
+// merge combines two series, preferring bars from newer when open times collide
+func merge(newer, older []connector.Kline) []connector.Kline {
+	byOpen := make(map[int64]connector.Kline, len(newer)+len(older))
+	for _, kline := range older {
+		byOpen[kline.OpenTime.UnixMilli()] = kline
+	}
+	for _, kline := range newer {
+		byOpen[kline.OpenTime.UnixMilli()] = kline
+	}
+
+	merged := make([]connector.Kline, 0, len(byOpen))
+	for _, kline := range byOpen {
+		merged = append(merged, kline)
+	}
+	sort.Slice(merged, func(i, j int) bool { return merged[i].OpenTime.Before(merged[j].OpenTime) })
+	return merged
+}
@@ -0,0 +1,14 @@
+package batch
+
+// DefaultConcurrency is how many single-order requests run at once when a
+// connector has no native batch endpoint
+const DefaultConcurrency = 4
+
+// Config bounds the fallback for connectors without native batches
+type Config struct {
+	Concurrency int
+}
+
+func DefaultConfig() Config {
+	return Config{Concurrency: DefaultConcurrency}
+}
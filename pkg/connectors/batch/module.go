@@ -0,0 +1,7 @@
+package batch
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(NewOrderBatcher),
+)
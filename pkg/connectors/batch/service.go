@@ -0,0 +1,230 @@
+package batch
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+// OrderBatcher places and cancels many orders per call, for strategies that
+// replace a ladder of quotes at once. Limit orders go through the
+// exchange's native batch endpoint where the connector has one, split to
+// its batch size; market orders, and every order on other connectors, are
+// sent one by one with bounded concurrency.
+//
+// Results line up with the input by index. The error summarises failed
+// orders and is also returned when the connector cannot be found.
+type OrderBatcher interface {
+	Configure(config Config)
+
+	PlaceOrders(exchange connector.ExchangeName, requests []types.OrderRequest) ([]types.OrderResult, error)
+
+	// CancelOrders cancels orders on one market; symbol is exchange-native
+	CancelOrders(exchange connector.ExchangeName, symbol string, orderIDs []string) ([]types.CancelResult, error)
+}
+
+type orderBatcher struct {
+	registry registry.ConnectorRegistry
+	logger   logging.ApplicationLogger
+
+	config Config
+	mu     sync.RWMutex
+}
+
+func NewOrderBatcher(
+	connectorRegistry registry.ConnectorRegistry,
+	logger logging.ApplicationLogger,
+) OrderBatcher {
+	return &orderBatcher{
+		registry: connectorRegistry,
+		logger:   logger,
+		config:   DefaultConfig(),
+	}
+}
+
+func (b *orderBatcher) Configure(config Config) {
+	if config.Concurrency <= 0 {
+		config.Concurrency = DefaultConcurrency
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.config = config
+}
+
+func (b *orderBatcher) PlaceOrders(exchange connector.ExchangeName, requests []types.OrderRequest) ([]types.OrderResult, error) {
+	conn, err := b.connector(exchange)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]types.OrderResult, len(requests))
+	var native, single []int
+	provider, batched := conn.(types.BatchOrderProvider)
+
+	for i, request := range requests {
+		if err := request.Validate(); err != nil {
+			results[i].Err = fmt.Errorf("invalid order: %w", err)
+			continue
+		}
+		if batched && request.Type == connector.OrderTypeLimit {
+			native = append(native, i)
+		} else {
+			single = append(single, i)
+		}
+	}
+
+	for _, chunk := range chunks(native, provider) {
+		batch := make([]types.OrderRequest, len(chunk))
+		for j, index := range chunk {
+			batch[j] = requests[index]
+		}
+
+		chunkResults, err := provider.PlaceOrders(batch)
+		for j, index := range chunk {
+			switch {
+			case err != nil:
+				results[index].Err = err
+			case j < len(chunkResults):
+				results[index] = chunkResults[j]
+			default:
+				results[index].Err = fmt.Errorf("no result for batched order")
+			}
+		}
+	}
+
+	b.each(single, func(index int) {
+		request := requests[index]
+		var response *connector.OrderResponse
+		var err error
+		if request.Type == connector.OrderTypeLimit {
+			response, err = conn.PlaceLimitOrder(request.Symbol, request.Side, request.Quantity, request.Price)
+		} else {
+			response, err = conn.PlaceMarketOrder(request.Symbol, request.Side, request.Quantity)
+		}
+		results[index] = types.OrderResult{Response: response, Err: err}
+	})
+
+	failed := 0
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+		}
+	}
+	b.logger.Info("Batch placed %d/%d orders on %s (%d native, %d single)",
+		len(requests)-failed, len(requests), exchange, len(native), len(single))
+
+	if failed > 0 {
+		return results, fmt.Errorf("%d of %d orders failed on %s", failed, len(requests), exchange)
+	}
+	return results, nil
+}
+
+func (b *orderBatcher) CancelOrders(exchange connector.ExchangeName, symbol string, orderIDs []string) ([]types.CancelResult, error) {
+	conn, err := b.connector(exchange)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]types.CancelResult, len(orderIDs))
+	indices := make([]int, len(orderIDs))
+	for i := range orderIDs {
+		indices[i] = i
+	}
+
+	if provider, ok := conn.(types.BatchOrderProvider); ok {
+		for _, chunk := range chunks(indices, provider) {
+			batch := make([]string, len(chunk))
+			for j, index := range chunk {
+				batch[j] = orderIDs[index]
+			}
+
+			chunkResults, err := provider.CancelOrders(symbol, batch)
+			for j, index := range chunk {
+				switch {
+				case err != nil:
+					results[index] = types.CancelResult{OrderID: orderIDs[index], Err: err}
+				case j < len(chunkResults):
+					results[index] = chunkResults[j]
+				default:
+					results[index] = types.CancelResult{OrderID: orderIDs[index], Err: fmt.Errorf("no result for batched cancel")}
+				}
+			}
+		}
+	} else {
+		b.each(indices, func(index int) {
+			response, err := conn.CancelOrder(symbol, orderIDs[index])
+			results[index] = types.CancelResult{OrderID: orderIDs[index], Response: response, Err: err}
+		})
+	}
+
+	failed := 0
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return results, fmt.Errorf("%d of %d cancels failed on %s", failed, len(orderIDs), exchange)
+	}
+	return results, nil
+}
+
+func (b *orderBatcher) connector(exchange connector.ExchangeName) (connector.Connector, error) {
+	conn, ok := b.registry.GetConnector(exchange)
+	if !ok {
+		return nil, fmt.Errorf("connector %s not registered", exchange)
+	}
+	if !conn.SupportsTradingOperations() {
+		return nil, fmt.Errorf("connector %s does not support trading", exchange)
+	}
+	return conn, nil
+}
+
+// each runs fn for every index with at most Concurrency in flight
+func (b *orderBatcher) each(indices []int, fn func(index int)) {
+	b.mu.RLock()
+	concurrency := b.config.Concurrency
+	b.mu.RUnlock()
+
+	slots := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for _, index := range indices {
+		slots <- struct{}{}
+		wg.Add(1)
+		go func(index int) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			fn(index)
+		}(index)
+	}
+	wg.Wait()
+}
+
+// chunks splits indices into batches the provider accepts
+func chunks(indices []int, provider types.BatchOrderProvider) [][]int {
+	if len(indices) == 0 {
+		return nil
+	}
+
+	size := provider.MaxBatchSize()
+	if size <= 0 {
+		return [][]int{indices}
+	}
+
+	var batches [][]int
+	for start := 0; start < len(indices); start += size {
+		end := start + size
+		if end > len(indices) {
+			end = len(indices)
+		}
+		batches = append(batches, indices[start:end])
+	}
+	return batches
+}
@@ -0,0 +1,27 @@
+package binance
+
+import (
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+)
+
+func (b *binance) GetAccountBalance() (*connector.AccountBalance, error) {
+	if err := b.limiter.Wait(ratelimit.EndpointAccount); err != nil {
+		return nil, err
+	}
+	return b.trading.GetAccountBalance()
+}
+
+func (b *binance) GetPositions() ([]connector.Position, error) {
+	if err := b.limiter.Wait(ratelimit.EndpointAccount); err != nil {
+		return nil, err
+	}
+	return b.trading.GetPositions()
+}
+
+func (b *binance) GetTradingHistory(symbol string, limit int) ([]connector.Trade, error) {
+	if err := b.limiter.Wait(ratelimit.EndpointTrades); err != nil {
+		return nil, err
+	}
+	return b.trading.GetTradingHistory(connector.TypePerpetual, symbol, limit)
+}
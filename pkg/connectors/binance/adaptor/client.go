@@ -0,0 +1,213 @@
+package adaptor
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+// Client is a lazily configured REST client for the Binance futures API.
+// Spot paths (/api and /sapi) are sent to the spot host instead.
+type Client interface {
+	Configure(baseURL, spotBaseURL, apiKey, apiSecret string, recvWindow int64) error
+	IsConfigured() bool
+
+	// Public performs an unsigned request and decodes the JSON body into out
+	Public(ctx context.Context, method, path string, params url.Values, out interface{}) error
+
+	// Signed performs an HMAC-SHA256 signed request and decodes the JSON body into out
+	Signed(ctx context.Context, method, path string, params url.Values, out interface{}) error
+
+	// Keyed performs a request that needs the API key header but no signature
+	Keyed(ctx context.Context, method, path string, params url.Values, out interface{}) error
+}
+
+// APIError is the error payload Binance returns for rejected requests
+type APIError struct {
+	Code       int    `json:"code"`
+	Message    string `json:"msg"`
+	StatusCode int    `json:"-"`
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("binance api error %d (http %d): %s", e.Code, e.StatusCode, e.Message)
+}
+
+// ErrorCategory maps Binance error codes onto the connector taxonomy
+func (e *APIError) ErrorCategory() types.ErrorCategory {
+	switch {
+	case e.StatusCode == http.StatusTooManyRequests || e.StatusCode == http.StatusTeapot,
+		e.Code == -1003, e.Code == -1015:
+		return types.CategoryRateLimited
+	case e.StatusCode == http.StatusUnauthorized,
+		e.Code == -1002, e.Code == -1022, e.Code == -2014, e.Code == -2015:
+		return types.CategoryUnauthorized
+	case e.Code == -2018, e.Code == -2019:
+		return types.CategoryInsufficientFunds
+	// -1021 is a timestamp outside recvWindow, which clears up on retry
+	case e.StatusCode >= http.StatusInternalServerError,
+		e.Code == -1001, e.Code == -1007, e.Code == -1021:
+		return types.CategoryConnectivity
+	case e.Code <= -1100 && e.Code >= -1199, e.Code == -1013, e.Code == -2010,
+		e.Code == -2022, e.Code <= -4000 && e.Code >= -4199:
+		return types.CategoryInvalidOrder
+	default:
+		return types.CategoryUnknown
+	}
+}
+
+type client struct {
+	httpClient   *http.Client
+	timeProvider temporal.TimeProvider
+	baseURL      string
+	spotBaseURL  string
+	apiKey       string
+	apiSecret    string
+	recvWindow   int64
+	configured   bool
+	mu           sync.RWMutex
+}
+
+// NewClient creates an unconfigured Binance REST client
+func NewClient(timeProvider temporal.TimeProvider) Client {
+	return &client{
+		httpClient:   &http.Client{Timeout: 10 * time.Second},
+		timeProvider: timeProvider,
+	}
+}
+
+// Configure sets up the client with runtime config
+func (c *client) Configure(baseURL, spotBaseURL, apiKey, apiSecret string, recvWindow int64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.configured {
+		return fmt.Errorf("client already configured")
+	}
+
+	if _, err := url.Parse(baseURL); err != nil {
+		return fmt.Errorf("invalid base url: %w", err)
+	}
+	if _, err := url.Parse(spotBaseURL); err != nil {
+		return fmt.Errorf("invalid spot base url: %w", err)
+	}
+
+	c.baseURL = baseURL
+	c.spotBaseURL = spotBaseURL
+	c.apiKey = apiKey
+	c.apiSecret = apiSecret
+	c.recvWindow = recvWindow
+	c.configured = true
+	return nil
+}
+
+func (c *client) IsConfigured() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.configured
+}
+
+func (c *client) Public(ctx context.Context, method, path string, params url.Values, out interface{}) error {
+	return c.do(ctx, method, path, params, false, false, out)
+}
+
+func (c *client) Signed(ctx context.Context, method, path string, params url.Values, out interface{}) error {
+	return c.do(ctx, method, path, params, true, true, out)
+}
+
+func (c *client) Keyed(ctx context.Context, method, path string, params url.Values, out interface{}) error {
+	return c.do(ctx, method, path, params, true, false, out)
+}
+
+func (c *client) do(ctx context.Context, method, path string, params url.Values, withKey, sign bool, out interface{}) error {
+	c.mu.RLock()
+	baseURL, apiKey, apiSecret, recvWindow, configured := c.baseURL, c.apiKey, c.apiSecret, c.recvWindow, c.configured
+	if isSpotPath(path) {
+		baseURL = c.spotBaseURL
+	}
+	c.mu.RUnlock()
+
+	if !configured {
+		return fmt.Errorf("binance client not configured")
+	}
+
+	if params == nil {
+		params = url.Values{}
+	}
+
+	if sign {
+		params.Set("timestamp", strconv.FormatInt(c.timeProvider.Now().UnixMilli(), 10))
+		if recvWindow > 0 {
+			params.Set("recvWindow", strconv.FormatInt(recvWindow, 10))
+		}
+	}
+
+	query := params.Encode()
+	if sign {
+		mac := hmac.New(sha256.New, []byte(apiSecret))
+		mac.Write([]byte(query))
+		query += "&signature=" + hex.EncodeToString(mac.Sum(nil))
+	}
+
+	endpoint := baseURL + path
+	if query != "" {
+		endpoint += "?" + query
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+
+	if withKey {
+		req.Header.Set("X-MBX-APIKEY", apiKey)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request to %s failed: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response from %s: %w", path, err)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		apiErr := &APIError{StatusCode: resp.StatusCode}
+		if jsonErr := json.Unmarshal(body, apiErr); jsonErr != nil || apiErr.Message == "" {
+			apiErr.Message = string(body)
+		}
+		return apiErr
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("failed to decode response from %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// isSpotPath reports whether a path belongs to the spot API, which Binance
+// serves from a different host than USDⓈ-M futures
+func isSpotPath(path string) bool {
+	return strings.HasPrefix(path, "/api/") || strings.HasPrefix(path, "/sapi/")
+}
@@ -0,0 +1,45 @@
+package binance
+
+import (
+	"fmt"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+)
+
+func (b *binance) FetchAvailablePerpetualAssets() ([]portfolio.Asset, error) {
+	if err := b.limiter.Wait(ratelimit.EndpointInstruments); err != nil {
+		return nil, err
+	}
+	return b.marketData.FetchAvailablePerpetualAssets()
+}
+
+func (b *binance) FetchAvailableSpotAssets() ([]portfolio.Asset, error) {
+	return nil, fmt.Errorf("spot trading not supported by the Binance futures connector")
+}
+
+func (b *binance) FetchContracts() ([]connector.ContractInfo, error) {
+	if err := b.limiter.Wait(ratelimit.EndpointInstruments); err != nil {
+		return nil, err
+	}
+	return b.marketData.FetchContracts()
+}
+
+func (b *binance) FetchCurrentFundingRates() (map[portfolio.Asset]connector.FundingRate, error) {
+	if err := b.limiter.Wait(ratelimit.EndpointFunding); err != nil {
+		return nil, err
+	}
+	return b.marketData.FetchCurrentFundingRates()
+}
+
+func (b *binance) FetchHistoricalFundingRates(asset portfolio.Asset, startTime, endTime int64) ([]connector.HistoricalFundingRate, error) {
+	if err := b.limiter.Wait(ratelimit.EndpointFunding); err != nil {
+		return nil, err
+	}
+	return b.marketData.FetchHistoricalFundingRates(b.GetPerpSymbol(asset), startTime, endTime)
+}
+
+func (b *binance) FetchRiskFundBalance(symbol string) (*connector.RiskFundBalance, error) {
+	return nil, fmt.Errorf("FetchRiskFundBalance not implemented for Binance")
+}
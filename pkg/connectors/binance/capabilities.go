@@ -0,0 +1,44 @@
+package binance
+
+import (
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+// SupportsTradingOperations returns whether trading operations are supported
+func (b *binance) SupportsTradingOperations() bool {
+	return b.trading != nil
+}
+
+// SupportsRealTimeData returns whether real-time data is supported
+func (b *binance) SupportsRealTimeData() bool {
+	return b.realTime != nil
+}
+
+func (b *binance) SupportsFundingRates() bool {
+	return true
+}
+
+func (b *binance) SupportsPerpetuals() bool {
+	return true
+}
+
+func (b *binance) SupportsSpot() bool {
+	return true
+}
+
+// GetConnectorInfo returns metadata about the exchange
+func (b *binance) GetConnectorInfo() *connector.Info {
+	return &connector.Info{
+		Name:             types.Binance,
+		TradingEnabled:   b.SupportsTradingOperations(),
+		WebSocketEnabled: true,
+		MaxLeverage:      numerical.NewFromFloat(125.0),
+		SupportedOrderTypes: []connector.OrderType{
+			connector.OrderTypeLimit,
+			connector.OrderTypeMarket,
+		},
+		QuoteCurrency: "USDT",
+	}
+}
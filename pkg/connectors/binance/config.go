@@ -0,0 +1,90 @@
+package binance
+
+import (
+	"fmt"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+const (
+	mainnetBaseURL      = "https://fapi.binance.com"
+	testnetBaseURL      = "https://testnet.binancefuture.com"
+	mainnetSpotBaseURL  = "https://api.binance.com"
+	testnetSpotBaseURL  = "https://testnet.binance.vision"
+	mainnetWebSocketURL = "wss://fstream.binance.com/ws"
+	testnetWebSocketURL = "wss://stream.binancefuture.com/ws"
+	defaultRecvWindow   = 5000
+)
+
+// Config holds the configuration for the Binance USDⓈ-M futures connector;
+// spot orders go through the spot API with the same key pair
+type Config struct {
+	APIKey          string  `json:"api_key"`
+	APISecret       string  `json:"api_secret"`
+	BaseURL         string  `json:"base_url,omitempty"`
+	SpotBaseURL     string  `json:"spot_base_url,omitempty"`
+	WebSocketURL    string  `json:"websocket_url,omitempty"`
+	IsTestnet       bool    `json:"is_testnet,omitempty"`
+	RecvWindow      int64   `json:"recv_window,omitempty"`      // Milliseconds, default 5000
+	DefaultSlippage float64 `json:"default_slippage,omitempty"` // Default 0.005 (0.5%)
+}
+
+var _ connector.Config = (*Config)(nil)
+var _ types.SecretConfig = (*Config)(nil)
+
+func (c *Config) ExchangeName() connector.ExchangeName {
+	return types.Binance
+}
+
+func (c *Config) UsesTestnet() bool {
+	return c.IsTestnet
+}
+
+func (c *Config) SecretFields() map[string]*string {
+	return map[string]*string{"api_key": &c.APIKey, "api_secret": &c.APISecret}
+}
+
+// Validate checks if the configuration is valid
+func (c *Config) Validate() error {
+	if c.APIKey == "" {
+		return fmt.Errorf("api_key is required")
+	}
+	if c.APISecret == "" {
+		return fmt.Errorf("api_secret is required")
+	}
+
+	if c.DefaultSlippage == 0 {
+		c.DefaultSlippage = 0.005
+	}
+
+	if c.RecvWindow == 0 {
+		c.RecvWindow = defaultRecvWindow
+	}
+
+	if c.BaseURL == "" {
+		if c.IsTestnet {
+			c.BaseURL = testnetBaseURL
+		} else {
+			c.BaseURL = mainnetBaseURL
+		}
+	}
+
+	if c.SpotBaseURL == "" {
+		if c.IsTestnet {
+			c.SpotBaseURL = testnetSpotBaseURL
+		} else {
+			c.SpotBaseURL = mainnetSpotBaseURL
+		}
+	}
+
+	if c.WebSocketURL == "" {
+		if c.IsTestnet {
+			c.WebSocketURL = testnetWebSocketURL
+		} else {
+			c.WebSocketURL = mainnetWebSocketURL
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package binance
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/binance/adaptor"
+	"github.com/backtesting-org/live-trading/pkg/connectors/binance/data"
+	"github.com/backtesting-org/live-trading/pkg/connectors/binance/data/real_time"
+	"github.com/backtesting-org/live-trading/pkg/connectors/binance/trading"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+// binance implements Connector and WebSocketConnector for Binance USDⓈ-M futures
+type binance struct {
+	client        adaptor.Client
+	marketData    data.MarketDataService
+	trading       trading.TradingService
+	realTime      real_time.RealTimeService
+	config        *Config
+	appLogger     logging.ApplicationLogger
+	tradingLogger logging.TradingLogger
+	timeProvider  temporal.TimeProvider
+	limiter       ratelimit.Limiter
+	initialized   bool
+
+	// Separate channels per orderbook subscription (key: "BTC", "ETH", etc.)
+	orderBookChannels map[string]chan connector.OrderBook
+	orderBookMu       sync.RWMutex
+
+	// Separate channels per kline subscription (key: "BTC:1m", "ETH:5m", etc.)
+	klineChannels map[string]chan connector.Kline
+	klineMu       sync.RWMutex
+
+	// WebSocket channels
+	tradeCh     chan connector.Trade
+	positionCh  chan connector.Position
+	balanceCh   chan connector.AccountBalance
+	markPriceCh chan types.MarkPrice
+	errorCh     chan error
+
+	// Symbols with an active position subscription; all share the user data stream
+	positionSymbols map[string]portfolio.Asset
+	balanceActive   bool
+	userMu          sync.RWMutex
+}
+
+var _ connector.Connector = (*binance)(nil)
+var _ connector.WebSocketConnector = (*binance)(nil)
+
+func NewBinance(
+	client adaptor.Client,
+	tradingService trading.TradingService,
+	marketDataService data.MarketDataService,
+	realTimeService real_time.RealTimeService,
+	appLogger logging.ApplicationLogger,
+	tradingLogger logging.TradingLogger,
+	timeProvider temporal.TimeProvider,
+	limiters ratelimit.RateLimiters,
+) connector.Connector {
+	return &binance{
+		client:            client,
+		trading:           tradingService,
+		marketData:        marketDataService,
+		realTime:          realTimeService,
+		appLogger:         appLogger,
+		tradingLogger:     tradingLogger,
+		timeProvider:      timeProvider,
+		limiter:           limiters.For(types.Binance),
+		tradeCh:           make(chan connector.Trade, 100),
+		positionCh:        make(chan connector.Position, 100),
+		balanceCh:         make(chan connector.AccountBalance, 100),
+		markPriceCh:       make(chan types.MarkPrice, 100),
+		errorCh:           make(chan error, 100),
+		orderBookChannels: make(map[string]chan connector.OrderBook),
+		klineChannels:     make(map[string]chan connector.Kline),
+		positionSymbols:   make(map[string]portfolio.Asset),
+	}
+}
+
+func (b *binance) Initialize(config connector.Config) error {
+	if b.initialized {
+		return fmt.Errorf("connector already initialized")
+	}
+
+	binanceConfig, ok := config.(*Config)
+	if !ok {
+		return fmt.Errorf("invalid config type for Binance connector: expected *binance.Config, got %T", config)
+	}
+
+	if err := binanceConfig.Validate(); err != nil {
+		return fmt.Errorf("invalid Binance config: %w", err)
+	}
+
+	if err := b.client.Configure(binanceConfig.BaseURL, binanceConfig.SpotBaseURL, binanceConfig.APIKey, binanceConfig.APISecret, binanceConfig.RecvWindow); err != nil {
+		return fmt.Errorf("failed to configure client: %w", err)
+	}
+
+	if err := b.realTime.Initialize(&real_time.Config{WebSocketURL: binanceConfig.WebSocketURL}); err != nil {
+		return fmt.Errorf("failed to initialize real-time service: %w", err)
+	}
+
+	b.config = binanceConfig
+	b.initialized = true
+	b.appLogger.Info("Binance connector initialized (testnet: %v)", binanceConfig.IsTestnet)
+	return nil
+}
+
+// IsInitialized implements Initializable interface
+func (b *binance) IsInitialized() bool {
+	return b.initialized
+}
+
+func (b *binance) GetPerpSymbol(asset portfolio.Asset) string {
+	symbol, _ := symbolRule.ToNative(asset, connector.TypePerpetual)
+	return symbol
+}
@@ -0,0 +1,601 @@
+package real_time
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/binance/adaptor"
+	"github.com/backtesting-org/live-trading/pkg/connectors/binance/data"
+	"github.com/backtesting-org/live-trading/pkg/websocket/connection"
+	"github.com/backtesting-org/live-trading/pkg/websocket/performance"
+	"github.com/backtesting-org/live-trading/pkg/websocket/security"
+)
+
+// listenKeyKeepAlive is how often the user data stream key is extended; Binance expires it after 60 minutes
+const listenKeyKeepAlive = 30 * time.Minute
+
+type Config struct {
+	WebSocketURL string
+}
+
+type RealTimeService interface {
+	Initialize(config *Config) error
+	Connect() error
+	Disconnect() error
+	IsConnected() bool
+	GetErrorChannel() <-chan error
+
+	SubscribeOrderBook(symbol string, callback func(*OrderBookMessage)) error
+	UnsubscribeOrderBook(symbol string) error
+	SubscribeTrades(symbol string, callback func(*TradeMessage)) error
+	UnsubscribeTrades(symbol string) error
+	SubscribeKlines(symbol, interval string, callback func(*KlineMessage)) error
+	UnsubscribeKlines(symbol, interval string) error
+	SubscribeMarkPrice(symbol string, callback func(*MarkPriceMessage)) error
+	UnsubscribeMarkPrice(symbol string) error
+
+	// SubscribeUserData opens the private user data stream for balance and position events
+	SubscribeUserData(callback func(*AccountUpdateMessage)) error
+	UnsubscribeUserData() error
+}
+
+type realTimeService struct {
+	client       adaptor.Client
+	logger       logging.ApplicationLogger
+	timeProvider temporal.TimeProvider
+	config       *Config
+
+	marketConn   connection.ConnectionManager
+	reconnectMgr connection.ReconnectManager
+
+	userConn     connection.ConnectionManager
+	userCallback func(*AccountUpdateMessage)
+	userCancel   context.CancelFunc
+	listenKey    string
+
+	// handlers keyed by Binance stream name, e.g. "btcusdt@aggTrade"
+	handlers map[string]func([]byte)
+
+	ctx       context.Context
+	cancel    context.CancelFunc
+	requestID int64
+	errorCh   chan error
+	mu        sync.RWMutex
+}
+
+func NewRealTimeService(
+	client adaptor.Client,
+	logger logging.ApplicationLogger,
+	timeProvider temporal.TimeProvider,
+) RealTimeService {
+	return &realTimeService{
+		client:       client,
+		logger:       logger,
+		timeProvider: timeProvider,
+		handlers:     make(map[string]func([]byte)),
+		errorCh:      make(chan error, 100),
+	}
+}
+
+func (r *realTimeService) Initialize(config *Config) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.marketConn != nil {
+		return fmt.Errorf("real-time service already initialized")
+	}
+
+	r.config = config
+	r.marketConn = r.newConnection(config.WebSocketURL)
+	r.reconnectMgr = connection.NewReconnectManager(
+		r.marketConn,
+		connection.NewExponentialBackoffStrategy(5*time.Second, 60*time.Second, 10),
+		r.logger,
+	)
+
+	r.marketConn.SetCallbacks(
+		func() error {
+			r.logger.Info("Binance market stream connected")
+			return nil
+		},
+		func() error {
+			r.logger.Info("Binance market stream disconnected")
+			return nil
+		},
+		r.onMarketMessage,
+		r.onError,
+	)
+
+	r.reconnectMgr.SetCallbacks(
+		func(attempt int) {
+			r.logger.Info("Starting Binance reconnection attempt %d", attempt)
+		},
+		func(attempt int, err error) {
+			r.logger.Warn("Binance reconnection attempt %d failed: %v", attempt, err)
+		},
+		func(attempt int) {
+			r.logger.Info("Binance reconnected after %d attempts, resubscribing", attempt)
+			r.resubscribeAll()
+		},
+	)
+
+	return nil
+}
+
+func (r *realTimeService) Connect() error {
+	r.mu.Lock()
+	if r.marketConn == nil {
+		r.mu.Unlock()
+		return fmt.Errorf("real-time service not initialized")
+	}
+	r.ctx, r.cancel = context.WithCancel(context.Background())
+	ctx := r.ctx
+	r.mu.Unlock()
+
+	if err := r.marketConn.Connect(ctx); err != nil {
+		return fmt.Errorf("binance websocket connection failed: %w", err)
+	}
+
+	return r.reconnectMgr.StartReconnection(ctx)
+}
+
+func (r *realTimeService) Disconnect() error {
+	r.mu.Lock()
+	if r.marketConn == nil {
+		r.mu.Unlock()
+		return fmt.Errorf("real-time service not initialized")
+	}
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.mu.Unlock()
+
+	r.reconnectMgr.StopReconnection()
+
+	if err := r.UnsubscribeUserData(); err != nil {
+		r.logger.Warn("Failed to close Binance user data stream: %v", err)
+	}
+
+	return r.marketConn.Disconnect()
+}
+
+func (r *realTimeService) IsConnected() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.marketConn != nil && r.marketConn.GetState() == connection.StateConnected
+}
+
+func (r *realTimeService) GetErrorChannel() <-chan error {
+	return r.errorCh
+}
+
+func (r *realTimeService) SubscribeOrderBook(symbol string, callback func(*OrderBookMessage)) error {
+	return r.subscribe(depthStream(symbol), func(message []byte) {
+		var event depthEvent
+		if err := json.Unmarshal(message, &event); err != nil {
+			r.onError(fmt.Errorf("failed to parse depth update: %w", err))
+			return
+		}
+
+		callback(&OrderBookMessage{
+			Symbol:    event.Symbol,
+			Bids:      data.ParseLevels(event.Bids),
+			Asks:      data.ParseLevels(event.Asks),
+			Timestamp: time.UnixMilli(event.TransactionTime),
+		})
+	})
+}
+
+func (r *realTimeService) UnsubscribeOrderBook(symbol string) error {
+	return r.unsubscribe(depthStream(symbol))
+}
+
+func (r *realTimeService) SubscribeTrades(symbol string, callback func(*TradeMessage)) error {
+	return r.subscribe(tradeStream(symbol), func(message []byte) {
+		var event aggTradeEvent
+		if err := json.Unmarshal(message, &event); err != nil {
+			r.onError(fmt.Errorf("failed to parse trade update: %w", err))
+			return
+		}
+
+		callback(&TradeMessage{
+			Symbol:    event.Symbol,
+			ID:        strconv.FormatInt(event.AggregateID, 10),
+			Price:     parseDecimal(event.Price),
+			Quantity:  parseDecimal(event.Quantity),
+			Side:      data.TakerSide(event.IsBuyerMaker),
+			Timestamp: time.UnixMilli(event.TradeTime),
+		})
+	})
+}
+
+func (r *realTimeService) UnsubscribeTrades(symbol string) error {
+	return r.unsubscribe(tradeStream(symbol))
+}
+
+func (r *realTimeService) SubscribeKlines(symbol, interval string, callback func(*KlineMessage)) error {
+	return r.subscribe(klineStream(symbol, interval), func(message []byte) {
+		var event klineEvent
+		if err := json.Unmarshal(message, &event); err != nil {
+			r.onError(fmt.Errorf("failed to parse kline update: %w", err))
+			return
+		}
+
+		callback(&KlineMessage{
+			Symbol:      event.Symbol,
+			Interval:    event.Kline.Interval,
+			OpenTime:    time.UnixMilli(event.Kline.OpenTime),
+			CloseTime:   time.UnixMilli(event.Kline.CloseTime),
+			Open:        parseDecimal(event.Kline.Open),
+			High:        parseDecimal(event.Kline.High),
+			Low:         parseDecimal(event.Kline.Low),
+			Close:       parseDecimal(event.Kline.Close),
+			Volume:      parseDecimal(event.Kline.Volume),
+			QuoteVolume: parseDecimal(event.Kline.QuoteVolume),
+			TradeCount:  event.Kline.TradeCount,
+			Closed:      event.Kline.Closed,
+		})
+	})
+}
+
+func (r *realTimeService) UnsubscribeKlines(symbol, interval string) error {
+	return r.unsubscribe(klineStream(symbol, interval))
+}
+
+func (r *realTimeService) SubscribeMarkPrice(symbol string, callback func(*MarkPriceMessage)) error {
+	return r.subscribe(markPriceStream(symbol), func(message []byte) {
+		var event markPriceEvent
+		if err := json.Unmarshal(message, &event); err != nil {
+			r.onError(fmt.Errorf("failed to parse mark price update: %w", err))
+			return
+		}
+
+		callback(&MarkPriceMessage{
+			Symbol:          event.Symbol,
+			MarkPrice:       parseDecimal(event.MarkPrice),
+			IndexPrice:      parseDecimal(event.IndexPrice),
+			FundingRate:     parseDecimal(event.FundingRate),
+			NextFundingTime: time.UnixMilli(event.NextFundingTime),
+			Timestamp:       time.UnixMilli(event.EventTime),
+		})
+	})
+}
+
+func (r *realTimeService) UnsubscribeMarkPrice(symbol string) error {
+	return r.unsubscribe(markPriceStream(symbol))
+}
+
+func (r *realTimeService) SubscribeUserData(callback func(*AccountUpdateMessage)) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.config == nil {
+		return fmt.Errorf("real-time service not initialized")
+	}
+
+	r.userCallback = callback
+	if r.userConn != nil {
+		return nil
+	}
+
+	var result struct {
+		ListenKey string `json:"listenKey"`
+	}
+	if err := r.client.Keyed(context.Background(), http.MethodPost, "/fapi/v1/listenKey", nil, &result); err != nil {
+		return fmt.Errorf("failed to create listen key: %w", err)
+	}
+
+	userConn := r.newConnection(strings.TrimSuffix(r.config.WebSocketURL, "/") + "/" + result.ListenKey)
+	userConn.SetCallbacks(
+		func() error {
+			r.logger.Info("Binance user data stream connected")
+			return nil
+		},
+		func() error {
+			r.logger.Info("Binance user data stream disconnected")
+			return nil
+		},
+		r.onUserMessage,
+		r.onError,
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := userConn.Connect(ctx); err != nil {
+		cancel()
+		return fmt.Errorf("failed to connect user data stream: %w", err)
+	}
+
+	r.userConn = userConn
+	r.userCancel = cancel
+	r.listenKey = result.ListenKey
+
+	go r.keepAliveListenKey(ctx)
+
+	r.logger.Info("Subscribed to Binance user data stream")
+	return nil
+}
+
+func (r *realTimeService) UnsubscribeUserData() error {
+	r.mu.Lock()
+	userConn, cancel := r.userConn, r.userCancel
+	r.userConn = nil
+	r.userCancel = nil
+	r.userCallback = nil
+	r.listenKey = ""
+	r.mu.Unlock()
+
+	if userConn == nil {
+		return nil
+	}
+
+	cancel()
+
+	if err := r.client.Keyed(context.Background(), http.MethodDelete, "/fapi/v1/listenKey", nil, nil); err != nil {
+		r.logger.Warn("Failed to close Binance listen key: %v", err)
+	}
+
+	return userConn.Disconnect()
+}
+
+func (r *realTimeService) keepAliveListenKey(ctx context.Context) {
+	ticker := r.timeProvider.NewTicker(listenKeyKeepAlive)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C():
+			if err := r.client.Keyed(ctx, http.MethodPut, "/fapi/v1/listenKey", nil, nil); err != nil {
+				r.onError(fmt.Errorf("failed to keep listen key alive: %w", err))
+			}
+		}
+	}
+}
+
+func (r *realTimeService) subscribe(stream string, handler func([]byte)) error {
+	r.mu.Lock()
+	if r.marketConn == nil {
+		r.mu.Unlock()
+		return fmt.Errorf("real-time service not initialized")
+	}
+
+	_, exists := r.handlers[stream]
+	r.handlers[stream] = handler
+	r.mu.Unlock()
+
+	if exists {
+		return nil
+	}
+
+	if err := r.sendStreamRequest("SUBSCRIBE", []string{stream}); err != nil {
+		r.mu.Lock()
+		delete(r.handlers, stream)
+		r.mu.Unlock()
+		return fmt.Errorf("failed to subscribe to %s: %w", stream, err)
+	}
+
+	r.logger.Info("Subscribed to Binance stream %s", stream)
+	return nil
+}
+
+func (r *realTimeService) unsubscribe(stream string) error {
+	r.mu.Lock()
+	if _, exists := r.handlers[stream]; !exists {
+		r.mu.Unlock()
+		return nil
+	}
+	delete(r.handlers, stream)
+	r.mu.Unlock()
+
+	if err := r.sendStreamRequest("UNSUBSCRIBE", []string{stream}); err != nil {
+		r.logger.Warn("Failed to send unsubscribe for %s: %v", stream, err)
+	}
+
+	r.logger.Info("Unsubscribed from Binance stream %s", stream)
+	return nil
+}
+
+func (r *realTimeService) resubscribeAll() {
+	r.mu.RLock()
+	streams := make([]string, 0, len(r.handlers))
+	for stream := range r.handlers {
+		streams = append(streams, stream)
+	}
+	r.mu.RUnlock()
+
+	if len(streams) == 0 {
+		return
+	}
+
+	if err := r.sendStreamRequest("SUBSCRIBE", streams); err != nil {
+		r.onError(fmt.Errorf("failed to resubscribe %d streams: %w", len(streams), err))
+		return
+	}
+
+	r.logger.Info("Resubscribed to %d Binance streams", len(streams))
+}
+
+func (r *realTimeService) sendStreamRequest(method string, streams []string) error {
+	r.mu.Lock()
+	r.requestID++
+	id := r.requestID
+	r.mu.Unlock()
+
+	return r.marketConn.SendJSON(map[string]interface{}{
+		"method": method,
+		"params": streams,
+		"id":     id,
+	})
+}
+
+func (r *realTimeService) onMarketMessage(message []byte) error {
+	var event streamEvent
+	if err := json.Unmarshal(message, &event); err != nil {
+		return fmt.Errorf("failed to parse binance message: %w", err)
+	}
+
+	// Subscription acknowledgements carry no event type
+	if event.EventType == "" {
+		return nil
+	}
+
+	var stream string
+	switch event.EventType {
+	case "depthUpdate":
+		stream = depthStream(event.Symbol)
+	case "aggTrade":
+		stream = tradeStream(event.Symbol)
+	case "markPriceUpdate":
+		stream = markPriceStream(event.Symbol)
+	case "kline":
+		var kline struct {
+			K struct {
+				Interval string `json:"i"`
+			} `json:"k"`
+		}
+		if err := json.Unmarshal(message, &kline); err != nil {
+			return fmt.Errorf("failed to parse kline interval: %w", err)
+		}
+		stream = klineStream(event.Symbol, kline.K.Interval)
+	default:
+		return nil
+	}
+
+	r.mu.RLock()
+	handler, exists := r.handlers[stream]
+	r.mu.RUnlock()
+
+	if exists {
+		handler(message)
+	}
+
+	return nil
+}
+
+func (r *realTimeService) onUserMessage(message []byte) error {
+	var event accountUpdateEvent
+	if err := json.Unmarshal(message, &event); err != nil {
+		return fmt.Errorf("failed to parse user data event: %w", err)
+	}
+
+	switch event.EventType {
+	case "ACCOUNT_UPDATE":
+	case "listenKeyExpired":
+		r.onError(fmt.Errorf("binance listen key expired, user data stream must be resubscribed"))
+		return nil
+	default:
+		return nil
+	}
+
+	r.mu.RLock()
+	callback := r.userCallback
+	r.mu.RUnlock()
+
+	if callback == nil {
+		return nil
+	}
+
+	update := &AccountUpdateMessage{
+		Reason:    event.Account.Reason,
+		Timestamp: time.UnixMilli(event.EventTime),
+	}
+
+	for _, balance := range event.Account.Balances {
+		update.Balances = append(update.Balances, BalanceUpdate{
+			Asset:              balance.Asset,
+			WalletBalance:      parseDecimal(balance.WalletBalance),
+			CrossWalletBalance: parseDecimal(balance.CrossWalletBalance),
+		})
+	}
+
+	for _, position := range event.Account.Positions {
+		update.Positions = append(update.Positions, PositionUpdate{
+			Symbol:        position.Symbol,
+			Amount:        parseDecimal(position.Amount),
+			EntryPrice:    parseDecimal(position.EntryPrice),
+			UnrealizedPnL: parseDecimal(position.UnrealizedPnL),
+			MarginType:    strings.ToUpper(position.MarginType),
+		})
+	}
+
+	callback(update)
+	return nil
+}
+
+func (r *realTimeService) onError(err error) {
+	select {
+	case r.errorCh <- err:
+	default:
+	}
+}
+
+func (r *realTimeService) newConnection(url string) connection.ConnectionManager {
+	connConfig := connection.TradingConfig(url)
+	authManager := security.NewAuthManager(&publicAuthProvider{}, r.logger)
+
+	return connection.NewConnectionManager(
+		connConfig,
+		authManager,
+		performance.NewMetrics(),
+		r.logger,
+		connection.NewGorillaDialer(connConfig),
+	)
+}
+
+// publicAuthProvider satisfies the auth manager; Binance authenticates streams via the URL
+type publicAuthProvider struct{}
+
+func (p *publicAuthProvider) GetAuthHeaders(_ context.Context) (http.Header, error) {
+	return make(http.Header), nil
+}
+
+func (p *publicAuthProvider) IsAuthenticated() bool {
+	return true
+}
+
+func (p *publicAuthProvider) Refresh(_ context.Context) error {
+	return nil
+}
+
+func (p *publicAuthProvider) GetTokenExpiry() time.Time {
+	return time.Now().Add(24 * time.Hour)
+}
+
+func depthStream(symbol string) string {
+	return strings.ToLower(symbol) + "@depth20@100ms"
+}
+
+func tradeStream(symbol string) string {
+	return strings.ToLower(symbol) + "@aggTrade"
+}
+
+func klineStream(symbol, interval string) string {
+	return strings.ToLower(symbol) + "@kline_" + interval
+}
+
+func markPriceStream(symbol string) string {
+	return strings.ToLower(symbol) + "@markPrice@1s"
+}
+
+func parseDecimal(value string) numerical.Decimal {
+	if value == "" {
+		return numerical.Zero()
+	}
+
+	d, err := numerical.NewFromString(value)
+	if err != nil {
+		return numerical.Zero()
+	}
+
+	return d
+}
@@ -0,0 +1,144 @@
+package real_time
+
+import (
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+)
+
+// OrderBookMessage is a partial depth snapshot from the <symbol>@depth20 stream
+type OrderBookMessage struct {
+	Symbol    string
+	Bids      []connector.PriceLevel
+	Asks      []connector.PriceLevel
+	Timestamp time.Time
+}
+
+// TradeMessage is an aggregated trade from the <symbol>@aggTrade stream
+type TradeMessage struct {
+	Symbol    string
+	ID        string
+	Price     numerical.Decimal
+	Quantity  numerical.Decimal
+	Side      connector.OrderSide
+	Timestamp time.Time
+}
+
+// KlineMessage is a candle update from the <symbol>@kline_<interval> stream
+type KlineMessage struct {
+	Symbol      string
+	Interval    string
+	OpenTime    time.Time
+	CloseTime   time.Time
+	Open        numerical.Decimal
+	High        numerical.Decimal
+	Low         numerical.Decimal
+	Close       numerical.Decimal
+	Volume      numerical.Decimal
+	QuoteVolume numerical.Decimal
+	TradeCount  int
+	Closed      bool
+}
+
+// MarkPriceMessage is an update from the <symbol>@markPrice@1s stream
+type MarkPriceMessage struct {
+	Symbol          string
+	MarkPrice       numerical.Decimal
+	IndexPrice      numerical.Decimal
+	FundingRate     numerical.Decimal
+	NextFundingTime time.Time
+	Timestamp       time.Time
+}
+
+// BalanceUpdate is a single asset balance inside an ACCOUNT_UPDATE event
+type BalanceUpdate struct {
+	Asset              string
+	WalletBalance      numerical.Decimal
+	CrossWalletBalance numerical.Decimal
+}
+
+// PositionUpdate is a single position inside an ACCOUNT_UPDATE event
+type PositionUpdate struct {
+	Symbol        string
+	Amount        numerical.Decimal
+	EntryPrice    numerical.Decimal
+	UnrealizedPnL numerical.Decimal
+	MarginType    string
+}
+
+// AccountUpdateMessage is an ACCOUNT_UPDATE event from the user data stream
+type AccountUpdateMessage struct {
+	Reason    string
+	Balances  []BalanceUpdate
+	Positions []PositionUpdate
+	Timestamp time.Time
+}
+
+// streamEvent carries the fields shared by every market stream payload
+type streamEvent struct {
+	EventType string `json:"e"`
+	EventTime int64  `json:"E"`
+	Symbol    string `json:"s"`
+}
+
+type depthEvent struct {
+	streamEvent
+	TransactionTime int64      `json:"T"`
+	Bids            [][]string `json:"b"`
+	Asks            [][]string `json:"a"`
+}
+
+type aggTradeEvent struct {
+	streamEvent
+	AggregateID  int64  `json:"a"`
+	Price        string `json:"p"`
+	Quantity     string `json:"q"`
+	TradeTime    int64  `json:"T"`
+	IsBuyerMaker bool   `json:"m"`
+}
+
+type klineEvent struct {
+	streamEvent
+	Kline struct {
+		OpenTime    int64  `json:"t"`
+		CloseTime   int64  `json:"T"`
+		Interval    string `json:"i"`
+		Open        string `json:"o"`
+		Close       string `json:"c"`
+		High        string `json:"h"`
+		Low         string `json:"l"`
+		Volume      string `json:"v"`
+		TradeCount  int    `json:"n"`
+		Closed      bool   `json:"x"`
+		QuoteVolume string `json:"q"`
+	} `json:"k"`
+}
+
+type markPriceEvent struct {
+	streamEvent
+	MarkPrice       string `json:"p"`
+	IndexPrice      string `json:"i"`
+	FundingRate     string `json:"r"`
+	NextFundingTime int64  `json:"T"`
+}
+
+type accountUpdateEvent struct {
+	EventType string `json:"e"`
+	EventTime int64  `json:"E"`
+	Account   struct {
+		Reason   string `json:"m"`
+		Balances []struct {
+			Asset              string `json:"a"`
+			WalletBalance      string `json:"wb"`
+			CrossWalletBalance string `json:"cw"`
+		} `json:"B"`
+		Positions []struct {
+			Symbol        string `json:"s"`
+			Amount        string `json:"pa"`
+			EntryPrice    string `json:"ep"`
+			UnrealizedPnL string `json:"up"`
+			MarginType    string `json:"mt"`
+		} `json:"P"`
+	} `json:"a"`
+}
@@ -0,0 +1,498 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/binance/adaptor"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+const quoteAsset = "USDT"
+
+// validDepths are the order book limits accepted by /fapi/v1/depth
+var validDepths = []int{5, 10, 20, 50, 100, 500, 1000}
+
+type MarketDataService interface {
+	FetchKlines(instrument connector.Instrument, symbol, interval string, limit int) ([]connector.Kline, error)
+	FetchKlinesRange(symbol, interval string, start, end time.Time, limit int) ([]connector.Kline, error)
+	FetchPrice(instrument connector.Instrument, symbol string) (*connector.Price, error)
+	FetchOrderBook(instrument connector.Instrument, symbol string, depth int) (*connector.OrderBook, error)
+	FetchRecentTrades(instrument connector.Instrument, symbol string, limit int) ([]connector.Trade, error)
+	FetchFundingRate(symbol string) (*connector.FundingRate, error)
+	FetchCurrentFundingRates() (map[portfolio.Asset]connector.FundingRate, error)
+	FetchHistoricalFundingRates(symbol string, startTime, endTime int64) ([]connector.HistoricalFundingRate, error)
+	FetchContracts() ([]connector.ContractInfo, error)
+	FetchAvailablePerpetualAssets() ([]portfolio.Asset, error)
+	FetchInstrumentStatuses() ([]types.InstrumentStatus, error)
+	FetchServerTime() (time.Time, error)
+}
+
+type marketDataService struct {
+	client       adaptor.Client
+	timeProvider temporal.TimeProvider
+}
+
+func NewMarketDataService(client adaptor.Client, timeProvider temporal.TimeProvider) MarketDataService {
+	return &marketDataService{
+		client:       client,
+		timeProvider: timeProvider,
+	}
+}
+
+// premiumIndex mirrors the /fapi/v1/premiumIndex payload
+type premiumIndex struct {
+	Symbol          string `json:"symbol"`
+	MarkPrice       string `json:"markPrice"`
+	IndexPrice      string `json:"indexPrice"`
+	LastFundingRate string `json:"lastFundingRate"`
+	NextFundingTime int64  `json:"nextFundingTime"`
+	Time            int64  `json:"time"`
+}
+
+// exchangeSymbol mirrors a symbol entry of /fapi/v1/exchangeInfo
+type exchangeSymbol struct {
+	Symbol       string `json:"symbol"`
+	ContractType string `json:"contractType"`
+	Status       string `json:"status"`
+	DeliveryDate int64  `json:"deliveryDate"`
+	BaseAsset    string `json:"baseAsset"`
+	QuoteAsset   string `json:"quoteAsset"`
+	Filters      []struct {
+		FilterType string `json:"filterType"`
+		TickSize   string `json:"tickSize"`
+		StepSize   string `json:"stepSize"`
+		MinQty     string `json:"minQty"`
+		MaxQty     string `json:"maxQty"`
+	} `json:"filters"`
+}
+
+func (m *marketDataService) FetchKlines(instrument connector.Instrument, symbol, interval string, limit int) ([]connector.Kline, error) {
+	params := url.Values{}
+	params.Set("symbol", symbol)
+	params.Set("interval", interval)
+	if limit > 0 {
+		params.Set("limit", strconv.Itoa(limit))
+	}
+
+	return m.fetchKlines(instrument, symbol, interval, params)
+}
+
+// FetchKlinesRange returns klines opening within [start, end], oldest first
+func (m *marketDataService) FetchKlinesRange(symbol, interval string, start, end time.Time, limit int) ([]connector.Kline, error) {
+	params := url.Values{}
+	params.Set("symbol", symbol)
+	params.Set("interval", interval)
+	params.Set("startTime", strconv.FormatInt(start.UnixMilli(), 10))
+	params.Set("endTime", strconv.FormatInt(end.UnixMilli(), 10))
+	if limit > 0 {
+		params.Set("limit", strconv.Itoa(limit))
+	}
+
+	return m.fetchKlines(connector.TypePerpetual, symbol, interval, params)
+}
+
+func (m *marketDataService) fetchKlines(instrument connector.Instrument, symbol, interval string, params url.Values) ([]connector.Kline, error) {
+	var result [][]json.RawMessage
+	if err := m.client.Public(context.Background(), http.MethodGet, marketPath(instrument, "klines"), params, &result); err != nil {
+		return nil, fmt.Errorf("failed to fetch klines: %w", err)
+	}
+
+	klines := make([]connector.Kline, 0, len(result))
+	for _, row := range result {
+		if len(row) < 11 {
+			continue
+		}
+
+		klines = append(klines, connector.Kline{
+			Symbol:      symbol,
+			Interval:    interval,
+			OpenTime:    time.UnixMilli(rawInt(row[0])),
+			Open:        rawDecimal(row[1]),
+			High:        rawDecimal(row[2]),
+			Low:         rawDecimal(row[3]),
+			Close:       rawDecimal(row[4]),
+			Volume:      rawDecimal(row[5]),
+			CloseTime:   time.UnixMilli(rawInt(row[6])),
+			QuoteVolume: rawDecimal(row[7]),
+			TradeCount:  int(rawInt(row[8])),
+			TakerVolume: rawDecimal(row[9]),
+		})
+	}
+
+	return klines, nil
+}
+
+func (m *marketDataService) FetchPrice(instrument connector.Instrument, symbol string) (*connector.Price, error) {
+	params := url.Values{}
+	params.Set("symbol", symbol)
+
+	var ticker struct {
+		Symbol             string `json:"symbol"`
+		LastPrice          string `json:"lastPrice"`
+		Volume             string `json:"volume"`
+		PriceChangePercent string `json:"priceChangePercent"`
+		CloseTime          int64  `json:"closeTime"`
+	}
+	if err := m.client.Public(context.Background(), http.MethodGet, marketPath(instrument, "ticker/24hr"), params, &ticker); err != nil {
+		return nil, fmt.Errorf("failed to fetch price: %w", err)
+	}
+
+	var book struct {
+		BidPrice string `json:"bidPrice"`
+		AskPrice string `json:"askPrice"`
+	}
+	if err := m.client.Public(context.Background(), http.MethodGet, marketPath(instrument, "ticker/bookTicker"), params, &book); err != nil {
+		return nil, fmt.Errorf("failed to fetch book ticker: %w", err)
+	}
+
+	return &connector.Price{
+		Symbol:    symbol,
+		Price:     parseDecimal(ticker.LastPrice),
+		BidPrice:  parseDecimal(book.BidPrice),
+		AskPrice:  parseDecimal(book.AskPrice),
+		Volume24h: parseDecimal(ticker.Volume),
+		Change24h: parseDecimal(ticker.PriceChangePercent),
+		Source:    types.Binance,
+		Timestamp: m.timeProvider.Now(),
+	}, nil
+}
+
+func (m *marketDataService) FetchOrderBook(instrument connector.Instrument, symbol string, depth int) (*connector.OrderBook, error) {
+	params := url.Values{}
+	params.Set("symbol", symbol)
+	params.Set("limit", strconv.Itoa(normaliseDepth(depth)))
+
+	var result struct {
+		LastUpdateID int64      `json:"lastUpdateId"`
+		Time         int64      `json:"T"`
+		Bids         [][]string `json:"bids"`
+		Asks         [][]string `json:"asks"`
+	}
+	if err := m.client.Public(context.Background(), http.MethodGet, marketPath(instrument, "depth"), params, &result); err != nil {
+		return nil, fmt.Errorf("failed to fetch orderbook: %w", err)
+	}
+
+	timestamp := m.timeProvider.Now()
+	if result.Time > 0 {
+		timestamp = time.UnixMilli(result.Time)
+	}
+
+	return &connector.OrderBook{
+		Asset:     portfolio.NewAsset(strings.TrimSuffix(symbol, quoteAsset)),
+		Bids:      ParseLevels(result.Bids),
+		Asks:      ParseLevels(result.Asks),
+		Timestamp: timestamp,
+	}, nil
+}
+
+func (m *marketDataService) FetchRecentTrades(instrument connector.Instrument, symbol string, limit int) ([]connector.Trade, error) {
+	params := url.Values{}
+	params.Set("symbol", symbol)
+	if limit > 0 {
+		params.Set("limit", strconv.Itoa(limit))
+	}
+
+	var result []struct {
+		ID           int64  `json:"id"`
+		Price        string `json:"price"`
+		Qty          string `json:"qty"`
+		Time         int64  `json:"time"`
+		IsBuyerMaker bool   `json:"isBuyerMaker"`
+	}
+	if err := m.client.Public(context.Background(), http.MethodGet, marketPath(instrument, "trades"), params, &result); err != nil {
+		return nil, fmt.Errorf("failed to fetch recent trades: %w", err)
+	}
+
+	trades := make([]connector.Trade, 0, len(result))
+	for _, trade := range result {
+		trades = append(trades, connector.Trade{
+			ID:        strconv.FormatInt(trade.ID, 10),
+			Symbol:    symbol,
+			Exchange:  types.Binance,
+			Price:     parseDecimal(trade.Price),
+			Quantity:  parseDecimal(trade.Qty),
+			Side:      TakerSide(trade.IsBuyerMaker),
+			Timestamp: time.UnixMilli(trade.Time),
+		})
+	}
+
+	return trades, nil
+}
+
+func (m *marketDataService) FetchFundingRate(symbol string) (*connector.FundingRate, error) {
+	params := url.Values{}
+	params.Set("symbol", symbol)
+
+	var result premiumIndex
+	if err := m.client.Public(context.Background(), http.MethodGet, "/fapi/v1/premiumIndex", params, &result); err != nil {
+		return nil, fmt.Errorf("failed to fetch funding rate: %w", err)
+	}
+
+	rate := toFundingRate(result)
+	return &rate, nil
+}
+
+func (m *marketDataService) FetchCurrentFundingRates() (map[portfolio.Asset]connector.FundingRate, error) {
+	var result []premiumIndex
+	if err := m.client.Public(context.Background(), http.MethodGet, "/fapi/v1/premiumIndex", nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to fetch funding rates: %w", err)
+	}
+
+	rates := make(map[portfolio.Asset]connector.FundingRate, len(result))
+	for _, index := range result {
+		if !strings.HasSuffix(index.Symbol, quoteAsset) {
+			continue
+		}
+		rates[portfolio.NewAsset(strings.TrimSuffix(index.Symbol, quoteAsset))] = toFundingRate(index)
+	}
+
+	return rates, nil
+}
+
+func (m *marketDataService) FetchHistoricalFundingRates(symbol string, startTime, endTime int64) ([]connector.HistoricalFundingRate, error) {
+	params := url.Values{}
+	params.Set("symbol", symbol)
+	params.Set("limit", "1000")
+	if startTime > 0 {
+		params.Set("startTime", strconv.FormatInt(startTime, 10))
+	}
+	if endTime > 0 {
+		params.Set("endTime", strconv.FormatInt(endTime, 10))
+	}
+
+	var result []struct {
+		FundingRate string `json:"fundingRate"`
+		FundingTime int64  `json:"fundingTime"`
+	}
+	if err := m.client.Public(context.Background(), http.MethodGet, "/fapi/v1/fundingRate", params, &result); err != nil {
+		return nil, fmt.Errorf("failed to fetch historical funding rates: %w", err)
+	}
+
+	rates := make([]connector.HistoricalFundingRate, 0, len(result))
+	for _, rate := range result {
+		rates = append(rates, connector.HistoricalFundingRate{
+			FundingRate: parseDecimal(rate.FundingRate),
+			Timestamp:   time.UnixMilli(rate.FundingTime),
+		})
+	}
+
+	return rates, nil
+}
+
+func (m *marketDataService) FetchContracts() ([]connector.ContractInfo, error) {
+	symbols, err := m.fetchExchangeSymbols()
+	if err != nil {
+		return nil, err
+	}
+
+	now := m.timeProvider.Now()
+	contracts := make([]connector.ContractInfo, 0, len(symbols))
+	for _, symbol := range symbols {
+		contract := connector.ContractInfo{
+			Symbol:       symbol.Symbol,
+			BaseAsset:    symbol.BaseAsset,
+			QuoteAsset:   symbol.QuoteAsset,
+			ContractType: symbol.ContractType,
+			Status:       symbol.Status,
+			UpdatedAt:    now,
+		}
+
+		for _, filter := range symbol.Filters {
+			switch filter.FilterType {
+			case "PRICE_FILTER":
+				contract.TickSize = parseDecimal(filter.TickSize)
+			case "LOT_SIZE":
+				contract.StepSize = parseDecimal(filter.StepSize)
+				contract.MinOrderSize = parseDecimal(filter.MinQty)
+				contract.MaxOrderSize = parseDecimal(filter.MaxQty)
+			}
+		}
+
+		contracts = append(contracts, contract)
+	}
+
+	return contracts, nil
+}
+
+func (m *marketDataService) FetchAvailablePerpetualAssets() ([]portfolio.Asset, error) {
+	symbols, err := m.fetchExchangeSymbols()
+	if err != nil {
+		return nil, err
+	}
+
+	assets := make([]portfolio.Asset, 0, len(symbols))
+	for _, symbol := range symbols {
+		if symbol.ContractType == "PERPETUAL" && symbol.Status == "TRADING" && symbol.QuoteAsset == quoteAsset {
+			assets = append(assets, portfolio.NewAsset(symbol.BaseAsset))
+		}
+	}
+
+	return assets, nil
+}
+
+// perpetualDeliveryYear is the placeholder delivery date Binance gives
+// perpetuals that have no scheduled delisting
+const perpetualDeliveryYear = 2100
+
+func (m *marketDataService) FetchInstrumentStatuses() ([]types.InstrumentStatus, error) {
+	symbols, err := m.fetchExchangeSymbols()
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]types.InstrumentStatus, 0, len(symbols))
+	for _, symbol := range symbols {
+		status := types.InstrumentStatus{
+			Symbol:      symbol.Symbol,
+			State:       instrumentState(symbol.Status),
+			RawStatus:   symbol.Status,
+			MaxLeverage: numerical.Zero(),
+		}
+		if symbol.DeliveryDate > 0 {
+			if delivery := time.UnixMilli(symbol.DeliveryDate).UTC(); delivery.Year() < perpetualDeliveryYear {
+				status.DeliveryAt = delivery
+			}
+		}
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+// instrumentState maps /fapi/v1/exchangeInfo contract statuses
+func instrumentState(status string) types.InstrumentState {
+	switch status {
+	case "TRADING":
+		return types.InstrumentTrading
+	case "PENDING_TRADING", "PRE_TRADING", "BREAK":
+		return types.InstrumentSuspended
+	case "PRE_DELIVERING", "DELIVERING", "PRE_SETTLE", "SETTLING":
+		return types.InstrumentDelisting
+	case "DELIVERED", "CLOSE":
+		return types.InstrumentDelisted
+	}
+	return types.InstrumentUnknown
+}
+
+func (m *marketDataService) fetchExchangeSymbols() ([]exchangeSymbol, error) {
+	var result struct {
+		Symbols []exchangeSymbol `json:"symbols"`
+	}
+	if err := m.client.Public(context.Background(), http.MethodGet, "/fapi/v1/exchangeInfo", nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to fetch exchange info: %w", err)
+	}
+
+	return result.Symbols, nil
+}
+
+// marketPath returns the spot or USDⓈ-M futures path of a public market
+// endpoint; both return the same payloads for the ones used here
+func marketPath(instrument connector.Instrument, endpoint string) string {
+	if instrument == connector.TypeSpot {
+		return "/api/v3/" + endpoint
+	}
+	return "/fapi/v1/" + endpoint
+}
+
+func toFundingRate(index premiumIndex) connector.FundingRate {
+	markPrice := parseDecimal(index.MarkPrice)
+	indexPrice := parseDecimal(index.IndexPrice)
+
+	premium := numerical.Zero()
+	if indexPrice.IsPositive() {
+		premium = markPrice.Sub(indexPrice).Div(indexPrice)
+	}
+
+	return connector.FundingRate{
+		CurrentRate:     parseDecimal(index.LastFundingRate),
+		NextFundingTime: time.UnixMilli(index.NextFundingTime),
+		Timestamp:       time.UnixMilli(index.Time),
+		MarkPrice:       markPrice,
+		IndexPrice:      indexPrice,
+		Premium:         premium,
+	}
+}
+
+// ParseLevels converts Binance [price, quantity] string pairs into price levels
+func ParseLevels(levels [][]string) []connector.PriceLevel {
+	result := make([]connector.PriceLevel, 0, len(levels))
+	for _, level := range levels {
+		if len(level) < 2 {
+			continue
+		}
+		result = append(result, connector.PriceLevel{
+			Price:    parseDecimal(level[0]),
+			Quantity: parseDecimal(level[1]),
+		})
+	}
+	return result
+}
+
+// TakerSide derives the aggressor side from Binance's buyer-is-maker flag
+func TakerSide(isBuyerMaker bool) connector.OrderSide {
+	if isBuyerMaker {
+		return connector.OrderSideSell
+	}
+	return connector.OrderSideBuy
+}
+
+func normaliseDepth(depth int) int {
+	for _, valid := range validDepths {
+		if depth <= valid {
+			return valid
+		}
+	}
+	return validDepths[len(validDepths)-1]
+}
+
+// FetchServerTime reads the futures clock, which the recvWindow check on
+// signed requests is measured against
+func (m *marketDataService) FetchServerTime() (time.Time, error) {
+	var result struct {
+		ServerTime int64 `json:"serverTime"`
+	}
+	if err := m.client.Public(context.Background(), http.MethodGet, "/fapi/v1/time", nil, &result); err != nil {
+		return time.Time{}, fmt.Errorf("failed to get server time: %w", err)
+	}
+	return time.UnixMilli(result.ServerTime), nil
+}
+
+func rawInt(raw json.RawMessage) int64 {
+	var value int64
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return 0
+	}
+	return value
+}
+
+func rawDecimal(raw json.RawMessage) numerical.Decimal {
+	var value string
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return numerical.Zero()
+	}
+	return parseDecimal(value)
+}
+
+func parseDecimal(value string) numerical.Decimal {
+	if value == "" {
+		return numerical.Zero()
+	}
+
+	d, err := numerical.NewFromString(value)
+	if err != nil {
+		return numerical.Zero()
+	}
+
+	return d
+}
@@ -0,0 +1,39 @@
+package binance
+
+import (
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+// historyWindow is the widest range /fapi/v1/userTrades accepts
+const historyWindow = 7 * 24 * time.Hour
+
+var _ types.TradeHistoryProvider = (*binance)(nil)
+
+// FetchTradingHistorySince walks forward a window at a time until one holds
+// fills, so a long quiet stretch does not read as the end of history
+func (b *binance) FetchTradingHistorySince(symbol string, since time.Time, limit int) ([]connector.Trade, error) {
+	now := b.timeProvider.Now()
+	for start := since; start.Before(now); start = start.Add(historyWindow) {
+		end := start.Add(historyWindow - time.Millisecond)
+		if end.After(now) {
+			end = now
+		}
+
+		if err := b.limiter.Wait(ratelimit.EndpointTrades); err != nil {
+			return nil, err
+		}
+		trades, err := b.trading.GetTradingHistoryRange(connector.TypePerpetual, symbol, start, end, limit)
+		if err != nil {
+			return nil, err
+		}
+		if len(trades) > 0 {
+			return trades, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,15 @@
+package binance
+
+import (
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+var _ types.InstrumentStatusProvider = (*binance)(nil)
+
+func (b *binance) FetchInstrumentStatuses() ([]types.InstrumentStatus, error) {
+	if err := b.limiter.Wait(ratelimit.EndpointInstruments); err != nil {
+		return nil, err
+	}
+	return b.marketData.FetchInstrumentStatuses()
+}
@@ -0,0 +1,74 @@
+package binance
+
+import (
+	"fmt"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/live-trading/pkg/connectors/binance/data/real_time"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+var _ types.MarkPriceProvider = (*binance)(nil)
+var _ types.MarkPriceStreamer = (*binance)(nil)
+
+// FetchMarkPrice reads mark and index from /fapi/v1/premiumIndex
+func (b *binance) FetchMarkPrice(asset portfolio.Asset) (*types.MarkPrice, error) {
+	if err := b.limiter.Wait(ratelimit.EndpointPrice); err != nil {
+		return nil, err
+	}
+	if !b.initialized {
+		return nil, fmt.Errorf("connector not initialized")
+	}
+
+	rate, err := b.marketData.FetchFundingRate(b.GetPerpSymbol(asset))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch mark price: %w", err)
+	}
+
+	return &types.MarkPrice{
+		Exchange:   types.Binance,
+		Asset:      asset,
+		MarkPrice:  rate.MarkPrice,
+		IndexPrice: rate.IndexPrice,
+		Timestamp:  rate.Timestamp,
+	}, nil
+}
+
+// SubscribeMarkPrice streams mark and index once a second
+func (b *binance) SubscribeMarkPrice(asset portfolio.Asset) error {
+	if !b.initialized {
+		return fmt.Errorf("connector not initialized")
+	}
+
+	symbol := b.GetPerpSymbol(asset)
+
+	return b.realTime.SubscribeMarkPrice(symbol, func(msg *real_time.MarkPriceMessage) {
+		select {
+		case b.markPriceCh <- types.MarkPrice{
+			Exchange:   types.Binance,
+			Asset:      asset,
+			MarkPrice:  msg.MarkPrice,
+			IndexPrice: msg.IndexPrice,
+			Timestamp:  msg.Timestamp,
+		}:
+		default:
+			select {
+			case b.errorCh <- fmt.Errorf("mark price channel full for %s, dropping update", symbol):
+			default:
+			}
+		}
+	})
+}
+
+func (b *binance) UnsubscribeMarkPrice(asset portfolio.Asset) error {
+	if !b.initialized {
+		return fmt.Errorf("connector not initialized")
+	}
+
+	return b.realTime.UnsubscribeMarkPrice(b.GetPerpSymbol(asset))
+}
+
+func (b *binance) MarkPriceUpdates() <-chan types.MarkPrice {
+	return b.markPriceCh
+}
@@ -0,0 +1,55 @@
+package binance
+
+import (
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+var _ types.DepthSnapshotProvider = (*binance)(nil)
+
+func (b *binance) FetchKlines(symbol, interval string, limit int) ([]connector.Kline, error) {
+	if err := b.limiter.Wait(ratelimit.EndpointKlines); err != nil {
+		return nil, err
+	}
+	return b.marketData.FetchKlines(connector.TypePerpetual, symbol, interval, limit)
+}
+
+func (b *binance) FetchPrice(symbol string) (*connector.Price, error) {
+	if err := b.limiter.Wait(ratelimit.EndpointPrice); err != nil {
+		return nil, err
+	}
+	return b.marketData.FetchPrice(connector.TypePerpetual, symbol)
+}
+
+func (b *binance) FetchOrderBook(asset portfolio.Asset, instrument connector.Instrument, depth int) (*connector.OrderBook, error) {
+	symbol, err := symbolRule.ToNative(asset, instrument)
+	if err != nil {
+		return nil, err
+	}
+	if err := b.limiter.Wait(ratelimit.EndpointOrderBook); err != nil {
+		return nil, err
+	}
+	return b.marketData.FetchOrderBook(instrument, symbol, depth)
+}
+
+func (b *binance) FetchRecentTrades(symbol string, limit int) ([]connector.Trade, error) {
+	if err := b.limiter.Wait(ratelimit.EndpointTrades); err != nil {
+		return nil, err
+	}
+	return b.marketData.FetchRecentTrades(connector.TypePerpetual, symbol, limit)
+}
+
+func (b *binance) FetchFundingRate(asset portfolio.Asset) (*connector.FundingRate, error) {
+	if err := b.limiter.Wait(ratelimit.EndpointFunding); err != nil {
+		return nil, err
+	}
+	return b.marketData.FetchFundingRate(b.GetPerpSymbol(asset))
+}
+
+// MaxSnapshotDepth is the largest limit /depth accepts; deeper requests are
+// rounded down to it
+func (b *binance) MaxSnapshotDepth(_ connector.Instrument) int {
+	return 1000
+}
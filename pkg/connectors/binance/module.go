@@ -0,0 +1,42 @@
+package binance
+
+import (
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/live-trading/pkg/connectors/binance/adaptor"
+	"github.com/backtesting-org/live-trading/pkg/connectors/binance/data"
+	"github.com/backtesting-org/live-trading/pkg/connectors/binance/data/real_time"
+	"github.com/backtesting-org/live-trading/pkg/connectors/binance/trading"
+	"github.com/backtesting-org/live-trading/pkg/connectors/symbols"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+	"go.uber.org/fx"
+)
+
+// symbolRule maps assets to USDⓈ-M perpetual and spot symbols, which share
+// the USDT suffix, e.g. BTC -> BTCUSDT
+var symbolRule = symbols.NewSuffixRule(map[connector.Instrument]string{
+	connector.TypePerpetual: "USDT",
+	connector.TypeSpot:      "USDT",
+})
+
+var Module = fx.Module("binance",
+	fx.Provide(
+		adaptor.NewClient,
+		trading.NewTradingService,
+		data.NewMarketDataService,
+		real_time.NewRealTimeService,
+		fx.Annotate(
+			NewBinance,
+			fx.ResultTags(`name:"binance"`),
+		),
+	),
+	fx.Invoke(fx.Annotate(
+		registerBinance,
+		fx.ParamTags(`name:"binance"`),
+	)),
+)
+
+func registerBinance(binanceConn connector.Connector, reg registry.ConnectorRegistry, mapper symbols.SymbolMapper) {
+	mapper.Register(types.Binance, symbolRule)
+	reg.RegisterConnector(types.Binance, binanceConn)
+}
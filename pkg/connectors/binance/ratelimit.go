@@ -0,0 +1,9 @@
+package binance
+
+import "github.com/backtesting-org/live-trading/pkg/connectors/types"
+
+var _ types.RateLimitProvider = (*binance)(nil)
+
+func (b *binance) RateLimitStatus() types.RateLimitStatus {
+	return b.limiter.Status()
+}
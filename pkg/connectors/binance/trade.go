@@ -0,0 +1,98 @@
+package binance
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+func (b *binance) PlaceLimitOrder(symbol string, side connector.OrderSide, quantity, price numerical.Decimal) (*connector.OrderResponse, error) {
+	if err := b.limiter.Wait(ratelimit.EndpointPlaceOrder); err != nil {
+		return nil, err
+	}
+	if !b.SupportsTradingOperations() {
+		return nil, fmt.Errorf("trading operations not supported")
+	}
+
+	resp, err := b.trading.PlaceLimitOrder(connector.TypePerpetual, symbol, side, quantity, price)
+	if err != nil {
+		return nil, b.wrapOrderError(symbol, side, quantity, price, err)
+	}
+	return resp, nil
+}
+
+func (b *binance) PlaceMarketOrder(symbol string, side connector.OrderSide, quantity numerical.Decimal) (*connector.OrderResponse, error) {
+	if err := b.limiter.Wait(ratelimit.EndpointPlaceOrder); err != nil {
+		return nil, err
+	}
+	if !b.SupportsTradingOperations() {
+		return nil, fmt.Errorf("trading operations not supported")
+	}
+
+	resp, err := b.trading.PlaceMarketOrder(connector.TypePerpetual, symbol, side, quantity)
+	if err != nil {
+		return nil, b.wrapOrderError(symbol, side, quantity, numerical.Zero(), err)
+	}
+	return resp, nil
+}
+
+func (b *binance) CancelOrder(symbol, orderID string) (*connector.CancelResponse, error) {
+	if err := b.limiter.Wait(ratelimit.EndpointCancelOrder); err != nil {
+		return nil, err
+	}
+	if !b.SupportsTradingOperations() {
+		return nil, fmt.Errorf("trading operations not supported")
+	}
+	return b.trading.CancelOrder(connector.TypePerpetual, symbol, orderID)
+}
+
+func (b *binance) GetOpenOrders() ([]connector.Order, error) {
+	if err := b.limiter.Wait(ratelimit.EndpointOrders); err != nil {
+		return nil, err
+	}
+	return b.trading.GetOpenOrders(connector.TypePerpetual)
+}
+
+func (b *binance) GetOrderStatus(orderID string) (*connector.Order, error) {
+	if err := b.limiter.Wait(ratelimit.EndpointOrders); err != nil {
+		return nil, err
+	}
+	return b.trading.GetOrderStatus(connector.TypePerpetual, orderID)
+}
+
+// wrapOrderError categorises order rejections, and tags insufficient-balance
+// ones with the balance at rejection time
+func (b *binance) wrapOrderError(symbol string, side connector.OrderSide, quantity, price numerical.Decimal, err error) error {
+	if !types.IsInsufficientBalance(err) {
+		return types.NewConnectorError(types.Binance, "place order", err)
+	}
+
+	rejection := &types.InsufficientBalanceError{
+		Exchange: types.Binance,
+		Symbol:   symbol,
+		Side:     side,
+		Quantity: quantity,
+		Price:    price,
+		Err:      err,
+	}
+	if balance, balanceErr := b.GetAccountBalance(); balanceErr == nil && balance != nil {
+		rejection.Available = balance.AvailableBalance
+	}
+
+	b.appLogger.Warn("Binance rejected order for insufficient balance: %s", rejection.Error())
+	return rejection
+}
+
+var _ types.OrderPayloadSource = (*binance)(nil)
+
+// OrderPayload returns Binance's last response about the order
+func (b *binance) OrderPayload(orderID string) (json.RawMessage, bool) {
+	if !b.SupportsTradingOperations() {
+		return nil, false
+	}
+	return b.trading.OrderPayload(orderID)
+}
@@ -0,0 +1,645 @@
+package trading
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/binance/adaptor"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+type TradingService interface {
+	PlaceLimitOrder(instrument connector.Instrument, symbol string, side connector.OrderSide, quantity, price numerical.Decimal) (*connector.OrderResponse, error)
+	PlaceMarketOrder(instrument connector.Instrument, symbol string, side connector.OrderSide, quantity numerical.Decimal) (*connector.OrderResponse, error)
+	CancelOrder(instrument connector.Instrument, symbol, orderID string) (*connector.CancelResponse, error)
+	GetOpenOrders(instrument connector.Instrument) ([]connector.Order, error)
+	GetOrderStatus(instrument connector.Instrument, orderID string) (*connector.Order, error)
+	GetAccountBalance() (*connector.AccountBalance, error)
+	GetSpotBalances() ([]types.SpotBalance, error)
+	GetPositions() ([]connector.Position, error)
+	GetTradingHistory(instrument connector.Instrument, symbol string, limit int) ([]connector.Trade, error)
+	GetTradingHistoryRange(instrument connector.Instrument, symbol string, start, end time.Time, limit int) ([]connector.Trade, error)
+	GetTransferIncome(since time.Time) ([]types.TransferEvent, error)
+	GetAPIKeyPermissions() (*types.APIKeyPermissions, error)
+
+	// OrderPayload is Binance's own response about the order, as last seen
+	OrderPayload(orderID string) (json.RawMessage, bool)
+}
+
+type tradingService struct {
+	client       adaptor.Client
+	timeProvider temporal.TimeProvider
+
+	// symbols remembers the symbol of every order placed or seen open, so
+	// a bare order ID can still be queried once it has left the open
+	// orders; entries are dropped when the order is seen finished
+	symbols map[string]string
+	mu      sync.Mutex
+
+	payloads *types.OrderPayloads
+}
+
+func NewTradingService(client adaptor.Client, timeProvider temporal.TimeProvider) TradingService {
+	return &tradingService{
+		client:       client,
+		timeProvider: timeProvider,
+		symbols:      make(map[string]string),
+		payloads:     types.NewOrderPayloads(types.DefaultPayloadCapacity),
+	}
+}
+
+// orderResponse mirrors the order payload returned by the order and
+// openOrders endpoints. Spot omits avgPrice and reports the quote amount
+// filled instead.
+type orderResponse struct {
+	OrderID            int64  `json:"orderId"`
+	ClientOrderID      string `json:"clientOrderId"`
+	Symbol             string `json:"symbol"`
+	Status             string `json:"status"`
+	Side               string `json:"side"`
+	Type               string `json:"type"`
+	Price              string `json:"price"`
+	AvgPrice           string `json:"avgPrice"`
+	OrigQty            string `json:"origQty"`
+	ExecutedQty        string `json:"executedQty"`
+	CumulativeQuoteQty string `json:"cummulativeQuoteQty"`
+	Time               int64  `json:"time"`
+	UpdateTime         int64  `json:"updateTime"`
+}
+
+func (t *tradingService) PlaceLimitOrder(instrument connector.Instrument, symbol string, side connector.OrderSide, quantity, price numerical.Decimal) (*connector.OrderResponse, error) {
+	params := url.Values{}
+	params.Set("symbol", symbol)
+	params.Set("side", string(side))
+	params.Set("type", "LIMIT")
+	params.Set("timeInForce", "GTC")
+	params.Set("quantity", quantity.String())
+	params.Set("price", price.String())
+	params.Set("newOrderRespType", "RESULT")
+
+	result, err := t.order(http.MethodPost, orderPath(instrument, "order"), params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to place limit order: %w", err)
+	}
+
+	return t.toOrderResponse(result, connector.OrderTypeLimit, side, quantity, price), nil
+}
+
+func (t *tradingService) PlaceMarketOrder(instrument connector.Instrument, symbol string, side connector.OrderSide, quantity numerical.Decimal) (*connector.OrderResponse, error) {
+	params := url.Values{}
+	params.Set("symbol", symbol)
+	params.Set("side", string(side))
+	params.Set("type", "MARKET")
+	params.Set("quantity", quantity.String())
+	params.Set("newOrderRespType", "RESULT")
+
+	result, err := t.order(http.MethodPost, orderPath(instrument, "order"), params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to place market order: %w", err)
+	}
+
+	return t.toOrderResponse(result, connector.OrderTypeMarket, side, quantity, numerical.Zero()), nil
+}
+
+func (t *tradingService) CancelOrder(instrument connector.Instrument, symbol, orderID string) (*connector.CancelResponse, error) {
+	params := url.Values{}
+	params.Set("symbol", symbol)
+	params.Set("orderId", orderID)
+
+	result, err := t.order(http.MethodDelete, orderPath(instrument, "order"), params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel order: %w", err)
+	}
+
+	return &connector.CancelResponse{
+		OrderID:       orderID,
+		ClientOrderID: result.ClientOrderID,
+		Symbol:        symbol,
+		Status:        connector.OrderStatusCanceled,
+		Timestamp:     t.timeProvider.Now(),
+	}, nil
+}
+
+func (t *tradingService) GetOpenOrders(instrument connector.Instrument) ([]connector.Order, error) {
+	var result []json.RawMessage
+	if err := t.client.Signed(context.Background(), http.MethodGet, orderPath(instrument, "openOrders"), nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to get open orders: %w", err)
+	}
+
+	orders := make([]connector.Order, 0, len(result))
+	for _, raw := range result {
+		order, err := t.decodeOrder(raw)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get open orders: %w", err)
+		}
+		orders = append(orders, toOrder(order))
+		t.remember(orders[len(orders)-1].ID, order.Symbol)
+	}
+
+	return orders, nil
+}
+
+// GetOrderStatus queries the order by ID. Binance requires the symbol to
+// query an order, which is known for orders placed or seen open through
+// this service; "SYMBOL:ORDERID" reaches any other order. Orders with no
+// known symbol are looked up among open orders.
+func (t *tradingService) GetOrderStatus(instrument connector.Instrument, orderID string) (*connector.Order, error) {
+	symbol, id, found := strings.Cut(orderID, ":")
+	if !found {
+		id = orderID
+		symbol = t.symbolOf(orderID)
+	}
+
+	if symbol == "" {
+		orders, err := t.GetOpenOrders(instrument)
+		if err != nil {
+			return nil, err
+		}
+		for _, order := range orders {
+			if order.ID == id {
+				return &order, nil
+			}
+		}
+		return nil, fmt.Errorf("order %s not found among open orders and its symbol is unknown", orderID)
+	}
+
+	params := url.Values{}
+	params.Set("symbol", symbol)
+	params.Set("orderId", id)
+
+	result, err := t.order(http.MethodGet, orderPath(instrument, "order"), params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order status: %w", err)
+	}
+
+	order := toOrder(result)
+	if isFinished(order.Status) {
+		t.forget(id)
+	}
+	return &order, nil
+}
+
+// order calls an order endpoint, keeping Binance's response for the order
+// before decoding it
+func (t *tradingService) order(method, path string, params url.Values) (orderResponse, error) {
+	var raw json.RawMessage
+	if err := t.client.Signed(context.Background(), method, path, params, &raw); err != nil {
+		return orderResponse{}, err
+	}
+	return t.decodeOrder(raw)
+}
+
+func (t *tradingService) decodeOrder(raw json.RawMessage) (orderResponse, error) {
+	var result orderResponse
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return orderResponse{}, fmt.Errorf("failed to decode order: %w", err)
+	}
+	t.payloads.Keep(strconv.FormatInt(result.OrderID, 10), raw)
+	return result, nil
+}
+
+func (t *tradingService) OrderPayload(orderID string) (json.RawMessage, bool) {
+	return t.payloads.OrderPayload(orderID)
+}
+
+func (t *tradingService) remember(orderID, symbol string) {
+	if orderID == "" || symbol == "" {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.symbols[orderID] = symbol
+}
+
+func (t *tradingService) symbolOf(orderID string) string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.symbols[orderID]
+}
+
+func (t *tradingService) forget(orderID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.symbols, orderID)
+}
+
+func isFinished(status connector.OrderStatus) bool {
+	switch status {
+	case connector.OrderStatusFilled, connector.OrderStatusCanceled,
+		connector.OrderStatusRejected, connector.OrderStatusExpired:
+		return true
+	}
+	return false
+}
+
+func (t *tradingService) GetAccountBalance() (*connector.AccountBalance, error) {
+	var result struct {
+		TotalWalletBalance    string `json:"totalWalletBalance"`
+		AvailableBalance      string `json:"availableBalance"`
+		TotalInitialMargin    string `json:"totalInitialMargin"`
+		TotalUnrealizedProfit string `json:"totalUnrealizedProfit"`
+	}
+
+	if err := t.client.Signed(context.Background(), http.MethodGet, "/fapi/v2/account", nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to get account balance: %w", err)
+	}
+
+	return &connector.AccountBalance{
+		TotalBalance:     parseDecimal(result.TotalWalletBalance),
+		AvailableBalance: parseDecimal(result.AvailableBalance),
+		UsedMargin:       parseDecimal(result.TotalInitialMargin),
+		UnrealizedPnL:    parseDecimal(result.TotalUnrealizedProfit),
+		Currency:         "USDT",
+		UpdatedAt:        t.timeProvider.Now(),
+	}, nil
+}
+
+// GetSpotBalances returns the non-zero coin balances of the spot wallet,
+// which is separate from the futures margin account
+func (t *tradingService) GetSpotBalances() ([]types.SpotBalance, error) {
+	var result struct {
+		UpdateTime int64 `json:"updateTime"`
+		Balances   []struct {
+			Asset  string `json:"asset"`
+			Free   string `json:"free"`
+			Locked string `json:"locked"`
+		} `json:"balances"`
+	}
+
+	params := url.Values{}
+	params.Set("omitZeroBalances", "true")
+
+	if err := t.client.Signed(context.Background(), http.MethodGet, "/api/v3/account", params, &result); err != nil {
+		return nil, fmt.Errorf("failed to get spot balances: %w", err)
+	}
+
+	updatedAt := t.timeProvider.Now()
+	if result.UpdateTime > 0 {
+		updatedAt = time.UnixMilli(result.UpdateTime)
+	}
+
+	balances := make([]types.SpotBalance, 0, len(result.Balances))
+	for _, balance := range result.Balances {
+		entry := types.SpotBalance{
+			Exchange:  types.Binance,
+			Asset:     balance.Asset,
+			Free:      parseDecimal(balance.Free),
+			Locked:    parseDecimal(balance.Locked),
+			UpdatedAt: updatedAt,
+		}
+		if entry.Total().IsZero() {
+			continue
+		}
+		balances = append(balances, entry)
+	}
+
+	return balances, nil
+}
+
+func (t *tradingService) GetPositions() ([]connector.Position, error) {
+	var result []struct {
+		Symbol           string `json:"symbol"`
+		PositionAmt      string `json:"positionAmt"`
+		EntryPrice       string `json:"entryPrice"`
+		MarkPrice        string `json:"markPrice"`
+		UnRealizedProfit string `json:"unRealizedProfit"`
+		Leverage         string `json:"leverage"`
+		MarginType       string `json:"marginType"`
+		LiquidationPrice string `json:"liquidationPrice"`
+		UpdateTime       int64  `json:"updateTime"`
+	}
+
+	if err := t.client.Signed(context.Background(), http.MethodGet, "/fapi/v2/positionRisk", nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to get positions: %w", err)
+	}
+
+	positions := make([]connector.Position, 0, len(result))
+	for _, pos := range result {
+		size := parseDecimal(pos.PositionAmt)
+		if size.IsZero() {
+			continue
+		}
+
+		side := connector.OrderSideBuy
+		if size.IsNegative() {
+			side = connector.OrderSideSell
+		}
+
+		positions = append(positions, connector.Position{
+			Symbol:           portfolio.NewAsset(strings.TrimSuffix(pos.Symbol, "USDT")),
+			Exchange:         types.Binance,
+			Side:             side,
+			Size:             size.Abs(),
+			EntryPrice:       parseDecimal(pos.EntryPrice),
+			MarkPrice:        parseDecimal(pos.MarkPrice),
+			UnrealizedPnL:    parseDecimal(pos.UnRealizedProfit),
+			Leverage:         parseDecimal(pos.Leverage),
+			MarginType:       strings.ToUpper(pos.MarginType),
+			LiquidationPrice: parseDecimal(pos.LiquidationPrice),
+			UpdatedAt:        time.UnixMilli(pos.UpdateTime),
+		})
+	}
+
+	return positions, nil
+}
+
+func (t *tradingService) GetTradingHistory(instrument connector.Instrument, symbol string, limit int) ([]connector.Trade, error) {
+	return t.fetchTrades(instrument, tradeParams(symbol, limit))
+}
+
+// GetTradingHistoryRange returns fills within [start, end], oldest first.
+// Futures accepts ranges up to seven days wide and spot up to one day.
+func (t *tradingService) GetTradingHistoryRange(instrument connector.Instrument, symbol string, start, end time.Time, limit int) ([]connector.Trade, error) {
+	params := tradeParams(symbol, limit)
+	params.Set("startTime", strconv.FormatInt(start.UnixMilli(), 10))
+	params.Set("endTime", strconv.FormatInt(end.UnixMilli(), 10))
+	return t.fetchTrades(instrument, params)
+}
+
+func tradeParams(symbol string, limit int) url.Values {
+	params := url.Values{}
+	params.Set("symbol", symbol)
+	if limit > 0 {
+		params.Set("limit", strconv.Itoa(limit))
+	}
+	return params
+}
+
+func (t *tradingService) fetchTrades(instrument connector.Instrument, params url.Values) ([]connector.Trade, error) {
+	if instrument == connector.TypeSpot {
+		return t.fetchSpotTrades(params)
+	}
+
+	var result []struct {
+		ID         int64  `json:"id"`
+		OrderID    int64  `json:"orderId"`
+		Symbol     string `json:"symbol"`
+		Price      string `json:"price"`
+		Qty        string `json:"qty"`
+		Side       string `json:"side"`
+		Maker      bool   `json:"maker"`
+		Commission string `json:"commission"`
+		Time       int64  `json:"time"`
+	}
+
+	if err := t.client.Signed(context.Background(), http.MethodGet, "/fapi/v1/userTrades", params, &result); err != nil {
+		return nil, fmt.Errorf("failed to get trading history: %w", err)
+	}
+
+	trades := make([]connector.Trade, 0, len(result))
+	for _, trade := range result {
+		trades = append(trades, connector.Trade{
+			ID:        strconv.FormatInt(trade.ID, 10),
+			OrderID:   strconv.FormatInt(trade.OrderID, 10),
+			Symbol:    trade.Symbol,
+			Exchange:  types.Binance,
+			Price:     parseDecimal(trade.Price),
+			Quantity:  parseDecimal(trade.Qty),
+			Side:      connector.FromString(trade.Side),
+			IsMaker:   trade.Maker,
+			Fee:       parseDecimal(trade.Commission),
+			Timestamp: time.UnixMilli(trade.Time),
+		})
+	}
+
+	return trades, nil
+}
+
+// fetchSpotTrades reads /api/v3/myTrades, which reports the side as
+// isBuyer rather than the futures side field
+func (t *tradingService) fetchSpotTrades(params url.Values) ([]connector.Trade, error) {
+	var result []struct {
+		ID         int64  `json:"id"`
+		OrderID    int64  `json:"orderId"`
+		Symbol     string `json:"symbol"`
+		Price      string `json:"price"`
+		Qty        string `json:"qty"`
+		Commission string `json:"commission"`
+		Time       int64  `json:"time"`
+		IsBuyer    bool   `json:"isBuyer"`
+		IsMaker    bool   `json:"isMaker"`
+	}
+
+	if err := t.client.Signed(context.Background(), http.MethodGet, "/api/v3/myTrades", params, &result); err != nil {
+		return nil, fmt.Errorf("failed to get spot trading history: %w", err)
+	}
+
+	trades := make([]connector.Trade, 0, len(result))
+	for _, trade := range result {
+		side := connector.OrderSideSell
+		if trade.IsBuyer {
+			side = connector.OrderSideBuy
+		}
+
+		trades = append(trades, connector.Trade{
+			ID:        strconv.FormatInt(trade.ID, 10),
+			OrderID:   strconv.FormatInt(trade.OrderID, 10),
+			Symbol:    trade.Symbol,
+			Exchange:  types.Binance,
+			Price:     parseDecimal(trade.Price),
+			Quantity:  parseDecimal(trade.Qty),
+			Side:      side,
+			IsMaker:   trade.IsMaker,
+			Fee:       parseDecimal(trade.Commission),
+			Timestamp: time.UnixMilli(trade.Time),
+		})
+	}
+
+	return trades, nil
+}
+
+func (t *tradingService) toOrderResponse(result orderResponse, orderType connector.OrderType, side connector.OrderSide, quantity, price numerical.Decimal) *connector.OrderResponse {
+	orderID := strconv.FormatInt(result.OrderID, 10)
+	t.remember(orderID, result.Symbol)
+
+	return &connector.OrderResponse{
+		OrderID:       orderID,
+		ClientOrderID: result.ClientOrderID,
+		Symbol:        result.Symbol,
+		Status:        convertOrderStatus(result.Status),
+		Side:          side,
+		Type:          orderType,
+		Quantity:      quantity,
+		Price:         price,
+		FilledQty:     parseDecimal(result.ExecutedQty),
+		AvgPrice:      averagePrice(result),
+		Timestamp:     t.timeProvider.Now(),
+	}
+}
+
+func toOrder(order orderResponse) connector.Order {
+	quantity := parseDecimal(order.OrigQty)
+	filled := parseDecimal(order.ExecutedQty)
+
+	return connector.Order{
+		ID:            strconv.FormatInt(order.OrderID, 10),
+		ClientOrderID: order.ClientOrderID,
+		Symbol:        order.Symbol,
+		Side:          connector.FromString(order.Side),
+		Type:          convertOrderType(order.Type),
+		Status:        convertOrderStatus(order.Status),
+		Quantity:      quantity,
+		Price:         parseDecimal(order.Price),
+		FilledQty:     filled,
+		RemainingQty:  quantity.Sub(filled),
+		AvgPrice:      averagePrice(order),
+		CreatedAt:     time.UnixMilli(order.Time),
+		UpdatedAt:     time.UnixMilli(order.UpdateTime),
+	}
+}
+
+// orderPath returns the spot or USDⓈ-M futures path of an order endpoint
+func orderPath(instrument connector.Instrument, endpoint string) string {
+	if instrument == connector.TypeSpot {
+		return "/api/v3/" + endpoint
+	}
+	return "/fapi/v1/" + endpoint
+}
+
+// averagePrice prefers the reported average and otherwise derives it from
+// the quote amount filled, which is all spot returns
+func averagePrice(order orderResponse) numerical.Decimal {
+	if price := parseDecimal(order.AvgPrice); !price.IsZero() {
+		return price
+	}
+
+	filled := parseDecimal(order.ExecutedQty)
+	if filled.IsZero() {
+		return numerical.Zero()
+	}
+	return parseDecimal(order.CumulativeQuoteQty).Div(filled)
+}
+
+func convertOrderStatus(status string) connector.OrderStatus {
+	switch status {
+	case "NEW":
+		return connector.OrderStatusOpen
+	case "PARTIALLY_FILLED":
+		return connector.OrderStatusPartiallyFilled
+	case "FILLED":
+		return connector.OrderStatusFilled
+	case "CANCELED":
+		return connector.OrderStatusCanceled
+	case "REJECTED":
+		return connector.OrderStatusRejected
+	case "EXPIRED", "EXPIRED_IN_MATCH":
+		return connector.OrderStatusExpired
+	default:
+		return connector.OrderStatusNew
+	}
+}
+
+func convertOrderType(orderType string) connector.OrderType {
+	switch orderType {
+	case "MARKET":
+		return connector.OrderTypeMarket
+	case "STOP":
+		return connector.OrderTypeStopLimit
+	case "STOP_MARKET":
+		return connector.OrderTypeStopMarket
+	case "TAKE_PROFIT":
+		return connector.OrderTypeTakeProfitLimit
+	case "TAKE_PROFIT_MARKET":
+		return connector.OrderTypeTakeProfitMarket
+	default:
+		return connector.OrderTypeLimit
+	}
+}
+
+// GetTransferIncome returns wallet transfers in and out of the futures account
+func (t *tradingService) GetTransferIncome(since time.Time) ([]types.TransferEvent, error) {
+	params := url.Values{}
+	params.Set("incomeType", "TRANSFER")
+	params.Set("startTime", strconv.FormatInt(since.UnixMilli(), 10))
+	params.Set("limit", "1000")
+
+	var result []struct {
+		Asset  string `json:"asset"`
+		Income string `json:"income"`
+		Info   string `json:"info"`
+		Time   int64  `json:"time"`
+		TranID int64  `json:"tranId"`
+	}
+
+	if err := t.client.Signed(context.Background(), http.MethodGet, "/fapi/v1/income", params, &result); err != nil {
+		return nil, fmt.Errorf("failed to get transfer history: %w", err)
+	}
+
+	events := make([]types.TransferEvent, 0, len(result))
+	for _, income := range result {
+		events = append(events, types.TransferEvent{
+			Exchange:  types.Binance,
+			ID:        strconv.FormatInt(income.TranID, 10),
+			Kind:      types.TransferKindTransfer,
+			Asset:     income.Asset,
+			Amount:    parseDecimal(income.Income),
+			Status:    income.Info,
+			Timestamp: time.UnixMilli(income.Time),
+		})
+	}
+
+	return events, nil
+}
+
+func parseDecimal(value string) numerical.Decimal {
+	if value == "" {
+		return numerical.Zero()
+	}
+
+	d, err := numerical.NewFromString(value)
+	if err != nil {
+		return numerical.Zero()
+	}
+
+	return d
+}
+
+// GetAPIKeyPermissions reads the key's restrictions from the spot wallet
+// API, which covers futures trading as well
+func (t *tradingService) GetAPIKeyPermissions() (*types.APIKeyPermissions, error) {
+	var result struct {
+		EnableReading              bool `json:"enableReading"`
+		EnableFutures              bool `json:"enableFutures"`
+		EnableSpotAndMarginTrading bool `json:"enableSpotAndMarginTrading"`
+		EnableWithdrawals          bool `json:"enableWithdrawals"`
+		EnableInternalTransfer     bool `json:"enableInternalTransfer"`
+		IPRestrict                 bool `json:"ipRestrict"`
+	}
+	if err := t.client.Signed(context.Background(), http.MethodGet, "/sapi/v1/account/apiRestrictions", url.Values{}, &result); err != nil {
+		return nil, fmt.Errorf("failed to get API key restrictions: %w", err)
+	}
+
+	permissions := &types.APIKeyPermissions{
+		Exchange: types.Binance,
+		Trade:    result.EnableFutures,
+		Withdraw: result.EnableWithdrawals,
+		ReadOnly: !result.EnableFutures && !result.EnableSpotAndMarginTrading,
+	}
+	for scope, enabled := range map[string]bool{
+		"reading":           result.EnableReading,
+		"futures":           result.EnableFutures,
+		"spot_margin":       result.EnableSpotAndMarginTrading,
+		"withdrawals":       result.EnableWithdrawals,
+		"internal_transfer": result.EnableInternalTransfer,
+		"ip_restricted":     result.IPRestrict,
+	} {
+		if enabled {
+			permissions.Scopes = append(permissions.Scopes, scope)
+		}
+	}
+	sort.Strings(permissions.Scopes)
+
+	return permissions, nil
+}
@@ -0,0 +1,24 @@
+package binance
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+var _ types.TransferHistoryProvider = (*binance)(nil)
+
+// FetchTransferEvents returns wallet transfers in and out of the futures account since the given time.
+// Withdrawals themselves go through the spot wallet API and are not visible to a futures key.
+func (b *binance) FetchTransferEvents(since time.Time) ([]types.TransferEvent, error) {
+	if err := b.limiter.Wait(ratelimit.EndpointAccount); err != nil {
+		return nil, err
+	}
+	if !b.initialized {
+		return nil, fmt.Errorf("connector not initialized")
+	}
+
+	return b.trading.GetTransferIncome(since)
+}
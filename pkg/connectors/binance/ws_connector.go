@@ -0,0 +1,378 @@
+package binance
+
+import (
+	"fmt"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/live-trading/pkg/connectors/binance/data/real_time"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+// StartWebSocket starts the WebSocket connection for real-time data
+func (b *binance) StartWebSocket() error {
+	if !b.initialized {
+		return fmt.Errorf("connector not initialized")
+	}
+
+	// Start error forwarding from realTime service
+	go b.forwardWebSocketErrors()
+
+	return b.realTime.Connect()
+}
+
+// forwardWebSocketErrors forwards errors from the realTime service to the connector's error channel
+func (b *binance) forwardWebSocketErrors() {
+	errCh := b.realTime.GetErrorChannel()
+	for err := range errCh {
+		select {
+		case b.errorCh <- err:
+		default:
+			// Error channel is full, drop the error
+		}
+	}
+}
+
+// StopWebSocket stops the WebSocket connection
+func (b *binance) StopWebSocket() error {
+	if !b.initialized {
+		return fmt.Errorf("connector not initialized")
+	}
+
+	if err := b.realTime.UnsubscribeUserData(); err != nil {
+		b.appLogger.Warn("Failed to stop Binance user data stream: %v", err)
+	}
+
+	return b.realTime.Disconnect()
+}
+
+// IsWebSocketConnected returns whether the WebSocket is connected
+func (b *binance) IsWebSocketConnected() bool {
+	if !b.initialized || b.realTime == nil {
+		return false
+	}
+	return b.realTime.IsConnected()
+}
+
+// GetOrderBookChannels returns all active orderbook channels
+func (b *binance) GetOrderBookChannels() map[string]<-chan connector.OrderBook {
+	b.orderBookMu.RLock()
+	defer b.orderBookMu.RUnlock()
+
+	result := make(map[string]<-chan connector.OrderBook, len(b.orderBookChannels))
+	for key, ch := range b.orderBookChannels {
+		result[key] = ch
+	}
+
+	return result
+}
+
+// TradeUpdates returns a channel for trade updates
+func (b *binance) TradeUpdates() <-chan connector.Trade {
+	return b.tradeCh
+}
+
+// PositionUpdates returns a channel for position updates
+func (b *binance) PositionUpdates() <-chan connector.Position {
+	return b.positionCh
+}
+
+// AccountBalanceUpdates returns a channel for account balance updates
+func (b *binance) AccountBalanceUpdates() <-chan connector.AccountBalance {
+	return b.balanceCh
+}
+
+// GetKlineChannels returns all active kline channels
+func (b *binance) GetKlineChannels() map[string]<-chan connector.Kline {
+	b.klineMu.RLock()
+	defer b.klineMu.RUnlock()
+
+	result := make(map[string]<-chan connector.Kline, len(b.klineChannels))
+	for key, ch := range b.klineChannels {
+		result[key] = ch
+	}
+
+	return result
+}
+
+// ErrorChannel returns a channel for WebSocket errors
+func (b *binance) ErrorChannel() <-chan error {
+	return b.errorCh
+}
+
+// SubscribeOrderBook subscribes to order book updates for an asset
+func (b *binance) SubscribeOrderBook(asset portfolio.Asset, _ connector.Instrument) error {
+	if !b.initialized {
+		return fmt.Errorf("connector not initialized")
+	}
+
+	key := asset.Symbol()
+	symbol := b.GetPerpSymbol(asset)
+
+	// Create dedicated channel for this asset if it doesn't exist
+	b.orderBookMu.Lock()
+	orderBookCh, exists := b.orderBookChannels[key]
+	if !exists {
+		orderBookCh = make(chan connector.OrderBook, 100)
+		b.orderBookChannels[key] = orderBookCh
+	}
+	b.orderBookMu.Unlock()
+
+	return b.realTime.SubscribeOrderBook(symbol, func(obMsg *real_time.OrderBookMessage) {
+		orderBook := connector.OrderBook{
+			Asset:     asset,
+			Timestamp: obMsg.Timestamp,
+			Bids:      obMsg.Bids,
+			Asks:      obMsg.Asks,
+		}
+
+		select {
+		case orderBookCh <- orderBook:
+		default:
+			select {
+			case b.errorCh <- fmt.Errorf("orderbook channel full for %s, dropping update", key):
+			default:
+			}
+		}
+	})
+}
+
+// UnsubscribeOrderBook unsubscribes from order book updates
+func (b *binance) UnsubscribeOrderBook(asset portfolio.Asset, _ connector.Instrument) error {
+	if !b.initialized {
+		return fmt.Errorf("connector not initialized")
+	}
+
+	b.orderBookMu.Lock()
+	delete(b.orderBookChannels, asset.Symbol())
+	b.orderBookMu.Unlock()
+
+	return b.realTime.UnsubscribeOrderBook(b.GetPerpSymbol(asset))
+}
+
+// SubscribeTrades subscribes to trade updates for an asset
+func (b *binance) SubscribeTrades(asset portfolio.Asset, _ connector.Instrument) error {
+	if !b.initialized {
+		return fmt.Errorf("connector not initialized")
+	}
+
+	symbol := b.GetPerpSymbol(asset)
+
+	return b.realTime.SubscribeTrades(symbol, func(trade *real_time.TradeMessage) {
+		select {
+		case b.tradeCh <- connector.Trade{
+			ID:        trade.ID,
+			Symbol:    trade.Symbol,
+			Exchange:  types.Binance,
+			Price:     trade.Price,
+			Quantity:  trade.Quantity,
+			Side:      trade.Side,
+			Timestamp: trade.Timestamp,
+		}:
+		default:
+			select {
+			case b.errorCh <- fmt.Errorf("trade channel full for %s, dropping update", symbol):
+			default:
+			}
+		}
+	})
+}
+
+// UnsubscribeTrades unsubscribes from trade updates
+func (b *binance) UnsubscribeTrades(asset portfolio.Asset, _ connector.Instrument) error {
+	if !b.initialized {
+		return fmt.Errorf("connector not initialized")
+	}
+
+	return b.realTime.UnsubscribeTrades(b.GetPerpSymbol(asset))
+}
+
+// SubscribePositions subscribes to position updates
+func (b *binance) SubscribePositions(asset portfolio.Asset, _ connector.Instrument) error {
+	if !b.initialized {
+		return fmt.Errorf("connector not initialized")
+	}
+
+	b.userMu.Lock()
+	b.positionSymbols[b.GetPerpSymbol(asset)] = asset
+	b.userMu.Unlock()
+
+	return b.realTime.SubscribeUserData(b.handleAccountUpdate)
+}
+
+// UnsubscribePositions unsubscribes from position updates
+func (b *binance) UnsubscribePositions(asset portfolio.Asset, _ connector.Instrument) error {
+	if !b.initialized {
+		return fmt.Errorf("connector not initialized")
+	}
+
+	symbol := b.GetPerpSymbol(asset)
+
+	b.userMu.Lock()
+	if _, exists := b.positionSymbols[symbol]; !exists {
+		b.userMu.Unlock()
+		return fmt.Errorf("no active subscription for positions:%s", symbol)
+	}
+	delete(b.positionSymbols, symbol)
+	b.userMu.Unlock()
+
+	return b.releaseUserData()
+}
+
+// SubscribeAccountBalance subscribes to account balance updates
+func (b *binance) SubscribeAccountBalance() error {
+	if !b.initialized {
+		return fmt.Errorf("connector not initialized")
+	}
+
+	b.userMu.Lock()
+	b.balanceActive = true
+	b.userMu.Unlock()
+
+	return b.realTime.SubscribeUserData(b.handleAccountUpdate)
+}
+
+// UnsubscribeAccountBalance unsubscribes from account balance updates
+func (b *binance) UnsubscribeAccountBalance() error {
+	if !b.initialized {
+		return fmt.Errorf("connector not initialized")
+	}
+
+	b.userMu.Lock()
+	if !b.balanceActive {
+		b.userMu.Unlock()
+		return fmt.Errorf("no active subscription for balance")
+	}
+	b.balanceActive = false
+	b.userMu.Unlock()
+
+	return b.releaseUserData()
+}
+
+// SubscribeKlines subscribes to kline updates for an asset
+func (b *binance) SubscribeKlines(asset portfolio.Asset, interval string) error {
+	if !b.initialized {
+		return fmt.Errorf("connector not initialized")
+	}
+
+	symbol := b.GetPerpSymbol(asset)
+	channelKey := fmt.Sprintf("%s:%s", asset.Symbol(), interval)
+
+	// Create dedicated channel for this subscription
+	b.klineMu.Lock()
+	klineCh := make(chan connector.Kline, 100)
+	b.klineChannels[channelKey] = klineCh
+	b.klineMu.Unlock()
+
+	return b.realTime.SubscribeKlines(symbol, interval, func(klineMsg *real_time.KlineMessage) {
+		kline := connector.Kline{
+			Symbol:    asset.Symbol(),
+			Interval:  klineMsg.Interval,
+			OpenTime:  klineMsg.OpenTime,
+			Open:      klineMsg.Open,
+			High:      klineMsg.High,
+			Low:       klineMsg.Low,
+			Close:     klineMsg.Close,
+			Volume:    klineMsg.Volume,
+			CloseTime: klineMsg.CloseTime,
+		}
+
+		select {
+		case klineCh <- kline:
+		default:
+			select {
+			case b.errorCh <- fmt.Errorf("kline channel full for %s, dropping update", channelKey):
+			default:
+			}
+		}
+	})
+}
+
+// UnsubscribeKlines unsubscribes from kline updates
+func (b *binance) UnsubscribeKlines(asset portfolio.Asset, interval string) error {
+	if !b.initialized {
+		return fmt.Errorf("connector not initialized")
+	}
+
+	b.klineMu.Lock()
+	delete(b.klineChannels, fmt.Sprintf("%s:%s", asset.Symbol(), interval))
+	b.klineMu.Unlock()
+
+	return b.realTime.UnsubscribeKlines(b.GetPerpSymbol(asset), interval)
+}
+
+// releaseUserData closes the user data stream once nothing depends on it
+func (b *binance) releaseUserData() error {
+	b.userMu.RLock()
+	inUse := b.balanceActive || len(b.positionSymbols) > 0
+	b.userMu.RUnlock()
+
+	if inUse {
+		return nil
+	}
+	return b.realTime.UnsubscribeUserData()
+}
+
+// handleAccountUpdate fans an ACCOUNT_UPDATE event out to the balance and position channels
+func (b *binance) handleAccountUpdate(msg *real_time.AccountUpdateMessage) {
+	b.userMu.RLock()
+	balanceActive := b.balanceActive
+	assets := make(map[string]portfolio.Asset, len(b.positionSymbols))
+	for symbol, asset := range b.positionSymbols {
+		assets[symbol] = asset
+	}
+	b.userMu.RUnlock()
+
+	if balanceActive {
+		for _, balance := range msg.Balances {
+			if balance.Asset != "USDT" {
+				continue
+			}
+
+			select {
+			case b.balanceCh <- connector.AccountBalance{
+				TotalBalance:     balance.WalletBalance,
+				AvailableBalance: balance.CrossWalletBalance,
+				Currency:         balance.Asset,
+				UpdatedAt:        msg.Timestamp,
+			}:
+			default:
+				select {
+				case b.errorCh <- fmt.Errorf("balance channel full, dropping update"):
+				default:
+				}
+			}
+		}
+	}
+
+	for _, pos := range msg.Positions {
+		asset, ok := assets[pos.Symbol]
+		if !ok {
+			continue
+		}
+
+		side := connector.OrderSideBuy
+		if pos.Amount.IsNegative() {
+			side = connector.OrderSideSell
+		}
+
+		select {
+		case b.positionCh <- connector.Position{
+			Symbol:        asset,
+			Exchange:      types.Binance,
+			Side:          side,
+			Size:          pos.Amount.Abs(),
+			EntryPrice:    pos.EntryPrice,
+			UnrealizedPnL: pos.UnrealizedPnL,
+			RealizedPnL:   numerical.Zero(),
+			UpdatedAt:     msg.Timestamp,
+		}:
+		default:
+			select {
+			case b.errorCh <- fmt.Errorf("position channel full for %s, dropping update", pos.Symbol):
+			default:
+			}
+		}
+	}
+}
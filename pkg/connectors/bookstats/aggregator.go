@@ -0,0 +1,375 @@
+package bookstats
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/scheduler"
+)
+
+const (
+	// DefaultSnapshotInterval is how often consolidated books are published
+	DefaultSnapshotInterval = time.Second
+
+	// AggregatorJobName is the scheduler job that publishes snapshots
+	AggregatorJobName = "order-book-snapshots"
+)
+
+// TopOfBook is the cached best bid and ask for one book
+type TopOfBook struct {
+	Exchange  connector.ExchangeName
+	Asset     portfolio.Asset
+	BestBid   connector.PriceLevel
+	BestAsk   connector.PriceLevel
+	Mid       numerical.Decimal
+	Spread    numerical.Decimal
+	SpreadBps numerical.Decimal
+	UpdatedAt time.Time
+}
+
+// OrderBookAggregator keeps one book per exchange and asset, updated from
+// full snapshots or level deltas, so strategies can read the touch without
+// re-parsing L2. Top-of-book queries are served from a cache refreshed on
+// every update.
+type OrderBookAggregator interface {
+	// Configure sets the snapshot cadence; zero keeps the default
+	Configure(snapshotInterval time.Duration)
+
+	// Start registers the snapshot job with the scheduler
+	Start() error
+	Stop() error
+
+	// Update replaces the exchange's book with a full snapshot
+	Update(exchange connector.ExchangeName, book connector.OrderBook)
+
+	// ApplyDelta sets the given levels; a zero quantity removes the level.
+	// It fails until a snapshot has been seen for the book.
+	ApplyDelta(exchange connector.ExchangeName, asset portfolio.Asset, bids, asks []connector.PriceLevel, at time.Time) error
+
+	BestBid(exchange connector.ExchangeName, asset portfolio.Asset) (connector.PriceLevel, bool)
+	BestAsk(exchange connector.ExchangeName, asset portfolio.Asset) (connector.PriceLevel, bool)
+	Spread(exchange connector.ExchangeName, asset portfolio.Asset) (numerical.Decimal, bool)
+	Top(exchange connector.ExchangeName, asset portfolio.Asset) (TopOfBook, bool)
+	DepthWithinBps(exchange connector.ExchangeName, asset portfolio.Asset, bandBps numerical.Decimal) (Depth, bool)
+
+	Book(exchange connector.ExchangeName, asset portfolio.Asset) (connector.OrderBook, bool)
+
+	// Consolidated merges every exchange's book for the asset into one
+	Consolidated(asset portfolio.Asset) (connector.OrderBook, bool)
+
+	// Snapshots publishes a consolidated book per asset every interval
+	Snapshots() <-chan connector.OrderBook
+}
+
+type bookKey struct {
+	exchange connector.ExchangeName
+	symbol   string
+}
+
+// levelBook holds levels keyed by price so deltas are O(1); the sorted
+// slices are rebuilt lazily when a full view is needed
+type levelBook struct {
+	asset  portfolio.Asset
+	bids   map[string]connector.PriceLevel
+	asks   map[string]connector.PriceLevel
+	sorted *connector.OrderBook
+	top    TopOfBook
+}
+
+type orderBookAggregator struct {
+	scheduler    scheduler.Scheduler
+	timeProvider temporal.TimeProvider
+
+	interval   time.Duration
+	books      map[bookKey]*levelBook
+	snapshotCh chan connector.OrderBook
+	mu         sync.RWMutex
+}
+
+func NewOrderBookAggregator(
+	jobScheduler scheduler.Scheduler,
+	timeProvider temporal.TimeProvider,
+) OrderBookAggregator {
+	return &orderBookAggregator{
+		scheduler:    jobScheduler,
+		timeProvider: timeProvider,
+		interval:     DefaultSnapshotInterval,
+		books:        make(map[bookKey]*levelBook),
+		snapshotCh:   make(chan connector.OrderBook, 100),
+	}
+}
+
+func (a *orderBookAggregator) Configure(snapshotInterval time.Duration) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if snapshotInterval > 0 {
+		a.interval = snapshotInterval
+	}
+}
+
+func (a *orderBookAggregator) Snapshots() <-chan connector.OrderBook {
+	return a.snapshotCh
+}
+
+func (a *orderBookAggregator) Start() error {
+	a.mu.RLock()
+	interval := a.interval
+	a.mu.RUnlock()
+
+	return a.scheduler.Register(scheduler.Job{
+		Name:     AggregatorJobName,
+		Interval: interval,
+		Run: func(_ context.Context) error {
+			a.publish()
+			return nil
+		},
+	})
+}
+
+func (a *orderBookAggregator) Stop() error {
+	return a.scheduler.Unregister(AggregatorJobName)
+}
+
+func (a *orderBookAggregator) Update(exchange connector.ExchangeName, book connector.OrderBook) {
+	levels := &levelBook{
+		asset: book.Asset,
+		bids:  make(map[string]connector.PriceLevel, len(book.Bids)),
+		asks:  make(map[string]connector.PriceLevel, len(book.Asks)),
+	}
+	setLevels(levels.bids, book.Bids)
+	setLevels(levels.asks, book.Asks)
+
+	at := book.Timestamp
+	if at.IsZero() {
+		at = a.timeProvider.Now()
+	}
+	levels.refreshTop(exchange, at)
+
+	a.mu.Lock()
+	a.books[bookKey{exchange, book.Asset.Symbol()}] = levels
+	a.mu.Unlock()
+}
+
+func (a *orderBookAggregator) ApplyDelta(exchange connector.ExchangeName, asset portfolio.Asset, bids, asks []connector.PriceLevel, at time.Time) error {
+	if at.IsZero() {
+		at = a.timeProvider.Now()
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	levels, ok := a.books[bookKey{exchange, asset.Symbol()}]
+	if !ok {
+		return fmt.Errorf("no %s book snapshot for %s yet", exchange, asset.Symbol())
+	}
+
+	setLevels(levels.bids, bids)
+	setLevels(levels.asks, asks)
+	levels.sorted = nil
+	levels.refreshTop(exchange, at)
+	return nil
+}
+
+func setLevels(side map[string]connector.PriceLevel, levels []connector.PriceLevel) {
+	for _, level := range levels {
+		key := level.Price.String()
+		if level.Quantity.IsPositive() {
+			side[key] = level
+		} else {
+			delete(side, key)
+		}
+	}
+}
+
+// refreshTop recomputes the cached touch. A delta rarely touches more than
+// a handful of levels, so a scan here is cheaper than keeping a heap.
+func (b *levelBook) refreshTop(exchange connector.ExchangeName, at time.Time) {
+	top := TopOfBook{
+		Exchange:  exchange,
+		Asset:     b.asset,
+		Mid:       numerical.Zero(),
+		Spread:    numerical.Zero(),
+		SpreadBps: numerical.Zero(),
+		UpdatedAt: at,
+	}
+
+	first := true
+	for _, level := range b.bids {
+		if first || level.Price.GreaterThan(top.BestBid.Price) {
+			top.BestBid = level
+			first = false
+		}
+	}
+	first = true
+	for _, level := range b.asks {
+		if first || level.Price.LessThan(top.BestAsk.Price) {
+			top.BestAsk = level
+			first = false
+		}
+	}
+
+	if len(b.bids) > 0 && len(b.asks) > 0 {
+		top.Mid = top.BestBid.Price.Add(top.BestAsk.Price).Div(numerical.NewFromInt(2))
+		top.Spread = top.BestAsk.Price.Sub(top.BestBid.Price)
+		if top.Mid.IsPositive() {
+			top.SpreadBps = top.Spread.Div(top.Mid).Mul(tenThousand)
+		}
+	}
+	b.top = top
+}
+
+// view returns the book sorted best first; callers hold the write lock
+func (b *levelBook) view() *connector.OrderBook {
+	if b.sorted != nil {
+		return b.sorted
+	}
+
+	book := &connector.OrderBook{
+		Asset:     b.asset,
+		Bids:      sortedLevels(b.bids, true),
+		Asks:      sortedLevels(b.asks, false),
+		Timestamp: b.top.UpdatedAt,
+	}
+	b.sorted = book
+	return book
+}
+
+func sortedLevels(side map[string]connector.PriceLevel, descending bool) []connector.PriceLevel {
+	levels := make([]connector.PriceLevel, 0, len(side))
+	for _, level := range side {
+		levels = append(levels, level)
+	}
+	sort.Slice(levels, func(i, j int) bool {
+		if descending {
+			return levels[i].Price.GreaterThan(levels[j].Price)
+		}
+		return levels[i].Price.LessThan(levels[j].Price)
+	})
+	return levels
+}
+
+func (a *orderBookAggregator) Top(exchange connector.ExchangeName, asset portfolio.Asset) (TopOfBook, bool) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	levels, ok := a.books[bookKey{exchange, asset.Symbol()}]
+	if !ok {
+		return TopOfBook{}, false
+	}
+	return levels.top, true
+}
+
+func (a *orderBookAggregator) BestBid(exchange connector.ExchangeName, asset portfolio.Asset) (connector.PriceLevel, bool) {
+	top, ok := a.Top(exchange, asset)
+	if !ok || !top.BestBid.Quantity.IsPositive() {
+		return connector.PriceLevel{}, false
+	}
+	return top.BestBid, true
+}
+
+func (a *orderBookAggregator) BestAsk(exchange connector.ExchangeName, asset portfolio.Asset) (connector.PriceLevel, bool) {
+	top, ok := a.Top(exchange, asset)
+	if !ok || !top.BestAsk.Quantity.IsPositive() {
+		return connector.PriceLevel{}, false
+	}
+	return top.BestAsk, true
+}
+
+func (a *orderBookAggregator) Spread(exchange connector.ExchangeName, asset portfolio.Asset) (numerical.Decimal, bool) {
+	top, ok := a.Top(exchange, asset)
+	if !ok || !top.Mid.IsPositive() {
+		return numerical.Zero(), false
+	}
+	return top.Spread, true
+}
+
+func (a *orderBookAggregator) DepthWithinBps(exchange connector.ExchangeName, asset portfolio.Asset, bandBps numerical.Decimal) (Depth, bool) {
+	book, ok := a.Book(exchange, asset)
+	if !ok {
+		return Depth{BandBps: bandBps}, false
+	}
+	return DepthWithin(&book, bandBps)
+}
+
+func (a *orderBookAggregator) Book(exchange connector.ExchangeName, asset portfolio.Asset) (connector.OrderBook, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	levels, ok := a.books[bookKey{exchange, asset.Symbol()}]
+	if !ok {
+		return connector.OrderBook{}, false
+	}
+	return *levels.view(), true
+}
+
+func (a *orderBookAggregator) Consolidated(asset portfolio.Asset) (connector.OrderBook, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.consolidatedLocked(asset.Symbol())
+}
+
+func (a *orderBookAggregator) consolidatedLocked(symbol string) (connector.OrderBook, bool) {
+	bids := make(map[string]connector.PriceLevel)
+	asks := make(map[string]connector.PriceLevel)
+	var consolidated connector.OrderBook
+	found := false
+
+	for key, levels := range a.books {
+		if key.symbol != symbol {
+			continue
+		}
+		found = true
+		consolidated.Asset = levels.asset
+		if levels.top.UpdatedAt.After(consolidated.Timestamp) {
+			consolidated.Timestamp = levels.top.UpdatedAt
+		}
+		mergeLevels(bids, levels.bids)
+		mergeLevels(asks, levels.asks)
+	}
+	if !found {
+		return connector.OrderBook{}, false
+	}
+
+	consolidated.Bids = sortedLevels(bids, true)
+	consolidated.Asks = sortedLevels(asks, false)
+	return consolidated, true
+}
+
+func mergeLevels(into, from map[string]connector.PriceLevel) {
+	for key, level := range from {
+		if existing, ok := into[key]; ok {
+			level.Quantity = level.Quantity.Add(existing.Quantity)
+		}
+		into[key] = level
+	}
+}
+
+func (a *orderBookAggregator) publish() {
+	a.mu.Lock()
+	symbols := make(map[string]bool)
+	for key := range a.books {
+		symbols[key.symbol] = true
+	}
+	books := make([]connector.OrderBook, 0, len(symbols))
+	for symbol := range symbols {
+		if book, ok := a.consolidatedLocked(symbol); ok {
+			books = append(books, book)
+		}
+	}
+	a.mu.Unlock()
+
+	for _, book := range books {
+		select {
+		case a.snapshotCh <- book:
+		default:
+		}
+	}
+}
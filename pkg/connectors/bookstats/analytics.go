@@ -0,0 +1,181 @@
+package bookstats
+
+import (
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+)
+
+var tenThousand = numerical.NewFromInt(10000)
+
+// Depth is the resting liquidity on each side of the book within a band around mid
+type Depth struct {
+	BandBps     numerical.Decimal
+	BidQuantity numerical.Decimal
+	AskQuantity numerical.Decimal
+	BidNotional numerical.Decimal
+	AskNotional numerical.Decimal
+}
+
+// Impact estimates the cost of sweeping the book with a market order
+type Impact struct {
+	Side     connector.OrderSide
+	Quantity numerical.Decimal
+
+	// Filled is less than Quantity when the visible book is too thin
+	Filled     numerical.Decimal
+	AvgPrice   numerical.Decimal
+	WorstPrice numerical.Decimal
+
+	// CostBps is the distance of AvgPrice from mid, always non-negative
+	CostBps numerical.Decimal
+}
+
+// Complete reports whether the visible book could absorb the whole order
+func (i Impact) Complete() bool {
+	return !i.Filled.LessThan(i.Quantity)
+}
+
+// Mid returns the midpoint of the best bid and ask; false when either side is empty
+func Mid(book *connector.OrderBook) (numerical.Decimal, bool) {
+	if book == nil || len(book.Bids) == 0 || len(book.Asks) == 0 {
+		return numerical.Zero(), false
+	}
+	return book.Bids[0].Price.Add(book.Asks[0].Price).Div(numerical.NewFromInt(2)), true
+}
+
+// SpreadBps returns the best bid/ask spread relative to mid in basis points
+func SpreadBps(book *connector.OrderBook) (numerical.Decimal, bool) {
+	mid, ok := Mid(book)
+	if !ok || mid.IsZero() {
+		return numerical.Zero(), false
+	}
+	return book.Asks[0].Price.Sub(book.Bids[0].Price).Div(mid).Mul(tenThousand), true
+}
+
+// DepthWithin sums liquidity priced within bandBps of mid on each side
+func DepthWithin(book *connector.OrderBook, bandBps numerical.Decimal) (Depth, bool) {
+	depth := Depth{
+		BandBps:     bandBps,
+		BidQuantity: numerical.Zero(),
+		AskQuantity: numerical.Zero(),
+		BidNotional: numerical.Zero(),
+		AskNotional: numerical.Zero(),
+	}
+
+	mid, ok := Mid(book)
+	if !ok {
+		return depth, false
+	}
+
+	band := mid.Mul(bandBps).Div(tenThousand)
+	floor := mid.Sub(band)
+	ceiling := mid.Add(band)
+
+	// Levels are sorted best first, so each walk stops at the band edge
+	for _, level := range book.Bids {
+		if level.Price.LessThan(floor) {
+			break
+		}
+		depth.BidQuantity = depth.BidQuantity.Add(level.Quantity)
+		depth.BidNotional = depth.BidNotional.Add(level.Quantity.Mul(level.Price))
+	}
+	for _, level := range book.Asks {
+		if level.Price.GreaterThan(ceiling) {
+			break
+		}
+		depth.AskQuantity = depth.AskQuantity.Add(level.Quantity)
+		depth.AskNotional = depth.AskNotional.Add(level.Quantity.Mul(level.Price))
+	}
+
+	return depth, true
+}
+
+// EstimateImpact walks the opposite side of the book for a market order of quantity
+func EstimateImpact(book *connector.OrderBook, side connector.OrderSide, quantity numerical.Decimal) (Impact, bool) {
+	impact := Impact{
+		Side:       side,
+		Quantity:   quantity,
+		Filled:     numerical.Zero(),
+		AvgPrice:   numerical.Zero(),
+		WorstPrice: numerical.Zero(),
+		CostBps:    numerical.Zero(),
+	}
+
+	mid, ok := Mid(book)
+	if !ok || !quantity.IsPositive() {
+		return impact, false
+	}
+
+	levels := book.Asks
+	if side == connector.OrderSideSell {
+		levels = book.Bids
+	}
+
+	notional := numerical.Zero()
+	for _, level := range levels {
+		take := quantity.Sub(impact.Filled)
+		if level.Quantity.LessThan(take) {
+			take = level.Quantity
+		}
+
+		impact.Filled = impact.Filled.Add(take)
+		notional = notional.Add(take.Mul(level.Price))
+		impact.WorstPrice = level.Price
+
+		if !impact.Filled.LessThan(quantity) {
+			break
+		}
+	}
+
+	if impact.Filled.IsZero() {
+		return impact, false
+	}
+
+	impact.AvgPrice = notional.Div(impact.Filled)
+	impact.CostBps = impact.AvgPrice.Sub(mid).Abs().Div(mid).Mul(tenThousand)
+	return impact, true
+}
+
+// MaxQuantityWithin returns the largest size that can be executed with an
+// average cost no worse than maxCostBps from mid
+func MaxQuantityWithin(book *connector.OrderBook, side connector.OrderSide, maxCostBps numerical.Decimal) numerical.Decimal {
+	mid, ok := Mid(book)
+	if !ok {
+		return numerical.Zero()
+	}
+
+	levels := book.Asks
+	if side == connector.OrderSideSell {
+		levels = book.Bids
+	}
+
+	// Average cost only grows as the walk goes deeper, so the answer lies
+	// inside the first level that pushes the average past the limit
+	limit := mid.Mul(maxCostBps).Div(tenThousand)
+	filled := numerical.Zero()
+	excess := numerical.Zero() // sum of quantity * (price - mid) signed toward cost
+
+	for _, level := range levels {
+		distance := level.Price.Sub(mid)
+		if side == connector.OrderSideSell {
+			distance = distance.Neg()
+		}
+
+		// Solve (excess + q*distance) / (filled + q) = limit for q
+		if distance.GreaterThan(limit) {
+			room := limit.Mul(filled).Sub(excess)
+			if !room.IsPositive() {
+				return filled
+			}
+			q := room.Div(distance.Sub(limit))
+			if q.LessThan(level.Quantity) {
+				return filled.Add(q)
+			}
+		}
+
+		filled = filled.Add(level.Quantity)
+		excess = excess.Add(level.Quantity.Mul(distance))
+	}
+
+	return filled
+}
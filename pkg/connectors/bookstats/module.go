@@ -0,0 +1,10 @@
+package bookstats
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(
+		NewResiliencyTracker,
+		NewOrderBookAggregator,
+	),
+)
@@ -0,0 +1,145 @@
+package bookstats
+
+import (
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+)
+
+const (
+	// DefaultBandBps is the band around mid used to measure near-touch depth
+	DefaultBandBps = 10
+
+	// DefaultWindow is how much book history is kept per asset
+	DefaultWindow = 5 * time.Minute
+
+	// depletionRatio marks depth below this fraction of the window average as depleted
+	depletionRatio = 0.5
+)
+
+// Resiliency describes how near-touch liquidity behaves over the window
+type Resiliency struct {
+	Asset   portfolio.Asset
+	Samples int
+
+	AvgDepth numerical.Decimal
+	MinDepth numerical.Decimal
+
+	// Depletions counts drops below half the average depth; AvgRecovery is
+	// the mean time taken to climb back above the average afterwards
+	Depletions  int
+	AvgRecovery time.Duration
+}
+
+// ResiliencyTracker samples order books over time and measures how quickly
+// depth near mid refills after being taken
+type ResiliencyTracker interface {
+	Observe(book *connector.OrderBook)
+	Resiliency(asset portfolio.Asset) (Resiliency, bool)
+	Configure(bandBps numerical.Decimal, window time.Duration)
+}
+
+type depthSample struct {
+	at    time.Time
+	depth numerical.Decimal
+}
+
+type resiliencyTracker struct {
+	bandBps numerical.Decimal
+	window  time.Duration
+	samples map[string][]depthSample
+	mu      sync.Mutex
+}
+
+func NewResiliencyTracker() ResiliencyTracker {
+	return &resiliencyTracker{
+		bandBps: numerical.NewFromInt(DefaultBandBps),
+		window:  DefaultWindow,
+		samples: make(map[string][]depthSample),
+	}
+}
+
+func (r *resiliencyTracker) Configure(bandBps numerical.Decimal, window time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if bandBps.IsPositive() {
+		r.bandBps = bandBps
+	}
+	if window > 0 {
+		r.window = window
+	}
+}
+
+func (r *resiliencyTracker) Observe(book *connector.OrderBook) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	depth, ok := DepthWithin(book, r.bandBps)
+	if !ok {
+		return
+	}
+
+	symbol := book.Asset.Symbol()
+	samples := append(r.samples[symbol], depthSample{
+		at:    book.Timestamp,
+		depth: depth.BidNotional.Add(depth.AskNotional),
+	})
+
+	// Book timestamps come from the exchange, so age out relative to the newest sample
+	cutoff := book.Timestamp.Add(-r.window)
+	start := 0
+	for start < len(samples) && samples[start].at.Before(cutoff) {
+		start++
+	}
+	r.samples[symbol] = samples[start:]
+}
+
+func (r *resiliencyTracker) Resiliency(asset portfolio.Asset) (Resiliency, bool) {
+	r.mu.Lock()
+	samples := append([]depthSample(nil), r.samples[asset.Symbol()]...)
+	r.mu.Unlock()
+
+	result := Resiliency{Asset: asset, Samples: len(samples)}
+	if len(samples) == 0 {
+		return result, false
+	}
+
+	total := numerical.Zero()
+	result.MinDepth = samples[0].depth
+	for _, sample := range samples {
+		total = total.Add(sample.depth)
+		if sample.depth.LessThan(result.MinDepth) {
+			result.MinDepth = sample.depth
+		}
+	}
+	result.AvgDepth = total.Div(numerical.NewFromInt(int64(len(samples))))
+
+	threshold := result.AvgDepth.Mul(numerical.NewFromFloat(depletionRatio))
+	var depletedAt time.Time
+	var recoveries []time.Duration
+
+	for _, sample := range samples {
+		switch {
+		case depletedAt.IsZero() && sample.depth.LessThan(threshold):
+			depletedAt = sample.at
+			result.Depletions++
+		case !depletedAt.IsZero() && !sample.depth.LessThan(result.AvgDepth):
+			recoveries = append(recoveries, sample.at.Sub(depletedAt))
+			depletedAt = time.Time{}
+		}
+	}
+
+	if len(recoveries) > 0 {
+		var sum time.Duration
+		for _, recovery := range recoveries {
+			sum += recovery
+		}
+		result.AvgRecovery = sum / time.Duration(len(recoveries))
+	}
+
+	return result, true
+}
@@ -2,16 +2,26 @@ package bybit
 
 import (
 	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
 )
 
 func (b *bybit) GetAccountBalance() (*connector.AccountBalance, error) {
+	if err := b.limiter.Wait(ratelimit.EndpointAccount); err != nil {
+		return nil, err
+	}
 	return b.trading.GetAccountBalance()
 }
 
 func (b *bybit) GetPositions() ([]connector.Position, error) {
+	if err := b.limiter.Wait(ratelimit.EndpointAccount); err != nil {
+		return nil, err
+	}
 	return b.trading.GetPositions()
 }
 
 func (b *bybit) GetTradingHistory(symbol string, limit int) ([]connector.Trade, error) {
-	return b.trading.GetTradingHistory(symbol, limit)
+	if err := b.limiter.Wait(ratelimit.EndpointTrades); err != nil {
+		return nil, err
+	}
+	return b.trading.GetTradingHistory(connector.TypePerpetual, symbol, limit)
 }
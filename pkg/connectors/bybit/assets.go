@@ -5,13 +5,20 @@ import (
 
 	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
 )
 
 func (b *bybit) FetchAvailablePerpetualAssets() ([]portfolio.Asset, error) {
+	if err := b.limiter.Wait(ratelimit.EndpointInstruments); err != nil {
+		return nil, err
+	}
 	return b.marketData.FetchAvailablePerpetualAssets()
 }
 
 func (b *bybit) FetchAvailableSpotAssets() ([]portfolio.Asset, error) {
+	if err := b.limiter.Wait(ratelimit.EndpointInstruments); err != nil {
+		return nil, err
+	}
 	return b.marketData.FetchAvailableSpotAssets()
 }
 
@@ -20,10 +27,16 @@ func (b *bybit) FetchContracts() ([]connector.ContractInfo, error) {
 }
 
 func (b *bybit) FetchCurrentFundingRates() (map[portfolio.Asset]connector.FundingRate, error) {
+	if err := b.limiter.Wait(ratelimit.EndpointFunding); err != nil {
+		return nil, err
+	}
 	return b.marketData.FetchCurrentFundingRates()
 }
 
 func (b *bybit) FetchHistoricalFundingRates(symbol portfolio.Asset, startTime, endTime int64) ([]connector.HistoricalFundingRate, error) {
+	if err := b.limiter.Wait(ratelimit.EndpointFunding); err != nil {
+		return nil, err
+	}
 	return b.marketData.FetchHistoricalFundingRates(symbol.Symbol()+"USDT", startTime, endTime)
 }
 
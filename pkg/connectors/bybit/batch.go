@@ -0,0 +1,68 @@
+package bybit
+
+import (
+	"fmt"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+// maxBatchSize is the per-request limit for linear batch orders and cancels
+const maxBatchSize = 20
+
+var _ types.BatchOrderProvider = (*bybit)(nil)
+
+func (b *bybit) MaxBatchSize() int {
+	return maxBatchSize
+}
+
+func (b *bybit) PlaceOrders(requests []types.OrderRequest) ([]types.OrderResult, error) {
+	if len(requests) > maxBatchSize {
+		return nil, fmt.Errorf("batch of %d orders exceeds the limit of %d", len(requests), maxBatchSize)
+	}
+	for i, request := range requests {
+		if request.Type != connector.OrderTypeLimit {
+			return nil, fmt.Errorf("batch order %d: only limit orders can be batched", i)
+		}
+		if err := request.Validate(); err != nil {
+			return nil, fmt.Errorf("batch order %d: %w", i, err)
+		}
+	}
+	if err := b.limiter.Wait(ratelimit.EndpointPlaceOrder); err != nil {
+		return nil, err
+	}
+	if !b.SupportsTradingOperations() {
+		return nil, fmt.Errorf("trading operations not supported")
+	}
+
+	results, err := b.trading.PlaceBatchLimitOrders(connector.TypePerpetual, requests)
+	if err != nil {
+		return nil, types.NewConnectorError(types.Bybit, "place batch orders", err)
+	}
+	for i := range results {
+		if results[i].Err != nil {
+			request := requests[i]
+			results[i].Err = b.wrapOrderError(request.Symbol, request.Side, request.Quantity, request.Price, results[i].Err)
+		}
+	}
+	return results, nil
+}
+
+func (b *bybit) CancelOrders(symbol string, orderIDs []string) ([]types.CancelResult, error) {
+	if len(orderIDs) > maxBatchSize {
+		return nil, fmt.Errorf("batch of %d cancels exceeds the limit of %d", len(orderIDs), maxBatchSize)
+	}
+	if err := b.limiter.Wait(ratelimit.EndpointCancelOrder); err != nil {
+		return nil, err
+	}
+	if !b.SupportsTradingOperations() {
+		return nil, fmt.Errorf("trading operations not supported")
+	}
+
+	results, err := b.trading.CancelBatchOrders(connector.TypePerpetual, symbol, orderIDs)
+	if err != nil {
+		return nil, types.NewConnectorError(types.Bybit, "cancel batch orders", err)
+	}
+	return results, nil
+}
@@ -14,14 +14,32 @@ type Config struct {
 	BaseURL         string  `json:"base_url,omitempty"`
 	IsTestnet       bool    `json:"is_testnet,omitempty"`
 	DefaultSlippage float64 `json:"default_slippage,omitempty"` // Default 0.005 (0.5%)
+
+	// MarginMode is the account margin mode risk settings were sized for;
+	// startup warns when the account reports a different one
+	MarginMode types.MarginMode `json:"margin_mode,omitempty"`
 }
 
 var _ connector.Config = (*Config)(nil)
+var _ types.SecretConfig = (*Config)(nil)
 
 func (c *Config) ExchangeName() connector.ExchangeName {
 	return types.Bybit
 }
 
+func (c *Config) UsesTestnet() bool {
+	return c.IsTestnet
+}
+
+func (c *Config) SecretFields() map[string]*string {
+	return map[string]*string{"api_key": &c.APIKey, "api_secret": &c.APISecret}
+}
+
+// ExpectedMarginMode returns the configured margin mode, if any
+func (c *Config) ExpectedMarginMode() types.MarginMode {
+	return c.MarginMode
+}
+
 // Validate checks if the configuration is valid
 func (c *Config) Validate() error {
 	if c.APIKey == "" {
@@ -31,6 +49,10 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("api_secret is required")
 	}
 
+	if c.MarginMode != "" && !types.ValidMarginMode(c.MarginMode) {
+		return fmt.Errorf("margin_mode must be isolated, cross or portfolio, got %q", c.MarginMode)
+	}
+
 	// Set default slippage if not provided
 	if c.DefaultSlippage == 0 {
 		c.DefaultSlippage = 0.005
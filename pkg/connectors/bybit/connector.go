@@ -12,6 +12,8 @@ import (
 	"github.com/backtesting-org/live-trading/pkg/connectors/bybit/data"
 	"github.com/backtesting-org/live-trading/pkg/connectors/bybit/data/real_time"
 	"github.com/backtesting-org/live-trading/pkg/connectors/bybit/trading"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
 )
 
 type bybit struct {
@@ -22,6 +24,7 @@ type bybit struct {
 	appLogger     logging.ApplicationLogger
 	tradingLogger logging.TradingLogger
 	timeProvider  temporal.TimeProvider
+	limiter       ratelimit.Limiter
 	ctx           context.Context
 	initialized   bool
 
@@ -54,6 +57,7 @@ func NewBybit(
 	appLogger logging.ApplicationLogger,
 	tradingLogger logging.TradingLogger,
 	timeProvider temporal.TimeProvider,
+	limiters ratelimit.RateLimiters,
 ) connector.Connector {
 	return &bybit{
 		trading:       tradingService,
@@ -63,6 +67,7 @@ func NewBybit(
 		appLogger:     appLogger,
 		tradingLogger: tradingLogger,
 		timeProvider:  timeProvider,
+		limiter:       limiters.For(types.Bybit),
 		ctx:           context.Background(),
 		initialized:   false,
 		tradeCh:       make(chan connector.Trade, 100),
@@ -147,5 +152,6 @@ func (b *bybit) SupportsMarketData() bool {
 }
 
 func (b *bybit) GetPerpSymbol(asset portfolio.Asset) string {
-	return asset.Symbol() + "USDT"
+	symbol, _ := symbolRule.ToNative(asset, connector.TypePerpetual)
+	return symbol
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
 	bybit "github.com/bybit-exchange/bybit.go.api"
 )
 
@@ -24,15 +25,19 @@ type Config struct {
 
 type MarketDataService interface {
 	Initialize(config *Config) error
-	FetchKlines(symbol, interval string, limit int) ([]connector.Kline, error)
-	FetchPrice(symbol string) (*connector.Price, error)
-	FetchOrderBook(symbol string, depth int) (*connector.OrderBook, error)
-	FetchRecentTrades(symbol string, limit int) ([]connector.Trade, error)
+	FetchKlines(instrument connector.Instrument, symbol, interval string, limit int) ([]connector.Kline, error)
+	FetchKlinesRange(symbol, interval string, start, end time.Time, limit int) ([]connector.Kline, error)
+	FetchPrice(instrument connector.Instrument, symbol string) (*connector.Price, error)
+	FetchMarkPrice(symbol string) (*types.MarkPrice, error)
+	FetchOrderBook(instrument connector.Instrument, symbol string, depth int) (*connector.OrderBook, error)
+	FetchRecentTrades(instrument connector.Instrument, symbol string, limit int) ([]connector.Trade, error)
 	FetchFundingRate(symbol string) (*connector.FundingRate, error)
 	FetchCurrentFundingRates() (map[portfolio.Asset]connector.FundingRate, error)
 	FetchHistoricalFundingRates(symbol string, startTime, endTime int64) ([]connector.HistoricalFundingRate, error)
 	FetchAvailablePerpetualAssets() ([]portfolio.Asset, error)
 	FetchAvailableSpotAssets() ([]portfolio.Asset, error)
+	FetchInstrumentStatuses() ([]types.InstrumentStatus, error)
+	FetchServerTime() (time.Time, error)
 }
 
 type marketDataService struct {
@@ -61,7 +66,7 @@ func (m *marketDataService) Initialize(config *Config) error {
 	return nil
 }
 
-func (m *marketDataService) FetchKlines(symbol, interval string, limit int) ([]connector.Kline, error) {
+func (m *marketDataService) FetchKlines(instrument connector.Instrument, symbol, interval string, limit int) ([]connector.Kline, error) {
 	m.mu.RLock()
 	client := m.client
 	m.mu.RUnlock()
@@ -71,12 +76,41 @@ func (m *marketDataService) FetchKlines(symbol, interval string, limit int) ([]c
 	}
 
 	params := map[string]interface{}{
-		"category": "linear",
+		"category": category(instrument),
 		"symbol":   symbol,
 		"interval": interval,
 		"limit":    limit,
 	}
 
+	return m.fetchKlines(client, params)
+}
+
+// FetchKlinesRange returns klines opening within [start, end] as Bybit
+// orders them, newest first
+func (m *marketDataService) FetchKlinesRange(symbol, interval string, start, end time.Time, limit int) ([]connector.Kline, error) {
+	m.mu.RLock()
+	client := m.client
+	m.mu.RUnlock()
+
+	if client == nil {
+		return nil, fmt.Errorf("market data service not initialized")
+	}
+
+	params := map[string]interface{}{
+		"category": "linear",
+		"symbol":   symbol,
+		"interval": interval,
+		"start":    start.UnixMilli(),
+		"end":      end.UnixMilli(),
+	}
+	if limit > 0 {
+		params["limit"] = limit
+	}
+
+	return m.fetchKlines(client, params)
+}
+
+func (m *marketDataService) fetchKlines(client *bybit.Client, params map[string]interface{}) ([]connector.Kline, error) {
 	result, err := client.NewUtaBybitServiceWithParams(params).GetMarketKline(context.Background())
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch klines: %w", err)
@@ -139,7 +173,7 @@ func (m *marketDataService) parseKline(data []interface{}) connector.Kline {
 	return kline
 }
 
-func (m *marketDataService) FetchPrice(symbol string) (*connector.Price, error) {
+func (m *marketDataService) FetchPrice(instrument connector.Instrument, symbol string) (*connector.Price, error) {
 	m.mu.RLock()
 	client := m.client
 	m.mu.RUnlock()
@@ -149,7 +183,7 @@ func (m *marketDataService) FetchPrice(symbol string) (*connector.Price, error)
 	}
 
 	params := map[string]interface{}{
-		"category": "linear",
+		"category": category(instrument),
 		"symbol":   symbol,
 	}
 
@@ -180,7 +214,8 @@ func (m *marketDataService) FetchPrice(symbol string) (*connector.Price, error)
 	return nil, fmt.Errorf("price not found")
 }
 
-func (m *marketDataService) FetchOrderBook(symbol string, depth int) (*connector.OrderBook, error) {
+// FetchMarkPrice reads markPrice and indexPrice from the linear ticker
+func (m *marketDataService) FetchMarkPrice(symbol string) (*types.MarkPrice, error) {
 	m.mu.RLock()
 	client := m.client
 	m.mu.RUnlock()
@@ -192,6 +227,48 @@ func (m *marketDataService) FetchOrderBook(symbol string, depth int) (*connector
 	params := map[string]interface{}{
 		"category": "linear",
 		"symbol":   symbol,
+	}
+
+	result, err := client.NewUtaBybitServiceWithParams(params).GetMarketTickers(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch mark price: %w", err)
+	}
+
+	if result != nil && result.Result != nil {
+		if resultData, ok := result.Result.(map[string]interface{}); ok {
+			if listData, ok := resultData["list"].([]interface{}); ok && len(listData) > 0 {
+				if tickerData, ok := listData[0].(map[string]interface{}); ok {
+					markPrice, _ := tickerData["markPrice"].(string)
+					indexPrice, _ := tickerData["indexPrice"].(string)
+					if mark, err := numerical.NewFromString(markPrice); err == nil {
+						index, _ := numerical.NewFromString(indexPrice)
+						return &types.MarkPrice{
+							Exchange:   types.Bybit,
+							MarkPrice:  mark,
+							IndexPrice: index,
+							Timestamp:  m.timeProvider.Now(),
+						}, nil
+					}
+				}
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("mark price not found")
+}
+
+func (m *marketDataService) FetchOrderBook(instrument connector.Instrument, symbol string, depth int) (*connector.OrderBook, error) {
+	m.mu.RLock()
+	client := m.client
+	m.mu.RUnlock()
+
+	if client == nil {
+		return nil, fmt.Errorf("market data service not initialized")
+	}
+
+	params := map[string]interface{}{
+		"category": category(instrument),
+		"symbol":   symbol,
 		"limit":    depth,
 	}
 
@@ -247,7 +324,7 @@ func (m *marketDataService) FetchOrderBook(symbol string, depth int) (*connector
 	return orderBook, nil
 }
 
-func (m *marketDataService) FetchRecentTrades(symbol string, limit int) ([]connector.Trade, error) {
+func (m *marketDataService) FetchRecentTrades(instrument connector.Instrument, symbol string, limit int) ([]connector.Trade, error) {
 	m.mu.RLock()
 	client := m.client
 	m.mu.RUnlock()
@@ -257,7 +334,7 @@ func (m *marketDataService) FetchRecentTrades(symbol string, limit int) ([]conne
 	}
 
 	params := map[string]interface{}{
-		"category": "linear",
+		"category": category(instrument),
 		"symbol":   symbol,
 		"limit":    limit,
 	}
@@ -433,6 +510,94 @@ func (m *marketDataService) FetchAvailableSpotAssets() ([]portfolio.Asset, error
 	return assets, nil
 }
 
+func (m *marketDataService) FetchInstrumentStatuses() ([]types.InstrumentStatus, error) {
+	m.mu.RLock()
+	client := m.client
+	m.mu.RUnlock()
+
+	if client == nil {
+		return nil, fmt.Errorf("market data service not initialized")
+	}
+
+	params := map[string]interface{}{
+		"category": "linear",
+		"limit":    1000,
+	}
+
+	result, err := client.NewUtaBybitServiceWithParams(params).GetInstrumentInfo(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch instruments: %w", err)
+	}
+
+	var statuses []types.InstrumentStatus
+
+	if result != nil && result.Result != nil {
+		if resultData, ok := result.Result.(map[string]interface{}); ok {
+			if listData, ok := resultData["list"].([]interface{}); ok {
+				for _, item := range listData {
+					instrumentData, ok := item.(map[string]interface{})
+					if !ok {
+						continue
+					}
+					symbol, ok := instrumentData["symbol"].(string)
+					if !ok {
+						continue
+					}
+
+					raw, _ := instrumentData["status"].(string)
+					status := types.InstrumentStatus{
+						Symbol:      symbol,
+						State:       instrumentState(raw),
+						RawStatus:   raw,
+						MaxLeverage: numerical.Zero(),
+					}
+
+					// Perpetuals report "0" until a delisting is scheduled
+					if delivery, ok := instrumentData["deliveryTime"].(string); ok {
+						if ms, err := strconv.ParseInt(delivery, 10, 64); err == nil && ms > 0 {
+							status.DeliveryAt = time.UnixMilli(ms).UTC()
+						}
+					}
+					if leverage, ok := instrumentData["leverageFilter"].(map[string]interface{}); ok {
+						if maxLeverage, ok := leverage["maxLeverage"].(string); ok {
+							if value, err := numerical.NewFromString(maxLeverage); err == nil {
+								status.MaxLeverage = value
+							}
+						}
+					}
+
+					statuses = append(statuses, status)
+				}
+			}
+		}
+	}
+
+	return statuses, nil
+}
+
+// instrumentState maps /v5/market/instruments-info statuses
+// category is the v5 product category an instrument trades under
+func category(instrument connector.Instrument) string {
+	if instrument == connector.TypeSpot {
+		return "spot"
+	}
+	return "linear"
+}
+
+func instrumentState(status string) types.InstrumentState {
+	switch status {
+	case "Trading":
+		return types.InstrumentTrading
+	case "PreLaunch":
+		return types.InstrumentSuspended
+	case "Settling", "Delivering":
+		return types.InstrumentDelisting
+	case "Closed":
+		return types.InstrumentDelisted
+	}
+	return types.InstrumentUnknown
+}
+
 func (m *marketDataService) FetchCurrentFundingRates() (map[portfolio.Asset]connector.FundingRate, error) {
 	m.mu.RLock()
 	client := m.client
@@ -518,3 +683,30 @@ func (m *marketDataService) FetchHistoricalFundingRates(symbol string, startTime
 
 	return rates, nil
 }
+
+// FetchServerTime reads the exchange clock at nanosecond precision
+func (m *marketDataService) FetchServerTime() (time.Time, error) {
+	m.mu.RLock()
+	client := m.client
+	m.mu.RUnlock()
+
+	if client == nil {
+		return time.Time{}, fmt.Errorf("market data service not initialized")
+	}
+
+	result, err := client.NewUtaBybitServiceNoParams().GetServerTime(context.Background())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get server time: %w", err)
+	}
+	if result != nil && result.RetCode != 0 {
+		return time.Time{}, fmt.Errorf("server time query rejected: %s (code %d)", result.RetMsg, result.RetCode)
+	}
+
+	resultData, _ := result.Result.(map[string]interface{})
+	timeNano, _ := resultData["timeNano"].(string)
+	nanos, err := strconv.ParseInt(timeNano, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid server time %q: %w", timeNano, err)
+	}
+	return time.Unix(0, nanos), nil
+}
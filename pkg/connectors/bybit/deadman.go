@@ -0,0 +1,46 @@
+package bybit
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+// Disconnection cancel protection accepts windows of 3 to 300 seconds
+const (
+	minDisconnectWindow = 3 * time.Second
+	maxDisconnectWindow = 300 * time.Second
+)
+
+var (
+	_ types.CancelAllProvider          = (*bybit)(nil)
+	_ types.CancelOnDisconnectProvider = (*bybit)(nil)
+)
+
+func (b *bybit) CancelAllOrders() error {
+	if err := b.limiter.Wait(ratelimit.EndpointCancelOrder); err != nil {
+		return err
+	}
+	if !b.SupportsTradingOperations() {
+		return fmt.Errorf("trading operations not supported")
+	}
+	return b.trading.CancelAllOrders(connector.TypePerpetual)
+}
+
+// ArmCancelOnDisconnect sets the account's disconnection cancel protection
+// window. The setting is account-wide and outlives this process.
+func (b *bybit) ArmCancelOnDisconnect(window time.Duration) error {
+	if window < minDisconnectWindow || window > maxDisconnectWindow {
+		return fmt.Errorf("disconnect window %s outside %s-%s", window, minDisconnectWindow, maxDisconnectWindow)
+	}
+	if err := b.limiter.Wait(ratelimit.EndpointAccount); err != nil {
+		return err
+	}
+	if !b.SupportsTradingOperations() {
+		return fmt.Errorf("trading operations not supported")
+	}
+	return b.trading.SetDisconnectCancel(window)
+}
@@ -0,0 +1,15 @@
+package bybit
+
+import (
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+var _ types.InstrumentStatusProvider = (*bybit)(nil)
+
+func (b *bybit) FetchInstrumentStatuses() ([]types.InstrumentStatus, error) {
+	if err := b.limiter.Wait(ratelimit.EndpointInstruments); err != nil {
+		return nil, err
+	}
+	return b.marketData.FetchInstrumentStatuses()
+}
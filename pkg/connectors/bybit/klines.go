@@ -0,0 +1,18 @@
+package bybit
+
+import (
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+var _ types.KlineRangeProvider = (*bybit)(nil)
+
+func (b *bybit) FetchKlinesRange(symbol, interval string, start, end time.Time, limit int) ([]connector.Kline, error) {
+	if err := b.limiter.Wait(ratelimit.EndpointKlines); err != nil {
+		return nil, err
+	}
+	return b.marketData.FetchKlinesRange(symbol, interval, start, end, limit)
+}
@@ -0,0 +1,22 @@
+package bybit
+
+import (
+	"fmt"
+
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+var _ types.MarginInfoProvider = (*bybit)(nil)
+
+// FetchMarginInfo returns the unified account's margin mode and requirements
+func (b *bybit) FetchMarginInfo() (*types.MarginInfo, error) {
+	if err := b.limiter.Wait(ratelimit.EndpointAccount); err != nil {
+		return nil, err
+	}
+	if !b.initialized {
+		return nil, fmt.Errorf("connector not initialized")
+	}
+
+	return b.trading.GetMarginInfo()
+}
@@ -0,0 +1,28 @@
+package bybit
+
+import (
+	"fmt"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+var _ types.MarkPriceProvider = (*bybit)(nil)
+
+// FetchMarkPrice reads mark and index from the linear ticker
+func (b *bybit) FetchMarkPrice(asset portfolio.Asset) (*types.MarkPrice, error) {
+	if err := b.limiter.Wait(ratelimit.EndpointPrice); err != nil {
+		return nil, err
+	}
+	if !b.initialized {
+		return nil, fmt.Errorf("connector not initialized")
+	}
+
+	mark, err := b.marketData.FetchMarkPrice(asset.Symbol() + "USDT")
+	if err != nil {
+		return nil, err
+	}
+	mark.Asset = asset
+	return mark, nil
+}
@@ -3,24 +3,55 @@ package bybit
 import (
 	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
 )
 
+var _ types.DepthSnapshotProvider = (*bybit)(nil)
+
 func (b *bybit) FetchKlines(symbol, interval string, limit int) ([]connector.Kline, error) {
-	return b.marketData.FetchKlines(symbol, interval, limit)
+	if err := b.limiter.Wait(ratelimit.EndpointKlines); err != nil {
+		return nil, err
+	}
+	return b.marketData.FetchKlines(connector.TypePerpetual, symbol, interval, limit)
 }
 
 func (b *bybit) FetchPrice(symbol string) (*connector.Price, error) {
-	return b.marketData.FetchPrice(symbol)
+	if err := b.limiter.Wait(ratelimit.EndpointPrice); err != nil {
+		return nil, err
+	}
+	return b.marketData.FetchPrice(connector.TypePerpetual, symbol)
 }
 
 func (b *bybit) FetchOrderBook(symbol portfolio.Asset, instrument connector.Instrument, depth int) (*connector.OrderBook, error) {
-	return b.marketData.FetchOrderBook(symbol.Symbol()+"USDT", depth)
+	if err := b.limiter.Wait(ratelimit.EndpointOrderBook); err != nil {
+		return nil, err
+	}
+	native, err := symbolRule.ToNative(symbol, instrument)
+	if err != nil {
+		return nil, err
+	}
+	return b.marketData.FetchOrderBook(instrument, native, depth)
 }
 
 func (b *bybit) FetchRecentTrades(symbol string, limit int) ([]connector.Trade, error) {
-	return b.marketData.FetchRecentTrades(symbol, limit)
+	if err := b.limiter.Wait(ratelimit.EndpointTrades); err != nil {
+		return nil, err
+	}
+	return b.marketData.FetchRecentTrades(connector.TypePerpetual, symbol, limit)
 }
 
 func (b *bybit) FetchFundingRate(asset portfolio.Asset) (*connector.FundingRate, error) {
+	if err := b.limiter.Wait(ratelimit.EndpointFunding); err != nil {
+		return nil, err
+	}
 	return b.marketData.FetchFundingRate(asset.Symbol() + "USDT")
 }
+
+// MaxSnapshotDepth is the orderbook endpoint's limit, which is lower for spot
+func (b *bybit) MaxSnapshotDepth(instrument connector.Instrument) int {
+	if instrument == connector.TypeSpot {
+		return 200
+	}
+	return 500
+}
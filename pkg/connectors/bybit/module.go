@@ -6,10 +6,17 @@ import (
 	"github.com/backtesting-org/live-trading/pkg/connectors/bybit/data"
 	"github.com/backtesting-org/live-trading/pkg/connectors/bybit/data/real_time"
 	"github.com/backtesting-org/live-trading/pkg/connectors/bybit/trading"
+	"github.com/backtesting-org/live-trading/pkg/connectors/symbols"
 	"github.com/backtesting-org/live-trading/pkg/connectors/types"
 	"go.uber.org/fx"
 )
 
+// symbolRule maps assets to linear and spot symbols, which share the USDT suffix
+var symbolRule = symbols.NewSuffixRule(map[connector.Instrument]string{
+	connector.TypePerpetual: "USDT",
+	connector.TypeSpot:      "USDT",
+})
+
 var Module = fx.Options(
 	fx.Provide(
 		trading.NewTradingService,
@@ -26,6 +33,7 @@ var Module = fx.Options(
 	)),
 )
 
-func registerBybit(bybitConn connector.Connector, reg registry.ConnectorRegistry) {
+func registerBybit(bybitConn connector.Connector, reg registry.ConnectorRegistry, mapper symbols.SymbolMapper) {
+	mapper.Register(types.Bybit, symbolRule)
 	reg.RegisterConnector(types.Bybit, bybitConn)
 }
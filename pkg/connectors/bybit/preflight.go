@@ -0,0 +1,38 @@
+package bybit
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+var (
+	_ types.APIKeyInspector    = (*bybit)(nil)
+	_ types.ServerTimeProvider = (*bybit)(nil)
+)
+
+// FetchAPIKeyPermissions reports what the configured key may do
+func (b *bybit) FetchAPIKeyPermissions() (*types.APIKeyPermissions, error) {
+	if err := b.limiter.Wait(ratelimit.EndpointAccount); err != nil {
+		return nil, err
+	}
+	if !b.initialized {
+		return nil, fmt.Errorf("connector not initialized")
+	}
+
+	return b.trading.GetAPIKeyPermissions()
+}
+
+// FetchServerTime returns Bybit's clock, which signed requests are checked against
+func (b *bybit) FetchServerTime() (time.Time, error) {
+	if err := b.limiter.Wait(ratelimit.EndpointInstruments); err != nil {
+		return time.Time{}, err
+	}
+	if !b.initialized {
+		return time.Time{}, fmt.Errorf("connector not initialized")
+	}
+
+	return b.marketData.FetchServerTime()
+}
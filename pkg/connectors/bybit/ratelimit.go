@@ -0,0 +1,9 @@
+package bybit
+
+import "github.com/backtesting-org/live-trading/pkg/connectors/types"
+
+var _ types.RateLimitProvider = (*bybit)(nil)
+
+func (b *bybit) RateLimitStatus() types.RateLimitStatus {
+	return b.limiter.Status()
+}
@@ -0,0 +1,90 @@
+package bybit
+
+import (
+	"fmt"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+var _ types.InstrumentTrader = (*bybit)(nil)
+
+func (b *bybit) PlaceLimitOrderFor(instrument connector.Instrument, asset portfolio.Asset, side connector.OrderSide, quantity, price numerical.Decimal) (*connector.OrderResponse, error) {
+	symbol, err := symbolRule.ToNative(asset, instrument)
+	if err != nil {
+		return nil, err
+	}
+	if err := b.limiter.Wait(ratelimit.EndpointPlaceOrder); err != nil {
+		return nil, err
+	}
+	if !b.SupportsTradingOperations() {
+		return nil, fmt.Errorf("trading operations not supported")
+	}
+	resp, err := b.trading.PlaceLimitOrder(instrument, symbol, side, quantity, price)
+	if err != nil {
+		return nil, b.wrapOrderError(symbol, side, quantity, price, err)
+	}
+	return resp, nil
+}
+
+func (b *bybit) PlaceMarketOrderFor(instrument connector.Instrument, asset portfolio.Asset, side connector.OrderSide, quantity numerical.Decimal) (*connector.OrderResponse, error) {
+	symbol, err := symbolRule.ToNative(asset, instrument)
+	if err != nil {
+		return nil, err
+	}
+	if err := b.limiter.Wait(ratelimit.EndpointPlaceOrder); err != nil {
+		return nil, err
+	}
+	if !b.SupportsTradingOperations() {
+		return nil, fmt.Errorf("trading operations not supported")
+	}
+	resp, err := b.trading.PlaceMarketOrder(instrument, symbol, side, quantity)
+	if err != nil {
+		return nil, b.wrapOrderError(symbol, side, quantity, numerical.Zero(), err)
+	}
+	return resp, nil
+}
+
+func (b *bybit) CancelOrderFor(instrument connector.Instrument, asset portfolio.Asset, orderID string) (*connector.CancelResponse, error) {
+	symbol, err := symbolRule.ToNative(asset, instrument)
+	if err != nil {
+		return nil, err
+	}
+	if err := b.limiter.Wait(ratelimit.EndpointCancelOrder); err != nil {
+		return nil, err
+	}
+	if !b.SupportsTradingOperations() {
+		return nil, fmt.Errorf("trading operations not supported")
+	}
+	return b.trading.CancelOrder(instrument, symbol, orderID)
+}
+
+func (b *bybit) GetOpenOrdersFor(instrument connector.Instrument) ([]connector.Order, error) {
+	if err := b.limiter.Wait(ratelimit.EndpointOrders); err != nil {
+		return nil, err
+	}
+	return b.trading.GetOpenOrders(instrument)
+}
+
+func (b *bybit) FetchPriceFor(instrument connector.Instrument, asset portfolio.Asset) (*connector.Price, error) {
+	symbol, err := symbolRule.ToNative(asset, instrument)
+	if err != nil {
+		return nil, err
+	}
+	if err := b.limiter.Wait(ratelimit.EndpointPrice); err != nil {
+		return nil, err
+	}
+	return b.marketData.FetchPrice(instrument, symbol)
+}
+
+// GetSpotBalances lists coin holdings; under the unified account these are
+// the same wallet that collateralises perpetuals
+func (b *bybit) GetSpotBalances() ([]types.SpotBalance, error) {
+	if err := b.limiter.Wait(ratelimit.EndpointAccount); err != nil {
+		return nil, err
+	}
+	return b.trading.GetSpotBalances()
+}
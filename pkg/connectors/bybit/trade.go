@@ -1,37 +1,96 @@
 package bybit
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
 )
 
 func (b *bybit) PlaceLimitOrder(symbol string, side connector.OrderSide, quantity, price numerical.Decimal) (*connector.OrderResponse, error) {
+	if err := b.limiter.Wait(ratelimit.EndpointPlaceOrder); err != nil {
+		return nil, err
+	}
 	if !b.SupportsTradingOperations() {
 		return nil, fmt.Errorf("trading operations not supported")
 	}
-	return b.trading.PlaceLimitOrder(symbol, side, quantity, price)
+	resp, err := b.trading.PlaceLimitOrder(connector.TypePerpetual, symbol, side, quantity, price)
+	if err != nil {
+		return nil, b.wrapOrderError(symbol, side, quantity, price, err)
+	}
+	return resp, nil
 }
 
 func (b *bybit) PlaceMarketOrder(symbol string, side connector.OrderSide, quantity numerical.Decimal) (*connector.OrderResponse, error) {
+	if err := b.limiter.Wait(ratelimit.EndpointPlaceOrder); err != nil {
+		return nil, err
+	}
 	if !b.SupportsTradingOperations() {
 		return nil, fmt.Errorf("trading operations not supported")
 	}
-	return b.trading.PlaceMarketOrder(symbol, side, quantity)
+	resp, err := b.trading.PlaceMarketOrder(connector.TypePerpetual, symbol, side, quantity)
+	if err != nil {
+		return nil, b.wrapOrderError(symbol, side, quantity, numerical.Zero(), err)
+	}
+	return resp, nil
 }
 
 func (b *bybit) CancelOrder(symbol, orderID string) (*connector.CancelResponse, error) {
+	if err := b.limiter.Wait(ratelimit.EndpointCancelOrder); err != nil {
+		return nil, err
+	}
 	if !b.SupportsTradingOperations() {
 		return nil, fmt.Errorf("trading operations not supported")
 	}
-	return b.trading.CancelOrder(symbol, orderID)
+	return b.trading.CancelOrder(connector.TypePerpetual, symbol, orderID)
 }
 
 func (b *bybit) GetOpenOrders() ([]connector.Order, error) {
-	return b.trading.GetOpenOrders()
+	if err := b.limiter.Wait(ratelimit.EndpointOrders); err != nil {
+		return nil, err
+	}
+	return b.trading.GetOpenOrders(connector.TypePerpetual)
 }
 
 func (b *bybit) GetOrderStatus(orderID string) (*connector.Order, error) {
-	return b.trading.GetOrderStatus(orderID)
+	if err := b.limiter.Wait(ratelimit.EndpointOrders); err != nil {
+		return nil, err
+	}
+	return b.trading.GetOrderStatus(connector.TypePerpetual, orderID)
+}
+
+// wrapOrderError categorises order rejections, and tags insufficient-balance
+// ones with the balance at rejection time
+func (b *bybit) wrapOrderError(symbol string, side connector.OrderSide, quantity, price numerical.Decimal, err error) error {
+	if !types.IsInsufficientBalance(err) {
+		return types.NewConnectorError(types.Bybit, "place order", err)
+	}
+
+	rejection := &types.InsufficientBalanceError{
+		Exchange: types.Bybit,
+		Symbol:   symbol,
+		Side:     side,
+		Quantity: quantity,
+		Price:    price,
+		Err:      err,
+	}
+	if balance, balanceErr := b.GetAccountBalance(); balanceErr == nil && balance != nil {
+		rejection.Available = balance.AvailableBalance
+	}
+
+	b.appLogger.Warn("Bybit rejected order for insufficient balance: %s", rejection.Error())
+	return rejection
+}
+
+var _ types.OrderPayloadSource = (*bybit)(nil)
+
+// OrderPayload returns Bybit's last response about the order
+func (b *bybit) OrderPayload(orderID string) (json.RawMessage, bool) {
+	if !b.SupportsTradingOperations() {
+		return nil, false
+	}
+	return b.trading.OrderPayload(orderID)
 }
@@ -2,8 +2,12 @@ package trading
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strconv"
 	"sync"
+	"time"
 
 	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
 	bybit "github.com/bybit-exchange/bybit.go.api"
@@ -11,6 +15,7 @@ import (
 	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
 )
 
 type Config struct {
@@ -23,26 +28,46 @@ type Config struct {
 
 type TradingService interface {
 	Initialize(config *Config) error
-	PlaceLimitOrder(symbol string, side connector.OrderSide, quantity, price numerical.Decimal) (*connector.OrderResponse, error)
-	PlaceMarketOrder(symbol string, side connector.OrderSide, quantity numerical.Decimal) (*connector.OrderResponse, error)
-	CancelOrder(symbol, orderID string) (*connector.CancelResponse, error)
-	GetOpenOrders() ([]connector.Order, error)
-	GetOrderStatus(orderID string) (*connector.Order, error)
+	PlaceLimitOrder(instrument connector.Instrument, symbol string, side connector.OrderSide, quantity, price numerical.Decimal) (*connector.OrderResponse, error)
+	PlaceMarketOrder(instrument connector.Instrument, symbol string, side connector.OrderSide, quantity numerical.Decimal) (*connector.OrderResponse, error)
+	PlaceTriggerOrder(order types.TriggerOrder) (*connector.OrderResponse, error)
+	CancelOrder(instrument connector.Instrument, symbol, orderID string) (*connector.CancelResponse, error)
+	GetOpenOrders(instrument connector.Instrument) ([]connector.Order, error)
+	GetOrderStatus(instrument connector.Instrument, orderID string) (*connector.Order, error)
 	GetAccountBalance() (*connector.AccountBalance, error)
+	GetSpotBalances() ([]types.SpotBalance, error)
 	GetPositions() ([]connector.Position, error)
-	GetTradingHistory(symbol string, limit int) ([]connector.Trade, error)
+	GetTradingHistory(instrument connector.Instrument, symbol string, limit int) ([]connector.Trade, error)
+	GetTradingHistoryRange(instrument connector.Instrument, symbol string, start, end time.Time, limit int) ([]connector.Trade, error)
+	GetWithdrawals(since time.Time) ([]types.TransferEvent, error)
+	GetUniversalTransfers(since time.Time) ([]types.TransferEvent, error)
+	GetMarginInfo() (*types.MarginInfo, error)
+	CancelAllOrders(instrument connector.Instrument) error
+	PlaceBatchLimitOrders(instrument connector.Instrument, requests []types.OrderRequest) ([]types.OrderResult, error)
+	CancelBatchOrders(instrument connector.Instrument, symbol string, orderIDs []string) ([]types.CancelResult, error)
+	SetDisconnectCancel(window time.Duration) error
+	GetAPIKeyPermissions() (*types.APIKeyPermissions, error)
+
+	// OrderPayload is Bybit's own response about the order, as last seen
+	OrderPayload(orderID string) (json.RawMessage, bool)
 }
 
+// maxExecutionPage is the largest page /v5/execution/list returns
+const maxExecutionPage = 100
+
 type tradingService struct {
 	client       *bybit.Client
 	config       *Config
 	timeProvider temporal.TimeProvider
 	mu           sync.RWMutex
+
+	payloads *types.OrderPayloads
 }
 
 func NewTradingService(timeProvider temporal.TimeProvider) TradingService {
 	return &tradingService{
 		timeProvider: timeProvider,
+		payloads:     types.NewOrderPayloads(types.DefaultPayloadCapacity),
 	}
 }
 
@@ -59,7 +84,7 @@ func (t *tradingService) Initialize(config *Config) error {
 	return nil
 }
 
-func (t *tradingService) PlaceLimitOrder(symbol string, side connector.OrderSide, quantity, price numerical.Decimal) (*connector.OrderResponse, error) {
+func (t *tradingService) PlaceLimitOrder(instrument connector.Instrument, symbol string, side connector.OrderSide, quantity, price numerical.Decimal) (*connector.OrderResponse, error) {
 	t.mu.RLock()
 	client := t.client
 	t.mu.RUnlock()
@@ -69,7 +94,7 @@ func (t *tradingService) PlaceLimitOrder(symbol string, side connector.OrderSide
 	}
 
 	params := map[string]interface{}{
-		"category":    "linear",
+		"category":    category(instrument),
 		"symbol":      symbol,
 		"side":        string(side),
 		"orderType":   "Limit",
@@ -83,6 +108,10 @@ func (t *tradingService) PlaceLimitOrder(symbol string, side connector.OrderSide
 		return nil, fmt.Errorf("failed to place limit order: %w", err)
 	}
 
+	if result != nil && result.RetCode != 0 {
+		return nil, fmt.Errorf("limit order rejected: %s (code %d)", result.RetMsg, result.RetCode)
+	}
+
 	var orderID string
 	if result != nil && result.Result != nil {
 		if ordData, ok := result.Result.(map[string]interface{}); ok {
@@ -91,6 +120,7 @@ func (t *tradingService) PlaceLimitOrder(symbol string, side connector.OrderSide
 			}
 		}
 	}
+	t.payloads.Keep(orderID, result)
 
 	return &connector.OrderResponse{
 		OrderID:   orderID,
@@ -104,7 +134,7 @@ func (t *tradingService) PlaceLimitOrder(symbol string, side connector.OrderSide
 	}, nil
 }
 
-func (t *tradingService) PlaceMarketOrder(symbol string, side connector.OrderSide, quantity numerical.Decimal) (*connector.OrderResponse, error) {
+func (t *tradingService) PlaceMarketOrder(instrument connector.Instrument, symbol string, side connector.OrderSide, quantity numerical.Decimal) (*connector.OrderResponse, error) {
 	t.mu.RLock()
 	client := t.client
 	t.mu.RUnlock()
@@ -114,18 +144,26 @@ func (t *tradingService) PlaceMarketOrder(symbol string, side connector.OrderSid
 	}
 
 	params := map[string]interface{}{
-		"category":  "linear",
+		"category":  category(instrument),
 		"symbol":    symbol,
 		"side":      string(side),
 		"orderType": "Market",
 		"qty":       quantity.String(),
 	}
+	if instrument == connector.TypeSpot {
+		// Spot market buys are sized in the quote coin unless told otherwise
+		params["marketUnit"] = "baseCoin"
+	}
 
 	result, err := client.NewUtaBybitServiceWithParams(params).PlaceOrder(context.Background())
 	if err != nil {
 		return nil, fmt.Errorf("failed to place market order: %w", err)
 	}
 
+	if result != nil && result.RetCode != 0 {
+		return nil, fmt.Errorf("market order rejected: %s (code %d)", result.RetMsg, result.RetCode)
+	}
+
 	var orderID string
 	if result != nil && result.Result != nil {
 		if ordData, ok := result.Result.(map[string]interface{}); ok {
@@ -134,6 +172,7 @@ func (t *tradingService) PlaceMarketOrder(symbol string, side connector.OrderSid
 			}
 		}
 	}
+	t.payloads.Keep(orderID, result)
 
 	return &connector.OrderResponse{
 		OrderID:   orderID,
@@ -146,7 +185,9 @@ func (t *tradingService) PlaceMarketOrder(symbol string, side connector.OrderSid
 	}, nil
 }
 
-func (t *tradingService) CancelOrder(symbol, orderID string) (*connector.CancelResponse, error) {
+// PlaceTriggerOrder places a conditional order; Bybit needs the direction
+// price must cross the trigger in, which follows from the order's type and side
+func (t *tradingService) PlaceTriggerOrder(order types.TriggerOrder) (*connector.OrderResponse, error) {
 	t.mu.RLock()
 	client := t.client
 	t.mu.RUnlock()
@@ -155,16 +196,79 @@ func (t *tradingService) CancelOrder(symbol, orderID string) (*connector.CancelR
 		return nil, fmt.Errorf("trading service not initialized")
 	}
 
+	direction := 2
+	if order.TriggersOnRise() {
+		direction = 1
+	}
+
 	params := map[string]interface{}{
-		"category": "linear",
+		"category":         "linear",
+		"symbol":           order.Symbol,
+		"side":             string(order.Side),
+		"orderType":        "Market",
+		"qty":              order.Quantity.String(),
+		"triggerPrice":     order.TriggerPrice.String(),
+		"triggerDirection": direction,
+		"triggerBy":        "LastPrice",
+		"reduceOnly":       order.ReduceOnly,
+	}
+	if order.IsLimit() {
+		params["orderType"] = "Limit"
+		params["price"] = order.LimitPrice.String()
+		params["timeInForce"] = "GTC"
+	}
+
+	result, err := client.NewUtaBybitServiceWithParams(params).PlaceOrder(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to place %s order: %w", order.Type, err)
+	}
+
+	if result != nil && result.RetCode != 0 {
+		return nil, fmt.Errorf("%s order rejected: %s (code %d)", order.Type, result.RetMsg, result.RetCode)
+	}
+
+	var orderID string
+	if result != nil && result.Result != nil {
+		if ordData, ok := result.Result.(map[string]interface{}); ok {
+			if id, ok := ordData["orderId"].(string); ok {
+				orderID = id
+			}
+		}
+	}
+	t.payloads.Keep(orderID, result)
+
+	return &connector.OrderResponse{
+		OrderID:   orderID,
+		Symbol:    order.Symbol,
+		Status:    connector.OrderStatusNew,
+		Side:      order.Side,
+		Type:      order.Type,
+		Quantity:  order.Quantity,
+		Price:     order.LimitPrice,
+		Timestamp: t.timeProvider.Now(),
+	}, nil
+}
+
+func (t *tradingService) CancelOrder(instrument connector.Instrument, symbol, orderID string) (*connector.CancelResponse, error) {
+	t.mu.RLock()
+	client := t.client
+	t.mu.RUnlock()
+
+	if client == nil {
+		return nil, fmt.Errorf("trading service not initialized")
+	}
+
+	params := map[string]interface{}{
+		"category": category(instrument),
 		"symbol":   symbol,
 		"orderId":  orderID,
 	}
 
-	_, err := client.NewUtaBybitServiceWithParams(params).CancelOrder(context.Background())
+	result, err := client.NewUtaBybitServiceWithParams(params).CancelOrder(context.Background())
 	if err != nil {
 		return nil, fmt.Errorf("failed to cancel order: %w", err)
 	}
+	t.payloads.Keep(orderID, result)
 
 	return &connector.CancelResponse{
 		OrderID:   orderID,
@@ -174,7 +278,10 @@ func (t *tradingService) CancelOrder(symbol, orderID string) (*connector.CancelR
 	}, nil
 }
 
-func (t *tradingService) GetOpenOrders() ([]connector.Order, error) {
+// PlaceBatchLimitOrders places GTC limit orders through /v5/order/create-batch.
+// Bybit answers per order: the list carries the IDs and retExtInfo the
+// matching rejection codes.
+func (t *tradingService) PlaceBatchLimitOrders(instrument connector.Instrument, requests []types.OrderRequest) ([]types.OrderResult, error) {
 	t.mu.RLock()
 	client := t.client
 	t.mu.RUnlock()
@@ -183,8 +290,173 @@ func (t *tradingService) GetOpenOrders() ([]connector.Order, error) {
 		return nil, fmt.Errorf("trading service not initialized")
 	}
 
+	orders := make([]map[string]interface{}, len(requests))
+	for i, request := range requests {
+		orders[i] = map[string]interface{}{
+			"symbol":      request.Symbol,
+			"side":        string(request.Side),
+			"orderType":   "Limit",
+			"qty":         request.Quantity.String(),
+			"price":       request.Price.String(),
+			"timeInForce": "GTC",
+		}
+	}
+
 	params := map[string]interface{}{
-		"category": "linear",
+		"category": category(instrument),
+		"request":  orders,
+	}
+
+	result, err := client.NewUtaBybitServiceWithParams(params).PlaceBatchOrder(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to place batch orders: %w", err)
+	}
+	if result.RetCode != 0 {
+		return nil, fmt.Errorf("batch orders rejected: %s (code %d)", result.RetMsg, result.RetCode)
+	}
+
+	now := t.timeProvider.Now()
+	results := make([]types.OrderResult, len(requests))
+	for i, request := range requests {
+		if i < len(result.RetExtInfo.List) && result.RetExtInfo.List[i].Code != 0 {
+			ext := result.RetExtInfo.List[i]
+			results[i].Err = fmt.Errorf("limit order rejected: %s (code %d)", ext.Msg, ext.Code)
+			continue
+		}
+		if i >= len(result.Result.List) {
+			results[i].Err = fmt.Errorf("no result for batch order %d", i)
+			continue
+		}
+		results[i].Response = &connector.OrderResponse{
+			OrderID:   result.Result.List[i].OrderId,
+			Symbol:    request.Symbol,
+			Status:    connector.OrderStatusNew,
+			Side:      request.Side,
+			Type:      connector.OrderTypeLimit,
+			Quantity:  request.Quantity,
+			Price:     request.Price,
+			Timestamp: now,
+		}
+	}
+	return results, nil
+}
+
+// CancelBatchOrders cancels orders on one symbol through /v5/order/cancel-batch
+func (t *tradingService) CancelBatchOrders(instrument connector.Instrument, symbol string, orderIDs []string) ([]types.CancelResult, error) {
+	t.mu.RLock()
+	client := t.client
+	t.mu.RUnlock()
+
+	if client == nil {
+		return nil, fmt.Errorf("trading service not initialized")
+	}
+
+	cancels := make([]map[string]interface{}, len(orderIDs))
+	for i, orderID := range orderIDs {
+		cancels[i] = map[string]interface{}{
+			"symbol":  symbol,
+			"orderId": orderID,
+		}
+	}
+
+	params := map[string]interface{}{
+		"category": category(instrument),
+		"request":  cancels,
+	}
+
+	result, err := client.NewUtaBybitServiceWithParams(params).CancelBatchOrder(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel batch orders: %w", err)
+	}
+	if result.RetCode != 0 {
+		return nil, fmt.Errorf("batch cancel rejected: %s (code %d)", result.RetMsg, result.RetCode)
+	}
+
+	now := t.timeProvider.Now()
+	results := make([]types.CancelResult, len(orderIDs))
+	for i, orderID := range orderIDs {
+		results[i].OrderID = orderID
+		if i < len(result.RetExtInfo.List) && result.RetExtInfo.List[i].Code != 0 {
+			ext := result.RetExtInfo.List[i]
+			results[i].Err = fmt.Errorf("cancel rejected: %s (code %d)", ext.Msg, ext.Code)
+			continue
+		}
+		results[i].Response = &connector.CancelResponse{
+			OrderID:   orderID,
+			Symbol:    symbol,
+			Status:    connector.OrderStatusCanceled,
+			Timestamp: now,
+		}
+	}
+	return results, nil
+}
+
+// CancelAllOrders cancels every open order in the instrument's category;
+// linear orders are scoped by their USDT settle coin as the endpoint requires
+func (t *tradingService) CancelAllOrders(instrument connector.Instrument) error {
+	t.mu.RLock()
+	client := t.client
+	t.mu.RUnlock()
+
+	if client == nil {
+		return fmt.Errorf("trading service not initialized")
+	}
+
+	params := map[string]interface{}{
+		"category": category(instrument),
+	}
+	if instrument != connector.TypeSpot {
+		params["settleCoin"] = "USDT"
+	}
+
+	result, err := client.NewUtaBybitServiceWithParams(params).CancelAllOrders(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to cancel all orders: %w", err)
+	}
+	if result != nil && result.RetCode != 0 {
+		return fmt.Errorf("cancel all rejected: %s (code %d)", result.RetMsg, result.RetCode)
+	}
+	return nil
+}
+
+// SetDisconnectCancel sets the disconnection cancel protection window for
+// derivatives. Bybit starts the window when the private WebSocket drops and
+// cancels every derivatives order if it is not re-established in time.
+func (t *tradingService) SetDisconnectCancel(window time.Duration) error {
+	t.mu.RLock()
+	client := t.client
+	t.mu.RUnlock()
+
+	if client == nil {
+		return fmt.Errorf("trading service not initialized")
+	}
+
+	params := map[string]interface{}{
+		"product":    "DERIVATIVES",
+		"timeWindow": int(window / time.Second),
+	}
+
+	result, err := client.NewUtaBybitServiceWithParams(params).SetDisconnectCancelAll(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to set disconnect cancel window: %w", err)
+	}
+	if result != nil && result.RetCode != 0 {
+		return fmt.Errorf("disconnect cancel window rejected: %s (code %d)", result.RetMsg, result.RetCode)
+	}
+	return nil
+}
+
+func (t *tradingService) GetOpenOrders(instrument connector.Instrument) ([]connector.Order, error) {
+	t.mu.RLock()
+	client := t.client
+	t.mu.RUnlock()
+
+	if client == nil {
+		return nil, fmt.Errorf("trading service not initialized")
+	}
+
+	params := map[string]interface{}{
+		"category": category(instrument),
 	}
 
 	result, err := client.NewUtaBybitServiceWithParams(params).GetOpenOrders(context.Background())
@@ -209,7 +481,7 @@ func (t *tradingService) GetOpenOrders() ([]connector.Order, error) {
 	return orders, nil
 }
 
-func (t *tradingService) GetOrderStatus(orderID string) (*connector.Order, error) {
+func (t *tradingService) GetOrderStatus(instrument connector.Instrument, orderID string) (*connector.Order, error) {
 	t.mu.RLock()
 	client := t.client
 	t.mu.RUnlock()
@@ -219,7 +491,7 @@ func (t *tradingService) GetOrderStatus(orderID string) (*connector.Order, error
 	}
 
 	params := map[string]interface{}{
-		"category": "linear",
+		"category": category(instrument),
 		"orderId":  orderID,
 	}
 
@@ -254,6 +526,8 @@ func (t *tradingService) parseOrder(data map[string]interface{}) connector.Order
 	qty, _ := numerical.NewFromString(qtyStr)
 	price, _ := numerical.NewFromString(priceStr)
 
+	t.payloads.Keep(orderID, data)
+
 	return connector.Order{
 		ID:        orderID,
 		Symbol:    symbol,
@@ -264,6 +538,10 @@ func (t *tradingService) parseOrder(data map[string]interface{}) connector.Order
 	}
 }
 
+func (t *tradingService) OrderPayload(orderID string) (json.RawMessage, bool) {
+	return t.payloads.OrderPayload(orderID)
+}
+
 func (t *tradingService) GetAccountBalance() (*connector.AccountBalance, error) {
 	t.mu.RLock()
 	client := t.client
@@ -301,9 +579,11 @@ func (t *tradingService) GetAccountBalance() (*connector.AccountBalance, error)
 							balance.AvailableBalance = val
 						}
 					}
-					if totalMarginBalance, ok := accountData["totalMarginBalance"].(string); ok {
-						if val, err := numerical.NewFromString(totalMarginBalance); err == nil {
-							balance.UsedMargin = balance.TotalBalance.Sub(val)
+					// Exchange-computed initial margin is correct in every margin
+					// mode, unlike equity minus margin balance under portfolio margin
+					if totalInitialMargin, ok := accountData["totalInitialMargin"].(string); ok {
+						if val, err := numerical.NewFromString(totalInitialMargin); err == nil {
+							balance.UsedMargin = val
 						}
 					}
 					if totalPerpUPL, ok := accountData["totalPerpUPL"].(string); ok {
@@ -319,6 +599,60 @@ func (t *tradingService) GetAccountBalance() (*connector.AccountBalance, error)
 	return balance, nil
 }
 
+// GetSpotBalances returns the per-coin holdings of the unified account,
+// which is where Bybit keeps spot inventory
+func (t *tradingService) GetSpotBalances() ([]types.SpotBalance, error) {
+	t.mu.RLock()
+	client := t.client
+	t.mu.RUnlock()
+
+	if client == nil {
+		return nil, fmt.Errorf("trading service not initialized")
+	}
+
+	params := map[string]interface{}{
+		"accountType": "UNIFIED",
+	}
+
+	result, err := client.NewUtaBybitServiceWithParams(params).GetAccountWallet(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get wallet balance: %w", err)
+	}
+	if result != nil && result.RetCode != 0 {
+		return nil, fmt.Errorf("wallet balance query rejected: %s (code %d)", result.RetMsg, result.RetCode)
+	}
+
+	rows := resultRows(result, "list")
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	coins, _ := rows[0]["coin"].([]interface{})
+	balances := make([]types.SpotBalance, 0, len(coins))
+	for _, item := range coins {
+		coin, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		wallet := decimalField(coin, "walletBalance")
+		if wallet.IsZero() {
+			continue
+		}
+
+		locked := decimalField(coin, "locked")
+		balances = append(balances, types.SpotBalance{
+			Exchange:  types.Bybit,
+			Asset:     stringField(coin, "coin"),
+			Free:      wallet.Sub(locked),
+			Locked:    locked,
+			UpdatedAt: t.timeProvider.Now(),
+		})
+	}
+
+	return balances, nil
+}
+
 func (t *tradingService) GetPositions() ([]connector.Position, error) {
 	t.mu.RLock()
 	client := t.client
@@ -408,7 +742,7 @@ func (t *tradingService) parsePosition(data map[string]interface{}) connector.Po
 	return pos
 }
 
-func (t *tradingService) GetTradingHistory(symbol string, limit int) ([]connector.Trade, error) {
+func (t *tradingService) GetTradingHistory(instrument connector.Instrument, symbol string, limit int) ([]connector.Trade, error) {
 	t.mu.RLock()
 	client := t.client
 	t.mu.RUnlock()
@@ -418,51 +752,356 @@ func (t *tradingService) GetTradingHistory(symbol string, limit int) ([]connecto
 	}
 
 	params := map[string]interface{}{
-		"category": "linear",
+		"category": category(instrument),
 		"symbol":   symbol,
 		"limit":    limit,
 	}
 
-	result, err := client.NewUtaBybitServiceWithParams(params).GetTransactionLog(context.Background())
+	// Executions carry the maker flag and actual fee; the transaction log does not
+	result, err := client.NewUtaBybitServiceWithParams(params).GetTradeHistory(context.Background())
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch trading history: %w", err)
 	}
 
 	var trades []connector.Trade
+	for _, row := range resultRows(result, "list") {
+		trades = append(trades, t.parseExecution(row, symbol))
+	}
 
-	if result != nil && result.Result != nil {
-		if resultData, ok := result.Result.(map[string]interface{}); ok {
-			if listData, ok := resultData["list"].([]interface{}); ok {
-				for _, item := range listData {
-					if tradeData, ok := item.(map[string]interface{}); ok {
-						trade := connector.Trade{
-							Symbol:    symbol,
-							Timestamp: t.timeProvider.Now(),
-						}
+	return trades, nil
+}
 
-						if side, ok := tradeData["side"].(string); ok {
-							trade.Side = connector.OrderSide(side)
-						}
-						if price, ok := tradeData["execPrice"].(string); ok {
-							if val, err := numerical.NewFromString(price); err == nil {
-								trade.Price = val
-							}
-						}
-						if qty, ok := tradeData["execQty"].(string); ok {
-							if val, err := numerical.NewFromString(qty); err == nil {
-								trade.Quantity = val
-							}
-						}
-						if execID, ok := tradeData["execId"].(string); ok {
-							trade.ID = execID
-						}
+// GetTradingHistoryRange returns every execution within [start, end], oldest
+// first, following nextPageCursor until the range is exhausted. Bybit
+// rejects ranges wider than seven days.
+func (t *tradingService) GetTradingHistoryRange(instrument connector.Instrument, symbol string, start, end time.Time, limit int) ([]connector.Trade, error) {
+	t.mu.RLock()
+	client := t.client
+	t.mu.RUnlock()
 
-						trades = append(trades, trade)
-					}
+	if client == nil {
+		return nil, fmt.Errorf("trading service not initialized")
+	}
+	if limit <= 0 || limit > maxExecutionPage {
+		limit = maxExecutionPage
+	}
+
+	var trades []connector.Trade
+	cursor := ""
+	for {
+		params := map[string]interface{}{
+			"category":  category(instrument),
+			"symbol":    symbol,
+			"startTime": start.UnixMilli(),
+			"endTime":   end.UnixMilli(),
+			"limit":     limit,
+		}
+		if cursor != "" {
+			params["cursor"] = cursor
+		}
+
+		result, err := client.NewUtaBybitServiceWithParams(params).GetTradeHistory(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch trading history: %w", err)
+		}
+		if result != nil && result.RetCode != 0 {
+			return nil, fmt.Errorf("trading history query rejected: %s (code %d)", result.RetMsg, result.RetCode)
+		}
+
+		for _, row := range resultRows(result, "list") {
+			trades = append(trades, t.parseExecution(row, symbol))
+		}
+
+		cursor = nextPageCursor(result)
+		if cursor == "" {
+			break
+		}
+	}
+
+	// Pages arrive newest first
+	sort.SliceStable(trades, func(i, j int) bool {
+		return trades[i].Timestamp.Before(trades[j].Timestamp)
+	})
+	return trades, nil
+}
+
+func (t *tradingService) parseExecution(data map[string]interface{}, symbol string) connector.Trade {
+	trade := connector.Trade{
+		ID:        stringField(data, "execId"),
+		OrderID:   stringField(data, "orderId"),
+		Symbol:    symbol,
+		Exchange:  types.Bybit,
+		Side:      connector.OrderSide(stringField(data, "side")),
+		Price:     decimalField(data, "execPrice"),
+		Quantity:  decimalField(data, "execQty"),
+		Fee:       decimalField(data, "execFee"),
+		Timestamp: t.timeProvider.Now(),
+	}
+
+	if isMaker, ok := data["isMaker"].(bool); ok {
+		trade.IsMaker = isMaker
+	}
+	if execTime := millisField(data, "execTime"); !execTime.IsZero() {
+		trade.Timestamp = execTime
+	}
+
+	return trade
+}
+
+// GetWithdrawals returns on-chain and off-chain withdrawals created since the given time
+func (t *tradingService) GetWithdrawals(since time.Time) ([]types.TransferEvent, error) {
+	t.mu.RLock()
+	client := t.client
+	t.mu.RUnlock()
+
+	if client == nil {
+		return nil, fmt.Errorf("trading service not initialized")
+	}
+
+	params := map[string]interface{}{
+		"startTime": since.UnixMilli(),
+		"limit":     50,
+	}
+
+	result, err := client.NewUtaBybitServiceWithParams(params).GetWithdrawalRecords(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get withdrawals: %w", err)
+	}
+	if result != nil && result.RetCode != 0 {
+		return nil, fmt.Errorf("withdrawal query rejected: %s (code %d)", result.RetMsg, result.RetCode)
+	}
+
+	var events []types.TransferEvent
+	for _, row := range resultRows(result, "rows") {
+		event := types.TransferEvent{
+			Exchange:  types.Bybit,
+			Kind:      types.TransferKindWithdrawal,
+			ID:        stringField(row, "withdrawId"),
+			Asset:     stringField(row, "coin"),
+			Address:   stringField(row, "toAddress"),
+			Status:    stringField(row, "status"),
+			Timestamp: millisField(row, "createTime"),
+		}
+		if amount, err := numerical.NewFromString(stringField(row, "amount")); err == nil {
+			event.Amount = amount
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// GetUniversalTransfers returns transfers between the master account and sub-accounts since the given time
+func (t *tradingService) GetUniversalTransfers(since time.Time) ([]types.TransferEvent, error) {
+	t.mu.RLock()
+	client := t.client
+	t.mu.RUnlock()
+
+	if client == nil {
+		return nil, fmt.Errorf("trading service not initialized")
+	}
+
+	params := map[string]interface{}{
+		"startTime": since.UnixMilli(),
+		"limit":     50,
+	}
+
+	result, err := client.NewUtaBybitServiceWithParams(params).GetUniversalTransferRecords(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get universal transfers: %w", err)
+	}
+	if result != nil && result.RetCode != 0 {
+		return nil, fmt.Errorf("universal transfer query rejected: %s (code %d)", result.RetMsg, result.RetCode)
+	}
+
+	var events []types.TransferEvent
+	for _, row := range resultRows(result, "list") {
+		event := types.TransferEvent{
+			Exchange:  types.Bybit,
+			Kind:      types.TransferKindTransfer,
+			ID:        stringField(row, "transferId"),
+			Asset:     stringField(row, "coin"),
+			Address:   stringField(row, "toMemberId"),
+			Status:    stringField(row, "status"),
+			Timestamp: millisField(row, "timestamp"),
+		}
+		if amount, err := numerical.NewFromString(stringField(row, "amount")); err == nil {
+			event.Amount = amount
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// GetMarginInfo returns the unified account's margin mode and the
+// exchange-computed initial and maintenance requirements
+func (t *tradingService) GetMarginInfo() (*types.MarginInfo, error) {
+	t.mu.RLock()
+	client := t.client
+	t.mu.RUnlock()
+
+	if client == nil {
+		return nil, fmt.Errorf("trading service not initialized")
+	}
+
+	info, err := client.NewUtaBybitServiceNoParams().GetAccountInfo(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account info: %w", err)
+	}
+	if info != nil && info.RetCode != 0 {
+		return nil, fmt.Errorf("account info query rejected: %s (code %d)", info.RetMsg, info.RetCode)
+	}
+
+	margin := &types.MarginInfo{
+		Exchange:  types.Bybit,
+		Mode:      types.MarginModeUnknown,
+		UpdatedAt: t.timeProvider.Now(),
+	}
+
+	if resultData, ok := info.Result.(map[string]interface{}); ok {
+		switch stringField(resultData, "marginMode") {
+		case "ISOLATED_MARGIN":
+			margin.Mode = types.MarginModeIsolated
+		case "REGULAR_MARGIN":
+			margin.Mode = types.MarginModeCross
+		case "PORTFOLIO_MARGIN":
+			margin.Mode = types.MarginModePortfolio
+		}
+	}
+
+	wallet, err := client.NewUtaBybitServiceWithParams(map[string]interface{}{
+		"accountType": "UNIFIED",
+	}).GetAccountWallet(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get wallet balance: %w", err)
+	}
+
+	if rows := resultRows(wallet, "list"); len(rows) > 0 {
+		margin.Equity = decimalField(rows[0], "totalEquity")
+		margin.InitialMargin = decimalField(rows[0], "totalInitialMargin")
+		margin.MaintenanceMargin = decimalField(rows[0], "totalMaintenanceMargin")
+	}
+
+	return margin, nil
+}
+
+// GetAPIKeyPermissions reads the key's read-only flag and permission groups;
+// any contract, spot or options group grants trading
+func (t *tradingService) GetAPIKeyPermissions() (*types.APIKeyPermissions, error) {
+	t.mu.RLock()
+	client := t.client
+	t.mu.RUnlock()
+
+	if client == nil {
+		return nil, fmt.Errorf("trading service not initialized")
+	}
+
+	result, err := client.NewUtaBybitServiceNoParams().GetAPIKeyInfo(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API key info: %w", err)
+	}
+	if result != nil && result.RetCode != 0 {
+		return nil, fmt.Errorf("API key info query rejected: %s (code %d)", result.RetMsg, result.RetCode)
+	}
+
+	permissions := &types.APIKeyPermissions{Exchange: types.Bybit}
+	resultData, ok := result.Result.(map[string]interface{})
+	if !ok {
+		return permissions, nil
+	}
+
+	if readOnly, ok := resultData["readOnly"].(float64); ok {
+		permissions.ReadOnly = readOnly == 1
+	}
+
+	groups, _ := resultData["permissions"].(map[string]interface{})
+	for group, values := range groups {
+		list, _ := values.([]interface{})
+		for _, value := range list {
+			name, _ := value.(string)
+			if name == "" {
+				continue
+			}
+			permissions.Scopes = append(permissions.Scopes, group+"."+name)
+			switch group {
+			case "ContractTrade", "Spot", "Options":
+				permissions.Trade = true
+			case "Wallet":
+				if name == "Withdraw" {
+					permissions.Withdraw = true
 				}
 			}
 		}
 	}
+	sort.Strings(permissions.Scopes)
+	if permissions.ReadOnly {
+		permissions.Trade = false
+	}
 
-	return trades, nil
+	return permissions, nil
+}
+
+// category is the v5 product category an instrument trades under
+func category(instrument connector.Instrument) string {
+	if instrument == connector.TypeSpot {
+		return "spot"
+	}
+	return "linear"
+}
+
+func nextPageCursor(result *bybit.ServerResponse) string {
+	if result == nil || result.Result == nil {
+		return ""
+	}
+	resultData, ok := result.Result.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	return stringField(resultData, "nextPageCursor")
+}
+
+func resultRows(result *bybit.ServerResponse, key string) []map[string]interface{} {
+	if result == nil || result.Result == nil {
+		return nil
+	}
+
+	resultData, ok := result.Result.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	listData, ok := resultData[key].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	rows := make([]map[string]interface{}, 0, len(listData))
+	for _, item := range listData {
+		if row, ok := item.(map[string]interface{}); ok {
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+func decimalField(data map[string]interface{}, key string) numerical.Decimal {
+	value, err := numerical.NewFromString(stringField(data, key))
+	if err != nil {
+		return numerical.Zero()
+	}
+	return value
+}
+
+func stringField(data map[string]interface{}, key string) string {
+	value, _ := data[key].(string)
+	return value
+}
+
+func millisField(data map[string]interface{}, key string) time.Time {
+	ms, err := strconv.ParseInt(stringField(data, key), 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.UnixMilli(ms)
 }
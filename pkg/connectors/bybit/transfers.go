@@ -0,0 +1,33 @@
+package bybit
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+var _ types.TransferHistoryProvider = (*bybit)(nil)
+
+// FetchTransferEvents returns withdrawals and sub-account transfers since the given time
+func (b *bybit) FetchTransferEvents(since time.Time) ([]types.TransferEvent, error) {
+	if err := b.limiter.Wait(ratelimit.EndpointAccount); err != nil {
+		return nil, err
+	}
+	if !b.initialized {
+		return nil, fmt.Errorf("connector not initialized")
+	}
+
+	withdrawals, err := b.trading.GetWithdrawals(since)
+	if err != nil {
+		return nil, err
+	}
+
+	transfers, err := b.trading.GetUniversalTransfers(since)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(withdrawals, transfers...), nil
+}
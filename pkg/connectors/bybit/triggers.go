@@ -0,0 +1,28 @@
+package bybit
+
+import (
+	"fmt"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+var _ types.TriggerOrderProvider = (*bybit)(nil)
+
+func (b *bybit) PlaceTriggerOrder(order types.TriggerOrder) (*connector.OrderResponse, error) {
+	if err := order.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid trigger order: %w", err)
+	}
+	if err := b.limiter.Wait(ratelimit.EndpointPlaceOrder); err != nil {
+		return nil, err
+	}
+	if !b.SupportsTradingOperations() {
+		return nil, fmt.Errorf("trading operations not supported")
+	}
+	resp, err := b.trading.PlaceTriggerOrder(order)
+	if err != nil {
+		return nil, b.wrapOrderError(order.Symbol, order.Side, order.Quantity, order.LimitPrice, err)
+	}
+	return resp, nil
+}
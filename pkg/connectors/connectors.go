@@ -4,8 +4,10 @@ import (
 	"strings"
 
 	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/live-trading/pkg/connectors/binance"
 	"github.com/backtesting-org/live-trading/pkg/connectors/bybit"
 	"github.com/backtesting-org/live-trading/pkg/connectors/hyperliquid"
+	"github.com/backtesting-org/live-trading/pkg/connectors/okx"
 	"github.com/backtesting-org/live-trading/pkg/connectors/paradex"
 	"github.com/backtesting-org/live-trading/pkg/connectors/types"
 )
@@ -15,6 +17,8 @@ var AvailableConnectors = map[connector.ExchangeName]connector.Config{
 	types.Paradex:     &paradex.Config{},
 	types.Hyperliquid: &hyperliquid.Config{},
 	types.Bybit:       &bybit.Config{},
+	types.Binance:     &binance.Config{},
+	types.Okx:         &okx.Config{},
 }
 
 // IsAvailable checks if a connector is available for the given exchange
@@ -0,0 +1,203 @@
+package credentials
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const awsService = "secretsmanager"
+
+type awsProvider struct {
+	config   AWSConfig
+	client   *http.Client
+	endpoint *url.URL
+
+	// cache holds each secret's fields, so a connector's keys cost one read
+	cache map[string]map[string]string
+	mu    sync.Mutex
+}
+
+// NewAWSProvider reads "<path>/<field>" as field of the JSON secret whose
+// ID is path. Requests are signed with Signature Version 4.
+func NewAWSProvider(config AWSConfig, client *http.Client) (Provider, error) {
+	if config.Region == "" {
+		config.Region = os.Getenv("AWS_REGION")
+	}
+	if config.AccessKeyID == "" {
+		config.AccessKeyID = os.Getenv("AWS_ACCESS_KEY_ID")
+	}
+	if config.SecretAccessKey == "" {
+		config.SecretAccessKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
+	}
+	if config.SessionToken == "" {
+		config.SessionToken = os.Getenv("AWS_SESSION_TOKEN")
+	}
+	if config.Region == "" {
+		return nil, fmt.Errorf("aws region is required")
+	}
+	if config.AccessKeyID == "" || config.SecretAccessKey == "" {
+		return nil, fmt.Errorf("aws access key ID and secret access key are required")
+	}
+
+	raw := config.Endpoint
+	if raw == "" {
+		raw = fmt.Sprintf("https://%s.%s.amazonaws.com/", awsService, config.Region)
+	}
+	endpoint, err := url.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid aws endpoint: %w", err)
+	}
+	if endpoint.Path == "" {
+		endpoint.Path = "/"
+	}
+
+	return &awsProvider{config: config, client: client, endpoint: endpoint, cache: make(map[string]map[string]string)}, nil
+}
+
+func (p *awsProvider) Kind() Kind {
+	return KindAWS
+}
+
+func (p *awsProvider) Secret(key string) (string, error) {
+	path, field, err := splitKey(key)
+	if err != nil {
+		return "", err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	fields, ok := p.cache[path]
+	if !ok {
+		fields, err = p.read(path)
+		if err != nil {
+			return "", err
+		}
+		p.cache[path] = fields
+	}
+
+	value, ok := fields[field]
+	if !ok || value == "" {
+		return "", ErrNotFound
+	}
+	return value, nil
+}
+
+func (p *awsProvider) read(secretID string) (map[string]string, error) {
+	body, err := json.Marshal(map[string]string{"SecretId": secretID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode aws request: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, p.endpoint.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build aws request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "secretsmanager.GetSecretValue")
+	p.sign(req, body, time.Now().UTC())
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("aws request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read aws response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var failure struct {
+			Type    string `json:"__type"`
+			Message string `json:"message"`
+		}
+		_ = json.Unmarshal(data, &failure)
+		if strings.HasSuffix(failure.Type, "ResourceNotFoundException") {
+			return map[string]string{}, nil
+		}
+		return nil, fmt.Errorf("aws returned %d for %s: %s", resp.StatusCode, secretID, failure.Message)
+	}
+
+	var secret struct {
+		SecretString string `json:"SecretString"`
+	}
+	if err := json.Unmarshal(data, &secret); err != nil {
+		return nil, fmt.Errorf("failed to decode aws response: %w", err)
+	}
+
+	var values map[string]interface{}
+	if err := json.Unmarshal([]byte(secret.SecretString), &values); err != nil {
+		return nil, fmt.Errorf("secret %s is not a JSON object", secretID)
+	}
+	return stringFields(values), nil
+}
+
+// sign adds a Signature Version 4 Authorization header
+func (p *awsProvider) sign(req *http.Request, body []byte, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+
+	req.Header.Set("Host", p.endpoint.Host)
+	req.Header.Set("X-Amz-Date", amzDate)
+	if p.config.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", p.config.SessionToken)
+	}
+
+	names := make([]string, 0, len(req.Header))
+	for name := range req.Header {
+		names = append(names, strings.ToLower(name))
+	}
+	sort.Strings(names)
+
+	var headers strings.Builder
+	for _, name := range names {
+		headers.WriteString(name + ":" + strings.TrimSpace(req.Header.Get(name)) + "\n")
+	}
+	signed := strings.Join(names, ";")
+
+	canonical := strings.Join([]string{
+		req.Method,
+		p.endpoint.EscapedPath(),
+		p.endpoint.RawQuery,
+		headers.String(),
+		signed,
+		hexSHA256(body),
+	}, "\n")
+
+	scope := strings.Join([]string{date, p.config.Region, awsService, "aws4_request"}, "/")
+	toSign := strings.Join([]string{"AWS4-HMAC-SHA256", amzDate, scope, hexSHA256([]byte(canonical))}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+p.config.SecretAccessKey), date)
+	key = hmacSHA256(key, p.config.Region)
+	key = hmacSHA256(key, awsService)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, toSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		p.config.AccessKeyID, scope, signed, signature))
+}
+
+func hexSHA256(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
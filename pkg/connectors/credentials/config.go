@@ -0,0 +1,95 @@
+// Package credentials fills connector API credentials from a secret store,
+// so deployments need not keep raw keys in their config files
+package credentials
+
+import "time"
+
+// Kind selects the secret store
+type Kind string
+
+const (
+	KindEnv   Kind = "env"
+	KindFile  Kind = "file"
+	KindVault Kind = "vault"
+	KindAWS   Kind = "aws"
+)
+
+const (
+	// ReferencePrefix marks a config value as a secret key rather than the
+	// secret itself, e.g. "secret:bybit/api_secret"
+	ReferencePrefix = "secret:"
+
+	// DefaultKeyEnv holds the encrypted file's key when none is configured
+	DefaultKeyEnv = "LIVE_TRADING_SECRETS_KEY"
+
+	// DefaultVaultMount is the KV version 2 mount secrets are read from
+	DefaultVaultMount = "secret"
+
+	// DefaultTimeout bounds each remote secret store request
+	DefaultTimeout = 10 * time.Second
+)
+
+// Config selects and configures the secret store. Secret keys are
+// "<path>/<field>"; each store maps them onto its own naming, see the
+// provider constructors. An empty credential field is looked up as
+// "<exchange>/<json field>" and a ReferencePrefix value as the key it names.
+type Config struct {
+	Kind Kind
+
+	// RejectPlaintext fails resolution when a credential field holds a raw
+	// secret instead of a reference or nothing
+	RejectPlaintext bool
+
+	Env   EnvConfig
+	File  FileConfig
+	Vault VaultConfig
+	AWS   AWSConfig
+
+	Timeout time.Duration
+}
+
+// EnvConfig reads secrets from environment variables
+type EnvConfig struct {
+	// Prefix is prepended to every variable name, e.g. "LIVE_TRADING_"
+	Prefix string
+}
+
+// FileConfig reads secrets from a file sealed with SealFile
+type FileConfig struct {
+	Path string
+
+	// KeyFile holds the 32-byte key, hex or base64 encoded; without it the
+	// key is read from the KeyEnv variable
+	KeyFile string
+	KeyEnv  string
+}
+
+// VaultConfig reads secrets from a HashiCorp Vault KV version 2 mount
+type VaultConfig struct {
+	// Address and Token default to VAULT_ADDR and VAULT_TOKEN
+	Address   string
+	Token     string
+	Namespace string
+	Mount     string
+}
+
+// AWSConfig reads secrets from AWS Secrets Manager. Credentials default to
+// AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN, and the
+// region to AWS_REGION.
+type AWSConfig struct {
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+
+	// Endpoint overrides the regional Secrets Manager endpoint
+	Endpoint string
+}
+
+// DefaultConfig reads secrets from unprefixed environment variables
+func DefaultConfig() Config {
+	return Config{
+		Kind:    KindEnv,
+		Timeout: DefaultTimeout,
+	}
+}
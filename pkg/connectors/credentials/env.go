@@ -0,0 +1,33 @@
+package credentials
+
+import (
+	"os"
+	"strings"
+)
+
+type envProvider struct {
+	prefix string
+}
+
+// NewEnvProvider maps a key to an upper-case variable name with "/", "-"
+// and "." as underscores, so "bybit/api_secret" reads BYBIT_API_SECRET
+func NewEnvProvider(config EnvConfig) Provider {
+	return &envProvider{prefix: config.Prefix}
+}
+
+func (p *envProvider) Kind() Kind {
+	return KindEnv
+}
+
+func (p *envProvider) Secret(key string) (string, error) {
+	value, ok := os.LookupEnv(p.variable(key))
+	if !ok || value == "" {
+		return "", ErrNotFound
+	}
+	return value, nil
+}
+
+func (p *envProvider) variable(key string) string {
+	name := strings.NewReplacer("/", "_", "-", "_", ".", "_").Replace(key)
+	return p.prefix + strings.ToUpper(name)
+}
@@ -0,0 +1,154 @@
+package credentials
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// fileMagic versions the sealed file format: magic, a 12-byte nonce, then
+// the AES-256-GCM sealed JSON object of key to secret
+var fileMagic = []byte("LTSECRETS1")
+
+type fileProvider struct {
+	secrets map[string]string
+}
+
+// NewFileProvider decrypts the whole file once; keys are looked up verbatim
+func NewFileProvider(config FileConfig) (Provider, error) {
+	if config.Path == "" {
+		return nil, fmt.Errorf("secret file path is required")
+	}
+
+	key, err := loadKey(config)
+	if err != nil {
+		return nil, err
+	}
+
+	sealed, err := os.ReadFile(config.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read secret file: %w", err)
+	}
+
+	secrets, err := openSecrets(sealed, key)
+	if err != nil {
+		return nil, fmt.Errorf("secret file %s: %w", config.Path, err)
+	}
+	return &fileProvider{secrets: secrets}, nil
+}
+
+func (p *fileProvider) Kind() Kind {
+	return KindFile
+}
+
+func (p *fileProvider) Secret(key string) (string, error) {
+	value, ok := p.secrets[key]
+	if !ok || value == "" {
+		return "", ErrNotFound
+	}
+	return value, nil
+}
+
+// SealFile writes secrets encrypted under key to path, readable only by
+// the owner
+func SealFile(path string, key []byte, secrets map[string]string) error {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return err
+	}
+
+	plaintext, err := json.Marshal(secrets)
+	if err != nil {
+		return fmt.Errorf("failed to encode secrets: %w", err)
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	sealed := append(append([]byte(nil), fileMagic...), nonce...)
+	sealed = gcm.Seal(sealed, nonce, plaintext, fileMagic)
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, sealed, 0o600); err != nil {
+		return fmt.Errorf("failed to write secret file: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to replace secret file: %w", err)
+	}
+	return nil
+}
+
+// ParseKey decodes a 32-byte key given as hex or base64
+func ParseKey(encoded string) ([]byte, error) {
+	encoded = strings.TrimSpace(encoded)
+	if key, err := hex.DecodeString(encoded); err == nil && len(key) == 32 {
+		return key, nil
+	}
+	if key, err := base64.StdEncoding.DecodeString(encoded); err == nil && len(key) == 32 {
+		return key, nil
+	}
+	return nil, fmt.Errorf("secret key must be 32 bytes, hex or base64 encoded")
+}
+
+func loadKey(config FileConfig) ([]byte, error) {
+	if config.KeyFile != "" {
+		data, err := os.ReadFile(config.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read secret key file: %w", err)
+		}
+		return ParseKey(string(data))
+	}
+
+	env := config.KeyEnv
+	if env == "" {
+		env = DefaultKeyEnv
+	}
+	encoded := os.Getenv(env)
+	if encoded == "" {
+		return nil, fmt.Errorf("secret key not set: %s is empty and no key file is configured", env)
+	}
+	return ParseKey(encoded)
+}
+
+func openSecrets(sealed, key []byte) (map[string]string, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.HasPrefix(sealed, fileMagic) || len(sealed) < len(fileMagic)+gcm.NonceSize() {
+		return nil, fmt.Errorf("not a sealed secret file")
+	}
+
+	rest := sealed[len(fileMagic):]
+	nonce, ciphertext := rest[:gcm.NonceSize()], rest[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, fileMagic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt, wrong key or corrupted file")
+	}
+
+	var secrets map[string]string
+	if err := json.Unmarshal(plaintext, &secrets); err != nil {
+		return nil, fmt.Errorf("failed to decode secrets: %w", err)
+	}
+	return secrets, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	if len(key) != 32 {
+		return nil, fmt.Errorf("secret key must be 32 bytes, got %d", len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,7 @@
+package credentials
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(NewCredentialResolver),
+)
@@ -0,0 +1,47 @@
+package credentials
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ErrNotFound is returned by a provider that has no secret under a key
+var ErrNotFound = errors.New("secret not found")
+
+// Provider reads secrets by key from one store
+type Provider interface {
+	Kind() Kind
+	Secret(key string) (string, error)
+}
+
+// NewProvider builds the provider the config selects
+func NewProvider(config Config) (Provider, error) {
+	if config.Timeout <= 0 {
+		config.Timeout = DefaultTimeout
+	}
+	client := &http.Client{Timeout: config.Timeout}
+
+	switch config.Kind {
+	case "", KindEnv:
+		return NewEnvProvider(config.Env), nil
+	case KindFile:
+		return NewFileProvider(config.File)
+	case KindVault:
+		return NewVaultProvider(config.Vault, client)
+	case KindAWS:
+		return NewAWSProvider(config.AWS, client)
+	default:
+		return nil, fmt.Errorf("unknown secret store %q", config.Kind)
+	}
+}
+
+// splitKey separates a "<path>/<field>" key
+func splitKey(key string) (string, string, error) {
+	i := strings.LastIndex(key, "/")
+	if i <= 0 || i == len(key)-1 {
+		return "", "", fmt.Errorf("secret key %q must be <path>/<field>", key)
+	}
+	return key[:i], key[i+1:], nil
+}
@@ -0,0 +1,109 @@
+package credentials
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+// CredentialResolver fills connector configs' credential fields from the
+// configured secret store before they are validated
+type CredentialResolver interface {
+	// Configure selects the secret store; until it is called secrets are
+	// read from environment variables
+	Configure(config Config) error
+
+	// Resolve fills one config's credential fields in place; configs
+	// without credentials are left alone
+	Resolve(config connector.Config) error
+}
+
+type credentialResolver struct {
+	logger logging.ApplicationLogger
+
+	config   Config
+	provider Provider
+	mu       sync.Mutex
+}
+
+func NewCredentialResolver(logger logging.ApplicationLogger) CredentialResolver {
+	config := DefaultConfig()
+	return &credentialResolver{
+		logger:   logger,
+		config:   config,
+		provider: NewEnvProvider(config.Env),
+	}
+}
+
+func (r *credentialResolver) Configure(config Config) error {
+	provider, err := NewProvider(config)
+	if err != nil {
+		return fmt.Errorf("failed to configure secret store: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.config = config
+	r.provider = provider
+	return nil
+}
+
+func (r *credentialResolver) Resolve(config connector.Config) error {
+	secrets, ok := config.(types.SecretConfig)
+	if !ok {
+		return nil
+	}
+
+	r.mu.Lock()
+	provider := r.provider
+	rejectPlaintext := r.config.RejectPlaintext
+	r.mu.Unlock()
+
+	exchange := config.ExchangeName()
+	fields := secrets.SecretFields()
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	resolved := 0
+	for _, name := range names {
+		value := fields[name]
+
+		var key string
+		switch {
+		case strings.HasPrefix(*value, ReferencePrefix):
+			key = strings.TrimPrefix(*value, ReferencePrefix)
+		case *value == "":
+			key = fmt.Sprintf("%s/%s", exchange, name)
+		case rejectPlaintext:
+			return fmt.Errorf("%s %s holds a plaintext secret; use a %s reference", exchange, name, provider.Kind())
+		default:
+			continue
+		}
+
+		secret, err := provider.Secret(key)
+		if errors.Is(err, ErrNotFound) && *value == "" {
+			// Left empty for the config's own validation to report if required
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("%s %s: failed to read %s from %s: %w", exchange, name, key, provider.Kind(), err)
+		}
+
+		*value = secret
+		resolved++
+	}
+
+	if resolved > 0 {
+		r.logger.Info("Resolved %d %s credentials from %s", resolved, exchange, provider.Kind())
+	}
+	return nil
+}
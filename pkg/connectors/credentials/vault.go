@@ -0,0 +1,132 @@
+package credentials
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+)
+
+type vaultProvider struct {
+	config VaultConfig
+	client *http.Client
+
+	// cache holds each path's fields, so a connector's keys cost one read
+	cache map[string]map[string]string
+	mu    sync.Mutex
+}
+
+// NewVaultProvider reads "<path>/<field>" as field of the KV version 2
+// secret at <mount>/data/<path>
+func NewVaultProvider(config VaultConfig, client *http.Client) (Provider, error) {
+	if config.Address == "" {
+		config.Address = os.Getenv("VAULT_ADDR")
+	}
+	if config.Token == "" {
+		config.Token = os.Getenv("VAULT_TOKEN")
+	}
+	if config.Mount == "" {
+		config.Mount = DefaultVaultMount
+	}
+	if config.Address == "" {
+		return nil, fmt.Errorf("vault address is required")
+	}
+	if config.Token == "" {
+		return nil, fmt.Errorf("vault token is required")
+	}
+
+	config.Address = strings.TrimRight(config.Address, "/")
+	return &vaultProvider{config: config, client: client, cache: make(map[string]map[string]string)}, nil
+}
+
+func (p *vaultProvider) Kind() Kind {
+	return KindVault
+}
+
+func (p *vaultProvider) Secret(key string) (string, error) {
+	path, field, err := splitKey(key)
+	if err != nil {
+		return "", err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	fields, ok := p.cache[path]
+	if !ok {
+		fields, err = p.read(path)
+		if err != nil {
+			return "", err
+		}
+		p.cache[path] = fields
+	}
+
+	value, ok := fields[field]
+	if !ok || value == "" {
+		return "", ErrNotFound
+	}
+	return value, nil
+}
+
+func (p *vaultProvider) read(path string) (map[string]string, error) {
+	endpoint := fmt.Sprintf("%s/v1/%s/data/%s", p.config.Address, url.PathEscape(p.config.Mount), escapePath(path))
+	req, err := http.NewRequest(http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build vault request: %w", err)
+	}
+	req.Header.Set("X-Vault-Token", p.config.Token)
+	if p.config.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", p.config.Namespace)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("vault request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read vault response: %w", err)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return map[string]string{}, nil
+	case resp.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("vault returned %d for %s", resp.StatusCode, path)
+	}
+
+	var payload struct {
+		Data struct {
+			Data map[string]interface{} `json:"data"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("failed to decode vault response: %w", err)
+	}
+	return stringFields(payload.Data.Data), nil
+}
+
+func escapePath(path string) string {
+	parts := strings.Split(path, "/")
+	for i, part := range parts {
+		parts[i] = url.PathEscape(part)
+	}
+	return strings.Join(parts, "/")
+}
+
+// stringFields keeps the string values of a decoded secret object
+func stringFields(values map[string]interface{}) map[string]string {
+	fields := make(map[string]string, len(values))
+	for name, value := range values {
+		if s, ok := value.(string); ok {
+			fields[name] = s
+		}
+	}
+	return fields
+}
@@ -0,0 +1,44 @@
+package deadman
+
+import "time"
+
+const (
+	// DefaultInterval is how often every trading venue's connectivity is probed
+	DefaultInterval = 5 * time.Second
+
+	// DefaultDisconnectAfter is how long a venue must be unreachable before
+	// its resting orders are cancelled on recovery
+	DefaultDisconnectAfter = 30 * time.Second
+
+	// DefaultNativeWindow is the exchange-side cancel-on-disconnect window
+	// armed on venues that offer one
+	DefaultNativeWindow = 10 * time.Second
+
+	// DefaultProbeAsset is priced to probe venues without a WebSocket
+	DefaultProbeAsset = "BTC"
+
+	// JobName is the scheduler job the switch registers under
+	JobName = "dead-man-switch"
+)
+
+// Config controls both halves of the dead-man's switch
+type Config struct {
+	Interval        time.Duration
+	DisconnectAfter time.Duration
+
+	// NativeWindow is armed at Start on connectors whose exchange cancels
+	// orders itself; a negative window leaves the exchange setting alone
+	NativeWindow time.Duration
+	ProbeAsset   string
+}
+
+// DefaultConfig cancels resting orders after thirty seconds unreachable and
+// arms a ten second exchange-side window where available
+func DefaultConfig() Config {
+	return Config{
+		Interval:        DefaultInterval,
+		DisconnectAfter: DefaultDisconnectAfter,
+		NativeWindow:    DefaultNativeWindow,
+		ProbeAsset:      DefaultProbeAsset,
+	}
+}
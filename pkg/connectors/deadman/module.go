@@ -0,0 +1,7 @@
+package deadman
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(NewDeadMansSwitch),
+)
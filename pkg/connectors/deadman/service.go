@@ -0,0 +1,333 @@
+package deadman
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+	"github.com/backtesting-org/live-trading/pkg/scheduler"
+)
+
+// Trip is raised when a venue recovers from an outage long enough to have
+// its resting orders cancelled
+type Trip struct {
+	Exchange       connector.ExchangeName
+	DisconnectedAt time.Time
+	RecoveredAt    time.Time
+
+	// Cancelled is the number of orders open when the venue came back
+	Cancelled int
+	Errors    []string
+}
+
+// Downtime is how long the venue was unreachable
+func (t Trip) Downtime() time.Duration {
+	return t.RecoveredAt.Sub(t.DisconnectedAt)
+}
+
+// VenueStatus is the switch's view of one venue
+type VenueStatus struct {
+	Exchange  connector.ExchangeName
+	Connected bool
+
+	// DisconnectedAt is the first failed probe of the current outage
+	DisconnectedAt time.Time
+
+	// NativeArmed is set once the exchange-side window has been accepted
+	NativeArmed bool
+	CheckedAt   time.Time
+}
+
+// DeadMansSwitch guards resting orders against this process losing a venue.
+// Venues with a native cancel-on-disconnect window have it armed at Start;
+// every venue also gets the client-side equivalent, which cancels all open
+// orders once the venue is reachable again after DisconnectAfter or longer
+// unreachable, since the orders were unmanaged for that long.
+type DeadMansSwitch interface {
+	Configure(config Config)
+
+	// Start arms native windows and registers the probe job
+	Start() error
+	Stop() error
+
+	// Check probes every ready trading venue now and returns the trips it fired
+	Check() []Trip
+
+	Status() []VenueStatus
+	Trips() <-chan Trip
+}
+
+type venueState struct {
+	status VenueStatus
+
+	// pending keeps a long outage armed until its cancellation succeeds
+	pending bool
+}
+
+type deadMansSwitch struct {
+	registry     registry.ConnectorRegistry
+	scheduler    scheduler.Scheduler
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config Config
+	venues map[connector.ExchangeName]*venueState
+	tripCh chan Trip
+	mu     sync.Mutex
+}
+
+func NewDeadMansSwitch(
+	connectorRegistry registry.ConnectorRegistry,
+	jobScheduler scheduler.Scheduler,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) DeadMansSwitch {
+	return &deadMansSwitch{
+		registry:     connectorRegistry,
+		scheduler:    jobScheduler,
+		timeProvider: timeProvider,
+		logger:       logger,
+		config:       DefaultConfig(),
+		venues:       make(map[connector.ExchangeName]*venueState),
+		tripCh:       make(chan Trip, 100),
+	}
+}
+
+func (d *deadMansSwitch) Configure(config Config) {
+	defaults := DefaultConfig()
+	if config.Interval <= 0 {
+		config.Interval = defaults.Interval
+	}
+	if config.DisconnectAfter <= 0 {
+		config.DisconnectAfter = defaults.DisconnectAfter
+	}
+	if config.NativeWindow == 0 {
+		config.NativeWindow = defaults.NativeWindow
+	}
+	if config.ProbeAsset == "" {
+		config.ProbeAsset = defaults.ProbeAsset
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.config = config
+}
+
+func (d *deadMansSwitch) Trips() <-chan Trip {
+	return d.tripCh
+}
+
+func (d *deadMansSwitch) Start() error {
+	d.mu.Lock()
+	config := d.config
+	d.mu.Unlock()
+
+	if config.NativeWindow > 0 {
+		d.armNative(config.NativeWindow)
+	}
+
+	return d.scheduler.Register(scheduler.Job{
+		Name:       JobName,
+		Interval:   config.Interval,
+		RunOnStart: true,
+		Run: func(_ context.Context) error {
+			d.Check()
+			return nil
+		},
+	})
+}
+
+func (d *deadMansSwitch) Stop() error {
+	return d.scheduler.Unregister(JobName)
+}
+
+// armNative sets the exchange-side window on every venue that offers one. A
+// failure leaves that venue on the client-side switch alone.
+func (d *deadMansSwitch) armNative(window time.Duration) {
+	for _, conn := range d.tradingVenues() {
+		provider, ok := conn.(types.CancelOnDisconnectProvider)
+		if !ok {
+			continue
+		}
+		name := conn.GetConnectorInfo().Name
+
+		if err := provider.ArmCancelOnDisconnect(window); err != nil {
+			d.logger.Warn("Dead-man's switch: failed to arm %s cancel-on-disconnect: %v", name, err)
+			continue
+		}
+
+		d.mu.Lock()
+		d.venue(name).status.NativeArmed = true
+		d.mu.Unlock()
+		d.logger.Info("Dead-man's switch: %s cancels orders %s after disconnect", name, window)
+	}
+}
+
+func (d *deadMansSwitch) Check() []Trip {
+	d.mu.Lock()
+	config := d.config
+	d.mu.Unlock()
+
+	var trips []Trip
+	for _, conn := range d.tradingVenues() {
+		if trip, ok := d.check(conn, config); ok {
+			trips = append(trips, trip)
+		}
+	}
+	return trips
+}
+
+func (d *deadMansSwitch) check(conn connector.Connector, config Config) (Trip, bool) {
+	name := conn.GetConnectorInfo().Name
+	now := d.timeProvider.Now()
+	connected := d.probe(conn, config)
+
+	d.mu.Lock()
+	state := d.venue(name)
+	wasConnected := state.status.Connected
+	state.status.Connected = connected
+	state.status.CheckedAt = now
+
+	if !connected {
+		if state.status.DisconnectedAt.IsZero() {
+			state.status.DisconnectedAt = now
+			d.logger.Warn("Dead-man's switch: %s unreachable", name)
+		}
+		if now.Sub(state.status.DisconnectedAt) >= config.DisconnectAfter {
+			state.pending = true
+		}
+		d.mu.Unlock()
+		return Trip{}, false
+	}
+
+	disconnectedAt := state.status.DisconnectedAt
+	pending := state.pending
+	if !pending {
+		if !disconnectedAt.IsZero() && !wasConnected {
+			d.logger.Info("Dead-man's switch: %s back after %s", name, now.Sub(disconnectedAt).Round(time.Second))
+		}
+		state.status.DisconnectedAt = time.Time{}
+		d.mu.Unlock()
+		return Trip{}, false
+	}
+	d.mu.Unlock()
+
+	trip := d.cancelAll(conn, name, disconnectedAt, now)
+
+	d.mu.Lock()
+	// A failed fetch leaves the outage pending so the next probe retries
+	if trip.Cancelled >= 0 {
+		state.pending = false
+		state.status.DisconnectedAt = time.Time{}
+	}
+	d.mu.Unlock()
+
+	if trip.Cancelled < 0 {
+		return Trip{}, false
+	}
+
+	select {
+	case d.tripCh <- trip:
+	default:
+		d.logger.Warn("Dead-man's switch trip channel full, dropping trip for %s", name)
+	}
+	return trip, true
+}
+
+// probe reports a venue reachable by its WebSocket state when it streams and
+// by a REST price probe otherwise
+func (d *deadMansSwitch) probe(conn connector.Connector, config Config) bool {
+	if ws, ok := conn.(connector.WebSocketConnector); ok && conn.SupportsRealTimeData() {
+		return ws.IsWebSocketConnected()
+	}
+	_, err := conn.FetchPrice(conn.GetPerpSymbol(portfolio.NewAsset(config.ProbeAsset)))
+	return err == nil
+}
+
+// cancelAll cancels every open order on a recovered venue, in one request
+// where the exchange allows it. Cancelled is -1 when the open orders could
+// not be read, so the outage stays pending.
+func (d *deadMansSwitch) cancelAll(conn connector.Connector, name connector.ExchangeName, disconnectedAt, now time.Time) Trip {
+	trip := Trip{Exchange: name, DisconnectedAt: disconnectedAt, RecoveredAt: now}
+
+	orders, err := conn.GetOpenOrders()
+	if err != nil {
+		d.logger.Warn("Dead-man's switch: failed to fetch %s open orders after outage: %v", name, err)
+		trip.Cancelled = -1
+		return trip
+	}
+	if len(orders) == 0 {
+		d.logger.Info("Dead-man's switch: %s back after %s with no resting orders", name, trip.Downtime().Round(time.Second))
+		return trip
+	}
+
+	if provider, ok := conn.(types.CancelAllProvider); ok {
+		if err := provider.CancelAllOrders(); err == nil {
+			trip.Cancelled = len(orders)
+		} else {
+			trip.Errors = append(trip.Errors, fmt.Sprintf("cancel all: %v", err))
+		}
+	}
+
+	// Fall back to one cancel per order when there is no mass cancel or it failed
+	if trip.Cancelled == 0 {
+		for _, order := range orders {
+			if _, err := conn.CancelOrder(order.Symbol, order.ID); err != nil {
+				trip.Errors = append(trip.Errors, fmt.Sprintf("cancel %s: %v", order.ID, err))
+				continue
+			}
+			trip.Cancelled++
+		}
+	}
+
+	if len(trip.Errors) > 0 {
+		d.logger.Error("🛑 Dead-man's switch: %s back after %s, cancelled %d/%d resting orders: %s",
+			name, trip.Downtime().Round(time.Second), trip.Cancelled, len(orders), strings.Join(trip.Errors, "; "))
+	} else {
+		d.logger.Warn("🛑 Dead-man's switch: %s back after %s, cancelled %d resting orders",
+			name, trip.Downtime().Round(time.Second), trip.Cancelled)
+	}
+	return trip
+}
+
+// tradingVenues are the ready connectors that can hold resting orders
+func (d *deadMansSwitch) tradingVenues() []connector.Connector {
+	var venues []connector.Connector
+	for _, conn := range d.registry.GetReadyConnectors() {
+		if conn.SupportsTradingOperations() && conn.GetConnectorInfo() != nil {
+			venues = append(venues, conn)
+		}
+	}
+	return venues
+}
+
+// venue returns the state for a venue, creating it connected; callers hold mu
+func (d *deadMansSwitch) venue(name connector.ExchangeName) *venueState {
+	state, ok := d.venues[name]
+	if !ok {
+		state = &venueState{status: VenueStatus{Exchange: name, Connected: true}}
+		d.venues[name] = state
+	}
+	return state
+}
+
+func (d *deadMansSwitch) Status() []VenueStatus {
+	d.mu.Lock()
+	statuses := make([]VenueStatus, 0, len(d.venues))
+	for _, state := range d.venues {
+		statuses = append(statuses, state.status)
+	}
+	d.mu.Unlock()
+
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Exchange < statuses[j].Exchange })
+	return statuses
+}
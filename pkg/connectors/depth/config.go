@@ -0,0 +1,42 @@
+package depth
+
+import (
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/data/stores/market"
+)
+
+const (
+	// DefaultTTL is how long a snapshot answers requests for the same or
+	// shallower depth without another REST call
+	DefaultTTL = 2 * time.Second
+
+	// DefaultMaxStale is the oldest snapshot served instead of a fetch when
+	// the connector is short on request budget
+	DefaultMaxStale = 30 * time.Second
+
+	// DefaultMinHeadroom is the budget fraction below which cached
+	// snapshots are preferred over fresh ones
+	DefaultMinHeadroom = 0.2
+
+	// DataKeyDepthSnapshot tags snapshot updates in the market store's last
+	// updated map, apart from market.DataKeyOrderBooks for streamed books
+	DataKeyDepthSnapshot market.DataKey = "order_book_snapshots"
+)
+
+// Config controls snapshot caching
+type Config struct {
+	TTL         time.Duration
+	MaxStale    time.Duration
+	MinHeadroom float64
+}
+
+// DefaultConfig caches for two seconds and leans on the cache below 20%
+// of the request budget
+func DefaultConfig() Config {
+	return Config{
+		TTL:         DefaultTTL,
+		MaxStale:    DefaultMaxStale,
+		MinHeadroom: DefaultMinHeadroom,
+	}
+}
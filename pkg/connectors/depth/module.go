@@ -0,0 +1,7 @@
+package depth
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(NewSnapshotService),
+)
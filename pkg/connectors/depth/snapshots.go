@@ -0,0 +1,234 @@
+package depth
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/data/stores/market"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+// Snapshot is a one-off REST order book. It is kept apart from the
+// streamed book in the market store, which a snapshot must never replace:
+// the two come from different feeds and the stream keeps updating.
+type Snapshot struct {
+	Exchange   connector.ExchangeName
+	Asset      portfolio.Asset
+	Instrument connector.Instrument
+	Book       connector.OrderBook
+
+	// Requested is the depth asked for; Truncated is set when the venue
+	// caps REST books below it
+	Requested int
+	Truncated bool
+
+	FetchedAt time.Time
+
+	// fetched is the depth the REST call asked for, after the venue cap
+	fetched int
+
+	// Cached is set when the snapshot was served without a REST call;
+	// Stale when that was because the request budget was low
+	Cached bool
+	Stale  bool
+}
+
+// SnapshotService fetches deep order books on demand for strategies that
+// need more levels than the stream carries. Snapshots are cached per
+// market so repeated requests within the TTL, and concurrent requests for
+// the same market, cost one REST call.
+type SnapshotService interface {
+	Configure(config Config)
+
+	// Snapshot returns up to depth levels per side
+	Snapshot(exchange connector.ExchangeName, asset portfolio.Asset, instrument connector.Instrument, depth int) (*Snapshot, error)
+
+	// Latest is the cached snapshot for a market, however old
+	Latest(exchange connector.ExchangeName, asset portfolio.Asset, instrument connector.Instrument) (*Snapshot, bool)
+}
+
+type snapshotKey struct {
+	exchange   connector.ExchangeName
+	asset      string
+	instrument connector.Instrument
+}
+
+type fetch struct {
+	depth    int
+	done     chan struct{}
+	snapshot *Snapshot
+	err      error
+}
+
+type snapshotService struct {
+	registry     registry.ConnectorRegistry
+	store        market.MarketData
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config   Config
+	cache    map[snapshotKey]*Snapshot
+	inFlight map[snapshotKey]*fetch
+	mu       sync.Mutex
+}
+
+func NewSnapshotService(
+	connectorRegistry registry.ConnectorRegistry,
+	store market.MarketData,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) SnapshotService {
+	return &snapshotService{
+		registry:     connectorRegistry,
+		store:        store,
+		timeProvider: timeProvider,
+		logger:       logger,
+		config:       DefaultConfig(),
+		cache:        make(map[snapshotKey]*Snapshot),
+		inFlight:     make(map[snapshotKey]*fetch),
+	}
+}
+
+func (s *snapshotService) Configure(config Config) {
+	defaults := DefaultConfig()
+	if config.TTL <= 0 {
+		config.TTL = defaults.TTL
+	}
+	if config.MaxStale < config.TTL {
+		config.MaxStale = defaults.MaxStale
+	}
+	if config.MinHeadroom < 0 {
+		config.MinHeadroom = 0
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.config = config
+}
+
+func (s *snapshotService) Snapshot(exchange connector.ExchangeName, asset portfolio.Asset, instrument connector.Instrument, depth int) (*Snapshot, error) {
+	if depth <= 0 {
+		return nil, fmt.Errorf("snapshot depth must be positive")
+	}
+
+	conn, ok := s.registry.GetConnector(exchange)
+	if !ok {
+		return nil, fmt.Errorf("connector %s not registered", exchange)
+	}
+
+	fetchDepth := depth
+	if provider, ok := conn.(types.DepthSnapshotProvider); ok {
+		if limit := provider.MaxSnapshotDepth(instrument); limit > 0 && fetchDepth > limit {
+			fetchDepth = limit
+		}
+	}
+
+	key := snapshotKey{exchange, asset.Symbol(), instrument}
+	now := s.timeProvider.Now()
+
+	s.mu.Lock()
+	config := s.config
+	cached := s.cache[key]
+	if cached != nil && cached.fetched >= fetchDepth {
+		age := now.Sub(cached.FetchedAt)
+		if age < config.TTL {
+			snapshot := cached.trimmed(depth)
+			s.mu.Unlock()
+			snapshot.Cached = true
+			return snapshot, nil
+		}
+		if age < config.MaxStale && lowOnBudget(conn, config.MinHeadroom) {
+			snapshot := cached.trimmed(depth)
+			s.mu.Unlock()
+			s.logger.Debug("Serving %s %s depth snapshot from %s ago: request budget low", exchange, asset.Symbol(), age.Round(time.Millisecond))
+			snapshot.Cached, snapshot.Stale = true, true
+			return snapshot, nil
+		}
+	}
+
+	// A fetch already under way for at least this depth is shared
+	if pending, ok := s.inFlight[key]; ok && pending.depth >= fetchDepth {
+		s.mu.Unlock()
+		<-pending.done
+		if pending.err != nil {
+			return nil, pending.err
+		}
+		return pending.snapshot.trimmed(depth), nil
+	}
+
+	pending := &fetch{depth: fetchDepth, done: make(chan struct{})}
+	s.inFlight[key] = pending
+	s.mu.Unlock()
+
+	pending.snapshot, pending.err = s.fetch(conn, key, asset, fetchDepth, depth)
+
+	s.mu.Lock()
+	if s.inFlight[key] == pending {
+		delete(s.inFlight, key)
+	}
+	if pending.err == nil {
+		s.cache[key] = pending.snapshot
+	}
+	s.mu.Unlock()
+	close(pending.done)
+
+	if pending.err != nil {
+		return nil, pending.err
+	}
+
+	s.store.UpdateLastUpdated(market.UpdateKey{DataType: DataKeyDepthSnapshot, Asset: asset, Exchange: exchange})
+	return pending.snapshot.trimmed(depth), nil
+}
+
+func (s *snapshotService) fetch(conn connector.Connector, key snapshotKey, asset portfolio.Asset, fetchDepth, requested int) (*Snapshot, error) {
+	book, err := conn.FetchOrderBook(asset, key.instrument, fetchDepth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s depth snapshot on %s: %w", asset.Symbol(), key.exchange, err)
+	}
+
+	snapshot := &Snapshot{
+		Exchange:   key.exchange,
+		Asset:      asset,
+		Instrument: key.instrument,
+		Book:       *book,
+		Requested:  requested,
+		Truncated:  fetchDepth < requested,
+		FetchedAt:  s.timeProvider.Now(),
+		fetched:    fetchDepth,
+	}
+	snapshot.Book.Asset = asset
+	return snapshot, nil
+}
+
+func (s *snapshotService) Latest(exchange connector.ExchangeName, asset portfolio.Asset, instrument connector.Instrument) (*Snapshot, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cached, ok := s.cache[snapshotKey{exchange, asset.Symbol(), instrument}]
+	if !ok {
+		return nil, false
+	}
+	return cached.trimmed(cached.fetched), true
+}
+
+// trimmed copies the snapshot cut to depth levels per side, so callers
+// never share the cached slices
+func (s *Snapshot) trimmed(depth int) *Snapshot {
+	copied := *s
+	copied.Book.Bids = append([]connector.PriceLevel(nil), s.Book.Bids[:min(depth, len(s.Book.Bids))]...)
+	copied.Book.Asks = append([]connector.PriceLevel(nil), s.Book.Asks[:min(depth, len(s.Book.Asks))]...)
+	copied.Requested = depth
+	copied.Truncated = s.fetched < depth
+	return &copied
+}
+
+func lowOnBudget(conn connector.Connector, minHeadroom float64) bool {
+	provider, ok := conn.(types.RateLimitProvider)
+	return ok && provider.RateLimitStatus().Headroom() < minHeadroom
+}
@@ -0,0 +1,33 @@
+package execution
+
+import "time"
+
+const (
+	// DefaultInterval is how often working algorithms are advanced
+	DefaultInterval = time.Second
+
+	// DefaultSlices is the number of TWAP child orders when none is given
+	DefaultSlices = 10
+
+	// DefaultLegTimeout is how long multi-leg trades wait for every leg to fill
+	DefaultLegTimeout = 10 * time.Second
+
+	// DefaultRetention is how long a finished parent or multi-leg trade
+	// stays readable through Parent and Trade before it is evicted
+	DefaultRetention = 10 * time.Minute
+
+	// JobName is the scheduler job the executor registers under
+	JobName = "execution-algos"
+)
+
+// Config controls the algorithm executor
+type Config struct {
+	Interval  time.Duration
+	Retention time.Duration
+}
+
+// DefaultConfig advances working algorithms every second and forgets them
+// ten minutes after they finish
+func DefaultConfig() Config {
+	return Config{Interval: DefaultInterval, Retention: DefaultRetention}
+}
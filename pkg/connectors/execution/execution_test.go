@@ -0,0 +1,121 @@
+package execution_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	mockconnector "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	mockregistry "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	mocktemporal "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	logger "github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	mockswitches "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/connectors/switches"
+	mocktracker "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/connectors/tracker"
+	mockscheduler "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/scheduler"
+	"github.com/backtesting-org/live-trading/pkg/connectors/execution"
+	"github.com/backtesting-org/live-trading/pkg/connectors/latency"
+	"github.com/backtesting-org/live-trading/pkg/runlog"
+)
+
+func TestExecution(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Execution Suite")
+}
+
+// placed is one order a fake venue received
+type placed struct {
+	Symbol   string
+	Side     connector.OrderSide
+	Quantity numerical.Decimal
+	Price    numerical.Decimal
+}
+
+// venue is a mocked connector that records the orders placed on it and
+// answers with the response respond builds
+type venue struct {
+	conn    *mockconnector.Connector
+	orders  []placed
+	respond func(order placed, id string) (*connector.OrderResponse, error)
+}
+
+func newVenue(name connector.ExchangeName) *venue {
+	v := &venue{conn: mockconnector.NewConnector(GinkgoT())}
+	v.respond = func(order placed, id string) (*connector.OrderResponse, error) {
+		return &connector.OrderResponse{OrderID: id, Status: connector.OrderStatusFilled, FilledQty: order.Quantity, AvgPrice: numerical.NewFromInt(100)}, nil
+	}
+
+	place := func(order placed) (*connector.OrderResponse, error) {
+		v.orders = append(v.orders, order)
+		return v.respond(order, fmt.Sprintf("%s-%d", name, len(v.orders)))
+	}
+
+	v.conn.On("GetConnectorInfo").Return(&connector.Info{Name: name}).Maybe()
+	v.conn.On("SupportsTradingOperations").Return(true).Maybe()
+	v.conn.On("PlaceMarketOrder", mock.Anything, mock.Anything, mock.Anything).Return(
+		func(symbol string, side connector.OrderSide, quantity numerical.Decimal) (*connector.OrderResponse, error) {
+			return place(placed{Symbol: symbol, Side: side, Quantity: quantity})
+		}).Maybe()
+	v.conn.On("PlaceLimitOrder", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(
+		func(symbol string, side connector.OrderSide, quantity, price numerical.Decimal) (*connector.OrderResponse, error) {
+			return place(placed{Symbol: symbol, Side: side, Quantity: quantity, Price: price})
+		}).Maybe()
+	return v
+}
+
+// quantities lists the placed quantities as strings, for comparison
+// independent of decimal scale
+func (v *venue) quantities() []string {
+	quantities := make([]string, len(v.orders))
+	for i, order := range v.orders {
+		quantities[i] = order.Quantity.String()
+	}
+	return quantities
+}
+
+// harness is an executor over fake venues with a clock the spec moves
+type harness struct {
+	executor execution.AlgoExecutor
+	registry *mockregistry.ConnectorRegistry
+	tracker  *mocktracker.OrderTracker
+	now      time.Time
+}
+
+func newHarness(venues map[connector.ExchangeName]*venue) *harness {
+	h := &harness{
+		registry: mockregistry.NewConnectorRegistry(GinkgoT()),
+		tracker:  mocktracker.NewOrderTracker(GinkgoT()),
+		now:      time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	for name, v := range venues {
+		h.registry.On("GetConnector", name).Return(v.conn, true).Maybe()
+	}
+
+	mockTime := mocktemporal.NewTimeProvider(GinkgoT())
+	mockTime.On("Now").Return(func() time.Time { return h.now }).Maybe()
+
+	tradingSwitches := mockswitches.NewTradingSwitches(GinkgoT())
+	tradingSwitches.On("CheckOrder", mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	h.tracker.On("Track", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	jobs := mockscheduler.NewScheduler(GinkgoT())
+	noOp := logger.NewNoOpLogger()
+	h.executor = execution.NewAlgoExecutor(
+		h.registry,
+		jobs,
+		h.tracker,
+		latency.NewRecorder(),
+		tradingSwitches,
+		runlog.NewRunLogger(jobs, mockTime, noOp),
+		mockTime,
+		noOp,
+	)
+	return h
+}
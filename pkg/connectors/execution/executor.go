@@ -0,0 +1,559 @@
+package execution
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/latency"
+	"github.com/backtesting-org/live-trading/pkg/connectors/switches"
+	"github.com/backtesting-org/live-trading/pkg/connectors/tracker"
+	"github.com/backtesting-org/live-trading/pkg/runlog"
+	"github.com/backtesting-org/live-trading/pkg/scheduler"
+)
+
+// AlgoExecutor works large parent orders as TWAP slices or iceberg
+// replenishments, and multi-leg trades whose legs must fill together. Orders
+// are handed to the OrderTracker, which must be running for fills to be seen.
+type AlgoExecutor interface {
+	Configure(config Config)
+
+	// Start registers the job that advances working parents
+	Start() error
+	Stop() error
+
+	// Submit validates a parent order, places its first child and returns its ID
+	Submit(order ParentOrder) (string, error)
+
+	// Cancel stops a working parent and cancels its open children
+	Cancel(id string) error
+
+	// Advance moves every working parent and multi-leg trade forward once,
+	// and evicts those finished longer than the retention ago
+	Advance() error
+
+	Parent(id string) (ParentState, bool)
+	Active() []ParentState
+
+	// Completions publishes each parent once it stops working, with its
+	// aggregate fill price
+	Completions() <-chan ParentState
+
+	// SubmitLegs places every leg concurrently and returns the trade's ID.
+	// If a leg is rejected, stops short or is unfilled at the timeout, the
+	// open legs are cancelled and the filled ones hedged or unwound.
+	SubmitLegs(order MultiLegOrder) (string, error)
+
+	Trade(id string) (MultiLegState, bool)
+
+	// TradeCompletions publishes each multi-leg trade once it stops working
+	TradeCompletions() <-chan MultiLegState
+}
+
+// parent is a working parent order; mu serialises advancement so Submit
+// and the scheduled job never place the same slice twice
+type parent struct {
+	state    ParentState
+	slices   []numerical.Decimal
+	next     int
+	step     numerical.Decimal
+	interval time.Duration
+	mu       sync.Mutex
+}
+
+type algoExecutor struct {
+	registry     registry.ConnectorRegistry
+	scheduler    scheduler.Scheduler
+	tracker      tracker.OrderTracker
+	latency      latency.Recorder
+	switches     switches.TradingSwitches
+	runLog       runlog.RunLogger
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config       Config
+	parents      map[string]*parent
+	trades       map[string]*trade
+	completionCh chan ParentState
+	tradeCh      chan MultiLegState
+	sequence     atomic.Uint64
+	mu           sync.Mutex
+}
+
+func NewAlgoExecutor(
+	connectorRegistry registry.ConnectorRegistry,
+	jobScheduler scheduler.Scheduler,
+	orderTracker tracker.OrderTracker,
+	latencyRecorder latency.Recorder,
+	tradingSwitches switches.TradingSwitches,
+	runLog runlog.RunLogger,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) AlgoExecutor {
+	return &algoExecutor{
+		registry:     connectorRegistry,
+		scheduler:    jobScheduler,
+		tracker:      orderTracker,
+		latency:      latencyRecorder,
+		switches:     tradingSwitches,
+		runLog:       runLog,
+		timeProvider: timeProvider,
+		logger:       logger,
+		config:       DefaultConfig(),
+		parents:      make(map[string]*parent),
+		trades:       make(map[string]*trade),
+		completionCh: make(chan ParentState, 100),
+		tradeCh:      make(chan MultiLegState, 100),
+	}
+}
+
+func (e *algoExecutor) Configure(config Config) {
+	if config.Interval <= 0 {
+		config.Interval = DefaultInterval
+	}
+	if config.Retention <= 0 {
+		config.Retention = DefaultRetention
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.config = config
+}
+
+func (e *algoExecutor) Completions() <-chan ParentState {
+	return e.completionCh
+}
+
+func (e *algoExecutor) Start() error {
+	e.mu.Lock()
+	interval := e.config.Interval
+	e.mu.Unlock()
+
+	return e.scheduler.Register(scheduler.Job{
+		Name:     JobName,
+		Interval: interval,
+		Run: func(_ context.Context) error {
+			return e.Advance()
+		},
+	})
+}
+
+func (e *algoExecutor) Stop() error {
+	return e.scheduler.Unregister(JobName)
+}
+
+func (e *algoExecutor) Submit(order ParentOrder) (string, error) {
+	if err := order.validate(); err != nil {
+		return "", fmt.Errorf("invalid parent order: %w", err)
+	}
+
+	conn, ok := e.registry.GetConnector(order.Exchange)
+	if !ok {
+		return "", fmt.Errorf("connector %s not registered", order.Exchange)
+	}
+	if !conn.SupportsTradingOperations() {
+		return "", fmt.Errorf("connector %s does not support trading", order.Exchange)
+	}
+	if err := e.switches.CheckOrder(order.Exchange, order.Symbol, order.ReduceOnly); err != nil {
+		return "", err
+	}
+
+	now := e.timeProvider.Now()
+	p := &parent{
+		state: ParentState{
+			ID:        fmt.Sprintf("%s-%d-%d", order.Algo, now.UnixMilli(), e.sequence.Add(1)),
+			Order:     order,
+			Status:    StatusWorking,
+			FilledQty: numerical.Zero(),
+			AvgPrice:  numerical.Zero(),
+			StartedAt: now,
+		},
+		step: stepSize(conn, order.Symbol),
+	}
+
+	// Every child must be a whole number of lots, which only a total that
+	// is one can be split into
+	if p.step.IsPositive() && !roundToStep(order.Quantity, p.step).Equal(order.Quantity) {
+		return "", fmt.Errorf("invalid parent order: quantity %s is not a multiple of the %s lot step %s",
+			order.Quantity.String(), order.Symbol, p.step.String())
+	}
+
+	if order.Algo == AlgoTWAP {
+		p.slices = planSlices(order.Quantity, order.Slices, p.step)
+		p.interval = order.Duration / time.Duration(len(p.slices))
+	}
+
+	e.mu.Lock()
+	e.parents[p.state.ID] = p
+	e.mu.Unlock()
+
+	e.logger.Info("Execution %s started: %s %s %s on %s",
+		p.state.ID, order.Side, order.Quantity.String(), order.Symbol, order.Exchange)
+
+	e.advance(p)
+	return p.state.ID, nil
+}
+
+func (e *algoExecutor) Cancel(id string) error {
+	p, ok := e.parent(id)
+	if !ok {
+		return fmt.Errorf("parent order %s not found", id)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.state.Status != StatusWorking {
+		return fmt.Errorf("parent order %s is already %s", id, p.state.Status)
+	}
+
+	conn, ok := e.registry.GetConnector(p.state.Order.Exchange)
+	if !ok {
+		return fmt.Errorf("connector %s not registered", p.state.Order.Exchange)
+	}
+
+	e.refresh(p)
+	e.cancelChildren(conn, p)
+	e.finish(p, StatusCancelled, "")
+	return nil
+}
+
+func (e *algoExecutor) Advance() error {
+	e.evict()
+
+	e.mu.Lock()
+	working := make([]*parent, 0, len(e.parents))
+	for _, p := range e.parents {
+		working = append(working, p)
+	}
+	e.mu.Unlock()
+
+	for _, p := range working {
+		e.advance(p)
+	}
+	e.advanceTrades()
+	return nil
+}
+
+// evict forgets parents and trades finished before the retention cutoff.
+// Each is inspected under its own lock only, since SubmitLegs takes e.mu
+// while holding a trade's.
+func (e *algoExecutor) evict() {
+	e.mu.Lock()
+	cutoff := e.timeProvider.Now().Add(-e.config.Retention)
+	parents := make(map[string]*parent, len(e.parents))
+	for id, p := range e.parents {
+		parents[id] = p
+	}
+	trades := make(map[string]*trade, len(e.trades))
+	for id, t := range e.trades {
+		trades[id] = t
+	}
+	e.mu.Unlock()
+
+	var expiredParents, expiredTrades []string
+	for id, p := range parents {
+		p.mu.Lock()
+		if p.state.Status != StatusWorking && p.state.FinishedAt.Before(cutoff) {
+			expiredParents = append(expiredParents, id)
+		}
+		p.mu.Unlock()
+	}
+	for id, t := range trades {
+		t.mu.Lock()
+		if t.state.Status != StatusWorking && t.state.FinishedAt.Before(cutoff) {
+			expiredTrades = append(expiredTrades, id)
+		}
+		t.mu.Unlock()
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, id := range expiredParents {
+		delete(e.parents, id)
+	}
+	for _, id := range expiredTrades {
+		delete(e.trades, id)
+	}
+}
+
+func (e *algoExecutor) Parent(id string) (ParentState, bool) {
+	p, ok := e.parent(id)
+	if !ok {
+		return ParentState{}, false
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return snapshot(p.state), true
+}
+
+func (e *algoExecutor) Active() []ParentState {
+	e.mu.Lock()
+	parents := make([]*parent, 0, len(e.parents))
+	for _, p := range e.parents {
+		parents = append(parents, p)
+	}
+	e.mu.Unlock()
+
+	var active []ParentState
+	for _, p := range parents {
+		p.mu.Lock()
+		if p.state.Status == StatusWorking {
+			active = append(active, snapshot(p.state))
+		}
+		p.mu.Unlock()
+	}
+	sort.Slice(active, func(i, j int) bool { return active[i].StartedAt.Before(active[j].StartedAt) })
+	return active
+}
+
+func (e *algoExecutor) parent(id string) (*parent, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	p, ok := e.parents[id]
+	return p, ok
+}
+
+// advance refreshes a parent's children and places or cancels orders as
+// its algorithm requires
+func (e *algoExecutor) advance(p *parent) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.state.Status != StatusWorking {
+		return
+	}
+
+	conn, ok := e.registry.GetConnector(p.state.Order.Exchange)
+	if !ok {
+		e.finish(p, StatusFailed, fmt.Sprintf("connector %s not registered", p.state.Order.Exchange))
+		return
+	}
+
+	e.refresh(p)
+
+	var err error
+	switch p.state.Order.Algo {
+	case AlgoTWAP:
+		err = e.advanceTWAP(conn, p)
+	case AlgoIceberg:
+		err = e.advanceIceberg(conn, p)
+	}
+
+	if err != nil {
+		e.cancelChildren(conn, p)
+		e.finish(p, StatusFailed, err.Error())
+	}
+}
+
+// advanceTWAP places at most one overdue slice per tick, then waits one
+// further interval after the schedule ends for resting children to fill
+func (e *algoExecutor) advanceTWAP(conn connector.Connector, p *parent) error {
+	now := e.timeProvider.Now()
+
+	if p.next < len(p.slices) {
+		due := p.state.StartedAt.Add(p.interval * time.Duration(p.next))
+		if !now.Before(due) {
+			if err := e.placeChild(conn, p, p.slices[p.next]); err != nil {
+				return err
+			}
+			p.next++
+		}
+		return nil
+	}
+
+	if openChildren(p) == 0 {
+		e.finish(p, filledStatus(p), "")
+		return nil
+	}
+
+	if !now.Before(p.state.StartedAt.Add(p.state.Order.Duration + p.interval)) {
+		e.cancelChildren(conn, p)
+		e.finish(p, filledStatus(p), "")
+	}
+	return nil
+}
+
+// advanceIceberg keeps one child resting at the limit price, replacing it
+// as soon as it stops working
+func (e *algoExecutor) advanceIceberg(conn connector.Connector, p *parent) error {
+	order := p.state.Order
+
+	if !p.state.RemainingQty().IsPositive() {
+		e.finish(p, StatusCompleted, "")
+		return nil
+	}
+
+	if order.Duration > 0 && !e.timeProvider.Now().Before(p.state.StartedAt.Add(order.Duration)) {
+		e.cancelChildren(conn, p)
+		e.finish(p, filledStatus(p), "")
+		return nil
+	}
+
+	if openChildren(p) > 0 {
+		return nil
+	}
+
+	quantity := order.DisplayQuantity
+	remaining := p.state.RemainingQty()
+	if remaining.LessThan(quantity) {
+		quantity = remaining
+	}
+	if rounded := roundToStep(quantity, p.step); rounded.IsPositive() {
+		quantity = rounded
+	}
+
+	return e.placeChild(conn, p, quantity)
+}
+
+func (e *algoExecutor) placeChild(conn connector.Connector, p *parent, quantity numerical.Decimal) error {
+	order := p.state.Order
+
+	// A switch flipped mid-execution stops further children
+	if err := e.switches.CheckOrder(order.Exchange, order.Symbol, order.ReduceOnly); err != nil {
+		return err
+	}
+
+	response, err := e.placeOrder(conn, order.Symbol, order.Side, quantity, order.LimitPrice)
+	if err != nil {
+		return fmt.Errorf("child order %d rejected: %w", len(p.state.Children)+1, err)
+	}
+
+	if err := e.tracker.Track(order.Exchange, response); err != nil {
+		e.logger.Warn("Execution %s: child %s not tracked: %v", p.state.ID, response.OrderID, err)
+	}
+
+	status := response.Status
+	if status == "" {
+		status = connector.OrderStatusNew
+	}
+	p.state.Children = append(p.state.Children, ChildOrder{
+		OrderID:   response.OrderID,
+		Quantity:  quantity,
+		FilledQty: response.FilledQty,
+		AvgPrice:  response.AvgPrice,
+		Status:    status,
+		PlacedAt:  e.timeProvider.Now(),
+	})
+	aggregate(p)
+
+	e.logger.Info("Execution %s: child %d %s %s placed (%s)",
+		p.state.ID, len(p.state.Children), quantity.String(), order.Symbol, response.OrderID)
+	e.runLog.Record(runlog.LevelInfo, "order", runlog.Fields{
+		Exchange:      string(order.Exchange),
+		OrderID:       response.OrderID,
+		CorrelationID: p.state.ID,
+	}, "Execution %s child %d: %s %s %s placed", p.state.ID, len(p.state.Children), order.Side, quantity.String(), order.Symbol)
+	return nil
+}
+
+// refresh copies the tracker's view of each open child into the parent
+func (e *algoExecutor) refresh(p *parent) {
+	for i := range p.state.Children {
+		child := &p.state.Children[i]
+		if isTerminal(child.Status) {
+			continue
+		}
+
+		tracked, ok := e.tracker.Order(p.state.Order.Exchange, child.OrderID)
+		if !ok {
+			continue
+		}
+		child.Status = tracked.Order.Status
+		child.FilledQty = tracked.Order.FilledQty
+		child.AvgPrice = tracked.Order.AvgPrice
+	}
+	aggregate(p)
+}
+
+func (e *algoExecutor) cancelChildren(conn connector.Connector, p *parent) {
+	for i := range p.state.Children {
+		child := &p.state.Children[i]
+		if isTerminal(child.Status) {
+			continue
+		}
+
+		if err := e.latency.Time(conn, latency.OpCancelOrder, func() error {
+			_, err := conn.CancelOrder(p.state.Order.Symbol, child.OrderID)
+			return err
+		}); err != nil {
+			e.logger.Warn("Execution %s: failed to cancel child %s: %v", p.state.ID, child.OrderID, err)
+			continue
+		}
+		child.Status = connector.OrderStatusCanceled
+	}
+}
+
+func (e *algoExecutor) finish(p *parent, status Status, reason string) {
+	p.state.Status = status
+	p.state.Err = reason
+	p.state.FinishedAt = e.timeProvider.Now()
+
+	if reason != "" {
+		e.logger.Error("Execution %s %s: %s", p.state.ID, status, reason)
+	} else {
+		e.logger.Info("Execution %s %s: filled %s/%s @ %s",
+			p.state.ID, status, p.state.FilledQty.String(), p.state.Order.Quantity.String(), p.state.AvgPrice.String())
+	}
+
+	select {
+	case e.completionCh <- snapshot(p.state):
+	default:
+		e.logger.Warn("Execution completion channel full, dropping %s", p.state.ID)
+	}
+}
+
+// aggregate recomputes the parent's filled quantity and weighted average price
+func aggregate(p *parent) {
+	filled := numerical.Zero()
+	notional := numerical.Zero()
+	for _, child := range p.state.Children {
+		filled = filled.Add(child.FilledQty)
+		notional = notional.Add(child.FilledQty.Mul(child.AvgPrice))
+	}
+
+	p.state.FilledQty = filled
+	if filled.IsPositive() {
+		p.state.AvgPrice = notional.Div(filled)
+	}
+}
+
+func openChildren(p *parent) int {
+	open := 0
+	for _, child := range p.state.Children {
+		if !isTerminal(child.Status) {
+			open++
+		}
+	}
+	return open
+}
+
+func filledStatus(p *parent) Status {
+	if p.state.RemainingQty().IsPositive() {
+		return StatusCancelled
+	}
+	return StatusCompleted
+}
+
+func snapshot(state ParentState) ParentState {
+	state.Children = append([]ChildOrder(nil), state.Children...)
+	return state
+}
+
+func isTerminal(status connector.OrderStatus) bool {
+	switch status {
+	case connector.OrderStatusFilled, connector.OrderStatusCanceled,
+		connector.OrderStatusRejected, connector.OrderStatusExpired:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,7 @@
+package execution
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(NewAlgoExecutor),
+)
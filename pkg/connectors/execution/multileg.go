@@ -0,0 +1,445 @@
+package execution
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/live-trading/pkg/connectors/latency"
+	"github.com/backtesting-org/live-trading/pkg/runlog"
+)
+
+// trade is a working multi-leg trade; mu serialises advancement like a parent's
+type trade struct {
+	state    MultiLegState
+	deadline time.Time
+	mu       sync.Mutex
+}
+
+func (e *algoExecutor) SubmitLegs(order MultiLegOrder) (string, error) {
+	if err := order.validate(); err != nil {
+		return "", fmt.Errorf("invalid multi-leg order: %w", err)
+	}
+	if order.Timeout == 0 {
+		order.Timeout = DefaultLegTimeout
+	}
+
+	// Every leg is checked before any is placed, so a leg that could never
+	// be sent does not leave the others to unwind
+	conns := make([]connector.Connector, len(order.Legs))
+	for i, leg := range order.Legs {
+		conn, ok := e.registry.GetConnector(leg.Exchange)
+		if !ok {
+			return "", fmt.Errorf("leg %d: connector %s not registered", i+1, leg.Exchange)
+		}
+		if !conn.SupportsTradingOperations() {
+			return "", fmt.Errorf("leg %d: connector %s does not support trading", i+1, leg.Exchange)
+		}
+		if err := e.switches.CheckOrder(leg.Exchange, leg.Symbol, false); err != nil {
+			return "", fmt.Errorf("leg %d: %w", i+1, err)
+		}
+		conns[i] = conn
+	}
+
+	now := e.timeProvider.Now()
+	t := &trade{
+		state: MultiLegState{
+			ID:        fmt.Sprintf("legs-%d-%d", now.UnixMilli(), e.sequence.Add(1)),
+			Order:     order,
+			Status:    StatusWorking,
+			Legs:      make([]LegState, len(order.Legs)),
+			StartedAt: now,
+		},
+		deadline: now.Add(order.Timeout),
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	e.mu.Lock()
+	e.trades[t.state.ID] = t
+	e.mu.Unlock()
+
+	e.logger.Info("Multi-leg trade %s started: %d legs", t.state.ID, len(order.Legs))
+
+	var wg sync.WaitGroup
+	responses := make([]*connector.OrderResponse, len(order.Legs))
+	errs := make([]error, len(order.Legs))
+	for i, leg := range order.Legs {
+		wg.Add(1)
+		go func(i int, leg Leg) {
+			defer wg.Done()
+			responses[i], errs[i] = e.placeOrder(conns[i], leg.Symbol, leg.Side, leg.Quantity, leg.LimitPrice)
+		}(i, leg)
+	}
+	wg.Wait()
+
+	var rejected []string
+	for i, leg := range order.Legs {
+		state := &t.state.Legs[i]
+		state.Leg = leg
+		state.FilledQty = numerical.Zero()
+		state.AvgPrice = numerical.Zero()
+
+		if errs[i] != nil {
+			state.Status = connector.OrderStatusRejected
+			state.Err = errs[i].Error()
+			rejected = append(rejected, fmt.Sprintf("leg %d: %v", i+1, errs[i]))
+			continue
+		}
+		e.applyLegResponse(t, i, responses[i])
+	}
+
+	if len(rejected) > 0 {
+		e.recoverTrade(t, fmt.Sprintf("rejected %s", strings.Join(rejected, "; ")))
+		return t.state.ID, nil
+	}
+
+	e.checkTrade(t)
+	return t.state.ID, nil
+}
+
+func (e *algoExecutor) Trade(id string) (MultiLegState, bool) {
+	e.mu.Lock()
+	t, ok := e.trades[id]
+	e.mu.Unlock()
+	if !ok {
+		return MultiLegState{}, false
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return snapshotTrade(t.state), true
+}
+
+func (e *algoExecutor) TradeCompletions() <-chan MultiLegState {
+	return e.tradeCh
+}
+
+// advanceTrades moves every working multi-leg trade forward once
+func (e *algoExecutor) advanceTrades() {
+	e.mu.Lock()
+	working := make([]*trade, 0, len(e.trades))
+	for _, t := range e.trades {
+		working = append(working, t)
+	}
+	e.mu.Unlock()
+
+	for _, t := range working {
+		t.mu.Lock()
+		if t.state.Status == StatusWorking {
+			e.refreshLegs(t)
+			e.checkTrade(t)
+		}
+		t.mu.Unlock()
+	}
+}
+
+// checkTrade completes a trade once every leg has filled, and recovers it
+// once a leg has stopped short or the timeout has passed
+func (e *algoExecutor) checkTrade(t *trade) {
+	filled := true
+	for i, leg := range t.state.Legs {
+		if !leg.RemainingQty().IsPositive() {
+			continue
+		}
+		filled = false
+		if isTerminal(leg.Status) {
+			e.recoverTrade(t, fmt.Sprintf("leg %d %s with %s of %s filled", i+1, leg.Status, leg.FilledQty, leg.Leg.Quantity))
+			return
+		}
+	}
+
+	if filled {
+		e.finishTrade(t, StatusCompleted, "")
+		return
+	}
+	if !e.timeProvider.Now().Before(t.deadline) {
+		e.recoverTrade(t, fmt.Sprintf("legs not filled within %s", t.state.Order.Timeout))
+	}
+}
+
+// recoverTrade cancels the open legs and then hedges or unwinds what filled.
+// Fills the tracker has not seen yet when the legs are cancelled are missed,
+// so the recovery orders are sized from the last tracked state.
+func (e *algoExecutor) recoverTrade(t *trade, reason string) {
+	for i := range t.state.Legs {
+		leg := &t.state.Legs[i]
+		if leg.OrderID == "" || isTerminal(leg.Status) {
+			continue
+		}
+		if e.cancelLegOrder(t, i, leg.OrderID) {
+			leg.Status = connector.OrderStatusCanceled
+		}
+	}
+	e.refreshLegs(t)
+
+	// A trade in which nothing filled holds no exposure to recover
+	if !hasFills(t) {
+		t.state.Recovered = true
+		e.finishTrade(t, StatusFailed, reason)
+		return
+	}
+
+	recovery := t.state.Order.Recovery
+	failures := 0
+	if recovery == RecoveryHedge {
+		failures = e.hedgeLegs(t)
+		if failures > 0 {
+			e.logger.Warn("Multi-leg trade %s: %d hedge orders rejected, unwinding instead", t.state.ID, failures)
+			recovery = RecoveryUnwind
+		}
+	}
+	if recovery == RecoveryUnwind {
+		failures = e.unwindLegs(t)
+	}
+
+	t.state.Recovery = recovery
+	t.state.Recovered = failures == 0
+	e.finishTrade(t, StatusFailed, reason)
+}
+
+// hedgeLegs brings every leg up to the fill ratio of the furthest-filled
+// leg at market, so the legs are balanced for what actually traded rather
+// than for the whole order, and returns how many hedge orders were rejected
+func (e *algoExecutor) hedgeLegs(t *trade) int {
+	ratio := numerical.Zero()
+	for _, leg := range t.state.Legs {
+		if !leg.Leg.Quantity.IsPositive() {
+			continue
+		}
+		if filled := leg.FilledQty.Div(leg.Leg.Quantity); filled.GreaterThan(ratio) {
+			ratio = filled
+		}
+	}
+	if ratio.GreaterThan(numerical.NewFromInt(1)) {
+		ratio = numerical.NewFromInt(1)
+	}
+
+	failures := 0
+	for i := range t.state.Legs {
+		leg := &t.state.Legs[i]
+		shortfall := leg.Leg.Quantity.Mul(ratio).Sub(leg.FilledQty)
+		if !shortfall.IsPositive() {
+			continue
+		}
+		if err := e.placeRecovery(t, i, leg.Leg.Side, shortfall, false); err != nil {
+			leg.Err = err.Error()
+			failures++
+		}
+	}
+	return failures
+}
+
+// unwindLegs closes each leg's exposure, its fill plus whatever its hedges
+// filled, and returns how many unwind orders were rejected. Hedges still
+// open are cancelled first so they cannot add exposure after the unwind.
+func (e *algoExecutor) unwindLegs(t *trade) int {
+	failures := 0
+	for i := range t.state.Legs {
+		leg := &t.state.Legs[i]
+		e.settleRecovery(t, i)
+
+		exposure := leg.FilledQty
+		for _, order := range leg.Recovery {
+			exposure = exposure.Add(order.FilledQty)
+		}
+		if !exposure.IsPositive() {
+			continue
+		}
+		if err := e.placeRecovery(t, i, oppositeSide(leg.Leg.Side), exposure, true); err != nil {
+			leg.Err = err.Error()
+			failures++
+		}
+	}
+	return failures
+}
+
+// settleRecovery cancels a leg's open recovery orders and copies their last
+// tracked fill
+func (e *algoExecutor) settleRecovery(t *trade, index int) {
+	leg := &t.state.Legs[index]
+	for j := range leg.Recovery {
+		order := &leg.Recovery[j]
+		if !isTerminal(order.Status) && e.cancelLegOrder(t, index, order.OrderID) {
+			order.Status = connector.OrderStatusCanceled
+		}
+
+		tracked, ok := e.tracker.Order(leg.Leg.Exchange, order.OrderID)
+		if !ok {
+			continue
+		}
+		if tracked.Order.FilledQty.GreaterThan(order.FilledQty) {
+			order.FilledQty = tracked.Order.FilledQty
+			order.AvgPrice = tracked.Order.AvgPrice
+		}
+	}
+}
+
+// cancelLegOrder cancels one of a leg's orders and reports whether the
+// exchange accepted the cancel
+func (e *algoExecutor) cancelLegOrder(t *trade, index int, orderID string) bool {
+	leg := t.state.Legs[index]
+	conn, ok := e.registry.GetConnector(leg.Leg.Exchange)
+	if !ok {
+		return false
+	}
+	if err := e.latency.Time(conn, latency.OpCancelOrder, func() error {
+		_, err := conn.CancelOrder(leg.Leg.Symbol, orderID)
+		return err
+	}); err != nil {
+		e.logger.Warn("Multi-leg trade %s: failed to cancel leg %d order %s: %v", t.state.ID, index+1, orderID, err)
+		return false
+	}
+	return true
+}
+
+// hasFills reports whether any leg filled at all
+func hasFills(t *trade) bool {
+	for _, leg := range t.state.Legs {
+		if leg.FilledQty.IsPositive() {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *algoExecutor) placeRecovery(t *trade, index int, side connector.OrderSide, quantity numerical.Decimal, reduceOnly bool) error {
+	leg := &t.state.Legs[index]
+
+	conn, ok := e.registry.GetConnector(leg.Leg.Exchange)
+	if !ok {
+		return fmt.Errorf("connector %s not registered", leg.Leg.Exchange)
+	}
+	if err := e.switches.CheckOrder(leg.Leg.Exchange, leg.Leg.Symbol, reduceOnly); err != nil {
+		return err
+	}
+
+	response, err := e.placeOrder(conn, leg.Leg.Symbol, side, quantity, numerical.Zero())
+	if err != nil {
+		e.logger.Error("Multi-leg trade %s: recovery order for leg %d rejected: %v", t.state.ID, index+1, err)
+		return err
+	}
+	if err := e.tracker.Track(leg.Leg.Exchange, response); err != nil {
+		e.logger.Warn("Multi-leg trade %s: recovery order %s not tracked: %v", t.state.ID, response.OrderID, err)
+	}
+
+	leg.Recovery = append(leg.Recovery, ChildOrder{
+		OrderID:   response.OrderID,
+		Quantity:  quantity,
+		FilledQty: response.FilledQty,
+		AvgPrice:  response.AvgPrice,
+		Status:    response.Status,
+		PlacedAt:  e.timeProvider.Now(),
+	})
+
+	e.runLog.Record(runlog.LevelWarn, "order", runlog.Fields{
+		Exchange:      string(leg.Leg.Exchange),
+		OrderID:       response.OrderID,
+		CorrelationID: t.state.ID,
+	}, "Multi-leg trade %s leg %d recovery: %s %s %s placed", t.state.ID, index+1, side, quantity.String(), leg.Leg.Symbol)
+	return nil
+}
+
+// applyLegResponse records an accepted leg order and hands it to the tracker
+func (e *algoExecutor) applyLegResponse(t *trade, index int, response *connector.OrderResponse) {
+	leg := &t.state.Legs[index]
+
+	if err := e.tracker.Track(leg.Leg.Exchange, response); err != nil {
+		e.logger.Warn("Multi-leg trade %s: leg %s not tracked: %v", t.state.ID, response.OrderID, err)
+	}
+
+	leg.OrderID = response.OrderID
+	leg.Status = response.Status
+	if leg.Status == "" {
+		leg.Status = connector.OrderStatusNew
+	}
+	leg.FilledQty = response.FilledQty
+	leg.AvgPrice = response.AvgPrice
+
+	e.runLog.Record(runlog.LevelInfo, "order", runlog.Fields{
+		Exchange:      string(leg.Leg.Exchange),
+		OrderID:       response.OrderID,
+		CorrelationID: t.state.ID,
+	}, "Multi-leg trade %s leg %d: %s %s %s placed", t.state.ID, index+1, leg.Leg.Side, leg.Leg.Quantity.String(), leg.Leg.Symbol)
+}
+
+// refreshLegs copies the tracker's view of each open leg into the trade
+func (e *algoExecutor) refreshLegs(t *trade) {
+	for i := range t.state.Legs {
+		leg := &t.state.Legs[i]
+		if leg.OrderID == "" {
+			continue
+		}
+
+		tracked, ok := e.tracker.Order(leg.Leg.Exchange, leg.OrderID)
+		if !ok {
+			continue
+		}
+		if !isTerminal(leg.Status) {
+			leg.Status = tracked.Order.Status
+		}
+		// A fill that raced the cancel still counts towards the leg
+		if tracked.Order.FilledQty.GreaterThan(leg.FilledQty) {
+			leg.FilledQty = tracked.Order.FilledQty
+			leg.AvgPrice = tracked.Order.AvgPrice
+		}
+	}
+}
+
+func (e *algoExecutor) finishTrade(t *trade, status Status, reason string) {
+	t.state.Status = status
+	t.state.Err = reason
+	t.state.FinishedAt = e.timeProvider.Now()
+
+	switch {
+	case reason == "":
+		e.logger.Info("Multi-leg trade %s %s: %d legs filled", t.state.ID, status, len(t.state.Legs))
+	case t.state.Recovery == "" && t.state.Recovered:
+		e.logger.Error("Multi-leg trade %s %s: %s; nothing filled, no recovery needed", t.state.ID, status, reason)
+	case t.state.Recovered:
+		e.logger.Error("Multi-leg trade %s %s: %s; legs recovered by %s", t.state.ID, status, reason, t.state.Recovery)
+	default:
+		e.logger.Error("Multi-leg trade %s %s: %s; %s incomplete, legs left exposed", t.state.ID, status, reason, t.state.Recovery)
+	}
+
+	select {
+	case e.tradeCh <- snapshotTrade(t.state):
+	default:
+		e.logger.Warn("Multi-leg completion channel full, dropping %s", t.state.ID)
+	}
+}
+
+// placeOrder places a limit order when price is positive and a market order otherwise
+func (e *algoExecutor) placeOrder(conn connector.Connector, symbol string, side connector.OrderSide, quantity, price numerical.Decimal) (*connector.OrderResponse, error) {
+	var response *connector.OrderResponse
+	err := e.latency.Time(conn, latency.OpPlaceOrder, func() error {
+		var err error
+		if price.IsPositive() {
+			response, err = conn.PlaceLimitOrder(symbol, side, quantity, price)
+		} else {
+			response, err = conn.PlaceMarketOrder(symbol, side, quantity)
+		}
+		return err
+	})
+	return response, err
+}
+
+func snapshotTrade(state MultiLegState) MultiLegState {
+	state.Legs = append([]LegState(nil), state.Legs...)
+	for i := range state.Legs {
+		state.Legs[i].Recovery = append([]ChildOrder(nil), state.Legs[i].Recovery...)
+	}
+	state.Order.Legs = append([]Leg(nil), state.Order.Legs...)
+	return state
+}
+
+func oppositeSide(side connector.OrderSide) connector.OrderSide {
+	if side == connector.OrderSideBuy {
+		return connector.OrderSideSell
+	}
+	return connector.OrderSideBuy
+}
@@ -0,0 +1,175 @@
+package execution_test
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/live-trading/pkg/connectors/execution"
+	"github.com/backtesting-org/live-trading/pkg/connectors/tracker"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+var _ = Describe("Multi-leg recovery", func() {
+	var (
+		binance *venue
+		bybit   *venue
+		h       *harness
+
+		// fills is the tracker's view of each order, keyed by order ID
+		fills map[string]numerical.Decimal
+	)
+
+	// resting answers limit orders as open and unfilled, and fills market
+	// orders in full
+	resting := func(order placed, id string) (*connector.OrderResponse, error) {
+		if order.Price.IsPositive() {
+			return &connector.OrderResponse{OrderID: id, Status: connector.OrderStatusOpen, FilledQty: numerical.Zero()}, nil
+		}
+		return &connector.OrderResponse{OrderID: id, Status: connector.OrderStatusFilled, FilledQty: order.Quantity, AvgPrice: numerical.NewFromInt(100)}, nil
+	}
+
+	BeforeEach(func() {
+		binance = newVenue(types.Binance)
+		bybit = newVenue(types.Bybit)
+		h = newHarness(map[connector.ExchangeName]*venue{types.Binance: binance, types.Bybit: bybit})
+
+		fills = make(map[string]numerical.Decimal)
+		h.tracker.On("Order", mock.Anything, mock.Anything).Return(
+			func(exchange connector.ExchangeName, orderID string) (tracker.TrackedOrder, bool) {
+				filled, ok := fills[orderID]
+				if !ok {
+					return tracker.TrackedOrder{}, false
+				}
+				return tracker.TrackedOrder{Exchange: exchange, Order: connector.Order{
+					ID:        orderID,
+					Status:    connector.OrderStatusPartiallyFilled,
+					FilledQty: filled,
+					AvgPrice:  numerical.NewFromInt(100),
+				}}, true
+			}).Maybe()
+
+		for _, v := range []*venue{binance, bybit} {
+			v.conn.On("CancelOrder", mock.Anything, mock.Anything).Return(&connector.CancelResponse{}, nil).Maybe()
+		}
+	})
+
+	spread := func(recovery execution.Recovery, price float64) execution.MultiLegOrder {
+		return execution.MultiLegOrder{
+			Legs: []execution.Leg{
+				{Exchange: types.Binance, Symbol: "BTCUSDT", Side: connector.OrderSideBuy, Quantity: numerical.NewFromInt(1), LimitPrice: numerical.NewFromFloat(price)},
+				{Exchange: types.Bybit, Symbol: "BTCUSDT", Side: connector.OrderSideSell, Quantity: numerical.NewFromInt(2), LimitPrice: numerical.NewFromFloat(price)},
+			},
+			Timeout:  5 * time.Second,
+			Recovery: recovery,
+		}
+	}
+
+	// expire moves past the trade's timeout and returns its final state
+	expire := func(id string) execution.MultiLegState {
+		h.now = h.now.Add(6 * time.Second)
+		Expect(h.executor.Advance()).To(Succeed())
+
+		state, ok := h.executor.Trade(id)
+		Expect(ok).To(BeTrue())
+		return state
+	}
+
+	It("completes when every leg fills", func() {
+		id, err := h.executor.SubmitLegs(spread(execution.RecoveryUnwind, 0))
+		Expect(err).NotTo(HaveOccurred())
+
+		state, ok := h.executor.Trade(id)
+		Expect(ok).To(BeTrue())
+		Expect(state.Status).To(Equal(execution.StatusCompleted))
+		Expect(state.Recovery).To(BeEmpty())
+		Expect(binance.orders).To(HaveLen(1))
+		Expect(bybit.orders).To(HaveLen(1))
+	})
+
+	It("unwinds the filled leg by its fill when another is rejected", func() {
+		bybit.respond = func(placed, string) (*connector.OrderResponse, error) {
+			return nil, errors.New("insufficient margin")
+		}
+
+		id, err := h.executor.SubmitLegs(spread(execution.RecoveryUnwind, 0))
+		Expect(err).NotTo(HaveOccurred())
+
+		state, _ := h.executor.Trade(id)
+		Expect(state.Status).To(Equal(execution.StatusFailed))
+		Expect(state.Recovery).To(Equal(execution.RecoveryUnwind))
+		Expect(state.Recovered).To(BeTrue())
+
+		Expect(binance.orders).To(HaveLen(2))
+		Expect(binance.orders[1].Side).To(Equal(connector.OrderSideSell))
+		Expect(binance.quantities()[1]).To(Equal("1"))
+	})
+
+	It("hedges the lagging leg up to the furthest leg's fill ratio", func() {
+		binance.respond = resting
+		bybit.respond = resting
+
+		id, err := h.executor.SubmitLegs(spread(execution.RecoveryHedge, 100))
+		Expect(err).NotTo(HaveOccurred())
+		fills["binance-1"] = numerical.NewFromFloat(0.5)
+
+		state := expire(id)
+		Expect(state.Status).To(Equal(execution.StatusFailed))
+		Expect(state.Recovery).To(Equal(execution.RecoveryHedge))
+		Expect(state.Recovered).To(BeTrue())
+
+		// Half the Binance leg filled, so half the Bybit leg is sold at market
+		Expect(binance.orders).To(HaveLen(1))
+		Expect(bybit.orders).To(HaveLen(2))
+		Expect(bybit.orders[1].Side).To(Equal(connector.OrderSideSell))
+		Expect(bybit.orders[1].Price.IsZero()).To(BeTrue())
+		Expect(bybit.quantities()[1]).To(Equal("1"))
+		Expect(state.Legs[1].Recovery).To(HaveLen(1))
+	})
+
+	It("unwinds instead when a hedge order is rejected", func() {
+		binance.respond = resting
+		bybit.respond = func(order placed, id string) (*connector.OrderResponse, error) {
+			if order.Price.IsPositive() {
+				return resting(order, id)
+			}
+			return nil, errors.New("market closed")
+		}
+
+		id, err := h.executor.SubmitLegs(spread(execution.RecoveryHedge, 100))
+		Expect(err).NotTo(HaveOccurred())
+		fills["binance-1"] = numerical.NewFromFloat(0.5)
+
+		state := expire(id)
+		Expect(state.Recovery).To(Equal(execution.RecoveryUnwind))
+		Expect(state.Recovered).To(BeTrue())
+
+		Expect(binance.orders).To(HaveLen(2))
+		Expect(binance.orders[1].Side).To(Equal(connector.OrderSideSell))
+		Expect(binance.quantities()[1]).To(Equal("0.5"))
+	})
+
+	It("places no recovery orders when nothing filled", func() {
+		binance.respond = resting
+		bybit.respond = resting
+
+		id, err := h.executor.SubmitLegs(spread(execution.RecoveryHedge, 100))
+		Expect(err).NotTo(HaveOccurred())
+
+		state := expire(id)
+		Expect(state.Status).To(Equal(execution.StatusFailed))
+		Expect(state.Recovery).To(BeEmpty())
+		Expect(state.Recovered).To(BeTrue())
+		Expect(state.Err).To(ContainSubstring("not filled within 5s"))
+
+		Expect(binance.orders).To(HaveLen(1))
+		Expect(bybit.orders).To(HaveLen(1))
+		binance.conn.AssertCalled(GinkgoT(), "CancelOrder", "BTCUSDT", "binance-1")
+		bybit.conn.AssertCalled(GinkgoT(), "CancelOrder", "BTCUSDT", "bybit-1")
+	})
+})
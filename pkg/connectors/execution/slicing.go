@@ -0,0 +1,57 @@
+package execution
+
+import (
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+)
+
+// planSlices splits total into count child quantities rounded down to the
+// lot step, with the remainder on the last slice. Fewer slices are used
+// when the total is too small to give every slice at least one step. Submit
+// only plans totals that are a whole number of steps, so every slice is too.
+func planSlices(total numerical.Decimal, count int, step numerical.Decimal) []numerical.Decimal {
+	if count <= 0 {
+		count = DefaultSlices
+	}
+
+	if step.IsPositive() {
+		if steps := total.Div(step).Truncate(0).IntPart(); steps < int64(count) {
+			count = int(steps)
+		}
+		if count < 1 {
+			count = 1
+		}
+	}
+
+	slice := roundToStep(total.Div(numerical.NewFromInt(int64(count))), step)
+
+	slices := make([]numerical.Decimal, count)
+	allocated := numerical.Zero()
+	for i := 0; i < count-1; i++ {
+		slices[i] = slice
+		allocated = allocated.Add(slice)
+	}
+	slices[count-1] = total.Sub(allocated)
+	return slices
+}
+
+func roundToStep(quantity, step numerical.Decimal) numerical.Decimal {
+	if !step.IsPositive() {
+		return quantity
+	}
+	return quantity.Div(step).Truncate(0).Mul(step)
+}
+
+// stepSize looks up the symbol's lot step; zero means quantities are not rounded
+func stepSize(conn connector.Connector, symbol string) numerical.Decimal {
+	contracts, err := conn.FetchContracts()
+	if err != nil {
+		return numerical.Zero()
+	}
+	for _, contract := range contracts {
+		if contract.Symbol == symbol {
+			return contract.StepSize
+		}
+	}
+	return numerical.Zero()
+}
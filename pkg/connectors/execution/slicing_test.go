@@ -0,0 +1,109 @@
+package execution_test
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/live-trading/pkg/connectors/execution"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+var _ = Describe("TWAP slicing", func() {
+	var (
+		binance *venue
+		h       *harness
+	)
+
+	BeforeEach(func() {
+		binance = newVenue(types.Binance)
+		h = newHarness(map[connector.ExchangeName]*venue{types.Binance: binance})
+	})
+
+	withStep := func(step float64) {
+		binance.conn.On("FetchContracts").Return([]connector.ContractInfo{
+			{Symbol: "BTCUSDT", StepSize: numerical.NewFromFloat(step)},
+		}, nil)
+	}
+
+	twap := func(quantity float64, slices int) execution.ParentOrder {
+		return execution.ParentOrder{
+			Exchange: types.Binance,
+			Symbol:   "BTCUSDT",
+			Side:     connector.OrderSideBuy,
+			Quantity: numerical.NewFromFloat(quantity),
+			Algo:     execution.AlgoTWAP,
+			Duration: time.Minute,
+			Slices:   slices,
+		}
+	}
+
+	// run submits the order and advances the clock through its schedule
+	run := func(order execution.ParentOrder) execution.ParentState {
+		id, err := h.executor.Submit(order)
+		Expect(err).NotTo(HaveOccurred())
+
+		for i := 0; i < 20; i++ {
+			h.now = h.now.Add(order.Duration / 10)
+			Expect(h.executor.Advance()).To(Succeed())
+		}
+
+		state, ok := h.executor.Parent(id)
+		Expect(ok).To(BeTrue())
+		return state
+	}
+
+	It("rounds every slice down to the lot step and leaves the remainder on the last", func() {
+		withStep(0.1)
+
+		state := run(twap(1, 4))
+
+		Expect(binance.quantities()).To(Equal([]string{"0.2", "0.2", "0.2", "0.4"}))
+		Expect(state.Status).To(Equal(execution.StatusCompleted))
+		Expect(state.FilledQty.Equal(numerical.NewFromInt(1))).To(BeTrue())
+	})
+
+	It("uses fewer slices when the total has fewer lots than slices", func() {
+		withStep(0.1)
+
+		run(twap(0.3, 10))
+
+		Expect(binance.quantities()).To(Equal([]string{"0.1", "0.1", "0.1"}))
+	})
+
+	It("splits evenly when the venue publishes no lot step", func() {
+		binance.conn.On("FetchContracts").Return(nil, errors.New("not supported"))
+
+		run(twap(1, 4))
+
+		Expect(binance.quantities()).To(Equal([]string{"0.25", "0.25", "0.25", "0.25"}))
+	})
+
+	It("places one slice per interval", func() {
+		withStep(0.1)
+
+		_, err := h.executor.Submit(twap(1, 4))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(binance.orders).To(HaveLen(1))
+
+		h.now = h.now.Add(10 * time.Second)
+		Expect(h.executor.Advance()).To(Succeed())
+		Expect(binance.orders).To(HaveLen(1))
+
+		h.now = h.now.Add(5 * time.Second)
+		Expect(h.executor.Advance()).To(Succeed())
+		Expect(binance.orders).To(HaveLen(2))
+	})
+
+	It("refuses a quantity that is not a whole number of lots", func() {
+		withStep(0.1)
+
+		_, err := h.executor.Submit(twap(1.05, 4))
+		Expect(err).To(MatchError(ContainSubstring("not a multiple of the BTCUSDT lot step 0.1")))
+		Expect(binance.orders).To(BeEmpty())
+	})
+})
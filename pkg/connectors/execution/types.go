@@ -0,0 +1,239 @@
+package execution
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+)
+
+// Algo selects how a parent order is worked
+type Algo string
+
+const (
+	// AlgoTWAP splits the parent into equal slices spread evenly over Duration
+	AlgoTWAP Algo = "twap"
+
+	// AlgoIceberg rests DisplayQuantity at LimitPrice and replenishes it as it fills
+	AlgoIceberg Algo = "iceberg"
+)
+
+// Status is the lifecycle state of a parent order
+type Status string
+
+const (
+	StatusWorking   Status = "working"
+	StatusCompleted Status = "completed"
+	StatusCancelled Status = "cancelled"
+	StatusFailed    Status = "failed"
+)
+
+// ParentOrder is a large order to be worked as a series of child orders
+type ParentOrder struct {
+	Exchange connector.ExchangeName
+	Symbol   string
+	Side     connector.OrderSide
+	Quantity numerical.Decimal
+	Algo     Algo
+
+	// Duration is the TWAP schedule length, or the iceberg deadline after
+	// which any unfilled remainder is cancelled (zero means no deadline)
+	Duration time.Duration
+
+	// Slices is the TWAP child count
+	Slices int
+
+	// LimitPrice makes TWAP children limit orders; it is required for icebergs.
+	// Zero TWAP children are market orders.
+	LimitPrice numerical.Decimal
+
+	// DisplayQuantity is the iceberg's visible size
+	DisplayQuantity numerical.Decimal
+
+	// ReduceOnly declares that the order only shrinks an existing position,
+	// which lets it through reduce-only trading switches
+	ReduceOnly bool
+}
+
+func (p ParentOrder) validate() error {
+	if p.Symbol == "" {
+		return fmt.Errorf("symbol is required")
+	}
+	if !p.Side.IsValid() {
+		return fmt.Errorf("invalid side %q", p.Side)
+	}
+	if !p.Quantity.IsPositive() {
+		return fmt.Errorf("quantity must be positive")
+	}
+	if p.LimitPrice.IsNegative() {
+		return fmt.Errorf("limit price must not be negative")
+	}
+
+	switch p.Algo {
+	case AlgoTWAP:
+		if p.Duration <= 0 {
+			return fmt.Errorf("twap requires a duration")
+		}
+		if p.Slices < 0 {
+			return fmt.Errorf("twap slices must not be negative")
+		}
+	case AlgoIceberg:
+		if !p.LimitPrice.IsPositive() {
+			return fmt.Errorf("iceberg requires a limit price")
+		}
+		if !p.DisplayQuantity.IsPositive() {
+			return fmt.Errorf("iceberg requires a display quantity")
+		}
+		if p.Duration < 0 {
+			return fmt.Errorf("iceberg deadline must not be negative")
+		}
+	default:
+		return fmt.Errorf("unknown execution algo %q", p.Algo)
+	}
+
+	return nil
+}
+
+// ChildOrder is one exchange order placed on behalf of a parent
+type ChildOrder struct {
+	OrderID   string
+	Quantity  numerical.Decimal
+	FilledQty numerical.Decimal
+	AvgPrice  numerical.Decimal
+	Status    connector.OrderStatus
+	PlacedAt  time.Time
+}
+
+// ParentState is the progress of a parent order and its aggregate fill
+type ParentState struct {
+	ID     string
+	Order  ParentOrder
+	Status Status
+	Err    string
+
+	FilledQty numerical.Decimal
+
+	// AvgPrice is the quantity-weighted average fill price across children
+	AvgPrice numerical.Decimal
+
+	Children   []ChildOrder
+	StartedAt  time.Time
+	FinishedAt time.Time
+}
+
+// RemainingQty is the parent quantity not yet filled
+func (p ParentState) RemainingQty() numerical.Decimal {
+	remaining := p.Order.Quantity.Sub(p.FilledQty)
+	if remaining.IsNegative() {
+		return numerical.Zero()
+	}
+	return remaining
+}
+
+// Recovery is what happens to the filled legs of a multi-leg trade that
+// could not be completed
+type Recovery string
+
+const (
+	// RecoveryUnwind closes every filled leg with a reduce-only market order
+	RecoveryUnwind Recovery = "unwind"
+
+	// RecoveryHedge instead brings every leg up to the furthest-filled leg's
+	// fill ratio at market, falling back to unwinding if a hedge order is
+	// rejected
+	RecoveryHedge Recovery = "hedge"
+)
+
+// Leg is one order of a multi-leg trade
+type Leg struct {
+	Exchange connector.ExchangeName
+	Symbol   string
+	Side     connector.OrderSide
+	Quantity numerical.Decimal
+
+	// LimitPrice makes the leg a limit order; zero is a market order
+	LimitPrice numerical.Decimal
+}
+
+// MultiLegOrder is a set of legs executed together, e.g. both sides of a
+// spread. Either every leg fills within Timeout or the trade is recovered.
+type MultiLegOrder struct {
+	Legs []Leg
+
+	// Timeout is how long legs may take to fill; zero uses DefaultLegTimeout
+	Timeout  time.Duration
+	Recovery Recovery
+}
+
+func (m MultiLegOrder) validate() error {
+	if len(m.Legs) < 2 {
+		return fmt.Errorf("at least two legs are required, got %d", len(m.Legs))
+	}
+	if m.Timeout < 0 {
+		return fmt.Errorf("timeout must not be negative")
+	}
+
+	switch m.Recovery {
+	case RecoveryUnwind, RecoveryHedge:
+	default:
+		return fmt.Errorf("unknown recovery %q", m.Recovery)
+	}
+
+	for i, leg := range m.Legs {
+		if leg.Symbol == "" {
+			return fmt.Errorf("leg %d: symbol is required", i+1)
+		}
+		if !leg.Side.IsValid() {
+			return fmt.Errorf("leg %d: invalid side %q", i+1, leg.Side)
+		}
+		if !leg.Quantity.IsPositive() {
+			return fmt.Errorf("leg %d: quantity must be positive", i+1)
+		}
+		if leg.LimitPrice.IsNegative() {
+			return fmt.Errorf("leg %d: limit price must not be negative", i+1)
+		}
+	}
+	return nil
+}
+
+// LegState is one leg's order and any recovery orders placed for it
+type LegState struct {
+	Leg       Leg
+	OrderID   string
+	Status    connector.OrderStatus
+	FilledQty numerical.Decimal
+	AvgPrice  numerical.Decimal
+	Err       string
+
+	// Recovery holds the unwind or hedge orders placed for the leg
+	Recovery []ChildOrder
+}
+
+// RemainingQty is the leg quantity not yet filled
+func (l LegState) RemainingQty() numerical.Decimal {
+	remaining := l.Leg.Quantity.Sub(l.FilledQty)
+	if remaining.IsNegative() {
+		return numerical.Zero()
+	}
+	return remaining
+}
+
+// MultiLegState is the combined outcome of a multi-leg trade. A trade that
+// needed recovery is failed, and Recovered says whether the recovery orders
+// were all accepted.
+type MultiLegState struct {
+	ID     string
+	Order  MultiLegOrder
+	Status Status
+	Err    string
+
+	Legs []LegState
+
+	// Recovery is what was done after a leg failed, empty if nothing was needed
+	Recovery  Recovery
+	Recovered bool
+
+	StartedAt  time.Time
+	FinishedAt time.Time
+}
@@ -0,0 +1,199 @@
+package fake
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+)
+
+// Clock is a temporal.TimeProvider that only moves when told to. Timers,
+// tickers and After channels fire as Advance or Set passes their deadline.
+// Sleep advances the clock by the duration instead of blocking, so code that
+// backs off with the provider runs straight through in a test.
+type Clock struct {
+	now     time.Time
+	waiters []*waiter
+	mu      sync.Mutex
+}
+
+var _ temporal.TimeProvider = (*Clock)(nil)
+
+// waiter is a pending timer or ticker; period is zero for timers
+type waiter struct {
+	at     time.Time
+	period time.Duration
+	ch     chan time.Time
+}
+
+// NewClock starts a clock at start
+func NewClock(start time.Time) *Clock {
+	return &Clock{now: start}
+}
+
+func (c *Clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *Clock) Since(t time.Time) time.Duration {
+	return c.Now().Sub(t)
+}
+
+func (c *Clock) After(d time.Duration) <-chan time.Time {
+	return c.NewTimer(d).C()
+}
+
+func (c *Clock) Sleep(d time.Duration) {
+	c.Advance(d)
+}
+
+func (c *Clock) NewTimer(d time.Duration) temporal.Timer {
+	w := c.schedule(d, 0)
+	return &timer{clock: c, waiter: w}
+}
+
+func (c *Clock) NewTicker(d time.Duration) temporal.Ticker {
+	if d <= 0 {
+		panic("fake: non-positive interval for NewTicker")
+	}
+	w := c.schedule(d, d)
+	return &ticker{clock: c, waiter: w}
+}
+
+// Advance moves the clock forward by d
+func (c *Clock) Advance(d time.Duration) {
+	c.Set(c.Now().Add(d))
+}
+
+// Set moves the clock to t, firing every waiter due by then in deadline
+// order. Moving backwards fires nothing.
+func (c *Clock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if t.Before(c.now) {
+		c.now = t
+		return
+	}
+
+	for {
+		next := c.nextDue(t)
+		if next == nil {
+			break
+		}
+		c.now = next.at
+		c.fire(next)
+	}
+	c.now = t
+}
+
+// Pending is how many timers and tickers are still waiting; tests use it to
+// know a goroutine has reached its wait before advancing
+func (c *Clock) Pending() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.waiters)
+}
+
+func (c *Clock) schedule(d, period time.Duration) *waiter {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	w := &waiter{at: c.now.Add(d), period: period, ch: make(chan time.Time, 1)}
+	if d <= 0 {
+		c.fire(w)
+		if period == 0 {
+			return w
+		}
+	}
+	c.waiters = append(c.waiters, w)
+	return w
+}
+
+// nextDue returns the earliest waiter due by t; callers hold mu
+func (c *Clock) nextDue(t time.Time) *waiter {
+	sort.SliceStable(c.waiters, func(i, j int) bool { return c.waiters[i].at.Before(c.waiters[j].at) })
+	if len(c.waiters) == 0 || c.waiters[0].at.After(t) {
+		return nil
+	}
+	return c.waiters[0]
+}
+
+// fire delivers a tick without blocking, as the time package does, then
+// reschedules tickers and retires timers; callers hold mu
+func (c *Clock) fire(w *waiter) {
+	select {
+	case w.ch <- w.at:
+	default:
+	}
+
+	if w.period > 0 {
+		w.at = w.at.Add(w.period)
+		return
+	}
+	c.remove(w)
+}
+
+func (c *Clock) remove(w *waiter) bool {
+	for i, pending := range c.waiters {
+		if pending == w {
+			c.waiters = append(c.waiters[:i], c.waiters[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Clock) reset(w *waiter, d, period time.Duration) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	active := c.remove(w)
+	w.at = c.now.Add(d)
+	w.period = period
+	c.waiters = append(c.waiters, w)
+	return active
+}
+
+func (c *Clock) stop(w *waiter) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.remove(w)
+}
+
+type timer struct {
+	clock  *Clock
+	waiter *waiter
+}
+
+func (t *timer) C() <-chan time.Time {
+	return t.waiter.ch
+}
+
+func (t *timer) Reset(d time.Duration) bool {
+	return t.clock.reset(t.waiter, d, 0)
+}
+
+func (t *timer) Stop() bool {
+	return t.clock.stop(t.waiter)
+}
+
+type ticker struct {
+	clock  *Clock
+	waiter *waiter
+}
+
+func (t *ticker) C() <-chan time.Time {
+	return t.waiter.ch
+}
+
+func (t *ticker) Reset(d time.Duration) {
+	t.clock.reset(t.waiter, d, d)
+}
+
+func (t *ticker) Stop() {
+	t.clock.stop(t.waiter)
+}
@@ -0,0 +1,43 @@
+package fake
+
+import (
+	"fmt"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+)
+
+const (
+	// DefaultExchange names a fake connector when none is given
+	DefaultExchange connector.ExchangeName = "fake"
+
+	// DefaultCurrency is the quote and balance currency
+	DefaultCurrency = "USD"
+
+	// PerpSuffix turns an asset into the fake's native perpetual symbol
+	PerpSuffix = "-PERP"
+
+	// ChannelBuffer is the capacity of every stream channel; sends to a full
+	// channel are dropped, as the real connectors do
+	ChannelBuffer = 100
+)
+
+// Config initializes a fake connector the way a run initializes a real one
+type Config struct {
+	Exchange connector.ExchangeName
+	Testnet  bool
+}
+
+func (c *Config) ExchangeName() connector.ExchangeName {
+	return c.Exchange
+}
+
+func (c *Config) UsesTestnet() bool {
+	return c.Testnet
+}
+
+func (c *Config) Validate() error {
+	if c.Exchange == "" {
+		return fmt.Errorf("exchange name is required")
+	}
+	return nil
+}
@@ -0,0 +1,248 @@
+package fake
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+// Connector is a scriptable in-memory exchange for unit tests. Market data
+// is whatever the test sets; orders match against the scripted price, resting
+// limits fill when the price crosses them, and every fill moves the position
+// and is published on the trade stream. Faults can be injected per
+// operation. It is safe for concurrent use.
+//
+// Methods work before Initialize so a test can script state up front;
+// Initialize only validates the config, as a run would.
+type Connector struct {
+	name         connector.ExchangeName
+	timeProvider temporal.TimeProvider
+	initialized  bool
+
+	assets    []portfolio.Asset
+	prices    map[string]connector.Price
+	books     map[string]connector.OrderBook
+	klines    map[string][]connector.Kline
+	trades    map[string][]connector.Trade
+	funding   map[portfolio.Asset]connector.FundingRate
+	marks     map[portfolio.Asset]types.MarkPrice
+	contracts []connector.ContractInfo
+
+	balance   connector.AccountBalance
+	positions map[string]*position
+	orders    map[string]*connector.Order
+	fills     []connector.Trade
+	orderSeq  int64
+	fillSeq   int64
+
+	cancelWindow time.Duration
+
+	faults map[Operation][]fault
+	calls  map[Operation]int
+
+	connected     bool
+	bookChannels  map[string]chan connector.OrderBook
+	klineChannels map[string]chan connector.Kline
+	markCh        chan types.MarkPrice
+	tradeCh       chan connector.Trade
+	fillCh        chan connector.Trade
+	positionCh    chan connector.Position
+	balanceCh     chan connector.AccountBalance
+	errorCh       chan error
+
+	mu sync.Mutex
+}
+
+// position is a net position; quantity is signed, negative for short
+type position struct {
+	asset      portfolio.Asset
+	quantity   numerical.Decimal
+	entryPrice numerical.Decimal
+	realized   numerical.Decimal
+}
+
+var (
+	_ connector.WebSocketConnector     = (*Connector)(nil)
+	_ types.TradeHistoryProvider       = (*Connector)(nil)
+	_ types.KlineRangeProvider         = (*Connector)(nil)
+	_ types.MarkPriceProvider          = (*Connector)(nil)
+	_ types.MarkPriceStreamer          = (*Connector)(nil)
+	_ types.FillStreamer               = (*Connector)(nil)
+	_ types.CancelAllProvider          = (*Connector)(nil)
+	_ types.CancelOnDisconnectProvider = (*Connector)(nil)
+)
+
+// NewConnector returns an empty fake venue. Pass a *Clock to drive its
+// timestamps from the test; any TimeProvider works.
+func NewConnector(name connector.ExchangeName, timeProvider temporal.TimeProvider) *Connector {
+	if name == "" {
+		name = DefaultExchange
+	}
+	return &Connector{
+		name:          name,
+		timeProvider:  timeProvider,
+		prices:        make(map[string]connector.Price),
+		books:         make(map[string]connector.OrderBook),
+		klines:        make(map[string][]connector.Kline),
+		trades:        make(map[string][]connector.Trade),
+		funding:       make(map[portfolio.Asset]connector.FundingRate),
+		marks:         make(map[portfolio.Asset]types.MarkPrice),
+		balance:       connector.AccountBalance{TotalBalance: numerical.Zero(), AvailableBalance: numerical.Zero(), Currency: DefaultCurrency},
+		positions:     make(map[string]*position),
+		orders:        make(map[string]*connector.Order),
+		faults:        make(map[Operation][]fault),
+		calls:         make(map[Operation]int),
+		connected:     true,
+		bookChannels:  make(map[string]chan connector.OrderBook),
+		klineChannels: make(map[string]chan connector.Kline),
+		markCh:        make(chan types.MarkPrice, ChannelBuffer),
+		tradeCh:       make(chan connector.Trade, ChannelBuffer),
+		fillCh:        make(chan connector.Trade, ChannelBuffer),
+		positionCh:    make(chan connector.Position, ChannelBuffer),
+		balanceCh:     make(chan connector.AccountBalance, ChannelBuffer),
+		errorCh:       make(chan error, ChannelBuffer),
+	}
+}
+
+func (c *Connector) Initialize(config connector.Config) error {
+	fakeConfig, ok := config.(*Config)
+	if !ok {
+		return fmt.Errorf("invalid config type for fake connector: expected *fake.Config, got %T", config)
+	}
+	if err := fakeConfig.Validate(); err != nil {
+		return fmt.Errorf("invalid fake config: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.initialized {
+		return fmt.Errorf("connector already initialized")
+	}
+	c.name = fakeConfig.Exchange
+	c.initialized = true
+	return nil
+}
+
+func (c *Connector) IsInitialized() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.initialized
+}
+
+func (c *Connector) GetConnectorInfo() *connector.Info {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return &connector.Info{
+		Name:             c.name,
+		SupportedSymbols: append([]portfolio.Asset(nil), c.assets...),
+		TradingEnabled:   true,
+		WebSocketEnabled: true,
+		SupportedOrderTypes: []connector.OrderType{
+			connector.OrderTypeLimit,
+			connector.OrderTypeMarket,
+		},
+		QuoteCurrency: c.balance.Currency,
+	}
+}
+
+func (c *Connector) GetPerpSymbol(asset portfolio.Asset) string {
+	return asset.Symbol() + PerpSuffix
+}
+
+func (c *Connector) SupportsTradingOperations() bool { return true }
+func (c *Connector) SupportsRealTimeData() bool      { return true }
+func (c *Connector) SupportsFundingRates() bool      { return true }
+func (c *Connector) SupportsPerpetuals() bool        { return true }
+func (c *Connector) SupportsSpot() bool              { return false }
+
+// AddAsset lists an asset; SetPrice lists the asset it prices as well
+func (c *Connector) AddAsset(asset portfolio.Asset) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.addAssetLocked(asset)
+}
+
+func (c *Connector) addAssetLocked(asset portfolio.Asset) {
+	for _, listed := range c.assets {
+		if listed == asset {
+			return
+		}
+	}
+	c.assets = append(c.assets, asset)
+}
+
+// SetBalance replaces the account balance and publishes it on the balance stream
+func (c *Connector) SetBalance(balance connector.AccountBalance) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if balance.Currency == "" {
+		balance.Currency = DefaultCurrency
+	}
+	if balance.UpdatedAt.IsZero() {
+		balance.UpdatedAt = c.timeProvider.Now()
+	}
+	c.balance = balance
+	publish(c.balanceCh, balance)
+}
+
+// Disconnect drops the fake WebSocket and reports the drop on the error
+// stream, as a real connector does when its stream fails
+func (c *Connector) Disconnect(reason error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.connected = false
+	if reason == nil {
+		reason = fmt.Errorf("fake %s: websocket disconnected", c.name)
+	}
+	publish(c.errorCh, reason)
+}
+
+// Reconnect restores the fake WebSocket
+func (c *Connector) Reconnect() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.connected = true
+}
+
+// CancelOnDisconnectWindow is the last window armed, zero if never armed
+func (c *Connector) CancelOnDisconnectWindow() time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cancelWindow
+}
+
+// ArmCancelOnDisconnect records the window; the fake never cancels on its own
+func (c *Connector) ArmCancelOnDisconnect(window time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call(OpAccount); err != nil {
+		return err
+	}
+	c.cancelWindow = window
+	return nil
+}
+
+// assetFor maps a native symbol back to its asset
+func assetFor(symbol string) portfolio.Asset {
+	return portfolio.NewAsset(strings.TrimSuffix(symbol, PerpSuffix))
+}
+
+// publish sends without blocking; a full channel drops the value
+func publish[T any](ch chan T, value T) {
+	select {
+	case ch <- value:
+	default:
+	}
+}
@@ -0,0 +1,77 @@
+package fake
+
+import "fmt"
+
+// Operation groups connector methods for fault injection
+type Operation string
+
+const (
+	OpFetchPrice     Operation = "fetch_price"
+	OpFetchKlines    Operation = "fetch_klines"
+	OpFetchOrderBook Operation = "fetch_order_book"
+	OpFetchTrades    Operation = "fetch_trades"
+	OpFetchFunding   Operation = "fetch_funding"
+	OpFetchMarkets   Operation = "fetch_markets"
+	OpPlaceOrder     Operation = "place_order"
+	OpCancelOrder    Operation = "cancel_order"
+	OpOrders         Operation = "orders"
+	OpAccount        Operation = "account"
+	OpHistory        Operation = "history"
+	OpWebSocket      Operation = "websocket"
+)
+
+// fault is a scripted failure; remaining is nil for one that never runs out
+type fault struct {
+	err       error
+	remaining *int
+}
+
+// Fail makes the next times calls of op return err; times of zero or less
+// fails every call until ClearFaults
+func (c *Connector) Fail(op Operation, err error, times int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	f := fault{err: err}
+	if times > 0 {
+		f.remaining = &times
+	}
+	c.faults[op] = append(c.faults[op], f)
+}
+
+// ClearFaults removes every scripted failure
+func (c *Connector) ClearFaults() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.faults = make(map[Operation][]fault)
+}
+
+// Calls reports how many times op has been called, failed calls included
+func (c *Connector) Calls(op Operation) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.calls[op]
+}
+
+// call counts op and returns the scripted failure due for it, if any;
+// callers hold mu
+func (c *Connector) call(op Operation) error {
+	c.calls[op]++
+
+	faults := c.faults[op]
+	if len(faults) == 0 {
+		return nil
+	}
+
+	f := faults[0]
+	if f.remaining != nil {
+		*f.remaining--
+		if *f.remaining <= 0 {
+			c.faults[op] = faults[1:]
+		}
+	}
+	if f.err == nil {
+		return fmt.Errorf("fake %s: injected %s failure", c.name, op)
+	}
+	return f.err
+}
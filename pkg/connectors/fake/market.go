@@ -0,0 +1,317 @@
+package fake
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+// SetPrice sets the last price for an asset's perpetual and fills every
+// resting limit order the price reaches
+func (c *Connector) SetPrice(asset portfolio.Asset, price numerical.Decimal) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	symbol := c.GetPerpSymbol(asset)
+	c.addAssetLocked(asset)
+	c.prices[symbol] = connector.Price{
+		Symbol:    symbol,
+		Price:     price,
+		Source:    c.name,
+		Timestamp: c.timeProvider.Now(),
+	}
+	c.matchRestingLocked(symbol, price)
+}
+
+// SetOrderBook replaces an asset's book and publishes it to subscribers.
+// Market orders fill at the book's best level while one is set.
+func (c *Connector) SetOrderBook(book connector.OrderBook) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if book.Timestamp.IsZero() {
+		book.Timestamp = c.timeProvider.Now()
+	}
+	symbol := c.GetPerpSymbol(book.Asset)
+	c.addAssetLocked(book.Asset)
+	c.books[symbol] = book
+
+	if ch, ok := c.bookChannels[symbol]; ok {
+		publish(ch, book)
+	}
+}
+
+// SetKlines replaces an asset's kline series for an interval
+func (c *Connector) SetKlines(asset portfolio.Asset, interval string, klines []connector.Kline) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	series := append([]connector.Kline(nil), klines...)
+	sort.SliceStable(series, func(i, j int) bool { return series[i].OpenTime.Before(series[j].OpenTime) })
+	c.klines[klineKey(asset, interval)] = series
+}
+
+// PushKline appends a kline, or replaces the one with the same open time,
+// and publishes it to subscribers
+func (c *Connector) PushKline(asset portfolio.Asset, kline connector.Kline) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if kline.Symbol == "" {
+		kline.Symbol = c.GetPerpSymbol(asset)
+	}
+	key := klineKey(asset, kline.Interval)
+	series := c.klines[key]
+
+	replaced := false
+	for i := range series {
+		if series[i].OpenTime.Equal(kline.OpenTime) {
+			series[i] = kline
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		series = append(series, kline)
+		sort.SliceStable(series, func(i, j int) bool { return series[i].OpenTime.Before(series[j].OpenTime) })
+	}
+	c.klines[key] = series
+
+	if ch, ok := c.klineChannels[key]; ok {
+		publish(ch, kline)
+	}
+}
+
+// SetRecentTrades sets the public trades FetchRecentTrades returns
+func (c *Connector) SetRecentTrades(asset portfolio.Asset, trades []connector.Trade) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.trades[c.GetPerpSymbol(asset)] = append([]connector.Trade(nil), trades...)
+}
+
+func (c *Connector) SetFundingRate(asset portfolio.Asset, rate connector.FundingRate) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.funding[asset] = rate
+}
+
+// SetMarkPrice sets an asset's mark and publishes it on the mark stream
+func (c *Connector) SetMarkPrice(mark types.MarkPrice) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	mark.Exchange = c.name
+	if mark.Timestamp.IsZero() {
+		mark.Timestamp = c.timeProvider.Now()
+	}
+	c.marks[mark.Asset] = mark
+	publish(c.markCh, mark)
+}
+
+func (c *Connector) SetContracts(contracts []connector.ContractInfo) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.contracts = append([]connector.ContractInfo(nil), contracts...)
+}
+
+func (c *Connector) FetchPrice(symbol string) (*connector.Price, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call(OpFetchPrice); err != nil {
+		return nil, err
+	}
+	price, ok := c.prices[symbol]
+	if !ok {
+		return nil, fmt.Errorf("no price for %s", symbol)
+	}
+	return &price, nil
+}
+
+// FetchKlines returns the latest limit klines, oldest first
+func (c *Connector) FetchKlines(symbol, interval string, limit int) ([]connector.Kline, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call(OpFetchKlines); err != nil {
+		return nil, err
+	}
+	series := c.klines[klineKey(assetFor(symbol), interval)]
+	if limit > 0 && len(series) > limit {
+		series = series[len(series)-limit:]
+	}
+	return append([]connector.Kline(nil), series...), nil
+}
+
+// FetchKlinesRange returns klines opening within [start, end], oldest first
+func (c *Connector) FetchKlinesRange(symbol, interval string, start, end time.Time, limit int) ([]connector.Kline, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call(OpFetchKlines); err != nil {
+		return nil, err
+	}
+
+	var klines []connector.Kline
+	for _, kline := range c.klines[klineKey(assetFor(symbol), interval)] {
+		if kline.OpenTime.Before(start) || kline.OpenTime.After(end) {
+			continue
+		}
+		klines = append(klines, kline)
+		if limit > 0 && len(klines) == limit {
+			break
+		}
+	}
+	return klines, nil
+}
+
+// FetchOrderBook returns up to depth levels a side
+func (c *Connector) FetchOrderBook(asset portfolio.Asset, _ connector.Instrument, depth int) (*connector.OrderBook, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call(OpFetchOrderBook); err != nil {
+		return nil, err
+	}
+	book, ok := c.books[c.GetPerpSymbol(asset)]
+	if !ok {
+		return nil, fmt.Errorf("no order book for %s", asset.Symbol())
+	}
+
+	result := book
+	result.Bids = truncate(book.Bids, depth)
+	result.Asks = truncate(book.Asks, depth)
+	return &result, nil
+}
+
+func (c *Connector) FetchRecentTrades(symbol string, limit int) ([]connector.Trade, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call(OpFetchTrades); err != nil {
+		return nil, err
+	}
+	trades := c.trades[symbol]
+	if limit > 0 && len(trades) > limit {
+		trades = trades[len(trades)-limit:]
+	}
+	return append([]connector.Trade(nil), trades...), nil
+}
+
+func (c *Connector) FetchCurrentFundingRates() (map[portfolio.Asset]connector.FundingRate, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call(OpFetchFunding); err != nil {
+		return nil, err
+	}
+	rates := make(map[portfolio.Asset]connector.FundingRate, len(c.funding))
+	for asset, rate := range c.funding {
+		rates[asset] = rate
+	}
+	return rates, nil
+}
+
+func (c *Connector) FetchFundingRate(asset portfolio.Asset) (*connector.FundingRate, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call(OpFetchFunding); err != nil {
+		return nil, err
+	}
+	rate, ok := c.funding[asset]
+	if !ok {
+		return nil, fmt.Errorf("no funding rate for %s", asset.Symbol())
+	}
+	return &rate, nil
+}
+
+// FetchHistoricalFundingRates reports the current rate as the only history
+func (c *Connector) FetchHistoricalFundingRates(asset portfolio.Asset, startTime, endTime int64) ([]connector.HistoricalFundingRate, error) {
+	rate, err := c.FetchFundingRate(asset)
+	if err != nil {
+		return nil, err
+	}
+	at := rate.Timestamp.Unix()
+	if at < startTime || endTime > 0 && at > endTime {
+		return nil, nil
+	}
+	return []connector.HistoricalFundingRate{{FundingRate: rate.CurrentRate, Timestamp: rate.Timestamp}}, nil
+}
+
+func (c *Connector) FetchMarkPrice(asset portfolio.Asset) (*types.MarkPrice, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call(OpFetchPrice); err != nil {
+		return nil, err
+	}
+	mark, ok := c.marks[asset]
+	if !ok {
+		return nil, fmt.Errorf("no mark price for %s", asset.Symbol())
+	}
+	return &mark, nil
+}
+
+func (c *Connector) FetchContracts() ([]connector.ContractInfo, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call(OpFetchMarkets); err != nil {
+		return nil, err
+	}
+	return append([]connector.ContractInfo(nil), c.contracts...), nil
+}
+
+func (c *Connector) FetchRiskFundBalance(symbol string) (*connector.RiskFundBalance, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call(OpFetchMarkets); err != nil {
+		return nil, err
+	}
+	return &connector.RiskFundBalance{
+		Symbol:    symbol,
+		Balance:   numerical.Zero(),
+		Currency:  c.balance.Currency,
+		UpdatedAt: c.timeProvider.Now(),
+	}, nil
+}
+
+// FetchAvailableSpotAssets is always empty: the fake lists perpetuals only
+func (c *Connector) FetchAvailableSpotAssets() ([]portfolio.Asset, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call(OpFetchMarkets); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+func (c *Connector) FetchAvailablePerpetualAssets() ([]portfolio.Asset, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call(OpFetchMarkets); err != nil {
+		return nil, err
+	}
+	return append([]portfolio.Asset(nil), c.assets...), nil
+}
+
+// klineKey is also the kline channel key, e.g. "BTC-1m"
+func klineKey(asset portfolio.Asset, interval string) string {
+	return fmt.Sprintf("%s-%s", asset.Symbol(), interval)
+}
+
+func truncate(levels []connector.PriceLevel, depth int) []connector.PriceLevel {
+	if depth > 0 && len(levels) > depth {
+		levels = levels[:depth]
+	}
+	return append([]connector.PriceLevel(nil), levels...)
+}
@@ -0,0 +1,189 @@
+package fake
+
+import (
+	"fmt"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+// The fake's stream is always running; StartWebSocket and StopWebSocket
+// only move the connected flag, and subscriptions decide which order book
+// and kline channels exist. Trades, positions, balances and marks are
+// published whether or not they are subscribed.
+
+func (c *Connector) StartWebSocket() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call(OpWebSocket); err != nil {
+		return err
+	}
+	c.connected = true
+	return nil
+}
+
+func (c *Connector) StopWebSocket() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.connected = false
+	return nil
+}
+
+func (c *Connector) IsWebSocketConnected() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.connected
+}
+
+func (c *Connector) SubscribeOrderBook(asset portfolio.Asset, _ connector.Instrument) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.subscribeLocked(); err != nil {
+		return err
+	}
+	symbol := c.GetPerpSymbol(asset)
+	if _, ok := c.bookChannels[symbol]; !ok {
+		c.bookChannels[symbol] = make(chan connector.OrderBook, ChannelBuffer)
+	}
+	return nil
+}
+
+func (c *Connector) UnsubscribeOrderBook(asset portfolio.Asset, _ connector.Instrument) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.bookChannels, c.GetPerpSymbol(asset))
+	return nil
+}
+
+func (c *Connector) SubscribeKlines(asset portfolio.Asset, interval string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.subscribeLocked(); err != nil {
+		return err
+	}
+	key := klineKey(asset, interval)
+	if _, ok := c.klineChannels[key]; !ok {
+		c.klineChannels[key] = make(chan connector.Kline, ChannelBuffer)
+	}
+	return nil
+}
+
+func (c *Connector) UnsubscribeKlines(asset portfolio.Asset, interval string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.klineChannels, klineKey(asset, interval))
+	return nil
+}
+
+func (c *Connector) SubscribeTrades(_ portfolio.Asset, _ connector.Instrument) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.subscribeLocked()
+}
+
+func (c *Connector) UnsubscribeTrades(_ portfolio.Asset, _ connector.Instrument) error {
+	return nil
+}
+
+func (c *Connector) SubscribePositions(_ portfolio.Asset, _ connector.Instrument) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.subscribeLocked()
+}
+
+func (c *Connector) UnsubscribePositions(_ portfolio.Asset, _ connector.Instrument) error {
+	return nil
+}
+
+func (c *Connector) SubscribeAccountBalance() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.subscribeLocked()
+}
+
+func (c *Connector) UnsubscribeAccountBalance() error {
+	return nil
+}
+
+func (c *Connector) SubscribeFills() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.subscribeLocked()
+}
+
+func (c *Connector) UnsubscribeFills() error {
+	return nil
+}
+
+func (c *Connector) SubscribeMarkPrice(_ portfolio.Asset) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.subscribeLocked()
+}
+
+func (c *Connector) UnsubscribeMarkPrice(_ portfolio.Asset) error {
+	return nil
+}
+
+// subscribeLocked fails subscriptions while disconnected, as the real
+// connectors do; callers hold mu
+func (c *Connector) subscribeLocked() error {
+	if err := c.call(OpWebSocket); err != nil {
+		return err
+	}
+	if !c.connected {
+		return fmt.Errorf("fake %s: websocket not connected", c.name)
+	}
+	return nil
+}
+
+func (c *Connector) GetOrderBookChannels() map[string]<-chan connector.OrderBook {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	channels := make(map[string]<-chan connector.OrderBook, len(c.bookChannels))
+	for key, ch := range c.bookChannels {
+		channels[key] = ch
+	}
+	return channels
+}
+
+func (c *Connector) GetKlineChannels() map[string]<-chan connector.Kline {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	channels := make(map[string]<-chan connector.Kline, len(c.klineChannels))
+	for key, ch := range c.klineChannels {
+		channels[key] = ch
+	}
+	return channels
+}
+
+func (c *Connector) TradeUpdates() <-chan connector.Trade {
+	return c.tradeCh
+}
+
+// FillUpdates carries the fake's own fills, as a private execution feed would
+func (c *Connector) FillUpdates() <-chan connector.Trade {
+	return c.fillCh
+}
+
+func (c *Connector) PositionUpdates() <-chan connector.Position {
+	return c.positionCh
+}
+
+func (c *Connector) AccountBalanceUpdates() <-chan connector.AccountBalance {
+	return c.balanceCh
+}
+
+func (c *Connector) MarkPriceUpdates() <-chan types.MarkPrice {
+	return c.markCh
+}
+
+func (c *Connector) ErrorChannel() <-chan error {
+	return c.errorCh
+}
@@ -0,0 +1,437 @@
+package fake
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+)
+
+func (c *Connector) PlaceLimitOrder(symbol string, side connector.OrderSide, quantity, price numerical.Decimal) (*connector.OrderResponse, error) {
+	if !price.IsPositive() {
+		return nil, fmt.Errorf("limit price must be positive")
+	}
+	return c.placeOrder(symbol, side, connector.OrderTypeLimit, quantity, price)
+}
+
+func (c *Connector) PlaceMarketOrder(symbol string, side connector.OrderSide, quantity numerical.Decimal) (*connector.OrderResponse, error) {
+	return c.placeOrder(symbol, side, connector.OrderTypeMarket, quantity, numerical.Zero())
+}
+
+// placeOrder fills market orders in full at the best book level, or the last
+// price without a book. Limit orders that cross the market fill as takers
+// at the market price; the rest rest until SetPrice or Fill reaches them.
+func (c *Connector) placeOrder(symbol string, side connector.OrderSide, orderType connector.OrderType, quantity, price numerical.Decimal) (*connector.OrderResponse, error) {
+	if !side.IsValid() {
+		return nil, fmt.Errorf("invalid order side %q", side)
+	}
+	if !quantity.IsPositive() {
+		return nil, fmt.Errorf("order quantity must be positive")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call(OpPlaceOrder); err != nil {
+		return nil, err
+	}
+
+	market, hasMarket := c.marketPriceLocked(symbol, side)
+	if orderType == connector.OrderTypeMarket && !hasMarket {
+		return nil, fmt.Errorf("no price for %s", symbol)
+	}
+
+	now := c.timeProvider.Now()
+	c.orderSeq++
+	order := &connector.Order{
+		ID:           fmt.Sprintf("fake-%d", c.orderSeq),
+		Symbol:       symbol,
+		Side:         side,
+		Type:         orderType,
+		Status:       connector.OrderStatusOpen,
+		Quantity:     quantity,
+		Price:        price,
+		FilledQty:    numerical.Zero(),
+		RemainingQty: quantity,
+		AvgPrice:     numerical.Zero(),
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	c.orders[order.ID] = order
+
+	if hasMarket && (orderType == connector.OrderTypeMarket || crosses(side, price, market)) {
+		c.fillLocked(order, quantity, market, false)
+	}
+
+	return &connector.OrderResponse{
+		OrderID:   order.ID,
+		Symbol:    symbol,
+		Status:    order.Status,
+		Side:      side,
+		Type:      orderType,
+		Quantity:  quantity,
+		Price:     order.Price,
+		FilledQty: order.FilledQty,
+		AvgPrice:  order.AvgPrice,
+		Timestamp: now,
+	}, nil
+}
+
+func (c *Connector) CancelOrder(symbol, orderID string) (*connector.CancelResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call(OpCancelOrder); err != nil {
+		return nil, err
+	}
+
+	order, ok := c.orders[orderID]
+	if !ok || order.Symbol != symbol {
+		return nil, fmt.Errorf("order %s not found for %s", orderID, symbol)
+	}
+	if !isOpen(order.Status) {
+		return nil, fmt.Errorf("order %s is %s and cannot be canceled", orderID, order.Status)
+	}
+
+	now := c.timeProvider.Now()
+	order.Status = connector.OrderStatusCanceled
+	order.UpdatedAt = now
+
+	return &connector.CancelResponse{
+		OrderID:   orderID,
+		Symbol:    symbol,
+		Status:    connector.OrderStatusCanceled,
+		Timestamp: now,
+	}, nil
+}
+
+func (c *Connector) CancelAllOrders() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call(OpCancelOrder); err != nil {
+		return err
+	}
+
+	now := c.timeProvider.Now()
+	for _, order := range c.orders {
+		if isOpen(order.Status) {
+			order.Status = connector.OrderStatusCanceled
+			order.UpdatedAt = now
+		}
+	}
+	return nil
+}
+
+func (c *Connector) GetOpenOrders() ([]connector.Order, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call(OpOrders); err != nil {
+		return nil, err
+	}
+
+	var open []connector.Order
+	for _, order := range c.orders {
+		if isOpen(order.Status) {
+			open = append(open, *order)
+		}
+	}
+	sort.Slice(open, func(i, j int) bool { return placedBefore(&open[i], &open[j]) })
+	return open, nil
+}
+
+func (c *Connector) GetOrderStatus(orderID string) (*connector.Order, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call(OpOrders); err != nil {
+		return nil, err
+	}
+	order, ok := c.orders[orderID]
+	if !ok {
+		return nil, fmt.Errorf("order %s not found", orderID)
+	}
+	result := *order
+	return &result, nil
+}
+
+// GetAccountBalance returns the scripted balance with the open positions'
+// unrealized PnL at the last price
+func (c *Connector) GetAccountBalance() (*connector.AccountBalance, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call(OpAccount); err != nil {
+		return nil, err
+	}
+
+	balance := c.balance
+	balance.UnrealizedPnL = numerical.Zero()
+	for symbol, pos := range c.positions {
+		balance.UnrealizedPnL = balance.UnrealizedPnL.Add(c.positionLocked(symbol, pos).UnrealizedPnL)
+	}
+	return &balance, nil
+}
+
+func (c *Connector) GetPositions() ([]connector.Position, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call(OpAccount); err != nil {
+		return nil, err
+	}
+
+	var positions []connector.Position
+	for symbol, pos := range c.positions {
+		if !pos.quantity.IsZero() {
+			positions = append(positions, c.positionLocked(symbol, pos))
+		}
+	}
+	sort.Slice(positions, func(i, j int) bool { return positions[i].Symbol.Symbol() < positions[j].Symbol.Symbol() })
+	return positions, nil
+}
+
+// GetTradingHistory returns the account's fills on symbol, newest first
+func (c *Connector) GetTradingHistory(symbol string, limit int) ([]connector.Trade, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call(OpHistory); err != nil {
+		return nil, err
+	}
+
+	var trades []connector.Trade
+	for i := len(c.fills) - 1; i >= 0; i-- {
+		if c.fills[i].Symbol != symbol {
+			continue
+		}
+		trades = append(trades, c.fills[i])
+		if limit > 0 && len(trades) == limit {
+			break
+		}
+	}
+	return trades, nil
+}
+
+func (c *Connector) FetchTradingHistorySince(symbol string, since time.Time, limit int) ([]connector.Trade, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.call(OpHistory); err != nil {
+		return nil, err
+	}
+
+	var trades []connector.Trade
+	for _, fill := range c.fills {
+		if fill.Symbol != symbol || fill.Timestamp.Before(since) {
+			continue
+		}
+		trades = append(trades, fill)
+		if limit > 0 && len(trades) == limit {
+			break
+		}
+	}
+	return trades, nil
+}
+
+// Fill fills quantity of an open order at its limit price, or at the market
+// price for market orders, as a maker. Use it to script partial fills.
+func (c *Connector) Fill(orderID string, quantity numerical.Decimal) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	order, ok := c.orders[orderID]
+	if !ok {
+		return fmt.Errorf("order %s not found", orderID)
+	}
+	if !isOpen(order.Status) {
+		return fmt.Errorf("order %s is %s and cannot fill", orderID, order.Status)
+	}
+	if !quantity.IsPositive() || quantity.GreaterThan(order.RemainingQty) {
+		quantity = order.RemainingQty
+	}
+
+	price := order.Price
+	if !price.IsPositive() {
+		market, ok := c.marketPriceLocked(order.Symbol, order.Side)
+		if !ok {
+			return fmt.Errorf("no price for %s", order.Symbol)
+		}
+		price = market
+	}
+	c.fillLocked(order, quantity, price, true)
+	return nil
+}
+
+// Fills returns every fill so far, oldest first
+func (c *Connector) Fills() []connector.Trade {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]connector.Trade(nil), c.fills...)
+}
+
+// matchRestingLocked fills resting limits the new price reaches, at their
+// limit; callers hold mu
+func (c *Connector) matchRestingLocked(symbol string, price numerical.Decimal) {
+	var due []*connector.Order
+	for _, order := range c.orders {
+		if order.Symbol == symbol && order.Type == connector.OrderTypeLimit && isOpen(order.Status) && crosses(order.Side, order.Price, price) {
+			due = append(due, order)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool { return placedBefore(due[i], due[j]) })
+
+	for _, order := range due {
+		c.fillLocked(order, order.RemainingQty, order.Price, true)
+	}
+}
+
+// marketPriceLocked is the best opposite book level, or the last price
+// without a book; callers hold mu
+func (c *Connector) marketPriceLocked(symbol string, side connector.OrderSide) (numerical.Decimal, bool) {
+	if book, ok := c.books[symbol]; ok {
+		levels := book.Asks
+		if side == connector.OrderSideSell {
+			levels = book.Bids
+		}
+		if len(levels) > 0 {
+			return levels[0].Price, true
+		}
+	}
+	if price, ok := c.prices[symbol]; ok && price.Price.IsPositive() {
+		return price.Price, true
+	}
+	return numerical.Zero(), false
+}
+
+// fillLocked records a fill against an order, moves the position and
+// publishes both; callers hold mu
+func (c *Connector) fillLocked(order *connector.Order, quantity, price numerical.Decimal, maker bool) {
+	now := c.timeProvider.Now()
+
+	notional := order.AvgPrice.Mul(order.FilledQty).Add(price.Mul(quantity))
+	order.FilledQty = order.FilledQty.Add(quantity)
+	order.RemainingQty = order.Quantity.Sub(order.FilledQty)
+	order.AvgPrice = notional.Div(order.FilledQty)
+	order.UpdatedAt = now
+	if order.RemainingQty.IsPositive() {
+		order.Status = connector.OrderStatusPartiallyFilled
+	} else {
+		order.Status = connector.OrderStatusFilled
+	}
+
+	c.fillSeq++
+	fill := connector.Trade{
+		ID:        fmt.Sprintf("fake-fill-%d", c.fillSeq),
+		OrderID:   order.ID,
+		Symbol:    order.Symbol,
+		Exchange:  c.name,
+		Price:     price,
+		Quantity:  quantity,
+		Side:      order.Side,
+		IsMaker:   maker,
+		Fee:       numerical.Zero(),
+		Timestamp: now,
+	}
+	c.fills = append(c.fills, fill)
+
+	pos, ok := c.positions[order.Symbol]
+	if !ok {
+		pos = &position{asset: assetFor(order.Symbol), quantity: numerical.Zero(), entryPrice: numerical.Zero(), realized: numerical.Zero()}
+		c.positions[order.Symbol] = pos
+	}
+	signed := quantity
+	if order.Side == connector.OrderSideSell {
+		signed = quantity.Neg()
+	}
+	pos.apply(signed, price)
+
+	publish(c.tradeCh, fill)
+	publish(c.fillCh, fill)
+	publish(c.positionCh, c.positionLocked(order.Symbol, pos))
+}
+
+// apply moves the position by a signed quantity at price, realizing PnL on
+// the part that reduces it
+func (p *position) apply(signed, price numerical.Decimal) {
+	current := p.quantity
+	next := current.Add(signed)
+
+	switch {
+	case current.IsZero() || current.IsPositive() == signed.IsPositive():
+		// Opening or adding: the entry is the size-weighted average
+		p.entryPrice = p.entryPrice.Mul(current.Abs()).Add(price.Mul(signed.Abs())).Div(next.Abs())
+	default:
+		closed := signed.Abs()
+		if current.Abs().LessThan(closed) {
+			closed = current.Abs()
+		}
+		pnl := price.Sub(p.entryPrice).Mul(closed)
+		if current.IsNegative() {
+			pnl = pnl.Neg()
+		}
+		p.realized = p.realized.Add(pnl)
+
+		switch {
+		case next.IsZero():
+			p.entryPrice = numerical.Zero()
+		case next.IsPositive() != current.IsPositive():
+			// Flipped through flat: the remainder opened at this price
+			p.entryPrice = price
+		}
+	}
+	p.quantity = next
+}
+
+// positionLocked reports a position marked at the last price; callers hold mu
+func (c *Connector) positionLocked(symbol string, pos *position) connector.Position {
+	side := connector.OrderSideBuy
+	if pos.quantity.IsNegative() {
+		side = connector.OrderSideSell
+	}
+
+	mark := pos.entryPrice
+	if price, ok := c.prices[symbol]; ok && price.Price.IsPositive() {
+		mark = price.Price
+	}
+
+	return connector.Position{
+		Symbol:        pos.asset,
+		Exchange:      c.name,
+		Side:          side,
+		Size:          pos.quantity.Abs(),
+		EntryPrice:    pos.entryPrice,
+		MarkPrice:     mark,
+		UnrealizedPnL: mark.Sub(pos.entryPrice).Mul(pos.quantity),
+		RealizedPnL:   pos.realized,
+		UpdatedAt:     c.timeProvider.Now(),
+	}
+}
+
+// crosses reports whether an order at limit would trade at price
+func crosses(side connector.OrderSide, limit, price numerical.Decimal) bool {
+	if side == connector.OrderSideBuy {
+		return !price.GreaterThan(limit)
+	}
+	return !price.LessThan(limit)
+}
+
+// placedBefore orders by placement; the sequence number breaks ties at the
+// same fake-clock instant
+func placedBefore(a, b *connector.Order) bool {
+	if !a.CreatedAt.Equal(b.CreatedAt) {
+		return a.CreatedAt.Before(b.CreatedAt)
+	}
+	// IDs share a prefix, so the shorter one carries the smaller number
+	if len(a.ID) != len(b.ID) {
+		return len(a.ID) < len(b.ID)
+	}
+	return a.ID < b.ID
+}
+
+func isOpen(status connector.OrderStatus) bool {
+	return status == connector.OrderStatusOpen || status == connector.OrderStatusPartiallyFilled
+}
@@ -0,0 +1,37 @@
+package fillpolicy
+
+import "time"
+
+const (
+	// DefaultInterval is how often watched orders are checked for escalation
+	DefaultInterval = time.Second
+
+	// DefaultRetention is how long a finished watch stays readable through
+	// Watched before it is evicted
+	DefaultRetention = 10 * time.Minute
+
+	// JobName is the scheduler job the manager registers under
+	JobName = "fill-policy"
+
+	// EventEscalated is journaled on an order the policy cancelled to
+	// escalate, EventReplacement on the order that took its remainder
+	EventEscalated   = "fill_policy_escalated"
+	EventReplacement = "fill_policy_replacement"
+)
+
+// Config controls how often watched orders are checked and the policy used
+// for runs that have not set their own
+type Config struct {
+	Interval  time.Duration
+	Retention time.Duration
+
+	// Default applies to orders watched under a run with no policy; with
+	// no steps such orders cannot be watched
+	Default Policy
+}
+
+// DefaultConfig checks every second, forgets watches ten minutes after they
+// finish and has no default policy
+func DefaultConfig() Config {
+	return Config{Interval: DefaultInterval, Retention: DefaultRetention}
+}
@@ -0,0 +1,563 @@
+package fillpolicy
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/switches"
+	"github.com/backtesting-org/live-trading/pkg/connectors/tracker"
+	"github.com/backtesting-org/live-trading/pkg/runlog"
+	"github.com/backtesting-org/live-trading/pkg/scheduler"
+)
+
+// bps is one basis point as a fraction
+var bps = numerical.NewFromFloat(0.0001)
+
+// FillPolicyManager escalates passive limit orders that do not fill. Each
+// watched order follows its run's policy: when a step's time or drift
+// trigger fires, the working order is cancelled and its remainder repriced
+// through the touch, sent at market or dropped. Every step is journaled on
+// both the cancelled order and its replacement, so the order journal shows
+// the whole chain. Orders must be tracked by the OrderTracker, which must
+// be running for fills to be seen.
+type FillPolicyManager interface {
+	Configure(config Config)
+
+	// SetPolicy sets a run's escalation ladder, used for orders watched
+	// under the run from then on
+	SetPolicy(runID string, policy Policy) error
+	ClearPolicy(runID string)
+	Policy(runID string) (Policy, bool)
+
+	// Watch puts a tracked limit order under the run's policy, or the
+	// default policy when the run has none
+	Watch(exchange connector.ExchangeName, orderID, runID string) (Watch, error)
+
+	// Unwatch stops escalating an order, leaving whatever is working on
+	// the book
+	Unwatch(exchange connector.ExchangeName, orderID string) error
+
+	// Start registers the job that checks watched orders
+	Start() error
+	Stop() error
+
+	// Poll checks every working order once, escalating those whose step fired
+	Poll() error
+
+	// Watched looks a watch up by the order ID it started from
+	Watched(exchange connector.ExchangeName, orderID string) (Watch, bool)
+	Active() []Watch
+}
+
+// watched is one order under a policy; mu serialises escalation so Poll
+// and Unwatch never act on the same order at once
+type watched struct {
+	state Watch
+	mu    sync.Mutex
+}
+
+type manager struct {
+	registry     registry.ConnectorRegistry
+	scheduler    scheduler.Scheduler
+	tracker      tracker.OrderTracker
+	switches     switches.TradingSwitches
+	runLog       runlog.RunLogger
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config   Config
+	policies map[string]Policy
+	watches  map[string]*watched
+	mu       sync.Mutex
+}
+
+func NewFillPolicyManager(
+	connectorRegistry registry.ConnectorRegistry,
+	jobScheduler scheduler.Scheduler,
+	orderTracker tracker.OrderTracker,
+	tradingSwitches switches.TradingSwitches,
+	runLog runlog.RunLogger,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) FillPolicyManager {
+	return &manager{
+		registry:     connectorRegistry,
+		scheduler:    jobScheduler,
+		tracker:      orderTracker,
+		switches:     tradingSwitches,
+		runLog:       runLog,
+		timeProvider: timeProvider,
+		logger:       logger,
+		config:       DefaultConfig(),
+		policies:     make(map[string]Policy),
+		watches:      make(map[string]*watched),
+	}
+}
+
+func (m *manager) Configure(config Config) {
+	if config.Interval <= 0 {
+		config.Interval = DefaultInterval
+	}
+	if config.Retention <= 0 {
+		config.Retention = DefaultRetention
+	}
+	if len(config.Default.Steps) > 0 {
+		if err := config.Default.validate(); err != nil {
+			m.logger.Warn("Default fill policy ignored: %v", err)
+			config.Default = Policy{}
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.config = config
+}
+
+func (m *manager) SetPolicy(runID string, policy Policy) error {
+	if runID == "" {
+		return fmt.Errorf("run ID is required")
+	}
+	if err := policy.validate(); err != nil {
+		return fmt.Errorf("invalid fill policy: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.policies[runID] = Policy{Steps: append([]Step(nil), policy.Steps...)}
+	return nil
+}
+
+func (m *manager) ClearPolicy(runID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.policies, runID)
+}
+
+func (m *manager) Policy(runID string) (Policy, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	policy, ok := m.policies[runID]
+	return policy, ok
+}
+
+func (m *manager) Start() error {
+	m.mu.Lock()
+	interval := m.config.Interval
+	m.mu.Unlock()
+
+	return m.scheduler.Register(scheduler.Job{
+		Name:     JobName,
+		Interval: interval,
+		Run: func(_ context.Context) error {
+			return m.Poll()
+		},
+	})
+}
+
+func (m *manager) Stop() error {
+	return m.scheduler.Unregister(JobName)
+}
+
+func (m *manager) Watch(exchange connector.ExchangeName, orderID, runID string) (Watch, error) {
+	tracked, ok := m.tracker.Order(exchange, orderID)
+	if !ok {
+		return Watch{}, fmt.Errorf("order %s on %s not tracked", orderID, exchange)
+	}
+	if tracked.Order.Type != "" && tracked.Order.Type != connector.OrderTypeLimit {
+		return Watch{}, fmt.Errorf("order %s is %s, only limit orders can be escalated", orderID, tracked.Order.Type)
+	}
+	if isTerminal(tracked.Order.Status) {
+		return Watch{}, fmt.Errorf("order %s is already %s", orderID, tracked.Order.Status)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	policy, ok := m.policies[runID]
+	if !ok {
+		policy = m.config.Default
+	}
+	if len(policy.Steps) == 0 {
+		return Watch{}, fmt.Errorf("no fill policy for run %q", runID)
+	}
+
+	key := watchKey(exchange, orderID)
+	if _, exists := m.watches[key]; exists {
+		return Watch{}, fmt.Errorf("order %s on %s already watched", orderID, exchange)
+	}
+
+	now := m.timeProvider.Now()
+	w := &watched{state: Watch{
+		RunID:           runID,
+		Exchange:        exchange,
+		Symbol:          tracked.Order.Symbol,
+		Side:            tracked.Order.Side,
+		OriginalOrderID: orderID,
+		OrderID:         orderID,
+		Price:           tracked.Order.Price,
+		Policy:          policy,
+		StepStartedAt:   now,
+		Status:          StatusWorking,
+		StartedAt:       now,
+	}}
+	m.watches[key] = w
+	return w.state, nil
+}
+
+func (m *manager) Unwatch(exchange connector.ExchangeName, orderID string) error {
+	m.mu.Lock()
+	w, ok := m.watches[watchKey(exchange, orderID)]
+	m.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("order %s on %s not watched", orderID, exchange)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.state.Status == StatusWorking {
+		m.finish(w, StatusCancelled, "unwatched")
+	}
+	return nil
+}
+
+func (m *manager) Watched(exchange connector.ExchangeName, orderID string) (Watch, bool) {
+	m.mu.Lock()
+	w, ok := m.watches[watchKey(exchange, orderID)]
+	m.mu.Unlock()
+	if !ok {
+		return Watch{}, false
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return copyWatch(w.state), true
+}
+
+func (m *manager) Active() []Watch {
+	var active []Watch
+	for _, w := range m.working() {
+		w.mu.Lock()
+		if w.state.Status == StatusWorking {
+			active = append(active, copyWatch(w.state))
+		}
+		w.mu.Unlock()
+	}
+
+	sort.Slice(active, func(i, j int) bool {
+		return active[i].StartedAt.Before(active[j].StartedAt)
+	})
+	return active
+}
+
+func (m *manager) working() []*watched {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	working := make([]*watched, 0, len(m.watches))
+	for _, w := range m.watches {
+		working = append(working, w)
+	}
+	return working
+}
+
+func (m *manager) Poll() error {
+	m.evict()
+
+	var failed []string
+	for _, w := range m.working() {
+		if err := m.check(w); err != nil {
+			failed = append(failed, err.Error())
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("fill policy: %s", strings.Join(failed, "; "))
+	}
+	return nil
+}
+
+// check finishes a watch whose order has left the book and fires the next
+// step once its trigger is met
+func (m *manager) check(w *watched) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	state := &w.state
+	if state.Status != StatusWorking {
+		return nil
+	}
+
+	tracked, ok := m.tracker.Order(state.Exchange, state.OrderID)
+	if !ok {
+		m.finish(w, StatusFailed, fmt.Sprintf("order %s no longer tracked", state.OrderID))
+		return nil
+	}
+	if isTerminal(tracked.Order.Status) {
+		status := StatusCompleted
+		if tracked.Order.Status != connector.OrderStatusFilled {
+			status = StatusCancelled
+		}
+		m.finish(w, status, "")
+		return nil
+	}
+	if state.NextStep >= len(state.Policy.Steps) {
+		return nil
+	}
+
+	conn, ok := m.registry.GetConnector(state.Exchange)
+	if !ok {
+		return fmt.Errorf("%s: connector not registered", state.Exchange)
+	}
+
+	step := state.Policy.Steps[state.NextStep]
+	now := m.timeProvider.Now()
+
+	var touch numerical.Decimal
+	if step.MaxDriftBps > 0 || step.Action == ActionAggressive {
+		price, err := conn.FetchPrice(state.Symbol)
+		if err != nil || price == nil {
+			return fmt.Errorf("%s %s: price unavailable: %v", state.Exchange, state.Symbol, err)
+		}
+		touch = touchPrice(*price, state.Side)
+	}
+
+	reason := ""
+	if resting := now.Sub(state.StepStartedAt); step.After > 0 && resting >= step.After {
+		reason = fmt.Sprintf("unfilled after %s", resting.Round(time.Second))
+	} else if step.MaxDriftBps > 0 && state.Price.IsPositive() && touch.IsPositive() {
+		if drift := driftBps(state.Price, touch, state.Side); drift > step.MaxDriftBps {
+			reason = fmt.Sprintf("market moved %.1f bps away", drift)
+		}
+	}
+	if reason == "" {
+		return nil
+	}
+
+	return m.escalate(conn, w, tracked, step, touch, reason)
+}
+
+// escalate cancels the working order and hands its remainder to the step's
+// action. The remainder is read back after the cancel so a fill racing the
+// cancel is not sent again.
+func (m *manager) escalate(conn connector.Connector, w *watched, tracked tracker.TrackedOrder, step Step, touch numerical.Decimal, reason string) error {
+	state := &w.state
+	now := m.timeProvider.Now()
+	escalation := Escalation{
+		Step:        state.NextStep + 1,
+		Action:      step.Action,
+		Reason:      reason,
+		FromOrderID: state.OrderID,
+		At:          now,
+	}
+
+	if _, err := conn.CancelOrder(state.Symbol, state.OrderID); err != nil {
+		// The order may have filled in the meantime; the next poll sees it
+		return fmt.Errorf("%s %s: cancel for escalation failed: %v", state.Exchange, state.OrderID, err)
+	}
+
+	order := tracked.Order
+	if latest, err := conn.GetOrderStatus(state.OrderID); err == nil && latest != nil {
+		order.FilledQty = latest.FilledQty
+	}
+	remaining := order.Quantity.Sub(order.FilledQty)
+	escalation.Quantity = remaining
+
+	if !remaining.IsPositive() {
+		escalation.Err = "filled before the cancel"
+		m.record(w, escalation)
+		m.finish(w, StatusCompleted, "")
+		return nil
+	}
+
+	if step.Action == ActionCancel {
+		m.record(w, escalation)
+		m.finish(w, StatusCancelled, reason)
+		return nil
+	}
+
+	if err := m.switches.CheckOrder(state.Exchange, state.Symbol, false); err != nil {
+		escalation.Err = err.Error()
+		m.record(w, escalation)
+		m.finish(w, StatusFailed, fmt.Sprintf("replacement blocked: %v", err))
+		return nil
+	}
+
+	var response *connector.OrderResponse
+	var err error
+	if step.Action == ActionMarket {
+		response, err = conn.PlaceMarketOrder(state.Symbol, state.Side, remaining)
+	} else {
+		escalation.Price = aggressivePrice(touch, state.Side, step.AggressionBps, tickSize(conn, state.Symbol))
+		response, err = conn.PlaceLimitOrder(state.Symbol, state.Side, remaining, escalation.Price)
+	}
+	if err != nil {
+		escalation.Err = err.Error()
+		m.record(w, escalation)
+		m.finish(w, StatusFailed, fmt.Sprintf("replacement rejected: %v", err))
+		return nil
+	}
+
+	if err := m.tracker.Track(state.Exchange, response); err != nil {
+		m.logger.Warn("Fill policy: replacement %s not tracked: %v", response.OrderID, err)
+	}
+	escalation.ToOrderID = response.OrderID
+	m.record(w, escalation)
+
+	state.OrderID = response.OrderID
+	state.Price = escalation.Price
+	state.NextStep++
+	state.StepStartedAt = now
+	return nil
+}
+
+// record appends an escalation to the watch and journals it on both orders
+func (m *manager) record(w *watched, escalation Escalation) {
+	state := &w.state
+	state.Escalations = append(state.Escalations, escalation)
+
+	if err := m.tracker.Annotate(state.Exchange, escalation.FromOrderID, EventEscalated, escalation); err != nil {
+		m.logger.Debug("Fill policy escalation of %s not journaled: %v", escalation.FromOrderID, err)
+	}
+	if escalation.ToOrderID != "" {
+		if err := m.tracker.Annotate(state.Exchange, escalation.ToOrderID, EventReplacement, escalation); err != nil {
+			m.logger.Debug("Fill policy replacement %s not journaled: %v", escalation.ToOrderID, err)
+		}
+	}
+
+	message := fmt.Sprintf("Fill policy step %d on %s: %s %s %s via %s (%s)",
+		escalation.Step, state.OriginalOrderID, state.Side, escalation.Quantity.String(), state.Symbol,
+		escalation.Action, escalation.Reason)
+	if escalation.ToOrderID != "" {
+		message += " as " + escalation.ToOrderID
+	}
+	level := runlog.LevelInfo
+	if escalation.Err != "" {
+		level = runlog.LevelWarn
+		message += ": " + escalation.Err
+	}
+
+	m.logger.Info("⏩ %s", message)
+	m.runLog.Record(level, "order", runlog.Fields{
+		Exchange:      string(state.Exchange),
+		OrderID:       escalation.FromOrderID,
+		CorrelationID: state.OriginalOrderID,
+	}, "%s", message)
+}
+
+// evict forgets watches finished before the retention cutoff; each watch
+// is inspected under its own lock, never while holding m.mu
+func (m *manager) evict() {
+	m.mu.Lock()
+	cutoff := m.timeProvider.Now().Add(-m.config.Retention)
+	m.mu.Unlock()
+
+	var expired []string
+	for _, w := range m.working() {
+		w.mu.Lock()
+		if w.state.Status != StatusWorking && w.state.FinishedAt.Before(cutoff) {
+			expired = append(expired, watchKey(w.state.Exchange, w.state.OriginalOrderID))
+		}
+		w.mu.Unlock()
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, key := range expired {
+		delete(m.watches, key)
+	}
+}
+
+func (m *manager) finish(w *watched, status Status, reason string) {
+	w.state.Status = status
+	w.state.Err = reason
+	w.state.FinishedAt = m.timeProvider.Now()
+	if status == StatusFailed {
+		m.logger.Warn("Fill policy on %s %s failed: %s", w.state.Exchange, w.state.OriginalOrderID, reason)
+	}
+}
+
+// touchPrice is the far side of the book an order on side would take
+// from, falling back to the last price
+func touchPrice(price connector.Price, side connector.OrderSide) numerical.Decimal {
+	if side == connector.OrderSideBuy && price.AskPrice.IsPositive() {
+		return price.AskPrice
+	}
+	if side == connector.OrderSideSell && price.BidPrice.IsPositive() {
+		return price.BidPrice
+	}
+	return price.Price
+}
+
+// driftBps is how far the touch has moved away from the order's price;
+// negative when it moved towards it
+func driftBps(limit, touch numerical.Decimal, side connector.OrderSide) float64 {
+	moved := touch.Sub(limit)
+	if side == connector.OrderSideSell {
+		moved = moved.Neg()
+	}
+	return moved.Div(limit).Div(bps).InexactFloat64()
+}
+
+// aggressivePrice prices through the touch by aggression, rounded away
+// from the touch onto the tick so the order still crosses
+func aggressivePrice(touch numerical.Decimal, side connector.OrderSide, aggression float64, tick numerical.Decimal) numerical.Decimal {
+	offset := touch.Mul(numerical.NewFromFloat(aggression)).Mul(bps)
+	if side == connector.OrderSideSell {
+		price := touch.Sub(offset)
+		if tick.IsPositive() {
+			price = price.Div(tick).Truncate(0).Mul(tick)
+		}
+		return price
+	}
+
+	price := touch.Add(offset)
+	if tick.IsPositive() {
+		steps := price.Div(tick)
+		if rounded := steps.Truncate(0); rounded.LessThan(steps) {
+			steps = rounded.Add(numerical.NewFromInt(1))
+		}
+		price = steps.Mul(tick)
+	}
+	return price
+}
+
+// tickSize looks up the symbol's price tick; zero means prices are not rounded
+func tickSize(conn connector.Connector, symbol string) numerical.Decimal {
+	contracts, err := conn.FetchContracts()
+	if err != nil {
+		return numerical.Zero()
+	}
+	for _, contract := range contracts {
+		if contract.Symbol == symbol {
+			return contract.TickSize
+		}
+	}
+	return numerical.Zero()
+}
+
+func copyWatch(state Watch) Watch {
+	state.Escalations = append([]Escalation(nil), state.Escalations...)
+	state.Policy.Steps = append([]Step(nil), state.Policy.Steps...)
+	return state
+}
+
+func isTerminal(status connector.OrderStatus) bool {
+	switch status {
+	case connector.OrderStatusFilled, connector.OrderStatusCanceled,
+		connector.OrderStatusRejected, connector.OrderStatusExpired:
+		return true
+	}
+	return false
+}
+
+func watchKey(exchange connector.ExchangeName, orderID string) string {
+	return fmt.Sprintf("%s:%s", exchange, orderID)
+}
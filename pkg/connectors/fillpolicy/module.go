@@ -0,0 +1,7 @@
+package fillpolicy
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(NewFillPolicyManager),
+)
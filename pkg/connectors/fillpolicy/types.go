@@ -0,0 +1,119 @@
+package fillpolicy
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+)
+
+// Action is what an escalation step does with the unfilled remainder
+type Action string
+
+const (
+	// ActionAggressive reprices the remainder AggressionBps through the
+	// touch, so it takes liquidity unless the book moves first
+	ActionAggressive Action = "aggressive_limit"
+
+	// ActionMarket sends the remainder as a market order
+	ActionMarket Action = "market"
+
+	// ActionCancel cancels the remainder
+	ActionCancel Action = "cancel"
+)
+
+// Step is one escalation. It fires once the working order has rested for
+// After, or once the market has moved more than MaxDriftBps away from its
+// price, whichever comes first; a zero trigger is disabled.
+type Step struct {
+	After       time.Duration
+	MaxDriftBps float64
+	Action      Action
+
+	// AggressionBps applies to ActionAggressive; zero prices at the touch
+	AggressionBps float64
+}
+
+// Policy is the escalation ladder for a run's passive orders, applied in
+// order. Market and cancel steps end the ladder.
+type Policy struct {
+	Steps []Step
+}
+
+func (p Policy) validate() error {
+	if len(p.Steps) == 0 {
+		return fmt.Errorf("at least one step is required")
+	}
+
+	for i, step := range p.Steps {
+		if step.After < 0 || step.MaxDriftBps < 0 || step.AggressionBps < 0 {
+			return fmt.Errorf("step %d: triggers and aggression must not be negative", i+1)
+		}
+		if step.After == 0 && step.MaxDriftBps == 0 {
+			return fmt.Errorf("step %d: a time or drift trigger is required", i+1)
+		}
+
+		switch step.Action {
+		case ActionAggressive:
+		case ActionMarket, ActionCancel:
+			if i < len(p.Steps)-1 {
+				return fmt.Errorf("step %d: %s must be the last step", i+1, step.Action)
+			}
+		default:
+			return fmt.Errorf("step %d: unknown action %q", i+1, step.Action)
+		}
+	}
+	return nil
+}
+
+// Status is where a watched order stands
+type Status string
+
+const (
+	StatusWorking   Status = "working"
+	StatusCompleted Status = "completed"
+	StatusCancelled Status = "cancelled"
+	StatusFailed    Status = "failed"
+)
+
+// Escalation records one step taken on a watched order. FromOrderID is the
+// order that was cancelled and ToOrderID the one that took its remainder,
+// empty after a cancel step.
+type Escalation struct {
+	Step        int
+	Action      Action
+	Reason      string
+	FromOrderID string
+	ToOrderID   string
+	Quantity    numerical.Decimal
+	Price       numerical.Decimal
+	At          time.Time
+	Err         string `json:",omitempty"`
+}
+
+// Watch is a passive order under a fill policy. OriginalOrderID identifies
+// it throughout; OrderID and Price follow the working replacement.
+type Watch struct {
+	RunID    string
+	Exchange connector.ExchangeName
+	Symbol   string
+	Side     connector.OrderSide
+
+	OriginalOrderID string
+	OrderID         string
+	Price           numerical.Decimal
+
+	Policy Policy
+
+	// NextStep indexes the step still to fire; StepStartedAt is when the
+	// working order started resting
+	NextStep      int
+	StepStartedAt time.Time
+
+	Status      Status
+	Err         string
+	Escalations []Escalation
+	StartedAt   time.Time
+	FinishedAt  time.Time
+}
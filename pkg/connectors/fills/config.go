@@ -0,0 +1,47 @@
+// Package fills keeps a local, append-only copy of account fills and brings
+// it up to date incrementally from each exchange's trade history
+package fills
+
+import "time"
+
+const (
+	// DefaultInterval is how often tracked markets are synced
+	DefaultInterval = 5 * time.Minute
+
+	// DefaultPageSize is the number of fills requested per history call
+	DefaultPageSize = 500
+
+	// DefaultMaxPages bounds one market's sync so a backlog is worked off
+	// over several runs instead of stalling the job
+	DefaultMaxPages = 20
+
+	// DefaultLookback is how far back a market with no cursor starts
+	DefaultLookback = 30 * 24 * time.Hour
+
+	// FillsFileName and CursorsFileName live under Config.Directory
+	FillsFileName   = "fills.jsonl"
+	CursorsFileName = "cursors.json"
+
+	// JobName is the scheduler job the sync registers under
+	JobName = "fill-history-sync"
+)
+
+// Config controls where fills are stored and how history is paged
+type Config struct {
+	Directory string
+	Interval  time.Duration
+	PageSize  int
+	MaxPages  int
+	Lookback  time.Duration
+}
+
+// DefaultConfig stores fills under the given directory
+func DefaultConfig(directory string) Config {
+	return Config{
+		Directory: directory,
+		Interval:  DefaultInterval,
+		PageSize:  DefaultPageSize,
+		MaxPages:  DefaultMaxPages,
+		Lookback:  DefaultLookback,
+	}
+}
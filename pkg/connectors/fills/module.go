@@ -0,0 +1,20 @@
+package fills
+
+import (
+	"context"
+
+	"go.uber.org/fx"
+)
+
+var Module = fx.Options(
+	fx.Provide(NewHistorySync),
+	fx.Invoke(registerHooks),
+)
+
+func registerHooks(lifecycle fx.Lifecycle, sync HistorySync) {
+	lifecycle.Append(fx.Hook{
+		OnStop: func(context.Context) error {
+			return sync.Close()
+		},
+	})
+}
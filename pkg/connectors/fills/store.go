@@ -0,0 +1,204 @@
+package fills
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/live-trading/pkg/jsonl"
+)
+
+// Cursor is how far one market's history has been synced
+type Cursor struct {
+	Exchange connector.ExchangeName `json:"exchange"`
+	Symbol   string                 `json:"symbol"`
+
+	// LastFillAt is the execution time of the newest stored fill; the next
+	// sync reads from here, inclusive, and drops fills already stored
+	LastFillAt time.Time `json:"last_fill_at"`
+	SyncedAt   time.Time `json:"synced_at"`
+}
+
+type marketKey struct {
+	exchange connector.ExchangeName
+	symbol   string
+}
+
+// store is the on-disk fill log plus the cursors, both held in memory once
+// loaded. Fills are fsynced before the cursor that covers them is saved, and
+// the seen set makes a re-read after a crash between the two harmless.
+type store struct {
+	file        *os.File
+	cursorsPath string
+
+	fills   []connector.Trade
+	seen    map[marketKey]map[string]struct{}
+	cursors map[marketKey]Cursor
+}
+
+func openStore(directory string) (*store, error) {
+	if err := os.MkdirAll(directory, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create fill store directory: %w", err)
+	}
+
+	s := &store{
+		cursorsPath: filepath.Join(directory, CursorsFileName),
+		seen:        make(map[marketKey]map[string]struct{}),
+		cursors:     make(map[marketKey]Cursor),
+	}
+
+	fillsPath := filepath.Join(directory, FillsFileName)
+	if err := s.loadFills(fillsPath); err != nil {
+		return nil, err
+	}
+	if err := s.loadCursors(); err != nil {
+		return nil, err
+	}
+
+	file, err := jsonl.OpenAppend(fillsPath, 0o640)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open fill store: %w", err)
+	}
+	s.file = file
+	return s, nil
+}
+
+func (s *store) loadFills(path string) error {
+	err := jsonl.ScanFile(path, func(_ int, line []byte) error {
+		var trade connector.Trade
+		// A torn final line from a crash mid-append is skipped
+		if err := json.Unmarshal(line, &trade); err == nil {
+			s.remember(trade)
+		}
+		return nil
+	})
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read fill store: %w", err)
+	}
+
+	sort.SliceStable(s.fills, func(i, j int) bool {
+		return s.fills[i].Timestamp.Before(s.fills[j].Timestamp)
+	})
+	return nil
+}
+
+func (s *store) loadCursors() error {
+	data, err := os.ReadFile(s.cursorsPath)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read fill cursors: %w", err)
+	}
+
+	if len(data) > 0 {
+		var cursors []Cursor
+		if err := json.Unmarshal(data, &cursors); err != nil {
+			return fmt.Errorf("failed to decode fill cursors: %w", err)
+		}
+		for _, cursor := range cursors {
+			s.cursors[marketKey{cursor.Exchange, cursor.Symbol}] = cursor
+		}
+	}
+
+	// Fills can be ahead of a cursor that was not saved before a crash
+	for _, trade := range s.fills {
+		key := marketKey{trade.Exchange, trade.Symbol}
+		cursor, ok := s.cursors[key]
+		if !ok {
+			cursor = Cursor{Exchange: trade.Exchange, Symbol: trade.Symbol}
+		}
+		if trade.Timestamp.After(cursor.LastFillAt) {
+			cursor.LastFillAt = trade.Timestamp
+			s.cursors[key] = cursor
+		}
+	}
+	return nil
+}
+
+func (s *store) remember(trade connector.Trade) bool {
+	key := marketKey{trade.Exchange, trade.Symbol}
+	ids, ok := s.seen[key]
+	if !ok {
+		ids = make(map[string]struct{})
+		s.seen[key] = ids
+	}
+	if _, dup := ids[trade.ID]; dup {
+		return false
+	}
+
+	ids[trade.ID] = struct{}{}
+	s.fills = append(s.fills, trade)
+	return true
+}
+
+// append stores the fills not seen before and returns them
+func (s *store) append(trades []connector.Trade) ([]connector.Trade, error) {
+	fresh := make([]connector.Trade, 0, len(trades))
+	var lines []byte
+	for _, trade := range trades {
+		if !s.remember(trade) {
+			continue
+		}
+
+		line, err := jsonl.Marshal(trade)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode fill %s: %w", trade.ID, err)
+		}
+		lines = append(lines, line...)
+		fresh = append(fresh, trade)
+	}
+
+	if len(lines) == 0 {
+		return nil, nil
+	}
+	if _, err := s.file.Write(lines); err != nil {
+		return nil, fmt.Errorf("failed to append fills: %w", err)
+	}
+	if err := s.file.Sync(); err != nil {
+		return nil, fmt.Errorf("failed to sync fill store: %w", err)
+	}
+
+	// Exchanges page oldest first but a fallback page may not be
+	sort.SliceStable(s.fills, func(i, j int) bool {
+		return s.fills[i].Timestamp.Before(s.fills[j].Timestamp)
+	})
+	return fresh, nil
+}
+
+// saveCursors rewrites the cursor file through a rename so a crash leaves
+// either the old or the new set
+func (s *store) saveCursors() error {
+	cursors := make([]Cursor, 0, len(s.cursors))
+	for _, cursor := range s.cursors {
+		cursors = append(cursors, cursor)
+	}
+	sort.Slice(cursors, func(i, j int) bool {
+		if cursors[i].Exchange != cursors[j].Exchange {
+			return cursors[i].Exchange < cursors[j].Exchange
+		}
+		return cursors[i].Symbol < cursors[j].Symbol
+	})
+
+	data, err := json.MarshalIndent(cursors, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode fill cursors: %w", err)
+	}
+
+	tmp := s.cursorsPath + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o640); err != nil {
+		return fmt.Errorf("failed to write fill cursors: %w", err)
+	}
+	if err := os.Rename(tmp, s.cursorsPath); err != nil {
+		return fmt.Errorf("failed to replace fill cursors: %w", err)
+	}
+	return nil
+}
+
+func (s *store) close() error {
+	return s.file.Close()
+}
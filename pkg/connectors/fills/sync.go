@@ -0,0 +1,336 @@
+package fills
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+	"github.com/backtesting-org/live-trading/pkg/scheduler"
+)
+
+// ErrNotConfigured is returned until Configure has opened the store
+var ErrNotConfigured = errors.New("fill store not configured")
+
+// HistorySync keeps the local fill store current by reading each tracked
+// market's trade history forward from its cursor, so reconciliation and tax
+// reporting never need a full history pull
+type HistorySync interface {
+	// Configure opens the store and loads the fills and cursors already on disk
+	Configure(config Config) error
+
+	// Track adds a market to the periodic sync; symbol is exchange-native
+	Track(exchange connector.ExchangeName, symbol string)
+
+	Start() error
+	Stop() error
+
+	// Sync reads one market forward from its cursor and returns how many
+	// new fills were stored
+	Sync(exchange connector.ExchangeName, symbol string) (int, error)
+
+	// SyncAll syncs every tracked market
+	SyncAll() error
+
+	Cursor(exchange connector.ExchangeName, symbol string) (Cursor, bool)
+
+	// Fills returns a market's stored fills executed at or after since, oldest first
+	Fills(exchange connector.ExchangeName, symbol string, since time.Time) []connector.Trade
+
+	// Export writes every stored fill within [since, until] as CSV; a zero
+	// until means no upper bound
+	Export(w io.Writer, since, until time.Time) error
+
+	Close() error
+}
+
+type historySync struct {
+	registry     registry.ConnectorRegistry
+	scheduler    scheduler.Scheduler
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config  Config
+	store   *store
+	tracked map[marketKey]struct{}
+	mu      sync.Mutex
+}
+
+func NewHistorySync(
+	connectorRegistry registry.ConnectorRegistry,
+	jobScheduler scheduler.Scheduler,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) HistorySync {
+	return &historySync{
+		registry:     connectorRegistry,
+		scheduler:    jobScheduler,
+		timeProvider: timeProvider,
+		logger:       logger,
+		tracked:      make(map[marketKey]struct{}),
+	}
+}
+
+func (h *historySync) Configure(config Config) error {
+	if config.Directory == "" {
+		return fmt.Errorf("fill store directory is required")
+	}
+	defaults := DefaultConfig(config.Directory)
+	if config.Interval <= 0 {
+		config.Interval = defaults.Interval
+	}
+	if config.PageSize <= 0 {
+		config.PageSize = defaults.PageSize
+	}
+	if config.MaxPages <= 0 {
+		config.MaxPages = defaults.MaxPages
+	}
+	if config.Lookback <= 0 {
+		config.Lookback = defaults.Lookback
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.store != nil {
+		return fmt.Errorf("fill store already configured")
+	}
+
+	st, err := openStore(config.Directory)
+	if err != nil {
+		return err
+	}
+
+	h.config = config
+	h.store = st
+	h.logger.Info("Fill store loaded %d fills across %d markets", len(st.fills), len(st.cursors))
+	return nil
+}
+
+func (h *historySync) Track(exchange connector.ExchangeName, symbol string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.tracked[marketKey{exchange, symbol}] = struct{}{}
+}
+
+func (h *historySync) Start() error {
+	h.mu.Lock()
+	interval := h.config.Interval
+	configured := h.store != nil
+	h.mu.Unlock()
+
+	if !configured {
+		return ErrNotConfigured
+	}
+
+	return h.scheduler.Register(scheduler.Job{
+		Name:       JobName,
+		Interval:   interval,
+		RunOnStart: true,
+		Run: func(_ context.Context) error {
+			return h.SyncAll()
+		},
+	})
+}
+
+func (h *historySync) Stop() error {
+	return h.scheduler.Unregister(JobName)
+}
+
+func (h *historySync) Sync(exchange connector.ExchangeName, symbol string) (int, error) {
+	key := marketKey{exchange, symbol}
+
+	h.mu.Lock()
+	if h.store == nil {
+		h.mu.Unlock()
+		return 0, ErrNotConfigured
+	}
+	config := h.config
+	cursor, ok := h.store.cursors[key]
+	h.mu.Unlock()
+
+	now := h.timeProvider.Now()
+	if !ok {
+		cursor = Cursor{Exchange: exchange, Symbol: symbol, LastFillAt: now.Add(-config.Lookback)}
+	}
+
+	conn, ok := h.registry.GetConnector(exchange)
+	if !ok {
+		return 0, fmt.Errorf("connector %s not registered", exchange)
+	}
+	provider, paged := conn.(types.TradeHistoryProvider)
+
+	stored := 0
+	for page := 0; page < config.MaxPages; page++ {
+		var trades []connector.Trade
+		var err error
+		if paged {
+			trades, err = provider.FetchTradingHistorySince(symbol, cursor.LastFillAt, config.PageSize)
+		} else {
+			// Without a start time the latest page is all there is; the seen
+			// set keeps repeats out of the store
+			trades, err = conn.GetTradingHistory(symbol, config.PageSize)
+		}
+		if err != nil {
+			return stored, fmt.Errorf("failed to fetch %s fills on %s: %w", symbol, exchange, err)
+		}
+
+		for i := range trades {
+			trades[i].Exchange = exchange
+			if trades[i].Symbol == "" {
+				trades[i].Symbol = symbol
+			}
+		}
+
+		h.mu.Lock()
+		fresh, err := h.store.append(trades)
+		h.mu.Unlock()
+		if err != nil {
+			return stored, err
+		}
+
+		stored += len(fresh)
+		for _, trade := range fresh {
+			if trade.Timestamp.After(cursor.LastFillAt) {
+				cursor.LastFillAt = trade.Timestamp
+			}
+		}
+
+		// A short page is the end of history; a page of only known fills
+		// means every fill at the cursor's millisecond is already stored
+		if !paged || len(fresh) == 0 || len(trades) < config.PageSize {
+			break
+		}
+	}
+
+	cursor.SyncedAt = now
+
+	h.mu.Lock()
+	h.store.cursors[key] = cursor
+	err := h.store.saveCursors()
+	h.mu.Unlock()
+	if err != nil {
+		return stored, err
+	}
+
+	if stored > 0 {
+		h.logger.Info("Synced %d new %s fills from %s", stored, symbol, exchange)
+	}
+	return stored, nil
+}
+
+func (h *historySync) SyncAll() error {
+	h.mu.Lock()
+	markets := make([]marketKey, 0, len(h.tracked))
+	for key := range h.tracked {
+		markets = append(markets, key)
+	}
+	h.mu.Unlock()
+
+	var failed []string
+	for _, key := range markets {
+		if _, err := h.Sync(key.exchange, key.symbol); err != nil {
+			h.logger.Warn("Fill history sync failed: %v", err)
+			failed = append(failed, fmt.Sprintf("%s/%s", key.exchange, key.symbol))
+		}
+	}
+
+	if len(failed) > 0 {
+		sort.Strings(failed)
+		return fmt.Errorf("fill history sync failed for %v", failed)
+	}
+	return nil
+}
+
+func (h *historySync) Cursor(exchange connector.ExchangeName, symbol string) (Cursor, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.store == nil {
+		return Cursor{}, false
+	}
+	cursor, ok := h.store.cursors[marketKey{exchange, symbol}]
+	return cursor, ok
+}
+
+func (h *historySync) Fills(exchange connector.ExchangeName, symbol string, since time.Time) []connector.Trade {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.store == nil {
+		return nil
+	}
+
+	var fills []connector.Trade
+	for _, trade := range h.store.fills {
+		if trade.Exchange == exchange && trade.Symbol == symbol && !trade.Timestamp.Before(since) {
+			fills = append(fills, trade)
+		}
+	}
+	return fills
+}
+
+func (h *historySync) Export(w io.Writer, since, until time.Time) error {
+	h.mu.Lock()
+	if h.store == nil {
+		h.mu.Unlock()
+		return ErrNotConfigured
+	}
+	fills := make([]connector.Trade, 0, len(h.store.fills))
+	for _, trade := range h.store.fills {
+		if trade.Timestamp.Before(since) || !until.IsZero() && trade.Timestamp.After(until) {
+			continue
+		}
+		fills = append(fills, trade)
+	}
+	h.mu.Unlock()
+
+	writer := csv.NewWriter(w)
+	header := []string{"executed_at", "exchange", "symbol", "side", "quantity", "price", "fee", "maker", "order_id", "fill_id"}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write fill export: %w", err)
+	}
+
+	for _, trade := range fills {
+		record := []string{
+			trade.Timestamp.UTC().Format(time.RFC3339Nano),
+			string(trade.Exchange),
+			trade.Symbol,
+			string(trade.Side),
+			trade.Quantity.String(),
+			trade.Price.String(),
+			trade.Fee.String(),
+			strconv.FormatBool(trade.IsMaker),
+			trade.OrderID,
+			trade.ID,
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("failed to write fill export: %w", err)
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func (h *historySync) Close() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.store == nil {
+		return nil
+	}
+	err := h.store.close()
+	h.store = nil
+	return err
+}
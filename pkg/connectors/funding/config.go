@@ -0,0 +1,53 @@
+package funding
+
+import (
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+const (
+	// DefaultInterval is how often every connector's funding rates are pulled
+	DefaultInterval = time.Minute
+
+	// DefaultFundingPeriod is assumed for exchanges without a known period
+	DefaultFundingPeriod = 8 * time.Hour
+
+	// JobName is the scheduler job the feed registers under
+	JobName = "funding-rates"
+
+	hoursPerYear = 365 * 24
+)
+
+// DefaultFundingPeriods is how often each exchange charges funding, which
+// is what its quoted rate covers
+var DefaultFundingPeriods = map[connector.ExchangeName]time.Duration{
+	types.Binance:     8 * time.Hour,
+	types.Bybit:       8 * time.Hour,
+	types.Paradex:     8 * time.Hour,
+	types.Okx:         8 * time.Hour,
+	types.Hyperliquid: time.Hour,
+}
+
+// Config controls the funding rate feed
+type Config struct {
+	Interval time.Duration
+
+	// FundingPeriods overrides the funding period per exchange, e.g. for
+	// Bybit contracts that settle every four hours
+	FundingPeriods map[connector.ExchangeName]time.Duration
+}
+
+// DefaultConfig pulls rates every minute using each exchange's standard period
+func DefaultConfig() Config {
+	periods := make(map[connector.ExchangeName]time.Duration, len(DefaultFundingPeriods))
+	for exchange, period := range DefaultFundingPeriods {
+		periods[exchange] = period
+	}
+
+	return Config{
+		Interval:       DefaultInterval,
+		FundingPeriods: periods,
+	}
+}
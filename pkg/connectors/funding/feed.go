@@ -0,0 +1,277 @@
+package funding
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/data/stores/market"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/scheduler"
+)
+
+// Rate is one exchange's current funding rate for an asset, with the rate
+// scaled to a year so exchanges with different periods compare directly
+type Rate struct {
+	Exchange connector.ExchangeName
+	Asset    portfolio.Asset
+
+	// Rate is as quoted, per Period
+	Rate   numerical.Decimal
+	Period time.Duration
+
+	// Annualized is Rate compounded simply over a year; positive means
+	// longs pay shorts
+	Annualized numerical.Decimal
+
+	NextFundingTime time.Time
+	FetchedAt       time.Time
+}
+
+// Spread is the best funding carry for an asset: long where funding is
+// cheapest and short where it pays most
+type Spread struct {
+	Asset portfolio.Asset
+	Long  Rate
+	Short Rate
+
+	// Annualized is what the pair collects per year, Short minus Long
+	Annualized numerical.Decimal
+}
+
+// FundingRateFeed pulls current funding rates from every ready connector
+// concurrently, writes them to the market store, and answers which pair of
+// exchanges offers the widest funding spread per asset
+type FundingRateFeed interface {
+	Configure(config Config)
+
+	Start() error
+	Stop() error
+
+	// Refresh pulls every connector now; connectors that fail are skipped
+	// and the error names them
+	Refresh() error
+
+	Rate(asset portfolio.Asset, exchange connector.ExchangeName) (Rate, bool)
+	Rates(asset portfolio.Asset) []Rate
+
+	// BestSpread needs rates from at least two exchanges for the asset
+	BestSpread(asset portfolio.Asset) (Spread, bool)
+
+	// Spreads returns the best spread for every asset, widest first
+	Spreads() []Spread
+}
+
+type rateKey struct {
+	asset    string
+	exchange connector.ExchangeName
+}
+
+type fundingRateFeed struct {
+	registry     registry.ConnectorRegistry
+	store        market.MarketData
+	scheduler    scheduler.Scheduler
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config Config
+	rates  map[rateKey]Rate
+	mu     sync.RWMutex
+}
+
+func NewFundingRateFeed(
+	connectorRegistry registry.ConnectorRegistry,
+	store market.MarketData,
+	jobScheduler scheduler.Scheduler,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) FundingRateFeed {
+	return &fundingRateFeed{
+		registry:     connectorRegistry,
+		store:        store,
+		scheduler:    jobScheduler,
+		timeProvider: timeProvider,
+		logger:       logger,
+		config:       DefaultConfig(),
+		rates:        make(map[rateKey]Rate),
+	}
+}
+
+func (f *fundingRateFeed) Configure(config Config) {
+	if config.Interval <= 0 {
+		config.Interval = DefaultInterval
+	}
+	periods := make(map[connector.ExchangeName]time.Duration, len(DefaultFundingPeriods)+len(config.FundingPeriods))
+	for exchange, period := range DefaultFundingPeriods {
+		periods[exchange] = period
+	}
+	for exchange, period := range config.FundingPeriods {
+		if period > 0 {
+			periods[exchange] = period
+		}
+	}
+	config.FundingPeriods = periods
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.config = config
+}
+
+func (f *fundingRateFeed) Start() error {
+	f.mu.RLock()
+	interval := f.config.Interval
+	f.mu.RUnlock()
+
+	return f.scheduler.Register(scheduler.Job{
+		Name:       JobName,
+		Interval:   interval,
+		RunOnStart: true,
+		Run: func(_ context.Context) error {
+			return f.Refresh()
+		},
+	})
+}
+
+func (f *fundingRateFeed) Stop() error {
+	return f.scheduler.Unregister(JobName)
+}
+
+type fetchResult struct {
+	exchange connector.ExchangeName
+	rates    map[portfolio.Asset]connector.FundingRate
+	err      error
+}
+
+func (f *fundingRateFeed) Refresh() error {
+	f.mu.RLock()
+	periods := f.config.FundingPeriods
+	f.mu.RUnlock()
+
+	var conns []connector.Connector
+	for _, conn := range f.registry.GetReadyConnectors() {
+		if conn.SupportsFundingRates() {
+			conns = append(conns, conn)
+		}
+	}
+
+	results := make(chan fetchResult, len(conns))
+	var wg sync.WaitGroup
+	for _, conn := range conns {
+		wg.Add(1)
+		go func(conn connector.Connector) {
+			defer wg.Done()
+			rates, err := conn.FetchCurrentFundingRates()
+			results <- fetchResult{exchange: conn.GetConnectorInfo().Name, rates: rates, err: err}
+		}(conn)
+	}
+	wg.Wait()
+	close(results)
+
+	now := f.timeProvider.Now()
+	var failed []string
+	for result := range results {
+		if result.err != nil {
+			f.logger.Warn("Funding rates unavailable from %s: %v", result.exchange, result.err)
+			failed = append(failed, string(result.exchange))
+			continue
+		}
+
+		f.store.UpdateFundingRates(result.exchange, result.rates)
+
+		period, ok := periods[result.exchange]
+		if !ok {
+			period = DefaultFundingPeriod
+		}
+		perYear := numerical.NewFromFloat(hoursPerYear / period.Hours())
+
+		f.mu.Lock()
+		for asset, rate := range result.rates {
+			f.rates[rateKey{asset.Symbol(), result.exchange}] = Rate{
+				Exchange:        result.exchange,
+				Asset:           asset,
+				Rate:            rate.CurrentRate,
+				Period:          period,
+				Annualized:      rate.CurrentRate.Mul(perYear),
+				NextFundingTime: rate.NextFundingTime,
+				FetchedAt:       now,
+			}
+		}
+		f.mu.Unlock()
+	}
+
+	if len(failed) > 0 {
+		sort.Strings(failed)
+		return fmt.Errorf("funding rates unavailable from %v", failed)
+	}
+	return nil
+}
+
+func (f *fundingRateFeed) Rate(asset portfolio.Asset, exchange connector.ExchangeName) (Rate, bool) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	rate, ok := f.rates[rateKey{asset.Symbol(), exchange}]
+	return rate, ok
+}
+
+func (f *fundingRateFeed) Rates(asset portfolio.Asset) []Rate {
+	f.mu.RLock()
+	rates := make([]Rate, 0)
+	for key, rate := range f.rates {
+		if key.asset == asset.Symbol() {
+			rates = append(rates, rate)
+		}
+	}
+	f.mu.RUnlock()
+
+	sort.Slice(rates, func(i, j int) bool {
+		return rates[i].Annualized.LessThan(rates[j].Annualized)
+	})
+	return rates
+}
+
+func (f *fundingRateFeed) BestSpread(asset portfolio.Asset) (Spread, bool) {
+	rates := f.Rates(asset)
+	if len(rates) < 2 {
+		return Spread{}, false
+	}
+
+	// Rates are sorted cheapest first: go long at the bottom, short at the top
+	long, short := rates[0], rates[len(rates)-1]
+	return Spread{
+		Asset:      asset,
+		Long:       long,
+		Short:      short,
+		Annualized: short.Annualized.Sub(long.Annualized),
+	}, true
+}
+
+func (f *fundingRateFeed) Spreads() []Spread {
+	f.mu.RLock()
+	assets := make(map[string]portfolio.Asset)
+	for _, rate := range f.rates {
+		assets[rate.Asset.Symbol()] = rate.Asset
+	}
+	f.mu.RUnlock()
+
+	spreads := make([]Spread, 0, len(assets))
+	for _, asset := range assets {
+		if spread, ok := f.BestSpread(asset); ok {
+			spreads = append(spreads, spread)
+		}
+	}
+
+	sort.Slice(spreads, func(i, j int) bool {
+		if !spreads[i].Annualized.Equal(spreads[j].Annualized) {
+			return spreads[i].Annualized.GreaterThan(spreads[j].Annualized)
+		}
+		return spreads[i].Asset.Symbol() < spreads[j].Asset.Symbol()
+	})
+	return spreads
+}
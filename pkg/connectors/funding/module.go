@@ -0,0 +1,7 @@
+package funding
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(NewFundingRateFeed),
+)
@@ -0,0 +1,45 @@
+package health
+
+import "time"
+
+const (
+	// DefaultInterval is how often every registered connector is probed
+	DefaultInterval = 15 * time.Second
+
+	// DefaultMaxRESTLatency is the probe round trip above which a connector is degraded
+	DefaultMaxRESTLatency = 2 * time.Second
+
+	// DefaultMaxErrorRate is WebSocket connection errors per minute above
+	// which a connector is degraded
+	DefaultMaxErrorRate = 5.0
+
+	// DefaultMinHeadroom is the rate-limit budget fraction below which a
+	// connector is degraded
+	DefaultMinHeadroom = 0.1
+
+	// DefaultProbeAsset is priced to measure REST latency
+	DefaultProbeAsset = "BTC"
+
+	// JobName is the scheduler job the monitor registers under
+	JobName = "connector-health"
+)
+
+// Config controls health probing and degradation thresholds
+type Config struct {
+	Interval       time.Duration
+	MaxRESTLatency time.Duration
+	MaxErrorRate   float64
+	MinHeadroom    float64
+	ProbeAsset     string
+}
+
+// DefaultConfig probes every fifteen seconds by pricing BTC
+func DefaultConfig() Config {
+	return Config{
+		Interval:       DefaultInterval,
+		MaxRESTLatency: DefaultMaxRESTLatency,
+		MaxErrorRate:   DefaultMaxErrorRate,
+		MinHeadroom:    DefaultMinHeadroom,
+		ProbeAsset:     DefaultProbeAsset,
+	}
+}
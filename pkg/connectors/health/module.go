@@ -0,0 +1,7 @@
+package health
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(NewHealthService),
+)
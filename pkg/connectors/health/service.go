@@ -0,0 +1,354 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+	"github.com/backtesting-org/live-trading/pkg/scheduler"
+)
+
+// Status is a connector's aggregate health
+type Status string
+
+const (
+	StatusHealthy  Status = "healthy"
+	StatusDegraded Status = "degraded"
+	StatusDown     Status = "down"
+)
+
+// severity orders statuses so transitions can be classed as worse or better
+var severity = map[Status]int{StatusHealthy: 0, StatusDegraded: 1, StatusDown: 2}
+
+// ConnectorHealth is one probe's view of a connector
+type ConnectorHealth struct {
+	Exchange connector.ExchangeName `json:"exchange"`
+	Status   Status                 `json:"status"`
+	Ready    bool                   `json:"ready"`
+
+	// WebSocketConnected is nil for connectors without real-time data
+	WebSocketConnected *bool `json:"websocket_connected,omitempty"`
+
+	RESTLatency time.Duration `json:"rest_latency"`
+	RESTError   string        `json:"rest_error,omitempty"`
+
+	// ErrorRate is WebSocket connection errors per minute since the last probe
+	ErrorRate float64 `json:"error_rate"`
+
+	// RateLimitHeadroom is nil for connectors that do not track their budget
+	RateLimitHeadroom *float64 `json:"rate_limit_headroom,omitempty"`
+
+	Reasons   []string  `json:"reasons,omitempty"`
+	CheckedAt time.Time `json:"checked_at"`
+}
+
+// Alert is raised whenever a connector's status changes
+type Alert struct {
+	Exchange connector.ExchangeName
+	From     Status
+	To       Status
+	Reasons  []string
+	At       time.Time
+}
+
+// Degraded reports whether the change made the connector less healthy
+func (a Alert) Degraded() bool {
+	return severity[a.To] > severity[a.From]
+}
+
+// HealthService probes every registered connector and aggregates
+// WebSocket state, REST latency, stream error rates and rate-limit headroom
+type HealthService interface {
+	Configure(config Config)
+
+	// Start registers the probe job with the scheduler
+	Start() error
+	Stop() error
+
+	// Check probes every registered connector now
+	Check() []ConnectorHealth
+
+	Health(exchange connector.ExchangeName) (ConnectorHealth, bool)
+	Snapshot() []ConnectorHealth
+	Alerts() <-chan Alert
+
+	// Handler serves the latest snapshot as JSON, with 503 when any ready
+	// connector is down
+	Handler() http.Handler
+}
+
+// errorSample is the last cumulative error count seen for a connector
+type errorSample struct {
+	count float64
+	at    time.Time
+}
+
+type healthService struct {
+	registry     registry.ConnectorRegistry
+	scheduler    scheduler.Scheduler
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config  Config
+	latest  map[connector.ExchangeName]ConnectorHealth
+	errors  map[connector.ExchangeName]errorSample
+	alertCh chan Alert
+	mu      sync.Mutex
+}
+
+func NewHealthService(
+	connectorRegistry registry.ConnectorRegistry,
+	jobScheduler scheduler.Scheduler,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) HealthService {
+	return &healthService{
+		registry:     connectorRegistry,
+		scheduler:    jobScheduler,
+		timeProvider: timeProvider,
+		logger:       logger,
+		config:       DefaultConfig(),
+		latest:       make(map[connector.ExchangeName]ConnectorHealth),
+		errors:       make(map[connector.ExchangeName]errorSample),
+		alertCh:      make(chan Alert, 100),
+	}
+}
+
+func (h *healthService) Configure(config Config) {
+	defaults := DefaultConfig()
+	if config.Interval <= 0 {
+		config.Interval = defaults.Interval
+	}
+	if config.MaxRESTLatency <= 0 {
+		config.MaxRESTLatency = defaults.MaxRESTLatency
+	}
+	if config.MaxErrorRate <= 0 {
+		config.MaxErrorRate = defaults.MaxErrorRate
+	}
+	if config.MinHeadroom < 0 {
+		config.MinHeadroom = 0
+	}
+	if config.ProbeAsset == "" {
+		config.ProbeAsset = defaults.ProbeAsset
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.config = config
+}
+
+func (h *healthService) Alerts() <-chan Alert {
+	return h.alertCh
+}
+
+func (h *healthService) Start() error {
+	h.mu.Lock()
+	interval := h.config.Interval
+	h.mu.Unlock()
+
+	return h.scheduler.Register(scheduler.Job{
+		Name:       JobName,
+		Interval:   interval,
+		RunOnStart: true,
+		Run: func(_ context.Context) error {
+			h.Check()
+			return nil
+		},
+	})
+}
+
+func (h *healthService) Stop() error {
+	return h.scheduler.Unregister(JobName)
+}
+
+func (h *healthService) Check() []ConnectorHealth {
+	h.mu.Lock()
+	config := h.config
+	h.mu.Unlock()
+
+	var results []ConnectorHealth
+	for _, conn := range h.registry.GetAvailableConnectors() {
+		info := conn.GetConnectorInfo()
+		if info == nil {
+			continue
+		}
+
+		result := h.probe(conn, info.Name, config)
+		h.record(result)
+		results = append(results, result)
+	}
+
+	sort.Slice(results, func(i, j int) bool { return results[i].Exchange < results[j].Exchange })
+	return results
+}
+
+// probe gathers one connector's signals; connectors that are not ready are
+// reported but never probed, since they have no credentials or streams yet
+func (h *healthService) probe(conn connector.Connector, name connector.ExchangeName, config Config) ConnectorHealth {
+	now := h.timeProvider.Now()
+	result := ConnectorHealth{
+		Exchange:  name,
+		Status:    StatusHealthy,
+		Ready:     h.registry.IsConnectorReady(name),
+		CheckedAt: now,
+	}
+
+	if !result.Ready {
+		result.Reasons = append(result.Reasons, "not ready")
+		return result
+	}
+
+	degrade := func(status Status, reason string) {
+		if severity[status] > severity[result.Status] {
+			result.Status = status
+		}
+		result.Reasons = append(result.Reasons, reason)
+	}
+
+	started := time.Now()
+	_, err := conn.FetchPrice(conn.GetPerpSymbol(portfolio.NewAsset(config.ProbeAsset)))
+	result.RESTLatency = time.Since(started)
+	switch {
+	case err != nil:
+		result.RESTError = err.Error()
+		degrade(StatusDown, fmt.Sprintf("REST probe failed: %v", err))
+	case result.RESTLatency > config.MaxRESTLatency:
+		degrade(StatusDegraded, fmt.Sprintf("REST latency %s above %s", result.RESTLatency.Round(time.Millisecond), config.MaxRESTLatency))
+	}
+
+	if ws, ok := conn.(connector.WebSocketConnector); ok && conn.SupportsRealTimeData() {
+		connected := ws.IsWebSocketConnected()
+		result.WebSocketConnected = &connected
+		if !connected {
+			degrade(StatusDegraded, "websocket disconnected")
+		}
+	}
+
+	if provider, ok := conn.(types.WebSocketMetricsProvider); ok {
+		if count, ok := counterValue(provider.GetWebSocketMetrics()["connection_errors"]); ok {
+			result.ErrorRate = h.errorRate(name, count, now)
+			if result.ErrorRate > config.MaxErrorRate {
+				degrade(StatusDegraded, fmt.Sprintf("%.1f websocket errors/min above %.1f", result.ErrorRate, config.MaxErrorRate))
+			}
+		}
+	}
+
+	if provider, ok := conn.(types.RateLimitProvider); ok {
+		headroom := provider.RateLimitStatus().Headroom()
+		result.RateLimitHeadroom = &headroom
+		if headroom < config.MinHeadroom {
+			degrade(StatusDegraded, fmt.Sprintf("rate-limit headroom %.0f%% below %.0f%%", headroom*100, config.MinHeadroom*100))
+		}
+	}
+
+	return result
+}
+
+// errorRate converts a cumulative error counter into errors per minute
+// since the previous probe
+func (h *healthService) errorRate(name connector.ExchangeName, count float64, now time.Time) float64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	previous, seen := h.errors[name]
+	h.errors[name] = errorSample{count: count, at: now}
+
+	elapsed := now.Sub(previous.at).Minutes()
+	// Counters reset when a connector rebuilds its stream
+	if !seen || elapsed <= 0 || count < previous.count {
+		return 0
+	}
+	return (count - previous.count) / elapsed
+}
+
+func (h *healthService) record(result ConnectorHealth) {
+	h.mu.Lock()
+	previous, seen := h.latest[result.Exchange]
+	h.latest[result.Exchange] = result
+	h.mu.Unlock()
+
+	if !seen || previous.Status == result.Status || !result.Ready {
+		return
+	}
+
+	alert := Alert{
+		Exchange: result.Exchange,
+		From:     previous.Status,
+		To:       result.Status,
+		Reasons:  result.Reasons,
+		At:       result.CheckedAt,
+	}
+
+	if alert.Degraded() {
+		h.logger.Warn("⚠️ Connector %s %s -> %s: %v", alert.Exchange, alert.From, alert.To, alert.Reasons)
+	} else {
+		h.logger.Info("Connector %s recovered %s -> %s", alert.Exchange, alert.From, alert.To)
+	}
+
+	select {
+	case h.alertCh <- alert:
+	default:
+		h.logger.Warn("Health alert channel full, dropping alert for %s", alert.Exchange)
+	}
+}
+
+func (h *healthService) Health(exchange connector.ExchangeName) (ConnectorHealth, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	result, ok := h.latest[exchange]
+	return result, ok
+}
+
+func (h *healthService) Snapshot() []ConnectorHealth {
+	h.mu.Lock()
+	results := make([]ConnectorHealth, 0, len(h.latest))
+	for _, result := range h.latest {
+		results = append(results, result)
+	}
+	h.mu.Unlock()
+
+	sort.Slice(results, func(i, j int) bool { return results[i].Exchange < results[j].Exchange })
+	return results
+}
+
+func (h *healthService) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		snapshot := h.Snapshot()
+
+		code := http.StatusOK
+		for _, result := range snapshot {
+			if result.Ready && result.Status == StatusDown {
+				code = http.StatusServiceUnavailable
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		_ = json.NewEncoder(w).Encode(snapshot)
+	})
+}
+
+// counterValue converts the loosely typed counters found in GetMetrics maps
+func counterValue(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
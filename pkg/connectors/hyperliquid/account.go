@@ -7,11 +7,15 @@ import (
 	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
 	"github.com/backtesting-org/live-trading/pkg/connectors/types"
 )
 
 func (h *hyperliquid) GetAccountBalance() (*connector.AccountBalance, error) {
-	userState, err := h.marketData.GetUserState(h.config.AccountAddress)
+	if err := h.limiter.Wait(ratelimit.EndpointAccount); err != nil {
+		return nil, err
+	}
+	userState, err := h.marketData.GetUserState(h.account)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user state: %w", err)
 	}
@@ -30,7 +34,10 @@ func (h *hyperliquid) GetAccountBalance() (*connector.AccountBalance, error) {
 
 // GetPositions retrieves all positions from UserState and remaps them to connector.Position
 func (h *hyperliquid) GetPositions() ([]connector.Position, error) {
-	userState, err := h.marketData.GetUserState(h.config.AccountAddress)
+	if err := h.limiter.Wait(ratelimit.EndpointAccount); err != nil {
+		return nil, err
+	}
+	userState, err := h.marketData.GetUserState(h.account)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user state: %w", err)
 	}
@@ -92,7 +99,10 @@ func (h *hyperliquid) GetPositions() ([]connector.Position, error) {
 
 // GetTradingHistory retrieves trading history for the specified symbol
 func (h *hyperliquid) GetTradingHistory(symbol string, limit int) ([]connector.Trade, error) {
-	fills, err := h.marketData.GetUserFills(h.config.AccountAddress)
+	if err := h.limiter.Wait(ratelimit.EndpointTrades); err != nil {
+		return nil, err
+	}
+	fills, err := h.marketData.GetUserFills(h.account)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user fills: %w", err)
 	}
@@ -128,7 +138,7 @@ func (h *hyperliquid) GetTradingHistory(symbol string, limit int) ([]connector.T
 			Quantity:  quantity,
 			Fee:       numerical.Zero(),             // Hyperliquid doesn't provide fee in Fill
 			Timestamp: time.Unix(fill.Time/1000, 0), // Convert milliseconds to seconds
-			IsMaker:   false,                        // Can't determine from Fill struct
+			IsMaker:   !fill.Crossed,                // crossed means the fill took liquidity
 		})
 	}
 
@@ -5,10 +5,14 @@ import (
 	"strings"
 
 	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
 )
 
 // FetchAvailableSpotAssets fetches all available spot assets from Hyperliquid
 func (h *hyperliquid) FetchAvailableSpotAssets() ([]portfolio.Asset, error) {
+	if err := h.limiter.Wait(ratelimit.EndpointInstruments); err != nil {
+		return nil, err
+	}
 	spotMeta, err := h.marketData.GetSpotMeta()
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch spot meta: %w", err)
@@ -27,6 +31,9 @@ func (h *hyperliquid) FetchAvailableSpotAssets() ([]portfolio.Asset, error) {
 
 // FetchAvailablePerpetualAssets fetches all available perpetual assets from Hyperliquid
 func (h *hyperliquid) FetchAvailablePerpetualAssets() ([]portfolio.Asset, error) {
+	if err := h.limiter.Wait(ratelimit.EndpointInstruments); err != nil {
+		return nil, err
+	}
 	meta, err := h.marketData.GetMeta()
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch perpetual meta: %w", err)
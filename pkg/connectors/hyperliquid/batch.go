@@ -0,0 +1,145 @@
+package hyperliquid
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+	hl "github.com/sonirico/go-hyperliquid"
+)
+
+var _ types.BatchOrderProvider = (*hyperliquid)(nil)
+
+// MaxBatchSize is unbounded: a bulk action is one request for the IP limit
+// however many orders it carries, though each still counts against the
+// address budget
+func (h *hyperliquid) MaxBatchSize() int {
+	return 0
+}
+
+// PlaceOrders sends GTC limit orders as one bulk order action
+func (h *hyperliquid) PlaceOrders(requests []types.OrderRequest) ([]types.OrderResult, error) {
+	orders := make([]hl.CreateOrderRequest, len(requests))
+	for i, request := range requests {
+		if request.Type != connector.OrderTypeLimit {
+			return nil, fmt.Errorf("batch order %d: only limit orders can be batched", i)
+		}
+		if err := request.Validate(); err != nil {
+			return nil, fmt.Errorf("batch order %d: %w", i, err)
+		}
+		orders[i] = hl.CreateOrderRequest{
+			Coin:  request.Symbol,
+			IsBuy: request.Side == connector.OrderSideBuy,
+			Price: request.Price.InexactFloat64(),
+			Size:  request.Quantity.InexactFloat64(),
+			OrderType: hl.OrderType{
+				Limit: &hl.LimitOrderType{Tif: hl.TifGtc},
+			},
+		}
+	}
+
+	if err := h.limiter.Wait(ratelimit.EndpointPlaceOrder); err != nil {
+		return nil, err
+	}
+	if !h.SupportsTradingOperations() {
+		return nil, fmt.Errorf("trading operations not supported")
+	}
+
+	// The SDK reports the first rejected order as an error on an otherwise
+	// complete response, so the statuses decide what failed
+	resp, err := h.trading.PlaceBulkOrders(orders)
+	if resp == nil || len(resp.Data.Statuses) != len(requests) {
+		if err == nil {
+			err = fmt.Errorf("bulk order response carried no per-order statuses")
+		}
+		return nil, types.NewConnectorError(types.Hyperliquid, "place batch orders", err)
+	}
+
+	now := h.timeProvider.Now()
+	results := make([]types.OrderResult, len(requests))
+	for i, status := range resp.Data.Statuses {
+		request := requests[i]
+		if status.Error != nil {
+			results[i].Err = h.wrapOrderError(request.Symbol, request.Side, request.Quantity, request.Price, fmt.Errorf("%s", *status.Error))
+			continue
+		}
+
+		response := &connector.OrderResponse{
+			Symbol:    request.Symbol,
+			Status:    connector.OrderStatusNew,
+			Side:      request.Side,
+			Type:      connector.OrderTypeLimit,
+			Quantity:  request.Quantity,
+			Price:     request.Price,
+			Timestamp: now,
+		}
+		switch {
+		case status.Resting != nil:
+			response.OrderID = strconv.FormatInt(status.Resting.Oid, 10)
+		case status.Filled != nil:
+			response.OrderID = strconv.Itoa(status.Filled.Oid)
+			response.Status = connector.OrderStatusFilled
+		}
+		h.recordBuilderFee(response.OrderID)
+		results[i].Response = response
+	}
+	return results, nil
+}
+
+// CancelOrders sends every cancel as one bulk cancel action
+func (h *hyperliquid) CancelOrders(symbol string, orderIDs []string) ([]types.CancelResult, error) {
+	cancels := make([]hl.CancelOrderRequest, len(orderIDs))
+	for i, orderID := range orderIDs {
+		oid, err := strconv.ParseInt(orderID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid order ID format %q: %w", orderID, err)
+		}
+		cancels[i] = hl.CancelOrderRequest{Coin: symbol, OrderID: oid}
+	}
+
+	if err := h.limiter.Wait(ratelimit.EndpointCancelOrder); err != nil {
+		return nil, err
+	}
+	if !h.SupportsTradingOperations() {
+		return nil, fmt.Errorf("trading operations not supported")
+	}
+
+	resp, err := h.trading.CancelOrdersByID(cancels)
+	if err == nil && resp == nil {
+		err = fmt.Errorf("bulk cancel returned no response")
+	}
+	if err != nil {
+		return nil, types.NewConnectorError(types.Hyperliquid, "cancel batch orders", err)
+	}
+
+	now := h.timeProvider.Now()
+	results := make([]types.CancelResult, len(orderIDs))
+	for i, orderID := range orderIDs {
+		results[i].OrderID = orderID
+		if i >= len(resp.Data.Statuses) {
+			results[i].Err = fmt.Errorf("no status for cancel of %s", orderID)
+			continue
+		}
+		// Each status is "success" or an object carrying the error
+		status := resp.Data.Statuses[i]
+		if text, ok := status.String(); !ok || text != "success" {
+			reason := "cancel rejected"
+			if object, ok := status.Object(); ok {
+				if message, ok := object["error"].(string); ok {
+					reason = message
+				}
+			}
+			results[i].Err = fmt.Errorf("failed to cancel order %s: %s", orderID, reason)
+			continue
+		}
+		results[i].Response = &connector.CancelResponse{
+			OrderID:   orderID,
+			Symbol:    symbol,
+			Status:    connector.OrderStatusCanceled,
+			Timestamp: now,
+		}
+	}
+	return results, nil
+}
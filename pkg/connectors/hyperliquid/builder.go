@@ -0,0 +1,72 @@
+package hyperliquid
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+	hl "github.com/sonirico/go-hyperliquid"
+)
+
+var _ types.BuilderFeeProvider = (*hyperliquid)(nil)
+
+// maxBuilderFeeRecords bounds the per-order builder fee record; fills
+// arrive long before this many newer orders have been placed
+const maxBuilderFeeRecords = 10000
+
+// configureBuilder attaches the configured builder code to every order and
+// registers the referral code. Both are best effort: the account's own key
+// must approve the builder fee, so with an agent key the approval fails here
+// and orders are rejected until it is approved from the main wallet.
+func (h *hyperliquid) configureBuilder(config *Config) {
+	if config.BuilderAddress != "" {
+		builder := strings.ToLower(config.BuilderAddress)
+		maxFeeRate := strconv.FormatFloat(float64(config.BuilderFee)/1000, 'f', -1, 64) + "%"
+		if err := h.trading.ApproveBuilderFee(builder, maxFeeRate); err != nil {
+			h.appLogger.Warn("Hyperliquid builder fee for %s not approved: %v", builder, err)
+		}
+
+		h.trading.SetBuilder(&hl.BuilderInfo{Builder: builder, Fee: config.BuilderFee})
+		h.appLogger.Info("Hyperliquid orders carry builder %s at %s", builder, maxFeeRate)
+	}
+
+	if config.ReferralCode != "" {
+		if err := h.trading.SetReferrer(config.ReferralCode); err != nil {
+			h.appLogger.Warn("Hyperliquid referral code %s not set: %v", config.ReferralCode, err)
+		}
+	}
+}
+
+// recordBuilderFee remembers the builder fee an order was placed with
+func (h *hyperliquid) recordBuilderFee(orderID string) {
+	if orderID == "" || h.config == nil || h.config.BuilderAddress == "" {
+		return
+	}
+
+	h.builderMu.Lock()
+	defer h.builderMu.Unlock()
+
+	if _, ok := h.builderFees[orderID]; !ok {
+		h.builderFeeOrder = append(h.builderFeeOrder, orderID)
+	}
+	h.builderFees[orderID] = types.BuilderFee{
+		Builder:   strings.ToLower(h.config.BuilderAddress),
+		TenthsBps: h.config.BuilderFee,
+	}
+
+	if overflow := len(h.builderFeeOrder) - maxBuilderFeeRecords; overflow > 0 {
+		for _, id := range h.builderFeeOrder[:overflow] {
+			delete(h.builderFees, id)
+		}
+		h.builderFeeOrder = h.builderFeeOrder[overflow:]
+	}
+}
+
+// AppliedBuilderFee returns the builder fee an order was placed with
+func (h *hyperliquid) AppliedBuilderFee(orderID string) (types.BuilderFee, bool) {
+	h.builderMu.Lock()
+	defer h.builderMu.Unlock()
+
+	fee, ok := h.builderFees[orderID]
+	return fee, ok
+}
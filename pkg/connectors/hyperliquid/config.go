@@ -5,23 +5,54 @@ import (
 
 	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
 	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 type Config struct {
-	BaseURL         string  `json:"base_url,omitempty"`
-	PrivateKey      string  `json:"private_key"`
-	AccountAddress  string  `json:"account_address"`
-	VaultAddress    string  `json:"vault_address,omitempty"`
+	BaseURL        string `json:"base_url,omitempty"`
+	PrivateKey     string `json:"private_key"`
+	AccountAddress string `json:"account_address"`
+
+	// VaultAddress trades a vault the account leads: orders are signed on
+	// its behalf and balances, positions and fills are read from it
+	VaultAddress string `json:"vault_address,omitempty"`
+
+	// SubAccount trades one of AccountAddress's sub-accounts, given by name
+	// or address, the same way; it is resolved on initialize and cannot be
+	// combined with VaultAddress
+	SubAccount string `json:"sub_account,omitempty"`
+
 	UseTestnet      bool    `json:"use_testnet,omitempty"`
 	DefaultSlippage float64 `json:"default_slippage,omitempty"` // Default slippage for market orders (0.005 = 0.5%)
+
+	// BuilderAddress, when set, attaches a builder code to every order;
+	// BuilderFee is its fee in tenths of a basis point (10 = 0.01%)
+	BuilderAddress string `json:"builder_address,omitempty"`
+	BuilderFee     int    `json:"builder_fee,omitempty"`
+
+	// ReferralCode is registered for the account on initialize; the
+	// exchange keeps the first code an account sets
+	ReferralCode string `json:"referral_code,omitempty"`
 }
 
+// maxBuilderFee is the exchange's cap on perpetual builder fees, 0.1%
+const maxBuilderFee = 100
+
 var _ connector.Config = (*Config)(nil)
+var _ types.SecretConfig = (*Config)(nil)
 
 func (c *Config) ExchangeName() connector.ExchangeName {
 	return types.Hyperliquid
 }
 
+func (c *Config) UsesTestnet() bool {
+	return c.UseTestnet
+}
+
+func (c *Config) SecretFields() map[string]*string {
+	return map[string]*string{"private_key": &c.PrivateKey}
+}
+
 func (c *Config) Validate() error {
 	if c.PrivateKey == "" {
 		return fmt.Errorf("private_key is required")
@@ -30,6 +61,15 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("account_address is required")
 	}
 
+	if c.VaultAddress != "" {
+		if !common.IsHexAddress(c.VaultAddress) {
+			return fmt.Errorf("vault_address must be a hex address, got: %s", c.VaultAddress)
+		}
+		if c.SubAccount != "" {
+			return fmt.Errorf("vault_address and sub_account are mutually exclusive")
+		}
+	}
+
 	if c.UseTestnet {
 		c.BaseURL = "https://api.hyperliquid-testnet.xyz"
 	} else if c.BaseURL == "" {
@@ -46,5 +86,16 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("default_slippage must be between 0 and 0.1 (0-10%%), got: %f", c.DefaultSlippage)
 	}
 
+	if c.BuilderAddress != "" {
+		if !common.IsHexAddress(c.BuilderAddress) {
+			return fmt.Errorf("builder_address must be a hex address, got: %s", c.BuilderAddress)
+		}
+		if c.BuilderFee <= 0 || c.BuilderFee > maxBuilderFee {
+			return fmt.Errorf("builder_fee must be between 1 and %d tenths of a basis point, got: %d", maxBuilderFee, c.BuilderFee)
+		}
+	} else if c.BuilderFee != 0 {
+		return fmt.Errorf("builder_fee requires builder_address")
+	}
+
 	return nil
 }
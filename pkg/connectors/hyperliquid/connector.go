@@ -2,6 +2,7 @@ package hyperliquid
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
@@ -10,6 +11,8 @@ import (
 	"github.com/backtesting-org/live-trading/pkg/connectors/hyperliquid/adaptors"
 	"github.com/backtesting-org/live-trading/pkg/connectors/hyperliquid/rest"
 	"github.com/backtesting-org/live-trading/pkg/connectors/hyperliquid/websocket"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
 )
 
 // hyperliquid implements Connector and Initializable interfaces
@@ -23,11 +26,17 @@ type hyperliquid struct {
 	appLogger      logging.ApplicationLogger
 	tradingLogger  logging.TradingLogger
 	timeProvider   temporal.TimeProvider
+	limiter        ratelimit.Limiter
 	initialized    bool
 
+	// account is the address orders trade and account data is read from:
+	// the vault or sub-account when one is configured, else AccountAddress
+	account string
+
 	// WebSocket channels
 	tradeCh    chan connector.Trade
 	positionCh chan connector.Position
+	fillCh     chan connector.Trade
 	balanceCh  chan connector.AccountBalance
 	errorCh    chan error
 
@@ -42,11 +51,21 @@ type hyperliquid struct {
 	// Subscription tracking
 	subscriptions map[string]int
 	subMu         sync.RWMutex
+
+	// Builder fee each order was placed with, oldest first in builderFeeOrder
+	builderFees     map[string]types.BuilderFee
+	builderFeeOrder []string
+	builderMu       sync.Mutex
+
+	// payloads holds Hyperliquid's own response about each recent order
+	payloads *types.OrderPayloads
 }
 
 // Ensure hyperliquid implements all interfaces at compile time
 var _ connector.Connector = (*hyperliquid)(nil)
 var _ connector.WebSocketConnector = (*hyperliquid)(nil)
+var _ types.FillStreamer = (*hyperliquid)(nil)
+var _ types.OrderPayloadSource = (*hyperliquid)(nil)
 
 // NewHyperliquid creates a new Hyperliquid connector
 func NewHyperliquid(
@@ -58,6 +77,7 @@ func NewHyperliquid(
 	appLogger logging.ApplicationLogger,
 	tradingLogger logging.TradingLogger,
 	timeProvider temporal.TimeProvider,
+	limiters ratelimit.RateLimiters,
 ) connector.Connector {
 	return &hyperliquid{
 		exchangeClient:    exchangeClient,
@@ -69,14 +89,18 @@ func NewHyperliquid(
 		appLogger:         appLogger,
 		tradingLogger:     tradingLogger,
 		timeProvider:      timeProvider,
+		limiter:           limiters.For(types.Hyperliquid),
 		initialized:       false,
 		tradeCh:           make(chan connector.Trade, 100),
 		positionCh:        make(chan connector.Position, 100),
+		fillCh:            make(chan connector.Trade, 100),
 		balanceCh:         make(chan connector.AccountBalance, 100),
 		orderBookChannels: make(map[string]chan connector.OrderBook),
 		klineChannels:     make(map[string]chan connector.Kline),
 		errorCh:           make(chan error, 100),
 		subscriptions:     make(map[string]int),
+		builderFees:       make(map[string]types.BuilderFee),
+		payloads:          types.NewOrderPayloads(types.DefaultPayloadCapacity),
 	}
 }
 
@@ -91,15 +115,25 @@ func (h *hyperliquid) Initialize(config connector.Config) error {
 		return fmt.Errorf("invalid config type for Hyperliquid connector: expected *hyperliquid.Config, got %T", config)
 	}
 
-	// Configure the existing clients with runtime config
-	if err := h.exchangeClient.Configure(hlConfig.BaseURL, hlConfig.PrivateKey, hlConfig.VaultAddress, hlConfig.AccountAddress); err != nil {
-		return fmt.Errorf("failed to configure exchange client: %w", err)
-	}
-
+	// Configure the existing clients with runtime config. The info client
+	// comes first so a sub-account can be resolved before orders are signed.
 	if err := h.infoClient.Configure(hlConfig.BaseURL); err != nil {
 		return fmt.Errorf("failed to configure info client: %w", err)
 	}
 
+	vault, err := h.resolveVault(hlConfig)
+	if err != nil {
+		return err
+	}
+	account := hlConfig.AccountAddress
+	if vault != "" {
+		account = vault
+	}
+
+	if err := h.exchangeClient.Configure(hlConfig.BaseURL, hlConfig.PrivateKey, vault, account); err != nil {
+		return fmt.Errorf("failed to configure exchange client: %w", err)
+	}
+
 	// Initialize trading service to load asset metadata for price validation
 	if tradingService, ok := h.trading.(interface{ Initialize() error }); ok {
 		if err := tradingService.Initialize(); err != nil {
@@ -107,12 +141,38 @@ func (h *hyperliquid) Initialize(config connector.Config) error {
 		}
 	}
 
+	h.configureBuilder(hlConfig)
+
 	h.config = hlConfig
+	h.account = account
 	h.initialized = true
-	h.appLogger.Info("Hyperliquid connector initialized", "base_url", hlConfig.BaseURL)
+	h.appLogger.Info("Hyperliquid connector initialized", "base_url", hlConfig.BaseURL, "account", account)
 	return nil
 }
 
+// resolveVault returns the address orders are signed on behalf of: the
+// configured vault, the selected sub-account's address, or empty to trade
+// the account itself
+func (h *hyperliquid) resolveVault(config *Config) (string, error) {
+	if config.VaultAddress != "" {
+		return strings.ToLower(config.VaultAddress), nil
+	}
+	if config.SubAccount == "" {
+		return "", nil
+	}
+
+	subAccounts, err := h.marketData.GetSubAccounts(config.AccountAddress)
+	if err != nil {
+		return "", fmt.Errorf("failed to list sub-accounts: %w", err)
+	}
+	for _, sub := range subAccounts {
+		if sub.Name == config.SubAccount || strings.EqualFold(sub.User, config.SubAccount) {
+			return strings.ToLower(sub.User), nil
+		}
+	}
+	return "", fmt.Errorf("sub-account %q not found for %s", config.SubAccount, config.AccountAddress)
+}
+
 // IsInitialized implements Initializable interface
 func (h *hyperliquid) IsInitialized() bool {
 	return h.initialized
@@ -7,9 +7,13 @@ import (
 	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
 )
 
 func (h *hyperliquid) FetchCurrentFundingRates() (map[portfolio.Asset]connector.FundingRate, error) {
+	if err := h.limiter.Wait(ratelimit.EndpointFunding); err != nil {
+		return nil, err
+	}
 	contexts, err := h.marketData.GetAllAssetContexts()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get asset contexts: %w", err)
@@ -60,6 +64,9 @@ func (h *hyperliquid) FetchCurrentFundingRates() (map[portfolio.Asset]connector.
 }
 
 func (h *hyperliquid) FetchFundingRate(asset portfolio.Asset) (*connector.FundingRate, error) {
+	if err := h.limiter.Wait(ratelimit.EndpointFunding); err != nil {
+		return nil, err
+	}
 	ctx, err := h.marketData.GetAssetContext(asset.Symbol())
 	if err != nil {
 		return nil, fmt.Errorf("failed to get asset context: %w", err)
@@ -90,6 +97,9 @@ func (h *hyperliquid) FetchFundingRate(asset portfolio.Asset) (*connector.Fundin
 }
 
 func (h *hyperliquid) FetchHistoricalFundingRates(symbol portfolio.Asset, startTime, endTime int64) ([]connector.HistoricalFundingRate, error) {
+	if err := h.limiter.Wait(ratelimit.EndpointFunding); err != nil {
+		return nil, err
+	}
 	rawData, err := h.marketData.GetHistoricalFundingRates(symbol.Symbol(), startTime, endTime)
 	if err != nil {
 		return nil, err
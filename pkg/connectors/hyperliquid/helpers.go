@@ -5,8 +5,10 @@ import (
 	"log"
 	"strings"
 
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
 )
 
 // normaliseAssetName converts an asset symbol to the format Hyperliquid API accepts
@@ -21,6 +23,29 @@ func (h *hyperliquid) extractOrderID(result interface{}) string {
 	return fmt.Sprintf("%d", h.timeProvider.Now().UnixNano())
 }
 
+// wrapOrderError categorises order rejections, and tags insufficient-balance
+// ones with the balance at rejection time
+func (h *hyperliquid) wrapOrderError(symbol string, side connector.OrderSide, quantity, price numerical.Decimal, err error) error {
+	if !types.IsInsufficientBalance(err) {
+		return types.NewConnectorError(types.Hyperliquid, "place order", err)
+	}
+
+	rejection := &types.InsufficientBalanceError{
+		Exchange: types.Hyperliquid,
+		Symbol:   symbol,
+		Side:     side,
+		Quantity: quantity,
+		Price:    price,
+		Err:      err,
+	}
+	if balance, balanceErr := h.GetAccountBalance(); balanceErr == nil && balance != nil {
+		rejection.Available = balance.AvailableBalance
+	}
+
+	h.appLogger.Warn("Hyperliquid rejected order for insufficient balance: %s", rejection.Error())
+	return rejection
+}
+
 // convertInterval converts standard interval format to Hyperliquid format
 func convertInterval(interval string) string {
 	switch interval {
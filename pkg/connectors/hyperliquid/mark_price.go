@@ -0,0 +1,42 @@
+package hyperliquid
+
+import (
+	"fmt"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+var _ types.MarkPriceProvider = (*hyperliquid)(nil)
+
+// FetchMarkPrice reads markPx and oraclePx from the asset context; the
+// oracle price is Hyperliquid's index
+func (h *hyperliquid) FetchMarkPrice(asset portfolio.Asset) (*types.MarkPrice, error) {
+	if err := h.limiter.Wait(ratelimit.EndpointPrice); err != nil {
+		return nil, err
+	}
+	ctx, err := h.marketData.GetAssetContext(asset.Symbol())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get asset context: %w", err)
+	}
+
+	markPrice, err := numerical.NewFromString(ctx.MarkPrice)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mark price for %s: %w", asset.Symbol(), err)
+	}
+
+	oraclePrice, err := numerical.NewFromString(ctx.OraclePrice)
+	if err != nil {
+		return nil, fmt.Errorf("invalid oracle price for %s: %w", asset.Symbol(), err)
+	}
+
+	return &types.MarkPrice{
+		Exchange:   types.Hyperliquid,
+		Asset:      asset,
+		MarkPrice:  markPrice,
+		IndexPrice: oraclePrice,
+		Timestamp:  h.timeProvider.Now(),
+	}, nil
+}
@@ -7,11 +7,17 @@ import (
 	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
 	"github.com/backtesting-org/live-trading/pkg/connectors/types"
 )
 
+var _ types.DepthSnapshotProvider = (*hyperliquid)(nil)
+
 // FetchKlines retrieves historical candlestick data with decimal precision
 func (h *hyperliquid) FetchKlines(symbol, interval string, limit int) ([]connector.Kline, error) {
+	if err := h.limiter.Wait(ratelimit.EndpointKlines); err != nil {
+		return nil, err
+	}
 	hlInterval := convertInterval(interval)
 	endTime := h.timeProvider.Now().Unix()
 	startTime := endTime - int64(limit*intervalToSeconds(hlInterval))
@@ -64,6 +70,9 @@ func (h *hyperliquid) FetchKlines(symbol, interval string, limit int) ([]connect
 
 // FetchPrice retrieves current price with decimal precision
 func (h *hyperliquid) FetchPrice(symbol string) (*connector.Price, error) {
+	if err := h.limiter.Wait(ratelimit.EndpointPrice); err != nil {
+		return nil, err
+	}
 	mids, err := h.marketData.GetAllMids()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get current prices: %w", err)
@@ -89,6 +98,9 @@ func (h *hyperliquid) FetchPrice(symbol string) (*connector.Price, error) {
 
 // FetchOrderBook retrieves order book with decimal precision
 func (h *hyperliquid) FetchOrderBook(symbol portfolio.Asset, instrument connector.Instrument, depth int) (*connector.OrderBook, error) {
+	if err := h.limiter.Wait(ratelimit.EndpointOrderBook); err != nil {
+		return nil, err
+	}
 	l2Book, err := h.marketData.GetL2Book(symbol.Symbol())
 
 	if instrument != connector.TypePerpetual {
@@ -146,14 +158,17 @@ func (h *hyperliquid) FetchOrderBook(symbol portfolio.Asset, instrument connecto
 }
 
 func (h *hyperliquid) GetPerpSymbol(symbol portfolio.Asset) string {
-	return symbol.Symbol()
-
+	native, _ := symbolRule.ToNative(symbol, connector.TypePerpetual)
+	return native
 }
 
 // FetchRecentTrades retrieves recent trades for the specified symbol
 func (h *hyperliquid) FetchRecentTrades(symbol string, limit int) ([]connector.Trade, error) {
+	if err := h.limiter.Wait(ratelimit.EndpointTrades); err != nil {
+		return nil, err
+	}
 	// Get user's fills (their own trades)
-	fills, err := h.marketData.GetUserFills(h.config.AccountAddress)
+	fills, err := h.marketData.GetUserFills(h.account)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user fills: %w", err)
 	}
@@ -216,3 +231,8 @@ func (h *hyperliquid) FetchRiskFundBalance(symbol string) (*connector.RiskFundBa
 func (h *hyperliquid) FetchContracts() ([]connector.ContractInfo, error) {
 	return nil, fmt.Errorf("FetchContracts not implemented for Hyperliquid")
 }
+
+// MaxSnapshotDepth is what l2Book returns; it takes no depth parameter
+func (h *hyperliquid) MaxSnapshotDepth(_ connector.Instrument) int {
+	return 20
+}
@@ -6,10 +6,16 @@ import (
 	"github.com/backtesting-org/live-trading/pkg/connectors/hyperliquid/adaptors"
 	"github.com/backtesting-org/live-trading/pkg/connectors/hyperliquid/rest"
 	"github.com/backtesting-org/live-trading/pkg/connectors/hyperliquid/websocket"
+	"github.com/backtesting-org/live-trading/pkg/connectors/symbols"
 	"github.com/backtesting-org/live-trading/pkg/connectors/types"
 	"go.uber.org/fx"
 )
 
+// symbolRule maps assets to perpetual coins, which are the bare asset name
+var symbolRule = symbols.NewSuffixRule(map[connector.Instrument]string{
+	connector.TypePerpetual: "",
+})
+
 // Module is the main Hyperliquid connector module
 var Module = fx.Options(
 	websocket.WebSocketModule,
@@ -33,6 +39,7 @@ var Module = fx.Options(
 )
 
 // registerHyperliquid registers the hyperliquid connector with the SDK's ConnectorRegistry
-func registerHyperliquid(hyperliquidConn connector.Connector, reg registry.ConnectorRegistry) {
+func registerHyperliquid(hyperliquidConn connector.Connector, reg registry.ConnectorRegistry, mapper symbols.SymbolMapper) {
+	mapper.Register(types.Hyperliquid, symbolRule)
 	reg.RegisterConnector(types.Hyperliquid, hyperliquidConn)
 }
@@ -0,0 +1,9 @@
+package hyperliquid
+
+import "github.com/backtesting-org/live-trading/pkg/connectors/types"
+
+var _ types.RateLimitProvider = (*hyperliquid)(nil)
+
+func (h *hyperliquid) RateLimitStatus() types.RateLimitStatus {
+	return h.limiter.Status()
+}
@@ -15,15 +15,15 @@ func (t *tradingService) PlaceBuyMarketOrder(coin string, size, slippage float64
 	if err != nil {
 		return hyperliquid.OrderStatus{}, fmt.Errorf("exchange not configured: %w", err)
 	}
-	return ex.MarketOpen(coin, true, size, nil, slippage, nil, nil)
+	return ex.MarketOpen(coin, true, size, nil, slippage, nil, t.builder.Load())
 }
 
 func (t *tradingService) PlaceBuyStopLoss(coin string, size, triggerPrice float64) (hyperliquid.OrderStatus, error) {
-	return t.placeTriggerOrder(coin, size, triggerPrice, true, true)
+	return t.placeTriggerOrder(coin, size, triggerPrice, triggerPrice, true, true, "sl", false)
 }
 
 func (t *tradingService) PlaceBuyTakeProfit(coin string, size, triggerPrice float64) (hyperliquid.OrderStatus, error) {
-	return t.placeTriggerOrder(coin, size, triggerPrice, true, true)
+	return t.placeTriggerOrder(coin, size, triggerPrice, triggerPrice, true, true, "tp", false)
 }
 
 func (t *tradingService) PlaceBuyLimitOrderWithCustomRef(coin string, size, price float64, customRef string) (hyperliquid.OrderStatus, error) {
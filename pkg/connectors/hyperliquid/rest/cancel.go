@@ -21,3 +21,11 @@ func (t *tradingService) CancelOrderByCustomRef(coin, customRef string) (*hyperl
 	}
 	return ex.CancelByCloid(coin, customRef)
 }
+
+func (t *tradingService) CancelOrdersByID(requests []hyperliquid.CancelOrderRequest) (*hyperliquid.APIResponse[hyperliquid.CancelOrderResponse], error) {
+	ex, err := t.client.GetExchange()
+	if err != nil {
+		return nil, fmt.Errorf("exchange not configured: %w", err)
+	}
+	return ex.BulkCancel(requests)
+}
@@ -11,7 +11,7 @@ func (t *tradingService) ClosePosition(coin string, size *float64, slippage floa
 	if err != nil {
 		return hyperliquid.OrderStatus{}, fmt.Errorf("exchange not configured: %w", err)
 	}
-	return ex.MarketClose(coin, size, nil, slippage, nil, nil)
+	return ex.MarketClose(coin, size, nil, slippage, nil, t.builder.Load())
 }
 
 func (t *tradingService) CloseEntirePosition(coin string, slippage float64) (hyperliquid.OrderStatus, error) {
@@ -52,19 +52,27 @@ func (t *tradingService) placeLimitOrder(coin string, size, price float64, isBuy
 		ClientOrderID: clientOrderID,
 	}
 
-	return ex.Order(req, nil)
+	return ex.Order(req, t.builder.Load())
 }
 
-func (t *tradingService) placeTriggerOrder(coin string, size, triggerPrice float64, isBuy bool, isMarket bool) (hyperliquid.OrderStatus, error) {
+func (t *tradingService) placeTriggerOrder(coin string, size, triggerPrice, limitPrice float64, isBuy, isMarket bool, tpsl string, reduceOnly bool) (hyperliquid.OrderStatus, error) {
 	ex, err := t.client.GetExchange()
 	if err != nil {
 		return hyperliquid.OrderStatus{}, fmt.Errorf("exchange not configured: %w", err)
 	}
 
 	// Round trigger price to valid tick size
-	roundedPrice, err := t.priceValidator.RoundPrice(coin, triggerPrice)
+	roundedTrigger, err := t.priceValidator.RoundPrice(coin, triggerPrice)
 	if err != nil {
-		roundedPrice = triggerPrice
+		roundedTrigger = triggerPrice
+	}
+
+	// Market triggers still carry a price, which bounds slippage on execution
+	roundedPrice := roundedTrigger
+	if !isMarket {
+		if roundedPrice, err = t.priceValidator.RoundPrice(coin, limitPrice); err != nil {
+			roundedPrice = limitPrice
+		}
 	}
 
 	// Round size to valid decimals
@@ -78,14 +86,19 @@ func (t *tradingService) placeTriggerOrder(coin string, size, triggerPrice float
 		IsBuy:      isBuy,
 		Price:      roundedPrice,
 		Size:       roundedSize,
-		ReduceOnly: false,
+		ReduceOnly: reduceOnly,
 		OrderType: hyperliquid.OrderType{
 			Trigger: &hyperliquid.TriggerOrderType{
-				TriggerPx: roundedPrice,
+				TriggerPx: roundedTrigger,
 				IsMarket:  isMarket,
+				Tpsl:      tpsl,
 			},
 		},
 	}
 
-	return ex.Order(req, nil)
+	return ex.Order(req, t.builder.Load())
+}
+
+func (t *tradingService) PlaceTriggerOrder(coin string, size, triggerPrice, limitPrice float64, isBuy, isMarket bool, tpsl string, reduceOnly bool) (hyperliquid.OrderStatus, error) {
+	return t.placeTriggerOrder(coin, size, triggerPrice, limitPrice, isBuy, isMarket, tpsl, reduceOnly)
 }
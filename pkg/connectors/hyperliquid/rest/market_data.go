@@ -22,6 +22,7 @@ type MarketDataService interface {
 	GetUserState(user string) (hyperliquid.UserState, error)
 	GetOpenOrders(user string) ([]hyperliquid.OpenOrder, error)
 	GetUserFills(user string) ([]hyperliquid.Fill, error)
+	GetSubAccounts(user string) ([]hyperliquid.SubAccount, error)
 
 	// Funding rate methods - historical only
 	GetAssetContext(coin string) (*AssetContext, error)
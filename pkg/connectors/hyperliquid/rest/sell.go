@@ -15,15 +15,15 @@ func (t *tradingService) PlaceSellMarketOrder(coin string, size, slippage float6
 	if err != nil {
 		return hyperliquid.OrderStatus{}, fmt.Errorf("exchange not configured: %w", err)
 	}
-	return ex.MarketOpen(coin, false, size, nil, slippage, nil, nil)
+	return ex.MarketOpen(coin, false, size, nil, slippage, nil, t.builder.Load())
 }
 
 func (t *tradingService) PlaceSellStopLoss(coin string, size, triggerPrice float64) (hyperliquid.OrderStatus, error) {
-	return t.placeTriggerOrder(coin, size, triggerPrice, false, true)
+	return t.placeTriggerOrder(coin, size, triggerPrice, triggerPrice, false, true, "sl", false)
 }
 
 func (t *tradingService) PlaceSellTakeProfit(coin string, size, triggerPrice float64) (hyperliquid.OrderStatus, error) {
-	return t.placeTriggerOrder(coin, size, triggerPrice, false, true)
+	return t.placeTriggerOrder(coin, size, triggerPrice, triggerPrice, false, true, "tp", false)
 }
 
 func (t *tradingService) PlaceSellLimitOrderWithCustomRef(coin string, size, price float64, customRef string) (hyperliquid.OrderStatus, error) {
@@ -2,6 +2,7 @@ package rest
 
 import (
 	"fmt"
+	"sync/atomic"
 
 	"github.com/backtesting-org/live-trading/pkg/connectors/hyperliquid/adaptors"
 	hyperliquid "github.com/sonirico/go-hyperliquid"
@@ -26,6 +27,10 @@ type TradingService interface {
 	PlaceSellTakeProfit(coin string, size, triggerPrice float64) (hyperliquid.OrderStatus, error)
 	PlaceSellLimitOrderWithCustomRef(coin string, size, price float64, customRef string) (hyperliquid.OrderStatus, error)
 
+	// PlaceTriggerOrder places a stop ("sl") or take-profit ("tp") trigger;
+	// limitPrice is ignored for market triggers
+	PlaceTriggerOrder(coin string, size, triggerPrice, limitPrice float64, isBuy, isMarket bool, tpsl string, reduceOnly bool) (hyperliquid.OrderStatus, error)
+
 	// Close operations
 	ClosePosition(coin string, size *float64, slippage float64) (hyperliquid.OrderStatus, error)
 	CloseEntirePosition(coin string, slippage float64) (hyperliquid.OrderStatus, error)
@@ -33,6 +38,13 @@ type TradingService interface {
 	// Cancel operations
 	CancelOrderByID(coin string, orderID int64) (*hyperliquid.APIResponse[hyperliquid.CancelOrderResponse], error)
 	CancelOrderByCustomRef(coin, customRef string) (*hyperliquid.APIResponse[hyperliquid.CancelOrderResponse], error)
+	CancelOrdersByID(requests []hyperliquid.CancelOrderRequest) (*hyperliquid.APIResponse[hyperliquid.CancelOrderResponse], error)
+
+	// Builder codes and referrals. SetBuilder attaches the builder to every
+	// order placed afterwards; nil detaches it.
+	SetBuilder(builder *hyperliquid.BuilderInfo)
+	ApproveBuilderFee(builder string, maxFeeRate string) error
+	SetReferrer(code string) error
 }
 
 // tradingService implementation
@@ -40,6 +52,7 @@ type tradingService struct {
 	client         adaptors.ExchangeClient
 	infoClient     adaptors.InfoClient
 	priceValidator PriceValidator
+	builder        atomic.Pointer[hyperliquid.BuilderInfo]
 }
 
 // NewTradingService creates a new trading service
@@ -99,5 +112,57 @@ func (t *tradingService) PlaceBulkOrders(orders []hyperliquid.CreateOrderRequest
 	if err != nil {
 		return nil, fmt.Errorf("exchange not configured: %w", err)
 	}
-	return ex.BulkOrders(orders, nil)
+
+	// Round to valid ticks and decimals as single orders are; on a
+	// validation failure the exchange rejects the unrounded value instead
+	rounded := make([]hyperliquid.CreateOrderRequest, len(orders))
+	for i, order := range orders {
+		if price, err := t.priceValidator.RoundPrice(order.Coin, order.Price); err == nil {
+			order.Price = price
+		}
+		if size, err := t.priceValidator.RoundSize(order.Coin, order.Size); err == nil {
+			order.Size = size
+		}
+		rounded[i] = order
+	}
+	return ex.BulkOrders(rounded, t.builder.Load())
+}
+
+func (t *tradingService) SetBuilder(builder *hyperliquid.BuilderInfo) {
+	t.builder.Store(builder)
+}
+
+// ApproveBuilderFee lets builder charge up to maxFeeRate, a percentage
+// string such as "0.01%"; it must be signed by the account's own key, not
+// an agent wallet
+func (t *tradingService) ApproveBuilderFee(builder string, maxFeeRate string) error {
+	ex, err := t.client.GetExchange()
+	if err != nil {
+		return fmt.Errorf("exchange not configured: %w", err)
+	}
+
+	resp, err := ex.ApproveBuilderFee(builder, maxFeeRate)
+	if err != nil {
+		return fmt.Errorf("failed to approve builder fee: %w", err)
+	}
+	if resp != nil && resp.Status != "ok" {
+		return fmt.Errorf("builder fee approval rejected: %s", resp.Error)
+	}
+	return nil
+}
+
+func (t *tradingService) SetReferrer(code string) error {
+	ex, err := t.client.GetExchange()
+	if err != nil {
+		return fmt.Errorf("exchange not configured: %w", err)
+	}
+
+	resp, err := ex.SetReferrer(code)
+	if err != nil {
+		return fmt.Errorf("failed to set referrer: %w", err)
+	}
+	if resp != nil && resp.Status != "ok" {
+		return fmt.Errorf("referrer rejected: %s", resp.Error)
+	}
+	return nil
 }
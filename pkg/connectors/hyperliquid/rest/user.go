@@ -37,3 +37,11 @@ func (m *marketDataService) GetUserFills(user string) ([]hyperliquid.Fill, error
 	}
 	return info.UserFills(user)
 }
+
+func (m *marketDataService) GetSubAccounts(user string) ([]hyperliquid.SubAccount, error) {
+	info, err := m.client.GetInfo()
+	if err != nil {
+		return nil, fmt.Errorf("info client not configured: %w", err)
+	}
+	return info.QuerySubAccounts(user)
+}
@@ -1,16 +1,21 @@
 package hyperliquid
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"time"
 
 	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
 )
 
 // PlaceLimitOrder places a limit order on Hyperliquid
 func (h *hyperliquid) PlaceLimitOrder(symbol string, side connector.OrderSide, quantity, price numerical.Decimal) (*connector.OrderResponse, error) {
+	if err := h.limiter.Wait(ratelimit.EndpointPlaceOrder); err != nil {
+		return nil, err
+	}
 	if !h.SupportsTradingOperations() {
 		return nil, fmt.Errorf("trading operations not supported")
 	}
@@ -25,11 +30,15 @@ func (h *hyperliquid) PlaceLimitOrder(symbol string, side connector.OrderSide, q
 	}
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to place %s limit order: %w", side, err)
+		return nil, h.wrapOrderError(symbol, side, quantity, price, fmt.Errorf("failed to place %s limit order: %w", side, err))
 	}
 
+	orderID := h.extractOrderID(result)
+	h.recordBuilderFee(orderID)
+	h.payloads.Keep(orderID, result)
+
 	return &connector.OrderResponse{
-		OrderID:   h.extractOrderID(result),
+		OrderID:   orderID,
 		Symbol:    symbol,
 		Status:    connector.OrderStatusNew,
 		Side:      side,
@@ -42,6 +51,9 @@ func (h *hyperliquid) PlaceLimitOrder(symbol string, side connector.OrderSide, q
 
 // PlaceMarketOrder places a market order on Hyperliquid
 func (h *hyperliquid) PlaceMarketOrder(symbol string, side connector.OrderSide, quantity numerical.Decimal) (*connector.OrderResponse, error) {
+	if err := h.limiter.Wait(ratelimit.EndpointPlaceOrder); err != nil {
+		return nil, err
+	}
 	if !h.SupportsTradingOperations() {
 		return nil, fmt.Errorf("trading operations not supported")
 	}
@@ -56,11 +68,15 @@ func (h *hyperliquid) PlaceMarketOrder(symbol string, side connector.OrderSide,
 	}
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to place %s market order: %w", side, err)
+		return nil, h.wrapOrderError(symbol, side, quantity, numerical.Zero(), fmt.Errorf("failed to place %s market order: %w", side, err))
 	}
 
+	orderID := h.extractOrderID(result)
+	h.recordBuilderFee(orderID)
+	h.payloads.Keep(orderID, result)
+
 	return &connector.OrderResponse{
-		OrderID:   h.extractOrderID(result),
+		OrderID:   orderID,
 		Symbol:    symbol,
 		Status:    connector.OrderStatusNew,
 		Side:      side,
@@ -72,6 +88,9 @@ func (h *hyperliquid) PlaceMarketOrder(symbol string, side connector.OrderSide,
 
 // CancelOrder cancels an existing order on Hyperliquid
 func (h *hyperliquid) CancelOrder(symbol, orderID string) (*connector.CancelResponse, error) {
+	if err := h.limiter.Wait(ratelimit.EndpointCancelOrder); err != nil {
+		return nil, err
+	}
 	if !h.SupportsTradingOperations() {
 		return nil, fmt.Errorf("trading operations not supported")
 	}
@@ -81,10 +100,11 @@ func (h *hyperliquid) CancelOrder(symbol, orderID string) (*connector.CancelResp
 		return nil, fmt.Errorf("invalid order ID format: %w", err)
 	}
 
-	_, err = h.trading.CancelOrderByID(symbol, oid)
+	result, err := h.trading.CancelOrderByID(symbol, oid)
 	if err != nil {
 		return nil, fmt.Errorf("failed to cancel order: %w", err)
 	}
+	h.payloads.Keep(orderID, result)
 
 	return &connector.CancelResponse{
 		OrderID:   orderID,
@@ -96,7 +116,10 @@ func (h *hyperliquid) CancelOrder(symbol, orderID string) (*connector.CancelResp
 
 // GetOpenOrders retrieves current open orders
 func (h *hyperliquid) GetOpenOrders() ([]connector.Order, error) {
-	orders, err := h.marketData.GetOpenOrders(h.config.AccountAddress)
+	if err := h.limiter.Wait(ratelimit.EndpointOrders); err != nil {
+		return nil, err
+	}
+	orders, err := h.marketData.GetOpenOrders(h.account)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get open orders: %w", err)
 	}
@@ -112,6 +135,7 @@ func (h *hyperliquid) GetOpenOrders() ([]connector.Order, error) {
 			CreatedAt: time.Unix(order.Timestamp/1000, 0),
 		}
 		connectorOrders = append(connectorOrders, connectorOrder)
+		h.payloads.Keep(connectorOrder.ID, order)
 	}
 
 	return connectorOrders, nil
@@ -121,3 +145,8 @@ func (h *hyperliquid) GetOpenOrders() ([]connector.Order, error) {
 func (h *hyperliquid) GetOrderStatus(orderID string) (*connector.Order, error) {
 	return nil, fmt.Errorf("GetOrderStatus not yet implemented for Hyperliquid")
 }
+
+// OrderPayload returns Hyperliquid's last response about the order
+func (h *hyperliquid) OrderPayload(orderID string) (json.RawMessage, bool) {
+	return h.payloads.OrderPayload(orderID)
+}
@@ -0,0 +1,58 @@
+package hyperliquid
+
+import (
+	"fmt"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+var _ types.TriggerOrderProvider = (*hyperliquid)(nil)
+
+// PlaceTriggerOrder places a native Hyperliquid trigger order, which the
+// exchange classes as stop-loss ("sl") or take-profit ("tp")
+func (h *hyperliquid) PlaceTriggerOrder(order types.TriggerOrder) (*connector.OrderResponse, error) {
+	if err := order.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid trigger order: %w", err)
+	}
+	if err := h.limiter.Wait(ratelimit.EndpointPlaceOrder); err != nil {
+		return nil, err
+	}
+	if !h.SupportsTradingOperations() {
+		return nil, fmt.Errorf("trading operations not supported")
+	}
+
+	tpsl := "tp"
+	if order.IsStop() {
+		tpsl = "sl"
+	}
+
+	result, err := h.trading.PlaceTriggerOrder(
+		order.Symbol,
+		order.Quantity.InexactFloat64(),
+		order.TriggerPrice.InexactFloat64(),
+		order.LimitPrice.InexactFloat64(),
+		order.Side == connector.OrderSideBuy,
+		!order.IsLimit(),
+		tpsl,
+		order.ReduceOnly,
+	)
+	if err != nil {
+		return nil, h.wrapOrderError(order.Symbol, order.Side, order.Quantity, order.LimitPrice, fmt.Errorf("failed to place %s order: %w", order.Type, err))
+	}
+
+	orderID := h.extractOrderID(result)
+	h.recordBuilderFee(orderID)
+
+	return &connector.OrderResponse{
+		OrderID:   orderID,
+		Symbol:    order.Symbol,
+		Status:    connector.OrderStatusNew,
+		Side:      order.Side,
+		Type:      order.Type,
+		Quantity:  order.Quantity,
+		Price:     order.LimitPrice,
+		Timestamp: h.timeProvider.Now(),
+	}, nil
+}
@@ -0,0 +1,48 @@
+package websocket
+
+import (
+	"fmt"
+
+	"github.com/sonirico/go-hyperliquid"
+)
+
+// SubscribeToFills subscribes to the user's own fills
+func (ws *WebSocketService) SubscribeToFills(user string, callback func([]FillMessage)) (int, error) {
+	if callback == nil {
+		return 0, fmt.Errorf("callback cannot be nil")
+	}
+
+	subID := generateSubscriptionID()
+
+	rawSubID, err := ws.subscribeToChannel("userFills", user, "", func(msg hyperliquid.WSMessage) {
+		parsed, err := ws.parseFills(msg)
+		if err != nil {
+			ws.logger.Warn("Failed to parse fills: %v", err)
+			return
+		}
+		if len(parsed) > 0 {
+			callback(parsed)
+		}
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	ws.subscriptionsMu.Lock()
+	ws.subscriptions[rawSubID].ID = subID
+	ws.subscriptionsMu.Unlock()
+
+	ws.logger.Info("✅ Subscribed to fills for %s (ID: %d)", user, subID)
+	return subID, nil
+}
+
+// UnsubscribeFromFills unsubscribes from the user's fills
+func (ws *WebSocketService) UnsubscribeFromFills(user string, subscriptionID int) error {
+	if err := ws.unsubscribeFromChannel("userFills", user, "", subscriptionID); err != nil {
+		return err
+	}
+
+	ws.logger.Info("Unsubscribed from fills for %s (ID: %d)", user, subscriptionID)
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
 	"github.com/backtesting-org/live-trading/pkg/websocket/base"
+	"github.com/backtesting-org/live-trading/pkg/websocket/broker"
 	"github.com/backtesting-org/live-trading/pkg/websocket/connection"
 	"github.com/backtesting-org/live-trading/pkg/websocket/performance"
 	"github.com/backtesting-org/live-trading/pkg/websocket/security"
@@ -44,10 +45,11 @@ func NewValidationConfig() security.ValidationConfig {
 	return security.ValidationConfig{
 		MaxMessageSize: 65536,
 		AllowedTypes: map[string]bool{
-			"l2Book":   true,
-			"trades":   true,
-			"candle":   true,
-			"webData2": true,
+			"l2Book":    true,
+			"trades":    true,
+			"candle":    true,
+			"webData2":  true,
+			"userFills": true,
 		},
 		TypeField: "channel",
 	}
@@ -120,6 +122,11 @@ func NewReconnectManager(
 	return connection.NewReconnectManager(connManager, strategy, logger)
 }
 
+// NewSubscriptionBroker creates the shared subscription broker
+func NewSubscriptionBroker(logger logging.ApplicationLogger) broker.SubscriptionBroker {
+	return broker.NewSubscriptionBroker(logger)
+}
+
 // NewBaseServiceConfig creates base service configuration
 func NewBaseServiceConfig() base.Config {
 	return base.Config{
@@ -231,6 +238,10 @@ var WebSocketModule = fx.Module("hyperliquid_websocket",
 			),
 			fx.ResultTags(`name:"hyperliquid_base"`),
 		),
+		fx.Annotate(
+			NewSubscriptionBroker,
+			fx.ResultTags(`name:"hyperliquid_subscription_broker"`),
+		),
 		fx.Annotate(
 			NewWebSocketService,
 			fx.ParamTags(
@@ -239,6 +250,7 @@ var WebSocketModule = fx.Module("hyperliquid_websocket",
 				`name:"hyperliquid_base"`,
 				``,
 				`name:"hyperliquid_parser"`,
+				`name:"hyperliquid_subscription_broker"`,
 			),
 		),
 	),
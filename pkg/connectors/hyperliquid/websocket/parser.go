@@ -18,6 +18,7 @@ type MessageParser interface {
 	ParsePosition(msg hyperliquidsdk.WSMessage) (*PositionMessage, error)
 	ParseAccountBalance(msg hyperliquidsdk.WSMessage) (*AccountBalanceMessage, error)
 	ParseKline(msg hyperliquidsdk.WSMessage) (*KlineMessage, error)
+	ParseFills(msg hyperliquidsdk.WSMessage) ([]FillMessage, error)
 }
 
 // Parser handles parsing of WebSocket messages into typed structs
@@ -330,3 +331,67 @@ func (p *Parser) ParseKline(msg hyperliquidsdk.WSMessage) (*KlineMessage, error)
 		Timestamp: p.timeProvider.Now(),
 	}, nil
 }
+
+// ParseFills parses a userFills update. The snapshot sent on subscribing
+// replays past fills, so it yields none; only fills after it are returned.
+func (p *Parser) ParseFills(msg hyperliquidsdk.WSMessage) ([]FillMessage, error) {
+	if msg.Channel != "userFills" {
+		return nil, fmt.Errorf("expected userFills channel, got %s", msg.Channel)
+	}
+
+	var data struct {
+		IsSnapshot bool `json:"isSnapshot"`
+		Fills      []struct {
+			Coin    string `json:"coin"`
+			Px      string `json:"px"`
+			Sz      string `json:"sz"`
+			Side    string `json:"side"`
+			Time    int64  `json:"time"`
+			Oid     int64  `json:"oid"`
+			Tid     int64  `json:"tid"`
+			Fee     string `json:"fee"`
+			Crossed bool   `json:"crossed"`
+		} `json:"fills"`
+	}
+	if err := json.Unmarshal(msg.Data, &data); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal fills data: %w", err)
+	}
+
+	if data.IsSnapshot {
+		return nil, nil
+	}
+
+	result := make([]FillMessage, 0, len(data.Fills))
+	for _, fill := range data.Fills {
+		price, err := numerical.NewFromString(fill.Px)
+		if err != nil {
+			p.logger.Warn("Invalid fill price", "coin", fill.Coin, "price", fill.Px, "error", err)
+			continue
+		}
+
+		quantity, err := numerical.NewFromString(fill.Sz)
+		if err != nil {
+			p.logger.Warn("Invalid fill quantity", "coin", fill.Coin, "quantity", fill.Sz, "error", err)
+			continue
+		}
+
+		fee, err := numerical.NewFromString(fill.Fee)
+		if err != nil {
+			fee = numerical.Zero()
+		}
+
+		result = append(result, FillMessage{
+			Coin:      fill.Coin,
+			OrderID:   fill.Oid,
+			TradeID:   fill.Tid,
+			Price:     price,
+			Quantity:  quantity,
+			Side:      fill.Side,
+			Fee:       fee,
+			Crossed:   fill.Crossed,
+			Timestamp: time.UnixMilli(fill.Time),
+		})
+	}
+
+	return result, nil
+}
@@ -60,18 +60,7 @@ func (ws *WebSocketService) UnsubscribeFromOrderBook(coin string, subscriptionID
 	delete(ws.orderBookCallbacks, subscriptionID)
 	ws.orderBookMu.Unlock()
 
-	// Find and remove the subscription
-	ws.subscriptionsMu.Lock()
-	for rawID, sub := range ws.subscriptions {
-		if sub.ID == subscriptionID && sub.Channel == "l2Book" && sub.Coin == coin {
-			delete(ws.subscriptions, rawID)
-			ws.subscriptionsMu.Unlock()
-			return nil
-		}
-	}
-	ws.subscriptionsMu.Unlock()
-
-	return fmt.Errorf("subscription not found")
+	return ws.unsubscribeFromChannel("l2Book", coin, "", subscriptionID)
 }
 
 // SubscribeToKlines subscribes to kline updates
@@ -125,15 +114,5 @@ func (ws *WebSocketService) UnsubscribeFromKlines(coin, interval string, subscri
 	delete(ws.klinesCallbacks, subscriptionID)
 	ws.klinesMu.Unlock()
 
-	ws.subscriptionsMu.Lock()
-	for rawID, sub := range ws.subscriptions {
-		if sub.ID == subscriptionID && sub.Channel == "candle" && sub.Coin == coin && sub.Interval == interval {
-			delete(ws.subscriptions, rawID)
-			ws.subscriptionsMu.Unlock()
-			return nil
-		}
-	}
-	ws.subscriptionsMu.Unlock()
-
-	return fmt.Errorf("subscription not found")
+	return ws.unsubscribeFromChannel("candle", coin, interval, subscriptionID)
 }
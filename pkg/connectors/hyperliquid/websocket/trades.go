@@ -55,16 +55,10 @@ func (ws *WebSocketService) UnsubscribeFromTrades(coin string, subscriptionID in
 	delete(ws.tradesCallbacks, subscriptionID)
 	ws.tradesMu.Unlock()
 
-	ws.subscriptionsMu.Lock()
-	for rawID, sub := range ws.subscriptions {
-		if sub.ID == subscriptionID && sub.Channel == "trades" && sub.Coin == coin {
-			delete(ws.subscriptions, rawID)
-			ws.subscriptionsMu.Unlock()
-			ws.logger.Info("Unsubscribed from trades for %s (ID: %d)", coin, subscriptionID)
-			return nil
-		}
+	if err := ws.unsubscribeFromChannel("trades", coin, "", subscriptionID); err != nil {
+		return err
 	}
-	ws.subscriptionsMu.Unlock()
 
-	return fmt.Errorf("subscription not found")
+	ws.logger.Info("Unsubscribed from trades for %s (ID: %d)", coin, subscriptionID)
+	return nil
 }
@@ -12,6 +12,7 @@ type RealTimeService interface {
 	Disconnect() error
 	IsConnected() bool
 	GetErrorChannel() <-chan error
+	GetMetrics() map[string]interface{}
 
 	// Orderbook subscriptions
 	SubscribeToOrderBook(coin string, callback func(*OrderBookMessage)) (int, error)
@@ -30,6 +31,10 @@ type RealTimeService interface {
 	// Kline subscriptions
 	SubscribeToKlines(coin, interval string, callback func(*KlineMessage)) (int, error)
 	UnsubscribeFromKlines(coin, interval string, subscriptionID int) error
+
+	// Fill subscriptions, for the user's own trades
+	SubscribeToFills(user string, callback func([]FillMessage)) (int, error)
+	UnsubscribeFromFills(user string, subscriptionID int) error
 }
 
 // OrderBookMessage represents a parsed L2 order book update from WebSocket
@@ -57,6 +62,19 @@ type TradeMessage struct {
 	TradeID   int64
 }
 
+// FillMessage represents one of the user's own trades from the userFills channel
+type FillMessage struct {
+	Coin      string
+	OrderID   int64
+	TradeID   int64
+	Price     numerical.Decimal
+	Quantity  numerical.Decimal
+	Side      string
+	Fee       numerical.Decimal
+	Crossed   bool
+	Timestamp time.Time
+}
+
 // PositionMessage represents a parsed position update from WebSocket
 type PositionMessage struct {
 	Coin           string
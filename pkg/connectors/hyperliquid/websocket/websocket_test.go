@@ -0,0 +1,13 @@
+package websocket_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestWebSocket(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Hyperliquid WebSocket Suite")
+}
@@ -4,14 +4,25 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
 	"github.com/backtesting-org/live-trading/pkg/websocket/base"
+	"github.com/backtesting-org/live-trading/pkg/websocket/broker"
 	"github.com/backtesting-org/live-trading/pkg/websocket/connection"
 	"github.com/sonirico/go-hyperliquid"
 )
 
+const (
+	// subscriptionConfirmTimeout is how long to wait for a subscriptionResponse before re-sending
+	subscriptionConfirmTimeout = 5 * time.Second
+	// maxSubscriptionAttempts caps how many times a subscription is sent before giving up
+	maxSubscriptionAttempts = 3
+)
+
 // WebSocketService manages the WebSocket connection using the robust pkg/websocket infrastructure
 type WebSocketService struct {
 	connManager  connection.ConnectionManager
@@ -19,15 +30,18 @@ type WebSocketService struct {
 	baseService  base.BaseService
 	logger       logging.ApplicationLogger
 	parser       MessageParser
+	timeProvider temporal.TimeProvider
 
 	// Subscription tracking
 	subscriptionsMu sync.RWMutex
-	subscriptions   map[int]*SubscriptionHandler // Map subscription ID -> handler
+	subscriptions   map[int]*SubscriptionHandler // Map broker consumer ID -> handler
 
-	// Subscription index for direct routing (no iteration)
-	// Key format: "channel:coin:interval" (e.g., "l2Book:BTC:", "candle:ETH:1m")
-	subscriptionIndex map[string][]*SubscriptionHandler
-	indexMu           sync.RWMutex
+	// Shared upstream subscriptions, reference-counted per (channel, coin, interval)
+	broker broker.SubscriptionBroker
+
+	// Subscriptions sent upstream but not yet confirmed by a subscriptionResponse
+	pendingMu sync.Mutex
+	pending   map[string]*pendingSubscription
 
 	// Message routing
 	messageHandlers map[string]func([]byte) error // Channel -> handler
@@ -58,6 +72,24 @@ type SubscriptionHandler struct {
 	Callback func(hyperliquid.WSMessage)
 }
 
+// pendingSubscription tracks an upstream subscription awaiting confirmation
+type pendingSubscription struct {
+	key      broker.Key
+	attempts int
+	timer    temporal.Timer
+	cancel   chan struct{}
+}
+
+// stop abandons the confirmation wait in progress, if any
+func (p *pendingSubscription) stop() {
+	if p.timer == nil {
+		return
+	}
+	p.timer.Stop()
+	close(p.cancel)
+	p.timer, p.cancel = nil, nil
+}
+
 // NewWebSocketService creates a new WebSocket service using pkg/websocket infrastructure
 // All dependencies are injected via DI - no instantiation with new()
 func NewWebSocketService(
@@ -66,6 +98,8 @@ func NewWebSocketService(
 	baseService base.BaseService,
 	logger logging.ApplicationLogger,
 	parser MessageParser,
+	subscriptionBroker broker.SubscriptionBroker,
+	timeProvider temporal.TimeProvider,
 ) (RealTimeService, error) {
 	ws := &WebSocketService{
 		connManager:        connManager,
@@ -73,8 +107,10 @@ func NewWebSocketService(
 		baseService:        baseService,
 		logger:             logger,
 		parser:             parser,
+		timeProvider:       timeProvider,
 		subscriptions:      make(map[int]*SubscriptionHandler),
-		subscriptionIndex:  make(map[string][]*SubscriptionHandler),
+		broker:             subscriptionBroker,
+		pending:            make(map[string]*pendingSubscription),
 		messageHandlers:    make(map[string]func([]byte) error),
 		orderBookCallbacks: make(map[int]func(*OrderBookMessage)),
 		tradesCallbacks:    make(map[int]func([]TradeMessage)),
@@ -82,6 +118,8 @@ func NewWebSocketService(
 		errorCh:            make(chan error, 100),
 	}
 
+	subscriptionBroker.SetUpstream(&subscriptionUpstream{ws: ws})
+
 	// Set up connection manager callbacks
 	connManager.SetCallbacks(
 		ws.onConnect,
@@ -145,6 +183,10 @@ func (ws *WebSocketService) GetMetrics() map[string]interface{} {
 	ws.subscriptionsMu.RLock()
 	stats["active_subscriptions"] = len(ws.subscriptions)
 	ws.subscriptionsMu.RUnlock()
+	stats["upstream_subscriptions"] = len(ws.broker.Keys())
+	ws.pendingMu.Lock()
+	stats["pending_subscriptions"] = len(ws.pending)
+	ws.pendingMu.Unlock()
 
 	return stats
 }
@@ -183,6 +225,7 @@ func (ws *WebSocketService) onMessage(data []byte) error {
 	// Handle subscription confirmation messages (no routing needed)
 	if msgWrapper.Channel == "subscriptionResponse" {
 		ws.logger.Info("✅ Subscription confirmed: %s", string(msgWrapper.Data))
+		ws.confirmSubscription(msgWrapper.Data)
 		return nil
 	}
 
@@ -241,61 +284,155 @@ func (ws *WebSocketService) onReconnectSuccess(attempt int) {
 	ws.resubscribeAll()
 }
 
-// resubscribeAll re-subscribes to all tracked subscriptions
+// resubscribeAll re-sends every upstream subscription after a reconnect.
+// The server forgets subscriptions when the socket drops, so each shared key
+// is sent again and tracked until its subscriptionResponse arrives.
 func (ws *WebSocketService) resubscribeAll() {
-	ws.subscriptionsMu.RLock()
-	subscriptions := make([]*SubscriptionHandler, 0, len(ws.subscriptions))
-	for _, sub := range ws.subscriptions {
-		subscriptions = append(subscriptions, sub)
+	ws.clearPending()
+
+	keys := ws.broker.Keys()
+	ws.logger.Info("Re-subscribing to %d subscriptions after reconnect", len(keys))
+
+	for _, key := range keys {
+		ws.logger.Debug("Re-subscribing to %s", key)
+		if err := ws.subscribeWithConfirmation(key); err != nil {
+			ws.logger.Warn("❌ Failed to re-subscribe to %s: %v", key, err)
+			select {
+			case ws.errorCh <- fmt.Errorf("resubscribe %s failed: %w", key, err):
+			default:
+			}
+		}
 	}
-	ws.subscriptionsMu.RUnlock()
+}
+
+// subscribeWithConfirmation sends a subscription and starts waiting for its confirmation
+func (ws *WebSocketService) subscribeWithConfirmation(key broker.Key) error {
+	if err := ws.sendSubscription(key.Channel, key.Coin, key.Interval); err != nil {
+		return err
+	}
+
+	ws.pendingMu.Lock()
+	defer ws.pendingMu.Unlock()
+
+	id := confirmationID(key)
+	pending, exists := ws.pending[id]
+	if !exists {
+		pending = &pendingSubscription{key: key}
+		ws.pending[id] = pending
+	}
+	pending.attempts++
+	pending.stop()
+	pending.timer = ws.timeProvider.NewTimer(subscriptionConfirmTimeout)
+	pending.cancel = make(chan struct{})
+	go ws.awaitConfirmation(id, pending.timer, pending.cancel)
+
+	return nil
+}
+
+// awaitConfirmation retries a subscription once its timer fires, unless the
+// wait is abandoned first by a confirmation, a retry or a reconnect
+func (ws *WebSocketService) awaitConfirmation(id string, timer temporal.Timer, cancel <-chan struct{}) {
+	select {
+	case <-timer.C():
+		ws.onConfirmationTimeout(id)
+	case <-cancel:
+	}
+}
 
-	ws.logger.Info("Re-subscribing to %d subscriptions after reconnect", len(subscriptions))
-
-	for _, sub := range subscriptions {
-		// Re-subscribe based on channel type
-		switch sub.Channel {
-		case "l2Book":
-			ws.logger.Debug("Re-subscribing to orderbook: %s", sub.Coin)
-			// The subscription will be re-sent to server
-		case "trades":
-			ws.logger.Debug("Re-subscribing to trades: %s", sub.Coin)
-		case "candle":
-			ws.logger.Debug("Re-subscribing to candles: %s %s", sub.Coin, sub.Interval)
-		case "webData2":
-			ws.logger.Debug("Re-subscribing to webData2")
+// onConfirmationTimeout re-sends a subscription that was not confirmed in time
+func (ws *WebSocketService) onConfirmationTimeout(id string) {
+	ws.pendingMu.Lock()
+	pending, exists := ws.pending[id]
+	if !exists {
+		ws.pendingMu.Unlock()
+		return
+	}
+	key, attempts := pending.key, pending.attempts
+	ws.pendingMu.Unlock()
+
+	// The broker is consulted outside pendingMu: Attach holds the broker lock
+	// while sending, so taking them in the other order could deadlock
+	detached := ws.broker.RefCount(key) == 0
+	if detached || attempts >= maxSubscriptionAttempts {
+		ws.pendingMu.Lock()
+		delete(ws.pending, id)
+		ws.pendingMu.Unlock()
+	}
+
+	// Consumers detached while we were waiting - nothing to retry
+	if detached {
+		return
+	}
+
+	if attempts >= maxSubscriptionAttempts {
+		ws.logger.Error("❌ Subscription %s not confirmed after %d attempts", key, attempts)
+		select {
+		case ws.errorCh <- fmt.Errorf("subscription %s not confirmed after %d attempts", key, attempts):
+		default:
 		}
+		return
+	}
+
+	ws.logger.Warn("⚠️  Subscription %s not confirmed within %s, retrying (attempt %d)", key, subscriptionConfirmTimeout, attempts+1)
+	if err := ws.subscribeWithConfirmation(key); err != nil {
+		ws.logger.Warn("❌ Failed to re-send subscription %s: %v", key, err)
 	}
 }
 
-// subscribeToChannel is the internal method that handles raw subscriptions
-func (ws *WebSocketService) subscribeToChannel(channel, coin, interval string, callback func(hyperliquid.WSMessage)) (int, error) {
-	fmt.Printf("🟢 subscribeToChannel CALLED: channel=%s, coin=%s, interval=%s\n", channel, coin, interval)
+// confirmSubscription clears the pending entry matching a subscriptionResponse
+func (ws *WebSocketService) confirmSubscription(data json.RawMessage) {
+	var response struct {
+		Method       string `json:"method"`
+		Subscription struct {
+			Type     string `json:"type"`
+			Coin     string `json:"coin"`
+			User     string `json:"user"`
+			Interval string `json:"interval"`
+		} `json:"subscription"`
+	}
+	if err := json.Unmarshal(data, &response); err != nil || response.Method != "subscribe" {
+		return
+	}
 
-	subID := generateSubscriptionID()
-	fmt.Printf("🟢 Generated subID=%d for %s/%s/%s\n", subID, channel, coin, interval)
+	key := broker.Key{
+		Channel:  response.Subscription.Type,
+		Coin:     response.Subscription.Coin,
+		Interval: response.Subscription.Interval,
+	}
+	if isUserChannel(key.Channel) {
+		key.Coin = response.Subscription.User
+	}
 
-	sub := &SubscriptionHandler{
-		ID:       subID,
-		Channel:  channel,
-		Coin:     coin,
-		Interval: interval,
-		Callback: callback,
+	ws.pendingMu.Lock()
+	defer ws.pendingMu.Unlock()
+
+	id := confirmationID(key)
+	if pending, exists := ws.pending[id]; exists {
+		pending.stop()
+		delete(ws.pending, id)
 	}
+}
 
-	// Store subscription by ID
-	ws.subscriptionsMu.Lock()
-	ws.subscriptions[subID] = sub
-	fmt.Printf("🟢 Stored subscription subID=%d in subscriptions map (total: %d)\n", subID, len(ws.subscriptions))
-	ws.subscriptionsMu.Unlock()
+// clearPending drops confirmation tracking left over from a previous connection
+func (ws *WebSocketService) clearPending() {
+	ws.pendingMu.Lock()
+	defer ws.pendingMu.Unlock()
+
+	for id, pending := range ws.pending {
+		pending.stop()
+		delete(ws.pending, id)
+	}
+}
 
-	// Add to index for O(1) routing
-	indexKey := buildIndexKey(channel, coin, interval)
-	ws.indexMu.Lock()
-	ws.subscriptionIndex[indexKey] = append(ws.subscriptionIndex[indexKey], sub)
-	fmt.Printf("🟢 Added to index with key '%s' (total for this key: %d)\n", indexKey, len(ws.subscriptionIndex[indexKey]))
-	ws.indexMu.Unlock()
+// confirmationID normalises a key for matching against server echoes,
+// which may differ in case for user addresses
+func confirmationID(key broker.Key) string {
+	return strings.ToLower(key.String())
+}
 
+// subscribeToChannel is the internal method that handles raw subscriptions.
+// Consumers of the same channel/coin/interval share one upstream subscription.
+func (ws *WebSocketService) subscribeToChannel(channel, coin, interval string, callback func(hyperliquid.WSMessage)) (int, error) {
 	// Register message handler for this channel if not already registered
 	ws.handlersMu.Lock()
 	if _, exists := ws.messageHandlers[channel]; !exists {
@@ -303,21 +440,51 @@ func (ws *WebSocketService) subscribeToChannel(channel, coin, interval string, c
 			return ws.routeMessageToSubscriptions(channel, data)
 		}
 	}
-
 	ws.handlersMu.Unlock()
 
-	err := ws.sendSubscription(channel, coin, interval)
+	key := broker.Key{Channel: channel, Coin: coin, Interval: interval}
+	subID, err := ws.broker.Attach(key, func(message interface{}) {
+		if msg, ok := message.(hyperliquid.WSMessage); ok {
+			callback(msg)
+		}
+	})
 	if err != nil {
 		return 0, err
 	}
 
+	ws.subscriptionsMu.Lock()
+	ws.subscriptions[subID] = &SubscriptionHandler{
+		ID:       subID,
+		Channel:  channel,
+		Coin:     coin,
+		Interval: interval,
+		Callback: callback,
+	}
+	ws.subscriptionsMu.Unlock()
+
+	ws.logger.Debug("Attached subscription %d to %s (consumers: %d)", subID, key, ws.broker.RefCount(key))
 	return subID, nil
 }
 
-// buildIndexKey creates a lookup key for subscription routing
-// Format: "channel:coin:interval" (e.g., "l2Book:BTC:", "candle:ETH:1m")
-func buildIndexKey(channel, coin, interval string) string {
-	return fmt.Sprintf("%s:%s:%s", channel, coin, interval)
+// unsubscribeFromChannel detaches a consumer found by its parsed subscription
+// ID; the upstream unsubscribe is only sent once no consumers remain
+func (ws *WebSocketService) unsubscribeFromChannel(channel, coin, interval string, subscriptionID int) error {
+	ws.subscriptionsMu.Lock()
+	rawID := -1
+	for id, sub := range ws.subscriptions {
+		if sub.ID == subscriptionID && sub.Channel == channel && sub.Coin == coin && sub.Interval == interval {
+			rawID = id
+			break
+		}
+	}
+	if rawID < 0 {
+		ws.subscriptionsMu.Unlock()
+		return fmt.Errorf("subscription not found")
+	}
+	delete(ws.subscriptions, rawID)
+	ws.subscriptionsMu.Unlock()
+
+	return ws.broker.Detach(broker.Key{Channel: channel, Coin: coin, Interval: interval}, rawID)
 }
 
 // extractOrderBookCoin extracts coin from l2Book message data
@@ -356,7 +523,35 @@ func (ws *WebSocketService) extractCandleMetadata(data json.RawMessage) (coin, i
 	return coin, interval
 }
 
-// routeMessageToSubscriptions routes incoming messages to matching subscriptions using O(1) index lookup
+// extractTradesCoin extracts coin from a trades message
+// Trades messages carry an array of trades for a single coin
+func (ws *WebSocketService) extractTradesCoin(data json.RawMessage) string {
+	var trades []struct {
+		Coin string `json:"coin"`
+	}
+	if err := json.Unmarshal(data, &trades); err != nil || len(trades) == 0 {
+		return ""
+	}
+	return trades[0].Coin
+}
+
+// isUserChannel reports whether a channel is subscribed by user address
+func isUserChannel(channel string) bool {
+	return channel == "webData2" || channel == "userFills"
+}
+
+// extractUser extracts the user address from a webData2 or userFills message
+func (ws *WebSocketService) extractUser(data json.RawMessage) string {
+	var msgData struct {
+		User string `json:"user"`
+	}
+	if err := json.Unmarshal(data, &msgData); err != nil {
+		return ""
+	}
+	return msgData.User
+}
+
+// routeMessageToSubscriptions routes incoming messages to matching subscriptions through the broker
 func (ws *WebSocketService) routeMessageToSubscriptions(channel string, data []byte) error {
 	var msgWrapper struct {
 		Channel string          `json:"channel"`
@@ -376,35 +571,23 @@ func (ws *WebSocketService) routeMessageToSubscriptions(channel string, data []b
 		coin = ws.extractOrderBookCoin(msgWrapper.Data)
 	case "candle":
 		coin, interval = ws.extractCandleMetadata(msgWrapper.Data)
+	case "trades":
+		coin = ws.extractTradesCoin(msgWrapper.Data)
+	case "webData2", "userFills":
+		coin = ws.extractUser(msgWrapper.Data)
 	default:
 		fmt.Printf("🔴 Unknown channel type '%s' for metadata extraction\n", channel)
 	}
 
-	// Build index key for O(1) lookup
-	indexKey := buildIndexKey(channel, coin, interval)
-
-	// Direct O(1) lookup in index
-	ws.indexMu.RLock()
-	subscriptions := ws.subscriptionIndex[indexKey]
-	ws.indexMu.RUnlock()
-
-	if len(subscriptions) == 0 {
-		fmt.Printf("🔴 NO SUBSCRIPTIONS FOUND for key '%s'\n", indexKey)
-		ws.logger.Debug("⚠️  No subscriptions for %s", indexKey)
-		return nil
-	}
+	key := broker.Key{Channel: channel, Coin: coin, Interval: interval}
 
-	// Parse as hyperliquid.WSMessage
 	msg := hyperliquid.WSMessage{
 		Channel: msgWrapper.Channel,
 		Data:    msgWrapper.Data,
 	}
 
-	// Call all matching callbacks
-	for _, sub := range subscriptions {
-		if sub.Callback != nil {
-			sub.Callback(msg)
-		}
+	if delivered := ws.broker.Publish(key, msg); delivered == 0 {
+		ws.logger.Debug("⚠️  No subscriptions for %s", key)
 	}
 
 	return nil
@@ -412,35 +595,53 @@ func (ws *WebSocketService) routeMessageToSubscriptions(channel string, data []b
 
 // sendSubscription sends a subscription message to Hyperliquid
 func (ws *WebSocketService) sendSubscription(channel, coin, interval string) error {
-	fmt.Printf("🟢 sendSubscription CALLED: channel=%s, coin=%s, interval=%s\n", channel, coin, interval)
+	return ws.sendSubscriptionRequest("subscribe", channel, coin, interval)
+}
+
+// sendUnsubscription sends an unsubscribe message to Hyperliquid
+func (ws *WebSocketService) sendUnsubscription(channel, coin, interval string) error {
+	return ws.sendSubscriptionRequest("unsubscribe", channel, coin, interval)
+}
 
-	subMsg := map[string]interface{}{
-		"method": "subscribe",
-		"subscription": map[string]interface{}{
-			"type": channel,
-			"coin": coin,
-		},
+func (ws *WebSocketService) sendSubscriptionRequest(method, channel, coin, interval string) error {
+	subscription := map[string]interface{}{
+		"type": channel,
 	}
 
-	if interval != "" {
-		subMsg["subscription"].(map[string]interface{})["interval"] = interval
+	// User channels are keyed by address rather than coin
+	if isUserChannel(channel) {
+		subscription["user"] = coin
+	} else {
+		subscription["coin"] = coin
 	}
 
-	data, err := json.Marshal(subMsg)
-	if err != nil {
-		fmt.Printf("🔴 Failed to marshal subscription: %v\n", err)
-		return fmt.Errorf("failed to marshal subscription: %w", err)
+	if interval != "" {
+		subscription["interval"] = interval
 	}
 
-	fmt.Printf("🟢 Sending subscription message: %s\n", string(data))
-	err = ws.connManager.Send(data)
+	data, err := json.Marshal(map[string]interface{}{
+		"method":       method,
+		"subscription": subscription,
+	})
 	if err != nil {
-		fmt.Printf("🔴 connManager.Send FAILED: %v\n", err)
-		return err
+		return fmt.Errorf("failed to marshal %s request: %w", method, err)
 	}
 
-	fmt.Printf("✅ Subscription message sent successfully\n")
-	return nil
+	ws.logger.Debug("Sending %s message: %s", method, string(data))
+	return ws.connManager.Send(data)
+}
+
+// subscriptionUpstream lets the broker drive Hyperliquid subscribe/unsubscribe requests
+type subscriptionUpstream struct {
+	ws *WebSocketService
+}
+
+func (u *subscriptionUpstream) Subscribe(key broker.Key) error {
+	return u.ws.subscribeWithConfirmation(key)
+}
+
+func (u *subscriptionUpstream) Unsubscribe(key broker.Key) error {
+	return u.ws.sendUnsubscription(key.Channel, key.Coin, key.Interval)
 }
 
 // Parsing helper functions that use the injected parser
@@ -457,6 +658,10 @@ func (ws *WebSocketService) parseKline(msg hyperliquid.WSMessage) (*KlineMessage
 	return ws.parser.ParseKline(msg)
 }
 
+func (ws *WebSocketService) parseFills(msg hyperliquid.WSMessage) ([]FillMessage, error) {
+	return ws.parser.ParseFills(msg)
+}
+
 // Message handlers for specific channels
 
 func (ws *WebSocketService) handleOrderbookMessage(data []byte) error {
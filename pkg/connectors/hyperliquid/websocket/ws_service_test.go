@@ -0,0 +1,121 @@
+package websocket_test
+
+import (
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	logger "github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	mockwebsocket "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/connectors/hyperliquid/websocket"
+	mockbase "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/websocket/base"
+	mockconnection "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/websocket/connection"
+	"github.com/backtesting-org/live-trading/pkg/connectors/fake"
+	"github.com/backtesting-org/live-trading/pkg/connectors/hyperliquid/websocket"
+	"github.com/backtesting-org/live-trading/pkg/websocket/broker"
+)
+
+var _ = Describe("WebSocketService subscription confirmation", func() {
+	const confirmTimeout = 5 * time.Second
+
+	var (
+		clock     *fake.Clock
+		subs      broker.SubscriptionBroker
+		onMessage func([]byte) error
+		sent      []string
+		sentMu    sync.Mutex
+	)
+
+	btc := broker.Key{Channel: "l2Book", Coin: "BTC"}
+	ignore := func(interface{}) {}
+
+	sends := func() int {
+		sentMu.Lock()
+		defer sentMu.Unlock()
+		return len(sent)
+	}
+
+	BeforeEach(func() {
+		clock = fake.NewClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+		subs = broker.NewSubscriptionBroker(logger.NewNoOpLogger())
+		sent = nil
+
+		connManager := mockconnection.NewConnectionManager(GinkgoT())
+		connManager.On("SetCallbacks", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			onMessage = args.Get(2).(func([]byte) error)
+		}).Return()
+		connManager.On("Send", mock.Anything).Run(func(args mock.Arguments) {
+			sentMu.Lock()
+			defer sentMu.Unlock()
+			sent = append(sent, string(args.Get(0).([]byte)))
+		}).Return(nil).Maybe()
+
+		reconnectMgr := mockconnection.NewReconnectManager(GinkgoT())
+		reconnectMgr.On("SetCallbacks", mock.Anything, mock.Anything, mock.Anything).Return()
+
+		_, err := websocket.NewWebSocketService(
+			connManager,
+			reconnectMgr,
+			mockbase.NewBaseService(GinkgoT()),
+			logger.NewNoOpLogger(),
+			mockwebsocket.NewMessageParser(GinkgoT()),
+			subs,
+			clock,
+		)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("re-sends a subscription that is not confirmed in time", func() {
+		_, err := subs.Attach(btc, ignore)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sends()).To(Equal(1))
+
+		Eventually(clock.Pending).Should(Equal(1))
+		clock.Advance(confirmTimeout - time.Millisecond)
+		Consistently(sends, 50*time.Millisecond).Should(Equal(1))
+
+		clock.Advance(time.Millisecond)
+		Eventually(sends).Should(Equal(2))
+		Expect(sent[1]).To(MatchJSON(`{"method": "subscribe", "subscription": {"type": "l2Book", "coin": "BTC"}}`))
+	})
+
+	It("stops waiting once the subscription is confirmed", func() {
+		_, err := subs.Attach(btc, ignore)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(onMessage([]byte(`{"channel": "subscriptionResponse", "data": {"method": "subscribe", "subscription": {"type": "l2Book", "coin": "BTC"}}}`))).To(Succeed())
+		Expect(clock.Pending()).To(Equal(0))
+
+		clock.Advance(confirmTimeout)
+		Consistently(sends, 50*time.Millisecond).Should(Equal(1))
+	})
+
+	It("gives up after the last attempt", func() {
+		_, err := subs.Attach(btc, ignore)
+		Expect(err).NotTo(HaveOccurred())
+
+		for attempt := 1; attempt < 3; attempt++ {
+			Eventually(clock.Pending).Should(Equal(1))
+			clock.Advance(confirmTimeout)
+			Eventually(sends).Should(Equal(attempt + 1))
+		}
+
+		Eventually(clock.Pending).Should(Equal(1))
+		clock.Advance(confirmTimeout)
+		Eventually(clock.Pending).Should(Equal(0))
+		Consistently(sends, 50*time.Millisecond).Should(Equal(3))
+	})
+
+	It("drops the retry when every consumer detaches", func() {
+		id, err := subs.Attach(btc, ignore)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(subs.Detach(btc, id)).To(Succeed())
+		sentBefore := sends()
+
+		Eventually(clock.Pending).Should(Equal(1))
+		clock.Advance(confirmTimeout)
+		Consistently(sends, 50*time.Millisecond).Should(Equal(sentBefore))
+	})
+})
@@ -38,10 +38,11 @@ func (c HyperliquidWSConfig) ValidationConfig() security.ValidationConfig {
 		MaxMessageSize: c.MaxMessageSize,
 		TypeField:      "channel",
 		AllowedTypes: map[string]bool{
-			"l2Book":   true,
-			"candle":   true,
-			"trades":   true,
-			"webData2": true,
+			"l2Book":    true,
+			"candle":    true,
+			"trades":    true,
+			"webData2":  true,
+			"userFills": true,
 		},
 	}
 }
@@ -50,6 +50,14 @@ func (h *hyperliquid) IsWebSocketConnected() bool {
 	return h.realTime.IsConnected()
 }
 
+// GetWebSocketMetrics returns connection and subscription statistics for the stream
+func (h *hyperliquid) GetWebSocketMetrics() map[string]interface{} {
+	if !h.initialized || h.realTime == nil {
+		return map[string]interface{}{}
+	}
+	return h.realTime.GetMetrics()
+}
+
 // GetOrderBookChannels returns all active orderbook channels
 func (h *hyperliquid) GetOrderBookChannels() map[string]<-chan connector.OrderBook {
 	h.orderBookMu.RLock()
@@ -243,7 +251,7 @@ func (h *hyperliquid) SubscribePositions(asset portfolio.Asset, _ connector.Inst
 
 	symbol := h.normaliseAssetName(asset)
 
-	subID, err := h.realTime.SubscribeToPositions(h.config.AccountAddress, func(posMsg *websocket.PositionMessage) {
+	subID, err := h.realTime.SubscribeToPositions(h.account, func(posMsg *websocket.PositionMessage) {
 		if posMsg.Coin != symbol {
 			return
 		}
@@ -309,7 +317,7 @@ func (h *hyperliquid) SubscribeAccountBalance() error {
 		return fmt.Errorf("connector not initialized")
 	}
 
-	subID, err := h.realTime.SubscribeToAccountBalance(h.config.AccountAddress, func(balMsg *websocket.AccountBalanceMessage) {
+	subID, err := h.realTime.SubscribeToAccountBalance(h.account, func(balMsg *websocket.AccountBalanceMessage) {
 		select {
 		case h.balanceCh <- connector.AccountBalance{
 			TotalBalance:     balMsg.TotalAccountValue,
@@ -354,6 +362,74 @@ func (h *hyperliquid) UnsubscribeAccountBalance() error {
 	return nil
 }
 
+// SubscribeFills follows the account's own fills on the userFills channel
+func (h *hyperliquid) SubscribeFills() error {
+	if !h.initialized {
+		return fmt.Errorf("connector not initialized")
+	}
+
+	subID, err := h.realTime.SubscribeToFills(h.account, func(fills []websocket.FillMessage) {
+		for _, fill := range fills {
+			// "A" = ask/sell, "B" = bid/buy
+			side := connector.OrderSideSell
+			if fill.Side == "B" {
+				side = connector.OrderSideBuy
+			}
+
+			select {
+			case h.fillCh <- connector.Trade{
+				ID:        fmt.Sprintf("%d", fill.TradeID),
+				OrderID:   fmt.Sprintf("%d", fill.OrderID),
+				Symbol:    fill.Coin,
+				Exchange:  types.Hyperliquid,
+				Price:     fill.Price,
+				Quantity:  fill.Quantity,
+				Side:      side,
+				IsMaker:   !fill.Crossed,
+				Fee:       fill.Fee,
+				Timestamp: fill.Timestamp,
+			}:
+			default:
+				select {
+				case h.errorCh <- fmt.Errorf("fill channel full, dropping fill %d", fill.TradeID):
+				default:
+				}
+			}
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	h.subMu.Lock()
+	h.subscriptions["fills"] = subID
+	h.subMu.Unlock()
+	return nil
+}
+
+// UnsubscribeFills stops following the account's fills
+func (h *hyperliquid) UnsubscribeFills() error {
+	if !h.initialized {
+		return fmt.Errorf("connector not initialized")
+	}
+
+	h.subMu.Lock()
+	subID, exists := h.subscriptions["fills"]
+	if !exists {
+		h.subMu.Unlock()
+		return fmt.Errorf("no active subscription for fills")
+	}
+	delete(h.subscriptions, "fills")
+	h.subMu.Unlock()
+
+	return h.realTime.UnsubscribeFromFills(h.account, subID)
+}
+
+// FillUpdates carries the account's fills once SubscribeFills succeeds
+func (h *hyperliquid) FillUpdates() <-chan connector.Trade {
+	return h.fillCh
+}
+
 // SubscribeKlines subscribes to kline updates for an asset
 func (h *hyperliquid) SubscribeKlines(asset portfolio.Asset, interval string) error {
 	if !h.initialized {
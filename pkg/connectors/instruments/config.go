@@ -0,0 +1,37 @@
+package instruments
+
+import "time"
+
+const (
+	// DefaultInterval is how often instrument lists are polled; exchanges
+	// announce delistings days ahead, so this can be slow
+	DefaultInterval = 5 * time.Minute
+
+	// DefaultDelistingHorizon is how far ahead a scheduled delivery triggers
+	// reduce-only mode
+	DefaultDelistingHorizon = 7 * 24 * time.Hour
+
+	// Actor is recorded on trading switches the monitor sets
+	Actor = "instrument-monitor"
+
+	// JobName is the scheduler job the monitor registers under
+	JobName = "instrument-status"
+)
+
+// Config controls polling and the automatic reduce-only response
+type Config struct {
+	Interval         time.Duration
+	DelistingHorizon time.Duration
+
+	// AutoReduceOnly restricts instruments that are delisting, or scheduled
+	// to within DelistingHorizon, to reduce-only trading
+	AutoReduceOnly bool
+}
+
+// DefaultConfig polls every five minutes and only alerts
+func DefaultConfig() Config {
+	return Config{
+		Interval:         DefaultInterval,
+		DelistingHorizon: DefaultDelistingHorizon,
+	}
+}
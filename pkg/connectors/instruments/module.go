@@ -0,0 +1,7 @@
+package instruments
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(NewInstrumentMonitor),
+)
@@ -0,0 +1,341 @@
+package instruments
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/switches"
+	"github.com/backtesting-org/live-trading/pkg/connectors/symbols"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+	"github.com/backtesting-org/live-trading/pkg/scheduler"
+)
+
+// ChangeKind is what changed about an instrument between polls
+type ChangeKind string
+
+const (
+	ChangeStatus            ChangeKind = "status"
+	ChangeDelistingSchedule ChangeKind = "delisting_scheduled"
+	ChangeLeverageReduced   ChangeKind = "leverage_reduced"
+)
+
+// Alert reports an instrument change together with the positions it affects
+type Alert struct {
+	Exchange connector.ExchangeName
+	Asset    portfolio.Asset
+	Kind     ChangeKind
+	Previous types.InstrumentStatus
+	Current  types.InstrumentStatus
+
+	// Positions are the open positions held in the instrument
+	Positions []connector.Position
+
+	// ReduceOnly is set when the monitor restricted the asset in response
+	ReduceOnly bool
+	At         time.Time
+}
+
+// InstrumentMonitor polls instrument lists on every exchange that publishes
+// them and alerts on suspensions, scheduled delistings and leverage cuts
+type InstrumentMonitor interface {
+	Configure(config Config)
+
+	// Start registers the polling job with the scheduler
+	Start() error
+	Stop() error
+
+	// Poll refreshes every exchange now and returns the alerts raised
+	Poll() []Alert
+
+	Status(exchange connector.ExchangeName, symbol string) (types.InstrumentStatus, bool)
+
+	// Delisting lists instruments that are delisting or scheduled to
+	Delisting() map[connector.ExchangeName][]types.InstrumentStatus
+	Alerts() <-chan Alert
+}
+
+type instrumentMonitor struct {
+	registry     registry.ConnectorRegistry
+	scheduler    scheduler.Scheduler
+	switches     switches.TradingSwitches
+	symbols      symbols.SymbolMapper
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config     Config
+	statuses   map[connector.ExchangeName]map[string]types.InstrumentStatus
+	restricted map[connector.ExchangeName]map[string]bool
+	alertCh    chan Alert
+	mu         sync.Mutex
+}
+
+func NewInstrumentMonitor(
+	connectorRegistry registry.ConnectorRegistry,
+	jobScheduler scheduler.Scheduler,
+	tradingSwitches switches.TradingSwitches,
+	symbolMapper symbols.SymbolMapper,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) InstrumentMonitor {
+	return &instrumentMonitor{
+		registry:     connectorRegistry,
+		scheduler:    jobScheduler,
+		switches:     tradingSwitches,
+		symbols:      symbolMapper,
+		timeProvider: timeProvider,
+		logger:       logger,
+		config:       DefaultConfig(),
+		statuses:     make(map[connector.ExchangeName]map[string]types.InstrumentStatus),
+		restricted:   make(map[connector.ExchangeName]map[string]bool),
+		alertCh:      make(chan Alert, 100),
+	}
+}
+
+func (m *instrumentMonitor) Configure(config Config) {
+	defaults := DefaultConfig()
+	if config.Interval <= 0 {
+		config.Interval = defaults.Interval
+	}
+	if config.DelistingHorizon <= 0 {
+		config.DelistingHorizon = defaults.DelistingHorizon
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.config = config
+}
+
+func (m *instrumentMonitor) Alerts() <-chan Alert {
+	return m.alertCh
+}
+
+func (m *instrumentMonitor) Start() error {
+	m.mu.Lock()
+	interval := m.config.Interval
+	m.mu.Unlock()
+
+	return m.scheduler.Register(scheduler.Job{
+		Name:       JobName,
+		Interval:   interval,
+		RunOnStart: true,
+		Run: func(_ context.Context) error {
+			m.Poll()
+			return nil
+		},
+	})
+}
+
+func (m *instrumentMonitor) Stop() error {
+	return m.scheduler.Unregister(JobName)
+}
+
+func (m *instrumentMonitor) Poll() []Alert {
+	m.mu.Lock()
+	config := m.config
+	m.mu.Unlock()
+
+	var alerts []Alert
+	for _, conn := range m.registry.GetReadyConnectors() {
+		provider, ok := conn.(types.InstrumentStatusProvider)
+		if !ok {
+			continue
+		}
+
+		name := conn.GetConnectorInfo().Name
+		statuses, err := provider.FetchInstrumentStatuses()
+		if err != nil {
+			m.logger.Warn("Failed to fetch instrument statuses from %s: %v", name, err)
+			continue
+		}
+
+		alerts = append(alerts, m.apply(conn, name, statuses, config)...)
+	}
+
+	return alerts
+}
+
+// apply diffs one exchange's instrument list against the previous poll. The
+// first poll only reports instruments that are already winding down.
+func (m *instrumentMonitor) apply(conn connector.Connector, name connector.ExchangeName, statuses []types.InstrumentStatus, config Config) []Alert {
+	now := m.timeProvider.Now()
+
+	m.mu.Lock()
+	previous, seen := m.statuses[name]
+	current := make(map[string]types.InstrumentStatus, len(statuses))
+	for _, status := range statuses {
+		current[status.Symbol] = status
+	}
+	m.statuses[name] = current
+	m.mu.Unlock()
+
+	var alerts []Alert
+	for _, status := range statuses {
+		before, existed := previous[status.Symbol]
+		kind, changed := diff(before, status, seen && existed)
+		if !changed {
+			continue
+		}
+
+		alerts = append(alerts, Alert{
+			Exchange: name,
+			Asset:    m.asset(name, status.Symbol),
+			Kind:     kind,
+			Previous: before,
+			Current:  status,
+			At:       now,
+		})
+	}
+
+	if len(alerts) == 0 {
+		return nil
+	}
+
+	positions, err := conn.GetPositions()
+	if err != nil {
+		m.logger.Warn("Failed to fetch %s positions for instrument alerts: %v", name, err)
+	}
+
+	for i := range alerts {
+		alert := &alerts[i]
+		alert.Positions = affected(positions, alert.Asset)
+
+		if config.AutoReduceOnly && winding(alert.Current, now, config.DelistingHorizon) {
+			alert.ReduceOnly = m.restrict(name, *alert)
+		}
+		m.publish(*alert)
+	}
+
+	return alerts
+}
+
+// diff classifies the most severe change; without a prior observation only
+// instruments already winding down are worth reporting
+func diff(before, after types.InstrumentStatus, compare bool) (ChangeKind, bool) {
+	if !compare {
+		if after.State == types.InstrumentDelisting || after.State == types.InstrumentSuspended {
+			return ChangeStatus, true
+		}
+		if !after.DeliveryAt.IsZero() && after.State == types.InstrumentTrading {
+			return ChangeDelistingSchedule, true
+		}
+		return "", false
+	}
+
+	if before.State != after.State {
+		return ChangeStatus, true
+	}
+	if !after.DeliveryAt.IsZero() && !after.DeliveryAt.Equal(before.DeliveryAt) {
+		return ChangeDelistingSchedule, true
+	}
+	if before.MaxLeverage.IsPositive() && after.MaxLeverage.IsPositive() && after.MaxLeverage.LessThan(before.MaxLeverage) {
+		return ChangeLeverageReduced, true
+	}
+	return "", false
+}
+
+// winding reports whether new exposure in the instrument should stop
+func winding(status types.InstrumentStatus, now time.Time, horizon time.Duration) bool {
+	switch status.State {
+	case types.InstrumentDelisting, types.InstrumentDelisted:
+		return true
+	}
+	return !status.DeliveryAt.IsZero() && status.DeliveryAt.Sub(now) <= horizon
+}
+
+// restrict sets a reduce-only switch once per instrument
+func (m *instrumentMonitor) restrict(name connector.ExchangeName, alert Alert) bool {
+	symbol := alert.Current.Symbol
+
+	m.mu.Lock()
+	if m.restricted[name] == nil {
+		m.restricted[name] = make(map[string]bool)
+	}
+	if m.restricted[name][symbol] {
+		m.mu.Unlock()
+		return true
+	}
+	m.restricted[name][symbol] = true
+	m.mu.Unlock()
+
+	reason := fmt.Sprintf("%s is %s", symbol, alert.Current.State)
+	if !alert.Current.DeliveryAt.IsZero() {
+		reason = fmt.Sprintf("%s delists at %s", symbol, alert.Current.DeliveryAt.Format(time.RFC3339))
+	}
+
+	if err := m.switches.RestrictToReduceOnly(alert.Asset, name, reason, Actor); err != nil {
+		m.logger.Error("Failed to restrict %s on %s to reduce-only: %v", symbol, name, err)
+
+		m.mu.Lock()
+		delete(m.restricted[name], symbol)
+		m.mu.Unlock()
+		return false
+	}
+	return true
+}
+
+func (m *instrumentMonitor) publish(alert Alert) {
+	m.logger.Warn("⚠️ %s %s %s: %s -> %s (delivery %v, %d open positions)",
+		alert.Exchange, alert.Current.Symbol, alert.Kind, alert.Previous.State, alert.Current.State,
+		alert.Current.DeliveryAt, len(alert.Positions))
+
+	select {
+	case m.alertCh <- alert:
+	default:
+		m.logger.Warn("Instrument alert channel full, dropping alert for %s", alert.Current.Symbol)
+	}
+}
+
+func (m *instrumentMonitor) asset(name connector.ExchangeName, symbol string) portfolio.Asset {
+	asset, _, err := m.symbols.FromNative(name, symbol)
+	if err != nil {
+		return portfolio.NewAsset(symbol)
+	}
+	return asset
+}
+
+// affected filters positions to the alert's asset. Connectors report
+// positions by base asset, so the comparison is on the asset symbol.
+func affected(positions []connector.Position, asset portfolio.Asset) []connector.Position {
+	var result []connector.Position
+	for _, position := range positions {
+		if position.Size.IsZero() {
+			continue
+		}
+		if strings.EqualFold(position.Symbol.Symbol(), asset.Symbol()) {
+			result = append(result, position)
+		}
+	}
+	return result
+}
+
+func (m *instrumentMonitor) Status(exchange connector.ExchangeName, symbol string) (types.InstrumentStatus, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	status, ok := m.statuses[exchange][symbol]
+	return status, ok
+}
+
+func (m *instrumentMonitor) Delisting() map[connector.ExchangeName][]types.InstrumentStatus {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	result := make(map[connector.ExchangeName][]types.InstrumentStatus)
+	for name, statuses := range m.statuses {
+		for _, status := range statuses {
+			if status.State == types.InstrumentDelisting || !status.DeliveryAt.IsZero() {
+				result[name] = append(result[name], status)
+			}
+		}
+		sort.Slice(result[name], func(i, j int) bool { return result[name][i].Symbol < result[name][j].Symbol })
+	}
+	return result
+}
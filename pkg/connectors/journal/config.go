@@ -0,0 +1,33 @@
+package journal
+
+import "time"
+
+const (
+	// DefaultSegmentDuration is the time span covered by one segment file
+	DefaultSegmentDuration = 24 * time.Hour
+
+	// DefaultRetention is how long closed segments are kept before pruning
+	DefaultRetention = 90 * 24 * time.Hour
+
+	// JobName is the scheduler job Prune runs under
+	JobName = "order-journal-prune"
+
+	// PruneInterval is how often segments past retention are removed
+	PruneInterval = time.Hour
+)
+
+// Config controls where the journal is written and how long it is kept
+type Config struct {
+	Directory       string
+	SegmentDuration time.Duration
+	Retention       time.Duration
+}
+
+// DefaultConfig returns a journal rooted at the given directory
+func DefaultConfig(directory string) Config {
+	return Config{
+		Directory:       directory,
+		SegmentDuration: DefaultSegmentDuration,
+		Retention:       DefaultRetention,
+	}
+}
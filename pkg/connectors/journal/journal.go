@@ -0,0 +1,367 @@
+package journal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/jsonl"
+	"github.com/backtesting-org/live-trading/pkg/scheduler"
+)
+
+const segmentSuffix = ".jsonl"
+
+// OrderTransition is one journaled change in an order's state
+type OrderTransition struct {
+	Sequence   uint64                 `json:"seq"`
+	RecordedAt time.Time              `json:"recorded_at"`
+	Exchange   connector.ExchangeName `json:"exchange"`
+	OrderID    string                 `json:"order_id"`
+	Symbol     string                 `json:"symbol"`
+	From       connector.OrderStatus  `json:"from,omitempty"`
+	To         connector.OrderStatus  `json:"to"`
+
+	// Event names a lifecycle step that is not a status change, such as a
+	// fill policy escalation; Payload then carries its detail
+	Event   string          `json:"event,omitempty"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+
+	// Raw marks a Payload that is the exchange's own response about the
+	// order rather than the order as the tracker decoded it
+	Raw bool `json:"raw,omitempty"`
+}
+
+// OrderJournal is an append-only, clock-stamped record of order state
+// transitions kept for disputes with exchanges
+type OrderJournal interface {
+	Configure(config Config) error
+
+	// Record stamps the transition with a sequence number and the local
+	// clock, then appends it to the current segment
+	Record(transition OrderTransition) error
+
+	// Export writes every transition for an order as JSON lines, oldest first
+	Export(orderID string, w io.Writer) error
+
+	// Transitions returns every transition for an order, oldest first
+	Transitions(orderID string) ([]OrderTransition, error)
+
+	// Prune removes segments older than the retention window
+	Prune() error
+
+	// Start registers Prune with the scheduler to run every PruneInterval;
+	// Stop removes it
+	Start() error
+	Stop() error
+
+	// Handler serves the transitions of the order named by the order query
+	// parameter as JSON lines
+	Handler() http.Handler
+
+	Close() error
+}
+
+type orderJournal struct {
+	config       Config
+	scheduler    scheduler.Scheduler
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	file         *os.File
+	segmentStart time.Time
+	sequence     uint64
+	configured   bool
+	mu           sync.Mutex
+}
+
+func NewOrderJournal(
+	jobScheduler scheduler.Scheduler,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) OrderJournal {
+	return &orderJournal{
+		scheduler:    jobScheduler,
+		timeProvider: timeProvider,
+		logger:       logger,
+	}
+}
+
+func (j *orderJournal) Configure(config Config) error {
+	if config.Directory == "" {
+		return fmt.Errorf("journal directory is required")
+	}
+	if config.SegmentDuration <= 0 {
+		config.SegmentDuration = DefaultSegmentDuration
+	}
+
+	if err := os.MkdirAll(config.Directory, 0o750); err != nil {
+		return fmt.Errorf("failed to create journal directory: %w", err)
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.configured {
+		return fmt.Errorf("journal already configured")
+	}
+
+	j.config = config
+	j.sequence = uint64(j.timeProvider.Now().UnixNano())
+	j.configured = true
+	return nil
+}
+
+func (j *orderJournal) Record(transition OrderTransition) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if !j.configured {
+		return fmt.Errorf("journal not configured")
+	}
+
+	now := j.timeProvider.Now()
+	if err := j.rotate(now); err != nil {
+		return err
+	}
+
+	// Sequence is seeded from the clock at configure time so it stays
+	// monotonic across restarts without reading old segments
+	j.sequence++
+	transition.Sequence = j.sequence
+	transition.RecordedAt = now
+
+	line, err := jsonl.Marshal(transition)
+	if err != nil {
+		return fmt.Errorf("failed to encode transition: %w", err)
+	}
+
+	if _, err := j.file.Write(line); err != nil {
+		return fmt.Errorf("failed to append transition: %w", err)
+	}
+
+	return j.file.Sync()
+}
+
+func (j *orderJournal) Export(orderID string, w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	return j.scan(orderID, func(transition OrderTransition) error {
+		if err := encoder.Encode(transition); err != nil {
+			return fmt.Errorf("failed to write transition: %w", err)
+		}
+		return nil
+	})
+}
+
+func (j *orderJournal) Transitions(orderID string) ([]OrderTransition, error) {
+	var transitions []OrderTransition
+	err := j.scan(orderID, func(transition OrderTransition) error {
+		transitions = append(transitions, transition)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return transitions, nil
+}
+
+// scan visits every transition for an order, oldest first
+func (j *orderJournal) scan(orderID string, visit func(OrderTransition) error) error {
+	j.mu.Lock()
+	segments, err := j.segments()
+	j.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	for _, segment := range segments {
+		if err := scanSegment(segment, orderID, visit); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (j *orderJournal) Prune() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if !j.configured || j.config.Retention <= 0 {
+		return nil
+	}
+
+	segments, err := j.segments()
+	if err != nil {
+		return err
+	}
+
+	cutoff := j.timeProvider.Now().Add(-j.config.Retention)
+	for _, segment := range segments {
+		start, err := segmentTime(segment)
+		if err != nil || j.file != nil && segment == j.file.Name() {
+			continue
+		}
+
+		// A segment is only removed once its newest possible entry is past retention
+		if start.Add(j.config.SegmentDuration).Before(cutoff) {
+			if err := os.Remove(segment); err != nil {
+				return fmt.Errorf("failed to prune segment %s: %w", segment, err)
+			}
+			j.logger.Info("Pruned order journal segment %s", filepath.Base(segment))
+		}
+	}
+
+	return nil
+}
+
+func (j *orderJournal) Start() error {
+	return j.scheduler.Register(scheduler.Job{
+		Name:       JobName,
+		Interval:   PruneInterval,
+		RunOnStart: true,
+		Run: func(_ context.Context) error {
+			return j.Prune()
+		},
+	})
+}
+
+func (j *orderJournal) Stop() error {
+	return j.scheduler.Unregister(JobName)
+}
+
+func (j *orderJournal) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		orderID := req.URL.Query().Get("order")
+		if orderID == "" {
+			http.Error(w, "order is required", http.StatusBadRequest)
+			return
+		}
+
+		transitions, err := j.Transitions(orderID)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if len(transitions) == 0 {
+			http.Error(w, fmt.Sprintf("no transitions journaled for order %s", orderID), http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		encoder := json.NewEncoder(w)
+		for _, transition := range transitions {
+			if err := encoder.Encode(transition); err != nil {
+				j.logger.Warn("Order journal export for %s failed: %v", orderID, err)
+				return
+			}
+		}
+	})
+}
+
+func (j *orderJournal) Close() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.file == nil {
+		return nil
+	}
+
+	err := j.file.Close()
+	j.file = nil
+	return err
+}
+
+// rotate opens the segment covering now, closing the previous one; caller must hold j.mu
+func (j *orderJournal) rotate(now time.Time) error {
+	start := now.UTC().Truncate(j.config.SegmentDuration)
+	if j.file != nil && start.Equal(j.segmentStart) {
+		return nil
+	}
+
+	if j.file != nil {
+		if err := j.file.Close(); err != nil {
+			j.logger.Warn("Failed to close order journal segment: %v", err)
+		}
+	}
+
+	name := filepath.Join(j.config.Directory, start.Format("20060102T150405Z")+segmentSuffix)
+	file, err := jsonl.OpenAppend(name, 0o640)
+	if err != nil {
+		j.file = nil
+		return fmt.Errorf("failed to open journal segment: %w", err)
+	}
+
+	j.file = file
+	j.segmentStart = start
+	return nil
+}
+
+// segments lists segment files oldest first; caller must hold j.mu
+func (j *orderJournal) segments() ([]string, error) {
+	if !j.configured {
+		return nil, fmt.Errorf("journal not configured")
+	}
+
+	entries, err := os.ReadDir(j.config.Directory)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list journal segments: %w", err)
+	}
+
+	var segments []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), segmentSuffix) {
+			segments = append(segments, filepath.Join(j.config.Directory, entry.Name()))
+		}
+	}
+
+	// Names are UTC timestamps, so lexical order is chronological
+	sort.Strings(segments)
+	return segments, nil
+}
+
+func segmentTime(path string) (time.Time, error) {
+	return time.Parse("20060102T150405Z", strings.TrimSuffix(filepath.Base(path), segmentSuffix))
+}
+
+func scanSegment(path, orderID string, visit func(OrderTransition) error) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open segment %s: %w", path, err)
+	}
+	defer file.Close()
+
+	var written error
+	err = jsonl.Scan(file, func(_ int, line []byte) error {
+		var transition OrderTransition
+		if err := json.Unmarshal(line, &transition); err != nil {
+			// A torn final line from a crash should not block exporting the rest
+			return nil
+		}
+		if transition.OrderID != orderID {
+			return nil
+		}
+		if err := visit(transition); err != nil {
+			written = err
+			return written
+		}
+		return nil
+	})
+	if written != nil {
+		return written
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read segment %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package journal
+
+import (
+	"context"
+
+	"go.uber.org/fx"
+)
+
+var Module = fx.Options(
+	fx.Provide(NewOrderJournal),
+	fx.Invoke(registerHooks),
+)
+
+// registerHooks prunes the journal for the application's lifetime and
+// closes its open segment on shutdown
+func registerHooks(lifecycle fx.Lifecycle, orderJournal OrderJournal) {
+	lifecycle.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			return orderJournal.Start()
+		},
+		OnStop: func(context.Context) error {
+			if err := orderJournal.Stop(); err != nil {
+				return err
+			}
+			return orderJournal.Close()
+		},
+	})
+}
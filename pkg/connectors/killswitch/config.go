@@ -0,0 +1,36 @@
+package killswitch
+
+import (
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+)
+
+const (
+	// DefaultInterval is how often equity is sampled for the drawdown breaker
+	DefaultInterval = 30 * time.Second
+
+	// JobName is the scheduler job the drawdown breaker registers under
+	JobName = "drawdown-breaker"
+)
+
+// Config controls the kill switch and its automatic drawdown breaker
+type Config struct {
+	Interval time.Duration
+
+	// MaxDrawdown is the fraction of peak equity, e.g. 0.1 for 10%, that
+	// trips the breaker; zero disables automatic triggering
+	MaxDrawdown numerical.Decimal
+
+	// FlattenOnBreach closes positions as well as cancelling orders when
+	// the breaker trips
+	FlattenOnBreach bool
+}
+
+// DefaultConfig samples equity every 30 seconds with the breaker disabled
+func DefaultConfig() Config {
+	return Config{
+		Interval:    DefaultInterval,
+		MaxDrawdown: numerical.Zero(),
+	}
+}
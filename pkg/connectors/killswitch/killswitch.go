@@ -0,0 +1,629 @@
+package killswitch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/data/stores/activity"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/execution"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/markprice"
+	"github.com/backtesting-org/live-trading/pkg/connectors/symbols"
+	"github.com/backtesting-org/live-trading/pkg/runreport"
+	"github.com/backtesting-org/live-trading/pkg/scheduler"
+)
+
+// ErrEngaged is returned for every signal refused while the switch is engaged
+var ErrEngaged = errors.New("kill switch engaged")
+
+// HaltHandler is told about a halt after the strategies are disabled and
+// before any orders are touched, e.g. to stop work outside the registry
+type HaltHandler func(reason string)
+
+// Report summarises what a kill switch activation did on each exchange
+type Report struct {
+	Reason      string
+	TriggeredAt time.Time
+	Cancelled   map[connector.ExchangeName]int
+	Flattened   map[connector.ExchangeName]int
+	Errors      []string
+}
+
+// Status is whether the switch is engaged and what its last activation did
+type Status struct {
+	Engaged bool
+	Halted  []strategy.StrategyName
+	Last    *Report
+
+	// RunID is the run the drawdown breaker is measuring, with the capital
+	// it started from, its peak equity since and its PnL at the last sample
+	RunID       string
+	StartEquity numerical.Decimal
+	PeakEquity  numerical.Decimal
+	PnL         numerical.Decimal
+}
+
+// KillSwitch stops all trading at once: every enabled strategy is disabled,
+// signals are refused, the strategies' open orders cancelled on every ready
+// connector and, optionally, the active run's positions flattened. Orders
+// and positions the run does not own are left alone.
+type KillSwitch interface {
+	// Trigger engages the switch; it is safe to call again while engaged
+	Trigger(reason string, flatten bool) (*Report, error)
+
+	// Reset disengages the switch and re-enables the strategies it disabled
+	Reset()
+	Engaged() bool
+	Status() Status
+
+	// Start registers the drawdown breaker with the scheduler
+	Start() error
+	Stop() error
+
+	// CheckDrawdown samples the active run's equity, the capital it started
+	// from plus its realized and unrealized PnL, and trips the breaker when
+	// the drawdown from the run's peak breaches the limit; with no active
+	// run there is nothing to measure
+	CheckDrawdown() (numerical.Decimal, error)
+
+	// Hook refuses every signal with ErrEngaged while the switch is
+	// engaged; it is registered with the SDK hook registry
+	Hook() execution.ExecutionHook
+
+	// Handler serves Status on GET, triggers on POST with ?reason= and
+	// optional ?flatten=true, and resets on DELETE
+	Handler() http.Handler
+
+	SetHaltHandler(handler HaltHandler)
+	Configure(config Config)
+}
+
+// runDrawdown is the active run's drawdown baseline
+type runDrawdown struct {
+	runID string
+	start numerical.Decimal
+	peak  numerical.Decimal
+	pnl   numerical.Decimal
+}
+
+type killSwitch struct {
+	registry     registry.ConnectorRegistry
+	strategies   registry.StrategyRegistry
+	assets       registry.AssetRegistry
+	positions    activity.Positions
+	reporter     runreport.RunReporter
+	marks        markprice.MarkPriceFeed
+	scheduler    scheduler.Scheduler
+	symbols      symbols.SymbolMapper
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config  Config
+	halt    HaltHandler
+	engaged bool
+	halted  map[strategy.StrategyName]bool
+	last    *Report
+	run     *runDrawdown
+	mu      sync.Mutex
+}
+
+func NewKillSwitch(
+	connectorRegistry registry.ConnectorRegistry,
+	strategyRegistry registry.StrategyRegistry,
+	assetRegistry registry.AssetRegistry,
+	positions activity.Positions,
+	reporter runreport.RunReporter,
+	markPriceFeed markprice.MarkPriceFeed,
+	jobScheduler scheduler.Scheduler,
+	symbolMapper symbols.SymbolMapper,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) KillSwitch {
+	return &killSwitch{
+		registry:     connectorRegistry,
+		strategies:   strategyRegistry,
+		assets:       assetRegistry,
+		positions:    positions,
+		reporter:     reporter,
+		marks:        markPriceFeed,
+		scheduler:    jobScheduler,
+		symbols:      symbolMapper,
+		timeProvider: timeProvider,
+		logger:       logger,
+		config:       DefaultConfig(),
+		halted:       make(map[strategy.StrategyName]bool),
+	}
+}
+
+func (k *killSwitch) Configure(config Config) {
+	if config.Interval <= 0 {
+		config.Interval = DefaultInterval
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.config = config
+}
+
+func (k *killSwitch) SetHaltHandler(handler HaltHandler) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.halt = handler
+}
+
+func (k *killSwitch) Engaged() bool {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	return k.engaged
+}
+
+func (k *killSwitch) Status() Status {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	status := Status{Engaged: k.engaged, Last: k.last}
+	for name := range k.halted {
+		status.Halted = append(status.Halted, name)
+	}
+	sort.Slice(status.Halted, func(i, j int) bool { return status.Halted[i] < status.Halted[j] })
+	if k.run != nil {
+		status.RunID = k.run.runID
+		status.StartEquity = k.run.start
+		status.PeakEquity = k.run.peak
+		status.PnL = k.run.pnl
+	}
+	return status
+}
+
+func (k *killSwitch) Reset() {
+	k.mu.Lock()
+	k.engaged = false
+	// Start the drawdown measurement afresh rather than re-tripping on
+	// the peak that caused the last breach
+	k.run = nil
+	halted := k.halted
+	k.halted = make(map[strategy.StrategyName]bool)
+	k.mu.Unlock()
+
+	for name := range halted {
+		if err := k.strategies.EnableStrategy(name); err != nil {
+			k.logger.Error("Kill switch reset could not re-enable strategy %s: %v", name, err)
+		}
+	}
+	k.logger.Info("Kill switch reset, %d strategies re-enabled", len(halted))
+}
+
+func (k *killSwitch) Start() error {
+	k.mu.Lock()
+	interval := k.config.Interval
+	k.mu.Unlock()
+
+	return k.scheduler.Register(scheduler.Job{
+		Name:       JobName,
+		Interval:   interval,
+		RunOnStart: true,
+		Run: func(_ context.Context) error {
+			_, err := k.CheckDrawdown()
+			return err
+		},
+	})
+}
+
+func (k *killSwitch) Stop() error {
+	return k.scheduler.Unregister(JobName)
+}
+
+func (k *killSwitch) Trigger(reason string, flatten bool) (*Report, error) {
+	k.mu.Lock()
+	k.engaged = true
+	halt := k.halt
+	k.mu.Unlock()
+
+	k.logger.Error("🛑 Kill switch engaged: %s", reason)
+
+	k.haltStrategies()
+	if halt != nil {
+		halt(reason)
+	}
+
+	report := &Report{
+		Reason:      reason,
+		TriggeredAt: k.timeProvider.Now(),
+		Cancelled:   make(map[connector.ExchangeName]int),
+		Flattened:   make(map[connector.ExchangeName]int),
+	}
+
+	var positions []runreport.Position
+	if flatten {
+		positions = k.runPositions(report)
+	}
+
+	for _, conn := range k.registry.GetReadyConnectors() {
+		if !conn.SupportsTradingOperations() {
+			continue
+		}
+		name := conn.GetConnectorInfo().Name
+
+		report.Cancelled[name] = k.cancelAll(conn, name, report)
+		if flatten {
+			report.Flattened[name] = k.flatten(conn, name, positions, report)
+		}
+	}
+
+	k.mu.Lock()
+	k.last = report
+	k.mu.Unlock()
+
+	if len(report.Errors) > 0 {
+		return report, fmt.Errorf("kill switch incomplete: %s", strings.Join(report.Errors, "; "))
+	}
+	return report, nil
+}
+
+// haltStrategies disables every enabled strategy, remembering which so
+// Reset re-enables only those
+func (k *killSwitch) haltStrategies() {
+	for _, strat := range k.strategies.GetEnabledStrategies() {
+		name := strat.GetName()
+		if err := k.strategies.DisableStrategy(name); err != nil {
+			k.logger.Error("Kill switch could not disable strategy %s: %v", name, err)
+			continue
+		}
+		k.mu.Lock()
+		k.halted[name] = true
+		k.mu.Unlock()
+	}
+}
+
+func (k *killSwitch) CheckDrawdown() (numerical.Decimal, error) {
+	k.mu.Lock()
+	config := k.config
+	engaged := k.engaged
+	k.mu.Unlock()
+
+	runID, ok := k.reporter.Active()
+	if !ok {
+		return numerical.Zero(), nil
+	}
+
+	pnl, err := k.runPnL(runID)
+	if err != nil {
+		return numerical.Zero(), err
+	}
+
+	k.mu.Lock()
+	fresh := k.run == nil || k.run.runID != runID
+	k.mu.Unlock()
+
+	// Each run is measured from the capital it started with, read once at
+	// its first sample, and moves only with the run's own PnL after that,
+	// so a loss taken by an earlier run, another process or by hand never
+	// counts against it
+	var capital numerical.Decimal
+	if fresh {
+		if capital, err = k.capital(); err != nil {
+			return numerical.Zero(), err
+		}
+	}
+
+	k.mu.Lock()
+	if k.run == nil || k.run.runID != runID {
+		if !fresh {
+			// Reset between the reads; the next sample starts afresh
+			k.mu.Unlock()
+			return numerical.Zero(), nil
+		}
+		start := capital.Sub(pnl)
+		k.run = &runDrawdown{runID: runID, start: start, peak: start}
+	}
+	k.run.pnl = pnl
+	equity := k.run.start.Add(pnl)
+	if equity.GreaterThan(k.run.peak) {
+		k.run.peak = equity
+	}
+	start, peak := k.run.start, k.run.peak
+	k.mu.Unlock()
+
+	if !peak.IsPositive() {
+		return numerical.Zero(), nil
+	}
+
+	drawdown := peak.Sub(equity).Div(peak)
+	if engaged || !config.MaxDrawdown.IsPositive() || drawdown.LessThan(config.MaxDrawdown) {
+		return drawdown, nil
+	}
+
+	reason := fmt.Sprintf("run %s drawdown %s%% exceeds limit %s%% (equity %s, peak %s, start %s, PnL %s)",
+		runID,
+		drawdown.Mul(numerical.NewFromInt(100)).Round(2).String(),
+		config.MaxDrawdown.Mul(numerical.NewFromInt(100)).Round(2).String(),
+		equity.String(), peak.String(), start.String(), pnl.String())
+
+	_, err = k.Trigger(reason, config.FlattenOnBreach)
+	return drawdown, err
+}
+
+// capital sums TotalBalance across ready connectors. It only sizes a run's
+// drawdown; the account balance also moves with trading the run does not
+// own, so later samples follow the run's PnL instead.
+func (k *killSwitch) capital() (numerical.Decimal, error) {
+	total := numerical.Zero()
+	var failed []string
+
+	for _, conn := range k.registry.GetReadyConnectors() {
+		if !conn.SupportsTradingOperations() {
+			continue
+		}
+
+		balance, err := conn.GetAccountBalance()
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", conn.GetConnectorInfo().Name, err))
+			continue
+		}
+		total = total.Add(balance.TotalBalance)
+	}
+
+	// A partial sum would read as a drawdown, so skip this sample entirely
+	if len(failed) > 0 {
+		return numerical.Zero(), fmt.Errorf("equity unavailable: %s", strings.Join(failed, "; "))
+	}
+	return total, nil
+}
+
+// runPnL is what the run has made: the net realized PnL booked since it
+// began plus its open positions valued at the mark. A position without a
+// mark fails the sample, as leaving it out would read as a move in equity.
+func (k *killSwitch) runPnL(runID string) (numerical.Decimal, error) {
+	realized, err := k.reporter.RealizedPnL(runID)
+	if err != nil {
+		return numerical.Zero(), err
+	}
+	positions, err := k.reporter.Positions(runID)
+	if err != nil {
+		return numerical.Zero(), err
+	}
+
+	unrealized := numerical.Zero()
+	held := make(map[connector.ExchangeName][]connector.Position)
+	var failed []string
+
+	for _, position := range positions {
+		exchangePositions, ok := held[position.Exchange]
+		if !ok {
+			if exchangePositions, err = k.exchangePositions(position.Exchange); err != nil {
+				failed = append(failed, fmt.Sprintf("%s: %v", position.Exchange, err))
+				continue
+			}
+			held[position.Exchange] = exchangePositions
+		}
+
+		pnl, ok := k.unrealized(position, exchangePositions)
+		if !ok {
+			failed = append(failed, fmt.Sprintf("%s/%s: no mark price", position.Exchange, position.Symbol))
+			continue
+		}
+		unrealized = unrealized.Add(pnl)
+	}
+
+	if len(failed) > 0 {
+		return numerical.Zero(), fmt.Errorf("run PnL unavailable: %s", strings.Join(failed, "; "))
+	}
+	return realized.Add(unrealized), nil
+}
+
+func (k *killSwitch) exchangePositions(name connector.ExchangeName) ([]connector.Position, error) {
+	conn, ok := k.registry.GetConnector(name)
+	if !ok {
+		return nil, fmt.Errorf("connector not registered")
+	}
+	return conn.GetPositions()
+}
+
+// unrealized values the run's part of a position from the ledger's entry
+// price, at the tracked mark or else the mark the exchange reports
+func (k *killSwitch) unrealized(position runreport.Position, held []connector.Position) (numerical.Decimal, bool) {
+	asset := k.assetOf(position.Exchange, position.Symbol)
+	valued := connector.Position{
+		Symbol:     asset,
+		Exchange:   position.Exchange,
+		Side:       connector.OrderSideBuy,
+		Size:       position.Size.Abs(),
+		EntryPrice: position.EntryPrice,
+	}
+	if position.Size.IsNegative() {
+		valued.Side = connector.OrderSideSell
+	}
+
+	for _, exchangePosition := range held {
+		if k.assetOf(position.Exchange, exchangePosition.Symbol.Symbol()).Symbol() == asset.Symbol() {
+			valued.MarkPrice = exchangePosition.MarkPrice
+			break
+		}
+	}
+	return k.marks.UnrealizedPnL(valued)
+}
+
+// runPositions is what the active run has opened; with no active run there
+// is nothing of the run's to flatten
+func (k *killSwitch) runPositions(report *Report) []runreport.Position {
+	runID, ok := k.reporter.Active()
+	if !ok {
+		k.logger.Info("Kill switch has no active run to flatten")
+		return nil
+	}
+
+	positions, err := k.reporter.Positions(runID)
+	if err != nil {
+		report.Errors = append(report.Errors, fmt.Sprintf("run %s: fetch positions: %v", runID, err))
+		return nil
+	}
+	return positions
+}
+
+// cancelAll cancels the open orders a strategy placed, leaving orders
+// placed by hand or by another process on the same account alone
+func (k *killSwitch) cancelAll(conn connector.Connector, name connector.ExchangeName, report *Report) int {
+	orders, err := conn.GetOpenOrders()
+	if err != nil {
+		report.Errors = append(report.Errors, fmt.Sprintf("%s: fetch open orders: %v", name, err))
+		return 0
+	}
+
+	owned, cancelled := 0, 0
+	for _, order := range orders {
+		if _, ok := k.positions.GetStrategyForOrder(order.ID); !ok {
+			continue
+		}
+		owned++
+
+		if _, err := conn.CancelOrder(order.Symbol, order.ID); err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("%s: cancel %s: %v", name, order.ID, err))
+			continue
+		}
+		cancelled++
+	}
+
+	k.logger.Info("Kill switch cancelled %d/%d strategy orders on %s", cancelled, owned, name)
+	return cancelled
+}
+
+// flatten closes the run's positions on the exchange at market. A close is
+// capped at what the ledger still holds, so a position already reduced by
+// hand is not flipped.
+func (k *killSwitch) flatten(conn connector.Connector, name connector.ExchangeName, positions []runreport.Position, report *Report) int {
+	closing, flattened := 0, 0
+	for _, position := range positions {
+		if position.Exchange != name || position.Size.Sign() != position.Held.Sign() {
+			continue
+		}
+		closing++
+
+		side := connector.OrderSideSell
+		if position.Size.IsNegative() {
+			side = connector.OrderSideBuy
+		}
+		size := position.Size.Abs()
+		if held := position.Held.Abs(); held.LessThan(size) {
+			size = held
+		}
+
+		symbol := k.orderSymbol(conn, position.Symbol)
+		if _, err := conn.PlaceMarketOrder(symbol, side, size); err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("%s: close %s: %v", name, symbol, err))
+			continue
+		}
+		flattened++
+	}
+
+	k.logger.Info("Kill switch flattened %d/%d run positions on %s", flattened, closing, name)
+	return flattened
+}
+
+func (k *killSwitch) Hook() execution.ExecutionHook {
+	return &haltHook{switch_: k}
+}
+
+func (k *killSwitch) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case http.MethodGet:
+		case http.MethodPost:
+			reason := req.URL.Query().Get("reason")
+			if reason == "" {
+				http.Error(w, "reason is required", http.StatusBadRequest)
+				return
+			}
+			if _, err := k.Trigger(reason, req.URL.Query().Get("flatten") == "true"); err != nil {
+				// The switch is engaged even when some cancels failed; the
+				// status below carries the errors
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadGateway)
+				_ = json.NewEncoder(w).Encode(k.Status())
+				return
+			}
+		case http.MethodDelete:
+			k.Reset()
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(k.Status())
+	})
+}
+
+// haltHook refuses signals inside the SDK executor, so nothing reaches an
+// exchange between the switch engaging and the strategies stopping
+type haltHook struct {
+	switch_ *killSwitch
+}
+
+func (h *haltHook) BeforeExecute(ctx *execution.ExecutionContext) error {
+	if !h.switch_.Engaged() {
+		return nil
+	}
+	if ctx.Signal != nil {
+		return fmt.Errorf("signal %s from %s refused: %w", ctx.Signal.ID, ctx.Signal.Strategy, ErrEngaged)
+	}
+	return ErrEngaged
+}
+
+func (h *haltHook) AfterExecute(*execution.ExecutionContext, *execution.ExecutionResult) error {
+	return nil
+}
+
+func (h *haltHook) OnError(*execution.ExecutionContext, error) error {
+	return nil
+}
+
+// orderSymbol maps a position's market to the connector's native symbol for
+// the position's instrument. The ledger holds whichever symbol a connector
+// reports fills under, base asset or full market; the mapper accepts either.
+func (k *killSwitch) orderSymbol(conn connector.Connector, symbol string) string {
+	name := conn.GetConnectorInfo().Name
+	instrument := k.instrumentOf(name, symbol)
+
+	native, err := k.symbols.Resolve(name, symbol, instrument)
+	if err != nil {
+		if instrument == connector.TypePerpetual {
+			return conn.GetPerpSymbol(k.assetOf(name, symbol))
+		}
+		return symbol
+	}
+	return native
+}
+
+// instrumentOf reads the instrument from a full market symbol, else from the
+// asset's registered instrument when it has only one; a bare asset traded on
+// several instruments is taken to be the perpetual, as connectors list it
+func (k *killSwitch) instrumentOf(exchange connector.ExchangeName, symbol string) connector.Instrument {
+	if _, instrument, err := k.symbols.FromNative(exchange, symbol); err == nil {
+		return instrument
+	}
+	if instruments := k.assets.GetInstrumentTypes(portfolio.NewAsset(symbol)); len(instruments) == 1 {
+		return instruments[0]
+	}
+	return connector.TypePerpetual
+}
+
+// assetOf is the asset behind a base asset or full market symbol
+func (k *killSwitch) assetOf(exchange connector.ExchangeName, symbol string) portfolio.Asset {
+	if asset, _, err := k.symbols.FromNative(exchange, symbol); err == nil {
+		return asset
+	}
+	return portfolio.NewAsset(symbol)
+}
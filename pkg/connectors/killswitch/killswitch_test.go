@@ -0,0 +1,13 @@
+package killswitch_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestKillSwitch(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Kill Switch Suite")
+}
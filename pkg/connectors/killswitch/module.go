@@ -0,0 +1,28 @@
+package killswitch
+
+import (
+	"context"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"go.uber.org/fx"
+)
+
+var Module = fx.Options(
+	fx.Provide(NewKillSwitch),
+	fx.Invoke(registerHooks),
+)
+
+// registerHooks has the SDK executor refuse signals while the switch is
+// engaged and runs the drawdown breaker for the application's lifetime
+func registerHooks(lifecycle fx.Lifecycle, hooks registry.Hooks, killSwitch KillSwitch) {
+	hooks.RegisterHook(killSwitch.Hook())
+
+	lifecycle.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			return killSwitch.Start()
+		},
+		OnStop: func(context.Context) error {
+			return killSwitch.Stop()
+		},
+	})
+}
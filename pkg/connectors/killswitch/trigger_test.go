@@ -0,0 +1,323 @@
+package killswitch_test
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	mockconnector "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	mockregistry "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	mockstrategy "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+	mocktemporal "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/kronos-sdk/pkg/stores/activity/position"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/data/stores/activity"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/execution"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	logger "github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+	mockmarkprice "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/connectors/markprice"
+	mocksymbols "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/connectors/symbols"
+	mockrunreport "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/runreport"
+	mockscheduler "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/scheduler"
+	"github.com/backtesting-org/live-trading/pkg/connectors/killswitch"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+	"github.com/backtesting-org/live-trading/pkg/runreport"
+	"github.com/backtesting-org/live-trading/pkg/scheduler"
+)
+
+var _ = Describe("KillSwitch", func() {
+	var (
+		connectors *mockregistry.ConnectorRegistry
+		strategies *mockregistry.StrategyRegistry
+		assets     *mockregistry.AssetRegistry
+		positions  activity.Positions
+		reporter   *mockrunreport.RunReporter
+		marks      *mockmarkprice.MarkPriceFeed
+		jobs       *mockscheduler.Scheduler
+		conn       *mockconnector.Connector
+		momentum   *mockstrategy.Strategy
+
+		balance  numerical.Decimal
+		realized numerical.Decimal
+		held     []runreport.Position
+		runID    string
+		switch_  killswitch.KillSwitch
+		signalOf = func(name strategy.StrategyName) *execution.ExecutionContext {
+			return &execution.ExecutionContext{Signal: &strategy.Signal{Strategy: name}}
+		}
+	)
+
+	BeforeEach(func() {
+		connectors = mockregistry.NewConnectorRegistry(GinkgoT())
+		strategies = mockregistry.NewStrategyRegistry(GinkgoT())
+		assets = mockregistry.NewAssetRegistry(GinkgoT())
+		reporter = mockrunreport.NewRunReporter(GinkgoT())
+		marks = mockmarkprice.NewMarkPriceFeed(GinkgoT())
+		jobs = mockscheduler.NewScheduler(GinkgoT())
+		conn = mockconnector.NewConnector(GinkgoT())
+		momentum = mockstrategy.NewStrategy(GinkgoT())
+
+		symbolMapper := mocksymbols.NewSymbolMapper(GinkgoT())
+		symbolMapper.On("FromNative", types.Binance, mock.Anything).Return(portfolio.Asset{}, connector.Instrument(""), errors.New("not a market symbol")).Maybe()
+		symbolMapper.On("Resolve", types.Binance, "BTC", connector.TypePerpetual).Return("BTCUSDT", nil).Maybe()
+		symbolMapper.On("Resolve", types.Binance, "ETH", connector.TypeSpot).Return("ETHUSDT", nil).Maybe()
+		assets.On("GetInstrumentTypes", portfolio.NewAsset("BTC")).Return([]connector.Instrument{connector.TypePerpetual}).Maybe()
+		assets.On("GetInstrumentTypes", portfolio.NewAsset("ETH")).Return([]connector.Instrument{connector.TypeSpot}).Maybe()
+
+		mockTime := mocktemporal.NewTimeProvider(GinkgoT())
+		mockTime.On("Now").Return(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).Maybe()
+		positions = position.NewStore(mockTime)
+		positions.AddOrderToStrategy("momentum", connector.Order{ID: "1", Symbol: "BTC"})
+		positions.AddOrderToStrategy("momentum", connector.Order{ID: "2", Symbol: "BTC"})
+
+		balance = numerical.NewFromInt(1000)
+		realized = numerical.Zero()
+		held = nil
+		runID = "run-1"
+
+		connectors.On("GetReadyConnectors").Return([]connector.Connector{conn}).Maybe()
+		connectors.On("GetConnector", types.Binance).Return(conn, true).Maybe()
+		conn.On("SupportsTradingOperations").Return(true).Maybe()
+		conn.On("GetConnectorInfo").Return(&connector.Info{Name: types.Binance}).Maybe()
+		conn.On("GetAccountBalance").Return(func() *connector.AccountBalance {
+			return &connector.AccountBalance{TotalBalance: balance}
+		}, nil).Maybe()
+		conn.On("GetOpenOrders").Return([]connector.Order{{ID: "1", Symbol: "BTCUSDT"}, {ID: "2", Symbol: "BTCUSDT"}}, nil).Maybe()
+		conn.On("CancelOrder", "BTCUSDT", mock.Anything).Return(&connector.CancelResponse{}, nil).Maybe()
+
+		momentum.On("GetName").Return(strategy.StrategyName("momentum")).Maybe()
+		strategies.On("GetEnabledStrategies").Return([]strategy.Strategy{momentum}).Maybe()
+		strategies.On("DisableStrategy", strategy.StrategyName("momentum")).Return(nil).Maybe()
+
+		reporter.On("Active").Return(func() string { return runID }, func() bool { return runID != "" }).Maybe()
+		reporter.On("RealizedPnL", mock.Anything).Return(func(string) numerical.Decimal { return realized }, nil).Maybe()
+		reporter.On("Positions", mock.Anything).Return(func(string) []runreport.Position { return held }, nil).Maybe()
+
+		switch_ = killswitch.NewKillSwitch(connectors, strategies, assets, positions, reporter, marks, jobs, symbolMapper, mockTime, logger.NewNoOpLogger())
+	})
+
+	Describe("Trigger", func() {
+		It("disables the enabled strategies and cancels open orders", func() {
+			report, err := switch_.Trigger("manual", false)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(report.Cancelled).To(HaveKeyWithValue(types.Binance, 2))
+			Expect(report.Flattened).To(BeEmpty())
+			strategies.AssertCalled(GinkgoT(), "DisableStrategy", strategy.StrategyName("momentum"))
+			conn.AssertNotCalled(GinkgoT(), "GetPositions")
+
+			status := switch_.Status()
+			Expect(status.Engaged).To(BeTrue())
+			Expect(status.Halted).To(ConsistOf(strategy.StrategyName("momentum")))
+			Expect(status.Last).To(Equal(report))
+		})
+
+		It("leaves open orders no strategy placed alone", func() {
+			conn.ExpectedCalls = nil
+			conn.On("SupportsTradingOperations").Return(true)
+			conn.On("GetConnectorInfo").Return(&connector.Info{Name: types.Binance})
+			conn.On("GetOpenOrders").Return([]connector.Order{{ID: "1", Symbol: "BTCUSDT"}, {ID: "manual", Symbol: "BTCUSDT"}}, nil)
+			conn.On("CancelOrder", "BTCUSDT", "1").Return(&connector.CancelResponse{}, nil).Once()
+
+			report, err := switch_.Trigger("manual", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(report.Cancelled).To(HaveKeyWithValue(types.Binance, 1))
+			conn.AssertNotCalled(GinkgoT(), "CancelOrder", "BTCUSDT", "manual")
+		})
+
+		It("closes only what the run opened, on each position's instrument", func() {
+			held = []runreport.Position{
+				{Exchange: types.Binance, Symbol: "BTC", Size: numerical.NewFromFloat(0.5), Held: numerical.NewFromFloat(0.8)},
+				{Exchange: types.Binance, Symbol: "ETH", Size: numerical.NewFromInt(-3), Held: numerical.NewFromInt(-2)},
+				{Exchange: types.Binance, Symbol: "SOL", Size: numerical.NewFromInt(4), Held: numerical.NewFromInt(-1)},
+			}
+			conn.On("PlaceMarketOrder", "BTCUSDT", connector.OrderSideSell, numerical.NewFromFloat(0.5)).
+				Return(&connector.OrderResponse{}, nil).Once()
+			conn.On("PlaceMarketOrder", "ETHUSDT", connector.OrderSideBuy, numerical.NewFromInt(2)).
+				Return(&connector.OrderResponse{}, nil).Once()
+
+			report, err := switch_.Trigger("manual", true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(report.Flattened).To(HaveKeyWithValue(types.Binance, 2))
+			conn.AssertNotCalled(GinkgoT(), "GetPositions")
+		})
+
+		It("flattens nothing without an active run", func() {
+			runID = ""
+
+			report, err := switch_.Trigger("manual", true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(report.Flattened).To(HaveKeyWithValue(types.Binance, 0))
+			reporter.AssertNotCalled(GinkgoT(), "Positions", mock.Anything)
+		})
+
+		It("reports the actions that failed while staying engaged", func() {
+			conn.ExpectedCalls = nil
+			conn.On("SupportsTradingOperations").Return(true)
+			conn.On("GetConnectorInfo").Return(&connector.Info{Name: types.Binance})
+			conn.On("GetOpenOrders").Return(nil, errors.New("timeout"))
+
+			report, err := switch_.Trigger("manual", false)
+			Expect(err).To(MatchError(ContainSubstring("fetch open orders: timeout")))
+			Expect(report.Errors).To(HaveLen(1))
+			Expect(switch_.Engaged()).To(BeTrue())
+		})
+	})
+
+	Describe("Hook", func() {
+		It("refuses signals only while engaged", func() {
+			hook := switch_.Hook()
+			Expect(hook.BeforeExecute(signalOf("momentum"))).To(Succeed())
+
+			_, err := switch_.Trigger("manual", false)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = hook.BeforeExecute(signalOf("momentum"))
+			Expect(errors.Is(err, killswitch.ErrEngaged)).To(BeTrue())
+		})
+	})
+
+	Describe("Reset", func() {
+		It("re-enables only the strategies the switch disabled", func() {
+			_, err := switch_.Trigger("manual", false)
+			Expect(err).NotTo(HaveOccurred())
+
+			strategies.On("EnableStrategy", strategy.StrategyName("momentum")).Return(nil).Once()
+			switch_.Reset()
+
+			Expect(switch_.Engaged()).To(BeFalse())
+			Expect(switch_.Status().Halted).To(BeEmpty())
+			Expect(switch_.Hook().BeforeExecute(signalOf("momentum"))).To(Succeed())
+		})
+	})
+
+	Describe("CheckDrawdown", func() {
+		BeforeEach(func() {
+			switch_.Configure(killswitch.Config{MaxDrawdown: numerical.NewFromFloat(0.1)})
+		})
+
+		It("measures nothing without an active run", func() {
+			runID = ""
+
+			drawdown, err := switch_.CheckDrawdown()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drawdown.IsZero()).To(BeTrue())
+			conn.AssertNotCalled(GinkgoT(), "GetAccountBalance")
+		})
+
+		It("measures the run's PnL from its peak and trips at the limit", func() {
+			_, err := switch_.CheckDrawdown()
+			Expect(err).NotTo(HaveOccurred())
+
+			realized = numerical.NewFromInt(200)
+			_, err = switch_.CheckDrawdown()
+			Expect(err).NotTo(HaveOccurred())
+
+			realized = numerical.NewFromInt(100)
+			drawdown, err := switch_.CheckDrawdown()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(switch_.Engaged()).To(BeFalse())
+			Expect(drawdown.GreaterThan(numerical.NewFromFloat(0.08))).To(BeTrue())
+
+			realized = numerical.NewFromInt(80)
+			_, err = switch_.CheckDrawdown()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(switch_.Engaged()).To(BeTrue())
+
+			status := switch_.Status()
+			Expect(status.RunID).To(Equal("run-1"))
+			Expect(status.StartEquity.Equal(numerical.NewFromInt(1000))).To(BeTrue())
+			Expect(status.PeakEquity.Equal(numerical.NewFromInt(1200))).To(BeTrue())
+			Expect(status.PnL.Equal(numerical.NewFromInt(80))).To(BeTrue())
+			Expect(status.Last.Reason).To(ContainSubstring("run run-1 drawdown"))
+		})
+
+		It("ignores balance moves the run did not make", func() {
+			_, err := switch_.CheckDrawdown()
+			Expect(err).NotTo(HaveOccurred())
+
+			balance = numerical.NewFromInt(500)
+			drawdown, err := switch_.CheckDrawdown()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drawdown.IsZero()).To(BeTrue())
+			Expect(switch_.Engaged()).To(BeFalse())
+		})
+
+		It("values the run's open positions at the mark", func() {
+			held = []runreport.Position{{
+				Exchange:   types.Binance,
+				Symbol:     "BTC",
+				Size:       numerical.NewFromInt(2),
+				Held:       numerical.NewFromInt(2),
+				EntryPrice: numerical.NewFromInt(100),
+			}}
+			conn.On("GetPositions").Return([]connector.Position{
+				{Symbol: portfolio.NewAsset("BTC"), Side: connector.OrderSideBuy, Size: numerical.NewFromInt(2), MarkPrice: numerical.NewFromInt(60)},
+			}, nil)
+			marks.On("UnrealizedPnL", mock.MatchedBy(func(p connector.Position) bool {
+				return p.Side == connector.OrderSideBuy && p.Size.Equal(numerical.NewFromInt(2)) &&
+					p.EntryPrice.Equal(numerical.NewFromInt(100)) && p.MarkPrice.Equal(numerical.NewFromInt(60))
+			})).Return(func(connector.Position) numerical.Decimal { return realized.Neg() }, true)
+
+			_, err := switch_.CheckDrawdown()
+			Expect(err).NotTo(HaveOccurred())
+
+			realized = numerical.NewFromInt(80)
+			drawdown, err := switch_.CheckDrawdown()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drawdown.IsZero()).To(BeTrue())
+			Expect(switch_.Status().PnL.IsZero()).To(BeTrue())
+		})
+
+		It("skips a sample when a run position has no mark", func() {
+			held = []runreport.Position{{Exchange: types.Binance, Symbol: "BTC", Size: numerical.NewFromInt(1), Held: numerical.NewFromInt(1)}}
+			conn.On("GetPositions").Return([]connector.Position{}, nil)
+			marks.On("UnrealizedPnL", mock.Anything).Return(numerical.Zero(), false)
+
+			_, err := switch_.CheckDrawdown()
+			Expect(err).To(MatchError(ContainSubstring("binance/BTC: no mark price")))
+			Expect(switch_.Status().RunID).To(BeEmpty())
+		})
+
+		It("starts a new baseline for each run", func() {
+			_, err := switch_.CheckDrawdown()
+			Expect(err).NotTo(HaveOccurred())
+
+			runID = "run-2"
+			balance = numerical.NewFromInt(850)
+			drawdown, err := switch_.CheckDrawdown()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drawdown.IsZero()).To(BeTrue())
+			Expect(switch_.Engaged()).To(BeFalse())
+			Expect(switch_.Status().StartEquity.Equal(numerical.NewFromInt(850))).To(BeTrue())
+		})
+
+		It("skips a sample when any balance is unavailable", func() {
+			conn.ExpectedCalls = nil
+			conn.On("SupportsTradingOperations").Return(true)
+			conn.On("GetConnectorInfo").Return(&connector.Info{Name: types.Binance})
+			conn.On("GetAccountBalance").Return(nil, errors.New("timeout"))
+
+			_, err := switch_.CheckDrawdown()
+			Expect(err).To(MatchError(ContainSubstring("equity unavailable")))
+			Expect(switch_.Status().RunID).To(BeEmpty())
+		})
+	})
+
+	Describe("Start", func() {
+		It("registers the drawdown breaker and unregisters it on Stop", func() {
+			jobs.On("Register", mock.MatchedBy(func(job scheduler.Job) bool {
+				return job.Name == killswitch.JobName && job.Interval == killswitch.DefaultInterval && job.RunOnStart
+			})).Return(nil).Once()
+			jobs.On("Unregister", killswitch.JobName).Return(nil).Once()
+
+			Expect(switch_.Start()).To(Succeed())
+			Expect(switch_.Stop()).To(Succeed())
+		})
+	})
+})
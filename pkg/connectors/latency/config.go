@@ -0,0 +1,35 @@
+package latency
+
+import "time"
+
+const (
+	// FileName is the daily aggregate log kept inside the configured directory
+	FileName = "latency_daily.jsonl"
+
+	// JobName is the scheduler job that closes out each day's aggregates
+	JobName = "latency-rollup"
+
+	// DefaultRollupInterval is how often the rollup checks whether the day
+	// has turned; samples observed since the last check land in the day
+	// that just ended
+	DefaultRollupInterval = time.Minute
+)
+
+// Config controls where daily aggregates are stored. With no Directory they
+// are kept in memory only.
+type Config struct {
+	Directory      string
+	RollupInterval time.Duration
+}
+
+func DefaultConfig() Config {
+	return Config{
+		RollupInterval: DefaultRollupInterval,
+	}
+}
+
+func (c *Config) applyDefaults() {
+	if c.RollupInterval <= 0 {
+		c.RollupInterval = DefaultRollupInterval
+	}
+}
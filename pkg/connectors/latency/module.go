@@ -0,0 +1,44 @@
+package latency
+
+import (
+	"context"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/events"
+	"github.com/backtesting-org/live-trading/pkg/connectors/tracker"
+	"github.com/backtesting-org/live-trading/pkg/eventschema"
+	"go.uber.org/fx"
+)
+
+var Module = fx.Options(
+	fx.Provide(
+		NewRecorder,
+		NewOrderTimer,
+		NewDailyRollup,
+	),
+	fx.Invoke(subscribeFills),
+	fx.Invoke(registerHooks),
+)
+
+// subscribeFills closes out orders waiting on their first fill from the
+// tracker's fill events
+func subscribeFills(bus events.EventBus, timer OrderTimer) {
+	bus.Subscribe(eventschema.TopicFill, func(event interface{}) {
+		if envelope, ok := event.(eventschema.Envelope); ok {
+			event = envelope.Event
+		}
+		if fill, ok := event.(tracker.FillEvent); ok {
+			timer.ObserveFill(fill)
+		}
+	})
+}
+
+func registerHooks(lifecycle fx.Lifecycle, rollup DailyRollup) {
+	lifecycle.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			return rollup.Start()
+		},
+		OnStop: func(context.Context) error {
+			return rollup.Stop()
+		},
+	})
+}
@@ -0,0 +1,211 @@
+package latency
+
+import (
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/data/stores/activity"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/execution"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/tracker"
+)
+
+// DefaultFillHorizon is how long an order is waited on for its first fill
+// before it stops counting towards the submit-to-fill distribution
+const DefaultFillHorizon = 24 * time.Hour
+
+// OrderTimer records submit-to-acknowledgement and submit-to-first-fill
+// latency for the orders the SDK executor places. The executor stamps each
+// order as its placement returns and places a signal's actions one after
+// another, so an order's round trip runs from the previous order's stamp, or
+// from the signal reaching the executor for the first one. Acknowledged
+// orders are handed to the order tracker, whose fill events close them out.
+type OrderTimer interface {
+	Wrap(inner execution.Executor) execution.Executor
+
+	// ObserveFill records the submit-to-fill latency of the order's first fill
+	ObserveFill(fill tracker.FillEvent)
+}
+
+type submittedOrder struct {
+	submitted time.Time
+	live      bool
+}
+
+type orderTimer struct {
+	recorder     Recorder
+	registry     registry.ConnectorRegistry
+	positions    activity.Positions
+	tracker      tracker.OrderTracker
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	// awaiting holds acknowledged orders that have not filled yet
+	awaiting map[string]submittedOrder
+	mu       sync.Mutex
+}
+
+func NewOrderTimer(
+	recorder Recorder,
+	connectorRegistry registry.ConnectorRegistry,
+	positions activity.Positions,
+	orderTracker tracker.OrderTracker,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) OrderTimer {
+	return &orderTimer{
+		recorder:     recorder,
+		registry:     connectorRegistry,
+		positions:    positions,
+		tracker:      orderTracker,
+		timeProvider: timeProvider,
+		logger:       logger,
+		awaiting:     make(map[string]submittedOrder),
+	}
+}
+
+func (t *orderTimer) Wrap(inner execution.Executor) execution.Executor {
+	return &timedExecutor{timer: t, inner: inner}
+}
+
+// placed returns the orders recorded for the strategy since it held seen
+func (t *orderTimer) placed(name strategy.StrategyName, seen int) []connector.Order {
+	execution := t.positions.GetStrategyExecution(name)
+	if execution == nil || len(execution.Orders) <= seen {
+		return nil
+	}
+	return append([]connector.Order(nil), execution.Orders[seen:]...)
+}
+
+func (t *orderTimer) recorded(name strategy.StrategyName) int {
+	execution := t.positions.GetStrategyExecution(name)
+	if execution == nil {
+		return 0
+	}
+	return len(execution.Orders)
+}
+
+// observe pairs each placed order with the trade action that produced it,
+// by symbol and side in action order, and records its round trip
+func (t *orderTimer) observe(signal *strategy.Signal, orders []connector.Order, submitted time.Time) {
+	next := 0
+	for _, action := range signal.Actions {
+		side, ok := orderSide(action.Action)
+		if !ok {
+			continue
+		}
+
+		for next < len(orders) && (orders[next].Symbol != action.Asset.Symbol() || orders[next].Side != side) {
+			next++
+		}
+		if next == len(orders) {
+			return
+		}
+
+		order := orders[next]
+		next++
+
+		live := t.live(action.Exchange)
+		if live {
+			t.recorder.Observe(action.Exchange, OpPlaceOrder, order.CreatedAt.Sub(submitted))
+		}
+		t.await(action.Exchange, order, submitted, live)
+		submitted = order.CreatedAt
+	}
+}
+
+// await follows the order to its first fill through the tracker
+func (t *orderTimer) await(exchange connector.ExchangeName, order connector.Order, submitted time.Time, live bool) {
+	t.mu.Lock()
+	for key, pending := range t.awaiting {
+		if order.CreatedAt.Sub(pending.submitted) > DefaultFillHorizon {
+			delete(t.awaiting, key)
+		}
+	}
+	t.awaiting[awaitKey(exchange, order.ID)] = submittedOrder{submitted: submitted, live: live}
+	t.mu.Unlock()
+
+	response := &connector.OrderResponse{
+		OrderID:   order.ID,
+		Symbol:    order.Symbol,
+		Side:      order.Side,
+		Type:      order.Type,
+		Quantity:  order.Quantity,
+		Price:     order.Price,
+		Timestamp: order.CreatedAt,
+	}
+	if err := t.tracker.Track(exchange, response); err != nil {
+		t.logger.Warn("Order latency: cannot follow order %s on %s to a fill: %v", order.ID, exchange, err)
+		t.mu.Lock()
+		delete(t.awaiting, awaitKey(exchange, order.ID))
+		t.mu.Unlock()
+	}
+}
+
+func (t *orderTimer) ObserveFill(fill tracker.FillEvent) {
+	key := awaitKey(fill.Exchange, fill.OrderID)
+
+	t.mu.Lock()
+	pending, ok := t.awaiting[key]
+	delete(t.awaiting, key)
+	t.mu.Unlock()
+
+	if ok && pending.live {
+		t.recorder.Observe(fill.Exchange, OpFirstFill, fill.Timestamp.Sub(pending.submitted))
+	}
+}
+
+// live reports whether orders on the exchange reach a real venue
+func (t *orderTimer) live(exchange connector.ExchangeName) bool {
+	conn, ok := t.registry.GetConnector(exchange)
+	if !ok {
+		return false
+	}
+	simulated, ok := conn.(Simulated)
+	return !ok || !simulated.SimulatesExecution()
+}
+
+type timedExecutor struct {
+	timer *orderTimer
+	inner execution.Executor
+}
+
+func (e *timedExecutor) ExecuteSignal(signal *strategy.Signal) error {
+	if signal == nil {
+		return e.inner.ExecuteSignal(signal)
+	}
+
+	seen := e.timer.recorded(signal.Strategy)
+	submitted := e.timer.timeProvider.Now()
+
+	err := e.inner.ExecuteSignal(signal)
+
+	// A failed signal still acknowledged the orders placed before the failure
+	if orders := e.timer.placed(signal.Strategy, seen); len(orders) > 0 {
+		e.timer.observe(signal, orders, submitted)
+	}
+	return err
+}
+
+func (e *timedExecutor) HandleTradeExecution(trade connector.Trade) error {
+	return e.inner.HandleTradeExecution(trade)
+}
+
+func awaitKey(exchange connector.ExchangeName, orderID string) string {
+	return string(exchange) + "/" + orderID
+}
+
+func orderSide(action strategy.Action) (connector.OrderSide, bool) {
+	switch action {
+	case strategy.ActionBuy, strategy.ActionCover:
+		return connector.OrderSideBuy, true
+	case strategy.ActionSell, strategy.ActionSellShort:
+		return connector.OrderSideSell, true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,140 @@
+package latency_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	mockexecution "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/execution"
+	sdkregistry "github.com/backtesting-org/kronos-sdk/pkg/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/stores/activity/position"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/data/stores/activity"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	logger "github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+	mocktracker "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/connectors/tracker"
+	"github.com/backtesting-org/live-trading/pkg/connectors/fake"
+	"github.com/backtesting-org/live-trading/pkg/connectors/latency"
+	"github.com/backtesting-org/live-trading/pkg/connectors/paper"
+	"github.com/backtesting-org/live-trading/pkg/connectors/tracker"
+)
+
+var _ = Describe("OrderTimer", func() {
+	var (
+		clock      *fake.Clock
+		connectors registry.ConnectorRegistry
+		positions  activity.Positions
+		recorder   latency.Recorder
+		inner      *mockexecution.Executor
+		orders     *mocktracker.OrderTracker
+		timer      latency.OrderTimer
+		executor   interface{ ExecuteSignal(*strategy.Signal) error }
+	)
+
+	btc := portfolio.NewAsset("BTC")
+	eth := portfolio.NewAsset("ETH")
+
+	// place records an order the way the SDK executor does once the
+	// exchange acknowledges it, after the given round trip
+	place := func(asset portfolio.Asset, side connector.OrderSide, roundTrip time.Duration) {
+		clock.Advance(roundTrip)
+		positions.AddOrderToStrategy("momentum", connector.Order{
+			ID:        asset.Symbol() + "-" + string(side),
+			Symbol:    asset.Symbol(),
+			Side:      side,
+			CreatedAt: clock.Now(),
+		})
+	}
+
+	signal := func(exchange connector.ExchangeName) *strategy.Signal {
+		return &strategy.Signal{
+			Strategy: "momentum",
+			Actions: []strategy.TradeAction{
+				{Action: strategy.ActionBuy, Asset: btc, Exchange: exchange, Quantity: numerical.NewFromInt(1)},
+				{Action: strategy.ActionHold, Asset: btc, Exchange: exchange},
+				{Action: strategy.ActionSellShort, Asset: eth, Exchange: exchange, Quantity: numerical.NewFromInt(2)},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		clock = fake.NewClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+		connectors = sdkregistry.NewConnectorRegistry()
+		positions = position.NewStore(clock)
+		recorder = latency.NewRecorder()
+		inner = mockexecution.NewExecutor(GinkgoT())
+		orders = mocktracker.NewOrderTracker(GinkgoT())
+		orders.On("Track", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+		timer = latency.NewOrderTimer(recorder, connectors, positions, orders, clock, logger.NewNoOpLogger())
+		executor = timer.Wrap(inner)
+	})
+
+	It("records each order's submit to acknowledgement latency", func() {
+		connectors.RegisterConnector("alpha", fake.NewConnector("alpha", clock))
+		inner.On("ExecuteSignal", mock.Anything).Run(func(mock.Arguments) {
+			place(btc, connector.OrderSideBuy, 40*time.Millisecond)
+			place(eth, connector.OrderSideSell, 60*time.Millisecond)
+		}).Return(nil).Once()
+
+		Expect(executor.ExecuteSignal(signal("alpha"))).To(Succeed())
+
+		Expect(recorder.Count("alpha", latency.OpPlaceOrder)).To(Equal(2))
+		Expect(quantile(recorder, "alpha", 0.5, latency.OpPlaceOrder)).To(BeNumerically("~", 40*time.Millisecond, time.Millisecond))
+		Expect(quantile(recorder, "alpha", 1, latency.OpPlaceOrder)).To(Equal(60 * time.Millisecond))
+
+		sample, ok := recorder.Sample("alpha", latency.OpPlaceOrder)
+		Expect(ok).To(BeTrue())
+		Expect(sample).To(BeNumerically(">=", 40*time.Millisecond))
+		Expect(sample).To(BeNumerically("<=", 60*time.Millisecond))
+	})
+
+	It("records the orders acknowledged before a failed action", func() {
+		connectors.RegisterConnector("alpha", fake.NewConnector("alpha", clock))
+		inner.On("ExecuteSignal", mock.Anything).Run(func(mock.Arguments) {
+			place(btc, connector.OrderSideBuy, 25*time.Millisecond)
+		}).Return(errRejected).Once()
+
+		Expect(executor.ExecuteSignal(signal("alpha"))).To(MatchError(errRejected))
+		Expect(recorder.Count("alpha", latency.OpPlaceOrder)).To(Equal(1))
+		Expect(quantile(recorder, "alpha", 1, latency.OpPlaceOrder)).To(Equal(25 * time.Millisecond))
+	})
+
+	It("leaves simulated executions out of the distribution", func() {
+		live := fake.NewConnector("alpha", clock)
+		connectors.RegisterConnector("alpha", paper.NewPaperConnector(live, recorder, clock, logger.NewNoOpLogger()))
+		inner.On("ExecuteSignal", mock.Anything).Run(func(mock.Arguments) {
+			place(btc, connector.OrderSideBuy, 40*time.Millisecond)
+		}).Return(nil).Once()
+
+		Expect(executor.ExecuteSignal(signal("alpha"))).To(Succeed())
+		Expect(recorder.Count("alpha", latency.OpPlaceOrder)).To(BeZero())
+	})
+
+	It("records submit to first fill for tracked orders", func() {
+		connectors.RegisterConnector("alpha", fake.NewConnector("alpha", clock))
+		submitted := clock.Now()
+		inner.On("ExecuteSignal", mock.Anything).Run(func(mock.Arguments) {
+			place(btc, connector.OrderSideBuy, 40*time.Millisecond)
+		}).Return(nil).Once()
+
+		Expect(executor.ExecuteSignal(signal("alpha"))).To(Succeed())
+		orders.AssertCalled(GinkgoT(), "Track", connector.ExchangeName("alpha"), mock.MatchedBy(func(response *connector.OrderResponse) bool {
+			return response.OrderID == "BTC-BUY" && response.Symbol == "BTC"
+		}))
+
+		fill := tracker.FillEvent{Exchange: "alpha", OrderID: "BTC-BUY", Timestamp: submitted.Add(250 * time.Millisecond)}
+		timer.ObserveFill(fill)
+		// Only the first fill of an order counts
+		fill.Timestamp = submitted.Add(time.Second)
+		timer.ObserveFill(fill)
+
+		Expect(recorder.Count("alpha", latency.OpFirstFill)).To(Equal(1))
+		Expect(quantile(recorder, "alpha", 1, latency.OpFirstFill)).To(Equal(250 * time.Millisecond))
+	})
+})
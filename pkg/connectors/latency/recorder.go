@@ -0,0 +1,169 @@
+package latency
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/live-trading/pkg/websocket/performance"
+)
+
+// Operation is the exchange call whose round trip is measured
+type Operation string
+
+const (
+	// OpPlaceOrder is order placement until the exchange acknowledges it
+	OpPlaceOrder  Operation = "place_order"
+	OpCancelOrder Operation = "cancel_order"
+
+	// OpFirstFill is order placement until the order's first fill
+	OpFirstFill Operation = "first_fill"
+)
+
+// Key identifies one latency distribution
+type Key struct {
+	Exchange  connector.ExchangeName
+	Operation Operation
+}
+
+// Simulated is implemented by connectors whose orders never reach an
+// exchange, so their timings would pollute the live distribution
+type Simulated interface {
+	SimulatesExecution() bool
+}
+
+// Recorder keeps a latency histogram per exchange and operation so round
+// trips can be exported as metrics and replayed by paper execution
+type Recorder interface {
+	Observe(exchange connector.ExchangeName, op Operation, latency time.Duration)
+
+	// Time runs call against conn and records how long it took, whether or
+	// not it failed. Simulated connectors are not recorded.
+	Time(conn connector.Connector, op Operation, call func() error) error
+
+	// Sample draws a latency from the recorded distribution
+	Sample(exchange connector.ExchangeName, op Operation) (time.Duration, bool)
+
+	// Quantile returns the q-th quantile (0..1) of the recorded distribution
+	Quantile(exchange connector.ExchangeName, op Operation, q float64) (time.Duration, bool)
+
+	Count(exchange connector.ExchangeName, op Operation) int
+	Keys() []Key
+
+	// Roll hands over the histograms observed since the previous Roll and
+	// starts new ones, leaving the distributions above untouched
+	Roll() map[Key]performance.LatencyHistogram
+}
+
+type recorder struct {
+	histograms map[Key]performance.LatencyHistogram
+	window     map[Key]performance.LatencyHistogram
+	random     *rand.Rand
+	mu         sync.Mutex
+}
+
+func NewRecorder() Recorder {
+	return &recorder{
+		histograms: make(map[Key]performance.LatencyHistogram),
+		window:     make(map[Key]performance.LatencyHistogram),
+		random:     rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+func (r *recorder) Observe(exchange connector.ExchangeName, op Operation, latency time.Duration) {
+	if latency < 0 {
+		return
+	}
+
+	r.mu.Lock()
+	key := Key{Exchange: exchange, Operation: op}
+	histogram, ok := r.histograms[key]
+	if !ok {
+		histogram = performance.NewLatencyHistogram()
+		r.histograms[key] = histogram
+	}
+	window, ok := r.window[key]
+	if !ok {
+		window = performance.NewLatencyHistogram()
+		r.window[key] = window
+	}
+	r.mu.Unlock()
+
+	histogram.Record(latency)
+	window.Record(latency)
+}
+
+func (r *recorder) Time(conn connector.Connector, op Operation, call func() error) error {
+	if simulated, ok := conn.(Simulated); ok && simulated.SimulatesExecution() {
+		return call()
+	}
+
+	info := conn.GetConnectorInfo()
+	if info == nil {
+		return call()
+	}
+
+	started := time.Now()
+	err := call()
+	r.Observe(info.Name, op, time.Since(started))
+	return err
+}
+
+// Sample draws from the histogram by inverting it at a uniform quantile
+func (r *recorder) Sample(exchange connector.ExchangeName, op Operation) (time.Duration, bool) {
+	r.mu.Lock()
+	histogram, ok := r.histograms[Key{Exchange: exchange, Operation: op}]
+	q := r.random.Float64()
+	r.mu.Unlock()
+
+	if !ok || histogram.Count() == 0 {
+		return 0, false
+	}
+	return histogram.Percentile(q), true
+}
+
+func (r *recorder) Quantile(exchange connector.ExchangeName, op Operation, q float64) (time.Duration, bool) {
+	histogram, ok := r.histogram(exchange, op)
+	if !ok || histogram.Count() == 0 {
+		return 0, false
+	}
+	return histogram.Percentile(q), true
+}
+
+func (r *recorder) Count(exchange connector.ExchangeName, op Operation) int {
+	histogram, ok := r.histogram(exchange, op)
+	if !ok {
+		return 0
+	}
+	return int(histogram.Count())
+}
+
+func (r *recorder) Keys() []Key {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	keys := make([]Key, 0, len(r.histograms))
+	for key := range r.histograms {
+		keys = append(keys, key)
+	}
+	sortKeys(keys)
+	return keys
+}
+
+func (r *recorder) Roll() map[Key]performance.LatencyHistogram {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	window := r.window
+	r.window = make(map[Key]performance.LatencyHistogram)
+	return window
+}
+
+func (r *recorder) histogram(exchange connector.ExchangeName, op Operation) (performance.LatencyHistogram, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	histogram, ok := r.histograms[Key{Exchange: exchange, Operation: op}]
+	return histogram, ok
+}
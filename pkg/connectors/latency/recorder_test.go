@@ -0,0 +1,59 @@
+package latency_test
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/live-trading/pkg/connectors/latency"
+)
+
+var errRejected = errors.New("order rejected")
+
+func quantile(recorder latency.Recorder, exchange string, q float64, op latency.Operation) time.Duration {
+	value, ok := recorder.Quantile(connector.ExchangeName(exchange), op, q)
+	Expect(ok).To(BeTrue())
+	return value
+}
+
+var _ = Describe("Recorder", func() {
+	var recorder latency.Recorder
+
+	BeforeEach(func() {
+		recorder = latency.NewRecorder()
+	})
+
+	It("reports nothing for an exchange it has not observed", func() {
+		_, ok := recorder.Sample("alpha", latency.OpPlaceOrder)
+		Expect(ok).To(BeFalse())
+		_, ok = recorder.Quantile("alpha", latency.OpPlaceOrder, 0.5)
+		Expect(ok).To(BeFalse())
+		Expect(recorder.Keys()).To(BeEmpty())
+	})
+
+	It("keeps a distribution per exchange and operation", func() {
+		for i := 1; i <= 100; i++ {
+			recorder.Observe("alpha", latency.OpPlaceOrder, time.Duration(i)*time.Millisecond)
+		}
+		recorder.Observe("alpha", latency.OpCancelOrder, 5*time.Millisecond)
+		recorder.Observe("beta", latency.OpPlaceOrder, time.Second)
+
+		Expect(recorder.Count("alpha", latency.OpPlaceOrder)).To(Equal(100))
+		Expect(recorder.Keys()).To(Equal([]latency.Key{
+			{Exchange: "alpha", Operation: latency.OpCancelOrder},
+			{Exchange: "alpha", Operation: latency.OpPlaceOrder},
+			{Exchange: "beta", Operation: latency.OpPlaceOrder},
+		}))
+
+		Expect(quantile(recorder, "alpha", 0.5, latency.OpPlaceOrder)).To(BeNumerically("~", 50*time.Millisecond, time.Millisecond))
+		Expect(quantile(recorder, "beta", 0.5, latency.OpPlaceOrder)).To(Equal(time.Second))
+	})
+
+	It("ignores negative latencies", func() {
+		recorder.Observe("alpha", latency.OpPlaceOrder, -time.Millisecond)
+		Expect(recorder.Count("alpha", latency.OpPlaceOrder)).To(BeZero())
+	})
+})
@@ -0,0 +1,308 @@
+package latency
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/jsonl"
+	"github.com/backtesting-org/live-trading/pkg/scheduler"
+	"github.com/backtesting-org/live-trading/pkg/websocket/performance"
+)
+
+// Summary is one latency distribution reduced to its percentiles
+type Summary struct {
+	Exchange  connector.ExchangeName `json:"exchange"`
+	Operation Operation              `json:"operation"`
+	Count     int64                  `json:"count"`
+	P50       time.Duration          `json:"p50"`
+	P95       time.Duration          `json:"p95"`
+	P99       time.Duration          `json:"p99"`
+}
+
+// DailyAggregate is a distribution's summary over one UTC day
+type DailyAggregate struct {
+	Day time.Time `json:"day"`
+	Summary
+}
+
+// DailyRollup keeps a summary of every exchange latency distribution per
+// UTC day, for trends longer than the recorder's lifetime
+type DailyRollup interface {
+	Configure(config Config) error
+
+	// Start schedules the rollup job; Stop closes out the day so far, so a
+	// day the process restarted in has one aggregate per session
+	Start() error
+	Stop() error
+
+	// Roll closes out the previous day once the clock has passed midnight
+	Roll() error
+
+	// Current summarizes the recorder's distributions since start
+	Current() []Summary
+	Aggregates(since, until time.Time) []DailyAggregate
+
+	// Handler serves the current summaries and the daily aggregates between
+	// ?since= and ?until= (RFC 3339), as JSON
+	Handler() http.Handler
+}
+
+type dailyRollup struct {
+	recorder     Recorder
+	scheduler    scheduler.Scheduler
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config     Config
+	file       *os.File
+	day        time.Time
+	aggregates []DailyAggregate
+	mu         sync.Mutex
+}
+
+func NewDailyRollup(
+	recorder Recorder,
+	jobScheduler scheduler.Scheduler,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) DailyRollup {
+	return &dailyRollup{
+		recorder:     recorder,
+		scheduler:    jobScheduler,
+		timeProvider: timeProvider,
+		logger:       logger,
+		config:       DefaultConfig(),
+	}
+}
+
+func (r *dailyRollup) Configure(config Config) error {
+	config.applyDefaults()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if config.Directory == "" {
+		r.config = config
+		return nil
+	}
+	if r.file != nil {
+		return fmt.Errorf("latency rollup already configured")
+	}
+	if err := os.MkdirAll(config.Directory, 0o750); err != nil {
+		return fmt.Errorf("failed to create latency directory: %w", err)
+	}
+
+	r.config = config
+	path := filepath.Join(config.Directory, FileName)
+	if err := r.replay(path); err != nil {
+		return err
+	}
+
+	file, err := jsonl.OpenAppend(path, 0o640)
+	if err != nil {
+		return fmt.Errorf("failed to open latency rollup log: %w", err)
+	}
+	r.file = file
+	return nil
+}
+
+// replay loads stored aggregates; caller must hold r.mu
+func (r *dailyRollup) replay(path string) error {
+	err := jsonl.ScanFile(path, func(_ int, line []byte) error {
+		var aggregate DailyAggregate
+		// A torn final line from a crash mid-write is skipped, not fatal
+		if err := json.Unmarshal(line, &aggregate); err != nil {
+			r.logger.Warn("Skipping unreadable latency rollup line: %v", err)
+			return nil
+		}
+		r.aggregates = append(r.aggregates, aggregate)
+		return nil
+	})
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read latency rollup log: %w", err)
+	}
+	return nil
+}
+
+func (r *dailyRollup) Start() error {
+	// Whatever was observed before the rollup started belongs to today
+	r.mu.Lock()
+	r.day = dayOf(r.timeProvider.Now())
+	interval := r.config.RollupInterval
+	r.mu.Unlock()
+
+	return r.scheduler.Register(scheduler.Job{
+		Name:     JobName,
+		Interval: interval,
+		Run: func(context.Context) error {
+			return r.Roll()
+		},
+	})
+}
+
+func (r *dailyRollup) Stop() error {
+	if err := r.scheduler.Unregister(JobName); err != nil {
+		r.logger.Warn("Latency rollup: %v", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	err := r.close(r.day)
+	if r.file != nil {
+		if closeErr := r.file.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("failed to close latency rollup log: %w", closeErr)
+		}
+		r.file = nil
+	}
+	return err
+}
+
+func (r *dailyRollup) Roll() error {
+	today := dayOf(r.timeProvider.Now())
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.day.IsZero() {
+		r.day = today
+		return nil
+	}
+	if !today.After(r.day) {
+		return nil
+	}
+
+	day := r.day
+	r.day = today
+	return r.close(day)
+}
+
+// close summarizes the recorder's window into the day's aggregates and
+// stores them; caller must hold r.mu
+func (r *dailyRollup) close(day time.Time) error {
+	window := r.recorder.Roll()
+	if len(window) == 0 {
+		return nil
+	}
+
+	keys := make([]Key, 0, len(window))
+	for key := range window {
+		keys = append(keys, key)
+	}
+	sortKeys(keys)
+
+	records := make([]interface{}, 0, len(keys))
+	for _, key := range keys {
+		aggregate := DailyAggregate{Day: day, Summary: summarize(key, window[key])}
+		r.aggregates = append(r.aggregates, aggregate)
+		records = append(records, aggregate)
+	}
+
+	if r.file == nil {
+		return nil
+	}
+	if err := jsonl.Append(r.file, records...); err != nil {
+		return fmt.Errorf("failed to store latency aggregates for %s: %w", day.Format("2006-01-02"), err)
+	}
+	return nil
+}
+
+func (r *dailyRollup) Current() []Summary {
+	keys := r.recorder.Keys()
+	summaries := make([]Summary, 0, len(keys))
+	for _, key := range keys {
+		summary := Summary{
+			Exchange:  key.Exchange,
+			Operation: key.Operation,
+			Count:     int64(r.recorder.Count(key.Exchange, key.Operation)),
+		}
+		summary.P50, _ = r.recorder.Quantile(key.Exchange, key.Operation, 0.5)
+		summary.P95, _ = r.recorder.Quantile(key.Exchange, key.Operation, 0.95)
+		summary.P99, _ = r.recorder.Quantile(key.Exchange, key.Operation, 0.99)
+		summaries = append(summaries, summary)
+	}
+	return summaries
+}
+
+func (r *dailyRollup) Aggregates(since, until time.Time) []DailyAggregate {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var aggregates []DailyAggregate
+	for _, aggregate := range r.aggregates {
+		if !since.IsZero() && aggregate.Day.Before(dayOf(since)) {
+			continue
+		}
+		if !until.IsZero() && aggregate.Day.After(until) {
+			continue
+		}
+		aggregates = append(aggregates, aggregate)
+	}
+	return aggregates
+}
+
+func (r *dailyRollup) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var since, until time.Time
+		for name, target := range map[string]*time.Time{"since": &since, "until": &until} {
+			value := req.URL.Query().Get(name)
+			if value == "" {
+				continue
+			}
+			parsed, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("invalid %s: %v", name, err), http.StatusBadRequest)
+				return
+			}
+			*target = parsed
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(struct {
+			Current []Summary        `json:"current"`
+			Daily   []DailyAggregate `json:"daily"`
+		}{
+			Current: r.Current(),
+			Daily:   r.Aggregates(since, until),
+		})
+	})
+}
+
+func summarize(key Key, histogram performance.LatencyHistogram) Summary {
+	return Summary{
+		Exchange:  key.Exchange,
+		Operation: key.Operation,
+		Count:     histogram.Count(),
+		P50:       histogram.Percentile(0.5),
+		P95:       histogram.Percentile(0.95),
+		P99:       histogram.Percentile(0.99),
+	}
+}
+
+func dayOf(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func sortKeys(keys []Key) {
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Exchange != keys[j].Exchange {
+			return keys[i].Exchange < keys[j].Exchange
+		}
+		return keys[i].Operation < keys[j].Operation
+	})
+}
@@ -0,0 +1,114 @@
+package latency_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	logger "github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	mockscheduler "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/scheduler"
+	"github.com/backtesting-org/live-trading/pkg/connectors/fake"
+	"github.com/backtesting-org/live-trading/pkg/connectors/latency"
+	"github.com/backtesting-org/live-trading/pkg/scheduler"
+)
+
+var _ = Describe("DailyRollup", func() {
+	var (
+		clock    *fake.Clock
+		recorder latency.Recorder
+		jobs     *mockscheduler.Scheduler
+		job      scheduler.Job
+		rollup   latency.DailyRollup
+	)
+
+	day := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+
+	BeforeEach(func() {
+		clock = fake.NewClock(day.Add(23 * time.Hour))
+		recorder = latency.NewRecorder()
+
+		jobs = mockscheduler.NewScheduler(GinkgoT())
+		jobs.On("Register", mock.Anything).Run(func(args mock.Arguments) {
+			job = args.Get(0).(scheduler.Job)
+		}).Return(nil).Maybe()
+		jobs.On("Unregister", latency.JobName).Return(nil).Maybe()
+
+		rollup = latency.NewDailyRollup(recorder, jobs, clock, logger.NewNoOpLogger())
+	})
+
+	It("closes out a day's percentiles once the clock passes midnight", func() {
+		Expect(rollup.Configure(latency.Config{Directory: GinkgoT().TempDir()})).To(Succeed())
+		Expect(rollup.Start()).To(Succeed())
+		Expect(job.Name).To(Equal(latency.JobName))
+		Expect(job.Interval).To(Equal(latency.DefaultRollupInterval))
+
+		for i := 1; i <= 100; i++ {
+			recorder.Observe("alpha", latency.OpPlaceOrder, time.Duration(i)*time.Millisecond)
+		}
+
+		Expect(job.Run(context.Background())).To(Succeed())
+		Expect(rollup.Aggregates(time.Time{}, time.Time{})).To(BeEmpty())
+
+		clock.Advance(2 * time.Hour)
+		Expect(job.Run(context.Background())).To(Succeed())
+
+		aggregates := rollup.Aggregates(day, day)
+		Expect(aggregates).To(HaveLen(1))
+		Expect(aggregates[0].Day).To(Equal(day))
+		Expect(aggregates[0].Exchange).To(BeEquivalentTo("alpha"))
+		Expect(aggregates[0].Operation).To(Equal(latency.OpPlaceOrder))
+		Expect(aggregates[0].Count).To(BeEquivalentTo(100))
+		Expect(aggregates[0].P95).To(BeNumerically("~", 95*time.Millisecond, time.Millisecond))
+
+		// The new day starts empty while the live distribution carries on
+		Expect(rollup.Aggregates(day.Add(24*time.Hour), time.Time{})).To(BeEmpty())
+		Expect(recorder.Count("alpha", latency.OpPlaceOrder)).To(Equal(100))
+	})
+
+	It("reloads stored aggregates", func() {
+		directory := GinkgoT().TempDir()
+		Expect(rollup.Configure(latency.Config{Directory: directory})).To(Succeed())
+		Expect(rollup.Start()).To(Succeed())
+		recorder.Observe("alpha", latency.OpFirstFill, 300*time.Millisecond)
+		Expect(rollup.Stop()).To(Succeed())
+
+		reloaded := latency.NewDailyRollup(latency.NewRecorder(), jobs, clock, logger.NewNoOpLogger())
+		Expect(reloaded.Configure(latency.Config{Directory: directory})).To(Succeed())
+
+		aggregates := reloaded.Aggregates(time.Time{}, time.Time{})
+		Expect(aggregates).To(HaveLen(1))
+		Expect(aggregates[0].Operation).To(Equal(latency.OpFirstFill))
+		Expect(aggregates[0].P50).To(Equal(300 * time.Millisecond))
+	})
+
+	It("serves current percentiles and daily aggregates", func() {
+		Expect(rollup.Start()).To(Succeed())
+		recorder.Observe("alpha", latency.OpPlaceOrder, 40*time.Millisecond)
+		clock.Advance(2 * time.Hour)
+		Expect(rollup.Roll()).To(Succeed())
+
+		response := httptest.NewRecorder()
+		rollup.Handler().ServeHTTP(response, httptest.NewRequest(http.MethodGet, "/latency?since=2026-03-01T00:00:00Z", nil))
+		Expect(response.Code).To(Equal(http.StatusOK))
+
+		var body struct {
+			Current []latency.Summary        `json:"current"`
+			Daily   []latency.DailyAggregate `json:"daily"`
+		}
+		Expect(json.Unmarshal(response.Body.Bytes(), &body)).To(Succeed())
+		Expect(body.Current).To(HaveLen(1))
+		Expect(body.Current[0].P99).To(Equal(40 * time.Millisecond))
+		Expect(body.Daily).To(HaveLen(1))
+		Expect(body.Daily[0].Day).To(Equal(day))
+
+		response = httptest.NewRecorder()
+		rollup.Handler().ServeHTTP(response, httptest.NewRequest(http.MethodGet, "/latency?since=yesterday", nil))
+		Expect(response.Code).To(Equal(http.StatusBadRequest))
+	})
+})
@@ -0,0 +1,70 @@
+package loadgen
+
+import (
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+)
+
+const (
+	DefaultExchange   connector.ExchangeName = "loadgen"
+	DefaultSymbols                           = 10
+	DefaultDepth                             = 20
+	DefaultBasePrice                         = 100.0
+	DefaultTickSize                          = 0.01
+	DefaultBufferSize                        = 100
+
+	// DefaultResolution is how often the generator catches up to its rates;
+	// finer resolution smooths bursts at the cost of more wakeups
+	DefaultResolution = time.Millisecond
+)
+
+// Config sets the synthetic load. Rates are per second across all symbols;
+// a zero rate switches that stream off.
+type Config struct {
+	Exchange connector.ExchangeName
+
+	// Symbols is how many synthetic assets to spread the load over,
+	// named SYN0, SYN1, ...
+	Symbols int
+
+	BookUpdatesPerSecond int
+	TradesPerSecond      int
+	FillsPerSecond       int
+
+	Depth     int
+	BasePrice float64
+	TickSize  float64
+
+	// MakerRatio is the share of generated fills marked as maker
+	MakerRatio float64
+	FeeRate    float64
+
+	// Seed makes a run reproducible; zero seeds from the clock
+	Seed int64
+
+	BufferSize int
+	Resolution time.Duration
+
+	// Block waits for consumers instead of dropping, measuring how fast the
+	// pipeline can drain rather than how much it sheds at a fixed rate
+	Block bool
+}
+
+// DefaultConfig generates 10k book updates per second across ten symbols
+func DefaultConfig() Config {
+	return Config{
+		Exchange:             DefaultExchange,
+		Symbols:              DefaultSymbols,
+		BookUpdatesPerSecond: 10000,
+		TradesPerSecond:      1000,
+		FillsPerSecond:       10,
+		Depth:                DefaultDepth,
+		BasePrice:            DefaultBasePrice,
+		TickSize:             DefaultTickSize,
+		MakerRatio:           0.5,
+		FeeRate:              0.0004,
+		BufferSize:           DefaultBufferSize,
+		Resolution:           DefaultResolution,
+	}
+}
@@ -0,0 +1,366 @@
+package loadgen
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+)
+
+// Stats counts what the generator produced and what consumers could not keep up with
+type Stats struct {
+	BookUpdates   int64
+	Trades        int64
+	Fills         int64
+	DroppedBooks  int64
+	DroppedTrades int64
+	DroppedFills  int64
+	Elapsed       time.Duration
+}
+
+// Throughput is the achieved rate per second for each stream
+func (s Stats) Throughput() (books, trades, fills float64) {
+	seconds := s.Elapsed.Seconds()
+	if seconds <= 0 {
+		return 0, 0, 0
+	}
+	return float64(s.BookUpdates) / seconds, float64(s.Trades) / seconds, float64(s.Fills) / seconds
+}
+
+// LoadGenerator produces synthetic order books, market trades and fills at
+// configured rates, so the parsing, store and executor pipelines can be
+// measured under peak load without an exchange. Feed Books into
+// bookstats.OrderBookAggregator.Update and Fills into the executor's
+// HandleTradeExecution or the accounting ledger.
+type LoadGenerator interface {
+	Configure(config Config) error
+
+	Start() error
+	Stop() error
+
+	Books() <-chan connector.OrderBook
+	Trades() <-chan connector.Trade
+	Fills() <-chan connector.Trade
+
+	Stats() Stats
+}
+
+// symbolState is one synthetic asset's random walk
+type symbolState struct {
+	asset portfolio.Asset
+	mid   float64
+}
+
+type loadGenerator struct {
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config  Config
+	symbols []symbolState
+	rng     *rand.Rand
+	bookCh  chan connector.OrderBook
+	tradeCh chan connector.Trade
+	fillCh  chan connector.Trade
+	cancel  context.CancelFunc
+	done    chan struct{}
+	started time.Time
+	stopped time.Time
+	mu      sync.Mutex
+
+	books         atomic.Int64
+	trades        atomic.Int64
+	fills         atomic.Int64
+	droppedBooks  atomic.Int64
+	droppedTrades atomic.Int64
+	droppedFills  atomic.Int64
+}
+
+func NewLoadGenerator(
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) LoadGenerator {
+	g := &loadGenerator{
+		timeProvider: timeProvider,
+		logger:       logger,
+	}
+	_ = g.Configure(DefaultConfig())
+	return g
+}
+
+func (g *loadGenerator) Configure(config Config) error {
+	defaults := DefaultConfig()
+	if config.Exchange == "" {
+		config.Exchange = defaults.Exchange
+	}
+	if config.Symbols <= 0 {
+		config.Symbols = defaults.Symbols
+	}
+	if config.Depth <= 0 {
+		config.Depth = defaults.Depth
+	}
+	if config.BasePrice <= 0 {
+		config.BasePrice = defaults.BasePrice
+	}
+	if config.TickSize <= 0 {
+		config.TickSize = defaults.TickSize
+	}
+	if config.BufferSize <= 0 {
+		config.BufferSize = defaults.BufferSize
+	}
+	if config.Resolution <= 0 {
+		config.Resolution = defaults.Resolution
+	}
+	if config.MakerRatio < 0 || config.MakerRatio > 1 {
+		return fmt.Errorf("maker ratio must be between 0 and 1, got %v", config.MakerRatio)
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.cancel != nil {
+		return fmt.Errorf("load generator is running")
+	}
+
+	seed := config.Seed
+	if seed == 0 {
+		seed = g.timeProvider.Now().UnixNano()
+	}
+
+	g.config = config
+	g.rng = rand.New(rand.NewSource(seed))
+	g.symbols = make([]symbolState, config.Symbols)
+	for i := range g.symbols {
+		g.symbols[i] = symbolState{
+			asset: portfolio.NewAsset(fmt.Sprintf("SYN%d", i)),
+			mid:   config.BasePrice,
+		}
+	}
+	g.bookCh = make(chan connector.OrderBook, config.BufferSize)
+	g.tradeCh = make(chan connector.Trade, config.BufferSize)
+	g.fillCh = make(chan connector.Trade, config.BufferSize)
+	return nil
+}
+
+func (g *loadGenerator) Start() error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.cancel != nil {
+		return fmt.Errorf("load generator already running")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	g.cancel = cancel
+	g.done = make(chan struct{})
+	g.started = time.Now()
+	g.stopped = time.Time{}
+	for _, counter := range []*atomic.Int64{&g.books, &g.trades, &g.fills, &g.droppedBooks, &g.droppedTrades, &g.droppedFills} {
+		counter.Store(0)
+	}
+
+	go g.run(ctx, g.config)
+
+	g.logger.Info("Load generator started: %d book updates/s, %d trades/s, %d fills/s across %d symbols",
+		g.config.BookUpdatesPerSecond, g.config.TradesPerSecond, g.config.FillsPerSecond, g.config.Symbols)
+	return nil
+}
+
+func (g *loadGenerator) Stop() error {
+	g.mu.Lock()
+	cancel, done := g.cancel, g.done
+	g.cancel = nil
+	g.mu.Unlock()
+
+	if cancel == nil {
+		return nil
+	}
+	cancel()
+	<-done
+
+	g.mu.Lock()
+	g.stopped = time.Now()
+	g.mu.Unlock()
+	return nil
+}
+
+func (g *loadGenerator) Books() <-chan connector.OrderBook {
+	return g.bookCh
+}
+
+func (g *loadGenerator) Trades() <-chan connector.Trade {
+	return g.tradeCh
+}
+
+func (g *loadGenerator) Fills() <-chan connector.Trade {
+	return g.fillCh
+}
+
+func (g *loadGenerator) Stats() Stats {
+	g.mu.Lock()
+	elapsed := time.Duration(0)
+	if !g.started.IsZero() {
+		end := g.stopped
+		if end.IsZero() {
+			end = time.Now()
+		}
+		elapsed = end.Sub(g.started)
+	}
+	g.mu.Unlock()
+
+	return Stats{
+		BookUpdates:   g.books.Load(),
+		Trades:        g.trades.Load(),
+		Fills:         g.fills.Load(),
+		DroppedBooks:  g.droppedBooks.Load(),
+		DroppedTrades: g.droppedTrades.Load(),
+		DroppedFills:  g.droppedFills.Load(),
+		Elapsed:       elapsed,
+	}
+}
+
+// run emits whatever each stream is owed since the last tick, so the
+// configured rates hold even when a tick is late
+func (g *loadGenerator) run(ctx context.Context, config Config) {
+	defer close(g.done)
+
+	ticker := time.NewTicker(config.Resolution)
+	defer ticker.Stop()
+
+	var owedBooks, owedTrades, owedFills float64
+	last := time.Now()
+	sequence := 0
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			elapsed := now.Sub(last).Seconds()
+			last = now
+
+			owedBooks += float64(config.BookUpdatesPerSecond) * elapsed
+			owedTrades += float64(config.TradesPerSecond) * elapsed
+			owedFills += float64(config.FillsPerSecond) * elapsed
+
+			for ; owedBooks >= 1; owedBooks-- {
+				if !g.emitBook(ctx, config) {
+					return
+				}
+			}
+			for ; owedTrades >= 1; owedTrades-- {
+				sequence++
+				if !g.emitTrade(ctx, config, sequence) {
+					return
+				}
+			}
+			for ; owedFills >= 1; owedFills-- {
+				sequence++
+				if !g.emitFill(ctx, config, sequence) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// step moves a random symbol's mid by up to one tick and returns it
+func (g *loadGenerator) step(config Config) *symbolState {
+	state := &g.symbols[g.rng.Intn(len(g.symbols))]
+	state.mid += float64(g.rng.Intn(3)-1) * config.TickSize
+	if state.mid < config.TickSize*float64(config.Depth+1) {
+		state.mid = config.TickSize * float64(config.Depth+1)
+	}
+	return state
+}
+
+func (g *loadGenerator) emitBook(ctx context.Context, config Config) bool {
+	state := g.step(config)
+	book := connector.OrderBook{
+		Asset:     state.asset,
+		Bids:      make([]connector.PriceLevel, config.Depth),
+		Asks:      make([]connector.PriceLevel, config.Depth),
+		Timestamp: g.timeProvider.Now(),
+	}
+	for level := 0; level < config.Depth; level++ {
+		offset := float64(level+1) * config.TickSize
+		book.Bids[level] = connector.PriceLevel{Price: g.price(config, state.mid-offset), Quantity: g.quantity()}
+		book.Asks[level] = connector.PriceLevel{Price: g.price(config, state.mid+offset), Quantity: g.quantity()}
+	}
+
+	return send(ctx, g.bookCh, book, config.Block, &g.books, &g.droppedBooks)
+}
+
+func (g *loadGenerator) emitTrade(ctx context.Context, config Config, sequence int) bool {
+	trade := g.trade(config, sequence)
+	return send(ctx, g.tradeCh, trade, config.Block, &g.trades, &g.droppedTrades)
+}
+
+func (g *loadGenerator) emitFill(ctx context.Context, config Config, sequence int) bool {
+	fill := g.trade(config, sequence)
+	fill.OrderID = fmt.Sprintf("loadgen-order-%d", sequence)
+	fill.IsMaker = g.rng.Float64() < config.MakerRatio
+	fill.Fee = fill.Price.Mul(fill.Quantity).Mul(numerical.NewFromFloat(config.FeeRate))
+	return send(ctx, g.fillCh, fill, config.Block, &g.fills, &g.droppedFills)
+}
+
+func (g *loadGenerator) trade(config Config, sequence int) connector.Trade {
+	state := g.step(config)
+	side := connector.OrderSideBuy
+	price := state.mid + config.TickSize
+	if g.rng.Intn(2) == 0 {
+		side = connector.OrderSideSell
+		price = state.mid - config.TickSize
+	}
+	return connector.Trade{
+		ID:        fmt.Sprintf("loadgen-%d", sequence),
+		Symbol:    state.asset.Symbol(),
+		Exchange:  config.Exchange,
+		Price:     g.price(config, price),
+		Quantity:  g.quantity(),
+		Side:      side,
+		Timestamp: g.timeProvider.Now(),
+	}
+}
+
+// price rounds to the tick's precision so float drift in the walk never shows
+func (g *loadGenerator) price(config Config, value float64) numerical.Decimal {
+	places := int32(math.Ceil(-math.Log10(config.TickSize)))
+	if places < 0 {
+		places = 0
+	}
+	return numerical.NewFromFloat(value).Round(places)
+}
+
+func (g *loadGenerator) quantity() numerical.Decimal {
+	return numerical.NewFromFloat(float64(1+g.rng.Intn(1000)) / 100)
+}
+
+// send delivers or drops; it reports false only when the generator is stopping
+func send[T any](ctx context.Context, ch chan T, value T, block bool, sent, dropped *atomic.Int64) bool {
+	if block {
+		select {
+		case ch <- value:
+			sent.Add(1)
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	select {
+	case ch <- value:
+		sent.Add(1)
+	default:
+		dropped.Add(1)
+	}
+	return true
+}
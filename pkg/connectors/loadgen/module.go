@@ -0,0 +1,7 @@
+package loadgen
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(NewLoadGenerator),
+)
@@ -0,0 +1,29 @@
+package markprice
+
+import "time"
+
+const (
+	// DefaultInterval is how often tracked assets without a live stream are polled
+	DefaultInterval = 5 * time.Second
+
+	// DefaultStaleAfter is how long a streamed mark may go without an
+	// update before the poller refreshes it over REST
+	DefaultStaleAfter = 15 * time.Second
+
+	// JobName is the scheduler job the feed registers under
+	JobName = "mark-prices"
+)
+
+// Config controls the mark price feed
+type Config struct {
+	Interval   time.Duration
+	StaleAfter time.Duration
+}
+
+// DefaultConfig polls every five seconds and falls back from a stream after fifteen
+func DefaultConfig() Config {
+	return Config{
+		Interval:   DefaultInterval,
+		StaleAfter: DefaultStaleAfter,
+	}
+}
@@ -0,0 +1,373 @@
+package markprice
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+	"github.com/backtesting-org/live-trading/pkg/scheduler"
+)
+
+// MarkPriceFeed keeps the latest mark and index price for tracked assets.
+// Connectors with a native mark price channel are streamed; the rest, and
+// streams that go quiet, are polled over REST.
+type MarkPriceFeed interface {
+	Configure(config Config)
+
+	// Track starts following an asset on an exchange
+	Track(exchange connector.ExchangeName, asset portfolio.Asset) error
+	Untrack(exchange connector.ExchangeName, asset portfolio.Asset) error
+
+	Start() error
+	Stop() error
+
+	// Refresh polls every tracked asset that has no fresh streamed mark
+	Refresh() error
+
+	Mark(exchange connector.ExchangeName, asset portfolio.Asset) (types.MarkPrice, bool)
+	Marks() []types.MarkPrice
+	Updates() <-chan types.MarkPrice
+
+	// UnrealizedPnL values a position at the tracked mark, falling back to
+	// the position's own MarkPrice
+	UnrealizedPnL(position connector.Position) (numerical.Decimal, bool)
+
+	// LiquidationDistance is types.LiquidationDistance at the tracked mark
+	LiquidationDistance(position connector.Position) (numerical.Decimal, bool)
+}
+
+type markKey struct {
+	exchange connector.ExchangeName
+	symbol   string
+}
+
+type tracked struct {
+	asset    portfolio.Asset
+	streamed bool
+	mark     *types.MarkPrice
+}
+
+type markPriceFeed struct {
+	registry     registry.ConnectorRegistry
+	scheduler    scheduler.Scheduler
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config     Config
+	tracked    map[markKey]*tracked
+	forwarding map[connector.ExchangeName]bool
+	updateCh   chan types.MarkPrice
+	stopCh     chan struct{}
+	mu         sync.RWMutex
+}
+
+func NewMarkPriceFeed(
+	connectorRegistry registry.ConnectorRegistry,
+	jobScheduler scheduler.Scheduler,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) MarkPriceFeed {
+	return &markPriceFeed{
+		registry:     connectorRegistry,
+		scheduler:    jobScheduler,
+		timeProvider: timeProvider,
+		logger:       logger,
+		config:       DefaultConfig(),
+		tracked:      make(map[markKey]*tracked),
+		forwarding:   make(map[connector.ExchangeName]bool),
+		updateCh:     make(chan types.MarkPrice, 100),
+	}
+}
+
+func (f *markPriceFeed) Configure(config Config) {
+	if config.Interval <= 0 {
+		config.Interval = DefaultInterval
+	}
+	if config.StaleAfter <= 0 {
+		config.StaleAfter = DefaultStaleAfter
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.config = config
+}
+
+func (f *markPriceFeed) Updates() <-chan types.MarkPrice {
+	return f.updateCh
+}
+
+func (f *markPriceFeed) Track(exchange connector.ExchangeName, asset portfolio.Asset) error {
+	conn, ok := f.registry.GetConnector(exchange)
+	if !ok {
+		return fmt.Errorf("connector %s not registered", exchange)
+	}
+
+	_, polls := conn.(types.MarkPriceProvider)
+	streamer, streams := conn.(types.MarkPriceStreamer)
+	if !polls && !streams {
+		return fmt.Errorf("connector %s does not provide mark prices", exchange)
+	}
+
+	key := markKey{exchange, asset.Symbol()}
+	f.mu.Lock()
+	if _, exists := f.tracked[key]; exists {
+		f.mu.Unlock()
+		return nil
+	}
+	entry := &tracked{asset: asset}
+	f.tracked[key] = entry
+	f.mu.Unlock()
+
+	if !streams {
+		return nil
+	}
+
+	// A failed subscription is not fatal; the poller covers the asset
+	if err := streamer.SubscribeMarkPrice(asset); err != nil {
+		f.logger.Warn("Mark price stream unavailable for %s on %s, polling instead: %v", asset.Symbol(), exchange, err)
+		return nil
+	}
+
+	f.mu.Lock()
+	entry.streamed = true
+	running := f.stopCh != nil
+	f.mu.Unlock()
+
+	if running {
+		f.forward(exchange, streamer)
+	}
+	return nil
+}
+
+func (f *markPriceFeed) Untrack(exchange connector.ExchangeName, asset portfolio.Asset) error {
+	key := markKey{exchange, asset.Symbol()}
+
+	f.mu.Lock()
+	entry, ok := f.tracked[key]
+	delete(f.tracked, key)
+	f.mu.Unlock()
+
+	if !ok || !entry.streamed {
+		return nil
+	}
+
+	conn, ok := f.registry.GetConnector(exchange)
+	if !ok {
+		return nil
+	}
+	if streamer, ok := conn.(types.MarkPriceStreamer); ok {
+		return streamer.UnsubscribeMarkPrice(asset)
+	}
+	return nil
+}
+
+func (f *markPriceFeed) Start() error {
+	f.mu.Lock()
+	if f.stopCh != nil {
+		f.mu.Unlock()
+		return nil
+	}
+	f.stopCh = make(chan struct{})
+	interval := f.config.Interval
+
+	streaming := make(map[connector.ExchangeName]bool)
+	for key, entry := range f.tracked {
+		if entry.streamed {
+			streaming[key.exchange] = true
+		}
+	}
+	f.mu.Unlock()
+
+	for exchange := range streaming {
+		if conn, ok := f.registry.GetConnector(exchange); ok {
+			if streamer, ok := conn.(types.MarkPriceStreamer); ok {
+				f.forward(exchange, streamer)
+			}
+		}
+	}
+
+	return f.scheduler.Register(scheduler.Job{
+		Name:       JobName,
+		Interval:   interval,
+		RunOnStart: true,
+		Run: func(_ context.Context) error {
+			return f.Refresh()
+		},
+	})
+}
+
+func (f *markPriceFeed) Stop() error {
+	f.mu.Lock()
+	if f.stopCh != nil {
+		close(f.stopCh)
+		f.stopCh = nil
+	}
+	f.forwarding = make(map[connector.ExchangeName]bool)
+	f.mu.Unlock()
+
+	return f.scheduler.Unregister(JobName)
+}
+
+// forward drains one connector's mark price channel until Stop
+func (f *markPriceFeed) forward(exchange connector.ExchangeName, streamer types.MarkPriceStreamer) {
+	f.mu.Lock()
+	if f.forwarding[exchange] || f.stopCh == nil {
+		f.mu.Unlock()
+		return
+	}
+	f.forwarding[exchange] = true
+	stopCh := f.stopCh
+	f.mu.Unlock()
+
+	updates := streamer.MarkPriceUpdates()
+	go func() {
+		for {
+			select {
+			case <-stopCh:
+				return
+			case mark, ok := <-updates:
+				if !ok {
+					return
+				}
+				f.record(mark)
+			}
+		}
+	}()
+}
+
+func (f *markPriceFeed) Refresh() error {
+	f.mu.RLock()
+	staleAfter := f.config.StaleAfter
+	now := f.timeProvider.Now()
+	due := make([]markKey, 0, len(f.tracked))
+	for key, entry := range f.tracked {
+		if entry.streamed && entry.mark != nil && now.Sub(entry.mark.Timestamp) < staleAfter {
+			continue
+		}
+		due = append(due, key)
+	}
+	f.mu.RUnlock()
+
+	var errs []error
+	for _, key := range due {
+		conn, ok := f.registry.GetConnector(key.exchange)
+		if !ok {
+			continue
+		}
+		provider, ok := conn.(types.MarkPriceProvider)
+		if !ok {
+			continue
+		}
+
+		f.mu.RLock()
+		entry, ok := f.tracked[key]
+		f.mu.RUnlock()
+		if !ok {
+			continue
+		}
+
+		mark, err := provider.FetchMarkPrice(entry.asset)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s %s: %w", key.exchange, key.symbol, err))
+			continue
+		}
+		mark.Exchange = key.exchange
+		f.record(*mark)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("mark price refresh: %v", errs)
+	}
+	return nil
+}
+
+func (f *markPriceFeed) record(mark types.MarkPrice) {
+	if !mark.MarkPrice.IsPositive() {
+		return
+	}
+
+	f.mu.Lock()
+	entry, ok := f.tracked[markKey{mark.Exchange, mark.Asset.Symbol()}]
+	if !ok {
+		f.mu.Unlock()
+		return
+	}
+	copied := mark
+	entry.mark = &copied
+	f.mu.Unlock()
+
+	select {
+	case f.updateCh <- mark:
+	default:
+	}
+}
+
+func (f *markPriceFeed) Mark(exchange connector.ExchangeName, asset portfolio.Asset) (types.MarkPrice, bool) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	entry, ok := f.tracked[markKey{exchange, asset.Symbol()}]
+	if !ok || entry.mark == nil {
+		return types.MarkPrice{}, false
+	}
+	return *entry.mark, true
+}
+
+func (f *markPriceFeed) Marks() []types.MarkPrice {
+	f.mu.RLock()
+	marks := make([]types.MarkPrice, 0, len(f.tracked))
+	for _, entry := range f.tracked {
+		if entry.mark != nil {
+			marks = append(marks, *entry.mark)
+		}
+	}
+	f.mu.RUnlock()
+
+	sort.Slice(marks, func(i, j int) bool {
+		if marks[i].Exchange != marks[j].Exchange {
+			return marks[i].Exchange < marks[j].Exchange
+		}
+		return marks[i].Asset.Symbol() < marks[j].Asset.Symbol()
+	})
+	return marks
+}
+
+// markFor prefers the tracked mark over the one the exchange put on the position
+func (f *markPriceFeed) markFor(position connector.Position) (numerical.Decimal, bool) {
+	if mark, ok := f.Mark(position.Exchange, position.Symbol); ok {
+		return mark.MarkPrice, true
+	}
+	if position.MarkPrice.IsPositive() {
+		return position.MarkPrice, true
+	}
+	return numerical.Zero(), false
+}
+
+func (f *markPriceFeed) UnrealizedPnL(position connector.Position) (numerical.Decimal, bool) {
+	mark, ok := f.markFor(position)
+	if !ok || !position.EntryPrice.IsPositive() {
+		return numerical.Zero(), false
+	}
+
+	pnl := mark.Sub(position.EntryPrice).Mul(position.Size.Abs())
+	if position.Side == connector.OrderSideSell {
+		pnl = pnl.Neg()
+	}
+	return pnl, true
+}
+
+func (f *markPriceFeed) LiquidationDistance(position connector.Position) (numerical.Decimal, bool) {
+	mark, ok := f.markFor(position)
+	if !ok {
+		return numerical.Zero(), false
+	}
+	return types.LiquidationDistance(position, mark)
+}
@@ -0,0 +1,7 @@
+package markprice
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(NewMarkPriceFeed),
+)
@@ -1,16 +1,76 @@
 package connectors
 
 import (
+	"github.com/backtesting-org/live-trading/pkg/connectors/accounting"
+	"github.com/backtesting-org/live-trading/pkg/connectors/backfill"
+	"github.com/backtesting-org/live-trading/pkg/connectors/batch"
+	"github.com/backtesting-org/live-trading/pkg/connectors/binance"
+	"github.com/backtesting-org/live-trading/pkg/connectors/bookstats"
 	"github.com/backtesting-org/live-trading/pkg/connectors/bybit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/credentials"
+	"github.com/backtesting-org/live-trading/pkg/connectors/deadman"
+	"github.com/backtesting-org/live-trading/pkg/connectors/depth"
+	"github.com/backtesting-org/live-trading/pkg/connectors/execution"
+	"github.com/backtesting-org/live-trading/pkg/connectors/fillpolicy"
+	"github.com/backtesting-org/live-trading/pkg/connectors/fills"
+	"github.com/backtesting-org/live-trading/pkg/connectors/funding"
+	"github.com/backtesting-org/live-trading/pkg/connectors/health"
 	"github.com/backtesting-org/live-trading/pkg/connectors/hyperliquid"
+	"github.com/backtesting-org/live-trading/pkg/connectors/instruments"
+	"github.com/backtesting-org/live-trading/pkg/connectors/journal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/killswitch"
+	"github.com/backtesting-org/live-trading/pkg/connectors/latency"
+	"github.com/backtesting-org/live-trading/pkg/connectors/loadgen"
+	"github.com/backtesting-org/live-trading/pkg/connectors/markprice"
+	"github.com/backtesting-org/live-trading/pkg/connectors/oco"
+	"github.com/backtesting-org/live-trading/pkg/connectors/okx"
+	"github.com/backtesting-org/live-trading/pkg/connectors/oracle"
 	"github.com/backtesting-org/live-trading/pkg/connectors/paradex"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/reconcile"
+	"github.com/backtesting-org/live-trading/pkg/connectors/riskmetrics"
+	"github.com/backtesting-org/live-trading/pkg/connectors/sanity"
+	"github.com/backtesting-org/live-trading/pkg/connectors/switches"
+	"github.com/backtesting-org/live-trading/pkg/connectors/symbols"
+	"github.com/backtesting-org/live-trading/pkg/connectors/tracker"
+	"github.com/backtesting-org/live-trading/pkg/connectors/watchdog"
 	"go.uber.org/fx"
 )
 
 // Module includes all exchange connector modules
 // Each connector module automatically registers itself via fx groups
 var Module = fx.Options(
+	symbols.Module,
+	latency.Module,
+	ratelimit.Module,
 	paradex.Module,
 	hyperliquid.Module,
 	bybit.Module,
+	binance.Module,
+	okx.Module,
+	sanity.Module,
+	journal.Module,
+	watchdog.Module,
+	tracker.Module,
+	killswitch.Module,
+	bookstats.Module,
+	riskmetrics.Module,
+	backfill.Module,
+	oracle.Module,
+	execution.Module,
+	accounting.Module,
+	switches.Module,
+	health.Module,
+	instruments.Module,
+	oco.Module,
+	fillpolicy.Module,
+	markprice.Module,
+	loadgen.Module,
+	funding.Module,
+	fills.Module,
+	deadman.Module,
+	batch.Module,
+	reconcile.Module,
+	credentials.Module,
+	depth.Module,
 )
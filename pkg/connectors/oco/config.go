@@ -0,0 +1,27 @@
+package oco
+
+import "time"
+
+const (
+	// DefaultInterval is how often open legs are checked against the book
+	DefaultInterval = time.Second
+
+	// DefaultRetention is how long a finished pair stays readable through
+	// Pair before it is evicted
+	DefaultRetention = 10 * time.Minute
+
+	// JobName is the scheduler job the manager registers under
+	JobName = "oco-orders"
+)
+
+// Config controls OCO polling
+type Config struct {
+	Interval  time.Duration
+	Retention time.Duration
+}
+
+// DefaultConfig checks legs every second and forgets pairs ten minutes
+// after they finish
+func DefaultConfig() Config {
+	return Config{Interval: DefaultInterval, Retention: DefaultRetention}
+}
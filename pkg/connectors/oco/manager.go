@@ -0,0 +1,374 @@
+package oco
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/switches"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+	"github.com/backtesting-org/live-trading/pkg/scheduler"
+)
+
+// OCOManager emulates OCO orders client-side for venues without native
+// support: both legs rest on the exchange and the manager cancels the
+// survivor as soon as the other leaves the book or starts filling
+type OCOManager interface {
+	Configure(config Config)
+
+	// Start registers the polling job with the scheduler
+	Start() error
+	Stop() error
+
+	// Place puts both legs on the book; if the second leg fails the first
+	// is cancelled so no half-pair is left behind
+	Place(order Order) (Pair, error)
+
+	// Cancel cancels both legs of an active pair
+	Cancel(id string) error
+
+	// Poll checks every active pair against its exchange's open orders
+	Poll() error
+
+	Pair(id string) (Pair, bool)
+	Active() []Pair
+
+	// Completions publishes pairs as they leave StatusActive
+	Completions() <-chan Pair
+}
+
+type manager struct {
+	registry     registry.ConnectorRegistry
+	scheduler    scheduler.Scheduler
+	switches     switches.TradingSwitches
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config   Config
+	pairs    map[string]*Pair
+	doneCh   chan Pair
+	sequence atomic.Int64
+	mu       sync.Mutex
+}
+
+func NewOCOManager(
+	connectorRegistry registry.ConnectorRegistry,
+	jobScheduler scheduler.Scheduler,
+	tradingSwitches switches.TradingSwitches,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) OCOManager {
+	return &manager{
+		registry:     connectorRegistry,
+		scheduler:    jobScheduler,
+		switches:     tradingSwitches,
+		timeProvider: timeProvider,
+		logger:       logger,
+		config:       DefaultConfig(),
+		pairs:        make(map[string]*Pair),
+		doneCh:       make(chan Pair, 100),
+	}
+}
+
+func (m *manager) Configure(config Config) {
+	if config.Interval <= 0 {
+		config.Interval = DefaultInterval
+	}
+	if config.Retention <= 0 {
+		config.Retention = DefaultRetention
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.config = config
+}
+
+func (m *manager) Completions() <-chan Pair {
+	return m.doneCh
+}
+
+func (m *manager) Start() error {
+	m.mu.Lock()
+	interval := m.config.Interval
+	m.mu.Unlock()
+
+	return m.scheduler.Register(scheduler.Job{
+		Name:     JobName,
+		Interval: interval,
+		Run: func(_ context.Context) error {
+			return m.Poll()
+		},
+	})
+}
+
+func (m *manager) Stop() error {
+	return m.scheduler.Unregister(JobName)
+}
+
+func (m *manager) Place(order Order) (Pair, error) {
+	if err := order.validate(); err != nil {
+		return Pair{}, fmt.Errorf("invalid OCO order: %w", err)
+	}
+
+	conn, ok := m.registry.GetConnector(order.Exchange)
+	if !ok {
+		return Pair{}, fmt.Errorf("connector %s not registered", order.Exchange)
+	}
+	triggers, ok := conn.(types.TriggerOrderProvider)
+	if !ok {
+		return Pair{}, fmt.Errorf("connector %s does not support stop orders", order.Exchange)
+	}
+	if err := m.switches.CheckOrder(order.Exchange, order.Symbol, order.ReduceOnly); err != nil {
+		return Pair{}, err
+	}
+
+	stop := types.TriggerOrder{
+		Symbol:       order.Symbol,
+		Side:         order.Side,
+		Type:         connector.OrderTypeStopMarket,
+		Quantity:     order.Quantity,
+		TriggerPrice: order.StopPrice,
+		ReduceOnly:   order.ReduceOnly,
+	}
+	if order.StopLimitPrice.IsPositive() {
+		stop.Type = connector.OrderTypeStopLimit
+		stop.LimitPrice = order.StopLimitPrice
+	}
+
+	// The stop goes first: a position briefly without a take-profit is
+	// safer than one briefly without a stop
+	stopResp, err := triggers.PlaceTriggerOrder(stop)
+	if err != nil {
+		return Pair{}, fmt.Errorf("failed to place stop leg: %w", err)
+	}
+
+	tpResp, err := conn.PlaceLimitOrder(order.Symbol, order.Side, order.Quantity, order.TakeProfitPrice)
+	if err != nil {
+		if _, cancelErr := conn.CancelOrder(order.Symbol, stopResp.OrderID); cancelErr != nil {
+			m.logger.Error("⚠️ OCO take-profit failed and stop %s could not be cancelled: %v", stopResp.OrderID, cancelErr)
+		}
+		return Pair{}, fmt.Errorf("failed to place take-profit leg: %w", err)
+	}
+
+	now := m.timeProvider.Now()
+	pair := &Pair{
+		ID:                fmt.Sprintf("oco-%d-%d", now.UnixMilli(), m.sequence.Add(1)),
+		Order:             order,
+		Status:            StatusActive,
+		TakeProfitOrderID: tpResp.OrderID,
+		StopLossOrderID:   stopResp.OrderID,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}
+
+	m.mu.Lock()
+	m.pairs[pair.ID] = pair
+	m.mu.Unlock()
+
+	m.logger.Info("OCO %s placed on %s %s: take-profit %s @ %s, stop %s @ %s",
+		pair.ID, order.Exchange, order.Symbol, tpResp.OrderID, order.TakeProfitPrice, stopResp.OrderID, order.StopPrice)
+	return *pair, nil
+}
+
+func (m *manager) Cancel(id string) error {
+	m.mu.Lock()
+	pair, ok := m.pairs[id]
+	if !ok {
+		m.mu.Unlock()
+		return fmt.Errorf("OCO %s not found", id)
+	}
+	if pair.Status != StatusActive {
+		m.mu.Unlock()
+		return fmt.Errorf("OCO %s is already %s", id, pair.Status)
+	}
+	snapshot := *pair
+	m.mu.Unlock()
+
+	conn, ok := m.registry.GetConnector(snapshot.Order.Exchange)
+	if !ok {
+		return fmt.Errorf("connector %s not registered", snapshot.Order.Exchange)
+	}
+
+	var errs []error
+	for _, leg := range []Leg{LegTakeProfit, LegStopLoss} {
+		if _, err := conn.CancelOrder(snapshot.Order.Symbol, snapshot.OrderID(leg)); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", leg, err))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to cancel OCO %s: %v", id, errs)
+	}
+
+	m.finish(id, StatusCancelled, "", "")
+	return nil
+}
+
+func (m *manager) Poll() error {
+	byExchange := make(map[connector.ExchangeName][]Pair)
+	m.mu.Lock()
+	// Finished pairs are kept for the retention so Pair still reports
+	// how they ended, then forgotten
+	cutoff := m.timeProvider.Now().Add(-m.config.Retention)
+	for id, pair := range m.pairs {
+		if pair.Status != StatusActive && pair.UpdatedAt.Before(cutoff) {
+			delete(m.pairs, id)
+		}
+	}
+	for _, pair := range m.pairs {
+		if pair.Status == StatusActive {
+			byExchange[pair.Order.Exchange] = append(byExchange[pair.Order.Exchange], *pair)
+		}
+	}
+	m.mu.Unlock()
+
+	var errs []error
+	for exchange, pairs := range byExchange {
+		conn, ok := m.registry.GetConnector(exchange)
+		if !ok {
+			errs = append(errs, fmt.Errorf("connector %s not registered", exchange))
+			continue
+		}
+
+		orders, err := conn.GetOpenOrders()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: fetch open orders: %w", exchange, err))
+			continue
+		}
+
+		open := make(map[string]connector.Order, len(orders))
+		for _, order := range orders {
+			open[order.ID] = order
+		}
+
+		for _, pair := range pairs {
+			m.check(conn, pair, open)
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("OCO poll: %v", errs)
+	}
+	return nil
+}
+
+// check resolves one pair: a leg that has left the book, or started
+// filling, is taken as executed and the other leg is cancelled
+func (m *manager) check(conn connector.Connector, pair Pair, open map[string]connector.Order) {
+	tp, tpOpen := open[pair.TakeProfitOrderID]
+	sl, slOpen := open[pair.StopLossOrderID]
+
+	var executed Leg
+	switch {
+	case tpOpen && slOpen:
+		switch {
+		case tp.FilledQty.IsPositive():
+			executed = LegTakeProfit
+		case sl.FilledQty.IsPositive():
+			executed = LegStopLoss
+		default:
+			return
+		}
+	case !tpOpen && !slOpen:
+		m.finish(pair.ID, StatusFailed, "", "both legs left the book")
+		return
+	case !tpOpen:
+		executed = LegTakeProfit
+	default:
+		executed = LegStopLoss
+	}
+
+	// A leg cancelled by someone else takes the pair with it
+	if m.cancelled(conn, pair.OrderID(executed)) {
+		m.cancelLeg(conn, pair, other(executed))
+		m.finish(pair.ID, StatusCancelled, "", fmt.Sprintf("%s cancelled outside the OCO", executed))
+		return
+	}
+
+	if !m.cancelLeg(conn, pair, other(executed)) {
+		// Left active so the next poll retries the cancel
+		return
+	}
+	m.finish(pair.ID, StatusTriggered, executed, "")
+}
+
+// cancelled reports whether the exchange says the order was cancelled;
+// venues that cannot report status are assumed to have executed it
+func (m *manager) cancelled(conn connector.Connector, orderID string) bool {
+	order, err := conn.GetOrderStatus(orderID)
+	if err != nil || order == nil {
+		return false
+	}
+	return order.Status == connector.OrderStatusCanceled
+}
+
+func (m *manager) cancelLeg(conn connector.Connector, pair Pair, leg Leg) bool {
+	if _, err := conn.CancelOrder(pair.Order.Symbol, pair.OrderID(leg)); err != nil {
+		m.logger.Error("⚠️ OCO %s could not cancel %s leg %s: %v", pair.ID, leg, pair.OrderID(leg), err)
+
+		m.mu.Lock()
+		if p, ok := m.pairs[pair.ID]; ok {
+			p.Error = err.Error()
+			p.UpdatedAt = m.timeProvider.Now()
+		}
+		m.mu.Unlock()
+		return false
+	}
+	return true
+}
+
+func (m *manager) finish(id string, status Status, triggered Leg, reason string) {
+	m.mu.Lock()
+	pair, ok := m.pairs[id]
+	if !ok || pair.Status != StatusActive {
+		m.mu.Unlock()
+		return
+	}
+	pair.Status = status
+	pair.Triggered = triggered
+	pair.Error = reason
+	pair.UpdatedAt = m.timeProvider.Now()
+	snapshot := *pair
+	m.mu.Unlock()
+
+	if triggered != "" {
+		m.logger.Info("OCO %s %s leg executed; %s leg cancelled", id, triggered, other(triggered))
+	} else {
+		m.logger.Info("OCO %s %s %s", id, status, reason)
+	}
+
+	select {
+	case m.doneCh <- snapshot:
+	default:
+		m.logger.Warn("OCO completion channel full, dropping update for %s", id)
+	}
+}
+
+func (m *manager) Pair(id string) (Pair, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	pair, ok := m.pairs[id]
+	if !ok {
+		return Pair{}, false
+	}
+	return *pair, true
+}
+
+func (m *manager) Active() []Pair {
+	m.mu.Lock()
+	var pairs []Pair
+	for _, pair := range m.pairs {
+		if pair.Status == StatusActive {
+			pairs = append(pairs, *pair)
+		}
+	}
+	m.mu.Unlock()
+
+	sort.Slice(pairs, func(i, j int) bool { return pairs[i].CreatedAt.Before(pairs[j].CreatedAt) })
+	return pairs
+}
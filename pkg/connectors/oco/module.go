@@ -0,0 +1,7 @@
+package oco
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(NewOCOManager),
+)
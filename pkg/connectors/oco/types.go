@@ -0,0 +1,105 @@
+package oco
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+)
+
+// Leg names one side of an OCO pair
+type Leg string
+
+const (
+	LegTakeProfit Leg = "take_profit"
+	LegStopLoss   Leg = "stop_loss"
+)
+
+// Status is the lifecycle state of an OCO pair
+type Status string
+
+const (
+	StatusActive    Status = "active"
+	StatusTriggered Status = "triggered"
+	StatusCancelled Status = "cancelled"
+	StatusFailed    Status = "failed"
+)
+
+// Order is a take-profit limit and a stop that cancel each other: whichever
+// executes first, even partially, cancels the other. Both legs trade Side,
+// which is normally the side that closes the position being protected.
+type Order struct {
+	Exchange connector.ExchangeName
+	Symbol   string
+	Side     connector.OrderSide
+	Quantity numerical.Decimal
+
+	// TakeProfitPrice is the limit price of the take-profit leg
+	TakeProfitPrice numerical.Decimal
+
+	// StopPrice triggers the stop leg
+	StopPrice numerical.Decimal
+
+	// StopLimitPrice makes the stop leg a stop-limit; zero means stop-market
+	StopLimitPrice numerical.Decimal
+
+	ReduceOnly bool
+}
+
+func (o Order) validate() error {
+	if o.Symbol == "" {
+		return fmt.Errorf("symbol is required")
+	}
+	if o.Side != connector.OrderSideBuy && o.Side != connector.OrderSideSell {
+		return fmt.Errorf("side must be %s or %s", connector.OrderSideBuy, connector.OrderSideSell)
+	}
+	if !o.Quantity.IsPositive() {
+		return fmt.Errorf("quantity must be positive")
+	}
+	if !o.TakeProfitPrice.IsPositive() || !o.StopPrice.IsPositive() {
+		return fmt.Errorf("take-profit and stop prices must be positive")
+	}
+
+	// Selling closes a long: profit is above the stop. Buying closes a short.
+	if o.Side == connector.OrderSideSell && !o.TakeProfitPrice.GreaterThan(o.StopPrice) {
+		return fmt.Errorf("sell OCO needs the take-profit above the stop")
+	}
+	if o.Side == connector.OrderSideBuy && !o.TakeProfitPrice.LessThan(o.StopPrice) {
+		return fmt.Errorf("buy OCO needs the take-profit below the stop")
+	}
+	return nil
+}
+
+// Pair is the live state of an OCO order
+type Pair struct {
+	ID     string
+	Order  Order
+	Status Status
+
+	TakeProfitOrderID string
+	StopLossOrderID   string
+
+	// Triggered is the leg that executed; the other was cancelled
+	Triggered Leg
+
+	Error     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// OrderID returns the exchange order ID of one leg
+func (p Pair) OrderID(leg Leg) string {
+	if leg == LegTakeProfit {
+		return p.TakeProfitOrderID
+	}
+	return p.StopLossOrderID
+}
+
+// other returns the leg opposite to leg
+func other(leg Leg) Leg {
+	if leg == LegTakeProfit {
+		return LegStopLoss
+	}
+	return LegTakeProfit
+}
@@ -0,0 +1,30 @@
+package okx
+
+import (
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+)
+
+// GetAccountBalance reports the unified account as it is margined: adjusted
+// equity in USD across every currency in multi-currency and portfolio
+// margin mode, the USDT balance otherwise
+func (o *okx) GetAccountBalance() (*connector.AccountBalance, error) {
+	if err := o.limiter.Wait(ratelimit.EndpointAccount); err != nil {
+		return nil, err
+	}
+	return o.trading.GetAccountBalance()
+}
+
+func (o *okx) GetPositions() ([]connector.Position, error) {
+	if err := o.limiter.Wait(ratelimit.EndpointAccount); err != nil {
+		return nil, err
+	}
+	return o.trading.GetPositions()
+}
+
+func (o *okx) GetTradingHistory(symbol string, limit int) ([]connector.Trade, error) {
+	if err := o.limiter.Wait(ratelimit.EndpointTrades); err != nil {
+		return nil, err
+	}
+	return o.trading.GetTradingHistory(connector.TypePerpetual, symbol, limit)
+}
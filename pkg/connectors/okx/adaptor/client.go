@@ -0,0 +1,260 @@
+package adaptor
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+// loginPath is the request path OKX expects WebSocket logins to sign
+const loginPath = "/users/self/verify"
+
+// Client is a lazily configured REST client for the OKX v5 API. Every
+// response arrives in a {code, msg, data} envelope; the client unwraps it
+// and decodes data into out.
+type Client interface {
+	Configure(baseURL, apiKey, apiSecret, passphrase string, simulated bool) error
+	IsConfigured() bool
+
+	// Public performs an unsigned request
+	Public(ctx context.Context, method, path string, params url.Values, out interface{}) error
+
+	// Signed performs a request signed with the API secret; body is sent as
+	// JSON and may be nil
+	Signed(ctx context.Context, method, path string, params url.Values, body interface{}, out interface{}) error
+
+	// LoginArgs signs a login for the private WebSocket
+	LoginArgs() (*LoginArgs, error)
+}
+
+// LoginArgs is the single argument of a WebSocket login request
+type LoginArgs struct {
+	APIKey     string `json:"apiKey"`
+	Passphrase string `json:"passphrase"`
+	Timestamp  string `json:"timestamp"`
+	Sign       string `json:"sign"`
+}
+
+// APIError is the error OKX returns for rejected requests. For orders the
+// envelope code is generic and the per-order sCode carries the reason, so
+// that is reported instead.
+type APIError struct {
+	Code       string `json:"code"`
+	Message    string `json:"msg"`
+	StatusCode int    `json:"-"`
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("okx api error %s (http %d): %s", e.Code, e.StatusCode, e.Message)
+}
+
+// ErrorCategory maps OKX error codes onto the connector taxonomy
+func (e *APIError) ErrorCategory() types.ErrorCategory {
+	code, _ := strconv.Atoi(e.Code)
+	switch {
+	case e.StatusCode == http.StatusTooManyRequests,
+		code == 50011, code == 50040, code == 50061:
+		return types.CategoryRateLimited
+	// 50102 is a request timestamp outside the accepted window, which clears up on retry
+	case e.StatusCode >= http.StatusInternalServerError,
+		code == 50001, code == 50004, code == 50013, code == 50026, code == 50102:
+		return types.CategoryConnectivity
+	case e.StatusCode == http.StatusUnauthorized,
+		code >= 50100 && code <= 50119:
+		return types.CategoryUnauthorized
+	case code == 51008, code == 51127, code == 51131:
+		return types.CategoryInsufficientFunds
+	case code >= 51000 && code <= 51999, code >= 54000 && code <= 54099:
+		return types.CategoryInvalidOrder
+	default:
+		return types.CategoryUnknown
+	}
+}
+
+type envelope struct {
+	Code    string          `json:"code"`
+	Message string          `json:"msg"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// itemStatus is the per-item outcome carried by batch-style responses
+type itemStatus struct {
+	SCode string `json:"sCode"`
+	SMsg  string `json:"sMsg"`
+}
+
+type client struct {
+	httpClient   *http.Client
+	timeProvider temporal.TimeProvider
+	baseURL      string
+	apiKey       string
+	apiSecret    string
+	passphrase   string
+	simulated    bool
+	configured   bool
+	mu           sync.RWMutex
+}
+
+// NewClient creates an unconfigured OKX REST client
+func NewClient(timeProvider temporal.TimeProvider) Client {
+	return &client{
+		httpClient:   &http.Client{Timeout: 10 * time.Second},
+		timeProvider: timeProvider,
+	}
+}
+
+// Configure sets up the client with runtime config; simulated routes every
+// request to demo trading
+func (c *client) Configure(baseURL, apiKey, apiSecret, passphrase string, simulated bool) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.configured {
+		return fmt.Errorf("client already configured")
+	}
+
+	if _, err := url.Parse(baseURL); err != nil {
+		return fmt.Errorf("invalid base url: %w", err)
+	}
+
+	c.baseURL = baseURL
+	c.apiKey = apiKey
+	c.apiSecret = apiSecret
+	c.passphrase = passphrase
+	c.simulated = simulated
+	c.configured = true
+	return nil
+}
+
+func (c *client) IsConfigured() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.configured
+}
+
+func (c *client) Public(ctx context.Context, method, path string, params url.Values, out interface{}) error {
+	return c.do(ctx, method, path, params, nil, false, out)
+}
+
+func (c *client) Signed(ctx context.Context, method, path string, params url.Values, body interface{}, out interface{}) error {
+	return c.do(ctx, method, path, params, body, true, out)
+}
+
+func (c *client) LoginArgs() (*LoginArgs, error) {
+	c.mu.RLock()
+	apiKey, apiSecret, passphrase, configured := c.apiKey, c.apiSecret, c.passphrase, c.configured
+	c.mu.RUnlock()
+
+	if !configured {
+		return nil, fmt.Errorf("okx client not configured")
+	}
+
+	timestamp := strconv.FormatInt(c.timeProvider.Now().Unix(), 10)
+	return &LoginArgs{
+		APIKey:     apiKey,
+		Passphrase: passphrase,
+		Timestamp:  timestamp,
+		Sign:       sign(apiSecret, timestamp+http.MethodGet+loginPath),
+	}, nil
+}
+
+func (c *client) do(ctx context.Context, method, path string, params url.Values, body interface{}, signed bool, out interface{}) error {
+	c.mu.RLock()
+	baseURL, apiKey, apiSecret, passphrase, simulated, configured := c.baseURL, c.apiKey, c.apiSecret, c.passphrase, c.simulated, c.configured
+	c.mu.RUnlock()
+
+	if !configured {
+		return fmt.Errorf("okx client not configured")
+	}
+
+	requestPath := path
+	if len(params) > 0 {
+		requestPath += "?" + params.Encode()
+	}
+
+	var payload []byte
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request body: %w", err)
+		}
+		payload = encoded
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, baseURL+requestPath, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	if signed {
+		timestamp := c.timeProvider.Now().UTC().Format("2006-01-02T15:04:05.000Z")
+		req.Header.Set("OK-ACCESS-KEY", apiKey)
+		req.Header.Set("OK-ACCESS-PASSPHRASE", passphrase)
+		req.Header.Set("OK-ACCESS-TIMESTAMP", timestamp)
+		req.Header.Set("OK-ACCESS-SIGN", sign(apiSecret, timestamp+method+requestPath+string(payload)))
+	}
+	if simulated {
+		req.Header.Set("x-simulated-trading", "1")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request to %s failed: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response from %s: %w", path, err)
+	}
+
+	var result envelope
+	if jsonErr := json.Unmarshal(raw, &result); jsonErr != nil {
+		if resp.StatusCode >= http.StatusBadRequest {
+			return &APIError{StatusCode: resp.StatusCode, Message: string(raw)}
+		}
+		return fmt.Errorf("failed to decode response from %s: %w", path, jsonErr)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest || result.Code != "0" {
+		apiErr := &APIError{Code: result.Code, Message: result.Message, StatusCode: resp.StatusCode}
+		var items []itemStatus
+		if json.Unmarshal(result.Data, &items) == nil && len(items) > 0 && items[0].SCode != "" && items[0].SCode != "0" {
+			apiErr.Code, apiErr.Message = items[0].SCode, items[0].SMsg
+		}
+		if apiErr.Message == "" {
+			apiErr.Message = string(raw)
+		}
+		return apiErr
+	}
+
+	if out == nil || len(result.Data) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(result.Data, out); err != nil {
+		return fmt.Errorf("failed to decode response from %s: %w", path, err)
+	}
+
+	return nil
+}
+
+func sign(secret, prehash string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(prehash))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,48 @@
+package okx
+
+import (
+	"fmt"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+)
+
+func (o *okx) FetchAvailablePerpetualAssets() ([]portfolio.Asset, error) {
+	if err := o.limiter.Wait(ratelimit.EndpointInstruments); err != nil {
+		return nil, err
+	}
+	return o.marketData.FetchAvailablePerpetualAssets()
+}
+
+func (o *okx) FetchAvailableSpotAssets() ([]portfolio.Asset, error) {
+	if err := o.limiter.Wait(ratelimit.EndpointInstruments); err != nil {
+		return nil, err
+	}
+	return o.marketData.FetchAvailableSpotAssets()
+}
+
+func (o *okx) FetchContracts() ([]connector.ContractInfo, error) {
+	if err := o.limiter.Wait(ratelimit.EndpointInstruments); err != nil {
+		return nil, err
+	}
+	return o.marketData.FetchContracts()
+}
+
+func (o *okx) FetchCurrentFundingRates() (map[portfolio.Asset]connector.FundingRate, error) {
+	if err := o.limiter.Wait(ratelimit.EndpointFunding); err != nil {
+		return nil, err
+	}
+	return o.marketData.FetchCurrentFundingRates()
+}
+
+func (o *okx) FetchHistoricalFundingRates(asset portfolio.Asset, startTime, endTime int64) ([]connector.HistoricalFundingRate, error) {
+	if err := o.limiter.Wait(ratelimit.EndpointFunding); err != nil {
+		return nil, err
+	}
+	return o.marketData.FetchHistoricalFundingRates(o.GetPerpSymbol(asset), startTime, endTime)
+}
+
+func (o *okx) FetchRiskFundBalance(symbol string) (*connector.RiskFundBalance, error) {
+	return nil, fmt.Errorf("FetchRiskFundBalance not implemented for OKX")
+}
@@ -0,0 +1,44 @@
+package okx
+
+import (
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+// SupportsTradingOperations returns whether trading operations are supported
+func (o *okx) SupportsTradingOperations() bool {
+	return o.trading != nil
+}
+
+// SupportsRealTimeData returns whether real-time data is supported
+func (o *okx) SupportsRealTimeData() bool {
+	return o.realTime != nil
+}
+
+func (o *okx) SupportsFundingRates() bool {
+	return true
+}
+
+func (o *okx) SupportsPerpetuals() bool {
+	return true
+}
+
+func (o *okx) SupportsSpot() bool {
+	return true
+}
+
+// GetConnectorInfo returns metadata about the exchange
+func (o *okx) GetConnectorInfo() *connector.Info {
+	return &connector.Info{
+		Name:             types.Okx,
+		TradingEnabled:   o.SupportsTradingOperations(),
+		WebSocketEnabled: true,
+		MaxLeverage:      numerical.NewFromFloat(100.0),
+		SupportedOrderTypes: []connector.OrderType{
+			connector.OrderTypeLimit,
+			connector.OrderTypeMarket,
+		},
+		QuoteCurrency: "USDT",
+	}
+}
@@ -0,0 +1,127 @@
+package okx
+
+import (
+	"fmt"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+const (
+	mainnetBaseURL       = "https://www.okx.com"
+	mainnetPublicWSURL   = "wss://ws.okx.com:8443/ws/v5/public"
+	demoPublicWSURL      = "wss://wspap.okx.com:8443/ws/v5/public"
+	mainnetPrivateWSURL  = "wss://ws.okx.com:8443/ws/v5/private"
+	demoPrivateWSURL     = "wss://wspap.okx.com:8443/ws/v5/private"
+	mainnetBusinessWSURL = "wss://ws.okx.com:8443/ws/v5/business"
+	demoBusinessWSURL    = "wss://wspap.okx.com:8443/ws/v5/business"
+)
+
+// Trade modes OKX accepts as tdMode on orders placed from a unified account
+const (
+	TradeModeCross    = "cross"
+	TradeModeIsolated = "isolated"
+	TradeModeCash     = "cash"
+)
+
+// Config holds the configuration for the OKX connector. OKX runs demo
+// trading on the mainnet REST host, selected by a request header, so
+// IsTestnet switches the header and the WebSocket hosts only.
+type Config struct {
+	APIKey          string  `json:"api_key"`
+	APISecret       string  `json:"api_secret"`
+	Passphrase      string  `json:"passphrase"`
+	BaseURL         string  `json:"base_url,omitempty"`
+	PublicWSURL     string  `json:"public_ws_url,omitempty"`
+	PrivateWSURL    string  `json:"private_ws_url,omitempty"`
+	BusinessWSURL   string  `json:"business_ws_url,omitempty"` // Candles are only served here
+	IsTestnet       bool    `json:"is_testnet,omitempty"`
+	DefaultSlippage float64 `json:"default_slippage,omitempty"` // Default 0.005 (0.5%)
+
+	// SwapTradeMode is the tdMode for swap orders, cross or isolated;
+	// default cross. Spot orders use cash unless the account borrows.
+	SwapTradeMode string `json:"swap_trade_mode,omitempty"`
+
+	// MarginMode is the account margin mode risk settings were sized for;
+	// startup warns when the account reports a different one
+	MarginMode types.MarginMode `json:"margin_mode,omitempty"`
+}
+
+var _ connector.Config = (*Config)(nil)
+var _ types.SecretConfig = (*Config)(nil)
+
+func (c *Config) ExchangeName() connector.ExchangeName {
+	return types.Okx
+}
+
+func (c *Config) UsesTestnet() bool {
+	return c.IsTestnet
+}
+
+func (c *Config) SecretFields() map[string]*string {
+	return map[string]*string{"api_key": &c.APIKey, "api_secret": &c.APISecret, "passphrase": &c.Passphrase}
+}
+
+// ExpectedMarginMode returns the configured margin mode, if any
+func (c *Config) ExpectedMarginMode() types.MarginMode {
+	return c.MarginMode
+}
+
+// Validate checks if the configuration is valid
+func (c *Config) Validate() error {
+	if c.APIKey == "" {
+		return fmt.Errorf("api_key is required")
+	}
+	if c.APISecret == "" {
+		return fmt.Errorf("api_secret is required")
+	}
+	if c.Passphrase == "" {
+		return fmt.Errorf("passphrase is required")
+	}
+
+	if c.MarginMode != "" && !types.ValidMarginMode(c.MarginMode) {
+		return fmt.Errorf("margin_mode must be isolated, cross or portfolio, got %q", c.MarginMode)
+	}
+
+	switch c.SwapTradeMode {
+	case "":
+		c.SwapTradeMode = TradeModeCross
+	case TradeModeCross, TradeModeIsolated:
+	default:
+		return fmt.Errorf("swap_trade_mode must be cross or isolated, got %q", c.SwapTradeMode)
+	}
+
+	if c.DefaultSlippage == 0 {
+		c.DefaultSlippage = 0.005
+	}
+
+	if c.BaseURL == "" {
+		c.BaseURL = mainnetBaseURL
+	}
+
+	if c.PublicWSURL == "" {
+		if c.IsTestnet {
+			c.PublicWSURL = demoPublicWSURL
+		} else {
+			c.PublicWSURL = mainnetPublicWSURL
+		}
+	}
+
+	if c.PrivateWSURL == "" {
+		if c.IsTestnet {
+			c.PrivateWSURL = demoPrivateWSURL
+		} else {
+			c.PrivateWSURL = mainnetPrivateWSURL
+		}
+	}
+
+	if c.BusinessWSURL == "" {
+		if c.IsTestnet {
+			c.BusinessWSURL = demoBusinessWSURL
+		} else {
+			c.BusinessWSURL = mainnetBusinessWSURL
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package okx
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/okx/adaptor"
+	"github.com/backtesting-org/live-trading/pkg/connectors/okx/data"
+	"github.com/backtesting-org/live-trading/pkg/connectors/okx/data/real_time"
+	"github.com/backtesting-org/live-trading/pkg/connectors/okx/trading"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+// okx implements Connector and WebSocketConnector for OKX USDT swaps and
+// spot, traded from a unified account
+type okx struct {
+	client        adaptor.Client
+	marketData    data.MarketDataService
+	trading       trading.TradingService
+	realTime      real_time.RealTimeService
+	config        *Config
+	appLogger     logging.ApplicationLogger
+	tradingLogger logging.TradingLogger
+	timeProvider  temporal.TimeProvider
+	limiter       ratelimit.Limiter
+	initialized   bool
+
+	// Separate channels per orderbook subscription (key: "BTC", "ETH", etc.)
+	orderBookChannels map[string]chan connector.OrderBook
+	orderBookMu       sync.RWMutex
+
+	// Separate channels per kline subscription (key: "BTC:1m", "ETH:5m", etc.)
+	klineChannels map[string]chan connector.Kline
+	klineMu       sync.RWMutex
+
+	// WebSocket channels
+	tradeCh    chan connector.Trade
+	positionCh chan connector.Position
+	fillCh     chan connector.Trade
+	balanceCh  chan connector.AccountBalance
+	errorCh    chan error
+
+	// Swaps with an active position subscription; all share the private positions channel
+	positionSymbols map[string]portfolio.Asset
+	userMu          sync.RWMutex
+}
+
+var _ connector.Connector = (*okx)(nil)
+var _ connector.WebSocketConnector = (*okx)(nil)
+var _ types.FillStreamer = (*okx)(nil)
+
+func NewOkx(
+	client adaptor.Client,
+	tradingService trading.TradingService,
+	marketDataService data.MarketDataService,
+	realTimeService real_time.RealTimeService,
+	appLogger logging.ApplicationLogger,
+	tradingLogger logging.TradingLogger,
+	timeProvider temporal.TimeProvider,
+	limiters ratelimit.RateLimiters,
+) connector.Connector {
+	return &okx{
+		client:            client,
+		trading:           tradingService,
+		marketData:        marketDataService,
+		realTime:          realTimeService,
+		appLogger:         appLogger,
+		tradingLogger:     tradingLogger,
+		timeProvider:      timeProvider,
+		limiter:           limiters.For(types.Okx),
+		tradeCh:           make(chan connector.Trade, 100),
+		positionCh:        make(chan connector.Position, 100),
+		fillCh:            make(chan connector.Trade, 100),
+		balanceCh:         make(chan connector.AccountBalance, 100),
+		errorCh:           make(chan error, 100),
+		orderBookChannels: make(map[string]chan connector.OrderBook),
+		klineChannels:     make(map[string]chan connector.Kline),
+		positionSymbols:   make(map[string]portfolio.Asset),
+	}
+}
+
+func (o *okx) Initialize(config connector.Config) error {
+	if o.initialized {
+		return fmt.Errorf("connector already initialized")
+	}
+
+	okxConfig, ok := config.(*Config)
+	if !ok {
+		return fmt.Errorf("invalid config type for OKX connector: expected *okx.Config, got %T", config)
+	}
+
+	if err := okxConfig.Validate(); err != nil {
+		return fmt.Errorf("invalid OKX config: %w", err)
+	}
+
+	if err := o.client.Configure(okxConfig.BaseURL, okxConfig.APIKey, okxConfig.APISecret, okxConfig.Passphrase, okxConfig.IsTestnet); err != nil {
+		return fmt.Errorf("failed to configure client: %w", err)
+	}
+
+	if err := o.trading.Initialize(&trading.Config{SwapTradeMode: okxConfig.SwapTradeMode}); err != nil {
+		return fmt.Errorf("failed to initialize trading service: %w", err)
+	}
+
+	if err := o.realTime.Initialize(&real_time.Config{
+		PublicURL:   okxConfig.PublicWSURL,
+		PrivateURL:  okxConfig.PrivateWSURL,
+		BusinessURL: okxConfig.BusinessWSURL,
+	}); err != nil {
+		return fmt.Errorf("failed to initialize real-time service: %w", err)
+	}
+
+	o.config = okxConfig
+	o.initialized = true
+	o.appLogger.Info("OKX connector initialized (demo trading: %v)", okxConfig.IsTestnet)
+	return nil
+}
+
+// IsInitialized implements Initializable interface
+func (o *okx) IsInitialized() bool {
+	return o.initialized
+}
+
+func (o *okx) GetPerpSymbol(asset portfolio.Asset) string {
+	symbol, _ := symbolRule.ToNative(asset, connector.TypePerpetual)
+	return symbol
+}
@@ -0,0 +1,111 @@
+package data
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+)
+
+// OKX instrument types
+const (
+	InstTypeSwap = "SWAP"
+	InstTypeSpot = "SPOT"
+)
+
+// InstType returns the OKX instType of an instrument
+func InstType(instrument connector.Instrument) string {
+	if instrument == connector.TypeSpot {
+		return InstTypeSpot
+	}
+	return InstTypeSwap
+}
+
+// AssetOf returns the base asset of an instId such as BTC-USDT-SWAP
+func AssetOf(instID string) portfolio.Asset {
+	base, _, _ := strings.Cut(instID, "-")
+	return portfolio.NewAsset(base)
+}
+
+// Bar converts an interval such as 1h to OKX's bar notation, which
+// capitalises hour, day and week units
+func Bar(interval string) string {
+	if interval == "" {
+		return interval
+	}
+	switch unit := interval[len(interval)-1]; unit {
+	case 'h', 'd', 'w':
+		return interval[:len(interval)-1] + strings.ToUpper(string(unit))
+	}
+	return interval
+}
+
+// barDuration is the span of one candle; months count as thirty days
+func barDuration(interval string) time.Duration {
+	if len(interval) < 2 {
+		return time.Minute
+	}
+
+	count, err := strconv.Atoi(interval[:len(interval)-1])
+	if err != nil || count <= 0 {
+		return time.Minute
+	}
+
+	unit := time.Minute
+	switch interval[len(interval)-1] {
+	case 'h', 'H':
+		unit = time.Hour
+	case 'd', 'D':
+		unit = 24 * time.Hour
+	case 'w', 'W':
+		unit = 7 * 24 * time.Hour
+	case 'M':
+		unit = 30 * 24 * time.Hour
+	}
+	return time.Duration(count) * unit
+}
+
+// ParseLevels converts OKX [price, size, deprecated, orders] rows into price
+// levels, scaling sizes by the contract value
+func ParseLevels(levels [][]string, contractValue numerical.Decimal) []connector.PriceLevel {
+	result := make([]connector.PriceLevel, 0, len(levels))
+	for _, level := range levels {
+		if len(level) < 2 {
+			continue
+		}
+		result = append(result, connector.PriceLevel{
+			Price:    parseDecimal(level[0]),
+			Quantity: parseDecimal(level[1]).Mul(contractValue),
+		})
+	}
+	return result
+}
+
+// Side converts OKX's lowercase buy and sell
+func Side(side string) connector.OrderSide {
+	return connector.FromString(strings.ToUpper(side))
+}
+
+func parseInt(value string) int64 {
+	parsed, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return parsed
+}
+
+func parseDecimal(value string) numerical.Decimal {
+	if value == "" {
+		return numerical.Zero()
+	}
+
+	d, err := numerical.NewFromString(value)
+	if err != nil {
+		return numerical.Zero()
+	}
+
+	return d
+}
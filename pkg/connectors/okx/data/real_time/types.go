@@ -0,0 +1,166 @@
+package real_time
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+)
+
+// OrderBookMessage is a five-level snapshot from the books5 channel, with
+// sizes in the base asset
+type OrderBookMessage struct {
+	Symbol    string
+	Bids      []connector.PriceLevel
+	Asks      []connector.PriceLevel
+	Timestamp time.Time
+}
+
+// TradeMessage is a public trade from the trades channel
+type TradeMessage struct {
+	Symbol    string
+	ID        string
+	Price     numerical.Decimal
+	Quantity  numerical.Decimal
+	Side      connector.OrderSide
+	Timestamp time.Time
+}
+
+// KlineMessage is a candle update from a candle<bar> channel
+type KlineMessage struct {
+	Symbol      string
+	Interval    string
+	OpenTime    time.Time
+	Open        numerical.Decimal
+	High        numerical.Decimal
+	Low         numerical.Decimal
+	Close       numerical.Decimal
+	Volume      numerical.Decimal
+	QuoteVolume numerical.Decimal
+	Closed      bool
+}
+
+// CurrencyBalance is one currency inside an account push
+type CurrencyBalance struct {
+	Currency      string
+	Equity        numerical.Decimal
+	Available     numerical.Decimal
+	InitialMargin numerical.Decimal
+	UnrealizedPnL numerical.Decimal
+}
+
+// AccountMessage is a push from the private account channel. AdjustedEquity
+// is only set in multi-currency and portfolio margin mode.
+type AccountMessage struct {
+	TotalEquity    numerical.Decimal
+	AdjustedEquity numerical.Decimal
+	InitialMargin  numerical.Decimal
+	UnrealizedPnL  numerical.Decimal
+	Balances       []CurrencyBalance
+	Timestamp      time.Time
+}
+
+// PositionMessage is one swap position from the private positions channel,
+// with the size in the base asset and signed by direction
+type PositionMessage struct {
+	Symbol        string
+	Size          numerical.Decimal
+	EntryPrice    numerical.Decimal
+	MarkPrice     numerical.Decimal
+	UnrealizedPnL numerical.Decimal
+	MarginMode    string
+	Timestamp     time.Time
+}
+
+// FillMessage is one trade against an account order from the private
+// orders channel, with the quantity in the base asset
+type FillMessage struct {
+	Symbol        string
+	OrderID       string
+	ClientOrderID string
+	TradeID       string
+	Side          connector.OrderSide
+	Price         numerical.Decimal
+	Quantity      numerical.Decimal
+	Fee           numerical.Decimal
+	IsMaker       bool
+	Timestamp     time.Time
+}
+
+// channelArg identifies a subscription, as sent and as echoed on every push
+type channelArg struct {
+	Channel  string `json:"channel"`
+	InstID   string `json:"instId,omitempty"`
+	InstType string `json:"instType,omitempty"`
+}
+
+// key is the handler key of a subscription
+func (a channelArg) key() string {
+	return a.Channel + ":" + a.InstID + a.InstType
+}
+
+// pushMessage is any frame OKX sends: a push carries arg and data, an
+// operation reply carries event, code and msg
+type pushMessage struct {
+	Event   string          `json:"event"`
+	Code    string          `json:"code"`
+	Message string          `json:"msg"`
+	Arg     channelArg      `json:"arg"`
+	Data    json.RawMessage `json:"data"`
+}
+
+type bookEvent struct {
+	Asks [][]string `json:"asks"`
+	Bids [][]string `json:"bids"`
+	Ts   string     `json:"ts"`
+}
+
+type tradeEvent struct {
+	InstID  string `json:"instId"`
+	TradeID string `json:"tradeId"`
+	Px      string `json:"px"`
+	Sz      string `json:"sz"`
+	Side    string `json:"side"`
+	Ts      string `json:"ts"`
+}
+
+type accountEvent struct {
+	TotalEq string `json:"totalEq"`
+	AdjEq   string `json:"adjEq"`
+	Imr     string `json:"imr"`
+	Upl     string `json:"upl"`
+	UTime   string `json:"uTime"`
+	Details []struct {
+		Ccy      string `json:"ccy"`
+		Eq       string `json:"eq"`
+		AvailEq  string `json:"availEq"`
+		AvailBal string `json:"availBal"`
+		Imr      string `json:"imr"`
+		Upl      string `json:"upl"`
+	} `json:"details"`
+}
+
+type positionEvent struct {
+	InstID  string `json:"instId"`
+	Pos     string `json:"pos"`
+	PosSide string `json:"posSide"`
+	AvgPx   string `json:"avgPx"`
+	MarkPx  string `json:"markPx"`
+	Upl     string `json:"upl"`
+	MgnMode string `json:"mgnMode"`
+	UTime   string `json:"uTime"`
+}
+
+type orderEvent struct {
+	InstID   string `json:"instId"`
+	OrdID    string `json:"ordId"`
+	ClOrdID  string `json:"clOrdId"`
+	Side     string `json:"side"`
+	TradeID  string `json:"tradeId"`
+	FillPx   string `json:"fillPx"`
+	FillSz   string `json:"fillSz"`
+	FillFee  string `json:"fillFee"`
+	FillTime string `json:"fillTime"`
+	ExecType string `json:"execType"`
+}
@@ -0,0 +1,627 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/okx/adaptor"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+const (
+	quoteAsset = "USDT"
+
+	// maxBookDepth is the deepest snapshot /api/v5/market/books returns
+	maxBookDepth = 400
+
+	// maxCandlePage is the most candles one history request returns
+	maxCandlePage = 100
+)
+
+// MarketDataService reads OKX public market data. Swap sizes are quoted in
+// contracts on the wire; every quantity it returns is converted to the base
+// asset so callers see the same units on spot and swap.
+type MarketDataService interface {
+	FetchKlines(instrument connector.Instrument, symbol, interval string, limit int) ([]connector.Kline, error)
+	FetchKlinesRange(symbol, interval string, start, end time.Time, limit int) ([]connector.Kline, error)
+	FetchPrice(instrument connector.Instrument, symbol string) (*connector.Price, error)
+	FetchOrderBook(instrument connector.Instrument, symbol string, depth int) (*connector.OrderBook, error)
+	FetchRecentTrades(instrument connector.Instrument, symbol string, limit int) ([]connector.Trade, error)
+	FetchFundingRate(symbol string) (*connector.FundingRate, error)
+	FetchCurrentFundingRates() (map[portfolio.Asset]connector.FundingRate, error)
+	FetchHistoricalFundingRates(symbol string, startTime, endTime int64) ([]connector.HistoricalFundingRate, error)
+	FetchContracts() ([]connector.ContractInfo, error)
+	FetchAvailablePerpetualAssets() ([]portfolio.Asset, error)
+	FetchAvailableSpotAssets() ([]portfolio.Asset, error)
+	FetchInstrumentStatuses() ([]types.InstrumentStatus, error)
+	FetchServerTime() (time.Time, error)
+
+	// ContractValue returns how much base asset one contract of a swap
+	// represents; spot symbols return one
+	ContractValue(symbol string) (numerical.Decimal, error)
+}
+
+type marketDataService struct {
+	client       adaptor.Client
+	timeProvider temporal.TimeProvider
+
+	// contractValues caches ctVal per swap instId; contract sizes only change
+	// on relisting, so the cache is refreshed on a miss only
+	contractValues map[string]numerical.Decimal
+	mu             sync.RWMutex
+}
+
+func NewMarketDataService(client adaptor.Client, timeProvider temporal.TimeProvider) MarketDataService {
+	return &marketDataService{
+		client:         client,
+		timeProvider:   timeProvider,
+		contractValues: make(map[string]numerical.Decimal),
+	}
+}
+
+// instrumentInfo mirrors an entry of /api/v5/public/instruments
+type instrumentInfo struct {
+	InstID    string `json:"instId"`
+	InstType  string `json:"instType"`
+	BaseCcy   string `json:"baseCcy"`
+	QuoteCcy  string `json:"quoteCcy"`
+	SettleCcy string `json:"settleCcy"`
+	CtVal     string `json:"ctVal"`
+	CtValCcy  string `json:"ctValCcy"`
+	CtType    string `json:"ctType"`
+	TickSz    string `json:"tickSz"`
+	LotSz     string `json:"lotSz"`
+	MinSz     string `json:"minSz"`
+	MaxLmtSz  string `json:"maxLmtSz"`
+	State     string `json:"state"`
+	Lever     string `json:"lever"`
+	ExpTime   string `json:"expTime"`
+}
+
+// base returns the instrument's base asset; swaps name it as the contract
+// value currency
+func (i instrumentInfo) base() string {
+	if i.InstType == InstTypeSwap {
+		return i.CtValCcy
+	}
+	return i.BaseCcy
+}
+
+func (i instrumentInfo) quote() string {
+	if i.InstType == InstTypeSwap {
+		return i.SettleCcy
+	}
+	return i.QuoteCcy
+}
+
+// usdtLinear reports whether the instrument is one the connector trades:
+// USDT spot pairs and USDT-margined linear swaps
+func (i instrumentInfo) usdtLinear() bool {
+	if i.InstType == InstTypeSwap {
+		return i.CtType == "linear" && i.SettleCcy == quoteAsset
+	}
+	return i.QuoteCcy == quoteAsset
+}
+
+func (m *marketDataService) FetchKlines(instrument connector.Instrument, symbol, interval string, limit int) ([]connector.Kline, error) {
+	params := url.Values{}
+	params.Set("instId", symbol)
+	params.Set("bar", Bar(interval))
+	if limit > 0 {
+		params.Set("limit", strconv.Itoa(limit))
+	}
+
+	return m.fetchKlines("/api/v5/market/candles", instrument, symbol, interval, params)
+}
+
+// FetchKlinesRange returns klines opening within [start, end], oldest first.
+// OKX pages backwards from end, at most maxCandlePage per request.
+func (m *marketDataService) FetchKlinesRange(symbol, interval string, start, end time.Time, limit int) ([]connector.Kline, error) {
+	if limit <= 0 || limit > maxCandlePage {
+		limit = maxCandlePage
+	}
+
+	params := url.Values{}
+	params.Set("instId", symbol)
+	params.Set("bar", Bar(interval))
+	params.Set("after", strconv.FormatInt(end.UnixMilli()+1, 10))
+	params.Set("before", strconv.FormatInt(start.UnixMilli()-1, 10))
+	params.Set("limit", strconv.Itoa(limit))
+
+	return m.fetchKlines("/api/v5/market/history-candles", connector.TypePerpetual, symbol, interval, params)
+}
+
+func (m *marketDataService) fetchKlines(path string, instrument connector.Instrument, symbol, interval string, params url.Values) ([]connector.Kline, error) {
+	var result [][]string
+	if err := m.client.Public(context.Background(), http.MethodGet, path, params, &result); err != nil {
+		return nil, fmt.Errorf("failed to fetch klines: %w", err)
+	}
+
+	duration := barDuration(interval)
+	klines := make([]connector.Kline, 0, len(result))
+	for _, row := range result {
+		if len(row) < 8 {
+			continue
+		}
+
+		// Swap candles report contracts in vol and base currency in volCcy;
+		// spot reports base in vol and quote in volCcy
+		volume := parseDecimal(row[5])
+		if instrument != connector.TypeSpot {
+			volume = parseDecimal(row[6])
+		}
+
+		openTime := time.UnixMilli(parseInt(row[0]))
+		klines = append(klines, connector.Kline{
+			Symbol:      symbol,
+			Interval:    interval,
+			OpenTime:    openTime,
+			Open:        parseDecimal(row[1]),
+			High:        parseDecimal(row[2]),
+			Low:         parseDecimal(row[3]),
+			Close:       parseDecimal(row[4]),
+			Volume:      volume,
+			CloseTime:   openTime.Add(duration - time.Millisecond),
+			QuoteVolume: parseDecimal(row[7]),
+		})
+	}
+
+	// OKX returns newest first
+	sort.Slice(klines, func(i, j int) bool {
+		return klines[i].OpenTime.Before(klines[j].OpenTime)
+	})
+
+	return klines, nil
+}
+
+func (m *marketDataService) FetchPrice(instrument connector.Instrument, symbol string) (*connector.Price, error) {
+	params := url.Values{}
+	params.Set("instId", symbol)
+
+	var result []struct {
+		InstID    string `json:"instId"`
+		Last      string `json:"last"`
+		AskPx     string `json:"askPx"`
+		BidPx     string `json:"bidPx"`
+		Open24h   string `json:"open24h"`
+		Vol24h    string `json:"vol24h"`
+		VolCcy24h string `json:"volCcy24h"`
+		Ts        string `json:"ts"`
+	}
+	if err := m.client.Public(context.Background(), http.MethodGet, "/api/v5/market/ticker", params, &result); err != nil {
+		return nil, fmt.Errorf("failed to fetch price: %w", err)
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("no ticker for %s", symbol)
+	}
+
+	ticker := result[0]
+	last := parseDecimal(ticker.Last)
+	open := parseDecimal(ticker.Open24h)
+
+	change := numerical.Zero()
+	if open.IsPositive() {
+		change = last.Sub(open).Div(open).Mul(numerical.NewFromInt(100))
+	}
+
+	volume := parseDecimal(ticker.Vol24h)
+	if instrument != connector.TypeSpot {
+		volume = parseDecimal(ticker.VolCcy24h)
+	}
+
+	return &connector.Price{
+		Symbol:    symbol,
+		Price:     last,
+		BidPrice:  parseDecimal(ticker.BidPx),
+		AskPrice:  parseDecimal(ticker.AskPx),
+		Volume24h: volume,
+		Change24h: change,
+		Source:    types.Okx,
+		Timestamp: m.timestamp(ticker.Ts),
+	}, nil
+}
+
+func (m *marketDataService) FetchOrderBook(instrument connector.Instrument, symbol string, depth int) (*connector.OrderBook, error) {
+	if depth <= 0 || depth > maxBookDepth {
+		depth = maxBookDepth
+	}
+
+	contractValue, err := m.contractValue(instrument, symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	params.Set("instId", symbol)
+	params.Set("sz", strconv.Itoa(depth))
+
+	var result []struct {
+		Asks [][]string `json:"asks"`
+		Bids [][]string `json:"bids"`
+		Ts   string     `json:"ts"`
+	}
+	if err := m.client.Public(context.Background(), http.MethodGet, "/api/v5/market/books", params, &result); err != nil {
+		return nil, fmt.Errorf("failed to fetch orderbook: %w", err)
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("no orderbook for %s", symbol)
+	}
+
+	return &connector.OrderBook{
+		Asset:     AssetOf(symbol),
+		Bids:      ParseLevels(result[0].Bids, contractValue),
+		Asks:      ParseLevels(result[0].Asks, contractValue),
+		Timestamp: m.timestamp(result[0].Ts),
+	}, nil
+}
+
+func (m *marketDataService) FetchRecentTrades(instrument connector.Instrument, symbol string, limit int) ([]connector.Trade, error) {
+	contractValue, err := m.contractValue(instrument, symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	params.Set("instId", symbol)
+	if limit > 0 {
+		params.Set("limit", strconv.Itoa(limit))
+	}
+
+	var result []struct {
+		InstID  string `json:"instId"`
+		TradeID string `json:"tradeId"`
+		Px      string `json:"px"`
+		Sz      string `json:"sz"`
+		Side    string `json:"side"`
+		Ts      string `json:"ts"`
+	}
+	if err := m.client.Public(context.Background(), http.MethodGet, "/api/v5/market/trades", params, &result); err != nil {
+		return nil, fmt.Errorf("failed to fetch recent trades: %w", err)
+	}
+
+	trades := make([]connector.Trade, 0, len(result))
+	for _, trade := range result {
+		trades = append(trades, connector.Trade{
+			ID:        trade.TradeID,
+			Symbol:    symbol,
+			Exchange:  types.Okx,
+			Price:     parseDecimal(trade.Px),
+			Quantity:  parseDecimal(trade.Sz).Mul(contractValue),
+			Side:      Side(trade.Side),
+			Timestamp: m.timestamp(trade.Ts),
+		})
+	}
+
+	return trades, nil
+}
+
+// fundingRate mirrors an entry of /api/v5/public/funding-rate
+type fundingRate struct {
+	InstID          string `json:"instId"`
+	FundingRate     string `json:"fundingRate"`
+	FundingTime     string `json:"fundingTime"`
+	NextFundingTime string `json:"nextFundingTime"`
+	Premium         string `json:"premium"`
+	Ts              string `json:"ts"`
+}
+
+// markPrice mirrors an entry of /api/v5/public/mark-price
+type markPrice struct {
+	InstID string `json:"instId"`
+	MarkPx string `json:"markPx"`
+	Ts     string `json:"ts"`
+}
+
+func (m *marketDataService) FetchFundingRate(symbol string) (*connector.FundingRate, error) {
+	params := url.Values{}
+	params.Set("instId", symbol)
+
+	var rates []fundingRate
+	if err := m.client.Public(context.Background(), http.MethodGet, "/api/v5/public/funding-rate", params, &rates); err != nil {
+		return nil, fmt.Errorf("failed to fetch funding rate: %w", err)
+	}
+	if len(rates) == 0 {
+		return nil, fmt.Errorf("no funding rate for %s", symbol)
+	}
+
+	params.Set("instType", InstTypeSwap)
+	var marks []markPrice
+	if err := m.client.Public(context.Background(), http.MethodGet, "/api/v5/public/mark-price", params, &marks); err != nil {
+		return nil, fmt.Errorf("failed to fetch mark price: %w", err)
+	}
+
+	indexParams := url.Values{}
+	indexParams.Set("instId", strings.TrimSuffix(symbol, "-SWAP"))
+	var index []struct {
+		IdxPx string `json:"idxPx"`
+	}
+	if err := m.client.Public(context.Background(), http.MethodGet, "/api/v5/market/index-tickers", indexParams, &index); err != nil {
+		return nil, fmt.Errorf("failed to fetch index price: %w", err)
+	}
+
+	rate := m.toFundingRate(rates[0])
+	if len(marks) > 0 {
+		rate.MarkPrice = parseDecimal(marks[0].MarkPx)
+	}
+	if len(index) > 0 {
+		rate.IndexPrice = parseDecimal(index[0].IdxPx)
+	}
+	if rate.IndexPrice.IsPositive() && rate.MarkPrice.IsPositive() {
+		rate.Premium = rate.MarkPrice.Sub(rate.IndexPrice).Div(rate.IndexPrice)
+	}
+
+	return &rate, nil
+}
+
+// FetchCurrentFundingRates reads every swap's rate in one request and the
+// mark prices in another; index prices are left out, with the exchange's
+// own premium reported instead
+func (m *marketDataService) FetchCurrentFundingRates() (map[portfolio.Asset]connector.FundingRate, error) {
+	params := url.Values{}
+	params.Set("instId", "ANY")
+
+	var rates []fundingRate
+	if err := m.client.Public(context.Background(), http.MethodGet, "/api/v5/public/funding-rate", params, &rates); err != nil {
+		return nil, fmt.Errorf("failed to fetch funding rates: %w", err)
+	}
+
+	markParams := url.Values{}
+	markParams.Set("instType", InstTypeSwap)
+	var marks []markPrice
+	if err := m.client.Public(context.Background(), http.MethodGet, "/api/v5/public/mark-price", markParams, &marks); err != nil {
+		return nil, fmt.Errorf("failed to fetch mark prices: %w", err)
+	}
+
+	markBySymbol := make(map[string]numerical.Decimal, len(marks))
+	for _, mark := range marks {
+		markBySymbol[mark.InstID] = parseDecimal(mark.MarkPx)
+	}
+
+	result := make(map[portfolio.Asset]connector.FundingRate, len(rates))
+	for _, rate := range rates {
+		if !strings.HasSuffix(rate.InstID, "-"+quoteAsset+"-SWAP") {
+			continue
+		}
+		converted := m.toFundingRate(rate)
+		converted.MarkPrice = markBySymbol[rate.InstID]
+		result[AssetOf(rate.InstID)] = converted
+	}
+
+	return result, nil
+}
+
+func (m *marketDataService) FetchHistoricalFundingRates(symbol string, startTime, endTime int64) ([]connector.HistoricalFundingRate, error) {
+	params := url.Values{}
+	params.Set("instId", symbol)
+	params.Set("limit", "100")
+	if startTime > 0 {
+		params.Set("before", strconv.FormatInt(startTime-1, 10))
+	}
+	if endTime > 0 {
+		params.Set("after", strconv.FormatInt(endTime+1, 10))
+	}
+
+	var result []struct {
+		FundingRate  string `json:"fundingRate"`
+		RealizedRate string `json:"realizedRate"`
+		FundingTime  string `json:"fundingTime"`
+	}
+	if err := m.client.Public(context.Background(), http.MethodGet, "/api/v5/public/funding-rate-history", params, &result); err != nil {
+		return nil, fmt.Errorf("failed to fetch historical funding rates: %w", err)
+	}
+
+	rates := make([]connector.HistoricalFundingRate, 0, len(result))
+	for _, rate := range result {
+		value := parseDecimal(rate.RealizedRate)
+		if value.IsZero() {
+			value = parseDecimal(rate.FundingRate)
+		}
+		rates = append(rates, connector.HistoricalFundingRate{
+			FundingRate: value,
+			Timestamp:   time.UnixMilli(parseInt(rate.FundingTime)),
+		})
+	}
+
+	sort.Slice(rates, func(i, j int) bool {
+		return rates[i].Timestamp.Before(rates[j].Timestamp)
+	})
+
+	return rates, nil
+}
+
+// FetchContracts lists USDT swaps and spot pairs, with swap lot sizes
+// converted from contracts to the base asset
+func (m *marketDataService) FetchContracts() ([]connector.ContractInfo, error) {
+	now := m.timeProvider.Now()
+	var contracts []connector.ContractInfo
+
+	for _, instType := range []string{InstTypeSwap, InstTypeSpot} {
+		instruments, err := m.fetchInstruments(instType)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, info := range instruments {
+			if !info.usdtLinear() {
+				continue
+			}
+
+			contractType := "SPOT"
+			scale := numerical.NewFromInt(1)
+			if info.InstType == InstTypeSwap {
+				contractType = "PERPETUAL"
+				scale = parseDecimal(info.CtVal)
+			}
+
+			contracts = append(contracts, connector.ContractInfo{
+				Symbol:       info.InstID,
+				BaseAsset:    info.base(),
+				QuoteAsset:   info.quote(),
+				ContractType: contractType,
+				TickSize:     parseDecimal(info.TickSz),
+				StepSize:     parseDecimal(info.LotSz).Mul(scale),
+				MinOrderSize: parseDecimal(info.MinSz).Mul(scale),
+				MaxOrderSize: parseDecimal(info.MaxLmtSz).Mul(scale),
+				Status:       strings.ToUpper(info.State),
+				UpdatedAt:    now,
+			})
+		}
+	}
+
+	return contracts, nil
+}
+
+func (m *marketDataService) FetchAvailablePerpetualAssets() ([]portfolio.Asset, error) {
+	return m.liveAssets(InstTypeSwap)
+}
+
+func (m *marketDataService) FetchAvailableSpotAssets() ([]portfolio.Asset, error) {
+	return m.liveAssets(InstTypeSpot)
+}
+
+func (m *marketDataService) liveAssets(instType string) ([]portfolio.Asset, error) {
+	instruments, err := m.fetchInstruments(instType)
+	if err != nil {
+		return nil, err
+	}
+
+	assets := make([]portfolio.Asset, 0, len(instruments))
+	for _, info := range instruments {
+		if info.usdtLinear() && info.State == "live" {
+			assets = append(assets, portfolio.NewAsset(info.base()))
+		}
+	}
+
+	return assets, nil
+}
+
+func (m *marketDataService) FetchInstrumentStatuses() ([]types.InstrumentStatus, error) {
+	instruments, err := m.fetchInstruments(InstTypeSwap)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]types.InstrumentStatus, 0, len(instruments))
+	for _, info := range instruments {
+		status := types.InstrumentStatus{
+			Symbol:      info.InstID,
+			State:       instrumentState(info.State),
+			RawStatus:   info.State,
+			MaxLeverage: parseDecimal(info.Lever),
+		}
+		if expiry := parseInt(info.ExpTime); expiry > 0 {
+			status.DeliveryAt = time.UnixMilli(expiry).UTC()
+		}
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+// instrumentState maps /api/v5/public/instruments states
+func instrumentState(state string) types.InstrumentState {
+	switch state {
+	case "live":
+		return types.InstrumentTrading
+	case "suspend", "preopen", "test":
+		return types.InstrumentSuspended
+	case "expired":
+		return types.InstrumentDelisted
+	}
+	return types.InstrumentUnknown
+}
+
+func (m *marketDataService) FetchServerTime() (time.Time, error) {
+	var result []struct {
+		Ts string `json:"ts"`
+	}
+	if err := m.client.Public(context.Background(), http.MethodGet, "/api/v5/public/time", nil, &result); err != nil {
+		return time.Time{}, fmt.Errorf("failed to get server time: %w", err)
+	}
+	if len(result) == 0 {
+		return time.Time{}, fmt.Errorf("empty server time response")
+	}
+	return time.UnixMilli(parseInt(result[0].Ts)), nil
+}
+
+func (m *marketDataService) ContractValue(symbol string) (numerical.Decimal, error) {
+	if !strings.HasSuffix(symbol, "-SWAP") {
+		return numerical.NewFromInt(1), nil
+	}
+
+	m.mu.RLock()
+	value, ok := m.contractValues[symbol]
+	m.mu.RUnlock()
+	if ok {
+		return value, nil
+	}
+
+	instruments, err := m.fetchInstruments(InstTypeSwap)
+	if err != nil {
+		return numerical.Zero(), err
+	}
+
+	m.mu.Lock()
+	for _, info := range instruments {
+		if ctVal := parseDecimal(info.CtVal); ctVal.IsPositive() {
+			m.contractValues[info.InstID] = ctVal
+		}
+	}
+	value, ok = m.contractValues[symbol]
+	m.mu.Unlock()
+
+	if !ok {
+		return numerical.Zero(), fmt.Errorf("unknown swap instrument %s", symbol)
+	}
+	return value, nil
+}
+
+func (m *marketDataService) contractValue(instrument connector.Instrument, symbol string) (numerical.Decimal, error) {
+	if instrument == connector.TypeSpot {
+		return numerical.NewFromInt(1), nil
+	}
+	return m.ContractValue(symbol)
+}
+
+func (m *marketDataService) fetchInstruments(instType string) ([]instrumentInfo, error) {
+	params := url.Values{}
+	params.Set("instType", instType)
+
+	var result []instrumentInfo
+	if err := m.client.Public(context.Background(), http.MethodGet, "/api/v5/public/instruments", params, &result); err != nil {
+		return nil, fmt.Errorf("failed to fetch %s instruments: %w", strings.ToLower(instType), err)
+	}
+
+	return result, nil
+}
+
+func (m *marketDataService) toFundingRate(rate fundingRate) connector.FundingRate {
+	// nextFundingTime is the settlement after the one fundingRate applies to
+	return connector.FundingRate{
+		CurrentRate:     parseDecimal(rate.FundingRate),
+		NextFundingTime: time.UnixMilli(parseInt(rate.FundingTime)),
+		Timestamp:       m.timestamp(rate.Ts),
+		MarkPrice:       numerical.Zero(),
+		IndexPrice:      numerical.Zero(),
+		Premium:         parseDecimal(rate.Premium),
+	}
+}
+
+// timestamp parses an OKX millisecond timestamp, falling back to now
+func (m *marketDataService) timestamp(ts string) time.Time {
+	if ms := parseInt(ts); ms > 0 {
+		return time.UnixMilli(ms)
+	}
+	return m.timeProvider.Now()
+}
@@ -0,0 +1,41 @@
+package okx
+
+import (
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+// historyWindow keeps each /api/v5/trade/fills-history request to a day;
+// OKX pages newest first, so narrow windows keep the page limit from
+// skipping the oldest fills of a busy stretch
+const historyWindow = 24 * time.Hour
+
+var _ types.TradeHistoryProvider = (*okx)(nil)
+
+// FetchTradingHistorySince walks forward a window at a time until one holds
+// fills, so a long quiet stretch does not read as the end of history
+func (o *okx) FetchTradingHistorySince(symbol string, since time.Time, limit int) ([]connector.Trade, error) {
+	now := o.timeProvider.Now()
+	for start := since; start.Before(now); start = start.Add(historyWindow) {
+		end := start.Add(historyWindow - time.Millisecond)
+		if end.After(now) {
+			end = now
+		}
+
+		if err := o.limiter.Wait(ratelimit.EndpointTrades); err != nil {
+			return nil, err
+		}
+		trades, err := o.trading.GetTradingHistoryRange(connector.TypePerpetual, symbol, start, end, limit)
+		if err != nil {
+			return nil, err
+		}
+		if len(trades) > 0 {
+			return trades, nil
+		}
+	}
+
+	return nil, nil
+}
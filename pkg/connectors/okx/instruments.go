@@ -0,0 +1,15 @@
+package okx
+
+import (
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+var _ types.InstrumentStatusProvider = (*okx)(nil)
+
+func (o *okx) FetchInstrumentStatuses() ([]types.InstrumentStatus, error) {
+	if err := o.limiter.Wait(ratelimit.EndpointInstruments); err != nil {
+		return nil, err
+	}
+	return o.marketData.FetchInstrumentStatuses()
+}
@@ -0,0 +1,22 @@
+package okx
+
+import (
+	"fmt"
+
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+var _ types.MarginInfoProvider = (*okx)(nil)
+
+// FetchMarginInfo returns the unified account's margin mode and requirements
+func (o *okx) FetchMarginInfo() (*types.MarginInfo, error) {
+	if err := o.limiter.Wait(ratelimit.EndpointAccount); err != nil {
+		return nil, err
+	}
+	if !o.initialized {
+		return nil, fmt.Errorf("connector not initialized")
+	}
+
+	return o.trading.GetMarginInfo()
+}
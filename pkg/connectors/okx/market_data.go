@@ -0,0 +1,55 @@
+package okx
+
+import (
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+var _ types.DepthSnapshotProvider = (*okx)(nil)
+
+func (o *okx) FetchKlines(symbol, interval string, limit int) ([]connector.Kline, error) {
+	if err := o.limiter.Wait(ratelimit.EndpointKlines); err != nil {
+		return nil, err
+	}
+	return o.marketData.FetchKlines(connector.TypePerpetual, symbol, interval, limit)
+}
+
+func (o *okx) FetchPrice(symbol string) (*connector.Price, error) {
+	if err := o.limiter.Wait(ratelimit.EndpointPrice); err != nil {
+		return nil, err
+	}
+	return o.marketData.FetchPrice(connector.TypePerpetual, symbol)
+}
+
+func (o *okx) FetchOrderBook(asset portfolio.Asset, instrument connector.Instrument, depth int) (*connector.OrderBook, error) {
+	symbol, err := symbolRule.ToNative(asset, instrument)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.limiter.Wait(ratelimit.EndpointOrderBook); err != nil {
+		return nil, err
+	}
+	return o.marketData.FetchOrderBook(instrument, symbol, depth)
+}
+
+func (o *okx) FetchRecentTrades(symbol string, limit int) ([]connector.Trade, error) {
+	if err := o.limiter.Wait(ratelimit.EndpointTrades); err != nil {
+		return nil, err
+	}
+	return o.marketData.FetchRecentTrades(connector.TypePerpetual, symbol, limit)
+}
+
+func (o *okx) FetchFundingRate(asset portfolio.Asset) (*connector.FundingRate, error) {
+	if err := o.limiter.Wait(ratelimit.EndpointFunding); err != nil {
+		return nil, err
+	}
+	return o.marketData.FetchFundingRate(o.GetPerpSymbol(asset))
+}
+
+// MaxSnapshotDepth is the deepest snapshot /api/v5/market/books returns;
+// deeper requests are rounded down to it
+func (o *okx) MaxSnapshotDepth(_ connector.Instrument) int {
+	return 400
+}
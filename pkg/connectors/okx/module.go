@@ -0,0 +1,42 @@
+package okx
+
+import (
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/live-trading/pkg/connectors/okx/adaptor"
+	"github.com/backtesting-org/live-trading/pkg/connectors/okx/data"
+	"github.com/backtesting-org/live-trading/pkg/connectors/okx/data/real_time"
+	"github.com/backtesting-org/live-trading/pkg/connectors/okx/trading"
+	"github.com/backtesting-org/live-trading/pkg/connectors/symbols"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+	"go.uber.org/fx"
+)
+
+// symbolRule maps assets to USDT swap and spot instIds, e.g. BTC ->
+// BTC-USDT-SWAP and BTC-USDT
+var symbolRule = symbols.NewSuffixRule(map[connector.Instrument]string{
+	connector.TypePerpetual: "-USDT-SWAP",
+	connector.TypeSpot:      "-USDT",
+})
+
+var Module = fx.Module("okx",
+	fx.Provide(
+		adaptor.NewClient,
+		trading.NewTradingService,
+		data.NewMarketDataService,
+		real_time.NewRealTimeService,
+		fx.Annotate(
+			NewOkx,
+			fx.ResultTags(`name:"okx"`),
+		),
+	),
+	fx.Invoke(fx.Annotate(
+		registerOkx,
+		fx.ParamTags(`name:"okx"`),
+	)),
+)
+
+func registerOkx(okxConn connector.Connector, reg registry.ConnectorRegistry, mapper symbols.SymbolMapper) {
+	mapper.Register(types.Okx, symbolRule)
+	reg.RegisterConnector(types.Okx, okxConn)
+}
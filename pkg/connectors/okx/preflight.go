@@ -0,0 +1,38 @@
+package okx
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+var (
+	_ types.APIKeyInspector    = (*okx)(nil)
+	_ types.ServerTimeProvider = (*okx)(nil)
+)
+
+// FetchAPIKeyPermissions reports what the configured key may do
+func (o *okx) FetchAPIKeyPermissions() (*types.APIKeyPermissions, error) {
+	if err := o.limiter.Wait(ratelimit.EndpointAccount); err != nil {
+		return nil, err
+	}
+	if !o.initialized {
+		return nil, fmt.Errorf("connector not initialized")
+	}
+
+	return o.trading.GetAPIKeyPermissions()
+}
+
+// FetchServerTime returns the clock signed request timestamps are checked against
+func (o *okx) FetchServerTime() (time.Time, error) {
+	if err := o.limiter.Wait(ratelimit.EndpointInstruments); err != nil {
+		return time.Time{}, err
+	}
+	if !o.initialized {
+		return time.Time{}, fmt.Errorf("connector not initialized")
+	}
+
+	return o.marketData.FetchServerTime()
+}
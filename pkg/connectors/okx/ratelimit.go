@@ -0,0 +1,9 @@
+package okx
+
+import "github.com/backtesting-org/live-trading/pkg/connectors/types"
+
+var _ types.RateLimitProvider = (*okx)(nil)
+
+func (o *okx) RateLimitStatus() types.RateLimitStatus {
+	return o.limiter.Status()
+}
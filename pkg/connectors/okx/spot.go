@@ -0,0 +1,93 @@
+package okx
+
+import (
+	"fmt"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+var _ types.InstrumentTrader = (*okx)(nil)
+
+func (o *okx) PlaceLimitOrderFor(instrument connector.Instrument, asset portfolio.Asset, side connector.OrderSide, quantity, price numerical.Decimal) (*connector.OrderResponse, error) {
+	symbol, err := symbolRule.ToNative(asset, instrument)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.limiter.Wait(ratelimit.EndpointPlaceOrder); err != nil {
+		return nil, err
+	}
+	if !o.SupportsTradingOperations() {
+		return nil, fmt.Errorf("trading operations not supported")
+	}
+
+	resp, err := o.trading.PlaceLimitOrder(instrument, symbol, side, quantity, price)
+	if err != nil {
+		return nil, o.wrapOrderError(symbol, side, quantity, price, err)
+	}
+	return resp, nil
+}
+
+func (o *okx) PlaceMarketOrderFor(instrument connector.Instrument, asset portfolio.Asset, side connector.OrderSide, quantity numerical.Decimal) (*connector.OrderResponse, error) {
+	symbol, err := symbolRule.ToNative(asset, instrument)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.limiter.Wait(ratelimit.EndpointPlaceOrder); err != nil {
+		return nil, err
+	}
+	if !o.SupportsTradingOperations() {
+		return nil, fmt.Errorf("trading operations not supported")
+	}
+
+	resp, err := o.trading.PlaceMarketOrder(instrument, symbol, side, quantity)
+	if err != nil {
+		return nil, o.wrapOrderError(symbol, side, quantity, numerical.Zero(), err)
+	}
+	return resp, nil
+}
+
+func (o *okx) CancelOrderFor(instrument connector.Instrument, asset portfolio.Asset, orderID string) (*connector.CancelResponse, error) {
+	symbol, err := symbolRule.ToNative(asset, instrument)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.limiter.Wait(ratelimit.EndpointCancelOrder); err != nil {
+		return nil, err
+	}
+	if !o.SupportsTradingOperations() {
+		return nil, fmt.Errorf("trading operations not supported")
+	}
+	return o.trading.CancelOrder(instrument, symbol, orderID)
+}
+
+func (o *okx) GetOpenOrdersFor(instrument connector.Instrument) ([]connector.Order, error) {
+	if err := o.limiter.Wait(ratelimit.EndpointOrders); err != nil {
+		return nil, err
+	}
+	return o.trading.GetOpenOrders(instrument)
+}
+
+func (o *okx) FetchPriceFor(instrument connector.Instrument, asset portfolio.Asset) (*connector.Price, error) {
+	symbol, err := symbolRule.ToNative(asset, instrument)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.limiter.Wait(ratelimit.EndpointPrice); err != nil {
+		return nil, err
+	}
+	return o.marketData.FetchPrice(instrument, symbol)
+}
+
+// GetSpotBalances lists the trading account's coin holdings. The unified
+// account margins swaps with the same wallet, so these are not separate
+// from GetAccountBalance the way a spot wallet is elsewhere.
+func (o *okx) GetSpotBalances() ([]types.SpotBalance, error) {
+	if err := o.limiter.Wait(ratelimit.EndpointAccount); err != nil {
+		return nil, err
+	}
+	return o.trading.GetSpotBalances()
+}
@@ -0,0 +1,98 @@
+package okx
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+func (o *okx) PlaceLimitOrder(symbol string, side connector.OrderSide, quantity, price numerical.Decimal) (*connector.OrderResponse, error) {
+	if err := o.limiter.Wait(ratelimit.EndpointPlaceOrder); err != nil {
+		return nil, err
+	}
+	if !o.SupportsTradingOperations() {
+		return nil, fmt.Errorf("trading operations not supported")
+	}
+
+	resp, err := o.trading.PlaceLimitOrder(connector.TypePerpetual, symbol, side, quantity, price)
+	if err != nil {
+		return nil, o.wrapOrderError(symbol, side, quantity, price, err)
+	}
+	return resp, nil
+}
+
+func (o *okx) PlaceMarketOrder(symbol string, side connector.OrderSide, quantity numerical.Decimal) (*connector.OrderResponse, error) {
+	if err := o.limiter.Wait(ratelimit.EndpointPlaceOrder); err != nil {
+		return nil, err
+	}
+	if !o.SupportsTradingOperations() {
+		return nil, fmt.Errorf("trading operations not supported")
+	}
+
+	resp, err := o.trading.PlaceMarketOrder(connector.TypePerpetual, symbol, side, quantity)
+	if err != nil {
+		return nil, o.wrapOrderError(symbol, side, quantity, numerical.Zero(), err)
+	}
+	return resp, nil
+}
+
+func (o *okx) CancelOrder(symbol, orderID string) (*connector.CancelResponse, error) {
+	if err := o.limiter.Wait(ratelimit.EndpointCancelOrder); err != nil {
+		return nil, err
+	}
+	if !o.SupportsTradingOperations() {
+		return nil, fmt.Errorf("trading operations not supported")
+	}
+	return o.trading.CancelOrder(connector.TypePerpetual, symbol, orderID)
+}
+
+func (o *okx) GetOpenOrders() ([]connector.Order, error) {
+	if err := o.limiter.Wait(ratelimit.EndpointOrders); err != nil {
+		return nil, err
+	}
+	return o.trading.GetOpenOrders(connector.TypePerpetual)
+}
+
+func (o *okx) GetOrderStatus(orderID string) (*connector.Order, error) {
+	if err := o.limiter.Wait(ratelimit.EndpointOrders); err != nil {
+		return nil, err
+	}
+	return o.trading.GetOrderStatus(connector.TypePerpetual, orderID)
+}
+
+// wrapOrderError categorises order rejections, and tags insufficient-balance
+// ones with the balance at rejection time
+func (o *okx) wrapOrderError(symbol string, side connector.OrderSide, quantity, price numerical.Decimal, err error) error {
+	if !types.IsInsufficientBalance(err) {
+		return types.NewConnectorError(types.Okx, "place order", err)
+	}
+
+	rejection := &types.InsufficientBalanceError{
+		Exchange: types.Okx,
+		Symbol:   symbol,
+		Side:     side,
+		Quantity: quantity,
+		Price:    price,
+		Err:      err,
+	}
+	if balance, balanceErr := o.GetAccountBalance(); balanceErr == nil && balance != nil {
+		rejection.Available = balance.AvailableBalance
+	}
+
+	o.appLogger.Warn("OKX rejected order for insufficient balance: %s", rejection.Error())
+	return rejection
+}
+
+var _ types.OrderPayloadSource = (*okx)(nil)
+
+// OrderPayload returns OKX's last entry for the order
+func (o *okx) OrderPayload(orderID string) (json.RawMessage, bool) {
+	if !o.SupportsTradingOperations() {
+		return nil, false
+	}
+	return o.trading.OrderPayload(orderID)
+}
@@ -0,0 +1,766 @@
+package trading
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/okx/adaptor"
+	"github.com/backtesting-org/live-trading/pkg/connectors/okx/data"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+// OKX account levels, as /api/v5/account/config reports acctLv
+const (
+	AccountLevelSpot           = "1"
+	AccountLevelSingleCurrency = "2"
+	AccountLevelMultiCurrency  = "3"
+	AccountLevelPortfolio      = "4"
+)
+
+// netPositionMode is the only position mode orders are placed for; in
+// long/short mode every order would need a posSide the connector interface
+// cannot express
+const netPositionMode = "net_mode"
+
+type Config struct {
+	// SwapTradeMode is the tdMode for swap orders; spot orders trade cash
+	SwapTradeMode string
+}
+
+type TradingService interface {
+	Initialize(config *Config) error
+
+	PlaceLimitOrder(instrument connector.Instrument, symbol string, side connector.OrderSide, quantity, price numerical.Decimal) (*connector.OrderResponse, error)
+	PlaceMarketOrder(instrument connector.Instrument, symbol string, side connector.OrderSide, quantity numerical.Decimal) (*connector.OrderResponse, error)
+	CancelOrder(instrument connector.Instrument, symbol, orderID string) (*connector.CancelResponse, error)
+	GetOpenOrders(instrument connector.Instrument) ([]connector.Order, error)
+	GetOrderStatus(instrument connector.Instrument, orderID string) (*connector.Order, error)
+	GetAccountBalance() (*connector.AccountBalance, error)
+	GetSpotBalances() ([]types.SpotBalance, error)
+	GetPositions() ([]connector.Position, error)
+	GetTradingHistory(instrument connector.Instrument, symbol string, limit int) ([]connector.Trade, error)
+	GetTradingHistoryRange(instrument connector.Instrument, symbol string, start, end time.Time, limit int) ([]connector.Trade, error)
+	GetMarginInfo() (*types.MarginInfo, error)
+	GetAPIKeyPermissions() (*types.APIKeyPermissions, error)
+
+	// OrderPayload is OKX's own entry for the order, as last seen
+	OrderPayload(orderID string) (json.RawMessage, bool)
+}
+
+type tradingService struct {
+	client       adaptor.Client
+	marketData   data.MarketDataService
+	timeProvider temporal.TimeProvider
+
+	config  *Config
+	account *accountConfig
+	mu      sync.RWMutex
+
+	payloads *types.OrderPayloads
+}
+
+func NewTradingService(client adaptor.Client, marketData data.MarketDataService, timeProvider temporal.TimeProvider) TradingService {
+	return &tradingService{
+		client:       client,
+		marketData:   marketData,
+		timeProvider: timeProvider,
+		payloads:     types.NewOrderPayloads(types.DefaultPayloadCapacity),
+	}
+}
+
+// accountConfig mirrors /api/v5/account/config
+type accountConfig struct {
+	UID     string `json:"uid"`
+	AcctLv  string `json:"acctLv"`
+	PosMode string `json:"posMode"`
+	Perm    string `json:"perm"`
+	IP      string `json:"ip"`
+}
+
+// orderInfo mirrors an order from /api/v5/trade/order and orders-pending
+type orderInfo struct {
+	InstID    string `json:"instId"`
+	InstType  string `json:"instType"`
+	OrdID     string `json:"ordId"`
+	ClOrdID   string `json:"clOrdId"`
+	Px        string `json:"px"`
+	Sz        string `json:"sz"`
+	AccFillSz string `json:"accFillSz"`
+	AvgPx     string `json:"avgPx"`
+	Side      string `json:"side"`
+	OrdType   string `json:"ordType"`
+	State     string `json:"state"`
+	CTime     string `json:"cTime"`
+	UTime     string `json:"uTime"`
+}
+
+// fill mirrors an entry of /api/v5/trade/fills
+type fill struct {
+	InstID   string `json:"instId"`
+	TradeID  string `json:"tradeId"`
+	OrdID    string `json:"ordId"`
+	FillPx   string `json:"fillPx"`
+	FillSz   string `json:"fillSz"`
+	Side     string `json:"side"`
+	ExecType string `json:"execType"`
+	Fee      string `json:"fee"`
+	Ts       string `json:"ts"`
+}
+
+func (t *tradingService) Initialize(config *Config) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.config != nil {
+		return fmt.Errorf("trading service already initialized")
+	}
+
+	t.config = config
+	return nil
+}
+
+func (t *tradingService) PlaceLimitOrder(instrument connector.Instrument, symbol string, side connector.OrderSide, quantity, price numerical.Decimal) (*connector.OrderResponse, error) {
+	body, err := t.orderBody(instrument, symbol, side, quantity)
+	if err != nil {
+		return nil, err
+	}
+	body["ordType"] = "limit"
+	body["px"] = price.String()
+
+	resp, err := t.placeOrder(body, connector.OrderTypeLimit, symbol, side, quantity, price)
+	if err != nil {
+		return nil, fmt.Errorf("failed to place limit order: %w", err)
+	}
+	return resp, nil
+}
+
+func (t *tradingService) PlaceMarketOrder(instrument connector.Instrument, symbol string, side connector.OrderSide, quantity numerical.Decimal) (*connector.OrderResponse, error) {
+	body, err := t.orderBody(instrument, symbol, side, quantity)
+	if err != nil {
+		return nil, err
+	}
+	body["ordType"] = "market"
+	if instrument == connector.TypeSpot {
+		// Spot market buys are sized in the quote currency unless told otherwise
+		body["tgtCcy"] = "base_ccy"
+	}
+
+	resp, err := t.placeOrder(body, connector.OrderTypeMarket, symbol, side, quantity, numerical.Zero())
+	if err != nil {
+		return nil, fmt.Errorf("failed to place market order: %w", err)
+	}
+	return resp, nil
+}
+
+// orderBody builds the fields shared by every order, converting a swap
+// quantity from the base asset to contracts
+func (t *tradingService) orderBody(instrument connector.Instrument, symbol string, side connector.OrderSide, quantity numerical.Decimal) (map[string]interface{}, error) {
+	t.mu.RLock()
+	config := t.config
+	t.mu.RUnlock()
+
+	if config == nil {
+		return nil, fmt.Errorf("trading service not initialized")
+	}
+
+	tradeMode := "cash"
+	size := quantity
+	if instrument != connector.TypeSpot {
+		account, err := t.accountConfig(false)
+		if err != nil {
+			return nil, err
+		}
+		if account.PosMode != netPositionMode {
+			return nil, fmt.Errorf("okx account is in %s; switch it to net mode to trade swaps", account.PosMode)
+		}
+
+		contractValue, err := t.marketData.ContractValue(symbol)
+		if err != nil {
+			return nil, err
+		}
+		tradeMode = config.SwapTradeMode
+		size = quantity.Div(contractValue)
+	}
+
+	return map[string]interface{}{
+		"instId": symbol,
+		"tdMode": tradeMode,
+		"side":   strings.ToLower(string(side)),
+		"sz":     size.String(),
+	}, nil
+}
+
+func (t *tradingService) placeOrder(body map[string]interface{}, orderType connector.OrderType, symbol string, side connector.OrderSide, quantity, price numerical.Decimal) (*connector.OrderResponse, error) {
+	var result []struct {
+		OrdID   string `json:"ordId"`
+		ClOrdID string `json:"clOrdId"`
+		Ts      string `json:"ts"`
+	}
+	if err := t.orders(http.MethodPost, "/api/v5/trade/order", nil, body, &result); err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("empty order response for %s", symbol)
+	}
+
+	return &connector.OrderResponse{
+		OrderID:       result[0].OrdID,
+		ClientOrderID: result[0].ClOrdID,
+		Symbol:        symbol,
+		Status:        connector.OrderStatusNew,
+		Side:          side,
+		Type:          orderType,
+		Quantity:      quantity,
+		Price:         price,
+		FilledQty:     numerical.Zero(),
+		AvgPrice:      numerical.Zero(),
+		Timestamp:     t.timeProvider.Now(),
+	}, nil
+}
+
+func (t *tradingService) CancelOrder(_ connector.Instrument, symbol, orderID string) (*connector.CancelResponse, error) {
+	body := map[string]interface{}{
+		"instId": symbol,
+		"ordId":  orderID,
+	}
+
+	var result []struct {
+		OrdID   string `json:"ordId"`
+		ClOrdID string `json:"clOrdId"`
+	}
+	if err := t.orders(http.MethodPost, "/api/v5/trade/cancel-order", nil, body, &result); err != nil {
+		return nil, fmt.Errorf("failed to cancel order: %w", err)
+	}
+
+	response := &connector.CancelResponse{
+		OrderID:   orderID,
+		Symbol:    symbol,
+		Status:    connector.OrderStatusCanceled,
+		Timestamp: t.timeProvider.Now(),
+	}
+	if len(result) > 0 {
+		response.ClientOrderID = result[0].ClOrdID
+	}
+	return response, nil
+}
+
+func (t *tradingService) GetOpenOrders(instrument connector.Instrument) ([]connector.Order, error) {
+	params := url.Values{}
+	params.Set("instType", data.InstType(instrument))
+
+	var result []orderInfo
+	if err := t.orders(http.MethodGet, "/api/v5/trade/orders-pending", params, nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to get open orders: %w", err)
+	}
+
+	orders := make([]connector.Order, 0, len(result))
+	for _, order := range result {
+		converted, err := t.toOrder(order)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, converted)
+	}
+
+	return orders, nil
+}
+
+// GetOrderStatus looks the order up among open orders. OKX requires the
+// instId to query closed orders, so pass "INSTID:ORDERID" to reach those.
+func (t *tradingService) GetOrderStatus(instrument connector.Instrument, orderID string) (*connector.Order, error) {
+	if symbol, id, found := strings.Cut(orderID, ":"); found {
+		params := url.Values{}
+		params.Set("instId", symbol)
+		params.Set("ordId", id)
+
+		var result []orderInfo
+		if err := t.orders(http.MethodGet, "/api/v5/trade/order", params, nil, &result); err != nil {
+			return nil, fmt.Errorf("failed to get order status: %w", err)
+		}
+		if len(result) == 0 {
+			return nil, fmt.Errorf("order %s not found", orderID)
+		}
+
+		order, err := t.toOrder(result[0])
+		if err != nil {
+			return nil, err
+		}
+		return &order, nil
+	}
+
+	orders, err := t.GetOpenOrders(instrument)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, order := range orders {
+		if order.ID == orderID {
+			return &order, nil
+		}
+	}
+
+	return nil, fmt.Errorf("order %s not found among open orders", orderID)
+}
+
+// accountBalance mirrors /api/v5/account/balance. Account-level figures are
+// USD-valued across currencies; adjEq, imr and mmr are only populated in
+// multi-currency and portfolio margin mode.
+type accountBalance struct {
+	TotalEq string `json:"totalEq"`
+	AdjEq   string `json:"adjEq"`
+	Imr     string `json:"imr"`
+	Mmr     string `json:"mmr"`
+	Upl     string `json:"upl"`
+	UTime   string `json:"uTime"`
+	Details []struct {
+		Ccy       string `json:"ccy"`
+		Eq        string `json:"eq"`
+		CashBal   string `json:"cashBal"`
+		AvailBal  string `json:"availBal"`
+		AvailEq   string `json:"availEq"`
+		FrozenBal string `json:"frozenBal"`
+		Imr       string `json:"imr"`
+		Mmr       string `json:"mmr"`
+		Upl       string `json:"upl"`
+	} `json:"details"`
+}
+
+// GetAccountBalance reports the balance the way the unified account
+// margins it. In multi-currency and portfolio margin every holding backs
+// the swaps at its discounted USD value, so the balance is adjusted equity
+// in USD with the free margin as available. In single-currency and spot
+// mode only USDT backs USDT swaps, so the USDT balance is reported.
+func (t *tradingService) GetAccountBalance() (*connector.AccountBalance, error) {
+	account, err := t.accountConfig(false)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := t.fetchBalance()
+	if err != nil {
+		return nil, err
+	}
+
+	updatedAt := t.timestamp(balance.UTime)
+
+	switch account.AcctLv {
+	case AccountLevelMultiCurrency, AccountLevelPortfolio:
+		equity := parseDecimal(balance.AdjEq)
+		used := parseDecimal(balance.Imr)
+		available := equity.Sub(used)
+		if available.IsNegative() {
+			available = numerical.Zero()
+		}
+
+		return &connector.AccountBalance{
+			TotalBalance:     equity,
+			AvailableBalance: available,
+			UsedMargin:       used,
+			UnrealizedPnL:    parseDecimal(balance.Upl),
+			Currency:         "USD",
+			UpdatedAt:        updatedAt,
+		}, nil
+	}
+
+	result := &connector.AccountBalance{
+		TotalBalance:     numerical.Zero(),
+		AvailableBalance: numerical.Zero(),
+		UsedMargin:       numerical.Zero(),
+		UnrealizedPnL:    numerical.Zero(),
+		Currency:         "USDT",
+		UpdatedAt:        updatedAt,
+	}
+	for _, detail := range balance.Details {
+		if detail.Ccy != "USDT" {
+			continue
+		}
+
+		available := parseDecimal(detail.AvailEq)
+		if detail.AvailEq == "" {
+			// Spot mode has no margin, so availEq is left empty
+			available = parseDecimal(detail.AvailBal)
+		}
+
+		result.TotalBalance = parseDecimal(detail.Eq)
+		result.AvailableBalance = available
+		result.UsedMargin = parseDecimal(detail.Imr)
+		result.UnrealizedPnL = parseDecimal(detail.Upl)
+	}
+
+	return result, nil
+}
+
+// GetSpotBalances returns the non-zero coin holdings of the trading account.
+// The unified account keeps spot and margin in one wallet, so these are the
+// same coins that back the swaps.
+func (t *tradingService) GetSpotBalances() ([]types.SpotBalance, error) {
+	balance, err := t.fetchBalance()
+	if err != nil {
+		return nil, err
+	}
+
+	updatedAt := t.timestamp(balance.UTime)
+	balances := make([]types.SpotBalance, 0, len(balance.Details))
+	for _, detail := range balance.Details {
+		entry := types.SpotBalance{
+			Exchange:  types.Okx,
+			Asset:     detail.Ccy,
+			Free:      parseDecimal(detail.AvailBal),
+			Locked:    parseDecimal(detail.FrozenBal),
+			UpdatedAt: updatedAt,
+		}
+		if entry.Total().IsZero() {
+			continue
+		}
+		balances = append(balances, entry)
+	}
+
+	return balances, nil
+}
+
+func (t *tradingService) fetchBalance() (*accountBalance, error) {
+	var result []accountBalance
+	if err := t.client.Signed(context.Background(), http.MethodGet, "/api/v5/account/balance", nil, nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to get account balance: %w", err)
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("empty account balance response")
+	}
+	return &result[0], nil
+}
+
+func (t *tradingService) GetPositions() ([]connector.Position, error) {
+	params := url.Values{}
+	params.Set("instType", data.InstTypeSwap)
+
+	var result []struct {
+		InstID      string `json:"instId"`
+		Pos         string `json:"pos"`
+		PosSide     string `json:"posSide"`
+		AvgPx       string `json:"avgPx"`
+		MarkPx      string `json:"markPx"`
+		Upl         string `json:"upl"`
+		RealizedPnl string `json:"realizedPnl"`
+		Lever       string `json:"lever"`
+		MgnMode     string `json:"mgnMode"`
+		LiqPx       string `json:"liqPx"`
+		UTime       string `json:"uTime"`
+	}
+
+	if err := t.client.Signed(context.Background(), http.MethodGet, "/api/v5/account/positions", params, nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to get positions: %w", err)
+	}
+
+	positions := make([]connector.Position, 0, len(result))
+	for _, pos := range result {
+		contracts := parseDecimal(pos.Pos)
+		if contracts.IsZero() {
+			continue
+		}
+
+		contractValue, err := t.marketData.ContractValue(pos.InstID)
+		if err != nil {
+			return nil, err
+		}
+
+		side := connector.OrderSideBuy
+		if pos.PosSide == "short" || (pos.PosSide != "long" && contracts.IsNegative()) {
+			side = connector.OrderSideSell
+		}
+
+		positions = append(positions, connector.Position{
+			Symbol:           data.AssetOf(pos.InstID),
+			Exchange:         types.Okx,
+			Side:             side,
+			Size:             contracts.Abs().Mul(contractValue),
+			EntryPrice:       parseDecimal(pos.AvgPx),
+			MarkPrice:        parseDecimal(pos.MarkPx),
+			UnrealizedPnL:    parseDecimal(pos.Upl),
+			RealizedPnL:      parseDecimal(pos.RealizedPnl),
+			Leverage:         parseDecimal(pos.Lever),
+			MarginType:       strings.ToUpper(pos.MgnMode),
+			LiquidationPrice: parseDecimal(pos.LiqPx),
+			UpdatedAt:        t.timestamp(pos.UTime),
+		})
+	}
+
+	return positions, nil
+}
+
+// GetTradingHistory returns fills from the last three days, which is all
+// /api/v5/trade/fills keeps
+func (t *tradingService) GetTradingHistory(instrument connector.Instrument, symbol string, limit int) ([]connector.Trade, error) {
+	return t.fetchFills("/api/v5/trade/fills", fillParams(instrument, symbol, limit))
+}
+
+// GetTradingHistoryRange returns fills within [start, end] from the
+// three-month archive, oldest first
+func (t *tradingService) GetTradingHistoryRange(instrument connector.Instrument, symbol string, start, end time.Time, limit int) ([]connector.Trade, error) {
+	params := fillParams(instrument, symbol, limit)
+	params.Set("begin", strconv.FormatInt(start.UnixMilli(), 10))
+	params.Set("end", strconv.FormatInt(end.UnixMilli(), 10))
+	return t.fetchFills("/api/v5/trade/fills-history", params)
+}
+
+func fillParams(instrument connector.Instrument, symbol string, limit int) url.Values {
+	params := url.Values{}
+	params.Set("instType", data.InstType(instrument))
+	if symbol != "" {
+		params.Set("instId", symbol)
+	}
+	if limit > 0 {
+		params.Set("limit", strconv.Itoa(limit))
+	}
+	return params
+}
+
+func (t *tradingService) fetchFills(path string, params url.Values) ([]connector.Trade, error) {
+	var result []fill
+	if err := t.client.Signed(context.Background(), http.MethodGet, path, params, nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to get trading history: %w", err)
+	}
+
+	trades := make([]connector.Trade, 0, len(result))
+	for _, f := range result {
+		contractValue, err := t.marketData.ContractValue(f.InstID)
+		if err != nil {
+			return nil, err
+		}
+
+		trades = append(trades, connector.Trade{
+			ID:       f.TradeID,
+			OrderID:  f.OrdID,
+			Symbol:   f.InstID,
+			Exchange: types.Okx,
+			Price:    parseDecimal(f.FillPx),
+			Quantity: parseDecimal(f.FillSz).Mul(contractValue),
+			Side:     data.Side(f.Side),
+			IsMaker:  f.ExecType == "M",
+			// OKX reports fees charged as negative amounts
+			Fee:       parseDecimal(f.Fee).Neg(),
+			Timestamp: t.timestamp(f.Ts),
+		})
+	}
+
+	sort.Slice(trades, func(i, j int) bool {
+		return trades[i].Timestamp.Before(trades[j].Timestamp)
+	})
+
+	return trades, nil
+}
+
+// GetMarginInfo returns the unified account's margin mode and the
+// exchange-computed initial and maintenance requirements
+func (t *tradingService) GetMarginInfo() (*types.MarginInfo, error) {
+	account, err := t.accountConfig(true)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := t.fetchBalance()
+	if err != nil {
+		return nil, err
+	}
+
+	margin := &types.MarginInfo{
+		Exchange:  types.Okx,
+		Mode:      marginMode(account.AcctLv),
+		UpdatedAt: t.timestamp(balance.UTime),
+	}
+
+	switch account.AcctLv {
+	case AccountLevelMultiCurrency, AccountLevelPortfolio:
+		margin.Equity = parseDecimal(balance.AdjEq)
+		margin.InitialMargin = parseDecimal(balance.Imr)
+		margin.MaintenanceMargin = parseDecimal(balance.Mmr)
+	default:
+		// Below multi-currency mode requirements are only reported per currency
+		margin.Equity = parseDecimal(balance.TotalEq)
+		margin.InitialMargin = numerical.Zero()
+		margin.MaintenanceMargin = numerical.Zero()
+		for _, detail := range balance.Details {
+			margin.InitialMargin = margin.InitialMargin.Add(parseDecimal(detail.Imr))
+			margin.MaintenanceMargin = margin.MaintenanceMargin.Add(parseDecimal(detail.Mmr))
+		}
+	}
+
+	return margin, nil
+}
+
+// marginMode maps OKX account levels; spot mode margins swaps per position
+func marginMode(level string) types.MarginMode {
+	switch level {
+	case AccountLevelSpot:
+		return types.MarginModeIsolated
+	case AccountLevelSingleCurrency, AccountLevelMultiCurrency:
+		return types.MarginModeCross
+	case AccountLevelPortfolio:
+		return types.MarginModePortfolio
+	}
+	return types.MarginModeUnknown
+}
+
+// GetAPIKeyPermissions reads the key's permissions from the account config
+func (t *tradingService) GetAPIKeyPermissions() (*types.APIKeyPermissions, error) {
+	account, err := t.accountConfig(true)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions := &types.APIKeyPermissions{Exchange: types.Okx}
+	for _, scope := range strings.Split(account.Perm, ",") {
+		scope = strings.TrimSpace(scope)
+		switch scope {
+		case "":
+			continue
+		case "trade":
+			permissions.Trade = true
+		case "withdraw":
+			permissions.Withdraw = true
+		}
+		permissions.Scopes = append(permissions.Scopes, scope)
+	}
+	if account.IP != "" {
+		permissions.Scopes = append(permissions.Scopes, "ip_restricted")
+	}
+	permissions.ReadOnly = !permissions.Trade
+	sort.Strings(permissions.Scopes)
+
+	return permissions, nil
+}
+
+// accountConfig returns the account level and position mode, read once and
+// cached since OKX only lets them change with no positions open
+func (t *tradingService) accountConfig(refresh bool) (*accountConfig, error) {
+	t.mu.RLock()
+	cached := t.account
+	t.mu.RUnlock()
+
+	if cached != nil && !refresh {
+		return cached, nil
+	}
+
+	var result []accountConfig
+	if err := t.client.Signed(context.Background(), http.MethodGet, "/api/v5/account/config", nil, nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to get account config: %w", err)
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("empty account config response")
+	}
+
+	t.mu.Lock()
+	t.account = &result[0]
+	t.mu.Unlock()
+
+	return &result[0], nil
+}
+
+// orders calls an order endpoint, keeping OKX's entry for each order in the
+// response before decoding them into out
+func (t *tradingService) orders(method, path string, params url.Values, body, out interface{}) error {
+	var entries []json.RawMessage
+	if err := t.client.Signed(context.Background(), method, path, params, body, &entries); err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		var id struct {
+			OrdID string `json:"ordId"`
+		}
+		if err := json.Unmarshal(entry, &id); err == nil {
+			t.payloads.Keep(id.OrdID, entry)
+		}
+	}
+
+	encoded, err := json.Marshal(entries)
+	if err != nil {
+		return fmt.Errorf("failed to decode orders: %w", err)
+	}
+	return json.Unmarshal(encoded, out)
+}
+
+func (t *tradingService) OrderPayload(orderID string) (json.RawMessage, bool) {
+	return t.payloads.OrderPayload(orderID)
+}
+
+func (t *tradingService) toOrder(order orderInfo) (connector.Order, error) {
+	contractValue, err := t.marketData.ContractValue(order.InstID)
+	if err != nil {
+		return connector.Order{}, err
+	}
+
+	quantity := parseDecimal(order.Sz).Mul(contractValue)
+	filled := parseDecimal(order.AccFillSz).Mul(contractValue)
+
+	return connector.Order{
+		ID:            order.OrdID,
+		ClientOrderID: order.ClOrdID,
+		Symbol:        order.InstID,
+		Side:          data.Side(order.Side),
+		Type:          convertOrderType(order.OrdType),
+		Status:        convertOrderStatus(order.State),
+		Quantity:      quantity,
+		Price:         parseDecimal(order.Px),
+		FilledQty:     filled,
+		RemainingQty:  quantity.Sub(filled),
+		AvgPrice:      parseDecimal(order.AvgPx),
+		CreatedAt:     t.timestamp(order.CTime),
+		UpdatedAt:     t.timestamp(order.UTime),
+	}, nil
+}
+
+func convertOrderStatus(state string) connector.OrderStatus {
+	switch state {
+	case "live":
+		return connector.OrderStatusOpen
+	case "partially_filled":
+		return connector.OrderStatusPartiallyFilled
+	case "filled":
+		return connector.OrderStatusFilled
+	case "canceled", "mmp_canceled":
+		return connector.OrderStatusCanceled
+	default:
+		return connector.OrderStatusNew
+	}
+}
+
+func convertOrderType(orderType string) connector.OrderType {
+	switch orderType {
+	case "market", "optimal_limit_ioc":
+		return connector.OrderTypeMarket
+	default:
+		return connector.OrderTypeLimit
+	}
+}
+
+// timestamp parses an OKX millisecond timestamp, falling back to now
+func (t *tradingService) timestamp(ts string) time.Time {
+	if ms, err := strconv.ParseInt(ts, 10, 64); err == nil && ms > 0 {
+		return time.UnixMilli(ms)
+	}
+	return t.timeProvider.Now()
+}
+
+func parseDecimal(value string) numerical.Decimal {
+	if value == "" {
+		return numerical.Zero()
+	}
+
+	d, err := numerical.NewFromString(value)
+	if err != nil {
+		return numerical.Zero()
+	}
+
+	return d
+}
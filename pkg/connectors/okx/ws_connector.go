@@ -0,0 +1,442 @@
+package okx
+
+import (
+	"fmt"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/live-trading/pkg/connectors/okx/data/real_time"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+// StartWebSocket starts the public and business streams; the private
+// stream connects on the first account, position or fill subscription
+func (o *okx) StartWebSocket() error {
+	if !o.initialized {
+		return fmt.Errorf("connector not initialized")
+	}
+
+	// Start error forwarding from realTime service
+	go o.forwardWebSocketErrors()
+
+	return o.realTime.Connect()
+}
+
+// forwardWebSocketErrors forwards errors from the realTime service to the connector's error channel
+func (o *okx) forwardWebSocketErrors() {
+	errCh := o.realTime.GetErrorChannel()
+	for err := range errCh {
+		select {
+		case o.errorCh <- err:
+		default:
+			// Error channel is full, drop the error
+		}
+	}
+}
+
+// StopWebSocket stops every WebSocket connection
+func (o *okx) StopWebSocket() error {
+	if !o.initialized {
+		return fmt.Errorf("connector not initialized")
+	}
+
+	return o.realTime.Disconnect()
+}
+
+// IsWebSocketConnected returns whether the WebSocket is connected
+func (o *okx) IsWebSocketConnected() bool {
+	if !o.initialized || o.realTime == nil {
+		return false
+	}
+	return o.realTime.IsConnected()
+}
+
+// GetOrderBookChannels returns all active orderbook channels
+func (o *okx) GetOrderBookChannels() map[string]<-chan connector.OrderBook {
+	o.orderBookMu.RLock()
+	defer o.orderBookMu.RUnlock()
+
+	result := make(map[string]<-chan connector.OrderBook, len(o.orderBookChannels))
+	for key, ch := range o.orderBookChannels {
+		result[key] = ch
+	}
+
+	return result
+}
+
+// TradeUpdates returns a channel for trade updates
+func (o *okx) TradeUpdates() <-chan connector.Trade {
+	return o.tradeCh
+}
+
+// PositionUpdates returns a channel for position updates
+func (o *okx) PositionUpdates() <-chan connector.Position {
+	return o.positionCh
+}
+
+// AccountBalanceUpdates returns a channel for account balance updates
+func (o *okx) AccountBalanceUpdates() <-chan connector.AccountBalance {
+	return o.balanceCh
+}
+
+// GetKlineChannels returns all active kline channels
+func (o *okx) GetKlineChannels() map[string]<-chan connector.Kline {
+	o.klineMu.RLock()
+	defer o.klineMu.RUnlock()
+
+	result := make(map[string]<-chan connector.Kline, len(o.klineChannels))
+	for key, ch := range o.klineChannels {
+		result[key] = ch
+	}
+
+	return result
+}
+
+// ErrorChannel returns a channel for WebSocket errors
+func (o *okx) ErrorChannel() <-chan error {
+	return o.errorCh
+}
+
+// SubscribeOrderBook subscribes to order book updates for an asset's swap or spot market
+func (o *okx) SubscribeOrderBook(asset portfolio.Asset, instrument connector.Instrument) error {
+	if !o.initialized {
+		return fmt.Errorf("connector not initialized")
+	}
+
+	symbol, err := symbolRule.ToNative(asset, instrument)
+	if err != nil {
+		return err
+	}
+	key := asset.Symbol()
+
+	// Create dedicated channel for this asset if it doesn't exist
+	o.orderBookMu.Lock()
+	orderBookCh, exists := o.orderBookChannels[key]
+	if !exists {
+		orderBookCh = make(chan connector.OrderBook, 100)
+		o.orderBookChannels[key] = orderBookCh
+	}
+	o.orderBookMu.Unlock()
+
+	return o.realTime.SubscribeOrderBook(symbol, func(obMsg *real_time.OrderBookMessage) {
+		orderBook := connector.OrderBook{
+			Asset:     asset,
+			Timestamp: obMsg.Timestamp,
+			Bids:      obMsg.Bids,
+			Asks:      obMsg.Asks,
+		}
+
+		select {
+		case orderBookCh <- orderBook:
+		default:
+			select {
+			case o.errorCh <- fmt.Errorf("orderbook channel full for %s, dropping update", key):
+			default:
+			}
+		}
+	})
+}
+
+// UnsubscribeOrderBook unsubscribes from order book updates
+func (o *okx) UnsubscribeOrderBook(asset portfolio.Asset, instrument connector.Instrument) error {
+	if !o.initialized {
+		return fmt.Errorf("connector not initialized")
+	}
+
+	symbol, err := symbolRule.ToNative(asset, instrument)
+	if err != nil {
+		return err
+	}
+
+	o.orderBookMu.Lock()
+	delete(o.orderBookChannels, asset.Symbol())
+	o.orderBookMu.Unlock()
+
+	return o.realTime.UnsubscribeOrderBook(symbol)
+}
+
+// SubscribeTrades subscribes to trade updates for an asset's swap or spot market
+func (o *okx) SubscribeTrades(asset portfolio.Asset, instrument connector.Instrument) error {
+	if !o.initialized {
+		return fmt.Errorf("connector not initialized")
+	}
+
+	symbol, err := symbolRule.ToNative(asset, instrument)
+	if err != nil {
+		return err
+	}
+
+	return o.realTime.SubscribeTrades(symbol, func(trade *real_time.TradeMessage) {
+		select {
+		case o.tradeCh <- connector.Trade{
+			ID:        trade.ID,
+			Symbol:    trade.Symbol,
+			Exchange:  types.Okx,
+			Price:     trade.Price,
+			Quantity:  trade.Quantity,
+			Side:      trade.Side,
+			Timestamp: trade.Timestamp,
+		}:
+		default:
+			select {
+			case o.errorCh <- fmt.Errorf("trade channel full for %s, dropping update", symbol):
+			default:
+			}
+		}
+	})
+}
+
+// UnsubscribeTrades unsubscribes from trade updates
+func (o *okx) UnsubscribeTrades(asset portfolio.Asset, instrument connector.Instrument) error {
+	if !o.initialized {
+		return fmt.Errorf("connector not initialized")
+	}
+
+	symbol, err := symbolRule.ToNative(asset, instrument)
+	if err != nil {
+		return err
+	}
+
+	return o.realTime.UnsubscribeTrades(symbol)
+}
+
+// SubscribePositions subscribes to swap position updates
+func (o *okx) SubscribePositions(asset portfolio.Asset, _ connector.Instrument) error {
+	if !o.initialized {
+		return fmt.Errorf("connector not initialized")
+	}
+
+	o.userMu.Lock()
+	o.positionSymbols[o.GetPerpSymbol(asset)] = asset
+	o.userMu.Unlock()
+
+	return o.realTime.SubscribePositions(o.handlePositionUpdate)
+}
+
+// UnsubscribePositions unsubscribes from position updates
+func (o *okx) UnsubscribePositions(asset portfolio.Asset, _ connector.Instrument) error {
+	if !o.initialized {
+		return fmt.Errorf("connector not initialized")
+	}
+
+	symbol := o.GetPerpSymbol(asset)
+
+	o.userMu.Lock()
+	if _, exists := o.positionSymbols[symbol]; !exists {
+		o.userMu.Unlock()
+		return fmt.Errorf("no active subscription for positions:%s", symbol)
+	}
+	delete(o.positionSymbols, symbol)
+	remaining := len(o.positionSymbols)
+	o.userMu.Unlock()
+
+	if remaining > 0 {
+		return nil
+	}
+	return o.realTime.UnsubscribePositions()
+}
+
+// SubscribeAccountBalance subscribes to account balance updates
+func (o *okx) SubscribeAccountBalance() error {
+	if !o.initialized {
+		return fmt.Errorf("connector not initialized")
+	}
+
+	return o.realTime.SubscribeAccount(o.handleAccountUpdate)
+}
+
+// UnsubscribeAccountBalance unsubscribes from account balance updates
+func (o *okx) UnsubscribeAccountBalance() error {
+	if !o.initialized {
+		return fmt.Errorf("connector not initialized")
+	}
+
+	return o.realTime.UnsubscribeAccount()
+}
+
+// SubscribeFills follows the account's own swap fills on the private
+// orders channel
+func (o *okx) SubscribeFills() error {
+	if !o.initialized {
+		return fmt.Errorf("connector not initialized")
+	}
+
+	return o.realTime.SubscribeFills(o.handleFillUpdate)
+}
+
+// UnsubscribeFills stops following the account's fills
+func (o *okx) UnsubscribeFills() error {
+	if !o.initialized {
+		return fmt.Errorf("connector not initialized")
+	}
+
+	return o.realTime.UnsubscribeFills()
+}
+
+// FillUpdates carries the account's fills once SubscribeFills succeeds
+func (o *okx) FillUpdates() <-chan connector.Trade {
+	return o.fillCh
+}
+
+// SubscribeKlines subscribes to swap kline updates for an asset
+func (o *okx) SubscribeKlines(asset portfolio.Asset, interval string) error {
+	if !o.initialized {
+		return fmt.Errorf("connector not initialized")
+	}
+
+	symbol := o.GetPerpSymbol(asset)
+	channelKey := fmt.Sprintf("%s:%s", asset.Symbol(), interval)
+
+	// Create dedicated channel for this subscription
+	o.klineMu.Lock()
+	klineCh := make(chan connector.Kline, 100)
+	o.klineChannels[channelKey] = klineCh
+	o.klineMu.Unlock()
+
+	return o.realTime.SubscribeKlines(symbol, interval, func(klineMsg *real_time.KlineMessage) {
+		kline := connector.Kline{
+			Symbol:      asset.Symbol(),
+			Interval:    klineMsg.Interval,
+			OpenTime:    klineMsg.OpenTime,
+			Open:        klineMsg.Open,
+			High:        klineMsg.High,
+			Low:         klineMsg.Low,
+			Close:       klineMsg.Close,
+			Volume:      klineMsg.Volume,
+			QuoteVolume: klineMsg.QuoteVolume,
+		}
+
+		select {
+		case klineCh <- kline:
+		default:
+			select {
+			case o.errorCh <- fmt.Errorf("kline channel full for %s, dropping update", channelKey):
+			default:
+			}
+		}
+	})
+}
+
+// UnsubscribeKlines unsubscribes from kline updates
+func (o *okx) UnsubscribeKlines(asset portfolio.Asset, interval string) error {
+	if !o.initialized {
+		return fmt.Errorf("connector not initialized")
+	}
+
+	o.klineMu.Lock()
+	delete(o.klineChannels, fmt.Sprintf("%s:%s", asset.Symbol(), interval))
+	o.klineMu.Unlock()
+
+	return o.realTime.UnsubscribeKlines(o.GetPerpSymbol(asset), interval)
+}
+
+// handleAccountUpdate publishes an account push with the same margin model
+// as GetAccountBalance; OKX only sets adjusted equity in multi-currency and
+// portfolio margin mode, which is how the two are told apart here
+func (o *okx) handleAccountUpdate(msg *real_time.AccountMessage) {
+	balance := connector.AccountBalance{
+		TotalBalance:     numerical.Zero(),
+		AvailableBalance: numerical.Zero(),
+		UsedMargin:       numerical.Zero(),
+		UnrealizedPnL:    numerical.Zero(),
+		Currency:         "USDT",
+		UpdatedAt:        msg.Timestamp,
+	}
+
+	if msg.AdjustedEquity.IsPositive() {
+		available := msg.AdjustedEquity.Sub(msg.InitialMargin)
+		if available.IsNegative() {
+			available = numerical.Zero()
+		}
+		balance.TotalBalance = msg.AdjustedEquity
+		balance.AvailableBalance = available
+		balance.UsedMargin = msg.InitialMargin
+		balance.UnrealizedPnL = msg.UnrealizedPnL
+		balance.Currency = "USD"
+	} else {
+		found := false
+		for _, currency := range msg.Balances {
+			if currency.Currency != "USDT" {
+				continue
+			}
+			found = true
+			balance.TotalBalance = currency.Equity
+			balance.AvailableBalance = currency.Available
+			balance.UsedMargin = currency.InitialMargin
+			balance.UnrealizedPnL = currency.UnrealizedPnL
+		}
+		// Account pushes only carry the currencies that changed
+		if !found {
+			return
+		}
+	}
+
+	select {
+	case o.balanceCh <- balance:
+	default:
+		select {
+		case o.errorCh <- fmt.Errorf("balance channel full, dropping update"):
+		default:
+		}
+	}
+}
+
+// handleFillUpdate forwards a private fill to FillUpdates
+func (o *okx) handleFillUpdate(msg *real_time.FillMessage) {
+	select {
+	case o.fillCh <- connector.Trade{
+		ID:        msg.TradeID,
+		OrderID:   msg.OrderID,
+		Symbol:    msg.Symbol,
+		Exchange:  types.Okx,
+		Price:     msg.Price,
+		Quantity:  msg.Quantity,
+		Side:      msg.Side,
+		IsMaker:   msg.IsMaker,
+		Fee:       msg.Fee,
+		Timestamp: msg.Timestamp,
+	}:
+	default:
+		select {
+		case o.errorCh <- fmt.Errorf("fill channel full for %s, dropping fill %s", msg.Symbol, msg.TradeID):
+		default:
+		}
+	}
+}
+
+// handlePositionUpdate fans a positions push out to the subscribed swaps
+func (o *okx) handlePositionUpdate(msg *real_time.PositionMessage) {
+	o.userMu.RLock()
+	asset, ok := o.positionSymbols[msg.Symbol]
+	o.userMu.RUnlock()
+
+	if !ok {
+		return
+	}
+
+	side := connector.OrderSideBuy
+	if msg.Size.IsNegative() {
+		side = connector.OrderSideSell
+	}
+
+	select {
+	case o.positionCh <- connector.Position{
+		Symbol:        asset,
+		Exchange:      types.Okx,
+		Side:          side,
+		Size:          msg.Size.Abs(),
+		EntryPrice:    msg.EntryPrice,
+		MarkPrice:     msg.MarkPrice,
+		UnrealizedPnL: msg.UnrealizedPnL,
+		RealizedPnL:   numerical.Zero(),
+		MarginType:    msg.MarginMode,
+		UpdatedAt:     msg.Timestamp,
+	}:
+	default:
+		select {
+		case o.errorCh <- fmt.Errorf("position channel full for %s, dropping update", msg.Symbol):
+		default:
+		}
+	}
+}
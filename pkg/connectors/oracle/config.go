@@ -0,0 +1,55 @@
+package oracle
+
+import "time"
+
+const (
+	// DefaultEndpoint is Pyth's public Hermes price service
+	DefaultEndpoint = "https://hermes.pyth.network"
+
+	// DefaultInterval is how often oracle and exchange prices are refreshed
+	DefaultInterval = 10 * time.Second
+
+	// DefaultMaxAge is how old an oracle publish may be before it is unusable
+	DefaultMaxAge = time.Minute
+
+	// JobName is the scheduler job the feed registers under
+	JobName = "oracle-prices"
+)
+
+// DefaultFeedIDs are Pyth USD price feed IDs for commonly traded assets
+var DefaultFeedIDs = map[string]string{
+	"BTC": "e62df6c8b4a85fe1a67db44dc12de5db330f7ac66b72dc658afedf0f4a415b43",
+	"ETH": "ff61491a931112ddf1bd8147cd1b641375f79f5825126d665480874634fd0ace",
+	"SOL": "ef0d8b6fda2ceba41da15d4095d1da392a0d2f8ed0c6c7bc0f4cfac8c280b56d",
+}
+
+// Config controls the oracle feed
+type Config struct {
+	Endpoint string
+	Interval time.Duration
+	MaxAge   time.Duration
+
+	// FeedIDs maps asset symbols to Pyth price feed IDs; only assets listed
+	// here are polled
+	FeedIDs map[string]string
+
+	// UseForSanity installs the feed as the price sanity checker's
+	// reference source while it is running
+	UseForSanity bool
+}
+
+// DefaultConfig polls the public Hermes endpoint for BTC, ETH and SOL every ten seconds
+func DefaultConfig() Config {
+	feeds := make(map[string]string, len(DefaultFeedIDs))
+	for symbol, id := range DefaultFeedIDs {
+		feeds[symbol] = id
+	}
+
+	return Config{
+		Endpoint:     DefaultEndpoint,
+		Interval:     DefaultInterval,
+		MaxAge:       DefaultMaxAge,
+		FeedIDs:      feeds,
+		UseForSanity: true,
+	}
+}
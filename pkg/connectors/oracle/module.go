@@ -0,0 +1,7 @@
+package oracle
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(NewOracleFeed),
+)
@@ -0,0 +1,98 @@
+package oracle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+)
+
+// requestTimeout bounds a single Hermes call so a slow oracle cannot stall the job
+const requestTimeout = 5 * time.Second
+
+type pythPrice struct {
+	Price       string `json:"price"`
+	Conf        string `json:"conf"`
+	Expo        int32  `json:"expo"`
+	PublishTime int64  `json:"publish_time"`
+}
+
+type pythUpdate struct {
+	Parsed []struct {
+		ID    string    `json:"id"`
+		Price pythPrice `json:"price"`
+	} `json:"parsed"`
+}
+
+// pythClient reads the latest prices from a Hermes endpoint
+type pythClient struct {
+	endpoint   string
+	httpClient *http.Client
+}
+
+func newPythClient(endpoint string) *pythClient {
+	return &pythClient{
+		endpoint:   strings.TrimRight(endpoint, "/"),
+		httpClient: &http.Client{Timeout: requestTimeout},
+	}
+}
+
+// latest returns oracle prices keyed by lowercase feed ID
+func (c *pythClient) latest(ctx context.Context, feedIDs []string) (map[string]Price, error) {
+	params := url.Values{}
+	for _, id := range feedIDs {
+		params.Add("ids[]", id)
+	}
+	params.Set("parsed", "true")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.endpoint+"/v2/updates/price/latest?"+params.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build pyth request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("pyth request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("pyth returned status %d", resp.StatusCode)
+	}
+
+	var update pythUpdate
+	if err := json.NewDecoder(resp.Body).Decode(&update); err != nil {
+		return nil, fmt.Errorf("failed to decode pyth response: %w", err)
+	}
+
+	prices := make(map[string]Price, len(update.Parsed))
+	for _, feed := range update.Parsed {
+		price, err := scaled(feed.Price.Price, feed.Price.Expo)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pyth price for %s: %w", feed.ID, err)
+		}
+		confidence, err := scaled(feed.Price.Conf, feed.Price.Expo)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pyth confidence for %s: %w", feed.ID, err)
+		}
+
+		prices[strings.ToLower(strings.TrimPrefix(feed.ID, "0x"))] = Price{
+			Source:      SourcePyth,
+			Price:       price,
+			Confidence:  confidence,
+			PublishedAt: time.Unix(feed.Price.PublishTime, 0),
+		}
+	}
+
+	return prices, nil
+}
+
+// scaled applies a Pyth exponent to its integer mantissa
+func scaled(mantissa string, expo int32) (numerical.Decimal, error) {
+	return numerical.NewFromString(fmt.Sprintf("%se%d", mantissa, expo))
+}
@@ -0,0 +1,311 @@
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/sanity"
+	"github.com/backtesting-org/live-trading/pkg/scheduler"
+)
+
+// Source identifies the oracle network a price came from
+type Source string
+
+const (
+	SourcePyth Source = "pyth"
+)
+
+// Price is an oracle's published price with its confidence interval
+type Price struct {
+	Source      Source
+	Price       numerical.Decimal
+	Confidence  numerical.Decimal
+	PublishedAt time.Time
+}
+
+// ExchangeQuote is an exchange price captured in the same pass as the oracle price
+type ExchangeQuote struct {
+	Exchange  connector.ExchangeName
+	Price     numerical.Decimal
+	Timestamp time.Time
+
+	// Basis is the exchange price's relative distance from the oracle,
+	// positive when the exchange trades above it
+	Basis float64
+}
+
+// Snapshot pairs an asset's oracle price with the exchange prices seen alongside it
+type Snapshot struct {
+	Asset     portfolio.Asset
+	Oracle    Price
+	Exchanges []ExchangeQuote
+	TakenAt   time.Time
+}
+
+// OracleFeed polls an external oracle for exchange-independent reference
+// prices and records them next to the prices each ready connector reports
+type OracleFeed interface {
+	sanity.ReferencePriceSource
+
+	Configure(config Config) error
+
+	// Start registers the polling job and, when configured, installs the
+	// feed as the price sanity checker's reference source
+	Start() error
+	Stop() error
+
+	// Refresh polls the oracle and exchanges now
+	Refresh() error
+
+	// Price returns the latest oracle price; stale prices are still returned
+	Price(asset portfolio.Asset) (*Price, bool)
+	Snapshot(asset portfolio.Asset) (*Snapshot, bool)
+	Snapshots() []Snapshot
+}
+
+type oracleFeed struct {
+	registry     registry.ConnectorRegistry
+	scheduler    scheduler.Scheduler
+	sanity       sanity.PriceSanityChecker
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config    Config
+	client    *pythClient
+	snapshots map[string]*Snapshot
+	mu        sync.RWMutex
+}
+
+func NewOracleFeed(
+	connectorRegistry registry.ConnectorRegistry,
+	jobScheduler scheduler.Scheduler,
+	sanityChecker sanity.PriceSanityChecker,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) OracleFeed {
+	config := DefaultConfig()
+	return &oracleFeed{
+		registry:     connectorRegistry,
+		scheduler:    jobScheduler,
+		sanity:       sanityChecker,
+		timeProvider: timeProvider,
+		logger:       logger,
+		config:       config,
+		client:       newPythClient(config.Endpoint),
+		snapshots:    make(map[string]*Snapshot),
+	}
+}
+
+func (o *oracleFeed) Configure(config Config) error {
+	defaults := DefaultConfig()
+	if config.Endpoint == "" {
+		config.Endpoint = defaults.Endpoint
+	}
+	if config.Interval <= 0 {
+		config.Interval = defaults.Interval
+	}
+	if config.MaxAge <= 0 {
+		config.MaxAge = defaults.MaxAge
+	}
+	if len(config.FeedIDs) == 0 {
+		return fmt.Errorf("at least one oracle feed ID is required")
+	}
+
+	feeds := make(map[string]string, len(config.FeedIDs))
+	for symbol, id := range config.FeedIDs {
+		id = strings.ToLower(strings.TrimPrefix(id, "0x"))
+		if id == "" {
+			return fmt.Errorf("empty feed ID for %s", symbol)
+		}
+		feeds[strings.ToUpper(symbol)] = id
+	}
+	config.FeedIDs = feeds
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.config = config
+	o.client = newPythClient(config.Endpoint)
+	return nil
+}
+
+func (o *oracleFeed) Start() error {
+	o.mu.RLock()
+	interval := o.config.Interval
+	useForSanity := o.config.UseForSanity
+	o.mu.RUnlock()
+
+	if err := o.scheduler.Register(scheduler.Job{
+		Name:       JobName,
+		Interval:   interval,
+		RunOnStart: true,
+		Run: func(_ context.Context) error {
+			return o.Refresh()
+		},
+	}); err != nil {
+		return err
+	}
+
+	if useForSanity {
+		o.sanity.SetReferenceSource(o)
+	}
+	return nil
+}
+
+func (o *oracleFeed) Stop() error {
+	o.mu.RLock()
+	useForSanity := o.config.UseForSanity
+	o.mu.RUnlock()
+
+	if useForSanity {
+		o.sanity.SetReferenceSource(nil)
+	}
+	return o.scheduler.Unregister(JobName)
+}
+
+func (o *oracleFeed) Refresh() error {
+	o.mu.RLock()
+	config := o.config
+	client := o.client
+	o.mu.RUnlock()
+
+	symbolsByID := make(map[string]string, len(config.FeedIDs))
+	ids := make([]string, 0, len(config.FeedIDs))
+	for symbol, id := range config.FeedIDs {
+		symbolsByID[id] = symbol
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	prices, err := client.latest(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("failed to refresh oracle prices: %w", err)
+	}
+
+	now := o.timeProvider.Now()
+	for id, price := range prices {
+		symbol, ok := symbolsByID[id]
+		if !ok {
+			continue
+		}
+
+		asset := portfolio.NewAsset(symbol)
+		snapshot := &Snapshot{
+			Asset:     asset,
+			Oracle:    price,
+			Exchanges: o.exchangeQuotes(asset, price.Price),
+			TakenAt:   now,
+		}
+
+		o.mu.Lock()
+		o.snapshots[symbol] = snapshot
+		o.mu.Unlock()
+	}
+
+	for id, symbol := range symbolsByID {
+		if _, ok := prices[id]; !ok {
+			o.logger.Warn("Oracle: no %s price returned for %s", SourcePyth, symbol)
+		}
+	}
+
+	return nil
+}
+
+// exchangeQuotes captures each ready connector's price for comparison;
+// connectors that cannot quote the asset are skipped
+func (o *oracleFeed) exchangeQuotes(asset portfolio.Asset, reference numerical.Decimal) []ExchangeQuote {
+	var quotes []ExchangeQuote
+	for _, conn := range o.registry.GetReadyConnectors() {
+		info := conn.GetConnectorInfo()
+		if info == nil {
+			continue
+		}
+
+		price, err := conn.FetchPrice(conn.GetPerpSymbol(asset))
+		if err != nil {
+			continue
+		}
+
+		quote := ExchangeQuote{
+			Exchange:  info.Name,
+			Price:     price.Price,
+			Timestamp: price.Timestamp,
+		}
+		if reference.IsPositive() {
+			quote.Basis = price.Price.Sub(reference).Div(reference).InexactFloat64()
+		}
+		quotes = append(quotes, quote)
+	}
+	return quotes
+}
+
+func (o *oracleFeed) ReferencePrice(asset portfolio.Asset) (numerical.Decimal, error) {
+	o.mu.RLock()
+	snapshot, ok := o.snapshots[strings.ToUpper(asset.Symbol())]
+	maxAge := o.config.MaxAge
+	o.mu.RUnlock()
+
+	if !ok {
+		return numerical.Zero(), fmt.Errorf("no oracle price for %s", asset.Symbol())
+	}
+
+	age := o.timeProvider.Now().Sub(snapshot.Oracle.PublishedAt)
+	if age > maxAge {
+		return numerical.Zero(), fmt.Errorf("oracle price for %s is stale by %s", asset.Symbol(), age)
+	}
+
+	return snapshot.Oracle.Price, nil
+}
+
+func (o *oracleFeed) Price(asset portfolio.Asset) (*Price, bool) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	snapshot, ok := o.snapshots[strings.ToUpper(asset.Symbol())]
+	if !ok {
+		return nil, false
+	}
+	price := snapshot.Oracle
+	return &price, true
+}
+
+func (o *oracleFeed) Snapshot(asset portfolio.Asset) (*Snapshot, bool) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	snapshot, ok := o.snapshots[strings.ToUpper(asset.Symbol())]
+	if !ok {
+		return nil, false
+	}
+	copied := *snapshot
+	copied.Exchanges = append([]ExchangeQuote(nil), snapshot.Exchanges...)
+	return &copied, true
+}
+
+func (o *oracleFeed) Snapshots() []Snapshot {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+
+	snapshots := make([]Snapshot, 0, len(o.snapshots))
+	for _, snapshot := range o.snapshots {
+		copied := *snapshot
+		copied.Exchanges = append([]ExchangeQuote(nil), snapshot.Exchanges...)
+		snapshots = append(snapshots, copied)
+	}
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].Asset.Symbol() < snapshots[j].Asset.Symbol()
+	})
+	return snapshots
+}
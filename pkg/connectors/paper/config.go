@@ -0,0 +1,92 @@
+package paper
+
+import (
+	"fmt"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+const (
+	// DefaultStartingBalance is the simulated account equity when none is set
+	DefaultStartingBalance = 10000.0
+
+	// DefaultMakerFeeRate is charged on the notional of resting limit
+	// orders that fill (0.0002 = 2 bps)
+	DefaultMakerFeeRate = 0.0002
+
+	// DefaultTakerFeeRate is charged on the notional of market orders and
+	// of limit orders that cross the book on arrival (0.0005 = 5 bps)
+	DefaultTakerFeeRate = 0.0005
+
+	// DefaultBookDepth is how many levels are walked when simulating a fill
+	DefaultBookDepth = 50
+)
+
+// Config selects paper execution for a run. It wraps the live connector's
+// config: market data still comes from the exchange, orders never reach it.
+type Config struct {
+	Live            connector.Config
+	StartingBalance numerical.Decimal
+	MakerFeeRate    float64
+	TakerFeeRate    float64
+	BookDepth       int
+
+	// SimulateLatency delays each simulated order and cancel by a latency
+	// drawn from the live exchange's recorded ack latencies, so fills see
+	// the book as it is after the round trip. Orders are acknowledged at
+	// once and fill, or are rejected, once the time provider reaches their
+	// arrival; a canceled order can still fill until its cancel arrives.
+	SimulateLatency bool
+}
+
+// ExchangeName reports the wrapped exchange so the run binds to the same connector
+func (c *Config) ExchangeName() connector.ExchangeName {
+	if c.Live == nil {
+		return ""
+	}
+	return c.Live.ExchangeName()
+}
+
+// SecretFields are the wrapped config's, since market data still needs its credentials
+func (c *Config) SecretFields() map[string]*string {
+	if secrets, ok := c.Live.(types.SecretConfig); ok {
+		return secrets.SecretFields()
+	}
+	return nil
+}
+
+// UsesTestnet is always true: paper orders never reach the exchange
+func (c *Config) UsesTestnet() bool {
+	return true
+}
+
+func (c *Config) Validate() error {
+	if c.Live == nil {
+		return fmt.Errorf("paper config requires a live connector config")
+	}
+	if err := c.Live.Validate(); err != nil {
+		return fmt.Errorf("invalid live config: %w", err)
+	}
+
+	if c.StartingBalance.IsZero() {
+		c.StartingBalance = numerical.NewFromFloat(DefaultStartingBalance)
+	}
+	if c.StartingBalance.IsNegative() {
+		return fmt.Errorf("starting balance must not be negative")
+	}
+	if c.MakerFeeRate == 0 {
+		c.MakerFeeRate = DefaultMakerFeeRate
+	}
+	if c.TakerFeeRate == 0 {
+		c.TakerFeeRate = DefaultTakerFeeRate
+	}
+	if c.MakerFeeRate < 0 || c.TakerFeeRate < 0 {
+		return fmt.Errorf("fee rates must not be negative")
+	}
+	if c.BookDepth <= 0 {
+		c.BookDepth = DefaultBookDepth
+	}
+	return nil
+}
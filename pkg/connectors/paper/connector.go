@@ -0,0 +1,362 @@
+package paper
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/latency"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+// paperConnector routes market data to a live connector and simulates
+// execution against its order book. Resting limit orders are matched lazily,
+// whenever orders or account state are queried or a new order is placed.
+type paperConnector struct {
+	live         connector.Connector
+	config       *Config
+	latency      latency.Recorder
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+	initialized  bool
+
+	currency  string
+	cash      numerical.Decimal
+	positions map[string]*position
+	orders    map[string]*connector.Order
+	trades    []connector.Trade
+	orderSeq  int64
+
+	// order ID -> when a placement or cancel in flight reaches the exchange
+	// under simulated latency
+	arrivals map[string]time.Time
+	cancels  map[string]time.Time
+
+	// symbol -> asset, resolved from the live connector's perpetual listing
+	assets map[string]portfolio.Asset
+
+	positionCh chan connector.Position
+	balanceCh  chan connector.AccountBalance
+
+	mu sync.Mutex
+}
+
+var (
+	_ connector.Connector = (*paperConnector)(nil)
+	_ latency.Simulated   = (*paperConnector)(nil)
+)
+
+// NewPaperConnector wraps a live connector for paper execution. The result
+// also implements connector.WebSocketConnector when the live connector does.
+func NewPaperConnector(
+	live connector.Connector,
+	latencyRecorder latency.Recorder,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) connector.Connector {
+	p := &paperConnector{
+		live:         live,
+		latency:      latencyRecorder,
+		timeProvider: timeProvider,
+		logger:       logger,
+		positions:    make(map[string]*position),
+		orders:       make(map[string]*connector.Order),
+		arrivals:     make(map[string]time.Time),
+		cancels:      make(map[string]time.Time),
+		assets:       make(map[string]portfolio.Asset),
+		positionCh:   make(chan connector.Position, 100),
+		balanceCh:    make(chan connector.AccountBalance, 100),
+	}
+
+	if ws, ok := live.(connector.WebSocketConnector); ok {
+		return &paperWSConnector{paperConnector: p, ws: ws}
+	}
+	return p
+}
+
+func (p *paperConnector) Initialize(config connector.Config) error {
+	if p.initialized {
+		return fmt.Errorf("connector already initialized")
+	}
+
+	paperConfig, ok := config.(*Config)
+	if !ok {
+		return fmt.Errorf("invalid config type for paper connector: expected *paper.Config, got %T", config)
+	}
+
+	if err := paperConfig.Validate(); err != nil {
+		return fmt.Errorf("invalid paper config: %w", err)
+	}
+
+	if !p.live.IsInitialized() {
+		if err := p.live.Initialize(paperConfig.Live); err != nil {
+			return fmt.Errorf("failed to initialize live connector: %w", err)
+		}
+	}
+
+	p.currency = "USD"
+	if info := p.live.GetConnectorInfo(); info != nil && info.QuoteCurrency != "" {
+		p.currency = info.QuoteCurrency
+	}
+
+	p.config = paperConfig
+	p.cash = paperConfig.StartingBalance
+	p.initialized = true
+
+	p.logger.Info("📝 Paper execution enabled for %s (balance %s %s)",
+		paperConfig.ExchangeName(), p.cash.String(), p.currency)
+	return nil
+}
+
+func (p *paperConnector) IsInitialized() bool {
+	return p.initialized
+}
+
+func (p *paperConnector) GetConnectorInfo() *connector.Info {
+	info := p.live.GetConnectorInfo()
+	if info == nil {
+		return nil
+	}
+
+	paperInfo := *info
+	paperInfo.TradingEnabled = true
+	paperInfo.SupportedOrderTypes = []connector.OrderType{
+		connector.OrderTypeLimit,
+		connector.OrderTypeMarket,
+	}
+	return &paperInfo
+}
+
+// SimulatesExecution keeps paper round trips out of the live latency distribution
+func (p *paperConnector) SimulatesExecution() bool {
+	return true
+}
+
+func (p *paperConnector) SupportsTradingOperations() bool {
+	return true
+}
+
+func (p *paperConnector) PlaceLimitOrder(symbol string, side connector.OrderSide, quantity, price numerical.Decimal) (*connector.OrderResponse, error) {
+	return p.placeOrder(symbol, side, connector.OrderTypeLimit, quantity, &price)
+}
+
+func (p *paperConnector) PlaceMarketOrder(symbol string, side connector.OrderSide, quantity numerical.Decimal) (*connector.OrderResponse, error) {
+	return p.placeOrder(symbol, side, connector.OrderTypeMarket, quantity, nil)
+}
+
+func (p *paperConnector) CancelOrder(symbol, orderID string) (*connector.CancelResponse, error) {
+	if !p.initialized {
+		return nil, fmt.Errorf("connector not initialized")
+	}
+
+	delay := p.sampleLatency(latency.OpCancelOrder)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	order, exists := p.orders[orderID]
+	if !exists || order.Symbol != symbol {
+		return nil, fmt.Errorf("order %s not found for %s", orderID, symbol)
+	}
+	if !isOpen(order.Status) {
+		return nil, fmt.Errorf("order %s is %s and cannot be canceled", orderID, order.Status)
+	}
+
+	now := p.timeProvider.Now()
+
+	// The order keeps working while the cancel is in flight; matchResting
+	// applies it once the time provider reaches its arrival
+	if delay > 0 {
+		p.cancels[orderID] = now.Add(delay)
+	} else {
+		delete(p.arrivals, orderID)
+		order.Status = connector.OrderStatusCanceled
+		order.UpdatedAt = now
+	}
+
+	return &connector.CancelResponse{
+		OrderID:   orderID,
+		Symbol:    symbol,
+		Status:    connector.OrderStatusCanceled,
+		Timestamp: now,
+	}, nil
+}
+
+func (p *paperConnector) GetOpenOrders() ([]connector.Order, error) {
+	if !p.initialized {
+		return nil, fmt.Errorf("connector not initialized")
+	}
+
+	p.matchResting()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var open []connector.Order
+	for _, order := range p.orders {
+		if isOpen(order.Status) {
+			open = append(open, *order)
+		}
+	}
+
+	sort.Slice(open, func(i, j int) bool {
+		return open[i].CreatedAt.Before(open[j].CreatedAt)
+	})
+	return open, nil
+}
+
+func (p *paperConnector) GetOrderStatus(orderID string) (*connector.Order, error) {
+	if !p.initialized {
+		return nil, fmt.Errorf("connector not initialized")
+	}
+
+	p.matchResting()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	order, exists := p.orders[orderID]
+	if !exists {
+		return nil, fmt.Errorf("order %s not found", orderID)
+	}
+
+	result := *order
+	return &result, nil
+}
+
+func (p *paperConnector) GetAccountBalance() (*connector.AccountBalance, error) {
+	if !p.initialized {
+		return nil, fmt.Errorf("connector not initialized")
+	}
+
+	p.matchResting()
+	marks := p.fetchMarks()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.balanceLocked(marks), nil
+}
+
+func (p *paperConnector) GetPositions() ([]connector.Position, error) {
+	if !p.initialized {
+		return nil, fmt.Errorf("connector not initialized")
+	}
+
+	p.matchResting()
+	marks := p.fetchMarks()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var positions []connector.Position
+	for symbol, pos := range p.positions {
+		if pos.quantity.IsZero() {
+			continue
+		}
+		positions = append(positions, p.positionLocked(pos, marks[symbol]))
+	}
+	return positions, nil
+}
+
+func (p *paperConnector) GetTradingHistory(symbol string, limit int) ([]connector.Trade, error) {
+	if !p.initialized {
+		return nil, fmt.Errorf("connector not initialized")
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var trades []connector.Trade
+	for i := len(p.trades) - 1; i >= 0; i-- {
+		if p.trades[i].Symbol != symbol {
+			continue
+		}
+		trades = append(trades, p.trades[i])
+		if limit > 0 && len(trades) == limit {
+			break
+		}
+	}
+	return trades, nil
+}
+
+// Market data and metadata come straight from the live connector
+
+func (p *paperConnector) FetchRiskFundBalance(symbol string) (*connector.RiskFundBalance, error) {
+	return p.live.FetchRiskFundBalance(symbol)
+}
+
+func (p *paperConnector) FetchContracts() ([]connector.ContractInfo, error) {
+	return p.live.FetchContracts()
+}
+
+func (p *paperConnector) FetchPrice(symbol string) (*connector.Price, error) {
+	return p.live.FetchPrice(symbol)
+}
+
+func (p *paperConnector) FetchKlines(symbol, interval string, limit int) ([]connector.Kline, error) {
+	return p.live.FetchKlines(symbol, interval, limit)
+}
+
+func (p *paperConnector) FetchOrderBook(asset portfolio.Asset, instrumentType connector.Instrument, depth int) (*connector.OrderBook, error) {
+	return p.live.FetchOrderBook(asset, instrumentType, depth)
+}
+
+func (p *paperConnector) FetchRecentTrades(symbol string, limit int) ([]connector.Trade, error) {
+	return p.live.FetchRecentTrades(symbol, limit)
+}
+
+func (p *paperConnector) FetchCurrentFundingRates() (map[portfolio.Asset]connector.FundingRate, error) {
+	return p.live.FetchCurrentFundingRates()
+}
+
+func (p *paperConnector) FetchFundingRate(asset portfolio.Asset) (*connector.FundingRate, error) {
+	return p.live.FetchFundingRate(asset)
+}
+
+// FetchMarkPrice passes through to the live connector so paper positions
+// are marked the same way as live ones
+func (p *paperConnector) FetchMarkPrice(asset portfolio.Asset) (*types.MarkPrice, error) {
+	provider, ok := p.live.(types.MarkPriceProvider)
+	if !ok {
+		return nil, fmt.Errorf("live connector does not provide mark prices")
+	}
+	return provider.FetchMarkPrice(asset)
+}
+
+func (p *paperConnector) FetchHistoricalFundingRates(asset portfolio.Asset, startTime, endTime int64) ([]connector.HistoricalFundingRate, error) {
+	return p.live.FetchHistoricalFundingRates(asset, startTime, endTime)
+}
+
+func (p *paperConnector) FetchAvailableSpotAssets() ([]portfolio.Asset, error) {
+	return p.live.FetchAvailableSpotAssets()
+}
+
+func (p *paperConnector) FetchAvailablePerpetualAssets() ([]portfolio.Asset, error) {
+	return p.live.FetchAvailablePerpetualAssets()
+}
+
+func (p *paperConnector) GetPerpSymbol(asset portfolio.Asset) string {
+	return p.live.GetPerpSymbol(asset)
+}
+
+func (p *paperConnector) SupportsRealTimeData() bool {
+	return p.live.SupportsRealTimeData()
+}
+
+func (p *paperConnector) SupportsFundingRates() bool {
+	return p.live.SupportsFundingRates()
+}
+
+func (p *paperConnector) SupportsPerpetuals() bool {
+	return p.live.SupportsPerpetuals()
+}
+
+func (p *paperConnector) SupportsSpot() bool {
+	return p.live.SupportsSpot()
+}
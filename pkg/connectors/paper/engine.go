@@ -0,0 +1,104 @@
+package paper
+
+import (
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+)
+
+// position is a simulated net position; quantity is signed, negative for short
+type position struct {
+	asset      portfolio.Asset
+	quantity   numerical.Decimal
+	entryPrice numerical.Decimal
+	realized   numerical.Decimal
+}
+
+// walkBook fills quantity against the opposite side of the book, best level
+// first, stopping at limit when one is given. It returns the filled quantity
+// and its volume-weighted average price.
+func walkBook(book *connector.OrderBook, side connector.OrderSide, quantity numerical.Decimal, limit *numerical.Decimal) (numerical.Decimal, numerical.Decimal) {
+	levels := book.Asks
+	if side == connector.OrderSideSell {
+		levels = book.Bids
+	}
+
+	filled := numerical.Zero()
+	notional := numerical.Zero()
+
+	for _, level := range levels {
+		if limit != nil {
+			if side == connector.OrderSideBuy && level.Price.GreaterThan(*limit) {
+				break
+			}
+			if side == connector.OrderSideSell && level.Price.LessThan(*limit) {
+				break
+			}
+		}
+
+		take := quantity.Sub(filled)
+		if level.Quantity.LessThan(take) {
+			take = level.Quantity
+		}
+
+		filled = filled.Add(take)
+		notional = notional.Add(take.Mul(level.Price))
+
+		if !filled.LessThan(quantity) {
+			break
+		}
+	}
+
+	if filled.IsZero() {
+		return filled, numerical.Zero()
+	}
+	return filled, notional.Div(filled)
+}
+
+// apply books a fill into the position and returns the realized PnL it produced
+func (p *position) apply(side connector.OrderSide, quantity, price numerical.Decimal) numerical.Decimal {
+	delta := quantity
+	if side == connector.OrderSideSell {
+		delta = quantity.Neg()
+	}
+
+	realized := numerical.Zero()
+
+	switch {
+	case p.quantity.IsZero() || p.quantity.IsNegative() == delta.IsNegative():
+		// Opening or adding: average the entry
+		total := p.quantity.Abs().Add(delta.Abs())
+		p.entryPrice = p.quantity.Abs().Mul(p.entryPrice).Add(delta.Abs().Mul(price)).Div(total)
+		p.quantity = p.quantity.Add(delta)
+
+	default:
+		// Reducing, closing or flipping
+		closing := delta.Abs()
+		if p.quantity.Abs().LessThan(closing) {
+			closing = p.quantity.Abs()
+		}
+
+		realized = price.Sub(p.entryPrice).Mul(closing)
+		if p.quantity.IsNegative() {
+			realized = realized.Neg()
+		}
+
+		previous := p.quantity
+		p.quantity = p.quantity.Add(delta)
+
+		switch {
+		case p.quantity.IsZero():
+			p.entryPrice = numerical.Zero()
+		case p.quantity.IsNegative() != previous.IsNegative():
+			p.entryPrice = price
+		}
+	}
+
+	p.realized = p.realized.Add(realized)
+	return realized
+}
+
+// unrealized marks the position against the given price
+func (p *position) unrealized(mark numerical.Decimal) numerical.Decimal {
+	return mark.Sub(p.entryPrice).Mul(p.quantity)
+}
@@ -0,0 +1,393 @@
+package paper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/live-trading/pkg/connectors/latency"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+func (p *paperConnector) placeOrder(symbol string, side connector.OrderSide, orderType connector.OrderType, quantity numerical.Decimal, limit *numerical.Decimal) (*connector.OrderResponse, error) {
+	if !p.initialized {
+		return nil, fmt.Errorf("connector not initialized")
+	}
+	if !side.IsValid() {
+		return nil, fmt.Errorf("invalid order side %q", side)
+	}
+	if !quantity.IsPositive() {
+		return nil, fmt.Errorf("order quantity must be positive")
+	}
+
+	asset, err := p.resolveAsset(symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	// Without simulated latency the order meets the current book at once;
+	// otherwise it reaches the matching engine a sampled round trip from
+	// now and fills once the time provider passes that point
+	delay := p.sampleLatency(latency.OpPlaceOrder)
+
+	var book *connector.OrderBook
+	if delay <= 0 {
+		book, err = p.live.FetchOrderBook(asset, connector.TypePerpetual, p.config.BookDepth)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch order book for simulated fill: %w", err)
+		}
+	}
+
+	p.matchResting()
+
+	now := p.timeProvider.Now()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.orderSeq++
+	order := &connector.Order{
+		ID:           fmt.Sprintf("paper-%d", p.orderSeq),
+		Symbol:       symbol,
+		Side:         side,
+		Type:         orderType,
+		Status:       connector.OrderStatusOpen,
+		Quantity:     quantity,
+		FilledQty:    numerical.Zero(),
+		RemainingQty: quantity,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	if limit != nil {
+		order.Price = *limit
+	}
+
+	p.orders[order.ID] = order
+	if book != nil {
+		p.arriveLocked(order, asset, book)
+	} else {
+		p.arrivals[order.ID] = now.Add(delay)
+	}
+
+	if order.Status == connector.OrderStatusRejected {
+		return nil, fmt.Errorf("paper market order for %s rejected: no liquidity on the %s side", symbol, side)
+	}
+
+	return &connector.OrderResponse{
+		OrderID:   order.ID,
+		Symbol:    symbol,
+		Status:    order.Status,
+		Side:      side,
+		Type:      orderType,
+		Quantity:  quantity,
+		Price:     order.Price,
+		FilledQty: order.FilledQty,
+		AvgPrice:  order.AvgPrice,
+		Timestamp: now,
+	}, nil
+}
+
+// sampleLatency draws a delay from the live exchange's recorded latency
+// distribution; it is zero when latency is not simulated or nothing has
+// been recorded yet
+func (p *paperConnector) sampleLatency(op latency.Operation) time.Duration {
+	if !p.config.SimulateLatency || p.latency == nil {
+		return 0
+	}
+
+	delay, ok := p.latency.Sample(p.config.ExchangeName(), op)
+	if !ok || delay <= 0 {
+		return 0
+	}
+	return delay
+}
+
+// matchResting re-checks open orders against fresh books. Orders whose
+// simulated arrival has come meet the book as takers, resting limit orders
+// fill as makers, and cancels in flight take effect once they arrive.
+func (p *paperConnector) matchResting() {
+	p.mu.Lock()
+	symbols := make(map[string]bool)
+	for _, order := range p.orders {
+		if isOpen(order.Status) {
+			symbols[order.Symbol] = true
+		}
+	}
+	p.mu.Unlock()
+
+	for symbol := range symbols {
+		asset, err := p.resolveAsset(symbol)
+		if err != nil {
+			continue
+		}
+
+		book, err := p.live.FetchOrderBook(asset, connector.TypePerpetual, p.config.BookDepth)
+		if err != nil {
+			p.logger.Warn("Paper: failed to fetch %s book for resting orders: %v", symbol, err)
+			continue
+		}
+
+		p.mu.Lock()
+		now := p.timeProvider.Now()
+		for _, order := range p.orders {
+			if order.Symbol != symbol || !isOpen(order.Status) {
+				continue
+			}
+
+			if arrival, pending := p.arrivals[order.ID]; pending {
+				if arrival.After(now) {
+					continue
+				}
+				p.arriveLocked(order, asset, book)
+				continue
+			}
+			p.fillLocked(order, asset, book, true)
+		}
+		p.mu.Unlock()
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.applyCancelsLocked(p.timeProvider.Now())
+}
+
+// arriveLocked matches an order the moment it reaches the book: whatever
+// crosses is taker flow, and a market order's unfilled remainder is dropped.
+// Caller must hold p.mu.
+func (p *paperConnector) arriveLocked(order *connector.Order, asset portfolio.Asset, book *connector.OrderBook) {
+	delete(p.arrivals, order.ID)
+
+	p.fillLocked(order, asset, book, false)
+
+	if order.Type != connector.OrderTypeMarket {
+		return
+	}
+
+	switch {
+	case order.FilledQty.IsZero():
+		order.Status = connector.OrderStatusRejected
+		order.UpdatedAt = p.timeProvider.Now()
+	case order.RemainingQty.IsPositive():
+		// Market orders never rest; the unfilled remainder is dropped
+		order.Status = connector.OrderStatusCanceled
+		order.UpdatedAt = p.timeProvider.Now()
+	}
+}
+
+// applyCancelsLocked cancels the orders whose cancel request has arrived by
+// now; caller must hold p.mu
+func (p *paperConnector) applyCancelsLocked(now time.Time) {
+	for orderID, at := range p.cancels {
+		if at.After(now) {
+			continue
+		}
+		delete(p.cancels, orderID)
+
+		order, exists := p.orders[orderID]
+		if !exists || !isOpen(order.Status) {
+			continue
+		}
+		delete(p.arrivals, orderID)
+		order.Status = connector.OrderStatusCanceled
+		order.UpdatedAt = now
+	}
+}
+
+// fillLocked fills as much of the order as the book allows, charging the
+// maker rate when the order was resting and the taker rate when it crossed
+// on arrival; caller must hold p.mu
+func (p *paperConnector) fillLocked(order *connector.Order, asset portfolio.Asset, book *connector.OrderBook, maker bool) {
+	var limit *numerical.Decimal
+	if order.Type == connector.OrderTypeLimit {
+		limit = &order.Price
+	}
+
+	filled, avgPrice := walkBook(book, order.Side, order.RemainingQty, limit)
+	if filled.IsZero() {
+		return
+	}
+
+	now := p.timeProvider.Now()
+	feeRate, liquidity := p.config.TakerFeeRate, "taker"
+	if maker {
+		feeRate, liquidity = p.config.MakerFeeRate, "maker"
+	}
+	fee := filled.Mul(avgPrice).Mul(numerical.NewFromFloat(feeRate))
+
+	pos, exists := p.positions[order.Symbol]
+	if !exists {
+		pos = &position{asset: asset, quantity: numerical.Zero(), entryPrice: numerical.Zero(), realized: numerical.Zero()}
+		p.positions[order.Symbol] = pos
+	}
+
+	realized := pos.apply(order.Side, filled, avgPrice)
+	p.cash = p.cash.Add(realized).Sub(fee)
+
+	previousFilled := order.FilledQty
+	order.FilledQty = order.FilledQty.Add(filled)
+	order.RemainingQty = order.Quantity.Sub(order.FilledQty)
+	order.AvgPrice = previousFilled.Mul(order.AvgPrice).Add(filled.Mul(avgPrice)).Div(order.FilledQty)
+	order.UpdatedAt = now
+
+	if order.RemainingQty.IsPositive() {
+		order.Status = connector.OrderStatusPartiallyFilled
+	} else {
+		order.Status = connector.OrderStatusFilled
+	}
+
+	p.trades = append(p.trades, connector.Trade{
+		ID:        fmt.Sprintf("%s-%d", order.ID, len(p.trades)+1),
+		OrderID:   order.ID,
+		Symbol:    order.Symbol,
+		Exchange:  p.config.ExchangeName(),
+		Price:     avgPrice,
+		Quantity:  filled,
+		Side:      order.Side,
+		IsMaker:   maker,
+		Fee:       fee,
+		Timestamp: now,
+	})
+
+	p.logger.Info("📝 Paper fill %s %s %s @ %s (order %s, %s fee %s)",
+		order.Side, filled.String(), order.Symbol, avgPrice.String(), order.ID, liquidity, fee.String())
+
+	p.publishLocked(order.Symbol, pos, avgPrice)
+}
+
+// publishLocked emits position and balance updates after a fill; caller must hold p.mu
+func (p *paperConnector) publishLocked(symbol string, pos *position, mark numerical.Decimal) {
+	select {
+	case p.positionCh <- p.positionLocked(pos, mark):
+	default:
+	}
+
+	marks := make(map[string]numerical.Decimal, len(p.positions))
+	for symbol, other := range p.positions {
+		marks[symbol] = other.entryPrice
+	}
+	marks[symbol] = mark
+
+	select {
+	case p.balanceCh <- *p.balanceLocked(marks):
+	default:
+	}
+}
+
+// fetchMarks gets a mark price for every symbol with an open position,
+// preferring the exchange mark over the last trade where it is available
+func (p *paperConnector) fetchMarks() map[string]numerical.Decimal {
+	p.mu.Lock()
+	open := make(map[string]portfolio.Asset, len(p.positions))
+	for symbol, pos := range p.positions {
+		if !pos.quantity.IsZero() {
+			open[symbol] = pos.asset
+		}
+	}
+	p.mu.Unlock()
+
+	provider, hasMarks := p.live.(types.MarkPriceProvider)
+
+	marks := make(map[string]numerical.Decimal, len(open))
+	for symbol, asset := range open {
+		if hasMarks {
+			if mark, err := provider.FetchMarkPrice(asset); err == nil && mark.MarkPrice.IsPositive() {
+				marks[symbol] = mark.MarkPrice
+				continue
+			}
+		}
+
+		price, err := p.live.FetchPrice(symbol)
+		if err != nil || price == nil {
+			continue
+		}
+		marks[symbol] = price.Price
+	}
+	return marks
+}
+
+// balanceLocked values the account; positions without a mark use their entry price
+func (p *paperConnector) balanceLocked(marks map[string]numerical.Decimal) *connector.AccountBalance {
+	unrealized := numerical.Zero()
+	usedMargin := numerical.Zero()
+
+	for symbol, pos := range p.positions {
+		if pos.quantity.IsZero() {
+			continue
+		}
+
+		mark, ok := marks[symbol]
+		if !ok {
+			mark = pos.entryPrice
+		}
+
+		unrealized = unrealized.Add(pos.unrealized(mark))
+		usedMargin = usedMargin.Add(pos.quantity.Abs().Mul(pos.entryPrice))
+	}
+
+	total := p.cash.Add(unrealized)
+	return &connector.AccountBalance{
+		TotalBalance:     total,
+		AvailableBalance: total.Sub(usedMargin),
+		UsedMargin:       usedMargin,
+		UnrealizedPnL:    unrealized,
+		Currency:         p.currency,
+		UpdatedAt:        p.timeProvider.Now(),
+	}
+}
+
+func (p *paperConnector) positionLocked(pos *position, mark numerical.Decimal) connector.Position {
+	if mark.IsZero() {
+		mark = pos.entryPrice
+	}
+
+	side := connector.OrderSideBuy
+	if pos.quantity.IsNegative() {
+		side = connector.OrderSideSell
+	}
+
+	return connector.Position{
+		Symbol:        pos.asset,
+		Exchange:      p.config.ExchangeName(),
+		Side:          side,
+		Size:          pos.quantity.Abs(),
+		EntryPrice:    pos.entryPrice,
+		MarkPrice:     mark,
+		UnrealizedPnL: pos.unrealized(mark),
+		RealizedPnL:   pos.realized,
+		UpdatedAt:     p.timeProvider.Now(),
+	}
+}
+
+// resolveAsset maps an exchange symbol back to its asset via the live listing
+func (p *paperConnector) resolveAsset(symbol string) (portfolio.Asset, error) {
+	p.mu.Lock()
+	asset, ok := p.assets[symbol]
+	p.mu.Unlock()
+	if ok {
+		return asset, nil
+	}
+
+	assets, err := p.live.FetchAvailablePerpetualAssets()
+	if err != nil {
+		return portfolio.Asset{}, fmt.Errorf("failed to resolve %s: %w", symbol, err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, candidate := range assets {
+		p.assets[p.live.GetPerpSymbol(candidate)] = candidate
+	}
+
+	asset, ok = p.assets[symbol]
+	if !ok {
+		return portfolio.Asset{}, fmt.Errorf("unknown symbol %s", symbol)
+	}
+	return asset, nil
+}
+
+func isOpen(status connector.OrderStatus) bool {
+	return status == connector.OrderStatusOpen || status == connector.OrderStatusPartiallyFilled
+}
@@ -0,0 +1,212 @@
+package paper_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mockconnector "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	mocktemporal "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	logger "github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/live-trading/pkg/connectors/latency"
+	"github.com/backtesting-org/live-trading/pkg/connectors/paper"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+func level(price, quantity float64) connector.PriceLevel {
+	return connector.PriceLevel{Price: numerical.NewFromFloat(price), Quantity: numerical.NewFromFloat(quantity)}
+}
+
+func decimal(value float64) numerical.Decimal {
+	return numerical.NewFromFloat(value)
+}
+
+var _ = Describe("Paper execution", func() {
+	var (
+		live     *mockconnector.Connector
+		recorder latency.Recorder
+		conn     connector.Connector
+		config   *paper.Config
+
+		now  time.Time
+		book *connector.OrderBook
+	)
+
+	btc := portfolio.NewAsset("BTC")
+
+	BeforeEach(func() {
+		now = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		book = &connector.OrderBook{
+			Asset: btc,
+			Bids:  []connector.PriceLevel{level(99, 1), level(98, 2)},
+			Asks:  []connector.PriceLevel{level(100, 1), level(101, 2)},
+		}
+
+		liveConfig := mockconnector.NewConfig(GinkgoT())
+		liveConfig.On("Validate").Return(nil).Maybe()
+		liveConfig.On("ExchangeName").Return(types.Binance).Maybe()
+		config = &paper.Config{Live: liveConfig}
+
+		live = mockconnector.NewConnector(GinkgoT())
+		live.On("IsInitialized").Return(true).Maybe()
+		live.On("GetConnectorInfo").Return(&connector.Info{Name: types.Binance, QuoteCurrency: "USDT"}).Maybe()
+		live.On("FetchAvailablePerpetualAssets").Return([]portfolio.Asset{btc}, nil).Maybe()
+		live.On("GetPerpSymbol", btc).Return("BTCUSDT").Maybe()
+		live.On("FetchOrderBook", btc, connector.TypePerpetual, paper.DefaultBookDepth).
+			Return(func(portfolio.Asset, connector.Instrument, int) *connector.OrderBook { return book }, nil).Maybe()
+
+		mockTime := mocktemporal.NewTimeProvider(GinkgoT())
+		mockTime.On("Now").Return(func() time.Time { return now }).Maybe()
+
+		recorder = latency.NewRecorder()
+		conn = paper.NewPaperConnector(live, recorder, mockTime, logger.NewNoOpLogger())
+	})
+
+	initialize := func() {
+		Expect(conn.Initialize(config)).To(Succeed())
+	}
+
+	lastTrade := func() connector.Trade {
+		trades, err := conn.GetTradingHistory("BTCUSDT", 1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(trades).To(HaveLen(1))
+		return trades[0]
+	}
+
+	Describe("fills", func() {
+		BeforeEach(initialize)
+
+		It("walks the book for a market order and charges the taker rate", func() {
+			response, err := conn.PlaceMarketOrder("BTCUSDT", connector.OrderSideBuy, decimal(2))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(response.Status).To(Equal(connector.OrderStatusFilled))
+			Expect(response.FilledQty.Equal(decimal(2))).To(BeTrue())
+			Expect(response.AvgPrice.Equal(decimal(100.5))).To(BeTrue())
+
+			trade := lastTrade()
+			Expect(trade.IsMaker).To(BeFalse())
+			Expect(trade.Fee.Equal(decimal(201 * paper.DefaultTakerFeeRate))).To(BeTrue())
+		})
+
+		It("drops the unfilled remainder of a market order", func() {
+			response, err := conn.PlaceMarketOrder("BTCUSDT", connector.OrderSideSell, decimal(5))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(response.Status).To(Equal(connector.OrderStatusCanceled))
+			Expect(response.FilledQty.Equal(decimal(3))).To(BeTrue())
+		})
+
+		It("rejects a market order with no liquidity on its side", func() {
+			book.Asks = nil
+
+			_, err := conn.PlaceMarketOrder("BTCUSDT", connector.OrderSideBuy, decimal(1))
+			Expect(err).To(MatchError(ContainSubstring("no liquidity on the BUY side")))
+		})
+
+		It("charges the taker rate for a limit order that crosses on arrival", func() {
+			response, err := conn.PlaceLimitOrder("BTCUSDT", connector.OrderSideBuy, decimal(1), decimal(101))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(response.Status).To(Equal(connector.OrderStatusFilled))
+			Expect(lastTrade().IsMaker).To(BeFalse())
+		})
+
+		It("charges the maker rate once a resting limit order fills", func() {
+			response, err := conn.PlaceLimitOrder("BTCUSDT", connector.OrderSideBuy, decimal(1), decimal(99.5))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Status).To(Equal(connector.OrderStatusOpen))
+
+			book.Asks = []connector.PriceLevel{level(99.5, 0.4)}
+
+			order, err := conn.GetOrderStatus(response.OrderID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(order.Status).To(Equal(connector.OrderStatusPartiallyFilled))
+			Expect(order.FilledQty.Equal(decimal(0.4))).To(BeTrue())
+
+			trade := lastTrade()
+			Expect(trade.IsMaker).To(BeTrue())
+			Expect(trade.Fee.Equal(decimal(0.4 * 99.5 * paper.DefaultMakerFeeRate))).To(BeTrue())
+		})
+
+		It("cancels a resting order at once without simulated latency", func() {
+			response, err := conn.PlaceLimitOrder("BTCUSDT", connector.OrderSideBuy, decimal(1), decimal(90))
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = conn.CancelOrder("BTCUSDT", response.OrderID)
+			Expect(err).NotTo(HaveOccurred())
+
+			order, err := conn.GetOrderStatus(response.OrderID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(order.Status).To(Equal(connector.OrderStatusCanceled))
+		})
+	})
+
+	Describe("simulated latency", func() {
+		BeforeEach(func() {
+			config.SimulateLatency = true
+			recorder.Observe(types.Binance, latency.OpPlaceOrder, 200*time.Millisecond)
+			recorder.Observe(types.Binance, latency.OpCancelOrder, 200*time.Millisecond)
+			initialize()
+		})
+
+		It("acknowledges at once and fills against the book on arrival", func() {
+			response, err := conn.PlaceMarketOrder("BTCUSDT", connector.OrderSideBuy, decimal(1))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Status).To(Equal(connector.OrderStatusOpen))
+			Expect(response.FilledQty.IsZero()).To(BeTrue())
+
+			now = now.Add(100 * time.Millisecond)
+			order, err := conn.GetOrderStatus(response.OrderID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(order.Status).To(Equal(connector.OrderStatusOpen))
+
+			// The book the order meets is the one when it arrives
+			book.Asks = []connector.PriceLevel{level(105, 1)}
+			now = now.Add(100 * time.Millisecond)
+			order, err = conn.GetOrderStatus(response.OrderID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(order.Status).To(Equal(connector.OrderStatusFilled))
+			Expect(order.AvgPrice.Equal(decimal(105))).To(BeTrue())
+			Expect(lastTrade().IsMaker).To(BeFalse())
+		})
+
+		It("rejects a market order on arrival when the book is empty", func() {
+			book.Asks = nil
+
+			response, err := conn.PlaceMarketOrder("BTCUSDT", connector.OrderSideBuy, decimal(1))
+			Expect(err).NotTo(HaveOccurred())
+
+			now = now.Add(200 * time.Millisecond)
+			order, err := conn.GetOrderStatus(response.OrderID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(order.Status).To(Equal(connector.OrderStatusRejected))
+		})
+
+		It("keeps filling an order until its cancel arrives", func() {
+			response, err := conn.PlaceLimitOrder("BTCUSDT", connector.OrderSideBuy, decimal(1), decimal(99.5))
+			Expect(err).NotTo(HaveOccurred())
+
+			now = now.Add(200 * time.Millisecond)
+			_, err = conn.CancelOrder("BTCUSDT", response.OrderID)
+			Expect(err).NotTo(HaveOccurred())
+
+			book.Asks = []connector.PriceLevel{level(99.5, 0.4)}
+			now = now.Add(100 * time.Millisecond)
+			order, err := conn.GetOrderStatus(response.OrderID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(order.Status).To(Equal(connector.OrderStatusPartiallyFilled))
+
+			book.Asks = []connector.PriceLevel{level(101, 1)}
+			now = now.Add(100 * time.Millisecond)
+			order, err = conn.GetOrderStatus(response.OrderID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(order.Status).To(Equal(connector.OrderStatusCanceled))
+			Expect(order.FilledQty.Equal(decimal(0.4))).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,103 @@
+package paper
+
+import (
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+// paperWSConnector streams market data from the live connector while
+// position and balance updates come from the simulated account
+type paperWSConnector struct {
+	*paperConnector
+	ws connector.WebSocketConnector
+}
+
+var _ connector.WebSocketConnector = (*paperWSConnector)(nil)
+
+func (p *paperWSConnector) StartWebSocket() error {
+	return p.ws.StartWebSocket()
+}
+
+func (p *paperWSConnector) StopWebSocket() error {
+	return p.ws.StopWebSocket()
+}
+
+func (p *paperWSConnector) IsWebSocketConnected() bool {
+	return p.ws.IsWebSocketConnected()
+}
+
+func (p *paperWSConnector) SubscribeOrderBook(asset portfolio.Asset, instrumentType connector.Instrument) error {
+	return p.ws.SubscribeOrderBook(asset, instrumentType)
+}
+
+func (p *paperWSConnector) SubscribeTrades(asset portfolio.Asset, instrumentType connector.Instrument) error {
+	return p.ws.SubscribeTrades(asset, instrumentType)
+}
+
+// SubscribePositions is a no-op: simulated positions are always published
+func (p *paperWSConnector) SubscribePositions(_ portfolio.Asset, _ connector.Instrument) error {
+	return nil
+}
+
+// SubscribeAccountBalance is a no-op: the simulated balance is always published
+func (p *paperWSConnector) SubscribeAccountBalance() error {
+	return nil
+}
+
+func (p *paperWSConnector) SubscribeKlines(asset portfolio.Asset, interval string) error {
+	return p.ws.SubscribeKlines(asset, interval)
+}
+
+func (p *paperWSConnector) UnsubscribeKlines(asset portfolio.Asset, interval string) error {
+	return p.ws.UnsubscribeKlines(asset, interval)
+}
+
+func (p *paperWSConnector) UnsubscribeTrades(asset portfolio.Asset, instrumentType connector.Instrument) error {
+	return p.ws.UnsubscribeTrades(asset, instrumentType)
+}
+
+func (p *paperWSConnector) UnsubscribeOrderBook(asset portfolio.Asset, instrumentType connector.Instrument) error {
+	return p.ws.UnsubscribeOrderBook(asset, instrumentType)
+}
+
+func (p *paperWSConnector) UnsubscribePositions(_ portfolio.Asset, _ connector.Instrument) error {
+	return nil
+}
+
+func (p *paperWSConnector) UnsubscribeAccountBalance() error {
+	return nil
+}
+
+func (p *paperWSConnector) GetOrderBookChannels() map[string]<-chan connector.OrderBook {
+	return p.ws.GetOrderBookChannels()
+}
+
+func (p *paperWSConnector) GetKlineChannels() map[string]<-chan connector.Kline {
+	return p.ws.GetKlineChannels()
+}
+
+// TradeUpdates carries public market trades from the live stream
+func (p *paperWSConnector) TradeUpdates() <-chan connector.Trade {
+	return p.ws.TradeUpdates()
+}
+
+func (p *paperWSConnector) PositionUpdates() <-chan connector.Position {
+	return p.positionCh
+}
+
+func (p *paperWSConnector) AccountBalanceUpdates() <-chan connector.AccountBalance {
+	return p.balanceCh
+}
+
+func (p *paperWSConnector) ErrorChannel() <-chan error {
+	return p.ws.ErrorChannel()
+}
+
+// GetWebSocketMetrics reports the live connector's stream statistics
+func (p *paperWSConnector) GetWebSocketMetrics() map[string]interface{} {
+	if provider, ok := p.ws.(types.WebSocketMetricsProvider); ok {
+		return provider.GetWebSocketMetrics()
+	}
+	return map[string]interface{}{}
+}
@@ -7,9 +7,13 @@ import (
 	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
 )
 
 func (p *paradex) GetAccountBalance() (*connector.AccountBalance, error) {
+	if err := p.limiter.Wait(ratelimit.EndpointAccount); err != nil {
+		return nil, err
+	}
 	account, err := p.paradexService.GetAccount(p.ctx)
 	if err != nil {
 		return nil, err
@@ -45,6 +49,9 @@ func (p *paradex) GetAccountBalance() (*connector.AccountBalance, error) {
 }
 
 func (p *paradex) GetPositions() ([]connector.Position, error) {
+	if err := p.limiter.Wait(ratelimit.EndpointAccount); err != nil {
+		return nil, err
+	}
 	positionsResp, err := p.paradexService.GetUserPositions(p.ctx) // returns *models.ResponsesGetPositionsResp
 	if err != nil {
 		return nil, err
@@ -2,13 +2,20 @@ package paradex
 
 import (
 	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
 )
 
 func (p *paradex) FetchAvailableSpotAssets() ([]portfolio.Asset, error) {
+	if err := p.limiter.Wait(ratelimit.EndpointInstruments); err != nil {
+		return nil, err
+	}
 	return []portfolio.Asset{}, nil
 }
 
 func (p *paradex) FetchAvailablePerpetualAssets() ([]portfolio.Asset, error) {
+	if err := p.limiter.Wait(ratelimit.EndpointInstruments); err != nil {
+		return nil, err
+	}
 	markets, err := p.paradexService.GetMarkets(p.ctx)
 	if err != nil {
 		return nil, err
@@ -1,8 +1,6 @@
 package paradex
 
 import (
-	"fmt"
-
 	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
@@ -24,7 +22,8 @@ func (p *paradex) GetConnectorInfo() *connector.Info {
 }
 
 func (p *paradex) GetPerpSymbol(symbol portfolio.Asset) string {
-	return fmt.Sprintf("%s-USD-PERP", symbol.Symbol())
+	native, _ := symbolRule.ToNative(symbol, connector.TypePerpetual)
+	return native
 }
 
 func (p *paradex) SupportsTradingOperations() bool {
@@ -18,6 +18,7 @@ type Config struct {
 }
 
 var _ connector.Config = (*Config)(nil)
+var _ types.SecretConfig = (*Config)(nil)
 
 func (c *Config) Validate() error {
 	if c.EthPrivateKey == "" {
@@ -66,3 +67,11 @@ func (c *Config) Validate() error {
 func (c *Config) ExchangeName() connector.ExchangeName {
 	return types.Paradex
 }
+
+func (c *Config) UsesTestnet() bool {
+	return c.Network == "testnet"
+}
+
+func (c *Config) SecretFields() map[string]*string {
+	return map[string]*string{"eth_private_key": &c.EthPrivateKey, "l2_private_key": &c.L2PrivateKey}
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/backtesting-org/live-trading/pkg/connectors/paradex/adaptor"
 	"github.com/backtesting-org/live-trading/pkg/connectors/paradex/requests"
 	websockets2 "github.com/backtesting-org/live-trading/pkg/connectors/paradex/websocket"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
 )
 
 // paradex implements Connector, WebSocketConnector, and Initializable interfaces
@@ -20,6 +22,7 @@ type paradex struct {
 	appLogger      logging.ApplicationLogger
 	tradingLogger  logging.TradingLogger
 	timeProvider   temporal.TimeProvider
+	limiter        ratelimit.Limiter
 	ctx            context.Context
 	initialized    bool
 
@@ -40,16 +43,22 @@ type paradex struct {
 	// Separate channels per kline subscription (key: "BTC:1m", "ETH:5m", etc.)
 	klineChannels map[string]chan connector.Kline
 	klineMu       sync.RWMutex
+	klineRouter   sync.Once
+
+	// payloads holds Paradex's own response about each recent order
+	payloads *types.OrderPayloads
 }
 
 // Ensure paradex implements all interfaces at compile time
 var _ connector.Connector = (*paradex)(nil)
 var _ connector.WebSocketConnector = (*paradex)(nil)
+var _ types.OrderPayloadSource = (*paradex)(nil)
 
 func NewParadex(
 	appLogger logging.ApplicationLogger,
 	tradingLogger logging.TradingLogger,
 	timeProvider temporal.TimeProvider,
+	limiters ratelimit.RateLimiters,
 ) connector.Connector {
 	return &paradex{
 		paradexService:    nil, // Will be created during initialization
@@ -58,11 +67,13 @@ func NewParadex(
 		appLogger:         appLogger,
 		tradingLogger:     tradingLogger,
 		timeProvider:      timeProvider,
+		limiter:           limiters.For(types.Paradex),
 		ctx:               context.Background(),
 		initialized:       false,
 		orderBookChannels: make(map[string]chan connector.OrderBook),
 		klineChannels:     make(map[string]chan connector.Kline),
 		tradeCh:           make(chan connector.Trade, 100),
+		payloads:          types.NewOrderPayloads(types.DefaultPayloadCapacity),
 	}
 }
 
@@ -0,0 +1,20 @@
+package paradex
+
+import (
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+var _ types.CancelAllProvider = (*paradex)(nil)
+
+func (p *paradex) CancelAllOrders() error {
+	if err := p.limiter.Wait(ratelimit.EndpointCancelOrder); err != nil {
+		return err
+	}
+	p.tradingLogger.OrderLifecycle("Cancelling open orders on %s", "all markets")
+	if err := p.paradexService.CancelAllOrders(p.ctx, nil); err != nil {
+		p.tradingLogger.OrderLifecycle("Failed to cancel open orders on %s: %v", "all markets", err)
+		return err
+	}
+	return nil
+}
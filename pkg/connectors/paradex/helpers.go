@@ -5,10 +5,13 @@ import (
 
 	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
 	"github.com/trishtzy/go-paradex/models"
 )
 
 func (p *paradex) convertParadexOrder(paradexOrder *models.ResponsesOrderResp) connector.Order {
+	p.payloads.Keep(paradexOrder.ID, paradexOrder)
+
 	// Parse decimal values with error handling
 	quantity, _ := numerical.NewFromString(paradexOrder.Size)
 	price, _ := numerical.NewFromString(paradexOrder.Price)
@@ -87,3 +90,26 @@ func (p *paradex) convertOrderSide(side models.ResponsesOrderSide) connector.Ord
 		return connector.OrderSideUnknown
 	}
 }
+
+// wrapOrderError categorises order rejections, and tags insufficient-balance
+// ones with the balance at rejection time
+func (p *paradex) wrapOrderError(symbol string, side connector.OrderSide, quantity, price numerical.Decimal, err error) error {
+	if !types.IsInsufficientBalance(err) {
+		return types.NewConnectorError(types.Paradex, "place order", err)
+	}
+
+	rejection := &types.InsufficientBalanceError{
+		Exchange: types.Paradex,
+		Symbol:   symbol,
+		Side:     side,
+		Quantity: quantity,
+		Price:    price,
+		Err:      err,
+	}
+	if balance, balanceErr := p.GetAccountBalance(); balanceErr == nil && balance != nil {
+		rejection.Available = balance.AvailableBalance
+	}
+
+	p.tradingLogger.OrderLifecycle("Paradex rejected order for insufficient balance: %s", rejection.Error())
+	return rejection
+}
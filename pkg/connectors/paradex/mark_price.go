@@ -0,0 +1,45 @@
+package paradex
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+var _ types.MarkPriceProvider = (*paradex)(nil)
+
+// FetchMarkPrice reads mark and underlying price from the market summary
+func (p *paradex) FetchMarkPrice(asset portfolio.Asset) (*types.MarkPrice, error) {
+	if err := p.limiter.Wait(ratelimit.EndpointPrice); err != nil {
+		return nil, err
+	}
+
+	symbol := p.GetPerpSymbol(asset)
+	summary, err := p.paradexService.GetMarketSummary(p.ctx, symbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch mark price for %s: %w", symbol, err)
+	}
+
+	markPrice, err := numerical.NewFromString(summary.MarkPrice)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mark price for %s: %w", symbol, err)
+	}
+	indexPrice, _ := numerical.NewFromString(summary.UnderlyingPrice)
+
+	timestamp := p.timeProvider.Now()
+	if summary.CreatedAt > 0 {
+		timestamp = time.UnixMilli(summary.CreatedAt)
+	}
+
+	return &types.MarkPrice{
+		Exchange:   types.Paradex,
+		Asset:      asset,
+		MarkPrice:  markPrice,
+		IndexPrice: indexPrice,
+		Timestamp:  timestamp,
+	}, nil
+}
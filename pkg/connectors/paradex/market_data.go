@@ -7,9 +7,13 @@ import (
 	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
 )
 
 func (p *paradex) FetchPrice(symbol string) (*connector.Price, error) {
+	if err := p.limiter.Wait(ratelimit.EndpointPrice); err != nil {
+		return nil, err
+	}
 	price, err := p.paradexService.GetPrice(p.ctx, symbol)
 
 	if err != nil {
@@ -42,6 +46,9 @@ func (p *paradex) FetchPrice(symbol string) (*connector.Price, error) {
 }
 
 func (p *paradex) FetchOrderBook(symbol portfolio.Asset, instrument connector.Instrument, depth int) (*connector.OrderBook, error) {
+	if err := p.limiter.Wait(ratelimit.EndpointOrderBook); err != nil {
+		return nil, err
+	}
 	if instrument != connector.TypePerpetual {
 		return nil, fmt.Errorf("order book only supported for perpetual contracts")
 	}
@@ -93,6 +100,9 @@ func (p *paradex) FetchRecentTrades(symbol string, limit int) ([]connector.Trade
 }
 
 func (p *paradex) FetchKlines(symbol, interval string, limit int) ([]connector.Kline, error) {
+	if err := p.limiter.Wait(ratelimit.EndpointKlines); err != nil {
+		return nil, err
+	}
 	// Convert interval string (e.g., "5m", "1h") to resolution in minutes
 	resolution, err := parseIntervalToMinutes(interval)
 	if err != nil {
@@ -3,10 +3,16 @@ package paradex
 import (
 	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/live-trading/pkg/connectors/symbols"
 	"github.com/backtesting-org/live-trading/pkg/connectors/types"
 	"go.uber.org/fx"
 )
 
+// symbolRule maps assets to perpetual markets, e.g. BTC -> BTC-USD-PERP
+var symbolRule = symbols.NewSuffixRule(map[connector.Instrument]string{
+	connector.TypePerpetual: "-USD-PERP",
+})
+
 var Module = fx.Options(
 	fx.Provide(
 		fx.Annotate(
@@ -22,7 +28,9 @@ var Module = fx.Options(
 )
 
 // registerParadex registers the paradex connector with the SDK's ConnectorRegistry
-func registerParadex(paradexConn connector.Connector, reg registry.ConnectorRegistry) {
+func registerParadex(paradexConn connector.Connector, reg registry.ConnectorRegistry, mapper symbols.SymbolMapper) {
+	mapper.Register(types.Paradex, symbolRule)
+
 	// Register the connector
 	reg.RegisterConnector(types.Paradex, paradexConn)
 }
@@ -0,0 +1,9 @@
+package paradex
+
+import "github.com/backtesting-org/live-trading/pkg/connectors/types"
+
+var _ types.RateLimitProvider = (*paradex)(nil)
+
+func (p *paradex) RateLimitStatus() types.RateLimitStatus {
+	return p.limiter.Status()
+}
@@ -35,6 +35,21 @@ func (s *Service) GetPrice(ctx context.Context, market string) (*models.Response
 	return resp.Payload, nil
 }
 
+// GetMarketSummary returns the latest summary for one market, including
+// mark and underlying (index) prices
+func (s *Service) GetMarketSummary(ctx context.Context, market string) (*models.ResponsesMarketSummaryResp, error) {
+	params := markets.NewGetMarketsSummaryParams().WithContext(ctx)
+	params.SetMarket(market)
+	resp, err := s.client.API().Markets.GetMarketsSummary(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get market summary: %w", err)
+	}
+	if resp.Payload == nil || len(resp.Payload.Results) == 0 || resp.Payload.Results[0] == nil {
+		return nil, fmt.Errorf("market not found: %s", market)
+	}
+	return resp.Payload.Results[0], nil
+}
+
 // KlineData represents a single kline/candlestick
 type KlineData struct {
 	Timestamp int64   // Unix timestamp in milliseconds
@@ -115,6 +115,19 @@ func (s *Service) CancelOrder(ctx context.Context, orderID string) error {
 	return nil
 }
 
+// CancelAllOrders cancels every open order, or only those on market when it is set
+func (s *Service) CancelAllOrders(ctx context.Context, market *string) error {
+	cancelParams := orders.NewOrdersCancelAllParams().WithContext(ctx)
+	cancelParams.SetMarket(market)
+
+	_, err := s.client.API().Orders.OrdersCancelAll(cancelParams, s.client.AuthWriter(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to cancel all orders: %w", err)
+	}
+
+	return nil
+}
+
 func (s *Service) GetOrder(ctx context.Context, orderID string) (*models.ResponsesOrderResp, error) {
 	orderParams := orders.NewOrdersGetParams().WithContext(ctx)
 	orderParams.SetOrderID(orderID)
@@ -2,15 +2,20 @@ package paradex
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
 	"github.com/backtesting-org/live-trading/pkg/connectors/paradex/requests"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
 )
 
 func (p *paradex) PlaceLimitOrder(symbol string, side connector.OrderSide, quantity, price numerical.Decimal) (*connector.OrderResponse, error) {
+	if err := p.limiter.Wait(ratelimit.EndpointPlaceOrder); err != nil {
+		return nil, err
+	}
 	orderReq := requests.PlaceOrderParams{
 		Market:    symbol + "-USD-PERP",
 		Side:      string(side), // "BUY" or "SELL"
@@ -22,8 +27,9 @@ func (p *paradex) PlaceLimitOrder(symbol string, side connector.OrderSide, quant
 
 	resp, err := p.paradexService.PlaceOrder(p.ctx, orderReq)
 	if err != nil {
-		return nil, err
+		return nil, p.wrapOrderError(symbol, side, quantity, price, err)
 	}
+	p.payloads.Keep(resp.ID, resp)
 
 	return &connector.OrderResponse{
 		OrderID:   resp.ID,
@@ -39,6 +45,9 @@ func (p *paradex) PlaceLimitOrder(symbol string, side connector.OrderSide, quant
 }
 
 func (p *paradex) PlaceMarketOrder(symbol string, side connector.OrderSide, quantity numerical.Decimal) (*connector.OrderResponse, error) {
+	if err := p.limiter.Wait(ratelimit.EndpointPlaceOrder); err != nil {
+		return nil, err
+	}
 	orderReq := requests.PlaceOrderParams{
 		Market:    symbol,
 		Side:      string(side),
@@ -50,8 +59,9 @@ func (p *paradex) PlaceMarketOrder(symbol string, side connector.OrderSide, quan
 
 	resp, err := p.paradexService.PlaceOrder(p.ctx, orderReq)
 	if err != nil {
-		return nil, err
+		return nil, p.wrapOrderError(symbol, side, quantity, numerical.Zero(), err)
 	}
+	p.payloads.Keep(resp.ID, resp)
 
 	return &connector.OrderResponse{
 		OrderID:   resp.ID,
@@ -66,6 +76,9 @@ func (p *paradex) PlaceMarketOrder(symbol string, side connector.OrderSide, quan
 }
 
 func (p *paradex) CancelOrder(symbol, orderID string) (*connector.CancelResponse, error) {
+	if err := p.limiter.Wait(ratelimit.EndpointCancelOrder); err != nil {
+		return nil, err
+	}
 	p.tradingLogger.OrderLifecycle("Cancelling order %s for symbol %s", orderID, symbol)
 	err := p.paradexService.CancelOrder(p.ctx, orderID)
 	if err != nil {
@@ -81,6 +94,9 @@ func (p *paradex) CancelOrder(symbol, orderID string) (*connector.CancelResponse
 }
 
 func (p *paradex) GetOpenOrders() ([]connector.Order, error) {
+	if err := p.limiter.Wait(ratelimit.EndpointOrders); err != nil {
+		return nil, err
+	}
 	ctx := context.Background()
 	paradexOrders, err := p.paradexService.GetOpenOrders(ctx, nil)
 	if err != nil {
@@ -104,6 +120,9 @@ func (p *paradex) GetOpenOrders() ([]connector.Order, error) {
 }
 
 func (p *paradex) GetOrderStatus(orderID string) (*connector.Order, error) {
+	if err := p.limiter.Wait(ratelimit.EndpointOrders); err != nil {
+		return nil, err
+	}
 	order, err := p.paradexService.GetOrder(p.ctx, orderID)
 	if err != nil {
 		return nil, err
@@ -119,6 +138,11 @@ func (p *paradex) GetOrderStatus(orderID string) (*connector.Order, error) {
 	return &convertedOrder, nil
 }
 
+// OrderPayload returns Paradex's last response about the order
+func (p *paradex) OrderPayload(orderID string) (json.RawMessage, bool) {
+	return p.payloads.OrderPayload(orderID)
+}
+
 // GetRawOrder returns the raw paradex order details
 func (p *paradex) GetRawOrder(ctx context.Context, orderID string) (interface{}, error) {
 	return p.paradexService.GetOrder(ctx, orderID)
@@ -0,0 +1,54 @@
+package paradex
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/live-trading/pkg/connectors/paradex/requests"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+var _ types.TriggerOrderProvider = (*paradex)(nil)
+
+// PlaceTriggerOrder places a native Paradex conditional order; Paradex's
+// order type names match the SDK's trigger order types
+func (p *paradex) PlaceTriggerOrder(order types.TriggerOrder) (*connector.OrderResponse, error) {
+	if err := order.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid trigger order: %w", err)
+	}
+	if err := p.limiter.Wait(ratelimit.EndpointPlaceOrder); err != nil {
+		return nil, err
+	}
+
+	orderReq := requests.PlaceOrderParams{
+		Market:       order.Symbol,
+		Side:         string(order.Side),
+		Size:         order.Quantity.String(),
+		OrderType:    string(order.Type),
+		TriggerPrice: order.TriggerPrice.String(),
+		ReduceOnly:   order.ReduceOnly,
+	}
+	if order.IsLimit() {
+		orderReq.Price = order.LimitPrice.String()
+	}
+
+	resp, err := p.paradexService.PlaceOrder(p.ctx, orderReq)
+	if err != nil {
+		return nil, p.wrapOrderError(order.Symbol, order.Side, order.Quantity, order.LimitPrice, err)
+	}
+
+	return &connector.OrderResponse{
+		OrderID:   resp.ID,
+		Symbol:    resp.Market,
+		Status:    connector.OrderStatusNew,
+		Side:      order.Side,
+		Type:      order.Type,
+		Quantity:  order.Quantity,
+		Price:     order.LimitPrice,
+		FilledQty: numerical.Zero(),
+		Timestamp: time.Now(),
+	}, nil
+}
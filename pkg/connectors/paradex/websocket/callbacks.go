@@ -235,6 +235,8 @@ func (s *service) processTradeData(channel string, data json.RawMessage) error {
 		TradeID:   paradexTrade.ID,
 	}
 
+	s.klines.AddTrade(symbol, price, quantity, update.Timestamp)
+
 	select {
 	case s.tradeChan <- update:
 	default:
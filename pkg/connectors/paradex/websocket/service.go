@@ -37,9 +37,8 @@ type service struct {
 	accountChan   chan AccountUpdate
 	errorChan     chan error
 
-	// Add kline builder
-	klineBuilder *KlineBuilder
-	klineChan    chan KlineUpdate
+	// Paradex has no candle stream, so klines are built from trades
+	klines base.TradeToKlineAggregator
 }
 
 func NewService(
@@ -74,16 +73,13 @@ func NewService(
 		accountChan:   make(chan AccountUpdate, 100),
 		errorChan:     make(chan error, 10),
 
-		// Initialize kline builder
-		klineBuilder: NewKlineBuilder(timeProvider),
-		klineChan:    make(chan KlineUpdate, 1000),
+		klines: base.NewTradeToKlineAggregator(timeProvider, logger),
 	}
 
 	service.setupCallbacks()
 	service.registerHandlers()
 
-	// Start feeding trades to kline builder
-	go service.feedTradesToKlineBuilder()
+	go service.klines.Start(context.Background())
 
 	return service
 }
@@ -134,18 +130,9 @@ func (s *service) SubscribeAccount() error {
 	return s.SubscribeAccountUpdates()
 }
 
-// Thread-safe write method
-func (s *service) safeWriteJSON(message interface{}) error {
+// safeWrite sends an already encoded frame
+func (s *service) safeWrite(frame []byte) error {
 	s.writeMutex.Lock()
 	defer s.writeMutex.Unlock()
-	return s.connectionManager.SendJSON(message)
-}
-
-// Feed trades to kline builder
-func (s *service) feedTradesToKlineBuilder() {
-	for trade := range s.tradeChan {
-		if s.klineBuilder != nil {
-			s.klineBuilder.ProcessTrade(trade)
-		}
-	}
+	return s.connectionManager.SendMessage(frame)
 }
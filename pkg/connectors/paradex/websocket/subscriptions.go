@@ -4,8 +4,28 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+
+	"github.com/backtesting-org/live-trading/pkg/websocket/connection"
+)
+
+// Subscription frames are pre-marshalled; only the request ID and channel
+// are filled in per send
+var (
+	subscribeFrame   = connection.MustCompileFrame(channelFrame("subscribe"))
+	unsubscribeFrame = connection.MustCompileFrame(channelFrame("unsubscribe"))
 )
 
+func channelFrame(method string) map[string]interface{} {
+	return map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      connection.Slot(0),
+		"method":  method,
+		"params": map[string]interface{}{
+			"channel": connection.Slot(1),
+		},
+	}
+}
+
 type subscriptionManager struct {
 	subscriptions map[string]bool
 	mutex         sync.RWMutex
@@ -106,18 +126,14 @@ func (s *service) SubscribeOrderbook(symbol string) error {
 		priceTick,
 	)
 
-	subMsg := map[string]interface{}{
-		"jsonrpc": "2.0",
-		"id":      s.getNextRequestID(),
-		"method":  "subscribe",
-		"params": map[string]interface{}{
-			"channel": channel,
-		},
+	subMsg, err := subscribeFrame.Render(s.getNextRequestID(), channel)
+	if err != nil {
+		return err
 	}
 
 	s.applicationLogger.Debug("Subscribing to orderbook with channel: %s", channel)
 
-	if err := s.safeWriteJSON(subMsg); err != nil {
+	if err := s.safeWrite(subMsg); err != nil {
 		return err
 	}
 
@@ -133,16 +149,12 @@ func (s *service) UnsubscribeOrderbook(symbol string) error {
 	priceTick := s.getOptimalPriceTick(symbol)
 	channel := s.buildOrderbookChannel(symbol, 15, "100ms", priceTick)
 
-	subMsg := map[string]interface{}{
-		"jsonrpc": "2.0",
-		"id":      s.getNextRequestID(),
-		"method":  "unsubscribe",
-		"params": map[string]interface{}{
-			"channel": channel,
-		},
+	subMsg, err := unsubscribeFrame.Render(s.getNextRequestID(), channel)
+	if err != nil {
+		return err
 	}
 
-	if err := s.safeWriteJSON(subMsg); err != nil {
+	if err := s.safeWrite(subMsg); err != nil {
 		return err
 	}
 
@@ -154,16 +166,12 @@ func (s *service) SubscribeTradesForSymbol(symbol string) error {
 	marketSymbol := s.ensureParadexFormat(symbol)
 	channel := fmt.Sprintf("trades.%s", marketSymbol)
 
-	subMsg := map[string]interface{}{
-		"jsonrpc": "2.0",
-		"id":      s.getNextRequestID(),
-		"method":  "subscribe",
-		"params": map[string]interface{}{
-			"channel": channel,
-		},
+	subMsg, err := subscribeFrame.Render(s.getNextRequestID(), channel)
+	if err != nil {
+		return err
 	}
 
-	if err := s.safeWriteJSON(subMsg); err != nil {
+	if err := s.safeWrite(subMsg); err != nil {
 		return err
 	}
 
@@ -179,16 +187,12 @@ func (s *service) UnsubscribeTrades(symbol string) error {
 	marketSymbol := s.ensureParadexFormat(symbol)
 	channel := fmt.Sprintf("trades.%s", marketSymbol)
 
-	subMsg := map[string]interface{}{
-		"jsonrpc": "2.0",
-		"id":      s.getNextRequestID(),
-		"method":  "unsubscribe",
-		"params": map[string]interface{}{
-			"channel": channel,
-		},
+	subMsg, err := unsubscribeFrame.Render(s.getNextRequestID(), channel)
+	if err != nil {
+		return err
 	}
 
-	if err := s.safeWriteJSON(subMsg); err != nil {
+	if err := s.safeWrite(subMsg); err != nil {
 		return err
 	}
 
@@ -197,16 +201,12 @@ func (s *service) UnsubscribeTrades(symbol string) error {
 }
 
 func (s *service) SubscribeAccountUpdates() error {
-	subMsg := map[string]interface{}{
-		"jsonrpc": "2.0",
-		"id":      s.getNextRequestID(),
-		"method":  "subscribe",
-		"params": map[string]interface{}{
-			"channel": "account",
-		},
+	subMsg, err := subscribeFrame.Render(s.getNextRequestID(), "account")
+	if err != nil {
+		return err
 	}
 
-	if err := s.safeWriteJSON(subMsg); err != nil {
+	if err := s.safeWrite(subMsg); err != nil {
 		return err
 	}
 
@@ -219,16 +219,12 @@ func (s *service) UnsubscribeAccount() error {
 		return nil
 	}
 
-	subMsg := map[string]interface{}{
-		"jsonrpc": "2.0",
-		"id":      s.getNextRequestID(),
-		"method":  "unsubscribe",
-		"params": map[string]interface{}{
-			"channel": "account",
-		},
+	subMsg, err := unsubscribeFrame.Render(s.getNextRequestID(), "account")
+	if err != nil {
+		return err
 	}
 
-	if err := s.safeWriteJSON(subMsg); err != nil {
+	if err := s.safeWrite(subMsg); err != nil {
 		return err
 	}
 
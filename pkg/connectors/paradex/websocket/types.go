@@ -3,6 +3,7 @@ package websockets
 import (
 	"time"
 
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
 )
 
@@ -25,11 +26,15 @@ type WebSocketService interface {
 	UnsubscribeTrades(symbol string) error
 	UnsubscribeAccount() error
 
+	// TrackKlines builds bars of interval from a subscribed trade stream
+	TrackKlines(symbol, interval string) error
+	UntrackKlines(symbol, interval string)
+
 	// Data channels
 	OrderbookUpdates() <-chan OrderbookUpdate
 	TradeUpdates() <-chan TradeUpdate
 	AccountUpdates() <-chan AccountUpdate
-	KlineUpdates() <-chan KlineUpdate
+	KlineUpdates() <-chan connector.Kline
 	ErrorChannel() <-chan error
 
 	// Metrics
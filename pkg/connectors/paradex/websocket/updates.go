@@ -1,5 +1,7 @@
 package websockets
 
+import "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+
 func (s *service) OrderbookUpdates() <-chan OrderbookUpdate {
 	return s.orderbookChan
 }
@@ -12,11 +14,14 @@ func (s *service) AccountUpdates() <-chan AccountUpdate {
 	return s.accountChan
 }
 
-func (s *service) KlineUpdates() <-chan KlineUpdate {
-	if s.klineBuilder == nil {
-		ch := make(chan KlineUpdate)
-		close(ch)
-		return ch
-	}
-	return s.klineBuilder.Output()
+func (s *service) KlineUpdates() <-chan connector.Kline {
+	return s.klines.Klines()
+}
+
+func (s *service) TrackKlines(symbol, interval string) error {
+	return s.klines.Track(symbol, interval)
+}
+
+func (s *service) UntrackKlines(symbol, interval string) {
+	s.klines.Untrack(symbol, interval)
 }
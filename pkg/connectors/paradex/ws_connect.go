@@ -54,3 +54,15 @@ func (p *paradex) IsWebSocketConnected() bool {
 
 	return p.wsService.IsConnected()
 }
+
+// GetWebSocketMetrics returns connection statistics for the stream
+func (p *paradex) GetWebSocketMetrics() map[string]interface{} {
+	p.wsMutex.RLock()
+	defer p.wsMutex.RUnlock()
+
+	if p.wsService == nil {
+		return map[string]interface{}{}
+	}
+
+	return p.wsService.GetMetrics()
+}
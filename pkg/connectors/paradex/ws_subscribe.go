@@ -114,10 +114,26 @@ func (p *paradex) SubscribeKlines(asset portfolio.Asset, interval string) error
 
 	symbol := p.GetPerpSymbol(asset)
 
+	if err := p.wsService.TrackKlines(symbol, interval); err != nil {
+		return fmt.Errorf("failed to track klines for %s: %w", asset.Symbol(), err)
+	}
+
 	if err := p.wsService.SubscribeTrades(symbol); err != nil {
+		p.wsService.UntrackKlines(symbol, interval)
 		return fmt.Errorf("failed to subscribe to trades for klines %s: %w", asset.Symbol(), err)
 	}
 
+	p.klineMu.Lock()
+	channelKey := fmt.Sprintf("%s:%s", asset.Symbol(), interval)
+	if _, ok := p.klineChannels[channelKey]; !ok {
+		p.klineChannels[channelKey] = make(chan connector.Kline, 100)
+	}
+	p.klineMu.Unlock()
+
+	p.klineRouter.Do(func() {
+		go p.routeKlines()
+	})
+
 	p.appLogger.Info("Subscribed to trades for klines %s %s", asset.Symbol(), interval)
 	return nil
 }
@@ -127,10 +143,14 @@ func (p *paradex) UnsubscribeKlines(asset portfolio.Asset, interval string) erro
 		return fmt.Errorf("WebSocket not connected")
 	}
 
-	// Since klines are built from trades, we could unsubscribe from trades
-	// BUT: this is tricky because trades might be used for other purposes too
-	// For now, just log that klines will stop when trades stop
+	// The trade stream stays up since trades might be used for other
+	// purposes too; only the bars for this interval stop
+	p.wsService.UntrackKlines(p.GetPerpSymbol(asset), interval)
+
+	p.klineMu.Lock()
+	delete(p.klineChannels, fmt.Sprintf("%s:%s", asset.Symbol(), interval))
+	p.klineMu.Unlock()
 
-	p.appLogger.Info("Klines unsubscription requested for %s %s - klines will stop when trade stream stops", asset.Symbol(), interval)
+	p.appLogger.Info("Unsubscribed from klines %s %s", asset.Symbol(), interval)
 	return nil
 }
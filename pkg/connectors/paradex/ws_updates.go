@@ -1,8 +1,9 @@
 package paradex
 
 import (
+	"fmt"
+
 	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
-	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
 )
 
 func (p *paradex) GetKlineChannels() map[string]<-chan connector.Kline {
@@ -88,42 +89,23 @@ func (p *paradex) convertAccountBalanceUpdates(out chan<- connector.AccountBalan
 	<-p.wsContext.Done()
 }
 
-func (p *paradex) convertKlineUpdates(out chan<- connector.Kline) {
-	defer close(out)
-
-	for {
-		select {
-		case <-p.wsContext.Done():
-			return
-		case paradexKline, ok := <-p.wsService.KlineUpdates():
-			if !ok {
-				return
-			}
-
-			// Convert from paradex KlineUpdate to connector.Kline
-			connectorKline := connector.Kline{
-				Symbol:      paradexKline.Symbol,
-				Interval:    paradexKline.Interval,
-				OpenTime:    paradexKline.OpenTime,
-				Open:        paradexKline.Open,
-				High:        paradexKline.High,
-				Low:         paradexKline.Low,
-				Close:       paradexKline.Close,
-				Volume:      paradexKline.Volume,
-				CloseTime:   paradexKline.CloseTime,
-				QuoteVolume: numerical.Zero(),             // Not available from paradex
-				TradeCount:  int(paradexKline.TradeCount), // Convert int64 to int
-				TakerVolume: numerical.Zero(),             // Not available from paradex
-			}
+// routeKlines fans the service's built bars out to the per-subscription
+// channels, keyed like the channels by asset and interval
+func (p *paradex) routeKlines() {
+	for kline := range p.wsService.KlineUpdates() {
+		asset := p.parseAssetFromSymbol(kline.Symbol)
+		kline.Symbol = asset.Symbol()
 
+		p.klineMu.RLock()
+		ch, ok := p.klineChannels[fmt.Sprintf("%s:%s", asset.Symbol(), kline.Interval)]
+		if ok {
 			select {
-			case out <- connectorKline:
-			case <-p.wsContext.Done():
-				return
+			case ch <- kline:
 			default:
 				// Channel full, drop update to prevent blocking
-				p.appLogger.Debug("Dropped kline update for %s due to full channel", paradexKline.Symbol)
+				p.appLogger.Debug("Dropped kline update for %s due to full channel", kline.Symbol)
 			}
 		}
+		p.klineMu.RUnlock()
 	}
 }
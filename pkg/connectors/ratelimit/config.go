@@ -0,0 +1,119 @@
+package ratelimit
+
+import (
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+// Endpoint groups REST calls that an exchange weighs alike
+type Endpoint string
+
+const (
+	EndpointPrice       Endpoint = "price"
+	EndpointOrderBook   Endpoint = "order_book"
+	EndpointKlines      Endpoint = "klines"
+	EndpointTrades      Endpoint = "trades"
+	EndpointFunding     Endpoint = "funding"
+	EndpointInstruments Endpoint = "instruments"
+	EndpointPlaceOrder  Endpoint = "place_order"
+	EndpointCancelOrder Endpoint = "cancel_order"
+	EndpointOrders      Endpoint = "orders"
+	EndpointAccount     Endpoint = "account"
+)
+
+const (
+	// DefaultCapacity is the burst budget for exchanges without their own defaults
+	DefaultCapacity = 100
+
+	// DefaultRefillPerSecond is how fast the budget recovers
+	DefaultRefillPerSecond = 20
+
+	// DefaultMaxWait is the longest a call queues before it is refused
+	DefaultMaxWait = 10 * time.Second
+)
+
+// Config is one exchange's token bucket. Each call spends its endpoint's
+// weight, or 1 when the endpoint is not listed.
+type Config struct {
+	Capacity        float64
+	RefillPerSecond float64
+	MaxWait         time.Duration
+	Weights         map[Endpoint]float64
+}
+
+// Weight is what one call to the endpoint costs
+func (c Config) Weight(endpoint Endpoint) float64 {
+	if weight, ok := c.Weights[endpoint]; ok && weight > 0 {
+		return weight
+	}
+	return 1
+}
+
+// DefaultConfig returns a budget that stays under the exchange's published
+// IP limits with some margin for other processes sharing the key
+func DefaultConfig(exchange connector.ExchangeName) Config {
+	switch exchange {
+	case types.Hyperliquid:
+		// 1200 weight per minute; info requests cost 2 or 20, actions 1
+		return Config{
+			Capacity:        1200,
+			RefillPerSecond: 20,
+			MaxWait:         DefaultMaxWait,
+			Weights: map[Endpoint]float64{
+				EndpointPrice:       2,
+				EndpointOrderBook:   2,
+				EndpointKlines:      20,
+				EndpointTrades:      20,
+				EndpointFunding:     20,
+				EndpointInstruments: 20,
+				EndpointOrders:      20,
+				EndpointAccount:     20,
+			},
+		}
+	case types.Binance:
+		// 2400 request weight per minute
+		return Config{
+			Capacity:        2400,
+			RefillPerSecond: 40,
+			MaxWait:         DefaultMaxWait,
+			Weights: map[Endpoint]float64{
+				EndpointOrderBook:   10,
+				EndpointKlines:      5,
+				EndpointFunding:     10,
+				EndpointInstruments: 1,
+				EndpointOrders:      5,
+				EndpointAccount:     5,
+			},
+		}
+	case types.Okx:
+		// Most endpoints allow 20 requests per 2s; order placement 60 per 2s
+		return Config{
+			Capacity:        40,
+			RefillPerSecond: 10,
+			MaxWait:         DefaultMaxWait,
+			Weights: map[Endpoint]float64{
+				EndpointPlaceOrder:  0.5,
+				EndpointCancelOrder: 0.5,
+			},
+		}
+	case types.Bybit:
+		// Order endpoints are limited to 10/s per account
+		return Config{
+			Capacity:        100,
+			RefillPerSecond: 20,
+			MaxWait:         DefaultMaxWait,
+			Weights: map[Endpoint]float64{
+				EndpointPlaceOrder:  2,
+				EndpointCancelOrder: 2,
+			},
+		}
+	}
+
+	return Config{
+		Capacity:        DefaultCapacity,
+		RefillPerSecond: DefaultRefillPerSecond,
+		MaxWait:         DefaultMaxWait,
+	}
+}
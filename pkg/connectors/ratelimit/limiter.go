@@ -0,0 +1,175 @@
+package ratelimit
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+// ErrRateLimited is matched by errors.Is for calls refused by a limiter; it
+// is the connector taxonomy's rate-limit sentinel
+var ErrRateLimited = types.ErrRateLimited
+
+// Limiter is one exchange's token bucket. Calls that find it empty reserve
+// their weight and queue, so bursts are spread out in arrival order.
+type Limiter interface {
+	// Wait blocks until the endpoint's weight is available, or returns
+	// ErrRateLimited if that would take longer than MaxWait
+	Wait(endpoint Endpoint) error
+	Status() types.RateLimitStatus
+}
+
+// RateLimiters hands out one shared limiter per exchange so every service
+// calling that exchange draws from the same budget
+type RateLimiters interface {
+	Configure(exchange connector.ExchangeName, config Config)
+	For(exchange connector.ExchangeName) Limiter
+	Statuses() map[connector.ExchangeName]types.RateLimitStatus
+}
+
+type rateLimiters struct {
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+	limiters     map[connector.ExchangeName]*limiter
+	mu           sync.Mutex
+}
+
+func NewRateLimiters(timeProvider temporal.TimeProvider, logger logging.ApplicationLogger) RateLimiters {
+	return &rateLimiters{
+		timeProvider: timeProvider,
+		logger:       logger,
+		limiters:     make(map[connector.ExchangeName]*limiter),
+	}
+}
+
+func (r *rateLimiters) Configure(exchange connector.ExchangeName, config Config) {
+	r.For(exchange).(*limiter).configure(config)
+}
+
+func (r *rateLimiters) For(exchange connector.ExchangeName) Limiter {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if l, ok := r.limiters[exchange]; ok {
+		return l
+	}
+
+	l := &limiter{exchange: exchange, timeProvider: r.timeProvider, logger: r.logger}
+	l.configure(DefaultConfig(exchange))
+	r.limiters[exchange] = l
+	return l
+}
+
+func (r *rateLimiters) Statuses() map[connector.ExchangeName]types.RateLimitStatus {
+	r.mu.Lock()
+	limiters := make(map[connector.ExchangeName]*limiter, len(r.limiters))
+	for name, l := range r.limiters {
+		limiters[name] = l
+	}
+	r.mu.Unlock()
+
+	statuses := make(map[connector.ExchangeName]types.RateLimitStatus, len(limiters))
+	for name, l := range limiters {
+		statuses[name] = l.Status()
+	}
+	return statuses
+}
+
+type limiter struct {
+	exchange     connector.ExchangeName
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config     Config
+	tokens     float64
+	lastRefill time.Time
+	throttled  int64
+	rejected   int64
+	waited     time.Duration
+	mu         sync.Mutex
+}
+
+func (l *limiter) configure(config Config) {
+	defaults := DefaultConfig(l.exchange)
+	if config.Capacity <= 0 {
+		config.Capacity = defaults.Capacity
+	}
+	if config.RefillPerSecond <= 0 {
+		config.RefillPerSecond = defaults.RefillPerSecond
+	}
+	if config.MaxWait <= 0 {
+		config.MaxWait = defaults.MaxWait
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// A fresh bucket starts full; a reconfigured one keeps what it has left
+	if l.lastRefill.IsZero() {
+		l.tokens = config.Capacity
+		l.lastRefill = l.timeProvider.Now()
+	}
+	l.tokens = math.Min(l.tokens, config.Capacity)
+	l.config = config
+}
+
+// refillLocked credits tokens accrued since the last call; caller must hold l.mu
+func (l *limiter) refillLocked(now time.Time) {
+	elapsed := now.Sub(l.lastRefill).Seconds()
+	if elapsed > 0 {
+		l.tokens = math.Min(l.config.Capacity, l.tokens+elapsed*l.config.RefillPerSecond)
+		l.lastRefill = now
+	}
+}
+
+func (l *limiter) Wait(endpoint Endpoint) error {
+	l.mu.Lock()
+	now := l.timeProvider.Now()
+	l.refillLocked(now)
+
+	weight := l.config.Weight(endpoint)
+	if l.tokens >= weight {
+		l.tokens -= weight
+		l.mu.Unlock()
+		return nil
+	}
+
+	// Tokens may already be negative from earlier reservations, which is
+	// what queues this call behind them
+	delay := time.Duration((weight - l.tokens) / l.config.RefillPerSecond * float64(time.Second))
+	if delay > l.config.MaxWait {
+		l.rejected++
+		maxWait := l.config.MaxWait
+		l.mu.Unlock()
+		return fmt.Errorf("%s %s: budget exhausted for %s (max wait %s): %w", l.exchange, endpoint, delay.Round(time.Millisecond), maxWait, ErrRateLimited)
+	}
+
+	l.tokens -= weight
+	l.throttled++
+	l.waited += delay
+	l.mu.Unlock()
+
+	l.logger.Debug("Throttling %s %s for %s", l.exchange, endpoint, delay.Round(time.Millisecond))
+	l.timeProvider.Sleep(delay)
+	return nil
+}
+
+func (l *limiter) Status() types.RateLimitStatus {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refillLocked(l.timeProvider.Now())
+	return types.RateLimitStatus{
+		Remaining: math.Max(l.tokens, 0),
+		Limit:     l.config.Capacity,
+		Throttled: l.throttled,
+		Rejected:  l.rejected,
+		Waited:    l.waited,
+	}
+}
@@ -0,0 +1,117 @@
+package ratelimit_test
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	mocktemporal "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	logger "github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/live-trading/pkg/connectors/ratelimit"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+var _ = Describe("Limiter", func() {
+	var (
+		now      time.Time
+		slept    []time.Duration
+		limiters ratelimit.RateLimiters
+		limiter  ratelimit.Limiter
+	)
+
+	BeforeEach(func() {
+		now = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		slept = nil
+
+		mockTime := mocktemporal.NewTimeProvider(GinkgoT())
+		mockTime.On("Now").Return(func() time.Time { return now }).Maybe()
+		mockTime.On("Sleep", mock.Anything).Run(func(args mock.Arguments) {
+			delay := args.Get(0).(time.Duration)
+			slept = append(slept, delay)
+			now = now.Add(delay)
+		}).Return().Maybe()
+
+		limiters = ratelimit.NewRateLimiters(mockTime, logger.NewNoOpLogger())
+		limiters.Configure(types.Binance, ratelimit.Config{
+			Capacity:        4,
+			RefillPerSecond: 2,
+			MaxWait:         2 * time.Second,
+			Weights:         map[ratelimit.Endpoint]float64{ratelimit.EndpointOrderBook: 2},
+		})
+		limiter = limiters.For(types.Binance)
+	})
+
+	It("spends the endpoint weight without waiting while the bucket holds it", func() {
+		Expect(limiter.Wait(ratelimit.EndpointOrderBook)).To(Succeed())
+		Expect(limiter.Wait(ratelimit.EndpointPrice)).To(Succeed())
+
+		Expect(slept).To(BeEmpty())
+		Expect(limiter.Status().Remaining).To(Equal(1.0))
+	})
+
+	It("sleeps on the time provider until the reserved weight refills", func() {
+		Expect(limiter.Wait(ratelimit.EndpointOrderBook)).To(Succeed())
+		Expect(limiter.Wait(ratelimit.EndpointOrderBook)).To(Succeed())
+		Expect(limiter.Wait(ratelimit.EndpointOrderBook)).To(Succeed())
+
+		Expect(slept).To(Equal([]time.Duration{time.Second}))
+
+		status := limiter.Status()
+		Expect(status.Throttled).To(Equal(int64(1)))
+		Expect(status.Waited).To(Equal(time.Second))
+	})
+
+	It("queues later calls behind earlier reservations", func() {
+		for i := 0; i < 2; i++ {
+			Expect(limiter.Wait(ratelimit.EndpointOrderBook)).To(Succeed())
+		}
+
+		// Sleeping is instant here, so hold the clock to see the queue build
+		frozen := now
+		Expect(limiter.Wait(ratelimit.EndpointPrice)).To(Succeed())
+		now = frozen
+		Expect(limiter.Wait(ratelimit.EndpointPrice)).To(Succeed())
+
+		Expect(slept).To(Equal([]time.Duration{500 * time.Millisecond, time.Second}))
+	})
+
+	It("refuses a call that would wait longer than MaxWait", func() {
+		for i := 0; i < 2; i++ {
+			Expect(limiter.Wait(ratelimit.EndpointOrderBook)).To(Succeed())
+		}
+
+		limiters.Configure(types.Binance, ratelimit.Config{
+			Capacity:        4,
+			RefillPerSecond: 2,
+			MaxWait:         500 * time.Millisecond,
+			Weights:         map[ratelimit.Endpoint]float64{ratelimit.EndpointOrderBook: 2},
+		})
+
+		err := limiter.Wait(ratelimit.EndpointOrderBook)
+		Expect(errors.Is(err, ratelimit.ErrRateLimited)).To(BeTrue())
+		Expect(slept).To(BeEmpty())
+		Expect(limiter.Status().Rejected).To(Equal(int64(1)))
+	})
+
+	It("refills from the time provider's clock, up to capacity", func() {
+		for i := 0; i < 2; i++ {
+			Expect(limiter.Wait(ratelimit.EndpointOrderBook)).To(Succeed())
+		}
+		Expect(limiter.Status().Remaining).To(Equal(0.0))
+
+		now = now.Add(time.Second)
+		Expect(limiter.Status().Remaining).To(Equal(2.0))
+
+		now = now.Add(time.Minute)
+		Expect(limiter.Status().Remaining).To(Equal(4.0))
+	})
+
+	It("shares one bucket per exchange", func() {
+		Expect(limiters.For(types.Binance)).To(BeIdenticalTo(limiter))
+		Expect(limiters.For(types.Bybit)).NotTo(BeIdenticalTo(limiter))
+		Expect(limiters.Statuses()).To(HaveKey(types.Binance))
+	})
+})
@@ -0,0 +1,7 @@
+package ratelimit
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(NewRateLimiters),
+)
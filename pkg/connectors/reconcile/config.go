@@ -0,0 +1,47 @@
+// Package reconcile polls exchange balances and positions and compares them
+// with what the accounting ledger has booked, so missed fills, manual trades
+// and unbooked transfers surface instead of silently skewing PnL
+package reconcile
+
+import (
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+)
+
+const (
+	// DefaultInterval is how often tracked exchanges are reconciled
+	DefaultInterval = time.Minute
+
+	// DiscrepanciesFileName lives under Config.Directory
+	DiscrepanciesFileName = "reconciliation.jsonl"
+
+	// JobName is the scheduler job the reconciler registers under
+	JobName = "balance-reconciliation"
+)
+
+// Config controls polling and when drift is alerted. Every discrepancy is
+// recorded; only those beyond a threshold are published as alerts.
+type Config struct {
+	RunID     string
+	Directory string
+	Interval  time.Duration
+
+	// PositionThreshold is the absolute size difference, in contracts, and
+	// BalanceThreshold the wallet difference, in the account currency. Zero
+	// alerts on any drift.
+	PositionThreshold numerical.Decimal
+	BalanceThreshold  numerical.Decimal
+}
+
+// DefaultConfig stores discrepancies under the given directory and alerts on
+// any position drift or a wallet that is off by more than one unit
+func DefaultConfig(runID, directory string) Config {
+	return Config{
+		RunID:             runID,
+		Directory:         directory,
+		Interval:          DefaultInterval,
+		PositionThreshold: numerical.Zero(),
+		BalanceThreshold:  numerical.NewFromInt(1),
+	}
+}
@@ -0,0 +1,7 @@
+package reconcile
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(NewBalanceReconciler),
+)
@@ -0,0 +1,348 @@
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/events"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/accounting"
+	"github.com/backtesting-org/live-trading/pkg/connectors/symbols"
+	"github.com/backtesting-org/live-trading/pkg/eventschema"
+	"github.com/backtesting-org/live-trading/pkg/scheduler"
+)
+
+// ErrNotConfigured is returned until Configure has opened the store
+var ErrNotConfigured = errors.New("balance reconciler not configured")
+
+// BalanceReconciler periodically compares each tracked exchange's positions
+// and wallet with the ledger. Positions are compared directly. The wallet is
+// compared against the balance seen on the first poll plus the net realized
+// PnL booked since, so deposits and withdrawals show up as drift until
+// Rebase is called.
+type BalanceReconciler interface {
+	// Configure opens the store and loads the discrepancies already on disk
+	Configure(config Config) error
+
+	Track(exchange connector.ExchangeName)
+
+	Start() error
+	Stop() error
+
+	// Reconcile polls one exchange and returns the discrepancies it recorded
+	Reconcile(exchange connector.ExchangeName) ([]Discrepancy, error)
+
+	// ReconcileAll reconciles every tracked exchange
+	ReconcileAll() error
+
+	// Rebase takes the exchange's next wallet reading as the new baseline,
+	// e.g. after a known transfer
+	Rebase(exchange connector.ExchangeName)
+
+	// Discrepancies returns a run's records at or after since, oldest first
+	Discrepancies(runID string, since time.Time) []Discrepancy
+
+	Close() error
+}
+
+// baseline is the wallet and the ledger's realized PnL at the same poll
+type baseline struct {
+	wallet   numerical.Decimal
+	realized numerical.Decimal
+}
+
+type balanceReconciler struct {
+	registry     registry.ConnectorRegistry
+	ledger       accounting.Ledger
+	symbols      symbols.SymbolMapper
+	bus          events.EventBus
+	scheduler    scheduler.Scheduler
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config    Config
+	store     *store
+	tracked   map[connector.ExchangeName]struct{}
+	baselines map[connector.ExchangeName]baseline
+	mu        sync.Mutex
+}
+
+func NewBalanceReconciler(
+	connectorRegistry registry.ConnectorRegistry,
+	ledger accounting.Ledger,
+	symbolMapper symbols.SymbolMapper,
+	bus events.EventBus,
+	jobScheduler scheduler.Scheduler,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) BalanceReconciler {
+	return &balanceReconciler{
+		registry:     connectorRegistry,
+		ledger:       ledger,
+		symbols:      symbolMapper,
+		bus:          bus,
+		scheduler:    jobScheduler,
+		timeProvider: timeProvider,
+		logger:       logger,
+		tracked:      make(map[connector.ExchangeName]struct{}),
+		baselines:    make(map[connector.ExchangeName]baseline),
+	}
+}
+
+func (r *balanceReconciler) Configure(config Config) error {
+	if config.Directory == "" {
+		return fmt.Errorf("reconciliation directory is required")
+	}
+	if config.PositionThreshold.IsNegative() || config.BalanceThreshold.IsNegative() {
+		return fmt.Errorf("reconciliation thresholds must not be negative")
+	}
+	if config.Interval <= 0 {
+		config.Interval = DefaultInterval
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.store != nil {
+		return fmt.Errorf("balance reconciler already configured")
+	}
+
+	st, err := openStore(config.Directory)
+	if err != nil {
+		return err
+	}
+
+	r.config = config
+	r.store = st
+	return nil
+}
+
+func (r *balanceReconciler) Track(exchange connector.ExchangeName) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.tracked[exchange] = struct{}{}
+}
+
+func (r *balanceReconciler) Start() error {
+	r.mu.Lock()
+	interval := r.config.Interval
+	configured := r.store != nil
+	r.mu.Unlock()
+
+	if !configured {
+		return ErrNotConfigured
+	}
+
+	return r.scheduler.Register(scheduler.Job{
+		Name:       JobName,
+		Interval:   interval,
+		RunOnStart: true,
+		Run: func(_ context.Context) error {
+			return r.ReconcileAll()
+		},
+	})
+}
+
+func (r *balanceReconciler) Stop() error {
+	return r.scheduler.Unregister(JobName)
+}
+
+func (r *balanceReconciler) Reconcile(exchange connector.ExchangeName) ([]Discrepancy, error) {
+	r.mu.Lock()
+	if r.store == nil {
+		r.mu.Unlock()
+		return nil, ErrNotConfigured
+	}
+	config := r.config
+	r.mu.Unlock()
+
+	conn, ok := r.registry.GetConnector(exchange)
+	if !ok {
+		return nil, fmt.Errorf("connector %s not registered", exchange)
+	}
+
+	// Read the ledger first: a fill booked between the two reads then
+	// shows as transient drift rather than being missed
+	booked := make(map[string]numerical.Decimal)
+	realized := numerical.Zero()
+	for _, summary := range r.ledger.Summaries() {
+		if summary.Exchange != exchange {
+			continue
+		}
+		booked[summary.Symbol] = summary.Position
+		realized = realized.Add(summary.NetRealizedPnL)
+	}
+
+	positions, err := conn.GetPositions()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch positions on %s: %w", exchange, err)
+	}
+	balance, err := conn.GetAccountBalance()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch balance on %s: %w", exchange, err)
+	}
+
+	now := r.timeProvider.Now()
+	var found []Discrepancy
+
+	held := make(map[string]numerical.Decimal, len(positions))
+	for _, position := range positions {
+		size := position.Size.Abs()
+		if position.Side == connector.OrderSideSell {
+			size = size.Neg()
+		}
+		symbol := marketSymbol(r.symbols, conn, position.Symbol)
+		held[symbol] = held[symbol].Add(size)
+	}
+
+	for symbol := range booked {
+		if _, ok := held[symbol]; !ok {
+			held[symbol] = numerical.Zero()
+		}
+	}
+	for symbol, actual := range held {
+		expected, ok := booked[symbol]
+		if !ok {
+			expected = numerical.Zero()
+		}
+		if drift := actual.Sub(expected); !drift.IsZero() {
+			found = append(found, discrepancy(config, exchange, KindPosition, symbol, expected, actual, config.PositionThreshold, now))
+		}
+	}
+
+	// Unrealized PnL is not booked, so it is taken out of the wallet
+	wallet := balance.TotalBalance.Sub(balance.UnrealizedPnL)
+
+	r.mu.Lock()
+	base, ok := r.baselines[exchange]
+	if !ok {
+		base = baseline{wallet: wallet, realized: realized}
+		r.baselines[exchange] = base
+	}
+	r.mu.Unlock()
+
+	expected := base.wallet.Add(realized.Sub(base.realized))
+	if drift := wallet.Sub(expected); !drift.IsZero() {
+		found = append(found, discrepancy(config, exchange, KindBalance, "", expected, wallet, config.BalanceThreshold, now))
+	}
+
+	sort.Slice(found, func(i, j int) bool {
+		if found[i].Kind != found[j].Kind {
+			return found[i].Kind > found[j].Kind
+		}
+		return found[i].Symbol < found[j].Symbol
+	})
+
+	r.mu.Lock()
+	if r.store == nil {
+		r.mu.Unlock()
+		return nil, ErrNotConfigured
+	}
+	err = r.store.append(found)
+	r.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, record := range found {
+		if !record.Alerted {
+			continue
+		}
+		r.logger.Warn("Reconciliation drift on %s %s %s: exchange %s, ledger %s", exchange, record.Kind, record.Symbol, record.Actual, record.Expected)
+		r.bus.Publish(eventschema.TopicReconciliation, record)
+	}
+	return found, nil
+}
+
+func (r *balanceReconciler) ReconcileAll() error {
+	r.mu.Lock()
+	exchanges := make([]connector.ExchangeName, 0, len(r.tracked))
+	for exchange := range r.tracked {
+		exchanges = append(exchanges, exchange)
+	}
+	r.mu.Unlock()
+
+	var failed []string
+	for _, exchange := range exchanges {
+		if _, err := r.Reconcile(exchange); err != nil {
+			r.logger.Warn("Balance reconciliation failed: %v", err)
+			failed = append(failed, string(exchange))
+		}
+	}
+
+	if len(failed) > 0 {
+		sort.Strings(failed)
+		return fmt.Errorf("balance reconciliation failed for %v", failed)
+	}
+	return nil
+}
+
+func (r *balanceReconciler) Rebase(exchange connector.ExchangeName) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.baselines, exchange)
+}
+
+func (r *balanceReconciler) Discrepancies(runID string, since time.Time) []Discrepancy {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.store == nil {
+		return nil
+	}
+
+	var records []Discrepancy
+	for _, record := range r.store.records {
+		if record.RunID == runID && !record.At.Before(since) {
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+func (r *balanceReconciler) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.store == nil {
+		return nil
+	}
+	err := r.store.close()
+	r.store = nil
+	return err
+}
+
+func discrepancy(config Config, exchange connector.ExchangeName, kind Kind, symbol string, expected, actual, threshold numerical.Decimal, at time.Time) Discrepancy {
+	drift := actual.Sub(expected)
+	return Discrepancy{
+		RunID:     config.RunID,
+		Exchange:  exchange,
+		Kind:      kind,
+		Symbol:    symbol,
+		Expected:  expected,
+		Actual:    actual,
+		Drift:     drift,
+		Threshold: threshold,
+		Alerted:   drift.Abs().GreaterThan(threshold),
+		At:        at,
+	}
+}
+
+// marketSymbol maps a position's asset to the native symbol the ledger books
+// fills under
+func marketSymbol(mapper symbols.SymbolMapper, conn connector.Connector, asset portfolio.Asset) string {
+	symbol, err := mapper.Resolve(conn.GetConnectorInfo().Name, asset.Symbol(), connector.TypePerpetual)
+	if err != nil {
+		return conn.GetPerpSymbol(asset)
+	}
+	return symbol
+}
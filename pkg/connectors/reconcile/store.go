@@ -0,0 +1,113 @@
+package reconcile
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/live-trading/pkg/jsonl"
+)
+
+// Kind is what was reconciled
+type Kind string
+
+const (
+	KindPosition Kind = "position"
+	KindBalance  Kind = "balance"
+)
+
+// Discrepancy is one difference between the exchange and the ledger. Drift
+// is Actual less Expected: for positions a signed size, negative for shorts,
+// and for balances the wallet difference in the account currency.
+type Discrepancy struct {
+	RunID    string
+	Exchange connector.ExchangeName
+	Kind     Kind
+
+	// Symbol is exchange-native and empty for balances
+	Symbol string
+
+	Expected  numerical.Decimal
+	Actual    numerical.Decimal
+	Drift     numerical.Decimal
+	Threshold numerical.Decimal
+
+	// Alerted is set when the drift exceeded the threshold and was published
+	Alerted bool
+	At      time.Time
+}
+
+// store is the append-only discrepancy log, held in memory once loaded
+type store struct {
+	file    *os.File
+	records []Discrepancy
+}
+
+func openStore(directory string) (*store, error) {
+	if err := os.MkdirAll(directory, 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create reconciliation directory: %w", err)
+	}
+
+	s := &store{}
+	path := filepath.Join(directory, DiscrepanciesFileName)
+	if err := s.load(path); err != nil {
+		return nil, err
+	}
+
+	file, err := jsonl.OpenAppend(path, 0o640)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open reconciliation store: %w", err)
+	}
+	s.file = file
+	return s, nil
+}
+
+func (s *store) load(path string) error {
+	err := jsonl.ScanFile(path, func(_ int, line []byte) error {
+		var record Discrepancy
+		// A torn final line from a crash mid-append is skipped
+		if err := json.Unmarshal(line, &record); err == nil {
+			s.records = append(s.records, record)
+		}
+		return nil
+	})
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read reconciliation store: %w", err)
+	}
+	return nil
+}
+
+func (s *store) append(records []Discrepancy) error {
+	var lines []byte
+	for _, record := range records {
+		line, err := jsonl.Marshal(record)
+		if err != nil {
+			return fmt.Errorf("failed to encode %s discrepancy on %s: %w", record.Kind, record.Exchange, err)
+		}
+		lines = append(lines, line...)
+	}
+
+	if len(lines) == 0 {
+		return nil
+	}
+	if _, err := s.file.Write(lines); err != nil {
+		return fmt.Errorf("failed to append discrepancies: %w", err)
+	}
+	if err := s.file.Sync(); err != nil {
+		return fmt.Errorf("failed to sync reconciliation store: %w", err)
+	}
+
+	s.records = append(s.records, records...)
+	return nil
+}
+
+func (s *store) close() error {
+	return s.file.Close()
+}
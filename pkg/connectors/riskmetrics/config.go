@@ -0,0 +1,57 @@
+package riskmetrics
+
+import "time"
+
+// Method selects how VaR and expected shortfall are estimated
+type Method string
+
+const (
+	// MethodParametric assumes normally distributed portfolio returns
+	MethodParametric Method = "parametric"
+
+	// MethodHistorical replays the lookback window's returns against current positions
+	MethodHistorical Method = "historical"
+)
+
+const (
+	// DefaultCheckInterval is how often positions are checked for significant changes
+	DefaultCheckInterval = time.Minute
+
+	// DefaultRecalculateEvery forces a recalculation even when positions are unchanged
+	DefaultRecalculateEvery = 15 * time.Minute
+
+	// JobName is the scheduler job the service registers under
+	JobName = "portfolio-var"
+)
+
+// Config controls the VaR methodology and how often it is recalculated
+type Config struct {
+	Method Method
+
+	// Confidence is the VaR confidence level, e.g. 0.99
+	Confidence float64
+
+	// Lookback is the number of candles of Interval used for returns
+	Lookback int
+	Interval string
+
+	CheckInterval    time.Duration
+	RecalculateEvery time.Duration
+
+	// PositionChangeThreshold triggers a recalculation when gross notional
+	// moves by more than this fraction since the last report
+	PositionChangeThreshold float64
+}
+
+// DefaultConfig is one-day historical VaR at 99% over a year of daily candles
+func DefaultConfig() Config {
+	return Config{
+		Method:                  MethodHistorical,
+		Confidence:              0.99,
+		Lookback:                365,
+		Interval:                "1d",
+		CheckInterval:           DefaultCheckInterval,
+		RecalculateEvery:        DefaultRecalculateEvery,
+		PositionChangeThreshold: 0.2,
+	}
+}
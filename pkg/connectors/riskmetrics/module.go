@@ -0,0 +1,7 @@
+package riskmetrics
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(NewRiskService),
+)
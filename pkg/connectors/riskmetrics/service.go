@@ -0,0 +1,367 @@
+package riskmetrics
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/symbols"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+	"github.com/backtesting-org/live-trading/pkg/scheduler"
+)
+
+// PositionRisk is one position's contribution to the portfolio
+type PositionRisk struct {
+	Exchange connector.ExchangeName
+	Asset    portfolio.Asset
+
+	// Notional is signed: negative for shorts
+	Notional numerical.Decimal
+}
+
+// Report is a point-in-time VaR and expected shortfall for the whole
+// portfolio, in account currency over one Interval
+type Report struct {
+	Method     Method
+	Confidence float64
+	Lookback   int
+	Interval   string
+
+	VaR               numerical.Decimal
+	ExpectedShortfall numerical.Decimal
+	GrossNotional     numerical.Decimal
+
+	// Observations is the number of aligned returns actually used
+	Observations int
+	Positions    []PositionRisk
+	ComputedAt   time.Time
+}
+
+// RiskService computes portfolio VaR and expected shortfall on a schedule
+// and whenever positions change significantly
+type RiskService interface {
+	Configure(config Config) error
+
+	// Start registers the recalculation job with the scheduler
+	Start() error
+	Stop() error
+
+	// Calculate recomputes the report from current positions now
+	Calculate() (*Report, error)
+	Latest() (*Report, bool)
+	Reports() <-chan Report
+}
+
+type riskService struct {
+	registry     registry.ConnectorRegistry
+	scheduler    scheduler.Scheduler
+	symbols      symbols.SymbolMapper
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config   Config
+	latest   *Report
+	reportCh chan Report
+	mu       sync.Mutex
+}
+
+func NewRiskService(
+	connectorRegistry registry.ConnectorRegistry,
+	jobScheduler scheduler.Scheduler,
+	symbolMapper symbols.SymbolMapper,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) RiskService {
+	return &riskService{
+		registry:     connectorRegistry,
+		scheduler:    jobScheduler,
+		symbols:      symbolMapper,
+		timeProvider: timeProvider,
+		logger:       logger,
+		config:       DefaultConfig(),
+		reportCh:     make(chan Report, 100),
+	}
+}
+
+func (r *riskService) Configure(config Config) error {
+	defaults := DefaultConfig()
+	if config.Method == "" {
+		config.Method = defaults.Method
+	}
+	if config.Method != MethodParametric && config.Method != MethodHistorical {
+		return fmt.Errorf("unknown VaR method: %s", config.Method)
+	}
+	if config.Confidence <= 0.5 || config.Confidence >= 1 {
+		return fmt.Errorf("confidence must be between 0.5 and 1, got %v", config.Confidence)
+	}
+	if config.Lookback < 2 {
+		return fmt.Errorf("lookback must cover at least 2 candles")
+	}
+	if config.Interval == "" {
+		config.Interval = defaults.Interval
+	}
+	if config.CheckInterval <= 0 {
+		config.CheckInterval = defaults.CheckInterval
+	}
+	if config.RecalculateEvery <= 0 {
+		config.RecalculateEvery = defaults.RecalculateEvery
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.config = config
+	return nil
+}
+
+func (r *riskService) Latest() (*Report, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.latest == nil {
+		return nil, false
+	}
+	report := *r.latest
+	return &report, true
+}
+
+func (r *riskService) Reports() <-chan Report {
+	return r.reportCh
+}
+
+func (r *riskService) Start() error {
+	r.mu.Lock()
+	interval := r.config.CheckInterval
+	r.mu.Unlock()
+
+	return r.scheduler.Register(scheduler.Job{
+		Name:       JobName,
+		Interval:   interval,
+		RunOnStart: true,
+		Run: func(_ context.Context) error {
+			return r.check()
+		},
+	})
+}
+
+func (r *riskService) Stop() error {
+	return r.scheduler.Unregister(JobName)
+}
+
+// check recalculates when the last report is stale or positions moved enough
+func (r *riskService) check() error {
+	r.mu.Lock()
+	config := r.config
+	latest := r.latest
+	r.mu.Unlock()
+
+	if latest != nil && r.timeProvider.Now().Sub(latest.ComputedAt) < config.RecalculateEvery {
+		positions, err := r.positions()
+		if err != nil {
+			return err
+		}
+		if !significantChange(latest.GrossNotional, grossNotional(positions), config.PositionChangeThreshold) {
+			return nil
+		}
+		r.logger.Info("Positions changed significantly, recalculating VaR")
+	}
+
+	_, err := r.Calculate()
+	return err
+}
+
+func (r *riskService) Calculate() (*Report, error) {
+	r.mu.Lock()
+	config := r.config
+	r.mu.Unlock()
+
+	positions, err := r.positions()
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		Method:            config.Method,
+		Confidence:        config.Confidence,
+		Lookback:          config.Lookback,
+		Interval:          config.Interval,
+		VaR:               numerical.Zero(),
+		ExpectedShortfall: numerical.Zero(),
+		GrossNotional:     grossNotional(positions),
+		Positions:         positions,
+		ComputedAt:        r.timeProvider.Now(),
+	}
+
+	if len(positions) > 0 {
+		pnl, err := r.portfolioPnL(positions, config)
+		if err != nil {
+			return nil, err
+		}
+
+		var valueAtRisk, shortfall float64
+		if config.Method == MethodParametric {
+			valueAtRisk, shortfall = parametricVaR(pnl, config.Confidence)
+		} else {
+			valueAtRisk, shortfall = historicalVaR(pnl, config.Confidence)
+		}
+
+		report.VaR = numerical.NewFromFloat(math.Max(valueAtRisk, 0))
+		report.ExpectedShortfall = numerical.NewFromFloat(math.Max(shortfall, 0))
+		report.Observations = len(pnl)
+	}
+
+	r.mu.Lock()
+	r.latest = report
+	r.mu.Unlock()
+
+	r.logger.Info("📉 Portfolio %s VaR(%.1f%%, %s): %s, ES: %s on gross notional %s",
+		report.Method, report.Confidence*100, report.Interval,
+		report.VaR.Round(2).String(), report.ExpectedShortfall.Round(2).String(), report.GrossNotional.Round(2).String())
+
+	select {
+	case r.reportCh <- *report:
+	default:
+	}
+
+	return report, nil
+}
+
+// positions collects signed notional for every open position on ready connectors
+func (r *riskService) positions() ([]PositionRisk, error) {
+	var positions []PositionRisk
+	var failed []string
+
+	for _, conn := range r.registry.GetReadyConnectors() {
+		if !conn.SupportsTradingOperations() {
+			continue
+		}
+		name := conn.GetConnectorInfo().Name
+
+		open, err := conn.GetPositions()
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", name, err))
+			continue
+		}
+
+		for _, position := range open {
+			if position.Size.IsZero() {
+				continue
+			}
+
+			price := position.MarkPrice
+			if price.IsZero() {
+				if provider, ok := conn.(types.MarkPriceProvider); ok {
+					if mark, err := provider.FetchMarkPrice(position.Symbol); err == nil {
+						price = mark.MarkPrice
+					}
+				}
+			}
+			if price.IsZero() {
+				quote, err := conn.FetchPrice(marketSymbol(r.symbols, conn, position.Symbol))
+				if err != nil {
+					failed = append(failed, fmt.Sprintf("%s %s: %v", name, position.Symbol.Symbol(), err))
+					continue
+				}
+				price = quote.Price
+			}
+
+			notional := position.Size.Abs().Mul(price)
+			if position.Side == connector.OrderSideSell {
+				notional = notional.Neg()
+			}
+
+			positions = append(positions, PositionRisk{Exchange: name, Asset: position.Symbol, Notional: notional})
+		}
+	}
+
+	// VaR on a partial portfolio would understate risk
+	if len(failed) > 0 {
+		return nil, fmt.Errorf("positions unavailable: %s", strings.Join(failed, "; "))
+	}
+	return positions, nil
+}
+
+// portfolioPnL applies each period's returns to current notional. Series
+// are aligned on their most recent candles so correlation is preserved.
+func (r *riskService) portfolioPnL(positions []PositionRisk, config Config) ([]float64, error) {
+	returns := make([][]float64, len(positions))
+	observations := config.Lookback
+
+	for i, position := range positions {
+		conn, ok := r.registry.GetConnector(position.Exchange)
+		if !ok {
+			return nil, fmt.Errorf("connector %s not registered", position.Exchange)
+		}
+
+		klines, err := conn.FetchKlines(marketSymbol(r.symbols, conn, position.Asset), config.Interval, config.Lookback+1)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch %s history on %s: %w", position.Asset.Symbol(), position.Exchange, err)
+		}
+
+		returns[i] = simpleReturns(klines)
+		if len(returns[i]) < observations {
+			observations = len(returns[i])
+		}
+	}
+
+	if observations < 2 {
+		return nil, fmt.Errorf("not enough price history to estimate VaR")
+	}
+
+	pnl := make([]float64, observations)
+	for i, position := range positions {
+		notional := position.Notional.InexactFloat64()
+		series := returns[i][len(returns[i])-observations:]
+		for t, ret := range series {
+			pnl[t] += notional * ret
+		}
+	}
+
+	return pnl, nil
+}
+
+// marketSymbol maps a position's asset to the connector's native symbol. Some
+// connectors report positions by base asset and others by full market
+// symbol; the mapper accepts either.
+func marketSymbol(mapper symbols.SymbolMapper, conn connector.Connector, asset portfolio.Asset) string {
+	symbol, err := mapper.Resolve(conn.GetConnectorInfo().Name, asset.Symbol(), connector.TypePerpetual)
+	if err != nil {
+		return conn.GetPerpSymbol(asset)
+	}
+	return symbol
+}
+
+func simpleReturns(klines []connector.Kline) []float64 {
+	var returns []float64
+	for i := 1; i < len(klines); i++ {
+		previous := klines[i-1].Close.InexactFloat64()
+		if previous == 0 {
+			continue
+		}
+		returns = append(returns, klines[i].Close.InexactFloat64()/previous-1)
+	}
+	return returns
+}
+
+func grossNotional(positions []PositionRisk) numerical.Decimal {
+	total := numerical.Zero()
+	for _, position := range positions {
+		total = total.Add(position.Notional.Abs())
+	}
+	return total
+}
+
+func significantChange(previous, current numerical.Decimal, threshold float64) bool {
+	if previous.IsZero() {
+		return !current.IsZero()
+	}
+	change := current.Sub(previous).Abs().Div(previous).InexactFloat64()
+	return change > threshold
+}
@@ -0,0 +1,86 @@
+package riskmetrics
+
+import (
+	"math"
+	"sort"
+)
+
+// historicalVaR returns VaR and ES as positive losses from a P&L sample
+func historicalVaR(pnl []float64, confidence float64) (float64, float64) {
+	sorted := append([]float64(nil), pnl...)
+	sort.Float64s(sorted)
+
+	// Number of observations in the loss tail, at least one
+	tail := int(math.Ceil(float64(len(sorted)) * (1 - confidence)))
+	if tail < 1 {
+		tail = 1
+	}
+
+	sum := 0.0
+	for _, value := range sorted[:tail] {
+		sum += value
+	}
+
+	return -sorted[tail-1], -sum / float64(tail)
+}
+
+// parametricVaR returns VaR and ES as positive losses under a normal fit of pnl
+func parametricVaR(pnl []float64, confidence float64) (float64, float64) {
+	mean, stddev := meanStdDev(pnl)
+	z := normalQuantile(confidence)
+	density := math.Exp(-z*z/2) / math.Sqrt(2*math.Pi)
+
+	return stddev*z - mean, stddev*density/(1-confidence) - mean
+}
+
+func meanStdDev(values []float64) (float64, float64) {
+	if len(values) == 0 {
+		return 0, 0
+	}
+
+	mean := 0.0
+	for _, value := range values {
+		mean += value
+	}
+	mean /= float64(len(values))
+
+	if len(values) < 2 {
+		return mean, 0
+	}
+
+	variance := 0.0
+	for _, value := range values {
+		variance += (value - mean) * (value - mean)
+	}
+	return mean, math.Sqrt(variance / float64(len(values)-1))
+}
+
+// normalQuantile is the inverse standard normal CDF (Acklam's approximation,
+// relative error below 1.15e-9)
+func normalQuantile(p float64) float64 {
+	a := [6]float64{-3.969683028665376e+01, 2.209460984245205e+02, -2.759285104469687e+02,
+		1.383577518672690e+02, -3.066479806614716e+01, 2.506628277459239e+00}
+	b := [5]float64{-5.447609879822406e+01, 1.615858368580409e+02, -1.556989798598866e+02,
+		6.680131188771972e+01, -1.328068155288572e+01}
+	c := [6]float64{-7.784894002430293e-03, -3.223964580411365e-01, -2.400758277161838e+00,
+		-2.549732539343734e+00, 4.374664141464968e+00, 2.938163982698783e+00}
+	d := [4]float64{7.784695709041462e-03, 3.224671290700398e-01, 2.445134137142996e+00,
+		3.754408661907416e+00}
+
+	const low = 0.02425
+	switch {
+	case p < low:
+		q := math.Sqrt(-2 * math.Log(p))
+		return (((((c[0]*q+c[1])*q+c[2])*q+c[3])*q+c[4])*q + c[5]) /
+			((((d[0]*q+d[1])*q+d[2])*q+d[3])*q + 1)
+	case p > 1-low:
+		q := math.Sqrt(-2 * math.Log(1-p))
+		return -(((((c[0]*q+c[1])*q+c[2])*q+c[3])*q+c[4])*q + c[5]) /
+			((((d[0]*q+d[1])*q+d[2])*q+d[3])*q + 1)
+	default:
+		q := p - 0.5
+		r := q * q
+		return (((((a[0]*r+a[1])*r+a[2])*r+a[3])*r+a[4])*r + a[5]) * q /
+			(((((b[0]*r+b[1])*r+b[2])*r+b[3])*r+b[4])*r + 1)
+	}
+}
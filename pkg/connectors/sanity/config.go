@@ -0,0 +1,43 @@
+package sanity
+
+import "time"
+
+const (
+	// DefaultMaxDeviation is the relative distance from the consensus price
+	// beyond which a quote is flagged (0.02 = 2%)
+	DefaultMaxDeviation = 0.02
+
+	// DefaultMaxAge is how old a quote may be before it is treated as stale
+	DefaultMaxAge = 30 * time.Second
+
+	// DefaultMinSources is the number of quotes needed to form a consensus
+	DefaultMinSources = 2
+
+	// DefaultInterval is how often every required asset is cross-checked
+	DefaultInterval = 15 * time.Second
+
+	// JobName is the scheduler job the checker registers under
+	JobName = "price-sanity"
+)
+
+// Config controls price cross-validation thresholds
+type Config struct {
+	MaxDeviation   float64
+	MaxAge         time.Duration
+	MinSources     int
+	BlockOnSuspect bool
+
+	// Interval is how often the scheduled job runs CheckAll
+	Interval time.Duration
+}
+
+// DefaultConfig returns thresholds suitable for liquid perpetual markets
+func DefaultConfig() Config {
+	return Config{
+		MaxDeviation:   DefaultMaxDeviation,
+		MaxAge:         DefaultMaxAge,
+		MinSources:     DefaultMinSources,
+		BlockOnSuspect: true,
+		Interval:       DefaultInterval,
+	}
+}
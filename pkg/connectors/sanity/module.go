@@ -0,0 +1,26 @@
+package sanity
+
+import (
+	"context"
+
+	"go.uber.org/fx"
+)
+
+var Module = fx.Options(
+	fx.Provide(
+		NewPriceSanityChecker,
+	),
+	fx.Invoke(registerHooks),
+)
+
+// registerHooks cross-checks prices for the application's lifetime
+func registerHooks(lifecycle fx.Lifecycle, checker PriceSanityChecker) {
+	lifecycle.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			return checker.Start()
+		},
+		OnStop: func(context.Context) error {
+			return checker.Stop()
+		},
+	})
+}
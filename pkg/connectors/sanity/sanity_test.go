@@ -0,0 +1,13 @@
+package sanity_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSanity(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Sanity Suite")
+}
@@ -0,0 +1,309 @@
+package sanity
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/execution"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/scheduler"
+)
+
+// ReferencePriceSource supplies an independent index price for an asset
+type ReferencePriceSource interface {
+	ReferencePrice(asset portfolio.Asset) (numerical.Decimal, error)
+}
+
+// PriceSanityChecker cross-validates an asset's price across ready connectors
+type PriceSanityChecker interface {
+	// Check fetches a quote from every ready connector and flags outliers
+	Check(asset portfolio.Asset) (*CheckResult, error)
+
+	// CheckAll checks every asset the run requires, as the scheduled job
+	// does. It is a no-op while too few connectors are ready to form a
+	// consensus without a reference source.
+	CheckAll() error
+
+	// Start registers CheckAll with the scheduler
+	Start() error
+	Stop() error
+
+	// IsSuspect reports whether the last check flagged this asset on this exchange
+	IsSuspect(asset portfolio.Asset, exchange connector.ExchangeName) bool
+
+	// Guard returns an error when execution against a suspect price should be blocked
+	Guard(asset portfolio.Asset, exchange connector.ExchangeName) error
+
+	// Wrap returns an executor that guards every traded market before inner
+	Wrap(inner execution.Executor) execution.Executor
+
+	SetReferenceSource(source ReferencePriceSource)
+	Configure(config Config)
+}
+
+// Quote is a single exchange's price as seen during a check
+type Quote struct {
+	Exchange  connector.ExchangeName
+	Price     numerical.Decimal
+	Timestamp time.Time
+	Deviation float64
+	Suspect   bool
+	Reason    string
+}
+
+// CheckResult is the outcome of one cross-validation pass for an asset
+type CheckResult struct {
+	Asset     portfolio.Asset
+	Consensus numerical.Decimal
+	Quotes    []Quote
+	CheckedAt time.Time
+}
+
+type priceSanityChecker struct {
+	registry     registry.ConnectorRegistry
+	assets       registry.AssetRegistry
+	scheduler    scheduler.Scheduler
+	config       Config
+	reference    ReferencePriceSource
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	// suspects keyed by asset symbol then exchange, holding the flag reason
+	suspects map[string]map[connector.ExchangeName]string
+	mu       sync.RWMutex
+}
+
+func NewPriceSanityChecker(
+	connectorRegistry registry.ConnectorRegistry,
+	assetRegistry registry.AssetRegistry,
+	jobScheduler scheduler.Scheduler,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) PriceSanityChecker {
+	return &priceSanityChecker{
+		registry:     connectorRegistry,
+		assets:       assetRegistry,
+		scheduler:    jobScheduler,
+		config:       DefaultConfig(),
+		timeProvider: timeProvider,
+		logger:       logger,
+		suspects:     make(map[string]map[connector.ExchangeName]string),
+	}
+}
+
+func (p *priceSanityChecker) SetReferenceSource(source ReferencePriceSource) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.reference = source
+}
+
+func (p *priceSanityChecker) Configure(config Config) {
+	if config.MinSources < 1 {
+		config.MinSources = 1
+	}
+	if config.Interval <= 0 {
+		config.Interval = DefaultInterval
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.config = config
+}
+
+func (p *priceSanityChecker) Check(asset portfolio.Asset) (*CheckResult, error) {
+	p.mu.RLock()
+	config := p.config
+	p.mu.RUnlock()
+
+	now := p.timeProvider.Now()
+	result := &CheckResult{Asset: asset, CheckedAt: now}
+
+	for _, conn := range p.registry.GetReadyConnectors() {
+		info := conn.GetConnectorInfo()
+		if info == nil {
+			continue
+		}
+
+		price, err := conn.FetchPrice(conn.GetPerpSymbol(asset))
+		if err != nil {
+			p.logger.Warn("Price sanity: %s quote for %s unavailable: %v", info.Name, asset.Symbol(), err)
+			continue
+		}
+
+		result.Quotes = append(result.Quotes, Quote{
+			Exchange:  info.Name,
+			Price:     price.Price,
+			Timestamp: price.Timestamp,
+		})
+	}
+
+	consensus, err := p.consensus(asset, result.Quotes, config.MinSources)
+	if err != nil {
+		return nil, err
+	}
+	if !consensus.IsPositive() {
+		return nil, fmt.Errorf("no positive consensus price for %s", asset.Symbol())
+	}
+	result.Consensus = consensus
+
+	flagged := make(map[connector.ExchangeName]string)
+	for i := range result.Quotes {
+		quote := &result.Quotes[i]
+		quote.Deviation = quote.Price.Sub(consensus).Abs().Div(consensus).InexactFloat64()
+
+		switch {
+		case !quote.Timestamp.IsZero() && now.Sub(quote.Timestamp) > config.MaxAge:
+			quote.Suspect = true
+			quote.Reason = fmt.Sprintf("stale by %s", now.Sub(quote.Timestamp))
+		case quote.Deviation > config.MaxDeviation:
+			quote.Suspect = true
+			quote.Reason = fmt.Sprintf("deviates %.2f%% from consensus %s", quote.Deviation*100, consensus.String())
+		}
+
+		if quote.Suspect {
+			flagged[quote.Exchange] = quote.Reason
+			p.logger.Warn("Price sanity: %s %s flagged: %s", quote.Exchange, asset.Symbol(), quote.Reason)
+		}
+	}
+
+	p.mu.Lock()
+	p.suspects[asset.Symbol()] = flagged
+	p.mu.Unlock()
+
+	return result, nil
+}
+
+func (p *priceSanityChecker) CheckAll() error {
+	p.mu.RLock()
+	minSources := p.config.MinSources
+	hasReference := p.reference != nil
+	p.mu.RUnlock()
+
+	if !hasReference && len(p.registry.GetReadyConnectors()) < minSources {
+		return nil
+	}
+
+	var failed []string
+	for _, asset := range p.assets.GetRequiredAssets() {
+		if _, err := p.Check(asset); err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", asset.Symbol(), err))
+		}
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("price sanity check failed: %s", strings.Join(failed, "; "))
+	}
+	return nil
+}
+
+func (p *priceSanityChecker) Start() error {
+	p.mu.RLock()
+	interval := p.config.Interval
+	p.mu.RUnlock()
+
+	return p.scheduler.Register(scheduler.Job{
+		Name:       JobName,
+		Interval:   interval,
+		RunOnStart: true,
+		Run: func(_ context.Context) error {
+			return p.CheckAll()
+		},
+	})
+}
+
+func (p *priceSanityChecker) Stop() error {
+	return p.scheduler.Unregister(JobName)
+}
+
+func (p *priceSanityChecker) IsSuspect(asset portfolio.Asset, exchange connector.ExchangeName) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	_, suspect := p.suspects[asset.Symbol()][exchange]
+	return suspect
+}
+
+func (p *priceSanityChecker) Guard(asset portfolio.Asset, exchange connector.ExchangeName) error {
+	p.mu.RLock()
+	block := p.config.BlockOnSuspect
+	reason, suspect := p.suspects[asset.Symbol()][exchange]
+	p.mu.RUnlock()
+
+	if block && suspect {
+		return fmt.Errorf("price for %s on %s is suspect: %s", asset.Symbol(), exchange, reason)
+	}
+
+	return nil
+}
+
+func (p *priceSanityChecker) Wrap(inner execution.Executor) execution.Executor {
+	return &guardedExecutor{checker: p, inner: inner}
+}
+
+// consensus prefers the reference index and falls back to the median quote
+func (p *priceSanityChecker) consensus(asset portfolio.Asset, quotes []Quote, minSources int) (numerical.Decimal, error) {
+	p.mu.RLock()
+	reference := p.reference
+	p.mu.RUnlock()
+
+	if reference != nil {
+		price, err := reference.ReferencePrice(asset)
+		if err == nil && price.IsPositive() {
+			return price, nil
+		}
+		p.logger.Warn("Price sanity: reference price for %s unavailable, using median: %v", asset.Symbol(), err)
+	}
+
+	if len(quotes) < minSources {
+		return numerical.Zero(), fmt.Errorf("need %d quotes for %s, got %d", minSources, asset.Symbol(), len(quotes))
+	}
+
+	prices := make([]numerical.Decimal, len(quotes))
+	for i, quote := range quotes {
+		prices[i] = quote.Price
+	}
+	sort.Slice(prices, func(i, j int) bool { return prices[i].LessThan(prices[j]) })
+
+	middle := len(prices) / 2
+	if len(prices)%2 == 1 {
+		return prices[middle], nil
+	}
+
+	return prices[middle-1].Add(prices[middle]).Div(numerical.NewFromInt(2)), nil
+}
+
+type guardedExecutor struct {
+	checker *priceSanityChecker
+	inner   execution.Executor
+}
+
+func (e *guardedExecutor) ExecuteSignal(signal *strategy.Signal) error {
+	if signal == nil {
+		return fmt.Errorf("signal is nil")
+	}
+
+	for _, action := range signal.Actions {
+		if action.Action == strategy.ActionHold {
+			continue
+		}
+		if err := e.checker.Guard(action.Asset, action.Exchange); err != nil {
+			e.checker.logger.Warn("Price sanity: refused signal from %s: %v", signal.Strategy, err)
+			return err
+		}
+	}
+	return e.inner.ExecuteSignal(signal)
+}
+
+func (e *guardedExecutor) HandleTradeExecution(trade connector.Trade) error {
+	return e.inner.HandleTradeExecution(trade)
+}
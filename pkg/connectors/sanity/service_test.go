@@ -0,0 +1,120 @@
+package sanity_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	mockexecution "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/execution"
+	mockregistry "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	sdkregistry "github.com/backtesting-org/kronos-sdk/pkg/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/execution"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	logger "github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+	mockscheduler "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/scheduler"
+	"github.com/backtesting-org/live-trading/pkg/connectors/fake"
+	"github.com/backtesting-org/live-trading/pkg/connectors/sanity"
+	"github.com/backtesting-org/live-trading/pkg/scheduler"
+)
+
+var _ = Describe("PriceSanityChecker", func() {
+	var (
+		btc      portfolio.Asset
+		checker  sanity.PriceSanityChecker
+		jobs     *mockscheduler.Scheduler
+		inner    *mockexecution.Executor
+		executor execution.Executor
+	)
+
+	BeforeEach(func() {
+		btc = portfolio.NewAsset("BTC")
+		clock := fake.NewClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+
+		connectors := sdkregistry.NewConnectorRegistry()
+		for name, price := range map[connector.ExchangeName]int64{"alpha": 100, "beta": 101, "gamma": 150} {
+			exchange := fake.NewConnector(name, clock)
+			Expect(exchange.Initialize(&fake.Config{Exchange: name})).To(Succeed())
+			exchange.SetPrice(btc, numerical.NewFromInt(price))
+			connectors.RegisterConnector(name, exchange)
+			Expect(connectors.MarkConnectorReady(name)).To(Succeed())
+		}
+
+		assets := mockregistry.NewAssetRegistry(GinkgoT())
+		assets.On("GetRequiredAssets").Return([]portfolio.Asset{btc}).Maybe()
+
+		jobs = mockscheduler.NewScheduler(GinkgoT())
+
+		checker = sanity.NewPriceSanityChecker(connectors, assets, jobs, clock, logger.NewNoOpLogger())
+
+		inner = mockexecution.NewExecutor(GinkgoT())
+		executor = checker.Wrap(inner)
+	})
+
+	signal := func(exchange connector.ExchangeName) *strategy.Signal {
+		return &strategy.Signal{
+			Strategy: "momentum",
+			Actions: []strategy.TradeAction{{
+				Action:   strategy.ActionBuy,
+				Asset:    btc,
+				Exchange: exchange,
+				Quantity: numerical.NewFromInt(1),
+				Price:    numerical.NewFromInt(150),
+			}},
+		}
+	}
+
+	It("flags the venue that deviates from the consensus", func() {
+		Expect(checker.CheckAll()).To(Succeed())
+
+		Expect(checker.IsSuspect(btc, "gamma")).To(BeTrue())
+		Expect(checker.IsSuspect(btc, "alpha")).To(BeFalse())
+		Expect(checker.IsSuspect(btc, "beta")).To(BeFalse())
+	})
+
+	It("rejects an order on a suspect price before it reaches the executor", func() {
+		Expect(checker.CheckAll()).To(Succeed())
+
+		err := executor.ExecuteSignal(signal("gamma"))
+		Expect(err).To(MatchError(ContainSubstring("price for BTC on gamma is suspect")))
+		inner.AssertNotCalled(GinkgoT(), "ExecuteSignal", mock.Anything)
+	})
+
+	It("passes orders on sound prices through", func() {
+		Expect(checker.CheckAll()).To(Succeed())
+
+		s := signal("alpha")
+		inner.On("ExecuteSignal", s).Return(nil).Once()
+		Expect(executor.ExecuteSignal(s)).To(Succeed())
+	})
+
+	It("lets orders through when blocking is off", func() {
+		config := sanity.DefaultConfig()
+		config.BlockOnSuspect = false
+		checker.Configure(config)
+		Expect(checker.CheckAll()).To(Succeed())
+
+		s := signal("gamma")
+		inner.On("ExecuteSignal", s).Return(nil).Once()
+		Expect(executor.ExecuteSignal(s)).To(Succeed())
+	})
+
+	It("runs CheckAll as a scheduler job", func() {
+		var job scheduler.Job
+		jobs.On("Register", mock.Anything).Run(func(args mock.Arguments) {
+			job = args.Get(0).(scheduler.Job)
+		}).Return(nil).Once()
+
+		Expect(checker.Start()).To(Succeed())
+		Expect(job.Name).To(Equal(sanity.JobName))
+		Expect(job.Interval).To(Equal(sanity.DefaultInterval))
+
+		Expect(job.Run(context.Background())).To(Succeed())
+		Expect(checker.IsSuspect(btc, "gamma")).To(BeTrue())
+	})
+})
@@ -0,0 +1,16 @@
+package switches
+
+import (
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"go.uber.org/fx"
+)
+
+var Module = fx.Options(
+	fx.Provide(NewTradingSwitches),
+	fx.Invoke(registerHooks),
+)
+
+// registerHooks has the SDK executor refuse signals for switched assets
+func registerHooks(hooks registry.Hooks, switches TradingSwitches) {
+	hooks.RegisterHook(switches.Hook())
+}
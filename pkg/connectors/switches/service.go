@@ -0,0 +1,269 @@
+package switches
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/execution"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/symbols"
+)
+
+// ErrTradingDisabled is matched by errors.Is for orders refused by a switch
+var ErrTradingDisabled = errors.New("trading disabled")
+
+// DisabledError names the switch that refused an order
+type DisabledError struct {
+	Switch Switch
+}
+
+func (e *DisabledError) Error() string {
+	mode := "disabled"
+	if e.Switch.ReduceOnly {
+		mode = "restricted to reduce-only"
+	}
+	return fmt.Sprintf("trading %s on %s %s by %s: %s", e.Switch.Asset, scopeName(e.Switch.Exchange), mode, e.Switch.Actor, e.Switch.Reason)
+}
+
+func (e *DisabledError) Unwrap() error {
+	return ErrTradingDisabled
+}
+
+// TradingSwitches lets operators stop trading one asset, everywhere or on a
+// single exchange, without touching strategies. Every change is audited.
+type TradingSwitches interface {
+	// Disable stops new orders for the asset; pass AllExchanges for every exchange
+	Disable(asset portfolio.Asset, exchange connector.ExchangeName, reason, actor string) error
+
+	// RestrictToReduceOnly admits only orders that shrink an existing position
+	RestrictToReduceOnly(asset portfolio.Asset, exchange connector.ExchangeName, reason, actor string) error
+	Enable(asset portfolio.Asset, exchange connector.ExchangeName, actor string) error
+
+	// Check returns a *DisabledError when the asset may not be traded on the
+	// exchange; reduce-only switches refuse here too
+	Check(asset portfolio.Asset, exchange connector.ExchangeName) error
+
+	// CheckSymbol resolves an exchange-native symbol to its asset and checks it
+	CheckSymbol(exchange connector.ExchangeName, symbol string) error
+
+	// CheckOrder is CheckSymbol for an order that declares whether it only
+	// reduces a position, which reduce-only switches admit
+	CheckOrder(exchange connector.ExchangeName, symbol string, reduceOnly bool) error
+
+	// CheckSignal checks every action of a signal; close actions count as
+	// reduce-only, anything else may add to a position
+	CheckSignal(signal *strategy.Signal) error
+
+	// Hook refuses, through the SDK hook registry, every signal that
+	// CheckSignal refuses, so strategy orders are switched like the
+	// executors' own
+	Hook() execution.ExecutionHook
+
+	Switches() []Switch
+	Audit(limit int) []AuditEntry
+	SetStore(store Store)
+}
+
+type tradingSwitches struct {
+	symbols      symbols.SymbolMapper
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	store Store
+	mu    sync.RWMutex
+}
+
+func NewTradingSwitches(
+	symbolMapper symbols.SymbolMapper,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) TradingSwitches {
+	return &tradingSwitches{
+		symbols:      symbolMapper,
+		timeProvider: timeProvider,
+		logger:       logger,
+		store:        newMemoryStore(),
+	}
+}
+
+func (t *tradingSwitches) SetStore(store Store) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.store = store
+}
+
+func (t *tradingSwitches) Disable(asset portfolio.Asset, exchange connector.ExchangeName, reason, actor string) error {
+	return t.set(asset, exchange, false, reason, actor)
+}
+
+func (t *tradingSwitches) RestrictToReduceOnly(asset portfolio.Asset, exchange connector.ExchangeName, reason, actor string) error {
+	return t.set(asset, exchange, true, reason, actor)
+}
+
+func (t *tradingSwitches) set(asset portfolio.Asset, exchange connector.ExchangeName, reduceOnly bool, reason, actor string) error {
+	if !asset.IsValid() {
+		return fmt.Errorf("asset is required")
+	}
+	if reason == "" {
+		return fmt.Errorf("a reason is required to restrict trading")
+	}
+	if actor == "" {
+		return fmt.Errorf("an actor is required to restrict trading")
+	}
+
+	now := t.timeProvider.Now()
+	symbol := normaliseAsset(asset)
+	action := ActionDisable
+	if reduceOnly {
+		action = ActionReduceOnly
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.store.Put(Switch{Asset: symbol, Exchange: exchange, ReduceOnly: reduceOnly, Reason: reason, Actor: actor, Since: now})
+	t.store.Append(AuditEntry{Action: action, Asset: symbol, Exchange: exchange, Reason: reason, Actor: actor, At: now})
+
+	t.logger.Warn("🚫 Trading %s set to %s on %s by %s: %s", symbol, action, scopeName(exchange), actor, reason)
+	return nil
+}
+
+func (t *tradingSwitches) Enable(asset portfolio.Asset, exchange connector.ExchangeName, actor string) error {
+	if actor == "" {
+		return fmt.Errorf("an actor is required to enable trading")
+	}
+
+	symbol := normaliseAsset(asset)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, exists := t.findLocked(symbol, exchange); !exists {
+		return fmt.Errorf("trading %s on %s is not disabled", symbol, scopeName(exchange))
+	}
+
+	t.store.Delete(symbol, exchange)
+	t.store.Append(AuditEntry{Action: ActionEnable, Asset: symbol, Exchange: exchange, Actor: actor, At: t.timeProvider.Now()})
+
+	t.logger.Info("Trading %s re-enabled on %s by %s", symbol, scopeName(exchange), actor)
+	return nil
+}
+
+func (t *tradingSwitches) Check(asset portfolio.Asset, exchange connector.ExchangeName) error {
+	return t.check(asset, exchange, false)
+}
+
+func (t *tradingSwitches) CheckSymbol(exchange connector.ExchangeName, symbol string) error {
+	return t.CheckOrder(exchange, symbol, false)
+}
+
+func (t *tradingSwitches) CheckOrder(exchange connector.ExchangeName, symbol string, reduceOnly bool) error {
+	asset, _, err := t.symbols.FromNative(exchange, symbol)
+	if err != nil {
+		// Unknown formats are checked as the bare symbol
+		asset = portfolio.NewAsset(symbol)
+	}
+	return t.check(asset, exchange, reduceOnly)
+}
+
+func (t *tradingSwitches) CheckSignal(signal *strategy.Signal) error {
+	if signal == nil {
+		return nil
+	}
+	for _, action := range signal.Actions {
+		if action.Action == strategy.ActionHold {
+			continue
+		}
+		if err := t.check(action.Asset, action.Exchange, action.Action == strategy.ActionClose); err != nil {
+			return fmt.Errorf("signal %s from %s: %w", signal.ID, signal.Strategy, err)
+		}
+	}
+	return nil
+}
+
+func (t *tradingSwitches) Hook() execution.ExecutionHook {
+	return &switchHook{switches: t}
+}
+
+func (t *tradingSwitches) check(asset portfolio.Asset, exchange connector.ExchangeName, reduceOnly bool) error {
+	symbol := normaliseAsset(asset)
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	// An asset-wide switch wins over an exchange-specific one
+	for _, scope := range []connector.ExchangeName{AllExchanges, exchange} {
+		sw, exists := t.findLocked(symbol, scope)
+		if !exists || (sw.ReduceOnly && reduceOnly) {
+			continue
+		}
+		return &DisabledError{Switch: sw}
+	}
+	return nil
+}
+
+func (t *tradingSwitches) Switches() []Switch {
+	t.mu.RLock()
+	switches := t.store.Switches()
+	t.mu.RUnlock()
+
+	sort.Slice(switches, func(i, j int) bool {
+		if switches[i].Asset != switches[j].Asset {
+			return switches[i].Asset < switches[j].Asset
+		}
+		return switches[i].Exchange < switches[j].Exchange
+	})
+	return switches
+}
+
+func (t *tradingSwitches) Audit(limit int) []AuditEntry {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.store.Audit(limit)
+}
+
+// findLocked looks up one switch; caller must hold t.mu
+func (t *tradingSwitches) findLocked(symbol string, exchange connector.ExchangeName) (Switch, bool) {
+	for _, sw := range t.store.Switches() {
+		if sw.Asset == symbol && sw.Exchange == exchange {
+			return sw, true
+		}
+	}
+	return Switch{}, false
+}
+
+func normaliseAsset(asset portfolio.Asset) string {
+	return strings.ToUpper(asset.Symbol())
+}
+
+func scopeName(exchange connector.ExchangeName) string {
+	if exchange == AllExchanges {
+		return "all exchanges"
+	}
+	return string(exchange)
+}
+
+// switchHook checks signals inside the SDK executor, which places strategy
+// orders without going through CheckOrder
+type switchHook struct {
+	switches *tradingSwitches
+}
+
+func (h *switchHook) BeforeExecute(ctx *execution.ExecutionContext) error {
+	return h.switches.CheckSignal(ctx.Signal)
+}
+
+func (h *switchHook) AfterExecute(*execution.ExecutionContext, *execution.ExecutionResult) error {
+	return nil
+}
+
+func (h *switchHook) OnError(*execution.ExecutionContext, error) error {
+	return nil
+}
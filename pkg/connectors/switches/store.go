@@ -0,0 +1,101 @@
+package switches
+
+import (
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+)
+
+// AllExchanges scopes a switch to an asset on every exchange
+const AllExchanges connector.ExchangeName = ""
+
+// Switch disables trading of one asset, on one exchange or on all of them
+type Switch struct {
+	Asset    string
+	Exchange connector.ExchangeName
+
+	// ReduceOnly still admits orders that only shrink an existing position
+	ReduceOnly bool
+
+	Reason string
+	Actor  string
+	Since  time.Time
+}
+
+// Action is what an audit entry records
+type Action string
+
+const (
+	ActionDisable    Action = "disable"
+	ActionReduceOnly Action = "reduce-only"
+	ActionEnable     Action = "enable"
+)
+
+// AuditEntry records who changed a switch, when, and why
+type AuditEntry struct {
+	Action   Action
+	Asset    string
+	Exchange connector.ExchangeName
+	Reason   string
+	Actor    string
+	At       time.Time
+}
+
+// Store persists switches and their audit trail. The default keeps both in
+// memory; a durable store can be swapped in with SetStore.
+type Store interface {
+	Switches() []Switch
+	Put(sw Switch)
+	Delete(asset string, exchange connector.ExchangeName)
+	Append(entry AuditEntry)
+	Audit(limit int) []AuditEntry
+}
+
+type switchKey struct {
+	asset    string
+	exchange connector.ExchangeName
+}
+
+type memoryStore struct {
+	switches map[switchKey]Switch
+	audit    []AuditEntry
+}
+
+// newMemoryStore is not safe for concurrent use; the service serialises access
+func newMemoryStore() *memoryStore {
+	return &memoryStore{switches: make(map[switchKey]Switch)}
+}
+
+func (m *memoryStore) Switches() []Switch {
+	switches := make([]Switch, 0, len(m.switches))
+	for _, sw := range m.switches {
+		switches = append(switches, sw)
+	}
+	return switches
+}
+
+func (m *memoryStore) Put(sw Switch) {
+	m.switches[switchKey{asset: sw.Asset, exchange: sw.Exchange}] = sw
+}
+
+func (m *memoryStore) Delete(asset string, exchange connector.ExchangeName) {
+	delete(m.switches, switchKey{asset: asset, exchange: exchange})
+}
+
+func (m *memoryStore) Append(entry AuditEntry) {
+	m.audit = append(m.audit, entry)
+}
+
+func (m *memoryStore) Audit(limit int) []AuditEntry {
+	entries := m.audit
+	if limit > 0 && len(entries) > limit {
+		entries = entries[len(entries)-limit:]
+	}
+
+	// Newest first
+	result := make([]AuditEntry, len(entries))
+	for i, entry := range entries {
+		result[len(entries)-1-i] = entry
+	}
+	return result
+}
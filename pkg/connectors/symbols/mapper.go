@@ -0,0 +1,101 @@
+package symbols
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+)
+
+// SymbolMapper is the single place symbol formats are looked up; each
+// connector module registers its exchange's rule at startup
+type SymbolMapper interface {
+	Register(exchange connector.ExchangeName, rule Rule)
+
+	ToNative(exchange connector.ExchangeName, asset portfolio.Asset, instrument connector.Instrument) (string, error)
+	FromNative(exchange connector.ExchangeName, symbol string) (portfolio.Asset, connector.Instrument, error)
+	Resolve(exchange connector.ExchangeName, symbol string, instrument connector.Instrument) (string, error)
+
+	Exchanges() []connector.ExchangeName
+}
+
+type symbolMapper struct {
+	rules map[connector.ExchangeName]Rule
+	mu    sync.RWMutex
+}
+
+func NewSymbolMapper() SymbolMapper {
+	return &symbolMapper{
+		rules: make(map[connector.ExchangeName]Rule),
+	}
+}
+
+func (m *symbolMapper) Register(exchange connector.ExchangeName, rule Rule) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.rules[exchange] = rule
+}
+
+func (m *symbolMapper) ToNative(exchange connector.ExchangeName, asset portfolio.Asset, instrument connector.Instrument) (string, error) {
+	rule, err := m.rule(exchange)
+	if err != nil {
+		return "", err
+	}
+
+	symbol, err := rule.ToNative(asset, instrument)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", exchange, err)
+	}
+	return symbol, nil
+}
+
+func (m *symbolMapper) FromNative(exchange connector.ExchangeName, symbol string) (portfolio.Asset, connector.Instrument, error) {
+	rule, err := m.rule(exchange)
+	if err != nil {
+		return portfolio.Asset{}, "", err
+	}
+
+	asset, instrument, err := rule.FromNative(symbol)
+	if err != nil {
+		return portfolio.Asset{}, "", fmt.Errorf("%s: %w", exchange, err)
+	}
+	return asset, instrument, nil
+}
+
+func (m *symbolMapper) Resolve(exchange connector.ExchangeName, symbol string, instrument connector.Instrument) (string, error) {
+	rule, err := m.rule(exchange)
+	if err != nil {
+		return "", err
+	}
+
+	native, err := rule.Resolve(symbol, instrument)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", exchange, err)
+	}
+	return native, nil
+}
+
+func (m *symbolMapper) Exchanges() []connector.ExchangeName {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	exchanges := make([]connector.ExchangeName, 0, len(m.rules))
+	for exchange := range m.rules {
+		exchanges = append(exchanges, exchange)
+	}
+	sort.Slice(exchanges, func(i, j int) bool { return exchanges[i] < exchanges[j] })
+	return exchanges
+}
+
+func (m *symbolMapper) rule(exchange connector.ExchangeName) (Rule, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	rule, ok := m.rules[exchange]
+	if !ok {
+		return nil, fmt.Errorf("no symbol rule registered for %s", exchange)
+	}
+	return rule, nil
+}
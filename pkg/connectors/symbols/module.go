@@ -0,0 +1,7 @@
+package symbols
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(NewSymbolMapper),
+)
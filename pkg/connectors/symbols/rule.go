@@ -0,0 +1,76 @@
+package symbols
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+)
+
+// Rule converts between portfolio assets and one exchange's native symbols
+type Rule interface {
+	ToNative(asset portfolio.Asset, instrument connector.Instrument) (string, error)
+	FromNative(symbol string) (portfolio.Asset, connector.Instrument, error)
+
+	// Resolve accepts either a base asset or a native symbol and returns
+	// the native symbol, for callers holding symbols of unknown form
+	Resolve(symbol string, instrument connector.Instrument) (string, error)
+}
+
+type suffixRule struct {
+	suffixes map[connector.Instrument]string
+	order    []connector.Instrument
+}
+
+// NewSuffixRule builds a rule for exchanges whose native symbol is the base
+// asset followed by a fixed suffix per instrument, e.g. "USDT" or "-USD-PERP".
+// Instruments missing from the map are unsupported.
+func NewSuffixRule(suffixes map[connector.Instrument]string) Rule {
+	order := make([]connector.Instrument, 0, len(suffixes))
+	for instrument := range suffixes {
+		order = append(order, instrument)
+	}
+
+	// Longest suffix first so FromNative prefers the most specific match;
+	// ties resolve to perpetual since that is what every connector trades
+	sort.Slice(order, func(i, j int) bool {
+		a, b := suffixes[order[i]], suffixes[order[j]]
+		if len(a) != len(b) {
+			return len(a) > len(b)
+		}
+		return order[i] == connector.TypePerpetual
+	})
+
+	return &suffixRule{suffixes: suffixes, order: order}
+}
+
+func (r *suffixRule) ToNative(asset portfolio.Asset, instrument connector.Instrument) (string, error) {
+	suffix, ok := r.suffixes[instrument]
+	if !ok {
+		return "", fmt.Errorf("instrument %s not supported", instrument)
+	}
+	return asset.Symbol() + suffix, nil
+}
+
+func (r *suffixRule) FromNative(symbol string) (portfolio.Asset, connector.Instrument, error) {
+	for _, instrument := range r.order {
+		suffix := r.suffixes[instrument]
+		if len(symbol) > len(suffix) && strings.HasSuffix(symbol, suffix) {
+			return portfolio.NewAsset(strings.TrimSuffix(symbol, suffix)), instrument, nil
+		}
+	}
+	return portfolio.Asset{}, "", fmt.Errorf("unrecognised symbol %q", symbol)
+}
+
+func (r *suffixRule) Resolve(symbol string, instrument connector.Instrument) (string, error) {
+	suffix, ok := r.suffixes[instrument]
+	if !ok {
+		return "", fmt.Errorf("instrument %s not supported", instrument)
+	}
+	if suffix != "" && strings.HasSuffix(symbol, suffix) {
+		return symbol, nil
+	}
+	return symbol + suffix, nil
+}
@@ -0,0 +1,40 @@
+package tracker
+
+import "time"
+
+const (
+	// DefaultInterval is how often tracked orders are polled for status
+	DefaultInterval = 2 * time.Second
+
+	// DefaultRetention is how long an order stays readable through Order
+	// after reaching a terminal state, so executors polling it see the
+	// final fill before it is evicted
+	DefaultRetention = 10 * time.Minute
+
+	// DefaultReconcileInterval is how often orders on an exchange whose
+	// fills are streamed are still polled
+	DefaultReconcileInterval = 30 * time.Second
+
+	// JobName is the scheduler job the tracker registers under
+	JobName = "order-tracker"
+)
+
+// Config controls the order tracker
+type Config struct {
+	Interval  time.Duration
+	Retention time.Duration
+
+	// ReconcileInterval is how often orders followed on a fill stream are
+	// polled anyway, for status changes the stream does not carry
+	ReconcileInterval time.Duration
+}
+
+// DefaultConfig polls tracked orders every two seconds, or every thirty on
+// exchanges that stream fills, and forgets them ten minutes after they finish
+func DefaultConfig() Config {
+	return Config{
+		Interval:          DefaultInterval,
+		Retention:         DefaultRetention,
+		ReconcileInterval: DefaultReconcileInterval,
+	}
+}
@@ -0,0 +1,148 @@
+package tracker_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/events"
+	sdkregistry "github.com/backtesting-org/kronos-sdk/pkg/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	logger "github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	mockjournal "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/connectors/journal"
+	mockscheduler "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/scheduler"
+	"github.com/backtesting-org/live-trading/pkg/connectors/fake"
+	"github.com/backtesting-org/live-trading/pkg/connectors/tracker"
+	"github.com/backtesting-org/live-trading/pkg/eventschema"
+)
+
+var _ = Describe("OrderTracker fills", func() {
+	var (
+		clock      *fake.Clock
+		exchange   *fake.Connector
+		connectors registry.ConnectorRegistry
+		orders     tracker.OrderTracker
+		fills      chan tracker.FillEvent
+		order      *connector.OrderResponse
+	)
+
+	BeforeEach(func() {
+		clock = fake.NewClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+		exchange = fake.NewConnector("fake", clock)
+		Expect(exchange.Initialize(&fake.Config{Exchange: "fake"})).To(Succeed())
+		Expect(exchange.StartWebSocket()).To(Succeed())
+
+		connectors = sdkregistry.NewConnectorRegistry()
+		connectors.RegisterConnector("fake", exchange)
+		Expect(connectors.MarkConnectorReady("fake")).To(Succeed())
+
+		jobs := mockscheduler.NewScheduler(GinkgoT())
+		jobs.On("Register", mock.Anything).Return(nil).Maybe()
+		jobs.On("Unregister", tracker.JobName).Return(nil).Maybe()
+
+		journal := mockjournal.NewOrderJournal(GinkgoT())
+		journal.On("Record", mock.Anything).Return(nil).Maybe()
+
+		bus := events.NewEventBus()
+		fills = make(chan tracker.FillEvent, 10)
+		bus.Subscribe(eventschema.TopicFill, func(event interface{}) {
+			fills <- event.(tracker.FillEvent)
+		})
+
+		orders = tracker.NewOrderTracker(connectors, jobs, journal, bus, clock, logger.NewNoOpLogger())
+
+		var err error
+		order, err = exchange.PlaceLimitOrder("BTC-PERP", connector.OrderSideBuy, numerical.NewFromInt(1), numerical.NewFromInt(90))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(orders.Track("fake", order)).To(Succeed())
+	})
+
+	It("publishes a fill from the connector's private stream on the bus", func() {
+		Expect(orders.Start()).To(Succeed())
+		DeferCleanup(orders.Stop)
+
+		Expect(exchange.Fill(order.OrderID, numerical.NewFromFloat(0.4))).To(Succeed())
+
+		var fill tracker.FillEvent
+		Eventually(fills).Should(Receive(&fill))
+		Expect(fill.Exchange).To(Equal(connector.ExchangeName("fake")))
+		Expect(fill.OrderID).To(Equal(order.OrderID))
+		Expect(fill.Quantity.Equal(numerical.NewFromFloat(0.4))).To(BeTrue())
+		Expect(fill.Price.Equal(numerical.NewFromInt(90))).To(BeTrue())
+
+		tracked, ok := orders.Order("fake", order.OrderID)
+		Expect(ok).To(BeTrue())
+		Expect(tracked.Order.Status).To(Equal(connector.OrderStatusPartiallyFilled))
+	})
+
+	It("does not publish a streamed fill again when the order is polled", func() {
+		Expect(orders.Start()).To(Succeed())
+		DeferCleanup(orders.Stop)
+
+		Expect(exchange.Fill(order.OrderID, numerical.NewFromFloat(0.4))).To(Succeed())
+		Eventually(fills).Should(Receive())
+
+		clock.Advance(tracker.DefaultReconcileInterval)
+		Expect(orders.Poll()).To(Succeed())
+		Consistently(fills, 100*time.Millisecond).ShouldNot(Receive())
+	})
+
+	It("skips polling streamed orders until the reconcile interval passes", func() {
+		Expect(orders.Start()).To(Succeed())
+		DeferCleanup(orders.Stop)
+
+		calls := exchange.Calls(fake.OpOrders)
+		Expect(orders.Poll()).To(Succeed())
+		Expect(exchange.Calls(fake.OpOrders)).To(Equal(calls))
+
+		clock.Advance(tracker.DefaultReconcileInterval)
+		Expect(orders.Poll()).To(Succeed())
+		Expect(exchange.Calls(fake.OpOrders)).To(Equal(calls + 1))
+	})
+
+	It("publishes fills found by polling when the connector is not streamed", func() {
+		Expect(exchange.Fill(order.OrderID, numerical.Zero())).To(Succeed())
+		Expect(orders.Poll()).To(Succeed())
+
+		var fill tracker.FillEvent
+		Eventually(fills).Should(Receive(&fill))
+		Expect(fill.Quantity.Equal(numerical.NewFromInt(1))).To(BeTrue())
+		Expect(fill.Status).To(Equal(connector.OrderStatusFilled))
+	})
+
+	It("publishes only the fills an adopted order makes after adoption", func() {
+		earlier, err := exchange.PlaceLimitOrder("BTC-PERP", connector.OrderSideSell, numerical.NewFromInt(1), numerical.NewFromInt(110))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exchange.Fill(earlier.OrderID, numerical.NewFromFloat(0.3))).To(Succeed())
+
+		open, err := exchange.GetOpenOrders()
+		Expect(err).NotTo(HaveOccurred())
+		var found connector.Order
+		for _, candidate := range open {
+			if candidate.ID == earlier.OrderID {
+				found = candidate
+			}
+		}
+		Expect(found.FilledQty.Equal(numerical.NewFromFloat(0.3))).To(BeTrue())
+
+		Expect(orders.Adopt("fake", found)).To(Succeed())
+		Expect(orders.Adopt("fake", found)).To(MatchError(ContainSubstring("already tracked")))
+		tracked, ok := orders.Order("fake", earlier.OrderID)
+		Expect(ok).To(BeTrue())
+		Expect(tracked.Order.Status).To(Equal(connector.OrderStatusPartiallyFilled))
+
+		Expect(exchange.Fill(earlier.OrderID, numerical.NewFromFloat(0.2))).To(Succeed())
+		Expect(orders.Poll()).To(Succeed())
+
+		var fill tracker.FillEvent
+		Eventually(fills).Should(Receive(&fill))
+		Expect(fill.OrderID).To(Equal(earlier.OrderID))
+		Expect(fill.Quantity.Equal(numerical.NewFromFloat(0.2))).To(BeTrue())
+		Expect(fill.FilledQty.Equal(numerical.NewFromFloat(0.5))).To(BeTrue())
+		Consistently(fills, 100*time.Millisecond).ShouldNot(Receive())
+	})
+})
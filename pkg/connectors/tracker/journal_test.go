@@ -0,0 +1,100 @@
+package tracker_test
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/events"
+	sdkregistry "github.com/backtesting-org/kronos-sdk/pkg/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	logger "github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	mockjournal "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/connectors/journal"
+	mockscheduler "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/scheduler"
+	"github.com/backtesting-org/live-trading/pkg/connectors/fake"
+	"github.com/backtesting-org/live-trading/pkg/connectors/journal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/tracker"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+// payloadConnector is a fake exchange that keeps a native response per order
+type payloadConnector struct {
+	*fake.Connector
+	payloads *types.OrderPayloads
+}
+
+func (c *payloadConnector) OrderPayload(orderID string) (json.RawMessage, bool) {
+	return c.payloads.OrderPayload(orderID)
+}
+
+var _ = Describe("OrderTracker journal", func() {
+	var (
+		clock       *fake.Clock
+		exchange    *fake.Connector
+		payloads    *types.OrderPayloads
+		transitions []journal.OrderTransition
+		orders      tracker.OrderTracker
+	)
+
+	newTracker := func(conn connector.Connector) {
+		connectors := sdkregistry.NewConnectorRegistry()
+		connectors.RegisterConnector("fake", conn)
+		Expect(connectors.MarkConnectorReady("fake")).To(Succeed())
+
+		jobs := mockscheduler.NewScheduler(GinkgoT())
+		jobs.On("Register", mock.Anything).Return(nil).Maybe()
+		jobs.On("Unregister", tracker.JobName).Return(nil).Maybe()
+
+		orderJournal := mockjournal.NewOrderJournal(GinkgoT())
+		orderJournal.On("Record", mock.Anything).Run(func(args mock.Arguments) {
+			transitions = append(transitions, args.Get(0).(journal.OrderTransition))
+		}).Return(nil).Maybe()
+
+		orders = tracker.NewOrderTracker(connectors, jobs, orderJournal, events.NewEventBus(), clock, logger.NewNoOpLogger())
+	}
+
+	BeforeEach(func() {
+		clock = fake.NewClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+		exchange = fake.NewConnector("fake", clock)
+		Expect(exchange.Initialize(&fake.Config{Exchange: "fake"})).To(Succeed())
+		payloads = types.NewOrderPayloads(types.DefaultPayloadCapacity)
+		transitions = nil
+	})
+
+	It("journals the exchange's own response when the connector keeps one", func() {
+		newTracker(&payloadConnector{Connector: exchange, payloads: payloads})
+
+		order, err := exchange.PlaceLimitOrder("BTC-PERP", connector.OrderSideBuy, numerical.NewFromInt(1), numerical.NewFromInt(90))
+		Expect(err).NotTo(HaveOccurred())
+		payloads.Keep(order.OrderID, map[string]interface{}{"orderId": order.OrderID, "status": "NEW"})
+
+		Expect(orders.Track("fake", order)).To(Succeed())
+
+		Expect(transitions).NotTo(BeEmpty())
+		for _, transition := range transitions {
+			Expect(transition.Raw).To(BeTrue())
+			Expect(transition.Payload).To(MatchJSON(`{"orderId": "` + order.OrderID + `", "status": "NEW"}`))
+		}
+	})
+
+	It("journals the tracked order when the connector keeps no response", func() {
+		newTracker(exchange)
+
+		order, err := exchange.PlaceLimitOrder("BTC-PERP", connector.OrderSideBuy, numerical.NewFromInt(1), numerical.NewFromInt(90))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(orders.Track("fake", order)).To(Succeed())
+
+		Expect(transitions).NotTo(BeEmpty())
+		for _, transition := range transitions {
+			Expect(transition.Raw).To(BeFalse())
+
+			var journaled connector.Order
+			Expect(json.Unmarshal(transition.Payload, &journaled)).To(Succeed())
+			Expect(journaled.ID).To(Equal(order.OrderID))
+		}
+	})
+})
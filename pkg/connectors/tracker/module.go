@@ -0,0 +1,24 @@
+package tracker
+
+import (
+	"context"
+
+	"go.uber.org/fx"
+)
+
+var Module = fx.Options(
+	fx.Provide(NewOrderTracker),
+	fx.Invoke(registerHooks),
+)
+
+// registerHooks follows tracked orders for the application's lifetime
+func registerHooks(lifecycle fx.Lifecycle, orderTracker OrderTracker) {
+	lifecycle.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			return orderTracker.Start()
+		},
+		OnStop: func(context.Context) error {
+			return orderTracker.Stop()
+		},
+	})
+}
@@ -0,0 +1,605 @@
+package tracker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/events"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/journal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+	"github.com/backtesting-org/live-trading/pkg/eventschema"
+	"github.com/backtesting-org/live-trading/pkg/scheduler"
+)
+
+// FillEvent is published on eventschema.TopicFill whenever a tracked
+// order's filled quantity grows
+type FillEvent struct {
+	Exchange connector.ExchangeName
+	OrderID  string
+	Symbol   string
+	Side     connector.OrderSide
+
+	// Quantity and Price describe this fill only; FilledQty is cumulative
+	Quantity  numerical.Decimal
+	Price     numerical.Decimal
+	FilledQty numerical.Decimal
+
+	Status    connector.OrderStatus
+	Timestamp time.Time
+}
+
+// TrackedOrder is the latest known state of an order placed through the tracker
+type TrackedOrder struct {
+	Exchange connector.ExchangeName
+	Order    connector.Order
+
+	// TrackedAt is when Track was called, by the tracker's clock, for
+	// attributing orders to the run that was active then
+	TrackedAt time.Time
+	UpdatedAt time.Time
+}
+
+// OrderTracker follows orders from placement until they reach a terminal
+// state, journaling each transition and publishing fills as they happen.
+// Connectors with a private fill stream are followed on it; the rest are
+// polled over REST.
+type OrderTracker interface {
+	// Track starts following an order returned by PlaceLimitOrder or PlaceMarketOrder
+	Track(exchange connector.ExchangeName, response *connector.OrderResponse) error
+
+	// Adopt starts following an open order an earlier session placed, such
+	// as one found at startup. What it has filled so far is taken as already
+	// published; only later fills are.
+	Adopt(exchange connector.ExchangeName, order connector.Order) error
+
+	// Start subscribes to the fill streams of ready connectors and registers
+	// the polling job with the scheduler
+	Start() error
+	Stop() error
+
+	// Poll picks up fill streams of connectors that became ready, refreshes
+	// every active order that is due and evicts orders that finished more
+	// than the retention ago. Orders on streamed exchanges are only polled
+	// every ReconcileInterval, for the cancels and expiries a fill stream
+	// does not carry.
+	Poll() error
+
+	// Annotate journals a lifecycle step on a tracked order that does not
+	// change its status, such as an escalation replacing it
+	Annotate(exchange connector.ExchangeName, orderID, event string, detail interface{}) error
+
+	Order(exchange connector.ExchangeName, orderID string) (TrackedOrder, bool)
+	Active() []TrackedOrder
+	Configure(config Config)
+}
+
+// fillState is what the tracker knows about an order beyond its snapshot:
+// the streamed trades already counted and when it was last polled
+type fillState struct {
+	trades      map[string]bool
+	streamedQty numerical.Decimal
+	polledAt    time.Time
+}
+
+type orderTracker struct {
+	registry     registry.ConnectorRegistry
+	scheduler    scheduler.Scheduler
+	journal      journal.OrderJournal
+	bus          events.EventBus
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config Config
+	orders map[string]*TrackedOrder
+	fills  map[string]*fillState
+
+	// streams holds the exchanges whose fill stream is being followed; a
+	// nil entry is one whose subscription failed and is polled instead
+	streams map[connector.ExchangeName]types.FillStreamer
+	stopCh  chan struct{}
+	mu      sync.Mutex
+}
+
+func NewOrderTracker(
+	connectorRegistry registry.ConnectorRegistry,
+	jobScheduler scheduler.Scheduler,
+	orderJournal journal.OrderJournal,
+	bus events.EventBus,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) OrderTracker {
+	return &orderTracker{
+		registry:     connectorRegistry,
+		scheduler:    jobScheduler,
+		journal:      orderJournal,
+		bus:          bus,
+		timeProvider: timeProvider,
+		logger:       logger,
+		config:       DefaultConfig(),
+		orders:       make(map[string]*TrackedOrder),
+		fills:        make(map[string]*fillState),
+		streams:      make(map[connector.ExchangeName]types.FillStreamer),
+	}
+}
+
+func (t *orderTracker) Configure(config Config) {
+	if config.Interval <= 0 {
+		config.Interval = DefaultInterval
+	}
+	if config.Retention <= 0 {
+		config.Retention = DefaultRetention
+	}
+	if config.ReconcileInterval <= 0 {
+		config.ReconcileInterval = DefaultReconcileInterval
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.config = config
+}
+
+func (t *orderTracker) Start() error {
+	t.mu.Lock()
+	if t.stopCh != nil {
+		t.mu.Unlock()
+		return nil
+	}
+	t.stopCh = make(chan struct{})
+	interval := t.config.Interval
+	t.mu.Unlock()
+
+	t.follow()
+
+	return t.scheduler.Register(scheduler.Job{
+		Name:     JobName,
+		Interval: interval,
+		Run: func(_ context.Context) error {
+			return t.Poll()
+		},
+	})
+}
+
+func (t *orderTracker) Stop() error {
+	t.mu.Lock()
+	if t.stopCh != nil {
+		close(t.stopCh)
+		t.stopCh = nil
+	}
+	streams := t.streams
+	t.streams = make(map[connector.ExchangeName]types.FillStreamer)
+	t.mu.Unlock()
+
+	for exchange, streamer := range streams {
+		if streamer == nil {
+			continue
+		}
+		if err := streamer.UnsubscribeFills(); err != nil {
+			t.logger.Warn("Failed to unsubscribe from %s fills: %v", exchange, err)
+		}
+	}
+
+	return t.scheduler.Unregister(JobName)
+}
+
+// follow subscribes to the fill stream of every ready connector that has
+// one and is not followed yet. A failed subscription is not retried; the
+// poller covers that exchange.
+func (t *orderTracker) follow() {
+	for _, conn := range t.registry.GetReadyConnectors() {
+		streamer, ok := conn.(types.FillStreamer)
+		if !ok {
+			continue
+		}
+		exchange := conn.GetConnectorInfo().Name
+
+		t.mu.Lock()
+		_, followed := t.streams[exchange]
+		stopCh := t.stopCh
+		if !followed && stopCh != nil {
+			t.streams[exchange] = nil
+		}
+		t.mu.Unlock()
+
+		if followed || stopCh == nil {
+			continue
+		}
+
+		if err := streamer.SubscribeFills(); err != nil {
+			t.logger.Warn("Fill stream unavailable on %s, polling instead: %v", exchange, err)
+			continue
+		}
+
+		t.mu.Lock()
+		t.streams[exchange] = streamer
+		t.mu.Unlock()
+
+		t.logger.Info("Following fills on %s over its private stream", exchange)
+		go t.forward(exchange, streamer.FillUpdates(), stopCh)
+	}
+}
+
+// forward drains one connector's fill stream until Stop
+func (t *orderTracker) forward(exchange connector.ExchangeName, fills <-chan connector.Trade, stopCh chan struct{}) {
+	for {
+		select {
+		case <-stopCh:
+			return
+		case fill, ok := <-fills:
+			if !ok {
+				return
+			}
+			t.applyFill(exchange, fill)
+		}
+	}
+}
+
+func (t *orderTracker) Track(exchange connector.ExchangeName, response *connector.OrderResponse) error {
+	if response == nil || response.OrderID == "" {
+		return fmt.Errorf("order response has no order ID")
+	}
+
+	status := response.Status
+	if status == "" {
+		status = connector.OrderStatusNew
+	}
+
+	order := connector.Order{
+		ID:            response.OrderID,
+		ClientOrderID: response.ClientOrderID,
+		Symbol:        response.Symbol,
+		Side:          response.Side,
+		Type:          response.Type,
+		Status:        connector.OrderStatusNew,
+		Quantity:      response.Quantity,
+		Price:         response.Price,
+		CreatedAt:     response.Timestamp,
+	}
+
+	key := orderKey(exchange, response.OrderID)
+
+	t.mu.Lock()
+	if _, exists := t.orders[key]; exists {
+		t.mu.Unlock()
+		return fmt.Errorf("order %s on %s already tracked", response.OrderID, exchange)
+	}
+	now := t.timeProvider.Now()
+	tracked := &TrackedOrder{Exchange: exchange, Order: order, TrackedAt: now, UpdatedAt: now}
+	t.orders[key] = tracked
+	t.fills[key] = &fillState{trades: make(map[string]bool), polledAt: now}
+	t.mu.Unlock()
+
+	t.record(exchange, order, "", connector.OrderStatusNew)
+
+	// Market orders often come back already filled - apply that immediately
+	// rather than waiting for the next poll
+	if status != connector.OrderStatusNew || response.FilledQty.IsPositive() {
+		update := order
+		update.Status = status
+		update.FilledQty = response.FilledQty
+		update.AvgPrice = response.AvgPrice
+		t.apply(key, update)
+	}
+
+	return nil
+}
+
+func (t *orderTracker) Adopt(exchange connector.ExchangeName, order connector.Order) error {
+	if order.ID == "" {
+		return fmt.Errorf("order has no ID")
+	}
+	order.Status = normaliseStatus(order)
+
+	key := orderKey(exchange, order.ID)
+
+	t.mu.Lock()
+	if _, exists := t.orders[key]; exists {
+		t.mu.Unlock()
+		return fmt.Errorf("order %s on %s already tracked", order.ID, exchange)
+	}
+	now := t.timeProvider.Now()
+	t.orders[key] = &TrackedOrder{Exchange: exchange, Order: order, TrackedAt: now, UpdatedAt: now}
+	t.fills[key] = &fillState{trades: make(map[string]bool), streamedQty: order.FilledQty, polledAt: now}
+	t.mu.Unlock()
+
+	t.record(exchange, order, "", order.Status)
+	return nil
+}
+
+func (t *orderTracker) Poll() error {
+	t.evict()
+	t.follow()
+
+	var failed []string
+
+	for _, tracked := range t.due() {
+		conn, ok := t.registry.GetConnector(tracked.Exchange)
+		if !ok {
+			failed = append(failed, fmt.Sprintf("%s: connector not registered", tracked.Exchange))
+			continue
+		}
+
+		order, err := conn.GetOrderStatus(tracked.Order.ID)
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("%s %s: %v", tracked.Exchange, tracked.Order.ID, err))
+			continue
+		}
+
+		t.apply(orderKey(tracked.Exchange, tracked.Order.ID), *order)
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("order status unavailable: %s", strings.Join(failed, "; "))
+	}
+	return nil
+}
+
+func (t *orderTracker) Order(exchange connector.ExchangeName, orderID string) (TrackedOrder, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	tracked, exists := t.orders[orderKey(exchange, orderID)]
+	if !exists {
+		return TrackedOrder{}, false
+	}
+	return *tracked, true
+}
+
+func (t *orderTracker) Annotate(exchange connector.ExchangeName, orderID, event string, detail interface{}) error {
+	tracked, ok := t.Order(exchange, orderID)
+	if !ok {
+		return fmt.Errorf("order %s on %s not tracked", orderID, exchange)
+	}
+
+	payload, err := json.Marshal(detail)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s detail for order %s: %w", event, orderID, err)
+	}
+
+	return t.journal.Record(journal.OrderTransition{
+		Exchange: exchange,
+		OrderID:  orderID,
+		Symbol:   tracked.Order.Symbol,
+		From:     tracked.Order.Status,
+		To:       tracked.Order.Status,
+		Event:    event,
+		Payload:  payload,
+	})
+}
+
+func (t *orderTracker) Active() []TrackedOrder {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	active := make([]TrackedOrder, 0, len(t.orders))
+	for _, tracked := range t.orders {
+		if !isTerminal(tracked.Order.Status) {
+			active = append(active, *tracked)
+		}
+	}
+	return active
+}
+
+// due returns the active orders to poll now: all of them on polled
+// exchanges, and on streamed ones those not polled for ReconcileInterval
+func (t *orderTracker) due() []TrackedOrder {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.timeProvider.Now()
+	due := make([]TrackedOrder, 0, len(t.orders))
+	for key, tracked := range t.orders {
+		if isTerminal(tracked.Order.Status) {
+			continue
+		}
+		state := t.fills[key]
+		if t.streams[tracked.Exchange] != nil && now.Sub(state.polledAt) < t.config.ReconcileInterval {
+			continue
+		}
+		state.polledAt = now
+		due = append(due, *tracked)
+	}
+	return due
+}
+
+// evict forgets terminal orders once they have been finished for longer
+// than the retention
+func (t *orderTracker) evict() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	cutoff := t.timeProvider.Now().Add(-t.config.Retention)
+	for key, tracked := range t.orders {
+		if isTerminal(tracked.Order.Status) && tracked.UpdatedAt.Before(cutoff) {
+			delete(t.orders, key)
+			delete(t.fills, key)
+		}
+	}
+}
+
+// applyFill counts one streamed trade against its tracked order. The same
+// fill may already have been seen by a poll, so the order's filled quantity
+// only moves once the streamed trades add up to more than it.
+func (t *orderTracker) applyFill(exchange connector.ExchangeName, fill connector.Trade) {
+	key := orderKey(exchange, fill.OrderID)
+
+	t.mu.Lock()
+	tracked, exists := t.orders[key]
+	state := t.fills[key]
+	if !exists || state == nil || state.trades[fill.ID] {
+		t.mu.Unlock()
+		return
+	}
+	state.trades[fill.ID] = true
+	state.streamedQty = state.streamedQty.Add(fill.Quantity)
+
+	previous := tracked.Order
+	if !state.streamedQty.GreaterThan(previous.FilledQty) {
+		t.mu.Unlock()
+		return
+	}
+
+	update := previous
+	update.FilledQty = state.streamedQty
+	update.AvgPrice = previous.AvgPrice.Mul(previous.FilledQty).
+		Add(fill.Price.Mul(update.FilledQty.Sub(previous.FilledQty))).
+		Div(update.FilledQty)
+	if remaining := previous.Quantity.Sub(update.FilledQty); remaining.IsPositive() {
+		update.RemainingQty = remaining
+	} else {
+		update.RemainingQty = numerical.Zero()
+	}
+	update.UpdatedAt = fill.Timestamp
+	t.mu.Unlock()
+
+	t.apply(key, update)
+}
+
+// apply merges an exchange snapshot into the tracked order, journaling the
+// status change and publishing the fill delta if the filled quantity grew.
+// A snapshot behind what the fill stream already delivered keeps the
+// streamed quantities.
+func (t *orderTracker) apply(key string, update connector.Order) {
+	t.mu.Lock()
+	tracked, exists := t.orders[key]
+	if !exists {
+		t.mu.Unlock()
+		return
+	}
+
+	previous := tracked.Order
+	now := t.timeProvider.Now()
+
+	if update.FilledQty.LessThan(previous.FilledQty) {
+		update.FilledQty = previous.FilledQty
+		update.RemainingQty = previous.RemainingQty
+		update.AvgPrice = previous.AvgPrice
+	}
+	if !update.Quantity.IsPositive() {
+		update.Quantity = previous.Quantity
+	}
+
+	status := normaliseStatus(update)
+	tracked.Order.Status = status
+	tracked.Order.FilledQty = update.FilledQty
+	tracked.Order.RemainingQty = update.RemainingQty
+	tracked.Order.AvgPrice = update.AvgPrice
+	tracked.Order.UpdatedAt = update.UpdatedAt
+	tracked.UpdatedAt = now
+	exchange := tracked.Exchange
+	current := tracked.Order
+	t.mu.Unlock()
+
+	if status != previous.Status {
+		t.record(exchange, current, previous.Status, status)
+	}
+
+	delta := current.FilledQty.Sub(previous.FilledQty)
+	if !delta.IsPositive() {
+		return
+	}
+
+	fill := FillEvent{
+		Exchange:  exchange,
+		OrderID:   current.ID,
+		Symbol:    current.Symbol,
+		Side:      current.Side,
+		Quantity:  delta,
+		Price:     fillPrice(previous, current, delta),
+		FilledQty: current.FilledQty,
+		Status:    status,
+		Timestamp: now,
+	}
+
+	t.logger.Info("📥 Fill on %s %s: %s %s @ %s (filled %s/%s)",
+		exchange, current.ID, fill.Quantity.String(), current.Symbol, fill.Price.String(),
+		current.FilledQty.String(), current.Quantity.String())
+
+	t.bus.Publish(eventschema.TopicFill, fill)
+}
+
+func (t *orderTracker) record(exchange connector.ExchangeName, order connector.Order, from, to connector.OrderStatus) {
+	payload, raw := t.exchangePayload(exchange, order.ID)
+	if !raw {
+		encoded, err := json.Marshal(order)
+		if err != nil {
+			t.logger.Warn("Failed to encode order %s for journal: %v", order.ID, err)
+		}
+		payload = encoded
+	}
+
+	if err := t.journal.Record(journal.OrderTransition{
+		Exchange: exchange,
+		OrderID:  order.ID,
+		Symbol:   order.Symbol,
+		From:     from,
+		To:       to,
+		Payload:  payload,
+		Raw:      raw,
+	}); err != nil {
+		t.logger.Debug("Order transition %s %s -> %s not journaled: %v", order.ID, from, to, err)
+	}
+}
+
+// exchangePayload returns the exchange's own response about the order when
+// its connector keeps them
+func (t *orderTracker) exchangePayload(exchange connector.ExchangeName, orderID string) (json.RawMessage, bool) {
+	conn, ok := t.registry.GetConnector(exchange)
+	if !ok {
+		return nil, false
+	}
+	source, ok := conn.(types.OrderPayloadSource)
+	if !ok {
+		return nil, false
+	}
+	return source.OrderPayload(orderID)
+}
+
+// normaliseStatus derives partial fills from quantities since not every
+// exchange reports PARTIALLY_FILLED for open orders
+func normaliseStatus(order connector.Order) connector.OrderStatus {
+	if isTerminal(order.Status) {
+		return order.Status
+	}
+	if order.FilledQty.IsPositive() {
+		if order.Quantity.IsPositive() && order.FilledQty.GreaterThanOrEqual(order.Quantity) {
+			return connector.OrderStatusFilled
+		}
+		return connector.OrderStatusPartiallyFilled
+	}
+	if order.Status == "" {
+		return connector.OrderStatusNew
+	}
+	return order.Status
+}
+
+// fillPrice recovers the price of the latest fill from the change in
+// cumulative average price
+func fillPrice(previous, current connector.Order, delta numerical.Decimal) numerical.Decimal {
+	if previous.FilledQty.IsZero() || previous.AvgPrice.IsZero() {
+		return current.AvgPrice
+	}
+	notional := current.AvgPrice.Mul(current.FilledQty).Sub(previous.AvgPrice.Mul(previous.FilledQty))
+	return notional.Div(delta)
+}
+
+func isTerminal(status connector.OrderStatus) bool {
+	switch status {
+	case connector.OrderStatusFilled, connector.OrderStatusCanceled,
+		connector.OrderStatusRejected, connector.OrderStatusExpired:
+		return true
+	}
+	return false
+}
+
+func orderKey(exchange connector.ExchangeName, orderID string) string {
+	return fmt.Sprintf("%s:%s", exchange, orderID)
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+)
+
+// OrderRequest is one order in a batch
+type OrderRequest struct {
+	// Symbol is the exchange-native market
+	Symbol   string
+	Side     connector.OrderSide
+	Type     connector.OrderType
+	Quantity numerical.Decimal
+
+	// Price is required for limit orders and ignored for market orders
+	Price numerical.Decimal
+}
+
+// Validate checks the fields every exchange requires; only limit and market
+// orders can be batched
+func (r OrderRequest) Validate() error {
+	if r.Symbol == "" {
+		return fmt.Errorf("symbol is required")
+	}
+	if !r.Side.IsValid() {
+		return fmt.Errorf("invalid order side %q", r.Side)
+	}
+	if !r.Quantity.IsPositive() {
+		return fmt.Errorf("order quantity must be positive")
+	}
+	switch r.Type {
+	case connector.OrderTypeLimit:
+		if !r.Price.IsPositive() {
+			return fmt.Errorf("limit price must be positive")
+		}
+	case connector.OrderTypeMarket:
+	default:
+		return fmt.Errorf("order type %q cannot be batched", r.Type)
+	}
+	return nil
+}
+
+// OrderResult is the outcome of the request at the same index
+type OrderResult struct {
+	Response *connector.OrderResponse
+	Err      error
+}
+
+// CancelResult is the outcome of the cancel at the same index
+type CancelResult struct {
+	OrderID  string
+	Response *connector.CancelResponse
+	Err      error
+}
+
+// BatchOrderProvider is implemented by connectors whose exchange places and
+// cancels several orders in one request. Native batches take limit orders
+// only. Results line up with the input by index, and the error is reserved
+// for the batch as a whole failing; one order's rejection is in its result.
+type BatchOrderProvider interface {
+	PlaceOrders(requests []OrderRequest) ([]OrderResult, error)
+	CancelOrders(symbol string, orderIDs []string) ([]CancelResult, error)
+
+	// MaxBatchSize is the most orders one request may carry; zero is no limit
+	MaxBatchSize() int
+}
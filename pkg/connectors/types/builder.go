@@ -0,0 +1,22 @@
+package types
+
+// BuilderFee is a fee an order pays to a third-party builder on top of the
+// exchange's own fee, as Hyperliquid's builder codes do
+type BuilderFee struct {
+	Builder string
+
+	// TenthsBps is the fee in tenths of a basis point of notional, the unit
+	// Hyperliquid signs orders with; 10 is 0.01%
+	TenthsBps int
+}
+
+// Bps is the fee in basis points of notional
+func (f BuilderFee) Bps() float64 {
+	return float64(f.TenthsBps) / 10
+}
+
+// BuilderFeeProvider is implemented by connectors that attach builder fees
+// to orders, so fee accounting can add the fee each order was placed with
+type BuilderFeeProvider interface {
+	AppliedBuilderFee(orderID string) (BuilderFee, bool)
+}
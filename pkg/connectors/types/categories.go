@@ -0,0 +1,170 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+)
+
+// ErrorCategory groups connector failures by how a caller should react
+type ErrorCategory string
+
+const (
+	CategoryRateLimited       ErrorCategory = "rate_limited"
+	CategoryConnectivity      ErrorCategory = "connectivity"
+	CategoryInsufficientFunds ErrorCategory = "insufficient_funds"
+	CategoryInvalidOrder      ErrorCategory = "invalid_order"
+	CategoryUnauthorized      ErrorCategory = "unauthorized"
+	CategoryUnknown           ErrorCategory = "unknown"
+)
+
+// Sentinels matched by errors.Is for each category; ErrInsufficientFunds is
+// the same value as ErrInsufficientBalance
+var (
+	ErrRateLimited       = errors.New("rate limited")
+	ErrConnectivity      = errors.New("exchange unreachable")
+	ErrInsufficientFunds = ErrInsufficientBalance
+	ErrInvalidOrder      = errors.New("invalid order")
+	ErrUnauthorized      = errors.New("unauthorized")
+)
+
+// Retryable reports whether a failure in the category can succeed if the
+// same request is sent again later
+func (c ErrorCategory) Retryable() bool {
+	return c == CategoryRateLimited || c == CategoryConnectivity
+}
+
+func (c ErrorCategory) sentinel() error {
+	switch c {
+	case CategoryRateLimited:
+		return ErrRateLimited
+	case CategoryConnectivity:
+		return ErrConnectivity
+	case CategoryInsufficientFunds:
+		return ErrInsufficientFunds
+	case CategoryInvalidOrder:
+		return ErrInvalidOrder
+	case CategoryUnauthorized:
+		return ErrUnauthorized
+	default:
+		return nil
+	}
+}
+
+// CategorizedError is implemented by exchange client errors that can tell
+// their category from an error code, which beats matching on the message
+type CategorizedError interface {
+	ErrorCategory() ErrorCategory
+}
+
+// ConnectorError tags an exchange failure with its category, so callers can
+// use errors.Is against the category sentinels
+type ConnectorError struct {
+	Exchange connector.ExchangeName
+	Op       string
+	Category ErrorCategory
+	Err      error
+}
+
+func (e *ConnectorError) Error() string {
+	return fmt.Sprintf("%s %s failed (%s): %v", e.Exchange, e.Op, e.Category, e.Err)
+}
+
+func (e *ConnectorError) ErrorCategory() ErrorCategory {
+	return e.Category
+}
+
+func (e *ConnectorError) Unwrap() []error {
+	if sentinel := e.Category.sentinel(); sentinel != nil {
+		return []error{sentinel, e.Err}
+	}
+	return []error{e.Err}
+}
+
+// NewConnectorError classifies err and wraps it; nil and already
+// categorised errors are returned unchanged
+func NewConnectorError(exchange connector.ExchangeName, op string, err error) error {
+	if err == nil {
+		return nil
+	}
+	var existing *ConnectorError
+	if errors.As(err, &existing) {
+		return err
+	}
+	return &ConnectorError{Exchange: exchange, Op: op, Category: Classify(err), Err: err}
+}
+
+// categoryMessages are lower-cased fragments exchanges and transports use
+// for each category, checked when nothing typed is in the chain
+var categoryMessages = []struct {
+	category  ErrorCategory
+	fragments []string
+}{
+	{CategoryUnauthorized, []string{
+		"invalid api key", "api key is invalid", "api-key format invalid", "invalid api-key",
+		"signature for this request is not valid", "error sign", "invalid signature",
+		"unauthorized", "permission denied", "forbidden",
+	}},
+	{CategoryRateLimited, []string{
+		"too many requests", "too many visits", "rate limit", "request weight",
+		"http 429", "status 429",
+	}},
+	{CategoryInvalidOrder, []string{
+		"invalid order", "invalid quantity", "invalid price", "qty invalid", "price invalid",
+		"min notional", "minimum notional", "lot size", "price filter", "precision is over",
+		"reduce only", "reduceonly", "order would immediately", "post only",
+		"invalid symbol", "unknown symbol", "params error",
+	}},
+	{CategoryConnectivity, []string{
+		"timeout", "timed out", "connection refused", "connection reset", "broken pipe",
+		"no such host", "unexpected eof", "bad gateway", "service unavailable",
+		"gateway timeout", "status 502", "status 503", "status 504",
+	}},
+}
+
+// Classify puts an error into a category: typed errors and category
+// sentinels first, then transport errors, then well-known exchange messages
+func Classify(err error) ErrorCategory {
+	if err == nil {
+		return ""
+	}
+
+	// Insufficient funds first: its rejections often carry an "invalid" code too
+	if IsInsufficientBalance(err) {
+		return CategoryInsufficientFunds
+	}
+
+	var categorized CategorizedError
+	if errors.As(err, &categorized) {
+		if category := categorized.ErrorCategory(); category != CategoryUnknown {
+			return category
+		}
+	}
+
+	for _, category := range []ErrorCategory{CategoryUnauthorized, CategoryInvalidOrder, CategoryRateLimited, CategoryConnectivity} {
+		if errors.Is(err, category.sentinel()) {
+			return category
+		}
+	}
+
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, io.ErrUnexpectedEOF) || errors.As(err, &netErr) {
+		return CategoryConnectivity
+	}
+
+	message := strings.ToLower(err.Error())
+	for _, entry := range categoryMessages {
+		for _, fragment := range entry.fragments {
+			if strings.Contains(message, fragment) {
+				return entry.category
+			}
+		}
+	}
+
+	return CategoryUnknown
+}
@@ -8,6 +8,8 @@ const (
 	Hyperliquid connector.ExchangeName = "hyperliquid"
 	Paradex     connector.ExchangeName = "paradex"
 	Bybit       connector.ExchangeName = "bybit"
+	Binance     connector.ExchangeName = "binance"
+	Okx         connector.ExchangeName = "okx"
 )
 
 // ConnectorInfo contains metadata about an available connector
@@ -0,0 +1,18 @@
+package types
+
+import "time"
+
+// CancelAllProvider is implemented by connectors whose exchange cancels every
+// resting order in one request, so a mass cancel is not held up by the
+// per-order rate limit
+type CancelAllProvider interface {
+	CancelAllOrders() error
+}
+
+// CancelOnDisconnectProvider is implemented by connectors whose exchange
+// offers a native dead-man's switch: once armed, the exchange cancels resting
+// orders itself when the session has been gone for the window, whatever
+// state this process is in
+type CancelOnDisconnectProvider interface {
+	ArmCancelOnDisconnect(window time.Duration) error
+}
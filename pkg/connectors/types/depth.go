@@ -0,0 +1,12 @@
+package types
+
+import "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+
+// DepthSnapshotProvider is implemented by connectors that know how deep one
+// REST order book request can go, so on-demand snapshots ask for no more
+// than the venue returns and report when a request was capped
+type DepthSnapshotProvider interface {
+	// MaxSnapshotDepth is the most levels per side FetchOrderBook returns
+	// for instrument
+	MaxSnapshotDepth(instrument connector.Instrument) int
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+)
+
+// ErrInsufficientBalance is matched by errors.Is for every order rejected by
+// an exchange because the account could not fund it
+var ErrInsufficientBalance = errors.New("insufficient balance")
+
+// insufficientBalanceMessages are lower-cased fragments exchanges use when
+// rejecting an order for lack of funds or margin
+var insufficientBalanceMessages = []string{
+	"insufficient margin",
+	"insufficient balance",
+	"insufficient wallet balance",
+	"insufficient available balance",
+	"insufficient spot balance",
+	"not enough for new order",
+	"not_enough_margin",
+	"insufficient_margin",
+	"margin is insufficient",
+}
+
+// InsufficientBalanceError records the order and the balance snapshot taken
+// at the moment the exchange rejected it, for shortfall diagnosis
+type InsufficientBalanceError struct {
+	Exchange  connector.ExchangeName
+	Symbol    string
+	Side      connector.OrderSide
+	Quantity  numerical.Decimal
+	Price     numerical.Decimal
+	Available numerical.Decimal
+	Err       error
+}
+
+func (e *InsufficientBalanceError) Error() string {
+	return fmt.Sprintf("%s rejected %s %s %s: insufficient balance (available %s): %v",
+		e.Exchange, e.Side, e.Quantity.String(), e.Symbol, e.Available.String(), e.Err)
+}
+
+func (e *InsufficientBalanceError) Unwrap() []error {
+	return []error{ErrInsufficientBalance, e.Err}
+}
+
+// IsInsufficientBalance reports whether err is, or looks like, an
+// insufficient-balance rejection from an exchange
+func IsInsufficientBalance(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, ErrInsufficientBalance) {
+		return true
+	}
+
+	message := strings.ToLower(err.Error())
+	for _, fragment := range insufficientBalanceMessages {
+		if strings.Contains(message, fragment) {
+			return true
+		}
+	}
+
+	return false
+}
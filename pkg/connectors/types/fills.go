@@ -0,0 +1,13 @@
+package types
+
+import "github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+
+// FillStreamer is implemented by connectors with a private execution
+// channel. Each trade is one fill of one of the account's own orders and
+// carries the exchange order ID, so order state can follow fills as they
+// happen instead of being polled.
+type FillStreamer interface {
+	SubscribeFills() error
+	UnsubscribeFills() error
+	FillUpdates() <-chan connector.Trade
+}
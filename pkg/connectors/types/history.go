@@ -0,0 +1,15 @@
+package types
+
+import (
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+)
+
+// TradeHistoryProvider is implemented by connectors that can page account
+// fills forward from a point in time, which incremental history sync needs
+type TradeHistoryProvider interface {
+	// FetchTradingHistorySince returns up to limit fills executed at or after
+	// since, oldest first. An empty result means nothing has filled since.
+	FetchTradingHistorySince(symbol string, since time.Time, limit int) ([]connector.Trade, error)
+}
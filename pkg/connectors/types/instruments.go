@@ -0,0 +1,43 @@
+package types
+
+import (
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+)
+
+// InstrumentState is an exchange's listing status normalised across venues
+type InstrumentState string
+
+const (
+	InstrumentTrading   InstrumentState = "trading"
+	InstrumentSuspended InstrumentState = "suspended"
+
+	// InstrumentDelisting is settling or delivering; only closing is possible
+	InstrumentDelisting InstrumentState = "delisting"
+	InstrumentDelisted  InstrumentState = "delisted"
+	InstrumentUnknown   InstrumentState = "unknown"
+)
+
+// InstrumentStatus is one contract's listing state as the exchange reports it
+type InstrumentStatus struct {
+	Symbol string
+	State  InstrumentState
+
+	// RawStatus is the exchange's own status string
+	RawStatus string
+
+	// DeliveryAt is when the contract settles; zero for perpetuals without
+	// a scheduled delisting
+	DeliveryAt time.Time
+
+	// MaxLeverage is zero when the exchange does not publish it with the
+	// instrument list
+	MaxLeverage numerical.Decimal
+}
+
+// InstrumentStatusProvider is implemented by connectors that can list the
+// status of every instrument they trade
+type InstrumentStatusProvider interface {
+	FetchInstrumentStatuses() ([]InstrumentStatus, error)
+}
@@ -0,0 +1,15 @@
+package types
+
+import (
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+)
+
+// KlineRangeProvider is implemented by connectors whose exchange can
+// return klines for an explicit time range, which paging backfills need
+type KlineRangeProvider interface {
+	// FetchKlinesRange returns up to limit klines opening within [start, end];
+	// ordering is exchange-specific
+	FetchKlinesRange(symbol, interval string, start, end time.Time, limit int) ([]connector.Kline, error)
+}
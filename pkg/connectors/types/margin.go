@@ -0,0 +1,68 @@
+package types
+
+import (
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+)
+
+// MarginMode is how an exchange account nets margin across positions
+type MarginMode string
+
+const (
+	MarginModeIsolated  MarginMode = "isolated"
+	MarginModeCross     MarginMode = "cross"
+	MarginModePortfolio MarginMode = "portfolio"
+	MarginModeUnknown   MarginMode = "unknown"
+)
+
+// MarginInfo is the account's margin mode and the requirements the exchange
+// itself computes. Under portfolio margin these can be far below what
+// notional / leverage suggests, so risk checks should prefer them.
+type MarginInfo struct {
+	Exchange          connector.ExchangeName
+	Mode              MarginMode
+	Equity            numerical.Decimal
+	InitialMargin     numerical.Decimal
+	MaintenanceMargin numerical.Decimal
+	UpdatedAt         time.Time
+}
+
+// InitialUtilization is initial margin as a fraction of equity
+func (m MarginInfo) InitialUtilization() numerical.Decimal {
+	if !m.Equity.IsPositive() {
+		return numerical.Zero()
+	}
+	return m.InitialMargin.Div(m.Equity)
+}
+
+// MaintenanceUtilization is maintenance margin as a fraction of equity;
+// liquidation starts as it approaches 1
+func (m MarginInfo) MaintenanceUtilization() numerical.Decimal {
+	if !m.Equity.IsPositive() {
+		return numerical.Zero()
+	}
+	return m.MaintenanceMargin.Div(m.Equity)
+}
+
+// MarginInfoProvider is implemented by connectors that can report the
+// account's margin mode and exchange-computed requirements
+type MarginInfoProvider interface {
+	FetchMarginInfo() (*MarginInfo, error)
+}
+
+// MarginModeExpectation is implemented by connector configs that declare
+// which margin mode their risk settings assume
+type MarginModeExpectation interface {
+	ExpectedMarginMode() MarginMode
+}
+
+// ValidMarginMode reports whether mode is one a config may declare
+func ValidMarginMode(mode MarginMode) bool {
+	switch mode {
+	case MarginModeIsolated, MarginModeCross, MarginModePortfolio:
+		return true
+	}
+	return false
+}
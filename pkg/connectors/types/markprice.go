@@ -0,0 +1,60 @@
+package types
+
+import (
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+)
+
+// MarkPrice is a perpetual's mark and index price. Exchanges value
+// positions and trigger liquidations off the mark, not the last trade;
+// the index is the spot reference the mark is anchored to.
+type MarkPrice struct {
+	Exchange   connector.ExchangeName
+	Asset      portfolio.Asset
+	MarkPrice  numerical.Decimal
+	IndexPrice numerical.Decimal
+	Timestamp  time.Time
+}
+
+// Premium is how far the mark trades from the index, as a fraction of the index
+func (m MarkPrice) Premium() numerical.Decimal {
+	if !m.IndexPrice.IsPositive() {
+		return numerical.Zero()
+	}
+	return m.MarkPrice.Sub(m.IndexPrice).Div(m.IndexPrice)
+}
+
+// MarkPriceProvider is implemented by connectors that can fetch mark and
+// index prices over REST
+type MarkPriceProvider interface {
+	FetchMarkPrice(asset portfolio.Asset) (*MarkPrice, error)
+}
+
+// MarkPriceStreamer is implemented by connectors with a native mark price
+// channel
+type MarkPriceStreamer interface {
+	SubscribeMarkPrice(asset portfolio.Asset) error
+	UnsubscribeMarkPrice(asset portfolio.Asset) error
+	MarkPriceUpdates() <-chan MarkPrice
+}
+
+// LiquidationDistance is how far the mark has to move against the
+// position, as a fraction of the mark, to reach its liquidation price.
+// It reports false when the exchange gave no liquidation price.
+func LiquidationDistance(position connector.Position, mark numerical.Decimal) (numerical.Decimal, bool) {
+	if !position.LiquidationPrice.IsPositive() || !mark.IsPositive() {
+		return numerical.Zero(), false
+	}
+
+	distance := mark.Sub(position.LiquidationPrice)
+	if position.Side == connector.OrderSideSell {
+		distance = distance.Neg()
+	}
+	if distance.IsNegative() {
+		return numerical.Zero(), true
+	}
+	return distance.Div(mark), true
+}
@@ -0,0 +1,7 @@
+package types
+
+// WebSocketMetricsProvider is implemented by WebSocket connectors whose
+// stream exposes connection statistics (state, message counts, latency)
+type WebSocketMetricsProvider interface {
+	GetWebSocketMetrics() map[string]interface{}
+}
@@ -0,0 +1,7 @@
+package types
+
+// NetworkConfig is implemented by connector configs that can target an
+// exchange's testnet, so tooling can refuse to send test orders to mainnet
+type NetworkConfig interface {
+	UsesTestnet() bool
+}
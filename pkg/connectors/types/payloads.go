@@ -0,0 +1,88 @@
+package types
+
+import (
+	"encoding/json"
+	"sync"
+)
+
+// DefaultPayloadCapacity is how many orders an OrderPayloads keeps
+// responses for before dropping the oldest
+const DefaultPayloadCapacity = 1024
+
+// OrderPayloadSource is implemented by connectors that keep the exchange's
+// own response about each order: the placement, cancellation or status
+// query they last saw for it, in the exchange's field names rather than
+// the SDK's. The order tracker journals it so a dispute with the exchange
+// can be argued from what the exchange said.
+type OrderPayloadSource interface {
+	OrderPayload(orderID string) (json.RawMessage, bool)
+}
+
+// OrderPayloads keeps the latest exchange response per order ID for the
+// most recent orders; connectors hold one to implement OrderPayloadSource
+type OrderPayloads struct {
+	capacity int
+	payloads map[string]json.RawMessage
+	order    []string
+	mu       sync.Mutex
+}
+
+func NewOrderPayloads(capacity int) *OrderPayloads {
+	if capacity <= 0 {
+		capacity = DefaultPayloadCapacity
+	}
+	return &OrderPayloads{
+		capacity: capacity,
+		payloads: make(map[string]json.RawMessage),
+	}
+}
+
+// Keep stores the response for an order. Raw bytes from the wire are kept
+// as they are; a decoded response is encoded back as JSON.
+func (p *OrderPayloads) Keep(orderID string, response interface{}) {
+	if p == nil || orderID == "" || response == nil {
+		return
+	}
+
+	var payload json.RawMessage
+	switch raw := response.(type) {
+	case json.RawMessage:
+		payload = append(json.RawMessage(nil), raw...)
+	case []byte:
+		payload = append(json.RawMessage(nil), raw...)
+	default:
+		encoded, err := json.Marshal(response)
+		if err != nil {
+			return
+		}
+		payload = encoded
+	}
+	if !json.Valid(payload) {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, known := p.payloads[orderID]; !known {
+		p.order = append(p.order, orderID)
+	}
+	p.payloads[orderID] = payload
+
+	for len(p.order) > p.capacity {
+		delete(p.payloads, p.order[0])
+		p.order = p.order[1:]
+	}
+}
+
+func (p *OrderPayloads) OrderPayload(orderID string) (json.RawMessage, bool) {
+	if p == nil {
+		return nil, false
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	payload, ok := p.payloads[orderID]
+	return payload, ok
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+)
+
+// APIKeyPermissions is what the exchange says the configured key may do
+type APIKeyPermissions struct {
+	Exchange connector.ExchangeName
+	ReadOnly bool
+	Trade    bool
+
+	// Withdraw is reported so operators can spot keys broader than a
+	// strategy needs
+	Withdraw bool
+
+	// Scopes is the exchange's own permission names, for reports
+	Scopes []string
+}
+
+// APIKeyInspector is implemented by connectors that can query the
+// permissions of their own API key
+type APIKeyInspector interface {
+	FetchAPIKeyPermissions() (*APIKeyPermissions, error)
+}
+
+// ServerTimeProvider is implemented by connectors that expose the
+// exchange's clock, so signed-request clock skew can be measured
+type ServerTimeProvider interface {
+	FetchServerTime() (time.Time, error)
+}
@@ -0,0 +1,35 @@
+package types
+
+import "time"
+
+// RateLimitStatus is how much of an exchange's request budget is left
+type RateLimitStatus struct {
+	Remaining float64
+	Limit     float64
+
+	// Throttled counts calls that had to queue for budget
+	Throttled int64
+
+	// Rejected counts calls refused because the queue wait was too long
+	Rejected int64
+
+	// Waited is the total time calls spent queued
+	Waited time.Duration
+}
+
+// Headroom is the fraction of the budget still available (0..1)
+func (s RateLimitStatus) Headroom() float64 {
+	if s.Limit <= 0 {
+		return 1
+	}
+	if s.Remaining <= 0 {
+		return 0
+	}
+	return s.Remaining / s.Limit
+}
+
+// RateLimitProvider is implemented by connectors that track their REST
+// request budget
+type RateLimitProvider interface {
+	RateLimitStatus() RateLimitStatus
+}
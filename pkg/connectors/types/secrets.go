@@ -0,0 +1,8 @@
+package types
+
+// SecretConfig is implemented by connector configs that carry credentials,
+// so they can be filled from a secret store instead of the config file. Keys
+// are the fields' JSON names.
+type SecretConfig interface {
+	SecretFields() map[string]*string
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+)
+
+// SpotBalance is one coin held in the spot wallet. Spot holdings are
+// inventory rather than margin, so they are reported per coin instead of
+// being folded into the perpetual account balance.
+type SpotBalance struct {
+	Exchange connector.ExchangeName
+	Asset    string
+	Free     numerical.Decimal
+
+	// Locked is reserved by resting spot orders
+	Locked    numerical.Decimal
+	UpdatedAt time.Time
+}
+
+// Total is the free and locked amount together
+func (b SpotBalance) Total() numerical.Decimal {
+	return b.Free.Add(b.Locked)
+}
+
+// InstrumentTrader is implemented by connectors that trade spot pairs as well
+// as perpetuals. The connector.Connector order methods keep trading
+// perpetuals; these take the instrument explicitly and map the asset to the
+// matching native symbol.
+type InstrumentTrader interface {
+	PlaceLimitOrderFor(instrument connector.Instrument, asset portfolio.Asset, side connector.OrderSide, quantity, price numerical.Decimal) (*connector.OrderResponse, error)
+	PlaceMarketOrderFor(instrument connector.Instrument, asset portfolio.Asset, side connector.OrderSide, quantity numerical.Decimal) (*connector.OrderResponse, error)
+	CancelOrderFor(instrument connector.Instrument, asset portfolio.Asset, orderID string) (*connector.CancelResponse, error)
+	GetOpenOrdersFor(instrument connector.Instrument) ([]connector.Order, error)
+	FetchPriceFor(instrument connector.Instrument, asset portfolio.Asset) (*connector.Price, error)
+	GetSpotBalances() ([]SpotBalance, error)
+}
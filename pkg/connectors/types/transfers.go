@@ -0,0 +1,34 @@
+package types
+
+import (
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+)
+
+// TransferKind distinguishes funds leaving the exchange from internal moves
+type TransferKind string
+
+const (
+	TransferKindWithdrawal TransferKind = "withdrawal"
+	TransferKindTransfer   TransferKind = "transfer"
+)
+
+// TransferEvent is a withdrawal or account transfer reported by an exchange
+type TransferEvent struct {
+	Exchange  connector.ExchangeName
+	ID        string
+	Kind      TransferKind
+	Asset     string
+	Amount    numerical.Decimal
+	Address   string
+	Status    string
+	Timestamp time.Time
+}
+
+// TransferHistoryProvider is implemented by connectors whose exchange exposes
+// withdrawal or transfer history on the trading API key
+type TransferHistoryProvider interface {
+	FetchTransferEvents(since time.Time) ([]TransferEvent, error)
+}
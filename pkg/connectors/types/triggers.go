@@ -0,0 +1,73 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+)
+
+// TriggerOrder is a stop or take-profit order that rests off the book until
+// the trigger price trades, then becomes a market or limit order
+type TriggerOrder struct {
+	// Symbol is the exchange-native market
+	Symbol   string
+	Side     connector.OrderSide
+	Type     connector.OrderType
+	Quantity numerical.Decimal
+
+	TriggerPrice numerical.Decimal
+
+	// LimitPrice is required for the stop-limit and take-profit-limit types
+	LimitPrice numerical.Decimal
+
+	ReduceOnly bool
+}
+
+// IsLimit reports whether the order becomes a limit order once triggered
+func (o TriggerOrder) IsLimit() bool {
+	return o.Type == connector.OrderTypeStopLimit || o.Type == connector.OrderTypeTakeProfitLimit
+}
+
+// IsStop reports whether the order is a stop, as opposed to a take-profit.
+// A stop triggers when price moves against the order's side: a sell stop
+// fires on a fall, a buy stop on a rise. Take-profits are the reverse.
+func (o TriggerOrder) IsStop() bool {
+	return o.Type == connector.OrderTypeStopMarket || o.Type == connector.OrderTypeStopLimit
+}
+
+// TriggersOnRise reports whether the trigger fires when price rises to it
+func (o TriggerOrder) TriggersOnRise() bool {
+	return o.IsStop() == (o.Side == connector.OrderSideBuy)
+}
+
+func (o TriggerOrder) Validate() error {
+	switch o.Type {
+	case connector.OrderTypeStopMarket, connector.OrderTypeStopLimit,
+		connector.OrderTypeTakeProfitMarket, connector.OrderTypeTakeProfitLimit:
+	default:
+		return fmt.Errorf("order type %s is not a trigger order", o.Type)
+	}
+	if o.Symbol == "" {
+		return fmt.Errorf("symbol is required")
+	}
+	if o.Side != connector.OrderSideBuy && o.Side != connector.OrderSideSell {
+		return fmt.Errorf("side must be %s or %s", connector.OrderSideBuy, connector.OrderSideSell)
+	}
+	if !o.Quantity.IsPositive() {
+		return fmt.Errorf("quantity must be positive")
+	}
+	if !o.TriggerPrice.IsPositive() {
+		return fmt.Errorf("trigger price must be positive")
+	}
+	if o.IsLimit() && !o.LimitPrice.IsPositive() {
+		return fmt.Errorf("%s orders need a limit price", o.Type)
+	}
+	return nil
+}
+
+// TriggerOrderProvider is implemented by connectors that can place stop and
+// take-profit orders natively
+type TriggerOrderProvider interface {
+	PlaceTriggerOrder(order TriggerOrder) (*connector.OrderResponse, error)
+}
@@ -0,0 +1,41 @@
+package watchdog
+
+import "time"
+
+// Policy decides what the watchdog does when it sees an unexpected transfer
+type Policy string
+
+const (
+	// PolicyAlert raises a critical alert only
+	PolicyAlert Policy = "alert"
+
+	// PolicyHalt raises a critical alert and triggers the kill switch without
+	// flattening
+	PolicyHalt Policy = "halt"
+)
+
+const (
+	// DefaultInterval is how often transfer history is polled
+	DefaultInterval = 5 * time.Minute
+
+	// JobName is the scheduler job the watchdog registers under
+	JobName = "transfer-watchdog"
+)
+
+// Config controls the transfer watchdog
+type Config struct {
+	Interval time.Duration
+	Policy   Policy
+
+	// AllowedAddresses are destinations that never raise an alert, e.g. a
+	// treasury wallet or a known sub-account
+	AllowedAddresses []string
+}
+
+// DefaultConfig polls every five minutes and halts on any unexpected transfer
+func DefaultConfig() Config {
+	return Config{
+		Interval: DefaultInterval,
+		Policy:   PolicyHalt,
+	}
+}
@@ -0,0 +1,25 @@
+package watchdog
+
+import (
+	"context"
+
+	"go.uber.org/fx"
+)
+
+var Module = fx.Options(
+	fx.Provide(NewTransferWatchdog),
+	fx.Invoke(registerHooks),
+)
+
+// registerHooks polls for transfers for the application's lifetime;
+// transfers before start are ignored
+func registerHooks(lifecycle fx.Lifecycle, watchdog TransferWatchdog) {
+	lifecycle.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			return watchdog.Start()
+		},
+		OnStop: func(context.Context) error {
+			return watchdog.Stop()
+		},
+	})
+}
@@ -0,0 +1,212 @@
+package watchdog
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/killswitch"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+	"github.com/backtesting-org/live-trading/pkg/scheduler"
+)
+
+// HaltHandler is told about a halt after the kill switch has engaged; it is
+// invoked with a human-readable reason
+type HaltHandler func(reason string)
+
+// Alert is raised for every transfer the watchdog did not expect
+type Alert struct {
+	Event      types.TransferEvent
+	DetectedAt time.Time
+	Halted     bool
+}
+
+// TransferWatchdog polls exchanges for withdrawals and transfers the system
+// never initiates, treating any it finds as a sign of compromised keys
+type TransferWatchdog interface {
+	// Start registers the polling job; transfers before Start are ignored
+	Start() error
+	Stop() error
+
+	// Check polls every ready connector that exposes transfer history
+	Check() ([]Alert, error)
+
+	Alerts() <-chan Alert
+	SetHaltHandler(handler HaltHandler)
+	Configure(config Config)
+}
+
+type transferWatchdog struct {
+	registry     registry.ConnectorRegistry
+	killSwitch   killswitch.KillSwitch
+	scheduler    scheduler.Scheduler
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config  Config
+	halt    HaltHandler
+	since   time.Time
+	seen    map[string]bool
+	alertCh chan Alert
+	mu      sync.Mutex
+}
+
+func NewTransferWatchdog(
+	connectorRegistry registry.ConnectorRegistry,
+	killSwitch killswitch.KillSwitch,
+	jobScheduler scheduler.Scheduler,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) TransferWatchdog {
+	return &transferWatchdog{
+		registry:     connectorRegistry,
+		killSwitch:   killSwitch,
+		scheduler:    jobScheduler,
+		timeProvider: timeProvider,
+		logger:       logger,
+		config:       DefaultConfig(),
+		seen:         make(map[string]bool),
+		alertCh:      make(chan Alert, 100),
+	}
+}
+
+func (w *transferWatchdog) Configure(config Config) {
+	if config.Interval <= 0 {
+		config.Interval = DefaultInterval
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.config = config
+}
+
+func (w *transferWatchdog) SetHaltHandler(handler HaltHandler) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.halt = handler
+}
+
+func (w *transferWatchdog) Alerts() <-chan Alert {
+	return w.alertCh
+}
+
+func (w *transferWatchdog) Start() error {
+	w.mu.Lock()
+	w.since = w.timeProvider.Now()
+	interval := w.config.Interval
+	w.mu.Unlock()
+
+	return w.scheduler.Register(scheduler.Job{
+		Name:     JobName,
+		Interval: interval,
+		Jitter:   interval / 10,
+		Run: func(_ context.Context) error {
+			_, err := w.Check()
+			return err
+		},
+	})
+}
+
+func (w *transferWatchdog) Stop() error {
+	return w.scheduler.Unregister(JobName)
+}
+
+func (w *transferWatchdog) Check() ([]Alert, error) {
+	w.mu.Lock()
+	since := w.since
+	config := w.config
+	halt := w.halt
+	w.mu.Unlock()
+
+	if since.IsZero() {
+		return nil, fmt.Errorf("transfer watchdog not started")
+	}
+
+	var alerts []Alert
+	var failed []string
+
+	for _, conn := range w.registry.GetReadyConnectors() {
+		provider, ok := conn.(types.TransferHistoryProvider)
+		if !ok {
+			continue
+		}
+
+		events, err := provider.FetchTransferEvents(since)
+		if err != nil {
+			failed = append(failed, err.Error())
+			w.logger.Warn("Transfer watchdog: failed to fetch transfer history: %v", err)
+			continue
+		}
+
+		for _, event := range events {
+			if w.expected(event, since, config) {
+				continue
+			}
+			alerts = append(alerts, Alert{Event: event, DetectedAt: w.timeProvider.Now()})
+		}
+	}
+
+	if len(alerts) > 0 && config.Policy == PolicyHalt {
+		reason := fmt.Sprintf("%d unexpected transfer event(s) detected", len(alerts))
+		// Positions are left alone: with the keys in doubt a human decides
+		// whether to flatten, but nothing new may trade until then
+		if _, err := w.killSwitch.Trigger(reason, false); err != nil {
+			w.logger.Error("Transfer watchdog: kill switch incomplete: %v", err)
+		}
+		if halt != nil {
+			halt(reason)
+		}
+		for i := range alerts {
+			alerts[i].Halted = true
+		}
+	}
+
+	for _, alert := range alerts {
+		w.logger.Error("🚨 Unexpected %s on %s: %s %s to %q (id %s, status %s)",
+			alert.Event.Kind, alert.Event.Exchange, alert.Event.Amount.String(), alert.Event.Asset,
+			alert.Event.Address, alert.Event.ID, alert.Event.Status)
+
+		select {
+		case w.alertCh <- alert:
+		default:
+		}
+	}
+
+	if len(failed) > 0 {
+		return alerts, fmt.Errorf("transfer history unavailable: %s", strings.Join(failed, "; "))
+	}
+	return alerts, nil
+}
+
+// expected filters out events already reported, events before Start and allowed destinations
+func (w *transferWatchdog) expected(event types.TransferEvent, since time.Time, config Config) bool {
+	if !event.Timestamp.IsZero() && event.Timestamp.Before(since) {
+		return true
+	}
+
+	for _, address := range config.AllowedAddresses {
+		if event.Address != "" && strings.EqualFold(event.Address, address) {
+			return true
+		}
+	}
+
+	key := eventKey(event.Exchange, event.Kind, event.ID)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.seen[key] {
+		return true
+	}
+	w.seen[key] = true
+	return false
+}
+
+func eventKey(exchange connector.ExchangeName, kind types.TransferKind, id string) string {
+	return fmt.Sprintf("%s:%s:%s", exchange, kind, id)
+}
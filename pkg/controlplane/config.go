@@ -0,0 +1,45 @@
+// Package controlplane serves a typed gRPC API over the plugin manager,
+// strategy registry, run supervisor, run reports and run stream, for
+// operators automating the host
+package controlplane
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultAddress is where the control plane listens unless configured
+	DefaultAddress = ":9465"
+
+	// DefaultStopGrace is how long Stop lets in-flight calls finish before
+	// closing every connection
+	DefaultStopGrace = 5 * time.Second
+)
+
+// Config controls where the control plane listens
+type Config struct {
+	Address   string
+	StopGrace time.Duration
+}
+
+// DefaultConfig listens on DefaultAddress
+func DefaultConfig() Config {
+	return Config{
+		Address:   DefaultAddress,
+		StopGrace: DefaultStopGrace,
+	}
+}
+
+func (c *Config) applyDefaults() error {
+	if c.StopGrace < 0 {
+		return fmt.Errorf("stop grace must not be negative, got %s", c.StopGrace)
+	}
+	if c.Address == "" {
+		c.Address = DefaultAddress
+	}
+	if c.StopGrace == 0 {
+		c.StopGrace = DefaultStopGrace
+	}
+	return nil
+}
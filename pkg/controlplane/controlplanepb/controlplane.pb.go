@@ -0,0 +1,1260 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: controlplane.proto
+
+// Control plane for operators automating the trading host: plugins,
+// strategies, supervised runs, run reports and live run telemetry.
+
+package controlplanepb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PluginKind int32
+
+const (
+	PluginKind_PLUGIN_KIND_UNSPECIFIED PluginKind = 0
+	PluginKind_PLUGIN_KIND_STRATEGY    PluginKind = 1
+	PluginKind_PLUGIN_KIND_HOOK        PluginKind = 2
+)
+
+// Enum value maps for PluginKind.
+var (
+	PluginKind_name = map[int32]string{
+		0: "PLUGIN_KIND_UNSPECIFIED",
+		1: "PLUGIN_KIND_STRATEGY",
+		2: "PLUGIN_KIND_HOOK",
+	}
+	PluginKind_value = map[string]int32{
+		"PLUGIN_KIND_UNSPECIFIED": 0,
+		"PLUGIN_KIND_STRATEGY":    1,
+		"PLUGIN_KIND_HOOK":        2,
+	}
+)
+
+func (x PluginKind) Enum() *PluginKind {
+	p := new(PluginKind)
+	*p = x
+	return p
+}
+
+func (x PluginKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PluginKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_controlplane_proto_enumTypes[0].Descriptor()
+}
+
+func (PluginKind) Type() protoreflect.EnumType {
+	return &file_controlplane_proto_enumTypes[0]
+}
+
+func (x PluginKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PluginKind.Descriptor instead.
+func (PluginKind) EnumDescriptor() ([]byte, []int) {
+	return file_controlplane_proto_rawDescGZIP(), []int{0}
+}
+
+type LoadPluginRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Path  string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// kind defaults to a strategy plugin
+	Kind          PluginKind `protobuf:"varint,2,opt,name=kind,proto3,enum=livetrading.controlplane.v1.PluginKind" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoadPluginRequest) Reset() {
+	*x = LoadPluginRequest{}
+	mi := &file_controlplane_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoadPluginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadPluginRequest) ProtoMessage() {}
+
+func (x *LoadPluginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadPluginRequest.ProtoReflect.Descriptor instead.
+func (*LoadPluginRequest) Descriptor() ([]byte, []int) {
+	return file_controlplane_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LoadPluginRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *LoadPluginRequest) GetKind() PluginKind {
+	if x != nil {
+		return x.Kind
+	}
+	return PluginKind_PLUGIN_KIND_UNSPECIFIED
+}
+
+type LoadPluginResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// strategy is set for strategy plugins
+	Strategy      *Strategy `protobuf:"bytes,1,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoadPluginResponse) Reset() {
+	*x = LoadPluginResponse{}
+	mi := &file_controlplane_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoadPluginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadPluginResponse) ProtoMessage() {}
+
+func (x *LoadPluginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadPluginResponse.ProtoReflect.Descriptor instead.
+func (*LoadPluginResponse) Descriptor() ([]byte, []int) {
+	return file_controlplane_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LoadPluginResponse) GetStrategy() *Strategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return nil
+}
+
+type Strategy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	RiskLevel     string                 `protobuf:"bytes,3,opt,name=risk_level,json=riskLevel,proto3" json:"risk_level,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Enabled       bool                   `protobuf:"varint,5,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Strategy) Reset() {
+	*x = Strategy{}
+	mi := &file_controlplane_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Strategy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Strategy) ProtoMessage() {}
+
+func (x *Strategy) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Strategy.ProtoReflect.Descriptor instead.
+func (*Strategy) Descriptor() ([]byte, []int) {
+	return file_controlplane_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Strategy) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Strategy) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Strategy) GetRiskLevel() string {
+	if x != nil {
+		return x.RiskLevel
+	}
+	return ""
+}
+
+func (x *Strategy) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Strategy) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type ListStrategiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStrategiesRequest) Reset() {
+	*x = ListStrategiesRequest{}
+	mi := &file_controlplane_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStrategiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStrategiesRequest) ProtoMessage() {}
+
+func (x *ListStrategiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStrategiesRequest.ProtoReflect.Descriptor instead.
+func (*ListStrategiesRequest) Descriptor() ([]byte, []int) {
+	return file_controlplane_proto_rawDescGZIP(), []int{3}
+}
+
+type ListStrategiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Strategies    []*Strategy            `protobuf:"bytes,1,rep,name=strategies,proto3" json:"strategies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStrategiesResponse) Reset() {
+	*x = ListStrategiesResponse{}
+	mi := &file_controlplane_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStrategiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStrategiesResponse) ProtoMessage() {}
+
+func (x *ListStrategiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStrategiesResponse.ProtoReflect.Descriptor instead.
+func (*ListStrategiesResponse) Descriptor() ([]byte, []int) {
+	return file_controlplane_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListStrategiesResponse) GetStrategies() []*Strategy {
+	if x != nil {
+		return x.Strategies
+	}
+	return nil
+}
+
+type StrategyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StrategyRequest) Reset() {
+	*x = StrategyRequest{}
+	mi := &file_controlplane_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StrategyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StrategyRequest) ProtoMessage() {}
+
+func (x *StrategyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StrategyRequest.ProtoReflect.Descriptor instead.
+func (*StrategyRequest) Descriptor() ([]byte, []int) {
+	return file_controlplane_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StrategyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListRunsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRunsRequest) Reset() {
+	*x = ListRunsRequest{}
+	mi := &file_controlplane_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRunsRequest) ProtoMessage() {}
+
+func (x *ListRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListRunsRequest) Descriptor() ([]byte, []int) {
+	return file_controlplane_proto_rawDescGZIP(), []int{6}
+}
+
+type ListRunsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Runs          []*RunStatus           `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRunsResponse) Reset() {
+	*x = ListRunsResponse{}
+	mi := &file_controlplane_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRunsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRunsResponse) ProtoMessage() {}
+
+func (x *ListRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListRunsResponse) Descriptor() ([]byte, []int) {
+	return file_controlplane_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListRunsResponse) GetRuns() []*RunStatus {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+type RunRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunRequest) Reset() {
+	*x = RunRequest{}
+	mi := &file_controlplane_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunRequest) ProtoMessage() {}
+
+func (x *RunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunRequest.ProtoReflect.Descriptor instead.
+func (*RunRequest) Descriptor() ([]byte, []int) {
+	return file_controlplane_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RunRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RunStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Desired       string                 `protobuf:"bytes,2,opt,name=desired,proto3" json:"desired,omitempty"`
+	State         string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Restarts      int32                  `protobuf:"varint,4,opt,name=restarts,proto3" json:"restarts,omitempty"`
+	LastError     string                 `protobuf:"bytes,5,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	NextRestartAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=next_restart_at,json=nextRestartAt,proto3" json:"next_restart_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunStatus) Reset() {
+	*x = RunStatus{}
+	mi := &file_controlplane_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunStatus) ProtoMessage() {}
+
+func (x *RunStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunStatus.ProtoReflect.Descriptor instead.
+func (*RunStatus) Descriptor() ([]byte, []int) {
+	return file_controlplane_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RunStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RunStatus) GetDesired() string {
+	if x != nil {
+		return x.Desired
+	}
+	return ""
+}
+
+func (x *RunStatus) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *RunStatus) GetRestarts() int32 {
+	if x != nil {
+		return x.Restarts
+	}
+	return 0
+}
+
+func (x *RunStatus) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *RunStatus) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *RunStatus) GetNextRestartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRestartAt
+	}
+	return nil
+}
+
+type RunReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RunId         string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunReportRequest) Reset() {
+	*x = RunReportRequest{}
+	mi := &file_controlplane_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunReportRequest) ProtoMessage() {}
+
+func (x *RunReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunReportRequest.ProtoReflect.Descriptor instead.
+func (*RunReportRequest) Descriptor() ([]byte, []int) {
+	return file_controlplane_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RunReportRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+// RunReport carries decimals as strings so no precision is lost
+type RunReport struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RunId          string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Active         bool                   `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	StartedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	EndedAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ended_at,json=endedAt,proto3" json:"ended_at,omitempty"`
+	Outcome        string                 `protobuf:"bytes,5,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Error          string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	Fills          int32                  `protobuf:"varint,7,opt,name=fills,proto3" json:"fills,omitempty"`
+	NetRealizedPnl string                 `protobuf:"bytes,8,opt,name=net_realized_pnl,json=netRealizedPnl,proto3" json:"net_realized_pnl,omitempty"`
+	Fees           string                 `protobuf:"bytes,9,opt,name=fees,proto3" json:"fees,omitempty"`
+	MaxDrawdown    string                 `protobuf:"bytes,10,opt,name=max_drawdown,json=maxDrawdown,proto3" json:"max_drawdown,omitempty"`
+	StartEquity    string                 `protobuf:"bytes,11,opt,name=start_equity,json=startEquity,proto3" json:"start_equity,omitempty"`
+	EndEquity      string                 `protobuf:"bytes,12,opt,name=end_equity,json=endEquity,proto3" json:"end_equity,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RunReport) Reset() {
+	*x = RunReport{}
+	mi := &file_controlplane_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunReport) ProtoMessage() {}
+
+func (x *RunReport) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunReport.ProtoReflect.Descriptor instead.
+func (*RunReport) Descriptor() ([]byte, []int) {
+	return file_controlplane_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RunReport) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *RunReport) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *RunReport) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *RunReport) GetEndedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndedAt
+	}
+	return nil
+}
+
+func (x *RunReport) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
+	}
+	return ""
+}
+
+func (x *RunReport) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *RunReport) GetFills() int32 {
+	if x != nil {
+		return x.Fills
+	}
+	return 0
+}
+
+func (x *RunReport) GetNetRealizedPnl() string {
+	if x != nil {
+		return x.NetRealizedPnl
+	}
+	return ""
+}
+
+func (x *RunReport) GetFees() string {
+	if x != nil {
+		return x.Fees
+	}
+	return ""
+}
+
+func (x *RunReport) GetMaxDrawdown() string {
+	if x != nil {
+		return x.MaxDrawdown
+	}
+	return ""
+}
+
+func (x *RunReport) GetStartEquity() string {
+	if x != nil {
+		return x.StartEquity
+	}
+	return ""
+}
+
+func (x *RunReport) GetEndEquity() string {
+	if x != nil {
+		return x.EndEquity
+	}
+	return ""
+}
+
+type StreamRunEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// run_id defaults to the active run
+	RunId string `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// kinds narrows the stream to kinds starting with any of these, e.g.
+	// run.equity or log.
+	Kinds         []string `protobuf:"bytes,2,rep,name=kinds,proto3" json:"kinds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamRunEventsRequest) Reset() {
+	*x = StreamRunEventsRequest{}
+	mi := &file_controlplane_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamRunEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamRunEventsRequest) ProtoMessage() {}
+
+func (x *StreamRunEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamRunEventsRequest.ProtoReflect.Descriptor instead.
+func (*StreamRunEventsRequest) Descriptor() ([]byte, []int) {
+	return file_controlplane_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *StreamRunEventsRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *StreamRunEventsRequest) GetKinds() []string {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+type RunEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RunId string                 `protobuf:"bytes,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Kind  string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	At    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=at,proto3" json:"at,omitempty"`
+	// data is the event payload encoded as JSON
+	Data          []byte `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunEvent) Reset() {
+	*x = RunEvent{}
+	mi := &file_controlplane_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunEvent) ProtoMessage() {}
+
+func (x *RunEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunEvent.ProtoReflect.Descriptor instead.
+func (*RunEvent) Descriptor() ([]byte, []int) {
+	return file_controlplane_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RunEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RunEvent) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *RunEvent) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *RunEvent) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *RunEvent) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ExportOrderJournalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportOrderJournalRequest) Reset() {
+	*x = ExportOrderJournalRequest{}
+	mi := &file_controlplane_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportOrderJournalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportOrderJournalRequest) ProtoMessage() {}
+
+func (x *ExportOrderJournalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportOrderJournalRequest.ProtoReflect.Descriptor instead.
+func (*ExportOrderJournalRequest) Descriptor() ([]byte, []int) {
+	return file_controlplane_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ExportOrderJournalRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type OrderTransition struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Sequence   uint64                 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	RecordedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=recorded_at,json=recordedAt,proto3" json:"recorded_at,omitempty"`
+	Exchange   string                 `protobuf:"bytes,3,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	OrderId    string                 `protobuf:"bytes,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Symbol     string                 `protobuf:"bytes,5,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	From       string                 `protobuf:"bytes,6,opt,name=from,proto3" json:"from,omitempty"`
+	To         string                 `protobuf:"bytes,7,opt,name=to,proto3" json:"to,omitempty"`
+	Event      string                 `protobuf:"bytes,8,opt,name=event,proto3" json:"event,omitempty"`
+	// payload is JSON: the exchange's own response when raw is set, else
+	// the order as the tracker saw it or the event's detail
+	Payload       []byte `protobuf:"bytes,9,opt,name=payload,proto3" json:"payload,omitempty"`
+	Raw           bool   `protobuf:"varint,10,opt,name=raw,proto3" json:"raw,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderTransition) Reset() {
+	*x = OrderTransition{}
+	mi := &file_controlplane_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderTransition) ProtoMessage() {}
+
+func (x *OrderTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_controlplane_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderTransition.ProtoReflect.Descriptor instead.
+func (*OrderTransition) Descriptor() ([]byte, []int) {
+	return file_controlplane_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *OrderTransition) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *OrderTransition) GetRecordedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RecordedAt
+	}
+	return nil
+}
+
+func (x *OrderTransition) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *OrderTransition) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *OrderTransition) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *OrderTransition) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *OrderTransition) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *OrderTransition) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *OrderTransition) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *OrderTransition) GetRaw() bool {
+	if x != nil {
+		return x.Raw
+	}
+	return false
+}
+
+var File_controlplane_proto protoreflect.FileDescriptor
+
+const file_controlplane_proto_rawDesc = "" +
+	"\n" +
+	"\x12controlplane.proto\x12\x1blivetrading.controlplane.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"d\n" +
+	"\x11LoadPluginRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12;\n" +
+	"\x04kind\x18\x02 \x01(\x0e2'.livetrading.controlplane.v1.PluginKindR\x04kind\"W\n" +
+	"\x12LoadPluginResponse\x12A\n" +
+	"\bstrategy\x18\x01 \x01(\v2%.livetrading.controlplane.v1.StrategyR\bstrategy\"\x8d\x01\n" +
+	"\bStrategy\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"risk_level\x18\x03 \x01(\tR\triskLevel\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x18\n" +
+	"\aenabled\x18\x05 \x01(\bR\aenabled\"\x17\n" +
+	"\x15ListStrategiesRequest\"_\n" +
+	"\x16ListStrategiesResponse\x12E\n" +
+	"\n" +
+	"strategies\x18\x01 \x03(\v2%.livetrading.controlplane.v1.StrategyR\n" +
+	"strategies\"%\n" +
+	"\x0fStrategyRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\x11\n" +
+	"\x0fListRunsRequest\"N\n" +
+	"\x10ListRunsResponse\x12:\n" +
+	"\x04runs\x18\x01 \x03(\v2&.livetrading.controlplane.v1.RunStatusR\x04runs\" \n" +
+	"\n" +
+	"RunRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\x89\x02\n" +
+	"\tRunStatus\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\adesired\x18\x02 \x01(\tR\adesired\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x12\x1a\n" +
+	"\brestarts\x18\x04 \x01(\x05R\brestarts\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x05 \x01(\tR\tlastError\x129\n" +
+	"\n" +
+	"started_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12B\n" +
+	"\x0fnext_restart_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\rnextRestartAt\")\n" +
+	"\x10RunReportRequest\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\"\x95\x03\n" +
+	"\tRunReport\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\x12\x16\n" +
+	"\x06active\x18\x02 \x01(\bR\x06active\x129\n" +
+	"\n" +
+	"started_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x125\n" +
+	"\bended_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendedAt\x12\x18\n" +
+	"\aoutcome\x18\x05 \x01(\tR\aoutcome\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\x12\x14\n" +
+	"\x05fills\x18\a \x01(\x05R\x05fills\x12(\n" +
+	"\x10net_realized_pnl\x18\b \x01(\tR\x0enetRealizedPnl\x12\x12\n" +
+	"\x04fees\x18\t \x01(\tR\x04fees\x12!\n" +
+	"\fmax_drawdown\x18\n" +
+	" \x01(\tR\vmaxDrawdown\x12!\n" +
+	"\fstart_equity\x18\v \x01(\tR\vstartEquity\x12\x1d\n" +
+	"\n" +
+	"end_equity\x18\f \x01(\tR\tendEquity\"E\n" +
+	"\x16StreamRunEventsRequest\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\x12\x14\n" +
+	"\x05kinds\x18\x02 \x03(\tR\x05kinds\"\x85\x01\n" +
+	"\bRunEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x15\n" +
+	"\x06run_id\x18\x02 \x01(\tR\x05runId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12*\n" +
+	"\x02at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\x12\x12\n" +
+	"\x04data\x18\x05 \x01(\fR\x04data\"6\n" +
+	"\x19ExportOrderJournalRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"\x9f\x02\n" +
+	"\x0fOrderTransition\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x04R\bsequence\x12;\n" +
+	"\vrecorded_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"recordedAt\x12\x1a\n" +
+	"\bexchange\x18\x03 \x01(\tR\bexchange\x12\x19\n" +
+	"\border_id\x18\x04 \x01(\tR\aorderId\x12\x16\n" +
+	"\x06symbol\x18\x05 \x01(\tR\x06symbol\x12\x12\n" +
+	"\x04from\x18\x06 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\a \x01(\tR\x02to\x12\x14\n" +
+	"\x05event\x18\b \x01(\tR\x05event\x12\x18\n" +
+	"\apayload\x18\t \x01(\fR\apayload\x12\x10\n" +
+	"\x03raw\x18\n" +
+	" \x01(\bR\x03raw*Y\n" +
+	"\n" +
+	"PluginKind\x12\x1b\n" +
+	"\x17PLUGIN_KIND_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14PLUGIN_KIND_STRATEGY\x10\x01\x12\x14\n" +
+	"\x10PLUGIN_KIND_HOOK\x10\x022\xa0\t\n" +
+	"\fControlPlane\x12m\n" +
+	"\n" +
+	"LoadPlugin\x12..livetrading.controlplane.v1.LoadPluginRequest\x1a/.livetrading.controlplane.v1.LoadPluginResponse\x12y\n" +
+	"\x0eListStrategies\x122.livetrading.controlplane.v1.ListStrategiesRequest\x1a3.livetrading.controlplane.v1.ListStrategiesResponse\x12e\n" +
+	"\x0eEnableStrategy\x12,.livetrading.controlplane.v1.StrategyRequest\x1a%.livetrading.controlplane.v1.Strategy\x12f\n" +
+	"\x0fDisableStrategy\x12,.livetrading.controlplane.v1.StrategyRequest\x1a%.livetrading.controlplane.v1.Strategy\x12g\n" +
+	"\bListRuns\x12,.livetrading.controlplane.v1.ListRunsRequest\x1a-.livetrading.controlplane.v1.ListRunsResponse\x12[\n" +
+	"\bStartRun\x12'.livetrading.controlplane.v1.RunRequest\x1a&.livetrading.controlplane.v1.RunStatus\x12Z\n" +
+	"\aStopRun\x12'.livetrading.controlplane.v1.RunRequest\x1a&.livetrading.controlplane.v1.RunStatus\x12_\n" +
+	"\fGetRunStatus\x12'.livetrading.controlplane.v1.RunRequest\x1a&.livetrading.controlplane.v1.RunStatus\x12e\n" +
+	"\fGetRunReport\x12-.livetrading.controlplane.v1.RunReportRequest\x1a&.livetrading.controlplane.v1.RunReport\x12o\n" +
+	"\x0fStreamRunEvents\x123.livetrading.controlplane.v1.StreamRunEventsRequest\x1a%.livetrading.controlplane.v1.RunEvent0\x01\x12|\n" +
+	"\x12ExportOrderJournal\x126.livetrading.controlplane.v1.ExportOrderJournalRequest\x1a,.livetrading.controlplane.v1.OrderTransition0\x01BXZVgithub.com/backtesting-org/live-trading/pkg/controlplane/controlplanepb;controlplanepbb\x06proto3"
+
+var (
+	file_controlplane_proto_rawDescOnce sync.Once
+	file_controlplane_proto_rawDescData []byte
+)
+
+func file_controlplane_proto_rawDescGZIP() []byte {
+	file_controlplane_proto_rawDescOnce.Do(func() {
+		file_controlplane_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_controlplane_proto_rawDesc), len(file_controlplane_proto_rawDesc)))
+	})
+	return file_controlplane_proto_rawDescData
+}
+
+var file_controlplane_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_controlplane_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_controlplane_proto_goTypes = []any{
+	(PluginKind)(0),                   // 0: livetrading.controlplane.v1.PluginKind
+	(*LoadPluginRequest)(nil),         // 1: livetrading.controlplane.v1.LoadPluginRequest
+	(*LoadPluginResponse)(nil),        // 2: livetrading.controlplane.v1.LoadPluginResponse
+	(*Strategy)(nil),                  // 3: livetrading.controlplane.v1.Strategy
+	(*ListStrategiesRequest)(nil),     // 4: livetrading.controlplane.v1.ListStrategiesRequest
+	(*ListStrategiesResponse)(nil),    // 5: livetrading.controlplane.v1.ListStrategiesResponse
+	(*StrategyRequest)(nil),           // 6: livetrading.controlplane.v1.StrategyRequest
+	(*ListRunsRequest)(nil),           // 7: livetrading.controlplane.v1.ListRunsRequest
+	(*ListRunsResponse)(nil),          // 8: livetrading.controlplane.v1.ListRunsResponse
+	(*RunRequest)(nil),                // 9: livetrading.controlplane.v1.RunRequest
+	(*RunStatus)(nil),                 // 10: livetrading.controlplane.v1.RunStatus
+	(*RunReportRequest)(nil),          // 11: livetrading.controlplane.v1.RunReportRequest
+	(*RunReport)(nil),                 // 12: livetrading.controlplane.v1.RunReport
+	(*StreamRunEventsRequest)(nil),    // 13: livetrading.controlplane.v1.StreamRunEventsRequest
+	(*RunEvent)(nil),                  // 14: livetrading.controlplane.v1.RunEvent
+	(*ExportOrderJournalRequest)(nil), // 15: livetrading.controlplane.v1.ExportOrderJournalRequest
+	(*OrderTransition)(nil),           // 16: livetrading.controlplane.v1.OrderTransition
+	(*timestamppb.Timestamp)(nil),     // 17: google.protobuf.Timestamp
+}
+var file_controlplane_proto_depIdxs = []int32{
+	0,  // 0: livetrading.controlplane.v1.LoadPluginRequest.kind:type_name -> livetrading.controlplane.v1.PluginKind
+	3,  // 1: livetrading.controlplane.v1.LoadPluginResponse.strategy:type_name -> livetrading.controlplane.v1.Strategy
+	3,  // 2: livetrading.controlplane.v1.ListStrategiesResponse.strategies:type_name -> livetrading.controlplane.v1.Strategy
+	10, // 3: livetrading.controlplane.v1.ListRunsResponse.runs:type_name -> livetrading.controlplane.v1.RunStatus
+	17, // 4: livetrading.controlplane.v1.RunStatus.started_at:type_name -> google.protobuf.Timestamp
+	17, // 5: livetrading.controlplane.v1.RunStatus.next_restart_at:type_name -> google.protobuf.Timestamp
+	17, // 6: livetrading.controlplane.v1.RunReport.started_at:type_name -> google.protobuf.Timestamp
+	17, // 7: livetrading.controlplane.v1.RunReport.ended_at:type_name -> google.protobuf.Timestamp
+	17, // 8: livetrading.controlplane.v1.RunEvent.at:type_name -> google.protobuf.Timestamp
+	17, // 9: livetrading.controlplane.v1.OrderTransition.recorded_at:type_name -> google.protobuf.Timestamp
+	1,  // 10: livetrading.controlplane.v1.ControlPlane.LoadPlugin:input_type -> livetrading.controlplane.v1.LoadPluginRequest
+	4,  // 11: livetrading.controlplane.v1.ControlPlane.ListStrategies:input_type -> livetrading.controlplane.v1.ListStrategiesRequest
+	6,  // 12: livetrading.controlplane.v1.ControlPlane.EnableStrategy:input_type -> livetrading.controlplane.v1.StrategyRequest
+	6,  // 13: livetrading.controlplane.v1.ControlPlane.DisableStrategy:input_type -> livetrading.controlplane.v1.StrategyRequest
+	7,  // 14: livetrading.controlplane.v1.ControlPlane.ListRuns:input_type -> livetrading.controlplane.v1.ListRunsRequest
+	9,  // 15: livetrading.controlplane.v1.ControlPlane.StartRun:input_type -> livetrading.controlplane.v1.RunRequest
+	9,  // 16: livetrading.controlplane.v1.ControlPlane.StopRun:input_type -> livetrading.controlplane.v1.RunRequest
+	9,  // 17: livetrading.controlplane.v1.ControlPlane.GetRunStatus:input_type -> livetrading.controlplane.v1.RunRequest
+	11, // 18: livetrading.controlplane.v1.ControlPlane.GetRunReport:input_type -> livetrading.controlplane.v1.RunReportRequest
+	13, // 19: livetrading.controlplane.v1.ControlPlane.StreamRunEvents:input_type -> livetrading.controlplane.v1.StreamRunEventsRequest
+	15, // 20: livetrading.controlplane.v1.ControlPlane.ExportOrderJournal:input_type -> livetrading.controlplane.v1.ExportOrderJournalRequest
+	2,  // 21: livetrading.controlplane.v1.ControlPlane.LoadPlugin:output_type -> livetrading.controlplane.v1.LoadPluginResponse
+	5,  // 22: livetrading.controlplane.v1.ControlPlane.ListStrategies:output_type -> livetrading.controlplane.v1.ListStrategiesResponse
+	3,  // 23: livetrading.controlplane.v1.ControlPlane.EnableStrategy:output_type -> livetrading.controlplane.v1.Strategy
+	3,  // 24: livetrading.controlplane.v1.ControlPlane.DisableStrategy:output_type -> livetrading.controlplane.v1.Strategy
+	8,  // 25: livetrading.controlplane.v1.ControlPlane.ListRuns:output_type -> livetrading.controlplane.v1.ListRunsResponse
+	10, // 26: livetrading.controlplane.v1.ControlPlane.StartRun:output_type -> livetrading.controlplane.v1.RunStatus
+	10, // 27: livetrading.controlplane.v1.ControlPlane.StopRun:output_type -> livetrading.controlplane.v1.RunStatus
+	10, // 28: livetrading.controlplane.v1.ControlPlane.GetRunStatus:output_type -> livetrading.controlplane.v1.RunStatus
+	12, // 29: livetrading.controlplane.v1.ControlPlane.GetRunReport:output_type -> livetrading.controlplane.v1.RunReport
+	14, // 30: livetrading.controlplane.v1.ControlPlane.StreamRunEvents:output_type -> livetrading.controlplane.v1.RunEvent
+	16, // 31: livetrading.controlplane.v1.ControlPlane.ExportOrderJournal:output_type -> livetrading.controlplane.v1.OrderTransition
+	21, // [21:32] is the sub-list for method output_type
+	10, // [10:21] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_controlplane_proto_init() }
+func file_controlplane_proto_init() {
+	if File_controlplane_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_controlplane_proto_rawDesc), len(file_controlplane_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_controlplane_proto_goTypes,
+		DependencyIndexes: file_controlplane_proto_depIdxs,
+		EnumInfos:         file_controlplane_proto_enumTypes,
+		MessageInfos:      file_controlplane_proto_msgTypes,
+	}.Build()
+	File_controlplane_proto = out.File
+	file_controlplane_proto_goTypes = nil
+	file_controlplane_proto_depIdxs = nil
+}
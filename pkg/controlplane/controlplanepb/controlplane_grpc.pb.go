@@ -0,0 +1,527 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: controlplane.proto
+
+// Control plane for operators automating the trading host: plugins,
+// strategies, supervised runs, run reports and live run telemetry.
+
+package controlplanepb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ControlPlane_LoadPlugin_FullMethodName         = "/livetrading.controlplane.v1.ControlPlane/LoadPlugin"
+	ControlPlane_ListStrategies_FullMethodName     = "/livetrading.controlplane.v1.ControlPlane/ListStrategies"
+	ControlPlane_EnableStrategy_FullMethodName     = "/livetrading.controlplane.v1.ControlPlane/EnableStrategy"
+	ControlPlane_DisableStrategy_FullMethodName    = "/livetrading.controlplane.v1.ControlPlane/DisableStrategy"
+	ControlPlane_ListRuns_FullMethodName           = "/livetrading.controlplane.v1.ControlPlane/ListRuns"
+	ControlPlane_StartRun_FullMethodName           = "/livetrading.controlplane.v1.ControlPlane/StartRun"
+	ControlPlane_StopRun_FullMethodName            = "/livetrading.controlplane.v1.ControlPlane/StopRun"
+	ControlPlane_GetRunStatus_FullMethodName       = "/livetrading.controlplane.v1.ControlPlane/GetRunStatus"
+	ControlPlane_GetRunReport_FullMethodName       = "/livetrading.controlplane.v1.ControlPlane/GetRunReport"
+	ControlPlane_StreamRunEvents_FullMethodName    = "/livetrading.controlplane.v1.ControlPlane/StreamRunEvents"
+	ControlPlane_ExportOrderJournal_FullMethodName = "/livetrading.controlplane.v1.ControlPlane/ExportOrderJournal"
+)
+
+// ControlPlaneClient is the client API for ControlPlane service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ControlPlaneClient interface {
+	// LoadPlugin loads a strategy or hook plugin and registers it
+	LoadPlugin(ctx context.Context, in *LoadPluginRequest, opts ...grpc.CallOption) (*LoadPluginResponse, error)
+	ListStrategies(ctx context.Context, in *ListStrategiesRequest, opts ...grpc.CallOption) (*ListStrategiesResponse, error)
+	EnableStrategy(ctx context.Context, in *StrategyRequest, opts ...grpc.CallOption) (*Strategy, error)
+	DisableStrategy(ctx context.Context, in *StrategyRequest, opts ...grpc.CallOption) (*Strategy, error)
+	// StartRun and StopRun change a supervised run's desired state
+	ListRuns(ctx context.Context, in *ListRunsRequest, opts ...grpc.CallOption) (*ListRunsResponse, error)
+	StartRun(ctx context.Context, in *RunRequest, opts ...grpc.CallOption) (*RunStatus, error)
+	StopRun(ctx context.Context, in *RunRequest, opts ...grpc.CallOption) (*RunStatus, error)
+	GetRunStatus(ctx context.Context, in *RunRequest, opts ...grpc.CallOption) (*RunStatus, error)
+	// GetRunReport returns the active run's report so far, or a finished
+	// run's; an empty run_id selects the active run, or the latest one
+	GetRunReport(ctx context.Context, in *RunReportRequest, opts ...grpc.CallOption) (*RunReport, error)
+	// StreamRunEvents sends a run's telemetry as it happens until the
+	// client cancels or the server stops
+	StreamRunEvents(ctx context.Context, in *StreamRunEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RunEvent], error)
+	// ExportOrderJournal sends every journaled state transition of an
+	// order, oldest first
+	ExportOrderJournal(ctx context.Context, in *ExportOrderJournalRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OrderTransition], error)
+}
+
+type controlPlaneClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewControlPlaneClient(cc grpc.ClientConnInterface) ControlPlaneClient {
+	return &controlPlaneClient{cc}
+}
+
+func (c *controlPlaneClient) LoadPlugin(ctx context.Context, in *LoadPluginRequest, opts ...grpc.CallOption) (*LoadPluginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoadPluginResponse)
+	err := c.cc.Invoke(ctx, ControlPlane_LoadPlugin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneClient) ListStrategies(ctx context.Context, in *ListStrategiesRequest, opts ...grpc.CallOption) (*ListStrategiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStrategiesResponse)
+	err := c.cc.Invoke(ctx, ControlPlane_ListStrategies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneClient) EnableStrategy(ctx context.Context, in *StrategyRequest, opts ...grpc.CallOption) (*Strategy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Strategy)
+	err := c.cc.Invoke(ctx, ControlPlane_EnableStrategy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneClient) DisableStrategy(ctx context.Context, in *StrategyRequest, opts ...grpc.CallOption) (*Strategy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Strategy)
+	err := c.cc.Invoke(ctx, ControlPlane_DisableStrategy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneClient) ListRuns(ctx context.Context, in *ListRunsRequest, opts ...grpc.CallOption) (*ListRunsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRunsResponse)
+	err := c.cc.Invoke(ctx, ControlPlane_ListRuns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneClient) StartRun(ctx context.Context, in *RunRequest, opts ...grpc.CallOption) (*RunStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunStatus)
+	err := c.cc.Invoke(ctx, ControlPlane_StartRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneClient) StopRun(ctx context.Context, in *RunRequest, opts ...grpc.CallOption) (*RunStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunStatus)
+	err := c.cc.Invoke(ctx, ControlPlane_StopRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneClient) GetRunStatus(ctx context.Context, in *RunRequest, opts ...grpc.CallOption) (*RunStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunStatus)
+	err := c.cc.Invoke(ctx, ControlPlane_GetRunStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneClient) GetRunReport(ctx context.Context, in *RunReportRequest, opts ...grpc.CallOption) (*RunReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunReport)
+	err := c.cc.Invoke(ctx, ControlPlane_GetRunReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneClient) StreamRunEvents(ctx context.Context, in *StreamRunEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RunEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ControlPlane_ServiceDesc.Streams[0], ControlPlane_StreamRunEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamRunEventsRequest, RunEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ControlPlane_StreamRunEventsClient = grpc.ServerStreamingClient[RunEvent]
+
+func (c *controlPlaneClient) ExportOrderJournal(ctx context.Context, in *ExportOrderJournalRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OrderTransition], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ControlPlane_ServiceDesc.Streams[1], ControlPlane_ExportOrderJournal_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportOrderJournalRequest, OrderTransition]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ControlPlane_ExportOrderJournalClient = grpc.ServerStreamingClient[OrderTransition]
+
+// ControlPlaneServer is the server API for ControlPlane service.
+// All implementations must embed UnimplementedControlPlaneServer
+// for forward compatibility.
+type ControlPlaneServer interface {
+	// LoadPlugin loads a strategy or hook plugin and registers it
+	LoadPlugin(context.Context, *LoadPluginRequest) (*LoadPluginResponse, error)
+	ListStrategies(context.Context, *ListStrategiesRequest) (*ListStrategiesResponse, error)
+	EnableStrategy(context.Context, *StrategyRequest) (*Strategy, error)
+	DisableStrategy(context.Context, *StrategyRequest) (*Strategy, error)
+	// StartRun and StopRun change a supervised run's desired state
+	ListRuns(context.Context, *ListRunsRequest) (*ListRunsResponse, error)
+	StartRun(context.Context, *RunRequest) (*RunStatus, error)
+	StopRun(context.Context, *RunRequest) (*RunStatus, error)
+	GetRunStatus(context.Context, *RunRequest) (*RunStatus, error)
+	// GetRunReport returns the active run's report so far, or a finished
+	// run's; an empty run_id selects the active run, or the latest one
+	GetRunReport(context.Context, *RunReportRequest) (*RunReport, error)
+	// StreamRunEvents sends a run's telemetry as it happens until the
+	// client cancels or the server stops
+	StreamRunEvents(*StreamRunEventsRequest, grpc.ServerStreamingServer[RunEvent]) error
+	// ExportOrderJournal sends every journaled state transition of an
+	// order, oldest first
+	ExportOrderJournal(*ExportOrderJournalRequest, grpc.ServerStreamingServer[OrderTransition]) error
+	mustEmbedUnimplementedControlPlaneServer()
+}
+
+// UnimplementedControlPlaneServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedControlPlaneServer struct{}
+
+func (UnimplementedControlPlaneServer) LoadPlugin(context.Context, *LoadPluginRequest) (*LoadPluginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadPlugin not implemented")
+}
+func (UnimplementedControlPlaneServer) ListStrategies(context.Context, *ListStrategiesRequest) (*ListStrategiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStrategies not implemented")
+}
+func (UnimplementedControlPlaneServer) EnableStrategy(context.Context, *StrategyRequest) (*Strategy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableStrategy not implemented")
+}
+func (UnimplementedControlPlaneServer) DisableStrategy(context.Context, *StrategyRequest) (*Strategy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableStrategy not implemented")
+}
+func (UnimplementedControlPlaneServer) ListRuns(context.Context, *ListRunsRequest) (*ListRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRuns not implemented")
+}
+func (UnimplementedControlPlaneServer) StartRun(context.Context, *RunRequest) (*RunStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartRun not implemented")
+}
+func (UnimplementedControlPlaneServer) StopRun(context.Context, *RunRequest) (*RunStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopRun not implemented")
+}
+func (UnimplementedControlPlaneServer) GetRunStatus(context.Context, *RunRequest) (*RunStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRunStatus not implemented")
+}
+func (UnimplementedControlPlaneServer) GetRunReport(context.Context, *RunReportRequest) (*RunReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRunReport not implemented")
+}
+func (UnimplementedControlPlaneServer) StreamRunEvents(*StreamRunEventsRequest, grpc.ServerStreamingServer[RunEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamRunEvents not implemented")
+}
+func (UnimplementedControlPlaneServer) ExportOrderJournal(*ExportOrderJournalRequest, grpc.ServerStreamingServer[OrderTransition]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportOrderJournal not implemented")
+}
+func (UnimplementedControlPlaneServer) mustEmbedUnimplementedControlPlaneServer() {}
+func (UnimplementedControlPlaneServer) testEmbeddedByValue()                      {}
+
+// UnsafeControlPlaneServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ControlPlaneServer will
+// result in compilation errors.
+type UnsafeControlPlaneServer interface {
+	mustEmbedUnimplementedControlPlaneServer()
+}
+
+func RegisterControlPlaneServer(s grpc.ServiceRegistrar, srv ControlPlaneServer) {
+	// If the following call pancis, it indicates UnimplementedControlPlaneServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ControlPlane_ServiceDesc, srv)
+}
+
+func _ControlPlane_LoadPlugin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadPluginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServer).LoadPlugin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlane_LoadPlugin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServer).LoadPlugin(ctx, req.(*LoadPluginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlane_ListStrategies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStrategiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServer).ListStrategies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlane_ListStrategies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServer).ListStrategies(ctx, req.(*ListStrategiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlane_EnableStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StrategyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServer).EnableStrategy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlane_EnableStrategy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServer).EnableStrategy(ctx, req.(*StrategyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlane_DisableStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StrategyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServer).DisableStrategy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlane_DisableStrategy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServer).DisableStrategy(ctx, req.(*StrategyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlane_ListRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServer).ListRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlane_ListRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServer).ListRuns(ctx, req.(*ListRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlane_StartRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServer).StartRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlane_StartRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServer).StartRun(ctx, req.(*RunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlane_StopRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServer).StopRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlane_StopRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServer).StopRun(ctx, req.(*RunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlane_GetRunStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServer).GetRunStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlane_GetRunStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServer).GetRunStatus(ctx, req.(*RunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlane_GetRunReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServer).GetRunReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlane_GetRunReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServer).GetRunReport(ctx, req.(*RunReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlane_StreamRunEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamRunEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ControlPlaneServer).StreamRunEvents(m, &grpc.GenericServerStream[StreamRunEventsRequest, RunEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ControlPlane_StreamRunEventsServer = grpc.ServerStreamingServer[RunEvent]
+
+func _ControlPlane_ExportOrderJournal_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportOrderJournalRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ControlPlaneServer).ExportOrderJournal(m, &grpc.GenericServerStream[ExportOrderJournalRequest, OrderTransition]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ControlPlane_ExportOrderJournalServer = grpc.ServerStreamingServer[OrderTransition]
+
+// ControlPlane_ServiceDesc is the grpc.ServiceDesc for ControlPlane service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ControlPlane_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "livetrading.controlplane.v1.ControlPlane",
+	HandlerType: (*ControlPlaneServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LoadPlugin",
+			Handler:    _ControlPlane_LoadPlugin_Handler,
+		},
+		{
+			MethodName: "ListStrategies",
+			Handler:    _ControlPlane_ListStrategies_Handler,
+		},
+		{
+			MethodName: "EnableStrategy",
+			Handler:    _ControlPlane_EnableStrategy_Handler,
+		},
+		{
+			MethodName: "DisableStrategy",
+			Handler:    _ControlPlane_DisableStrategy_Handler,
+		},
+		{
+			MethodName: "ListRuns",
+			Handler:    _ControlPlane_ListRuns_Handler,
+		},
+		{
+			MethodName: "StartRun",
+			Handler:    _ControlPlane_StartRun_Handler,
+		},
+		{
+			MethodName: "StopRun",
+			Handler:    _ControlPlane_StopRun_Handler,
+		},
+		{
+			MethodName: "GetRunStatus",
+			Handler:    _ControlPlane_GetRunStatus_Handler,
+		},
+		{
+			MethodName: "GetRunReport",
+			Handler:    _ControlPlane_GetRunReport_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamRunEvents",
+			Handler:       _ControlPlane_StreamRunEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportOrderJournal",
+			Handler:       _ControlPlane_ExportOrderJournal_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "controlplane.proto",
+}
@@ -0,0 +1,25 @@
+package controlplane
+
+import (
+	"context"
+
+	"go.uber.org/fx"
+)
+
+// Module is optional and not part of pkg.Module; hosts that want the gRPC
+// API add it next to it
+var Module = fx.Options(
+	fx.Provide(NewServer),
+	fx.Invoke(registerHooks),
+)
+
+func registerHooks(lifecycle fx.Lifecycle, server Server) {
+	lifecycle.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			return server.Start()
+		},
+		OnStop: func(ctx context.Context) error {
+			return server.Stop(ctx)
+		},
+	})
+}
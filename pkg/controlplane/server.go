@@ -0,0 +1,225 @@
+package controlplane
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/plugin"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/apitokens"
+	"github.com/backtesting-org/live-trading/pkg/connectors/journal"
+	"github.com/backtesting-org/live-trading/pkg/controlplane/controlplanepb"
+	"github.com/backtesting-org/live-trading/pkg/runreport"
+	"github.com/backtesting-org/live-trading/pkg/runstream"
+	"github.com/backtesting-org/live-trading/pkg/supervisor"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Server serves the ControlPlane gRPC service. Callers authenticate with
+// an "authorization: Bearer <token>" header: the API token store's admin
+// token may call everything, while scoped tokens may read the report and
+// event stream of runs they cover with the status scope.
+type Server interface {
+	Configure(config Config) error
+
+	Start() error
+	Stop(ctx context.Context) error
+
+	// Address is where the server is listening, empty when stopped
+	Address() string
+}
+
+type server struct {
+	controlplanepb.UnimplementedControlPlaneServer
+
+	plugins      plugin.Manager
+	strategies   registry.StrategyRegistry
+	runs         supervisor.RunSupervisor
+	reporter     runreport.RunReporter
+	stream       runstream.RunStream
+	tokens       apitokens.TokenStore
+	journal      journal.OrderJournal
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config     Config
+	grpcServer *grpc.Server
+	listener   net.Listener
+
+	// stopping is closed by Stop to end open event streams
+	stopping chan struct{}
+	mu       sync.Mutex
+}
+
+func NewServer(
+	pluginManager plugin.Manager,
+	strategyRegistry registry.StrategyRegistry,
+	runSupervisor supervisor.RunSupervisor,
+	reporter runreport.RunReporter,
+	runStream runstream.RunStream,
+	tokenStore apitokens.TokenStore,
+	orderJournal journal.OrderJournal,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) Server {
+	return &server{
+		plugins:      pluginManager,
+		strategies:   strategyRegistry,
+		runs:         runSupervisor,
+		reporter:     reporter,
+		stream:       runStream,
+		tokens:       tokenStore,
+		journal:      orderJournal,
+		timeProvider: timeProvider,
+		logger:       logger,
+		config:       DefaultConfig(),
+	}
+}
+
+func (s *server) Configure(config Config) error {
+	if err := config.applyDefaults(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.config = config
+	return nil
+}
+
+func (s *server) Start() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.grpcServer != nil {
+		return fmt.Errorf("control plane already running on %s", s.listener.Addr())
+	}
+
+	listener, err := net.Listen("tcp", s.config.Address)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", s.config.Address, err)
+	}
+
+	grpcServer := grpc.NewServer()
+	controlplanepb.RegisterControlPlaneServer(grpcServer, s)
+	s.grpcServer = grpcServer
+	s.listener = listener
+	s.stopping = make(chan struct{})
+
+	go func() {
+		if err := grpcServer.Serve(listener); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			s.logger.Error("Control plane stopped: %v", err)
+		}
+	}()
+
+	s.logger.Info("🛰️ Control plane listening on %s", listener.Addr())
+	return nil
+}
+
+func (s *server) Stop(ctx context.Context) error {
+	s.mu.Lock()
+	grpcServer := s.grpcServer
+	grace := s.config.StopGrace
+	s.grpcServer = nil
+	s.listener = nil
+	if s.stopping != nil {
+		close(s.stopping)
+		s.stopping = nil
+	}
+	s.mu.Unlock()
+
+	if grpcServer == nil {
+		return nil
+	}
+
+	// Event streams end as soon as stopping closes; unary calls in flight
+	// get the grace period
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	timer := s.timeProvider.NewTimer(grace)
+	defer timer.Stop()
+
+	select {
+	case <-stopped:
+	case <-timer.C():
+		grpcServer.Stop()
+	case <-ctx.Done():
+		grpcServer.Stop()
+	}
+	return nil
+}
+
+func (s *server) Address() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.listener == nil {
+		return ""
+	}
+	return s.listener.Addr().String()
+}
+
+// done is closed once Stop has begun
+func (s *server) done() <-chan struct{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.stopping == nil {
+		closed := make(chan struct{})
+		close(closed)
+		return closed
+	}
+	return s.stopping
+}
+
+// requireAdmin refuses callers without the admin token
+func (s *server) requireAdmin(ctx context.Context) error {
+	if s.tokens.Admin(bearer(ctx)) {
+		return nil
+	}
+	return status.Error(codes.Unauthenticated, "admin token required")
+}
+
+// authorize admits the admin token, or a token with scope over runID
+func (s *server) authorize(ctx context.Context, runID string, scope apitokens.Scope) error {
+	secret := bearer(ctx)
+	if s.tokens.Admin(secret) {
+		return nil
+	}
+
+	_, err := s.tokens.Authorize(secret, runID, scope)
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, apitokens.ErrForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return status.Error(codes.Unauthenticated, apitokens.ErrUnauthorized.Error())
+	}
+}
+
+func bearer(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, header := range md.Get("authorization") {
+		if len(header) > 7 && strings.EqualFold(header[:7], "bearer ") {
+			return strings.TrimSpace(header[7:])
+		}
+	}
+	return ""
+}
@@ -0,0 +1,295 @@
+package controlplane
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+	"github.com/backtesting-org/live-trading/pkg/apitokens"
+	"github.com/backtesting-org/live-trading/pkg/connectors/journal"
+	"github.com/backtesting-org/live-trading/pkg/controlplane/controlplanepb"
+	"github.com/backtesting-org/live-trading/pkg/runreport"
+	"github.com/backtesting-org/live-trading/pkg/supervisor"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *server) LoadPlugin(ctx context.Context, req *controlplanepb.LoadPluginRequest) (*controlplanepb.LoadPluginResponse, error) {
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if req.GetPath() == "" {
+		return nil, status.Error(codes.InvalidArgument, "plugin path is required")
+	}
+
+	if req.GetKind() == controlplanepb.PluginKind_PLUGIN_KIND_HOOK {
+		if err := s.plugins.LoadHookPlugin(req.GetPath()); err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "failed to load hook plugin: %v", err)
+		}
+		s.logger.Info("🛰️ Loaded hook plugin %s", req.GetPath())
+		return &controlplanepb.LoadPluginResponse{}, nil
+	}
+
+	loaded, err := s.plugins.LoadStrategyPlugin(req.GetPath())
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to load strategy plugin: %v", err)
+	}
+	s.logger.Info("🛰️ Loaded strategy plugin %s from %s", loaded.GetName(), req.GetPath())
+	return &controlplanepb.LoadPluginResponse{Strategy: toStrategy(loaded)}, nil
+}
+
+func (s *server) ListStrategies(ctx context.Context, _ *controlplanepb.ListStrategiesRequest) (*controlplanepb.ListStrategiesResponse, error) {
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	registered := s.strategies.GetAllStrategies()
+	sort.Slice(registered, func(i, j int) bool { return registered[i].GetName() < registered[j].GetName() })
+
+	resp := &controlplanepb.ListStrategiesResponse{}
+	for _, strat := range registered {
+		resp.Strategies = append(resp.Strategies, toStrategy(strat))
+	}
+	return resp, nil
+}
+
+func (s *server) EnableStrategy(ctx context.Context, req *controlplanepb.StrategyRequest) (*controlplanepb.Strategy, error) {
+	return s.toggleStrategy(ctx, req.GetName(), s.strategies.EnableStrategy)
+}
+
+func (s *server) DisableStrategy(ctx context.Context, req *controlplanepb.StrategyRequest) (*controlplanepb.Strategy, error) {
+	return s.toggleStrategy(ctx, req.GetName(), s.strategies.DisableStrategy)
+}
+
+func (s *server) toggleStrategy(ctx context.Context, name string, toggle func(strategy.StrategyName) error) (*controlplanepb.Strategy, error) {
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	strat, ok := s.strategies.GetStrategy(strategy.StrategyName(name))
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "strategy %s not registered", name)
+	}
+	if err := toggle(strat.GetName()); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "strategy %s: %v", name, err)
+	}
+	return toStrategy(strat), nil
+}
+
+func (s *server) ListRuns(ctx context.Context, _ *controlplanepb.ListRunsRequest) (*controlplanepb.ListRunsResponse, error) {
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	resp := &controlplanepb.ListRunsResponse{}
+	for _, run := range s.runs.Statuses() {
+		resp.Runs = append(resp.Runs, toRunStatus(run))
+	}
+	return resp, nil
+}
+
+func (s *server) StartRun(ctx context.Context, req *controlplanepb.RunRequest) (*controlplanepb.RunStatus, error) {
+	return s.changeRun(ctx, req.GetName(), s.runs.StartRun)
+}
+
+func (s *server) StopRun(ctx context.Context, req *controlplanepb.RunRequest) (*controlplanepb.RunStatus, error) {
+	return s.changeRun(ctx, req.GetName(), s.runs.StopRun)
+}
+
+func (s *server) changeRun(ctx context.Context, name string, change func(string) error) (*controlplanepb.RunStatus, error) {
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if _, ok := s.runs.Status(name); !ok {
+		return nil, status.Errorf(codes.NotFound, "run %s not registered", name)
+	}
+
+	if err := change(name); err != nil {
+		code := codes.Internal
+		if errors.Is(err, supervisor.ErrRunsNotConfigured) {
+			code = codes.FailedPrecondition
+		}
+		return nil, status.Errorf(code, "run %s: %v", name, err)
+	}
+
+	run, _ := s.runs.Status(name)
+	return toRunStatus(run), nil
+}
+
+func (s *server) GetRunStatus(ctx context.Context, req *controlplanepb.RunRequest) (*controlplanepb.RunStatus, error) {
+	if err := s.requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	run, ok := s.runs.Status(req.GetName())
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "run %s not registered", req.GetName())
+	}
+	return toRunStatus(run), nil
+}
+
+func (s *server) GetRunReport(ctx context.Context, req *controlplanepb.RunReportRequest) (*controlplanepb.RunReport, error) {
+	runID := req.GetRunId()
+	if runID == "" {
+		if active, ok := s.reporter.Active(); ok {
+			runID = active
+		} else if latest, ok := s.reporter.Latest(); ok {
+			runID = latest.RunID
+		}
+	}
+	if runID == "" {
+		return nil, status.Error(codes.NotFound, "no run has been reported on")
+	}
+	if err := s.authorize(ctx, runID, apitokens.ScopeStatus); err != nil {
+		return nil, err
+	}
+
+	if current, ok := s.reporter.Current(); ok && current.RunID == runID {
+		report := toRunReport(current)
+		report.Active = true
+		return report, nil
+	}
+	report, ok := s.reporter.Report(runID)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "run %s not found", runID)
+	}
+	return toRunReport(report), nil
+}
+
+func (s *server) StreamRunEvents(req *controlplanepb.StreamRunEventsRequest, stream controlplanepb.ControlPlane_StreamRunEventsServer) error {
+	ctx := stream.Context()
+
+	runID := req.GetRunId()
+	if runID == "" {
+		runID, _ = s.reporter.Active()
+	}
+	if runID == "" {
+		return status.Error(codes.NotFound, "no active run")
+	}
+	if err := s.authorize(ctx, runID, apitokens.ScopeStatus); err != nil {
+		return err
+	}
+
+	events, cancel, err := s.stream.Subscribe(runID, req.GetKinds())
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "run stream: %v", err)
+	}
+	defer cancel()
+
+	done := s.done()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-done:
+			return status.Error(codes.Unavailable, "control plane stopping")
+		case event, ok := <-events:
+			if !ok {
+				return status.Error(codes.Unavailable, "run stream stopped")
+			}
+			data, err := json.Marshal(event.Data)
+			if err != nil {
+				s.logger.Warn("Control plane event %s not encoded: %v", event.Kind, err)
+				continue
+			}
+			if err := stream.Send(&controlplanepb.RunEvent{
+				Id:    event.ID,
+				RunId: event.RunID,
+				Kind:  event.Kind,
+				At:    timestamp(event.At),
+				Data:  data,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *server) ExportOrderJournal(req *controlplanepb.ExportOrderJournalRequest, stream controlplanepb.ControlPlane_ExportOrderJournalServer) error {
+	if err := s.requireAdmin(stream.Context()); err != nil {
+		return err
+	}
+	if req.GetOrderId() == "" {
+		return status.Error(codes.InvalidArgument, "order_id is required")
+	}
+
+	transitions, err := s.journal.Transitions(req.GetOrderId())
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "order journal: %v", err)
+	}
+	if len(transitions) == 0 {
+		return status.Errorf(codes.NotFound, "no transitions journaled for order %s", req.GetOrderId())
+	}
+
+	for _, transition := range transitions {
+		if err := stream.Send(toOrderTransition(transition)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func toStrategy(strat strategy.Strategy) *controlplanepb.Strategy {
+	return &controlplanepb.Strategy{
+		Name:        string(strat.GetName()),
+		Description: strat.GetDescription(),
+		RiskLevel:   string(strat.GetRiskLevel()),
+		Type:        string(strat.GetStrategyType()),
+		Enabled:     strat.IsEnabled(),
+	}
+}
+
+func toRunStatus(run supervisor.RunStatus) *controlplanepb.RunStatus {
+	return &controlplanepb.RunStatus{
+		Name:          run.Name,
+		Desired:       string(run.Desired),
+		State:         string(run.State),
+		Restarts:      int32(run.Restarts),
+		LastError:     run.LastError,
+		StartedAt:     timestamp(run.StartedAt),
+		NextRestartAt: timestamp(run.NextRestartAt),
+	}
+}
+
+func toRunReport(report *runreport.Report) *controlplanepb.RunReport {
+	return &controlplanepb.RunReport{
+		RunId:          report.RunID,
+		StartedAt:      timestamp(report.StartedAt),
+		EndedAt:        timestamp(report.EndedAt),
+		Outcome:        string(report.Outcome),
+		Error:          report.Error,
+		Fills:          int32(report.Fills),
+		NetRealizedPnl: report.NetRealizedPnL.String(),
+		Fees:           report.Fees.String(),
+		MaxDrawdown:    report.MaxDrawdown.String(),
+		StartEquity:    report.StartEquity.String(),
+		EndEquity:      report.EndEquity.String(),
+	}
+}
+
+// timestamp leaves unset times unset rather than sending the zero time
+func toOrderTransition(transition journal.OrderTransition) *controlplanepb.OrderTransition {
+	return &controlplanepb.OrderTransition{
+		Sequence:   transition.Sequence,
+		RecordedAt: timestamp(transition.RecordedAt),
+		Exchange:   string(transition.Exchange),
+		OrderId:    transition.OrderID,
+		Symbol:     transition.Symbol,
+		From:       string(transition.From),
+		To:         string(transition.To),
+		Event:      transition.Event,
+		Payload:    transition.Payload,
+		Raw:        transition.Raw,
+	}
+}
+
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
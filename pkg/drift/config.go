@@ -0,0 +1,42 @@
+package drift
+
+import (
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+)
+
+const (
+	DefaultInterval            = 10 * time.Second
+	DefaultConsecutiveBreaches = 3
+
+	// JobName is the scheduler job that compares positions
+	JobName = "position-drift"
+)
+
+// Config sets how positions are compared
+type Config struct {
+	Interval time.Duration
+
+	// Tolerance is the absolute size difference allowed for a market whose
+	// declarations do not set their own
+	Tolerance numerical.Decimal
+
+	// ConsecutiveBreaches is how many checks in a row a market must diverge
+	// before it is reported, so an order still filling or a position not yet
+	// visible on the exchange does not count
+	ConsecutiveBreaches int
+
+	// AutoPause disables the strategies behind a divergence; when false
+	// divergences are only reported
+	AutoPause bool
+}
+
+// DefaultConfig reports any divergence that persists and pauses nothing
+func DefaultConfig() Config {
+	return Config{
+		Interval:            DefaultInterval,
+		Tolerance:           numerical.Zero(),
+		ConsecutiveBreaches: DefaultConsecutiveBreaches,
+	}
+}
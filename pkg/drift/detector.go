@@ -0,0 +1,360 @@
+package drift
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/events"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/eventschema"
+	"github.com/backtesting-org/live-trading/pkg/scheduler"
+)
+
+// Divergence is a market whose exchange position has differed from what its
+// strategies declare for ConsecutiveBreaches checks in a row
+type Divergence struct {
+	Exchange connector.ExchangeName
+	Asset    string
+
+	// Strategies are every strategy declaring the market; Expected is the
+	// sum of their declarations
+	Strategies []strategy.StrategyName
+	Expected   numerical.Decimal
+	Actual     numerical.Decimal
+	Drift      numerical.Decimal
+	Tolerance  numerical.Decimal
+	Paused     bool
+	At         time.Time
+}
+
+// DriftDetector compares the positions strategies declare through
+// PositionDeclarer against the positions the exchanges report, catching
+// partial fills a strategy did not account for and manual intervention.
+// A divergence is reported once until the market is back within tolerance.
+type DriftDetector interface {
+	Configure(config Config)
+
+	Start() error
+	Stop() error
+
+	// Check compares every declared market once and returns the markets
+	// newly reported as divergent
+	Check() ([]Divergence, error)
+
+	// Resume re-enables a paused strategy; a divergence that persists is
+	// reported again
+	Resume(name strategy.StrategyName) error
+	Paused() []strategy.StrategyName
+}
+
+type marketKey struct {
+	exchange connector.ExchangeName
+	asset    string
+}
+
+type declared struct {
+	expected   numerical.Decimal
+	tolerance  numerical.Decimal
+	strategies []strategy.StrategyName
+}
+
+type driftDetector struct {
+	strategies   registry.StrategyRegistry
+	connectors   registry.ConnectorRegistry
+	bus          events.EventBus
+	scheduler    scheduler.Scheduler
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config   Config
+	breaches map[marketKey]int
+	reported map[marketKey][]strategy.StrategyName
+	paused   map[strategy.StrategyName]bool
+	mu       sync.Mutex
+}
+
+func NewDriftDetector(
+	strategyRegistry registry.StrategyRegistry,
+	connectorRegistry registry.ConnectorRegistry,
+	bus events.EventBus,
+	jobScheduler scheduler.Scheduler,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) DriftDetector {
+	return &driftDetector{
+		strategies:   strategyRegistry,
+		connectors:   connectorRegistry,
+		bus:          bus,
+		scheduler:    jobScheduler,
+		timeProvider: timeProvider,
+		logger:       logger,
+		config:       DefaultConfig(),
+		breaches:     make(map[marketKey]int),
+		reported:     make(map[marketKey][]strategy.StrategyName),
+		paused:       make(map[strategy.StrategyName]bool),
+	}
+}
+
+func (d *driftDetector) Configure(config Config) {
+	if config.Interval <= 0 {
+		config.Interval = DefaultInterval
+	}
+	if config.ConsecutiveBreaches <= 0 {
+		config.ConsecutiveBreaches = DefaultConsecutiveBreaches
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.config = config
+}
+
+func (d *driftDetector) Start() error {
+	d.mu.Lock()
+	interval := d.config.Interval
+	d.mu.Unlock()
+
+	return d.scheduler.Register(scheduler.Job{
+		Name:     JobName,
+		Interval: interval,
+		Run: func(_ context.Context) error {
+			_, err := d.Check()
+			return err
+		},
+	})
+}
+
+func (d *driftDetector) Stop() error {
+	return d.scheduler.Unregister(JobName)
+}
+
+func (d *driftDetector) Check() ([]Divergence, error) {
+	d.mu.Lock()
+	config := d.config
+	d.mu.Unlock()
+
+	markets := d.declarations(config)
+	if len(markets) == 0 {
+		return nil, nil
+	}
+
+	exchanges := make(map[connector.ExchangeName]struct{})
+	for key := range markets {
+		exchanges[key.exchange] = struct{}{}
+	}
+
+	held := make(map[marketKey]numerical.Decimal)
+	fetched := make(map[connector.ExchangeName]bool, len(exchanges))
+	var failed []string
+	for exchange := range exchanges {
+		if err := d.positions(exchange, held); err != nil {
+			d.logger.Warn("Position drift check skipped %s: %v", exchange, err)
+			failed = append(failed, string(exchange))
+			continue
+		}
+		fetched[exchange] = true
+	}
+
+	now := d.timeProvider.Now()
+	var found []Divergence
+
+	d.mu.Lock()
+	for key, market := range markets {
+		// An exchange that could not be read neither breaches nor clears
+		if !fetched[key.exchange] {
+			continue
+		}
+
+		actual, ok := held[key]
+		if !ok {
+			actual = numerical.Zero()
+		}
+		drift := actual.Sub(market.expected)
+		if !drift.Abs().GreaterThan(market.tolerance) {
+			delete(d.breaches, key)
+			delete(d.reported, key)
+			continue
+		}
+
+		if _, ok := d.reported[key]; ok {
+			continue
+		}
+		d.breaches[key]++
+		if d.breaches[key] < config.ConsecutiveBreaches {
+			continue
+		}
+		delete(d.breaches, key)
+		d.reported[key] = market.strategies
+
+		found = append(found, Divergence{
+			Exchange:   key.exchange,
+			Asset:      key.asset,
+			Strategies: market.strategies,
+			Expected:   market.expected,
+			Actual:     actual,
+			Drift:      drift,
+			Tolerance:  market.tolerance,
+			At:         now,
+		})
+	}
+	d.mu.Unlock()
+
+	sort.Slice(found, func(i, j int) bool {
+		if found[i].Exchange != found[j].Exchange {
+			return found[i].Exchange < found[j].Exchange
+		}
+		return found[i].Asset < found[j].Asset
+	})
+
+	for i := range found {
+		if config.AutoPause {
+			found[i].Paused = d.pause(found[i])
+		}
+		d.logger.Warn("⚠️ Position drift on %s %s: exchange %s, strategies %v expect %s",
+			found[i].Exchange, found[i].Asset, found[i].Actual, found[i].Strategies, found[i].Expected)
+		d.bus.Publish(eventschema.TopicPositionDrift, found[i])
+	}
+
+	if len(failed) > 0 {
+		sort.Strings(failed)
+		return found, fmt.Errorf("position drift check failed for %v", failed)
+	}
+	return found, nil
+}
+
+// declarations sums what every declaring strategy expects per market.
+// Disabled strategies are included since they still hold their positions.
+func (d *driftDetector) declarations(config Config) map[marketKey]*declared {
+	markets := make(map[marketKey]*declared)
+	for _, strat := range d.strategies.GetAllStrategies() {
+		declarer, ok := strat.(PositionDeclarer)
+		if !ok {
+			continue
+		}
+
+		name := strat.GetName()
+		for _, position := range declarer.ExpectedPositions() {
+			key := marketKey{position.Exchange, position.Asset.Symbol()}
+			market, ok := markets[key]
+			if !ok {
+				market = &declared{expected: numerical.Zero(), tolerance: config.Tolerance}
+				markets[key] = market
+			}
+
+			market.expected = market.expected.Add(position.Size)
+			if position.Tolerance.GreaterThan(market.tolerance) {
+				market.tolerance = position.Tolerance
+			}
+			if n := len(market.strategies); n == 0 || market.strategies[n-1] != name {
+				market.strategies = append(market.strategies, name)
+			}
+		}
+	}
+
+	for _, market := range markets {
+		sort.Slice(market.strategies, func(i, j int) bool { return market.strategies[i] < market.strategies[j] })
+	}
+	return markets
+}
+
+// positions adds an exchange's signed position sizes to held
+func (d *driftDetector) positions(exchange connector.ExchangeName, held map[marketKey]numerical.Decimal) error {
+	conn, ok := d.connectors.GetConnector(exchange)
+	if !ok {
+		return fmt.Errorf("connector %s not registered", exchange)
+	}
+
+	positions, err := conn.GetPositions()
+	if err != nil {
+		return fmt.Errorf("failed to fetch positions on %s: %w", exchange, err)
+	}
+
+	for _, position := range positions {
+		size := position.Size.Abs()
+		if position.Side == connector.OrderSideSell {
+			size = size.Neg()
+		}
+		key := marketKey{exchange, position.Symbol.Symbol()}
+		current, ok := held[key]
+		if !ok {
+			current = numerical.Zero()
+		}
+		held[key] = current.Add(size)
+	}
+	return nil
+}
+
+// pause disables every strategy behind a divergence and reports whether all
+// of them are paused
+func (d *driftDetector) pause(divergence Divergence) bool {
+	paused := true
+	for _, name := range divergence.Strategies {
+		d.mu.Lock()
+		already := d.paused[name]
+		d.mu.Unlock()
+		if already {
+			continue
+		}
+
+		if err := d.strategies.DisableStrategy(name); err != nil {
+			d.logger.Error("Failed to pause strategy %s on position drift: %v", name, err)
+			paused = false
+			continue
+		}
+
+		d.mu.Lock()
+		d.paused[name] = true
+		d.mu.Unlock()
+
+		d.logger.Error("🛑 Strategy %s paused: %s %s position %s diverges from expected %s",
+			name, divergence.Exchange, divergence.Asset, divergence.Actual, divergence.Expected)
+	}
+	return paused
+}
+
+func (d *driftDetector) Resume(name strategy.StrategyName) error {
+	d.mu.Lock()
+	if !d.paused[name] {
+		d.mu.Unlock()
+		return fmt.Errorf("strategy %s is not paused", name)
+	}
+	d.mu.Unlock()
+
+	if err := d.strategies.EnableStrategy(name); err != nil {
+		return fmt.Errorf("failed to resume strategy %s: %w", name, err)
+	}
+
+	d.mu.Lock()
+	delete(d.paused, name)
+	for key, strategies := range d.reported {
+		for _, reported := range strategies {
+			if reported == name {
+				delete(d.reported, key)
+				break
+			}
+		}
+	}
+	d.mu.Unlock()
+
+	d.logger.Info("Strategy %s resumed", name)
+	return nil
+}
+
+func (d *driftDetector) Paused() []strategy.StrategyName {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	names := make([]strategy.StrategyName, 0, len(d.paused))
+	for name := range d.paused {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+	return names
+}
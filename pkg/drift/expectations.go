@@ -0,0 +1,27 @@
+package drift
+
+import (
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+)
+
+// PositionDeclarer is implemented by strategies that declare the position
+// they believe they hold. Strategies without it are not checked.
+type PositionDeclarer interface {
+	// ExpectedPositions lists every market the strategy expects to be in,
+	// including ones it expects to be flat in
+	ExpectedPositions() []ExpectedPosition
+}
+
+// ExpectedPosition is one strategy's view of a perpetual position
+type ExpectedPosition struct {
+	Exchange connector.ExchangeName
+	Asset    portfolio.Asset
+
+	// Size is signed: positive is long, negative is short
+	Size numerical.Decimal
+
+	// Tolerance overrides Config.Tolerance when positive
+	Tolerance numerical.Decimal
+}
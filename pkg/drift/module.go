@@ -0,0 +1,7 @@
+package drift
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(NewDriftDetector),
+)
@@ -0,0 +1,141 @@
+package errortracking
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+)
+
+// Occurrence is the result of recording one error
+type Occurrence struct {
+	Group
+
+	// Alert is true when this occurrence should raise an alert: the first
+	// time the fingerprint is seen, or once AlertWindow has passed since
+	// the last alert for it
+	Alert bool
+}
+
+// ErrorAggregator groups repeated errors by fingerprint per run, so one
+// failure mode shows up once with a count instead of thousands of lines,
+// and alerts fire once per fingerprint per window
+type ErrorAggregator interface {
+	Configure(config Config)
+
+	// Record counts err under its fingerprint for the run
+	Record(runID, source string, err error) Occurrence
+
+	// Summary lists a run's groups, most frequent first
+	Summary(runID string) []Group
+
+	Reset(runID string)
+	SetStore(store GroupStore)
+}
+
+type errorAggregator struct {
+	config       Config
+	store        GroupStore
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+	mu           sync.Mutex
+}
+
+func NewErrorAggregator(
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) ErrorAggregator {
+	return &errorAggregator{
+		config:       DefaultConfig(),
+		store:        newMemoryGroups(),
+		timeProvider: timeProvider,
+		logger:       logger,
+	}
+}
+
+func (a *errorAggregator) Configure(config Config) {
+	defaults := DefaultConfig()
+	if config.AlertWindow <= 0 {
+		config.AlertWindow = defaults.AlertWindow
+	}
+	if config.MaxGroups <= 0 {
+		config.MaxGroups = defaults.MaxGroups
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.config = config
+}
+
+func (a *errorAggregator) SetStore(store GroupStore) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.store = store
+}
+
+func (a *errorAggregator) Record(runID, source string, err error) Occurrence {
+	if err == nil {
+		return Occurrence{}
+	}
+
+	now := a.timeProvider.Now()
+	fingerprint := Fingerprint(source, err)
+	message := err.Error()
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	group, exists := a.store.Get(runID, fingerprint)
+	if !exists && a.store.Count(runID) >= a.config.MaxGroups {
+		fingerprint = OverflowFingerprint
+		group, exists = a.store.Get(runID, fingerprint)
+	}
+
+	if !exists {
+		group = Group{
+			RunID:       runID,
+			Fingerprint: fingerprint,
+			Source:      source,
+			Normalised:  Normalise(message),
+			FirstSeen:   now,
+		}
+		if fingerprint == OverflowFingerprint {
+			a.logger.Warn("Error groups for run %s exceeded %d, aggregating new fingerprints as overflow", runID, a.config.MaxGroups)
+		}
+	}
+
+	group.Count++
+	group.Sample = message
+	group.LastSeen = now
+
+	occurrence := Occurrence{}
+	if group.LastAlerted.IsZero() || now.Sub(group.LastAlerted) >= a.config.AlertWindow {
+		group.LastAlerted = now
+		occurrence.Alert = true
+	}
+
+	a.store.Put(group)
+	occurrence.Group = group
+	return occurrence
+}
+
+func (a *errorAggregator) Summary(runID string) []Group {
+	a.mu.Lock()
+	groups := a.store.Groups(runID)
+	a.mu.Unlock()
+
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].Count != groups[j].Count {
+			return groups[i].Count > groups[j].Count
+		}
+		return groups[i].LastSeen.After(groups[j].LastSeen)
+	})
+	return groups
+}
+
+func (a *errorAggregator) Reset(runID string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.store.Delete(runID)
+}
@@ -0,0 +1,29 @@
+package errortracking
+
+import "time"
+
+const (
+	// DefaultAlertWindow is how long repeats of an alerted fingerprint stay quiet
+	DefaultAlertWindow = 10 * time.Minute
+
+	// DefaultMaxGroups bounds distinct fingerprints kept per run; further
+	// new fingerprints are counted under an overflow group
+	DefaultMaxGroups = 1000
+
+	// OverflowFingerprint collects errors once a run hits MaxGroups
+	OverflowFingerprint = "overflow"
+)
+
+// Config controls error aggregation and alert deduplication
+type Config struct {
+	AlertWindow time.Duration
+	MaxGroups   int
+}
+
+// DefaultConfig re-alerts a fingerprint at most every ten minutes
+func DefaultConfig() Config {
+	return Config{
+		AlertWindow: DefaultAlertWindow,
+		MaxGroups:   DefaultMaxGroups,
+	}
+}
@@ -0,0 +1,67 @@
+package errortracking
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// normalisers replace the variable parts of error messages (IDs, amounts,
+// addresses, timestamps) so repeats of the same failure share a fingerprint.
+// Order matters: specific patterns run before the generic number match.
+var normalisers = []struct {
+	pattern     *regexp.Regexp
+	replacement string
+}{
+	{regexp.MustCompile(`[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`), "<uuid>"},
+	{regexp.MustCompile(`0x[0-9a-fA-F]+`), "<hex>"},
+	{regexp.MustCompile(`\d{4}-\d{2}-\d{2}[T ]\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:?\d{2})?`), "<time>"},
+	{regexp.MustCompile(`"[^"]*"`), "<str>"},
+	{regexp.MustCompile(`\b[0-9a-fA-F]{24,}\b`), "<id>"},
+	{regexp.MustCompile(`-?\d+(\.\d+)?`), "<n>"},
+}
+
+// Normalise lower-cases a message and masks its variable parts
+func Normalise(message string) string {
+	normalised := message
+	for _, n := range normalisers {
+		normalised = n.pattern.ReplaceAllString(normalised, n.replacement)
+	}
+	return strings.ToLower(strings.Join(strings.Fields(normalised), " "))
+}
+
+// Fingerprint identifies a class of error: the source that raised it, the
+// concrete types along its wrap chain and its normalised message
+func Fingerprint(source string, err error) string {
+	hash := sha1.New()
+	hash.Write([]byte(source))
+	hash.Write([]byte{0})
+	hash.Write([]byte(chainTypes(err)))
+	hash.Write([]byte{0})
+	hash.Write([]byte(Normalise(err.Error())))
+	return hex.EncodeToString(hash.Sum(nil))[:16]
+}
+
+// chainTypes lists the types along err's Unwrap chain; fmt.Errorf wrappers
+// are skipped since they carry no information beyond their message
+func chainTypes(err error) string {
+	var types []string
+	for err != nil {
+		typeName := fmt.Sprintf("%T", err)
+		if typeName != "*fmt.wrapError" && typeName != "*fmt.wrapErrors" {
+			types = append(types, typeName)
+		}
+
+		if multi, ok := err.(interface{ Unwrap() []error }); ok {
+			for _, inner := range multi.Unwrap() {
+				types = append(types, chainTypes(inner))
+			}
+			break
+		}
+		err = errors.Unwrap(err)
+	}
+	return strings.Join(types, ">")
+}
@@ -0,0 +1,7 @@
+package errortracking
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(NewErrorAggregator),
+)
@@ -0,0 +1,69 @@
+package errortracking
+
+import "time"
+
+// Group is every occurrence of one fingerprint within a run
+type Group struct {
+	RunID       string
+	Fingerprint string
+	Source      string
+
+	// Normalised is the masked message shared by the group; Sample is the
+	// latest raw message
+	Normalised string
+	Sample     string
+
+	Count     int
+	FirstSeen time.Time
+	LastSeen  time.Time
+
+	// LastAlerted is zero until an alert has been raised for the fingerprint
+	LastAlerted time.Time
+}
+
+// GroupStore persists error groups. The default keeps them in memory; a
+// durable store can be swapped in with SetStore.
+type GroupStore interface {
+	Get(runID, fingerprint string) (Group, bool)
+	Put(group Group)
+	Groups(runID string) []Group
+	Count(runID string) int
+	Delete(runID string)
+}
+
+type memoryGroups struct {
+	groups map[string]map[string]Group
+}
+
+// newMemoryGroups is not safe for concurrent use; the aggregator serialises access
+func newMemoryGroups() *memoryGroups {
+	return &memoryGroups{groups: make(map[string]map[string]Group)}
+}
+
+func (m *memoryGroups) Get(runID, fingerprint string) (Group, bool) {
+	group, ok := m.groups[runID][fingerprint]
+	return group, ok
+}
+
+func (m *memoryGroups) Put(group Group) {
+	if m.groups[group.RunID] == nil {
+		m.groups[group.RunID] = make(map[string]Group)
+	}
+	m.groups[group.RunID][group.Fingerprint] = group
+}
+
+func (m *memoryGroups) Groups(runID string) []Group {
+	groups := make([]Group, 0, len(m.groups[runID]))
+	for _, group := range m.groups[runID] {
+		groups = append(groups, group)
+	}
+	return groups
+}
+
+func (m *memoryGroups) Count(runID string) int {
+	return len(m.groups[runID])
+}
+
+func (m *memoryGroups) Delete(runID string) {
+	delete(m.groups, runID)
+}
@@ -0,0 +1,48 @@
+package eventschema
+
+import "github.com/backtesting-org/kronos-sdk/pkg/events"
+
+// versionedBus seals every publish into an Envelope before handing it to
+// the inner bus; subscriptions pass straight through
+type versionedBus struct {
+	events.EventBus
+	registry *schemaRegistry
+}
+
+func (r *schemaRegistry) Wrap(inner events.EventBus) events.EventBus {
+	return &versionedBus{EventBus: inner, registry: r}
+}
+
+func (b *versionedBus) Publish(topic string, event interface{}) {
+	// Re-publishing a received envelope must not nest it
+	if envelope, ok := event.(Envelope); ok {
+		b.EventBus.Publish(topic, envelope)
+		return
+	}
+
+	envelope, err := b.registry.seal(topic, event)
+	if err != nil {
+		b.registry.reject(envelope, err)
+		return
+	}
+	b.EventBus.Publish(topic, envelope)
+}
+
+// Versioned adapts a handler written against an older schema version into
+// a bus subscriber, down-converting each envelope before delivery. Events
+// that cannot be converted are logged and skipped.
+func (r *schemaRegistry) Versioned(version int, handler func(Envelope)) func(event interface{}) {
+	return func(event interface{}) {
+		envelope, ok := event.(Envelope)
+		if !ok {
+			return
+		}
+
+		converted, err := r.Downgrade(envelope, version)
+		if err != nil {
+			r.logger.Warn("Skipped %s event for v%d consumer: %v", envelope.Topic, version, err)
+			return
+		}
+		handler(converted)
+	}
+}
@@ -0,0 +1,24 @@
+// Package eventschema versions the payloads published on the SDK event bus.
+// Every event is delivered inside an Envelope carrying its schema version,
+// and consumers pinned to an older version can have events down-converted.
+package eventschema
+
+// DefaultMaxViolations is how many validation failures are kept for inspection
+const DefaultMaxViolations = 100
+
+// Config controls publish-time validation
+type Config struct {
+	// Validate checks every payload against its topic's schema on publish
+	// and drops the ones that fail; meant for development, since it costs
+	// an encode per event
+	Validate bool
+
+	MaxViolations int
+}
+
+// DefaultConfig stamps versions without validating
+func DefaultConfig() Config {
+	return Config{
+		MaxViolations: DefaultMaxViolations,
+	}
+}
@@ -0,0 +1,16 @@
+package eventschema
+
+import (
+	"github.com/backtesting-org/kronos-sdk/pkg/events"
+	"go.uber.org/fx"
+)
+
+var Module = fx.Options(
+	fx.Provide(NewSchemaRegistry),
+	fx.Decorate(decorateEventBus),
+)
+
+// decorateEventBus versions every event published on the SDK bus
+func decorateEventBus(inner events.EventBus, registry SchemaRegistry) events.EventBus {
+	return registry.Wrap(inner)
+}
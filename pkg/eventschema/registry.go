@@ -0,0 +1,292 @@
+package eventschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/events"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+)
+
+// Envelope is what subscribers receive for every published event
+type Envelope struct {
+	Topic string `json:"topic"`
+
+	// SchemaVersion is zero for topics without a registered schema
+	SchemaVersion int                    `json:"schema_version"`
+	PublishedAt   time.Time              `json:"published_at"`
+	Payload       map[string]interface{} `json:"payload"`
+
+	// Event is the publisher's value, for in-process subscribers on the
+	// current version; it is nil once the envelope has been down-converted
+	Event interface{} `json:"-"`
+}
+
+// Violation is a payload that failed validation and was not delivered
+type Violation struct {
+	Topic   string
+	Version int
+	Error   string
+	At      time.Time
+}
+
+// SchemaRegistry holds the versioned schemas for event bus topics and
+// converts envelopes between versions
+type SchemaRegistry interface {
+	Configure(config Config)
+
+	// Register adds a schema version; the highest registered version of a
+	// topic is the one publishers are held to
+	Register(schema Schema) error
+
+	// RegisterDowngrade adds the conversion from version from of a topic to
+	// version from-1
+	RegisterDowngrade(topic string, from int, convert Downgrade) error
+
+	// Current returns the latest schema of a topic
+	Current(topic string) (Schema, bool)
+	Schemas() []Schema
+
+	// Downgrade converts an envelope to an older version, one step at a time
+	Downgrade(envelope Envelope, version int) (Envelope, error)
+
+	// Versioned is the compatibility shim for older consumers: subscribe the
+	// returned function and handler receives envelopes at the given version
+	Versioned(version int, handler func(Envelope)) func(event interface{})
+
+	// Wrap returns a bus that stamps versions on publish, and validates
+	// when configured to
+	Wrap(inner events.EventBus) events.EventBus
+
+	Violations() []Violation
+}
+
+type schemaKey struct {
+	topic   string
+	version int
+}
+
+type schemaRegistry struct {
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config     Config
+	schemas    map[schemaKey]Schema
+	current    map[string]int
+	downgrades map[schemaKey]Downgrade
+	violations []Violation
+	mu         sync.RWMutex
+}
+
+func NewSchemaRegistry(
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) SchemaRegistry {
+	r := &schemaRegistry{
+		timeProvider: timeProvider,
+		logger:       logger,
+		config:       DefaultConfig(),
+		schemas:      make(map[schemaKey]Schema),
+		current:      make(map[string]int),
+		downgrades:   make(map[schemaKey]Downgrade),
+	}
+	for _, schema := range DefaultSchemas() {
+		_ = r.Register(schema)
+	}
+	return r
+}
+
+func (r *schemaRegistry) Configure(config Config) {
+	if config.MaxViolations <= 0 {
+		config.MaxViolations = DefaultMaxViolations
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.config = config
+}
+
+func (r *schemaRegistry) Register(schema Schema) error {
+	if err := schema.validate(); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := schemaKey{schema.Topic, schema.Version}
+	if _, exists := r.schemas[key]; exists {
+		return fmt.Errorf("schema %s v%d already registered", schema.Topic, schema.Version)
+	}
+
+	r.schemas[key] = schema
+	if schema.Version > r.current[schema.Topic] {
+		r.current[schema.Topic] = schema.Version
+	}
+	return nil
+}
+
+func (r *schemaRegistry) RegisterDowngrade(topic string, from int, convert Downgrade) error {
+	if from < 2 {
+		return fmt.Errorf("downgrade for %s must start from version 2 or later, got %d", topic, from)
+	}
+	if convert == nil {
+		return fmt.Errorf("downgrade for %s v%d is nil", topic, from)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.schemas[schemaKey{topic, from}]; !ok {
+		return fmt.Errorf("schema %s v%d not registered", topic, from)
+	}
+	r.downgrades[schemaKey{topic, from}] = convert
+	return nil
+}
+
+func (r *schemaRegistry) Current(topic string) (Schema, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	version, ok := r.current[topic]
+	if !ok {
+		return Schema{}, false
+	}
+	return r.schemas[schemaKey{topic, version}], true
+}
+
+func (r *schemaRegistry) Schemas() []Schema {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	schemas := make([]Schema, 0, len(r.schemas))
+	for _, schema := range r.schemas {
+		schemas = append(schemas, schema)
+	}
+	sort.Slice(schemas, func(i, j int) bool {
+		if schemas[i].Topic != schemas[j].Topic {
+			return schemas[i].Topic < schemas[j].Topic
+		}
+		return schemas[i].Version < schemas[j].Version
+	})
+	return schemas
+}
+
+func (r *schemaRegistry) Downgrade(envelope Envelope, version int) (Envelope, error) {
+	if version >= envelope.SchemaVersion {
+		return envelope, nil
+	}
+	if version < 1 {
+		return Envelope{}, fmt.Errorf("cannot downgrade %s below version 1", envelope.Topic)
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	payload := copyPayload(envelope.Payload)
+	for from := envelope.SchemaVersion; from > version; from-- {
+		convert, ok := r.downgrades[schemaKey{envelope.Topic, from}]
+		if !ok {
+			return Envelope{}, fmt.Errorf("no downgrade registered for %s v%d to v%d", envelope.Topic, from, from-1)
+		}
+
+		var err error
+		if payload, err = convert(payload); err != nil {
+			return Envelope{}, fmt.Errorf("failed to downgrade %s v%d to v%d: %w", envelope.Topic, from, from-1, err)
+		}
+	}
+
+	envelope.SchemaVersion = version
+	envelope.Payload = payload
+	envelope.Event = nil
+	return envelope, nil
+}
+
+func (r *schemaRegistry) Violations() []Violation {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	violations := make([]Violation, len(r.violations))
+	copy(violations, r.violations)
+	return violations
+}
+
+// seal builds the envelope for a publish, or returns an error when
+// validation is on and the payload does not fit its schema
+func (r *schemaRegistry) seal(topic string, event interface{}) (Envelope, error) {
+	r.mu.RLock()
+	validate := r.config.Validate
+	version := r.current[topic]
+	schema := r.schemas[schemaKey{topic, version}]
+	r.mu.RUnlock()
+
+	payload, err := toPayload(event)
+	if err != nil {
+		return Envelope{}, err
+	}
+
+	envelope := Envelope{
+		Topic:         topic,
+		SchemaVersion: version,
+		PublishedAt:   r.timeProvider.Now(),
+		Payload:       payload,
+		Event:         event,
+	}
+
+	if validate {
+		if version == 0 {
+			return envelope, fmt.Errorf("no schema registered for topic %s", topic)
+		}
+		if err := schema.Check(payload); err != nil {
+			return envelope, err
+		}
+	}
+	return envelope, nil
+}
+
+func (r *schemaRegistry) reject(envelope Envelope, err error) {
+	r.mu.Lock()
+	r.violations = append(r.violations, Violation{
+		Topic:   envelope.Topic,
+		Version: envelope.SchemaVersion,
+		Error:   err.Error(),
+		At:      envelope.PublishedAt,
+	})
+	if excess := len(r.violations) - r.config.MaxViolations; excess > 0 {
+		r.violations = r.violations[excess:]
+	}
+	r.mu.Unlock()
+
+	r.logger.Error("Dropped %s event failing its schema: %v", envelope.Topic, err)
+}
+
+// toPayload encodes an event the way a downstream consumer would see it
+func toPayload(event interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		// Scalars and slices are wrapped so every payload is an object
+		var value interface{}
+		if err := json.Unmarshal(data, &value); err != nil {
+			return nil, fmt.Errorf("failed to decode event: %w", err)
+		}
+		payload = map[string]interface{}{"value": value}
+	}
+	return payload, nil
+}
+
+func copyPayload(payload map[string]interface{}) map[string]interface{} {
+	copied := make(map[string]interface{}, len(payload))
+	for key, value := range payload {
+		copied[key] = value
+	}
+	return copied
+}
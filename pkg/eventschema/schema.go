@@ -0,0 +1,127 @@
+package eventschema
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// FieldType is the JSON shape a payload field must have
+type FieldType string
+
+const (
+	FieldString FieldType = "string"
+	FieldNumber FieldType = "number"
+
+	// FieldDecimal is a numerical.Decimal, which encodes as a numeric string
+	FieldDecimal FieldType = "decimal"
+	FieldBool    FieldType = "bool"
+
+	// FieldTime is an RFC 3339 timestamp
+	FieldTime   FieldType = "time"
+	FieldObject FieldType = "object"
+	FieldArray  FieldType = "array"
+)
+
+// Field is one top-level payload field
+type Field struct {
+	Name     string
+	Type     FieldType
+	Required bool
+}
+
+// Schema describes one version of a topic's payload. Fields not listed are
+// allowed, so adding a field does not need a new version; renaming,
+// removing or retyping one does.
+type Schema struct {
+	Topic   string
+	Version int
+	Fields  []Field
+}
+
+// Downgrade rewrites a payload from its schema version to the one before
+type Downgrade func(payload map[string]interface{}) (map[string]interface{}, error)
+
+func (s Schema) validate() error {
+	if s.Topic == "" {
+		return fmt.Errorf("schema topic is required")
+	}
+	if s.Version < 1 {
+		return fmt.Errorf("schema %s: version must be at least 1, got %d", s.Topic, s.Version)
+	}
+
+	seen := make(map[string]bool, len(s.Fields))
+	for _, field := range s.Fields {
+		if field.Name == "" {
+			return fmt.Errorf("schema %s v%d: field name is required", s.Topic, s.Version)
+		}
+		if seen[field.Name] {
+			return fmt.Errorf("schema %s v%d: duplicate field %s", s.Topic, s.Version, field.Name)
+		}
+		seen[field.Name] = true
+	}
+	return nil
+}
+
+// Check lists every way the payload breaks the schema
+func (s Schema) Check(payload map[string]interface{}) error {
+	var problems []string
+	for _, field := range s.Fields {
+		value, ok := payload[field.Name]
+		if !ok || value == nil {
+			if field.Required {
+				problems = append(problems, fmt.Sprintf("%s is required", field.Name))
+			}
+			continue
+		}
+		if !matches(field.Type, value) {
+			problems = append(problems, fmt.Sprintf("%s must be %s, got %T", field.Name, field.Type, value))
+		}
+	}
+
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return fmt.Errorf("%s v%d: %v", s.Topic, s.Version, problems)
+	}
+	return nil
+}
+
+// matches checks a value decoded by encoding/json against a field type
+func matches(fieldType FieldType, value interface{}) bool {
+	switch fieldType {
+	case FieldString:
+		_, ok := value.(string)
+		return ok
+	case FieldNumber:
+		_, ok := value.(float64)
+		return ok
+	case FieldDecimal:
+		switch v := value.(type) {
+		case float64:
+			return true
+		case string:
+			_, err := strconv.ParseFloat(v, 64)
+			return err == nil
+		}
+		return false
+	case FieldBool:
+		_, ok := value.(bool)
+		return ok
+	case FieldTime:
+		s, ok := value.(string)
+		if !ok {
+			return false
+		}
+		_, err := time.Parse(time.RFC3339Nano, s)
+		return err == nil
+	case FieldObject:
+		_, ok := value.(map[string]interface{})
+		return ok
+	case FieldArray:
+		_, ok := value.([]interface{})
+		return ok
+	default:
+		return true
+	}
+}
@@ -0,0 +1,184 @@
+package eventschema
+
+// Topics for the payload types this repo defines. Payloads encode with Go
+// field names since none of these types carry JSON tags.
+const (
+	// TopicFill carries tracker.FillEvent
+	TopicFill = "fills"
+
+	// TopicTransfer carries types.TransferEvent
+	TopicTransfer = "transfers"
+
+	// TopicRunEvent carries runreport.Event
+	TopicRunEvent = "run.events"
+
+	// TopicRunEquity carries runreport.EquityUpdate on every equity sample
+	TopicRunEquity = "run.equity"
+
+	// TopicReconciliation carries reconcile.Discrepancy for drift beyond
+	// its threshold
+	TopicReconciliation = "reconciliation.drift"
+
+	// TopicCapitalSweep carries capital.Sweep when a sweep recommends a
+	// transfer to the reserve
+	TopicCapitalSweep = "capital.sweeps"
+
+	// TopicPositionDrift carries drift.Divergence when a strategy's declared
+	// positions stop matching the exchange
+	TopicPositionDrift = "positions.drift"
+
+	// TopicSignalConflict carries signalarbiter.Conflict when runs send
+	// opposing signals to the same market
+	TopicSignalConflict = "signals.conflicts"
+
+	// TopicSignalSkipped carries freshness.SkippedSignal when a signal is
+	// kept from executing on stale market data
+	TopicSignalSkipped = "signals.skipped"
+
+	// TopicInsufficientBalance carries shortfall.Rejection when an exchange
+	// rejects an order for insufficient balance
+	TopicInsufficientBalance = "orders.insufficient_balance"
+)
+
+// DefaultSchemas are the current versions of the built-in topics
+func DefaultSchemas() []Schema {
+	return []Schema{
+		{
+			Topic:   TopicFill,
+			Version: 1,
+			Fields: []Field{
+				{Name: "Exchange", Type: FieldString, Required: true},
+				{Name: "OrderID", Type: FieldString, Required: true},
+				{Name: "Symbol", Type: FieldString, Required: true},
+				{Name: "Side", Type: FieldString, Required: true},
+				{Name: "Quantity", Type: FieldDecimal, Required: true},
+				{Name: "Price", Type: FieldDecimal, Required: true},
+				{Name: "FilledQty", Type: FieldDecimal, Required: true},
+				{Name: "Status", Type: FieldString},
+				{Name: "Timestamp", Type: FieldTime, Required: true},
+			},
+		},
+		{
+			Topic:   TopicTransfer,
+			Version: 1,
+			Fields: []Field{
+				{Name: "Exchange", Type: FieldString, Required: true},
+				{Name: "ID", Type: FieldString, Required: true},
+				{Name: "Kind", Type: FieldString, Required: true},
+				{Name: "Asset", Type: FieldString, Required: true},
+				{Name: "Amount", Type: FieldDecimal, Required: true},
+				{Name: "Address", Type: FieldString},
+				{Name: "Status", Type: FieldString},
+				{Name: "Timestamp", Type: FieldTime, Required: true},
+			},
+		},
+		{
+			Topic:   TopicRunEvent,
+			Version: 1,
+			Fields: []Field{
+				{Name: "RunID", Type: FieldString},
+				{Name: "Kind", Type: FieldString, Required: true},
+				{Name: "Message", Type: FieldString, Required: true},
+				{Name: "At", Type: FieldTime, Required: true},
+			},
+		},
+		{
+			Topic:   TopicRunEquity,
+			Version: 1,
+			Fields: []Field{
+				{Name: "RunID", Type: FieldString, Required: true},
+				{Name: "Equity", Type: FieldDecimal, Required: true},
+				{Name: "Drawdown", Type: FieldDecimal, Required: true},
+				{Name: "At", Type: FieldTime, Required: true},
+			},
+		},
+		{
+			Topic:   TopicReconciliation,
+			Version: 1,
+			Fields: []Field{
+				{Name: "RunID", Type: FieldString},
+				{Name: "Exchange", Type: FieldString, Required: true},
+				{Name: "Kind", Type: FieldString, Required: true},
+				{Name: "Symbol", Type: FieldString},
+				{Name: "Expected", Type: FieldDecimal, Required: true},
+				{Name: "Actual", Type: FieldDecimal, Required: true},
+				{Name: "Drift", Type: FieldDecimal, Required: true},
+				{Name: "Threshold", Type: FieldDecimal, Required: true},
+				{Name: "Alerted", Type: FieldBool},
+				{Name: "At", Type: FieldTime, Required: true},
+			},
+		},
+		{
+			Topic:   TopicCapitalSweep,
+			Version: 1,
+			Fields: []Field{
+				{Name: "RunID", Type: FieldString, Required: true},
+				{Name: "Mode", Type: FieldString, Required: true},
+				{Name: "Amount", Type: FieldDecimal, Required: true},
+				{Name: "Reserve", Type: FieldDecimal, Required: true},
+				{Name: "At", Type: FieldTime, Required: true},
+			},
+		},
+		{
+			Topic:   TopicPositionDrift,
+			Version: 1,
+			Fields: []Field{
+				{Name: "Exchange", Type: FieldString, Required: true},
+				{Name: "Asset", Type: FieldString, Required: true},
+				{Name: "Strategies", Type: FieldArray, Required: true},
+				{Name: "Expected", Type: FieldDecimal, Required: true},
+				{Name: "Actual", Type: FieldDecimal, Required: true},
+				{Name: "Drift", Type: FieldDecimal, Required: true},
+				{Name: "Tolerance", Type: FieldDecimal, Required: true},
+				{Name: "Paused", Type: FieldBool},
+				{Name: "At", Type: FieldTime, Required: true},
+			},
+		},
+		{
+			Topic:   TopicSignalConflict,
+			Version: 1,
+			Fields: []Field{
+				{Name: "Exchange", Type: FieldString, Required: true},
+				{Name: "Asset", Type: FieldString, Required: true},
+				{Name: "SignalID", Type: FieldString, Required: true},
+				{Name: "Strategy", Type: FieldString, Required: true},
+				{Name: "ConflictsWith", Type: FieldArray, Required: true},
+				{Name: "Policy", Type: FieldString, Required: true},
+				{Name: "Outcome", Type: FieldString, Required: true},
+				{Name: "At", Type: FieldTime, Required: true},
+			},
+		},
+		{
+			Topic:   TopicSignalSkipped,
+			Version: 1,
+			Fields: []Field{
+				{Name: "SignalID", Type: FieldString, Required: true},
+				{Name: "Strategy", Type: FieldString, Required: true},
+				{Name: "Exchange", Type: FieldString, Required: true},
+				{Name: "Asset", Type: FieldString, Required: true},
+				{Name: "LastUpdated", Type: FieldTime},
+				{Name: "MaxAge", Type: FieldNumber, Required: true},
+				{Name: "Reason", Type: FieldString, Required: true},
+				{Name: "At", Type: FieldTime, Required: true},
+			},
+		},
+		{
+			Topic:   TopicInsufficientBalance,
+			Version: 1,
+			Fields: []Field{
+				{Name: "RunID", Type: FieldString},
+				{Name: "SignalID", Type: FieldString, Required: true},
+				{Name: "Strategy", Type: FieldString, Required: true},
+				{Name: "Exchange", Type: FieldString},
+				{Name: "Symbol", Type: FieldString},
+				{Name: "Quantity", Type: FieldDecimal, Required: true},
+				{Name: "Required", Type: FieldDecimal, Required: true},
+				{Name: "Available", Type: FieldDecimal, Required: true},
+				{Name: "Shortfall", Type: FieldDecimal, Required: true},
+				{Name: "Outcome", Type: FieldString, Required: true},
+				{Name: "Scale", Type: FieldDecimal, Required: true},
+				{Name: "At", Type: FieldTime, Required: true},
+			},
+		},
+	}
+}
@@ -0,0 +1,102 @@
+package flags
+
+import (
+	"fmt"
+	"time"
+)
+
+const defaultEvaluationCapacity = 1000
+
+// Flag is a deployment-wide feature flag. When Enabled, Rollout is the
+// fraction of evaluation keys that see the flag on (1 = everyone).
+type Flag struct {
+	Name        string
+	Description string
+	Enabled     bool
+	Rollout     float64
+	UpdatedAt   time.Time
+}
+
+func (f Flag) validate() error {
+	if f.Name == "" {
+		return fmt.Errorf("flag name is required")
+	}
+	if f.Rollout < 0 || f.Rollout > 1 {
+		return fmt.Errorf("flag %s: rollout must be between 0 and 1, got %v", f.Name, f.Rollout)
+	}
+	return nil
+}
+
+// Context identifies who is asking. Key buckets partial rollouts, so the
+// same key always gets the same answer; use a signal or order ID to split
+// traffic, or leave it empty to bucket by run.
+type Context struct {
+	RunID string
+	Key   string
+}
+
+// Reason explains an evaluation result
+type Reason string
+
+const (
+	ReasonUndefined   Reason = "undefined"
+	ReasonDisabled    Reason = "disabled"
+	ReasonRunOverride Reason = "run_override"
+	ReasonRollout     Reason = "rollout"
+	ReasonExcluded    Reason = "excluded"
+)
+
+// Evaluation records one flag check so behaviour differences between runs
+// can be traced back to the flags they saw
+type Evaluation struct {
+	Flag       string
+	Deployment string
+	RunID      string
+	Key        string
+	Enabled    bool
+	Reason     Reason
+	At         time.Time
+}
+
+// EvaluationStore persists evaluations. The default keeps a bounded
+// in-memory ring per flag; a durable store can be swapped in with
+// SetEvaluationStore.
+type EvaluationStore interface {
+	Record(evaluation Evaluation)
+	Recent(flag string, limit int) []Evaluation
+}
+
+type memoryEvaluations struct {
+	capacity    int
+	evaluations map[string][]Evaluation
+}
+
+// newMemoryEvaluations is not safe for concurrent use; the service serialises access
+func newMemoryEvaluations(capacity int) *memoryEvaluations {
+	return &memoryEvaluations{
+		capacity:    capacity,
+		evaluations: make(map[string][]Evaluation),
+	}
+}
+
+func (m *memoryEvaluations) Record(evaluation Evaluation) {
+	evaluations := append(m.evaluations[evaluation.Flag], evaluation)
+	if len(evaluations) > m.capacity {
+		evaluations = evaluations[len(evaluations)-m.capacity:]
+	}
+	m.evaluations[evaluation.Flag] = evaluations
+}
+
+func (m *memoryEvaluations) Recent(flag string, limit int) []Evaluation {
+	evaluations := m.evaluations[flag]
+	if limit > 0 && len(evaluations) > limit {
+		evaluations = evaluations[len(evaluations)-limit:]
+	}
+
+	// Newest first
+	result := make([]Evaluation, len(evaluations))
+	for i, evaluation := range evaluations {
+		result[len(evaluations)-1-i] = evaluation
+	}
+	return result
+}
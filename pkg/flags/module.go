@@ -0,0 +1,7 @@
+package flags
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(NewFlagService),
+)
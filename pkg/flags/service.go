@@ -0,0 +1,201 @@
+package flags
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"sync"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+)
+
+// rolloutBuckets is the resolution of partial rollouts (0.01%)
+const rolloutBuckets = 10000
+
+// FlagService evaluates feature flags for strategies and internal services.
+// Deployment-wide flags can be narrowed or widened per run with overrides,
+// and every evaluation is recorded.
+type FlagService interface {
+	// SetDeployment names the deployment recorded on evaluations
+	SetDeployment(name string)
+
+	// Define creates or replaces a deployment-wide flag
+	Define(flag Flag) error
+	Remove(name string)
+	Flag(name string) (Flag, bool)
+	Flags() []Flag
+
+	// SetRunOverride forces a flag on or off for one run, regardless of rollout
+	SetRunOverride(runID, name string, enabled bool) error
+	ClearRunOverrides(runID string)
+
+	IsEnabled(name string, ctx Context) bool
+	Evaluate(name string, ctx Context) Evaluation
+
+	Evaluations(name string, limit int) []Evaluation
+	SetEvaluationStore(store EvaluationStore)
+}
+
+type flagService struct {
+	deployment   string
+	flags        map[string]Flag
+	overrides    map[string]map[string]bool
+	evaluations  EvaluationStore
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+	mu           sync.Mutex
+}
+
+func NewFlagService(
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) FlagService {
+	return &flagService{
+		flags:        make(map[string]Flag),
+		overrides:    make(map[string]map[string]bool),
+		evaluations:  newMemoryEvaluations(defaultEvaluationCapacity),
+		timeProvider: timeProvider,
+		logger:       logger,
+	}
+}
+
+func (f *flagService) SetDeployment(name string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.deployment = name
+}
+
+func (f *flagService) Define(flag Flag) error {
+	if err := flag.validate(); err != nil {
+		return err
+	}
+	flag.UpdatedAt = f.timeProvider.Now()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.flags[flag.Name] = flag
+	f.logger.Info("Feature flag %s set: enabled=%t rollout=%.2f%%", flag.Name, flag.Enabled, flag.Rollout*100)
+	return nil
+}
+
+func (f *flagService) Remove(name string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.flags, name)
+}
+
+func (f *flagService) Flag(name string) (Flag, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	flag, ok := f.flags[name]
+	return flag, ok
+}
+
+func (f *flagService) Flags() []Flag {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	flags := make([]Flag, 0, len(f.flags))
+	for _, flag := range f.flags {
+		flags = append(flags, flag)
+	}
+	sort.Slice(flags, func(i, j int) bool { return flags[i].Name < flags[j].Name })
+	return flags
+}
+
+func (f *flagService) SetRunOverride(runID, name string, enabled bool) error {
+	if runID == "" {
+		return fmt.Errorf("run ID is required for an override")
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.flags[name]; !ok {
+		return fmt.Errorf("flag %s is not defined", name)
+	}
+	if f.overrides[runID] == nil {
+		f.overrides[runID] = make(map[string]bool)
+	}
+	f.overrides[runID][name] = enabled
+	return nil
+}
+
+func (f *flagService) ClearRunOverrides(runID string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.overrides, runID)
+}
+
+func (f *flagService) IsEnabled(name string, ctx Context) bool {
+	return f.Evaluate(name, ctx).Enabled
+}
+
+func (f *flagService) Evaluate(name string, ctx Context) Evaluation {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	evaluation := Evaluation{
+		Flag:       name,
+		Deployment: f.deployment,
+		RunID:      ctx.RunID,
+		Key:        ctx.Key,
+		At:         f.timeProvider.Now(),
+	}
+
+	flag, defined := f.flags[name]
+	override, overridden := f.overrides[ctx.RunID][name]
+
+	switch {
+	case !defined:
+		evaluation.Reason = ReasonUndefined
+	case overridden:
+		evaluation.Enabled = override
+		evaluation.Reason = ReasonRunOverride
+	case !flag.Enabled:
+		evaluation.Reason = ReasonDisabled
+	case inRollout(name, bucketKey(ctx), flag.Rollout):
+		evaluation.Enabled = true
+		evaluation.Reason = ReasonRollout
+	default:
+		evaluation.Reason = ReasonExcluded
+	}
+
+	f.evaluations.Record(evaluation)
+	return evaluation
+}
+
+func (f *flagService) Evaluations(name string, limit int) []Evaluation {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.evaluations.Recent(name, limit)
+}
+
+func (f *flagService) SetEvaluationStore(store EvaluationStore) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.evaluations = store
+}
+
+func bucketKey(ctx Context) string {
+	if ctx.Key != "" {
+		return ctx.Key
+	}
+	return ctx.RunID
+}
+
+// inRollout hashes the flag and key together so each flag splits keys
+// independently of the others
+func inRollout(flag, key string, rollout float64) bool {
+	if rollout >= 1 {
+		return true
+	}
+	if rollout <= 0 {
+		return false
+	}
+
+	hash := fnv.New32a()
+	hash.Write([]byte(flag + ":" + key))
+	return float64(hash.Sum32()%rolloutBuckets) < rollout*rolloutBuckets
+}
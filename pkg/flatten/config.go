@@ -0,0 +1,30 @@
+// Package flatten closes out everything a run has opened, on demand
+package flatten
+
+import "time"
+
+const (
+	// DefaultFillTimeout is how long a flatten waits for its closing orders
+	// to fill before reporting them unfilled
+	DefaultFillTimeout = 30 * time.Second
+
+	// DefaultPollInterval is how often closing orders are refreshed while waiting
+	DefaultPollInterval = 500 * time.Millisecond
+
+	// EventKind marks a flatten in the run report's events
+	EventKind = "flatten"
+)
+
+// Config controls how a flatten waits for its fills
+type Config struct {
+	FillTimeout  time.Duration
+	PollInterval time.Duration
+}
+
+// DefaultConfig waits up to 30s, refreshing orders twice a second
+func DefaultConfig() Config {
+	return Config{
+		FillTimeout:  DefaultFillTimeout,
+		PollInterval: DefaultPollInterval,
+	}
+}
@@ -0,0 +1,289 @@
+package flatten
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/tracker"
+	"github.com/backtesting-org/live-trading/pkg/runreport"
+)
+
+// Close is the closing order for one market
+type Close struct {
+	Exchange  connector.ExchangeName
+	Symbol    string
+	Side      connector.OrderSide
+	Quantity  numerical.Decimal
+	OrderID   string
+	Status    connector.OrderStatus
+	FilledQty numerical.Decimal
+	Error     string
+}
+
+// Result is what a flatten did
+type Result struct {
+	RunID      string
+	Closes     []Close
+	StartedAt  time.Time
+	FinishedAt time.Time
+}
+
+// Filled counts the closes that filled completely
+func (r *Result) Filled() int {
+	filled := 0
+	for _, c := range r.Closes {
+		if c.Status == connector.OrderStatusFilled {
+			filled++
+		}
+	}
+	return filled
+}
+
+// RunFlattener closes what one run has opened with offsetting market
+// orders, leaving positions held before the run, or opened by hand, alone
+type RunFlattener interface {
+	Configure(config Config)
+
+	// FlattenRun closes the active run's positions and waits for the fills;
+	// it errors if any close was not placed or did not fill in time
+	FlattenRun(runID string) (*Result, error)
+
+	// Handler flattens ?run= on POST and returns the result as JSON
+	Handler() http.Handler
+}
+
+type runFlattener struct {
+	registry     registry.ConnectorRegistry
+	reporter     runreport.RunReporter
+	tracker      tracker.OrderTracker
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config     Config
+	flattening map[string]bool
+	mu         sync.Mutex
+}
+
+func NewRunFlattener(
+	connectorRegistry registry.ConnectorRegistry,
+	reporter runreport.RunReporter,
+	orderTracker tracker.OrderTracker,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) RunFlattener {
+	return &runFlattener{
+		registry:     connectorRegistry,
+		reporter:     reporter,
+		tracker:      orderTracker,
+		timeProvider: timeProvider,
+		logger:       logger,
+		config:       DefaultConfig(),
+		flattening:   make(map[string]bool),
+	}
+}
+
+func (f *runFlattener) Configure(config Config) {
+	if config.FillTimeout <= 0 {
+		config.FillTimeout = DefaultFillTimeout
+	}
+	if config.PollInterval <= 0 {
+		config.PollInterval = DefaultPollInterval
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.config = config
+}
+
+func (f *runFlattener) FlattenRun(runID string) (*Result, error) {
+	if runID == "" {
+		return nil, fmt.Errorf("run ID is required")
+	}
+
+	f.mu.Lock()
+	if f.flattening[runID] {
+		f.mu.Unlock()
+		return nil, fmt.Errorf("run %s is already being flattened", runID)
+	}
+	f.flattening[runID] = true
+	config := f.config
+	f.mu.Unlock()
+
+	defer func() {
+		f.mu.Lock()
+		delete(f.flattening, runID)
+		f.mu.Unlock()
+	}()
+
+	positions, err := f.reporter.Positions(runID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{RunID: runID, StartedAt: f.timeProvider.Now()}
+	for _, position := range positions {
+		if c, ok := f.place(position); ok {
+			result.Closes = append(result.Closes, c)
+		}
+	}
+
+	f.await(result, config)
+	result.FinishedAt = f.timeProvider.Now()
+
+	var failed []string
+	for _, c := range result.Closes {
+		if c.Status == connector.OrderStatusFilled {
+			continue
+		}
+		reason := c.Error
+		if reason == "" {
+			reason = fmt.Sprintf("%s after %s", c.Status, config.FillTimeout)
+		}
+		failed = append(failed, fmt.Sprintf("%s/%s: %s", c.Exchange, c.Symbol, reason))
+	}
+
+	summary := fmt.Sprintf("Flattened %d/%d positions", result.Filled(), len(result.Closes))
+	if len(failed) > 0 {
+		summary += ": " + strings.Join(failed, "; ")
+	}
+	f.reporter.Event(EventKind, summary)
+	f.logger.Info("Run %s: %s", runID, summary)
+
+	if len(failed) > 0 {
+		return result, fmt.Errorf("flatten of run %s incomplete: %s", runID, strings.Join(failed, "; "))
+	}
+	return result, nil
+}
+
+// place sends the offsetting order for one position. The close is capped at
+// what the ledger still holds, so a position already reduced by hand is not
+// flipped; ok is false when nothing is left to close.
+func (f *runFlattener) place(position runreport.Position) (Close, bool) {
+	if position.Size.Sign() != position.Held.Sign() {
+		return Close{}, false
+	}
+
+	side := connector.OrderSideSell
+	if position.Size.IsNegative() {
+		side = connector.OrderSideBuy
+	}
+	quantity := position.Size.Abs()
+	if held := position.Held.Abs(); held.LessThan(quantity) {
+		quantity = held
+	}
+
+	c := Close{
+		Exchange:  position.Exchange,
+		Symbol:    position.Symbol,
+		Side:      side,
+		Quantity:  quantity,
+		Status:    connector.OrderStatusRejected,
+		FilledQty: numerical.Zero(),
+	}
+
+	conn, ok := f.registry.GetConnector(position.Exchange)
+	if !ok {
+		c.Error = "connector not registered"
+		return c, true
+	}
+	if !conn.SupportsTradingOperations() {
+		c.Error = "trading operations not supported"
+		return c, true
+	}
+
+	response, err := conn.PlaceMarketOrder(c.Symbol, c.Side, c.Quantity)
+	if err != nil {
+		c.Error = err.Error()
+		return c, true
+	}
+
+	c.OrderID = response.OrderID
+	c.Status = response.Status
+	if c.Status == "" {
+		c.Status = connector.OrderStatusNew
+	}
+	if err := f.tracker.Track(c.Exchange, response); err != nil {
+		c.Error = fmt.Sprintf("placed but not tracked: %v", err)
+	}
+	return c, true
+}
+
+// await refreshes the tracked closes until every one is terminal or the
+// fill timeout passes
+func (f *runFlattener) await(result *Result, config Config) {
+	deadline := f.timeProvider.Now().Add(config.FillTimeout)
+
+	for {
+		pending := 0
+		for i := range result.Closes {
+			c := &result.Closes[i]
+			if c.OrderID == "" || c.Error != "" {
+				continue
+			}
+			if tracked, ok := f.tracker.Order(c.Exchange, c.OrderID); ok {
+				c.Status = tracked.Order.Status
+				c.FilledQty = tracked.Order.FilledQty
+			}
+			if !isTerminal(c.Status) {
+				pending++
+			}
+		}
+
+		if pending == 0 || !f.timeProvider.Now().Before(deadline) {
+			return
+		}
+
+		f.timeProvider.Sleep(config.PollInterval)
+		if err := f.tracker.Poll(); err != nil {
+			f.logger.Debug("Flatten order refresh failed: %v", err)
+		}
+	}
+}
+
+func (f *runFlattener) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			http.Error(w, "flatten requires POST", http.StatusMethodNotAllowed)
+			return
+		}
+
+		runID := req.URL.Query().Get("run")
+		if runID == "" {
+			runID, _ = f.reporter.Active()
+		}
+		if runID == "" {
+			http.Error(w, "no active run", http.StatusNotFound)
+			return
+		}
+
+		result, err := f.FlattenRun(runID)
+		if result == nil {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err != nil {
+			w.WriteHeader(http.StatusBadGateway)
+		}
+		_ = json.NewEncoder(w).Encode(result)
+	})
+}
+
+func isTerminal(status connector.OrderStatus) bool {
+	switch status {
+	case connector.OrderStatusFilled, connector.OrderStatusCanceled,
+		connector.OrderStatusRejected, connector.OrderStatusExpired:
+		return true
+	}
+	return false
+}
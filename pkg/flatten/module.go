@@ -0,0 +1,7 @@
+package flatten
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(NewRunFlattener),
+)
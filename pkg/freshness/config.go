@@ -0,0 +1,63 @@
+// Package freshness keeps signals from executing against market data that
+// has stopped updating
+package freshness
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/data/stores/market"
+)
+
+// Mode is what the guard does about a stale market
+type Mode string
+
+const (
+	// ModeBlock skips the signal
+	ModeBlock Mode = "block"
+
+	// ModeRefetch fetches the price over REST into the market data store
+	// and skips the signal only when that fails
+	ModeRefetch Mode = "refetch"
+)
+
+// DefaultMaxAge is how long a market may go without an update before its
+// data is treated as stale
+const DefaultMaxAge = 10 * time.Second
+
+// Config controls the staleness threshold
+type Config struct {
+	Mode   Mode
+	MaxAge time.Duration
+
+	// DataKeys are the market data kinds that count as an update; the
+	// freshest of them decides. A market with none recorded is stale.
+	DataKeys []market.DataKey
+}
+
+// DefaultConfig re-fetches prices more than ten seconds old
+func DefaultConfig() Config {
+	return Config{
+		Mode:     ModeRefetch,
+		MaxAge:   DefaultMaxAge,
+		DataKeys: []market.DataKey{market.DataKeyAssetPrice, market.DataKeyOrderBooks},
+	}
+}
+
+func (c *Config) applyDefaults() error {
+	defaults := DefaultConfig()
+	switch c.Mode {
+	case "":
+		c.Mode = defaults.Mode
+	case ModeBlock, ModeRefetch:
+	default:
+		return fmt.Errorf("unknown data freshness mode %q", c.Mode)
+	}
+	if c.MaxAge <= 0 {
+		c.MaxAge = defaults.MaxAge
+	}
+	if len(c.DataKeys) == 0 {
+		c.DataKeys = defaults.DataKeys
+	}
+	return nil
+}
@@ -0,0 +1,229 @@
+package freshness
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/events"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/data/stores/market"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/execution"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/symbols"
+	"github.com/backtesting-org/live-trading/pkg/eventschema"
+)
+
+// ErrStaleData is returned for a signal skipped because a market it trades
+// has not updated within MaxAge
+var ErrStaleData = errors.New("market data is stale")
+
+// SkippedSignal is published on eventschema.TopicSignalSkipped for each
+// stale market that kept a signal from executing
+type SkippedSignal struct {
+	SignalID string
+	Strategy strategy.StrategyName
+	Exchange connector.ExchangeName
+	Asset    string
+
+	// LastUpdated is the freshest update of any configured data key; zero
+	// when the market has never updated
+	LastUpdated time.Time
+	MaxAge      time.Duration
+	Reason      string
+	At          time.Time
+}
+
+// DataFreshnessGuard checks the market data store's last update times for
+// every market a signal trades before it reaches the executor. A silently
+// stalled feed otherwise goes unnoticed while strategies keep trading on
+// its last prices. Hold actions are not checked.
+type DataFreshnessGuard interface {
+	Configure(config Config) error
+
+	// Wrap returns an executor that checks freshness before inner
+	Wrap(inner execution.Executor) execution.Executor
+
+	// Check returns ErrStaleData, wrapped, when the signal should not execute
+	Check(signal *strategy.Signal) error
+}
+
+type marketKey struct {
+	exchange connector.ExchangeName
+	asset    string
+}
+
+type dataFreshnessGuard struct {
+	registry     registry.ConnectorRegistry
+	marketData   market.MarketData
+	symbols      symbols.SymbolMapper
+	bus          events.EventBus
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config Config
+	mu     sync.Mutex
+}
+
+func NewDataFreshnessGuard(
+	connectorRegistry registry.ConnectorRegistry,
+	marketData market.MarketData,
+	symbolMapper symbols.SymbolMapper,
+	bus events.EventBus,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) DataFreshnessGuard {
+	return &dataFreshnessGuard{
+		registry:     connectorRegistry,
+		marketData:   marketData,
+		symbols:      symbolMapper,
+		bus:          bus,
+		timeProvider: timeProvider,
+		logger:       logger,
+		config:       DefaultConfig(),
+	}
+}
+
+func (g *dataFreshnessGuard) Configure(config Config) error {
+	if err := config.applyDefaults(); err != nil {
+		return err
+	}
+	config.DataKeys = append([]market.DataKey(nil), config.DataKeys...)
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.config = config
+	return nil
+}
+
+func (g *dataFreshnessGuard) Wrap(inner execution.Executor) execution.Executor {
+	return &guardedExecutor{guard: g, inner: inner}
+}
+
+func (g *dataFreshnessGuard) Check(signal *strategy.Signal) error {
+	if signal == nil {
+		return fmt.Errorf("signal is nil")
+	}
+
+	g.mu.Lock()
+	config := g.config
+	g.mu.Unlock()
+
+	updated := g.marketData.GetLastUpdated()
+	now := g.timeProvider.Now()
+	checked := make(map[marketKey]struct{})
+	var skipped []SkippedSignal
+
+	for _, action := range signal.Actions {
+		if action.Action == strategy.ActionHold {
+			continue
+		}
+		key := marketKey{action.Exchange, action.Asset.Symbol()}
+		if _, seen := checked[key]; seen {
+			continue
+		}
+		checked[key] = struct{}{}
+
+		last := latest(updated, config.DataKeys, action.Asset, action.Exchange)
+		age := now.Sub(last)
+		if !last.IsZero() && age <= config.MaxAge {
+			continue
+		}
+
+		reason := "no market data received"
+		if !last.IsZero() {
+			reason = fmt.Sprintf("no update for %s", age.Round(time.Millisecond))
+		}
+		if config.Mode == ModeRefetch {
+			err := g.refetch(action.Asset, action.Exchange)
+			if err == nil {
+				g.logger.Info("Re-fetched stale %s price on %s before executing signal %s (%s)",
+					key.asset, key.exchange, signal.ID, reason)
+				continue
+			}
+			reason = fmt.Sprintf("%s; re-fetch failed: %v", reason, err)
+		}
+
+		skipped = append(skipped, SkippedSignal{
+			SignalID:    signal.ID.String(),
+			Strategy:    signal.Strategy,
+			Exchange:    key.exchange,
+			Asset:       key.asset,
+			LastUpdated: last,
+			MaxAge:      config.MaxAge,
+			Reason:      reason,
+			At:          now,
+		})
+	}
+
+	if len(skipped) == 0 {
+		return nil
+	}
+
+	for _, skip := range skipped {
+		g.logger.Warn("⏸️ Skipped signal %s from %s: %s %s data stale, %s",
+			skip.SignalID, skip.Strategy, skip.Exchange, skip.Asset, skip.Reason)
+		g.bus.Publish(eventschema.TopicSignalSkipped, skip)
+	}
+	return fmt.Errorf("signal %s from %s on %s %s: %w",
+		signal.ID, signal.Strategy, skipped[0].Exchange, skipped[0].Asset, ErrStaleData)
+}
+
+// latest is the freshest update of any of keys for one market
+func latest(updated market.LastUpdatedMap, keys []market.DataKey, asset portfolio.Asset, exchange connector.ExchangeName) time.Time {
+	var last time.Time
+	for _, dataType := range keys {
+		at := updated[market.UpdateKey{DataType: dataType, Asset: asset, Exchange: exchange}]
+		if at.After(last) {
+			last = at
+		}
+	}
+	return last
+}
+
+// refetch reads the price over REST and stores it, which also refreshes the
+// market's asset price update time
+func (g *dataFreshnessGuard) refetch(asset portfolio.Asset, exchange connector.ExchangeName) error {
+	conn, ok := g.registry.GetConnector(exchange)
+	if !ok {
+		return fmt.Errorf("connector %s not registered", exchange)
+	}
+
+	symbol, err := g.symbols.Resolve(exchange, asset.Symbol(), connector.TypePerpetual)
+	if err != nil {
+		symbol = conn.GetPerpSymbol(asset)
+	}
+
+	price, err := conn.FetchPrice(symbol)
+	if err != nil {
+		return err
+	}
+	if price == nil {
+		return fmt.Errorf("no price returned for %s", symbol)
+	}
+
+	g.marketData.UpdateAssetPrice(asset, exchange, *price)
+	return nil
+}
+
+// guardedExecutor is what the signal queue executes through
+type guardedExecutor struct {
+	guard *dataFreshnessGuard
+	inner execution.Executor
+}
+
+func (e *guardedExecutor) ExecuteSignal(signal *strategy.Signal) error {
+	if err := e.guard.Check(signal); err != nil {
+		return err
+	}
+	return e.inner.ExecuteSignal(signal)
+}
+
+func (e *guardedExecutor) HandleTradeExecution(trade connector.Trade) error {
+	return e.inner.HandleTradeExecution(trade)
+}
@@ -0,0 +1,7 @@
+package freshness
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(NewDataFreshnessGuard),
+)
@@ -0,0 +1,25 @@
+package introspection
+
+import "time"
+
+const (
+	// DefaultStaleAfter is how old a stream's last update may be before it is flagged
+	DefaultStaleAfter = 15 * time.Second
+
+	// ConfigKeyPluginVersion and ConfigKeyConfigVersion are the run config
+	// entries, as passed to RunReporter.Begin, that carry the versions
+	ConfigKeyPluginVersion = "plugin_version"
+	ConfigKeyConfigVersion = "config_version"
+)
+
+// Config controls how the dependency document is assembled
+type Config struct {
+	StaleAfter time.Duration
+}
+
+// DefaultConfig flags streams that have been quiet for fifteen seconds
+func DefaultConfig() Config {
+	return Config{
+		StaleAfter: DefaultStaleAfter,
+	}
+}
@@ -0,0 +1,85 @@
+package introspection
+
+import (
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/live-trading/pkg/connectors/health"
+	"github.com/backtesting-org/live-trading/pkg/connectors/riskmetrics"
+	"github.com/backtesting-org/live-trading/pkg/connectors/switches"
+	"github.com/backtesting-org/live-trading/pkg/overrides"
+)
+
+// Document is everything a run depends on, gathered in one place for
+// support and debugging
+type Document struct {
+	RunID       string    `json:"run_id"`
+	Active      bool      `json:"active"`
+	StartedAt   time.Time `json:"started_at"`
+	GeneratedAt time.Time `json:"generated_at"`
+
+	PluginVersion string            `json:"plugin_version,omitempty"`
+	ConfigVersion string            `json:"config_version,omitempty"`
+	Config        map[string]string `json:"config,omitempty"`
+
+	// Overrides are the temporary parameter overrides applied on top of
+	// Config, soonest expiry first
+	Overrides []overrides.Override `json:"overrides,omitempty"`
+
+	// The sections below describe the live process and are only filled
+	// for the active run
+	Strategies []string   `json:"strategies,omitempty"`
+	Exchanges  []Binding  `json:"exchanges,omitempty"`
+	Streams    []Stream   `json:"streams,omitempty"`
+	Risk       *Risk      `json:"risk,omitempty"`
+	Capital    []Capital  `json:"capital,omitempty"`
+	Positions  []Position `json:"positions,omitempty"`
+	Orders     []Order    `json:"orders,omitempty"`
+
+	// Warnings lists sources that could not be read; the rest of the
+	// document is still served
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// Binding is one exchange the run is bound to
+type Binding struct {
+	Exchange connector.ExchangeName  `json:"exchange"`
+	Ready    bool                    `json:"ready"`
+	Health   *health.ConnectorHealth `json:"health,omitempty"`
+}
+
+// Stream is one subscribed data stream and how fresh it is
+type Stream struct {
+	Exchange   connector.ExchangeName `json:"exchange"`
+	Asset      portfolio.Asset        `json:"asset"`
+	Kind       string                 `json:"kind"`
+	LastUpdate time.Time              `json:"last_update"`
+	Age        time.Duration          `json:"age"`
+	Stale      bool                   `json:"stale"`
+}
+
+// Risk is the risk profile currently applied to the run
+type Risk struct {
+	KillSwitchEngaged bool                `json:"kill_switch_engaged"`
+	Switches          []switches.Switch   `json:"switches,omitempty"`
+	Metrics           *riskmetrics.Report `json:"metrics,omitempty"`
+}
+
+// Capital is the balance available to the run on one exchange
+type Capital struct {
+	Exchange connector.ExchangeName   `json:"exchange"`
+	Balance  connector.AccountBalance `json:"balance"`
+}
+
+// Position is an open position on one exchange
+type Position struct {
+	Exchange connector.ExchangeName `json:"exchange"`
+	connector.Position
+}
+
+// Order is a resting order on one exchange
+type Order struct {
+	Exchange connector.ExchangeName `json:"exchange"`
+	connector.Order
+}
@@ -0,0 +1,214 @@
+package introspection
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/health"
+	"github.com/backtesting-org/live-trading/pkg/connectors/killswitch"
+	"github.com/backtesting-org/live-trading/pkg/connectors/markprice"
+	"github.com/backtesting-org/live-trading/pkg/connectors/riskmetrics"
+	"github.com/backtesting-org/live-trading/pkg/connectors/switches"
+	"github.com/backtesting-org/live-trading/pkg/overrides"
+	"github.com/backtesting-org/live-trading/pkg/runreport"
+)
+
+// RunInspector assembles a run's dependency document: versions, bound
+// exchanges, data streams with freshness, risk profile, capital and open
+// positions and orders. Finished runs only carry their run metadata, since
+// the process no longer holds their live state.
+type RunInspector interface {
+	Configure(config Config)
+
+	// Inspect builds the document for runID; an empty ID selects the
+	// active run, or the latest finished one
+	Inspect(runID string) (*Document, error)
+
+	// Handler serves Inspect as JSON; ?run= selects the run
+	Handler() http.Handler
+}
+
+type runInspector struct {
+	connectors   registry.ConnectorRegistry
+	strategies   registry.StrategyRegistry
+	reporter     runreport.RunReporter
+	health       health.HealthService
+	marks        markprice.MarkPriceFeed
+	switches     switches.TradingSwitches
+	killSwitch   killswitch.KillSwitch
+	risk         riskmetrics.RiskService
+	overrides    overrides.ParameterOverrides
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config Config
+	mu     sync.Mutex
+}
+
+func NewRunInspector(
+	connectorRegistry registry.ConnectorRegistry,
+	strategyRegistry registry.StrategyRegistry,
+	reporter runreport.RunReporter,
+	healthService health.HealthService,
+	markPriceFeed markprice.MarkPriceFeed,
+	tradingSwitches switches.TradingSwitches,
+	killSwitch killswitch.KillSwitch,
+	riskService riskmetrics.RiskService,
+	parameterOverrides overrides.ParameterOverrides,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) RunInspector {
+	return &runInspector{
+		connectors:   connectorRegistry,
+		strategies:   strategyRegistry,
+		reporter:     reporter,
+		health:       healthService,
+		marks:        markPriceFeed,
+		switches:     tradingSwitches,
+		killSwitch:   killSwitch,
+		risk:         riskService,
+		overrides:    parameterOverrides,
+		timeProvider: timeProvider,
+		logger:       logger,
+		config:       DefaultConfig(),
+	}
+}
+
+func (i *runInspector) Configure(config Config) {
+	if config.StaleAfter <= 0 {
+		config.StaleAfter = DefaultStaleAfter
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.config = config
+}
+
+func (i *runInspector) Inspect(runID string) (*Document, error) {
+	if current, ok := i.reporter.Current(); ok && (runID == "" || runID == current.RunID) {
+		doc := newDocument(current, true, i.timeProvider)
+		i.fillLive(doc)
+		return doc, nil
+	}
+
+	report, ok := i.reporter.Latest()
+	if runID != "" {
+		report, ok = i.reporter.Report(runID)
+	}
+	if !ok {
+		if runID == "" {
+			return nil, fmt.Errorf("no run to inspect")
+		}
+		return nil, fmt.Errorf("run %s not found", runID)
+	}
+	return newDocument(report, false, i.timeProvider), nil
+}
+
+func newDocument(report *runreport.Report, active bool, timeProvider temporal.TimeProvider) *Document {
+	return &Document{
+		RunID:         report.RunID,
+		Active:        active,
+		StartedAt:     report.StartedAt,
+		GeneratedAt:   timeProvider.Now(),
+		PluginVersion: report.Config[ConfigKeyPluginVersion],
+		ConfigVersion: report.Config[ConfigKeyConfigVersion],
+		Config:        report.Config,
+	}
+}
+
+// fillLive adds the process's current state; a source that fails is noted
+// in Warnings so one unreachable exchange does not hide the rest
+func (i *runInspector) fillLive(doc *Document) {
+	i.mu.Lock()
+	staleAfter := i.config.StaleAfter
+	i.mu.Unlock()
+
+	for _, strat := range i.strategies.GetEnabledStrategies() {
+		doc.Strategies = append(doc.Strategies, string(strat.GetName()))
+	}
+	sort.Strings(doc.Strategies)
+
+	for _, conn := range i.connectors.GetAvailableConnectors() {
+		name := conn.GetConnectorInfo().Name
+		ready := i.connectors.IsConnectorReady(name)
+		binding := Binding{Exchange: name, Ready: ready}
+		if probe, ok := i.health.Health(name); ok {
+			binding.Health = &probe
+		}
+		doc.Exchanges = append(doc.Exchanges, binding)
+
+		if !ready || !conn.SupportsTradingOperations() {
+			continue
+		}
+
+		if balance, err := conn.GetAccountBalance(); err != nil {
+			doc.Warnings = append(doc.Warnings, fmt.Sprintf("%s balance: %s", name, err.Error()))
+		} else if balance != nil {
+			doc.Capital = append(doc.Capital, Capital{Exchange: name, Balance: *balance})
+		}
+
+		if positions, err := conn.GetPositions(); err != nil {
+			doc.Warnings = append(doc.Warnings, fmt.Sprintf("%s positions: %s", name, err.Error()))
+		} else {
+			for _, position := range positions {
+				doc.Positions = append(doc.Positions, Position{Exchange: name, Position: position})
+			}
+		}
+
+		if orders, err := conn.GetOpenOrders(); err != nil {
+			doc.Warnings = append(doc.Warnings, fmt.Sprintf("%s open orders: %s", name, err.Error()))
+		} else {
+			for _, order := range orders {
+				doc.Orders = append(doc.Orders, Order{Exchange: name, Order: order})
+			}
+		}
+	}
+	sort.Slice(doc.Exchanges, func(a, b int) bool { return doc.Exchanges[a].Exchange < doc.Exchanges[b].Exchange })
+
+	doc.Overrides = i.overrides.Active(doc.RunID)
+
+	now := i.timeProvider.Now()
+	for _, mark := range i.marks.Marks() {
+		age := now.Sub(mark.Timestamp)
+		doc.Streams = append(doc.Streams, Stream{
+			Exchange:   mark.Exchange,
+			Asset:      mark.Asset,
+			Kind:       "mark_price",
+			LastUpdate: mark.Timestamp,
+			Age:        age,
+			Stale:      age > staleAfter,
+		})
+	}
+
+	risk := &Risk{
+		KillSwitchEngaged: i.killSwitch.Engaged(),
+		Switches:          i.switches.Switches(),
+	}
+	if metrics, ok := i.risk.Latest(); ok {
+		risk.Metrics = metrics
+	}
+	doc.Risk = risk
+
+	if len(doc.Warnings) > 0 {
+		i.logger.Warn(fmt.Sprintf("run %s inspected with %d unreadable sources", doc.RunID, len(doc.Warnings)))
+	}
+}
+
+func (i *runInspector) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		doc, err := i.Inspect(req.URL.Query().Get("run"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(doc)
+	})
+}
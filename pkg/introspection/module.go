@@ -0,0 +1,7 @@
+package introspection
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(NewRunInspector),
+)
@@ -0,0 +1,86 @@
+// Package jsonl appends to and scans the newline-delimited JSON files the
+// journals, stores and recordings keep, with one line limit for all of them
+package jsonl
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+)
+
+// MaxLineSize is the longest line that may be written or read back. A
+// record that would encode longer is refused on append, so every line a
+// file holds can be scanned.
+const MaxLineSize = 16 * 1024 * 1024
+
+// initialBuffer is the scanner's starting buffer; it grows to MaxLineSize
+const initialBuffer = 64 * 1024
+
+// OpenAppend opens path for appending, creating it with perm
+func OpenAppend(path string, perm os.FileMode) (*os.File, error) {
+	return os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, perm)
+}
+
+// Marshal encodes record as one line, trailing newline included
+func Marshal(record interface{}) ([]byte, error) {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+	if len(line) >= MaxLineSize {
+		return nil, fmt.Errorf("record of %d bytes exceeds the %d byte line limit", len(line), MaxLineSize)
+	}
+	return append(line, '\n'), nil
+}
+
+// Append encodes every record and writes them in a single call, so a crash
+// mid-append tears at most the final line. Nothing is written when any
+// record fails to encode.
+func Append(w io.Writer, records ...interface{}) error {
+	var lines []byte
+	for _, record := range records {
+		line, err := Marshal(record)
+		if err != nil {
+			return err
+		}
+		lines = append(lines, line...)
+	}
+	if len(lines) == 0 {
+		return nil
+	}
+	_, err := w.Write(lines)
+	return err
+}
+
+// Scan calls fn with every non-empty line of r and its 1-based line number,
+// stopping at the first error fn returns. The line is only valid until fn
+// returns.
+func Scan(r io.Reader, fn func(number int, line []byte) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, initialBuffer), MaxLineSize)
+
+	number := 0
+	for scanner.Scan() {
+		number++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		if err := fn(number, scanner.Bytes()); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// ScanFile is Scan over the file at path. The error from opening it is
+// returned as is, so callers can treat os.ErrNotExist as an empty file.
+func ScanFile(path string, fn func(number int, line []byte) error) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return Scan(file, fn)
+}
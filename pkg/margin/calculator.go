@@ -0,0 +1,592 @@
+package margin
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/execution"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+	"github.com/backtesting-org/live-trading/pkg/connectors/markprice"
+	"github.com/backtesting-org/live-trading/pkg/connectors/symbols"
+	"github.com/backtesting-org/live-trading/pkg/runlog"
+)
+
+// ErrMarginExceeded is returned for a signal refused because an order would
+// take the account over its maximum leverage or past its available margin
+var ErrMarginExceeded = errors.New("order exceeds margin limits")
+
+// Outcome is what the check did with an order
+type Outcome string
+
+const (
+	OutcomeAllowed   Outcome = "allowed"
+	OutcomeDownsized Outcome = "downsized"
+	OutcomeRejected  Outcome = "rejected"
+)
+
+// Estimate is an order's effect on the account it trades on. Notional is
+// open positions at the mark across the exchange; leverage is notional over
+// equity, and utilization is leverage as a fraction of the maximum.
+type Estimate struct {
+	Exchange connector.ExchangeName
+	Asset    string
+
+	Equity    numerical.Decimal
+	Available numerical.Decimal
+	Price     numerical.Decimal
+
+	// Position is the signed position on the asset before the order
+	Position numerical.Decimal
+	Quantity numerical.Decimal
+
+	// Increases is false for orders that only shrink the position, which
+	// are never limited
+	Increases bool
+
+	Notional         numerical.Decimal
+	PostNotional     numerical.Decimal
+	PostLeverage     numerical.Decimal
+	PostUtilization  numerical.Decimal
+	MaxLeverage      numerical.Decimal
+	AllowedQuantity  numerical.Decimal
+	AvailableLimited bool
+}
+
+// Decision is the check's verdict on one order
+type Decision struct {
+	Outcome  Outcome
+	Estimate Estimate
+	Reason   string
+}
+
+// MarginCalculator sits in front of the executor and, once enabled, checks
+// every order that adds to a position against the account's latest balance,
+// positions and mark price. An order whose post-trade leverage would exceed
+// its run's maximum, or whose added margin at that leverage exceeds the
+// available balance, is cut to what fits or refuses the signal, and the
+// decision is recorded in the run's execution log. The actions of a signal
+// are checked in order against one read of each account, each seeing the
+// margin the ones before it would use. An order whose account cannot be
+// read is let through with a warning rather than risk blocking an exit.
+type MarginCalculator interface {
+	Configure(config Config) error
+
+	// BindStrategy makes a strategy's signals subject to a run's limit; an
+	// empty run ID unbinds it, leaving it on the configured default
+	BindStrategy(runID string, name strategy.StrategyName) error
+	RunFor(name strategy.StrategyName) (string, bool)
+
+	// SetMaxLeverage sets a run's own limit; zero returns it to the
+	// configured default
+	SetMaxLeverage(runID string, leverage numerical.Decimal) error
+	MaxLeverage(runID string) numerical.Decimal
+
+	// Estimate computes an action's effect on its account under the limit
+	// of the run the strategy is bound to
+	Estimate(name strategy.StrategyName, action strategy.TradeAction) (Estimate, error)
+
+	// Check returns the signal to execute, with any downsized quantities,
+	// or ErrMarginExceeded, wrapped, when it should not execute
+	Check(signal *strategy.Signal) (*strategy.Signal, error)
+
+	// Wrap returns an executor that checks margin before inner
+	Wrap(inner execution.Executor) execution.Executor
+}
+
+type marginCalculator struct {
+	registry registry.ConnectorRegistry
+	marks    markprice.MarkPriceFeed
+	symbols  symbols.SymbolMapper
+	runLog   runlog.RunLogger
+	logger   logging.ApplicationLogger
+
+	config   Config
+	runs     map[strategy.StrategyName]string
+	leverage map[string]numerical.Decimal
+	mu       sync.Mutex
+}
+
+// account is one read of an exchange account, updated as a signal's actions
+// are checked so later actions see the exposure earlier ones would add
+type account struct {
+	conn      connector.Connector
+	equity    numerical.Decimal
+	available numerical.Decimal
+
+	// positions are signed sizes and prices mark prices, both by asset
+	positions map[string]numerical.Decimal
+	prices    map[string]numerical.Decimal
+
+	contracts     []connector.ContractInfo
+	contractsRead bool
+}
+
+func NewMarginCalculator(
+	connectorRegistry registry.ConnectorRegistry,
+	markPriceFeed markprice.MarkPriceFeed,
+	symbolMapper symbols.SymbolMapper,
+	runLog runlog.RunLogger,
+	logger logging.ApplicationLogger,
+) MarginCalculator {
+	return &marginCalculator{
+		registry: connectorRegistry,
+		marks:    markPriceFeed,
+		symbols:  symbolMapper,
+		runLog:   runLog,
+		logger:   logger,
+		config:   DefaultConfig(),
+		runs:     make(map[strategy.StrategyName]string),
+		leverage: make(map[string]numerical.Decimal),
+	}
+}
+
+func (m *marginCalculator) Configure(config Config) error {
+	if err := config.applyDefaults(); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.config = config
+	return nil
+}
+
+func (m *marginCalculator) Wrap(inner execution.Executor) execution.Executor {
+	return &checkedExecutor{calculator: m, inner: inner}
+}
+
+func (m *marginCalculator) BindStrategy(runID string, name strategy.StrategyName) error {
+	if name == "" {
+		return fmt.Errorf("strategy name is required")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if runID == "" {
+		delete(m.runs, name)
+		return nil
+	}
+	m.runs[name] = runID
+	return nil
+}
+
+func (m *marginCalculator) RunFor(name strategy.StrategyName) (string, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	runID, ok := m.runs[name]
+	return runID, ok
+}
+
+func (m *marginCalculator) SetMaxLeverage(runID string, leverage numerical.Decimal) error {
+	if runID == "" {
+		return fmt.Errorf("run ID is required")
+	}
+	if leverage.IsNegative() {
+		return fmt.Errorf("max leverage must not be negative")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if leverage.IsZero() {
+		delete(m.leverage, runID)
+		return nil
+	}
+	m.leverage[runID] = leverage
+	return nil
+}
+
+func (m *marginCalculator) MaxLeverage(runID string) numerical.Decimal {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.maxLeverageLocked(runID)
+}
+
+func (m *marginCalculator) maxLeverageLocked(runID string) numerical.Decimal {
+	if leverage, ok := m.leverage[runID]; ok {
+		return leverage
+	}
+	return m.config.MaxLeverage
+}
+
+func (m *marginCalculator) Estimate(name strategy.StrategyName, action strategy.TradeAction) (Estimate, error) {
+	m.mu.Lock()
+	maxLeverage := m.maxLeverageLocked(m.runs[name])
+	m.mu.Unlock()
+
+	acct, err := m.account(action.Exchange)
+	if err != nil {
+		return Estimate{
+			Exchange:    action.Exchange,
+			Asset:       action.Asset.Symbol(),
+			Quantity:    action.Quantity,
+			MaxLeverage: maxLeverage,
+		}, err
+	}
+	return m.estimate(acct, action, maxLeverage)
+}
+
+// account reads an exchange's balance and positions once
+func (m *marginCalculator) account(exchange connector.ExchangeName) (*account, error) {
+	conn, ok := m.registry.GetConnector(exchange)
+	if !ok {
+		return nil, fmt.Errorf("connector %s not registered", exchange)
+	}
+
+	balance, err := conn.GetAccountBalance()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s balance: %w", exchange, err)
+	}
+	positions, err := conn.GetPositions()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s positions: %w", exchange, err)
+	}
+
+	acct := &account{
+		conn:      conn,
+		equity:    balance.TotalBalance,
+		available: balance.AvailableBalance,
+		positions: make(map[string]numerical.Decimal),
+		prices:    make(map[string]numerical.Decimal),
+	}
+	for _, position := range positions {
+		asset := position.Symbol.Symbol()
+		size, ok := acct.positions[asset]
+		if !ok {
+			size = numerical.Zero()
+		}
+		if position.Side == connector.OrderSideSell {
+			size = size.Sub(position.Size.Abs())
+		} else {
+			size = size.Add(position.Size.Abs())
+		}
+		acct.positions[asset] = size
+		if _, ok := acct.prices[asset]; !ok {
+			acct.prices[asset] = m.mark(conn, position.Symbol, position.MarkPrice)
+		}
+	}
+	return acct, nil
+}
+
+func (m *marginCalculator) estimate(acct *account, action strategy.TradeAction, maxLeverage numerical.Decimal) (Estimate, error) {
+	estimate := Estimate{
+		Exchange:    action.Exchange,
+		Asset:       action.Asset.Symbol(),
+		Quantity:    action.Quantity,
+		MaxLeverage: maxLeverage,
+		Equity:      acct.equity,
+		Available:   acct.available,
+		Position:    numerical.Zero(),
+		Notional:    numerical.Zero(),
+	}
+
+	others := numerical.Zero()
+	for asset, size := range acct.positions {
+		if asset == estimate.Asset {
+			estimate.Position = size
+			continue
+		}
+		others = others.Add(size.Abs().Mul(acct.prices[asset]))
+	}
+
+	price, ok := acct.prices[estimate.Asset]
+	if !ok || !price.IsPositive() {
+		price = m.mark(acct.conn, action.Asset, action.Price)
+		acct.prices[estimate.Asset] = price
+	}
+	estimate.Price = price
+	if !estimate.Price.IsPositive() {
+		return estimate, fmt.Errorf("no price for %s on %s", estimate.Asset, action.Exchange)
+	}
+	estimate.Notional = others.Add(estimate.Position.Abs().Mul(estimate.Price))
+
+	post := estimate.Position.Add(delta(action, estimate.Position))
+	estimate.Increases = post.Abs().GreaterThan(estimate.Position.Abs())
+	estimate.PostNotional = others.Add(post.Abs().Mul(estimate.Price))
+	estimate.PostLeverage = numerical.Zero()
+	estimate.PostUtilization = numerical.Zero()
+	if estimate.Equity.IsPositive() {
+		estimate.PostLeverage = estimate.PostNotional.Div(estimate.Equity)
+		if maxLeverage.IsPositive() {
+			estimate.PostUtilization = estimate.PostLeverage.Div(maxLeverage)
+		}
+	}
+
+	estimate.AllowedQuantity = action.Quantity
+	if estimate.Increases {
+		estimate.AllowedQuantity = m.allowed(acct, action, &estimate)
+	}
+	return estimate, nil
+}
+
+// allowed is the largest quantity of the action that keeps post-trade
+// notional under max leverage and the margin it adds, at that leverage,
+// under the available balance
+func (m *marginCalculator) allowed(acct *account, action strategy.TradeAction, estimate *Estimate) numerical.Decimal {
+	if !estimate.Equity.IsPositive() {
+		return numerical.Zero()
+	}
+
+	headroom := estimate.MaxLeverage.Mul(estimate.Equity).Sub(estimate.Notional)
+	if byAvailable := estimate.Available.Mul(estimate.MaxLeverage); byAvailable.LessThan(headroom) {
+		headroom = byAvailable
+		estimate.AvailableLimited = true
+	}
+
+	// The largest position size the headroom allows, then the order that
+	// reaches it: adding to the position or flipping through it
+	current := estimate.Position.Abs()
+	target := current.Add(headroom.Div(estimate.Price))
+	if target.IsNegative() {
+		target = numerical.Zero()
+	}
+	quantity := target.Sub(current)
+	if flips(action, estimate.Position) {
+		quantity = target.Add(current)
+	}
+	if !quantity.IsPositive() {
+		return numerical.Zero()
+	}
+	if quantity.GreaterThan(action.Quantity) {
+		return action.Quantity
+	}
+	return roundToStep(quantity, m.stepSize(acct, action.Asset))
+}
+
+// apply books an action that will be sent into the account, so the next
+// action of the signal is checked against the post-trade position and the
+// margin this one uses
+func (acct *account) apply(action strategy.TradeAction, estimate Estimate) {
+	position := estimate.Position
+	post := position.Add(delta(action, position))
+	acct.positions[estimate.Asset] = post
+
+	added := post.Abs().Sub(position.Abs())
+	if added.IsPositive() && estimate.MaxLeverage.IsPositive() {
+		acct.available = acct.available.Sub(added.Mul(estimate.Price).Div(estimate.MaxLeverage))
+	}
+}
+
+func (m *marginCalculator) Check(signal *strategy.Signal) (*strategy.Signal, error) {
+	if signal == nil {
+		return nil, fmt.Errorf("signal is nil")
+	}
+
+	m.mu.Lock()
+	config := m.config
+	runID := m.runs[signal.Strategy]
+	maxLeverage := m.maxLeverageLocked(runID)
+	m.mu.Unlock()
+
+	if !config.Enabled {
+		return signal, nil
+	}
+
+	checked := *signal
+	checked.Actions = append([]strategy.TradeAction(nil), signal.Actions...)
+
+	accounts := make(map[connector.ExchangeName]*account)
+	unreadable := make(map[connector.ExchangeName]error)
+
+	for i, action := range checked.Actions {
+		if !limited(action) {
+			continue
+		}
+
+		acct, ok := accounts[action.Exchange]
+		if !ok {
+			if err, failed := unreadable[action.Exchange]; failed {
+				m.record(signal, Decision{Outcome: OutcomeAllowed, Estimate: unchecked(action, maxLeverage), Reason: err.Error()})
+				continue
+			}
+			var err error
+			if acct, err = m.account(action.Exchange); err != nil {
+				// Without positions there is no telling an exit from an
+				// entry, and blocking exits on a failed read is the worse
+				// outcome
+				unreadable[action.Exchange] = err
+				m.record(signal, Decision{Outcome: OutcomeAllowed, Estimate: unchecked(action, maxLeverage), Reason: err.Error()})
+				continue
+			}
+			accounts[action.Exchange] = acct
+		}
+
+		estimate, err := m.estimate(acct, action, maxLeverage)
+		if err != nil {
+			m.record(signal, Decision{Outcome: OutcomeAllowed, Estimate: estimate, Reason: err.Error()})
+			continue
+		}
+		if !estimate.Increases || estimate.AllowedQuantity.GreaterThanOrEqual(action.Quantity) {
+			acct.apply(action, estimate)
+			continue
+		}
+
+		reason := fmt.Sprintf("post-trade leverage %sx exceeds %sx",
+			estimate.PostLeverage.Round(2), maxLeverage.Round(2))
+		if estimate.AvailableLimited {
+			reason = fmt.Sprintf("added margin exceeds available balance %s", estimate.Available.Round(2))
+		}
+
+		if config.Mode == ModeReject || !estimate.AllowedQuantity.IsPositive() {
+			m.record(signal, Decision{Outcome: OutcomeRejected, Estimate: estimate, Reason: reason})
+			return nil, fmt.Errorf("signal %s from %s on %s %s: %s: %w",
+				signal.ID, signal.Strategy, action.Exchange, estimate.Asset, reason, ErrMarginExceeded)
+		}
+
+		checked.Actions[i].Quantity = estimate.AllowedQuantity
+		acct.apply(checked.Actions[i], estimate)
+		m.record(signal, Decision{Outcome: OutcomeDownsized, Estimate: estimate, Reason: reason})
+	}
+
+	return &checked, nil
+}
+
+// unchecked is the estimate recorded for an action whose account could not
+// be read
+func unchecked(action strategy.TradeAction, maxLeverage numerical.Decimal) Estimate {
+	return Estimate{
+		Exchange:    action.Exchange,
+		Asset:       action.Asset.Symbol(),
+		Quantity:    action.Quantity,
+		MaxLeverage: maxLeverage,
+	}
+}
+
+// record writes a decision other than a plain allow to the run log and
+// application log
+func (m *marginCalculator) record(signal *strategy.Signal, decision Decision) {
+	estimate := decision.Estimate
+	level := runlog.LevelWarn
+	var message string
+	switch decision.Outcome {
+	case OutcomeDownsized:
+		level = runlog.LevelInfo
+		message = fmt.Sprintf("Order %s %s on %s downsized to %s: %s",
+			estimate.Quantity, estimate.Asset, estimate.Exchange, estimate.AllowedQuantity, decision.Reason)
+	case OutcomeRejected:
+		message = fmt.Sprintf("Order %s %s on %s rejected: %s",
+			estimate.Quantity, estimate.Asset, estimate.Exchange, decision.Reason)
+	default:
+		message = fmt.Sprintf("Order %s %s on %s sent unchecked: %s",
+			estimate.Quantity, estimate.Asset, estimate.Exchange, decision.Reason)
+	}
+	if estimate.Equity.IsPositive() {
+		message += fmt.Sprintf(" (equity %s, notional %s, utilization %s%%)",
+			estimate.Equity.Round(2), estimate.Notional.Round(2),
+			estimate.PostUtilization.Mul(numerical.NewFromInt(100)).Round(1))
+	}
+
+	m.logger.Warn("📉 Signal %s from %s: %s", signal.ID, signal.Strategy, message)
+	m.runLog.Record(level, LogKind, runlog.Fields{
+		Asset:    estimate.Asset,
+		Exchange: string(estimate.Exchange),
+		SignalID: signal.ID.String(),
+	}, "%s", message)
+}
+
+// mark prefers the mark price feed, then the given price, then the last
+// traded price; zero when none is known
+func (m *marginCalculator) mark(conn connector.Connector, asset portfolio.Asset, fallback numerical.Decimal) numerical.Decimal {
+	if mark, ok := m.marks.Mark(conn.GetConnectorInfo().Name, asset); ok && mark.MarkPrice.IsPositive() {
+		return mark.MarkPrice
+	}
+	if fallback.IsPositive() {
+		return fallback
+	}
+
+	price, err := conn.FetchPrice(m.symbol(conn, asset))
+	if err != nil || price == nil {
+		return numerical.Zero()
+	}
+	return price.Price
+}
+
+func (m *marginCalculator) symbol(conn connector.Connector, asset portfolio.Asset) string {
+	symbol, err := m.symbols.Resolve(conn.GetConnectorInfo().Name, asset.Symbol(), connector.TypePerpetual)
+	if err != nil {
+		return conn.GetPerpSymbol(asset)
+	}
+	return symbol
+}
+
+// stepSize looks up the lot step, fetching the account's contracts at most
+// once; zero means quantities are not rounded
+func (m *marginCalculator) stepSize(acct *account, asset portfolio.Asset) numerical.Decimal {
+	if !acct.contractsRead {
+		acct.contractsRead = true
+		contracts, err := acct.conn.FetchContracts()
+		if err != nil {
+			m.logger.Warn("Margin check: failed to read %s contracts: %v", acct.conn.GetConnectorInfo().Name, err)
+		}
+		acct.contracts = contracts
+	}
+
+	symbol := m.symbol(acct.conn, asset)
+	for _, contract := range acct.contracts {
+		if contract.Symbol == symbol {
+			return contract.StepSize
+		}
+	}
+	return numerical.Zero()
+}
+
+func roundToStep(quantity, step numerical.Decimal) numerical.Decimal {
+	if !step.IsPositive() {
+		return quantity
+	}
+	return quantity.Div(step).Truncate(0).Mul(step)
+}
+
+// limited reports whether an action places an order that can add exposure
+func limited(action strategy.TradeAction) bool {
+	switch action.Action {
+	case strategy.ActionBuy, strategy.ActionSell, strategy.ActionSellShort, strategy.ActionCover:
+		return action.Quantity.IsPositive()
+	}
+	return false
+}
+
+// delta is the signed position change an action makes
+func delta(action strategy.TradeAction, position numerical.Decimal) numerical.Decimal {
+	switch action.Action {
+	case strategy.ActionBuy, strategy.ActionCover:
+		return action.Quantity
+	case strategy.ActionSell, strategy.ActionSellShort:
+		return action.Quantity.Neg()
+	case strategy.ActionClose:
+		return position.Neg()
+	}
+	return numerical.Zero()
+}
+
+// flips reports whether the action trades against the current position
+func flips(action strategy.TradeAction, position numerical.Decimal) bool {
+	change := delta(action, position)
+	return (position.IsPositive() && change.IsNegative()) || (position.IsNegative() && change.IsPositive())
+}
+
+// checkedExecutor is what the freshness guard executes through
+type checkedExecutor struct {
+	calculator *marginCalculator
+	inner      execution.Executor
+}
+
+func (e *checkedExecutor) ExecuteSignal(signal *strategy.Signal) error {
+	checked, err := e.calculator.Check(signal)
+	if err != nil {
+		return err
+	}
+	return e.inner.ExecuteSignal(checked)
+}
+
+func (e *checkedExecutor) HandleTradeExecution(trade connector.Trade) error {
+	return e.inner.HandleTradeExecution(trade)
+}
@@ -0,0 +1,188 @@
+package margin_test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	mockconnector "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	mockregistry "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	mocktemporal "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	logger "github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+	mockmarkprice "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/connectors/markprice"
+	mocksymbols "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/connectors/symbols"
+	mockscheduler "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/scheduler"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+	"github.com/backtesting-org/live-trading/pkg/margin"
+	"github.com/backtesting-org/live-trading/pkg/runlog"
+)
+
+var _ = Describe("MarginCalculator", func() {
+	var (
+		conn       *mockconnector.Connector
+		calculator margin.MarginCalculator
+
+		balance   *connector.AccountBalance
+		positions []connector.Position
+		step      numerical.Decimal
+	)
+
+	btc := portfolio.NewAsset("BTC")
+
+	action := func(kind strategy.Action, quantity float64) strategy.TradeAction {
+		return strategy.TradeAction{Action: kind, Asset: btc, Exchange: types.Binance, Quantity: numerical.NewFromFloat(quantity)}
+	}
+
+	signal := func(actions ...strategy.TradeAction) *strategy.Signal {
+		return &strategy.Signal{ID: uuid.New(), Strategy: "momentum", Actions: actions}
+	}
+
+	enable := func(mode margin.Mode) {
+		Expect(calculator.Configure(margin.Config{Enabled: true, Mode: mode})).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		conn = mockconnector.NewConnector(GinkgoT())
+		balance = &connector.AccountBalance{TotalBalance: numerical.NewFromInt(1000), AvailableBalance: numerical.NewFromInt(1000)}
+		positions = nil
+		step = numerical.NewFromFloat(0.001)
+
+		connectors := mockregistry.NewConnectorRegistry(GinkgoT())
+		connectors.On("GetConnector", types.Binance).Return(conn, true).Maybe()
+
+		conn.On("GetConnectorInfo").Return(&connector.Info{Name: types.Binance}).Maybe()
+		conn.On("GetAccountBalance").Return(func() *connector.AccountBalance { return balance }, nil).Maybe()
+		conn.On("GetPositions").Return(func() []connector.Position { return positions }, nil).Maybe()
+		conn.On("FetchContracts").Return(func() []connector.ContractInfo {
+			return []connector.ContractInfo{{Symbol: "BTCUSDT", StepSize: step}}
+		}, nil).Maybe()
+
+		marks := mockmarkprice.NewMarkPriceFeed(GinkgoT())
+		marks.On("Mark", types.Binance, mock.Anything).Return(types.MarkPrice{MarkPrice: numerical.NewFromInt(100)}, true).Maybe()
+
+		symbolMapper := mocksymbols.NewSymbolMapper(GinkgoT())
+		symbolMapper.On("Resolve", types.Binance, "BTC", connector.TypePerpetual).Return("BTCUSDT", nil).Maybe()
+
+		mockTime := mocktemporal.NewTimeProvider(GinkgoT())
+		mockTime.On("Now").Return(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).Maybe()
+
+		noOp := logger.NewNoOpLogger()
+		runLog := runlog.NewRunLogger(mockscheduler.NewScheduler(GinkgoT()), mockTime, noOp)
+		calculator = margin.NewMarginCalculator(connectors, marks, symbolMapper, runLog, noOp)
+	})
+
+	It("passes every signal untouched until enabled", func() {
+		original := signal(action(strategy.ActionBuy, 500))
+
+		checked, err := calculator.Check(original)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(checked).To(BeIdenticalTo(original))
+		conn.AssertNotCalled(GinkgoT(), "GetAccountBalance")
+	})
+
+	Context("when enabled", func() {
+		BeforeEach(func() {
+			enable(margin.ModeDownsize)
+		})
+
+		It("leaves an order that fits under the limit", func() {
+			checked, err := calculator.Check(signal(action(strategy.ActionBuy, 10)))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checked.Actions[0].Quantity.Equal(numerical.NewFromInt(10))).To(BeTrue())
+		})
+
+		It("downsizes an order to what keeps leverage at the maximum", func() {
+			original := signal(action(strategy.ActionBuy, 60))
+
+			checked, err := calculator.Check(original)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Five times 1000 equity at a mark of 100 is 50 BTC
+			Expect(checked.Actions[0].Quantity.Equal(numerical.NewFromInt(50))).To(BeTrue())
+			Expect(original.Actions[0].Quantity.Equal(numerical.NewFromInt(60))).To(BeTrue())
+		})
+
+		It("rounds the downsized quantity down to the lot step", func() {
+			step = numerical.NewFromFloat(0.3)
+
+			checked, err := calculator.Check(signal(action(strategy.ActionBuy, 60)))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checked.Actions[0].Quantity.Equal(numerical.NewFromFloat(49.8))).To(BeTrue())
+		})
+
+		It("limits by available margin when it binds before leverage", func() {
+			balance.AvailableBalance = numerical.NewFromInt(200)
+
+			checked, err := calculator.Check(signal(action(strategy.ActionBuy, 30)))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checked.Actions[0].Quantity.Equal(numerical.NewFromInt(10))).To(BeTrue())
+		})
+
+		It("checks each action against the exposure the earlier ones add", func() {
+			checked, err := calculator.Check(signal(action(strategy.ActionBuy, 30), action(strategy.ActionBuy, 30)))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(checked.Actions[0].Quantity.Equal(numerical.NewFromInt(30))).To(BeTrue())
+			Expect(checked.Actions[1].Quantity.Equal(numerical.NewFromInt(20))).To(BeTrue())
+			conn.AssertNumberOfCalls(GinkgoT(), "GetAccountBalance", 1)
+			conn.AssertNumberOfCalls(GinkgoT(), "FetchContracts", 1)
+		})
+
+		It("never limits an order that shrinks the position", func() {
+			positions = []connector.Position{{Symbol: btc, Side: connector.OrderSideBuy, Size: numerical.NewFromInt(80)}}
+
+			checked, err := calculator.Check(signal(action(strategy.ActionSell, 30)))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checked.Actions[0].Quantity.Equal(numerical.NewFromInt(30))).To(BeTrue())
+		})
+
+		It("refuses the signal when nothing fits", func() {
+			positions = []connector.Position{{Symbol: btc, Side: connector.OrderSideBuy, Size: numerical.NewFromInt(50)}}
+
+			_, err := calculator.Check(signal(action(strategy.ActionBuy, 1)))
+			Expect(errors.Is(err, margin.ErrMarginExceeded)).To(BeTrue())
+		})
+
+		It("applies the limit of the run the strategy is bound to", func() {
+			Expect(calculator.BindStrategy("run-1", "momentum")).To(Succeed())
+			Expect(calculator.SetMaxLeverage("run-1", numerical.NewFromInt(2))).To(Succeed())
+
+			checked, err := calculator.Check(signal(action(strategy.ActionBuy, 30)))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checked.Actions[0].Quantity.Equal(numerical.NewFromInt(20))).To(BeTrue())
+
+			estimate, err := calculator.Estimate("momentum", action(strategy.ActionBuy, 30))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(estimate.MaxLeverage.Equal(numerical.NewFromInt(2))).To(BeTrue())
+			Expect(estimate.PostLeverage.Equal(numerical.NewFromInt(3))).To(BeTrue())
+		})
+
+		It("lets an order through when its account cannot be read", func() {
+			conn.ExpectedCalls = nil
+			conn.On("GetConnectorInfo").Return(&connector.Info{Name: types.Binance}).Maybe()
+			conn.On("GetAccountBalance").Return(nil, errors.New("timeout"))
+
+			checked, err := calculator.Check(signal(action(strategy.ActionBuy, 500)))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checked.Actions[0].Quantity.Equal(numerical.NewFromInt(500))).To(BeTrue())
+		})
+	})
+
+	Context("in reject mode", func() {
+		It("refuses a signal instead of downsizing it", func() {
+			enable(margin.ModeReject)
+
+			_, err := calculator.Check(signal(action(strategy.ActionBuy, 60)))
+			Expect(errors.Is(err, margin.ErrMarginExceeded)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("post-trade leverage 6x exceeds 5x")))
+		})
+	})
+})
@@ -0,0 +1,70 @@
+// Package margin estimates the margin a signal's orders would use and keeps
+// a run under its maximum leverage
+package margin
+
+import (
+	"fmt"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+)
+
+// Mode is what happens to an order that would take the account over its
+// maximum leverage
+type Mode string
+
+const (
+	// ModeReject refuses the signal
+	ModeReject Mode = "reject"
+
+	// ModeDownsize cuts the order to what fits under the limit and refuses
+	// the signal only when nothing fits
+	ModeDownsize Mode = "downsize"
+)
+
+const (
+	// DefaultMaxLeverage is the account leverage a run may trade up to
+	// unless it sets its own
+	DefaultMaxLeverage = 5
+
+	// LogKind is the run log kind margin decisions are recorded under
+	LogKind = "margin"
+)
+
+// Config controls the pre-trade margin check
+type Config struct {
+	// Enabled turns the check on; until then every signal passes untouched,
+	// since the limit has to suit the account before it may cut orders
+	Enabled bool
+	Mode    Mode
+
+	// MaxLeverage caps post-trade open notional as a multiple of equity on
+	// each exchange; runs may override it with SetMaxLeverage
+	MaxLeverage numerical.Decimal
+}
+
+// DefaultConfig leaves the check off; once enabled it downsizes orders that
+// would exceed five times equity
+func DefaultConfig() Config {
+	return Config{
+		Mode:        ModeDownsize,
+		MaxLeverage: numerical.NewFromInt(DefaultMaxLeverage),
+	}
+}
+
+func (c *Config) applyDefaults() error {
+	defaults := DefaultConfig()
+	switch c.Mode {
+	case "":
+		c.Mode = defaults.Mode
+	case ModeReject, ModeDownsize:
+	default:
+		return fmt.Errorf("unknown margin mode %q", c.Mode)
+	}
+	if c.MaxLeverage.IsNegative() {
+		return fmt.Errorf("max leverage must not be negative")
+	}
+	if c.MaxLeverage.IsZero() {
+		c.MaxLeverage = defaults.MaxLeverage
+	}
+	return nil
+}
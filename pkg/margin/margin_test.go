@@ -0,0 +1,13 @@
+package margin_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestMargin(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Margin Suite")
+}
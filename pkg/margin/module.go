@@ -0,0 +1,7 @@
+package margin
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(NewMarginCalculator),
+)
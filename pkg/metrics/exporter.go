@@ -0,0 +1,302 @@
+package metrics
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/live-trading/pkg/connectors/latency"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+	"github.com/backtesting-org/live-trading/pkg/scheduler"
+)
+
+const namespace = "live_trading"
+
+// latencyQuantiles are the points of each exchange latency distribution exported
+var latencyQuantiles = []float64{0.5, 0.95, 0.99}
+
+// counterStats are GetMetrics keys that only ever increase
+var counterStats = map[string]bool{
+	"messages_received":  true,
+	"messages_processed": true,
+	"messages_dropped":   true,
+	"connection_errors":  true,
+	"reconnection_count": true,
+	"dial_cold_count":    true,
+	"dial_warm_count":    true,
+	"standby_hits":       true,
+	"standby_misses":     true,
+	"wedged_recoveries":  true,
+}
+
+// Exporter gathers connector and scheduler metrics, plus any registered
+// collectors, in the Prometheus text format
+type Exporter interface {
+	// RegisterCollector adds samples from another subsystem, e.g. per-run
+	// signal and P&L gauges; a collector with the same name is replaced
+	RegisterCollector(name string, collector Collector)
+	UnregisterCollector(name string)
+
+	Gather() []Sample
+	Handler() http.Handler
+}
+
+type exporter struct {
+	registry   registry.ConnectorRegistry
+	scheduler  scheduler.Scheduler
+	latency    latency.Recorder
+	collectors map[string]Collector
+	mu         sync.RWMutex
+}
+
+func NewExporter(
+	connectorRegistry registry.ConnectorRegistry,
+	jobScheduler scheduler.Scheduler,
+	latencyRecorder latency.Recorder,
+) Exporter {
+	return &exporter{
+		registry:   connectorRegistry,
+		scheduler:  jobScheduler,
+		latency:    latencyRecorder,
+		collectors: make(map[string]Collector),
+	}
+}
+
+func (e *exporter) RegisterCollector(name string, collector Collector) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.collectors[name] = collector
+}
+
+func (e *exporter) UnregisterCollector(name string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	delete(e.collectors, name)
+}
+
+func (e *exporter) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		_, _ = w.Write([]byte(writeText(e.Gather())))
+	})
+}
+
+func (e *exporter) Gather() []Sample {
+	samples := e.connectorSamples()
+	samples = append(samples, e.schedulerSamples()...)
+	samples = append(samples, e.latencySamples()...)
+
+	e.mu.RLock()
+	names := make([]string, 0, len(e.collectors))
+	for name := range e.collectors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	collectors := make([]Collector, 0, len(names))
+	for _, name := range names {
+		collectors = append(collectors, e.collectors[name])
+	}
+	e.mu.RUnlock()
+
+	for _, collector := range collectors {
+		samples = append(samples, collector()...)
+	}
+	return samples
+}
+
+func (e *exporter) connectorSamples() []Sample {
+	var samples []Sample
+
+	for _, conn := range e.registry.GetAvailableConnectors() {
+		exchange := string(conn.GetConnectorInfo().Name)
+		labels := map[string]string{"exchange": exchange}
+
+		samples = append(samples, Sample{
+			Name:   namespace + "_connector_ready",
+			Help:   "Whether the connector has been initialized and marked ready.",
+			Type:   TypeGauge,
+			Labels: labels,
+			Value:  boolValue(e.registry.IsConnectorReady(conn.GetConnectorInfo().Name)),
+		})
+
+		if provider, ok := conn.(types.RateLimitProvider); ok {
+			samples = append(samples, rateLimitSamples(labels, provider.RateLimitStatus())...)
+		}
+	}
+
+	for _, conn := range e.registry.GetReadyWebSocketConnectors() {
+		exchange := string(conn.GetConnectorInfo().Name)
+		labels := map[string]string{"exchange": exchange}
+
+		samples = append(samples, Sample{
+			Name:   namespace + "_websocket_connected",
+			Help:   "Whether the exchange WebSocket is connected.",
+			Type:   TypeGauge,
+			Labels: labels,
+			Value:  boolValue(conn.IsWebSocketConnected()),
+		})
+
+		provider, ok := conn.(types.WebSocketMetricsProvider)
+		if !ok {
+			continue
+		}
+
+		for key, value := range provider.GetWebSocketMetrics() {
+			if key == "state" {
+				if state, ok := value.(string); ok {
+					samples = append(samples, Sample{
+						Name:   namespace + "_websocket_state",
+						Help:   "Current WebSocket connection state.",
+						Type:   TypeGauge,
+						Labels: map[string]string{"exchange": exchange, "state": state},
+						Value:  1,
+					})
+				}
+				continue
+			}
+
+			// "connected" is already reported from IsWebSocketConnected
+			if key == "connected" {
+				continue
+			}
+
+			number, ok := toFloat(value)
+			if !ok {
+				continue
+			}
+
+			sample := Sample{
+				Name:   namespace + "_websocket_" + key,
+				Type:   TypeGauge,
+				Labels: labels,
+				Value:  number,
+			}
+			if counterStats[key] {
+				sample.Name += "_total"
+				sample.Type = TypeCounter
+			}
+			samples = append(samples, sample)
+		}
+	}
+
+	return samples
+}
+
+func (e *exporter) schedulerSamples() []Sample {
+	var samples []Sample
+
+	for _, job := range e.scheduler.Jobs() {
+		labels := map[string]string{"job": job.Name}
+
+		samples = append(samples, Sample{
+			Name:   namespace + "_scheduler_job_enabled",
+			Help:   "Whether the scheduled job is enabled.",
+			Type:   TypeGauge,
+			Labels: labels,
+			Value:  boolValue(job.Enabled),
+		})
+
+		if job.LastRun == nil {
+			continue
+		}
+
+		samples = append(samples,
+			Sample{
+				Name:   namespace + "_scheduler_job_last_success",
+				Help:   "Whether the most recent run of the job succeeded.",
+				Type:   TypeGauge,
+				Labels: labels,
+				Value:  boolValue(job.LastRun.Err == ""),
+			},
+			Sample{
+				Name:   namespace + "_scheduler_job_last_duration_seconds",
+				Help:   "Duration of the most recent run of the job.",
+				Type:   TypeGauge,
+				Labels: labels,
+				Value:  job.LastRun.FinishedAt.Sub(job.LastRun.StartedAt).Seconds(),
+			},
+		)
+	}
+
+	return samples
+}
+
+func (e *exporter) latencySamples() []Sample {
+	var samples []Sample
+
+	for _, key := range e.latency.Keys() {
+		labels := map[string]string{"exchange": string(key.Exchange), "operation": string(key.Operation)}
+
+		for _, q := range latencyQuantiles {
+			value, ok := e.latency.Quantile(key.Exchange, key.Operation, q)
+			if !ok {
+				continue
+			}
+
+			quantileLabels := map[string]string{"quantile": strconv.FormatFloat(q, 'f', -1, 64)}
+			for name, label := range labels {
+				quantileLabels[name] = label
+			}
+
+			samples = append(samples, Sample{
+				Name:   namespace + "_exchange_latency_seconds",
+				Help:   "Exchange round-trip latency since start.",
+				Type:   TypeGauge,
+				Labels: quantileLabels,
+				Value:  value.Seconds(),
+			})
+		}
+
+		samples = append(samples, Sample{
+			Name:   namespace + "_exchange_latency_samples",
+			Help:   "Number of calls the latency quantiles are computed from.",
+			Type:   TypeGauge,
+			Labels: labels,
+			Value:  float64(e.latency.Count(key.Exchange, key.Operation)),
+		})
+	}
+
+	return samples
+}
+
+func rateLimitSamples(labels map[string]string, status types.RateLimitStatus) []Sample {
+	return []Sample{
+		{
+			Name:   namespace + "_rest_ratelimit_headroom",
+			Help:   "Fraction of the exchange REST request budget still available.",
+			Type:   TypeGauge,
+			Labels: labels,
+			Value:  status.Headroom(),
+		},
+		{
+			Name:   namespace + "_rest_throttled_total",
+			Help:   "REST calls that queued for rate-limit budget.",
+			Type:   TypeCounter,
+			Labels: labels,
+			Value:  float64(status.Throttled),
+		},
+		{
+			Name:   namespace + "_rest_rejected_total",
+			Help:   "REST calls refused because the rate-limit queue was too long.",
+			Type:   TypeCounter,
+			Labels: labels,
+			Value:  float64(status.Rejected),
+		},
+		{
+			Name:   namespace + "_rest_throttle_wait_seconds_total",
+			Help:   "Total time REST calls spent queued for rate-limit budget.",
+			Type:   TypeCounter,
+			Labels: labels,
+			Value:  status.Waited.Seconds(),
+		},
+	}
+}
+
+func boolValue(value bool) float64 {
+	if value {
+		return 1
+	}
+	return 0
+}
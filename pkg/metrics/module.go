@@ -0,0 +1,32 @@
+package metrics
+
+import (
+	"github.com/backtesting-org/live-trading/pkg/apitokens"
+	"github.com/backtesting-org/live-trading/pkg/connectors/killswitch"
+	"github.com/backtesting-org/live-trading/pkg/connectors/latency"
+	"go.uber.org/fx"
+)
+
+// Module is optional and not part of pkg.Module; binaries that want a
+// /metrics endpoint add it to their fx graph and call Server.Start
+var Module = fx.Options(
+	fx.Provide(
+		NewExporter,
+		NewServer,
+	),
+	fx.Invoke(mountRoutes),
+)
+
+const (
+	// LatencyPath serves order latency percentiles and their daily aggregates
+	LatencyPath = "/latency"
+
+	// KillSwitchPath serves the kill switch: its drawdown state on GET, to
+	// tokens covering the active run, and triggering and reset to the admin
+	KillSwitchPath = "/killswitch"
+)
+
+func mountRoutes(server Server, rollup latency.DailyRollup, killSwitch killswitch.KillSwitch, tokens apitokens.TokenStore) {
+	server.Handle(LatencyPath, rollup.Handler())
+	server.Handle(KillSwitchPath, tokens.Protect(apitokens.ScopeStatus, killSwitch.Handler()))
+}
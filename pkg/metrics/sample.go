@@ -0,0 +1,103 @@
+package metrics
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Type is the Prometheus metric type written in the TYPE comment
+type Type string
+
+const (
+	TypeGauge   Type = "gauge"
+	TypeCounter Type = "counter"
+)
+
+// Sample is a single labelled value in a metric family
+type Sample struct {
+	Name   string
+	Help   string
+	Type   Type
+	Labels map[string]string
+	Value  float64
+}
+
+// Collector produces samples on every scrape
+type Collector func() []Sample
+
+// toFloat converts the loosely typed values found in GetMetrics maps
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float64:
+		return v, true
+	case bool:
+		if v {
+			return 1, true
+		}
+		return 0, true
+	case time.Duration:
+		return v.Seconds(), true
+	case time.Time:
+		if v.IsZero() {
+			return 0, false
+		}
+		return float64(v.UnixNano()) / 1e9, true
+	}
+	return 0, false
+}
+
+// writeText renders samples in the Prometheus text exposition format,
+// grouping them into families by name
+func writeText(samples []Sample) string {
+	families := make(map[string][]Sample)
+	var names []string
+	for _, sample := range samples {
+		if _, exists := families[sample.Name]; !exists {
+			names = append(names, sample.Name)
+		}
+		families[sample.Name] = append(families[sample.Name], sample)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	for _, name := range names {
+		family := families[name]
+		if family[0].Help != "" {
+			fmt.Fprintf(&b, "# HELP %s %s\n", name, family[0].Help)
+		}
+		fmt.Fprintf(&b, "# TYPE %s %s\n", name, family[0].Type)
+		for _, sample := range family {
+			fmt.Fprintf(&b, "%s%s %s\n", name, formatLabels(sample.Labels), strconv.FormatFloat(sample.Value, 'g', -1, 64))
+		}
+	}
+	return b.String()
+}
+
+func formatLabels(labels map[string]string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%q", key, labels[key]))
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
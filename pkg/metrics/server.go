@@ -0,0 +1,113 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+)
+
+// DefaultAddress is where the metrics endpoint listens unless configured
+const DefaultAddress = ":9464"
+
+// Server serves the exporter on /metrics
+type Server interface {
+	// Handle mounts another API next to /metrics, e.g. the run metrics
+	// series handler; routes added after Start apply from the next Start
+	Handle(pattern string, handler http.Handler)
+
+	Start(address string) error
+	Stop(ctx context.Context) error
+	Address() string
+}
+
+type server struct {
+	exporter Exporter
+	logger   logging.ApplicationLogger
+
+	routes     map[string]http.Handler
+	httpServer *http.Server
+	listener   net.Listener
+	mu         sync.Mutex
+}
+
+func NewServer(exporter Exporter, logger logging.ApplicationLogger) Server {
+	return &server{
+		exporter: exporter,
+		logger:   logger,
+		routes:   make(map[string]http.Handler),
+	}
+}
+
+func (s *server) Handle(pattern string, handler http.Handler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.routes[pattern] = handler
+}
+
+func (s *server) Start(address string) error {
+	if address == "" {
+		address = DefaultAddress
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.httpServer != nil {
+		return fmt.Errorf("metrics server already running on %s", s.listener.Addr())
+	}
+
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", address, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", s.exporter.Handler())
+	for pattern, handler := range s.routes {
+		mux.Handle(pattern, handler)
+	}
+
+	s.listener = listener
+	s.httpServer = &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	go func(httpServer *http.Server) {
+		if err := httpServer.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.logger.Error("Metrics server stopped: %v", err)
+		}
+	}(s.httpServer)
+
+	s.logger.Info("📊 Metrics available at http://%s/metrics", listener.Addr())
+	return nil
+}
+
+func (s *server) Stop(ctx context.Context) error {
+	s.mu.Lock()
+	httpServer := s.httpServer
+	s.httpServer = nil
+	s.listener = nil
+	s.mu.Unlock()
+
+	if httpServer == nil {
+		return nil
+	}
+	return httpServer.Shutdown(ctx)
+}
+
+func (s *server) Address() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.listener == nil {
+		return ""
+	}
+	return s.listener.Addr().String()
+}
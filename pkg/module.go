@@ -2,13 +2,73 @@ package pkg
 
 import (
 	"github.com/backtesting-org/kronos-sdk/kronos"
+	"github.com/backtesting-org/live-trading/pkg/apitokens"
+	"github.com/backtesting-org/live-trading/pkg/capital"
+	"github.com/backtesting-org/live-trading/pkg/certification"
 	"github.com/backtesting-org/live-trading/pkg/connectors"
+	"github.com/backtesting-org/live-trading/pkg/drift"
+	"github.com/backtesting-org/live-trading/pkg/errortracking"
+	"github.com/backtesting-org/live-trading/pkg/eventschema"
+	"github.com/backtesting-org/live-trading/pkg/flags"
+	"github.com/backtesting-org/live-trading/pkg/flatten"
+	"github.com/backtesting-org/live-trading/pkg/freshness"
+	"github.com/backtesting-org/live-trading/pkg/introspection"
+	"github.com/backtesting-org/live-trading/pkg/margin"
+	"github.com/backtesting-org/live-trading/pkg/overrides"
+	"github.com/backtesting-org/live-trading/pkg/pipeline"
+	"github.com/backtesting-org/live-trading/pkg/positionimport"
+	"github.com/backtesting-org/live-trading/pkg/quotas"
+	"github.com/backtesting-org/live-trading/pkg/riskprofiles"
+	"github.com/backtesting-org/live-trading/pkg/runbinding"
+	"github.com/backtesting-org/live-trading/pkg/runlog"
+	"github.com/backtesting-org/live-trading/pkg/runmetrics"
+	"github.com/backtesting-org/live-trading/pkg/runreport"
+	"github.com/backtesting-org/live-trading/pkg/runstream"
+	"github.com/backtesting-org/live-trading/pkg/scheduler"
+	"github.com/backtesting-org/live-trading/pkg/sessions"
+	"github.com/backtesting-org/live-trading/pkg/shortfall"
+	"github.com/backtesting-org/live-trading/pkg/shutdown"
+	"github.com/backtesting-org/live-trading/pkg/signalarbiter"
+	"github.com/backtesting-org/live-trading/pkg/signaljournal"
+	"github.com/backtesting-org/live-trading/pkg/signallatency"
+	"github.com/backtesting-org/live-trading/pkg/signalqueue"
 	"github.com/backtesting-org/live-trading/pkg/startup"
+	"github.com/backtesting-org/live-trading/pkg/supervisor"
 	"go.uber.org/fx"
 )
 
 var Module = fx.Options(
 	kronos.Module,
 	connectors.Module,
+	scheduler.Module,
+	flags.Module,
+	errortracking.Module,
+	certification.Module,
 	startup.Module,
+	signaljournal.Module,
+	signallatency.Module,
+	signalqueue.Module,
+	signalarbiter.Module,
+	freshness.Module,
+	margin.Module,
+	riskprofiles.Module,
+	shortfall.Module,
+	runbinding.Module,
+	pipeline.Module,
+	runmetrics.Module,
+	runreport.Module,
+	runstream.Module,
+	apitokens.Module,
+	flatten.Module,
+	shutdown.Module,
+	positionimport.Module,
+	quotas.Module,
+	introspection.Module,
+	overrides.Module,
+	runlog.Module,
+	sessions.Module,
+	eventschema.Module,
+	capital.Module,
+	drift.Module,
+	supervisor.RunModule,
 )
@@ -0,0 +1,38 @@
+// Package overrides applies temporary, expiring changes to a run's config
+// parameters without cutting a new config version
+package overrides
+
+import "time"
+
+const (
+	// DefaultInterval is how often expired overrides are reverted
+	DefaultInterval = 5 * time.Second
+
+	// DefaultMaxDuration caps how far ahead an override may expire
+	DefaultMaxDuration = 24 * time.Hour
+
+	// JobName is the scheduler job that reverts expired overrides
+	JobName = "parameter-override-expiry"
+
+	// EventKind marks override changes in the run report's events
+	EventKind = "parameter_override"
+)
+
+// Config controls override expiry and auditing
+type Config struct {
+	Interval    time.Duration
+	MaxDuration time.Duration
+
+	// AuditPath receives every set, revert and expiry as JSON lines; empty
+	// keeps the audit trail in memory only
+	AuditPath string
+}
+
+// DefaultConfig checks expiry every five seconds and allows overrides of
+// up to a day
+func DefaultConfig() Config {
+	return Config{
+		Interval:    DefaultInterval,
+		MaxDuration: DefaultMaxDuration,
+	}
+}
@@ -0,0 +1,7 @@
+package overrides
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(NewParameterOverrides),
+)
@@ -0,0 +1,432 @@
+package overrides
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/jsonl"
+	"github.com/backtesting-org/live-trading/pkg/runreport"
+	"github.com/backtesting-org/live-trading/pkg/scheduler"
+)
+
+// Override is a temporary value for one parameter of one run's config
+type Override struct {
+	ID        string
+	RunID     string
+	Parameter string
+	Value     string
+
+	// Previous is the config value the override replaces
+	Previous string
+
+	Reason    string
+	Actor     string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// Action is what happened to an override
+type Action string
+
+const (
+	ActionSet     Action = "set"
+	ActionRevert  Action = "revert"
+	ActionExpired Action = "expired"
+)
+
+// AuditEntry records one change to a run's overrides
+type AuditEntry struct {
+	Action   Action
+	Override Override
+
+	// Actor is who set or reverted the override; expiry has none
+	Actor string
+	At    time.Time
+}
+
+// Request is an operator's ask for an override; ExpiresAt is mandatory
+type Request struct {
+	Parameter string
+	Value     string
+	ExpiresAt time.Time
+	Reason    string
+	Actor     string
+}
+
+// ParameterOverrides lets operators tweak a run's parameters for a while,
+// e.g. halving size through a volatile event, without a new config version.
+// Overrides apply on top of the run config passed to RunReporter.Begin,
+// stop applying the moment they expire and are removed by the expiry job.
+// Every change is audited and noted on the run report.
+type ParameterOverrides interface {
+	Configure(config Config) error
+
+	// Start registers the expiry job with the scheduler
+	Start() error
+	Stop() error
+
+	// Set overrides a parameter of the active run's config; a second
+	// override of the same parameter replaces the first
+	Set(runID string, request Request) (Override, error)
+
+	// Revert removes an override before it expires
+	Revert(runID, id, actor string) error
+
+	// Expire removes every override past its expiry
+	Expire() int
+
+	// Active lists a run's unexpired overrides, soonest expiry first
+	Active(runID string) []Override
+
+	// Effective is the active run's config with its overrides applied
+	Effective(runID string) (map[string]string, error)
+
+	// Value is one parameter of Effective
+	Value(runID, parameter string) (string, bool)
+
+	Audit(runID string) []AuditEntry
+
+	// Handler lists a run's overrides and audit trail on GET, sets one on
+	// POST with a JSON Request and reverts ?id= on DELETE; ?run= selects
+	// the run and defaults to the active one
+	Handler() http.Handler
+}
+
+type parameterOverrides struct {
+	reporter     runreport.RunReporter
+	scheduler    scheduler.Scheduler
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config    Config
+	overrides map[string]map[string]Override
+	audit     []AuditEntry
+	auditLog  *os.File
+	sequence  uint64
+	mu        sync.Mutex
+}
+
+func NewParameterOverrides(
+	reporter runreport.RunReporter,
+	jobScheduler scheduler.Scheduler,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) ParameterOverrides {
+	return &parameterOverrides{
+		reporter:     reporter,
+		scheduler:    jobScheduler,
+		timeProvider: timeProvider,
+		logger:       logger,
+		config:       DefaultConfig(),
+		overrides:    make(map[string]map[string]Override),
+	}
+}
+
+func (p *parameterOverrides) Configure(config Config) error {
+	if config.Interval <= 0 {
+		config.Interval = DefaultInterval
+	}
+	if config.MaxDuration <= 0 {
+		config.MaxDuration = DefaultMaxDuration
+	}
+
+	var auditLog *os.File
+	if config.AuditPath != "" {
+		file, err := jsonl.OpenAppend(config.AuditPath, 0o640)
+		if err != nil {
+			return fmt.Errorf("failed to open override audit log: %w", err)
+		}
+		auditLog = file
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.auditLog != nil {
+		_ = p.auditLog.Close()
+	}
+	p.config = config
+	p.auditLog = auditLog
+	return nil
+}
+
+func (p *parameterOverrides) Start() error {
+	p.mu.Lock()
+	interval := p.config.Interval
+	p.mu.Unlock()
+
+	return p.scheduler.Register(scheduler.Job{
+		Name:     JobName,
+		Interval: interval,
+		Run: func(_ context.Context) error {
+			p.Expire()
+			return nil
+		},
+	})
+}
+
+func (p *parameterOverrides) Stop() error {
+	return p.scheduler.Unregister(JobName)
+}
+
+func (p *parameterOverrides) Set(runID string, request Request) (Override, error) {
+	if request.Parameter == "" {
+		return Override{}, fmt.Errorf("parameter is required")
+	}
+	if request.Actor == "" {
+		return Override{}, fmt.Errorf("actor is required for an override")
+	}
+	if request.ExpiresAt.IsZero() {
+		return Override{}, fmt.Errorf("override of %s needs an expiry time", request.Parameter)
+	}
+
+	base, err := p.base(runID)
+	if err != nil {
+		return Override{}, err
+	}
+	previous, ok := base[request.Parameter]
+	if !ok {
+		return Override{}, fmt.Errorf("run %s has no parameter %s", runID, request.Parameter)
+	}
+
+	now := p.timeProvider.Now()
+	if !request.ExpiresAt.After(now) {
+		return Override{}, fmt.Errorf("override of %s expires in the past", request.Parameter)
+	}
+
+	p.mu.Lock()
+	maxDuration := p.config.MaxDuration
+	if request.ExpiresAt.Sub(now) > maxDuration {
+		p.mu.Unlock()
+		return Override{}, fmt.Errorf("override of %s may last at most %s", request.Parameter, maxDuration)
+	}
+
+	p.sequence++
+	override := Override{
+		ID:        fmt.Sprintf("override-%d-%d", now.UnixMilli(), p.sequence),
+		RunID:     runID,
+		Parameter: request.Parameter,
+		Value:     request.Value,
+		Previous:  previous,
+		Reason:    request.Reason,
+		Actor:     request.Actor,
+		CreatedAt: now,
+		ExpiresAt: request.ExpiresAt,
+	}
+
+	if p.overrides[runID] == nil {
+		p.overrides[runID] = make(map[string]Override)
+	}
+	replaced, wasSet := p.overrides[runID][override.Parameter]
+	p.overrides[runID][override.Parameter] = override
+	if wasSet {
+		p.recordLocked(AuditEntry{Action: ActionRevert, Override: replaced, Actor: request.Actor, At: now})
+	}
+	p.recordLocked(AuditEntry{Action: ActionSet, Override: override, Actor: request.Actor, At: now})
+	p.mu.Unlock()
+
+	p.reporter.Event(EventKind, fmt.Sprintf("%s set %s to %s (was %s) until %s: %s",
+		override.Actor, override.Parameter, override.Value, override.Previous,
+		override.ExpiresAt.UTC().Format(time.RFC3339), override.Reason))
+	p.logger.Info("Run %s parameter %s overridden to %s by %s until %s",
+		runID, override.Parameter, override.Value, override.Actor, override.ExpiresAt.UTC().Format(time.RFC3339))
+	return override, nil
+}
+
+func (p *parameterOverrides) Revert(runID, id, actor string) error {
+	if actor == "" {
+		return fmt.Errorf("actor is required to revert an override")
+	}
+
+	p.mu.Lock()
+	var override Override
+	for _, candidate := range p.overrides[runID] {
+		if candidate.ID == id {
+			override = candidate
+			break
+		}
+	}
+	if override.ID == "" {
+		p.mu.Unlock()
+		return fmt.Errorf("override %s not found on run %s", id, runID)
+	}
+
+	delete(p.overrides[runID], override.Parameter)
+	p.recordLocked(AuditEntry{Action: ActionRevert, Override: override, Actor: actor, At: p.timeProvider.Now()})
+	p.mu.Unlock()
+
+	if active, _ := p.reporter.Active(); active == runID {
+		p.reporter.Event(EventKind, fmt.Sprintf("%s reverted %s to %s", actor, override.Parameter, override.Previous))
+	}
+	p.logger.Info("Run %s parameter %s override reverted by %s", runID, override.Parameter, actor)
+	return nil
+}
+
+func (p *parameterOverrides) Expire() int {
+	now := p.timeProvider.Now()
+
+	p.mu.Lock()
+	var expired []Override
+	for runID, byParameter := range p.overrides {
+		for parameter, override := range byParameter {
+			if now.Before(override.ExpiresAt) {
+				continue
+			}
+			delete(byParameter, parameter)
+			expired = append(expired, override)
+			p.recordLocked(AuditEntry{Action: ActionExpired, Override: override, At: now})
+		}
+		if len(byParameter) == 0 {
+			delete(p.overrides, runID)
+		}
+	}
+	p.mu.Unlock()
+
+	active, _ := p.reporter.Active()
+	for _, override := range expired {
+		if override.RunID == active {
+			p.reporter.Event(EventKind, fmt.Sprintf("%s override expired, back to %s", override.Parameter, override.Previous))
+		}
+		p.logger.Info("Run %s parameter %s override expired", override.RunID, override.Parameter)
+	}
+	return len(expired)
+}
+
+func (p *parameterOverrides) Active(runID string) []Override {
+	now := p.timeProvider.Now()
+
+	p.mu.Lock()
+	active := make([]Override, 0, len(p.overrides[runID]))
+	for _, override := range p.overrides[runID] {
+		if now.Before(override.ExpiresAt) {
+			active = append(active, override)
+		}
+	}
+	p.mu.Unlock()
+
+	sort.Slice(active, func(i, j int) bool {
+		if !active[i].ExpiresAt.Equal(active[j].ExpiresAt) {
+			return active[i].ExpiresAt.Before(active[j].ExpiresAt)
+		}
+		return active[i].Parameter < active[j].Parameter
+	})
+	return active
+}
+
+func (p *parameterOverrides) Effective(runID string) (map[string]string, error) {
+	config, err := p.base(runID)
+	if err != nil {
+		return nil, err
+	}
+	// An override past its expiry no longer applies, even before the job
+	// gets to remove it
+	for _, override := range p.Active(runID) {
+		config[override.Parameter] = override.Value
+	}
+	return config, nil
+}
+
+func (p *parameterOverrides) Value(runID, parameter string) (string, bool) {
+	config, err := p.Effective(runID)
+	if err != nil {
+		return "", false
+	}
+	value, ok := config[parameter]
+	return value, ok
+}
+
+func (p *parameterOverrides) Audit(runID string) []AuditEntry {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var entries []AuditEntry
+	for _, entry := range p.audit {
+		if entry.Override.RunID == runID {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// base is a copy of the active run's config as it was begun
+func (p *parameterOverrides) base(runID string) (map[string]string, error) {
+	current, ok := p.reporter.Current()
+	if !ok || current.RunID != runID {
+		return nil, fmt.Errorf("run %s is not active", runID)
+	}
+	return current.Config, nil
+}
+
+// recordLocked appends to the audit trail and its log; a log write failure
+// is reported but keeps the in-memory entry. Callers hold mu.
+func (p *parameterOverrides) recordLocked(entry AuditEntry) {
+	p.audit = append(p.audit, entry)
+	if p.auditLog == nil {
+		return
+	}
+
+	if err := jsonl.Append(p.auditLog, entry); err != nil {
+		p.logger.Error("Override audit entry for %s not written: %v", entry.Override.ID, err)
+	}
+}
+
+// view is what Handler serves for a run
+type view struct {
+	RunID     string
+	Effective map[string]string
+	Overrides []Override
+	Audit     []AuditEntry
+}
+
+func (p *parameterOverrides) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		runID := req.URL.Query().Get("run")
+		if runID == "" {
+			runID, _ = p.reporter.Active()
+		}
+
+		switch req.Method {
+		case http.MethodGet:
+		case http.MethodPost:
+			var request Request
+			if err := json.NewDecoder(req.Body).Decode(&request); err != nil {
+				http.Error(w, fmt.Sprintf("invalid override request: %v", err), http.StatusBadRequest)
+				return
+			}
+			if _, err := p.Set(runID, request); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+		case http.MethodDelete:
+			if err := p.Revert(runID, req.URL.Query().Get("id"), req.URL.Query().Get("actor")); err != nil {
+				http.Error(w, err.Error(), http.StatusNotFound)
+				return
+			}
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		effective, err := p.Effective(runID)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(view{
+			RunID:     runID,
+			Effective: effective,
+			Overrides: p.Active(runID),
+			Audit:     p.Audit(runID),
+		})
+	})
+}
@@ -0,0 +1,53 @@
+// Package pipeline composes the stages every strategy signal passes through
+// on its way to the SDK executor
+package pipeline
+
+import (
+	"github.com/backtesting-org/kronos-sdk/pkg/types/execution"
+	"github.com/backtesting-org/live-trading/pkg/connectors/latency"
+	"github.com/backtesting-org/live-trading/pkg/connectors/sanity"
+	"github.com/backtesting-org/live-trading/pkg/freshness"
+	"github.com/backtesting-org/live-trading/pkg/margin"
+	"github.com/backtesting-org/live-trading/pkg/riskprofiles"
+	"github.com/backtesting-org/live-trading/pkg/runbinding"
+	"github.com/backtesting-org/live-trading/pkg/shortfall"
+	"github.com/backtesting-org/live-trading/pkg/signalarbiter"
+	"github.com/backtesting-org/live-trading/pkg/signallatency"
+	"github.com/backtesting-org/live-trading/pkg/signalqueue"
+	"go.uber.org/fx"
+)
+
+var Module = fx.Options(
+	fx.Decorate(decorateExecutor),
+)
+
+// decorateExecutor puts the queue in front of the SDK executor, so the
+// orchestrator's GetSignals loop never waits on an exchange, and the
+// arbiter in front of the queue so conflicting signals are never journaled
+// or executed. The run binding goes ahead of both, refusing exchanges the
+// run was not started with before anything else sees the signal. The freshness guard sits behind the queue, judging data as
+// of execution rather than of enqueueing, and the margin check behind it,
+// sizing against prices the guard has just vouched for. The run's risk
+// profile judges the quantities margin has settled on, and the price sanity
+// check refuses markets whose price disagrees with the other venues just
+// before the order can reach one. The shortfall policy is innermost, next
+// to the exchange rejections it reacts to, with only the order timer between
+// it and the executor so acknowledgement latency is measured from the last
+// stage. The latency tracker is outermost so its clock starts the moment
+// GetSignals returns.
+// fx allows one decorator per type, so every stage is applied here.
+func decorateExecutor(
+	inner execution.Executor,
+	queue signalqueue.SignalQueue,
+	arbiter signalarbiter.SignalArbiter,
+	guard freshness.DataFreshnessGuard,
+	calculator margin.MarginCalculator,
+	profiles riskprofiles.RiskProfiles,
+	checker sanity.PriceSanityChecker,
+	policy shortfall.ShortfallPolicy,
+	tracker signallatency.LatencyTracker,
+	orders latency.OrderTimer,
+	binding runbinding.ExchangeBinding,
+) execution.Executor {
+	return tracker.Wrap(binding.Wrap(arbiter.Wrap(queue.Wrap(guard.Wrap(calculator.Wrap(profiles.Wrap(checker.Wrap(policy.Wrap(orders.Wrap(inner))))))))))
+}
@@ -0,0 +1,44 @@
+// Package pluginstore keeps strategy and hook plugins uploaded over HTTP and
+// loads them into the running process, so operators can deploy a strategy
+// without a restart
+package pluginstore
+
+import "fmt"
+
+const (
+	// DefaultDirectory is where uploaded plugins are saved
+	DefaultDirectory = "plugins"
+
+	// DefaultMaxSize caps an uploaded plugin file
+	DefaultMaxSize = 256 << 20
+
+	// Path is where the plugin API is mounted on the metrics server
+	Path = "/plugins"
+)
+
+// Config controls where uploads are kept and how large they may be
+type Config struct {
+	Directory string
+	MaxSize   int64
+}
+
+// DefaultConfig saves plugins under ./plugins, up to 256 MiB each
+func DefaultConfig() Config {
+	return Config{
+		Directory: DefaultDirectory,
+		MaxSize:   DefaultMaxSize,
+	}
+}
+
+func (c *Config) applyDefaults() error {
+	if c.Directory == "" {
+		c.Directory = DefaultDirectory
+	}
+	if c.MaxSize < 0 {
+		return fmt.Errorf("max plugin size must not be negative, got %d", c.MaxSize)
+	}
+	if c.MaxSize == 0 {
+		c.MaxSize = DefaultMaxSize
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+package pluginstore
+
+import (
+	"github.com/backtesting-org/live-trading/pkg/metrics"
+	"go.uber.org/fx"
+)
+
+// Module is optional and not part of pkg.Module; it serves the plugin API
+// from the metrics server, so hosts add it with metrics.Module
+var Module = fx.Options(
+	fx.Provide(NewPluginStore),
+	fx.Invoke(mountRoutes),
+)
+
+func mountRoutes(server metrics.Server, store PluginStore) {
+	server.Handle(Path, store.Handler())
+}
@@ -0,0 +1,13 @@
+package pluginstore_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestPluginStore(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "PluginStore Suite")
+}
@@ -0,0 +1,409 @@
+package pluginstore
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/plugin"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/apitokens"
+)
+
+// Kind is what a plugin registers once loaded
+type Kind string
+
+const (
+	KindStrategy Kind = "strategy"
+	KindHook     Kind = "hook"
+)
+
+var (
+	// ErrInvalid is returned, wrapped, for uploads refused before loading:
+	// a bad name or kind, an oversized file or one that is not a shared object
+	ErrInvalid = errors.New("invalid plugin")
+
+	// ErrRejected is returned, wrapped, when the plugin manager cannot load
+	// an upload; the file is removed again
+	ErrRejected = errors.New("plugin rejected")
+
+	ErrExists   = errors.New("plugin already exists")
+	ErrNotFound = errors.New("plugin not found")
+)
+
+// elfMagic starts every shared object Go can load as a plugin
+var elfMagic = []byte{0x7f, 'E', 'L', 'F'}
+
+// Plugin is an uploaded plugin and what loading it registered
+type Plugin struct {
+	Name       string    `json:"name"`
+	Kind       Kind      `json:"kind"`
+	Path       string    `json:"path"`
+	Digest     string    `json:"digest"`
+	Size       int64     `json:"size"`
+	UploadedAt time.Time `json:"uploaded_at"`
+
+	// Strategy metadata, set for strategy plugins
+	Strategy    string `json:"strategy,omitempty"`
+	Description string `json:"description,omitempty"`
+	RiskLevel   string `json:"risk_level,omitempty"`
+	Type        string `json:"type,omitempty"`
+}
+
+// PluginStore saves uploaded plugins and loads them through the plugin
+// manager. Go cannot unload a plugin or load a second build of one already
+// loaded, so a new version must be uploaded under a new file name, and
+// Delete only stops a strategy from trading until the process restarts.
+type PluginStore interface {
+	Configure(config Config) error
+
+	// Upload validates the plugin, saves it under name and loads it; a
+	// plugin that does not load is removed again
+	Upload(name string, kind Kind, content io.Reader) (*Plugin, error)
+
+	// Plugins lists the plugins uploaded since start, by name
+	Plugins() []Plugin
+
+	// Delete disables the plugin's strategy and removes its file
+	Delete(name string) error
+
+	// Handler is the admin API: GET lists plugins, POST uploads the
+	// multipart "plugin" file, named by ?name= or its file name and of
+	// ?kind=, and DELETE removes ?name=
+	Handler() http.Handler
+}
+
+type pluginStore struct {
+	manager      plugin.Manager
+	strategies   registry.StrategyRegistry
+	tokens       apitokens.TokenStore
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config  Config
+	plugins map[string]Plugin
+
+	// uploading holds names being saved or loaded, so two uploads cannot
+	// race for one
+	uploading map[string]bool
+	mu        sync.Mutex
+}
+
+func NewPluginStore(
+	pluginManager plugin.Manager,
+	strategyRegistry registry.StrategyRegistry,
+	tokenStore apitokens.TokenStore,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) PluginStore {
+	return &pluginStore{
+		manager:      pluginManager,
+		strategies:   strategyRegistry,
+		tokens:       tokenStore,
+		timeProvider: timeProvider,
+		logger:       logger,
+		config:       DefaultConfig(),
+		plugins:      make(map[string]Plugin),
+		uploading:    make(map[string]bool),
+	}
+}
+
+func (s *pluginStore) Configure(config Config) error {
+	if err := config.applyDefaults(); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.config = config
+	return nil
+}
+
+func (s *pluginStore) Upload(name string, kind Kind, content io.Reader) (*Plugin, error) {
+	if kind == "" {
+		kind = KindStrategy
+	}
+	if kind != KindStrategy && kind != KindHook {
+		return nil, fmt.Errorf("%w: unknown kind %q", ErrInvalid, kind)
+	}
+	if err := validName(name); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	config := s.config
+	_, exists := s.plugins[name]
+	if exists || s.uploading[name] {
+		s.mu.Unlock()
+		return nil, fmt.Errorf("%w: %s", ErrExists, name)
+	}
+	s.uploading[name] = true
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		delete(s.uploading, name)
+		s.mu.Unlock()
+	}()
+
+	path := filepath.Join(config.Directory, name)
+	if _, err := os.Stat(path); err == nil {
+		return nil, fmt.Errorf("%w: %s is already on disk", ErrExists, name)
+	}
+
+	digest, size, err := save(path, content, config.MaxSize)
+	if err != nil {
+		return nil, err
+	}
+
+	uploaded := Plugin{
+		Name:       name,
+		Kind:       kind,
+		Path:       path,
+		Digest:     digest,
+		Size:       size,
+		UploadedAt: s.timeProvider.Now(),
+	}
+	if err := s.load(&uploaded); err != nil {
+		if removeErr := os.Remove(path); removeErr != nil {
+			s.logger.Warn("Failed to remove rejected plugin %s: %v", path, removeErr)
+		}
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.plugins[name] = uploaded
+	s.mu.Unlock()
+
+	s.logger.Info("🔌 Loaded uploaded %s plugin %s (%s)", kind, name, digest[:12])
+	return &uploaded, nil
+}
+
+// load registers the plugin and fills in its strategy's metadata
+func (s *pluginStore) load(uploaded *Plugin) error {
+	if uploaded.Kind == KindHook {
+		if err := s.manager.LoadHookPlugin(uploaded.Path); err != nil {
+			return fmt.Errorf("%w: %v", ErrRejected, err)
+		}
+		return nil
+	}
+
+	loaded, err := s.manager.LoadStrategyPlugin(uploaded.Path)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrRejected, err)
+	}
+	uploaded.Strategy = string(loaded.GetName())
+	uploaded.Description = loaded.GetDescription()
+	uploaded.RiskLevel = string(loaded.GetRiskLevel())
+	uploaded.Type = string(loaded.GetStrategyType())
+	return nil
+}
+
+func (s *pluginStore) Plugins() []Plugin {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	plugins := make([]Plugin, 0, len(s.plugins))
+	for _, uploaded := range s.plugins {
+		plugins = append(plugins, uploaded)
+	}
+	sort.Slice(plugins, func(i, j int) bool { return plugins[i].Name < plugins[j].Name })
+	return plugins
+}
+
+func (s *pluginStore) Delete(name string) error {
+	s.mu.Lock()
+	uploaded, ok := s.plugins[name]
+	delete(s.plugins, name)
+	s.mu.Unlock()
+
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrNotFound, name)
+	}
+
+	if uploaded.Strategy != "" {
+		if err := s.strategies.DisableStrategy(strategy.StrategyName(uploaded.Strategy)); err != nil {
+			s.logger.Warn("Failed to disable strategy %s of deleted plugin %s: %v", uploaded.Strategy, name, err)
+		}
+	}
+	if err := os.Remove(uploaded.Path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove plugin %s: %w", name, err)
+	}
+
+	s.logger.Info("🔌 Deleted uploaded plugin %s", name)
+	return nil
+}
+
+func (s *pluginStore) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if !s.tokens.Admin(bearer(req)) {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "admin token required", http.StatusUnauthorized)
+			return
+		}
+
+		switch req.Method {
+		case http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(s.Plugins())
+		case http.MethodPost:
+			s.serveUpload(w, req)
+		case http.MethodDelete:
+			if err := s.Delete(req.URL.Query().Get("name")); err != nil {
+				http.Error(w, err.Error(), statusFor(err))
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+func (s *pluginStore) serveUpload(w http.ResponseWriter, req *http.Request) {
+	s.mu.Lock()
+	maxSize := s.config.MaxSize
+	s.mu.Unlock()
+
+	// Leave room for the multipart framing around the file
+	req.Body = http.MaxBytesReader(w, req.Body, maxSize+1<<20)
+	reader, err := req.MultipartReader()
+	if err != nil {
+		http.Error(w, fmt.Sprintf("multipart upload required: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			http.Error(w, `no "plugin" file in upload`, http.StatusBadRequest)
+			return
+		}
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid upload: %v", err), http.StatusBadRequest)
+			return
+		}
+		if part.FormName() != "plugin" {
+			continue
+		}
+
+		name := req.URL.Query().Get("name")
+		if name == "" {
+			name = part.FileName()
+		}
+		uploaded, err := s.Upload(name, Kind(req.URL.Query().Get("kind")), part)
+		if err != nil {
+			http.Error(w, err.Error(), statusFor(err))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(uploaded)
+		return
+	}
+}
+
+// save writes the upload beside its final path and moves it into place once
+// it is known to be a shared object within the size limit
+func save(path string, content io.Reader, maxSize int64) (string, int64, error) {
+	directory := filepath.Dir(path)
+	if err := os.MkdirAll(directory, 0o750); err != nil {
+		return "", 0, fmt.Errorf("failed to create plugin directory: %w", err)
+	}
+
+	file, err := os.CreateTemp(directory, ".upload-*")
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to save plugin: %w", err)
+	}
+	saved := false
+	defer func() {
+		if !saved {
+			_ = os.Remove(file.Name())
+		}
+	}()
+
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(file, hash), io.LimitReader(content, maxSize+1))
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to save plugin: %w", err)
+	}
+	if size > maxSize {
+		return "", 0, fmt.Errorf("%w: larger than %d bytes", ErrInvalid, maxSize)
+	}
+
+	header := make([]byte, len(elfMagic))
+	if err := readHeader(file.Name(), header); err != nil || !bytes.Equal(header, elfMagic) {
+		return "", 0, fmt.Errorf("%w: not a shared object", ErrInvalid)
+	}
+
+	if err := os.Chmod(file.Name(), 0o640); err != nil {
+		return "", 0, fmt.Errorf("failed to save plugin: %w", err)
+	}
+	if err := os.Rename(file.Name(), path); err != nil {
+		return "", 0, fmt.Errorf("failed to save plugin: %w", err)
+	}
+	saved = true
+
+	return hex.EncodeToString(hash.Sum(nil)), size, nil
+}
+
+func readHeader(path string, header []byte) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = io.ReadFull(file, header)
+	return err
+}
+
+// validName accepts a bare .so file name, so an upload cannot be written
+// outside the plugin directory
+func validName(name string) error {
+	if name == "" || name != filepath.Base(name) || strings.HasPrefix(name, ".") || !strings.HasSuffix(name, ".so") {
+		return fmt.Errorf("%w: name must be a .so file name, got %q", ErrInvalid, name)
+	}
+	return nil
+}
+
+func statusFor(err error) int {
+	switch {
+	case errors.Is(err, ErrInvalid):
+		return http.StatusBadRequest
+	case errors.Is(err, ErrExists):
+		return http.StatusConflict
+	case errors.Is(err, ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, ErrRejected):
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func bearer(req *http.Request) string {
+	header := req.Header.Get("Authorization")
+	if len(header) > 7 && strings.EqualFold(header[:7], "bearer ") {
+		return strings.TrimSpace(header[7:])
+	}
+	return ""
+}
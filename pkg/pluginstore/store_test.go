@@ -0,0 +1,178 @@
+package pluginstore_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	mockplugin "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/plugin"
+	mockregistry "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	mockstrategy "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+	mocktemporal "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	logger "github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+	mockapitokens "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/apitokens"
+	"github.com/backtesting-org/live-trading/pkg/pluginstore"
+)
+
+var _ = Describe("PluginStore", func() {
+	var (
+		directory  string
+		manager    *mockplugin.Manager
+		strategies *mockregistry.StrategyRegistry
+		store      pluginstore.PluginStore
+	)
+
+	sharedObject := func() *bytes.Reader {
+		return bytes.NewReader(append([]byte{0x7f, 'E', 'L', 'F'}, make([]byte, 60)...))
+	}
+
+	loadedStrategy := func() *mockstrategy.Strategy {
+		strat := mockstrategy.NewStrategy(GinkgoT())
+		strat.On("GetName").Return(strategy.StrategyName("momentum")).Maybe()
+		strat.On("GetDescription").Return("rides trends").Maybe()
+		strat.On("GetRiskLevel").Return(strategy.RiskLevel("medium")).Maybe()
+		strat.On("GetStrategyType").Return(strategy.StrategyType("trend")).Maybe()
+		return strat
+	}
+
+	BeforeEach(func() {
+		directory = GinkgoT().TempDir()
+		manager = mockplugin.NewManager(GinkgoT())
+		strategies = mockregistry.NewStrategyRegistry(GinkgoT())
+
+		tokens := mockapitokens.NewTokenStore(GinkgoT())
+		tokens.On("Admin", "admin").Return(true).Maybe()
+		tokens.On("Admin", mock.Anything).Return(false).Maybe()
+
+		clock := mocktemporal.NewTimeProvider(GinkgoT())
+		clock.On("Now").Return(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)).Maybe()
+
+		store = pluginstore.NewPluginStore(manager, strategies, tokens, clock, logger.NewNoOpLogger())
+		Expect(store.Configure(pluginstore.Config{Directory: directory, MaxSize: 1024})).To(Succeed())
+	})
+
+	It("saves and loads a strategy plugin with its metadata", func() {
+		path := filepath.Join(directory, "momentum.so")
+		manager.On("LoadStrategyPlugin", path).Return(loadedStrategy(), nil).Once()
+
+		uploaded, err := store.Upload("momentum.so", pluginstore.KindStrategy, sharedObject())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(uploaded.Path).To(Equal(path))
+		Expect(uploaded.Strategy).To(Equal("momentum"))
+		Expect(uploaded.RiskLevel).To(Equal("medium"))
+		Expect(uploaded.Digest).To(HaveLen(64))
+		Expect(uploaded.Size).To(Equal(int64(64)))
+		Expect(path).To(BeAnExistingFile())
+		Expect(store.Plugins()).To(HaveLen(1))
+	})
+
+	It("refuses names that would leave the plugin directory", func() {
+		for _, name := range []string{"../escape.so", "nested/plugin.so", ".hidden.so", "plugin.txt", ""} {
+			_, err := store.Upload(name, pluginstore.KindStrategy, sharedObject())
+			Expect(errors.Is(err, pluginstore.ErrInvalid)).To(BeTrue(), name)
+		}
+	})
+
+	It("refuses files that are not shared objects or are too large", func() {
+		_, err := store.Upload("script.so", pluginstore.KindStrategy, strings.NewReader("#!/bin/sh"))
+		Expect(errors.Is(err, pluginstore.ErrInvalid)).To(BeTrue())
+
+		_, err = store.Upload("large.so", pluginstore.KindStrategy, bytes.NewReader(append([]byte{0x7f, 'E', 'L', 'F'}, make([]byte, 2048)...)))
+		Expect(errors.Is(err, pluginstore.ErrInvalid)).To(BeTrue())
+
+		entries, err := os.ReadDir(directory)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(BeEmpty())
+	})
+
+	It("removes a plugin the manager cannot load", func() {
+		manager.On("LoadHookPlugin", filepath.Join(directory, "hooks.so")).Return(errors.New("missing symbol")).Once()
+
+		_, err := store.Upload("hooks.so", pluginstore.KindHook, sharedObject())
+		Expect(errors.Is(err, pluginstore.ErrRejected)).To(BeTrue())
+		Expect(filepath.Join(directory, "hooks.so")).NotTo(BeAnExistingFile())
+		Expect(store.Plugins()).To(BeEmpty())
+	})
+
+	It("refuses a second upload under the same name", func() {
+		manager.On("LoadStrategyPlugin", mock.Anything).Return(loadedStrategy(), nil).Once()
+
+		_, err := store.Upload("momentum.so", "", sharedObject())
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = store.Upload("momentum.so", "", sharedObject())
+		Expect(errors.Is(err, pluginstore.ErrExists)).To(BeTrue())
+	})
+
+	It("disables the strategy and removes the file on delete", func() {
+		manager.On("LoadStrategyPlugin", mock.Anything).Return(loadedStrategy(), nil).Once()
+		strategies.On("DisableStrategy", strategy.StrategyName("momentum")).Return(nil).Once()
+
+		_, err := store.Upload("momentum.so", pluginstore.KindStrategy, sharedObject())
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(store.Delete("momentum.so")).To(Succeed())
+		Expect(filepath.Join(directory, "momentum.so")).NotTo(BeAnExistingFile())
+		Expect(store.Plugins()).To(BeEmpty())
+		Expect(errors.Is(store.Delete("momentum.so"), pluginstore.ErrNotFound)).To(BeTrue())
+	})
+
+	Describe("Handler", func() {
+		upload := func(token string) *httptest.ResponseRecorder {
+			var body bytes.Buffer
+			form := multipart.NewWriter(&body)
+			part, err := form.CreateFormFile("plugin", "momentum.so")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = sharedObject().WriteTo(part)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(form.Close()).To(Succeed())
+
+			req := httptest.NewRequest(http.MethodPost, pluginstore.Path+"?kind=strategy", &body)
+			req.Header.Set("Content-Type", form.FormDataContentType())
+			req.Header.Set("Authorization", "Bearer "+token)
+
+			recorder := httptest.NewRecorder()
+			store.Handler().ServeHTTP(recorder, req)
+			return recorder
+		}
+
+		It("requires the admin token", func() {
+			Expect(upload("scoped").Code).To(Equal(http.StatusUnauthorized))
+		})
+
+		It("uploads a multipart plugin and lists it", func() {
+			manager.On("LoadStrategyPlugin", filepath.Join(directory, "momentum.so")).Return(loadedStrategy(), nil).Once()
+
+			recorder := upload("admin")
+			Expect(recorder.Code).To(Equal(http.StatusCreated))
+
+			var uploaded pluginstore.Plugin
+			Expect(json.Unmarshal(recorder.Body.Bytes(), &uploaded)).To(Succeed())
+			Expect(uploaded.Name).To(Equal("momentum.so"))
+			Expect(uploaded.Strategy).To(Equal("momentum"))
+
+			req := httptest.NewRequest(http.MethodGet, pluginstore.Path, nil)
+			req.Header.Set("Authorization", "Bearer admin")
+			listed := httptest.NewRecorder()
+			store.Handler().ServeHTTP(listed, req)
+
+			var plugins []pluginstore.Plugin
+			Expect(json.Unmarshal(listed.Body.Bytes(), &plugins)).To(Succeed())
+			Expect(plugins).To(HaveLen(1))
+
+			Expect(upload("admin").Code).To(Equal(http.StatusConflict))
+		})
+	})
+})
@@ -0,0 +1,29 @@
+// Package positionimport brings positions opened outside the process, e.g.
+// by hand, under a run's management
+package positionimport
+
+const (
+	// LogKind marks imports in the run log
+	LogKind = "position_import"
+
+	// EventKind marks an import in the run report's events
+	EventKind = "position_import"
+
+	// DefaultMaxImports bounds how many imports are kept for the history
+	DefaultMaxImports = 1000
+)
+
+// CostBasis is where an imported position's entry price comes from
+type CostBasis string
+
+const (
+	// CostBasisExchange takes the exchange's average entry price
+	CostBasisExchange CostBasis = "exchange"
+
+	// CostBasisManual takes the price given with the selection
+	CostBasisManual CostBasis = "manual"
+)
+
+func (c CostBasis) valid() bool {
+	return c == CostBasisExchange || c == CostBasisManual
+}
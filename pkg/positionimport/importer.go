@@ -0,0 +1,465 @@
+package positionimport
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/data/stores/activity"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/accounting"
+	"github.com/backtesting-org/live-trading/pkg/runlog"
+	"github.com/backtesting-org/live-trading/pkg/runreport"
+)
+
+// Candidate is a position held on an exchange, with how much of it the
+// ledger already books and how much is free to import
+type Candidate struct {
+	Exchange connector.ExchangeName
+	Symbol   string
+
+	// Size is the signed exchange position; Tracked is the signed ledger
+	// position on the same market
+	Size       numerical.Decimal
+	Tracked    numerical.Decimal
+	EntryPrice numerical.Decimal
+	MarkPrice  numerical.Decimal
+}
+
+// Selection picks one exchange position to import
+type Selection struct {
+	Exchange connector.ExchangeName
+	Symbol   string
+
+	// Size is the signed amount to import; zero takes the whole position
+	Size numerical.Decimal
+
+	CostBasis CostBasis
+
+	// Price is the entry price for CostBasisManual
+	Price numerical.Decimal
+}
+
+// Request imports positions into a run. The run may be active or not yet
+// begun; Strategy, when set, is credited with the positions in the
+// strategy's execution history as well.
+type Request struct {
+	RunID     string
+	Strategy  strategy.StrategyName
+	Positions []Selection
+	Actor     string
+}
+
+// Import records one imported position
+type Import struct {
+	ID       string
+	RunID    string
+	Strategy strategy.StrategyName
+	Exchange connector.ExchangeName
+	Symbol   string
+
+	Size      numerical.Decimal
+	CostBasis CostBasis
+	Price     numerical.Decimal
+
+	// Booked is the part of Size the ledger did not know about and booked
+	// at Price; the rest was already in the ledger at its own entry price
+	Booked numerical.Decimal
+
+	Actor string
+	At    time.Time
+}
+
+// PositionImporter migrates positions opened outside the process, such as
+// a manual position, under algorithmic management. An imported position
+// is booked into the ledger at the chosen cost basis and handed to the
+// run, so run positions, flattening and PnL attribution treat it as if the
+// run had opened it.
+type PositionImporter interface {
+	// Candidates lists the positions held on every ready trading exchange
+	Candidates() ([]Candidate, error)
+
+	// Import checks every selection before applying any of them
+	Import(request Request) ([]Import, error)
+
+	// Imports is the import history, one run's or all of them when runID
+	// is empty, oldest first
+	Imports(runID string) []Import
+
+	// Handler lists candidates and ?run='s imports on GET and imports a
+	// JSON Request on POST
+	Handler() http.Handler
+}
+
+// plan is a checked selection ready to apply
+type plan struct {
+	imported Import
+	side     connector.OrderSide
+}
+
+type positionImporter struct {
+	registry     registry.ConnectorRegistry
+	ledger       accounting.Ledger
+	reporter     runreport.RunReporter
+	positions    activity.Positions
+	runLog       runlog.RunLogger
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	imports  []Import
+	sequence int
+	mu       sync.Mutex
+}
+
+func NewPositionImporter(
+	connectorRegistry registry.ConnectorRegistry,
+	ledger accounting.Ledger,
+	reporter runreport.RunReporter,
+	positions activity.Positions,
+	runLog runlog.RunLogger,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) PositionImporter {
+	return &positionImporter{
+		registry:     connectorRegistry,
+		ledger:       ledger,
+		reporter:     reporter,
+		positions:    positions,
+		runLog:       runLog,
+		timeProvider: timeProvider,
+		logger:       logger,
+	}
+}
+
+func (p *positionImporter) Candidates() ([]Candidate, error) {
+	var candidates []Candidate
+	for _, conn := range p.registry.GetReadyConnectors() {
+		if !conn.SupportsTradingOperations() {
+			continue
+		}
+		exchange := conn.GetConnectorInfo().Name
+		held, err := p.exchangePositions(exchange)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, held...)
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].Exchange != candidates[j].Exchange {
+			return candidates[i].Exchange < candidates[j].Exchange
+		}
+		return candidates[i].Symbol < candidates[j].Symbol
+	})
+	return candidates, nil
+}
+
+func (p *positionImporter) Import(request Request) ([]Import, error) {
+	if request.RunID == "" {
+		return nil, fmt.Errorf("run ID is required")
+	}
+	if len(request.Positions) == 0 {
+		return nil, fmt.Errorf("no positions selected")
+	}
+
+	running := false
+	if active, ok := p.reporter.Active(); ok {
+		if active != request.RunID {
+			return nil, fmt.Errorf("run %s is active, not %s", active, request.RunID)
+		}
+		running = true
+	}
+
+	plans, err := p.plan(request, running)
+	if err != nil {
+		return nil, err
+	}
+
+	imported := make([]Import, 0, len(plans))
+	for _, pl := range plans {
+		if err := p.apply(pl, running); err != nil {
+			return imported, fmt.Errorf("failed to import %s on %s: %w", pl.imported.Symbol, pl.imported.Exchange, err)
+		}
+		imported = append(imported, pl.imported)
+	}
+	return imported, nil
+}
+
+// plan checks every selection against the exchange and the ledger. In an
+// active run, what the run opened itself cannot be imported again.
+func (p *positionImporter) plan(request Request, running bool) ([]plan, error) {
+	owned := make(map[string]numerical.Decimal)
+	if running {
+		positions, err := p.reporter.Positions(request.RunID)
+		if err != nil {
+			return nil, err
+		}
+		for _, position := range positions {
+			owned[marketKey(position.Exchange, position.Symbol)] = position.Size
+		}
+	}
+
+	held := make(map[connector.ExchangeName][]Candidate)
+	selected := make(map[string]bool)
+	now := p.timeProvider.Now()
+
+	plans := make([]plan, 0, len(request.Positions))
+	for _, selection := range request.Positions {
+		key := marketKey(selection.Exchange, selection.Symbol)
+		if selected[key] {
+			return nil, fmt.Errorf("%s on %s is selected more than once", selection.Symbol, selection.Exchange)
+		}
+		selected[key] = true
+
+		if !selection.CostBasis.valid() {
+			return nil, fmt.Errorf("unknown cost basis %q for %s", selection.CostBasis, selection.Symbol)
+		}
+
+		candidates, ok := held[selection.Exchange]
+		if !ok {
+			var err error
+			candidates, err = p.exchangePositions(selection.Exchange)
+			if err != nil {
+				return nil, err
+			}
+			held[selection.Exchange] = candidates
+		}
+
+		candidate, ok := find(candidates, selection.Symbol)
+		if !ok {
+			return nil, fmt.Errorf("no %s position on %s", selection.Symbol, selection.Exchange)
+		}
+
+		importable := candidate.Size
+		if size, ok := owned[key]; ok {
+			importable = importable.Sub(size)
+		}
+
+		size := selection.Size
+		if size.IsZero() {
+			size = importable
+		}
+		if size.IsZero() || size.IsNegative() != importable.IsNegative() || size.Abs().GreaterThan(importable.Abs()) {
+			return nil, fmt.Errorf("cannot import %s %s on %s: %s is held outside the run", size, selection.Symbol, selection.Exchange, importable)
+		}
+
+		price := candidate.EntryPrice
+		if selection.CostBasis == CostBasisManual {
+			price = selection.Price
+		}
+		if !price.IsPositive() {
+			return nil, fmt.Errorf("no %s cost basis for %s on %s", selection.CostBasis, selection.Symbol, selection.Exchange)
+		}
+
+		// Only what the ledger does not already book is booked at the
+		// chosen cost basis
+		booked := numerical.Zero()
+		if untracked := candidate.Size.Sub(candidate.Tracked); !untracked.IsZero() && untracked.IsNegative() == size.IsNegative() {
+			booked = size
+			if untracked.Abs().LessThan(size.Abs()) {
+				booked = untracked
+			}
+		}
+
+		side := connector.OrderSideBuy
+		if size.IsNegative() {
+			side = connector.OrderSideSell
+		}
+
+		p.mu.Lock()
+		p.sequence++
+		id := fmt.Sprintf("import-%d-%d", now.UnixMilli(), p.sequence)
+		p.mu.Unlock()
+
+		plans = append(plans, plan{
+			imported: Import{
+				ID:        id,
+				RunID:     request.RunID,
+				Strategy:  request.Strategy,
+				Exchange:  selection.Exchange,
+				Symbol:    selection.Symbol,
+				Size:      size,
+				CostBasis: selection.CostBasis,
+				Price:     price,
+				Booked:    booked,
+				Actor:     request.Actor,
+				At:        now,
+			},
+			side: side,
+		})
+	}
+	return plans, nil
+}
+
+// apply books one checked import. What is booked into the ledger during an
+// active run is the run's already; everything else was held before the
+// run and is handed over.
+func (p *positionImporter) apply(pl plan, running bool) error {
+	imported := pl.imported
+
+	if !imported.Booked.IsZero() {
+		if err := p.ledger.Adopt(accounting.Fill{
+			Exchange:  imported.Exchange,
+			OrderID:   imported.ID,
+			Symbol:    imported.Symbol,
+			Side:      pl.side,
+			Quantity:  imported.Booked.Abs(),
+			Price:     imported.Price,
+			Timestamp: imported.At,
+		}); err != nil {
+			return err
+		}
+	}
+
+	handed := imported.Size
+	if running {
+		handed = handed.Sub(imported.Booked)
+	}
+	if err := p.reporter.Adopt(imported.RunID, imported.Exchange, imported.Symbol, handed); err != nil {
+		return err
+	}
+
+	if imported.Strategy != "" {
+		p.positions.AddTradeToStrategy(imported.Strategy, connector.Trade{
+			ID:        imported.ID,
+			Symbol:    imported.Symbol,
+			Exchange:  imported.Exchange,
+			Price:     imported.Price,
+			Quantity:  imported.Size.Abs(),
+			Side:      pl.side,
+			Timestamp: imported.At,
+		})
+	}
+
+	p.mu.Lock()
+	p.imports = append(p.imports, imported)
+	if len(p.imports) > DefaultMaxImports {
+		p.imports = p.imports[len(p.imports)-DefaultMaxImports:]
+	}
+	p.mu.Unlock()
+
+	message := fmt.Sprintf("Imported %s %s on %s into run %s at %s (%s cost basis)",
+		imported.Size, imported.Symbol, imported.Exchange, imported.RunID, imported.Price, imported.CostBasis)
+	if imported.Actor != "" {
+		message += " by " + imported.Actor
+	}
+	if running {
+		p.reporter.Event(EventKind, message)
+	}
+	p.runLog.Record(runlog.LevelInfo, LogKind, runlog.Fields{
+		Asset:    imported.Symbol,
+		Exchange: string(imported.Exchange),
+		OrderID:  imported.ID,
+	}, "%s", message)
+	p.logger.Info("📥 %s", message)
+	return nil
+}
+
+func (p *positionImporter) Imports(runID string) []Import {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	imports := make([]Import, 0, len(p.imports))
+	for _, imported := range p.imports {
+		if runID == "" || imported.RunID == runID {
+			imports = append(imports, imported)
+		}
+	}
+	return imports
+}
+
+// exchangePositions reads an exchange's positions alongside the ledger's
+func (p *positionImporter) exchangePositions(exchange connector.ExchangeName) ([]Candidate, error) {
+	conn, ok := p.registry.GetConnector(exchange)
+	if !ok {
+		return nil, fmt.Errorf("connector %s not registered", exchange)
+	}
+
+	positions, err := conn.GetPositions()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch positions on %s: %w", exchange, err)
+	}
+
+	candidates := make([]Candidate, 0, len(positions))
+	for _, position := range positions {
+		size := position.Size.Abs()
+		if position.Side == connector.OrderSideSell {
+			size = size.Neg()
+		}
+		if size.IsZero() {
+			continue
+		}
+
+		symbol := position.Symbol.Symbol()
+		tracked := numerical.Zero()
+		if summary, ok := p.ledger.Summary(exchange, symbol); ok {
+			tracked = summary.Position
+		}
+		candidates = append(candidates, Candidate{
+			Exchange:   exchange,
+			Symbol:     symbol,
+			Size:       size,
+			Tracked:    tracked,
+			EntryPrice: position.EntryPrice,
+			MarkPrice:  position.MarkPrice,
+		})
+	}
+	return candidates, nil
+}
+
+func (p *positionImporter) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case http.MethodGet:
+			candidates, err := p.Candidates()
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadGateway)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(struct {
+				Candidates []Candidate
+				Imports    []Import
+			}{candidates, p.Imports(req.URL.Query().Get("run"))})
+		case http.MethodPost:
+			var request Request
+			if err := json.NewDecoder(req.Body).Decode(&request); err != nil {
+				http.Error(w, fmt.Sprintf("invalid import request: %v", err), http.StatusBadRequest)
+				return
+			}
+			imported, err := p.Import(request)
+			if err != nil && len(imported) == 0 {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			if err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+			_ = json.NewEncoder(w).Encode(imported)
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+func find(candidates []Candidate, symbol string) (Candidate, bool) {
+	for _, candidate := range candidates {
+		if candidate.Symbol == symbol {
+			return candidate, true
+		}
+	}
+	return Candidate{}, false
+}
+
+func marketKey(exchange connector.ExchangeName, symbol string) string {
+	return fmt.Sprintf("%s:%s", exchange, symbol)
+}
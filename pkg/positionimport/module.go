@@ -0,0 +1,7 @@
+package positionimport
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(NewPositionImporter),
+)
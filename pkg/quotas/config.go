@@ -0,0 +1,48 @@
+package quotas
+
+import "time"
+
+const (
+	DefaultInterval            = 10 * time.Second
+	DefaultMaxLatency          = 5 * time.Second
+	DefaultMaxGoroutines       = 10000
+	DefaultMaxAllocRate        = 512 << 20 // bytes per second
+	DefaultConsecutiveBreaches = 3
+
+	// JobName is the scheduler job that samples usage
+	JobName = "strategy-quotas"
+)
+
+// Config sets the quotas. A zero MaxLatency, MaxGoroutines or
+// MaxAllocRate switches that quota off.
+type Config struct {
+	Interval time.Duration
+
+	// MaxLatency bounds one GetSignals call, per strategy
+	MaxLatency time.Duration
+
+	// MaxGoroutines and MaxAllocRate are process-wide: Go cannot attribute
+	// goroutines or allocations to the strategy that caused them
+	MaxGoroutines int
+	MaxAllocRate  uint64
+
+	// ConsecutiveBreaches is how many breaches in a row trigger action, so
+	// one slow tick does not suspend a strategy
+	ConsecutiveBreaches int
+
+	// Enforce suspends strategies over their latency quota; when false
+	// violations are only reported
+	Enforce bool
+}
+
+// DefaultConfig enforces every quota
+func DefaultConfig() Config {
+	return Config{
+		Interval:            DefaultInterval,
+		MaxLatency:          DefaultMaxLatency,
+		MaxGoroutines:       DefaultMaxGoroutines,
+		MaxAllocRate:        DefaultMaxAllocRate,
+		ConsecutiveBreaches: DefaultConsecutiveBreaches,
+		Enforce:             true,
+	}
+}
@@ -0,0 +1,7 @@
+package quotas
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(NewQuotaMonitor),
+)
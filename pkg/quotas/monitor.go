@@ -0,0 +1,339 @@
+package quotas
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/profiling"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/scheduler"
+)
+
+// Resource is what a quota limits
+type Resource string
+
+const (
+	ResourceLatency    Resource = "signal_latency"
+	ResourceGoroutines Resource = "goroutines"
+	ResourceAllocRate  Resource = "alloc_rate"
+)
+
+// Violation is a quota breached for ConsecutiveBreaches samples in a row.
+// Strategy is empty for process-wide quotas.
+type Violation struct {
+	Strategy  strategy.StrategyName
+	Resource  Resource
+	Value     float64
+	Limit     float64
+	Suspended bool
+	At        time.Time
+}
+
+// Usage is the latest sample for the process and each strategy
+type Usage struct {
+	Goroutines int
+	AllocRate  float64
+	Latency    map[strategy.StrategyName]time.Duration
+	SampledAt  time.Time
+}
+
+// QuotaMonitor samples resource usage and suspends strategies that stay
+// over their quota. Latency comes from the SDK profiling store, which the
+// orchestrator fills on every GetSignals call.
+type QuotaMonitor interface {
+	Configure(config Config)
+
+	Start() error
+	Stop() error
+
+	// Check takes one sample and applies the quotas
+	Check() []Violation
+
+	// Resume re-enables a suspended strategy and clears its breach count
+	Resume(name strategy.StrategyName) error
+	Suspended() []strategy.StrategyName
+
+	Usage() Usage
+	Violations() <-chan Violation
+}
+
+type quotaMonitor struct {
+	strategies   registry.StrategyRegistry
+	profiling    profiling.ProfilingStore
+	scheduler    scheduler.Scheduler
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config      Config
+	usage       Usage
+	lastAlloc   uint64
+	lastSample  time.Time
+	lastSeen    map[strategy.StrategyName]time.Time
+	breaches    map[string]int
+	suspended   map[strategy.StrategyName]bool
+	violationCh chan Violation
+	mu          sync.Mutex
+}
+
+func NewQuotaMonitor(
+	strategyRegistry registry.StrategyRegistry,
+	profilingStore profiling.ProfilingStore,
+	jobScheduler scheduler.Scheduler,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) QuotaMonitor {
+	return &quotaMonitor{
+		strategies:   strategyRegistry,
+		profiling:    profilingStore,
+		scheduler:    jobScheduler,
+		timeProvider: timeProvider,
+		logger:       logger,
+		config:       DefaultConfig(),
+		lastSeen:     make(map[strategy.StrategyName]time.Time),
+		breaches:     make(map[string]int),
+		suspended:    make(map[strategy.StrategyName]bool),
+		violationCh:  make(chan Violation, 100),
+	}
+}
+
+func (q *quotaMonitor) Configure(config Config) {
+	if config.Interval <= 0 {
+		config.Interval = DefaultInterval
+	}
+	if config.ConsecutiveBreaches <= 0 {
+		config.ConsecutiveBreaches = DefaultConsecutiveBreaches
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.config = config
+}
+
+func (q *quotaMonitor) Violations() <-chan Violation {
+	return q.violationCh
+}
+
+func (q *quotaMonitor) Start() error {
+	q.mu.Lock()
+	interval := q.config.Interval
+	q.mu.Unlock()
+
+	if q.profiling == nil {
+		q.logger.Warn("Profiling is disabled; strategy latency quotas will not be enforced")
+	}
+
+	return q.scheduler.Register(scheduler.Job{
+		Name:     JobName,
+		Interval: interval,
+		Run: func(_ context.Context) error {
+			q.Check()
+			return nil
+		},
+	})
+}
+
+func (q *quotaMonitor) Stop() error {
+	return q.scheduler.Unregister(JobName)
+}
+
+func (q *quotaMonitor) Check() []Violation {
+	q.mu.Lock()
+	config := q.config
+	q.mu.Unlock()
+
+	now := q.timeProvider.Now()
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+
+	usage := Usage{
+		Goroutines: runtime.NumGoroutine(),
+		Latency:    make(map[strategy.StrategyName]time.Duration),
+		SampledAt:  now,
+	}
+
+	var violations []Violation
+
+	q.mu.Lock()
+	if !q.lastSample.IsZero() {
+		if elapsed := now.Sub(q.lastSample).Seconds(); elapsed > 0 {
+			usage.AllocRate = float64(memStats.TotalAlloc-q.lastAlloc) / elapsed
+		}
+	}
+	q.lastAlloc = memStats.TotalAlloc
+	q.lastSample = now
+
+	if config.MaxGoroutines > 0 {
+		if v, ok := q.breach("", ResourceGoroutines, float64(usage.Goroutines), float64(config.MaxGoroutines), config, now); ok {
+			violations = append(violations, v)
+		}
+	}
+	if config.MaxAllocRate > 0 {
+		if v, ok := q.breach("", ResourceAllocRate, usage.AllocRate, float64(config.MaxAllocRate), config, now); ok {
+			violations = append(violations, v)
+		}
+	}
+	q.mu.Unlock()
+
+	if q.profiling != nil && config.MaxLatency > 0 {
+		for _, strat := range q.strategies.GetEnabledStrategies() {
+			name := strat.GetName()
+			executions := q.newExecutions(name)
+			if len(executions) == 0 {
+				continue
+			}
+
+			q.mu.Lock()
+			for _, execution := range executions {
+				usage.Latency[name] = execution.ExecutionTime
+				if v, ok := q.breach(name, ResourceLatency,
+					execution.ExecutionTime.Seconds(), config.MaxLatency.Seconds(), config, now); ok {
+					violations = append(violations, v)
+					break
+				}
+			}
+			q.mu.Unlock()
+		}
+	}
+
+	q.mu.Lock()
+	q.usage = usage
+	q.mu.Unlock()
+
+	for i := range violations {
+		if violations[i].Strategy != "" && config.Enforce {
+			violations[i].Suspended = q.suspend(violations[i])
+		}
+		q.publish(violations[i])
+	}
+	return violations
+}
+
+// newExecutions returns executions recorded since the last check, oldest first
+func (q *quotaMonitor) newExecutions(name strategy.StrategyName) []profiling.StrategyMetrics {
+	q.mu.Lock()
+	since := q.lastSeen[name]
+	window := q.config.ConsecutiveBreaches * 10
+	q.mu.Unlock()
+
+	var executions []profiling.StrategyMetrics
+	for _, m := range q.profiling.GetRecentMetrics(string(name), window) {
+		if m.Timestamp.After(since) {
+			executions = append(executions, m)
+		}
+	}
+	sort.Slice(executions, func(i, j int) bool { return executions[i].Timestamp.Before(executions[j].Timestamp) })
+
+	if n := len(executions); n > 0 {
+		q.mu.Lock()
+		q.lastSeen[name] = executions[n-1].Timestamp
+		q.mu.Unlock()
+	}
+	return executions
+}
+
+// breach counts a sample against its quota and reports a violation once
+// the quota has been exceeded ConsecutiveBreaches times in a row. Callers
+// hold q.mu.
+func (q *quotaMonitor) breach(name strategy.StrategyName, resource Resource, value, limit float64, config Config, now time.Time) (Violation, bool) {
+	key := string(resource) + "/" + string(name)
+	if value <= limit {
+		delete(q.breaches, key)
+		return Violation{}, false
+	}
+
+	q.breaches[key]++
+	if q.breaches[key] < config.ConsecutiveBreaches {
+		return Violation{}, false
+	}
+	delete(q.breaches, key)
+
+	return Violation{Strategy: name, Resource: resource, Value: value, Limit: limit, At: now}, true
+}
+
+func (q *quotaMonitor) suspend(violation Violation) bool {
+	q.mu.Lock()
+	if q.suspended[violation.Strategy] {
+		q.mu.Unlock()
+		return true
+	}
+	q.mu.Unlock()
+
+	if err := q.strategies.DisableStrategy(violation.Strategy); err != nil {
+		q.logger.Error("Failed to suspend strategy %s over quota: %v", violation.Strategy, err)
+		return false
+	}
+
+	q.mu.Lock()
+	q.suspended[violation.Strategy] = true
+	q.mu.Unlock()
+
+	q.logger.Error("🛑 Strategy %s suspended: %s %.2f exceeds quota %.2f",
+		violation.Strategy, violation.Resource, violation.Value, violation.Limit)
+	return true
+}
+
+func (q *quotaMonitor) publish(violation Violation) {
+	if violation.Strategy == "" {
+		q.logger.Warn("⚠️ Process %s %.0f exceeds quota %.0f", violation.Resource, violation.Value, violation.Limit)
+	}
+
+	select {
+	case q.violationCh <- violation:
+	default:
+		q.logger.Warn("Quota violation channel full, dropping %s violation", violation.Resource)
+	}
+}
+
+func (q *quotaMonitor) Resume(name strategy.StrategyName) error {
+	q.mu.Lock()
+	if !q.suspended[name] {
+		q.mu.Unlock()
+		return fmt.Errorf("strategy %s is not suspended", name)
+	}
+	q.mu.Unlock()
+
+	if err := q.strategies.EnableStrategy(name); err != nil {
+		return fmt.Errorf("failed to resume strategy %s: %w", name, err)
+	}
+
+	q.mu.Lock()
+	delete(q.suspended, name)
+	delete(q.breaches, string(ResourceLatency)+"/"+string(name))
+	// Slow executions from before the suspension must not count again
+	q.lastSeen[name] = q.timeProvider.Now()
+	q.mu.Unlock()
+
+	q.logger.Info("Strategy %s resumed", name)
+	return nil
+}
+
+func (q *quotaMonitor) Suspended() []strategy.StrategyName {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	names := make([]strategy.StrategyName, 0, len(q.suspended))
+	for name := range q.suspended {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+	return names
+}
+
+func (q *quotaMonitor) Usage() Usage {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	usage := q.usage
+	usage.Latency = make(map[strategy.StrategyName]time.Duration, len(q.usage.Latency))
+	for name, latency := range q.usage.Latency {
+		usage.Latency[name] = latency
+	}
+	return usage
+}
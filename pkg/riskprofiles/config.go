@@ -0,0 +1,36 @@
+// Package riskprofiles keeps the risk limits each plugin or run trades
+// under and enforces them against the run's own positions
+package riskprofiles
+
+import (
+	"fmt"
+)
+
+// LogKind is the run log kind refused signals are recorded under
+const LogKind = "risk"
+
+// Config controls where profiles are kept and what runs without one get
+type Config struct {
+	// Path holds every saved profile as JSON, rewritten on each change and
+	// read on Configure, so profiles survive a restart; empty keeps them in
+	// memory only
+	Path string
+
+	// Default applies to runs with no profile of their own or of their
+	// plugin; its key is ignored. The zero profile sets no limits.
+	Default Profile
+}
+
+// DefaultConfig keeps profiles in memory and leaves runs without one
+// unlimited
+func DefaultConfig() Config {
+	return Config{}
+}
+
+func (c *Config) applyDefaults() error {
+	if err := c.Default.validate(); err != nil {
+		return fmt.Errorf("default profile: %w", err)
+	}
+	c.Default.Key = ""
+	return nil
+}
@@ -0,0 +1,7 @@
+package riskprofiles
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(NewRiskProfiles),
+)
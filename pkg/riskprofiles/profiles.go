@@ -0,0 +1,648 @@
+package riskprofiles
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/execution"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/markprice"
+	"github.com/backtesting-org/live-trading/pkg/connectors/symbols"
+	"github.com/backtesting-org/live-trading/pkg/margin"
+	"github.com/backtesting-org/live-trading/pkg/runlog"
+	"github.com/backtesting-org/live-trading/pkg/runreport"
+)
+
+// ErrRiskLimitExceeded is returned for a signal refused because an order
+// would take its run past a limit of the run's risk profile
+var ErrRiskLimitExceeded = errors.New("order exceeds run risk limits")
+
+// Profile is the limits one plugin or run trades under. Zero leaves a limit
+// unset. Sizes are notional in the quote currency at the mark price.
+type Profile struct {
+	// Key is the run ID or plugin ID the profile belongs to
+	Key string
+
+	// MaxPositionSize caps the run's position on any one market
+	MaxPositionSize numerical.Decimal
+
+	// MaxExposure caps the run's open positions across every market
+	MaxExposure numerical.Decimal
+
+	// MaxLeverage is handed to the margin calculator as the run's account
+	// leverage limit
+	MaxLeverage numerical.Decimal
+
+	// MaxConcurrentTrades caps how many markets the run holds a position on
+	MaxConcurrentTrades int
+
+	UpdatedAt time.Time
+}
+
+func (p Profile) validate() error {
+	if p.MaxPositionSize.IsNegative() {
+		return fmt.Errorf("max position size must not be negative")
+	}
+	if p.MaxExposure.IsNegative() {
+		return fmt.Errorf("max exposure must not be negative")
+	}
+	if p.MaxLeverage.IsNegative() {
+		return fmt.Errorf("max leverage must not be negative")
+	}
+	if p.MaxConcurrentTrades < 0 {
+		return fmt.Errorf("max concurrent trades must not be negative")
+	}
+	return nil
+}
+
+// Source is where a run's profile was found
+type Source string
+
+const (
+	SourceRun     Source = "run"
+	SourcePlugin  Source = "plugin"
+	SourceDefault Source = "default"
+)
+
+// Assignment is the profile a run was started under
+type Assignment struct {
+	RunID      string
+	PluginID   string
+	Strategies []strategy.StrategyName
+	Source     Source
+	Profile    Profile
+}
+
+// RiskProfiles stores a risk profile per run or plugin and sits in front of
+// the executor, refusing any signal whose orders would take its run past
+// the profile's position size, exposure or concurrent trade limits. A run
+// is checked against its own positions, as tallied by the run reporter,
+// rather than the whole account's, and its leverage limit is enforced by
+// the margin calculator. Orders that only shrink a position are never
+// limited, and a run whose positions cannot be read is let through with a
+// warning rather than risk blocking an exit.
+type RiskProfiles interface {
+	Configure(config Config) error
+
+	// SaveProfile stores a profile under its key, replacing any before it,
+	// and reapplies it to runs already started under that key
+	SaveProfile(profile Profile) (Profile, error)
+	DeleteProfile(key string) error
+	Profile(key string) (Profile, bool)
+	Profiles() []Profile
+
+	// Apply is called when a run starts: it loads the run's profile, or
+	// else its plugin's, or else the configured default, binds the run's
+	// strategies to it and hands its leverage limit to the margin calculator
+	Apply(runID, pluginID string, strategies ...strategy.StrategyName) (Assignment, error)
+
+	// Release is called when a run ends and unbinds its strategies
+	Release(runID string) error
+
+	Assignment(runID string) (Assignment, bool)
+	Assignments() []Assignment
+
+	// Check returns ErrRiskLimitExceeded, wrapped, when the signal should
+	// not execute
+	Check(signal *strategy.Signal) error
+
+	// Wrap returns an executor that checks risk limits before inner
+	Wrap(inner execution.Executor) execution.Executor
+
+	// Handler serves profiles and assignments on GET, saves the posted
+	// profile on POST and deletes ?key= on DELETE
+	Handler() http.Handler
+}
+
+type riskProfiles struct {
+	registry     registry.ConnectorRegistry
+	marks        markprice.MarkPriceFeed
+	symbols      symbols.SymbolMapper
+	reporter     runreport.RunReporter
+	calculator   margin.MarginCalculator
+	runLog       runlog.RunLogger
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config      Config
+	profiles    map[string]Profile
+	assignments map[string]Assignment
+	runs        map[strategy.StrategyName]string
+	mu          sync.Mutex
+}
+
+// market is one of a run's positions while a signal is checked, updated as
+// its actions are so later actions see the exposure earlier ones would add
+type market struct {
+	size  numerical.Decimal
+	price numerical.Decimal
+}
+
+type marketKey struct {
+	exchange connector.ExchangeName
+	asset    string
+}
+
+func NewRiskProfiles(
+	connectorRegistry registry.ConnectorRegistry,
+	markPriceFeed markprice.MarkPriceFeed,
+	symbolMapper symbols.SymbolMapper,
+	reporter runreport.RunReporter,
+	calculator margin.MarginCalculator,
+	runLog runlog.RunLogger,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) RiskProfiles {
+	return &riskProfiles{
+		registry:     connectorRegistry,
+		marks:        markPriceFeed,
+		symbols:      symbolMapper,
+		reporter:     reporter,
+		calculator:   calculator,
+		runLog:       runLog,
+		timeProvider: timeProvider,
+		logger:       logger,
+		config:       DefaultConfig(),
+		profiles:     make(map[string]Profile),
+		assignments:  make(map[string]Assignment),
+		runs:         make(map[strategy.StrategyName]string),
+	}
+}
+
+func (r *riskProfiles) Configure(config Config) error {
+	if err := config.applyDefaults(); err != nil {
+		return err
+	}
+
+	profiles := make(map[string]Profile)
+	if config.Path != "" {
+		loaded, err := loadProfiles(config.Path)
+		if err != nil {
+			return err
+		}
+		profiles = loaded
+	}
+
+	r.mu.Lock()
+	r.config = config
+	r.profiles = profiles
+	runIDs := r.assignedLocked()
+	r.mu.Unlock()
+
+	return r.reapply(runIDs)
+}
+
+func (r *riskProfiles) Wrap(inner execution.Executor) execution.Executor {
+	return &checkedExecutor{profiles: r, inner: inner}
+}
+
+func (r *riskProfiles) SaveProfile(profile Profile) (Profile, error) {
+	if profile.Key == "" {
+		return Profile{}, fmt.Errorf("profile key is required")
+	}
+	if err := profile.validate(); err != nil {
+		return Profile{}, err
+	}
+	profile.UpdatedAt = r.timeProvider.Now()
+
+	r.mu.Lock()
+	previous, existed := r.profiles[profile.Key]
+	r.profiles[profile.Key] = profile
+	if err := r.persistLocked(); err != nil {
+		if existed {
+			r.profiles[profile.Key] = previous
+		} else {
+			delete(r.profiles, profile.Key)
+		}
+		r.mu.Unlock()
+		return Profile{}, err
+	}
+	runIDs := r.assignedLocked()
+	r.mu.Unlock()
+
+	r.logger.Info("🛡️ Risk profile %s saved", profile.Key)
+	return profile, r.reapply(runIDs)
+}
+
+func (r *riskProfiles) DeleteProfile(key string) error {
+	r.mu.Lock()
+	previous, ok := r.profiles[key]
+	if !ok {
+		r.mu.Unlock()
+		return fmt.Errorf("risk profile %s not found", key)
+	}
+	delete(r.profiles, key)
+	if err := r.persistLocked(); err != nil {
+		r.profiles[key] = previous
+		r.mu.Unlock()
+		return err
+	}
+	runIDs := r.assignedLocked()
+	r.mu.Unlock()
+
+	r.logger.Info("🛡️ Risk profile %s deleted", key)
+	return r.reapply(runIDs)
+}
+
+func (r *riskProfiles) Profile(key string) (Profile, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	profile, ok := r.profiles[key]
+	return profile, ok
+}
+
+func (r *riskProfiles) Profiles() []Profile {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	profiles := make([]Profile, 0, len(r.profiles))
+	for _, profile := range r.profiles {
+		profiles = append(profiles, profile)
+	}
+	sort.Slice(profiles, func(i, j int) bool { return profiles[i].Key < profiles[j].Key })
+	return profiles
+}
+
+func (r *riskProfiles) Apply(runID, pluginID string, strategies ...strategy.StrategyName) (Assignment, error) {
+	if runID == "" {
+		return Assignment{}, fmt.Errorf("run ID is required")
+	}
+	for _, name := range strategies {
+		if name == "" {
+			return Assignment{}, fmt.Errorf("strategy name is required")
+		}
+	}
+
+	r.mu.Lock()
+	assignment := Assignment{
+		RunID:      runID,
+		PluginID:   pluginID,
+		Strategies: append([]strategy.StrategyName(nil), strategies...),
+	}
+	assignment.Profile, assignment.Source = r.resolveLocked(runID, pluginID)
+	r.assignments[runID] = assignment
+	for _, name := range strategies {
+		r.runs[name] = runID
+	}
+	r.mu.Unlock()
+
+	if err := r.bindMargin(assignment); err != nil {
+		return assignment, err
+	}
+
+	r.logger.Info("🛡️ Run %s trades under the %s risk profile", runID, assignment.Source)
+	return assignment, nil
+}
+
+func (r *riskProfiles) Release(runID string) error {
+	r.mu.Lock()
+	assignment, ok := r.assignments[runID]
+	if !ok {
+		r.mu.Unlock()
+		return nil
+	}
+	delete(r.assignments, runID)
+	var unbind []strategy.StrategyName
+	for name, bound := range r.runs {
+		if bound == runID {
+			delete(r.runs, name)
+			unbind = append(unbind, name)
+		}
+	}
+	r.mu.Unlock()
+
+	for _, name := range unbind {
+		if current, ok := r.calculator.RunFor(name); ok && current == runID {
+			if err := r.calculator.BindStrategy("", name); err != nil {
+				return fmt.Errorf("failed to unbind %s from run %s: %w", name, assignment.RunID, err)
+			}
+		}
+	}
+	if err := r.calculator.SetMaxLeverage(runID, numerical.Zero()); err != nil {
+		return fmt.Errorf("failed to clear run %s leverage limit: %w", runID, err)
+	}
+	return nil
+}
+
+func (r *riskProfiles) Assignment(runID string) (Assignment, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	assignment, ok := r.assignments[runID]
+	return assignment, ok
+}
+
+func (r *riskProfiles) Assignments() []Assignment {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	assignments := make([]Assignment, 0, len(r.assignments))
+	for _, assignment := range r.assignments {
+		assignments = append(assignments, assignment)
+	}
+	sort.Slice(assignments, func(i, j int) bool { return assignments[i].RunID < assignments[j].RunID })
+	return assignments
+}
+
+// resolveLocked picks the run's own profile, then its plugin's, then the
+// default
+func (r *riskProfiles) resolveLocked(runID, pluginID string) (Profile, Source) {
+	if profile, ok := r.profiles[runID]; ok {
+		return profile, SourceRun
+	}
+	if pluginID != "" {
+		if profile, ok := r.profiles[pluginID]; ok {
+			return profile, SourcePlugin
+		}
+	}
+	return r.config.Default, SourceDefault
+}
+
+func (r *riskProfiles) assignedLocked() []string {
+	runIDs := make([]string, 0, len(r.assignments))
+	for runID := range r.assignments {
+		runIDs = append(runIDs, runID)
+	}
+	return runIDs
+}
+
+// reapply resolves started runs' profiles again after profiles changed
+func (r *riskProfiles) reapply(runIDs []string) error {
+	for _, runID := range runIDs {
+		r.mu.Lock()
+		assignment, ok := r.assignments[runID]
+		if ok {
+			assignment.Profile, assignment.Source = r.resolveLocked(runID, assignment.PluginID)
+			r.assignments[runID] = assignment
+		}
+		r.mu.Unlock()
+
+		if ok {
+			if err := r.bindMargin(assignment); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// bindMargin hands the run's leverage limit to the margin calculator; an
+// unset limit returns the run to the calculator's default
+func (r *riskProfiles) bindMargin(assignment Assignment) error {
+	for _, name := range assignment.Strategies {
+		if err := r.calculator.BindStrategy(assignment.RunID, name); err != nil {
+			return fmt.Errorf("failed to bind %s to run %s: %w", name, assignment.RunID, err)
+		}
+	}
+	if err := r.calculator.SetMaxLeverage(assignment.RunID, assignment.Profile.MaxLeverage); err != nil {
+		return fmt.Errorf("failed to set run %s leverage limit: %w", assignment.RunID, err)
+	}
+	return nil
+}
+
+func (r *riskProfiles) persistLocked() error {
+	if r.config.Path == "" {
+		return nil
+	}
+	return saveProfiles(r.config.Path, r.profiles)
+}
+
+func (r *riskProfiles) Check(signal *strategy.Signal) error {
+	if signal == nil {
+		return fmt.Errorf("signal is nil")
+	}
+
+	r.mu.Lock()
+	runID, bound := r.runs[signal.Strategy]
+	assignment := r.assignments[runID]
+	r.mu.Unlock()
+
+	profile := assignment.Profile
+	if !bound || (!profile.MaxPositionSize.IsPositive() && !profile.MaxExposure.IsPositive() && profile.MaxConcurrentTrades == 0) {
+		return nil
+	}
+
+	positions, err := r.reporter.Positions(runID)
+	if err != nil {
+		r.logger.Warn("🛡️ Signal %s from %s sent without risk limits: %v", signal.ID, signal.Strategy, err)
+		return nil
+	}
+
+	book := make(map[marketKey]*market)
+	for _, position := range positions {
+		asset, _, err := r.symbols.FromNative(position.Exchange, position.Symbol)
+		if err != nil {
+			asset = portfolio.NewAsset(position.Symbol)
+		}
+		key := marketKey{position.Exchange, asset.Symbol()}
+		held, ok := book[key]
+		if !ok {
+			held = &market{size: numerical.Zero(), price: r.price(position.Exchange, asset, numerical.Zero())}
+			book[key] = held
+		}
+		held.size = held.size.Add(position.Size)
+	}
+
+	for _, action := range signal.Actions {
+		if !limited(action) {
+			continue
+		}
+
+		key := marketKey{action.Exchange, action.Asset.Symbol()}
+		held, ok := book[key]
+		if !ok {
+			held = &market{size: numerical.Zero(), price: numerical.Zero()}
+			book[key] = held
+		}
+		if !held.price.IsPositive() {
+			held.price = r.price(action.Exchange, action.Asset, action.Price)
+		}
+
+		post := held.size.Add(delta(action, held.size))
+		if !post.Abs().GreaterThan(held.size.Abs()) {
+			held.size = post
+			continue
+		}
+
+		if reason := exceeded(profile, book, held, post); reason != "" {
+			r.record(runID, signal, action, reason)
+			return fmt.Errorf("signal %s from %s on %s %s: %s: %w",
+				signal.ID, signal.Strategy, action.Exchange, key.asset, reason, ErrRiskLimitExceeded)
+		}
+		held.size = post
+	}
+	return nil
+}
+
+// exceeded names the first limit taking held to post would break, empty
+// when none is; markets without a price count towards the open position
+// limit but not towards notional
+func exceeded(profile Profile, book map[marketKey]*market, held *market, post numerical.Decimal) string {
+	if profile.MaxConcurrentTrades > 0 && held.size.IsZero() {
+		open := 0
+		for _, other := range book {
+			if !other.size.IsZero() {
+				open++
+			}
+		}
+		if open >= profile.MaxConcurrentTrades {
+			return fmt.Sprintf("run already holds %d positions, limit %d", open, profile.MaxConcurrentTrades)
+		}
+	}
+
+	if !held.price.IsPositive() {
+		return ""
+	}
+
+	if profile.MaxPositionSize.IsPositive() {
+		if size := post.Abs().Mul(held.price); size.GreaterThan(profile.MaxPositionSize) {
+			return fmt.Sprintf("position size %s exceeds %s", size.Round(2), profile.MaxPositionSize.Round(2))
+		}
+	}
+
+	if profile.MaxExposure.IsPositive() {
+		exposure := post.Abs().Mul(held.price)
+		for _, other := range book {
+			if other != held {
+				exposure = exposure.Add(other.size.Abs().Mul(other.price))
+			}
+		}
+		if exposure.GreaterThan(profile.MaxExposure) {
+			return fmt.Sprintf("run exposure %s exceeds %s", exposure.Round(2), profile.MaxExposure.Round(2))
+		}
+	}
+	return ""
+}
+
+// record writes a refused signal to the run log and application log
+func (r *riskProfiles) record(runID string, signal *strategy.Signal, action strategy.TradeAction, reason string) {
+	message := fmt.Sprintf("Order %s %s on %s rejected for run %s: %s",
+		action.Quantity, action.Asset.Symbol(), action.Exchange, runID, reason)
+
+	r.logger.Warn("🛡️ Signal %s from %s: %s", signal.ID, signal.Strategy, message)
+	r.runLog.Record(runlog.LevelWarn, LogKind, runlog.Fields{
+		Asset:    action.Asset.Symbol(),
+		Exchange: string(action.Exchange),
+		SignalID: signal.ID.String(),
+	}, "%s", message)
+}
+
+// price prefers the mark price feed, then the given price, then the last
+// traded price; zero when none is known
+func (r *riskProfiles) price(exchange connector.ExchangeName, asset portfolio.Asset, fallback numerical.Decimal) numerical.Decimal {
+	if mark, ok := r.marks.Mark(exchange, asset); ok && mark.MarkPrice.IsPositive() {
+		return mark.MarkPrice
+	}
+	if fallback.IsPositive() {
+		return fallback
+	}
+
+	conn, ok := r.registry.GetConnector(exchange)
+	if !ok {
+		return numerical.Zero()
+	}
+	symbol, err := r.symbols.Resolve(exchange, asset.Symbol(), connector.TypePerpetual)
+	if err != nil {
+		symbol = conn.GetPerpSymbol(asset)
+	}
+	price, err := conn.FetchPrice(symbol)
+	if err != nil || price == nil {
+		return numerical.Zero()
+	}
+	return price.Price
+}
+
+// limited reports whether an action places an order that can add exposure
+func limited(action strategy.TradeAction) bool {
+	switch action.Action {
+	case strategy.ActionBuy, strategy.ActionSell, strategy.ActionSellShort, strategy.ActionCover:
+		return action.Quantity.IsPositive()
+	}
+	return false
+}
+
+// delta is the signed position change an action makes
+func delta(action strategy.TradeAction, position numerical.Decimal) numerical.Decimal {
+	switch action.Action {
+	case strategy.ActionBuy, strategy.ActionCover:
+		return action.Quantity
+	case strategy.ActionSell, strategy.ActionSellShort:
+		return action.Quantity.Neg()
+	case strategy.ActionClose:
+		return position.Neg()
+	}
+	return numerical.Zero()
+}
+
+// view is what Handler serves
+type view struct {
+	Default     Profile
+	Profiles    []Profile
+	Assignments []Assignment
+}
+
+func (r *riskProfiles) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case http.MethodGet:
+		case http.MethodPost:
+			var profile Profile
+			if err := json.NewDecoder(req.Body).Decode(&profile); err != nil {
+				http.Error(w, fmt.Sprintf("invalid risk profile: %v", err), http.StatusBadRequest)
+				return
+			}
+			if _, err := r.SaveProfile(profile); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+		case http.MethodDelete:
+			if err := r.DeleteProfile(req.URL.Query().Get("key")); err != nil {
+				http.Error(w, err.Error(), http.StatusNotFound)
+				return
+			}
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		r.mu.Lock()
+		defaults := r.config.Default
+		r.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(view{
+			Default:     defaults,
+			Profiles:    r.Profiles(),
+			Assignments: r.Assignments(),
+		})
+	})
+}
+
+// checkedExecutor is what the margin calculator executes through
+type checkedExecutor struct {
+	profiles *riskProfiles
+	inner    execution.Executor
+}
+
+func (e *checkedExecutor) ExecuteSignal(signal *strategy.Signal) error {
+	if err := e.profiles.Check(signal); err != nil {
+		return err
+	}
+	return e.inner.ExecuteSignal(signal)
+}
+
+func (e *checkedExecutor) HandleTradeExecution(trade connector.Trade) error {
+	return e.inner.HandleTradeExecution(trade)
+}
@@ -0,0 +1,63 @@
+package riskprofiles
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+func loadProfiles(path string) (map[string]Profile, error) {
+	profiles := make(map[string]Profile)
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return profiles, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read risk profiles: %w", err)
+	}
+	if len(data) == 0 {
+		return profiles, nil
+	}
+
+	var persisted []Profile
+	if err := json.Unmarshal(data, &persisted); err != nil {
+		return nil, fmt.Errorf("failed to decode risk profiles: %w", err)
+	}
+	for _, profile := range persisted {
+		if err := profile.validate(); err != nil {
+			return nil, fmt.Errorf("risk profile %q: %w", profile.Key, err)
+		}
+		profiles[profile.Key] = profile
+	}
+	return profiles, nil
+}
+
+// saveProfiles rewrites the profile file through a rename so a crash leaves
+// either the old or the new profiles
+func saveProfiles(path string, profiles map[string]Profile) error {
+	persisted := make([]Profile, 0, len(profiles))
+	for _, profile := range profiles {
+		persisted = append(persisted, profile)
+	}
+	sort.Slice(persisted, func(i, j int) bool { return persisted[i].Key < persisted[j].Key })
+
+	data, err := json.MarshalIndent(persisted, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode risk profiles: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("failed to create risk profile directory: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o640); err != nil {
+		return fmt.Errorf("failed to write risk profiles: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to replace risk profiles: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+// Package runbinding holds the exchanges the active run was started with and
+// keeps signals from trading anywhere else
+package runbinding
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/execution"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+)
+
+// ErrUnboundExchange is returned for a signal trading on an exchange the
+// active run was not started with, or when no run is active
+var ErrUnboundExchange = errors.New("exchange is not bound to the run")
+
+// ExchangeBinding is the set of exchanges the active run may trade on.
+// Startup binds the run's connectors once they validate and releases them
+// on Stop; the pipeline refuses any trade action outside the binding, so a
+// strategy cannot reach a connector that is registered but not configured
+// for its run. Hold actions are not checked.
+type ExchangeBinding interface {
+	Bind(exchanges []connector.ExchangeName)
+	Release()
+
+	// Exchanges returns the bound exchanges, sorted; empty with no run active
+	Exchanges() []connector.ExchangeName
+	IsBound(exchange connector.ExchangeName) bool
+
+	// Check returns ErrUnboundExchange, wrapped, when the signal trades off
+	// the binding
+	Check(signal *strategy.Signal) error
+
+	// Wrap returns an executor that checks the binding before inner
+	Wrap(inner execution.Executor) execution.Executor
+}
+
+type exchangeBinding struct {
+	logger logging.ApplicationLogger
+
+	exchanges map[connector.ExchangeName]bool
+	mu        sync.RWMutex
+}
+
+func NewExchangeBinding(logger logging.ApplicationLogger) ExchangeBinding {
+	return &exchangeBinding{
+		logger:    logger,
+		exchanges: make(map[connector.ExchangeName]bool),
+	}
+}
+
+func (b *exchangeBinding) Bind(exchanges []connector.ExchangeName) {
+	bound := make(map[connector.ExchangeName]bool, len(exchanges))
+	for _, exchange := range exchanges {
+		bound[exchange] = true
+	}
+
+	b.mu.Lock()
+	b.exchanges = bound
+	b.mu.Unlock()
+}
+
+func (b *exchangeBinding) Release() {
+	b.mu.Lock()
+	b.exchanges = make(map[connector.ExchangeName]bool)
+	b.mu.Unlock()
+}
+
+func (b *exchangeBinding) Exchanges() []connector.ExchangeName {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	exchanges := make([]connector.ExchangeName, 0, len(b.exchanges))
+	for exchange := range b.exchanges {
+		exchanges = append(exchanges, exchange)
+	}
+	sort.Slice(exchanges, func(i, j int) bool { return exchanges[i] < exchanges[j] })
+	return exchanges
+}
+
+func (b *exchangeBinding) IsBound(exchange connector.ExchangeName) bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.exchanges[exchange]
+}
+
+func (b *exchangeBinding) Check(signal *strategy.Signal) error {
+	if signal == nil {
+		return fmt.Errorf("signal is nil")
+	}
+
+	for _, action := range signal.Actions {
+		if action.Action == strategy.ActionHold {
+			continue
+		}
+		if !b.IsBound(action.Exchange) {
+			return fmt.Errorf("%w: %s %s on %s", ErrUnboundExchange, action.Action, action.Asset.Symbol(), action.Exchange)
+		}
+	}
+	return nil
+}
+
+func (b *exchangeBinding) Wrap(inner execution.Executor) execution.Executor {
+	return &boundExecutor{binding: b, inner: inner}
+}
+
+type boundExecutor struct {
+	binding *exchangeBinding
+	inner   execution.Executor
+}
+
+func (e *boundExecutor) ExecuteSignal(signal *strategy.Signal) error {
+	if err := e.binding.Check(signal); err != nil {
+		if signal != nil {
+			e.binding.logger.Warn("Run binding: refused signal %s from %s: %v", signal.ID, signal.Strategy, err)
+		}
+		return err
+	}
+	return e.inner.ExecuteSignal(signal)
+}
+
+func (e *boundExecutor) HandleTradeExecution(trade connector.Trade) error {
+	return e.inner.HandleTradeExecution(trade)
+}
@@ -0,0 +1,70 @@
+package runbinding_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mockexecution "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/execution"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/execution"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	logger "github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+	"github.com/backtesting-org/live-trading/pkg/runbinding"
+)
+
+var _ = Describe("ExchangeBinding", func() {
+	var (
+		binding  runbinding.ExchangeBinding
+		inner    *mockexecution.Executor
+		executor execution.Executor
+	)
+
+	btc := portfolio.NewAsset("BTC")
+
+	signal := func(actions ...strategy.TradeAction) *strategy.Signal {
+		return &strategy.Signal{Strategy: "momentum", Actions: actions}
+	}
+	buy := func(exchange connector.ExchangeName) strategy.TradeAction {
+		return strategy.TradeAction{Action: strategy.ActionBuy, Asset: btc, Exchange: exchange, Quantity: numerical.NewFromInt(1)}
+	}
+
+	BeforeEach(func() {
+		binding = runbinding.NewExchangeBinding(logger.NewNoOpLogger())
+		inner = mockexecution.NewExecutor(GinkgoT())
+		executor = binding.Wrap(inner)
+	})
+
+	It("passes signals trading on the run's exchanges", func() {
+		binding.Bind([]connector.ExchangeName{"binance", "okx"})
+		bound := signal(buy("binance"), buy("okx"))
+		inner.On("ExecuteSignal", bound).Return(nil).Once()
+
+		Expect(executor.ExecuteSignal(bound)).To(Succeed())
+	})
+
+	It("refuses a signal with any action on an unbound exchange", func() {
+		binding.Bind([]connector.ExchangeName{"binance"})
+
+		err := executor.ExecuteSignal(signal(buy("binance"), buy("bybit")))
+		Expect(err).To(MatchError(runbinding.ErrUnboundExchange))
+		Expect(err).To(MatchError(ContainSubstring("bybit")))
+		inner.AssertNotCalled(GinkgoT(), "ExecuteSignal")
+	})
+
+	It("refuses every trade once the run releases its binding", func() {
+		binding.Bind([]connector.ExchangeName{"binance"})
+		binding.Release()
+
+		Expect(binding.IsBound("binance")).To(BeFalse())
+		Expect(executor.ExecuteSignal(signal(buy("binance")))).To(MatchError(runbinding.ErrUnboundExchange))
+	})
+
+	It("does not check hold actions", func() {
+		hold := signal(strategy.TradeAction{Action: strategy.ActionHold, Asset: btc, Exchange: "bybit"})
+		inner.On("ExecuteSignal", hold).Return(nil).Once()
+
+		Expect(executor.ExecuteSignal(hold)).To(Succeed())
+	})
+})
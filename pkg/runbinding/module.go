@@ -0,0 +1,7 @@
+package runbinding
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(NewExchangeBinding),
+)
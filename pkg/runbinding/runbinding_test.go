@@ -0,0 +1,13 @@
+package runbinding_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestRunBinding(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "RunBinding Suite")
+}
@@ -0,0 +1,41 @@
+package runlog
+
+import "time"
+
+const (
+	DefaultFlushInterval = 2 * time.Second
+	DefaultBatchSize     = 500
+	DefaultMaxBuffered   = 10000
+
+	// JobName is the scheduler job that flushes buffered entries to the sinks
+	JobName = "run-log-flush"
+)
+
+// Config controls buffering and the built-in sinks
+type Config struct {
+	FlushInterval time.Duration
+
+	// BatchSize flushes early once this many entries are buffered
+	BatchSize int
+
+	// MaxBuffered caps what is held while sinks are failing; the oldest
+	// entries are dropped first
+	MaxBuffered int
+
+	// Directory enables the file sink, one JSONL file per run
+	Directory string
+
+	// LokiURL enables shipping to a Loki push endpoint, e.g.
+	// http://loki:3100/loki/api/v1/push
+	LokiURL    string
+	LokiLabels map[string]string
+}
+
+// DefaultConfig buffers in memory with no sinks configured
+func DefaultConfig() Config {
+	return Config{
+		FlushInterval: DefaultFlushInterval,
+		BatchSize:     DefaultBatchSize,
+		MaxBuffered:   DefaultMaxBuffered,
+	}
+}
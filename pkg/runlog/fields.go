@@ -0,0 +1,56 @@
+package runlog
+
+import "github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+
+// Fields is the context an entry is tagged with beyond its run
+type Fields struct {
+	Asset         string
+	Exchange      string
+	OrderID       string
+	SignalID      string
+	CorrelationID string
+}
+
+// merge overlays the non-empty fields of other
+func (f Fields) merge(other Fields) Fields {
+	if other.Asset != "" {
+		f.Asset = other.Asset
+	}
+	if other.Exchange != "" {
+		f.Exchange = other.Exchange
+	}
+	if other.OrderID != "" {
+		f.OrderID = other.OrderID
+	}
+	if other.SignalID != "" {
+		f.SignalID = other.SignalID
+	}
+	if other.CorrelationID != "" {
+		f.CorrelationID = other.CorrelationID
+	}
+	return f
+}
+
+func (f Fields) apply(entry *Entry) {
+	if entry.Asset == "" {
+		entry.Asset = f.Asset
+	}
+	if entry.Exchange == "" {
+		entry.Exchange = f.Exchange
+	}
+	entry.OrderID = f.OrderID
+	entry.SignalID = f.SignalID
+	entry.CorrelationID = f.CorrelationID
+}
+
+// WithFields returns a trading logger whose captured entries carry fields,
+// for code handling one order or signal to log through. A logger that was
+// not produced by a RunLogger is returned unchanged, so callers need not
+// know whether capture is enabled.
+func WithFields(logger logging.TradingLogger, fields Fields) logging.TradingLogger {
+	c, ok := logger.(*capturingLogger)
+	if !ok {
+		return logger
+	}
+	return &capturingLogger{inner: c.inner, run: c.run, fields: c.fields.merge(fields)}
+}
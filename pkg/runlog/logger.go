@@ -0,0 +1,390 @@
+package runlog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/scheduler"
+)
+
+// RunLogger captures strategy log output for the active run. Wrap puts it
+// in front of the SDK trading logger: every line still reaches the inner
+// logger, and while a run is active it is also tagged with run_id and
+// plugin_id, buffered, and flushed in batches to the configured sinks.
+type RunLogger interface {
+	Configure(config Config)
+
+	// Wrap returns a trading logger that captures into this run logger
+	// and forwards to inner
+	Wrap(inner logging.TradingLogger) logging.TradingLogger
+
+	// Begin starts capturing under runID; End flushes and stops
+	Begin(runID, pluginID string) error
+	End() error
+
+	AddSink(sink Sink)
+
+	// Record captures an entry from code that does not log through the
+	// trading logger, such as the signal queue
+	Record(level Level, kind string, fields Fields, msg string, args ...interface{})
+
+	// Query reads entries back from the first sink that supports it,
+	// flushing first so buffered entries are included
+	Query(filter Filter) ([]Entry, error)
+
+	// Handler serves Query as JSON; ?run= selects the run and defaults to
+	// the one being logged, and asset, exchange, order, signal,
+	// correlation, kind, level, since, until (RFC 3339) and limit narrow it
+	Handler() http.Handler
+
+	Start() error
+	Stop() error
+
+	// Flush writes everything buffered to every sink now
+	Flush() error
+
+	// Dropped is how many entries were discarded because the buffer was full
+	Dropped() int64
+}
+
+type runLogger struct {
+	scheduler    scheduler.Scheduler
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config   Config
+	sinks    []Sink
+	runID    string
+	pluginID string
+	buffer   []Entry
+	dropped  int64
+	mu       sync.Mutex
+
+	// flushMu serialises flushes so batches reach the sinks in order
+	flushMu sync.Mutex
+}
+
+func NewRunLogger(
+	jobScheduler scheduler.Scheduler,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) RunLogger {
+	return &runLogger{
+		scheduler:    jobScheduler,
+		timeProvider: timeProvider,
+		logger:       logger,
+		config:       DefaultConfig(),
+	}
+}
+
+func (r *runLogger) Configure(config Config) {
+	if config.FlushInterval <= 0 {
+		config.FlushInterval = DefaultFlushInterval
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = DefaultBatchSize
+	}
+	if config.MaxBuffered <= 0 {
+		config.MaxBuffered = DefaultMaxBuffered
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.config = config
+
+	sinks := make([]Sink, 0, 2)
+	if config.Directory != "" {
+		sinks = append(sinks, NewFileSink(config.Directory))
+	}
+	if config.LokiURL != "" {
+		sinks = append(sinks, NewLokiSink(config.LokiURL, config.LokiLabels))
+	}
+	// Sinks added through AddSink survive reconfiguration
+	for _, sink := range r.sinks {
+		if sink.Name() != "file" && sink.Name() != "loki" {
+			sinks = append(sinks, sink)
+		}
+	}
+	r.sinks = sinks
+}
+
+func (r *runLogger) AddSink(sink Sink) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sinks = append(r.sinks, sink)
+}
+
+func (r *runLogger) Record(level Level, kind string, fields Fields, msg string, args ...interface{}) {
+	message := msg
+	if len(args) > 0 {
+		message = fmt.Sprintf(msg, args...)
+	}
+	entry := Entry{Level: level, Kind: kind, Message: message}
+	fields.apply(&entry)
+	if r.capture(entry) {
+		go func() { _ = r.Flush() }()
+	}
+}
+
+func (r *runLogger) Query(filter Filter) ([]Entry, error) {
+	// A failed flush is already logged and keeps its batch buffered; what
+	// reached the sink is still worth returning
+	_ = r.Flush()
+
+	r.mu.Lock()
+	var queryable QueryableSink
+	for _, sink := range r.sinks {
+		if q, ok := sink.(QueryableSink); ok {
+			queryable = q
+			break
+		}
+	}
+	r.mu.Unlock()
+
+	if queryable == nil {
+		return nil, fmt.Errorf("no queryable run log sink configured")
+	}
+	return queryable.Query(filter)
+}
+
+func (r *runLogger) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		values := req.URL.Query()
+		filter := Filter{
+			RunID:         values.Get("run"),
+			Asset:         values.Get("asset"),
+			Exchange:      values.Get("exchange"),
+			OrderID:       values.Get("order"),
+			SignalID:      values.Get("signal"),
+			CorrelationID: values.Get("correlation"),
+			Kind:          values.Get("kind"),
+			Level:         Level(values.Get("level")),
+		}
+		if filter.RunID == "" {
+			r.mu.Lock()
+			filter.RunID = r.runID
+			r.mu.Unlock()
+		}
+		if filter.RunID == "" {
+			http.Error(w, "no run is being logged", http.StatusNotFound)
+			return
+		}
+
+		for name, target := range map[string]*time.Time{"since": &filter.Since, "until": &filter.Until} {
+			value := values.Get(name)
+			if value == "" {
+				continue
+			}
+			parsed, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("invalid %s: %v", name, err), http.StatusBadRequest)
+				return
+			}
+			*target = parsed
+		}
+		if limit := values.Get("limit"); limit != "" {
+			parsed, err := strconv.Atoi(limit)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("invalid limit: %v", err), http.StatusBadRequest)
+				return
+			}
+			filter.Limit = parsed
+		}
+
+		entries, err := r.Query(filter)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(entries)
+	})
+}
+
+func (r *runLogger) Begin(runID, pluginID string) error {
+	if runID == "" {
+		return fmt.Errorf("run ID is required")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.runID != "" {
+		return fmt.Errorf("run %s is still being logged", r.runID)
+	}
+	r.runID = runID
+	r.pluginID = pluginID
+	return nil
+}
+
+func (r *runLogger) End() error {
+	r.mu.Lock()
+	r.runID = ""
+	r.pluginID = ""
+	r.mu.Unlock()
+
+	return r.Flush()
+}
+
+func (r *runLogger) Start() error {
+	r.mu.Lock()
+	interval := r.config.FlushInterval
+	r.mu.Unlock()
+
+	return r.scheduler.Register(scheduler.Job{
+		Name:     JobName,
+		Interval: interval,
+		Run: func(_ context.Context) error {
+			return r.Flush()
+		},
+	})
+}
+
+func (r *runLogger) Stop() error {
+	if err := r.Flush(); err != nil {
+		r.logger.Warn(fmt.Sprintf("run log not fully flushed at stop: %s", err.Error()))
+	}
+	return r.scheduler.Unregister(JobName)
+}
+
+func (r *runLogger) Dropped() int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.dropped
+}
+
+// capture buffers an entry for the active run and reports whether the
+// buffer has reached a batch
+func (r *runLogger) capture(entry Entry) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.runID == "" {
+		return false
+	}
+	entry.RunID = r.runID
+	entry.PluginID = r.pluginID
+	entry.At = r.timeProvider.Now()
+
+	if len(r.buffer) >= r.config.MaxBuffered {
+		r.buffer = r.buffer[1:]
+		r.dropped++
+	}
+	r.buffer = append(r.buffer, entry)
+	return len(r.buffer) >= r.config.BatchSize
+}
+
+func (r *runLogger) Flush() error {
+	r.flushMu.Lock()
+	defer r.flushMu.Unlock()
+
+	r.mu.Lock()
+	if len(r.buffer) == 0 || len(r.sinks) == 0 {
+		r.mu.Unlock()
+		return nil
+	}
+	batch := r.buffer
+	r.buffer = nil
+	sinks := append([]Sink(nil), r.sinks...)
+	r.mu.Unlock()
+
+	var failed error
+	for _, sink := range sinks {
+		if err := sink.Write(batch); err != nil {
+			failed = fmt.Errorf("run log sink %s: %w", sink.Name(), err)
+			r.logger.Warn(failed.Error())
+		}
+	}
+
+	// Put a failed batch back in front of anything logged since, so the
+	// next flush retries it in order
+	if failed != nil {
+		r.mu.Lock()
+		r.buffer = append(batch, r.buffer...)
+		if overflow := len(r.buffer) - r.config.MaxBuffered; overflow > 0 {
+			r.buffer = r.buffer[overflow:]
+			r.dropped += int64(overflow)
+		}
+		r.mu.Unlock()
+	}
+	return failed
+}
+
+func (r *runLogger) Wrap(inner logging.TradingLogger) logging.TradingLogger {
+	return &capturingLogger{inner: inner, run: r}
+}
+
+// capturingLogger forwards to the SDK trading logger and captures a copy,
+// tagged with any fields attached through WithFields
+type capturingLogger struct {
+	inner  logging.TradingLogger
+	run    *runLogger
+	fields Fields
+}
+
+func (c *capturingLogger) record(level Level, kind, strategy, asset, exchange, msg string, args []interface{}) {
+	message := msg
+	if len(args) > 0 {
+		message = fmt.Sprintf(msg, args...)
+	}
+	entry := Entry{
+		Level:    level,
+		Kind:     kind,
+		Strategy: strategy,
+		Asset:    asset,
+		Exchange: exchange,
+		Message:  message,
+	}
+	c.fields.apply(&entry)
+	if c.run.capture(entry) {
+		go func() { _ = c.run.Flush() }()
+	}
+}
+
+func (c *capturingLogger) MarketCondition(msg string, args ...interface{}) {
+	c.inner.MarketCondition(msg, args...)
+	c.record(LevelInfo, "market_condition", "", "", "", msg, args)
+}
+
+func (c *capturingLogger) Opportunity(strategy, asset, msg string, args ...interface{}) {
+	c.inner.Opportunity(strategy, asset, msg, args...)
+	c.record(LevelInfo, "opportunity", strategy, asset, "", msg, args)
+}
+
+func (c *capturingLogger) Success(strategy, asset, msg string, args ...interface{}) {
+	c.inner.Success(strategy, asset, msg, args...)
+	c.record(LevelInfo, "success", strategy, asset, "", msg, args)
+}
+
+func (c *capturingLogger) Failed(strategy, asset, msg string, args ...interface{}) {
+	c.inner.Failed(strategy, asset, msg, args...)
+	c.record(LevelError, "failed", strategy, asset, "", msg, args)
+}
+
+func (c *capturingLogger) OrderLifecycle(msg, asset string, args ...interface{}) {
+	c.inner.OrderLifecycle(msg, asset, args...)
+	c.record(LevelInfo, "order_lifecycle", "", asset, "", msg, args)
+}
+
+func (c *capturingLogger) DataCollection(exchange, msg string, args ...interface{}) {
+	c.inner.DataCollection(exchange, msg, args...)
+	c.record(LevelInfo, "data_collection", "", "", exchange, msg, args)
+}
+
+func (c *capturingLogger) Debug(strategy, asset, msg string, args ...interface{}) {
+	c.inner.Debug(strategy, asset, msg, args...)
+	c.record(LevelDebug, "debug", strategy, asset, "", msg, args)
+}
+
+func (c *capturingLogger) Info(msg string, args ...interface{}) {
+	c.inner.Info(msg, args...)
+	c.record(LevelInfo, "info", "", "", "", msg, args)
+}
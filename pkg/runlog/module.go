@@ -0,0 +1,31 @@
+package runlog
+
+import (
+	"context"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"go.uber.org/fx"
+)
+
+var Module = fx.Options(
+	fx.Provide(NewRunLogger),
+	fx.Decorate(decorateTradingLogger),
+	fx.Invoke(registerHooks),
+)
+
+// decorateTradingLogger routes the SDK trading logger, which strategies
+// log through, via the run logger so their output is captured per run
+func decorateTradingLogger(inner logging.TradingLogger, runLogger RunLogger) logging.TradingLogger {
+	return runLogger.Wrap(inner)
+}
+
+func registerHooks(lifecycle fx.Lifecycle, runLogger RunLogger) {
+	lifecycle.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			return runLogger.Start()
+		},
+		OnStop: func(context.Context) error {
+			return runLogger.Stop()
+		},
+	})
+}
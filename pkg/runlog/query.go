@@ -0,0 +1,195 @@
+package runlog
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Filter selects entries from one run. Empty fields match everything; the
+// context columns are looked up through the index, the rest are checked
+// per entry.
+type Filter struct {
+	RunID string
+
+	Asset         string
+	Exchange      string
+	OrderID       string
+	SignalID      string
+	CorrelationID string
+
+	Kind  string
+	Level Level
+	Since time.Time
+	Until time.Time
+
+	// Limit caps how many entries are returned, oldest first; zero is no cap
+	Limit int
+}
+
+type column string
+
+const (
+	columnAsset         column = "asset"
+	columnExchange      column = "exchange"
+	columnOrderID       column = "order_id"
+	columnSignalID      column = "signal_id"
+	columnCorrelationID column = "correlation_id"
+)
+
+// runIndex maps each context column value to the offsets of the lines that
+// carry it, in file order
+type runIndex struct {
+	size    int64
+	all     []int64
+	columns map[column]map[string][]int64
+}
+
+func newRunIndex() *runIndex {
+	return &runIndex{columns: make(map[column]map[string][]int64)}
+}
+
+func (i *runIndex) add(entry Entry, offset int64) {
+	i.all = append(i.all, offset)
+	for col, value := range map[column]string{
+		columnAsset:         entry.Asset,
+		columnExchange:      entry.Exchange,
+		columnOrderID:       entry.OrderID,
+		columnSignalID:      entry.SignalID,
+		columnCorrelationID: entry.CorrelationID,
+	} {
+		if value == "" {
+			continue
+		}
+		values, ok := i.columns[col]
+		if !ok {
+			values = make(map[string][]int64)
+			i.columns[col] = values
+		}
+		values[value] = append(values[value], offset)
+	}
+}
+
+// lookup returns the offsets matching every column set in filter
+func (i *runIndex) lookup(filter Filter) []int64 {
+	offsets := i.all
+	for col, value := range map[column]string{
+		columnAsset:         filter.Asset,
+		columnExchange:      filter.Exchange,
+		columnOrderID:       filter.OrderID,
+		columnSignalID:      filter.SignalID,
+		columnCorrelationID: filter.CorrelationID,
+	} {
+		if value == "" {
+			continue
+		}
+		offsets = intersect(offsets, i.columns[col][value])
+		if len(offsets) == 0 {
+			return nil
+		}
+	}
+	return offsets
+}
+
+// intersect merges two ascending offset lists
+func intersect(a, b []int64) []int64 {
+	out := make([]int64, 0, min(len(a), len(b)))
+	for x, y := 0, 0; x < len(a) && y < len(b); {
+		switch {
+		case a[x] < b[y]:
+			x++
+		case a[x] > b[y]:
+			y++
+		default:
+			out = append(out, a[x])
+			x++
+			y++
+		}
+	}
+	return out
+}
+
+func (s *fileSink) Query(filter Filter) ([]Entry, error) {
+	if filter.RunID == "" {
+		return nil, fmt.Errorf("run ID is required")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := filepath.Join(s.directory, filter.RunID+".jsonl")
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open run log: %w", err)
+	}
+	defer file.Close()
+
+	index, ok := s.indexes[filter.RunID]
+	if !ok {
+		if index, err = buildIndex(file); err != nil {
+			return nil, err
+		}
+		s.indexes[filter.RunID] = index
+	}
+
+	var entries []Entry
+	for _, offset := range index.lookup(filter) {
+		line, err := bufio.NewReader(io.NewSectionReader(file, offset, index.size-offset)).ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return nil, fmt.Errorf("failed to read run log: %w", err)
+		}
+
+		var entry Entry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return nil, fmt.Errorf("failed to decode run log entry at offset %d: %w", offset, err)
+		}
+		if filter.Kind != "" && entry.Kind != filter.Kind {
+			continue
+		}
+		if filter.Level != "" && entry.Level != filter.Level {
+			continue
+		}
+		if !filter.Since.IsZero() && entry.At.Before(filter.Since) {
+			continue
+		}
+		if !filter.Until.IsZero() && entry.At.After(filter.Until) {
+			continue
+		}
+
+		entries = append(entries, entry)
+		if filter.Limit > 0 && len(entries) >= filter.Limit {
+			break
+		}
+	}
+	return entries, nil
+}
+
+// buildIndex scans a run's file once; lines that do not decode, such as one
+// torn by a crash mid-write, are left out of the index
+func buildIndex(file *os.File) (*runIndex, error) {
+	index := newRunIndex()
+	reader := bufio.NewReader(file)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(line) > 0 {
+			var entry Entry
+			if json.Unmarshal(line, &entry) == nil {
+				index.add(entry, index.size)
+			}
+			index.size += int64(len(line))
+		}
+		if err == io.EOF {
+			return index, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to index run log: %w", err)
+		}
+	}
+}
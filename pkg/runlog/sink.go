@@ -0,0 +1,208 @@
+package runlog
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/live-trading/pkg/jsonl"
+)
+
+// Level is the severity an entry was logged at
+type Level string
+
+const (
+	LevelDebug Level = "debug"
+	LevelInfo  Level = "info"
+	LevelWarn  Level = "warn"
+	LevelError Level = "error"
+)
+
+// Entry is one captured log line, tagged with the run that produced it.
+// Asset, exchange and the IDs are columns of their own rather than part of
+// the message so entries can be filtered on them.
+type Entry struct {
+	RunID         string    `json:"run_id"`
+	PluginID      string    `json:"plugin_id,omitempty"`
+	Level         Level     `json:"level"`
+	Kind          string    `json:"kind"`
+	Strategy      string    `json:"strategy,omitempty"`
+	Asset         string    `json:"asset,omitempty"`
+	Exchange      string    `json:"exchange,omitempty"`
+	OrderID       string    `json:"order_id,omitempty"`
+	SignalID      string    `json:"signal_id,omitempty"`
+	CorrelationID string    `json:"correlation_id,omitempty"`
+	Message       string    `json:"message"`
+	At            time.Time `json:"at"`
+}
+
+// Sink receives batches of entries. A failed Write is retried with the
+// next flush, so sinks must tolerate seeing a batch more than once.
+type Sink interface {
+	Name() string
+	Write(entries []Entry) error
+}
+
+// QueryableSink is a sink that can read entries back
+type QueryableSink interface {
+	Sink
+	Query(filter Filter) ([]Entry, error)
+}
+
+// fileSink appends entries to <directory>/<run_id>.jsonl and indexes each
+// run's context columns by line offset
+type fileSink struct {
+	directory string
+
+	indexes map[string]*runIndex
+	mu      sync.Mutex
+}
+
+// NewFileSink writes each run's entries to its own JSONL file under directory
+func NewFileSink(directory string) QueryableSink {
+	return &fileSink{directory: directory, indexes: make(map[string]*runIndex)}
+}
+
+func (s *fileSink) Name() string {
+	return "file"
+}
+
+func (s *fileSink) Write(entries []Entry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.MkdirAll(s.directory, 0o755); err != nil {
+		return fmt.Errorf("failed to create run log directory: %w", err)
+	}
+
+	byRun := make(map[string][]Entry)
+	for _, entry := range entries {
+		byRun[entry.RunID] = append(byRun[entry.RunID], entry)
+	}
+
+	for runID, runEntries := range byRun {
+		path := filepath.Join(s.directory, runID+".jsonl")
+		file, err := jsonl.OpenAppend(path, 0o644)
+		if err != nil {
+			return fmt.Errorf("failed to open run log: %w", err)
+		}
+		info, err := file.Stat()
+		if err != nil {
+			file.Close()
+			return fmt.Errorf("failed to stat run log: %w", err)
+		}
+
+		// An index built before this write is extended in place; one not yet
+		// built is read from the file on first query
+		index := s.indexes[runID]
+		if index != nil && index.size != info.Size() {
+			delete(s.indexes, runID)
+			index = nil
+		}
+
+		offset := info.Size()
+		for _, entry := range runEntries {
+			line, err := jsonl.Marshal(entry)
+			if err != nil {
+				file.Close()
+				return fmt.Errorf("failed to encode run log entry: %w", err)
+			}
+			if _, err := file.Write(line); err != nil {
+				file.Close()
+				delete(s.indexes, runID)
+				return fmt.Errorf("failed to write run log: %w", err)
+			}
+			if index != nil {
+				index.add(entry, offset)
+				index.size += int64(len(line))
+			}
+			offset += int64(len(line))
+		}
+		if err := file.Close(); err != nil {
+			return fmt.Errorf("failed to close run log: %w", err)
+		}
+	}
+	return nil
+}
+
+// lokiSink pushes entries to Loki, one stream per run and level
+type lokiSink struct {
+	url    string
+	labels map[string]string
+	client *http.Client
+}
+
+type lokiStream struct {
+	Stream map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"`
+}
+
+type lokiPush struct {
+	Streams []lokiStream `json:"streams"`
+}
+
+// NewLokiSink ships entries to a Loki push endpoint; labels are added to every stream
+func NewLokiSink(url string, labels map[string]string) Sink {
+	return &lokiSink{
+		url:    url,
+		labels: labels,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (s *lokiSink) Name() string {
+	return "loki"
+}
+
+func (s *lokiSink) Write(entries []Entry) error {
+	streams := make(map[string]*lokiStream)
+	order := make([]string, 0)
+	for _, entry := range entries {
+		key := entry.RunID + "|" + string(entry.Level)
+		stream, ok := streams[key]
+		if !ok {
+			labels := map[string]string{"run_id": entry.RunID, "level": string(entry.Level)}
+			if entry.PluginID != "" {
+				labels["plugin_id"] = entry.PluginID
+			}
+			for k, v := range s.labels {
+				labels[k] = v
+			}
+			stream = &lokiStream{Stream: labels}
+			streams[key] = stream
+			order = append(order, key)
+		}
+
+		line, err := json.Marshal(entry)
+		if err != nil {
+			return fmt.Errorf("failed to encode run log entry: %w", err)
+		}
+		stream.Values = append(stream.Values, [2]string{strconv.FormatInt(entry.At.UnixNano(), 10), string(line)})
+	}
+
+	push := lokiPush{Streams: make([]lokiStream, 0, len(order))}
+	for _, key := range order {
+		push.Streams = append(push.Streams, *streams[key])
+	}
+	body, err := json.Marshal(push)
+	if err != nil {
+		return fmt.Errorf("failed to encode loki push: %w", err)
+	}
+
+	resp, err := s.client.Post(s.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to push to loki: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("loki push returned status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package runmetrics
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultRawRetention keeps every recorded point for a day
+	DefaultRawRetention = 24 * time.Hour
+
+	// DefaultMinuteRetention keeps one-minute aggregates for a month
+	DefaultMinuteRetention = 30 * 24 * time.Hour
+
+	// DefaultCompactInterval is how often retention is applied and changed
+	// series are written out
+	DefaultCompactInterval = time.Minute
+
+	// DefaultMaxPoints is what a query returns at most when it sets no
+	// limit of its own
+	DefaultMaxPoints = 2000
+
+	// JobName is the scheduler job that compacts and persists series
+	JobName = "run-metrics-compact"
+)
+
+// Tier is one resolution series are kept at. A zero Resolution keeps points
+// as recorded; a zero Retention keeps them for the life of the run.
+type Tier struct {
+	Name       string
+	Resolution time.Duration
+	Retention  time.Duration
+}
+
+// Config controls the downsampling tiers and where series are persisted
+type Config struct {
+	// Tiers run from finest to coarsest; every tier aggregates the recorded
+	// values directly, so a coarse tier does not depend on a finer one
+	// still holding the points
+	Tiers []Tier
+
+	CompactInterval time.Duration
+	MaxPoints       int
+
+	// Directory receives <run>/<metric>.json on every compaction and is
+	// loaded on Configure; empty keeps series in memory only
+	Directory string
+}
+
+// DefaultTiers keep raw points for a day, one-minute aggregates for a month
+// and hourly aggregates for as long as the run lasts
+func DefaultTiers() []Tier {
+	return []Tier{
+		{Name: "raw", Retention: DefaultRawRetention},
+		{Name: "1m", Resolution: time.Minute, Retention: DefaultMinuteRetention},
+		{Name: "1h", Resolution: time.Hour},
+	}
+}
+
+// DefaultConfig uses the default tiers and keeps series in memory only
+func DefaultConfig() Config {
+	return Config{
+		Tiers:           DefaultTiers(),
+		CompactInterval: DefaultCompactInterval,
+		MaxPoints:       DefaultMaxPoints,
+	}
+}
+
+// Validate checks tiers are named uniquely and ordered finest first
+func (c Config) Validate() error {
+	if len(c.Tiers) == 0 {
+		return fmt.Errorf("at least one tier is required")
+	}
+
+	names := make(map[string]bool, len(c.Tiers))
+	for i, tier := range c.Tiers {
+		if tier.Name == "" {
+			return fmt.Errorf("tier %d has no name", i)
+		}
+		if names[tier.Name] {
+			return fmt.Errorf("tier %s is defined twice", tier.Name)
+		}
+		names[tier.Name] = true
+
+		if tier.Resolution < 0 || tier.Retention < 0 {
+			return fmt.Errorf("tier %s: resolution and retention cannot be negative", tier.Name)
+		}
+		if i > 0 && tier.Resolution <= c.Tiers[i-1].Resolution {
+			return fmt.Errorf("tier %s must be coarser than %s", tier.Name, c.Tiers[i-1].Name)
+		}
+	}
+	return nil
+}
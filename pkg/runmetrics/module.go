@@ -0,0 +1,23 @@
+package runmetrics
+
+import (
+	"context"
+
+	"go.uber.org/fx"
+)
+
+var Module = fx.Options(
+	fx.Provide(NewSeriesStore),
+	fx.Invoke(registerHooks),
+)
+
+func registerHooks(lifecycle fx.Lifecycle, store SeriesStore) {
+	lifecycle.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			return store.Start()
+		},
+		OnStop: func(context.Context) error {
+			return store.Stop()
+		},
+	})
+}
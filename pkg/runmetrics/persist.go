@@ -0,0 +1,143 @@
+package runmetrics
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// persistedSeries is the file form of one metric: <run>/<metric>.json with
+// the metric name path-escaped
+type persistedSeries struct {
+	RunID  string
+	Metric string
+	First  time.Time
+	Tiers  []persistedTier
+}
+
+type persistedTier struct {
+	Name       string
+	Resolution time.Duration
+	Points     []Point
+}
+
+// snapshot copies a series for writing outside the store lock
+func snapshot(key seriesKey, existing *series) persistedSeries {
+	persisted := persistedSeries{RunID: key.runID, Metric: key.metric, First: existing.first}
+	for _, tier := range existing.tiers {
+		persisted.Tiers = append(persisted.Tiers, persistedTier{
+			Name:       tier.tier.Name,
+			Resolution: tier.tier.Resolution,
+			Points:     append([]Point(nil), tier.points...),
+		})
+	}
+	return persisted
+}
+
+func seriesPath(directory, runID, metric string) string {
+	return filepath.Join(directory, runID, url.PathEscape(metric)+".json")
+}
+
+// save writes through a temporary file so a crash leaves the previous
+// version in place
+func save(directory string, persisted persistedSeries) error {
+	path := seriesPath(directory, persisted.RunID, persisted.Metric)
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("failed to create run metrics directory: %w", err)
+	}
+
+	data, err := json.Marshal(persisted)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s series: %w", persisted.Metric, err)
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o640); err != nil {
+		return fmt.Errorf("failed to write %s series: %w", persisted.Metric, err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to replace %s series: %w", persisted.Metric, err)
+	}
+	return nil
+}
+
+// load reads every persisted series onto the configured tiers; a tier that
+// no longer exists is dropped and a new one starts empty
+func load(directory string, tiers []Tier) (map[seriesKey]*series, error) {
+	runs, err := os.ReadDir(directory)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read run metrics directory: %w", err)
+	}
+
+	loaded := make(map[seriesKey]*series)
+	for _, run := range runs {
+		if !run.IsDir() {
+			continue
+		}
+
+		files, err := os.ReadDir(filepath.Join(directory, run.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read run metrics for %s: %w", run.Name(), err)
+		}
+
+		for _, file := range files {
+			if file.IsDir() || !strings.HasSuffix(file.Name(), ".json") {
+				continue
+			}
+
+			data, err := os.ReadFile(filepath.Join(directory, run.Name(), file.Name()))
+			if err != nil {
+				return nil, fmt.Errorf("failed to read %s: %w", file.Name(), err)
+			}
+			var persisted persistedSeries
+			if err := json.Unmarshal(data, &persisted); err != nil {
+				return nil, fmt.Errorf("failed to decode %s/%s: %w", run.Name(), file.Name(), err)
+			}
+
+			restored := &series{first: persisted.First}
+			for _, tier := range tiers {
+				restoredTier := &tierSeries{tier: tier}
+				for _, old := range persisted.Tiers {
+					if old.Name == tier.Name && old.Resolution == tier.Resolution {
+						restoredTier.points = old.Points
+					}
+				}
+				restored.tiers = append(restored.tiers, restoredTier)
+			}
+			loaded[seriesKey{persisted.RunID, persisted.Metric}] = restored
+		}
+	}
+	return loaded, nil
+}
+
+// diskUsage sums the size of a run's persisted files
+func diskUsage(directory, runID string) int64 {
+	files, err := os.ReadDir(filepath.Join(directory, runID))
+	if err != nil {
+		return 0
+	}
+
+	var total int64
+	for _, file := range files {
+		info, err := file.Info()
+		if err != nil || file.IsDir() {
+			continue
+		}
+		total += info.Size()
+	}
+	return total
+}
+
+func removeRun(directory, runID string) error {
+	if err := os.RemoveAll(filepath.Join(directory, runID)); err != nil {
+		return fmt.Errorf("failed to remove run metrics for %s: %w", runID, err)
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package runmetrics
+
+import (
+	"sort"
+	"time"
+)
+
+// pointSize approximates the in-memory footprint of one Point for usage
+// reporting: the timestamp plus the count and four aggregates
+const pointSize = 64
+
+// Point is a value as recorded, or the aggregate of every value recorded in
+// a bucket starting at At
+type Point struct {
+	At    time.Time
+	Count int
+	Min   float64
+	Max   float64
+	Mean  float64
+	Last  float64
+}
+
+func newPoint(at time.Time, value float64) Point {
+	return Point{At: at, Count: 1, Min: value, Max: value, Mean: value, Last: value}
+}
+
+// add folds a value recorded at or after the others into the point
+func (p *Point) add(value float64) {
+	p.Mean += (value - p.Mean) / float64(p.Count+1)
+	p.Count++
+	if value < p.Min {
+		p.Min = value
+	}
+	if value > p.Max {
+		p.Max = value
+	}
+	p.Last = value
+}
+
+// tierSeries is one metric at one tier, ordered by time
+type tierSeries struct {
+	tier   Tier
+	points []Point
+}
+
+// record adds a value, aggregating it into its bucket on a downsampled
+// tier. Values normally arrive in order; a late one is still placed by
+// time, though it only replaces Last when it is the newest in its bucket.
+func (s *tierSeries) record(at time.Time, value float64) {
+	if s.tier.Resolution > 0 {
+		at = at.Truncate(s.tier.Resolution)
+	}
+
+	n := len(s.points)
+	if n == 0 || at.After(s.points[n-1].At) {
+		s.points = append(s.points, newPoint(at, value))
+		return
+	}
+
+	i := sort.Search(n, func(i int) bool { return !s.points[i].At.Before(at) })
+	if s.tier.Resolution > 0 && i < n && s.points[i].At.Equal(at) {
+		last := s.points[i].Last
+		s.points[i].add(value)
+		if i < n-1 {
+			s.points[i].Last = last
+		}
+		return
+	}
+
+	s.points = append(s.points, Point{})
+	copy(s.points[i+1:], s.points[i:])
+	s.points[i] = newPoint(at, value)
+}
+
+// trim drops points older than the tier's retention and reports whether
+// anything was removed
+func (s *tierSeries) trim(now time.Time) bool {
+	if s.tier.Retention <= 0 || len(s.points) == 0 {
+		return false
+	}
+
+	cutoff := now.Add(-s.tier.Retention)
+	i := sort.Search(len(s.points), func(i int) bool { return !s.points[i].At.Before(cutoff) })
+	if i == 0 {
+		return false
+	}
+	s.points = append([]Point(nil), s.points[i:]...)
+	return true
+}
+
+// window returns the points in [from, to]; zero bounds are open
+func (s *tierSeries) window(from, to time.Time) []Point {
+	start := 0
+	if !from.IsZero() {
+		start = sort.Search(len(s.points), func(i int) bool { return !s.points[i].At.Before(from) })
+	}
+	end := len(s.points)
+	if !to.IsZero() {
+		end = sort.Search(len(s.points), func(i int) bool { return s.points[i].At.After(to) })
+	}
+	if start >= end {
+		return nil
+	}
+	return append([]Point(nil), s.points[start:end]...)
+}
+
+// covers reports whether the tier still holds everything recorded since
+// from: it keeps points forever, or from falls inside its retention
+func (s *tierSeries) covers(from, now time.Time) bool {
+	return s.tier.Retention <= 0 || !from.Before(now.Add(-s.tier.Retention))
+}
+
+// series is one metric of one run across every tier
+type series struct {
+	tiers []*tierSeries
+
+	// first is when the metric was first recorded, which trimming does not
+	// move, so an open-ended query knows how far back it reaches
+	first time.Time
+	dirty bool
+}
+
+func newSeries(tiers []Tier) *series {
+	s := &series{tiers: make([]*tierSeries, len(tiers))}
+	for i, tier := range tiers {
+		s.tiers[i] = &tierSeries{tier: tier}
+	}
+	return s
+}
+
+func (s *series) record(at time.Time, value float64) {
+	for _, tier := range s.tiers {
+		tier.record(at, value)
+	}
+	if s.first.IsZero() || at.Before(s.first) {
+		s.first = at
+	}
+	s.dirty = true
+}
+
+func (s *series) trim(now time.Time) {
+	for _, tier := range s.tiers {
+		if tier.trim(now) {
+			s.dirty = true
+		}
+	}
+}
@@ -0,0 +1,483 @@
+package runmetrics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/scheduler"
+)
+
+// SeriesStore keeps per-run metric time series, such as equity sampled every
+// few seconds, at several resolutions. Every value is recorded into each
+// tier, the downsampled ones aggregating it into their bucket, and each
+// tier forgets points past its retention, so a run going for months holds
+// a day of raw points rather than all of them. Queries pick the tier.
+type SeriesStore interface {
+	Configure(config Config) error
+
+	// Record adds a value for the run's metric at the current time
+	Record(runID, metric string, value float64)
+	RecordAt(runID, metric string, at time.Time, value float64)
+
+	// Query returns a metric over a window from the finest tier that still
+	// holds the whole window and fits within the point limit
+	Query(query Query) (*Series, error)
+
+	Runs() []string
+	Metrics(runID string) []string
+
+	// Usage reports how many points and bytes a run's series take per tier
+	Usage(runID string) (Usage, bool)
+
+	// Delete forgets a run's series, including what was written to disk
+	Delete(runID string) error
+
+	// Compact applies retention and persists changed series now
+	Compact() error
+
+	Start() error
+	Stop() error
+
+	// Handler serves ?run=&metric= as a series, narrowed by from and to
+	// (RFC 3339), points and tier; without metric it serves the run's usage
+	Handler() http.Handler
+}
+
+// Query selects a window of one metric. Zero From and To leave the window
+// open; a zero MaxPoints uses the configured limit. Tier forces a tier by
+// name instead of choosing one.
+type Query struct {
+	RunID     string
+	Metric    string
+	From      time.Time
+	To        time.Time
+	MaxPoints int
+	Tier      string
+}
+
+// Series is a query result and the tier it was served from
+type Series struct {
+	RunID      string
+	Metric     string
+	Tier       string
+	Resolution time.Duration
+	Points     []Point
+}
+
+// TierUsage is what one tier holds across a run's metrics
+type TierUsage struct {
+	Tier   string
+	Points int
+	Bytes  int64
+}
+
+// Usage is the storage a run's series take: Bytes in memory, estimated from
+// the point count, and DiskBytes for the persisted files
+type Usage struct {
+	RunID     string
+	Metrics   int
+	Tiers     []TierUsage
+	Bytes     int64
+	DiskBytes int64
+}
+
+type seriesKey struct {
+	runID  string
+	metric string
+}
+
+type seriesStore struct {
+	scheduler    scheduler.Scheduler
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config Config
+	series map[seriesKey]*series
+	mu     sync.Mutex
+
+	// persistMu serialises writes to the directory
+	persistMu sync.Mutex
+}
+
+func NewSeriesStore(
+	jobScheduler scheduler.Scheduler,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) SeriesStore {
+	return &seriesStore{
+		scheduler:    jobScheduler,
+		timeProvider: timeProvider,
+		logger:       logger,
+		config:       DefaultConfig(),
+		series:       make(map[seriesKey]*series),
+	}
+}
+
+func (s *seriesStore) Configure(config Config) error {
+	if len(config.Tiers) == 0 {
+		config.Tiers = DefaultTiers()
+	}
+	if config.CompactInterval <= 0 {
+		config.CompactInterval = DefaultCompactInterval
+	}
+	if config.MaxPoints <= 0 {
+		config.MaxPoints = DefaultMaxPoints
+	}
+	if err := config.Validate(); err != nil {
+		return fmt.Errorf("invalid run metrics config: %w", err)
+	}
+
+	var loaded map[seriesKey]*series
+	if config.Directory != "" {
+		var err error
+		if loaded, err = load(config.Directory, config.Tiers); err != nil {
+			return err
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.config = config
+	// Series already in memory keep the tiers that survive reconfiguration
+	for key, existing := range s.series {
+		s.series[key] = retier(existing, config.Tiers)
+	}
+	for key, persisted := range loaded {
+		if _, exists := s.series[key]; !exists {
+			s.series[key] = persisted
+		}
+	}
+	return nil
+}
+
+// retier moves a series onto a new tier layout; a tier keeps its points when
+// one with the same name and resolution remains, new tiers start empty
+func retier(existing *series, tiers []Tier) *series {
+	moved := newSeries(tiers)
+	moved.first = existing.first
+	moved.dirty = true
+	for _, tier := range moved.tiers {
+		for _, old := range existing.tiers {
+			if old.tier.Name == tier.tier.Name && old.tier.Resolution == tier.tier.Resolution {
+				tier.points = old.points
+			}
+		}
+	}
+	return moved
+}
+
+func (s *seriesStore) Record(runID, metric string, value float64) {
+	s.RecordAt(runID, metric, s.timeProvider.Now(), value)
+}
+
+func (s *seriesStore) RecordAt(runID, metric string, at time.Time, value float64) {
+	if runID == "" || metric == "" {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := seriesKey{runID, metric}
+	existing, ok := s.series[key]
+	if !ok {
+		existing = newSeries(s.config.Tiers)
+		s.series[key] = existing
+	}
+	existing.record(at, value)
+}
+
+func (s *seriesStore) Query(query Query) (*Series, error) {
+	if query.RunID == "" || query.Metric == "" {
+		return nil, fmt.Errorf("run ID and metric are required")
+	}
+
+	now := s.timeProvider.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, ok := s.series[seriesKey{query.RunID, query.Metric}]
+	if !ok {
+		return nil, fmt.Errorf("no %s series for run %s", query.Metric, query.RunID)
+	}
+
+	maxPoints := query.MaxPoints
+	if maxPoints <= 0 {
+		maxPoints = s.config.MaxPoints
+	}
+
+	tier, err := existing.pick(query, maxPoints, now)
+	if err != nil {
+		return nil, err
+	}
+	return &Series{
+		RunID:      query.RunID,
+		Metric:     query.Metric,
+		Tier:       tier.tier.Name,
+		Resolution: tier.tier.Resolution,
+		Points:     tier.window(query.From, query.To),
+	}, nil
+}
+
+// pick chooses the tier for a query. A tier that has already trimmed part
+// of the window would return a silently truncated series, so only tiers
+// covering the window qualify, and of those the finest within the point
+// limit wins. When even the coarsest covering tier is over the limit it is
+// used anyway; when none covers the window the coarsest tier is the best
+// there is.
+func (s *series) pick(query Query, maxPoints int, now time.Time) (*tierSeries, error) {
+	if query.Tier != "" {
+		for _, tier := range s.tiers {
+			if tier.tier.Name == query.Tier {
+				return tier, nil
+			}
+		}
+		return nil, fmt.Errorf("unknown tier %s", query.Tier)
+	}
+
+	from := query.From
+	if from.IsZero() || from.Before(s.first) {
+		from = s.first
+	}
+
+	var covering *tierSeries
+	for _, tier := range s.tiers {
+		if !tier.covers(from, now) {
+			continue
+		}
+		covering = tier
+		if len(tier.window(query.From, query.To)) <= maxPoints {
+			return tier, nil
+		}
+	}
+	if covering != nil {
+		return covering, nil
+	}
+	return s.tiers[len(s.tiers)-1], nil
+}
+
+func (s *seriesStore) Runs() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	seen := make(map[string]bool)
+	var runs []string
+	for key := range s.series {
+		if !seen[key.runID] {
+			seen[key.runID] = true
+			runs = append(runs, key.runID)
+		}
+	}
+	sort.Strings(runs)
+	return runs
+}
+
+func (s *seriesStore) Metrics(runID string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var metrics []string
+	for key := range s.series {
+		if key.runID == runID {
+			metrics = append(metrics, key.metric)
+		}
+	}
+	sort.Strings(metrics)
+	return metrics
+}
+
+func (s *seriesStore) Usage(runID string) (Usage, bool) {
+	s.mu.Lock()
+	usage := Usage{RunID: runID}
+	tiers := make(map[string]*TierUsage, len(s.config.Tiers))
+	for _, tier := range s.config.Tiers {
+		usage.Tiers = append(usage.Tiers, TierUsage{Tier: tier.Name})
+	}
+	for i := range usage.Tiers {
+		tiers[usage.Tiers[i].Tier] = &usage.Tiers[i]
+	}
+
+	for key, existing := range s.series {
+		if key.runID != runID {
+			continue
+		}
+		usage.Metrics++
+		for _, tier := range existing.tiers {
+			if tierUsage, ok := tiers[tier.tier.Name]; ok {
+				tierUsage.Points += len(tier.points)
+				tierUsage.Bytes += int64(len(tier.points)) * pointSize
+			}
+		}
+	}
+	directory := s.config.Directory
+	s.mu.Unlock()
+
+	if usage.Metrics == 0 {
+		return Usage{}, false
+	}
+	for _, tier := range usage.Tiers {
+		usage.Bytes += tier.Bytes
+	}
+	if directory != "" {
+		usage.DiskBytes = diskUsage(directory, runID)
+	}
+	return usage, true
+}
+
+func (s *seriesStore) Delete(runID string) error {
+	s.mu.Lock()
+	for key := range s.series {
+		if key.runID == runID {
+			delete(s.series, key)
+		}
+	}
+	directory := s.config.Directory
+	s.mu.Unlock()
+
+	if directory == "" {
+		return nil
+	}
+
+	s.persistMu.Lock()
+	defer s.persistMu.Unlock()
+	return removeRun(directory, runID)
+}
+
+func (s *seriesStore) Compact() error {
+	now := s.timeProvider.Now()
+
+	s.mu.Lock()
+	directory := s.config.Directory
+	var changed []persistedSeries
+	for key, existing := range s.series {
+		existing.trim(now)
+		if !existing.dirty || directory == "" {
+			continue
+		}
+		changed = append(changed, snapshot(key, existing))
+		existing.dirty = false
+	}
+	s.mu.Unlock()
+
+	if len(changed) == 0 {
+		return nil
+	}
+
+	s.persistMu.Lock()
+	defer s.persistMu.Unlock()
+
+	var failed int
+	var firstErr error
+	for _, persisted := range changed {
+		if err := save(directory, persisted); err != nil {
+			failed++
+			if firstErr == nil {
+				firstErr = err
+			}
+			s.markDirty(seriesKey{persisted.RunID, persisted.Metric})
+		}
+	}
+	if firstErr != nil {
+		return fmt.Errorf("failed to persist %d of %d series: %w", failed, len(changed), firstErr)
+	}
+	return nil
+}
+
+// markDirty retries a series on the next compaction after a failed write
+func (s *seriesStore) markDirty(key seriesKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if existing, ok := s.series[key]; ok {
+		existing.dirty = true
+	}
+}
+
+func (s *seriesStore) Start() error {
+	s.mu.Lock()
+	interval := s.config.CompactInterval
+	s.mu.Unlock()
+
+	return s.scheduler.Register(scheduler.Job{
+		Name:     JobName,
+		Interval: interval,
+		Run: func(_ context.Context) error {
+			return s.Compact()
+		},
+	})
+}
+
+func (s *seriesStore) Stop() error {
+	if err := s.Compact(); err != nil {
+		s.logger.Warn("Run metrics not fully persisted at stop: %v", err)
+	}
+	return s.scheduler.Unregister(JobName)
+}
+
+func (s *seriesStore) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		values := req.URL.Query()
+		runID := values.Get("run")
+		if runID == "" {
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(s.Runs())
+			return
+		}
+
+		metric := values.Get("metric")
+		if metric == "" {
+			usage, ok := s.Usage(runID)
+			if !ok {
+				http.Error(w, "run not found", http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(struct {
+				Usage
+				MetricNames []string
+			}{usage, s.Metrics(runID)})
+			return
+		}
+
+		query := Query{RunID: runID, Metric: metric, Tier: values.Get("tier")}
+		var err error
+		if query.From, err = parseTime(values.Get("from")); err != nil {
+			http.Error(w, fmt.Sprintf("invalid from: %v", err), http.StatusBadRequest)
+			return
+		}
+		if query.To, err = parseTime(values.Get("to")); err != nil {
+			http.Error(w, fmt.Sprintf("invalid to: %v", err), http.StatusBadRequest)
+			return
+		}
+		if points := values.Get("points"); points != "" {
+			if query.MaxPoints, err = strconv.Atoi(points); err != nil {
+				http.Error(w, fmt.Sprintf("invalid points: %v", err), http.StatusBadRequest)
+				return
+			}
+		}
+
+		result, err := s.Query(query)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(result)
+	})
+}
+
+func parseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
@@ -0,0 +1,44 @@
+package runreport
+
+import "time"
+
+const (
+	// DefaultSampleInterval is how often equity is sampled for the curve
+	DefaultSampleInterval = time.Minute
+
+	// DefaultMaxEvents bounds the notable events kept per run
+	DefaultMaxEvents = 500
+
+	// JobName is the scheduler job that samples equity during a run
+	JobName = "run-report-equity"
+)
+
+// Event kinds the reporter adds itself when a run begins and finishes
+const (
+	EventStarted  = "run_started"
+	EventFinished = "run_finished"
+)
+
+// Series the reporter records into the run metrics store on every sample
+const (
+	MetricEquity   = "equity"
+	MetricDrawdown = "drawdown"
+)
+
+// Config controls run reporting
+type Config struct {
+	SampleInterval time.Duration
+	MaxEvents      int
+
+	// Directory receives <run>/report.json, report.md and equity.svg when
+	// a run finishes; empty keeps reports in memory only
+	Directory string
+}
+
+// DefaultConfig samples equity every minute and writes no files
+func DefaultConfig() Config {
+	return Config{
+		SampleInterval: DefaultSampleInterval,
+		MaxEvents:      DefaultMaxEvents,
+	}
+}
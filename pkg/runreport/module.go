@@ -0,0 +1,25 @@
+package runreport
+
+import (
+	"context"
+
+	"go.uber.org/fx"
+)
+
+var Module = fx.Options(
+	fx.Provide(NewRunReporter),
+	fx.Invoke(registerHooks),
+)
+
+// registerHooks finishes a run left open at shutdown, so it still gets a report
+func registerHooks(lifecycle fx.Lifecycle, reporter RunReporter) {
+	lifecycle.Append(fx.Hook{
+		OnStop: func(context.Context) error {
+			if _, ok := reporter.Active(); !ok {
+				return nil
+			}
+			_, err := reporter.Finish(nil)
+			return err
+		},
+	})
+}
@@ -0,0 +1,256 @@
+package runreport
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/live-trading/pkg/capital"
+	"github.com/backtesting-org/live-trading/pkg/connectors/accounting"
+	"github.com/backtesting-org/live-trading/pkg/errortracking"
+	"github.com/backtesting-org/live-trading/pkg/signallatency"
+)
+
+// Outcome is how a run ended
+type Outcome string
+
+const (
+	OutcomeCompleted Outcome = "completed"
+	OutcomeFailed    Outcome = "failed"
+)
+
+// Event is something worth calling out in the report, e.g. a kill switch
+// activation or a connector outage
+type Event struct {
+	RunID   string
+	Kind    string
+	Message string
+	At      time.Time
+}
+
+// Position is one market's position change over a run
+type Position struct {
+	Exchange connector.ExchangeName
+	Symbol   string
+
+	// Size is signed, positive for net buying during the run; Held is the
+	// whole ledger position on the market, whoever opened it
+	Size numerical.Decimal
+	Held numerical.Decimal
+
+	// EntryPrice is the ledger's average entry on the market
+	EntryPrice numerical.Decimal
+}
+
+// EquityPoint is one equity sample
+type EquityPoint struct {
+	At     time.Time
+	Equity numerical.Decimal
+}
+
+// EquityUpdate is published with every equity sample so live views can
+// follow a run's PnL without polling
+type EquityUpdate struct {
+	RunID    string
+	Equity   numerical.Decimal
+	Drawdown numerical.Decimal
+	At       time.Time
+}
+
+// Report is the artifact left behind by every run
+type Report struct {
+	RunID   string
+	Config  map[string]string
+	Outcome Outcome
+	Error   string
+
+	StartedAt time.Time
+	EndedAt   time.Time
+	Duration  time.Duration
+
+	// Totals cover fills booked during the run only
+	GrossRealizedPnL numerical.Decimal
+	Fees             numerical.Decimal
+	Funding          numerical.Decimal
+	Slippage         numerical.Decimal
+	NetRealizedPnL   numerical.Decimal
+	Fills            int
+	Symbols          []accounting.Summary
+	Execution        accounting.ExecutionQuality
+
+	// Holding covers lots closed during the run; ClosedLots backs the
+	// holdings.csv export
+	Holding    accounting.HoldingStats
+	ClosedLots []accounting.ClosedLot `json:"-"`
+
+	StartEquity numerical.Decimal
+	EndEquity   numerical.Decimal
+	MaxDrawdown numerical.Decimal
+	Equity      []EquityPoint
+
+	// Latency covers signals generated during the run, from GetSignals to
+	// acknowledgement and first fill
+	Latency signallatency.Summary
+
+	// Capital is the run's allocation under its capital policy, when it had one
+	Capital *capital.Allocation
+
+	Events []Event
+	Errors []errortracking.Group
+}
+
+// WriteMarkdown renders the report for humans
+func (r *Report) WriteMarkdown(w io.Writer) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# Run %s: %s\n\n", r.RunID, r.Outcome)
+	fmt.Fprintf(&b, "- Started: %s\n", r.StartedAt.UTC().Format(time.RFC3339))
+	fmt.Fprintf(&b, "- Ended: %s\n", r.EndedAt.UTC().Format(time.RFC3339))
+	fmt.Fprintf(&b, "- Duration: %s\n", r.Duration.Round(time.Second))
+	if r.Error != "" {
+		fmt.Fprintf(&b, "- Error: %s\n", r.Error)
+	}
+
+	if len(r.Config) > 0 {
+		b.WriteString("\n## Config\n\n")
+		for _, key := range sortedKeys(r.Config) {
+			fmt.Fprintf(&b, "- %s: %s\n", key, r.Config[key])
+		}
+	}
+
+	b.WriteString("\n## PnL\n\n")
+	fmt.Fprintf(&b, "- Net realized: %s\n", r.NetRealizedPnL.Round(4))
+	fmt.Fprintf(&b, "- Gross realized: %s\n", r.GrossRealizedPnL.Round(4))
+	fmt.Fprintf(&b, "- Fees: %s\n", r.Fees.Round(4))
+	fmt.Fprintf(&b, "- Funding: %s\n", r.Funding.Round(4))
+	fmt.Fprintf(&b, "- Slippage: %s\n", r.Slippage.Round(4))
+	fmt.Fprintf(&b, "- Fills: %d\n", r.Fills)
+	if r.Fills > 0 {
+		fmt.Fprintf(&b, "- Passive fill ratio: %s%% (%d maker / %d taker, %d inferred)\n",
+			r.Execution.PassiveFillRatio.Mul(numerical.NewFromInt(100)).Round(2),
+			r.Execution.MakerFills, r.Execution.TakerFills, r.Execution.InferredFills)
+		fmt.Fprintf(&b, "- Fee rate: %s bps (maker %s, taker %s)\n",
+			r.Execution.FeeBps.Round(2), r.Execution.MakerFees.Round(4), r.Execution.TakerFees.Round(4))
+	}
+	if r.Holding.ClosedLots > 0 {
+		fmt.Fprintf(&b, "- Holding time: median %s, average %s, max %s over %d closed lots\n",
+			r.Holding.Median.Round(time.Second), r.Holding.Average.Round(time.Second),
+			r.Holding.Max.Round(time.Second), r.Holding.ClosedLots)
+		fmt.Fprintf(&b, "- Turnover: %sx average notional held\n", r.Holding.Turnover.Round(2))
+	}
+	if len(r.Equity) > 0 {
+		fmt.Fprintf(&b, "- Equity: %s → %s (max drawdown %s%%)\n",
+			r.StartEquity.Round(2), r.EndEquity.Round(2), r.MaxDrawdown.Mul(numerical.NewFromInt(100)).Round(2))
+	}
+
+	if len(r.Symbols) > 0 {
+		b.WriteString("\n| Exchange | Symbol | Fills | Net PnL | Fees | Position |\n|---|---|---|---|---|---|\n")
+		for _, s := range r.Symbols {
+			fmt.Fprintf(&b, "| %s | %s | %d | %s | %s | %s |\n",
+				s.Exchange, s.Symbol, s.Fills, s.NetRealizedPnL.Round(4), s.Fees.Round(4), s.Position)
+		}
+	}
+
+	if r.Latency.Signals > 0 {
+		b.WriteString("\n## Latency\n\n")
+		fmt.Fprintf(&b, "%d signals.\n\n| Segment | Count | p50 | p95 | p99 | Max |\n|---|---|---|---|---|---|\n", r.Latency.Signals)
+		for _, segment := range signallatency.Segments() {
+			h, ok := r.Latency.Segments[segment]
+			if !ok {
+				continue
+			}
+			fmt.Fprintf(&b, "| %s | %d | %s | %s | %s | %s |\n", segment, h.Count,
+				h.P50.Round(time.Millisecond), h.P95.Round(time.Millisecond),
+				h.P99.Round(time.Millisecond), h.Max.Round(time.Millisecond))
+		}
+
+		exchanges := make([]string, 0, len(r.Latency.ByExchange))
+		for exchange := range r.Latency.ByExchange {
+			exchanges = append(exchanges, string(exchange))
+		}
+		sort.Strings(exchanges)
+		for _, exchange := range exchanges {
+			if h, ok := r.Latency.ByExchange[connector.ExchangeName(exchange)][signallatency.SegmentAck]; ok {
+				fmt.Fprintf(&b, "\n- %s ack: p50 %s, p99 %s over %d signals", exchange,
+					h.P50.Round(time.Millisecond), h.P99.Round(time.Millisecond), h.Count)
+			}
+		}
+		if len(exchanges) > 0 {
+			b.WriteString("\n")
+		}
+	}
+
+	if r.Capital != nil {
+		b.WriteString("\n")
+		if err := r.Capital.WriteMarkdown(&b); err != nil {
+			return err
+		}
+	}
+
+	if len(r.Events) > 0 {
+		b.WriteString("\n## Events\n\n")
+		for _, e := range r.Events {
+			fmt.Fprintf(&b, "- %s [%s] %s\n", e.At.UTC().Format(time.RFC3339), e.Kind, e.Message)
+		}
+	}
+
+	if len(r.Errors) > 0 {
+		b.WriteString("\n## Errors\n\n")
+		for _, g := range r.Errors {
+			fmt.Fprintf(&b, "- %d× [%s] %s\n", g.Count, g.Source, g.Normalised)
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+const (
+	svgWidth   = 800
+	svgHeight  = 240
+	svgPadding = 10
+)
+
+// WriteEquitySVG draws the equity curve; SVG keeps the image dependency free
+func (r *Report) WriteEquitySVG(w io.Writer) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`,
+		svgWidth, svgHeight, svgWidth, svgHeight)
+	b.WriteString(`<rect width="100%" height="100%" fill="white"/>`)
+
+	if len(r.Equity) > 1 {
+		low, high := r.Equity[0].Equity.InexactFloat64(), r.Equity[0].Equity.InexactFloat64()
+		for _, p := range r.Equity {
+			v := p.Equity.InexactFloat64()
+			low, high = min(low, v), max(high, v)
+		}
+		if high == low {
+			high = low + 1
+		}
+
+		start := r.Equity[0].At
+		span := r.Equity[len(r.Equity)-1].At.Sub(start).Seconds()
+		if span <= 0 {
+			span = 1
+		}
+
+		points := make([]string, 0, len(r.Equity))
+		for _, p := range r.Equity {
+			x := svgPadding + p.At.Sub(start).Seconds()/span*(svgWidth-2*svgPadding)
+			y := svgHeight - svgPadding - (p.Equity.InexactFloat64()-low)/(high-low)*(svgHeight-2*svgPadding)
+			points = append(points, fmt.Sprintf("%.1f,%.1f", x, y))
+		}
+
+		fmt.Fprintf(&b, `<polyline fill="none" stroke="steelblue" stroke-width="2" points="%s"/>`, strings.Join(points, " "))
+		fmt.Fprintf(&b, `<text x="%d" y="20" font-family="monospace" font-size="12">%.2f</text>`, svgPadding, high)
+		fmt.Fprintf(&b, `<text x="%d" y="%d" font-family="monospace" font-size="12">%.2f</text>`, svgPadding, svgHeight-svgPadding-4, low)
+	}
+
+	b.WriteString(`</svg>`)
+	_, err := io.WriteString(w, b.String())
+	return err
+}
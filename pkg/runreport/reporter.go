@@ -0,0 +1,593 @@
+package runreport
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/events"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/capital"
+	"github.com/backtesting-org/live-trading/pkg/connectors/accounting"
+	"github.com/backtesting-org/live-trading/pkg/errortracking"
+	"github.com/backtesting-org/live-trading/pkg/eventschema"
+	"github.com/backtesting-org/live-trading/pkg/runmetrics"
+	"github.com/backtesting-org/live-trading/pkg/scheduler"
+	"github.com/backtesting-org/live-trading/pkg/signallatency"
+)
+
+// Deliverer sends a finished report somewhere, e.g. an alerting channel
+type Deliverer func(report *Report) error
+
+// RunReporter produces a completion report for every run. Begin when the
+// run starts, Finish when it stops; a run still open at fx stop is
+// finished from the stop hook so no run ends without a report.
+type RunReporter interface {
+	Configure(config Config)
+
+	Begin(runID string, config map[string]string) error
+
+	// Event records something notable for the active run's report
+	Event(kind, message string)
+
+	// Finish builds, writes and delivers the report; runErr marks the run failed
+	Finish(runErr error) (*Report, error)
+
+	Active() (string, bool)
+
+	// Current is the active run's report so far: ID, config and start time,
+	// without totals, which are only computed at Finish
+	Current() (*Report, bool)
+
+	// Positions is what the active run has opened: the ledger position on
+	// each market less the position it held when the run began
+	Positions(runID string) ([]Position, error)
+
+	// RealizedPnL is the net realized PnL the ledger has booked since the
+	// active run began
+	RealizedPnL(runID string) (numerical.Decimal, error)
+
+	// Adopt hands size of a position held before the run to runID, as
+	// though the run had opened it, so Positions, flattening and PnL
+	// attribution cover it. A run that has not begun picks it up at Begin.
+	Adopt(runID string, exchange connector.ExchangeName, symbol string, size numerical.Decimal) error
+
+	Report(runID string) (*Report, bool)
+	Latest() (*Report, bool)
+
+	AddDeliverer(deliverer Deliverer)
+
+	// Handler serves a report as JSON, or the equity curve with
+	// ?format=svg; ?run= selects the run and defaults to the latest
+	Handler() http.Handler
+}
+
+type symbolKey struct {
+	exchange connector.ExchangeName
+	symbol   string
+}
+
+// run is the state of the run being reported on
+type run struct {
+	report   *Report
+	baseline map[symbolKey]accounting.Summary
+	peak     numerical.Decimal
+}
+
+type runReporter struct {
+	registry     registry.ConnectorRegistry
+	ledger       accounting.Ledger
+	capital      capital.CapitalManager
+	errors       errortracking.ErrorAggregator
+	series       runmetrics.SeriesStore
+	latency      signallatency.LatencyTracker
+	scheduler    scheduler.Scheduler
+	bus          events.EventBus
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config     Config
+	active     *run
+	reports    map[string]*Report
+	adopted    map[string]map[symbolKey]numerical.Decimal
+	latest     string
+	deliverers []Deliverer
+	mu         sync.Mutex
+}
+
+func NewRunReporter(
+	connectorRegistry registry.ConnectorRegistry,
+	ledger accounting.Ledger,
+	capitalManager capital.CapitalManager,
+	errorAggregator errortracking.ErrorAggregator,
+	seriesStore runmetrics.SeriesStore,
+	latencyTracker signallatency.LatencyTracker,
+	jobScheduler scheduler.Scheduler,
+	bus events.EventBus,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) RunReporter {
+	return &runReporter{
+		registry:     connectorRegistry,
+		ledger:       ledger,
+		capital:      capitalManager,
+		errors:       errorAggregator,
+		series:       seriesStore,
+		latency:      latencyTracker,
+		scheduler:    jobScheduler,
+		bus:          bus,
+		timeProvider: timeProvider,
+		logger:       logger,
+		config:       DefaultConfig(),
+		reports:      make(map[string]*Report),
+		adopted:      make(map[string]map[symbolKey]numerical.Decimal),
+	}
+}
+
+func (r *runReporter) Configure(config Config) {
+	if config.SampleInterval <= 0 {
+		config.SampleInterval = DefaultSampleInterval
+	}
+	if config.MaxEvents <= 0 {
+		config.MaxEvents = DefaultMaxEvents
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.config = config
+}
+
+func (r *runReporter) AddDeliverer(deliverer Deliverer) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.deliverers = append(r.deliverers, deliverer)
+}
+
+func (r *runReporter) Begin(runID string, config map[string]string) error {
+	if runID == "" {
+		return fmt.Errorf("run ID is required")
+	}
+
+	// The ledger is cumulative for the process, so the report nets out
+	// whatever was booked before the run started
+	baseline := make(map[symbolKey]accounting.Summary)
+	for _, summary := range r.ledger.Summaries() {
+		baseline[symbolKey{summary.Exchange, summary.Symbol}] = summary
+	}
+
+	copied := make(map[string]string, len(config))
+	for k, v := range config {
+		copied[k] = v
+	}
+
+	r.mu.Lock()
+	if r.active != nil {
+		active := r.active.report.RunID
+		r.mu.Unlock()
+		return fmt.Errorf("run %s is still being reported on", active)
+	}
+	for key, size := range r.adopted[runID] {
+		baseline[key] = disown(baseline[key], size)
+	}
+	delete(r.adopted, runID)
+	r.active = &run{
+		report: &Report{
+			RunID:     runID,
+			Config:    copied,
+			StartedAt: r.timeProvider.Now(),
+		},
+		baseline: baseline,
+		peak:     numerical.Zero(),
+	}
+	interval := r.config.SampleInterval
+	r.mu.Unlock()
+
+	r.Event(EventStarted, fmt.Sprintf("run %s started", runID))
+	r.sample()
+
+	return r.scheduler.Register(scheduler.Job{
+		Name:     JobName,
+		Interval: interval,
+		Run: func(_ context.Context) error {
+			r.sample()
+			return nil
+		},
+	})
+}
+
+func (r *runReporter) Event(kind, message string) {
+	r.mu.Lock()
+	if r.active == nil {
+		r.mu.Unlock()
+		return
+	}
+	report := r.active.report
+	event := Event{RunID: report.RunID, Kind: kind, Message: message, At: r.timeProvider.Now()}
+	if len(report.Events) < r.config.MaxEvents {
+		report.Events = append(report.Events, event)
+	}
+	r.mu.Unlock()
+
+	// Live subscribers see every event, even past the report's cap
+	r.bus.Publish(eventschema.TopicRunEvent, event)
+}
+
+func (r *runReporter) Active() (string, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.active == nil {
+		return "", false
+	}
+	return r.active.report.RunID, true
+}
+
+func (r *runReporter) Current() (*Report, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.active == nil {
+		return nil, false
+	}
+	active := r.active.report
+	config := make(map[string]string, len(active.Config))
+	for k, v := range active.Config {
+		config[k] = v
+	}
+	return &Report{RunID: active.RunID, Config: config, StartedAt: active.StartedAt}, true
+}
+
+func (r *runReporter) Positions(runID string) ([]Position, error) {
+	r.mu.Lock()
+	if r.active == nil || r.active.report.RunID != runID {
+		r.mu.Unlock()
+		return nil, fmt.Errorf("run %s is not active", runID)
+	}
+	baseline := r.active.baseline
+	r.mu.Unlock()
+
+	var positions []Position
+	for _, summary := range r.ledger.Summaries() {
+		size := summary.Position
+		if before, ok := baseline[symbolKey{summary.Exchange, summary.Symbol}]; ok {
+			size = size.Sub(before.Position)
+		}
+		if size.IsZero() {
+			continue
+		}
+		positions = append(positions, Position{
+			Exchange:   summary.Exchange,
+			Symbol:     summary.Symbol,
+			Size:       size,
+			Held:       summary.Position,
+			EntryPrice: summary.EntryPrice,
+		})
+	}
+
+	sort.Slice(positions, func(i, j int) bool {
+		if positions[i].Exchange != positions[j].Exchange {
+			return positions[i].Exchange < positions[j].Exchange
+		}
+		return positions[i].Symbol < positions[j].Symbol
+	})
+	return positions, nil
+}
+
+func (r *runReporter) RealizedPnL(runID string) (numerical.Decimal, error) {
+	r.mu.Lock()
+	if r.active == nil || r.active.report.RunID != runID {
+		r.mu.Unlock()
+		return numerical.Zero(), fmt.Errorf("run %s is not active", runID)
+	}
+	baseline := r.active.baseline
+	r.mu.Unlock()
+
+	total := numerical.Zero()
+	for _, summary := range r.ledger.Summaries() {
+		if before, ok := baseline[symbolKey{summary.Exchange, summary.Symbol}]; ok {
+			summary = summary.Sub(before)
+		}
+		total = total.Add(summary.NetRealizedPnL)
+	}
+	return total, nil
+}
+
+func (r *runReporter) Adopt(runID string, exchange connector.ExchangeName, symbol string, size numerical.Decimal) error {
+	if runID == "" {
+		return fmt.Errorf("run ID is required")
+	}
+	if size.IsZero() {
+		return nil
+	}
+
+	key := symbolKey{exchange, symbol}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.active != nil {
+		if r.active.report.RunID != runID {
+			return fmt.Errorf("run %s is active, not %s", r.active.report.RunID, runID)
+		}
+		r.active.baseline[key] = disown(r.active.baseline[key], size)
+		return nil
+	}
+	if _, finished := r.reports[runID]; finished {
+		return fmt.Errorf("run %s has already finished", runID)
+	}
+
+	pending, ok := r.adopted[runID]
+	if !ok {
+		pending = make(map[symbolKey]numerical.Decimal)
+		r.adopted[runID] = pending
+	}
+	if current, ok := pending[key]; ok {
+		size = current.Add(size)
+	}
+	pending[key] = size
+	return nil
+}
+
+// disown takes an adopted size out of a baseline summary, so the ledger
+// position above it is counted as the run's
+func disown(before accounting.Summary, size numerical.Decimal) accounting.Summary {
+	before.Position = before.Position.Sub(size)
+	return before
+}
+
+// sample appends the current equity and records it, with the drawdown from
+// the run's peak, to the run's metric series; a failed read is skipped
+// rather than plotted as a drop
+func (r *runReporter) sample() {
+	equity, err := r.equity()
+	if err != nil {
+		r.logger.Debug("Run report equity sample skipped: %v", err)
+		return
+	}
+
+	r.mu.Lock()
+	if r.active == nil {
+		r.mu.Unlock()
+		return
+	}
+	at := r.timeProvider.Now()
+	report := r.active.report
+	report.Equity = append(report.Equity, EquityPoint{At: at, Equity: equity})
+
+	if equity.GreaterThan(r.active.peak) {
+		r.active.peak = equity
+	}
+	drawdown := numerical.Zero()
+	if r.active.peak.IsPositive() {
+		drawdown = r.active.peak.Sub(equity).Div(r.active.peak)
+		if drawdown.GreaterThan(report.MaxDrawdown) {
+			report.MaxDrawdown = drawdown
+		}
+	}
+	runID := report.RunID
+	r.mu.Unlock()
+
+	r.series.RecordAt(runID, MetricEquity, at, equity.InexactFloat64())
+	r.series.RecordAt(runID, MetricDrawdown, at, drawdown.InexactFloat64())
+	r.bus.Publish(eventschema.TopicRunEquity, EquityUpdate{RunID: runID, Equity: equity, Drawdown: drawdown, At: at})
+}
+
+// equity sums TotalBalance across ready trading connectors
+func (r *runReporter) equity() (numerical.Decimal, error) {
+	total := numerical.Zero()
+	var failed []string
+
+	for _, conn := range r.registry.GetReadyConnectors() {
+		if !conn.SupportsTradingOperations() {
+			continue
+		}
+
+		balance, err := conn.GetAccountBalance()
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", conn.GetConnectorInfo().Name, err))
+			continue
+		}
+		total = total.Add(balance.TotalBalance)
+	}
+
+	if len(failed) > 0 {
+		return numerical.Zero(), fmt.Errorf("equity unavailable: %s", strings.Join(failed, "; "))
+	}
+	return total, nil
+}
+
+func (r *runReporter) Finish(runErr error) (*Report, error) {
+	r.mu.Lock()
+	if r.active == nil {
+		r.mu.Unlock()
+		return nil, fmt.Errorf("no run is being reported on")
+	}
+	r.mu.Unlock()
+
+	if err := r.scheduler.Unregister(JobName); err != nil {
+		r.logger.Debug("Run report sampler not registered: %v", err)
+	}
+	r.sample()
+
+	outcome := OutcomeCompleted
+	if runErr != nil {
+		outcome = OutcomeFailed
+	}
+	r.Event(EventFinished, fmt.Sprintf("run %s", outcome))
+
+	r.mu.Lock()
+	active := r.active
+	r.active = nil
+	config := r.config
+	deliverers := append([]Deliverer(nil), r.deliverers...)
+	r.mu.Unlock()
+
+	report := active.report
+	report.EndedAt = r.timeProvider.Now()
+	report.Duration = report.EndedAt.Sub(report.StartedAt)
+	report.Outcome = outcome
+	if runErr != nil {
+		report.Error = runErr.Error()
+	}
+
+	r.tally(report, active.baseline)
+	report.ClosedLots = r.ledger.ClosedLots(report.StartedAt)
+	report.Holding = accounting.Holding(report.ClosedLots, report.StartedAt, report.EndedAt)
+	report.Errors = r.errors.Summary(report.RunID)
+	report.Latency = r.latency.Summary(report.StartedAt, report.EndedAt)
+	if allocation, ok := r.capital.Allocation(report.RunID); ok {
+		report.Capital = &allocation
+	}
+	if n := len(report.Equity); n > 0 {
+		report.StartEquity = report.Equity[0].Equity
+		report.EndEquity = report.Equity[n-1].Equity
+	}
+
+	r.mu.Lock()
+	r.reports[report.RunID] = report
+	r.latest = report.RunID
+	r.mu.Unlock()
+
+	var errs []string
+	if config.Directory != "" {
+		if err := r.write(config.Directory, report); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	for _, deliver := range deliverers {
+		if err := deliver(report); err != nil {
+			errs = append(errs, fmt.Sprintf("delivery: %v", err))
+		}
+	}
+
+	r.logger.Info("Run %s %s after %s: net PnL %s over %d fills, %d error groups",
+		report.RunID, report.Outcome, report.Duration, report.NetRealizedPnL, report.Fills, len(report.Errors))
+
+	if len(errs) > 0 {
+		return report, fmt.Errorf("run report %s: %s", report.RunID, strings.Join(errs, "; "))
+	}
+	return report, nil
+}
+
+// tally fills in PnL booked since Begin
+func (r *runReporter) tally(report *Report, baseline map[symbolKey]accounting.Summary) {
+	report.GrossRealizedPnL = numerical.Zero()
+	report.Fees = numerical.Zero()
+	report.Funding = numerical.Zero()
+	report.Slippage = numerical.Zero()
+	report.NetRealizedPnL = numerical.Zero()
+
+	for _, summary := range r.ledger.Summaries() {
+		if before, ok := baseline[symbolKey{summary.Exchange, summary.Symbol}]; ok {
+			summary = summary.Sub(before)
+		}
+		if summary.Fills == 0 && summary.Funding.IsZero() {
+			continue
+		}
+
+		report.GrossRealizedPnL = report.GrossRealizedPnL.Add(summary.GrossRealizedPnL)
+		report.Fees = report.Fees.Add(summary.Fees)
+		report.Funding = report.Funding.Add(summary.Funding)
+		report.Slippage = report.Slippage.Add(summary.Slippage)
+		report.NetRealizedPnL = report.NetRealizedPnL.Add(summary.NetRealizedPnL)
+		report.Fills += summary.Fills
+		report.Symbols = append(report.Symbols, summary)
+	}
+
+	report.Execution = accounting.Quality(report.Symbols)
+}
+
+func (r *runReporter) write(directory string, report *Report) error {
+	dir := filepath.Join(directory, report.RunID)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create report directory: %w", err)
+	}
+
+	files := map[string]func(f *os.File) error{
+		"report.json": func(f *os.File) error {
+			encoder := json.NewEncoder(f)
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(report)
+		},
+		"report.md": func(f *os.File) error {
+			return report.WriteMarkdown(f)
+		},
+		"equity.svg": func(f *os.File) error {
+			return report.WriteEquitySVG(f)
+		},
+		"holdings.csv": func(f *os.File) error {
+			return accounting.ExportClosedLots(f, report.ClosedLots)
+		},
+	}
+
+	for name, render := range files {
+		f, err := os.Create(filepath.Join(dir, name))
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %w", name, err)
+		}
+		err = render(f)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return fmt.Errorf("failed to write %s: %w", name, err)
+		}
+	}
+
+	r.logger.Info("Run report written to %s", dir)
+	return nil
+}
+
+func (r *runReporter) Report(runID string) (*Report, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	report, ok := r.reports[runID]
+	return report, ok
+}
+
+func (r *runReporter) Latest() (*Report, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	report, ok := r.reports[r.latest]
+	return report, ok
+}
+
+func (r *runReporter) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		report, ok := r.Latest()
+		if runID := req.URL.Query().Get("run"); runID != "" {
+			report, ok = r.Report(runID)
+		}
+		if !ok {
+			http.Error(w, "report not found", http.StatusNotFound)
+			return
+		}
+
+		if req.URL.Query().Get("format") == "svg" {
+			w.Header().Set("Content-Type", "image/svg+xml")
+			_ = report.WriteEquitySVG(w)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(report)
+	})
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,64 @@
+// Package runstream pushes a run's live telemetry to subscribers as it
+// happens, so dashboards need not poll the report and log endpoints
+package runstream
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultClientBuffer is how many events a slow subscriber may fall
+	// behind before new ones are dropped for it
+	DefaultClientBuffer = 256
+
+	DefaultMaxClients = 64
+
+	// DefaultHeartbeat keeps idle connections open through proxies
+	DefaultHeartbeat = 15 * time.Second
+
+	// LogKindPrefix is prepended to a run log entry's kind, e.g. log.signal
+	LogKindPrefix = "log."
+)
+
+// Config controls what is relayed and how many subscribers are served
+type Config struct {
+	// Topics are the event bus topics relayed; empty relays every topic
+	// with a built-in schema
+	Topics []string
+
+	ClientBuffer int
+	MaxClients   int
+	Heartbeat    time.Duration
+}
+
+// DefaultConfig relays the built-in topics to up to 64 subscribers
+func DefaultConfig() Config {
+	return Config{
+		ClientBuffer: DefaultClientBuffer,
+		MaxClients:   DefaultMaxClients,
+		Heartbeat:    DefaultHeartbeat,
+	}
+}
+
+func (c *Config) applyDefaults() error {
+	if c.ClientBuffer < 0 {
+		return fmt.Errorf("client buffer must not be negative, got %d", c.ClientBuffer)
+	}
+	if c.MaxClients < 0 {
+		return fmt.Errorf("max clients must not be negative, got %d", c.MaxClients)
+	}
+	if c.Heartbeat < 0 {
+		return fmt.Errorf("heartbeat must not be negative, got %s", c.Heartbeat)
+	}
+	if c.ClientBuffer == 0 {
+		c.ClientBuffer = DefaultClientBuffer
+	}
+	if c.MaxClients == 0 {
+		c.MaxClients = DefaultMaxClients
+	}
+	if c.Heartbeat == 0 {
+		c.Heartbeat = DefaultHeartbeat
+	}
+	return nil
+}
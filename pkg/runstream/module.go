@@ -0,0 +1,23 @@
+package runstream
+
+import (
+	"context"
+
+	"go.uber.org/fx"
+)
+
+var Module = fx.Options(
+	fx.Provide(NewRunStream),
+	fx.Invoke(registerHooks),
+)
+
+func registerHooks(lifecycle fx.Lifecycle, stream RunStream) {
+	lifecycle.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			return stream.Start()
+		},
+		OnStop: func(context.Context) error {
+			return stream.Stop()
+		},
+	})
+}
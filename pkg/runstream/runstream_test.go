@@ -0,0 +1,13 @@
+package runstream_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestRunStream(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "RunStream Suite")
+}
@@ -0,0 +1,355 @@
+package runstream
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/events"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/eventschema"
+	"github.com/backtesting-org/live-trading/pkg/runlog"
+	"github.com/backtesting-org/live-trading/pkg/runreport"
+)
+
+// Event is one item of a run's telemetry. Kind is the bus topic it was
+// published on, e.g. run.events for started and stopped or run.equity for
+// PnL, or LogKindPrefix plus the run log kind for signals, orders and
+// errors captured by the run logger.
+type Event struct {
+	ID    int64       `json:"id"`
+	RunID string      `json:"run_id"`
+	Kind  string      `json:"kind"`
+	At    time.Time   `json:"at"`
+	Data  interface{} `json:"data"`
+}
+
+// Stats is what the stream has relayed since it was created
+type Stats struct {
+	Subscribers int
+	Published   int64
+
+	// Dropped counts events a subscriber missed because it fell behind
+	Dropped int64
+}
+
+// RunStream relays event bus topics and run log entries to subscribers of
+// one run each. Events are tagged with the run named in their payload, or
+// the active run when they name none.
+type RunStream interface {
+	Configure(config Config) error
+
+	// Start attaches to the bus and the run logger. Neither can detach a
+	// single subscriber, so the attachment outlives Stop and only the
+	// relaying is paused.
+	Start() error
+
+	// Stop ends every subscription
+	Stop() error
+
+	// Subscribe receives runID's events whose kind starts with one of
+	// kinds, or all of them when kinds is empty. The channel is closed by
+	// cancel or Stop.
+	Subscribe(runID string, kinds []string) (<-chan Event, func(), error)
+
+	Stats() Stats
+
+	// Handler streams ?run= as server-sent events, defaulting to the active
+	// run; ?kind= narrows it to a comma separated list of kinds or prefixes
+	Handler() http.Handler
+}
+
+type subscriber struct {
+	runID  string
+	kinds  []string
+	events chan Event
+}
+
+func (s *subscriber) wants(event Event) bool {
+	if event.RunID != s.runID {
+		return false
+	}
+	if len(s.kinds) == 0 {
+		return true
+	}
+	for _, kind := range s.kinds {
+		if strings.HasPrefix(event.Kind, kind) {
+			return true
+		}
+	}
+	return false
+}
+
+type runStream struct {
+	bus          events.EventBus
+	runLog       runlog.RunLogger
+	reporter     runreport.RunReporter
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config      Config
+	running     bool
+	attached    map[string]bool
+	sinkAdded   bool
+	subscribers map[*subscriber]struct{}
+	sequence    int64
+	published   int64
+	dropped     int64
+	mu          sync.Mutex
+}
+
+func NewRunStream(
+	bus events.EventBus,
+	runLog runlog.RunLogger,
+	reporter runreport.RunReporter,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) RunStream {
+	return &runStream{
+		bus:          bus,
+		runLog:       runLog,
+		reporter:     reporter,
+		timeProvider: timeProvider,
+		logger:       logger,
+		config:       DefaultConfig(),
+		attached:     make(map[string]bool),
+		subscribers:  make(map[*subscriber]struct{}),
+	}
+}
+
+func (s *runStream) Configure(config Config) error {
+	if err := config.applyDefaults(); err != nil {
+		return err
+	}
+	config.Topics = append([]string(nil), config.Topics...)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.config = config
+	return nil
+}
+
+func (s *runStream) Start() error {
+	s.mu.Lock()
+	topics := s.config.Topics
+	if len(topics) == 0 {
+		for _, schema := range eventschema.DefaultSchemas() {
+			topics = append(topics, schema.Topic)
+		}
+	}
+
+	var subscribe []string
+	for _, topic := range topics {
+		if !s.attached[topic] {
+			s.attached[topic] = true
+			subscribe = append(subscribe, topic)
+		}
+	}
+	addSink := !s.sinkAdded
+	s.sinkAdded = true
+	s.running = true
+	s.mu.Unlock()
+
+	for _, topic := range subscribe {
+		topic := topic
+		s.bus.Subscribe(topic, func(event interface{}) {
+			s.relay(topic, event)
+		})
+	}
+	if addSink {
+		s.runLog.AddSink(&streamSink{stream: s})
+	}
+	return nil
+}
+
+func (s *runStream) Stop() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.running = false
+	for sub := range s.subscribers {
+		close(sub.events)
+		delete(s.subscribers, sub)
+	}
+	return nil
+}
+
+func (s *runStream) Subscribe(runID string, kinds []string) (<-chan Event, func(), error) {
+	if runID == "" {
+		return nil, nil, fmt.Errorf("run ID is required")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.running {
+		return nil, nil, fmt.Errorf("run stream is not running")
+	}
+	if len(s.subscribers) >= s.config.MaxClients {
+		return nil, nil, fmt.Errorf("run stream already has %d subscribers", len(s.subscribers))
+	}
+
+	sub := &subscriber{
+		runID:  runID,
+		kinds:  append([]string(nil), kinds...),
+		events: make(chan Event, s.config.ClientBuffer),
+	}
+	s.subscribers[sub] = struct{}{}
+
+	cancel := func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if _, ok := s.subscribers[sub]; ok {
+			close(sub.events)
+			delete(s.subscribers, sub)
+		}
+	}
+	return sub.events, cancel, nil
+}
+
+func (s *runStream) Stats() Stats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return Stats{Subscribers: len(s.subscribers), Published: s.published, Dropped: s.dropped}
+}
+
+// relay tags a bus event with its run and publishes it
+func (s *runStream) relay(topic string, event interface{}) {
+	runID := ""
+	at := time.Time{}
+	if envelope, ok := event.(eventschema.Envelope); ok {
+		runID, _ = envelope.Payload["RunID"].(string)
+		at = envelope.PublishedAt
+	}
+	if runID == "" {
+		runID, _ = s.reporter.Active()
+	}
+	if runID == "" {
+		return
+	}
+	if at.IsZero() {
+		at = s.timeProvider.Now()
+	}
+	s.publish(Event{RunID: runID, Kind: topic, At: at, Data: event})
+}
+
+// publish hands event to every subscriber that wants it without waiting
+// on any of them
+func (s *runStream) publish(event Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.running {
+		return
+	}
+	s.sequence++
+	event.ID = s.sequence
+	s.published++
+
+	for sub := range s.subscribers {
+		if !sub.wants(event) {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			s.dropped++
+		}
+	}
+}
+
+func (s *runStream) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			http.Error(w, "run stream requires GET", http.StatusMethodNotAllowed)
+			return
+		}
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "streaming is not supported by this connection", http.StatusInternalServerError)
+			return
+		}
+
+		runID := req.URL.Query().Get("run")
+		if runID == "" {
+			runID, _ = s.reporter.Active()
+		}
+		if runID == "" {
+			http.Error(w, "no active run", http.StatusNotFound)
+			return
+		}
+
+		var kinds []string
+		if filter := req.URL.Query().Get("kind"); filter != "" {
+			for _, kind := range strings.Split(filter, ",") {
+				if kind = strings.TrimSpace(kind); kind != "" {
+					kinds = append(kinds, kind)
+				}
+			}
+		}
+
+		stream, cancel, err := s.Subscribe(runID, kinds)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		defer cancel()
+
+		s.mu.Lock()
+		heartbeat := s.config.Heartbeat
+		s.mu.Unlock()
+		ticker := s.timeProvider.NewTicker(heartbeat)
+		defer ticker.Stop()
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		for {
+			select {
+			case <-req.Context().Done():
+				return
+			case <-ticker.C():
+				if _, err := fmt.Fprint(w, ": keepalive\n\n"); err != nil {
+					return
+				}
+			case event, ok := <-stream:
+				if !ok {
+					return
+				}
+				data, err := json.Marshal(event)
+				if err != nil {
+					s.logger.Warn("Run stream event %s not encoded: %v", event.Kind, err)
+					continue
+				}
+				if _, err := fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Kind, data); err != nil {
+					return
+				}
+			}
+			flusher.Flush()
+		}
+	})
+}
+
+// streamSink publishes run log entries as they are flushed. It never fails
+// a write, since a retried batch would be streamed twice.
+type streamSink struct {
+	stream *runStream
+}
+
+func (k *streamSink) Name() string {
+	return "stream"
+}
+
+func (k *streamSink) Write(entries []runlog.Entry) error {
+	for _, entry := range entries {
+		k.stream.publish(Event{RunID: entry.RunID, Kind: LogKindPrefix + entry.Kind, At: entry.At, Data: entry})
+	}
+	return nil
+}
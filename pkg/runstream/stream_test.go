@@ -0,0 +1,101 @@
+package runstream_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/events"
+	sdkregistry "github.com/backtesting-org/kronos-sdk/pkg/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	logger "github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	mockjournal "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/connectors/journal"
+	mockrunlog "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/runlog"
+	mockrunreport "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/runreport"
+	mockscheduler "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/scheduler"
+	"github.com/backtesting-org/live-trading/pkg/connectors/fake"
+	"github.com/backtesting-org/live-trading/pkg/connectors/tracker"
+	"github.com/backtesting-org/live-trading/pkg/eventschema"
+	"github.com/backtesting-org/live-trading/pkg/runstream"
+)
+
+var _ = Describe("RunStream", func() {
+	var (
+		clock    *fake.Clock
+		bus      events.EventBus
+		reporter *mockrunreport.RunReporter
+		stream   runstream.RunStream
+	)
+
+	BeforeEach(func() {
+		clock = fake.NewClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+		bus = eventschema.NewSchemaRegistry(clock, logger.NewNoOpLogger()).Wrap(events.NewEventBus())
+
+		runLog := mockrunlog.NewRunLogger(GinkgoT())
+		runLog.On("AddSink", mock.Anything).Return().Maybe()
+
+		reporter = mockrunreport.NewRunReporter(GinkgoT())
+		reporter.On("Active").Return("run-1", true).Maybe()
+
+		stream = runstream.NewRunStream(bus, runLog, reporter, clock, logger.NewNoOpLogger())
+		Expect(stream.Start()).To(Succeed())
+		DeferCleanup(stream.Stop)
+	})
+
+	It("relays a tracked order's fill to the active run's subscribers", func() {
+		exchange := fake.NewConnector("fake", clock)
+		Expect(exchange.Initialize(&fake.Config{Exchange: "fake"})).To(Succeed())
+
+		connectors := sdkregistry.NewConnectorRegistry()
+		connectors.RegisterConnector("fake", exchange)
+
+		jobs := mockscheduler.NewScheduler(GinkgoT())
+		journal := mockjournal.NewOrderJournal(GinkgoT())
+		journal.On("Record", mock.Anything).Return(nil).Maybe()
+		orders := tracker.NewOrderTracker(connectors, jobs, journal, bus, clock, logger.NewNoOpLogger())
+
+		received, cancel, err := stream.Subscribe("run-1", []string{eventschema.TopicFill})
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(cancel)
+
+		order, err := exchange.PlaceLimitOrder("BTC-PERP", connector.OrderSideBuy, numerical.NewFromInt(1), numerical.NewFromInt(90))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(orders.Track("fake", order)).To(Succeed())
+		Expect(exchange.Fill(order.OrderID, numerical.Zero())).To(Succeed())
+		Expect(orders.Poll()).To(Succeed())
+
+		var event runstream.Event
+		Eventually(received).Should(Receive(&event))
+		Expect(event.RunID).To(Equal("run-1"))
+		Expect(event.Kind).To(Equal(eventschema.TopicFill))
+
+		envelope, ok := event.Data.(eventschema.Envelope)
+		Expect(ok).To(BeTrue())
+		Expect(envelope.Payload["OrderID"]).To(Equal(order.OrderID))
+		fill, ok := envelope.Event.(tracker.FillEvent)
+		Expect(ok).To(BeTrue())
+		Expect(fill.Quantity.Equal(numerical.NewFromInt(1))).To(BeTrue())
+	})
+
+	It("does not relay fills to subscribers of another run", func() {
+		received, cancel, err := stream.Subscribe("run-2", nil)
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(cancel)
+
+		bus.Publish(eventschema.TopicFill, tracker.FillEvent{
+			Exchange:  "fake",
+			OrderID:   "fake-1",
+			Symbol:    "BTC-PERP",
+			Side:      connector.OrderSideBuy,
+			Quantity:  numerical.NewFromInt(1),
+			Price:     numerical.NewFromInt(90),
+			FilledQty: numerical.NewFromInt(1),
+			Timestamp: clock.Now(),
+		})
+
+		Consistently(received, 100*time.Millisecond).ShouldNot(Receive())
+	})
+})
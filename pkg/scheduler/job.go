@@ -0,0 +1,85 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+)
+
+// Job is a unit of recurring background work registered with the Scheduler
+type Job struct {
+	Name string
+
+	// Interval is the time between scheduled runs
+	Interval time.Duration
+
+	// Jitter adds a random delay in [0, Jitter) before each run so jobs
+	// sharing an interval do not all fire on the same tick
+	Jitter time.Duration
+
+	// RunOnStart triggers a run immediately when the scheduler starts
+	RunOnStart bool
+
+	Run func(ctx context.Context) error
+}
+
+// RunRecord is the outcome of a single scheduled run of a job
+type RunRecord struct {
+	Job        string
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Err        string
+
+	// Skipped is set when the tick fired while the previous run was still in progress
+	Skipped bool
+}
+
+// JobStatus is a point-in-time view of a registered job
+type JobStatus struct {
+	Name     string
+	Interval time.Duration
+	Enabled  bool
+	Running  bool
+	LastRun  *RunRecord
+}
+
+// HistoryStore persists run records. The default keeps a bounded in-memory
+// ring per job; a durable store can be swapped in with SetHistoryStore.
+type HistoryStore interface {
+	Record(record RunRecord)
+	Recent(job string, limit int) []RunRecord
+}
+
+type memoryHistory struct {
+	capacity int
+	records  map[string][]RunRecord
+}
+
+// newMemoryHistory is not safe for concurrent use; the scheduler serialises access
+func newMemoryHistory(capacity int) *memoryHistory {
+	return &memoryHistory{
+		capacity: capacity,
+		records:  make(map[string][]RunRecord),
+	}
+}
+
+func (m *memoryHistory) Record(record RunRecord) {
+	records := append(m.records[record.Job], record)
+	if len(records) > m.capacity {
+		records = records[len(records)-m.capacity:]
+	}
+	m.records[record.Job] = records
+}
+
+func (m *memoryHistory) Recent(job string, limit int) []RunRecord {
+	records := m.records[job]
+	if limit > 0 && len(records) > limit {
+		records = records[len(records)-limit:]
+	}
+
+	// Newest first
+	result := make([]RunRecord, len(records))
+	for i, record := range records {
+		result[len(records)-1-i] = record
+	}
+	return result
+}
@@ -0,0 +1,143 @@
+package scheduler_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	logger "github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/live-trading/pkg/connectors/fake"
+	"github.com/backtesting-org/live-trading/pkg/scheduler"
+)
+
+var _ = Describe("Scheduler", func() {
+	var (
+		clock *fake.Clock
+		jobs  scheduler.Scheduler
+		runs  atomic.Int32
+	)
+
+	count := func(context.Context) error {
+		runs.Add(1)
+		return nil
+	}
+
+	// advance moves the clock once every started job loop is waiting on it
+	advance := func(d time.Duration, waiting int) {
+		Eventually(clock.Pending).Should(BeNumerically(">=", waiting))
+		clock.Advance(d)
+	}
+
+	BeforeEach(func() {
+		clock = fake.NewClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+		jobs = scheduler.NewScheduler(clock, logger.NewNoOpLogger())
+		runs.Store(0)
+	})
+
+	AfterEach(func() {
+		jobs.Stop()
+	})
+
+	It("validates jobs on registration", func() {
+		Expect(jobs.Register(scheduler.Job{Interval: time.Second, Run: count})).NotTo(Succeed())
+		Expect(jobs.Register(scheduler.Job{Name: "a", Run: count})).NotTo(Succeed())
+		Expect(jobs.Register(scheduler.Job{Name: "a", Interval: time.Second})).NotTo(Succeed())
+		Expect(jobs.Register(scheduler.Job{Name: "a", Interval: time.Second, Run: count})).To(Succeed())
+		Expect(jobs.Register(scheduler.Job{Name: "a", Interval: time.Second, Run: count})).NotTo(Succeed())
+	})
+
+	It("runs nothing until started", func() {
+		Expect(jobs.Register(scheduler.Job{Name: "a", Interval: time.Second, RunOnStart: true, Run: count})).To(Succeed())
+		clock.Advance(10 * time.Second)
+		Consistently(runs.Load, "50ms").Should(BeZero())
+	})
+
+	It("runs jobs on start and on every interval", func() {
+		Expect(jobs.Register(scheduler.Job{Name: "a", Interval: time.Second, RunOnStart: true, Run: count})).To(Succeed())
+		Expect(jobs.Start(context.Background())).To(Succeed())
+		Eventually(runs.Load).Should(BeEquivalentTo(1))
+
+		advance(time.Second, 1)
+		Eventually(runs.Load).Should(BeEquivalentTo(2))
+		advance(time.Second, 1)
+		Eventually(runs.Load).Should(BeEquivalentTo(3))
+
+		status := jobs.Jobs()
+		Expect(status).To(HaveLen(1))
+		Expect(status[0].LastRun).NotTo(BeNil())
+	})
+
+	It("starts jobs registered after start", func() {
+		Expect(jobs.Start(context.Background())).To(Succeed())
+		Expect(jobs.Register(scheduler.Job{Name: "late", Interval: time.Second, Run: count})).To(Succeed())
+
+		advance(time.Second, 1)
+		Eventually(runs.Load).Should(BeEquivalentTo(1))
+	})
+
+	It("skips disabled jobs until enabled again", func() {
+		Expect(jobs.Register(scheduler.Job{Name: "a", Interval: time.Second, Run: count})).To(Succeed())
+		Expect(jobs.Start(context.Background())).To(Succeed())
+		Expect(jobs.Disable("a")).To(Succeed())
+
+		advance(time.Second, 1)
+		Consistently(runs.Load, "50ms").Should(BeZero())
+
+		Expect(jobs.Enable("a")).To(Succeed())
+		advance(time.Second, 1)
+		Eventually(runs.Load).Should(BeEquivalentTo(1))
+	})
+
+	It("records failures and panics in the history, newest first", func() {
+		calls := 0
+		Expect(jobs.Register(scheduler.Job{Name: "flaky", Interval: time.Hour, Run: func(context.Context) error {
+			calls++
+			if calls == 1 {
+				return errors.New("boom")
+			}
+			panic("worse")
+		}})).To(Succeed())
+
+		Expect(jobs.Trigger("flaky")).To(Succeed())
+		Expect(jobs.Trigger("flaky")).To(Succeed())
+
+		history, err := jobs.History("flaky", 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(history).To(HaveLen(2))
+		Expect(history[0].Err).To(ContainSubstring("panic: worse"))
+		Expect(history[1].Err).To(Equal("boom"))
+	})
+
+	It("refuses to trigger a job that is still running", func() {
+		release := make(chan struct{})
+		started := make(chan struct{})
+		Expect(jobs.Register(scheduler.Job{Name: "slow", Interval: time.Hour, Run: func(context.Context) error {
+			close(started)
+			<-release
+			return nil
+		}})).To(Succeed())
+
+		go func() {
+			defer GinkgoRecover()
+			Expect(jobs.Trigger("slow")).To(Succeed())
+		}()
+		Eventually(started).Should(BeClosed())
+		Expect(jobs.Trigger("slow")).To(MatchError(ContainSubstring("already running")))
+		close(release)
+	})
+
+	It("stops every job loop on Stop", func() {
+		Expect(jobs.Register(scheduler.Job{Name: "a", Interval: time.Second, Run: count})).To(Succeed())
+		Expect(jobs.Start(context.Background())).To(Succeed())
+		Eventually(clock.Pending).Should(Equal(1))
+
+		jobs.Stop()
+		Expect(clock.Pending()).To(BeZero())
+		clock.Advance(time.Minute)
+		Consistently(runs.Load, "50ms").Should(BeZero())
+	})
+})
@@ -0,0 +1,27 @@
+package scheduler
+
+import (
+	"context"
+
+	"go.uber.org/fx"
+)
+
+var Module = fx.Options(
+	fx.Provide(NewScheduler),
+	fx.Invoke(registerHooks),
+)
+
+// registerHooks starts the scheduler with the app so every registered job
+// runs. The start hook's context expires once startup completes, so jobs
+// run under their own context, cancelled by Stop.
+func registerHooks(lifecycle fx.Lifecycle, scheduler Scheduler) {
+	lifecycle.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			return scheduler.Start(context.Background())
+		},
+		OnStop: func(context.Context) error {
+			scheduler.Stop()
+			return nil
+		},
+	})
+}
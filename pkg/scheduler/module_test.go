@@ -0,0 +1,57 @@
+package scheduler_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+
+	rtime "github.com/backtesting-org/kronos-sdk/pkg/runtime/time"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/scheduler"
+)
+
+var _ = Describe("Module", func() {
+	It("starts the scheduler with the app and stops it with the app", func() {
+		fired := make(chan struct{}, 16)
+		var jobs scheduler.Scheduler
+
+		app := fxtest.New(GinkgoT(),
+			scheduler.Module,
+			fx.Provide(
+				func() temporal.TimeProvider { return rtime.NewTimeProvider() },
+				func() logging.ApplicationLogger { return logging.NewNoOpLogger() },
+			),
+			fx.Invoke(func(s scheduler.Scheduler) error {
+				jobs = s
+				return s.Register(scheduler.Job{
+					Name:       "probe",
+					Interval:   10 * time.Millisecond,
+					RunOnStart: true,
+					Run: func(context.Context) error {
+						select {
+						case fired <- struct{}{}:
+						default:
+						}
+						return nil
+					},
+				})
+			}),
+		)
+
+		Consistently(fired, "30ms").ShouldNot(Receive())
+
+		app.RequireStart()
+		Eventually(fired).Should(Receive())
+		Eventually(fired).Should(Receive())
+		Expect(jobs.Jobs()[0].LastRun).NotTo(BeNil())
+
+		app.RequireStop()
+		Expect(jobs.Start(context.Background())).To(Succeed())
+		jobs.Stop()
+	})
+})
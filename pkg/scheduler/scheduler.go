@@ -0,0 +1,320 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+)
+
+const defaultHistoryCapacity = 100
+
+// Scheduler runs registered jobs on fixed intervals, replacing ad hoc
+// per-subsystem ticker goroutines
+type Scheduler interface {
+	// Register adds a job; jobs registered after Start begin immediately
+	Register(job Job) error
+	Unregister(name string) error
+
+	Enable(name string) error
+	Disable(name string) error
+
+	// Trigger runs a job now, outside its schedule, unless it is already running
+	Trigger(name string) error
+
+	Start(ctx context.Context) error
+	Stop()
+
+	Jobs() []JobStatus
+	History(name string, limit int) ([]RunRecord, error)
+	SetHistoryStore(store HistoryStore)
+}
+
+type scheduledJob struct {
+	job     Job
+	enabled bool
+	running bool
+	lastRun *RunRecord
+	cancel  context.CancelFunc
+}
+
+type scheduler struct {
+	jobs         map[string]*scheduledJob
+	history      HistoryStore
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	ctx     context.Context
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	started bool
+	mu      sync.Mutex
+}
+
+func NewScheduler(
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) Scheduler {
+	return &scheduler{
+		jobs:         make(map[string]*scheduledJob),
+		history:      newMemoryHistory(defaultHistoryCapacity),
+		timeProvider: timeProvider,
+		logger:       logger,
+	}
+}
+
+func (s *scheduler) Register(job Job) error {
+	if job.Name == "" {
+		return fmt.Errorf("job name is required")
+	}
+	if job.Interval <= 0 {
+		return fmt.Errorf("job %s: interval must be positive", job.Name)
+	}
+	if job.Run == nil {
+		return fmt.Errorf("job %s: run function is required", job.Name)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.jobs[job.Name]; exists {
+		return fmt.Errorf("job %s already registered", job.Name)
+	}
+
+	sj := &scheduledJob{job: job, enabled: true}
+	s.jobs[job.Name] = sj
+
+	if s.started {
+		s.launch(sj)
+	}
+
+	s.logger.Info("Registered job %s (every %s)", job.Name, job.Interval)
+	return nil
+}
+
+func (s *scheduler) Unregister(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sj, exists := s.jobs[name]
+	if !exists {
+		return fmt.Errorf("job %s not registered", name)
+	}
+
+	if sj.cancel != nil {
+		sj.cancel()
+	}
+	delete(s.jobs, name)
+	return nil
+}
+
+func (s *scheduler) Enable(name string) error {
+	return s.setEnabled(name, true)
+}
+
+func (s *scheduler) Disable(name string) error {
+	return s.setEnabled(name, false)
+}
+
+func (s *scheduler) setEnabled(name string, enabled bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sj, exists := s.jobs[name]
+	if !exists {
+		return fmt.Errorf("job %s not registered", name)
+	}
+
+	sj.enabled = enabled
+	return nil
+}
+
+func (s *scheduler) Trigger(name string) error {
+	s.mu.Lock()
+	sj, exists := s.jobs[name]
+	ctx := s.ctx
+	s.mu.Unlock()
+
+	if !exists {
+		return fmt.Errorf("job %s not registered", name)
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if !s.execute(ctx, sj) {
+		return fmt.Errorf("job %s is already running", name)
+	}
+	return nil
+}
+
+func (s *scheduler) Start(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.started {
+		return fmt.Errorf("scheduler already started")
+	}
+
+	s.ctx, s.cancel = context.WithCancel(ctx)
+	s.started = true
+
+	for _, sj := range s.jobs {
+		s.launch(sj)
+	}
+
+	s.logger.Info("Scheduler started with %d jobs", len(s.jobs))
+	return nil
+}
+
+func (s *scheduler) Stop() {
+	s.mu.Lock()
+	if !s.started {
+		s.mu.Unlock()
+		return
+	}
+	s.cancel()
+	s.started = false
+	s.mu.Unlock()
+
+	s.wg.Wait()
+	s.logger.Info("Scheduler stopped")
+}
+
+func (s *scheduler) Jobs() []JobStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	statuses := make([]JobStatus, 0, len(s.jobs))
+	for _, sj := range s.jobs {
+		statuses = append(statuses, JobStatus{
+			Name:     sj.job.Name,
+			Interval: sj.job.Interval,
+			Enabled:  sj.enabled,
+			Running:  sj.running,
+			LastRun:  sj.lastRun,
+		})
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Name < statuses[j].Name
+	})
+	return statuses
+}
+
+func (s *scheduler) History(name string, limit int) ([]RunRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.jobs[name]; !exists {
+		return nil, fmt.Errorf("job %s not registered", name)
+	}
+	return s.history.Recent(name, limit), nil
+}
+
+func (s *scheduler) SetHistoryStore(store HistoryStore) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.history = store
+}
+
+// launch starts the job's ticker loop; caller must hold s.mu
+func (s *scheduler) launch(sj *scheduledJob) {
+	ctx, cancel := context.WithCancel(s.ctx)
+	sj.cancel = cancel
+
+	s.wg.Add(1)
+	go s.loop(ctx, sj)
+}
+
+func (s *scheduler) loop(ctx context.Context, sj *scheduledJob) {
+	defer s.wg.Done()
+
+	if sj.job.RunOnStart {
+		s.tick(ctx, sj)
+	}
+
+	ticker := s.timeProvider.NewTicker(sj.job.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C():
+			s.tick(ctx, sj)
+		}
+	}
+}
+
+func (s *scheduler) tick(ctx context.Context, sj *scheduledJob) {
+	s.mu.Lock()
+	enabled := sj.enabled
+	s.mu.Unlock()
+
+	if !enabled {
+		return
+	}
+
+	if sj.job.Jitter > 0 {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.timeProvider.After(time.Duration(rand.Int63n(int64(sj.job.Jitter)))):
+		}
+	}
+
+	if !s.execute(ctx, sj) {
+		now := s.timeProvider.Now()
+		s.record(sj, RunRecord{Job: sj.job.Name, StartedAt: now, FinishedAt: now, Skipped: true})
+		s.logger.Warn("Job %s skipped: previous run still in progress", sj.job.Name)
+	}
+}
+
+// execute runs the job synchronously; returns false if a run is already in progress
+func (s *scheduler) execute(ctx context.Context, sj *scheduledJob) bool {
+	s.mu.Lock()
+	if sj.running {
+		s.mu.Unlock()
+		return false
+	}
+	sj.running = true
+	s.mu.Unlock()
+
+	record := RunRecord{Job: sj.job.Name, StartedAt: s.timeProvider.Now()}
+	err := s.safeRun(ctx, sj.job)
+	record.FinishedAt = s.timeProvider.Now()
+	if err != nil {
+		record.Err = err.Error()
+		s.logger.Error("Job %s failed: %v", sj.job.Name, err)
+	}
+
+	s.mu.Lock()
+	sj.running = false
+	s.mu.Unlock()
+
+	s.record(sj, record)
+	return true
+}
+
+func (s *scheduler) safeRun(ctx context.Context, job Job) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return job.Run(ctx)
+}
+
+func (s *scheduler) record(sj *scheduledJob, record RunRecord) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sj.lastRun = &record
+	s.history.Record(record)
+}
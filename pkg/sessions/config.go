@@ -0,0 +1,105 @@
+package sessions
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultCheckInterval is how often the session state is re-evaluated
+	DefaultCheckInterval = 15 * time.Second
+
+	// DefaultTimezone is used when a config names none
+	DefaultTimezone = "UTC"
+
+	// JobName is the scheduler job that opens and closes sessions
+	JobName = "trading-sessions"
+
+	clockLayout = "15:04"
+)
+
+// Window is one recurring period in which trading is allowed. Start and End
+// are wall-clock times as "HH:MM" in the config's timezone; an End before
+// Start runs past midnight into the next day.
+type Window struct {
+	// Days the window opens on; empty means every day
+	Days  []time.Weekday
+	Start string
+	End   string
+}
+
+// Config defines a run's trading sessions. With no windows the session is
+// always open.
+type Config struct {
+	Timezone string
+	Windows  []Window
+
+	// CancelOrdersOnClose cancels resting orders on every ready connector
+	// when a session closes
+	CancelOrdersOnClose bool
+
+	CheckInterval time.Duration
+}
+
+// DefaultConfig is always open, checked every fifteen seconds
+func DefaultConfig() Config {
+	return Config{
+		Timezone:      DefaultTimezone,
+		CheckInterval: DefaultCheckInterval,
+	}
+}
+
+// Weekdays is Monday through Friday, for windows like 13:00–21:00 UTC weekdays
+func Weekdays() []time.Weekday {
+	return []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+}
+
+// window is a Window parsed to minutes after midnight
+type window struct {
+	days  map[time.Weekday]bool
+	start int
+	end   int
+}
+
+func parseWindow(w Window) (window, error) {
+	start, err := time.Parse(clockLayout, w.Start)
+	if err != nil {
+		return window{}, fmt.Errorf("invalid window start %q: %w", w.Start, err)
+	}
+	end, err := time.Parse(clockLayout, w.End)
+	if err != nil {
+		return window{}, fmt.Errorf("invalid window end %q: %w", w.End, err)
+	}
+
+	parsed := window{
+		start: start.Hour()*60 + start.Minute(),
+		end:   end.Hour()*60 + end.Minute(),
+	}
+	if parsed.start == parsed.end {
+		return window{}, fmt.Errorf("window %s-%s is empty", w.Start, w.End)
+	}
+	if len(w.Days) > 0 {
+		parsed.days = make(map[time.Weekday]bool, len(w.Days))
+		for _, day := range w.Days {
+			parsed.days[day] = true
+		}
+	}
+	return parsed, nil
+}
+
+// contains reports whether local falls in the window. A window past
+// midnight belongs to the day it opens on.
+func (w window) contains(local time.Time) bool {
+	minute := local.Hour()*60 + local.Minute()
+	opensOn := func(day time.Weekday) bool {
+		return w.days == nil || w.days[day]
+	}
+
+	if w.start < w.end {
+		return opensOn(local.Weekday()) && minute >= w.start && minute < w.end
+	}
+	if minute >= w.start {
+		return opensOn(local.Weekday())
+	}
+	return minute < w.end && opensOn(local.AddDate(0, 0, -1).Weekday())
+}
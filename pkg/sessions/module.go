@@ -0,0 +1,7 @@
+package sessions
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(NewTradingSessionScheduler),
+)
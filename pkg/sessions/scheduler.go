@@ -0,0 +1,284 @@
+package sessions
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/runreport"
+	"github.com/backtesting-org/live-trading/pkg/scheduler"
+)
+
+// EventKind is the run report event kind session transitions are logged under
+const EventKind = "session"
+
+// Transition is a session opening or closing
+type Transition struct {
+	Open bool
+	At   time.Time
+
+	// Strategies were paused on close, or resumed on open
+	Strategies []strategy.StrategyName
+
+	// CancelledOrders is only set on close with CancelOrdersOnClose
+	CancelledOrders int
+	Errors          []string
+}
+
+// TradingSessionScheduler confines a run to its trading windows. When a
+// session closes, enabled strategies are disabled so the orchestrator stops
+// polling them for signals, and resting orders are optionally cancelled;
+// when it reopens, the strategies it paused are enabled again. Every
+// transition is logged to the active run's report.
+type TradingSessionScheduler interface {
+	Configure(config Config) error
+
+	Start() error
+
+	// Stop resumes anything paused by a closed session
+	Stop() error
+
+	// Check evaluates the session now and applies any transition
+	Check() (*Transition, error)
+
+	Open() bool
+	Transitions() <-chan Transition
+}
+
+type tradingSessionScheduler struct {
+	strategies   registry.StrategyRegistry
+	connectors   registry.ConnectorRegistry
+	reporter     runreport.RunReporter
+	scheduler    scheduler.Scheduler
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config       Config
+	location     *time.Location
+	windows      []window
+	open         bool
+	paused       []strategy.StrategyName
+	transitionCh chan Transition
+	mu           sync.Mutex
+}
+
+func NewTradingSessionScheduler(
+	strategyRegistry registry.StrategyRegistry,
+	connectorRegistry registry.ConnectorRegistry,
+	reporter runreport.RunReporter,
+	jobScheduler scheduler.Scheduler,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) TradingSessionScheduler {
+	return &tradingSessionScheduler{
+		strategies:   strategyRegistry,
+		connectors:   connectorRegistry,
+		reporter:     reporter,
+		scheduler:    jobScheduler,
+		timeProvider: timeProvider,
+		logger:       logger,
+		config:       DefaultConfig(),
+		location:     time.UTC,
+		open:         true,
+		transitionCh: make(chan Transition, 100),
+	}
+}
+
+func (s *tradingSessionScheduler) Configure(config Config) error {
+	if config.Timezone == "" {
+		config.Timezone = DefaultTimezone
+	}
+	if config.CheckInterval <= 0 {
+		config.CheckInterval = DefaultCheckInterval
+	}
+
+	location, err := time.LoadLocation(config.Timezone)
+	if err != nil {
+		return fmt.Errorf("invalid session timezone: %w", err)
+	}
+
+	windows := make([]window, 0, len(config.Windows))
+	for _, w := range config.Windows {
+		parsed, err := parseWindow(w)
+		if err != nil {
+			return err
+		}
+		windows = append(windows, parsed)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.config = config
+	s.location = location
+	s.windows = windows
+	return nil
+}
+
+func (s *tradingSessionScheduler) Start() error {
+	s.mu.Lock()
+	interval := s.config.CheckInterval
+	s.mu.Unlock()
+
+	return s.scheduler.Register(scheduler.Job{
+		Name:       JobName,
+		Interval:   interval,
+		RunOnStart: true,
+		Run: func(_ context.Context) error {
+			_, err := s.Check()
+			return err
+		},
+	})
+}
+
+func (s *tradingSessionScheduler) Stop() error {
+	if err := s.scheduler.Unregister(JobName); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	closed := !s.open
+	s.mu.Unlock()
+	if closed {
+		_, err := s.transition(true, s.timeProvider.Now())
+		return err
+	}
+	return nil
+}
+
+func (s *tradingSessionScheduler) Open() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.open
+}
+
+func (s *tradingSessionScheduler) Transitions() <-chan Transition {
+	return s.transitionCh
+}
+
+func (s *tradingSessionScheduler) Check() (*Transition, error) {
+	now := s.timeProvider.Now()
+
+	s.mu.Lock()
+	shouldOpen := s.inSession(now)
+	changed := shouldOpen != s.open
+	s.mu.Unlock()
+
+	if !changed {
+		return nil, nil
+	}
+	return s.transition(shouldOpen, now)
+}
+
+// inSession reports whether now falls in any window; caller must hold s.mu
+func (s *tradingSessionScheduler) inSession(now time.Time) bool {
+	if len(s.windows) == 0 {
+		return true
+	}
+	local := now.In(s.location)
+	for _, w := range s.windows {
+		if w.contains(local) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *tradingSessionScheduler) transition(open bool, at time.Time) (*Transition, error) {
+	s.mu.Lock()
+	config := s.config
+	paused := s.paused
+	s.open = open
+	s.paused = nil
+	s.mu.Unlock()
+
+	transition := &Transition{Open: open, At: at}
+
+	if open {
+		for _, name := range paused {
+			if err := s.strategies.EnableStrategy(name); err != nil {
+				transition.Errors = append(transition.Errors, fmt.Sprintf("resume %s: %v", name, err))
+				continue
+			}
+			transition.Strategies = append(transition.Strategies, name)
+		}
+	} else {
+		for _, strat := range s.strategies.GetEnabledStrategies() {
+			name := strat.GetName()
+			if err := s.strategies.DisableStrategy(name); err != nil {
+				transition.Errors = append(transition.Errors, fmt.Sprintf("pause %s: %v", name, err))
+				continue
+			}
+			transition.Strategies = append(transition.Strategies, name)
+		}
+		sort.Slice(transition.Strategies, func(i, j int) bool { return transition.Strategies[i] < transition.Strategies[j] })
+
+		s.mu.Lock()
+		s.paused = transition.Strategies
+		s.mu.Unlock()
+
+		if config.CancelOrdersOnClose {
+			transition.CancelledOrders = s.cancelResting(transition)
+		}
+	}
+
+	message := s.describe(transition)
+	s.reporter.Event(EventKind, message)
+	s.logger.Info(message)
+
+	select {
+	case s.transitionCh <- *transition:
+	default:
+	}
+
+	if len(transition.Errors) > 0 {
+		return transition, fmt.Errorf("session transition incomplete: %v", transition.Errors)
+	}
+	return transition, nil
+}
+
+func (s *tradingSessionScheduler) cancelResting(transition *Transition) int {
+	cancelled := 0
+	for _, conn := range s.connectors.GetReadyConnectors() {
+		if !conn.SupportsTradingOperations() {
+			continue
+		}
+		name := conn.GetConnectorInfo().Name
+
+		orders, err := conn.GetOpenOrders()
+		if err != nil {
+			transition.Errors = append(transition.Errors, fmt.Sprintf("%s: fetch open orders: %v", name, err))
+			continue
+		}
+		for _, order := range orders {
+			if _, err := conn.CancelOrder(order.Symbol, order.ID); err != nil {
+				transition.Errors = append(transition.Errors, fmt.Sprintf("%s: cancel %s: %v", name, order.ID, err))
+				continue
+			}
+			cancelled++
+		}
+	}
+	return cancelled
+}
+
+func (s *tradingSessionScheduler) describe(transition *Transition) string {
+	if transition.Open {
+		return fmt.Sprintf("Trading session opened at %s; resumed %d strategies",
+			transition.At.In(s.location).Format(time.RFC3339), len(transition.Strategies))
+	}
+
+	message := fmt.Sprintf("Trading session closed at %s; paused %d strategies",
+		transition.At.In(s.location).Format(time.RFC3339), len(transition.Strategies))
+	s.mu.Lock()
+	cancel := s.config.CancelOrdersOnClose
+	s.mu.Unlock()
+	if cancel {
+		message += fmt.Sprintf(", cancelled %d resting orders", transition.CancelledOrders)
+	}
+	return message
+}
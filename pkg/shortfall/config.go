@@ -0,0 +1,85 @@
+// Package shortfall handles orders an exchange rejects for insufficient
+// balance, downsizing or pausing the run that sent them instead of letting
+// it repeat the rejection every tick
+package shortfall
+
+import (
+	"fmt"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+)
+
+// Mode is what happens to a run once one of its orders is rejected
+// for insufficient balance
+type Mode string
+
+const (
+	// ModeDownsize scales the run's later entries down on each rejection,
+	// by Factor or to the share of the order's margin the balance covered
+	// if that is smaller, and pauses it once the scale would fall below
+	// MinScale
+	ModeDownsize Mode = "downsize"
+
+	// ModePause refuses the run's entries until it is resumed
+	ModePause Mode = "pause"
+)
+
+// LogKind is the run log kind rejections and refused entries are recorded
+// under
+const LogKind = "shortfall"
+
+// Config controls the rejection policy
+type Config struct {
+	Mode Mode
+
+	// Factor multiplies a downsized run's scale on each rejection
+	Factor numerical.Decimal
+
+	// MinScale is the smallest scale a run is downsized to before it
+	// is paused instead
+	MinScale numerical.Decimal
+
+	// MaxRejections is how many rejections are kept for Rejections
+	MaxRejections int
+}
+
+// DefaultConfig halves a run's entries on each rejection and pauses
+// it after the third, when they would be under a quarter of their size
+func DefaultConfig() Config {
+	return Config{
+		Mode:          ModeDownsize,
+		Factor:        numerical.NewFromFloat(0.5),
+		MinScale:      numerical.NewFromFloat(0.25),
+		MaxRejections: 500,
+	}
+}
+
+func (c *Config) applyDefaults() error {
+	defaults := DefaultConfig()
+	switch c.Mode {
+	case "":
+		c.Mode = defaults.Mode
+	case ModeDownsize, ModePause:
+	default:
+		return fmt.Errorf("unknown shortfall mode %q", c.Mode)
+	}
+	if c.Factor.IsZero() {
+		c.Factor = defaults.Factor
+	}
+	if !c.Factor.IsPositive() || c.Factor.GreaterThanOrEqual(numerical.NewFromInt(1)) {
+		return fmt.Errorf("downsize factor must be between 0 and 1, got %s", c.Factor)
+	}
+	if c.MinScale.IsZero() {
+		c.MinScale = defaults.MinScale
+	}
+	if !c.MinScale.IsPositive() || c.MinScale.GreaterThan(numerical.NewFromInt(1)) {
+		return fmt.Errorf("min scale must be between 0 and 1, got %s", c.MinScale)
+	}
+	if c.MaxRejections < 0 {
+		return fmt.Errorf("max rejections must not be negative")
+	}
+	if c.MaxRejections == 0 {
+		c.MaxRejections = defaults.MaxRejections
+	}
+	return nil
+}
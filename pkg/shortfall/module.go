@@ -0,0 +1,7 @@
+package shortfall
+
+import "go.uber.org/fx"
+
+var Module = fx.Options(
+	fx.Provide(NewShortfallPolicy),
+)
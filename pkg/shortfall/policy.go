@@ -0,0 +1,553 @@
+package shortfall
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/events"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/execution"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/markprice"
+	"github.com/backtesting-org/live-trading/pkg/connectors/symbols"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+	"github.com/backtesting-org/live-trading/pkg/eventschema"
+	"github.com/backtesting-org/live-trading/pkg/margin"
+	"github.com/backtesting-org/live-trading/pkg/runlog"
+	"github.com/backtesting-org/live-trading/pkg/runreport"
+)
+
+// ErrStrategyHeldBack is returned for a signal refused because its run is
+// paused, or downsized below what the exchange will accept, after its orders
+// were rejected for insufficient balance
+var ErrStrategyHeldBack = errors.New("strategy held back after insufficient balance rejections")
+
+// Outcome is what a rejection did to its run
+type Outcome string
+
+const (
+	OutcomeDownsized Outcome = "downsized"
+	OutcomePaused    Outcome = "paused"
+)
+
+// Rejection is one order an exchange refused for insufficient balance. It is
+// published on eventschema.TopicInsufficientBalance as the alert.
+type Rejection struct {
+	RunID    string
+	SignalID string
+	Strategy strategy.StrategyName
+	Exchange connector.ExchangeName
+	Symbol   string
+	Side     connector.OrderSide
+	Quantity numerical.Decimal
+	Price    numerical.Decimal
+
+	// Required is the order's notional over the run's maximum leverage, the
+	// margin it would have tied up; Available is the balance the connector
+	// read at rejection time, and Shortfall what Required exceeds it by.
+	// The price is the order's own, else the mark, else the connector's
+	// last price; Required and Shortfall are zero only when none is known.
+	Required  numerical.Decimal
+	Available numerical.Decimal
+	Shortfall numerical.Decimal
+
+	Outcome Outcome
+
+	// Scale is what the run's entries are multiplied by from now on
+	Scale numerical.Decimal
+	Error string
+	At    time.Time
+}
+
+// State is how a run is held back; Strategy is the strategy whose order was
+// rejected last
+type State struct {
+	RunID         string
+	Strategy      strategy.StrategyName
+	Scale         numerical.Decimal
+	Paused        bool
+	Rejections    int
+	LastRejection time.Time
+}
+
+// ShortfallPolicy sits in front of the executor and watches for orders the
+// exchange rejects for insufficient balance. Each rejection is recorded with
+// the balance shortfall, published as an alert and, per the configured
+// mode, scales the later entries of the run that sent it down or pauses
+// them until Resume. A strategy belongs to the run the margin calculator
+// binds it to, else the active run, so a fresh run starts at full size.
+// Orders that only shrink a position are never held back; one whose account
+// cannot be read is judged by its action, buys and short sales opening and
+// sells and covers closing.
+type ShortfallPolicy interface {
+	Configure(config Config) error
+
+	// Check returns the signal to execute, with entries scaled for a
+	// downsized strategy, or ErrStrategyHeldBack, wrapped, when it should
+	// not execute
+	Check(signal *strategy.Signal) (*strategy.Signal, error)
+
+	// Record applies the policy to a signal's execution error, reporting
+	// false when err is not an insufficient-balance rejection
+	Record(signal *strategy.Signal, err error) (Rejection, bool)
+
+	// Resume lifts a run's downsizing or pause
+	Resume(runID string) error
+
+	State(runID string) (State, bool)
+	States() []State
+
+	// Rejections are the most recent rejections, oldest first
+	Rejections() []Rejection
+
+	// Wrap returns an executor that applies the policy around inner
+	Wrap(inner execution.Executor) execution.Executor
+
+	// Handler serves states and rejections on GET and resumes ?run= on
+	// POST
+	Handler() http.Handler
+}
+
+type shortfallPolicy struct {
+	registry     registry.ConnectorRegistry
+	assets       registry.AssetRegistry
+	marks        markprice.MarkPriceFeed
+	symbols      symbols.SymbolMapper
+	calculator   margin.MarginCalculator
+	reporter     runreport.RunReporter
+	bus          events.EventBus
+	runLog       runlog.RunLogger
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config     Config
+	states     map[string]*State
+	rejections []Rejection
+	mu         sync.Mutex
+}
+
+func NewShortfallPolicy(
+	connectorRegistry registry.ConnectorRegistry,
+	assetRegistry registry.AssetRegistry,
+	markPriceFeed markprice.MarkPriceFeed,
+	symbolMapper symbols.SymbolMapper,
+	calculator margin.MarginCalculator,
+	reporter runreport.RunReporter,
+	bus events.EventBus,
+	runLog runlog.RunLogger,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) ShortfallPolicy {
+	return &shortfallPolicy{
+		registry:     connectorRegistry,
+		assets:       assetRegistry,
+		marks:        markPriceFeed,
+		symbols:      symbolMapper,
+		calculator:   calculator,
+		reporter:     reporter,
+		bus:          bus,
+		runLog:       runLog,
+		timeProvider: timeProvider,
+		logger:       logger,
+		config:       DefaultConfig(),
+		states:       make(map[string]*State),
+	}
+}
+
+func (p *shortfallPolicy) Configure(config Config) error {
+	if err := config.applyDefaults(); err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.config = config
+	return nil
+}
+
+func (p *shortfallPolicy) Wrap(inner execution.Executor) execution.Executor {
+	return &policyExecutor{policy: p, inner: inner}
+}
+
+func (p *shortfallPolicy) Check(signal *strategy.Signal) (*strategy.Signal, error) {
+	if signal == nil {
+		return nil, fmt.Errorf("signal is nil")
+	}
+
+	runID := p.runFor(signal.Strategy)
+
+	p.mu.Lock()
+	state, held := p.states[runID]
+	var current State
+	if held {
+		current = *state
+	}
+	p.mu.Unlock()
+
+	if !held {
+		return signal, nil
+	}
+
+	checked := *signal
+	checked.Actions = append([]strategy.TradeAction(nil), signal.Actions...)
+	steps := make(map[connector.ExchangeName][]connector.ContractInfo)
+
+	for i, action := range checked.Actions {
+		if !limited(action) {
+			continue
+		}
+		if !p.increases(signal, action) {
+			continue
+		}
+
+		if current.Paused {
+			reason := fmt.Sprintf("paused after %d insufficient balance rejections", current.Rejections)
+			p.refuse(signal, action, reason)
+			return nil, fmt.Errorf("signal %s from %s on %s %s: %s: %w",
+				signal.ID, signal.Strategy, action.Exchange, action.Asset.Symbol(), reason, ErrStrategyHeldBack)
+		}
+
+		quantity := roundToStep(action.Quantity.Mul(current.Scale), p.stepSize(steps, action))
+		if !quantity.IsPositive() {
+			reason := fmt.Sprintf("downsized to %s, below the lot size", current.Scale)
+			p.refuse(signal, action, reason)
+			return nil, fmt.Errorf("signal %s from %s on %s %s: %s: %w",
+				signal.ID, signal.Strategy, action.Exchange, action.Asset.Symbol(), reason, ErrStrategyHeldBack)
+		}
+		checked.Actions[i].Quantity = quantity
+	}
+	return &checked, nil
+}
+
+func (p *shortfallPolicy) Record(signal *strategy.Signal, err error) (Rejection, bool) {
+	if signal == nil || !types.IsInsufficientBalance(err) {
+		return Rejection{}, false
+	}
+
+	now := p.timeProvider.Now()
+	runID := p.runFor(signal.Strategy)
+	rejection := Rejection{
+		RunID:     runID,
+		SignalID:  signal.ID.String(),
+		Strategy:  signal.Strategy,
+		Quantity:  numerical.Zero(),
+		Price:     numerical.Zero(),
+		Required:  numerical.Zero(),
+		Available: numerical.Zero(),
+		Shortfall: numerical.Zero(),
+		Error:     err.Error(),
+		At:        now,
+	}
+
+	var rejected *types.InsufficientBalanceError
+	if errors.As(err, &rejected) {
+		rejection.Exchange = rejected.Exchange
+		rejection.Symbol = rejected.Symbol
+		rejection.Side = rejected.Side
+		rejection.Quantity = rejected.Quantity
+		rejection.Price = rejected.Price
+		rejection.Available = rejected.Available
+
+		price := rejected.Price
+		if !price.IsPositive() {
+			price = p.mark(rejected.Exchange, rejected.Symbol)
+		}
+		if !price.IsPositive() {
+			price = p.lastPrice(rejected.Exchange, rejected.Symbol)
+		}
+		if leverage := p.calculator.MaxLeverage(runID); price.IsPositive() && leverage.IsPositive() {
+			rejection.Required = rejected.Quantity.Mul(price).Div(leverage)
+			if short := rejection.Required.Sub(rejected.Available); short.IsPositive() {
+				rejection.Shortfall = short
+			}
+		}
+	}
+
+	p.mu.Lock()
+	config := p.config
+	state, ok := p.states[runID]
+	if !ok {
+		state = &State{RunID: runID, Scale: numerical.NewFromInt(1)}
+		p.states[runID] = state
+	}
+	state.Strategy = signal.Strategy
+	state.Rejections++
+	state.LastRejection = now
+	if config.Mode == ModeDownsize && !state.Paused {
+		if scale := state.Scale.Mul(downsizeFactor(config.Factor, rejection)); scale.GreaterThanOrEqual(config.MinScale) {
+			state.Scale = scale
+		} else {
+			state.Paused = true
+		}
+	} else {
+		state.Paused = true
+	}
+	rejection.Scale = state.Scale
+	rejection.Outcome = OutcomeDownsized
+	if state.Paused {
+		rejection.Outcome = OutcomePaused
+	}
+	p.rejections = append(p.rejections, rejection)
+	if excess := len(p.rejections) - config.MaxRejections; excess > 0 {
+		p.rejections = append([]Rejection(nil), p.rejections[excess:]...)
+	}
+	p.mu.Unlock()
+
+	message := fmt.Sprintf("Order %s %s on %s rejected for insufficient balance: required %s, available %s, short %s; entries %s",
+		rejection.Quantity, rejection.Symbol, rejection.Exchange,
+		rejection.Required.Round(2), rejection.Available.Round(2), rejection.Shortfall.Round(2), rejection.Outcome)
+	if rejection.Outcome == OutcomeDownsized {
+		message += fmt.Sprintf(" to %s", rejection.Scale)
+	}
+
+	p.logger.Warn("💸 Signal %s from %s in run %s: %s", signal.ID, signal.Strategy, runID, message)
+	p.runLog.Record(runlog.LevelError, LogKind, runlog.Fields{
+		Exchange: string(rejection.Exchange),
+		SignalID: rejection.SignalID,
+	}, "%s", message)
+	p.bus.Publish(eventschema.TopicInsufficientBalance, rejection)
+	return rejection, true
+}
+
+func (p *shortfallPolicy) Resume(runID string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, ok := p.states[runID]; !ok {
+		return fmt.Errorf("run %s is not held back", runID)
+	}
+	delete(p.states, runID)
+	p.logger.Info("💸 Run %s resumed at full size", runID)
+	return nil
+}
+
+func (p *shortfallPolicy) State(runID string) (State, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	state, ok := p.states[runID]
+	if !ok {
+		return State{}, false
+	}
+	return *state, true
+}
+
+func (p *shortfallPolicy) States() []State {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	states := make([]State, 0, len(p.states))
+	for _, state := range p.states {
+		states = append(states, *state)
+	}
+	sort.Slice(states, func(i, j int) bool { return states[i].RunID < states[j].RunID })
+	return states
+}
+
+func (p *shortfallPolicy) Rejections() []Rejection {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]Rejection(nil), p.rejections...)
+}
+
+// refuse records an entry held back by the policy in the run log
+func (p *shortfallPolicy) refuse(signal *strategy.Signal, action strategy.TradeAction, reason string) {
+	message := fmt.Sprintf("Order %s %s on %s refused: %s",
+		action.Quantity, action.Asset.Symbol(), action.Exchange, reason)
+
+	p.logger.Warn("💸 Signal %s from %s: %s", signal.ID, signal.Strategy, message)
+	p.runLog.Record(runlog.LevelWarn, LogKind, runlog.Fields{
+		Asset:    action.Asset.Symbol(),
+		Exchange: string(action.Exchange),
+		SignalID: signal.ID.String(),
+	}, "%s", message)
+}
+
+// runFor is the run a strategy's signals count against: the one the margin
+// calculator binds it to, else the active run
+func (p *shortfallPolicy) runFor(name strategy.StrategyName) string {
+	if runID, ok := p.calculator.RunFor(name); ok {
+		return runID
+	}
+	runID, _ := p.reporter.Active()
+	return runID
+}
+
+// increases reports whether an action adds to its position. When the
+// account cannot be read, buys and short sales are taken to open and sells
+// and covers to close, so an exit is never held back on a failed read.
+func (p *shortfallPolicy) increases(signal *strategy.Signal, action strategy.TradeAction) bool {
+	estimate, err := p.calculator.Estimate(signal.Strategy, action)
+	if err == nil {
+		return estimate.Increases
+	}
+
+	opens := action.Action == strategy.ActionBuy || action.Action == strategy.ActionSellShort
+	p.logger.Warn("Shortfall policy: cannot estimate %s %s on %s for signal %s, judging it by its action (opens: %t): %v",
+		action.Action, action.Asset.Symbol(), action.Exchange, signal.ID, opens, err)
+	return opens
+}
+
+// downsizeFactor is what a rejection multiplies the run's scale by: the
+// configured factor, or less when the balance covered less than that share
+// of the order's margin
+func downsizeFactor(factor numerical.Decimal, rejection Rejection) numerical.Decimal {
+	if !rejection.Required.IsPositive() || rejection.Available.IsNegative() {
+		return factor
+	}
+	if covered := rejection.Available.Div(rejection.Required); covered.LessThan(factor) {
+		return covered
+	}
+	return factor
+}
+
+// mark is the mark price of an exchange symbol, zero when unknown
+func (p *shortfallPolicy) mark(exchange connector.ExchangeName, symbol string) numerical.Decimal {
+	asset, _, err := p.symbols.FromNative(exchange, symbol)
+	if err != nil {
+		asset = portfolio.NewAsset(symbol)
+	}
+	if mark, ok := p.marks.Mark(exchange, asset); ok {
+		return mark.MarkPrice
+	}
+	return numerical.Zero()
+}
+
+// lastPrice asks the connector for an exchange symbol's last traded price,
+// zero when it cannot be read
+func (p *shortfallPolicy) lastPrice(exchange connector.ExchangeName, symbol string) numerical.Decimal {
+	conn, ok := p.registry.GetConnector(exchange)
+	if !ok {
+		return numerical.Zero()
+	}
+	price, err := conn.FetchPrice(symbol)
+	if err != nil || price == nil {
+		return numerical.Zero()
+	}
+	return price.Price
+}
+
+// stepSize looks up the lot step, fetching each exchange's contracts at
+// most once per signal; zero means quantities are not rounded
+func (p *shortfallPolicy) stepSize(contracts map[connector.ExchangeName][]connector.ContractInfo, action strategy.TradeAction) numerical.Decimal {
+	conn, ok := p.registry.GetConnector(action.Exchange)
+	if !ok {
+		return numerical.Zero()
+	}
+
+	known, read := contracts[action.Exchange]
+	if !read {
+		fetched, err := conn.FetchContracts()
+		if err != nil {
+			p.logger.Warn("Shortfall policy: failed to read %s contracts: %v", action.Exchange, err)
+		}
+		contracts[action.Exchange] = fetched
+		known = fetched
+	}
+
+	instrument := p.instrumentOf(action.Asset)
+	symbol, err := p.symbols.Resolve(action.Exchange, action.Asset.Symbol(), instrument)
+	if err != nil {
+		if instrument != connector.TypePerpetual {
+			return numerical.Zero()
+		}
+		symbol = conn.GetPerpSymbol(action.Asset)
+	}
+	for _, contract := range known {
+		if contract.Symbol == symbol {
+			return contract.StepSize
+		}
+	}
+	return numerical.Zero()
+}
+
+// instrumentOf is the instrument the asset is registered for; an asset
+// registered for several, or for none, is taken to be the perpetual
+func (p *shortfallPolicy) instrumentOf(asset portfolio.Asset) connector.Instrument {
+	if instruments := p.assets.GetInstrumentTypes(asset); len(instruments) == 1 {
+		return instruments[0]
+	}
+	return connector.TypePerpetual
+}
+
+func roundToStep(quantity, step numerical.Decimal) numerical.Decimal {
+	if !step.IsPositive() {
+		return quantity
+	}
+	return quantity.Div(step).Truncate(0).Mul(step)
+}
+
+// limited reports whether an action places an order that can add exposure
+func limited(action strategy.TradeAction) bool {
+	switch action.Action {
+	case strategy.ActionBuy, strategy.ActionSell, strategy.ActionSellShort, strategy.ActionCover:
+		return action.Quantity.IsPositive()
+	}
+	return false
+}
+
+// view is what Handler serves
+type view struct {
+	Mode       Mode
+	States     []State
+	Rejections []Rejection
+}
+
+func (p *shortfallPolicy) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.Method {
+		case http.MethodGet:
+		case http.MethodPost:
+			if err := p.Resume(req.URL.Query().Get("run")); err != nil {
+				http.Error(w, err.Error(), http.StatusNotFound)
+				return
+			}
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		p.mu.Lock()
+		mode := p.config.Mode
+		p.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(view{
+			Mode:       mode,
+			States:     p.States(),
+			Rejections: p.Rejections(),
+		})
+	})
+}
+
+// policyExecutor is what the risk profile check executes through
+type policyExecutor struct {
+	policy *shortfallPolicy
+	inner  execution.Executor
+}
+
+func (e *policyExecutor) ExecuteSignal(signal *strategy.Signal) error {
+	checked, err := e.policy.Check(signal)
+	if err != nil {
+		return err
+	}
+	if err := e.inner.ExecuteSignal(checked); err != nil {
+		e.policy.Record(checked, err)
+		return err
+	}
+	return nil
+}
+
+func (e *policyExecutor) HandleTradeExecution(trade connector.Trade) error {
+	return e.inner.HandleTradeExecution(trade)
+}
@@ -0,0 +1,229 @@
+package shortfall_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	mockconnector "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	mockregistry "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	mocktemporal "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/kronos-sdk/pkg/events"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	logger "github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+	mockmarkprice "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/connectors/markprice"
+	mocksymbols "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/connectors/symbols"
+	mockmargin "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/margin"
+	mockrunreport "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/runreport"
+	mockscheduler "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/scheduler"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+	"github.com/backtesting-org/live-trading/pkg/margin"
+	"github.com/backtesting-org/live-trading/pkg/runlog"
+	"github.com/backtesting-org/live-trading/pkg/shortfall"
+)
+
+var _ = Describe("ShortfallPolicy", func() {
+	var (
+		calculator *mockmargin.MarginCalculator
+		policy     shortfall.ShortfallPolicy
+
+		runs        map[strategy.StrategyName]string
+		estimateErr error
+	)
+
+	btc := portfolio.NewAsset("BTC")
+	eth := portfolio.NewAsset("ETH")
+
+	signal := func(name strategy.StrategyName, actions ...strategy.TradeAction) *strategy.Signal {
+		return &strategy.Signal{ID: uuid.New(), Strategy: name, Actions: actions}
+	}
+
+	action := func(kind strategy.Action, asset portfolio.Asset, quantity float64) strategy.TradeAction {
+		return strategy.TradeAction{Action: kind, Asset: asset, Exchange: types.Binance, Quantity: numerical.NewFromFloat(quantity)}
+	}
+
+	rejectionOf := func(available int64) error {
+		return &types.InsufficientBalanceError{
+			Exchange:  types.Binance,
+			Symbol:    "BTCUSDT",
+			Side:      connector.OrderSideBuy,
+			Quantity:  numerical.NewFromInt(1),
+			Price:     numerical.Zero(),
+			Available: numerical.NewFromInt(available),
+			Err:       errors.New("insufficient margin"),
+		}
+	}
+
+	reject := func(name strategy.StrategyName, available int64) shortfall.Rejection {
+		rejection, ok := policy.Record(signal(name, action(strategy.ActionBuy, btc, 1)), rejectionOf(available))
+		Expect(ok).To(BeTrue())
+		return rejection
+	}
+
+	quantityOf := func(checked *strategy.Signal) numerical.Decimal {
+		return checked.Actions[0].Quantity
+	}
+
+	BeforeEach(func() {
+		runs = map[strategy.StrategyName]string{"momentum": "run-1", "trend": "run-1"}
+		estimateErr = nil
+
+		conn := mockconnector.NewConnector(GinkgoT())
+		conn.On("FetchPrice", "BTCUSDT").Return(&connector.Price{Price: numerical.NewFromInt(100)}, nil).Maybe()
+		conn.On("FetchContracts").Return([]connector.ContractInfo{
+			{Symbol: "BTCUSDT", StepSize: numerical.NewFromFloat(0.001)},
+			{Symbol: "ETHUSDT", StepSize: numerical.NewFromFloat(0.1)},
+		}, nil).Maybe()
+
+		connectors := mockregistry.NewConnectorRegistry(GinkgoT())
+		connectors.On("GetConnector", types.Binance).Return(conn, true).Maybe()
+
+		assets := mockregistry.NewAssetRegistry(GinkgoT())
+		assets.On("GetInstrumentTypes", btc).Return([]connector.Instrument{connector.TypePerpetual}).Maybe()
+		assets.On("GetInstrumentTypes", eth).Return([]connector.Instrument{connector.TypeSpot}).Maybe()
+
+		symbolMapper := mocksymbols.NewSymbolMapper(GinkgoT())
+		symbolMapper.On("FromNative", types.Binance, "BTCUSDT").Return(btc, connector.TypePerpetual, nil).Maybe()
+		symbolMapper.On("Resolve", types.Binance, "BTC", connector.TypePerpetual).Return("BTCUSDT", nil).Maybe()
+		symbolMapper.On("Resolve", types.Binance, "ETH", connector.TypeSpot).Return("ETHUSDT", nil).Maybe()
+
+		marks := mockmarkprice.NewMarkPriceFeed(GinkgoT())
+		marks.On("Mark", types.Binance, mock.Anything).Return(types.MarkPrice{}, false).Maybe()
+
+		calculator = mockmargin.NewMarginCalculator(GinkgoT())
+		calculator.On("RunFor", mock.Anything).Return(
+			func(name strategy.StrategyName) string { return runs[name] },
+			func(name strategy.StrategyName) bool { _, ok := runs[name]; return ok },
+		).Maybe()
+		calculator.On("MaxLeverage", mock.Anything).Return(numerical.NewFromInt(5)).Maybe()
+		calculator.On("Estimate", mock.Anything, mock.Anything).Return(
+			func(_ strategy.StrategyName, action strategy.TradeAction) margin.Estimate {
+				return margin.Estimate{Increases: action.Action == strategy.ActionBuy || action.Action == strategy.ActionSellShort}
+			},
+			func(strategy.StrategyName, strategy.TradeAction) error { return estimateErr },
+		).Maybe()
+
+		reporter := mockrunreport.NewRunReporter(GinkgoT())
+		reporter.On("Active").Return("run-active", true).Maybe()
+
+		mockTime := mocktemporal.NewTimeProvider(GinkgoT())
+		mockTime.On("Now").Return(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).Maybe()
+
+		noOp := logger.NewNoOpLogger()
+		runLog := runlog.NewRunLogger(mockscheduler.NewScheduler(GinkgoT()), mockTime, noOp)
+		policy = shortfall.NewShortfallPolicy(connectors, assets, marks, symbolMapper, calculator, reporter,
+			events.NewEventBus(), runLog, mockTime, noOp)
+	})
+
+	Describe("Record", func() {
+		It("prices a market order from the connector to measure the shortfall", func() {
+			rejection := reject("momentum", 15)
+
+			Expect(rejection.RunID).To(Equal("run-1"))
+			Expect(rejection.Required.Equal(numerical.NewFromInt(20))).To(BeTrue())
+			Expect(rejection.Shortfall.Equal(numerical.NewFromInt(5))).To(BeTrue())
+			Expect(rejection.Outcome).To(Equal(shortfall.OutcomeDownsized))
+			Expect(rejection.Scale.Equal(numerical.NewFromFloat(0.5))).To(BeTrue())
+		})
+
+		It("downsizes to the share of the margin the balance covered when that is smaller", func() {
+			rejection := reject("momentum", 6)
+
+			Expect(rejection.Outcome).To(Equal(shortfall.OutcomeDownsized))
+			Expect(rejection.Scale.Equal(numerical.NewFromFloat(0.3))).To(BeTrue())
+		})
+
+		It("pauses the run once the scale would fall below the minimum", func() {
+			rejection := reject("momentum", 2)
+
+			Expect(rejection.Outcome).To(Equal(shortfall.OutcomePaused))
+			state, ok := policy.State("run-1")
+			Expect(ok).To(BeTrue())
+			Expect(state.Paused).To(BeTrue())
+			Expect(state.Strategy).To(Equal(strategy.StrategyName("momentum")))
+		})
+
+		It("ignores errors that are not insufficient balance rejections", func() {
+			_, ok := policy.Record(signal("momentum", action(strategy.ActionBuy, btc, 1)), errors.New("timeout"))
+			Expect(ok).To(BeFalse())
+			Expect(policy.States()).To(BeEmpty())
+		})
+	})
+
+	Describe("Check", func() {
+		It("holds back every strategy of the run that was rejected", func() {
+			reject("momentum", 15)
+
+			checked, err := policy.Check(signal("trend", action(strategy.ActionBuy, btc, 0.5)))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(quantityOf(checked).Equal(numerical.NewFromFloat(0.25))).To(BeTrue())
+		})
+
+		It("starts a new run at full size", func() {
+			reject("momentum", 15)
+			runs["momentum"] = "run-2"
+
+			checked, err := policy.Check(signal("momentum", action(strategy.ActionBuy, btc, 0.5)))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(quantityOf(checked).Equal(numerical.NewFromFloat(0.5))).To(BeTrue())
+		})
+
+		It("counts an unbound strategy against the active run", func() {
+			reject("scalper", 15)
+
+			_, ok := policy.State("run-active")
+			Expect(ok).To(BeTrue())
+		})
+
+		It("rounds downsized entries to the step of the order's instrument", func() {
+			reject("momentum", 15)
+
+			checked, err := policy.Check(signal("momentum", action(strategy.ActionBuy, eth, 0.35)))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(quantityOf(checked).Equal(numerical.NewFromFloat(0.1))).To(BeTrue())
+		})
+
+		It("never holds back an order that shrinks the position", func() {
+			reject("momentum", 2)
+
+			checked, err := policy.Check(signal("momentum", action(strategy.ActionSell, btc, 1)))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(quantityOf(checked).Equal(numerical.NewFromInt(1))).To(BeTrue())
+		})
+
+		It("judges an order by its action when the account cannot be read", func() {
+			reject("momentum", 2)
+			estimateErr = errors.New("balance unavailable")
+
+			_, err := policy.Check(signal("momentum", action(strategy.ActionBuy, btc, 1)))
+			Expect(errors.Is(err, shortfall.ErrStrategyHeldBack)).To(BeTrue())
+
+			checked, err := policy.Check(signal("momentum", action(strategy.ActionCover, btc, 1)))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(quantityOf(checked).Equal(numerical.NewFromInt(1))).To(BeTrue())
+		})
+	})
+
+	Describe("Resume", func() {
+		It("lifts the run's pause from the handler", func() {
+			reject("momentum", 2)
+
+			recorder := httptest.NewRecorder()
+			policy.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/shortfall?run=run-1", nil))
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+
+			_, ok := policy.State("run-1")
+			Expect(ok).To(BeFalse())
+			Expect(policy.Resume("run-1")).To(MatchError(ContainSubstring("not held back")))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package shortfall_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestShortfall(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Shortfall Suite")
+}
@@ -0,0 +1,53 @@
+package shutdown
+
+import (
+	"fmt"
+	"time"
+)
+
+// Policy decides what happens to a run's open orders and positions on shutdown
+type Policy string
+
+const (
+	// PolicyLeave exits without touching the exchange
+	PolicyLeave Policy = "leave"
+
+	// PolicyCancelOrders cancels the run's open orders but keeps its positions
+	PolicyCancelOrders Policy = "cancel-orders"
+
+	// PolicyFlatten cancels the run's open orders and closes its positions
+	PolicyFlatten Policy = "flatten"
+)
+
+// DefaultTimeout bounds teardown so a hung exchange cannot block exit forever
+const DefaultTimeout = 30 * time.Second
+
+// Config controls one run's shutdown teardown
+type Config struct {
+	Policy  Policy
+	Timeout time.Duration
+}
+
+// DefaultConfig leaves orders and positions alone, so a normal stop never
+// touches the exchange unless the run asked for it
+func DefaultConfig() Config {
+	return Config{
+		Policy:  PolicyLeave,
+		Timeout: DefaultTimeout,
+	}
+}
+
+func (c *Config) applyDefaults() error {
+	if c.Policy == "" {
+		c.Policy = PolicyLeave
+	}
+	if c.Timeout <= 0 {
+		c.Timeout = DefaultTimeout
+	}
+
+	switch c.Policy {
+	case PolicyLeave, PolicyCancelOrders, PolicyFlatten:
+		return nil
+	}
+	return fmt.Errorf("unknown shutdown policy %q", c.Policy)
+}
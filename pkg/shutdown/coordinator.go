@@ -0,0 +1,193 @@
+package shutdown
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/live-trading/pkg/connectors/tracker"
+	"github.com/backtesting-org/live-trading/pkg/flatten"
+	"github.com/backtesting-org/live-trading/pkg/runreport"
+)
+
+// Report is what a teardown did to the run's orders and positions
+type Report struct {
+	RunID     string
+	Policy    Policy
+	Cancelled map[connector.ExchangeName]int
+	Flattened *flatten.Result
+	Errors    []string
+}
+
+// Coordinator applies the active run's shutdown policy before the process
+// exits. Each run sets its own policy when it starts; teardown runs once,
+// from the fx stop hook or an explicit call, whichever comes first, and
+// only touches orders and positions the run itself opened.
+type Coordinator interface {
+	// Apply sets runID's shutdown policy; a run without one is left alone
+	Apply(runID string, config Config) error
+	Release(runID string)
+	Policy(runID string) Policy
+
+	// Teardown applies the active run's policy, giving up after its
+	// timeout or when ctx is done. With no active run it does nothing.
+	Teardown(ctx context.Context) (*Report, error)
+}
+
+type coordinator struct {
+	registry  registry.ConnectorRegistry
+	reporter  runreport.RunReporter
+	tracker   tracker.OrderTracker
+	flattener flatten.RunFlattener
+	logger    logging.ApplicationLogger
+
+	configs map[string]Config
+	once    sync.Once
+	report  *Report
+	err     error
+	mu      sync.Mutex
+}
+
+func NewCoordinator(
+	connectorRegistry registry.ConnectorRegistry,
+	reporter runreport.RunReporter,
+	orderTracker tracker.OrderTracker,
+	flattener flatten.RunFlattener,
+	logger logging.ApplicationLogger,
+) Coordinator {
+	return &coordinator{
+		registry:  connectorRegistry,
+		reporter:  reporter,
+		tracker:   orderTracker,
+		flattener: flattener,
+		logger:    logger,
+		configs:   make(map[string]Config),
+	}
+}
+
+func (c *coordinator) Apply(runID string, config Config) error {
+	if runID == "" {
+		return fmt.Errorf("run ID is required")
+	}
+	if err := config.applyDefaults(); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.configs[runID] = config
+	return nil
+}
+
+func (c *coordinator) Release(runID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.configs, runID)
+}
+
+func (c *coordinator) Policy(runID string) Policy {
+	return c.config(runID).Policy
+}
+
+func (c *coordinator) config(runID string) Config {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if config, ok := c.configs[runID]; ok {
+		return config
+	}
+	return DefaultConfig()
+}
+
+func (c *coordinator) Teardown(ctx context.Context) (*Report, error) {
+	c.once.Do(func() {
+		c.report, c.err = c.teardown(ctx)
+	})
+	return c.report, c.err
+}
+
+func (c *coordinator) teardown(ctx context.Context) (*Report, error) {
+	current, ok := c.reporter.Current()
+	if !ok {
+		return nil, nil
+	}
+
+	config := c.config(current.RunID)
+	if config.Policy == PolicyLeave {
+		c.logger.Info("Shutdown policy %s for run %s: leaving open orders and positions", config.Policy, current.RunID)
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, config.Timeout)
+	defer cancel()
+
+	started := time.Now()
+	c.logger.Info("Shutdown policy %s for run %s: tearing down (timeout %s)", config.Policy, current.RunID, config.Timeout)
+
+	// Exchanges are called synchronously; run them aside so the timeout can
+	// still release the process if one of them hangs
+	done := make(chan *Report, 1)
+	go func() {
+		done <- c.apply(current.RunID, current.StartedAt, config.Policy)
+	}()
+
+	select {
+	case report := <-done:
+		if len(report.Errors) > 0 {
+			err := fmt.Errorf("shutdown teardown of run %s incomplete: %d errors", current.RunID, len(report.Errors))
+			c.logger.Warn("Shutdown teardown incomplete after %s: %v", time.Since(started), report.Errors)
+			return report, err
+		}
+		c.logger.Info("Shutdown teardown of run %s completed in %s", current.RunID, time.Since(started))
+		return report, nil
+	case <-ctx.Done():
+		c.logger.Warn("Shutdown teardown abandoned after %s: %v", time.Since(started), ctx.Err())
+		return nil, fmt.Errorf("shutdown teardown timed out: %w", ctx.Err())
+	}
+}
+
+// apply cancels the orders tracked since the run began and, for
+// PolicyFlatten, closes the positions the run opened
+func (c *coordinator) apply(runID string, startedAt time.Time, policy Policy) *Report {
+	report := &Report{
+		RunID:     runID,
+		Policy:    policy,
+		Cancelled: make(map[connector.ExchangeName]int),
+	}
+
+	orders := c.tracker.Active()
+	sort.Slice(orders, func(i, j int) bool { return orders[i].TrackedAt.Before(orders[j].TrackedAt) })
+
+	for _, tracked := range orders {
+		if tracked.TrackedAt.Before(startedAt) {
+			continue
+		}
+
+		conn, ok := c.registry.GetConnector(tracked.Exchange)
+		if !ok {
+			report.Errors = append(report.Errors, fmt.Sprintf("%s: connector not registered", tracked.Exchange))
+			continue
+		}
+
+		if _, err := conn.CancelOrder(tracked.Order.Symbol, tracked.Order.ID); err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("%s cancel %s: %v", tracked.Exchange, tracked.Order.ID, err))
+			continue
+		}
+		report.Cancelled[tracked.Exchange]++
+	}
+
+	if policy == PolicyFlatten {
+		result, err := c.flattener.FlattenRun(runID)
+		report.Flattened = result
+		if err != nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("flatten: %v", err))
+		}
+	}
+
+	return report
+}
@@ -0,0 +1,125 @@
+package shutdown_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mockconnector "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	mockregistry "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	logger "github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	mocktracker "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/connectors/tracker"
+	mockflatten "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/flatten"
+	mockrunreport "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/runreport"
+	"github.com/backtesting-org/live-trading/pkg/connectors/tracker"
+	"github.com/backtesting-org/live-trading/pkg/flatten"
+	"github.com/backtesting-org/live-trading/pkg/runreport"
+	"github.com/backtesting-org/live-trading/pkg/shutdown"
+)
+
+var _ = Describe("Coordinator", func() {
+	var (
+		started     time.Time
+		connectors  *mockregistry.ConnectorRegistry
+		conn        *mockconnector.Connector
+		reporter    *mockrunreport.RunReporter
+		orders      *mocktracker.OrderTracker
+		flattener   *mockflatten.RunFlattener
+		coordinator shutdown.Coordinator
+	)
+
+	tracked := func(id string, at time.Time) tracker.TrackedOrder {
+		return tracker.TrackedOrder{
+			Exchange:  "bybit",
+			Order:     connector.Order{ID: id, Symbol: "BTCUSDT", Status: connector.OrderStatusNew},
+			TrackedAt: at,
+		}
+	}
+
+	BeforeEach(func() {
+		started = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+		conn = mockconnector.NewConnector(GinkgoT())
+		connectors = mockregistry.NewConnectorRegistry(GinkgoT())
+		connectors.On("GetConnector", connector.ExchangeName("bybit")).Return(conn, true).Maybe()
+
+		reporter = mockrunreport.NewRunReporter(GinkgoT())
+		reporter.On("Current").Return(&runreport.Report{RunID: "run-1", StartedAt: started}, true).Maybe()
+
+		orders = mocktracker.NewOrderTracker(GinkgoT())
+		orders.On("Active").Return([]tracker.TrackedOrder{
+			tracked("before-run", started.Add(-time.Minute)),
+			tracked("run-order", started.Add(time.Minute)),
+		}).Maybe()
+
+		flattener = mockflatten.NewRunFlattener(GinkgoT())
+
+		coordinator = shutdown.NewCoordinator(connectors, reporter, orders, flattener, logger.NewNoOpLogger())
+	})
+
+	It("leaves the exchange alone by default", func() {
+		Expect(coordinator.Policy("run-1")).To(Equal(shutdown.PolicyLeave))
+
+		report, err := coordinator.Teardown(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report).To(BeNil())
+		conn.AssertNotCalled(GinkgoT(), "CancelOrder")
+	})
+
+	It("cancels only the orders placed since the run began", func() {
+		Expect(coordinator.Apply("run-1", shutdown.Config{Policy: shutdown.PolicyCancelOrders})).To(Succeed())
+		conn.On("CancelOrder", "BTCUSDT", "run-order").Return(&connector.CancelResponse{OrderID: "run-order"}, nil).Once()
+
+		report, err := coordinator.Teardown(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.RunID).To(Equal("run-1"))
+		Expect(report.Cancelled).To(HaveKeyWithValue(connector.ExchangeName("bybit"), 1))
+		Expect(report.Flattened).To(BeNil())
+	})
+
+	It("applies another run's policy only to that run", func() {
+		Expect(coordinator.Apply("run-2", shutdown.Config{Policy: shutdown.PolicyFlatten})).To(Succeed())
+
+		report, err := coordinator.Teardown(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report).To(BeNil())
+	})
+
+	It("flattens the run's positions after cancelling its orders", func() {
+		Expect(coordinator.Apply("run-1", shutdown.Config{Policy: shutdown.PolicyFlatten})).To(Succeed())
+		conn.On("CancelOrder", "BTCUSDT", "run-order").Return(&connector.CancelResponse{OrderID: "run-order"}, nil).Once()
+		flattener.On("FlattenRun", "run-1").Return(&flatten.Result{RunID: "run-1"}, nil).Once()
+
+		report, err := coordinator.Teardown(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report.Flattened.RunID).To(Equal("run-1"))
+	})
+
+	It("reports cancels that failed", func() {
+		Expect(coordinator.Apply("run-1", shutdown.Config{Policy: shutdown.PolicyCancelOrders})).To(Succeed())
+		conn.On("CancelOrder", "BTCUSDT", "run-order").Return(nil, errors.New("rate limited")).Once()
+
+		report, err := coordinator.Teardown(context.Background())
+		Expect(err).To(HaveOccurred())
+		Expect(report.Errors).To(ConsistOf(ContainSubstring("rate limited")))
+	})
+
+	It("does nothing without an active run", func() {
+		reporter.ExpectedCalls = nil
+		reporter.On("Current").Return(nil, false)
+		Expect(coordinator.Apply("run-1", shutdown.Config{Policy: shutdown.PolicyFlatten})).To(Succeed())
+
+		report, err := coordinator.Teardown(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(report).To(BeNil())
+	})
+
+	It("rejects an unknown policy", func() {
+		Expect(coordinator.Apply("run-1", shutdown.Config{Policy: "explode"})).To(MatchError(ContainSubstring("unknown shutdown policy")))
+		Expect(coordinator.Apply("", shutdown.Config{})).To(MatchError(ContainSubstring("run ID is required")))
+	})
+})
@@ -0,0 +1,24 @@
+package shutdown
+
+import (
+	"context"
+
+	"go.uber.org/fx"
+)
+
+// Module must come after runreport.Module: fx stops modules in reverse,
+// and teardown needs the run to still be active
+var Module = fx.Options(
+	fx.Provide(NewCoordinator),
+	fx.Invoke(registerHooks),
+)
+
+// registerHooks tears down on fx stop, which is how SIGTERM reaches the app
+func registerHooks(lifecycle fx.Lifecycle, coordinator Coordinator) {
+	lifecycle.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			_, err := coordinator.Teardown(ctx)
+			return err
+		},
+	})
+}
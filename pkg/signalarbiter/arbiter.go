@@ -0,0 +1,442 @@
+package signalarbiter
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/events"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/execution"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/eventschema"
+)
+
+// ErrSignalConflict is returned for a signal refused because it opposes a
+// recent signal from another run
+var ErrSignalConflict = errors.New("signal conflicts with another run")
+
+// Outcome is what the arbiter did with a conflicting signal
+type Outcome string
+
+const (
+	OutcomeRejected Outcome = "rejected"
+	OutcomeOverrode Outcome = "overrode"
+	OutcomeNetted   Outcome = "netted"
+)
+
+// Conflict is published on eventschema.TopicSignalConflict for every
+// signal that opposed another run's action on the same market
+type Conflict struct {
+	Exchange      connector.ExchangeName
+	Asset         string
+	SignalID      string
+	Strategy      strategy.StrategyName
+	ConflictsWith []strategy.StrategyName
+	Policy        Policy
+	Outcome       Outcome
+	At            time.Time
+}
+
+// SignalArbiter sits in front of the signal queue and settles opposing
+// actions that different runs send to the same asset and exchange, so two
+// strategies do not churn a position against each other. A signal is
+// accepted or refused whole, which keeps multi-leg signals hedged; netting
+// is the exception and can shrink any leg. Buy and cover count as one
+// direction, sell and sell short as the other; close and hold never
+// conflict.
+type SignalArbiter interface {
+	Configure(config Config) error
+
+	// Wrap returns an executor that arbitrates signals before inner
+	Wrap(inner execution.Executor) execution.Executor
+
+	// Flush nets and executes signals held under PolicyNet without waiting
+	// for NetHold
+	Flush()
+}
+
+type marketKey struct {
+	exchange connector.ExchangeName
+	asset    string
+}
+
+// executed is an accepted action that later opposing actions are checked against
+type executed struct {
+	strategy  strategy.StrategyName
+	direction int
+	at        time.Time
+}
+
+type signalArbiter struct {
+	bus          events.EventBus
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config     Config
+	inner      execution.Executor
+	recent     map[marketKey][]executed
+	held       []*strategy.Signal
+	generation uint64
+	holding    bool
+	mu         sync.Mutex
+}
+
+func NewSignalArbiter(
+	bus events.EventBus,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) SignalArbiter {
+	return &signalArbiter{
+		bus:          bus,
+		timeProvider: timeProvider,
+		logger:       logger,
+		config:       DefaultConfig(),
+		recent:       make(map[marketKey][]executed),
+	}
+}
+
+func (a *signalArbiter) Configure(config Config) error {
+	if err := config.applyDefaults(); err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	policy := a.config.Policy
+	a.config = config
+	a.mu.Unlock()
+
+	// Signals held for netting execute as they are under the new policy
+	if policy == PolicyNet && config.Policy != PolicyNet {
+		a.Flush()
+	}
+	return nil
+}
+
+func (a *signalArbiter) Wrap(inner execution.Executor) execution.Executor {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.inner = inner
+	return &arbitratedExecutor{arbiter: a, inner: inner}
+}
+
+func direction(action strategy.Action) int {
+	switch action {
+	case strategy.ActionBuy, strategy.ActionCover:
+		return 1
+	case strategy.ActionSell, strategy.ActionSellShort:
+		return -1
+	default:
+		return 0
+	}
+}
+
+func (a *signalArbiter) arbitrate(signal *strategy.Signal) error {
+	if signal == nil {
+		return fmt.Errorf("signal is nil")
+	}
+
+	a.mu.Lock()
+	config, inner := a.config, a.inner
+	if config.Policy == PolicyNet {
+		a.hold(signal)
+		a.mu.Unlock()
+		return nil
+	}
+
+	now := a.timeProvider.Now()
+	conflicts := a.conflicts(signal, now.Add(-config.Window), now)
+
+	outcome := OutcomeOverrode
+	if len(conflicts) > 0 && !a.outranks(signal.Strategy, conflicts) {
+		outcome = OutcomeRejected
+	} else {
+		a.remember(signal, now)
+	}
+	a.mu.Unlock()
+
+	for _, conflict := range conflicts {
+		conflict.Outcome = outcome
+		a.alert(conflict)
+	}
+
+	if outcome == OutcomeRejected {
+		return fmt.Errorf("signal %s from %s: %w", signal.ID, signal.Strategy, ErrSignalConflict)
+	}
+	return inner.ExecuteSignal(signal)
+}
+
+// conflicts lists each market where the signal opposes an action another
+// run had accepted since cutoff. Callers hold mu.
+func (a *signalArbiter) conflicts(signal *strategy.Signal, cutoff, now time.Time) []Conflict {
+	var found []Conflict
+	for _, action := range signal.Actions {
+		dir := direction(action.Action)
+		if dir == 0 {
+			continue
+		}
+
+		key := marketKey{action.Exchange, action.Asset.Symbol()}
+		kept := a.recent[key][:0]
+		against := make(map[strategy.StrategyName]struct{})
+		for _, prior := range a.recent[key] {
+			if prior.at.Before(cutoff) {
+				continue
+			}
+			kept = append(kept, prior)
+			if prior.strategy != signal.Strategy && prior.direction != dir {
+				against[prior.strategy] = struct{}{}
+			}
+		}
+		a.recent[key] = kept
+
+		if len(against) == 0 {
+			continue
+		}
+		found = append(found, Conflict{
+			Exchange:      key.exchange,
+			Asset:         key.asset,
+			SignalID:      signal.ID.String(),
+			Strategy:      signal.Strategy,
+			ConflictsWith: sortedNames(against),
+			Policy:        a.config.Policy,
+			At:            now,
+		})
+	}
+	return found
+}
+
+// outranks reports whether PolicyPriority lets name override every run it
+// conflicts with. Callers hold mu.
+func (a *signalArbiter) outranks(name strategy.StrategyName, conflicts []Conflict) bool {
+	if a.config.Policy != PolicyPriority {
+		return false
+	}
+	rank := a.config.Priorities[name]
+	for _, conflict := range conflicts {
+		for _, other := range conflict.ConflictsWith {
+			if a.config.Priorities[other] >= rank {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// remember records the signal's directional actions. Callers hold mu.
+func (a *signalArbiter) remember(signal *strategy.Signal, at time.Time) {
+	for _, action := range signal.Actions {
+		if dir := direction(action.Action); dir != 0 {
+			key := marketKey{action.Exchange, action.Asset.Symbol()}
+			a.recent[key] = append(a.recent[key], executed{strategy: signal.Strategy, direction: dir, at: at})
+		}
+	}
+}
+
+// hold queues a signal for the next netting batch, starting the batch
+// timer if none is running. Callers hold mu.
+func (a *signalArbiter) hold(signal *strategy.Signal) {
+	a.held = append(a.held, signal)
+	if a.holding {
+		return
+	}
+
+	a.holding = true
+	generation := a.generation
+	wait := a.timeProvider.After(a.config.NetHold)
+	go func() {
+		<-wait
+		a.flush(generation)
+	}()
+}
+
+func (a *signalArbiter) Flush() {
+	a.mu.Lock()
+	generation := a.generation
+	a.mu.Unlock()
+	a.flush(generation)
+}
+
+// flush executes the batch started in generation; a timer outliving an
+// explicit Flush finds a newer generation and does nothing
+func (a *signalArbiter) flush(generation uint64) {
+	a.mu.Lock()
+	if generation != a.generation {
+		a.mu.Unlock()
+		return
+	}
+	batch, inner := a.held, a.inner
+	a.held = nil
+	a.holding = false
+	a.generation++
+	a.mu.Unlock()
+
+	if len(batch) == 0 {
+		return
+	}
+
+	signals, conflicts := a.net(batch)
+	for _, conflict := range conflicts {
+		a.alert(conflict)
+	}
+	for _, signal := range signals {
+		if err := inner.ExecuteSignal(signal); err != nil {
+			a.logger.Error("Netted signal %s from %s failed: %v", signal.ID, signal.Strategy, err)
+		}
+	}
+}
+
+type leg struct {
+	signal int
+	action int
+}
+
+// net offsets opposing actions from different runs on the same market.
+// The losing side's actions are zeroed and the winning side is cut by the
+// same quantity, newest first. Signals left with no actions are dropped.
+func (a *signalArbiter) net(batch []*strategy.Signal) ([]*strategy.Signal, []Conflict) {
+	signals := make([]*strategy.Signal, len(batch))
+	markets := make(map[marketKey][]leg)
+	var order []marketKey
+	for i, original := range batch {
+		clone := *original
+		clone.Actions = append([]strategy.TradeAction(nil), original.Actions...)
+		signals[i] = &clone
+
+		for j, action := range clone.Actions {
+			if direction(action.Action) == 0 {
+				continue
+			}
+			key := marketKey{action.Exchange, action.Asset.Symbol()}
+			if _, seen := markets[key]; !seen {
+				order = append(order, key)
+			}
+			markets[key] = append(markets[key], leg{i, j})
+		}
+	}
+
+	now := a.timeProvider.Now()
+	var conflicts []Conflict
+	for _, key := range order {
+		legs := markets[key]
+		bought, sold := numerical.Zero(), numerical.Zero()
+		buyers := make(map[strategy.StrategyName]struct{})
+		sellers := make(map[strategy.StrategyName]struct{})
+		for _, l := range legs {
+			action := signals[l.signal].Actions[l.action]
+			if direction(action.Action) > 0 {
+				bought = bought.Add(action.Quantity)
+				buyers[signals[l.signal].Strategy] = struct{}{}
+			} else {
+				sold = sold.Add(action.Quantity)
+				sellers[signals[l.signal].Strategy] = struct{}{}
+			}
+		}
+		if !opposed(buyers, sellers) || bought.IsZero() || sold.IsZero() {
+			continue
+		}
+
+		winner, offset := 1, sold
+		if sold.GreaterThan(bought) {
+			winner, offset = -1, bought
+		}
+		for i := len(legs) - 1; i >= 0; i-- {
+			action := &signals[legs[i].signal].Actions[legs[i].action]
+			if direction(action.Action) != winner {
+				action.Quantity = numerical.Zero()
+				continue
+			}
+			cut := offset
+			if action.Quantity.LessThan(cut) {
+				cut = action.Quantity
+			}
+			action.Quantity = action.Quantity.Sub(cut)
+			offset = offset.Sub(cut)
+		}
+
+		for _, l := range legs {
+			name := signals[l.signal].Strategy
+			opposing := sellers
+			if direction(signals[l.signal].Actions[l.action].Action) < 0 {
+				opposing = buyers
+			}
+			against := make(map[strategy.StrategyName]struct{})
+			for other := range opposing {
+				if other != name {
+					against[other] = struct{}{}
+				}
+			}
+			conflicts = append(conflicts, Conflict{
+				Exchange:      key.exchange,
+				Asset:         key.asset,
+				SignalID:      signals[l.signal].ID.String(),
+				Strategy:      name,
+				ConflictsWith: sortedNames(against),
+				Policy:        PolicyNet,
+				Outcome:       OutcomeNetted,
+				At:            now,
+			})
+		}
+	}
+
+	kept := signals[:0]
+	for _, signal := range signals {
+		actions := signal.Actions[:0]
+		for _, action := range signal.Actions {
+			if direction(action.Action) == 0 || action.Quantity.IsPositive() {
+				actions = append(actions, action)
+			}
+		}
+		signal.Actions = actions
+		if len(actions) > 0 {
+			kept = append(kept, signal)
+		}
+	}
+	return kept, conflicts
+}
+
+// opposed reports whether some run buys while a different run sells
+func opposed(buyers, sellers map[strategy.StrategyName]struct{}) bool {
+	for buyer := range buyers {
+		for seller := range sellers {
+			if buyer != seller {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (a *signalArbiter) alert(conflict Conflict) {
+	a.logger.Warn("⚠️ Signal %s from %s conflicts with %v on %s %s: %s",
+		conflict.SignalID, conflict.Strategy, conflict.ConflictsWith, conflict.Exchange, conflict.Asset, conflict.Outcome)
+	a.bus.Publish(eventschema.TopicSignalConflict, conflict)
+}
+
+func sortedNames(set map[strategy.StrategyName]struct{}) []strategy.StrategyName {
+	names := make([]strategy.StrategyName, 0, len(set))
+	for name := range set {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+	return names
+}
+
+// arbitratedExecutor is what the orchestrator sees in front of the queue
+type arbitratedExecutor struct {
+	arbiter *signalArbiter
+	inner   execution.Executor
+}
+
+func (e *arbitratedExecutor) ExecuteSignal(signal *strategy.Signal) error {
+	return e.arbiter.arbitrate(signal)
+}
+
+func (e *arbitratedExecutor) HandleTradeExecution(trade connector.Trade) error {
+	return e.inner.HandleTradeExecution(trade)
+}
@@ -0,0 +1,75 @@
+package signalarbiter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+)
+
+// Policy is how a conflict between two strategies' signals is settled
+type Policy string
+
+const (
+	// PolicyReject refuses the later signal and alerts
+	PolicyReject Policy = "reject"
+
+	// PolicyPriority lets the later signal through only when its run has a
+	// higher priority than every run it conflicts with
+	PolicyPriority Policy = "priority"
+
+	// PolicyNet holds signals for NetHold and offsets opposing actions on
+	// the same market, executing only the residual
+	PolicyNet Policy = "net"
+)
+
+const (
+	// DefaultWindow is how long an executed action blocks an opposing one
+	DefaultWindow = 5 * time.Second
+
+	// DefaultNetHold is how long PolicyNet batches signals before netting
+	DefaultNetHold = 250 * time.Millisecond
+)
+
+// Config selects the conflict policy
+type Config struct {
+	Policy Policy
+
+	// Window is how far back PolicyReject and PolicyPriority look for an
+	// opposing action from another run
+	Window time.Duration
+
+	// NetHold delays every signal under PolicyNet; only signals held in the
+	// same batch are netted against each other
+	NetHold time.Duration
+
+	// Priorities rank runs for PolicyPriority; runs not listed rank zero
+	Priorities map[strategy.StrategyName]int
+}
+
+// DefaultConfig rejects opposing signals from another run within five seconds
+func DefaultConfig() Config {
+	return Config{
+		Policy:  PolicyReject,
+		Window:  DefaultWindow,
+		NetHold: DefaultNetHold,
+	}
+}
+
+func (c *Config) applyDefaults() error {
+	defaults := DefaultConfig()
+	switch c.Policy {
+	case "":
+		c.Policy = defaults.Policy
+	case PolicyReject, PolicyPriority, PolicyNet:
+	default:
+		return fmt.Errorf("unknown signal conflict policy %q", c.Policy)
+	}
+	if c.Window <= 0 {
+		c.Window = defaults.Window
+	}
+	if c.NetHold <= 0 {
+		c.NetHold = defaults.NetHold
+	}
+	return nil
+}
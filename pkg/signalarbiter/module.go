@@ -0,0 +1,23 @@
+package signalarbiter
+
+import (
+	"context"
+
+	"go.uber.org/fx"
+)
+
+// Module must follow signalqueue.Module so held signals are flushed into
+// the queue before it drains
+var Module = fx.Options(
+	fx.Provide(NewSignalArbiter),
+	fx.Invoke(registerHooks),
+)
+
+func registerHooks(lifecycle fx.Lifecycle, arbiter SignalArbiter) {
+	lifecycle.Append(fx.Hook{
+		OnStop: func(context.Context) error {
+			arbiter.Flush()
+			return nil
+		},
+	})
+}
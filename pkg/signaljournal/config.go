@@ -0,0 +1,16 @@
+package signaljournal
+
+// FileName is the journal file kept inside the configured directory
+const FileName = "signals.jsonl"
+
+// Config controls where the signal journal is written
+type Config struct {
+	Directory string
+}
+
+// DefaultConfig returns a journal rooted at the given directory
+func DefaultConfig(directory string) Config {
+	return Config{
+		Directory: directory,
+	}
+}
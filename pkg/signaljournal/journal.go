@@ -0,0 +1,346 @@
+package signaljournal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/jsonl"
+	"github.com/google/uuid"
+)
+
+// ErrNotConfigured is returned until Configure has opened the journal
+var ErrNotConfigured = errors.New("signal journal not configured")
+
+// Status is where a signal is in its execution
+type Status string
+
+const (
+	StatusPending   Status = "pending"
+	StatusExecuting Status = "executing"
+	StatusExecuted  Status = "executed"
+	StatusFailed    Status = "failed"
+	StatusExpired   Status = "expired"
+)
+
+// Terminal reports whether no further transition is allowed
+func (s Status) Terminal() bool {
+	return s == StatusExecuted || s == StatusFailed || s == StatusExpired
+}
+
+// allowed lists the transitions out of each non-terminal status
+var allowed = map[Status][]Status{
+	StatusPending:   {StatusExecuting, StatusFailed, StatusExpired},
+	StatusExecuting: {StatusExecuted, StatusFailed},
+}
+
+// Record is one journaled line. The signal itself is only written with the
+// pending record; later records carry just the status change.
+type Record struct {
+	Sequence   uint64           `json:"seq"`
+	RecordedAt time.Time        `json:"recorded_at"`
+	SignalID   uuid.UUID        `json:"signal_id"`
+	Status     Status           `json:"status"`
+	Detail     string           `json:"detail,omitempty"`
+	Signal     *strategy.Signal `json:"signal,omitempty"`
+
+	// Assets holds the symbol of each of Signal's actions, in order, since
+	// portfolio.Asset does not survive JSON encoding
+	Assets []string `json:"assets,omitempty"`
+}
+
+// Entry is a signal that has not reached a terminal status
+type Entry struct {
+	Signal    strategy.Signal
+	Status    Status
+	Detail    string
+	UpdatedAt time.Time
+}
+
+// SignalJournal is a write-ahead log of signals: each signal is recorded
+// as pending before execution begins, and every status change is appended
+// and synced before it takes effect. Whatever is still pending or
+// executing when the process restarts is in doubt.
+type SignalJournal interface {
+	// Configure opens the journal and replays it, so signals left in
+	// flight by a previous process are available from InDoubt
+	Configure(config Config) error
+
+	// Pending records a signal before it is executed
+	Pending(signal *strategy.Signal) error
+
+	// Transition moves a signal to status; moves not allowed from its
+	// current status are refused
+	Transition(signalID uuid.UUID, status Status, detail string) error
+
+	// InDoubt returns signals not yet executed, failed or expired, oldest first
+	InDoubt() []Entry
+
+	// Owner returns the strategy whose signal last executed a trade on the
+	// exchange's market for asset, so state left on the exchange can be
+	// credited back to it after a restart
+	Owner(exchange connector.ExchangeName, asset string) (strategy.StrategyName, bool)
+
+	Close() error
+}
+
+type signalJournal struct {
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	file     *os.File
+	sequence uint64
+	inFlight map[uuid.UUID]*Entry
+
+	// owners is the strategy that last executed on each market
+	owners map[market]strategy.StrategyName
+	mu     sync.Mutex
+}
+
+type market struct {
+	exchange connector.ExchangeName
+	asset    string
+}
+
+func NewSignalJournal(
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) SignalJournal {
+	return &signalJournal{
+		timeProvider: timeProvider,
+		logger:       logger,
+		inFlight:     make(map[uuid.UUID]*Entry),
+		owners:       make(map[market]strategy.StrategyName),
+	}
+}
+
+func (j *signalJournal) Configure(config Config) error {
+	if config.Directory == "" {
+		return fmt.Errorf("signal journal directory is required")
+	}
+	if err := os.MkdirAll(config.Directory, 0o750); err != nil {
+		return fmt.Errorf("failed to create signal journal directory: %w", err)
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.file != nil {
+		return fmt.Errorf("signal journal already configured")
+	}
+
+	path := filepath.Join(config.Directory, FileName)
+	if err := j.replay(path); err != nil {
+		return err
+	}
+
+	file, err := jsonl.OpenAppend(path, 0o640)
+	if err != nil {
+		return fmt.Errorf("failed to open signal journal: %w", err)
+	}
+	j.file = file
+
+	if len(j.inFlight) > 0 {
+		j.logger.Warn("Signal journal has %d signals in doubt from a previous session", len(j.inFlight))
+	}
+	return nil
+}
+
+// replay rebuilds the in-flight index from an existing journal; caller must hold j.mu
+func (j *signalJournal) replay(path string) error {
+	err := jsonl.ScanFile(path, func(_ int, line []byte) error {
+		var record Record
+		// A torn final line from a crash mid-write is skipped, not fatal
+		if err := json.Unmarshal(line, &record); err != nil {
+			j.logger.Warn("Skipping unreadable signal journal line: %v", err)
+			return nil
+		}
+		if record.Sequence > j.sequence {
+			j.sequence = record.Sequence
+		}
+		j.apply(record)
+		return nil
+	})
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read signal journal: %w", err)
+	}
+	return nil
+}
+
+// apply folds a record into the in-flight index; caller must hold j.mu
+func (j *signalJournal) apply(record Record) {
+	if record.Status.Terminal() {
+		if entry, ok := j.inFlight[record.SignalID]; ok && record.Status == StatusExecuted {
+			j.own(entry.Signal)
+		}
+		delete(j.inFlight, record.SignalID)
+		return
+	}
+
+	entry, ok := j.inFlight[record.SignalID]
+	if !ok {
+		if record.Signal == nil {
+			return
+		}
+		entry = &Entry{Signal: *record.Signal}
+		restoreAssets(&entry.Signal, record.Assets)
+		j.inFlight[record.SignalID] = entry
+	}
+	entry.Status = record.Status
+	entry.Detail = record.Detail
+	entry.UpdatedAt = record.RecordedAt
+}
+
+func (j *signalJournal) Pending(signal *strategy.Signal) error {
+	if signal == nil {
+		return fmt.Errorf("signal is nil")
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if _, exists := j.inFlight[signal.ID]; exists {
+		return fmt.Errorf("signal %s already journaled", signal.ID)
+	}
+	assets := make([]string, len(signal.Actions))
+	for i, action := range signal.Actions {
+		assets[i] = action.Asset.Symbol()
+	}
+	return j.append(Record{SignalID: signal.ID, Status: StatusPending, Signal: signal, Assets: assets})
+}
+
+// restoreAssets puts a replayed signal's asset symbols back on its actions,
+// copying the actions so the journaled signal is never modified
+func restoreAssets(signal *strategy.Signal, assets []string) {
+	if len(assets) != len(signal.Actions) {
+		return
+	}
+	actions := make([]strategy.TradeAction, len(signal.Actions))
+	copy(actions, signal.Actions)
+	for i, symbol := range assets {
+		if actions[i].Asset.Symbol() == "" {
+			actions[i].Asset = portfolio.NewAsset(symbol)
+		}
+	}
+	signal.Actions = actions
+}
+
+func (j *signalJournal) Transition(signalID uuid.UUID, status Status, detail string) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	entry, ok := j.inFlight[signalID]
+	if !ok {
+		if j.file == nil {
+			return ErrNotConfigured
+		}
+		return fmt.Errorf("signal %s is not in flight", signalID)
+	}
+
+	valid := false
+	for _, next := range allowed[entry.Status] {
+		if next == status {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return fmt.Errorf("signal %s cannot move from %s to %s", signalID, entry.Status, status)
+	}
+
+	return j.append(Record{SignalID: signalID, Status: status, Detail: detail})
+}
+
+// append writes and syncs a record, then applies it; caller must hold j.mu
+func (j *signalJournal) append(record Record) error {
+	if j.file == nil {
+		return ErrNotConfigured
+	}
+
+	j.sequence++
+	record.Sequence = j.sequence
+	record.RecordedAt = j.timeProvider.Now()
+
+	line, err := jsonl.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to encode signal record: %w", err)
+	}
+	if _, err := j.file.Write(line); err != nil {
+		return fmt.Errorf("failed to append signal record: %w", err)
+	}
+	// The status only counts once it is durable
+	if err := j.file.Sync(); err != nil {
+		return fmt.Errorf("failed to sync signal journal: %w", err)
+	}
+
+	j.apply(record)
+	return nil
+}
+
+// own credits the markets an executed signal traded to its strategy;
+// caller must hold j.mu
+func (j *signalJournal) own(signal strategy.Signal) {
+	for _, action := range signal.Actions {
+		if action.Action == strategy.ActionHold {
+			continue
+		}
+		j.owners[market{exchange: action.Exchange, asset: action.Asset.Symbol()}] = signal.Strategy
+	}
+}
+
+func (j *signalJournal) Owner(exchange connector.ExchangeName, asset string) (strategy.StrategyName, bool) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	owner, ok := j.owners[market{exchange: exchange, asset: asset}]
+	return owner, ok
+}
+
+func (j *signalJournal) InDoubt() []Entry {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	entries := make([]Entry, 0, len(j.inFlight))
+	for _, entry := range j.inFlight {
+		entries = append(entries, *entry)
+	}
+	sort.Slice(entries, func(a, b int) bool {
+		return entries[a].Signal.Timestamp.Before(entries[b].Signal.Timestamp)
+	})
+	return entries
+}
+
+func (j *signalJournal) Close() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.file == nil {
+		return nil
+	}
+
+	err := j.file.Close()
+	j.file = nil
+	return err
+}
+
+// ClientOrderID is the client order ID for action of a signal. An executor
+// that tags its orders with it lets startup match an in-doubt signal to its
+// orders exactly rather than by market, side and quantity. It is 26
+// alphanumeric characters, within every supported exchange's limit.
+func ClientOrderID(signalID uuid.UUID, action int) string {
+	return fmt.Sprintf("%s%02d", strings.ReplaceAll(signalID.String(), "-", "")[:24], action%100)
+}
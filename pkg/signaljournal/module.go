@@ -0,0 +1,22 @@
+package signaljournal
+
+import (
+	"context"
+
+	"go.uber.org/fx"
+)
+
+var Module = fx.Options(
+	fx.Provide(NewSignalJournal),
+	fx.Invoke(registerHooks),
+)
+
+// registerHooks closes the journal after the signal queue has drained,
+// since fx stops hooks in reverse registration order
+func registerHooks(lifecycle fx.Lifecycle, journal SignalJournal) {
+	lifecycle.Append(fx.Hook{
+		OnStop: func(context.Context) error {
+			return journal.Close()
+		},
+	})
+}
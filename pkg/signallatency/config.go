@@ -0,0 +1,68 @@
+// Package signallatency times every signal from the moment the strategy
+// produced it until the exchange filled it
+package signallatency
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// FileName is the timing log kept inside the configured directory
+	FileName = "latency.jsonl"
+
+	// DefaultMaxSignals bounds how many signals' timings are kept in memory
+	DefaultMaxSignals = 10000
+)
+
+// DefaultBuckets are the histogram upper bounds, from 1ms to 30s
+var DefaultBuckets = []time.Duration{
+	time.Millisecond,
+	2 * time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+	30 * time.Second,
+}
+
+// Config controls where timings are stored and how they are bucketed.
+// With no Directory timings are kept in memory only.
+type Config struct {
+	Directory  string
+	Buckets    []time.Duration
+	MaxSignals int
+}
+
+// DefaultConfig keeps the last 10000 signals in memory
+func DefaultConfig() Config {
+	return Config{
+		Buckets:    DefaultBuckets,
+		MaxSignals: DefaultMaxSignals,
+	}
+}
+
+func (c *Config) applyDefaults() error {
+	if len(c.Buckets) == 0 {
+		c.Buckets = DefaultBuckets
+	}
+	for i, bound := range c.Buckets {
+		if bound <= 0 {
+			return fmt.Errorf("latency bucket %d must be positive", i)
+		}
+		if i > 0 && bound <= c.Buckets[i-1] {
+			return fmt.Errorf("latency buckets must increase, %s follows %s", bound, c.Buckets[i-1])
+		}
+	}
+	if c.MaxSignals <= 0 {
+		c.MaxSignals = DefaultMaxSignals
+	}
+	return nil
+}
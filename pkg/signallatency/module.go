@@ -0,0 +1,26 @@
+package signallatency
+
+import (
+	"context"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	"go.uber.org/fx"
+)
+
+var Module = fx.Options(
+	fx.Provide(NewLatencyTracker),
+	fx.Invoke(registerHooks),
+)
+
+// registerHooks adds the execution hook to the SDK executor and closes the
+// timing log after the signal queue has drained, since fx stops hooks in
+// reverse registration order
+func registerHooks(lifecycle fx.Lifecycle, hooks registry.Hooks, tracker LatencyTracker) {
+	hooks.RegisterHook(tracker.Hook())
+
+	lifecycle.Append(fx.Hook{
+		OnStop: func(context.Context) error {
+			return tracker.Close()
+		},
+	})
+}
@@ -0,0 +1,432 @@
+package signallatency
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/execution"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/jsonl"
+	"github.com/google/uuid"
+)
+
+// record is one stored stage of one signal. Strategy and exchange are only
+// written with the generated stage and order IDs with the acked one.
+type record struct {
+	SignalID uuid.UUID              `json:"signal_id"`
+	Stage    Stage                  `json:"stage"`
+	At       time.Time              `json:"at"`
+	Strategy strategy.StrategyName  `json:"strategy,omitempty"`
+	Exchange connector.ExchangeName `json:"exchange,omitempty"`
+	OrderIDs []string               `json:"order_ids,omitempty"`
+	Error    string                 `json:"error,omitempty"`
+}
+
+// LatencyTracker stamps each signal as GetSignals returns it, as the SDK
+// executor sends its orders, as the exchange acknowledges them and as the
+// first fill comes back, so a slow venue or a slow strategy shows up in the
+// run stats. When a directory is configured every stage is appended to a
+// timing log there, which Configure replays after a restart.
+type LatencyTracker interface {
+	// Configure applies config and, when it names a directory, opens and
+	// replays the timing log
+	Configure(config Config) error
+
+	// Wrap returns an executor that stamps signals on their way in and
+	// fills on their way back; it must sit in front of every other
+	// decorator so queueing counts towards the latency
+	Wrap(inner execution.Executor) execution.Executor
+
+	// Hook stamps order sends and acknowledgements from inside the SDK
+	// executor; it is registered with the SDK hook registry
+	Hook() execution.ExecutionHook
+
+	Timing(signalID uuid.UUID) (Timing, bool)
+
+	// Summary builds histograms for the signals generated between since
+	// and until; a zero until leaves the window open
+	Summary(since, until time.Time) Summary
+
+	// Handler serves ?signal='s timing, or the summary between ?since=
+	// and ?until= (RFC 3339), as JSON
+	Handler() http.Handler
+
+	Close() error
+}
+
+type latencyTracker struct {
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config  Config
+	file    *os.File
+	timings map[uuid.UUID]*Timing
+
+	// order is signal IDs oldest first, for eviction
+	order []uuid.UUID
+
+	// awaiting maps acknowledged order IDs to the signal still waiting for
+	// its first fill
+	awaiting map[string]uuid.UUID
+	mu       sync.Mutex
+}
+
+func NewLatencyTracker(
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) LatencyTracker {
+	return &latencyTracker{
+		timeProvider: timeProvider,
+		logger:       logger,
+		config:       DefaultConfig(),
+		timings:      make(map[uuid.UUID]*Timing),
+		awaiting:     make(map[string]uuid.UUID),
+	}
+}
+
+func (l *latencyTracker) Configure(config Config) error {
+	if err := config.applyDefaults(); err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if config.Directory == "" {
+		l.config = config
+		return nil
+	}
+	if l.file != nil {
+		return fmt.Errorf("latency tracker already configured")
+	}
+	if err := os.MkdirAll(config.Directory, 0o750); err != nil {
+		return fmt.Errorf("failed to create latency directory: %w", err)
+	}
+
+	l.config = config
+	path := filepath.Join(config.Directory, FileName)
+	if err := l.replay(path); err != nil {
+		return err
+	}
+
+	file, err := jsonl.OpenAppend(path, 0o640)
+	if err != nil {
+		return fmt.Errorf("failed to open latency log: %w", err)
+	}
+	l.file = file
+	return nil
+}
+
+// replay rebuilds timings from an existing log; caller must hold l.mu
+func (l *latencyTracker) replay(path string) error {
+	err := jsonl.ScanFile(path, func(_ int, line []byte) error {
+		var rec record
+		// A torn final line from a crash mid-write is skipped, not fatal
+		if err := json.Unmarshal(line, &rec); err != nil {
+			l.logger.Warn("Skipping unreadable latency log line: %v", err)
+			return nil
+		}
+		l.apply(rec)
+		return nil
+	})
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read latency log: %w", err)
+	}
+	return nil
+}
+
+// stamp records a stage now, storing it when the log is open. A failed
+// write is logged rather than returned: latency is never worth failing an
+// order over.
+func (l *latencyTracker) stamp(rec record) {
+	rec.At = l.timeProvider.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.apply(rec) || l.file == nil {
+		return
+	}
+	if err := jsonl.Append(l.file, rec); err != nil {
+		l.logger.Warn("Failed to store %s latency of signal %s: %v", rec.Stage, rec.SignalID, err)
+	}
+}
+
+// apply folds a stage into its signal's timing and reports whether it
+// changed anything; caller must hold l.mu
+func (l *latencyTracker) apply(rec record) bool {
+	timing, ok := l.timings[rec.SignalID]
+	if !ok {
+		if rec.Stage == StageFilled {
+			return false
+		}
+		timing = &Timing{SignalID: rec.SignalID}
+		l.timings[rec.SignalID] = timing
+		l.order = append(l.order, rec.SignalID)
+		l.evict()
+	}
+
+	switch rec.Stage {
+	case StageGenerated:
+		timing.Generated = rec.At
+		timing.Strategy = rec.Strategy
+		timing.Exchange = rec.Exchange
+	case StageSent:
+		// A retried signal is timed from its last attempt
+		timing.Sent = rec.At
+		timing.Failed = time.Time{}
+		timing.Error = ""
+	case StageAcked:
+		timing.Acked = rec.At
+		timing.OrderIDs = append([]string(nil), rec.OrderIDs...)
+		for _, orderID := range rec.OrderIDs {
+			l.awaiting[orderID] = rec.SignalID
+		}
+	case StageFilled:
+		if !timing.Filled.IsZero() {
+			return false
+		}
+		timing.Filled = rec.At
+		for _, orderID := range timing.OrderIDs {
+			delete(l.awaiting, orderID)
+		}
+	case StageFailed:
+		timing.Failed = rec.At
+		timing.Error = rec.Error
+	default:
+		return false
+	}
+	return true
+}
+
+// evict drops the oldest timings beyond MaxSignals; caller must hold l.mu
+func (l *latencyTracker) evict() {
+	for len(l.order) > l.config.MaxSignals {
+		if timing, ok := l.timings[l.order[0]]; ok {
+			for _, orderID := range timing.OrderIDs {
+				delete(l.awaiting, orderID)
+			}
+			delete(l.timings, l.order[0])
+		}
+		l.order = l.order[1:]
+	}
+}
+
+// filled stamps the first fill of an order a signal is waiting on
+func (l *latencyTracker) filled(trade connector.Trade) {
+	orderID := trade.OrderID
+	if orderID == "" {
+		orderID = trade.ID
+	}
+
+	l.mu.Lock()
+	signalID, ok := l.awaiting[orderID]
+	l.mu.Unlock()
+	if !ok {
+		return
+	}
+	l.stamp(record{SignalID: signalID, Stage: StageFilled})
+}
+
+func (l *latencyTracker) Timing(signalID uuid.UUID) (Timing, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	timing, ok := l.timings[signalID]
+	if !ok {
+		return Timing{}, false
+	}
+	copied := *timing
+	copied.OrderIDs = append([]string(nil), timing.OrderIDs...)
+	return copied, true
+}
+
+func (l *latencyTracker) Summary(since, until time.Time) Summary {
+	l.mu.Lock()
+	bounds := l.config.Buckets
+	var timings []Timing
+	for _, id := range l.order {
+		timing, ok := l.timings[id]
+		if !ok {
+			continue
+		}
+		// A signal whose generation was not seen, e.g. one re-executed
+		// after a restart, is placed by when it was sent
+		at := timing.Generated
+		if at.IsZero() {
+			at = timing.Sent
+		}
+		if at.Before(since) || (!until.IsZero() && at.After(until)) {
+			continue
+		}
+		timings = append(timings, *timing)
+	}
+	l.mu.Unlock()
+
+	overall := make(map[Segment][]time.Duration)
+	byExchange := make(map[connector.ExchangeName]map[Segment][]time.Duration)
+	byStrategy := make(map[strategy.StrategyName]map[Segment][]time.Duration)
+	for _, timing := range timings {
+		for _, segment := range segments {
+			d, ok := timing.Duration(segment)
+			if !ok {
+				continue
+			}
+			overall[segment] = append(overall[segment], d)
+			if timing.Exchange != "" {
+				if byExchange[timing.Exchange] == nil {
+					byExchange[timing.Exchange] = make(map[Segment][]time.Duration)
+				}
+				byExchange[timing.Exchange][segment] = append(byExchange[timing.Exchange][segment], d)
+			}
+			if timing.Strategy != "" {
+				if byStrategy[timing.Strategy] == nil {
+					byStrategy[timing.Strategy] = make(map[Segment][]time.Duration)
+				}
+				byStrategy[timing.Strategy][segment] = append(byStrategy[timing.Strategy][segment], d)
+			}
+		}
+	}
+
+	summary := Summary{
+		Signals:    len(timings),
+		Segments:   histograms(bounds, overall),
+		ByExchange: make(map[connector.ExchangeName]map[Segment]Histogram, len(byExchange)),
+		ByStrategy: make(map[strategy.StrategyName]map[Segment]Histogram, len(byStrategy)),
+	}
+	for exchange, samples := range byExchange {
+		summary.ByExchange[exchange] = histograms(bounds, samples)
+	}
+	for name, samples := range byStrategy {
+		summary.ByStrategy[name] = histograms(bounds, samples)
+	}
+	return summary
+}
+
+func histograms(bounds []time.Duration, samples map[Segment][]time.Duration) map[Segment]Histogram {
+	result := make(map[Segment]Histogram, len(samples))
+	for segment, durations := range samples {
+		result[segment] = newHistogram(bounds, durations)
+	}
+	return result
+}
+
+func (l *latencyTracker) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		query := req.URL.Query()
+
+		if id := query.Get("signal"); id != "" {
+			signalID, err := uuid.Parse(id)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("invalid signal ID: %v", err), http.StatusBadRequest)
+				return
+			}
+			timing, ok := l.Timing(signalID)
+			if !ok {
+				http.Error(w, "signal not found", http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(timing)
+			return
+		}
+
+		var since, until time.Time
+		for name, target := range map[string]*time.Time{"since": &since, "until": &until} {
+			value := query.Get(name)
+			if value == "" {
+				continue
+			}
+			parsed, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("invalid %s: %v", name, err), http.StatusBadRequest)
+				return
+			}
+			*target = parsed
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(l.Summary(since, until))
+	})
+}
+
+func (l *latencyTracker) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.file == nil {
+		return nil
+	}
+
+	err := l.file.Close()
+	l.file = nil
+	return err
+}
+
+func (l *latencyTracker) Wrap(inner execution.Executor) execution.Executor {
+	return &timedExecutor{tracker: l, inner: inner}
+}
+
+func (l *latencyTracker) Hook() execution.ExecutionHook {
+	return &executionHook{tracker: l}
+}
+
+// timedExecutor is what the orchestrator sees in place of the SDK executor
+type timedExecutor struct {
+	tracker *latencyTracker
+	inner   execution.Executor
+}
+
+func (e *timedExecutor) ExecuteSignal(signal *strategy.Signal) error {
+	if signal != nil {
+		rec := record{SignalID: signal.ID, Stage: StageGenerated, Strategy: signal.Strategy}
+		if len(signal.Actions) > 0 {
+			rec.Exchange = signal.Actions[0].Exchange
+		}
+		e.tracker.stamp(rec)
+	}
+	return e.inner.ExecuteSignal(signal)
+}
+
+func (e *timedExecutor) HandleTradeExecution(trade connector.Trade) error {
+	e.tracker.filled(trade)
+	return e.inner.HandleTradeExecution(trade)
+}
+
+// executionHook sees the SDK executor place a signal's orders
+type executionHook struct {
+	tracker *latencyTracker
+}
+
+func (h *executionHook) BeforeExecute(ctx *execution.ExecutionContext) error {
+	if ctx.Signal != nil {
+		h.tracker.stamp(record{SignalID: ctx.Signal.ID, Stage: StageSent})
+	}
+	return nil
+}
+
+func (h *executionHook) AfterExecute(ctx *execution.ExecutionContext, result *execution.ExecutionResult) error {
+	if ctx.Signal != nil && result != nil {
+		h.tracker.stamp(record{SignalID: ctx.Signal.ID, Stage: StageAcked, OrderIDs: result.OrderIDs})
+	}
+	return nil
+}
+
+func (h *executionHook) OnError(ctx *execution.ExecutionContext, err error) error {
+	if ctx.Signal != nil && err != nil {
+		h.tracker.stamp(record{SignalID: ctx.Signal.ID, Stage: StageFailed, Error: err.Error()})
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+package signallatency
+
+import (
+	"sort"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+	"github.com/backtesting-org/live-trading/pkg/websocket/performance"
+	"github.com/google/uuid"
+)
+
+// Stage is a point in a signal's path to the exchange
+type Stage string
+
+const (
+	// StageGenerated is when GetSignals returned the signal to the executor
+	StageGenerated Stage = "generated"
+
+	// StageSent is when the SDK executor began sending the order requests,
+	// after queueing, arbitration and pre-trade checks
+	StageSent Stage = "sent"
+
+	// StageAcked is when the exchange had acknowledged every order
+	StageAcked Stage = "acked"
+
+	// StageFilled is when the first fill of any of the orders was seen
+	StageFilled Stage = "filled"
+
+	// StageFailed is when execution gave up on the signal
+	StageFailed Stage = "failed"
+)
+
+// Segment is the span between two stages that a histogram covers
+type Segment string
+
+const (
+	// SegmentQueue is generated to sent: queueing and pre-trade checks,
+	// where a slow strategy host or a backed-up worker shows
+	SegmentQueue Segment = "queue"
+
+	// SegmentAck is sent to acked: the exchange round trip
+	SegmentAck Segment = "ack"
+
+	// SegmentFill is acked to filled: how long orders rest
+	SegmentFill Segment = "fill"
+
+	// SegmentAckTotal is generated to acked
+	SegmentAckTotal Segment = "generated_to_ack"
+
+	// SegmentFillTotal is generated to filled
+	SegmentFillTotal Segment = "generated_to_fill"
+)
+
+// segments lists every segment in reporting order
+var segments = []Segment{SegmentQueue, SegmentAck, SegmentFill, SegmentAckTotal, SegmentFillTotal}
+
+// Segments returns every segment in reporting order
+func Segments() []Segment {
+	return append([]Segment(nil), segments...)
+}
+
+// span maps a segment to its start and end stage
+func (s Segment) span() (Stage, Stage) {
+	switch s {
+	case SegmentQueue:
+		return StageGenerated, StageSent
+	case SegmentAck:
+		return StageSent, StageAcked
+	case SegmentFill:
+		return StageAcked, StageFilled
+	case SegmentAckTotal:
+		return StageGenerated, StageAcked
+	default:
+		return StageGenerated, StageFilled
+	}
+}
+
+// Timing is when one signal reached each stage; a stage not reached yet
+// is zero
+type Timing struct {
+	SignalID uuid.UUID
+	Strategy strategy.StrategyName
+
+	// Exchange is the first action's venue
+	Exchange connector.ExchangeName
+	OrderIDs []string
+
+	Generated time.Time
+	Sent      time.Time
+	Acked     time.Time
+	Filled    time.Time
+	Failed    time.Time
+	Error     string
+}
+
+// At is when the signal reached stage
+func (t Timing) At(stage Stage) time.Time {
+	switch stage {
+	case StageGenerated:
+		return t.Generated
+	case StageSent:
+		return t.Sent
+	case StageAcked:
+		return t.Acked
+	case StageFilled:
+		return t.Filled
+	case StageFailed:
+		return t.Failed
+	}
+	return time.Time{}
+}
+
+// Duration is the length of segment; ok is false until both stages are reached
+func (t Timing) Duration(segment Segment) (time.Duration, bool) {
+	from, to := segment.span()
+	start, end := t.At(from), t.At(to)
+	if start.IsZero() || end.IsZero() {
+		return 0, false
+	}
+	// A wall clock step between two stages can put them out of order
+	d := end.Sub(start)
+	if d < 0 {
+		d = 0
+	}
+	return d, true
+}
+
+// Bucket counts samples above the previous bucket's bound up to UpperBound
+type Bucket struct {
+	UpperBound time.Duration
+	Count      int
+}
+
+// Histogram is the distribution of one segment
+type Histogram struct {
+	Count   int
+	Buckets []Bucket
+
+	// Overflow counts samples above the last bucket
+	Overflow int
+
+	Mean time.Duration
+	P50  time.Duration
+	P95  time.Duration
+	P99  time.Duration
+	Max  time.Duration
+}
+
+// Summary is the latency of the signals generated in a window, overall and
+// per venue and strategy
+type Summary struct {
+	Signals    int
+	Segments   map[Segment]Histogram
+	ByExchange map[connector.ExchangeName]map[Segment]Histogram
+	ByStrategy map[strategy.StrategyName]map[Segment]Histogram
+}
+
+// newHistogram counts samples into the configured buckets and takes the
+// quantiles from a performance.LatencyHistogram, accurate to within 1%
+func newHistogram(bounds []time.Duration, samples []time.Duration) Histogram {
+	h := Histogram{Count: len(samples), Buckets: make([]Bucket, len(bounds))}
+	for i, bound := range bounds {
+		h.Buckets[i].UpperBound = bound
+	}
+	if len(samples) == 0 {
+		return h
+	}
+
+	recorded := performance.NewLatencyHistogram()
+	var total time.Duration
+	for _, sample := range samples {
+		recorded.Record(sample)
+		total += sample
+		index := sort.Search(len(bounds), func(i int) bool { return sample <= bounds[i] })
+		if index == len(bounds) {
+			h.Overflow++
+			continue
+		}
+		h.Buckets[index].Count++
+	}
+
+	h.Mean = total / time.Duration(len(samples))
+	h.P50 = recorded.Percentile(0.50)
+	h.P95 = recorded.Percentile(0.95)
+	h.P99 = recorded.Percentile(0.99)
+	h.Max = recorded.Percentile(1)
+	return h
+}
@@ -0,0 +1,72 @@
+package signalqueue
+
+import (
+	"time"
+
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+const (
+	// DefaultWorkers is how many signals execute concurrently
+	DefaultWorkers = 4
+
+	// DefaultCapacity bounds each worker's backlog
+	DefaultCapacity = 256
+
+	// DefaultTTL drops signals that waited longer than this; acting on a
+	// stale signal is usually worse than skipping it
+	DefaultTTL = 30 * time.Second
+)
+
+// RetryPolicy is how a failed signal is retried for one error category.
+// The delay doubles from Backoff after each attempt, up to MaxBackoff.
+type RetryPolicy struct {
+	MaxAttempts int
+	Backoff     time.Duration
+	MaxBackoff  time.Duration
+}
+
+func (p RetryPolicy) delay(attempt int) time.Duration {
+	delay := p.Backoff
+	for i := 1; i < attempt && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+	return delay
+}
+
+// DefaultRetries retry rate limits and connectivity failures, which the
+// exchange may accept on a later attempt. Every other category aborts on the
+// first failure: a rejected key, order or balance will be rejected again.
+func DefaultRetries() map[types.ErrorCategory]RetryPolicy {
+	return map[types.ErrorCategory]RetryPolicy{
+		types.CategoryRateLimited:  {MaxAttempts: 4, Backoff: 500 * time.Millisecond, MaxBackoff: 5 * time.Second},
+		types.CategoryConnectivity: {MaxAttempts: 3, Backoff: 250 * time.Millisecond, MaxBackoff: 2 * time.Second},
+	}
+}
+
+// Config sizes the execution queue. Workers and Capacity are fixed when the
+// queue starts.
+type Config struct {
+	Workers  int
+	Capacity int
+	TTL      time.Duration
+
+	// Retries re-run a failed signal by the category of its error. A retry
+	// executes the whole signal again, so a connectivity timeout after an
+	// order reached the exchange can repeat it; drop the connectivity policy
+	// where that matters more than the missed signal.
+	Retries map[types.ErrorCategory]RetryPolicy
+}
+
+// DefaultConfig runs four workers with a thirty second TTL
+func DefaultConfig() Config {
+	return Config{
+		Workers:  DefaultWorkers,
+		Capacity: DefaultCapacity,
+		TTL:      DefaultTTL,
+		Retries:  DefaultRetries(),
+	}
+}
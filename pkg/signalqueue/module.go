@@ -0,0 +1,25 @@
+package signalqueue
+
+import (
+	"context"
+
+	"go.uber.org/fx"
+)
+
+// Module provides the queue only; pkg/pipeline puts it in front of the
+// SDK executor
+var Module = fx.Options(
+	fx.Provide(NewSignalQueue),
+	fx.Invoke(registerHooks),
+)
+
+func registerHooks(lifecycle fx.Lifecycle, queue SignalQueue) {
+	lifecycle.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			return queue.Start()
+		},
+		OnStop: func(ctx context.Context) error {
+			return queue.Stop(ctx)
+		},
+	})
+}
@@ -0,0 +1,409 @@
+package signalqueue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/execution"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+	"github.com/backtesting-org/live-trading/pkg/metrics"
+	"github.com/backtesting-org/live-trading/pkg/runlog"
+	"github.com/backtesting-org/live-trading/pkg/signaljournal"
+)
+
+// ErrQueueFull is returned when a strategy's worker backlog is at capacity
+var ErrQueueFull = errors.New("signal queue full")
+
+// Stats are the queue's counters since it started
+type Stats struct {
+	Depth    int
+	Capacity int
+	Workers  int
+	Enqueued int64
+	Executed int64
+	Failed   int64
+	Expired  int64
+	Rejected int64
+
+	// Retried counts extra attempts, not signals
+	Retried int64
+
+	// OldestWait is how long the longest-queued signal has waited
+	OldestWait time.Duration
+}
+
+// SignalQueue decouples signal generation from order execution. It wraps
+// the SDK executor so that ExecuteSignal only enqueues; a worker pool
+// executes signals in the background. Signals from one strategy always land
+// on the same worker, so each run's signals execute in the order generated.
+// When the signal journal is configured, every signal is journaled as
+// pending before it is accepted, and a signal that cannot be journaled is
+// refused rather than executed without a record.
+type SignalQueue interface {
+	Configure(config Config)
+
+	// Wrap returns an executor that enqueues into this queue and executes
+	// through inner. Until Start, signals execute synchronously.
+	Wrap(inner execution.Executor) execution.Executor
+
+	Start() error
+
+	// Stop refuses new signals and drains the backlog until ctx is done
+	Stop(ctx context.Context) error
+
+	Stats() Stats
+
+	// Collector exports Stats to the metrics exporter
+	Collector() metrics.Collector
+}
+
+type item struct {
+	signal     *strategy.Signal
+	enqueuedAt time.Time
+}
+
+type signalQueue struct {
+	journal      signaljournal.SignalJournal
+	runLog       runlog.RunLogger
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config  Config
+	inner   execution.Executor
+	shards  []chan item
+	heads   []atomic.Int64 // enqueue time (UnixNano) of each worker's current item
+	running bool
+	workers sync.WaitGroup
+	mu      sync.RWMutex
+
+	enqueued atomic.Int64
+	executed atomic.Int64
+	failed   atomic.Int64
+	expired  atomic.Int64
+	rejected atomic.Int64
+	retried  atomic.Int64
+}
+
+func NewSignalQueue(
+	journal signaljournal.SignalJournal,
+	runLog runlog.RunLogger,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) SignalQueue {
+	return &signalQueue{
+		journal:      journal,
+		runLog:       runLog,
+		timeProvider: timeProvider,
+		logger:       logger,
+		config:       DefaultConfig(),
+	}
+}
+
+func (q *signalQueue) Configure(config Config) {
+	defaults := DefaultConfig()
+	if config.Workers <= 0 {
+		config.Workers = defaults.Workers
+	}
+	if config.Capacity <= 0 {
+		config.Capacity = defaults.Capacity
+	}
+	if config.TTL <= 0 {
+		config.TTL = defaults.TTL
+	}
+	if config.Retries == nil {
+		config.Retries = defaults.Retries
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.running {
+		q.logger.Warn("Signal queue already running; new worker and capacity settings apply after restart")
+		q.config.TTL = config.TTL
+		q.config.Retries = config.Retries
+		return
+	}
+	q.config = config
+}
+
+func (q *signalQueue) Wrap(inner execution.Executor) execution.Executor {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.inner = inner
+	return &queuedExecutor{queue: q, inner: inner}
+}
+
+func (q *signalQueue) Start() error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.running {
+		return fmt.Errorf("signal queue already running")
+	}
+	// Nothing in the graph asked for an executor, so there is nothing to queue
+	if q.inner == nil {
+		q.logger.Debug("Signal queue not started: no executor wrapped")
+		return nil
+	}
+
+	q.shards = make([]chan item, q.config.Workers)
+	q.heads = make([]atomic.Int64, q.config.Workers)
+	for i := range q.shards {
+		q.shards[i] = make(chan item, q.config.Capacity)
+		q.workers.Add(1)
+		go q.work(i, q.shards[i])
+	}
+	q.running = true
+
+	q.logger.Info("Signal queue started with %d workers (capacity %d each, TTL %s)", q.config.Workers, q.config.Capacity, q.config.TTL)
+	return nil
+}
+
+func (q *signalQueue) Stop(ctx context.Context) error {
+	q.mu.Lock()
+	if !q.running {
+		q.mu.Unlock()
+		return nil
+	}
+	q.running = false
+	for _, shard := range q.shards {
+		close(shard)
+	}
+	q.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		q.workers.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		q.logger.Info("Signal queue drained")
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("signal queue did not drain: %w", ctx.Err())
+	}
+}
+
+// enqueue hands the signal to its strategy's worker, or executes it inline
+// when the queue is not running
+func (q *signalQueue) enqueue(signal *strategy.Signal) error {
+	if signal == nil {
+		return fmt.Errorf("signal is nil")
+	}
+
+	if err := q.journal.Pending(signal); err != nil && !errors.Is(err, signaljournal.ErrNotConfigured) {
+		return fmt.Errorf("signal %s not journaled, refusing to execute: %w", signal.ID, err)
+	}
+
+	q.mu.RLock()
+	if !q.running {
+		inner := q.inner
+		q.mu.RUnlock()
+		return q.run(inner, signal)
+	}
+	defer q.mu.RUnlock()
+
+	shard := q.shards[q.shardFor(signal.Strategy)]
+	select {
+	case shard <- item{signal: signal, enqueuedAt: q.timeProvider.Now()}:
+		q.enqueued.Add(1)
+		return nil
+	default:
+		q.rejected.Add(1)
+		q.transition(signal, signaljournal.StatusFailed, "queue full")
+		return fmt.Errorf("strategy %s: %w (%d pending)", signal.Strategy, ErrQueueFull, len(shard))
+	}
+}
+
+// shardFor pins a strategy to one worker; caller must hold q.mu
+func (q *signalQueue) shardFor(name strategy.StrategyName) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(name))
+	return int(h.Sum32() % uint32(len(q.shards)))
+}
+
+func (q *signalQueue) work(index int, shard <-chan item) {
+	defer q.workers.Done()
+
+	for next := range shard {
+		q.heads[index].Store(next.enqueuedAt.UnixNano())
+		q.execute(next)
+		q.heads[index].Store(0)
+	}
+}
+
+func (q *signalQueue) execute(next item) {
+	q.mu.RLock()
+	inner := q.inner
+	ttl := q.config.TTL
+	q.mu.RUnlock()
+
+	// Age is measured from generation when the strategy stamped the signal
+	generated := next.signal.Timestamp
+	if generated.IsZero() {
+		generated = next.enqueuedAt
+	}
+	if age := q.timeProvider.Since(generated); age > ttl {
+		q.expired.Add(1)
+		q.logger.Warn("Dropping signal %s from %s: %s old exceeds TTL %s", next.signal.ID, next.signal.Strategy, age.Round(time.Millisecond), ttl)
+		q.transition(next.signal, signaljournal.StatusExpired, fmt.Sprintf("waited %s", age.Round(time.Millisecond)))
+		return
+	}
+
+	if err := q.run(inner, next.signal); err != nil {
+		q.failed.Add(1)
+		q.logger.Error("Signal execution failed for %s: %v", next.signal.Strategy, err)
+		return
+	}
+	q.executed.Add(1)
+}
+
+// run executes a signal through inner, journaling the executing status first
+// and the outcome after. Failures are retried or abandoned according to the
+// retry policy for their error category.
+func (q *signalQueue) run(inner execution.Executor, signal *strategy.Signal) error {
+	q.mu.RLock()
+	retries := q.config.Retries
+	q.mu.RUnlock()
+
+	q.transition(signal, signaljournal.StatusExecuting, "")
+
+	for attempt := 1; ; attempt++ {
+		err := inner.ExecuteSignal(signal)
+		if err == nil {
+			q.transition(signal, signaljournal.StatusExecuted, "")
+			return nil
+		}
+
+		category := types.Classify(err)
+		policy, ok := retries[category]
+		if !ok || attempt >= policy.MaxAttempts {
+			q.transition(signal, signaljournal.StatusFailed, fmt.Sprintf("%s after %d attempt(s): %v", category, attempt, err))
+			return fmt.Errorf("%s: %w", category, err)
+		}
+
+		delay := policy.delay(attempt)
+		q.retried.Add(1)
+		q.logger.Warn("Retrying signal %s from %s in %s after %s failure (attempt %d of %d): %v",
+			signal.ID, signal.Strategy, delay, category, attempt, policy.MaxAttempts, err)
+		q.timeProvider.Sleep(delay)
+	}
+}
+
+// transition journals a status change and records it in the run log; a
+// journal failure is logged, since the signal is already durably recorded
+// as pending and startup recovery will treat it as in doubt
+func (q *signalQueue) transition(signal *strategy.Signal, status signaljournal.Status, detail string) {
+	err := q.journal.Transition(signal.ID, status, detail)
+	if err != nil && !errors.Is(err, signaljournal.ErrNotConfigured) {
+		q.logger.Warn("Signal %s %s not journaled: %v", signal.ID, status, err)
+	}
+
+	level := runlog.LevelInfo
+	if status == signaljournal.StatusFailed || status == signaljournal.StatusExpired {
+		level = runlog.LevelWarn
+	}
+	message := fmt.Sprintf("Signal from %s %s", signal.Strategy, status)
+	if detail != "" {
+		message += ": " + detail
+	}
+	q.runLog.Record(level, "signal", signalFields(signal), "%s", message)
+}
+
+// signalFields tags a signal's entries with its ID, which also correlates
+// them with the orders it produces, and with its market when every action
+// shares one
+func signalFields(signal *strategy.Signal) runlog.Fields {
+	fields := runlog.Fields{SignalID: signal.ID.String(), CorrelationID: signal.ID.String()}
+	for i, action := range signal.Actions {
+		if i == 0 {
+			fields.Asset = action.Asset.Symbol()
+			fields.Exchange = string(action.Exchange)
+			continue
+		}
+		if action.Asset.Symbol() != fields.Asset {
+			fields.Asset = ""
+		}
+		if string(action.Exchange) != fields.Exchange {
+			fields.Exchange = ""
+		}
+	}
+	return fields
+}
+
+func (q *signalQueue) Stats() Stats {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	stats := Stats{
+		Capacity: q.config.Capacity * len(q.shards),
+		Workers:  len(q.shards),
+		Enqueued: q.enqueued.Load(),
+		Executed: q.executed.Load(),
+		Failed:   q.failed.Load(),
+		Expired:  q.expired.Load(),
+		Rejected: q.rejected.Load(),
+		Retried:  q.retried.Load(),
+	}
+
+	now := q.timeProvider.Now()
+	for i, shard := range q.shards {
+		stats.Depth += len(shard)
+		if head := q.heads[i].Load(); head > 0 {
+			if wait := now.Sub(time.Unix(0, head)); wait > stats.OldestWait {
+				stats.OldestWait = wait
+			}
+		}
+	}
+	return stats
+}
+
+func (q *signalQueue) Collector() metrics.Collector {
+	return func() []metrics.Sample {
+		stats := q.Stats()
+		gauge := func(name, help string, value float64) metrics.Sample {
+			return metrics.Sample{Name: "live_trading_signal_queue_" + name, Help: help, Type: metrics.TypeGauge, Value: value}
+		}
+		counter := func(name, help string, value int64) metrics.Sample {
+			return metrics.Sample{Name: "live_trading_signal_queue_" + name + "_total", Help: help, Type: metrics.TypeCounter, Value: float64(value)}
+		}
+
+		return []metrics.Sample{
+			gauge("depth", "Signals waiting for an execution worker.", float64(stats.Depth)),
+			gauge("capacity", "Signals the queue can hold across all workers.", float64(stats.Capacity)),
+			gauge("oldest_wait_seconds", "How long the signal executing longest has been queued.", stats.OldestWait.Seconds()),
+			counter("enqueued", "Signals accepted into the queue.", stats.Enqueued),
+			counter("executed", "Signals executed successfully.", stats.Executed),
+			counter("failed", "Signals whose execution returned an error.", stats.Failed),
+			counter("expired", "Signals dropped for exceeding the TTL.", stats.Expired),
+			counter("rejected", "Signals refused because the queue was full.", stats.Rejected),
+			counter("retried", "Extra execution attempts after a retryable failure.", stats.Retried),
+		}
+	}
+}
+
+// queuedExecutor is what the orchestrator sees in place of the SDK executor
+type queuedExecutor struct {
+	queue *signalQueue
+	inner execution.Executor
+}
+
+func (e *queuedExecutor) ExecuteSignal(signal *strategy.Signal) error {
+	return e.queue.enqueue(signal)
+}
+
+// HandleTradeExecution reports fills, which must not wait behind signals
+func (e *queuedExecutor) HandleTradeExecution(trade connector.Trade) error {
+	return e.inner.HandleTradeExecution(trade)
+}
@@ -0,0 +1,218 @@
+package signalqueue_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	mockexecution "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/execution"
+	mocktemporal "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/execution"
+	logger "github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+	mockscheduler "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/scheduler"
+	mocksignaljournal "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/signaljournal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+	"github.com/backtesting-org/live-trading/pkg/runlog"
+	"github.com/backtesting-org/live-trading/pkg/signaljournal"
+	"github.com/backtesting-org/live-trading/pkg/signalqueue"
+)
+
+var _ = Describe("SignalQueue", func() {
+	var (
+		journal *mocksignaljournal.SignalJournal
+		inner   *mockexecution.Executor
+		queue   signalqueue.SignalQueue
+		wrapped execution.Executor
+
+		mu       sync.Mutex
+		now      time.Time
+		slept    []time.Duration
+		executed []uuid.UUID
+		results  []error
+	)
+
+	signal := func(name strategy.StrategyName) *strategy.Signal {
+		return &strategy.Signal{ID: uuid.New(), Strategy: name}
+	}
+
+	// executions is the IDs the inner executor saw, in order
+	executions := func() []uuid.UUID {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]uuid.UUID(nil), executed...)
+	}
+
+	BeforeEach(func() {
+		now = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		slept = nil
+		executed = nil
+		results = nil
+
+		journal = mocksignaljournal.NewSignalJournal(GinkgoT())
+		journal.On("Pending", mock.Anything).Return(signaljournal.ErrNotConfigured).Maybe()
+		journal.On("Transition", mock.Anything, mock.Anything, mock.Anything).Return(signaljournal.ErrNotConfigured).Maybe()
+
+		inner = mockexecution.NewExecutor(GinkgoT())
+		inner.On("ExecuteSignal", mock.Anything).Return(func(s *strategy.Signal) error {
+			mu.Lock()
+			defer mu.Unlock()
+			executed = append(executed, s.ID)
+			if len(results) == 0 {
+				return nil
+			}
+			err := results[0]
+			results = results[1:]
+			return err
+		}).Maybe()
+
+		mockTime := mocktemporal.NewTimeProvider(GinkgoT())
+		mockTime.On("Now").Return(func() time.Time {
+			mu.Lock()
+			defer mu.Unlock()
+			return now
+		}).Maybe()
+		mockTime.On("Since", mock.Anything).Return(func(t time.Time) time.Duration {
+			mu.Lock()
+			defer mu.Unlock()
+			return now.Sub(t)
+		}).Maybe()
+		mockTime.On("Sleep", mock.Anything).Run(func(args mock.Arguments) {
+			mu.Lock()
+			defer mu.Unlock()
+			slept = append(slept, args.Get(0).(time.Duration))
+		}).Return().Maybe()
+
+		noOp := logger.NewNoOpLogger()
+		queue = signalqueue.NewSignalQueue(journal, runlog.NewRunLogger(mockscheduler.NewScheduler(GinkgoT()), mockTime, noOp), mockTime, noOp)
+		wrapped = queue.Wrap(inner)
+	})
+
+	AfterEach(func() {
+		Expect(queue.Stop(context.Background())).To(Succeed())
+	})
+
+	Describe("before Start", func() {
+		It("executes inline and journals the outcome", func() {
+			s := signal("momentum")
+			Expect(wrapped.ExecuteSignal(s)).To(Succeed())
+
+			Expect(executions()).To(Equal([]uuid.UUID{s.ID}))
+			journal.AssertCalled(GinkgoT(), "Transition", s.ID, signaljournal.StatusExecuting, "")
+			journal.AssertCalled(GinkgoT(), "Transition", s.ID, signaljournal.StatusExecuted, "")
+		})
+
+		It("refuses a signal the journal cannot record", func() {
+			journal.ExpectedCalls = nil
+			journal.On("Pending", mock.Anything).Return(errors.New("disk full"))
+
+			err := wrapped.ExecuteSignal(signal("momentum"))
+			Expect(err).To(MatchError(ContainSubstring("not journaled, refusing to execute")))
+			Expect(executions()).To(BeEmpty())
+		})
+
+		It("retries a rate-limited signal with doubling backoff", func() {
+			results = []error{
+				fmt.Errorf("place order: %w", types.ErrRateLimited),
+				fmt.Errorf("place order: %w", types.ErrRateLimited),
+			}
+
+			Expect(wrapped.ExecuteSignal(signal("momentum"))).To(Succeed())
+			Expect(executions()).To(HaveLen(3))
+			Expect(slept).To(Equal([]time.Duration{500 * time.Millisecond, time.Second}))
+			Expect(queue.Stats().Retried).To(Equal(int64(2)))
+		})
+
+		It("gives up on the first failure of a category without a retry policy", func() {
+			results = []error{errors.New("order rejected")}
+
+			err := wrapped.ExecuteSignal(signal("momentum"))
+			Expect(err).To(MatchError(ContainSubstring("order rejected")))
+			Expect(executions()).To(HaveLen(1))
+			Expect(slept).To(BeEmpty())
+		})
+
+		It("stops retrying once the policy's attempts are spent", func() {
+			queue.Configure(signalqueue.Config{Retries: map[types.ErrorCategory]signalqueue.RetryPolicy{
+				types.CategoryRateLimited: {MaxAttempts: 2, Backoff: time.Second},
+			}})
+			results = []error{types.ErrRateLimited, types.ErrRateLimited, types.ErrRateLimited}
+
+			err := wrapped.ExecuteSignal(signal("momentum"))
+			Expect(errors.Is(err, types.ErrRateLimited)).To(BeTrue())
+			Expect(executions()).To(HaveLen(2))
+		})
+	})
+
+	Describe("after Start", func() {
+		It("executes each strategy's signals in the order generated", func() {
+			Expect(queue.Start()).To(Succeed())
+
+			var want []uuid.UUID
+			for i := 0; i < 20; i++ {
+				s := signal("momentum")
+				want = append(want, s.ID)
+				Expect(wrapped.ExecuteSignal(s)).To(Succeed())
+			}
+
+			Eventually(executions).Should(Equal(want))
+			Eventually(func() int64 { return queue.Stats().Executed }).Should(Equal(int64(20)))
+		})
+
+		It("drops a signal that waited longer than the TTL", func() {
+			queue.Configure(signalqueue.Config{TTL: time.Second})
+			Expect(queue.Start()).To(Succeed())
+
+			stale := signal("momentum")
+			stale.Timestamp = now.Add(-2 * time.Second)
+			Expect(wrapped.ExecuteSignal(stale)).To(Succeed())
+
+			Eventually(func() int64 { return queue.Stats().Expired }).Should(Equal(int64(1)))
+			Expect(executions()).To(BeEmpty())
+			journal.AssertCalled(GinkgoT(), "Transition", stale.ID, signaljournal.StatusExpired, "waited 2s")
+		})
+
+		It("refuses a signal when its worker's backlog is full", func() {
+			release := make(chan struct{})
+			inner.ExpectedCalls = nil
+			inner.On("ExecuteSignal", mock.Anything).Return(func(s *strategy.Signal) error {
+				mu.Lock()
+				executed = append(executed, s.ID)
+				mu.Unlock()
+				<-release
+				return nil
+			})
+
+			queue.Configure(signalqueue.Config{Workers: 1, Capacity: 1})
+			Expect(queue.Start()).To(Succeed())
+
+			Expect(wrapped.ExecuteSignal(signal("momentum"))).To(Succeed())
+			Eventually(executions).Should(HaveLen(1))
+			Expect(wrapped.ExecuteSignal(signal("momentum"))).To(Succeed())
+
+			err := wrapped.ExecuteSignal(signal("momentum"))
+			Expect(errors.Is(err, signalqueue.ErrQueueFull)).To(BeTrue())
+			Expect(queue.Stats().Rejected).To(Equal(int64(1)))
+
+			close(release)
+			Eventually(func() int64 { return queue.Stats().Executed }).Should(Equal(int64(2)))
+		})
+
+		It("drains the backlog on Stop", func() {
+			Expect(queue.Start()).To(Succeed())
+			for i := 0; i < 5; i++ {
+				Expect(wrapped.ExecuteSignal(signal(strategy.StrategyName(fmt.Sprintf("s%d", i))))).To(Succeed())
+			}
+
+			Expect(queue.Stop(context.Background())).To(Succeed())
+			Expect(executions()).To(HaveLen(5))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package signalqueue_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSignalQueue(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Signal Queue Suite")
+}
@@ -0,0 +1,329 @@
+package startup
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/live-trading/pkg/connectors/latency"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+const (
+	// DefaultStreamTimeout is how long bootstrap waits for a first order book update
+	DefaultStreamTimeout = 15 * time.Second
+
+	// testOrderDiscount places the test buy this far below market so it rests
+	// on the book instead of filling
+	testOrderDiscount = 0.5
+
+	streamPollInterval = 100 * time.Millisecond
+)
+
+// Bootstrap step names, in the order they run for each connector
+const (
+	StepConfig      = "config"
+	StepInitialize  = "initialize"
+	StepCredentials = "credentials"
+	StepStream      = "stream"
+	StepTestOrder   = "test_order"
+)
+
+// BootstrapConfig controls what a bootstrap run exercises
+type BootstrapConfig struct {
+	// Asset is used for the test stream and test order
+	Asset portfolio.Asset
+
+	StreamTimeout time.Duration
+
+	// PlaceTestOrder places and cancels a minimum-size limit buy far below
+	// market. It is refused on mainnet unless AllowMainnet is set.
+	PlaceTestOrder bool
+	AllowMainnet   bool
+
+	// ReportPath, when set, is where the JSON report is written
+	ReportPath string
+}
+
+// BootstrapStep is the outcome of one check against one exchange
+type BootstrapStep struct {
+	Exchange connector.ExchangeName `json:"exchange"`
+	Name     string                 `json:"name"`
+	Passed   bool                   `json:"passed"`
+	Skipped  bool                   `json:"skipped,omitempty"`
+	Detail   string                 `json:"detail,omitempty"`
+	Duration time.Duration          `json:"duration"`
+}
+
+// BootstrapReport records every step of a bootstrap run
+type BootstrapReport struct {
+	StartedAt  time.Time       `json:"started_at"`
+	FinishedAt time.Time       `json:"finished_at"`
+	Asset      string          `json:"asset"`
+	Steps      []BootstrapStep `json:"steps"`
+	Passed     bool            `json:"passed"`
+}
+
+// Failed returns the steps that did not pass and were not skipped
+func (b *BootstrapReport) Failed() []BootstrapStep {
+	var failed []BootstrapStep
+	for _, step := range b.Steps {
+		if !step.Passed && !step.Skipped {
+			failed = append(failed, step)
+		}
+	}
+	return failed
+}
+
+// Bootstrap validates configs, initializes each connector, checks its
+// credentials, waits for a live order book update and optionally round-trips
+// a test order. Every connector is exercised even when an earlier one fails,
+// so one report covers the whole deployment.
+func (r *startup) Bootstrap(
+	connectors map[connector.ExchangeName]connector.Config,
+	config BootstrapConfig,
+) (*BootstrapReport, error) {
+	if config.StreamTimeout <= 0 {
+		config.StreamTimeout = DefaultStreamTimeout
+	}
+	if !config.Asset.IsValid() {
+		return nil, fmt.Errorf("bootstrap requires an asset for the test stream")
+	}
+
+	report := &BootstrapReport{
+		StartedAt: r.timeProvider.Now(),
+		Asset:     config.Asset.Symbol(),
+	}
+
+	if err := r.validateConnectors(connectors); err != nil {
+		return nil, err
+	}
+
+	names := make([]connector.ExchangeName, 0, len(connectors))
+	for name := range connectors {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+
+	for _, name := range names {
+		report.Steps = append(report.Steps, r.bootstrapConnector(name, connectors[name], config)...)
+	}
+
+	report.FinishedAt = r.timeProvider.Now()
+	report.Passed = len(report.Failed()) == 0
+
+	for _, step := range report.Steps {
+		switch {
+		case step.Skipped:
+			r.logger.Info("bootstrap: %s %s skipped: %s", step.Exchange, step.Name, step.Detail)
+		case step.Passed:
+			r.logger.Info("bootstrap: %s %s passed (%s)", step.Exchange, step.Name, step.Duration)
+		default:
+			r.logger.Error("bootstrap: %s %s failed: %s", step.Exchange, step.Name, step.Detail)
+		}
+	}
+
+	if config.ReportPath != "" {
+		if err := writeReport(config.ReportPath, "bootstrap", report); err != nil {
+			return report, err
+		}
+	}
+
+	return report, nil
+}
+
+// bootstrapConnector runs every step for one exchange, skipping the rest
+// once a prerequisite fails
+func (r *startup) bootstrapConnector(
+	name connector.ExchangeName,
+	connConfig connector.Config,
+	config BootstrapConfig,
+) []BootstrapStep {
+	var steps []BootstrapStep
+	run := func(step string, check func() (string, error)) bool {
+		started := time.Now()
+		detail, err := check()
+		result := BootstrapStep{Exchange: name, Name: step, Passed: err == nil, Detail: detail, Duration: time.Since(started)}
+		if err != nil {
+			result.Detail = err.Error()
+		}
+		steps = append(steps, result)
+		return err == nil
+	}
+	skip := func(step, reason string) {
+		steps = append(steps, BootstrapStep{Exchange: name, Name: step, Skipped: true, Detail: reason})
+	}
+	skipRemaining := func(from int, reason string) {
+		for _, step := range []string{StepConfig, StepInitialize, StepCredentials, StepStream, StepTestOrder}[from:] {
+			skip(step, reason)
+		}
+	}
+
+	if !run(StepConfig, func() (string, error) { return "", connConfig.Validate() }) {
+		skipRemaining(1, "config invalid")
+		return steps
+	}
+
+	var conn connector.Connector
+	if !run(StepInitialize, func() (string, error) {
+		var err error
+		conn, err = r.initializeConnector(name, connConfig)
+		return "", err
+	}) {
+		skipRemaining(2, "connector not initialized")
+		return steps
+	}
+
+	if !run(StepCredentials, func() (string, error) {
+		balance, err := conn.GetAccountBalance()
+		if err != nil {
+			return "", fmt.Errorf("account balance unavailable: %w", err)
+		}
+		return fmt.Sprintf("total balance %s %s", balance.TotalBalance.String(), balance.Currency), nil
+	}) {
+		skipRemaining(3, "credentials rejected")
+		return steps
+	}
+
+	if wsConn, ok := conn.(connector.WebSocketConnector); ok && conn.SupportsRealTimeData() {
+		run(StepStream, func() (string, error) { return r.checkStream(wsConn, config) })
+	} else {
+		skip(StepStream, "connector has no real-time data")
+	}
+
+	switch {
+	case !config.PlaceTestOrder:
+		skip(StepTestOrder, "test order not requested")
+	case !conn.SupportsTradingOperations():
+		skip(StepTestOrder, "connector does not support trading")
+	case !usesTestnet(connConfig) && !config.AllowMainnet:
+		skip(StepTestOrder, "refusing to place a test order on mainnet")
+	default:
+		run(StepTestOrder, func() (string, error) { return r.roundTripTestOrder(conn, config.Asset) })
+	}
+
+	return steps
+}
+
+// checkStream subscribes to the asset's order book and waits for one update
+func (r *startup) checkStream(conn connector.WebSocketConnector, config BootstrapConfig) (string, error) {
+	if err := conn.StartWebSocket(); err != nil {
+		return "", fmt.Errorf("websocket start failed: %w", err)
+	}
+	defer func() {
+		if err := conn.StopWebSocket(); err != nil {
+			r.logger.Warn("bootstrap: websocket stop failed: %v", err)
+		}
+	}()
+
+	if err := conn.SubscribeOrderBook(config.Asset, connector.TypePerpetual); err != nil {
+		return "", fmt.Errorf("order book subscribe failed: %w", err)
+	}
+	defer func() {
+		if err := conn.UnsubscribeOrderBook(config.Asset, connector.TypePerpetual); err != nil {
+			r.logger.Warn("bootstrap: order book unsubscribe failed: %v", err)
+		}
+	}()
+
+	started := time.Now()
+	deadline := started.Add(config.StreamTimeout)
+	for time.Now().Before(deadline) {
+		for key, ch := range conn.GetOrderBookChannels() {
+			select {
+			case book, ok := <-ch:
+				if ok {
+					return fmt.Sprintf("first %s update after %s with %d bids / %d asks",
+						key, time.Since(started).Round(time.Millisecond), len(book.Bids), len(book.Asks)), nil
+				}
+			default:
+			}
+		}
+		time.Sleep(streamPollInterval)
+	}
+
+	return "", fmt.Errorf("no order book update within %s", config.StreamTimeout)
+}
+
+// roundTripTestOrder places a minimum-size buy far below market and cancels it.
+// Connectors expose no post-only flag, so the distance from market is what
+// keeps the order resting.
+func (r *startup) roundTripTestOrder(conn connector.Connector, asset portfolio.Asset) (string, error) {
+	symbol := conn.GetPerpSymbol(asset)
+
+	price, err := conn.FetchPrice(symbol)
+	if err != nil {
+		return "", fmt.Errorf("price unavailable: %w", err)
+	}
+
+	contract, err := findContract(conn, symbol)
+	if err != nil {
+		return "", err
+	}
+
+	quantity := contract.MinOrderSize
+	if !quantity.IsPositive() {
+		quantity = contract.StepSize
+	}
+	if !quantity.IsPositive() {
+		return "", fmt.Errorf("no minimum order size known for %s", symbol)
+	}
+
+	limit := price.Price.Mul(numerical.NewFromFloat(testOrderDiscount))
+	if contract.TickSize.IsPositive() {
+		limit = limit.Div(contract.TickSize).Truncate(0).Mul(contract.TickSize)
+	}
+	if !limit.IsPositive() {
+		return "", fmt.Errorf("test order price for %s rounds to zero", symbol)
+	}
+
+	var order *connector.OrderResponse
+	if err := r.latency.Time(conn, latency.OpPlaceOrder, func() error {
+		order, err = conn.PlaceLimitOrder(symbol, connector.OrderSideBuy, quantity, limit)
+		return err
+	}); err != nil {
+		return "", fmt.Errorf("test order rejected: %w", err)
+	}
+
+	if err := r.latency.Time(conn, latency.OpCancelOrder, func() error {
+		_, err := conn.CancelOrder(symbol, order.OrderID)
+		return err
+	}); err != nil {
+		return "", fmt.Errorf("test order %s placed but cancel failed, cancel it manually: %w", order.OrderID, err)
+	}
+
+	return fmt.Sprintf("placed and cancelled %s %s @ %s (order %s)", symbol, quantity.String(), limit.String(), order.OrderID), nil
+}
+
+func findContract(conn connector.Connector, symbol string) (*connector.ContractInfo, error) {
+	contracts, err := conn.FetchContracts()
+	if err != nil {
+		return nil, fmt.Errorf("contracts unavailable: %w", err)
+	}
+	for i := range contracts {
+		if contracts[i].Symbol == symbol {
+			return &contracts[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no contract listed for %s", symbol)
+}
+
+func usesTestnet(config connector.Config) bool {
+	network, ok := config.(types.NetworkConfig)
+	return ok && network.UsesTestnet()
+}
+
+func writeReport(path, kind string, report interface{}) error {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode %s report: %w", kind, err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write %s report: %w", kind, err)
+	}
+	return nil
+}
@@ -0,0 +1,449 @@
+package startup
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/live-trading/pkg/connectors/paper"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+)
+
+// DefaultMaxClockSkew is the largest local clock offset preflight accepts;
+// exchanges reject signed requests well before their receive windows of
+// several seconds, and order timestamps drift with the clock
+const DefaultMaxClockSkew = time.Second
+
+// CheckCertification runs once per preflight, before the connector checks
+const CheckCertification = "certification"
+
+// Preflight check names, in the order they run for each connector
+const (
+	CheckNetwork      = "network"
+	CheckConnectivity = "connectivity"
+	CheckPermissions  = "permissions"
+	CheckClockSkew    = "clock_skew"
+	CheckBalance      = "balance"
+	CheckSymbols      = "symbols"
+)
+
+// ErrPreflightFailed is returned by StartLive when a check failed and the
+// run was not forced
+var ErrPreflightFailed = errors.New("preflight checks failed")
+
+// PreflightConfig controls the checklist run before a strategy starts
+type PreflightConfig struct {
+	// Live allows mainnet connectors; without it only testnet and paper
+	// configs pass the network check
+	Live bool
+
+	// Force starts the run even when checks fail
+	Force bool
+
+	// PluginVersion is the strategy plugin's version; runs that trade a
+	// mainnet connector require a passing certificate for it
+	PluginVersion string
+
+	MaxClockSkew time.Duration
+
+	// MinBalance is the available balance each connector must hold; zero
+	// only requires it to be positive
+	MinBalance numerical.Decimal
+
+	// Output, when set, receives the printed pass/fail report
+	Output io.Writer
+
+	// ReportPath, when set, is where the JSON report is written
+	ReportPath string
+}
+
+// PreflightReport records every check of a preflight run. Steps reuse the
+// bootstrap step shape so both reports read the same way.
+type PreflightReport struct {
+	StartedAt  time.Time       `json:"started_at"`
+	FinishedAt time.Time       `json:"finished_at"`
+	Strategy   string          `json:"strategy"`
+	Live       bool            `json:"live"`
+	Steps      []BootstrapStep `json:"steps"`
+	Passed     bool            `json:"passed"`
+	Forced     bool            `json:"forced,omitempty"`
+}
+
+// Failed returns the checks that did not pass and were not skipped
+func (p *PreflightReport) Failed() []BootstrapStep {
+	var failed []BootstrapStep
+	for _, step := range p.Steps {
+		if !step.Passed && !step.Skipped {
+			failed = append(failed, step)
+		}
+	}
+	return failed
+}
+
+// Print writes the report as one line per check followed by a verdict
+func (p *PreflightReport) Print(w io.Writer) error {
+	mode := "testnet"
+	if p.Live {
+		mode = "live"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Preflight for %s (%s)\n", p.Strategy, mode)
+	for _, step := range p.Steps {
+		status := "FAIL"
+		switch {
+		case step.Skipped:
+			status = "SKIP"
+		case step.Passed:
+			status = "PASS"
+		}
+		fmt.Fprintf(&b, "  %-4s  %-12s  %-12s  %s\n", status, step.Exchange, step.Name, step.Detail)
+	}
+
+	switch failed := len(p.Failed()); {
+	case failed == 0:
+		b.WriteString("All checks passed\n")
+	case p.Forced:
+		fmt.Fprintf(&b, "%d check(s) failed; starting anyway because the run was forced\n", failed)
+	default:
+		fmt.Fprintf(&b, "%d check(s) failed; refusing to start (use --force to override)\n", failed)
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// StartLive runs the preflight checklist and starts the strategy only when
+// it passes or config.Force is set. Connectors initialized by the checks are
+// reused by Start rather than initialized twice.
+func (r *startup) StartLive(
+	strategyPath string,
+	connectors map[connector.ExchangeName]connector.Config,
+	assets map[portfolio.Asset][]connector.Instrument,
+	config PreflightConfig,
+) (*PreflightReport, error) {
+	report, err := r.Preflight(strategyPath, connectors, assets, config)
+	if err != nil {
+		return report, err
+	}
+
+	if !report.Passed && !config.Force {
+		return report, fmt.Errorf("%w: %d check(s) failed", ErrPreflightFailed, len(report.Failed()))
+	}
+
+	return report, r.Start(strategyPath, connectors, assets)
+}
+
+// Preflight validates plugin certification, then connectivity, key
+// permissions, clock skew, balance and symbol availability for every
+// connector a strategy will use. Every connector is checked even when an
+// earlier one fails.
+func (r *startup) Preflight(
+	strategyPath string,
+	connectors map[connector.ExchangeName]connector.Config,
+	assets map[portfolio.Asset][]connector.Instrument,
+	config PreflightConfig,
+) (*PreflightReport, error) {
+	if config.MaxClockSkew <= 0 {
+		config.MaxClockSkew = DefaultMaxClockSkew
+	}
+
+	if err := r.validateConnectors(connectors); err != nil {
+		return nil, err
+	}
+
+	report := &PreflightReport{
+		StartedAt: r.timeProvider.Now(),
+		Strategy:  strategyPath,
+		Live:      config.Live,
+	}
+
+	names := make([]connector.ExchangeName, 0, len(connectors))
+	for name := range connectors {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+
+	report.Steps = append(report.Steps, r.preflightCertification(strategyPath, connectors, config))
+	for _, name := range names {
+		report.Steps = append(report.Steps, r.preflightConnector(name, connectors[name], assets, config)...)
+	}
+
+	report.FinishedAt = r.timeProvider.Now()
+	report.Passed = len(report.Failed()) == 0
+	report.Forced = !report.Passed && config.Force
+
+	for _, step := range report.Failed() {
+		r.logger.Error("preflight: %s %s failed: %s", step.Exchange, step.Name, step.Detail)
+	}
+	if report.Passed {
+		r.logger.Info("preflight: %d checks passed for %s", len(report.Steps), strategyPath)
+	}
+
+	if config.Output != nil {
+		if err := report.Print(config.Output); err != nil {
+			return report, fmt.Errorf("failed to print preflight report: %w", err)
+		}
+	}
+	if config.ReportPath != "" {
+		if err := writeReport(config.ReportPath, "preflight", report); err != nil {
+			return report, err
+		}
+	}
+
+	return report, nil
+}
+
+// preflightCertification requires the plugin version to hold a passing
+// certificate before it trades real funds. Paper and testnet runs skip it.
+func (r *startup) preflightCertification(
+	strategyPath string,
+	connectors map[connector.ExchangeName]connector.Config,
+	config PreflightConfig,
+) BootstrapStep {
+	step := BootstrapStep{Name: CheckCertification}
+
+	mainnet := false
+	for _, connConfig := range connectors {
+		if _, isPaper := connConfig.(*paper.Config); !isPaper && !usesTestnet(connConfig) {
+			mainnet = true
+			break
+		}
+	}
+	if !config.Live || !mainnet {
+		step.Skipped = true
+		step.Detail = "no mainnet connectors"
+		return step
+	}
+
+	started := time.Now()
+	certificate, err := r.certifier.Verify(strategyPath, config.PluginVersion)
+	step.Duration = time.Since(started)
+	if err != nil {
+		step.Detail = err.Error()
+		return step
+	}
+
+	step.Passed = true
+	step.Detail = fmt.Sprintf("%s %s certified %s", certificate.Plugin, certificate.Version,
+		certificate.FinishedAt.UTC().Format(time.RFC3339))
+	return step
+}
+
+// preflightConnector runs every check for one exchange, skipping the rest
+// once the connector cannot be reached
+func (r *startup) preflightConnector(
+	name connector.ExchangeName,
+	connConfig connector.Config,
+	assets map[portfolio.Asset][]connector.Instrument,
+	config PreflightConfig,
+) []BootstrapStep {
+	var steps []BootstrapStep
+	run := func(check string, fn func() (string, error)) bool {
+		started := time.Now()
+		detail, err := fn()
+		result := BootstrapStep{Exchange: name, Name: check, Passed: err == nil, Detail: detail, Duration: time.Since(started)}
+		if err != nil {
+			result.Detail = err.Error()
+		}
+		steps = append(steps, result)
+		return err == nil
+	}
+	skip := func(check, reason string) {
+		steps = append(steps, BootstrapStep{Exchange: name, Name: check, Skipped: true, Detail: reason})
+	}
+
+	run(CheckNetwork, func() (string, error) { return checkNetwork(connConfig, config.Live) })
+
+	var conn connector.Connector
+	if !run(CheckConnectivity, func() (string, error) {
+		if err := connConfig.Validate(); err != nil {
+			return "", fmt.Errorf("config invalid: %w", err)
+		}
+		var err error
+		if conn, err = r.initializeConnector(name, connConfig); err != nil {
+			return "", fmt.Errorf("initialize failed: %w", err)
+		}
+		r.prepared[name] = conn
+		return checkConnectivity(conn, assets)
+	}) {
+		for _, check := range []string{CheckPermissions, CheckClockSkew, CheckBalance, CheckSymbols} {
+			skip(check, "connector unreachable")
+		}
+		return steps
+	}
+
+	run(CheckPermissions, func() (string, error) { return checkPermissions(conn) })
+
+	if clock, ok := conn.(types.ServerTimeProvider); ok {
+		run(CheckClockSkew, func() (string, error) { return checkClockSkew(clock, config.MaxClockSkew) })
+	} else {
+		skip(CheckClockSkew, "connector does not expose server time")
+	}
+
+	run(CheckBalance, func() (string, error) { return checkBalance(conn, config.MinBalance) })
+	run(CheckSymbols, func() (string, error) { return checkSymbols(conn, assets) })
+
+	return steps
+}
+
+func checkNetwork(config connector.Config, live bool) (string, error) {
+	if _, isPaper := config.(*paper.Config); isPaper {
+		return "paper execution", nil
+	}
+	if usesTestnet(config) {
+		return "testnet", nil
+	}
+	if !live {
+		return "", fmt.Errorf("config targets mainnet; pass --live to trade real funds")
+	}
+	return "mainnet (live)", nil
+}
+
+// checkConnectivity times a price request for the first configured asset
+func checkConnectivity(conn connector.Connector, assets map[portfolio.Asset][]connector.Instrument) (string, error) {
+	probe := sortedAssets(assets)
+	if len(probe) == 0 {
+		return "", fmt.Errorf("no assets configured to probe")
+	}
+
+	symbol := conn.GetPerpSymbol(probe[0])
+	started := time.Now()
+	price, err := conn.FetchPrice(symbol)
+	if err != nil {
+		return "", fmt.Errorf("price request for %s failed: %w", symbol, err)
+	}
+
+	return fmt.Sprintf("%s %s in %s", symbol, price.Price.String(), time.Since(started).Round(time.Millisecond)), nil
+}
+
+// checkPermissions requires a key that can trade. Connectors that cannot
+// report key scope pass when an authenticated read succeeds.
+func checkPermissions(conn connector.Connector) (string, error) {
+	if !conn.SupportsTradingOperations() {
+		return "", fmt.Errorf("connector does not support trading")
+	}
+
+	inspector, ok := conn.(types.APIKeyInspector)
+	if !ok {
+		if _, err := conn.GetOpenOrders(); err != nil {
+			return "", fmt.Errorf("authenticated request rejected: %w", err)
+		}
+		return "key scope not reported; authenticated reads succeed", nil
+	}
+
+	permissions, err := inspector.FetchAPIKeyPermissions()
+	if err != nil {
+		return "", fmt.Errorf("key permissions unavailable: %w", err)
+	}
+	if permissions.ReadOnly || !permissions.Trade {
+		return "", fmt.Errorf("key cannot trade (scopes: %s)", strings.Join(permissions.Scopes, ", "))
+	}
+
+	detail := "trading enabled"
+	if permissions.Withdraw {
+		detail += "; withdrawals also enabled, consider a narrower key"
+	}
+	return detail, nil
+}
+
+// checkClockSkew compares the exchange clock with the midpoint of the
+// request round trip
+func checkClockSkew(clock types.ServerTimeProvider, maxSkew time.Duration) (string, error) {
+	sent := time.Now()
+	server, err := clock.FetchServerTime()
+	if err != nil {
+		return "", fmt.Errorf("server time unavailable: %w", err)
+	}
+	received := time.Now()
+
+	// A positive offset means the local clock is ahead
+	roundTrip := received.Sub(sent)
+	offset := sent.Add(roundTrip / 2).Sub(server)
+	if offset.Abs() > maxSkew {
+		return "", fmt.Errorf("local clock is offset %s from the exchange (limit %s); sync NTP", offset.Round(time.Millisecond), maxSkew)
+	}
+	return fmt.Sprintf("offset %s (round trip %s)", offset.Round(time.Millisecond), roundTrip.Round(time.Millisecond)), nil
+}
+
+func checkBalance(conn connector.Connector, minimum numerical.Decimal) (string, error) {
+	balance, err := conn.GetAccountBalance()
+	if err != nil {
+		return "", fmt.Errorf("account balance unavailable: %w", err)
+	}
+
+	detail := fmt.Sprintf("%s %s available", balance.AvailableBalance.String(), balance.Currency)
+	if !balance.AvailableBalance.IsPositive() {
+		return "", fmt.Errorf("no available balance (%s)", detail)
+	}
+	if minimum.IsPositive() && balance.AvailableBalance.LessThan(minimum) {
+		return "", fmt.Errorf("%s, below the required %s", detail, minimum.String())
+	}
+	return detail, nil
+}
+
+// checkSymbols requires every perpetual the strategy trades to be listed
+// and, where the connector reports listing state, open for trading
+func checkSymbols(conn connector.Connector, assets map[portfolio.Asset][]connector.Instrument) (string, error) {
+	contracts, err := conn.FetchContracts()
+	if err != nil {
+		return "", fmt.Errorf("contracts unavailable: %w", err)
+	}
+	listed := make(map[string]bool, len(contracts))
+	for _, contract := range contracts {
+		listed[contract.Symbol] = true
+	}
+
+	states := make(map[string]types.InstrumentState)
+	if provider, ok := conn.(types.InstrumentStatusProvider); ok {
+		statuses, err := provider.FetchInstrumentStatuses()
+		if err != nil {
+			return "", fmt.Errorf("instrument statuses unavailable: %w", err)
+		}
+		for _, status := range statuses {
+			states[status.Symbol] = status.State
+		}
+	}
+
+	var checked, problems []string
+	for _, asset := range sortedAssets(assets) {
+		for _, instrument := range assets[asset] {
+			if instrument != connector.TypePerpetual {
+				continue
+			}
+			symbol := conn.GetPerpSymbol(asset)
+			checked = append(checked, symbol)
+
+			if !listed[symbol] {
+				problems = append(problems, symbol+" not listed")
+				continue
+			}
+			if state, ok := states[symbol]; ok && state != types.InstrumentTrading {
+				problems = append(problems, fmt.Sprintf("%s is %s", symbol, state))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return "", fmt.Errorf("%s", strings.Join(problems, "; "))
+	}
+	if len(checked) == 0 {
+		return "no perpetual symbols to check", nil
+	}
+	return fmt.Sprintf("%s available", strings.Join(checked, ", ")), nil
+}
+
+func sortedAssets(assets map[portfolio.Asset][]connector.Instrument) []portfolio.Asset {
+	sorted := make([]portfolio.Asset, 0, len(assets))
+	for asset := range assets {
+		sorted = append(sorted, asset)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Symbol() < sorted[j].Symbol() })
+	return sorted
+}
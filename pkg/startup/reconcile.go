@@ -0,0 +1,418 @@
+package startup
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+	"github.com/backtesting-org/live-trading/pkg/connectors/types"
+	"github.com/backtesting-org/live-trading/pkg/signaljournal"
+)
+
+// ExchangeState is the open order and position state found on an exchange
+// at startup, left over from a previous session
+type ExchangeState struct {
+	Exchange   connector.ExchangeName
+	OpenOrders []connector.Order
+	Positions  []connector.Position
+
+	// Margin is nil when the connector cannot report its margin mode
+	Margin *types.MarginInfo
+}
+
+// reconcileConnectors snapshots open orders and positions on every ready
+// connector before the runtime boots, so state from a previous session is
+// visible instead of silently carried. Fetch failures are logged, not fatal:
+// a connector that cannot report its state still trades.
+func (r *startup) reconcileConnectors(
+	names []connector.ExchangeName,
+	configs map[connector.ExchangeName]connector.Config,
+) map[connector.ExchangeName]*ExchangeState {
+	recovered := make(map[connector.ExchangeName]*ExchangeState, len(names))
+
+	for _, name := range names {
+		conn, exists := r.connectorRegistry.GetConnector(name)
+		if !exists {
+			continue
+		}
+
+		state := &ExchangeState{Exchange: name}
+
+		orders, err := conn.GetOpenOrders()
+		if err != nil {
+			r.logger.Warn("reconcile: %s open orders unavailable: %v", name, err)
+		} else {
+			state.OpenOrders = orders
+		}
+
+		positions, err := conn.GetPositions()
+		if err != nil {
+			r.logger.Warn("reconcile: %s positions unavailable: %v", name, err)
+		} else {
+			for _, position := range positions {
+				if !position.Size.IsZero() {
+					state.Positions = append(state.Positions, position)
+				}
+			}
+		}
+
+		for _, order := range state.OpenOrders {
+			r.logger.Info("reconcile: %s open order %s %s %s %s @ %s",
+				name, order.ID, order.Symbol, order.Side, order.RemainingQty.String(), order.Price.String())
+		}
+		for _, position := range state.Positions {
+			r.logger.Info("reconcile: %s position %s %s %s @ %s",
+				name, position.Symbol.Symbol(), position.Side, position.Size.String(), position.EntryPrice.String())
+		}
+
+		r.logger.Info("reconcile: %s has %d open orders and %d positions from a previous session",
+			name, len(state.OpenOrders), len(state.Positions))
+
+		state.Margin = r.checkMarginMode(name, conn, configs[name])
+
+		recovered[name] = state
+	}
+
+	return recovered
+}
+
+// checkMarginMode reports the account's margin mode and warns when it differs
+// from the mode the connector config says its risk settings assume
+func (r *startup) checkMarginMode(name connector.ExchangeName, conn connector.Connector, config connector.Config) *types.MarginInfo {
+	provider, ok := conn.(types.MarginInfoProvider)
+	if !ok {
+		return nil
+	}
+
+	margin, err := provider.FetchMarginInfo()
+	if err != nil {
+		r.logger.Warn("reconcile: %s margin mode unavailable: %v", name, err)
+		return nil
+	}
+
+	r.logger.Info("reconcile: %s account uses %s margin (initial %s%%, maintenance %s%% of equity)",
+		name, margin.Mode,
+		margin.InitialUtilization().Mul(numerical.NewFromInt(100)).Round(2).String(),
+		margin.MaintenanceUtilization().Mul(numerical.NewFromInt(100)).Round(2).String())
+
+	if expectation, ok := config.(types.MarginModeExpectation); ok {
+		expected := expectation.ExpectedMarginMode()
+		if expected != "" && margin.Mode != types.MarginModeUnknown && margin.Mode != expected {
+			r.logger.Warn("⚠️  reconcile: %s is configured for %s margin but the account uses %s margin; margin requirements will differ from what risk settings assume",
+				name, expected, margin.Mode)
+		}
+	}
+
+	return margin
+}
+
+// InDoubtSignal is a signal a previous process journaled but never brought
+// to a terminal status, with the open orders and positions on the
+// exchanges and assets it traded
+type InDoubtSignal struct {
+	Entry signaljournal.Entry
+
+	// Status is what reconciliation settled the signal as; StatusExecuting
+	// when the exchange state was ambiguous and it was left in doubt
+	Status signaljournal.Status
+
+	// Matched holds the open order matched to each trade action, in action
+	// order, for a signal settled as executed
+	Matched []connector.Order
+
+	OpenOrders []connector.Order
+	Positions  []connector.Position
+}
+
+// claim is an open order an executing signal's action matched, keyed by
+// exchange and order ID
+type claim struct {
+	key   string
+	order connector.Order
+	exact bool
+}
+
+// reconcileSignals settles every signal the journal holds in doubt. A
+// pending signal never started, so it is expired. An executing signal may
+// have reached the exchange, so each of its trade actions is matched to
+// the open orders there: an order carrying the action's journal client
+// order ID matches outright, otherwise one on the action's market and side,
+// for its quantity, placed no earlier than the signal. A signal whose every
+// action matched an order no other signal claims is executed; one with
+// nothing on the exchange for its markets failed. Anything else, such as two
+// signals claiming the same order or an action with no order but a
+// position on its market, is left in doubt for an operator.
+func (r *startup) reconcileSignals(recovered map[connector.ExchangeName]*ExchangeState) []InDoubtSignal {
+	entries := r.signals.InDoubt()
+	if len(entries) == 0 {
+		return nil
+	}
+
+	inDoubt := make([]InDoubtSignal, 0, len(entries))
+	for _, entry := range entries {
+		signal := entry.Signal
+		if entry.Status == signaljournal.StatusPending {
+			if err := r.signals.Transition(signal.ID, signaljournal.StatusExpired, "not executed before restart"); err != nil {
+				r.logger.Warn("reconcile: signal %s could not be expired: %v", signal.ID, err)
+			}
+			r.logger.Info("reconcile: signal %s from %s was never executed; expired", signal.ID, signal.Strategy)
+			continue
+		}
+
+		doubt := InDoubtSignal{Entry: entry, Status: signaljournal.StatusExecuting}
+		for _, action := range signal.Actions {
+			state, ok := recovered[action.Exchange]
+			if !ok {
+				continue
+			}
+			for _, order := range state.OpenOrders {
+				if r.orderMatches(action.Exchange, order.Symbol, action.Asset) {
+					doubt.OpenOrders = append(doubt.OpenOrders, order)
+				}
+			}
+			for _, position := range state.Positions {
+				if position.Symbol.Symbol() == action.Asset.Symbol() {
+					doubt.Positions = append(doubt.Positions, position)
+				}
+			}
+		}
+		inDoubt = append(inDoubt, doubt)
+	}
+
+	candidates, claimants := r.matchOrders(inDoubt, recovered)
+
+	for i := range inDoubt {
+		doubt := &inDoubt[i]
+		signal := doubt.Entry.Signal
+
+		matched, complete := settle(i, candidates[i], claimants)
+		var detail string
+		switch {
+		case complete:
+			doubt.Status = signaljournal.StatusExecuted
+			doubt.Matched = matched
+			detail = fmt.Sprintf("matched at restart to open orders %s", orderIDs(matched))
+		case len(doubt.OpenOrders) == 0 && len(doubt.Positions) == 0:
+			doubt.Status = signaljournal.StatusFailed
+			detail = "nothing on the exchange for its assets at restart"
+		default:
+			r.logger.Warn("⚠️  reconcile: signal %s from %s is still in doubt: %d open orders and %d positions on its assets do not match it unambiguously",
+				signal.ID, signal.Strategy, len(doubt.OpenOrders), len(doubt.Positions))
+			continue
+		}
+
+		if err := r.signals.Transition(signal.ID, doubt.Status, detail); err != nil {
+			r.logger.Warn("reconcile: signal %s could not be resolved: %v", signal.ID, err)
+		}
+		r.logger.Info("reconcile: signal %s from %s was executing when the previous session stopped; %s: %s",
+			signal.ID, signal.Strategy, doubt.Status, detail)
+	}
+
+	return inDoubt
+}
+
+// matchOrders finds the candidate open orders for each trade action of the
+// executing signals, and which signals claim each order. Actions that do
+// not trade are left nil. An exact client order ID match replaces the
+// looser ones.
+func (r *startup) matchOrders(
+	inDoubt []InDoubtSignal,
+	recovered map[connector.ExchangeName]*ExchangeState,
+) ([][][]claim, map[string]map[int]bool) {
+	candidates := make([][][]claim, len(inDoubt))
+	claimants := make(map[string]map[int]bool)
+
+	for i, doubt := range inDoubt {
+		signal := doubt.Entry.Signal
+		candidates[i] = make([][]claim, len(signal.Actions))
+
+		for a, action := range signal.Actions {
+			side, trades := actionSide(action.Action)
+			if !trades {
+				continue
+			}
+
+			clientOrderID := signaljournal.ClientOrderID(signal.ID, a)
+			exact, loose := []claim{}, []claim{}
+			if state, ok := recovered[action.Exchange]; ok {
+				for _, order := range state.OpenOrders {
+					c := claim{key: string(action.Exchange) + "/" + order.ID, order: order}
+					switch {
+					case order.ClientOrderID != "" && order.ClientOrderID == clientOrderID:
+						c.exact = true
+						exact = append(exact, c)
+					case order.Side == side &&
+						order.Quantity.Equal(action.Quantity) &&
+						!order.CreatedAt.Before(signal.Timestamp) &&
+						r.orderMatches(action.Exchange, order.Symbol, action.Asset):
+						loose = append(loose, c)
+					}
+				}
+			}
+			if len(exact) > 0 {
+				loose = exact
+			}
+			candidates[i][a] = loose
+
+			for _, c := range loose {
+				if claimants[c.key] == nil {
+					claimants[c.key] = make(map[int]bool)
+				}
+				claimants[c.key][i] = true
+			}
+		}
+	}
+
+	return candidates, claimants
+}
+
+// settle returns the order matched to each trade action of signal i, and
+// whether every one of them matched a single order of its own: no other
+// signal claims it, unless by exact client order ID, and no other action of
+// the signal took it
+func settle(i int, actions [][]claim, claimants map[string]map[int]bool) ([]connector.Order, bool) {
+	var matched []connector.Order
+	used := make(map[string]bool)
+
+	for _, candidates := range actions {
+		if candidates == nil {
+			continue
+		}
+		if len(candidates) != 1 {
+			return nil, false
+		}
+
+		c := candidates[0]
+		if used[c.key] || (!c.exact && len(claimants[c.key]) > 1) {
+			return nil, false
+		}
+		used[c.key] = true
+		matched = append(matched, c.order)
+	}
+
+	return matched, len(matched) > 0
+}
+
+// actionSide is the order side a trade action places, reporting false for
+// actions that place no order
+func actionSide(action strategy.Action) (connector.OrderSide, bool) {
+	switch action {
+	case strategy.ActionBuy, strategy.ActionCover:
+		return connector.OrderSideBuy, true
+	case strategy.ActionSell, strategy.ActionSellShort:
+		return connector.OrderSideSell, true
+	default:
+		return "", false
+	}
+}
+
+func orderIDs(orders []connector.Order) string {
+	ids := make([]string, len(orders))
+	for i, order := range orders {
+		ids[i] = order.ID
+	}
+	return strings.Join(ids, ", ")
+}
+
+// orderMatches maps an order's native symbol back to an asset, falling back
+// to comparing the raw symbol when the exchange has no mapping rule
+func (r *startup) orderMatches(exchange connector.ExchangeName, symbol string, asset portfolio.Asset) bool {
+	return r.assetOf(exchange, symbol) == asset.Symbol()
+}
+
+// seedState hands what the exchanges hold back to the process before the
+// strategies resume. Every open order is adopted by the order tracker, so
+// its later fills reach the ledger. Orders and positions on a market a
+// journaled strategy traded are credited to that strategy's execution, as
+// if this session had placed them; positions no journaled strategy traded
+// are left for the position importer.
+func (r *startup) seedState(recovered map[connector.ExchangeName]*ExchangeState, inDoubt []InDoubtSignal) {
+	now := r.timeProvider.Now()
+
+	for name, state := range recovered {
+		for _, order := range state.OpenOrders {
+			if _, tracked := r.tracker.Order(name, order.ID); !tracked {
+				if err := r.tracker.Adopt(name, order); err != nil {
+					r.logger.Warn("reconcile: %s open order %s not tracked: %v", name, order.ID, err)
+				}
+			}
+
+			asset := r.assetOf(name, order.Symbol)
+			owner, ok := r.owner(name, asset, inDoubt)
+			if !ok {
+				r.logger.Warn("reconcile: no journaled strategy traded %s on %s; open order %s is tracked but not credited", asset, name, order.ID)
+				continue
+			}
+			if _, seeded := r.positions.GetStrategyForOrder(order.ID); seeded {
+				continue
+			}
+
+			// Strategy executions record orders by asset, not native symbol
+			credited := order
+			credited.Symbol = asset
+			r.positions.AddOrderToStrategy(owner, credited)
+			r.logger.Info("reconcile: %s open order %s credited to %s", name, order.ID, owner)
+		}
+
+		for _, position := range state.Positions {
+			asset := position.Symbol.Symbol()
+			owner, ok := r.owner(name, asset, inDoubt)
+			if !ok {
+				r.logger.Warn("reconcile: no journaled strategy traded %s on %s; import its position to manage it", asset, name)
+				continue
+			}
+			// A restart within the process already holds the run's trades
+			if r.holds(owner, name, asset) {
+				continue
+			}
+
+			r.positions.AddTradeToStrategy(owner, connector.Trade{
+				ID:        fmt.Sprintf("reconcile-%s-%s", name, asset),
+				Symbol:    asset,
+				Exchange:  name,
+				Price:     position.EntryPrice,
+				Quantity:  position.Size.Abs(),
+				Side:      position.Side,
+				Timestamp: now,
+			})
+			r.logger.Info("reconcile: %s position %s %s %s credited to %s",
+				name, asset, position.Side, position.Size.Abs().String(), owner)
+		}
+	}
+}
+
+// owner is the strategy of the newest in-doubt signal trading the market,
+// or else of the last signal the journal saw executed on it
+func (r *startup) owner(exchange connector.ExchangeName, asset string, inDoubt []InDoubtSignal) (strategy.StrategyName, bool) {
+	for i := len(inDoubt) - 1; i >= 0; i-- {
+		signal := inDoubt[i].Entry.Signal
+		for _, action := range signal.Actions {
+			if action.Exchange == exchange && action.Asset.Symbol() == asset {
+				return signal.Strategy, true
+			}
+		}
+	}
+	return r.signals.Owner(exchange, asset)
+}
+
+// holds reports whether the strategy's execution already has trades on the market
+func (r *startup) holds(name strategy.StrategyName, exchange connector.ExchangeName, asset string) bool {
+	for _, trade := range r.positions.GetTradesForStrategy(name) {
+		if trade.Exchange == exchange && trade.Symbol == asset {
+			return true
+		}
+	}
+	return false
+}
+
+// assetOf maps an order's native symbol back to its asset symbol, keeping
+// the raw symbol when the exchange has no mapping rule
+func (r *startup) assetOf(exchange connector.ExchangeName, symbol string) string {
+	if mapped, _, err := r.symbols.FromNative(exchange, symbol); err == nil {
+		return mapped.Symbol()
+	}
+	return symbol
+}
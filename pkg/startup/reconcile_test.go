@@ -0,0 +1,219 @@
+package startup_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	mockplugin "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/plugin"
+	mockregistry "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	mockruntime "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/runtime"
+	sdkregistry "github.com/backtesting-org/kronos-sdk/pkg/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/stores/activity/position"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/data/stores/activity"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	logger "github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/strategy"
+	mockcertification "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/certification"
+	mockcredentials "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/connectors/credentials"
+	mocklatency "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/connectors/latency"
+	mocksymbols "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/connectors/symbols"
+	mocktracker "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/connectors/tracker"
+	mocksignaljournal "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/signaljournal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/fake"
+	"github.com/backtesting-org/live-trading/pkg/connectors/tracker"
+	"github.com/backtesting-org/live-trading/pkg/runbinding"
+	"github.com/backtesting-org/live-trading/pkg/signaljournal"
+	"github.com/backtesting-org/live-trading/pkg/startup"
+	"github.com/google/uuid"
+)
+
+var _ = Describe("Startup reconciliation", func() {
+	var (
+		exchange  *fake.Connector
+		signals   *mocksignaljournal.SignalJournal
+		orders    *mocktracker.OrderTracker
+		positions activity.Positions
+		service   startup.Startup
+		configs   map[connector.ExchangeName]connector.Config
+
+		open      *connector.OrderResponse
+		journaled []signaljournal.Entry
+		started   time.Time
+	)
+
+	btc := portfolio.NewAsset("BTC")
+
+	BeforeEach(func() {
+		started = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		clock := fake.NewClock(started)
+		exchange = fake.NewConnector("fake", clock)
+		Expect(exchange.Initialize(&fake.Config{Exchange: "fake"})).To(Succeed())
+
+		// A previous session bought 1 BTC and left a sell order resting
+		bought, err := exchange.PlaceLimitOrder("BTC-PERP", connector.OrderSideBuy, numerical.NewFromInt(1), numerical.NewFromInt(90))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exchange.Fill(bought.OrderID, numerical.Zero())).To(Succeed())
+		open, err = exchange.PlaceLimitOrder("BTC-PERP", connector.OrderSideSell, numerical.NewFromInt(1), numerical.NewFromInt(110))
+		Expect(err).NotTo(HaveOccurred())
+
+		connectors := sdkregistry.NewConnectorRegistry()
+		connectors.RegisterConnector("fake", exchange)
+
+		runtime := mockruntime.NewRuntime(GinkgoT())
+		runtime.On("Boot", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+		credentials := mockcredentials.NewCredentialResolver(GinkgoT())
+		credentials.On("Resolve", mock.Anything).Return(nil).Maybe()
+
+		signals = mocksignaljournal.NewSignalJournal(GinkgoT())
+		journaled = nil
+		signals.On("InDoubt").Return(func() []signaljournal.Entry { return journaled }).Maybe()
+
+		symbols := mocksymbols.NewSymbolMapper(GinkgoT())
+		symbols.On("FromNative", connector.ExchangeName("fake"), "BTC-PERP").Return(btc, connector.TypePerpetual, nil).Maybe()
+
+		orders = mocktracker.NewOrderTracker(GinkgoT())
+		orders.On("Order", connector.ExchangeName("fake"), open.OrderID).Return(tracker.TrackedOrder{}, false).Maybe()
+
+		positions = position.NewStore(clock)
+		service = startup.NewStartup(
+			connectors,
+			mockregistry.NewAssetRegistry(GinkgoT()),
+			mockplugin.NewManager(GinkgoT()),
+			runtime,
+			mocklatency.NewRecorder(GinkgoT()),
+			credentials,
+			signals,
+			symbols,
+			mockcertification.NewCertifier(GinkgoT()),
+			runbinding.NewExchangeBinding(logger.NewNoOpLogger()),
+			positions,
+			orders,
+			clock,
+			logger.NewNoOpLogger(),
+		)
+		configs = map[connector.ExchangeName]connector.Config{"fake": &fake.Config{Exchange: "fake"}}
+	})
+
+	It("hands the previous session's orders and positions to the strategy that traded them", func() {
+		signals.On("Owner", connector.ExchangeName("fake"), "BTC").Return(strategy.StrategyName("momentum"), true)
+		orders.On("Adopt", connector.ExchangeName("fake"), mock.MatchedBy(func(order connector.Order) bool {
+			return order.ID == open.OrderID
+		})).Return(nil).Once()
+
+		Expect(service.Start("momentum.so", configs, nil)).To(Succeed())
+
+		owner, ok := positions.GetStrategyForOrder(open.OrderID)
+		Expect(ok).To(BeTrue())
+		Expect(owner).To(Equal(strategy.StrategyName("momentum")))
+		credited := positions.GetStrategyExecution("momentum").Orders
+		Expect(credited).To(HaveLen(1))
+		Expect(credited[0].Symbol).To(Equal("BTC"))
+
+		trades := positions.GetTradesForStrategy("momentum")
+		Expect(trades).To(HaveLen(1))
+		Expect(trades[0].Exchange).To(Equal(connector.ExchangeName("fake")))
+		Expect(trades[0].Symbol).To(Equal("BTC"))
+		Expect(trades[0].Side).To(Equal(connector.OrderSideBuy))
+		Expect(trades[0].Quantity.Equal(numerical.NewFromInt(1))).To(BeTrue())
+		Expect(trades[0].Price.Equal(numerical.NewFromInt(90))).To(BeTrue())
+	})
+
+	It("does not credit the same state twice when the run restarts", func() {
+		signals.On("Owner", connector.ExchangeName("fake"), "BTC").Return(strategy.StrategyName("momentum"), true)
+		orders.On("Adopt", mock.Anything, mock.Anything).Return(nil).Once()
+
+		Expect(service.Start("momentum.so", configs, nil)).To(Succeed())
+
+		orders.ExpectedCalls = nil
+		orders.On("Order", connector.ExchangeName("fake"), open.OrderID).Return(tracker.TrackedOrder{}, true)
+		Expect(service.Start("momentum.so", configs, nil)).To(Succeed())
+
+		Expect(positions.GetStrategyExecution("momentum").Orders).To(HaveLen(1))
+		Expect(positions.GetTradesForStrategy("momentum")).To(HaveLen(1))
+	})
+
+	It("only tracks state no journaled strategy traded", func() {
+		signals.On("Owner", connector.ExchangeName("fake"), "BTC").Return(strategy.StrategyName(""), false)
+		orders.On("Adopt", connector.ExchangeName("fake"), mock.Anything).Return(nil).Once()
+
+		Expect(service.Start("momentum.so", configs, nil)).To(Succeed())
+
+		_, ok := positions.GetStrategyForOrder(open.OrderID)
+		Expect(ok).To(BeFalse())
+		Expect(positions.GetAllStrategyExecutions()).To(BeEmpty())
+	})
+
+	Context("with signals executing when the previous session stopped", func() {
+		executing := func(strategyName strategy.StrategyName, actions ...strategy.TradeAction) signaljournal.Entry {
+			return signaljournal.Entry{
+				Signal: strategy.Signal{
+					ID:        uuid.New(),
+					Strategy:  strategyName,
+					Actions:   actions,
+					Timestamp: started,
+				},
+				Status: signaljournal.StatusExecuting,
+			}
+		}
+		sell := strategy.TradeAction{Action: strategy.ActionSell, Asset: btc, Exchange: "fake", Quantity: numerical.NewFromInt(1)}
+
+		BeforeEach(func() {
+			signals.On("Owner", mock.Anything, mock.Anything).Return(strategy.StrategyName("momentum"), true).Maybe()
+			orders.On("Adopt", mock.Anything, mock.Anything).Return(nil).Maybe()
+		})
+
+		It("marks a signal executed when its order is on the exchange", func() {
+			entry := executing("momentum", sell)
+			journaled = []signaljournal.Entry{entry}
+			signals.On("Transition", entry.Signal.ID, signaljournal.StatusExecuted, mock.Anything).Return(nil).Once()
+
+			Expect(service.Start("momentum.so", configs, nil)).To(Succeed())
+
+			recovered := service.RecoveredSignals()
+			Expect(recovered).To(HaveLen(1))
+			Expect(recovered[0].Status).To(Equal(signaljournal.StatusExecuted))
+			Expect(recovered[0].Matched).To(HaveLen(1))
+			Expect(recovered[0].Matched[0].ID).To(Equal(open.OrderID))
+		})
+
+		It("leaves signals in doubt when they claim the same order", func() {
+			first := executing("momentum", sell)
+			second := executing("carry", sell)
+			journaled = []signaljournal.Entry{first, second}
+
+			Expect(service.Start("momentum.so", configs, nil)).To(Succeed())
+
+			signals.AssertNotCalled(GinkgoT(), "Transition", mock.Anything, mock.Anything, mock.Anything)
+			for _, doubt := range service.RecoveredSignals() {
+				Expect(doubt.Status).To(Equal(signaljournal.StatusExecuting))
+				Expect(doubt.OpenOrders).To(HaveLen(1))
+			}
+		})
+
+		It("leaves a signal in doubt when only part of it matches", func() {
+			buy := strategy.TradeAction{Action: strategy.ActionBuy, Asset: btc, Exchange: "fake", Quantity: numerical.NewFromInt(3)}
+			journaled = []signaljournal.Entry{executing("momentum", sell, buy)}
+
+			Expect(service.Start("momentum.so", configs, nil)).To(Succeed())
+
+			signals.AssertNotCalled(GinkgoT(), "Transition", mock.Anything, mock.Anything, mock.Anything)
+			Expect(service.RecoveredSignals()[0].Status).To(Equal(signaljournal.StatusExecuting))
+		})
+
+		It("fails a signal that left nothing on the exchange", func() {
+			eth := strategy.TradeAction{Action: strategy.ActionBuy, Asset: portfolio.NewAsset("ETH"), Exchange: "fake", Quantity: numerical.NewFromInt(1)}
+			entry := executing("momentum", eth)
+			journaled = []signaljournal.Entry{entry}
+			signals.On("Transition", entry.Signal.ID, signaljournal.StatusFailed, mock.Anything).Return(nil).Once()
+
+			Expect(service.Start("momentum.so", configs, nil)).To(Succeed())
+			Expect(service.RecoveredSignals()[0].Status).To(Equal(signaljournal.StatusFailed))
+		})
+	})
+})
@@ -5,11 +5,21 @@ import (
 	"fmt"
 
 	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/data/stores/activity"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/plugin"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
 	"github.com/backtesting-org/kronos-sdk/pkg/types/runtime"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/certification"
+	"github.com/backtesting-org/live-trading/pkg/connectors/credentials"
+	"github.com/backtesting-org/live-trading/pkg/connectors/latency"
+	"github.com/backtesting-org/live-trading/pkg/connectors/paper"
+	"github.com/backtesting-org/live-trading/pkg/connectors/symbols"
+	"github.com/backtesting-org/live-trading/pkg/connectors/tracker"
+	"github.com/backtesting-org/live-trading/pkg/runbinding"
+	"github.com/backtesting-org/live-trading/pkg/signaljournal"
 )
 
 type Startup interface {
@@ -19,6 +29,40 @@ type Startup interface {
 		assets map[portfolio.Asset][]connector.Instrument,
 	) error
 	Stop() error
+
+	// RecoveredState returns the open orders and positions found on each
+	// exchange during the last Start, keyed by exchange
+	RecoveredState() map[connector.ExchangeName]*ExchangeState
+
+	// RecoveredSignals returns the signals the signal journal held in doubt
+	// at the last Start, with the exchange state that may reflect them
+	RecoveredSignals() []InDoubtSignal
+
+	// Bootstrap verifies a first deployment end to end without booting the
+	// runtime; see BootstrapConfig for what it exercises
+	Bootstrap(
+		connectors map[connector.ExchangeName]connector.Config,
+		config BootstrapConfig,
+	) (*BootstrapReport, error)
+
+	// Preflight checks plugin certification, connectivity, key permissions,
+	// clock skew, balance and symbol availability before a run; see
+	// PreflightConfig
+	Preflight(
+		strategyPath string,
+		connectors map[connector.ExchangeName]connector.Config,
+		assets map[portfolio.Asset][]connector.Instrument,
+		config PreflightConfig,
+	) (*PreflightReport, error)
+
+	// StartLive runs Preflight and then Start, refusing to start on failed
+	// checks unless config.Force is set
+	StartLive(
+		strategyPath string,
+		connectors map[connector.ExchangeName]connector.Config,
+		assets map[portfolio.Asset][]connector.Instrument,
+		config PreflightConfig,
+	) (*PreflightReport, error)
 }
 
 func NewStartup(
@@ -26,6 +70,15 @@ func NewStartup(
 	assetRegistry registry.AssetRegistry,
 	pluginManager plugin.Manager,
 	runtime runtime.Runtime,
+	latencyRecorder latency.Recorder,
+	credentialResolver credentials.CredentialResolver,
+	signalJournal signaljournal.SignalJournal,
+	symbolMapper symbols.SymbolMapper,
+	certifier certification.Certifier,
+	exchangeBinding runbinding.ExchangeBinding,
+	positions activity.Positions,
+	orderTracker tracker.OrderTracker,
+	timeProvider temporal.TimeProvider,
 	logger logging.ApplicationLogger,
 ) Startup {
 	return &startup{
@@ -33,7 +86,18 @@ func NewStartup(
 		assetRegistry:     assetRegistry,
 		runtime:           runtime,
 		pluginManager:     pluginManager,
+		latency:           latencyRecorder,
+		credentials:       credentialResolver,
+		signals:           signalJournal,
+		symbols:           symbolMapper,
+		certifier:         certifier,
+		binding:           exchangeBinding,
+		positions:         positions,
+		tracker:           orderTracker,
+		timeProvider:      timeProvider,
 		logger:            logger,
+		prepared:          make(map[connector.ExchangeName]connector.Connector),
+		replaced:          make(map[connector.ExchangeName]connector.Connector),
 	}
 }
 
@@ -42,9 +106,27 @@ type startup struct {
 	assetRegistry     registry.AssetRegistry
 	pluginManager     plugin.Manager
 	runtime           runtime.Runtime
+	latency           latency.Recorder
+	credentials       credentials.CredentialResolver
+	signals           signaljournal.SignalJournal
+	symbols           symbols.SymbolMapper
+	certifier         certification.Certifier
+	binding           runbinding.ExchangeBinding
+	positions         activity.Positions
+	tracker           tracker.OrderTracker
+	timeProvider      temporal.TimeProvider
 	logger            logging.ApplicationLogger
+	recovered         map[connector.ExchangeName]*ExchangeState
+	inDoubt           []InDoubtSignal
 	ctx               context.Context
 	cancel            context.CancelFunc
+
+	// prepared holds connectors Preflight initialized, for Start to reuse
+	prepared map[connector.ExchangeName]connector.Connector
+
+	// replaced holds the registered connectors a run swapped out for its
+	// own, such as a paper wrapper, so Stop can put them back
+	replaced map[connector.ExchangeName]connector.Connector
 }
 
 // Start runs the trading strategy
@@ -60,21 +142,26 @@ func (r *startup) Start(
 		ConnectorNames: make([]connector.ExchangeName, 0, len(connectors)),
 	}
 
-	for name, config := range connectors {
-		conn, isRegistered := r.connectorRegistry.GetConnector(name)
-		if !isRegistered {
-			r.logger.Warn(fmt.Sprintf("connector %s is not registered", name))
-		}
+	if err := r.validateConnectors(connectors); err != nil {
+		r.logger.Error(fmt.Sprintf("connector binding invalid: %s", err.Error()))
+		return err
+	}
+
+	names := make([]connector.ExchangeName, 0, len(connectors))
+	for name := range connectors {
+		names = append(names, name)
+	}
+	r.binding.Bind(names)
 
-		err := conn.Initialize(config)
+	for name, config := range connectors {
+		conn, err := r.initializeConnector(name, config)
 		if err != nil {
-			r.logger.Error(fmt.Sprintf("connector %s initialize failed: %s", name, err.Error()))
 			return err
 		}
+		r.bindConnector(name, conn)
 
 		bootConfig.ConnectorNames = append(bootConfig.ConnectorNames, name)
-		err = r.connectorRegistry.MarkConnectorReady(name)
-		if err != nil {
+		if err := r.connectorRegistry.MarkConnectorReady(name); err != nil {
 			return err
 		}
 	}
@@ -85,6 +172,10 @@ func (r *startup) Start(
 		}
 	}
 
+	r.recovered = r.reconcileConnectors(bootConfig.ConnectorNames, connectors)
+	r.inDoubt = r.reconcileSignals(r.recovered)
+	r.seedState(r.recovered, r.inDoubt)
+
 	err := r.runtime.Boot(r.ctx, bootConfig)
 	if err != nil {
 		r.logger.Error(fmt.Sprintf("runtime boot failed: %s", err.Error()))
@@ -94,6 +185,97 @@ func (r *startup) Start(
 	return nil
 }
 
+// validateConnectors checks the run's exchange binding against the registry
+// and resolves credentials before any connector is initialized, so a bad
+// binding or missing secret never leaves a run half-started.
+func (r *startup) validateConnectors(connectors map[connector.ExchangeName]connector.Config) error {
+	if len(connectors) == 0 {
+		return fmt.Errorf("no connectors configured for run")
+	}
+
+	for name, config := range connectors {
+		if _, isRegistered := r.connectorRegistry.GetConnector(name); !isRegistered {
+			return fmt.Errorf("connector %s is not registered", name)
+		}
+
+		if config == nil {
+			return fmt.Errorf("connector %s has no config", name)
+		}
+
+		if config.ExchangeName() != name {
+			return fmt.Errorf("connector %s was given a config for %s", name, config.ExchangeName())
+		}
+
+		if err := r.credentials.Resolve(config); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// initializeConnector initializes a registered connector with its config,
+// wrapping it in paper execution when the config asks for it. The registry
+// is left alone; Start binds the result for the run's lifetime.
+func (r *startup) initializeConnector(name connector.ExchangeName, config connector.Config) (connector.Connector, error) {
+	if conn, ok := r.prepared[name]; ok {
+		delete(r.prepared, name)
+		return conn, nil
+	}
+
+	conn, _ := r.connectorRegistry.GetConnector(name)
+
+	// A paper config swaps in simulated execution for this run only
+	if _, isPaper := config.(*paper.Config); isPaper {
+		conn = paper.NewPaperConnector(conn, r.latency, r.timeProvider, r.logger)
+		r.logger.Info(fmt.Sprintf("connector %s running in paper execution mode", name))
+	}
+
+	// Connectors cannot be de-initialized, so a restarted run reuses the
+	// session its first start opened rather than failing to initialize twice
+	if conn.IsInitialized() {
+		r.logger.Info(fmt.Sprintf("connector %s already initialized; reusing it", name))
+		return conn, nil
+	}
+
+	if err := conn.Initialize(config); err != nil {
+		r.logger.Error(fmt.Sprintf("connector %s initialize failed: %s", name, err.Error()))
+		return nil, err
+	}
+
+	return conn, nil
+}
+
+// bindConnector registers the connector a run trades through under its
+// exchange name, remembering the one it replaces until Stop
+func (r *startup) bindConnector(name connector.ExchangeName, conn connector.Connector) {
+	registered, _ := r.connectorRegistry.GetConnector(name)
+	if registered == conn {
+		return
+	}
+	if _, ok := r.replaced[name]; !ok {
+		r.replaced[name] = registered
+	}
+	r.connectorRegistry.RegisterConnector(name, conn)
+}
+
+// restoreConnectors puts back the connectors the run replaced, so a later
+// run starts from the live connector rather than this run's wrapper
+func (r *startup) restoreConnectors() {
+	for name, original := range r.replaced {
+		r.connectorRegistry.RegisterConnector(name, original)
+		delete(r.replaced, name)
+	}
+}
+
+func (r *startup) RecoveredState() map[connector.ExchangeName]*ExchangeState {
+	return r.recovered
+}
+
+func (r *startup) RecoveredSignals() []InDoubtSignal {
+	return r.inDoubt
+}
+
 // Stop gracefully shuts down the runtime
 func (r *startup) Stop() error {
 	r.logger.Info("stopping startup service")
@@ -102,5 +284,8 @@ func (r *startup) Stop() error {
 		r.cancel()
 	}
 
-	return r.runtime.Stop(r.ctx)
+	err := r.runtime.Stop(r.ctx)
+	r.binding.Release()
+	r.restoreConnectors()
+	return err
 }
@@ -0,0 +1,92 @@
+package supervisor
+
+import "time"
+
+const (
+	// HeartbeatFDEnv names the inherited file descriptor the child writes heartbeats to
+	HeartbeatFDEnv = "LIVE_TRADING_HEARTBEAT_FD"
+
+	// RestartCountEnv tells the child how many times it has been restarted
+	RestartCountEnv = "LIVE_TRADING_RESTART_COUNT"
+
+	// LastExitEnv tells the child why the previous instance stopped
+	LastExitEnv = "LIVE_TRADING_LAST_EXIT"
+)
+
+// Config controls how the supervisor runs and restarts the trading process
+type Config struct {
+	Command string
+	Args    []string
+	Env     []string
+
+	// StartupGrace is how long a fresh process has to send its first heartbeat
+	StartupGrace time.Duration
+
+	// HeartbeatTimeout is the longest gap between heartbeats before the
+	// process is considered hung
+	HeartbeatTimeout time.Duration
+
+	// MaxGoroutines treats a goroutine count above this as a deadlock or
+	// leak; zero disables the check
+	MaxGoroutines int
+
+	// DumpGracePeriod is how long a hung process has to write its
+	// goroutine dump after SIGQUIT before it is killed
+	DumpGracePeriod time.Duration
+
+	// MaxRestarts within RestartWindow is treated as a crash loop and
+	// escalated instead of restarting again
+	MaxRestarts   int
+	RestartWindow time.Duration
+
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// DefaultConfig supervises the given command with conservative timeouts
+func DefaultConfig(command string, args ...string) Config {
+	return Config{
+		Command:          command,
+		Args:             args,
+		StartupGrace:     2 * time.Minute,
+		HeartbeatTimeout: 30 * time.Second,
+		MaxGoroutines:    10000,
+		DumpGracePeriod:  5 * time.Second,
+		MaxRestarts:      5,
+		RestartWindow:    10 * time.Minute,
+		InitialBackoff:   time.Second,
+		MaxBackoff:       time.Minute,
+	}
+}
+
+const (
+	// RunJobName is the scheduler job that checks supervised runs
+	RunJobName = "run-supervisor"
+
+	// RunStateFileName holds each run's desired state across restarts
+	RunStateFileName = "runs.json"
+
+	DefaultRunCheckInterval = 15 * time.Second
+	DefaultRunMaxRestarts   = 5
+	DefaultRunResetAfter    = 10 * time.Minute
+)
+
+// RunConfig controls the run supervisor
+type RunConfig struct {
+	// Directory holds RunStateFileName
+	Directory string
+
+	CheckInterval time.Duration
+
+	// Timezone schedules are evaluated in
+	Timezone string
+}
+
+// DefaultRunConfig keeps run state under directory and schedules in UTC
+func DefaultRunConfig(directory string) RunConfig {
+	return RunConfig{
+		Directory:     directory,
+		CheckInterval: DefaultRunCheckInterval,
+		Timezone:      "UTC",
+	}
+}
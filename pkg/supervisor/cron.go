@@ -0,0 +1,138 @@
+package supervisor
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxCronCatchUp bounds how far back a check looks for a missed schedule;
+// anything older is covered by the persisted desired state instead
+const maxCronCatchUp = 24 * time.Hour
+
+// cronSchedule is a five-field cron expression: minute, hour, day of month,
+// month and day of week. Fields take *, numbers, a-b ranges, comma lists
+// and /n steps; day of week runs 0-6 from Sunday, with 7 also Sunday.
+type cronSchedule struct {
+	minutes  []bool
+	hours    []bool
+	days     []bool
+	months   []bool
+	weekdays []bool
+
+	// Like cron, when both day fields are restricted either may match
+	anyDay     bool
+	anyWeekday bool
+}
+
+func parseCron(expr string) (*cronSchedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron expression %q must have 5 fields", expr)
+	}
+
+	schedule := &cronSchedule{
+		anyDay:     fields[2] == "*",
+		anyWeekday: fields[4] == "*",
+	}
+
+	var err error
+	if schedule.minutes, err = parseCronField(fields[0], 0, 59); err != nil {
+		return nil, fmt.Errorf("cron expression %q: minute: %w", expr, err)
+	}
+	if schedule.hours, err = parseCronField(fields[1], 0, 23); err != nil {
+		return nil, fmt.Errorf("cron expression %q: hour: %w", expr, err)
+	}
+	if schedule.days, err = parseCronField(fields[2], 1, 31); err != nil {
+		return nil, fmt.Errorf("cron expression %q: day of month: %w", expr, err)
+	}
+	if schedule.months, err = parseCronField(fields[3], 1, 12); err != nil {
+		return nil, fmt.Errorf("cron expression %q: month: %w", expr, err)
+	}
+	if schedule.weekdays, err = parseCronField(fields[4], 0, 7); err != nil {
+		return nil, fmt.Errorf("cron expression %q: day of week: %w", expr, err)
+	}
+	schedule.weekdays[0] = schedule.weekdays[0] || schedule.weekdays[7]
+	return schedule, nil
+}
+
+func parseCronField(field string, min, max int) ([]bool, error) {
+	set := make([]bool, max+1)
+	for _, part := range strings.Split(field, ",") {
+		step := 1
+		if base, stepText, ok := strings.Cut(part, "/"); ok {
+			n, err := strconv.Atoi(stepText)
+			if err != nil || n <= 0 {
+				return nil, fmt.Errorf("invalid step %q", stepText)
+			}
+			step = n
+			part = base
+		}
+
+		low, high := min, max
+		switch {
+		case part == "*":
+		case strings.Contains(part, "-"):
+			lowText, highText, _ := strings.Cut(part, "-")
+			var err error
+			if low, err = strconv.Atoi(lowText); err != nil {
+				return nil, fmt.Errorf("invalid range %q", part)
+			}
+			if high, err = strconv.Atoi(highText); err != nil {
+				return nil, fmt.Errorf("invalid range %q", part)
+			}
+		default:
+			n, err := strconv.Atoi(part)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value %q", part)
+			}
+			low, high = n, n
+			if step > 1 {
+				high = max
+			}
+		}
+
+		if low < min || high > max || low > high {
+			return nil, fmt.Errorf("%q is outside %d-%d", part, min, max)
+		}
+		for i := low; i <= high; i += step {
+			set[i] = true
+		}
+	}
+	return set, nil
+}
+
+func (c *cronSchedule) matches(t time.Time) bool {
+	if !c.minutes[t.Minute()] || !c.hours[t.Hour()] || !c.months[int(t.Month())] {
+		return false
+	}
+
+	day, weekday := c.days[t.Day()], c.weekdays[int(t.Weekday())]
+	switch {
+	case c.anyDay && c.anyWeekday:
+		return true
+	case c.anyDay:
+		return weekday
+	case c.anyWeekday:
+		return day
+	default:
+		return day || weekday
+	}
+}
+
+// firedBetween reports whether the schedule fired in (from, to], checking
+// each minute boundary in location
+func (c *cronSchedule) firedBetween(from, to time.Time, location *time.Location) bool {
+	if earliest := to.Add(-maxCronCatchUp); from.Before(earliest) {
+		from = earliest
+	}
+
+	minute := from.In(location).Truncate(time.Minute).Add(time.Minute)
+	for end := to.In(location); !minute.After(end); minute = minute.Add(time.Minute) {
+		if c.matches(minute) {
+			return true
+		}
+	}
+	return false
+}
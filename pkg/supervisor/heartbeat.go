@@ -0,0 +1,72 @@
+package supervisor
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"runtime"
+	"strconv"
+	"time"
+)
+
+// Heartbeat is written by the supervised process as one JSON line per beat
+type Heartbeat struct {
+	Time       time.Time `json:"time"`
+	Goroutines int       `json:"goroutines"`
+}
+
+// IsSupervised reports whether this process was started by a supervisor
+func IsSupervised() bool {
+	return os.Getenv(HeartbeatFDEnv) != ""
+}
+
+// RestartCount returns how many times the supervisor has restarted this
+// process; a non-zero count means startup should run recovery
+func RestartCount() int {
+	count, err := strconv.Atoi(os.Getenv(RestartCountEnv))
+	if err != nil {
+		return 0
+	}
+	return count
+}
+
+// LastExit returns why the previous instance stopped, if supervised
+func LastExit() string {
+	return os.Getenv(LastExitEnv)
+}
+
+// StartHeartbeat writes heartbeats to the supervisor until ctx is done.
+// It returns false without doing anything when the process is not supervised.
+func StartHeartbeat(ctx context.Context, interval time.Duration) bool {
+	fd, err := strconv.Atoi(os.Getenv(HeartbeatFDEnv))
+	if err != nil {
+		return false
+	}
+
+	pipe := os.NewFile(uintptr(fd), "supervisor-heartbeat")
+	if pipe == nil {
+		return false
+	}
+
+	go func() {
+		defer pipe.Close()
+
+		encoder := json.NewEncoder(pipe)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			if err := encoder.Encode(Heartbeat{Time: time.Now(), Goroutines: runtime.NumGoroutine()}); err != nil {
+				return
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return true
+}
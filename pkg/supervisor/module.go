@@ -0,0 +1,18 @@
+package supervisor
+
+import "go.uber.org/fx"
+
+// Module is used by the parent process only; the supervised trading process
+// calls StartHeartbeat instead of depending on the supervisor
+var Module = fx.Options(
+	fx.Provide(NewSupervisor),
+)
+
+// RunModule runs inside the trading process, supervising strategy runs
+// through startup
+var RunModule = fx.Options(
+	fx.Provide(
+		NewStartupRunner,
+		NewRunSupervisor,
+	),
+)
@@ -0,0 +1,100 @@
+package supervisor
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/portfolio"
+)
+
+// RestartPolicy says when a run that stopped on its own is started again
+type RestartPolicy string
+
+const (
+	RestartNever     RestartPolicy = "never"
+	RestartOnFailure RestartPolicy = "on-failure"
+	RestartAlways    RestartPolicy = "always"
+)
+
+// RunPolicy is how one plugin's run is restarted and scheduled
+type RunPolicy struct {
+	Restart RestartPolicy
+
+	// MaxRestarts in a row before the run is given up on; a run that stays
+	// up for ResetAfter starts counting again
+	MaxRestarts int
+	ResetAfter  time.Duration
+
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+
+	// StartSchedule and StopSchedule are five-field cron expressions, e.g.
+	// "0 13 * * 1-5", in RunConfig.Timezone; either may be empty
+	StartSchedule string
+	StopSchedule  string
+}
+
+// DefaultRunPolicy restarts failed runs with the process supervisor's backoff
+func DefaultRunPolicy() RunPolicy {
+	defaults := DefaultConfig("")
+	return RunPolicy{
+		Restart:        RestartOnFailure,
+		MaxRestarts:    DefaultRunMaxRestarts,
+		ResetAfter:     DefaultRunResetAfter,
+		InitialBackoff: defaults.InitialBackoff,
+		MaxBackoff:     defaults.MaxBackoff,
+	}
+}
+
+// RunSpec is one plugin run the supervisor owns. Specs are registered by
+// the host on every boot; only the desired state is persisted, so connector
+// credentials never reach the state file.
+type RunSpec struct {
+	Name         string
+	StrategyPath string
+	Connectors   map[connector.ExchangeName]connector.Config
+	Assets       map[portfolio.Asset][]connector.Instrument
+	Policy       RunPolicy
+}
+
+func (s *RunSpec) applyDefaults() error {
+	if s.Name == "" {
+		return fmt.Errorf("run name is required")
+	}
+
+	defaults := DefaultRunPolicy()
+	policy := &s.Policy
+	switch policy.Restart {
+	case "":
+		policy.Restart = defaults.Restart
+	case RestartNever, RestartOnFailure, RestartAlways:
+	default:
+		return fmt.Errorf("run %s: unknown restart policy %q", s.Name, policy.Restart)
+	}
+	if policy.MaxRestarts <= 0 {
+		policy.MaxRestarts = defaults.MaxRestarts
+	}
+	if policy.ResetAfter <= 0 {
+		policy.ResetAfter = defaults.ResetAfter
+	}
+	if policy.InitialBackoff <= 0 {
+		policy.InitialBackoff = defaults.InitialBackoff
+	}
+	if policy.MaxBackoff < policy.InitialBackoff {
+		policy.MaxBackoff = defaults.MaxBackoff
+	}
+	return nil
+}
+
+// backoff doubles from InitialBackoff for each restart already made
+func (p RunPolicy) backoff(restarts int) time.Duration {
+	delay := p.InitialBackoff
+	for i := 0; i < restarts && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+	return delay
+}
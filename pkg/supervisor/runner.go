@@ -0,0 +1,83 @@
+package supervisor
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/lifecycle"
+	"github.com/backtesting-org/live-trading/pkg/startup"
+)
+
+// ErrRunExited is reported for a run whose runtime stopped without being asked to
+var ErrRunExited = errors.New("runtime stopped without a stop request")
+
+// Runner starts and stops runs for the RunSupervisor
+type Runner interface {
+	Start(spec RunSpec) error
+	Stop(spec RunSpec) error
+
+	// Exited reports whether a started run has stopped on its own. A nil
+	// error is a clean exit; anything else counts as a failure.
+	Exited(spec RunSpec) (bool, error)
+}
+
+// startupRunner runs specs through startup.Startup, which boots one
+// strategy plugin per process, so only one run may be up at a time
+type startupRunner struct {
+	startup    startup.Startup
+	controller lifecycle.Controller
+
+	active string
+	mu     sync.Mutex
+}
+
+func NewStartupRunner(startupService startup.Startup, controller lifecycle.Controller) Runner {
+	return &startupRunner{
+		startup:    startupService,
+		controller: controller,
+	}
+}
+
+func (r *startupRunner) Start(spec RunSpec) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.active != "" && r.active != spec.Name {
+		return fmt.Errorf("cannot start run %s: run %s is up and startup runs one strategy at a time", spec.Name, r.active)
+	}
+
+	if err := r.startup.Start(spec.StrategyPath, spec.Connectors, spec.Assets); err != nil {
+		// A half-booted runtime is torn down so the next attempt starts clean
+		_ = r.startup.Stop()
+		return err
+	}
+	r.active = spec.Name
+	return nil
+}
+
+func (r *startupRunner) Stop(spec RunSpec) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.active != spec.Name {
+		return nil
+	}
+	r.active = ""
+	return r.startup.Stop()
+}
+
+func (r *startupRunner) Exited(spec RunSpec) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.active != spec.Name {
+		return true, nil
+	}
+	if r.controller.State() != lifecycle.StateStopped {
+		return false, nil
+	}
+
+	r.active = ""
+	return true, ErrRunExited
+}
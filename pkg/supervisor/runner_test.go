@@ -0,0 +1,158 @@
+package supervisor_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	mocklifecycle "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/lifecycle"
+	mockplugin "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/plugin"
+	mockregistry "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	mockruntime "github.com/backtesting-org/kronos-sdk/mocks/github.com/backtesting-org/kronos-sdk/pkg/types/runtime"
+	sdkregistry "github.com/backtesting-org/kronos-sdk/pkg/registry"
+	"github.com/backtesting-org/kronos-sdk/pkg/stores/activity/position"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/lifecycle"
+	logger "github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/registry"
+	mockcertification "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/certification"
+	mockcredentials "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/connectors/credentials"
+	mocklatency "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/connectors/latency"
+	mocksymbols "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/connectors/symbols"
+	mocktracker "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/connectors/tracker"
+	mocksignaljournal "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/signaljournal"
+	"github.com/backtesting-org/live-trading/pkg/connectors/fake"
+	"github.com/backtesting-org/live-trading/pkg/connectors/paper"
+	"github.com/backtesting-org/live-trading/pkg/runbinding"
+	"github.com/backtesting-org/live-trading/pkg/startup"
+	"github.com/backtesting-org/live-trading/pkg/supervisor"
+)
+
+var _ = Describe("StartupRunner", func() {
+	var (
+		connectors registry.ConnectorRegistry
+		exchange   *fake.Connector
+		binding    runbinding.ExchangeBinding
+		runtime    *mockruntime.Runtime
+		controller *mocklifecycle.Controller
+		runner     supervisor.Runner
+		spec       supervisor.RunSpec
+	)
+
+	BeforeEach(func() {
+		clock := fake.NewClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+		exchange = fake.NewConnector("fake", clock)
+
+		connectors = sdkregistry.NewConnectorRegistry()
+		connectors.RegisterConnector("fake", exchange)
+
+		runtime = mockruntime.NewRuntime(GinkgoT())
+		runtime.On("Boot", mock.Anything, mock.Anything).Return(nil).Maybe()
+		runtime.On("Stop", mock.Anything).Return(nil).Maybe()
+
+		controller = mocklifecycle.NewController(GinkgoT())
+		controller.On("State").Return(lifecycle.StateReady).Maybe()
+
+		credentials := mockcredentials.NewCredentialResolver(GinkgoT())
+		credentials.On("Resolve", mock.Anything).Return(nil).Maybe()
+
+		signals := mocksignaljournal.NewSignalJournal(GinkgoT())
+		signals.On("InDoubt").Return(nil).Maybe()
+
+		binding = runbinding.NewExchangeBinding(logger.NewNoOpLogger())
+
+		service := startup.NewStartup(
+			connectors,
+			mockregistry.NewAssetRegistry(GinkgoT()),
+			mockplugin.NewManager(GinkgoT()),
+			runtime,
+			mocklatency.NewRecorder(GinkgoT()),
+			credentials,
+			signals,
+			mocksymbols.NewSymbolMapper(GinkgoT()),
+			mockcertification.NewCertifier(GinkgoT()),
+			binding,
+			position.NewStore(clock),
+			mocktracker.NewOrderTracker(GinkgoT()),
+			clock,
+			logger.NewNoOpLogger(),
+		)
+		runner = supervisor.NewStartupRunner(service, controller)
+
+		spec = supervisor.RunSpec{
+			Name:         "momentum",
+			StrategyPath: "momentum.so",
+			Connectors: map[connector.ExchangeName]connector.Config{
+				"fake": &fake.Config{Exchange: "fake"},
+			},
+		}
+	})
+
+	It("restarts the same spec without re-initializing its connectors", func() {
+		for attempt := 0; attempt < 3; attempt++ {
+			Expect(runner.Start(spec)).To(Succeed(), "start %d", attempt+1)
+			Expect(connectors.IsConnectorReady("fake")).To(BeTrue())
+			Expect(runner.Stop(spec)).To(Succeed(), "stop %d", attempt+1)
+		}
+
+		conn, _ := connectors.GetConnector("fake")
+		Expect(conn).To(BeIdenticalTo(exchange))
+		Expect(exchange.IsInitialized()).To(BeTrue())
+		runtime.AssertNumberOfCalls(GinkgoT(), "Boot", 3)
+	})
+
+	It("scopes a paper connector to the run that asked for it", func() {
+		spec.Connectors = map[connector.ExchangeName]connector.Config{
+			"fake": &paper.Config{Live: &fake.Config{Exchange: "fake"}},
+		}
+
+		var wrappers []connector.Connector
+		for attempt := 0; attempt < 2; attempt++ {
+			Expect(runner.Start(spec)).To(Succeed(), "start %d", attempt+1)
+
+			conn, _ := connectors.GetConnector("fake")
+			Expect(conn).NotTo(BeIdenticalTo(exchange))
+			Expect(conn.IsInitialized()).To(BeTrue())
+			wrappers = append(wrappers, conn)
+
+			Expect(runner.Stop(spec)).To(Succeed(), "stop %d", attempt+1)
+
+			conn, _ = connectors.GetConnector("fake")
+			Expect(conn).To(BeIdenticalTo(exchange))
+		}
+		Expect(wrappers[1]).NotTo(BeIdenticalTo(wrappers[0]))
+	})
+
+	It("binds the run's exchanges until it stops", func() {
+		Expect(runner.Start(spec)).To(Succeed())
+		Expect(binding.Exchanges()).To(Equal([]connector.ExchangeName{"fake"}))
+
+		Expect(runner.Stop(spec)).To(Succeed())
+		Expect(binding.Exchanges()).To(BeEmpty())
+	})
+
+	It("refuses a second run while one is up", func() {
+		Expect(runner.Start(spec)).To(Succeed())
+
+		other := spec
+		other.Name = "carry"
+		Expect(runner.Start(other)).To(MatchError(ContainSubstring("run momentum is up")))
+	})
+
+	It("reports a runtime that stopped on its own as exited", func() {
+		Expect(runner.Start(spec)).To(Succeed())
+
+		exited, err := runner.Exited(spec)
+		Expect(exited).To(BeFalse())
+		Expect(err).NotTo(HaveOccurred())
+
+		controller.ExpectedCalls = nil
+		controller.On("State").Return(lifecycle.StateStopped)
+
+		exited, err = runner.Exited(spec)
+		Expect(exited).To(BeTrue())
+		Expect(err).To(MatchError(supervisor.ErrRunExited))
+	})
+})
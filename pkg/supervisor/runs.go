@@ -0,0 +1,526 @@
+package supervisor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+	"github.com/backtesting-org/live-trading/pkg/scheduler"
+)
+
+// ErrRunsNotConfigured is returned until Configure has loaded the run state
+var ErrRunsNotConfigured = errors.New("run supervisor not configured")
+
+// RunState is what a supervised run is doing right now
+type RunState string
+
+const (
+	RunStopped RunState = "stopped"
+	RunRunning RunState = "running"
+
+	// RunBackoff is waiting out the restart delay after an exit
+	RunBackoff RunState = "backoff"
+
+	// RunFailed has used up its restarts, or failed under RestartNever
+	RunFailed RunState = "failed"
+)
+
+// RunStatus is a point-in-time view of one supervised run
+type RunStatus struct {
+	Name          string
+	Desired       DesiredState
+	State         RunState
+	Restarts      int
+	LastError     string
+	StartedAt     time.Time
+	NextRestartAt time.Time
+}
+
+// RunSupervisor keeps registered plugin runs in their desired state. Runs
+// that exit are restarted per their RunPolicy, cron schedules flip the
+// desired state, and the desired state is persisted so runs that were up
+// before a host reboot resume once they are registered again.
+type RunSupervisor interface {
+	// Configure loads the persisted desired state from config.Directory
+	Configure(config RunConfig) error
+
+	// Register adds a run; a run persisted as running is started on the
+	// next check
+	Register(spec RunSpec) error
+
+	// StartRun and StopRun change and persist the desired state, then
+	// reconcile that run straight away
+	StartRun(name string) error
+	StopRun(name string) error
+
+	Start() error
+
+	// Stop halts every run without changing its desired state, so the
+	// runs resume on the next boot
+	Stop() error
+
+	// Check applies schedules, restarts exited runs and reconciles every
+	// run with its desired state
+	Check() error
+
+	Status(name string) (RunStatus, bool)
+	Statuses() []RunStatus
+}
+
+type supervisedRun struct {
+	spec  RunSpec
+	start *cronSchedule
+	stop  *cronSchedule
+
+	desired       DesiredState
+	state         RunState
+	restarts      int
+	lastError     string
+	startedAt     time.Time
+	nextRestartAt time.Time
+}
+
+type runSupervisor struct {
+	runner       Runner
+	scheduler    scheduler.Scheduler
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config    RunConfig
+	location  *time.Location
+	statePath string
+	persisted map[string]persistedRun
+	runs      map[string]*supervisedRun
+	lastCheck time.Time
+	mu        sync.Mutex
+
+	// checkMu serialises runner calls, which are made without holding mu
+	checkMu sync.Mutex
+}
+
+func NewRunSupervisor(
+	runner Runner,
+	jobScheduler scheduler.Scheduler,
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) RunSupervisor {
+	return &runSupervisor{
+		runner:       runner,
+		scheduler:    jobScheduler,
+		timeProvider: timeProvider,
+		logger:       logger,
+		runs:         make(map[string]*supervisedRun),
+	}
+}
+
+func (s *runSupervisor) Configure(config RunConfig) error {
+	if config.Directory == "" {
+		return fmt.Errorf("run state directory is required")
+	}
+	defaults := DefaultRunConfig(config.Directory)
+	if config.CheckInterval <= 0 {
+		config.CheckInterval = defaults.CheckInterval
+	}
+	if config.Timezone == "" {
+		config.Timezone = defaults.Timezone
+	}
+
+	location, err := time.LoadLocation(config.Timezone)
+	if err != nil {
+		return fmt.Errorf("invalid run schedule timezone %q: %w", config.Timezone, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.persisted != nil {
+		return fmt.Errorf("run supervisor already configured")
+	}
+
+	statePath := filepath.Join(config.Directory, RunStateFileName)
+	persisted, err := loadRunState(statePath)
+	if err != nil {
+		return err
+	}
+
+	s.config = config
+	s.location = location
+	s.statePath = statePath
+	s.persisted = persisted
+	s.logger.Info("Run supervisor loaded desired state for %d runs", len(persisted))
+	return nil
+}
+
+func (s *runSupervisor) Register(spec RunSpec) error {
+	if err := spec.applyDefaults(); err != nil {
+		return err
+	}
+
+	run := &supervisedRun{
+		spec:    spec,
+		desired: DesiredStopped,
+		state:   RunStopped,
+	}
+
+	var err error
+	if spec.Policy.StartSchedule != "" {
+		if run.start, err = parseCron(spec.Policy.StartSchedule); err != nil {
+			return fmt.Errorf("run %s start schedule: %w", spec.Name, err)
+		}
+	}
+	if spec.Policy.StopSchedule != "" {
+		if run.stop, err = parseCron(spec.Policy.StopSchedule); err != nil {
+			return fmt.Errorf("run %s stop schedule: %w", spec.Name, err)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.persisted == nil {
+		return ErrRunsNotConfigured
+	}
+	if _, exists := s.runs[spec.Name]; exists {
+		return fmt.Errorf("run %s already registered", spec.Name)
+	}
+
+	if saved, ok := s.persisted[spec.Name]; ok {
+		run.desired = saved.Desired
+		run.restarts = saved.Restarts
+		if run.desired == DesiredRunning {
+			s.logger.Info("Run %s was running before shutdown and will resume", spec.Name)
+		}
+	}
+
+	s.runs[spec.Name] = run
+	return nil
+}
+
+func (s *runSupervisor) StartRun(name string) error {
+	return s.setDesired(name, DesiredRunning)
+}
+
+func (s *runSupervisor) StopRun(name string) error {
+	return s.setDesired(name, DesiredStopped)
+}
+
+func (s *runSupervisor) setDesired(name string, desired DesiredState) error {
+	s.checkMu.Lock()
+	defer s.checkMu.Unlock()
+
+	s.mu.Lock()
+	run, ok := s.runs[name]
+	if !ok {
+		s.mu.Unlock()
+		return fmt.Errorf("run %s not registered", name)
+	}
+	s.want(run, desired)
+	err := s.saveLocked()
+	s.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	now := s.timeProvider.Now()
+	return s.reconcile(name, now, now)
+}
+
+// want changes the desired state; asking for a run again clears its
+// restart count and any failure. Callers hold mu.
+func (s *runSupervisor) want(run *supervisedRun, desired DesiredState) {
+	run.desired = desired
+	if desired != DesiredRunning {
+		return
+	}
+	run.restarts = 0
+	run.nextRestartAt = time.Time{}
+	if run.state == RunFailed {
+		run.state = RunStopped
+	}
+}
+
+func (s *runSupervisor) Start() error {
+	s.mu.Lock()
+	interval := s.config.CheckInterval
+	configured := s.persisted != nil
+	s.mu.Unlock()
+
+	if !configured {
+		return ErrRunsNotConfigured
+	}
+
+	return s.scheduler.Register(scheduler.Job{
+		Name:       RunJobName,
+		Interval:   interval,
+		RunOnStart: true,
+		Run: func(_ context.Context) error {
+			return s.Check()
+		},
+	})
+}
+
+func (s *runSupervisor) Stop() error {
+	unregisterErr := s.scheduler.Unregister(RunJobName)
+
+	s.checkMu.Lock()
+	defer s.checkMu.Unlock()
+
+	var failed []string
+	for _, name := range s.names() {
+		s.mu.Lock()
+		run := s.runs[name]
+		spec, state := run.spec, run.state
+		s.mu.Unlock()
+
+		if state != RunRunning {
+			continue
+		}
+		if err := s.runner.Stop(spec); err != nil {
+			s.logger.Warn("Failed to stop run %s: %v", name, err)
+			failed = append(failed, name)
+		}
+
+		s.mu.Lock()
+		run.state = RunStopped
+		s.mu.Unlock()
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to stop runs %v", failed)
+	}
+	return unregisterErr
+}
+
+func (s *runSupervisor) Check() error {
+	s.checkMu.Lock()
+	defer s.checkMu.Unlock()
+
+	s.mu.Lock()
+	if s.persisted == nil {
+		s.mu.Unlock()
+		return ErrRunsNotConfigured
+	}
+	now := s.timeProvider.Now()
+	from := s.lastCheck
+	if from.IsZero() {
+		// Schedules that fired while the host was down are not replayed;
+		// the persisted desired state already covers them
+		from = now
+	}
+	s.lastCheck = now
+	s.mu.Unlock()
+
+	var failed []string
+	for _, name := range s.names() {
+		if err := s.reconcile(name, from, now); err != nil {
+			s.logger.Warn("Run %s: %v", name, err)
+			failed = append(failed, name)
+		}
+	}
+
+	s.mu.Lock()
+	err := s.saveLocked()
+	s.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("run supervision failed for %v", failed)
+	}
+	return nil
+}
+
+// reconcile moves one run toward its desired state, applying schedules
+// that fired in (from, now]. Callers hold checkMu.
+func (s *runSupervisor) reconcile(name string, from, now time.Time) error {
+	s.mu.Lock()
+	run := s.runs[name]
+	// A start and a stop firing in the same window leave the run started
+	if run.stop != nil && run.stop.firedBetween(from, now, s.location) {
+		s.logger.Info("Stop schedule fired for run %s", name)
+		s.want(run, DesiredStopped)
+	}
+	if run.start != nil && run.start.firedBetween(from, now, s.location) {
+		s.logger.Info("Start schedule fired for run %s", name)
+		s.want(run, DesiredRunning)
+	}
+	spec := run.spec
+	s.mu.Unlock()
+
+	if s.state(run) == RunRunning {
+		exited, exitErr := s.runner.Exited(spec)
+		if !exited {
+			s.mu.Lock()
+			desired := run.desired
+			if run.restarts > 0 && now.Sub(run.startedAt) >= spec.Policy.ResetAfter {
+				run.restarts = 0
+			}
+			s.mu.Unlock()
+
+			if desired == DesiredRunning {
+				return nil
+			}
+
+			err := s.runner.Stop(spec)
+			s.mu.Lock()
+			run.state = RunStopped
+			s.mu.Unlock()
+			if err != nil {
+				return fmt.Errorf("failed to stop: %w", err)
+			}
+			s.logger.Info("Stopped run %s", name)
+			return nil
+		}
+
+		s.mu.Lock()
+		s.exited(run, exitErr, now)
+		s.mu.Unlock()
+	}
+
+	s.mu.Lock()
+	due := false
+	switch {
+	case run.desired != DesiredRunning:
+		if run.state == RunBackoff {
+			run.state = RunStopped
+		}
+	case run.state == RunStopped:
+		due = true
+	case run.state == RunBackoff:
+		due = !now.Before(run.nextRestartAt)
+	}
+	s.mu.Unlock()
+
+	if !due {
+		return nil
+	}
+
+	err := s.runner.Start(spec)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err != nil {
+		s.exited(run, err, now)
+		return fmt.Errorf("failed to start: %w", err)
+	}
+	run.state = RunRunning
+	run.startedAt = now
+	run.nextRestartAt = time.Time{}
+	s.logger.Info("Started run %s (restarts: %d)", name, run.restarts)
+	return nil
+}
+
+// exited applies the restart policy to a run that stopped or failed to
+// start; a nil err is a clean exit. Callers hold mu.
+func (s *runSupervisor) exited(run *supervisedRun, err error, now time.Time) {
+	policy := run.spec.Policy
+	clean := err == nil
+	if clean {
+		run.lastError = ""
+	} else {
+		run.lastError = err.Error()
+	}
+
+	restart := policy.Restart == RestartAlways || policy.Restart == RestartOnFailure && !clean
+	if !restart {
+		run.desired = DesiredStopped
+		run.state = RunStopped
+		if !clean {
+			run.state = RunFailed
+		}
+		s.logger.Info("Run %s exited and its %s policy does not restart it", run.spec.Name, policy.Restart)
+		return
+	}
+
+	if run.restarts >= policy.MaxRestarts {
+		run.desired = DesiredStopped
+		run.state = RunFailed
+		s.logger.Error("Run %s exited after %d restarts, giving up", run.spec.Name, run.restarts)
+		return
+	}
+
+	delay := policy.backoff(run.restarts)
+	run.restarts++
+	run.state = RunBackoff
+	run.nextRestartAt = now.Add(delay)
+	s.logger.Warn("Run %s exited, restarting in %s (attempt %d/%d)", run.spec.Name, delay, run.restarts, policy.MaxRestarts)
+}
+
+func (s *runSupervisor) Status(name string) (RunStatus, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	run, ok := s.runs[name]
+	if !ok {
+		return RunStatus{}, false
+	}
+	return run.status(), true
+}
+
+func (s *runSupervisor) Statuses() []RunStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	statuses := make([]RunStatus, 0, len(s.runs))
+	for _, run := range s.runs {
+		statuses = append(statuses, run.status())
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+	return statuses
+}
+
+func (r *supervisedRun) status() RunStatus {
+	return RunStatus{
+		Name:          r.spec.Name,
+		Desired:       r.desired,
+		State:         r.state,
+		Restarts:      r.restarts,
+		LastError:     r.lastError,
+		StartedAt:     r.startedAt,
+		NextRestartAt: r.nextRestartAt,
+	}
+}
+
+func (s *runSupervisor) state(run *supervisedRun) RunState {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return run.state
+}
+
+func (s *runSupervisor) names() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	names := make([]string, 0, len(s.runs))
+	for name := range s.runs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// saveLocked persists every registered run's desired state, keeping
+// entries for runs not registered this boot. Callers hold mu.
+func (s *runSupervisor) saveLocked() error {
+	now := s.timeProvider.Now()
+	for name, run := range s.runs {
+		saved, ok := s.persisted[name]
+		if ok && saved.Desired == run.desired && saved.Restarts == run.restarts {
+			continue
+		}
+		s.persisted[name] = persistedRun{
+			Name:      name,
+			Desired:   run.desired,
+			Restarts:  run.restarts,
+			UpdatedAt: now,
+		}
+	}
+	return saveRunState(s.statePath, s.persisted)
+}
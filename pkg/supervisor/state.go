@@ -0,0 +1,78 @@
+package supervisor
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// DesiredState is whether a run should be up. It survives host reboots, so
+// a run that was wanted running is started again once it is registered.
+type DesiredState string
+
+const (
+	DesiredRunning DesiredState = "running"
+	DesiredStopped DesiredState = "stopped"
+)
+
+// persistedRun is one run's entry in RunStateFileName
+type persistedRun struct {
+	Name      string       `json:"name"`
+	Desired   DesiredState `json:"desired"`
+	Restarts  int          `json:"restarts"`
+	UpdatedAt time.Time    `json:"updated_at"`
+}
+
+func loadRunState(path string) (map[string]persistedRun, error) {
+	runs := make(map[string]persistedRun)
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return runs, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read run state: %w", err)
+	}
+	if len(data) == 0 {
+		return runs, nil
+	}
+
+	var persisted []persistedRun
+	if err := json.Unmarshal(data, &persisted); err != nil {
+		return nil, fmt.Errorf("failed to decode run state: %w", err)
+	}
+	for _, run := range persisted {
+		runs[run.Name] = run
+	}
+	return runs, nil
+}
+
+// saveRunState rewrites the state file through a rename so a crash leaves
+// either the old or the new state
+func saveRunState(path string, runs map[string]persistedRun) error {
+	persisted := make([]persistedRun, 0, len(runs))
+	for _, run := range runs {
+		persisted = append(persisted, run)
+	}
+	sort.Slice(persisted, func(i, j int) bool { return persisted[i].Name < persisted[j].Name })
+
+	data, err := json.MarshalIndent(persisted, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode run state: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("failed to create run state directory: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o640); err != nil {
+		return fmt.Errorf("failed to write run state: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to replace run state: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,297 @@
+package supervisor
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+)
+
+// ExitReason classifies why a supervised process stopped
+type ExitReason string
+
+const (
+	ExitCrashed  ExitReason = "crashed"
+	ExitHung     ExitReason = "hung"
+	ExitDeadlock ExitReason = "deadlock"
+	ExitClean    ExitReason = "clean"
+	ExitStopped  ExitReason = "stopped"
+)
+
+// RestartRecord describes one run of the supervised process
+type RestartRecord struct {
+	Attempt   int
+	StartedAt time.Time
+	ExitedAt  time.Time
+	Reason    ExitReason
+	Err       string
+}
+
+// EscalationHandler is invoked with recent runs when a crash loop is detected
+type EscalationHandler func(records []RestartRecord)
+
+// Supervisor runs the trading process as a child, restarting it when it
+// crashes or stops sending heartbeats
+type Supervisor interface {
+	Configure(config Config) error
+
+	// Run blocks until ctx is done, the child exits cleanly, or a crash
+	// loop is escalated
+	Run(ctx context.Context) error
+
+	History() []RestartRecord
+	SetEscalationHandler(handler EscalationHandler)
+}
+
+type supervisor struct {
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	config   Config
+	escalate EscalationHandler
+	history  []RestartRecord
+	mu       sync.Mutex
+}
+
+func NewSupervisor(
+	timeProvider temporal.TimeProvider,
+	logger logging.ApplicationLogger,
+) Supervisor {
+	return &supervisor{
+		timeProvider: timeProvider,
+		logger:       logger,
+	}
+}
+
+func (s *supervisor) Configure(config Config) error {
+	if config.Command == "" {
+		return fmt.Errorf("supervised command is required")
+	}
+
+	defaults := DefaultConfig(config.Command)
+	if config.StartupGrace <= 0 {
+		config.StartupGrace = defaults.StartupGrace
+	}
+	if config.HeartbeatTimeout <= 0 {
+		config.HeartbeatTimeout = defaults.HeartbeatTimeout
+	}
+	if config.DumpGracePeriod <= 0 {
+		config.DumpGracePeriod = defaults.DumpGracePeriod
+	}
+	if config.MaxRestarts <= 0 {
+		config.MaxRestarts = defaults.MaxRestarts
+	}
+	if config.RestartWindow <= 0 {
+		config.RestartWindow = defaults.RestartWindow
+	}
+	if config.InitialBackoff <= 0 {
+		config.InitialBackoff = defaults.InitialBackoff
+	}
+	if config.MaxBackoff < config.InitialBackoff {
+		config.MaxBackoff = defaults.MaxBackoff
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.config = config
+	return nil
+}
+
+func (s *supervisor) SetEscalationHandler(handler EscalationHandler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.escalate = handler
+}
+
+func (s *supervisor) History() []RestartRecord {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]RestartRecord(nil), s.history...)
+}
+
+func (s *supervisor) Run(ctx context.Context) error {
+	s.mu.Lock()
+	config := s.config
+	s.mu.Unlock()
+
+	if config.Command == "" {
+		return fmt.Errorf("supervisor not configured")
+	}
+
+	backoff := config.InitialBackoff
+	var lastExit ExitReason
+
+	for attempt := 0; ; attempt++ {
+		record := RestartRecord{Attempt: attempt, StartedAt: s.timeProvider.Now()}
+		reason, err := s.runOnce(ctx, config, attempt, lastExit)
+		record.ExitedAt = s.timeProvider.Now()
+		record.Reason = reason
+		if err != nil {
+			record.Err = err.Error()
+		}
+		recent := s.addRecord(record, config.RestartWindow)
+
+		switch reason {
+		case ExitStopped:
+			return ctx.Err()
+		case ExitClean:
+			s.logger.Info("Supervised process exited cleanly")
+			return nil
+		}
+
+		s.logger.Error("🔁 Supervised process %s (attempt %d): %v", reason, attempt, err)
+
+		if len(recent) >= config.MaxRestarts {
+			s.logger.Error("🚨 Crash loop: %d failures within %s, not restarting", len(recent), config.RestartWindow)
+			s.mu.Lock()
+			escalate := s.escalate
+			s.mu.Unlock()
+			if escalate != nil {
+				escalate(recent)
+			}
+			return fmt.Errorf("crash loop detected: %d failures within %s", len(recent), config.RestartWindow)
+		}
+
+		// A run that outlived the window was healthy; start backing off afresh
+		if record.ExitedAt.Sub(record.StartedAt) >= config.RestartWindow {
+			backoff = config.InitialBackoff
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > config.MaxBackoff {
+			backoff = config.MaxBackoff
+		}
+		lastExit = reason
+	}
+}
+
+// addRecord appends to history and returns the failures inside the window
+func (s *supervisor) addRecord(record RestartRecord, window time.Duration) []RestartRecord {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.history = append(s.history, record)
+
+	var recent []RestartRecord
+	for _, r := range s.history {
+		if r.Reason == ExitClean || r.Reason == ExitStopped {
+			continue
+		}
+		if record.ExitedAt.Sub(r.ExitedAt) <= window {
+			recent = append(recent, r)
+		}
+	}
+	return recent
+}
+
+// runOnce starts the child and watches it until it exits or is judged unhealthy
+func (s *supervisor) runOnce(ctx context.Context, config Config, attempt int, lastExit ExitReason) (ExitReason, error) {
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		return ExitCrashed, fmt.Errorf("failed to create heartbeat pipe: %w", err)
+	}
+	defer reader.Close()
+
+	cmd := exec.Command(config.Command, config.Args...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.ExtraFiles = []*os.File{writer}
+	cmd.Env = append(os.Environ(), config.Env...)
+	cmd.Env = append(cmd.Env,
+		HeartbeatFDEnv+"=3",
+		RestartCountEnv+"="+strconv.Itoa(attempt),
+		LastExitEnv+"="+string(lastExit),
+	)
+
+	if err := cmd.Start(); err != nil {
+		writer.Close()
+		return ExitCrashed, fmt.Errorf("failed to start %s: %w", config.Command, err)
+	}
+	writer.Close()
+
+	s.logger.Info("▶️  Started supervised process %s (pid %d, attempt %d)", config.Command, cmd.Process.Pid, attempt)
+
+	heartbeats := make(chan Heartbeat, 100)
+	go readHeartbeats(reader, heartbeats)
+
+	exited := make(chan error, 1)
+	go func() {
+		exited <- cmd.Wait()
+	}()
+
+	deadline := time.NewTimer(config.StartupGrace)
+	defer deadline.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.terminate(cmd, exited, syscall.SIGTERM, config.DumpGracePeriod)
+			return ExitStopped, ctx.Err()
+
+		case err := <-exited:
+			if err == nil {
+				return ExitClean, nil
+			}
+			return ExitCrashed, err
+
+		case beat := <-heartbeats:
+			if config.MaxGoroutines > 0 && beat.Goroutines > config.MaxGoroutines {
+				s.terminate(cmd, exited, syscall.SIGQUIT, config.DumpGracePeriod)
+				return ExitDeadlock, fmt.Errorf("%d goroutines exceeds limit of %d", beat.Goroutines, config.MaxGoroutines)
+			}
+			deadline.Reset(config.HeartbeatTimeout)
+
+		case <-deadline.C:
+			// SIGQUIT makes the Go runtime dump every goroutine to stderr
+			s.terminate(cmd, exited, syscall.SIGQUIT, config.DumpGracePeriod)
+			return ExitHung, fmt.Errorf("no heartbeat within %s", config.HeartbeatTimeout)
+		}
+	}
+}
+
+// terminate signals the child, then kills it if it has not exited within grace
+func (s *supervisor) terminate(cmd *exec.Cmd, exited <-chan error, signal os.Signal, grace time.Duration) {
+	if err := cmd.Process.Signal(signal); err != nil {
+		s.logger.Warn("Failed to signal supervised process: %v", err)
+	}
+
+	select {
+	case <-exited:
+		return
+	case <-time.After(grace):
+	}
+
+	if err := cmd.Process.Kill(); err != nil {
+		s.logger.Warn("Failed to kill supervised process: %v", err)
+	}
+	<-exited
+}
+
+func readHeartbeats(reader *os.File, heartbeats chan<- Heartbeat) {
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		var beat Heartbeat
+		if err := json.Unmarshal(scanner.Bytes(), &beat); err != nil {
+			continue
+		}
+		select {
+		case heartbeats <- beat:
+		default:
+		}
+	}
+}
@@ -0,0 +1,208 @@
+package base
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	"github.com/backtesting-org/kronos-sdk/pkg/types/temporal"
+)
+
+// DefaultKlineFlushInterval is how often finished bars are checked for, which
+// bounds how late a bar with no trade after its close is published
+const DefaultKlineFlushInterval = time.Second
+
+// TradeToKlineAggregator builds OHLCV bars from a trade stream for venues
+// that do not stream candles. Bars are aligned to wall-clock boundaries in
+// UTC and published once their interval has ended by the TimeProvider's
+// clock; an interval with no trades produces no bar.
+type TradeToKlineAggregator interface {
+	// Track starts building bars of interval, e.g. "30s", "1m", "4h", "1d",
+	// for an exchange symbol
+	Track(symbol, interval string) error
+	Untrack(symbol, interval string)
+
+	// AddTrade folds a trade into every tracked bar for its symbol. Trades
+	// older than a symbol's open bar are dropped.
+	AddTrade(symbol string, price, quantity numerical.Decimal, at time.Time)
+
+	// Start publishes finished bars until ctx is done
+	Start(ctx context.Context)
+
+	// Flush publishes every bar whose interval has ended
+	Flush()
+
+	Klines() <-chan connector.Kline
+}
+
+type barKey struct {
+	symbol   string
+	interval string
+}
+
+type bar struct {
+	period time.Duration
+	kline  *connector.Kline
+}
+
+type tradeToKlineAggregator struct {
+	timeProvider temporal.TimeProvider
+	logger       logging.ApplicationLogger
+
+	bars   map[barKey]*bar
+	output chan connector.Kline
+	mu     sync.Mutex
+}
+
+func NewTradeToKlineAggregator(timeProvider temporal.TimeProvider, logger logging.ApplicationLogger) TradeToKlineAggregator {
+	return &tradeToKlineAggregator{
+		timeProvider: timeProvider,
+		logger:       logger,
+		bars:         make(map[barKey]*bar),
+		output:       make(chan connector.Kline, 1000),
+	}
+}
+
+func (a *tradeToKlineAggregator) Track(symbol, interval string) error {
+	period, err := parseKlineInterval(interval)
+	if err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	key := barKey{symbol, interval}
+	if _, ok := a.bars[key]; !ok {
+		a.bars[key] = &bar{period: period}
+	}
+	return nil
+}
+
+func (a *tradeToKlineAggregator) Untrack(symbol, interval string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	delete(a.bars, barKey{symbol, interval})
+}
+
+func (a *tradeToKlineAggregator) AddTrade(symbol string, price, quantity numerical.Decimal, at time.Time) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for key, b := range a.bars {
+		if key.symbol != symbol {
+			continue
+		}
+
+		openTime := at.UTC().Truncate(b.period)
+		if b.kline != nil {
+			if openTime.Before(b.kline.OpenTime) {
+				a.logger.Debug("Dropped late %s trade at %s for %s bar", symbol, at, key.interval)
+				continue
+			}
+			if openTime.After(b.kline.OpenTime) {
+				a.publish(*b.kline)
+				b.kline = nil
+			}
+		}
+
+		if b.kline == nil {
+			b.kline = &connector.Kline{
+				Symbol:      symbol,
+				Interval:    key.interval,
+				OpenTime:    openTime,
+				CloseTime:   openTime.Add(b.period),
+				Open:        price,
+				High:        price,
+				Low:         price,
+				Volume:      numerical.Zero(),
+				QuoteVolume: numerical.Zero(),
+				TakerVolume: numerical.Zero(),
+			}
+		}
+
+		k := b.kline
+		if price.GreaterThan(k.High) {
+			k.High = price
+		}
+		if price.LessThan(k.Low) {
+			k.Low = price
+		}
+		k.Close = price
+		k.Volume = k.Volume.Add(quantity)
+		k.QuoteVolume = k.QuoteVolume.Add(quantity.Mul(price))
+		k.TradeCount++
+	}
+}
+
+func (a *tradeToKlineAggregator) Start(ctx context.Context) {
+	ticker := a.timeProvider.NewTicker(DefaultKlineFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C():
+			a.Flush()
+		}
+	}
+}
+
+func (a *tradeToKlineAggregator) Flush() {
+	now := a.timeProvider.Now()
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, b := range a.bars {
+		if b.kline != nil && !now.Before(b.kline.CloseTime) {
+			a.publish(*b.kline)
+			b.kline = nil
+		}
+	}
+}
+
+func (a *tradeToKlineAggregator) Klines() <-chan connector.Kline {
+	return a.output
+}
+
+// publish never blocks the trade stream; a full channel drops the bar
+func (a *tradeToKlineAggregator) publish(kline connector.Kline) {
+	select {
+	case a.output <- kline:
+	default:
+		a.logger.Warn("Kline channel full, dropping %s %s bar at %s", kline.Symbol, kline.Interval, kline.OpenTime)
+	}
+}
+
+// parseKlineInterval accepts a count followed by s, m, h, d or w
+func parseKlineInterval(interval string) (time.Duration, error) {
+	if len(interval) < 2 {
+		return 0, fmt.Errorf("unsupported kline interval %q", interval)
+	}
+
+	count, err := strconv.Atoi(interval[:len(interval)-1])
+	if err != nil || count <= 0 {
+		return 0, fmt.Errorf("unsupported kline interval %q", interval)
+	}
+
+	units := map[string]time.Duration{
+		"s": time.Second,
+		"m": time.Minute,
+		"h": time.Hour,
+		"d": 24 * time.Hour,
+		"w": 7 * 24 * time.Hour,
+	}
+	unit, ok := units[strings.ToLower(interval[len(interval)-1:])]
+	if !ok || interval[len(interval)-1:] == "M" {
+		return 0, fmt.Errorf("unsupported kline interval %q", interval)
+	}
+	return time.Duration(count) * unit, nil
+}
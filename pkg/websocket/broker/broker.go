@@ -0,0 +1,135 @@
+package broker
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+)
+
+type consumer struct {
+	id      int
+	handler Handler
+}
+
+type subscriptionBroker struct {
+	upstream  Upstream
+	consumers map[Key][]consumer
+	nextID    int
+	logger    logging.ApplicationLogger
+	mu        sync.RWMutex
+}
+
+// NewSubscriptionBroker creates a broker; an upstream must be set before Attach
+func NewSubscriptionBroker(logger logging.ApplicationLogger) SubscriptionBroker {
+	return &subscriptionBroker{
+		consumers: make(map[Key][]consumer),
+		logger:    logger,
+	}
+}
+
+func (b *subscriptionBroker) SetUpstream(upstream Upstream) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.upstream = upstream
+}
+
+func (b *subscriptionBroker) Attach(key Key, handler Handler) (int, error) {
+	if handler == nil {
+		return 0, fmt.Errorf("handler cannot be nil")
+	}
+
+	// Held across the upstream call so concurrent first attaches cannot
+	// both subscribe
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.upstream == nil {
+		return 0, fmt.Errorf("subscription broker has no upstream")
+	}
+
+	if len(b.consumers[key]) == 0 {
+		if err := b.upstream.Subscribe(key); err != nil {
+			return 0, fmt.Errorf("failed to subscribe %s: %w", key, err)
+		}
+		b.logger.Debug("Broker subscribed upstream %s", key)
+	}
+
+	b.nextID++
+	b.consumers[key] = append(b.consumers[key], consumer{id: b.nextID, handler: handler})
+
+	return b.nextID, nil
+}
+
+func (b *subscriptionBroker) Detach(key Key, consumerID int) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	consumers := b.consumers[key]
+	index := -1
+	for i, c := range consumers {
+		if c.id == consumerID {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return fmt.Errorf("consumer %d not attached to %s", consumerID, key)
+	}
+
+	remaining := make([]consumer, 0, len(consumers)-1)
+	remaining = append(remaining, consumers[:index]...)
+	remaining = append(remaining, consumers[index+1:]...)
+
+	if len(remaining) > 0 {
+		b.consumers[key] = remaining
+		return nil
+	}
+
+	delete(b.consumers, key)
+
+	if b.upstream != nil {
+		if err := b.upstream.Unsubscribe(key); err != nil {
+			return fmt.Errorf("failed to unsubscribe %s: %w", key, err)
+		}
+		b.logger.Debug("Broker unsubscribed upstream %s", key)
+	}
+
+	return nil
+}
+
+func (b *subscriptionBroker) Publish(key Key, message interface{}) int {
+	b.mu.RLock()
+	consumers := b.consumers[key]
+	b.mu.RUnlock()
+
+	// Handlers run outside the lock so they may attach or detach; the slice
+	// is never mutated in place, so this snapshot stays valid
+	for _, c := range consumers {
+		c.handler(message)
+	}
+
+	return len(consumers)
+}
+
+func (b *subscriptionBroker) RefCount(key Key) int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return len(b.consumers[key])
+}
+
+func (b *subscriptionBroker) Keys() []Key {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	keys := make([]Key, 0, len(b.consumers))
+	for key := range b.consumers {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+	return keys
+}
@@ -0,0 +1,44 @@
+package broker
+
+import "fmt"
+
+// Key identifies one upstream subscription shared by every consumer of it
+type Key struct {
+	Channel  string
+	Coin     string
+	Interval string
+}
+
+func (k Key) String() string {
+	return fmt.Sprintf("%s:%s:%s", k.Channel, k.Coin, k.Interval)
+}
+
+// Handler receives messages published for a key
+type Handler func(message interface{})
+
+// Upstream sends the actual subscribe/unsubscribe requests to the exchange
+type Upstream interface {
+	Subscribe(key Key) error
+	Unsubscribe(key Key) error
+}
+
+// SubscriptionBroker reference-counts subscriptions per key so that many
+// consumers share one upstream subscription
+type SubscriptionBroker interface {
+	SetUpstream(upstream Upstream)
+
+	// Attach adds a consumer, subscribing upstream only for the first one
+	Attach(key Key, handler Handler) (int, error)
+
+	// Detach removes a consumer, unsubscribing upstream after the last one
+	Detach(key Key, consumerID int) error
+
+	// Publish fans a message out to every consumer of the key and returns
+	// how many received it
+	Publish(key Key, message interface{}) int
+
+	RefCount(key Key) int
+
+	// Keys returns every key with at least one consumer
+	Keys() []Key
+}
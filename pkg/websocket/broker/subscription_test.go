@@ -0,0 +1,129 @@
+package broker_test
+
+import (
+	"errors"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	logger "github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	mockbroker "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/websocket/broker"
+	"github.com/backtesting-org/live-trading/pkg/websocket/broker"
+)
+
+var _ = Describe("SubscriptionBroker", func() {
+	var (
+		upstream *mockbroker.Upstream
+		b        broker.SubscriptionBroker
+	)
+
+	btc := broker.Key{Channel: "l2Book", Coin: "BTC"}
+	eth := broker.Key{Channel: "l2Book", Coin: "ETH"}
+	ignore := func(interface{}) {}
+
+	BeforeEach(func() {
+		upstream = mockbroker.NewUpstream(GinkgoT())
+		b = broker.NewSubscriptionBroker(logger.NewNoOpLogger())
+		b.SetUpstream(upstream)
+	})
+
+	It("refuses to attach without an upstream", func() {
+		unwired := broker.NewSubscriptionBroker(logger.NewNoOpLogger())
+		_, err := unwired.Attach(btc, ignore)
+		Expect(err).To(MatchError(ContainSubstring("no upstream")))
+	})
+
+	It("refuses a nil handler", func() {
+		_, err := b.Attach(btc, nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("subscribes upstream once for many consumers and unsubscribes after the last", func() {
+		upstream.On("Subscribe", btc).Return(nil).Once()
+
+		first, err := b.Attach(btc, ignore)
+		Expect(err).NotTo(HaveOccurred())
+		second, err := b.Attach(btc, ignore)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(second).NotTo(Equal(first))
+		Expect(b.RefCount(btc)).To(Equal(2))
+
+		Expect(b.Detach(btc, first)).To(Succeed())
+		Expect(b.RefCount(btc)).To(Equal(1))
+
+		upstream.On("Unsubscribe", btc).Return(nil).Once()
+		Expect(b.Detach(btc, second)).To(Succeed())
+		Expect(b.RefCount(btc)).To(BeZero())
+		Expect(b.Keys()).To(BeEmpty())
+	})
+
+	It("attaches no consumer when the upstream subscribe fails", func() {
+		upstream.On("Subscribe", btc).Return(errors.New("rejected")).Once()
+
+		_, err := b.Attach(btc, ignore)
+		Expect(err).To(MatchError(ContainSubstring("rejected")))
+		Expect(b.RefCount(btc)).To(BeZero())
+	})
+
+	It("refuses to detach a consumer that is not attached", func() {
+		upstream.On("Subscribe", btc).Return(nil).Once()
+		id, err := b.Attach(btc, ignore)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(b.Detach(btc, id+1)).To(MatchError(ContainSubstring("not attached")))
+		Expect(b.Detach(eth, id)).To(HaveOccurred())
+		Expect(b.RefCount(btc)).To(Equal(1))
+	})
+
+	It("publishes only to the key's consumers", func() {
+		upstream.On("Subscribe", btc).Return(nil).Once()
+		upstream.On("Subscribe", eth).Return(nil).Once()
+
+		var received []interface{}
+		_, err := b.Attach(btc, func(message interface{}) { received = append(received, message) })
+		Expect(err).NotTo(HaveOccurred())
+		_, err = b.Attach(eth, ignore)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(b.Publish(btc, "book")).To(Equal(1))
+		Expect(b.Publish(broker.Key{Channel: "trades", Coin: "BTC"}, "trade")).To(BeZero())
+		Expect(received).To(Equal([]interface{}{"book"}))
+		Expect(b.Keys()).To(Equal([]broker.Key{btc, eth}))
+	})
+
+	It("lets a handler detach itself while publishing", func() {
+		upstream.On("Subscribe", btc).Return(nil).Once()
+		upstream.On("Unsubscribe", btc).Return(nil).Once()
+
+		var id int
+		calls := 0
+		id, err := b.Attach(btc, func(interface{}) {
+			calls++
+			Expect(b.Detach(btc, id)).To(Succeed())
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(b.Publish(btc, "book")).To(Equal(1))
+		Expect(b.Publish(btc, "book")).To(BeZero())
+		Expect(calls).To(Equal(1))
+	})
+
+	It("subscribes upstream once when consumers attach concurrently", func() {
+		upstream.On("Subscribe", btc).Return(nil).Once()
+
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+				_, err := b.Attach(btc, ignore)
+				Expect(err).NotTo(HaveOccurred())
+			}()
+		}
+		wg.Wait()
+
+		Expect(b.RefCount(btc)).To(Equal(20))
+	})
+})
@@ -44,6 +44,10 @@ type Config struct {
 	EnableHealthPings      bool          `json:"enable_health_pings"`
 	HealthCheckInterval    time.Duration `json:"health_check_interval"`
 	HealthCheckTimeout     time.Duration `json:"health_check_timeout"`
+
+	LowLatency LowLatencyConfig `json:"low_latency"`
+
+	StateWatchdog StateWatchdogConfig `json:"state_watchdog"`
 }
 
 // DefaultConfig returns a configuration with sensible defaults
@@ -71,6 +75,8 @@ func DefaultConfig() Config {
 		EnableHealthPings:      true,
 		HealthCheckInterval:    30 * time.Second,
 		HealthCheckTimeout:     10 * time.Second,
+		LowLatency:             LowLatencyFromEnv(),
+		StateWatchdog:          DefaultStateWatchdogConfig(30 * time.Second),
 	}
 }
 
@@ -161,6 +167,25 @@ func (c *Config) ApplyDefaults() {
 	if c.HealthCheckTimeout == 0 {
 		c.HealthCheckTimeout = defaults.HealthCheckTimeout
 	}
+
+	if c.StateWatchdog.Enabled {
+		watchdog := DefaultStateWatchdogConfig(c.ConnectTimeout)
+		if c.StateWatchdog.CheckInterval == 0 {
+			c.StateWatchdog.CheckInterval = watchdog.CheckInterval
+		}
+		if c.StateWatchdog.MaxDwell == nil {
+			c.StateWatchdog.MaxDwell = watchdog.MaxDwell
+		}
+	}
+
+	if c.LowLatency.Enabled {
+		if c.LowLatency.DNSRefreshInterval == 0 {
+			c.LowLatency.DNSRefreshInterval = DefaultDNSRefreshInterval
+		}
+		if c.LowLatency.StandbyMaxAge == 0 {
+			c.LowLatency.StandbyMaxAge = DefaultStandbyMaxAge
+		}
+	}
 }
 
 // TradingConfig returns a configuration optimized for trading applications
@@ -186,5 +211,6 @@ func TestConfig(url string) Config {
 	config.MaxReconnects = 3
 	config.ReconnectDelay = time.Second
 	config.RequireSSL = false // Allow non-SSL for testing
+	config.LowLatency = LowLatencyConfig{}
 	return config
 }
@@ -0,0 +1,155 @@
+package connection
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+)
+
+const slotPrefix = "\x00slot:"
+
+// Slot marks a value in a message passed to CompileFrame that changes on
+// every send. The same slot may appear more than once.
+func Slot(index int) string {
+	return slotPrefix + strconv.Itoa(index) + "\x00"
+}
+
+// FrameTemplate is a JSON message marshalled once, so sending it only
+// splices the per-send values into the pre-encoded bytes. Hot paths such
+// as subscriptions and order frames skip reflection entirely.
+type FrameTemplate struct {
+	parts [][]byte
+	slots []int
+	size  int
+}
+
+// CompileFrame marshals message with its Slot placeholders left open
+func CompileFrame(message interface{}) (*FrameTemplate, error) {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal frame template: %w", err)
+	}
+
+	// Marshal escapes the NUL delimiters, so placeholders are found by their
+	// encoded form including the quotes around them
+	open := []byte(`"\u0000slot:`)
+	closing := []byte(`\u0000"`)
+
+	template := &FrameTemplate{}
+	for {
+		start := bytes.Index(data, open)
+		if start < 0 {
+			break
+		}
+		end := bytes.Index(data[start+len(open):], closing)
+		if end < 0 {
+			return nil, fmt.Errorf("malformed slot in frame template")
+		}
+		end += start + len(open)
+
+		index, err := strconv.Atoi(string(data[start+len(open) : end]))
+		if err != nil {
+			return nil, fmt.Errorf("malformed slot in frame template: %w", err)
+		}
+
+		template.parts = append(template.parts, data[:start])
+		template.slots = append(template.slots, index)
+		template.size += start
+		data = data[end+len(closing):]
+	}
+	template.parts = append(template.parts, data)
+	template.size += len(data)
+	return template, nil
+}
+
+// MustCompileFrame is CompileFrame for package-level templates
+func MustCompileFrame(message interface{}) *FrameTemplate {
+	template, err := CompileFrame(message)
+	if err != nil {
+		panic(err)
+	}
+	return template
+}
+
+// Render fills the slots, values[i] going to Slot(i). Strings encode as
+// JSON strings and integers and floats as numbers; fmt.Stringer values such
+// as decimals encode as strings, the way exchanges expect prices and sizes.
+func (t *FrameTemplate) Render(values ...interface{}) ([]byte, error) {
+	out := make([]byte, 0, t.size+32*len(t.slots))
+	for i, slot := range t.slots {
+		out = append(out, t.parts[i]...)
+		if slot >= len(values) {
+			return nil, fmt.Errorf("frame template slot %d has no value", slot)
+		}
+
+		var err error
+		out, err = appendValue(out, values[slot])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return append(out, t.parts[len(t.parts)-1]...), nil
+}
+
+func appendValue(out []byte, value interface{}) ([]byte, error) {
+	switch v := value.(type) {
+	case string:
+		return appendString(out, v), nil
+	case int:
+		return strconv.AppendInt(out, int64(v), 10), nil
+	case int64:
+		return strconv.AppendInt(out, v, 10), nil
+	case float64:
+		return strconv.AppendFloat(out, v, 'f', -1, 64), nil
+	case bool:
+		return strconv.AppendBool(out, v), nil
+	case fmt.Stringer:
+		return appendString(out, v.String()), nil
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal frame value: %w", err)
+		}
+		return append(out, data...), nil
+	}
+}
+
+// appendString appends s as a JSON string, escaping what encoding/json
+// requires; HTML characters are left as they are
+func appendString(out []byte, s string) []byte {
+	const hex = "0123456789abcdef"
+
+	out = append(out, '"')
+	for i := 0; i < len(s); {
+		c := s[i]
+		if c < utf8.RuneSelf {
+			switch {
+			case c == '"' || c == '\\':
+				out = append(out, '\\', c)
+			case c >= 0x20:
+				out = append(out, c)
+			case c == '\n':
+				out = append(out, '\\', 'n')
+			case c == '\r':
+				out = append(out, '\\', 'r')
+			case c == '\t':
+				out = append(out, '\\', 't')
+			default:
+				out = append(out, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xf])
+			}
+			i++
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			out = append(out, `\ufffd`...)
+		} else {
+			out = append(out, s[i:i+size]...)
+		}
+		i += size
+	}
+	return append(out, '"')
+}
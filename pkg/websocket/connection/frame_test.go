@@ -0,0 +1,93 @@
+package connection_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/backtesting-org/kronos-sdk/pkg/types/kronos/numerical"
+	"github.com/backtesting-org/live-trading/pkg/websocket/connection"
+)
+
+func subscribeMessage(id interface{}, channel interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      id,
+		"method":  "subscribe",
+		"params": map[string]interface{}{
+			"channel": channel,
+		},
+	}
+}
+
+var _ = Describe("FrameTemplate", func() {
+	It("renders the same bytes as encoding/json", func() {
+		template, err := connection.CompileFrame(subscribeMessage(connection.Slot(0), connection.Slot(1)))
+		Expect(err).NotTo(HaveOccurred())
+
+		frame, err := template.Render(int64(42), "trades.BTC-USD-PERP")
+		Expect(err).NotTo(HaveOccurred())
+
+		expected, err := json.Marshal(subscribeMessage(42, "trades.BTC-USD-PERP"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(frame)).To(Equal(string(expected)))
+	})
+
+	It("escapes strings the way encoding/json does", func() {
+		template := connection.MustCompileFrame(map[string]string{"symbol": connection.Slot(0)})
+
+		for _, value := range []string{`quote " and \ slash`, "tab\tline\nnul\x00", "naïve ✓"} {
+			frame, err := template.Render(value)
+			Expect(err).NotTo(HaveOccurred())
+
+			var decoded map[string]string
+			Expect(json.Unmarshal(frame, &decoded)).To(Succeed())
+			Expect(decoded["symbol"]).To(Equal(value))
+		}
+	})
+
+	It("encodes decimals as strings and repeats a slot wherever it appears", func() {
+		template := connection.MustCompileFrame(map[string]string{
+			"price":     connection.Slot(0),
+			"trigger":   connection.Slot(0),
+			"client_id": connection.Slot(1),
+		})
+
+		frame, err := template.Render(numerical.NewFromFloat(101.5), "abc")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(frame)).To(Equal(`{"client_id":"abc","price":"101.5","trigger":"101.5"}`))
+	})
+
+	It("fails when a slot has no value", func() {
+		template := connection.MustCompileFrame(subscribeMessage(connection.Slot(0), connection.Slot(1)))
+
+		_, err := template.Render(int64(1))
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+// The benchmarks document what low-latency mode saves per frame:
+//
+//	go test ./pkg/websocket/connection -run '^$' -bench Frame -benchmem
+func BenchmarkFrameMarshal(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := json.Marshal(subscribeMessage(int64(i), "trades.BTC-USD-PERP")); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkFrameTemplate(b *testing.B) {
+	template := connection.MustCompileFrame(subscribeMessage(connection.Slot(0), connection.Slot(1)))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := template.Render(int64(i), "trades.BTC-USD-PERP"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
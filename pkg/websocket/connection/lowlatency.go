@@ -0,0 +1,270 @@
+package connection
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// LowLatencyEnv switches low-latency mode on for every connection built
+// from DefaultConfig, for deployments where connect and reconnect time matters
+const LowLatencyEnv = "LIVE_TRADING_LOW_LATENCY"
+
+const (
+	DefaultDNSRefreshInterval = time.Minute
+
+	// DefaultStandbyMaxAge keeps standbys well inside the idle limits
+	// exchanges apply to connections that never answer a ping
+	DefaultStandbyMaxAge = 30 * time.Second
+)
+
+// LowLatencyConfig trades extra connections and a pinned OS thread for
+// shorter connects and steadier reads
+type LowLatencyConfig struct {
+	Enabled bool `json:"enabled"`
+
+	// PreResolveDNS resolves the host when the dialer is built and serves
+	// later dials from the cache, refreshing it in the background
+	PreResolveDNS      bool          `json:"pre_resolve_dns"`
+	DNSRefreshInterval time.Duration `json:"dns_refresh_interval"`
+
+	// WarmStandby keeps one extra connection dialled and handshaken so a
+	// reconnect only has to pick it up. The standby carries the headers of
+	// the dial before it, so auth headers must stay valid for StandbyMaxAge.
+	WarmStandby   bool          `json:"warm_standby"`
+	StandbyMaxAge time.Duration `json:"standby_max_age"`
+
+	// PinReadLoop locks the read goroutine to its OS thread so the scheduler
+	// does not migrate it between messages
+	PinReadLoop bool `json:"pin_read_loop"`
+}
+
+// DefaultLowLatencyConfig switches every low-latency feature on
+func DefaultLowLatencyConfig() LowLatencyConfig {
+	return LowLatencyConfig{
+		Enabled:            true,
+		PreResolveDNS:      true,
+		DNSRefreshInterval: DefaultDNSRefreshInterval,
+		WarmStandby:        true,
+		StandbyMaxAge:      DefaultStandbyMaxAge,
+		PinReadLoop:        true,
+	}
+}
+
+// LowLatencyFromEnv returns DefaultLowLatencyConfig when LowLatencyEnv is
+// true and leaves low-latency mode off otherwise
+func LowLatencyFromEnv() LowLatencyConfig {
+	enabled, err := strconv.ParseBool(os.Getenv(LowLatencyEnv))
+	if err != nil || !enabled {
+		return LowLatencyConfig{}
+	}
+	return DefaultLowLatencyConfig()
+}
+
+type addressEntry struct {
+	addresses  []string
+	resolvedAt time.Time
+	refreshing bool
+}
+
+// addressCache resolves hosts ahead of the dial. A stale entry is still
+// served while it refreshes, so only the very first lookup of a host waits
+// on DNS.
+type addressCache struct {
+	refresh  time.Duration
+	resolver *net.Resolver
+	dialer   net.Dialer
+
+	entries map[string]*addressEntry
+	mu      sync.Mutex
+}
+
+func newAddressCache(refresh time.Duration) *addressCache {
+	return &addressCache{
+		refresh:  refresh,
+		resolver: net.DefaultResolver,
+		entries:  make(map[string]*addressEntry),
+	}
+}
+
+func (c *addressCache) lookup(ctx context.Context, host string) ([]string, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[host]
+	if ok {
+		if time.Since(entry.resolvedAt) > c.refresh && !entry.refreshing {
+			entry.refreshing = true
+			go c.resolve(context.Background(), host)
+		}
+		addresses := entry.addresses
+		c.mu.Unlock()
+		return addresses, nil
+	}
+	c.mu.Unlock()
+
+	return c.resolve(ctx, host)
+}
+
+func (c *addressCache) resolve(ctx context.Context, host string) ([]string, error) {
+	addresses, err := c.resolver.LookupHost(ctx, host)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err != nil || len(addresses) == 0 {
+		// A failed refresh keeps serving the addresses it had
+		if entry, ok := c.entries[host]; ok {
+			entry.refreshing = false
+			return entry.addresses, nil
+		}
+		return nil, err
+	}
+
+	c.entries[host] = &addressEntry{addresses: addresses, resolvedAt: time.Now()}
+	return addresses, nil
+}
+
+// dialContext is a net dial that resolves through the cache. TLS still
+// verifies against the URL's host name, which the websocket dialer passes
+// separately.
+func (c *addressCache) dialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil || net.ParseIP(host) != nil {
+		return c.dialer.DialContext(ctx, network, address)
+	}
+
+	addresses, err := c.lookup(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+
+	var firstErr error
+	for _, ip := range addresses {
+		conn, err := c.dialer.DialContext(ctx, network, net.JoinHostPort(ip, port))
+		if err == nil {
+			return conn, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return nil, firstErr
+}
+
+// standby holds one dialled connection for the URL last dialled, re-dialling
+// it every half StandbyMaxAge so a fresh one is always ready
+type standby struct {
+	dial    func(ctx context.Context, urlStr string, requestHeader http.Header) (WebSocketConn, *http.Response, error)
+	timeout time.Duration
+	maxAge  time.Duration
+
+	url      string
+	header   http.Header
+	conn     WebSocketConn
+	dialedAt time.Time
+	timer    *time.Timer
+	dialing  bool
+	closed   bool
+	hits     int64
+	misses   int64
+	mu       sync.Mutex
+}
+
+func newStandby(dial func(context.Context, string, http.Header) (WebSocketConn, *http.Response, error), timeout, maxAge time.Duration) *standby {
+	return &standby{dial: dial, timeout: timeout, maxAge: maxAge}
+}
+
+// take hands over the standby if it was dialled for urlStr and is still fresh
+func (s *standby) take(urlStr string) (WebSocketConn, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.conn != nil && s.url == urlStr && time.Since(s.dialedAt) < s.maxAge {
+		conn := s.conn
+		s.conn = nil
+		s.hits++
+		return conn, true
+	}
+
+	s.misses++
+	if s.conn != nil {
+		_ = s.conn.Close()
+		s.conn = nil
+	}
+	return nil, false
+}
+
+// replenish dials a new standby in the background
+func (s *standby) replenish(urlStr string, header http.Header) {
+	s.mu.Lock()
+	s.url = urlStr
+	s.header = header.Clone()
+	s.mu.Unlock()
+
+	go s.fill()
+}
+
+func (s *standby) fill() {
+	s.mu.Lock()
+	if s.closed || s.dialing {
+		s.mu.Unlock()
+		return
+	}
+	s.dialing = true
+	urlStr, header := s.url, s.header
+	if s.timer != nil {
+		s.timer.Stop()
+	}
+	s.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	conn, _, err := s.dial(ctx, urlStr, header)
+	cancel()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.dialing = false
+	if s.closed {
+		if err == nil {
+			_ = conn.Close()
+		}
+		return
+	}
+
+	// A failed dial is retried on the next refresh; reconnects dial cold
+	// until then
+	if err == nil {
+		if s.conn != nil {
+			_ = s.conn.Close()
+		}
+		s.conn = conn
+		s.dialedAt = time.Now()
+	}
+	s.timer = time.AfterFunc(s.maxAge/2, s.fill)
+}
+
+func (s *standby) stats() (hits, misses int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.hits, s.misses
+}
+
+func (s *standby) close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.closed = true
+	if s.timer != nil {
+		s.timer.Stop()
+	}
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/url"
+	"runtime"
 	"sync"
 	"time"
 
@@ -55,10 +57,18 @@ type connectionManager struct {
 
 	conn       WebSocketConn
 	state      ConnectionState
+	stateSince time.Time
 	stateMutex sync.RWMutex
 
-	ctx    context.Context
-	cancel context.CancelFunc
+	// dialAttempt is bumped by every Connect and forced teardown; a dial
+	// that completes under an older attempt is discarded
+	dialAttempt      uint64
+	wedgedRecoveries map[ConnectionState]int64
+	watchdogOnce     sync.Once
+
+	parentCtx context.Context
+	ctx       context.Context
+	cancel    context.CancelFunc
 
 	// Goroutine lifecycle management
 	stopCh   chan struct{}  // Closed when user commands disconnect
@@ -82,14 +92,16 @@ func NewConnectionManager(
 	dialer WebSocketDialer,
 ) ConnectionManager {
 	return &connectionManager{
-		config:         config,
-		authManager:    authManager,
-		metrics:        metrics,
-		circuitBreaker: performance.NewCircuitBreaker(3, 30*time.Second),
-		logger:         logger,
-		dialer:         dialer,
-		state:          StateDisconnected,
-		stopCh:         make(chan struct{}),
+		config:           config,
+		authManager:      authManager,
+		metrics:          metrics,
+		circuitBreaker:   performance.NewCircuitBreaker(3, 30*time.Second),
+		logger:           logger,
+		dialer:           dialer,
+		state:            StateDisconnected,
+		stateSince:       time.Now(),
+		wedgedRecoveries: make(map[ConnectionState]int64),
+		stopCh:           make(chan struct{}),
 	}
 }
 
@@ -107,21 +119,41 @@ func (cm *connectionManager) SetCallbacks(
 
 func (cm *connectionManager) Connect(ctx context.Context) error {
 	cm.stateMutex.Lock()
-	defer cm.stateMutex.Unlock()
-
 	if cm.state == StateConnected || cm.state == StateConnecting {
+		cm.stateMutex.Unlock()
 		return fmt.Errorf("already connected or connecting")
 	}
 
 	cm.setState(StateConnecting)
+	cm.parentCtx = ctx
 	cm.ctx, cm.cancel = context.WithCancel(ctx)
+	cm.dialAttempt++
+	attempt, connectCtx := cm.dialAttempt, cm.ctx
+	cm.stateMutex.Unlock()
+
+	cm.startStateWatchdog()
 
-	return cm.circuitBreaker.Execute(func() error {
-		return cm.doConnect()
+	err := cm.circuitBreaker.Execute(func() error {
+		return cm.doConnect(connectCtx, attempt)
 	})
+	if err != nil {
+		// Anything short of a connection, an open breaker included, must
+		// leave StateConnecting or every later Connect is refused
+		cm.stateMutex.Lock()
+		if cm.dialAttempt == attempt && cm.state == StateConnecting {
+			cm.setState(StateFailed)
+		}
+		cm.stateMutex.Unlock()
+	}
+	return err
 }
 
-func (cm *connectionManager) doConnect() error {
+type dialResult struct {
+	conn WebSocketConn
+	err  error
+}
+
+func (cm *connectionManager) doConnect(ctx context.Context, attempt uint64) error {
 	u, err := url.Parse(cm.config.URL)
 	if err != nil {
 		return fmt.Errorf("invalid WebSocket URL: %w", err)
@@ -131,35 +163,67 @@ func (cm *connectionManager) doConnect() error {
 		return fmt.Errorf("insecure WebSocket scheme: %s (must be wss)", u.Scheme)
 	}
 
-	headers, err := cm.authManager.GetSecureHeaders(cm.ctx)
+	headers, err := cm.authManager.GetSecureHeaders(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get auth headers: %w", err)
 	}
 
-	connectCtx, cancel := context.WithTimeout(cm.ctx, cm.config.ConnectTimeout)
+	connectCtx, cancel := context.WithTimeout(ctx, cm.config.ConnectTimeout)
 	defer cancel()
 
-	conn, _, err := cm.dialer.DialContext(connectCtx, u.String(), headers)
-	if err != nil {
-		cm.setState(StateFailed)
-		return fmt.Errorf("failed to connect to WebSocket: %w", err)
+	// The dial runs aside so a dialer that ignores its context cannot hold
+	// the breaker, and with it every later Connect, past ConnectTimeout
+	started := time.Now()
+	dialed := make(chan dialResult, 1)
+	go func() {
+		conn, _, err := cm.dialer.DialContext(connectCtx, u.String(), headers)
+		dialed <- dialResult{conn, err}
+	}()
+
+	var conn WebSocketConn
+	select {
+	case result := <-dialed:
+		if result.err != nil {
+			return fmt.Errorf("failed to connect to WebSocket: %w", result.err)
+		}
+		conn = result.conn
+	case <-connectCtx.Done():
+		go func() {
+			if late := <-dialed; late.conn != nil {
+				late.conn.Close()
+			}
+		}()
+		return fmt.Errorf("failed to connect to WebSocket: %w", connectCtx.Err())
+	}
+	if cm.metrics != nil {
+		cm.metrics.RecordConnectionDuration(time.Since(started))
 	}
 
 	// Set read timeout
 	if err := conn.SetReadDeadline(time.Now().Add(cm.config.ReadTimeout)); err != nil {
+		conn.Close()
 		return fmt.Errorf("failed to set read deadline: %w", err)
 	}
 
+	cm.stateMutex.Lock()
+	if cm.dialAttempt != attempt || cm.state != StateConnecting {
+		cm.stateMutex.Unlock()
+		conn.Close()
+		return fmt.Errorf("connection attempt abandoned while dialling")
+	}
+	// Held until the callback returns, as before the dial moved outside the
+	// lock, so the handlers see the connection only once Connect is done
+	defer cm.stateMutex.Unlock()
 	cm.conn = conn
 	cm.setState(StateConnected)
 	cm.updateLastActivity()
 
 	// Start core connection handlers
-	go cm.readMessages()
+	go cm.readMessages(ctx, conn)
 
 	// Optional: Basic health monitoring (configurable)
 	if cm.config.EnableHealthMonitoring {
-		go cm.simpleHealthMonitor()
+		go cm.simpleHealthMonitor(ctx)
 	}
 
 	if cm.onConnect != nil {
@@ -184,6 +248,8 @@ func (cm *connectionManager) Disconnect() error {
 
 	// Set state to Stopped (user commanded - never reconnect)
 	cm.setState(StateStopped)
+	conn, cancel := cm.conn, cm.cancel
+	cm.conn = nil
 	cm.stateMutex.Unlock()
 
 	cm.logger.Info("User commanded disconnect - stopping all goroutines")
@@ -194,15 +260,21 @@ func (cm *connectionManager) Disconnect() error {
 	})
 
 	// Cancel context
-	if cm.cancel != nil {
-		cm.cancel()
+	if cancel != nil {
+		cancel()
 	}
 
 	// Close connection
 	var err error
-	if cm.conn != nil {
-		err = cm.conn.Close()
-		cm.conn = nil
+	if conn != nil {
+		err = conn.Close()
+	}
+
+	// A warm standby is of no use once the user has stopped the connection
+	if closer, ok := cm.dialer.(io.Closer); ok {
+		if closeErr := closer.Close(); closeErr != nil {
+			cm.logger.Warn("Failed to close dialer standby: %v", closeErr)
+		}
 	}
 
 	// Wait for all goroutines to exit
@@ -289,14 +361,27 @@ func (cm *connectionManager) GetConnectionStats() map[string]interface{} {
 		"state":         cm.state.String(),
 		"connected":     cm.state == StateConnected,
 		"last_activity": lastActivity,
+		"state_since":   cm.stateSince,
 		"url":           cm.config.URL,
 	}
 
+	var recoveries int64
+	for state, count := range cm.wedgedRecoveries {
+		stats["wedged_recoveries_"+state.String()] = count
+		recoveries += count
+	}
+	stats["wedged_recoveries"] = recoveries
+
 	if cm.metrics != nil {
 		for k, v := range cm.metrics.GetStats() {
 			stats[k] = v
 		}
 	}
+	if provider, ok := cm.dialer.(DialerStats); ok {
+		for k, v := range provider.Stats() {
+			stats[k] = v
+		}
+	}
 
 	return stats
 }
@@ -315,24 +400,40 @@ func (cm *connectionManager) IsHealthy() bool {
 }
 
 func (cm *connectionManager) setState(state ConnectionState) {
+	if cm.state != state {
+		cm.stateSince = time.Now()
+	}
 	cm.state = state
 	cm.logger.Debug("Connection state changed to: %s", state.String())
 }
 
+func (cm *connectionManager) isCurrent(conn WebSocketConn) bool {
+	cm.stateMutex.RLock()
+	defer cm.stateMutex.RUnlock()
+	return cm.conn == conn
+}
+
 func (cm *connectionManager) updateLastActivity() {
 	cm.activityMutex.Lock()
 	defer cm.activityMutex.Unlock()
 	cm.lastActivity = time.Now()
 }
 
-func (cm *connectionManager) readMessages() {
+// readMessages reads conn until it fails; a connection replaced by a
+// re-dial is closed under it, which ends the loop
+func (cm *connectionManager) readMessages(ctx context.Context, conn WebSocketConn) {
 	cm.wg.Add(1)
 	defer cm.wg.Done()
 
+	if cm.config.LowLatency.Enabled && cm.config.LowLatency.PinReadLoop {
+		runtime.LockOSThread()
+		defer runtime.UnlockOSThread()
+	}
+
 	defer func() {
 		if r := recover(); r != nil {
 			cm.logger.Error("WebSocket read panic: %v", r)
-			if cm.GetState() != StateStopped {
+			if cm.GetState() != StateStopped && cm.isCurrent(conn) {
 				cm.handleConnectionError()
 			}
 		}
@@ -343,7 +444,7 @@ func (cm *connectionManager) readMessages() {
 		case <-cm.stopCh:
 			cm.logger.Info("Read loop stopping - user disconnect")
 			return
-		case <-cm.ctx.Done():
+		case <-ctx.Done():
 			cm.logger.Debug("Read loop cancelled by context")
 			return
 		default:
@@ -353,13 +454,8 @@ func (cm *connectionManager) readMessages() {
 			return
 		}
 
-		if cm.conn == nil {
-			cm.logger.Debug("Connection is nil, exiting read loop")
-			return
-		}
-
-		cm.conn.SetReadDeadline(time.Now().Add(5 * time.Minute))
-		_, message, err := cm.conn.ReadMessage()
+		conn.SetReadDeadline(time.Now().Add(5 * time.Minute))
+		_, message, err := conn.ReadMessage()
 
 		if err != nil {
 			if cm.GetState() == StateStopped {
@@ -373,7 +469,10 @@ func (cm *connectionManager) readMessages() {
 				cm.logger.Error("WebSocket read error: %v", err)
 			}
 
-			cm.handleConnectionError()
+			// A connection the watchdog already replaced is not this loop's to tear down
+			if cm.isCurrent(conn) {
+				cm.handleConnectionError()
+			}
 			return
 		}
 
@@ -394,7 +493,7 @@ func (cm *connectionManager) readMessages() {
 	}
 }
 
-func (cm *connectionManager) simpleHealthMonitor() {
+func (cm *connectionManager) simpleHealthMonitor(ctx context.Context) {
 	cm.wg.Add(1)
 	defer cm.wg.Done()
 
@@ -408,7 +507,7 @@ func (cm *connectionManager) simpleHealthMonitor() {
 		case <-cm.stopCh:
 			cm.logger.Debug("Health monitor stopping - user disconnect")
 			return
-		case <-cm.ctx.Done():
+		case <-ctx.Done():
 			cm.logger.Debug("Health monitor cancelled by context")
 			return
 		case <-ticker.C:
@@ -455,13 +554,14 @@ func (cm *connectionManager) handleConnectionError() {
 	}
 
 	cm.setState(StateDisconnected)
+	conn := cm.conn
+	cm.conn = nil
 	cm.stateMutex.Unlock()
 
 	cm.logger.Error("WebSocket connection error - transitioning to disconnected state")
 
-	if cm.conn != nil {
-		cm.conn.Close()
-		cm.conn = nil
+	if conn != nil {
+		conn.Close()
 	}
 
 	if cm.metrics != nil {
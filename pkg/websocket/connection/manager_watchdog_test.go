@@ -0,0 +1,133 @@
+package connection_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	logger "github.com/backtesting-org/kronos-sdk/pkg/types/logging"
+	mockconn "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/websocket/connection"
+	mockperf "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/websocket/performance"
+	mocksec "github.com/backtesting-org/live-trading/mocks/github.com/backtesting-org/live-trading/pkg/websocket/security"
+	"github.com/backtesting-org/live-trading/pkg/websocket/connection"
+)
+
+var _ = Describe("ConnectionManager - Wedged State Recovery", func() {
+	var (
+		mgr         connection.ConnectionManager
+		mockAuth    *mocksec.AuthManager
+		mockMetrics *mockperf.Metrics
+		mockDialer  *mockconn.WebSocketDialer
+		mockConn    *mockconn.WebSocketConn
+		ctx         context.Context
+		cancel      context.CancelFunc
+		config      connection.Config
+		release     chan struct{}
+	)
+
+	BeforeEach(func() {
+		mockAuth = mocksec.NewAuthManager(GinkgoT())
+		mockMetrics = mockperf.NewMetrics(GinkgoT())
+		mockDialer = mockconn.NewWebSocketDialer(GinkgoT())
+		mockConn = mockconn.NewWebSocketConn(GinkgoT())
+		ctx, cancel = context.WithCancel(context.Background())
+		release = make(chan struct{})
+
+		mockAuth.On("GetSecureHeaders", mock.Anything).Return(http.Header{}, nil).Maybe()
+		mockMetrics.On("GetStats").Return(map[string]interface{}{}).Maybe()
+		mockMetrics.On("IncrementConnectionError").Return().Maybe()
+		mockMetrics.On("IncrementReceived").Return().Maybe()
+		mockMetrics.On("RecordConnectionDuration", mock.Anything).Return().Maybe()
+
+		mockConn.On("SetReadDeadline", mock.Anything).Return(nil).Maybe()
+		mockConn.On("Close").Return(nil).Maybe()
+		mockConn.On("ReadMessage").Run(func(mock.Arguments) { <-release }).
+			Return(0, []byte{}, errors.New("connection closed")).Maybe()
+
+		config = connection.Config{
+			URL:            "wss://test.example.com/ws",
+			ConnectTimeout: 100 * time.Millisecond,
+			ReadTimeout:    30 * time.Second,
+			WriteTimeout:   10 * time.Second,
+		}
+	})
+
+	AfterEach(func() {
+		close(release)
+		cancel()
+		if mgr != nil {
+			_ = mgr.Disconnect()
+		}
+	})
+
+	Context("when the circuit breaker is open", func() {
+		BeforeEach(func() {
+			mockDialer.On("DialContext", mock.Anything, mock.Anything, mock.Anything).
+				Return(nil, (*http.Response)(nil), errors.New("dial refused")).Times(3)
+			mgr = connection.NewConnectionManager(config, mockAuth, mockMetrics, logger.NewNoOpLogger(), mockDialer)
+		})
+
+		It("should leave StateFailed so later connects are still attempted", func() {
+			for i := 0; i < 3; i++ {
+				Expect(mgr.Connect(ctx)).To(HaveOccurred())
+			}
+
+			err := mgr.Connect(ctx)
+			Expect(err).To(MatchError(ContainSubstring("circuit breaker open")))
+			Expect(mgr.GetState()).To(Equal(connection.StateFailed))
+
+			err = mgr.Connect(ctx)
+			Expect(err).To(MatchError(ContainSubstring("circuit breaker open")))
+		})
+	})
+
+	Context("when the dialer ignores its context", func() {
+		BeforeEach(func() {
+			mockDialer.On("DialContext", mock.Anything, mock.Anything, mock.Anything).
+				Run(func(mock.Arguments) { <-release }).
+				Return(nil, (*http.Response)(nil), errors.New("released")).Once()
+			mgr = connection.NewConnectionManager(config, mockAuth, mockMetrics, logger.NewNoOpLogger(), mockDialer)
+		})
+
+		It("should give up after the connect timeout", func() {
+			started := time.Now()
+			err := mgr.Connect(ctx)
+
+			Expect(err).To(MatchError(ContainSubstring("deadline exceeded")))
+			Expect(time.Since(started)).To(BeNumerically("<", time.Second))
+			Expect(mgr.GetState()).To(Equal(connection.StateFailed))
+		})
+	})
+
+	Context("when a state outstays its maximum dwell", func() {
+		BeforeEach(func() {
+			mockDialer.On("DialContext", mock.Anything, mock.Anything, mock.Anything).
+				Return(nil, (*http.Response)(nil), errors.New("dial refused")).Once()
+			mockDialer.On("DialContext", mock.Anything, mock.Anything, mock.Anything).
+				Return(mockConn, (*http.Response)(nil), nil).Once()
+
+			config.StateWatchdog = connection.StateWatchdogConfig{
+				Enabled:       true,
+				CheckInterval: 10 * time.Millisecond,
+				MaxDwell:      map[connection.ConnectionState]time.Duration{connection.StateFailed: 50 * time.Millisecond},
+			}
+			mgr = connection.NewConnectionManager(config, mockAuth, mockMetrics, logger.NewNoOpLogger(), mockDialer)
+		})
+
+		It("should tear down, re-dial and count the recovery", func() {
+			Expect(mgr.Connect(ctx)).To(HaveOccurred())
+			Expect(mgr.GetState()).To(Equal(connection.StateFailed))
+
+			Eventually(mgr.GetState, time.Second, 10*time.Millisecond).Should(Equal(connection.StateConnected))
+
+			stats := mgr.GetConnectionStats()
+			Expect(stats["wedged_recoveries"]).To(Equal(int64(1)))
+			Expect(stats["wedged_recoveries_failed"]).To(Equal(int64(1)))
+		})
+	})
+})
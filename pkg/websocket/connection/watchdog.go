@@ -0,0 +1,106 @@
+package connection
+
+import "time"
+
+const (
+	// DefaultWatchdogInterval is how often connection state dwell is checked
+	DefaultWatchdogInterval = 5 * time.Second
+
+	// DefaultFailedDwell is how long a connection may sit in StateFailed,
+	// e.g. after the reconnect manager gives up, before it is re-dialled
+	DefaultFailedDwell = 2 * time.Minute
+)
+
+// StateWatchdogConfig bounds how long a connection may stay in a state.
+// When a limit is exceeded the connection is torn down and re-dialled, so a
+// dial that never resolves or a connection nobody retries recovers on its own.
+type StateWatchdogConfig struct {
+	Enabled       bool          `json:"enabled"`
+	CheckInterval time.Duration `json:"check_interval"`
+
+	// MaxDwell is the longest time allowed per state; states not listed
+	// may last indefinitely. StateConnected and StateStopped are never
+	// limited.
+	MaxDwell map[ConnectionState]time.Duration `json:"max_dwell"`
+}
+
+// DefaultStateWatchdogConfig allows two connect timeouts in StateConnecting
+// and two minutes in StateFailed
+func DefaultStateWatchdogConfig(connectTimeout time.Duration) StateWatchdogConfig {
+	return StateWatchdogConfig{
+		Enabled:       true,
+		CheckInterval: DefaultWatchdogInterval,
+		MaxDwell: map[ConnectionState]time.Duration{
+			StateConnecting: 2 * connectTimeout,
+			StateFailed:     DefaultFailedDwell,
+		},
+	}
+}
+
+func (cm *connectionManager) startStateWatchdog() {
+	if !cm.config.StateWatchdog.Enabled || cm.config.StateWatchdog.CheckInterval <= 0 {
+		return
+	}
+	cm.watchdogOnce.Do(func() {
+		cm.wg.Add(1)
+		go cm.watchState()
+	})
+}
+
+func (cm *connectionManager) watchState() {
+	defer cm.wg.Done()
+
+	ticker := time.NewTicker(cm.config.StateWatchdog.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-cm.stopCh:
+			return
+		case <-ticker.C:
+			cm.checkStateDwell()
+		}
+	}
+}
+
+// checkStateDwell tears down a connection that has outstayed its state's
+// limit and dials again. The in-flight attempt, if any, is cancelled and
+// its result discarded.
+func (cm *connectionManager) checkStateDwell() {
+	cm.stateMutex.Lock()
+	state := cm.state
+	dwell := time.Since(cm.stateSince)
+	limit, limited := cm.config.StateWatchdog.MaxDwell[state]
+	if !limited || limit <= 0 || state == StateConnected || state == StateStopped || dwell < limit {
+		cm.stateMutex.Unlock()
+		return
+	}
+
+	cm.dialAttempt++
+	if cm.cancel != nil {
+		cm.cancel()
+	}
+	conn := cm.conn
+	cm.conn = nil
+	cm.wedgedRecoveries[state]++
+	cm.setState(StateDisconnected)
+	parent := cm.parentCtx
+	cm.stateMutex.Unlock()
+
+	cm.logger.Warn("WebSocket wedged in %s for %v, forcing teardown and re-dial of %s", state, dwell.Round(time.Second), cm.config.URL)
+	if conn != nil {
+		conn.Close()
+	}
+
+	if parent == nil || parent.Err() != nil {
+		return
+	}
+	select {
+	case <-cm.stopCh:
+		return
+	default:
+	}
+	if err := cm.Connect(parent); err != nil {
+		cm.logger.Warn("Re-dial after wedged %s failed: %v", state, err)
+	}
+}
@@ -3,8 +3,10 @@ package connection
 import (
 	"context"
 	"net/http"
+	"net/url"
 	"time"
 
+	"github.com/backtesting-org/live-trading/pkg/websocket/performance"
 	"github.com/gorilla/websocket"
 )
 
@@ -22,6 +24,12 @@ type WebSocketDialer interface {
 	DialContext(ctx context.Context, urlStr string, requestHeader http.Header) (WebSocketConn, *http.Response, error)
 }
 
+// DialerStats is implemented by dialers that time their own dials; the
+// stats are merged into GetConnectionStats
+type DialerStats interface {
+	Stats() map[string]interface{}
+}
+
 // gorillaWebSocketConn adapts gorilla/websocket.Conn to our interface
 type gorillaWebSocketConn struct {
 	conn *websocket.Conn
@@ -49,21 +57,82 @@ func (g *gorillaWebSocketConn) SetWriteDeadline(t time.Time) error {
 
 // gorillaWebSocketDialer adapts gorilla/websocket.Dialer to our interface
 type gorillaWebSocketDialer struct {
-	dialer *websocket.Dialer
+	dialer  *websocket.Dialer
+	standby *standby
+
+	coldDials performance.LatencyHistogram
+	warmDials performance.LatencyHistogram
 }
 
 // NewGorillaDialer creates a production WebSocket dialer using gorilla/websocket
 func NewGorillaDialer(config Config) WebSocketDialer {
-	return &gorillaWebSocketDialer{
+	g := &gorillaWebSocketDialer{
 		dialer: &websocket.Dialer{
 			HandshakeTimeout: config.HandshakeTimeout,
 			ReadBufferSize:   config.ReadBufferSize,
 			WriteBufferSize:  config.WriteBufferSize,
 		},
+		coldDials: performance.NewLatencyHistogram(),
+		warmDials: performance.NewLatencyHistogram(),
+	}
+
+	lowLatency := config.LowLatency
+	if !lowLatency.Enabled {
+		return g
+	}
+
+	if lowLatency.PreResolveDNS {
+		refresh := lowLatency.DNSRefreshInterval
+		if refresh <= 0 {
+			refresh = DefaultDNSRefreshInterval
+		}
+		addresses := newAddressCache(refresh)
+		g.dialer.NetDialContext = addresses.dialContext
+
+		if u, err := url.Parse(config.URL); err == nil && u.Hostname() != "" {
+			go func() { _, _ = addresses.lookup(context.Background(), u.Hostname()) }()
+		}
+	}
+
+	if lowLatency.WarmStandby {
+		maxAge := lowLatency.StandbyMaxAge
+		if maxAge <= 0 {
+			maxAge = DefaultStandbyMaxAge
+		}
+		timeout := config.ConnectTimeout
+		if timeout <= 0 {
+			timeout = DefaultConfig().ConnectTimeout
+		}
+		g.standby = newStandby(g.dial, timeout, maxAge)
 	}
+
+	return g
 }
 
 func (g *gorillaWebSocketDialer) DialContext(ctx context.Context, urlStr string, requestHeader http.Header) (WebSocketConn, *http.Response, error) {
+	started := time.Now()
+
+	if g.standby != nil {
+		if conn, ok := g.standby.take(urlStr); ok {
+			g.warmDials.Record(time.Since(started))
+			g.standby.replenish(urlStr, requestHeader)
+			return conn, nil, nil
+		}
+	}
+
+	conn, resp, err := g.dial(ctx, urlStr, requestHeader)
+	if err != nil {
+		return nil, resp, err
+	}
+	g.coldDials.Record(time.Since(started))
+
+	if g.standby != nil {
+		g.standby.replenish(urlStr, requestHeader)
+	}
+	return conn, resp, nil
+}
+
+func (g *gorillaWebSocketDialer) dial(ctx context.Context, urlStr string, requestHeader http.Header) (WebSocketConn, *http.Response, error) {
 	conn, resp, err := g.dialer.DialContext(ctx, urlStr, requestHeader)
 	if err != nil {
 		return nil, resp, err
@@ -72,3 +141,30 @@ func (g *gorillaWebSocketDialer) DialContext(ctx context.Context, urlStr string,
 	// Wrap gorilla conn in our adapter
 	return &gorillaWebSocketConn{conn: conn}, resp, nil
 }
+
+// Stats reports cold dials next to standby pick-ups, which is the gain
+// low-latency mode buys on reconnect
+func (g *gorillaWebSocketDialer) Stats() map[string]interface{} {
+	stats := map[string]interface{}{
+		"dial_cold_count":  g.coldDials.Count(),
+		"dial_cold_p50_us": g.coldDials.Percentile(0.50).Microseconds(),
+		"dial_cold_p99_us": g.coldDials.Percentile(0.99).Microseconds(),
+		"dial_warm_count":  g.warmDials.Count(),
+		"dial_warm_p50_us": g.warmDials.Percentile(0.50).Microseconds(),
+		"dial_warm_p99_us": g.warmDials.Percentile(0.99).Microseconds(),
+	}
+	if g.standby != nil {
+		hits, misses := g.standby.stats()
+		stats["standby_hits"] = hits
+		stats["standby_misses"] = misses
+	}
+	return stats
+}
+
+// Close drops the standby connection and stops refreshing it
+func (g *gorillaWebSocketDialer) Close() error {
+	if g.standby == nil {
+		return nil
+	}
+	return g.standby.close()
+}
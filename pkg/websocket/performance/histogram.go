@@ -0,0 +1,119 @@
+package performance
+
+import (
+	"math/bits"
+	"sync"
+	"time"
+)
+
+// subBucketBits controls histogram precision: each power-of-two range is split
+// into 2^subBucketBits linear buckets, keeping relative error below 1%.
+const (
+	subBucketBits  = 7
+	subBucketCount = 1 << subBucketBits
+)
+
+type latencyHistogram struct {
+	counts map[int]int64
+	total  int64
+	max    int64
+	mutex  sync.RWMutex
+}
+
+// NewLatencyHistogram creates a log-linear histogram with microsecond resolution
+func NewLatencyHistogram() LatencyHistogram {
+	return &latencyHistogram{
+		counts: make(map[int]int64),
+	}
+}
+
+func (h *latencyHistogram) Record(latency time.Duration) {
+	value := latency.Microseconds()
+	if value < 0 {
+		value = 0
+	}
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	h.counts[bucketIndex(value)]++
+	h.total++
+	if value > h.max {
+		h.max = value
+	}
+}
+
+// Percentile returns the upper bound of the bucket holding the q-th quantile (0 < q <= 1)
+func (h *latencyHistogram) Percentile(q float64) time.Duration {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	if h.total == 0 {
+		return 0
+	}
+	if q <= 0 {
+		q = 0
+	}
+	if q >= 1 {
+		return time.Duration(h.max) * time.Microsecond
+	}
+
+	target := int64(q*float64(h.total) + 0.5)
+	if target < 1 {
+		target = 1
+	}
+
+	maxIndex := bucketIndex(h.max)
+	var seen int64
+	for index := 0; index <= maxIndex; index++ {
+		seen += h.counts[index]
+		if seen >= target {
+			upper := bucketUpperBound(index)
+			if upper > h.max {
+				upper = h.max
+			}
+			return time.Duration(upper) * time.Microsecond
+		}
+	}
+
+	return time.Duration(h.max) * time.Microsecond
+}
+
+func (h *latencyHistogram) Count() int64 {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+	return h.total
+}
+
+func (h *latencyHistogram) Reset() {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.counts = make(map[int]int64)
+	h.total = 0
+	h.max = 0
+}
+
+// bucketIndex maps a value to its bucket; values below subBucketCount are exact
+func bucketIndex(value int64) int {
+	if value < subBucketCount {
+		return int(value)
+	}
+
+	exponent := bits.Len64(uint64(value)) - 1
+	shift := exponent - subBucketBits
+	sub := int(value>>shift) & (subBucketCount - 1)
+
+	return subBucketCount + shift*subBucketCount + sub
+}
+
+func bucketUpperBound(index int) int64 {
+	if index < subBucketCount {
+		return int64(index)
+	}
+
+	shift := (index - subBucketCount) / subBucketCount
+	sub := int64((index - subBucketCount) % subBucketCount)
+	lower := (int64(subBucketCount) + sub) << shift
+
+	return lower + (int64(1) << shift) - 1
+}
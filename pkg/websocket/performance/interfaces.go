@@ -9,6 +9,9 @@ type Metrics interface {
 	IncrementDropped()
 	IncrementConnectionError()
 	IncrementReconnection()
+
+	// RecordConnectionDuration records how long a dial and handshake took
+	RecordConnectionDuration(duration time.Duration)
 	GetStats() map[string]interface{}
 }
 
@@ -17,3 +20,11 @@ type CircuitBreaker interface {
 	Execute(fn func() error) error
 	GetState() string
 }
+
+// LatencyHistogram records latency samples and reports percentiles
+type LatencyHistogram interface {
+	Record(latency time.Duration)
+	Percentile(q float64) time.Duration
+	Count() int64
+	Reset()
+}
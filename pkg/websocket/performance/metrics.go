@@ -13,11 +13,17 @@ type metrics struct {
 	ReconnectionCount int64
 	LastMessageTime   time.Time
 	ProcessingLatency time.Duration
+	latencies         LatencyHistogram
+	ConnectLatency    time.Duration
+	connectLatencies  LatencyHistogram
 	mutex             sync.RWMutex
 }
 
 func NewMetrics() Metrics {
-	return &metrics{}
+	return &metrics{
+		latencies:        NewLatencyHistogram(),
+		connectLatencies: NewLatencyHistogram(),
+	}
 }
 
 func (m *metrics) IncrementReceived() {
@@ -32,6 +38,7 @@ func (m *metrics) IncrementProcessed(latency time.Duration) {
 	defer m.mutex.Unlock()
 	m.MessagesProcessed++
 	m.ProcessingLatency = latency
+	m.latencies.Record(latency)
 }
 
 func (m *metrics) IncrementDropped() {
@@ -52,6 +59,13 @@ func (m *metrics) IncrementReconnection() {
 	m.ReconnectionCount++
 }
 
+func (m *metrics) RecordConnectionDuration(duration time.Duration) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.ConnectLatency = duration
+	m.connectLatencies.Record(duration)
+}
+
 func (m *metrics) GetStats() map[string]interface{} {
 	m.mutex.RLock()
 	defer m.mutex.RUnlock()
@@ -64,5 +78,11 @@ func (m *metrics) GetStats() map[string]interface{} {
 		"reconnection_count":    m.ReconnectionCount,
 		"last_message_time":     m.LastMessageTime,
 		"processing_latency_ms": m.ProcessingLatency.Milliseconds(),
+		"processing_p50_us":     m.latencies.Percentile(0.50).Microseconds(),
+		"processing_p95_us":     m.latencies.Percentile(0.95).Microseconds(),
+		"processing_p99_us":     m.latencies.Percentile(0.99).Microseconds(),
+		"connect_latency_ms":    m.ConnectLatency.Milliseconds(),
+		"connect_p50_ms":        m.connectLatencies.Percentile(0.50).Milliseconds(),
+		"connect_p99_ms":        m.connectLatencies.Percentile(0.99).Milliseconds(),
 	}
 }
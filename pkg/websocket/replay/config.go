@@ -0,0 +1,26 @@
+// Package replay records raw WebSocket frames and plays them back through the
+// connection manager in place of an exchange. Both dialers satisfy
+// connection.WebSocketDialer, so a connector is switched into record or replay
+// mode by swapping its dialer, e.g. decorating name:"hyperliquid_dialer".
+package replay
+
+// DefaultSpeed replays frames at their originally recorded pace
+const DefaultSpeed = 1.0
+
+// Config controls how recorded frames are played back
+type Config struct {
+	// Path is the JSONL file of recorded frames
+	Path string
+	// Speed multiplies the recorded pace; 2 plays twice as fast, 0 plays without delays
+	Speed float64
+	// URL restricts playback to frames recorded from this endpoint; empty replays everything
+	URL string
+}
+
+// DefaultConfig returns a config that replays at recorded speed
+func DefaultConfig(path string) Config {
+	return Config{
+		Path:  path,
+		Speed: DefaultSpeed,
+	}
+}
@@ -0,0 +1,75 @@
+package replay
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/live-trading/pkg/jsonl"
+)
+
+// Frame is a single inbound WebSocket message as written to a recording
+type Frame struct {
+	At   time.Time `json:"at"`
+	URL  string    `json:"url"`
+	Type int       `json:"type"`
+	Data string    `json:"data"`
+}
+
+// LoadFrames reads a JSONL recording, keeping only frames from url when it is set
+func LoadFrames(path, url string) ([]Frame, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open recording: %w", err)
+	}
+	defer file.Close()
+
+	var frames []Frame
+	var parseErr error
+	err = jsonl.Scan(file, func(number int, line []byte) error {
+		var frame Frame
+		if err := json.Unmarshal(line, &frame); err != nil {
+			parseErr = fmt.Errorf("failed to parse frame on line %d: %w", number, err)
+			return parseErr
+		}
+		if url == "" || frame.URL == url {
+			frames = append(frames, frame)
+		}
+		return nil
+	})
+	if parseErr != nil {
+		return nil, parseErr
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read recording: %w", err)
+	}
+	return frames, nil
+}
+
+// frameWriter appends frames to a recording, shared by every connection a dialer opens
+type frameWriter struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+func newFrameWriter(path string) (*frameWriter, error) {
+	file, err := jsonl.OpenAppend(path, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open recording: %w", err)
+	}
+	return &frameWriter{file: file}, nil
+}
+
+func (w *frameWriter) write(frame Frame) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return jsonl.Append(w.file, frame)
+}
+
+func (w *frameWriter) close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.file.Close()
+}
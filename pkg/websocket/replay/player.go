@@ -0,0 +1,120 @@
+package replay
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/backtesting-org/live-trading/pkg/websocket/connection"
+)
+
+// ErrClosed is returned by reads on a replay connection after it has been closed
+var ErrClosed = errors.New("replay connection closed")
+
+// ReplayDialer serves recorded frames in place of an exchange connection.
+// Outbound messages are discarded, and reconnects resume from the next unplayed
+// frame so a reconnect never delivers the same message twice.
+type ReplayDialer struct {
+	speed float64
+
+	mu     sync.Mutex
+	frames []Frame
+	cursor int
+	last   time.Time
+}
+
+// NewReplayDialer loads the recording described by config
+func NewReplayDialer(config Config) (*ReplayDialer, error) {
+	frames, err := LoadFrames(config.Path, config.URL)
+	if err != nil {
+		return nil, err
+	}
+	if config.Speed < 0 {
+		config.Speed = DefaultSpeed
+	}
+	return &ReplayDialer{speed: config.Speed, frames: frames}, nil
+}
+
+func (d *ReplayDialer) DialContext(ctx context.Context, _ string, _ http.Header) (connection.WebSocketConn, *http.Response, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	return &replayConn{dialer: d, closed: make(chan struct{})}, nil, nil
+}
+
+// Remaining reports how many frames have not been played yet
+func (d *ReplayDialer) Remaining() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return len(d.frames) - d.cursor
+}
+
+// Done reports whether every recorded frame has been played
+func (d *ReplayDialer) Done() bool {
+	return d.Remaining() == 0
+}
+
+// next returns the next frame and how long to wait before delivering it
+func (d *ReplayDialer) next() (Frame, time.Duration, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.cursor >= len(d.frames) {
+		return Frame{}, 0, false
+	}
+	frame := d.frames[d.cursor]
+	d.cursor++
+
+	var wait time.Duration
+	if d.speed > 0 && !d.last.IsZero() && frame.At.After(d.last) {
+		wait = time.Duration(float64(frame.At.Sub(d.last)) / d.speed)
+	}
+	d.last = frame.At
+	return frame, wait, true
+}
+
+type replayConn struct {
+	dialer    *ReplayDialer
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func (c *replayConn) ReadMessage() (int, []byte, error) {
+	frame, wait, ok := c.dialer.next()
+	if !ok {
+		// The recording is exhausted; hold the connection open until it is torn down
+		<-c.closed
+		return 0, nil, io.EOF
+	}
+	if wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-c.closed:
+			return 0, nil, ErrClosed
+		}
+	}
+	return frame.Type, []byte(frame.Data), nil
+}
+
+func (c *replayConn) WriteMessage(_ int, _ []byte) error {
+	select {
+	case <-c.closed:
+		return ErrClosed
+	default:
+		return nil
+	}
+}
+
+func (c *replayConn) Close() error {
+	c.closeOnce.Do(func() { close(c.closed) })
+	return nil
+}
+
+func (c *replayConn) SetReadDeadline(_ time.Time) error { return nil }
+
+func (c *replayConn) SetWriteDeadline(_ time.Time) error { return nil }
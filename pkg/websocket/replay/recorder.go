@@ -0,0 +1,53 @@
+package replay
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/backtesting-org/live-trading/pkg/websocket/connection"
+)
+
+// RecordingDialer wraps a live dialer and appends every inbound frame to a recording
+type RecordingDialer struct {
+	inner  connection.WebSocketDialer
+	writer *frameWriter
+}
+
+// NewRecordingDialer records frames read through inner to the JSONL file at path
+func NewRecordingDialer(inner connection.WebSocketDialer, path string) (*RecordingDialer, error) {
+	writer, err := newFrameWriter(path)
+	if err != nil {
+		return nil, err
+	}
+	return &RecordingDialer{inner: inner, writer: writer}, nil
+}
+
+func (d *RecordingDialer) DialContext(ctx context.Context, urlStr string, requestHeader http.Header) (connection.WebSocketConn, *http.Response, error) {
+	conn, resp, err := d.inner.DialContext(ctx, urlStr, requestHeader)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &recordingConn{WebSocketConn: conn, url: urlStr, writer: d.writer}, resp, nil
+}
+
+// Close flushes and closes the recording file
+func (d *RecordingDialer) Close() error {
+	return d.writer.close()
+}
+
+type recordingConn struct {
+	connection.WebSocketConn
+	url    string
+	writer *frameWriter
+}
+
+func (c *recordingConn) ReadMessage() (int, []byte, error) {
+	messageType, data, err := c.WebSocketConn.ReadMessage()
+	if err != nil {
+		return messageType, data, err
+	}
+	// A failed write must not break the live stream, so the frame is only lost from the recording
+	_ = c.writer.write(Frame{At: time.Now(), URL: c.url, Type: messageType, Data: string(data)})
+	return messageType, data, nil
+}
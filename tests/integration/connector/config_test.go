@@ -5,8 +5,10 @@ import (
 	"path/filepath"
 
 	"github.com/backtesting-org/kronos-sdk/pkg/types/connector"
+	"github.com/backtesting-org/live-trading/pkg/connectors/binance"
 	"github.com/backtesting-org/live-trading/pkg/connectors/bybit"
 	"github.com/backtesting-org/live-trading/pkg/connectors/hyperliquid"
+	"github.com/backtesting-org/live-trading/pkg/connectors/okx"
 	"github.com/backtesting-org/live-trading/pkg/connectors/paradex"
 	"github.com/backtesting-org/live-trading/pkg/connectors/types"
 	"github.com/joho/godotenv"
@@ -24,7 +26,7 @@ func init() {
 // ========================================
 const (
 	// Which connector to test
-	testConnectorName = types.Hyperliquid // Change to types.Paradex or types.Bybit
+	testConnectorName = types.Hyperliquid // Change to types.Paradex, types.Bybit, types.Binance or types.Okx
 
 	// Test asset
 	testSymbol = "ETH"
@@ -48,6 +50,10 @@ func getConnectorConfig(name connector.ExchangeName) connector.Config {
 		return getParadexConfig()
 	case types.Bybit:
 		return getBybitConfig()
+	case types.Binance:
+		return getBinanceConfig()
+	case types.Okx:
+		return getOkxConfig()
 	default:
 		panic("unknown connector: " + name)
 	}
@@ -94,6 +100,23 @@ func getBybitConfig() connector.Config {
 	}
 }
 
+func getBinanceConfig() connector.Config {
+	return &binance.Config{
+		APIKey:    mustGetEnv("BINANCE_API_KEY"),
+		APISecret: mustGetEnv("BINANCE_API_SECRET"),
+		IsTestnet: getEnv("BINANCE_TESTNET", "true") == "true",
+	}
+}
+
+func getOkxConfig() connector.Config {
+	return &okx.Config{
+		APIKey:     mustGetEnv("OKX_API_KEY"),
+		APISecret:  mustGetEnv("OKX_API_SECRET"),
+		Passphrase: mustGetEnv("OKX_PASSPHRASE"),
+		IsTestnet:  getEnv("OKX_TESTNET", "true") == "true",
+	}
+}
+
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value